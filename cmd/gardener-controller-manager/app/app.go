@@ -59,6 +59,8 @@ func NewCommand() *cobra.Command {
 	verflag.AddFlags(flags)
 	opts.addFlags(flags)
 
+	cmd.AddCommand(newRestoreGardenCommand())
+
 	return cmd
 }
 
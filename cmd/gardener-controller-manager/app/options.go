@@ -13,11 +13,13 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/cmd/utils/initrun"
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
 	controllermanagervalidation "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1/validation"
 	"github.com/gardener/gardener/pkg/features"
+	"github.com/gardener/gardener/pkg/logger"
 )
 
 var configDecoder runtime.Decoder
@@ -69,3 +71,14 @@ func (o *options) Validate() error {
 func (o *options) LogConfig() (string, string) {
 	return o.config.LogLevel, o.config.LogFormat
 }
+
+func (o *options) LogSamplingConfig() *logger.SamplingConfig {
+	if o.config.LogSampling == nil {
+		return nil
+	}
+
+	return &logger.SamplingConfig{
+		Initial:    int(ptr.Deref(o.config.LogSampling.Initial, 100)),
+		Thereafter: int(ptr.Deref(o.config.LogSampling.Thereafter, 100)),
+	}
+}
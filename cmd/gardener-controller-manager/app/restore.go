@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/gardener/backup"
+)
+
+type restoreGardenOptions struct {
+	snapshotFile      string
+	encryptionKeyFile string
+	kubeconfig        string
+}
+
+// newRestoreGardenCommand creates a new cobra.Command for restoring garden cluster resources from a snapshot
+// exported by the GardenBackup controller.
+func newRestoreGardenCommand() *cobra.Command {
+	opts := &restoreGardenOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "restore-garden",
+		Short: "Restore garden cluster resources from a snapshot exported by the GardenBackup controller",
+		Long: "Restore garden cluster resources from a snapshot exported by the GardenBackup controller. " +
+			"Resources contained in the snapshot which do not exist in the garden cluster anymore are re-created, " +
+			"existing resources are left untouched.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := opts.validate(); err != nil {
+				return err
+			}
+			return opts.run(cmd)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&opts.snapshotFile, "snapshot", "", "Path to the snapshot file to restore from")
+	flags.StringVar(&opts.encryptionKeyFile, "encryption-key-file", "", "Path to a file containing the 32 byte key with which the snapshot is encrypted")
+	flags.StringVar(&opts.kubeconfig, "kubeconfig", os.Getenv("KUBECONFIG"), "Path to a kubeconfig for the garden cluster")
+
+	return cmd
+}
+
+func (o *restoreGardenOptions) validate() error {
+	if o.snapshotFile == "" {
+		return fmt.Errorf("--snapshot is required")
+	}
+	if o.encryptionKeyFile == "" {
+		return fmt.Errorf("--encryption-key-file is required")
+	}
+	if o.kubeconfig == "" {
+		return fmt.Errorf("--kubeconfig or the KUBECONFIG environment variable is required")
+	}
+	return nil
+}
+
+func (o *restoreGardenOptions) run(cmd *cobra.Command) error {
+	data, err := os.ReadFile(o.snapshotFile)
+	if err != nil {
+		return fmt.Errorf("failed reading snapshot file: %w", err)
+	}
+
+	encryptionKey, err := os.ReadFile(o.encryptionKeyFile)
+	if err != nil {
+		return fmt.Errorf("failed reading encryption key file: %w", err)
+	}
+
+	restConfig, err := kubernetes.RESTConfigFromKubeconfigFile(o.kubeconfig, kubernetes.AuthTokenFile)
+	if err != nil {
+		return err
+	}
+
+	c, err := client.New(restConfig, client.Options{Scheme: kubernetes.GardenScheme})
+	if err != nil {
+		return fmt.Errorf("failed creating garden client: %w", err)
+	}
+
+	if err := backup.Import(cmd.Context(), c, data, bytes.TrimSpace(encryptionKey)); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), "Successfully restored garden cluster resources from snapshot")
+	return nil
+}
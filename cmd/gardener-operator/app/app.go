@@ -31,6 +31,7 @@ import (
 	"github.com/gardener/gardener/cmd/utils/initrun"
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/extensions/pkg/webhook/certificates"
+	"github.com/gardener/gardener/imagevector"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	clientmapbuilder "github.com/gardener/gardener/pkg/client/kubernetes/clientmap/builder"
@@ -76,6 +77,13 @@ func NewCommand() *cobra.Command {
 func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *operatorconfigv1alpha1.OperatorConfiguration) error {
 	log.Info("Feature Gates", "featureGates", features.DefaultFeatureGate)
 
+	if verification := cfg.ImageVectorVerification; verification != nil {
+		log.Info("Verifying image vector signatures")
+		if err := imagevector.VerifySignatures(verification.PublicKeys); err != nil {
+			return fmt.Errorf("failed verifying image vector signatures: %w", err)
+		}
+	}
+
 	log.Info("Getting rest config")
 	if kubeconfig := os.Getenv("KUBECONFIG"); kubeconfig != "" {
 		cfg.RuntimeClientConnection.Kubeconfig = kubeconfig
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
 	goruntime "runtime"
 	"strconv"
@@ -43,6 +44,7 @@ import (
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	"github.com/gardener/gardener/cmd/utils/initrun"
+	"github.com/gardener/gardener/imagevector"
 	"github.com/gardener/gardener/pkg/api/indexer"
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
@@ -96,12 +98,44 @@ func NewCommand() *cobra.Command {
 	verflag.AddFlags(flags)
 	opts.addFlags(flags)
 
+	cmd.AddCommand(newPreflightCommand())
+
 	return cmd
 }
 
 func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *gardenletconfigv1alpha1.GardenletConfiguration) error {
 	log.Info("Feature Gates", "featureGates", features.DefaultFeatureGate)
 
+	if override := cfg.ImageVectorOverride; override != nil {
+		log.Info("Applying image vector override from gardenlet configuration")
+
+		if v := override.Containers; v != nil {
+			if err := imagevector.OverrideContainers([]byte(*v)); err != nil {
+				return fmt.Errorf("failed applying containers image vector override: %w", err)
+			}
+		}
+
+		if v := override.Charts; v != nil {
+			if err := imagevector.OverrideCharts([]byte(*v)); err != nil {
+				return fmt.Errorf("failed applying charts image vector override: %w", err)
+			}
+		}
+
+		if ptr.Deref(override.ResolveTagsToDigests, false) {
+			log.Info("Resolving image vector tags to digests")
+			if err := imagevector.ResolveContainersTagsToDigests(); err != nil {
+				return fmt.Errorf("failed resolving image vector tags to digests: %w", err)
+			}
+		}
+	}
+
+	if verification := cfg.ImageVectorVerification; verification != nil {
+		log.Info("Verifying image vector signatures")
+		if err := imagevector.VerifySignatures(verification.PublicKeys); err != nil {
+			return fmt.Errorf("failed verifying image vector signatures: %w", err)
+		}
+	}
+
 	if kubeconfig := os.Getenv("GARDEN_KUBECONFIG"); kubeconfig != "" {
 		cfg.GardenClientConnection.Kubeconfig = kubeconfig
 	}
@@ -249,6 +283,15 @@ func (g *garden) Start(ctx context.Context) error {
 		return err
 	}
 
+	if proxyURL := g.config.GardenClientConnection.ProxyURL; proxyURL != nil {
+		u, err := url.Parse(*proxyURL)
+		if err != nil {
+			return fmt.Errorf("invalid proxy URL %q in garden client connection: %w", *proxyURL, err)
+		}
+		log.Info("Brokering connections to the garden cluster via tunnel/proxy server", "proxyURL", u.Redacted())
+		gardenRESTConfig.Proxy = http.ProxyURL(u)
+	}
+
 	if err := g.overwriteGardenHostWhenDeployedInRuntimeCluster(ctx, log, gardenRESTConfig); err != nil {
 		return err
 	}
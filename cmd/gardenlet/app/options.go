@@ -12,6 +12,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/cmd/utils/initrun"
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
@@ -19,6 +20,7 @@ import (
 	"github.com/gardener/gardener/pkg/features"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	gardenletvalidation "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1/validation"
+	"github.com/gardener/gardener/pkg/logger"
 )
 
 var configDecoder runtime.Decoder
@@ -84,3 +86,14 @@ func (o *options) Validate() error {
 func (o *options) LogConfig() (string, string) {
 	return o.config.LogLevel, o.config.LogFormat
 }
+
+func (o *options) LogSamplingConfig() *logger.SamplingConfig {
+	if o.config.LogSampling == nil {
+		return nil
+	}
+
+	return &logger.SamplingConfig{
+		Initial:    int(ptr.Deref(o.config.LogSampling.Initial, 100)),
+		Thereafter: int(ptr.Deref(o.config.LogSampling.Thereafter, 100)),
+	}
+}
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/preflight"
+)
+
+// newPreflightCommand creates a new cobra.Command for validating a prospective seed cluster before registration.
+func newPreflightCommand() *cobra.Command {
+	opts := &preflightOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "preflight",
+		Short: "Validate a prospective seed cluster before registration",
+		Long: "Validate a prospective seed cluster against the requirements of gardenlet (Kubernetes version, CNI " +
+			"capabilities, load balancer support, required CustomResourceDefinitions, wildcard DNS record) and print a " +
+			"machine-readable report. The command exits with a non-zero code if any check fails.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := opts.validate(); err != nil {
+				return err
+			}
+			return opts.run(cmd.Context(), cmd.OutOrStdout())
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+type preflightOptions struct {
+	kubeconfig    string
+	ingressDomain string
+}
+
+func (o *preflightOptions) addFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.kubeconfig, "kubeconfig", "", "Path to a kubeconfig for the prospective seed cluster")
+	fs.StringVar(&o.ingressDomain, "ingress-domain", "", "Ingress domain the seed shall be registered with (if set, the wildcard DNS record for the domain is checked for resolvability)")
+}
+
+func (o *preflightOptions) validate() error {
+	if o.kubeconfig == "" {
+		return fmt.Errorf("must provide a path to a kubeconfig for the prospective seed cluster via --kubeconfig")
+	}
+	return nil
+}
+
+func (o *preflightOptions) run(ctx context.Context, out io.Writer) error {
+	restConfig, err := kubernetes.RESTConfigFromKubeconfigFile(o.kubeconfig)
+	if err != nil {
+		return fmt.Errorf("failed creating REST config from kubeconfig file %s: %w", o.kubeconfig, err)
+	}
+
+	seedClient, err := client.New(restConfig, client.Options{Scheme: kubernetes.SeedScheme})
+	if err != nil {
+		return fmt.Errorf("failed creating client for the prospective seed cluster: %w", err)
+	}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("failed creating discovery client for the prospective seed cluster: %w", err)
+	}
+
+	checker := &preflight.Checker{
+		Client:          seedClient,
+		DiscoveryClient: discoveryClient,
+		IngressDomain:   o.ingressDomain,
+		LookupHost:      net.DefaultResolver.LookupHost,
+	}
+
+	report := checker.RunChecks(ctx)
+
+	output, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed marshalling the preflight report: %w", err)
+	}
+
+	if _, err := fmt.Fprintln(out, string(output)); err != nil {
+		return err
+	}
+
+	if !report.Succeeded {
+		return fmt.Errorf("the cluster does not fulfill the requirements for being registered as a seed, see the report for details")
+	}
+
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	"k8s.io/component-base/version/verflag"
 	"k8s.io/klog/v2"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	"github.com/gardener/gardener/pkg/logger"
 )
@@ -32,6 +33,13 @@ type Options interface {
 	LogConfig() (logLevel, logFormat string)
 }
 
+// SamplingOptions is an optional interface for Options whose component config supports sampling of repeated log
+// entries.
+type SamplingOptions interface {
+	// LogSamplingConfig returns the log sampling config. If nil, no sampling is performed.
+	LogSamplingConfig() *logger.SamplingConfig
+}
+
 // InitRun initializes the run command by completing and validating the options, creating and settings a logger,
 // printing all command line flags, and configuring command settings.
 func InitRun(cmd *cobra.Command, opts Options, name string) (logr.Logger, error) {
@@ -45,8 +53,15 @@ func InitRun(cmd *cobra.Command, opts Options, name string) (logr.Logger, error)
 		return logr.Discard(), err
 	}
 
+	var zapOpts []logzap.Opts
+	if samplingOpts, ok := opts.(SamplingOptions); ok {
+		if config := samplingOpts.LogSamplingConfig(); config != nil {
+			zapOpts = append(zapOpts, logger.Sampling(*config))
+		}
+	}
+
 	logLevel, logFormat := opts.LogConfig()
-	log, err := logger.NewZapLogger(logLevel, logFormat)
+	log, err := logger.NewZapLogger(logLevel, logFormat, zapOpts...)
 	if err != nil {
 		return logr.Discard(), fmt.Errorf("error instantiating zap logger: %w", err)
 	}
@@ -49,6 +49,15 @@ func ReconcileError(t gardencorev1beta1.LastOperationType, description string, p
 	return LastOperation(t, gardencorev1beta1.LastOperationStateError, progress, description), LastError(description, codes...)
 }
 
+// ReportProgress patches the structured progress information in the status of the given extension resource. It can be
+// called while an operation is being processed in order to publish fine-grained progress information, e.g. the
+// completion percentage and the step the controller is currently working on.
+func ReportProgress(ctx context.Context, c client.Client, obj extensionsv1alpha1.Object, progress *extensionsv1alpha1.OperationProgress) error {
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	obj.GetExtensionStatus().SetProgress(progress)
+	return c.Status().Patch(ctx, obj, patch)
+}
+
 // StatusUpdater contains functions for updating statuses of extension resources after a controller operation.
 type StatusUpdater interface {
 	// Processing updates the last operation of an extension resource when an operation is started.
@@ -157,6 +166,7 @@ func (s *statusUpdater) ErrorCustom(
 	obj.GetExtensionStatus().SetObservedGeneration(obj.GetGeneration())
 	obj.GetExtensionStatus().SetLastOperation(lastOp)
 	obj.GetExtensionStatus().SetLastError(lastErr)
+	obj.GetExtensionStatus().SetProgress(nil)
 	if updater != nil {
 		err := updater(obj.GetExtensionStatus())
 		if err != nil {
@@ -195,6 +205,7 @@ func (s *statusUpdater) SuccessCustom(
 	obj.GetExtensionStatus().SetObservedGeneration(obj.GetGeneration())
 	obj.GetExtensionStatus().SetLastOperation(lastOp)
 	obj.GetExtensionStatus().SetLastError(lastErr)
+	obj.GetExtensionStatus().SetProgress(nil)
 	if updater != nil {
 		err := updater(obj.GetExtensionStatus())
 		if err != nil {
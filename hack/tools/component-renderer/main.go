@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/component/clusteridentity"
+	"github.com/gardener/gardener/pkg/component/networking/dnscache"
+	"github.com/gardener/gardener/pkg/component/networking/nginxingress"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+// renderFunc constructs a component from the given values and returns it together with the name of the
+// ManagedResource it renders its manifests into.
+type renderFunc func(c client.Client, namespace string, valuesData []byte) (component.Deployer, string, error)
+
+var components = map[string]renderFunc{
+	"cluster-identity": func(c client.Client, namespace string, valuesData []byte) (component.Deployer, string, error) {
+		values := struct {
+			Identity string `json:"identity"`
+		}{}
+		if err := yaml.Unmarshal(valuesData, &values); err != nil {
+			return nil, "", err
+		}
+		return clusteridentity.NewForSeed(c, namespace, values.Identity), clusteridentity.ManagedResourceControlName, nil
+	},
+	"dns-cache": func(c client.Client, namespace string, valuesData []byte) (component.Deployer, string, error) {
+		values := dnscache.Values{}
+		if err := yaml.Unmarshal(valuesData, &values); err != nil {
+			return nil, "", err
+		}
+		return dnscache.New(c, namespace, values), dnscache.ManagedResourceName, nil
+	},
+	"nginx-ingress": func(c client.Client, namespace string, valuesData []byte) (component.Deployer, string, error) {
+		values := nginxingress.Values{}
+		if err := yaml.Unmarshal(valuesData, &values); err != nil {
+			return nil, "", err
+		}
+
+		managedResourceName := "nginx-ingress"
+		if values.ClusterType == component.ClusterTypeShoot {
+			managedResourceName = "shoot-addon-nginx-ingress"
+		}
+
+		return nginxingress.New(c, namespace, values), managedResourceName, nil
+	},
+}
+
+func main() {
+	const short = "Render the manifests of a component (see pkg/component) to YAML"
+
+	var (
+		componentName string
+		namespace     string
+		valuesFile    string
+		outputFile    string
+	)
+
+	cmd := &cobra.Command{
+		Use: "component-renderer --component name [--namespace namespace] [--values file] [--output file]",
+
+		Short: short,
+		Long: short + `
+
+component-renderer runs the Deploy function of the selected component against a fake cluster and prints the
+manifests rendered into its ManagedResource as a multi-document YAML. The values file is unmarshalled into the
+Values struct of the component. The output can be stored as a golden file to make rendered-manifest changes
+reviewable in pull requests, or diffed between two revisions when operating Gardener.
+
+Example values file for --component dns-cache:
+
+  image: registry.example.com/coredns:v1.23.0
+`,
+
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cmd.SilenceUsage = true
+			return run(componentName, namespace, valuesFile, outputFile)
+		},
+	}
+
+	cmd.Flags().StringVar(&componentName, "component", "", "name of the component to render (one of: "+strings.Join(componentNames(), ", ")+")")
+	cmd.Flags().StringVar(&namespace, "namespace", "garden", "namespace the component is rendered for")
+	cmd.Flags().StringVar(&valuesFile, "values", "", "path to a YAML file with the values for the component")
+	cmd.Flags().StringVar(&outputFile, "output", "", "path to the file the rendered manifests are written to (defaults to stdout)")
+
+	if err := cmd.Execute(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(componentName, namespace, valuesFile, outputFile string) error {
+	render, ok := components[componentName]
+	if !ok {
+		return fmt.Errorf("unknown component %q (supported components: %s)", componentName, strings.Join(componentNames(), ", "))
+	}
+
+	var (
+		valuesData []byte
+		err        error
+	)
+
+	if valuesFile != "" {
+		valuesData, err = os.ReadFile(valuesFile)
+		if err != nil {
+			return fmt.Errorf("failed reading values file: %w", err)
+		}
+	}
+
+	c := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+	deployer, managedResourceName, err := render(c, namespace, valuesData)
+	if err != nil {
+		return fmt.Errorf("failed constructing component %q: %w", componentName, err)
+	}
+
+	manifests, err := test.RenderComponent(context.Background(), c, deployer, namespace, managedResourceName)
+	if err != nil {
+		return fmt.Errorf("failed rendering component %q: %w", componentName, err)
+	}
+
+	output := test.CombineManifests(manifests)
+
+	if outputFile == "" {
+		fmt.Print(output)
+		return nil
+	}
+
+	return os.WriteFile(outputFile, []byte(output), 0o644)
+}
+
+func componentNames() []string {
+	names := make([]string, 0, len(components))
+	for name := range components {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
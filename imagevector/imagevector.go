@@ -49,3 +49,47 @@ func Containers() imagevector.ImageVector {
 func Charts() imagevector.ImageVector {
 	return chartsImageVector
 }
+
+// OverrideContainers merges the given raw image vector override into the containers image vector.
+func OverrideContainers(override []byte) error {
+	overridden, err := imagevector.WithOverride(containersImageVector, override)
+	if err != nil {
+		return err
+	}
+
+	containersImageVector = overridden
+	return nil
+}
+
+// OverrideCharts merges the given raw image vector override into the charts image vector.
+func OverrideCharts(override []byte) error {
+	overridden, err := imagevector.WithOverride(chartsImageVector, override)
+	if err != nil {
+		return err
+	}
+
+	chartsImageVector = overridden
+	return nil
+}
+
+// VerifySignatures verifies the cosign signatures of all images in the containers and charts image vectors against the
+// given PEM-encoded public keys.
+func VerifySignatures(publicKeys []string) error {
+	if err := imagevector.VerifySignatures(containersImageVector, publicKeys); err != nil {
+		return err
+	}
+
+	return imagevector.VerifySignatures(chartsImageVector, publicKeys)
+}
+
+// ResolveContainersTagsToDigests resolves the tags of all images in the containers image vector to their digests by
+// querying the respective registries.
+func ResolveContainersTagsToDigests() error {
+	resolved, err := imagevector.ResolveTagsToDigests(containersImageVector)
+	if err != nil {
+		return err
+	}
+
+	containersImageVector = resolved
+	return nil
+}
@@ -46,11 +46,23 @@ type ServerConfiguration struct {
 	// ResourceAdmissionConfiguration is the configuration for the resource admission.
 	// +optional
 	ResourceAdmissionConfiguration *ResourceAdmissionConfiguration `json:"resourceAdmissionConfiguration,omitempty"`
+	// KubeconfigSecretAdmissionConfiguration is the configuration for the kubeconfig secret admission. If set, secrets
+	// in project namespaces containing kubeconfigs with embedded static credentials are rejected.
+	// +optional
+	KubeconfigSecretAdmissionConfiguration *KubeconfigSecretAdmissionConfiguration `json:"kubeconfigSecretAdmissionConfiguration,omitempty"`
 	// EnableDebugHandlers determines whether the /debug/ handlers are enabled.
 	// +optional
 	EnableDebugHandlers *bool `json:"enableDebugHandlers,omitempty"`
 }
 
+// KubeconfigSecretAdmissionConfiguration contains settings for the validation of kubeconfig secrets in project
+// namespaces.
+type KubeconfigSecretAdmissionConfiguration struct {
+	// OperationMode specifies the mode the webhooks operates in. Allowed values are "block" and "log". Defaults to "block".
+	// +optional
+	OperationMode *ResourceAdmissionWebhookMode `json:"operationMode,omitempty"`
+}
+
 // ResourceAdmissionConfiguration contains settings about arbitrary kinds and the size each resource should have at most.
 type ResourceAdmissionConfiguration struct {
 	// Limits contains configuration for resources which are subjected to size limitations.
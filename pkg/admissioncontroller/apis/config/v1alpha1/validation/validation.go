@@ -35,6 +35,22 @@ func ValidateAdmissionControllerConfiguration(config *admissioncontrollerconfigv
 		allErrs = append(allErrs, ValidateResourceAdmissionConfiguration(config.Server.ResourceAdmissionConfiguration, serverPath.Child("resourceAdmissionConfiguration"))...)
 	}
 
+	if config.Server.KubeconfigSecretAdmissionConfiguration != nil {
+		allErrs = append(allErrs, ValidateKubeconfigSecretAdmissionConfiguration(config.Server.KubeconfigSecretAdmissionConfiguration, serverPath.Child("kubeconfigSecretAdmissionConfiguration"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateKubeconfigSecretAdmissionConfiguration validates the given `KubeconfigSecretAdmissionConfiguration`.
+func ValidateKubeconfigSecretAdmissionConfiguration(config *admissioncontrollerconfigv1alpha1.KubeconfigSecretAdmissionConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	validValues := sets.New(string(admissioncontrollerconfigv1alpha1.AdmissionModeBlock), string(admissioncontrollerconfigv1alpha1.AdmissionModeLog))
+
+	if config.OperationMode != nil && !validValues.Has(string(*config.OperationMode)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), string(*config.OperationMode), validValues.UnsortedList()))
+	}
+
 	return allErrs
 }
 
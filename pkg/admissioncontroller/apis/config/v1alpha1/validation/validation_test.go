@@ -90,6 +90,36 @@ var _ = Describe("#ValidateAdmissionControllerConfiguration", func() {
 			Entry("should deny non existing mode", "foo", Not(BeEmpty())),
 		)
 
+		DescribeTable("Kubeconfig secret operation mode validation",
+			func(mode string, matcher gomegatypes.GomegaMatcher) {
+				var (
+					admissionConfig *admissioncontrollerconfigv1alpha1.KubeconfigSecretAdmissionConfiguration
+					webhookMode     = admissioncontrollerconfigv1alpha1.ResourceAdmissionWebhookMode(mode)
+				)
+				if mode != "" {
+					admissionConfig = &admissioncontrollerconfigv1alpha1.KubeconfigSecretAdmissionConfiguration{
+						OperationMode: &webhookMode,
+					}
+				}
+
+				config := &admissioncontrollerconfigv1alpha1.AdmissionControllerConfiguration{
+					LogLevel:  "info",
+					LogFormat: "json",
+					Server: admissioncontrollerconfigv1alpha1.ServerConfiguration{
+						KubeconfigSecretAdmissionConfiguration: admissionConfig,
+					},
+				}
+
+				errs := ValidateAdmissionControllerConfiguration(config)
+
+				Expect(errs).To(matcher)
+			},
+			Entry("should allow no mode", "", BeEmpty()),
+			Entry("should allow blocking mode", "block", BeEmpty()),
+			Entry("should allow logging mode", "log", BeEmpty()),
+			Entry("should deny non existing mode", "foo", Not(BeEmpty())),
+		)
+
 		var (
 			apiGroups = []string{"core.gardener.cloud"}
 			versions  = []string{"v1beta1", "v1alpha1"}
@@ -65,6 +65,27 @@ func (in *HTTPSServer) DeepCopy() *HTTPSServer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeconfigSecretAdmissionConfiguration) DeepCopyInto(out *KubeconfigSecretAdmissionConfiguration) {
+	*out = *in
+	if in.OperationMode != nil {
+		in, out := &in.OperationMode, &out.OperationMode
+		*out = new(ResourceAdmissionWebhookMode)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeconfigSecretAdmissionConfiguration.
+func (in *KubeconfigSecretAdmissionConfiguration) DeepCopy() *KubeconfigSecretAdmissionConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeconfigSecretAdmissionConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceAdmissionConfiguration) DeepCopyInto(out *ResourceAdmissionConfiguration) {
 	*out = *in
@@ -174,6 +195,11 @@ func (in *ServerConfiguration) DeepCopyInto(out *ServerConfiguration) {
 		*out = new(ResourceAdmissionConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.KubeconfigSecretAdmissionConfiguration != nil {
+		in, out := &in.KubeconfigSecretAdmissionConfiguration, &out.KubeconfigSecretAdmissionConfiguration
+		*out = new(KubeconfigSecretAdmissionConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.EnableDebugHandlers != nil {
 		in, out := &in.EnableDebugHandlers, &out.EnableDebugHandlers
 		*out = new(bool)
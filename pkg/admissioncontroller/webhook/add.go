@@ -62,6 +62,7 @@ func AddToManager(
 
 	if err := (&kubeconfigsecret.Handler{
 		Logger: mgr.GetLogger().WithName("webhook").WithName(kubeconfigsecret.HandlerName),
+		Config: cfg.Server.KubeconfigSecretAdmissionConfiguration,
 	}).AddToManager(mgr); err != nil {
 		return fmt.Errorf("failed adding %s webhook handler: %w", kubeconfigsecret.HandlerName, err)
 	}
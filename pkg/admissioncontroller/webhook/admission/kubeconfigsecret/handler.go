@@ -15,19 +15,28 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	admissioncontrollerconfigv1alpha1 "github.com/gardener/gardener/pkg/admissioncontroller/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/admissioncontroller/metrics"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 )
 
-// metricReasonRejectedKubeconfig is a metric reason value for a reason when a kubeconfig was rejected.
-const metricReasonRejectedKubeconfig = "Rejected Kubeconfig"
+const (
+	// metricReasonRejectedKubeconfig is a metric reason value for a reason when a kubeconfig was rejected.
+	metricReasonRejectedKubeconfig = "Rejected Kubeconfig"
+	// metricReasonRejectedStaticCredentials is a metric reason value for a reason when a kubeconfig contained static
+	// credentials.
+	metricReasonRejectedStaticCredentials = "Rejected Static Credentials"
+)
 
 // Handler checks, if the secrets contains a kubeconfig and denies kubeconfigs with invalid fields (e.g. tokenFile or
-// exec).
+// exec). If Config is set, it additionally denies (or warns about, depending on the configured operation mode)
+// kubeconfigs with embedded static credentials.
 type Handler struct {
 	Logger logr.Logger
+	Config *admissioncontrollerconfigv1alpha1.KubeconfigSecretAdmissionConfiguration
 }
 
 // ValidateCreate performs the check.
@@ -91,5 +100,53 @@ func (h *Handler) handle(ctx context.Context, obj runtime.Object) (admission.War
 		return nil, apierrors.NewInvalid(schema.GroupKind{Group: corev1.GroupName, Kind: "Secret"}, secret.Name, field.ErrorList{field.Invalid(field.NewPath("data", "kubeconfig"), kubeconfig, fmt.Sprintf("secret contains invalid kubeconfig: %s", err))})
 	}
 
+	if h.Config != nil {
+		if err := validateNoStaticCredentials(rawConfig); err != nil {
+			if h.Config.OperationMode == nil || *h.Config.OperationMode == admissioncontrollerconfigv1alpha1.AdmissionModeBlock {
+				h.Logger.Info("Rejected secret",
+					"namespace", secret.Namespace,
+					"name", secret.Name,
+					"username", req.UserInfo.Username,
+					"reason", err.Error(),
+				)
+
+				metrics.RejectedResources.WithLabelValues(
+					string(req.Operation),
+					req.Kind.Kind,
+					req.Namespace,
+					metricReasonRejectedStaticCredentials,
+				).Inc()
+
+				return nil, apierrors.NewInvalid(schema.GroupKind{Group: corev1.GroupName, Kind: "Secret"}, secret.Name, field.ErrorList{field.Invalid(field.NewPath("data", "kubeconfig"), kubeconfig, fmt.Sprintf("secret contains kubeconfig with static credentials: %s", err))})
+			}
+
+			h.Logger.Info("Secret contains kubeconfig with static credentials, request would be denied in blocking mode",
+				"namespace", secret.Namespace,
+				"name", secret.Name,
+				"username", req.UserInfo.Username,
+				"reason", err.Error(),
+			)
+
+			return admission.Warnings{fmt.Sprintf("secret contains kubeconfig with static credentials: %s", err)}, nil
+		}
+	}
+
 	return nil, nil
 }
+
+// validateNoStaticCredentials checks that the auth infos of the given kubeconfig don't contain static credentials,
+// i.e. tokens, client certificates, or basic auth credentials.
+func validateNoStaticCredentials(config clientcmdapi.Config) error {
+	for user, authInfo := range config.AuthInfos {
+		switch {
+		case authInfo.Token != "":
+			return fmt.Errorf("static token is not allowed (user %q)", user)
+		case len(authInfo.ClientCertificateData) > 0 || len(authInfo.ClientKeyData) > 0:
+			return fmt.Errorf("static client certificate is not allowed (user %q)", user)
+		case authInfo.Username != "" || authInfo.Password != "":
+			return fmt.Errorf("static basic auth credentials are not allowed (user %q)", user)
+		}
+	}
+
+	return nil
+}
@@ -17,6 +17,7 @@ import (
 	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	admissioncontrollerconfigv1alpha1 "github.com/gardener/gardener/pkg/admissioncontroller/apis/config/v1alpha1"
 	. "github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/kubeconfigsecret"
 	"github.com/gardener/gardener/pkg/logger"
 )
@@ -122,6 +123,65 @@ users:
 				},
 			}
 		}
+
+		tokenKubeconfig = `
+---
+apiVersion: v1
+kind: Config
+current-context: local-garden
+clusters:
+- name: local-garden
+  cluster:
+    certificate-authority-data: Z2FyZGVuZXIK
+    server: https://localhost:2443
+contexts:
+- name: local-garden
+  context:
+    cluster: local-garden
+    user: local-garden
+users:
+- name: local-garden
+  user:
+    token: foobar
+`
+
+		tokenKubeconfigSecret = func() runtime.Object {
+			return &corev1.Secret{
+				TypeMeta: secretTypeMeta,
+				Data: map[string][]byte{
+					"kubeconfig": []byte(tokenKubeconfig),
+				},
+			}
+		}
+
+		tokenlessKubeconfig = `
+---
+apiVersion: v1
+kind: Config
+current-context: local-garden
+clusters:
+- name: local-garden
+  cluster:
+    certificate-authority-data: Z2FyZGVuZXIK
+    server: https://localhost:2443
+contexts:
+- name: local-garden
+  context:
+    cluster: local-garden
+    user: local-garden
+users:
+- name: local-garden
+  user: {}
+`
+
+		tokenlessKubeconfigSecret = func() runtime.Object {
+			return &corev1.Secret{
+				TypeMeta: secretTypeMeta,
+				Data: map[string][]byte{
+					"kubeconfig": []byte(tokenlessKubeconfig),
+				},
+			}
+		}
 	)
 
 	BeforeEach(func() {
@@ -165,4 +225,35 @@ users:
 		Expect(warning).To(BeNil())
 		Expect(err).To(Succeed())
 	})
+
+	It("should pass because static credentials admission is not configured (create)", func() {
+		test(tokenKubeconfigSecret, Succeed())
+	})
+
+	Context("when static credentials admission is configured", func() {
+		BeforeEach(func() {
+			handler.Config = &admissioncontrollerconfigv1alpha1.KubeconfigSecretAdmissionConfiguration{}
+		})
+
+		It("should pass because Kubeconfig has no static credentials (create)", func() {
+			test(tokenlessKubeconfigSecret, Succeed())
+		})
+
+		It("should fail because Kubeconfig contains a static token (create)", func() {
+			test(tokenKubeconfigSecret, MatchError(ContainSubstring("static token is not allowed")))
+		})
+
+		It("should fail because Kubeconfig contains a static client certificate (create)", func() {
+			test(validKubeconfigSecret, MatchError(ContainSubstring("static client certificate is not allowed")))
+		})
+
+		It("should only warn in log mode (create)", func() {
+			mode := admissioncontrollerconfigv1alpha1.AdmissionModeLog
+			handler.Config.OperationMode = &mode
+
+			warning, err = handler.ValidateCreate(ctx, tokenKubeconfigSecret())
+			Expect(warning).To(ConsistOf(ContainSubstring("static token is not allowed")))
+			Expect(err).To(Succeed())
+		})
+	})
 })
@@ -7,11 +7,14 @@ package namespacedeletion
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -19,7 +22,6 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
-	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
 // Handler handles namespace deletions.
@@ -57,7 +59,7 @@ func (h *Handler) ValidateDelete(ctx context.Context, _ runtime.Object) (admissi
 	return nil, nil
 }
 
-// admitNamespace does only allow the request if no Shoots exist in this specific namespace anymore.
+// admitNamespace does only allow the request if no Shoots or BackupEntries exist in this specific namespace anymore.
 func (h *Handler) admitNamespace(ctx context.Context, namespaceName string) error {
 	// Determine project for given namespace.
 	// TODO: we should use a direct lookup here, as we might falsely allow the request, if our cache is
@@ -80,20 +82,45 @@ func (h *Handler) admitNamespace(ctx context.Context, namespaceName string) erro
 		return nil
 
 	case project.DeletionTimestamp != nil:
-		// if project is marked for deletion we need to wait until all shoots in the namespace are gone
-		namespaceInUse, err := kubernetesutils.ResourcesExist(ctx, h.APIReader, &gardencorev1beta1.ShootList{}, h.Client.Scheme(), client.InNamespace(namespace.Name))
+		// if project is marked for deletion we need to wait until all shoots and backup entries in the namespace are gone
+		blockingResources, err := h.blockingResources(ctx, namespace.Name)
 		if err != nil {
 			return apierrors.NewInternalError(err)
 		}
 
-		if !namespaceInUse {
+		if len(blockingResources) == 0 {
 			return nil
 		}
 
-		return apierrors.NewForbidden(schema.GroupResource{Group: corev1.GroupName, Resource: "Namespace"}, namespace.Name, fmt.Errorf("deletion of namespace %q is not permitted (it still contains Shoots)", namespace.Name))
+		return apierrors.NewForbidden(schema.GroupResource{Group: corev1.GroupName, Resource: "Namespace"}, namespace.Name, fmt.Errorf("deletion of namespace %q is not permitted (it still contains the following resources: %s)", namespace.Name, strings.Join(blockingResources, ", ")))
 	}
 
 	// Namespace is not yet marked for deletion and project is not marked as well. We do not admit and respond that
 	// namespace deletion is only allowed via project deletion.
 	return apierrors.NewForbidden(schema.GroupResource{Group: corev1.GroupName, Resource: "Namespace"}, namespace.Name, fmt.Errorf("direct deletion of namespace %q is not permitted (you must delete the corresponding project %q)", namespace.Name, project.Name))
 }
+
+// blockingResources returns a sorted list of the Shoots and BackupEntries which still exist in the given namespace and
+// hence block its deletion.
+func (h *Handler) blockingResources(ctx context.Context, namespaceName string) ([]string, error) {
+	var blockers []string
+
+	for _, listKind := range []schema.GroupVersionKind{
+		gardencorev1beta1.SchemeGroupVersion.WithKind("ShootList"),
+		gardencorev1beta1.SchemeGroupVersion.WithKind("BackupEntryList"),
+	} {
+		objList := &metav1.PartialObjectMetadataList{}
+		objList.SetGroupVersionKind(listKind)
+		if err := h.APIReader.List(ctx, objList, client.InNamespace(namespaceName)); err != nil {
+			return nil, err
+		}
+
+		kind := strings.TrimSuffix(listKind.Kind, "List")
+		for _, obj := range objList.Items {
+			blockers = append(blockers, kind+"/"+obj.Name)
+		}
+	}
+
+	slices.Sort(blockers)
+	return blockers, nil
+}
@@ -24,7 +24,6 @@ import (
 
 	. "github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/namespacedeletion"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
-	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/logger"
 	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
 )
@@ -40,10 +39,11 @@ var _ = Describe("handler", func() {
 		mockClient *mockclient.MockClient
 		mockReader *mockclient.MockReader
 
-		namespaceName     = "foo"
-		projectName       = "bar"
-		namespace         *corev1.Namespace
-		shootMetadataList *metav1.PartialObjectMetadataList
+		namespaceName           = "foo"
+		projectName             = "bar"
+		namespace               *corev1.Namespace
+		shootMetadataList       *metav1.PartialObjectMetadataList
+		backupEntryMetadataList *metav1.PartialObjectMetadataList
 	)
 
 	BeforeEach(func() {
@@ -63,6 +63,9 @@ var _ = Describe("handler", func() {
 		shootMetadataList = &metav1.PartialObjectMetadataList{}
 		shootMetadataList.SetGroupVersionKind(gardencorev1beta1.SchemeGroupVersion.WithKind("ShootList"))
 
+		backupEntryMetadataList = &metav1.PartialObjectMetadataList{}
+		backupEntryMetadataList.SetGroupVersionKind(gardencorev1beta1.SchemeGroupVersion.WithKind("BackupEntryList"))
+
 		handler = &Handler{Logger: log, APIReader: mockReader, Client: mockClient}
 	})
 
@@ -156,32 +159,49 @@ var _ = Describe("handler", func() {
 					relatedProject.DeepCopyInto(obj)
 					return nil
 				})
-				mockClient.EXPECT().Scheme().Return(kubernetes.GardenScheme)
 			})
 
 			It("should fail because listing shoots fails", func() {
-				mockReader.EXPECT().List(gomock.Any(), shootMetadataList, client.InNamespace(namespaceName), client.Limit(1)).DoAndReturn(func(_ context.Context, _ *metav1.PartialObjectMetadataList, _ ...client.ListOption) error {
+				mockReader.EXPECT().List(gomock.Any(), shootMetadataList, client.InNamespace(namespaceName)).DoAndReturn(func(_ context.Context, _ *metav1.PartialObjectMetadataList, _ ...client.ListOption) error {
 					return errors.New("fake")
 				})
 
 				test(MatchError(ContainSubstring("fake")))
 			})
 
-			It("should pass because namespace is does not contain any shoots", func() {
-				mockReader.EXPECT().List(gomock.Any(), shootMetadataList, client.InNamespace(namespaceName), client.Limit(1)).DoAndReturn(func(_ context.Context, _ *metav1.PartialObjectMetadataList, _ ...client.ListOption) error {
+			It("should fail because listing backup entries fails", func() {
+				mockReader.EXPECT().List(gomock.Any(), shootMetadataList, client.InNamespace(namespaceName)).DoAndReturn(func(_ context.Context, _ *metav1.PartialObjectMetadataList, _ ...client.ListOption) error {
+					return nil
+				})
+				mockReader.EXPECT().List(gomock.Any(), backupEntryMetadataList, client.InNamespace(namespaceName)).DoAndReturn(func(_ context.Context, _ *metav1.PartialObjectMetadataList, _ ...client.ListOption) error {
+					return errors.New("fake")
+				})
+
+				test(MatchError(ContainSubstring("fake")))
+			})
+
+			It("should pass because namespace does not contain any shoots or backup entries", func() {
+				mockReader.EXPECT().List(gomock.Any(), shootMetadataList, client.InNamespace(namespaceName)).DoAndReturn(func(_ context.Context, _ *metav1.PartialObjectMetadataList, _ ...client.ListOption) error {
+					return nil
+				})
+				mockReader.EXPECT().List(gomock.Any(), backupEntryMetadataList, client.InNamespace(namespaceName)).DoAndReturn(func(_ context.Context, _ *metav1.PartialObjectMetadataList, _ ...client.ListOption) error {
 					return nil
 				})
 
 				test(Succeed())
 			})
 
-			It("should forbid namespace deletion because it still contain shoots", func() {
-				mockReader.EXPECT().List(gomock.Any(), shootMetadataList, client.InNamespace(namespaceName), client.Limit(1)).DoAndReturn(func(_ context.Context, list *metav1.PartialObjectMetadataList, _ ...client.ListOption) error {
+			It("should forbid namespace deletion because it still contains shoots and backup entries", func() {
+				mockReader.EXPECT().List(gomock.Any(), shootMetadataList, client.InNamespace(namespaceName)).DoAndReturn(func(_ context.Context, list *metav1.PartialObjectMetadataList, _ ...client.ListOption) error {
 					list.Items = []metav1.PartialObjectMetadata{{ObjectMeta: metav1.ObjectMeta{Name: "shoot1", Namespace: namespaceName}}}
 					return nil
 				})
+				mockReader.EXPECT().List(gomock.Any(), backupEntryMetadataList, client.InNamespace(namespaceName)).DoAndReturn(func(_ context.Context, list *metav1.PartialObjectMetadataList, _ ...client.ListOption) error {
+					list.Items = []metav1.PartialObjectMetadata{{ObjectMeta: metav1.ObjectMeta{Name: "entry1", Namespace: namespaceName}}}
+					return nil
+				})
 
-				test(MatchError(ContainSubstring("still contains Shoots")))
+				test(MatchError(ContainSubstring("still contains the following resources: BackupEntry/entry1, Shoot/shoot1")))
 			})
 		})
 	})
@@ -154,6 +154,32 @@ func (u unstructuredStatusAccessor) SetLastOperation(lastOp *gardencorev1beta1.L
 	}
 }
 
+// GetProgress implements Status.
+func (u unstructuredStatusAccessor) GetProgress() *extensionsv1alpha1.OperationProgress {
+	val, ok, err := unstructured.NestedFieldNoCopy(u.UnstructuredContent(), "status", "progress")
+	if err != nil || !ok {
+		return nil
+	}
+
+	progress := &extensionsv1alpha1.OperationProgress{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(val.(map[string]any), progress); err != nil {
+		return nil
+	}
+	return progress
+}
+
+// SetProgress implements Status.
+func (u unstructuredStatusAccessor) SetProgress(progress *extensionsv1alpha1.OperationProgress) {
+	unstrc, err := runtime.DefaultUnstructuredConverter.ToUnstructured(progress)
+	if err != nil {
+		return
+	}
+
+	if err := unstructured.SetNestedField(u.UnstructuredContent(), unstrc, "status", "progress"); err != nil {
+		return
+	}
+}
+
 // GetLastError implements Status.
 func (u unstructuredStatusAccessor) GetLastError() *gardencorev1beta1.LastError {
 	val, ok, err := unstructured.NestedFieldNoCopy(u.UnstructuredContent(), "status", "lastError")
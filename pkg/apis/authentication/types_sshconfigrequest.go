@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authentication
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SSHConfigRequest can be used to request a short-lived SSH certificate for the nodes of a Shoot cluster.
+type SSHConfigRequest struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+
+	// Spec is the specification of the SSHConfigRequest.
+	Spec SSHConfigRequestSpec
+	// Status is the status of the SSHConfigRequest.
+	Status SSHConfigRequestStatus
+}
+
+// SSHConfigRequestSpec contains the SSH public key to be signed and the expiration time of the certificate.
+type SSHConfigRequestSpec struct {
+	// PublicKey is the SSH public key (in OpenSSH `authorized_keys` format) which shall be signed.
+	PublicKey []byte
+	// ExpirationSeconds is the requested validity duration of the credential. The credential issuer may return a
+	// credential with a different validity duration so a client needs to check the 'expirationTimestamp' field in a
+	// response.
+	// Defaults to 1 hour.
+	ExpirationSeconds int64
+}
+
+// SSHConfigRequestStatus is the status of the SSHConfigRequest containing the SSH certificate and expiration of the
+// credential.
+type SSHConfigRequestStatus struct {
+	// Certificate contains the signed SSH certificate (in OpenSSH `authorized_keys` format) for the requested public
+	// key. The shoot's nodes trust certificates signed by the shoot's SSH certificate authority, so the SSH session
+	// can be established through the Gardener-managed reverse tunnel without a bastion host.
+	Certificate []byte
+	// ExpirationTimestamp is the expiration timestamp of the returned credential.
+	ExpirationTimestamp metav1.Time
+}
@@ -26,6 +26,22 @@ func Convert_authentication_KubeconfigRequest_To_v1alpha1_AdminKubeconfigRequest
 	return nil
 }
 
+func Convert_v1alpha1_AdminSSHConfigRequest_To_authentication_SSHConfigRequest(in *AdminSSHConfigRequest, out *authentication.SSHConfigRequest, _ conversion.Scope) error {
+	out.Spec.PublicKey = in.Spec.PublicKey
+	out.Spec.ExpirationSeconds = ptr.Deref(in.Spec.ExpirationSeconds, 0)
+	out.Status.Certificate = in.Status.Certificate
+	out.Status.ExpirationTimestamp = in.Status.ExpirationTimestamp
+	return nil
+}
+
+func Convert_authentication_SSHConfigRequest_To_v1alpha1_AdminSSHConfigRequest(in *authentication.SSHConfigRequest, out *AdminSSHConfigRequest, _ conversion.Scope) error {
+	out.Spec.PublicKey = in.Spec.PublicKey
+	out.Spec.ExpirationSeconds = &in.Spec.ExpirationSeconds
+	out.Status.Certificate = in.Status.Certificate
+	out.Status.ExpirationTimestamp = in.Status.ExpirationTimestamp
+	return nil
+}
+
 func Convert_v1alpha1_ViewerKubeconfigRequest_To_authentication_KubeconfigRequest(in *ViewerKubeconfigRequest, out *authentication.KubeconfigRequest, _ conversion.Scope) error {
 	out.Spec.ExpirationSeconds = ptr.Deref(in.Spec.ExpirationSeconds, 0)
 	out.Status.Kubeconfig = in.Status.Kubeconfig
@@ -17,6 +17,8 @@ var _ = Describe("conversion", func() {
 	var (
 		expirationSeconds   int64 = 1337
 		kubeconfig                = []byte("kubeconfig")
+		publicKey                 = []byte("public-key")
+		certificate               = []byte("certificate")
 		expirationTimestamp       = metav1.Now()
 	)
 
@@ -79,4 +81,34 @@ var _ = Describe("conversion", func() {
 			Expect(out.Status).To(Equal(ViewerKubeconfigRequestStatus{Kubeconfig: kubeconfig, ExpirationTimestamp: expirationTimestamp}))
 		})
 	})
+
+	Describe("#Convert_v1alpha1_AdminSSHConfigRequest_To_authentication_SSHConfigRequest", func() {
+		It("should properly convert", func() {
+			in := &AdminSSHConfigRequest{
+				Spec:   AdminSSHConfigRequestSpec{PublicKey: publicKey, ExpirationSeconds: &expirationSeconds},
+				Status: AdminSSHConfigRequestStatus{Certificate: certificate, ExpirationTimestamp: expirationTimestamp},
+			}
+			out := &authentication.SSHConfigRequest{}
+
+			Expect(Convert_v1alpha1_AdminSSHConfigRequest_To_authentication_SSHConfigRequest(in, out, nil)).To(Succeed())
+
+			Expect(out.Spec).To(Equal(authentication.SSHConfigRequestSpec{PublicKey: publicKey, ExpirationSeconds: expirationSeconds}))
+			Expect(out.Status).To(Equal(authentication.SSHConfigRequestStatus{Certificate: certificate, ExpirationTimestamp: expirationTimestamp}))
+		})
+	})
+
+	Describe("#Convert_authentication_SSHConfigRequest_To_v1alpha1_AdminSSHConfigRequest", func() {
+		It("should properly convert", func() {
+			in := &authentication.SSHConfigRequest{
+				Spec:   authentication.SSHConfigRequestSpec{PublicKey: publicKey, ExpirationSeconds: expirationSeconds},
+				Status: authentication.SSHConfigRequestStatus{Certificate: certificate, ExpirationTimestamp: expirationTimestamp},
+			}
+			out := &AdminSSHConfigRequest{}
+
+			Expect(Convert_authentication_SSHConfigRequest_To_v1alpha1_AdminSSHConfigRequest(in, out, nil)).To(Succeed())
+
+			Expect(out.Spec).To(Equal(AdminSSHConfigRequestSpec{PublicKey: publicKey, ExpirationSeconds: &expirationSeconds}))
+			Expect(out.Status).To(Equal(AdminSSHConfigRequestStatus{Certificate: certificate, ExpirationTimestamp: expirationTimestamp}))
+		})
+	})
 })
@@ -115,10 +115,94 @@ func (m *AdminKubeconfigRequestStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AdminKubeconfigRequestStatus proto.InternalMessageInfo
 
+func (m *AdminSSHConfigRequest) Reset()      { *m = AdminSSHConfigRequest{} }
+func (*AdminSSHConfigRequest) ProtoMessage() {}
+func (*AdminSSHConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ad0cb10cdbf25b8, []int{3}
+}
+func (m *AdminSSHConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminSSHConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AdminSSHConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminSSHConfigRequest.Merge(m, src)
+}
+func (m *AdminSSHConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminSSHConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminSSHConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminSSHConfigRequest proto.InternalMessageInfo
+
+func (m *AdminSSHConfigRequestSpec) Reset()      { *m = AdminSSHConfigRequestSpec{} }
+func (*AdminSSHConfigRequestSpec) ProtoMessage() {}
+func (*AdminSSHConfigRequestSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ad0cb10cdbf25b8, []int{4}
+}
+func (m *AdminSSHConfigRequestSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminSSHConfigRequestSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AdminSSHConfigRequestSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminSSHConfigRequestSpec.Merge(m, src)
+}
+func (m *AdminSSHConfigRequestSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminSSHConfigRequestSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminSSHConfigRequestSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminSSHConfigRequestSpec proto.InternalMessageInfo
+
+func (m *AdminSSHConfigRequestStatus) Reset()      { *m = AdminSSHConfigRequestStatus{} }
+func (*AdminSSHConfigRequestStatus) ProtoMessage() {}
+func (*AdminSSHConfigRequestStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ad0cb10cdbf25b8, []int{5}
+}
+func (m *AdminSSHConfigRequestStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminSSHConfigRequestStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AdminSSHConfigRequestStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminSSHConfigRequestStatus.Merge(m, src)
+}
+func (m *AdminSSHConfigRequestStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminSSHConfigRequestStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminSSHConfigRequestStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminSSHConfigRequestStatus proto.InternalMessageInfo
+
 func (m *ViewerKubeconfigRequest) Reset()      { *m = ViewerKubeconfigRequest{} }
 func (*ViewerKubeconfigRequest) ProtoMessage() {}
 func (*ViewerKubeconfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4ad0cb10cdbf25b8, []int{3}
+	return fileDescriptor_4ad0cb10cdbf25b8, []int{6}
 }
 func (m *ViewerKubeconfigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -146,7 +230,7 @@ var xxx_messageInfo_ViewerKubeconfigRequest proto.InternalMessageInfo
 func (m *ViewerKubeconfigRequestSpec) Reset()      { *m = ViewerKubeconfigRequestSpec{} }
 func (*ViewerKubeconfigRequestSpec) ProtoMessage() {}
 func (*ViewerKubeconfigRequestSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4ad0cb10cdbf25b8, []int{4}
+	return fileDescriptor_4ad0cb10cdbf25b8, []int{7}
 }
 func (m *ViewerKubeconfigRequestSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -174,7 +258,7 @@ var xxx_messageInfo_ViewerKubeconfigRequestSpec proto.InternalMessageInfo
 func (m *ViewerKubeconfigRequestStatus) Reset()      { *m = ViewerKubeconfigRequestStatus{} }
 func (*ViewerKubeconfigRequestStatus) ProtoMessage() {}
 func (*ViewerKubeconfigRequestStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4ad0cb10cdbf25b8, []int{5}
+	return fileDescriptor_4ad0cb10cdbf25b8, []int{8}
 }
 func (m *ViewerKubeconfigRequestStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -203,6 +287,9 @@ func init() {
 	proto.RegisterType((*AdminKubeconfigRequest)(nil), "github.com.gardener.gardener.pkg.apis.authentication.v1alpha1.AdminKubeconfigRequest")
 	proto.RegisterType((*AdminKubeconfigRequestSpec)(nil), "github.com.gardener.gardener.pkg.apis.authentication.v1alpha1.AdminKubeconfigRequestSpec")
 	proto.RegisterType((*AdminKubeconfigRequestStatus)(nil), "github.com.gardener.gardener.pkg.apis.authentication.v1alpha1.AdminKubeconfigRequestStatus")
+	proto.RegisterType((*AdminSSHConfigRequest)(nil), "github.com.gardener.gardener.pkg.apis.authentication.v1alpha1.AdminSSHConfigRequest")
+	proto.RegisterType((*AdminSSHConfigRequestSpec)(nil), "github.com.gardener.gardener.pkg.apis.authentication.v1alpha1.AdminSSHConfigRequestSpec")
+	proto.RegisterType((*AdminSSHConfigRequestStatus)(nil), "github.com.gardener.gardener.pkg.apis.authentication.v1alpha1.AdminSSHConfigRequestStatus")
 	proto.RegisterType((*ViewerKubeconfigRequest)(nil), "github.com.gardener.gardener.pkg.apis.authentication.v1alpha1.ViewerKubeconfigRequest")
 	proto.RegisterType((*ViewerKubeconfigRequestSpec)(nil), "github.com.gardener.gardener.pkg.apis.authentication.v1alpha1.ViewerKubeconfigRequestSpec")
 	proto.RegisterType((*ViewerKubeconfigRequestStatus)(nil), "github.com.gardener.gardener.pkg.apis.authentication.v1alpha1.ViewerKubeconfigRequestStatus")
@@ -213,40 +300,47 @@ func init() {
 }
 
 var fileDescriptor_4ad0cb10cdbf25b8 = []byte{
-	// 524 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0x4f, 0x6b, 0xd4, 0x40,
-	0x14, 0xcf, 0x74, 0x4b, 0x91, 0xb1, 0x14, 0x9a, 0xa2, 0x2e, 0x5b, 0xcd, 0xca, 0x9e, 0x44, 0x70,
-	0xe2, 0x8a, 0x88, 0x97, 0x1e, 0x8c, 0xf4, 0x24, 0x45, 0x48, 0x45, 0xb0, 0x7a, 0x70, 0x92, 0xbc,
-	0x26, 0xe3, 0x9a, 0x64, 0xcc, 0x4c, 0x56, 0x8b, 0x1e, 0x0a, 0xfa, 0x01, 0xfc, 0x58, 0xab, 0xa7,
-	0x1e, 0x7b, 0x5a, 0xdc, 0xf8, 0x39, 0x04, 0x99, 0xd9, 0xb4, 0xd9, 0xee, 0x76, 0x57, 0x61, 0x5d,
-	0xa4, 0xb7, 0xf7, 0x32, 0xef, 0xf7, 0x67, 0xe6, 0xfd, 0x20, 0x78, 0x27, 0x64, 0x32, 0xca, 0x3d,
-	0xe2, 0xa7, 0xb1, 0x1d, 0xd2, 0x2c, 0x80, 0x04, 0xb2, 0xaa, 0xe0, 0x9d, 0xd0, 0xa6, 0x9c, 0x09,
-	0x9b, 0xe6, 0x32, 0x82, 0x44, 0x32, 0x9f, 0x4a, 0x96, 0x26, 0x76, 0xb7, 0x4d, 0xdf, 0xf2, 0x88,
-	0xb6, 0xed, 0x50, 0x8d, 0x51, 0x09, 0x01, 0xe1, 0x59, 0x2a, 0x53, 0x73, 0xab, 0xa2, 0x23, 0x27,
-	0x2c, 0x55, 0xc1, 0x3b, 0x21, 0x51, 0x74, 0xe4, 0x2c, 0x1d, 0x39, 0xa1, 0x6b, 0xdc, 0x19, 0x75,
-	0x93, 0x86, 0xa9, 0xad, 0x59, 0xbd, 0x7c, 0x5f, 0x77, 0xba, 0xd1, 0xd5, 0x50, 0xad, 0x71, 0xbf,
-	0xf3, 0x50, 0x10, 0x96, 0x2a, 0x8b, 0x31, 0xf5, 0x23, 0x96, 0x40, 0x76, 0x50, 0x79, 0x8e, 0x41,
-	0x52, 0xbb, 0x3b, 0xe1, 0xb1, 0x61, 0x4f, 0x43, 0x65, 0x79, 0x22, 0x59, 0x0c, 0x13, 0x80, 0x07,
-	0x7f, 0x02, 0x08, 0x3f, 0x82, 0x98, 0x8e, 0xe3, 0x5a, 0xbf, 0x96, 0xf0, 0xd5, 0x47, 0x41, 0xcc,
-	0x92, 0x27, 0xb9, 0x07, 0x7e, 0x9a, 0xec, 0xb3, 0xd0, 0x85, 0x77, 0x39, 0x08, 0x69, 0xbe, 0xc6,
-	0x97, 0x94, 0xbd, 0x80, 0x4a, 0x5a, 0x47, 0x37, 0xd1, 0xad, 0xcb, 0xf7, 0xee, 0x92, 0xa1, 0x0a,
-	0x19, 0x55, 0xa9, 0x5e, 0x4c, 0x4d, 0x93, 0x6e, 0x9b, 0x3c, 0xf5, 0xde, 0x80, 0x2f, 0x77, 0x40,
-	0x52, 0xc7, 0xec, 0xf5, 0x9b, 0x46, 0xd1, 0x6f, 0xe2, 0xea, 0x9b, 0x7b, 0xca, 0x6a, 0x7e, 0xc4,
-	0xcb, 0x82, 0x83, 0x5f, 0x5f, 0xd2, 0xec, 0x2f, 0xc8, 0x5c, 0x8b, 0x21, 0xe7, 0x5f, 0x63, 0x97,
-	0x83, 0xef, 0xac, 0x96, 0x36, 0x96, 0x55, 0xe7, 0x6a, 0x51, 0xf3, 0x33, 0xc2, 0x2b, 0x42, 0x52,
-	0x99, 0x8b, 0x7a, 0x4d, 0xeb, 0xbf, 0x5c, 0x8c, 0xbe, 0x96, 0x70, 0xd6, 0x4a, 0x07, 0x2b, 0xc3,
-	0xde, 0x2d, 0xa5, 0x5b, 0x14, 0x37, 0xa6, 0xfb, 0x36, 0x1f, 0xe3, 0x75, 0xf8, 0xc0, 0x59, 0xa6,
-	0x95, 0x76, 0xd5, 0x40, 0x20, 0xf4, 0x2e, 0x6a, 0xce, 0x95, 0xa2, 0xdf, 0x5c, 0xdf, 0x1e, 0x3f,
-	0x74, 0x27, 0xe7, 0x5b, 0xdf, 0x10, 0xbe, 0x3e, 0xcb, 0x9b, 0x49, 0x30, 0xee, 0x9c, 0x1e, 0x69,
-	0xfa, 0x55, 0x67, 0x4d, 0x2d, 0x6d, 0x04, 0x30, 0x32, 0x61, 0x1e, 0xe0, 0x8d, 0x4a, 0xe5, 0x19,
-	0x8b, 0x41, 0x48, 0x1a, 0xf3, 0x72, 0x8b, 0xb7, 0xff, 0x2e, 0x23, 0x0a, 0xe6, 0x6c, 0x96, 0x8f,
-	0xb2, 0xb1, 0x3d, 0x49, 0xe7, 0x9e, 0xa7, 0xd1, 0x3a, 0xac, 0xe1, 0x6b, 0xcf, 0x19, 0xbc, 0x87,
-	0xec, 0x7f, 0xe4, 0xf5, 0xd3, 0x99, 0xbc, 0xee, 0xcd, 0x99, 0x97, 0x29, 0xf7, 0x98, 0x1a, 0xd8,
-	0x2f, 0xe3, 0x81, 0x7d, 0xb5, 0x20, 0x03, 0xb3, 0x13, 0xeb, 0xe1, 0xcd, 0x19, 0xce, 0xff, 0x4d,
-	0x64, 0xbf, 0x23, 0x7c, 0x63, 0xa6, 0xbb, 0x0b, 0x94, 0x59, 0xc7, 0xef, 0x0d, 0x2c, 0xe3, 0x68,
-	0x60, 0x19, 0xc7, 0x03, 0xcb, 0x38, 0x2c, 0x2c, 0xd4, 0x2b, 0x2c, 0x74, 0x54, 0x58, 0xe8, 0xb8,
-	0xb0, 0xd0, 0x8f, 0xc2, 0x42, 0x5f, 0x7f, 0x5a, 0xc6, 0xde, 0xd6, 0x5c, 0x3f, 0xb9, 0xdf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x70, 0x4f, 0x31, 0xf7, 0x24, 0x07, 0x00, 0x00,
+	// 630 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xce, 0xa4, 0xa5, 0xd8, 0x69, 0xad, 0x74, 0x4b, 0xb5, 0x26, 0xba, 0x91, 0x9c, 0x44, 0x71,
+	0xd6, 0x88, 0x88, 0x97, 0x1e, 0xdc, 0x50, 0x10, 0x4a, 0x51, 0x36, 0x22, 0x5a, 0x3d, 0x38, 0x3b,
+	0x99, 0x6c, 0xc6, 0x74, 0x7f, 0xb8, 0x3b, 0x1b, 0x8d, 0x7a, 0x28, 0xea, 0x55, 0xf0, 0xcf, 0x8a,
+	0x7a, 0xe9, 0xb1, 0xa7, 0x60, 0xd6, 0xff, 0x42, 0x10, 0x64, 0x27, 0x9b, 0x6e, 0x7e, 0xed, 0xaa,
+	0xa4, 0xb1, 0x78, 0x9b, 0x37, 0xf3, 0xde, 0xf7, 0x7d, 0x6f, 0xdf, 0xc7, 0x63, 0xe1, 0x8e, 0xc1,
+	0x78, 0xdd, 0xd7, 0x11, 0xb1, 0x4d, 0xc5, 0xc0, 0x6e, 0x95, 0x5a, 0xd4, 0x8d, 0x0f, 0x4e, 0xc3,
+	0x50, 0xb0, 0xc3, 0x3c, 0x05, 0xfb, 0xbc, 0x4e, 0x2d, 0xce, 0x08, 0xe6, 0xcc, 0xb6, 0x94, 0x66,
+	0x09, 0xef, 0x39, 0x75, 0x5c, 0x52, 0x8c, 0x30, 0x0d, 0x73, 0x5a, 0x45, 0x8e, 0x6b, 0x73, 0x5b,
+	0xda, 0x8c, 0xe1, 0x50, 0x1f, 0x25, 0x3e, 0x38, 0x0d, 0x03, 0x85, 0x70, 0x68, 0x18, 0x0e, 0xf5,
+	0xe1, 0x72, 0xd7, 0x06, 0xd5, 0xd8, 0x86, 0xad, 0x08, 0x54, 0xdd, 0xaf, 0x89, 0x48, 0x04, 0xe2,
+	0xd4, 0x63, 0xcb, 0xdd, 0x6c, 0xdc, 0xf6, 0x10, 0xb3, 0x43, 0x89, 0x26, 0x26, 0x75, 0x66, 0x51,
+	0xb7, 0x15, 0x6b, 0x36, 0x29, 0xc7, 0x4a, 0x73, 0x4c, 0x63, 0x4e, 0x49, 0xaa, 0x72, 0x7d, 0x8b,
+	0x33, 0x93, 0x8e, 0x15, 0xdc, 0xfa, 0x5d, 0x81, 0x47, 0xea, 0xd4, 0xc4, 0xa3, 0x75, 0xc5, 0x9f,
+	0x59, 0x78, 0xf6, 0x4e, 0xd5, 0x64, 0xd6, 0xb6, 0xaf, 0x53, 0x62, 0x5b, 0x35, 0x66, 0x68, 0xf4,
+	0x85, 0x4f, 0x3d, 0x2e, 0x3d, 0x83, 0xa7, 0x42, 0x79, 0x55, 0xcc, 0xf1, 0x06, 0xb8, 0x04, 0x2e,
+	0x2f, 0xdd, 0xb8, 0x8e, 0x7a, 0x2c, 0x68, 0x90, 0x25, 0xfe, 0x62, 0x61, 0x36, 0x6a, 0x96, 0xd0,
+	0x3d, 0xfd, 0x39, 0x25, 0x7c, 0x87, 0x72, 0xac, 0x4a, 0xed, 0x4e, 0x21, 0x13, 0x74, 0x0a, 0x30,
+	0xbe, 0xd3, 0x8e, 0x50, 0xa5, 0x37, 0x70, 0xde, 0x73, 0x28, 0xd9, 0xc8, 0x0a, 0xf4, 0xc7, 0x68,
+	0xaa, 0xc1, 0xa0, 0xc9, 0x6d, 0x54, 0x1c, 0x4a, 0xd4, 0xe5, 0x48, 0xc6, 0x7c, 0x18, 0x69, 0x82,
+	0x54, 0x7a, 0x0f, 0xe0, 0x82, 0xc7, 0x31, 0xf7, 0xbd, 0x8d, 0x39, 0xc1, 0xff, 0x64, 0x36, 0xfc,
+	0x82, 0x42, 0x5d, 0x89, 0x14, 0x2c, 0xf4, 0x62, 0x2d, 0xa2, 0x2e, 0x62, 0x98, 0x4b, 0xd6, 0x2d,
+	0x95, 0xe1, 0x2a, 0x7d, 0xe5, 0x30, 0x57, 0x30, 0x55, 0xc2, 0x84, 0xaa, 0x27, 0x66, 0x31, 0xa7,
+	0xae, 0x07, 0x9d, 0xc2, 0xea, 0xd6, 0xe8, 0xa3, 0x36, 0x9e, 0x5f, 0xfc, 0x0c, 0xe0, 0x85, 0x34,
+	0x6d, 0x12, 0x82, 0xb0, 0x71, 0xf4, 0x24, 0xe0, 0x97, 0xd5, 0x95, 0x70, 0x68, 0x03, 0x05, 0x03,
+	0x19, 0x52, 0x0b, 0xae, 0xc5, 0x2c, 0x0f, 0x98, 0x49, 0x3d, 0x8e, 0x4d, 0x27, 0x9a, 0xe2, 0x95,
+	0x3f, 0xf3, 0x48, 0x58, 0xa6, 0xe6, 0xa3, 0x8f, 0xb2, 0xb6, 0x35, 0x0e, 0xa7, 0x4d, 0xe2, 0x28,
+	0xfe, 0xc8, 0xc2, 0x75, 0xd1, 0x4b, 0xa5, 0x72, 0xb7, 0xfc, 0x8f, 0xdd, 0xfa, 0x7a, 0xc8, 0xad,
+	0x8f, 0x8e, 0xc3, 0x2d, 0xa3, 0x5d, 0x24, 0x9a, 0xf5, 0xdd, 0xa8, 0x59, 0x77, 0x67, 0x42, 0x9f,
+	0xee, 0xd5, 0x8f, 0x00, 0x9e, 0x4f, 0x94, 0x2d, 0x5d, 0x85, 0x8b, 0x8e, 0xaf, 0xef, 0x31, 0xb2,
+	0x4d, 0x5b, 0x91, 0x89, 0x4e, 0x07, 0x9d, 0xc2, 0xe2, 0xfd, 0xfe, 0xa5, 0x16, 0xbf, 0x4f, 0x36,
+	0x76, 0xf6, 0x2f, 0x8d, 0xfd, 0x15, 0xc0, 0x7c, 0x4a, 0x1f, 0x52, 0x09, 0x2e, 0x11, 0xea, 0x72,
+	0x56, 0x0b, 0xbb, 0xa7, 0x91, 0xa6, 0x33, 0x41, 0xa7, 0xb0, 0x54, 0x8e, 0xaf, 0xb5, 0xc1, 0x9c,
+	0x93, 0xb4, 0xf6, 0xfe, 0x1c, 0x3c, 0xf7, 0x90, 0xd1, 0x97, 0xd4, 0x3d, 0x89, 0x55, 0xfc, 0x76,
+	0xc8, 0xdc, 0xd3, 0xba, 0x2b, 0xa1, 0x8f, 0x44, 0x7b, 0x7f, 0x18, 0xb5, 0xf7, 0xd3, 0x19, 0x09,
+	0x48, 0x37, 0xb8, 0x0e, 0xf3, 0x29, 0xca, 0x8f, 0x67, 0x1b, 0x7f, 0x01, 0xf0, 0x62, 0xaa, 0xba,
+	0xff, 0x68, 0x1d, 0xab, 0xa4, 0xdd, 0x95, 0x33, 0x07, 0x5d, 0x39, 0x73, 0xd8, 0x95, 0x33, 0xfb,
+	0x81, 0x0c, 0xda, 0x81, 0x0c, 0x0e, 0x02, 0x19, 0x1c, 0x06, 0x32, 0xf8, 0x16, 0xc8, 0xe0, 0xd3,
+	0x77, 0x39, 0xb3, 0xbb, 0x39, 0xd5, 0xff, 0xdb, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xde, 0x53,
+	0xe1, 0x7c, 0xff, 0x09, 0x00, 0x00,
 }
 
 func (m *AdminKubeconfigRequest) Marshal() (dAtA []byte, err error) {
@@ -370,6 +464,134 @@ func (m *AdminKubeconfigRequestStatus) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *AdminSSHConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminSSHConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminSSHConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminSSHConfigRequestSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminSSHConfigRequestSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminSSHConfigRequestSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.ExpirationSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PublicKey != nil {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminSSHConfigRequestStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminSSHConfigRequestStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminSSHConfigRequestStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ExpirationTimestamp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Certificate != nil {
+		i -= len(m.Certificate)
+		copy(dAtA[i:], m.Certificate)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Certificate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ViewerKubeconfigRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -544,6 +766,52 @@ func (m *AdminKubeconfigRequestStatus) Size() (n int) {
 	return n
 }
 
+func (m *AdminSSHConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *AdminSSHConfigRequestSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PublicKey != nil {
+		l = len(m.PublicKey)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ExpirationSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.ExpirationSeconds))
+	}
+	return n
+}
+
+func (m *AdminSSHConfigRequestStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Certificate != nil {
+		l = len(m.Certificate)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = m.ExpirationTimestamp.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ViewerKubeconfigRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -625,6 +893,40 @@ func (this *AdminKubeconfigRequestStatus) String() string {
 	}, "")
 	return s
 }
+func (this *AdminSSHConfigRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminSSHConfigRequest{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "AdminSSHConfigRequestSpec", "AdminSSHConfigRequestSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "AdminSSHConfigRequestStatus", "AdminSSHConfigRequestStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminSSHConfigRequestSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminSSHConfigRequestSpec{`,
+		`PublicKey:` + valueToStringGenerated(this.PublicKey) + `,`,
+		`ExpirationSeconds:` + valueToStringGenerated(this.ExpirationSeconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminSSHConfigRequestStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminSSHConfigRequestStatus{`,
+		`Certificate:` + valueToStringGenerated(this.Certificate) + `,`,
+		`ExpirationTimestamp:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ExpirationTimestamp), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ViewerKubeconfigRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -1002,6 +1304,376 @@ func (m *AdminKubeconfigRequestStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AdminSSHConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminSSHConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminSSHConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminSSHConfigRequestSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminSSHConfigRequestSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminSSHConfigRequestSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExpirationSeconds = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminSSHConfigRequestStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminSSHConfigRequestStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminSSHConfigRequestStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Certificate = append(m.Certificate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Certificate == nil {
+				m.Certificate = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpirationTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ViewerKubeconfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
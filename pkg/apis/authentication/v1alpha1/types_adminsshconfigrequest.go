@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AdminSSHConfigRequest can be used to request a short-lived SSH certificate for the nodes of a Shoot cluster.
+// The certificate is signed by the shoot's SSH certificate authority which the shoot's nodes trust, so the SSH
+// session can be established through the Gardener-managed reverse tunnel without a bastion host.
+type AdminSSHConfigRequest struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec is the specification of the AdminSSHConfigRequest.
+	Spec AdminSSHConfigRequestSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Status is the status of the AdminSSHConfigRequest.
+	Status AdminSSHConfigRequestStatus `json:"status" protobuf:"bytes,3,opt,name=status"`
+}
+
+// AdminSSHConfigRequestSpec contains the SSH public key to be signed and the expiration time of the certificate.
+type AdminSSHConfigRequestSpec struct {
+	// PublicKey is the SSH public key (in OpenSSH `authorized_keys` format) which shall be signed.
+	PublicKey []byte `json:"publicKey" protobuf:"bytes,1,opt,name=publicKey"`
+	// ExpirationSeconds is the requested validity duration of the credential. The
+	// credential issuer may return a credential with a different validity duration so a
+	// client needs to check the 'expirationTimestamp' field in a response.
+	// Defaults to 1 hour.
+	// +optional
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty" protobuf:"varint,2,opt,name=expirationSeconds"`
+}
+
+// AdminSSHConfigRequestStatus is the status of the AdminSSHConfigRequest containing
+// the SSH certificate and expiration of the credential.
+type AdminSSHConfigRequestStatus struct {
+	// Certificate contains the signed SSH certificate (in OpenSSH `authorized_keys` format) for the requested public
+	// key.
+	Certificate []byte `json:"certificate" protobuf:"bytes,1,opt,name=certificate"`
+	// ExpirationTimestamp is the expiration timestamp of the returned credential.
+	ExpirationTimestamp metav1.Time `json:"expirationTimestamp" protobuf:"bytes,2,opt,name=expirationTimestamp"`
+}
@@ -32,11 +32,21 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*authentication.SSHConfigRequest)(nil), (*AdminSSHConfigRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_authentication_SSHConfigRequest_To_v1alpha1_AdminSSHConfigRequest(a.(*authentication.SSHConfigRequest), b.(*AdminSSHConfigRequest), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*AdminKubeconfigRequest)(nil), (*authentication.KubeconfigRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_AdminKubeconfigRequest_To_authentication_KubeconfigRequest(a.(*AdminKubeconfigRequest), b.(*authentication.KubeconfigRequest), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*AdminSSHConfigRequest)(nil), (*authentication.SSHConfigRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AdminSSHConfigRequest_To_authentication_SSHConfigRequest(a.(*AdminSSHConfigRequest), b.(*authentication.SSHConfigRequest), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*ViewerKubeconfigRequest)(nil), (*authentication.KubeconfigRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ViewerKubeconfigRequest_To_authentication_KubeconfigRequest(a.(*ViewerKubeconfigRequest), b.(*authentication.KubeconfigRequest), scope)
 	}); err != nil {
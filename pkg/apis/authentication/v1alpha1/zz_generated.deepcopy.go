@@ -84,6 +84,82 @@ func (in *AdminKubeconfigRequestStatus) DeepCopy() *AdminKubeconfigRequestStatus
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdminSSHConfigRequest) DeepCopyInto(out *AdminSSHConfigRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdminSSHConfigRequest.
+func (in *AdminSSHConfigRequest) DeepCopy() *AdminSSHConfigRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(AdminSSHConfigRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AdminSSHConfigRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdminSSHConfigRequestSpec) DeepCopyInto(out *AdminSSHConfigRequestSpec) {
+	*out = *in
+	if in.PublicKey != nil {
+		in, out := &in.PublicKey, &out.PublicKey
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExpirationSeconds != nil {
+		in, out := &in.ExpirationSeconds, &out.ExpirationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdminSSHConfigRequestSpec.
+func (in *AdminSSHConfigRequestSpec) DeepCopy() *AdminSSHConfigRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AdminSSHConfigRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdminSSHConfigRequestStatus) DeepCopyInto(out *AdminSSHConfigRequestStatus) {
+	*out = *in
+	if in.Certificate != nil {
+		in, out := &in.Certificate, &out.Certificate
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	in.ExpirationTimestamp.DeepCopyInto(&out.ExpirationTimestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdminSSHConfigRequestStatus.
+func (in *AdminSSHConfigRequestStatus) DeepCopy() *AdminSSHConfigRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AdminSSHConfigRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ViewerKubeconfigRequest) DeepCopyInto(out *ViewerKubeconfigRequest) {
 	*out = *in
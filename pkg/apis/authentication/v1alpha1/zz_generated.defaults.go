@@ -18,6 +18,7 @@ import (
 // All generated defaulters are covering - they call all nested defaulters.
 func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&AdminKubeconfigRequest{}, func(obj interface{}) { SetObjectDefaults_AdminKubeconfigRequest(obj.(*AdminKubeconfigRequest)) })
+	scheme.AddTypeDefaultingFunc(&AdminSSHConfigRequest{}, func(obj interface{}) { SetObjectDefaults_AdminSSHConfigRequest(obj.(*AdminSSHConfigRequest)) })
 	scheme.AddTypeDefaultingFunc(&ViewerKubeconfigRequest{}, func(obj interface{}) { SetObjectDefaults_ViewerKubeconfigRequest(obj.(*ViewerKubeconfigRequest)) })
 	return nil
 }
@@ -26,6 +27,10 @@ func SetObjectDefaults_AdminKubeconfigRequest(in *AdminKubeconfigRequest) {
 	SetDefaults_AdminKubeconfigRequestSpec(&in.Spec)
 }
 
+func SetObjectDefaults_AdminSSHConfigRequest(in *AdminSSHConfigRequest) {
+	SetDefaults_AdminSSHConfigRequestSpec(&in.Spec)
+}
+
 func SetObjectDefaults_ViewerKubeconfigRequest(in *ViewerKubeconfigRequest) {
 	SetDefaults_ViewerKubeconfigRequestSpec(&in.Spec)
 }
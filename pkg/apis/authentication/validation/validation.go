@@ -29,3 +29,22 @@ func ValidateKubeconfigRequest(req *authentication.KubeconfigRequest) field.Erro
 	}
 	return allErrs
 }
+
+// ValidateSSHConfigRequest validates an SSHConfigRequest.
+func ValidateSSHConfigRequest(req *authentication.SSHConfigRequest) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+
+	if len(req.Spec.PublicKey) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("publicKey"), "must provide an SSH public key"))
+	}
+
+	const min = 10 * time.Minute
+	if req.Spec.ExpirationSeconds < int64(min.Seconds()) {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("expirationSeconds"), req.Spec.ExpirationSeconds, "may not specify a duration less than 10 minutes"))
+	}
+	if req.Spec.ExpirationSeconds > math.MaxUint32 {
+		allErrs = append(allErrs, field.TooLong(specPath.Child("expirationSeconds"), req.Spec.ExpirationSeconds, math.MaxUint32))
+	}
+	return allErrs
+}
@@ -69,3 +69,55 @@ var _ = Describe("ValidateKubeconfigRequest", func() {
 		Expect(errors).To(BeEmpty())
 	})
 })
+
+var _ = Describe("ValidateSSHConfigRequest", func() {
+	var req *authentication.SSHConfigRequest
+
+	BeforeEach(func() {
+		req = &authentication.SSHConfigRequest{}
+		req.Spec.PublicKey = []byte("ssh-rsa AAAA")
+		req.Spec.ExpirationSeconds = int64((time.Hour).Seconds())
+	})
+
+	It("should fail when publicKey is not set", func() {
+		req.Spec.PublicKey = nil
+
+		errors := validation.ValidateSSHConfigRequest(req)
+
+		Expect(errors).To(HaveLen(1))
+		Expect(errors).To(ConsistOfFields(Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("spec.publicKey"),
+		}))
+	})
+
+	It("should fail when expirationSeconds is less than 10 minutes", func() {
+		req.Spec.ExpirationSeconds = int64((time.Minute * 9).Seconds())
+
+		errors := validation.ValidateSSHConfigRequest(req)
+
+		Expect(errors).To(HaveLen(1))
+		Expect(errors).To(ConsistOfFields(Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("spec.expirationSeconds"),
+		}))
+	})
+
+	It("should fail when expirationSeconds is more than 2^32 seconds", func() {
+		req.Spec.ExpirationSeconds = math.MaxUint32 + 1
+
+		errors := validation.ValidateSSHConfigRequest(req)
+
+		Expect(errors).To(HaveLen(1))
+		Expect(errors).To(ConsistOfFields(Fields{
+			"Type":  Equal(field.ErrorTypeTooLong),
+			"Field": Equal("spec.expirationSeconds"),
+		}))
+	})
+
+	It("should succeed for a valid request", func() {
+		errors := validation.ValidateSSHConfigRequest(req)
+
+		Expect(errors).To(BeEmpty())
+	})
+})
@@ -78,3 +78,74 @@ func (in *KubeconfigRequestStatus) DeepCopy() *KubeconfigRequestStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHConfigRequest) DeepCopyInto(out *SSHConfigRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHConfigRequest.
+func (in *SSHConfigRequest) DeepCopy() *SSHConfigRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHConfigRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SSHConfigRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHConfigRequestSpec) DeepCopyInto(out *SSHConfigRequestSpec) {
+	*out = *in
+	if in.PublicKey != nil {
+		in, out := &in.PublicKey, &out.PublicKey
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHConfigRequestSpec.
+func (in *SSHConfigRequestSpec) DeepCopy() *SSHConfigRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHConfigRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHConfigRequestStatus) DeepCopyInto(out *SSHConfigRequestStatus) {
+	*out = *in
+	if in.Certificate != nil {
+		in, out := &in.Certificate, &out.Certificate
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	in.ExpirationTimestamp.DeepCopyInto(&out.ExpirationTimestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHConfigRequestStatus.
+func (in *SSHConfigRequestStatus) DeepCopy() *SSHConfigRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHConfigRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
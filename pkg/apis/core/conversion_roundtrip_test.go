@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencoreinstall "github.com/gardener/gardener/pkg/apis/core/install"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Roundtrip conversion", func() {
+	var scheme *runtime.Scheme
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		gardencoreinstall.Install(scheme)
+	})
+
+	// roundTrip converts in to the internal version and back into out.
+	roundTrip := func(in, internal, out runtime.Object) {
+		GinkgoHelper()
+
+		Expect(scheme.Convert(in, internal, nil)).To(Succeed())
+		Expect(scheme.Convert(internal, out, nil)).To(Succeed())
+	}
+
+	It("should round-trip a Shoot through the internal version without loss", func() {
+		in := &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "shoot",
+				Namespace:   "garden-project",
+				Labels:      map[string]string{"foo": "bar"},
+				Annotations: map[string]string{"baz": "qux"},
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName:  ptr.To("profile"),
+				Region:            "region",
+				SecretBindingName: ptr.To("secret-binding"),
+				Provider: gardencorev1beta1.Provider{
+					Type: "provider",
+					Workers: []gardencorev1beta1.Worker{{
+						Name: "worker",
+						Machine: gardencorev1beta1.Machine{
+							Type:         "machine-type",
+							Image:        &gardencorev1beta1.ShootMachineImage{Name: "image", Version: ptr.To("1.0.0")},
+							Architecture: ptr.To("amd64"),
+						},
+						Minimum: 1,
+						Maximum: 2,
+						Zones:   []string{"a", "b"},
+					}},
+				},
+				Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.31.1"},
+				Networking: &gardencorev1beta1.Networking{Type: ptr.To("some-network-plugin")},
+				DNS:        &gardencorev1beta1.DNS{Domain: ptr.To("shoot.example.com")},
+			},
+		}
+
+		out := &gardencorev1beta1.Shoot{}
+		roundTrip(in, &gardencore.Shoot{}, out)
+		Expect(out).To(DeepEqual(in))
+	})
+
+	It("should round-trip a Seed through the internal version without loss", func() {
+		in := &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: "seed"},
+			Spec: gardencorev1beta1.SeedSpec{
+				Provider: gardencorev1beta1.SeedProvider{
+					Type:   "provider",
+					Region: "region",
+					Zones:  []string{"a", "b"},
+				},
+				Ingress: &gardencorev1beta1.Ingress{
+					Domain:     "ingress.seed.example.com",
+					Controller: gardencorev1beta1.IngressController{Kind: "nginx"},
+				},
+				Networks: gardencorev1beta1.SeedNetworks{
+					Pods:     "10.0.0.0/16",
+					Services: "10.1.0.0/16",
+					Nodes:    ptr.To("10.2.0.0/16"),
+				},
+				Settings: &gardencorev1beta1.SeedSettings{
+					Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: true},
+				},
+			},
+		}
+
+		out := &gardencorev1beta1.Seed{}
+		roundTrip(in, &gardencore.Seed{}, out)
+		Expect(out).To(DeepEqual(in))
+	})
+
+	It("should round-trip a CloudProfile through the internal version without loss", func() {
+		in := &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				Type: "provider",
+				Kubernetes: gardencorev1beta1.KubernetesSettings{
+					Versions: []gardencorev1beta1.ExpirableVersion{{Version: "1.31.1"}},
+				},
+				MachineImages: []gardencorev1beta1.MachineImage{{
+					Name: "image",
+					Versions: []gardencorev1beta1.MachineImageVersion{{
+						ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0"},
+						CRI:              []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRINameContainerD}},
+						Architectures:    []string{"amd64"},
+					}},
+				}},
+				MachineTypes: []gardencorev1beta1.MachineType{{
+					Name:         "machine-type",
+					Architecture: ptr.To("amd64"),
+				}},
+				Regions: []gardencorev1beta1.Region{{Name: "region"}},
+			},
+		}
+
+		out := &gardencorev1beta1.CloudProfile{}
+		roundTrip(in, &gardencore.CloudProfile{}, out)
+		Expect(out).To(DeepEqual(in))
+	})
+
+	It("should preserve unknown helm providerConfig fields of a ControllerDeployment across the round-trip", func() {
+		in := &gardencorev1beta1.ControllerDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "deployment"},
+			Type:       "helm",
+			ProviderConfig: runtime.RawExtension{
+				Raw: []byte(`{"chart":"` + "Zm9v" + `","values":{"foo":"bar"},"futureField":{"new":"setting"}}`),
+			},
+		}
+
+		internal := &gardencore.ControllerDeployment{}
+		out := &gardencorev1beta1.ControllerDeployment{}
+		roundTrip(in, internal, out)
+
+		By("recording the unknown field in the preserved fields annotation of the internal version")
+		Expect(internal.Annotations).To(HaveKeyWithValue("core.gardener.cloud/preserved-fields", `{"helmProviderConfig":{"futureField":{"new":"setting"}}}`))
+
+		By("restoring the unknown field in the providerConfig and dropping the annotation")
+		Expect(out.Annotations).To(BeEmpty())
+		var expected, actual map[string]any
+		Expect(json.Unmarshal(in.ProviderConfig.Raw, &expected)).To(Succeed())
+		Expect(json.Unmarshal(out.ProviderConfig.Raw, &actual)).To(Succeed())
+		Expect(actual).To(Equal(expected))
+	})
+})
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package deprecation contains the central registry of deprecated fields and values of the Gardener core APIs. The
+// API server strategies derive admission warnings from it, so that deprecation messages and their target removal
+// releases stay consistent across API versions and resources.
+package deprecation
+
+import (
+	"github.com/Masterminds/semver/v3"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/core/helper"
+	versionutils "github.com/gardener/gardener/pkg/utils/version"
+)
+
+// Deprecation describes a single deprecated field or value of an API object of type T.
+type Deprecation[T any] struct {
+	// Field is the path to the deprecated field.
+	Field string
+	// RemovalRelease is the release with which the deprecated field or value is planned to stop working.
+	RemovalRelease string
+	// Warning is the admission warning returned to clients using the deprecated field or value.
+	Warning string
+	// Used reports whether the given object uses the deprecated field or value.
+	Used func(obj T) bool
+}
+
+// Warnings returns the admission warnings for all deprecations in the given registry that are used by obj.
+func Warnings[T any](obj T, registry []Deprecation[T]) []string {
+	var warnings []string
+
+	for _, deprecation := range registry {
+		if deprecation.Used(obj) {
+			warnings = append(warnings, deprecation.Warning)
+		}
+	}
+
+	return warnings
+}
+
+// ShootDeprecations is the registry of deprecated Shoot fields and values.
+var ShootDeprecations = []Deprecation[*core.Shoot]{
+	{
+		// TODO(plkokanov): Remove this after support for Kubernetes v1.32 is dropped.
+		// We do not check for the Kubernetes version here because the shoot validation code is called before this
+		// and forbids setting .spec.kubernetes.kubeControllerManager.podEvictionTimeout for kubernetes >= v1.33.
+		Field:          "spec.kubernetes.kubeControllerManager.podEvictionTimeout",
+		RemovalRelease: "Kubernetes 1.33",
+		Warning:        "you are setting the spec.kubernetes.kubeControllerManager.podEvictionTimeout field. The field does not have effect since Kubernetes 1.13 and is forbidden to be set starting from Kubernetes 1.33. Instead, use the spec.kubernetes.kubeAPIServer.(defaultNotReadyTolerationSeconds/defaultUnreachableTolerationSeconds) fields.",
+		Used: func(shoot *core.Shoot) bool {
+			return shoot.Spec.Kubernetes.KubeControllerManager != nil && shoot.Spec.Kubernetes.KubeControllerManager.PodEvictionTimeout != nil
+		},
+	},
+	{
+		Field:          "spec.kubernetes.clusterAutoscaler.maxEmptyBulkDelete",
+		RemovalRelease: "Kubernetes 1.33",
+		Warning:        "you are setting the spec.kubernetes.clusterAutoscaler.maxEmptyBulkDelete field. The field has been deprecated and is forbidden to be set starting from Kubernetes 1.33. The value is not used and will be set to nil. Instead, use the spec.kubernetes.clusterAutoscaler.maxScaleDownParallelism field.",
+		Used: func(shoot *core.Shoot) bool {
+			supportedVersion, _ := versionutils.CompareVersions(shoot.Spec.Kubernetes.Version, "<", "1.33")
+			return supportedVersion && shoot.Spec.Kubernetes.ClusterAutoscaler != nil && shoot.Spec.Kubernetes.ClusterAutoscaler.MaxEmptyBulkDelete != nil
+		},
+	},
+	{
+		Field:          "spec.kubernetes.kubeAPIServer.enableAnonymousAuthentication",
+		RemovalRelease: "Kubernetes 1.32",
+		Warning:        "you are setting the spec.kubernetes.kubeAPIServer.enableAnonymousAuthentication field. The field is deprecated. Using Kubernetes v1.32 and above, please use anonymous authentication configuration. See: https://kubernetes.io/docs/reference/access-authn-authz/authentication/#anonymous-authenticator-configuration",
+		Used: func(shoot *core.Shoot) bool {
+			return helper.IsLegacyAnonymousAuthenticationSet(shoot.Spec.Kubernetes.KubeAPIServer)
+		},
+	},
+	{
+		Field:          "spec.cloudProfileName",
+		RemovalRelease: "Kubernetes 1.34",
+		Warning:        "you are setting the spec.cloudProfileName field. The field is deprecated and will be forcefully set empty starting with Kubernetes 1.34. Use the new spec.cloudProfile.name field instead.",
+		Used: func(shoot *core.Shoot) bool {
+			kubernetesVersion, err := semver.NewVersion(shoot.Spec.Kubernetes.Version)
+			return err == nil && versionutils.ConstraintK8sGreaterEqual133.Check(kubernetesVersion) && ptr.Deref(shoot.Spec.CloudProfileName, "") != ""
+		},
+	},
+	{
+		Field:          "spec.secretBindingName",
+		RemovalRelease: "Kubernetes 1.34",
+		Warning:        "spec.secretBindingName is deprecated and will be disallowed starting with Kubernetes 1.34. For migration instructions, see: https://github.com/gardener/gardener/blob/master/docs/usage/shoot-operations/secretbinding-to-credentialsbinding-migration.md",
+		Used: func(shoot *core.Shoot) bool {
+			return shoot.Spec.SecretBindingName != nil
+		},
+	},
+}
+
+// SeedDeprecations is the registry of deprecated Seed fields and values. It is currently empty, new Seed
+// deprecations must be added here so that the seed strategy emits the corresponding admission warnings.
+var SeedDeprecations []Deprecation[*core.Seed]
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package deprecation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/gardener/gardener/pkg/apis/core/deprecation"
+)
+
+var _ = Describe("Deprecation", func() {
+	Describe("#Warnings", func() {
+		var registry []Deprecation[*core.Shoot]
+
+		BeforeEach(func() {
+			registry = []Deprecation[*core.Shoot]{
+				{
+					Field:          "spec.foo",
+					RemovalRelease: "v1.2.3",
+					Warning:        "foo is deprecated",
+					Used:           func(shoot *core.Shoot) bool { return shoot.Spec.Region == "foo" },
+				},
+				{
+					Field:          "spec.bar",
+					RemovalRelease: "v4.5.6",
+					Warning:        "bar is deprecated",
+					Used:           func(shoot *core.Shoot) bool { return shoot.Spec.Region == "bar" },
+				},
+			}
+		})
+
+		It("should return no warnings if no deprecated field is used", func() {
+			Expect(Warnings(&core.Shoot{}, registry)).To(BeEmpty())
+		})
+
+		It("should return the warnings of all used deprecated fields", func() {
+			shoot := &core.Shoot{Spec: core.ShootSpec{Region: "foo"}}
+			Expect(Warnings(shoot, registry)).To(ConsistOf("foo is deprecated"))
+		})
+	})
+
+	Describe("#ShootDeprecations", func() {
+		It("should have a field, a removal release, and a warning for every entry", func() {
+			for _, deprecation := range ShootDeprecations {
+				Expect(deprecation.Field).NotTo(BeEmpty())
+				Expect(deprecation.RemovalRelease).NotTo(BeEmpty())
+				Expect(deprecation.Warning).NotTo(BeEmpty())
+				Expect(deprecation.Used).NotTo(BeNil())
+			}
+		})
+
+		It("should not report anything for an empty Shoot", func() {
+			Expect(Warnings(&core.Shoot{}, ShootDeprecations)).To(BeEmpty())
+		})
+
+		It("should report the usage of deprecated fields", func() {
+			shoot := &core.Shoot{
+				Spec: core.ShootSpec{
+					SecretBindingName: ptr.To("secret-binding"),
+				},
+			}
+
+			Expect(Warnings(shoot, ShootDeprecations)).To(ConsistOf(ContainSubstring("spec.secretBindingName is deprecated")))
+		})
+	})
+})
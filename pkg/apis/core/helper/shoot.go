@@ -213,6 +213,11 @@ func NginxIngressEnabled(addons *core.Addons) bool {
 	return addons != nil && addons.NginxIngress != nil && addons.NginxIngress.Enabled
 }
 
+// ResourceBackupEnabled returns true if the resource-backup addon is enabled in the Shoot manifest.
+func ResourceBackupEnabled(addons *core.Addons) bool {
+	return addons != nil && addons.ResourceBackup != nil && addons.ResourceBackup.Enabled
+}
+
 // FindPrimaryDNSProvider finds the primary provider among the given `providers`.
 // It returns the first provider if multiple candidates are found.
 func FindPrimaryDNSProvider(providers []core.DNSProvider) *core.DNSProvider {
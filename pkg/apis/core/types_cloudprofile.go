@@ -76,6 +76,20 @@ type CloudProfileSpec struct {
 	// The order of values for a given capability is relevant. The most important value is listed first.
 	// During maintenance upgrades, the image that matches most capabilities will be selected.
 	MachineCapabilities []CapabilityDefinition
+	// RegistryMirrors contains declarations of registry mirrors / alternate image locations that shoot worker nodes
+	// targeting this profile should use when pulling container images, optionally restricted to certain regions.
+	RegistryMirrors []RegistryMirror
+}
+
+// RegistryMirror declares a mirror / alternate image location for an upstream container registry.
+type RegistryMirror struct {
+	// Upstream is the host (and optionally port) of the upstream container registry that is mirrored.
+	Upstream string
+	// Hosts are the URLs of the mirror hosts to be used for the upstream, in the order in which they should be tried.
+	Hosts []string
+	// Regions restricts this mirror declaration to shoots in the given regions.
+	// If empty, the mirror applies to all regions.
+	Regions []string
 }
 
 // SeedSelector contains constraints for selecting seed to be usable for shoots using a profile
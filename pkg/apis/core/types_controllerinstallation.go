@@ -62,6 +62,9 @@ type ControllerInstallationStatus struct {
 const (
 	// ControllerInstallationHealthy is a condition type for indicating whether the controller is healthy.
 	ControllerInstallationHealthy ConditionType = "Healthy"
+	// ControllerInstallationHeartbeatUpdated is a condition type for indicating whether the extension controller
+	// regularly renews its heartbeat lease in the seed cluster.
+	ControllerInstallationHeartbeatUpdated ConditionType = "HeartbeatUpdated"
 	// ControllerInstallationInstalled is a condition type for indicating whether the controller has been installed.
 	ControllerInstallationInstalled ConditionType = "Installed"
 	// ControllerInstallationProgressing is a condition type for indicating whether the controller is progressing.
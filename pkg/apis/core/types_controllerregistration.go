@@ -6,6 +6,7 @@ package core
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // +genclient
@@ -84,6 +85,18 @@ type ControllerResource struct {
 	// If compatibility is not specified, it will be defaulted to 'shoot'.
 	// This field can only be set for resources of kind "Extension".
 	ClusterCompatibility []ClusterType
+	// Addon describes the shoot addon backed by this resource. If set, users can enable the addon in their Shoot
+	// spec via `.spec.managedAddons` using the addon's name.
+	// This field can only be set for resources of kind "Extension".
+	Addon *ControllerResourceAddon
+}
+
+// ControllerResourceAddon describes a shoot addon backed by a ControllerResource of kind "Extension".
+type ControllerResourceAddon struct {
+	// Name is the name under which the addon can be enabled in the Shoot spec.
+	Name string
+	// Schema is an optional JSON schema describing the configuration options of the addon.
+	Schema *runtime.RawExtension
 }
 
 // DeploymentRef contains information about `ControllerDeployment` references.
@@ -6,6 +6,7 @@ package core
 
 import (
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -78,6 +79,28 @@ type ProjectStatus struct {
 	StaleAutoDeleteTimestamp *metav1.Time
 	// LastActivityTimestamp contains the timestamp from the last activity performed in this project.
 	LastActivityTimestamp *metav1.Time
+	// UsageSummary aggregates the counts and capacity consumption of the shoots in this project. It is maintained by
+	// the "project-usage" controller of the gardener-controller-manager.
+	UsageSummary *ProjectUsageSummary
+}
+
+// ProjectUsageSummary aggregates the counts and capacity consumption of the shoots in a project.
+type ProjectUsageSummary struct {
+	// Shoots is the total number of shoots in the project.
+	Shoots int32
+	// HibernatedShoots is the number of shoots in the project which are hibernated.
+	HibernatedShoots int32
+	// Nodes is the maximum number of nodes the worker pools of the non-hibernated shoots in the project may scale up
+	// to.
+	Nodes int32
+	// CPU is the CPU capacity the worker pools of the non-hibernated shoots in the project may consume at their
+	// maximum size.
+	CPU resource.Quantity
+	// Memory is the memory capacity the worker pools of the non-hibernated shoots in the project may consume at their
+	// maximum size.
+	Memory resource.Quantity
+	// LastUpdateTime is the time when the usage summary was last updated.
+	LastUpdateTime metav1.Time
 }
 
 // ProjectMember is a member of a project.
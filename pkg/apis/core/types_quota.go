@@ -51,6 +51,8 @@ const (
 	QuotaMetricGPU corev1.ResourceName = "gpu"
 	// QuotaMetricMemory is the constraint for the amount of memory
 	QuotaMetricMemory corev1.ResourceName = corev1.ResourceMemory
+	// QuotaMetricNodes is the constraint for the amount of worker nodes
+	QuotaMetricNodes corev1.ResourceName = "nodes"
 	// QuotaMetricStorageStandard is the constraint for the size of a standard disk
 	QuotaMetricStorageStandard corev1.ResourceName = corev1.ResourceStorage + ".standard"
 	// QuotaMetricStoragePremium is the constraint for the size of a premium disk (e.g. SSD)
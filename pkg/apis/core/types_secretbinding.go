@@ -29,6 +29,14 @@ type SecretBinding struct {
 	// Provider defines the provider type of the SecretBinding.
 	// This field is immutable.
 	Provider *SecretBindingProvider
+	// Status contains the latest observed status of the SecretBinding.
+	Status SecretBindingStatus
+}
+
+// SecretBindingStatus contains the latest observed status of a SecretBinding.
+type SecretBindingStatus struct {
+	// Conditions represents the latest available observations of the referenced credentials' validity.
+	Conditions []Condition
 }
 
 // SecretBindingProvider defines the provider type of the SecretBinding.
@@ -99,6 +99,23 @@ type SeedStatus struct {
 	ClientCertificateExpirationTimestamp *metav1.Time
 	// LastOperation holds information about the last operation on the Seed.
 	LastOperation *LastOperation
+	// Availability contains a compact availability history of the seed as observed via the heartbeat Lease of its
+	// gardenlet.
+	Availability *SeedAvailability
+}
+
+// SeedAvailability contains a compact availability history of a seed.
+type SeedAvailability struct {
+	// DowntimeWindows is the number of downtime windows observed for the seed, i.e. how often its gardenlet stopped
+	// renewing the heartbeat Lease in time.
+	DowntimeWindows int32
+	// AccumulatedDowntimeSeconds is the total duration in seconds of all closed downtime windows.
+	AccumulatedDowntimeSeconds int64
+	// LastDowntimeBegin is the time when the most recent downtime window began.
+	LastDowntimeBegin *metav1.Time
+	// LastDowntimeEnd is the time when the most recent downtime window ended. It is not set while a downtime window
+	// is still open.
+	LastDowntimeEnd *metav1.Time
 }
 
 // Backup contains the object store configuration for backups for shoot (currently only etcd).
@@ -221,6 +238,12 @@ type SeedSettings struct {
 	// TopologyAwareRouting controls certain settings for topology-aware traffic routing in the seed.
 	// See https://github.com/gardener/gardener/blob/master/docs/operations/topology_aware_routing.md.
 	TopologyAwareRouting *SeedSettingTopologyAwareRouting
+	// IstioIngressGateway controls the autoscaling and resource configuration of the istio ingress gateways deployed
+	// in the seed.
+	IstioIngressGateway *SeedSettingIstioIngressGateway
+	// DNSCache controls the deployment of a caching DNS resolver in the seed.
+	// See https://github.com/gardener/gardener/blob/master/docs/operations/seed_settings.md#dns-cache.
+	DNSCache *SeedSettingDNSCache
 }
 
 // SeedSettingExcessCapacityReservation controls the excess capacity reservation for shoot control planes in the
@@ -234,12 +257,17 @@ type SeedSettingExcessCapacityReservation struct {
 
 // SeedSettingExcessCapacityReservationConfig configures excess capacity reservation deployments for shoot control planes in the seed.
 type SeedSettingExcessCapacityReservationConfig struct {
-	// Resources specify the resource requests and limits of the excess-capacity-reservation pod.
+	// Resources specify the resource requests and limits of the excess-capacity-reservation pod. It is mutually
+	// exclusive with percentage and exactly one of both must be set.
 	Resources corev1.ResourceList
 	// NodeSelector specifies the node where the excess-capacity-reservation pod should run.
 	NodeSelector map[string]string
 	// Tolerations specify the tolerations for the the excess-capacity-reservation pod.
 	Tolerations []corev1.Toleration
+	// Percentage is the percentage of the seed cluster's allocatable cpu and memory capacity that should be reserved.
+	// The reservation is split evenly across the excess-capacity-reservation pod replicas. It is mutually exclusive
+	// with resources and exactly one of both must be set.
+	Percentage *int32
 }
 
 // SeedSettingScheduling controls settings for scheduling decisions for the seed.
@@ -336,6 +364,9 @@ type SeedSettingDependencyWatchdogWeeder struct {
 	// helps to alleviate the delay where control plane components remain unavailable by finding the respective pods in
 	// CrashLoopBackoff status and restarting them once their dependants become ready and available again.
 	Enabled bool
+	// WatchDuration is the duration for which the weeder watches for dependant pods to transition to CrashLoopBackoff
+	// after the target service has recovered.
+	WatchDuration *metav1.Duration
 }
 
 // SeedSettingDependencyWatchdogProber controls the prober settings for the dependency-watchdog for the seed.
@@ -343,6 +374,8 @@ type SeedSettingDependencyWatchdogProber struct {
 	// Enabled controls whether the prober of the dependency-watchdog should be enabled.
 	// reachable via its external ingress in order to avoid melt-down situations.
 	Enabled bool
+	// ProbeInterval is the interval in which the prober probes the kube-apiservers of shoot clusters.
+	ProbeInterval *metav1.Duration
 }
 
 // SeedSettingTopologyAwareRouting controls certain settings for topology-aware traffic routing in the seed.
@@ -353,6 +386,47 @@ type SeedSettingTopologyAwareRouting struct {
 	Enabled bool
 }
 
+// SeedSettingIstioIngressGateway controls the autoscaling and resource configuration of the istio ingress gateways
+// deployed in the seed.
+type SeedSettingIstioIngressGateway struct {
+	// Autoscaling overrides the default autoscaling and resource configuration of the default istio ingress gateway.
+	// The configuration applies to each of its ingress gateway deployments (including zonal ones) individually.
+	Autoscaling *SeedSettingIstioIngressGatewayAutoscaling
+	// ExposureClassHandlers contains overrides for the istio ingress gateways of exposure class handlers.
+	ExposureClassHandlers []SeedSettingIstioIngressGatewayExposureClassHandler
+}
+
+// SeedSettingIstioIngressGatewayExposureClassHandler contains overrides for the istio ingress gateway of an exposure
+// class handler.
+type SeedSettingIstioIngressGatewayExposureClassHandler struct {
+	// Name is the name of the exposure class handler as configured in the gardenlet.
+	Name string
+	// Autoscaling overrides the autoscaling and resource configuration of the istio ingress gateway of this handler.
+	// The configuration applies to each of its ingress gateway deployments (including zonal ones) individually.
+	Autoscaling *SeedSettingIstioIngressGatewayAutoscaling
+}
+
+// SeedSettingIstioIngressGatewayAutoscaling contains the autoscaling and resource configuration of an istio ingress
+// gateway.
+type SeedSettingIstioIngressGatewayAutoscaling struct {
+	// MinReplicas is the minimum number of istio-ingressgateway replicas.
+	MinReplicas *int32
+	// MaxReplicas is the maximum number of istio-ingressgateway replicas.
+	MaxReplicas *int32
+	// Requests overrides the compute resource requests of the istio-proxy container. Only the cpu and memory requests
+	// may be set.
+	Requests corev1.ResourceList
+}
+
+// SeedSettingDNSCache controls the deployment of a caching DNS resolver in the seed.
+// See https://github.com/gardener/gardener/blob/master/docs/operations/seed_settings.md#dns-cache.
+type SeedSettingDNSCache struct {
+	// Enabled controls whether a caching DNS resolver shall be deployed into the garden namespace in the seed cluster.
+	// It caches responses (including negative responses) of the cluster DNS in order to reduce the sensitivity of
+	// high-frequency lookups performed by shoot control plane components to short upstream DNS outages.
+	Enabled bool
+}
+
 // SeedTaint describes a taint on a seed.
 type SeedTaint struct {
 	// Key is the taint key to be applied to a seed.
@@ -115,6 +115,12 @@ type ShootSpec struct {
 	CredentialsBindingName *string
 	// AccessRestrictions describe a list of access restrictions for this shoot cluster.
 	AccessRestrictions []AccessRestrictionWithOptions
+	// SeedAffinity contains affinity rules which gardener-scheduler evaluates when selecting a seed for this shoot.
+	// It is only respected if no seed name is set.
+	SeedAffinity *SeedAffinity
+	// ManagedAddons contains the addons registered via `ControllerRegistration`s which shall be enabled for this shoot
+	// cluster. In contrast to the legacy `.spec.addons` field, this list is generic and backed by extensions.
+	ManagedAddons []ManagedAddon
 }
 
 // ShootStatus holds the most recently observed status of the Shoot cluster.
@@ -174,6 +180,13 @@ type ShootStatus struct {
 	InPlaceUpdates *InPlaceUpdatesStatus
 	// ManualWorkerPoolRollout contains information about the worker pool rollout progress.
 	ManualWorkerPoolRollout *ManualWorkerPoolRollout
+	// ResourceConsumption contains information about the resource consumption of the shoot's control plane.
+	ResourceConsumption *ControlPlaneResourceConsumption
+	// WorkerPools contains the most recently observed machine counts per worker pool.
+	WorkerPools []WorkerPoolStatus
+	// UpgradePaths contains machine-readable upgrade path recommendations for the Shoot, computed from the referenced
+	// CloudProfile.
+	UpgradePaths *ShootUpgradePaths
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -200,6 +213,18 @@ type NetworkingStatus struct {
 	// Infrastructure extension controller. For certain environments the egress IPs may not be stable in which case the
 	// extension controller may opt to not populate this field.
 	EgressCIDRs []string
+	// EgressCIDRsByZone is a list of CIDRs used by the shoot as the source IP for egress traffic, grouped by the
+	// availability zone the traffic originates from (e.g. the IPs of zonal NAT gateways) as reported by the used
+	// Infrastructure extension controller. It is only populated if the egress IPs differ per zone.
+	EgressCIDRsByZone []ZoneEgressCIDRs
+}
+
+// ZoneEgressCIDRs contains the egress CIDRs used by worker nodes in a single availability zone.
+type ZoneEgressCIDRs struct {
+	// Zone is the name of the availability zone.
+	Zone string
+	// CIDRs is a list of CIDRs used as the source IP for egress traffic originating from this zone.
+	CIDRs []string
 }
 
 // InPlaceUpdatesStatus contains information about in-place updates for the Shoot workers.
@@ -236,6 +261,8 @@ type ShootCredentialsRotation struct {
 	ServiceAccountKey *ServiceAccountKeyRotation
 	// ETCDEncryptionKey contains information about the ETCD encryption key credential rotation.
 	ETCDEncryptionKey *ETCDEncryptionKeyRotation
+	// CloudCredentials contains information about the rollout of rotated cloud provider credentials.
+	CloudCredentials *CloudCredentialsRotation
 }
 
 // EncryptionAtRest contains information about Shoot data encryption at rest.
@@ -244,6 +271,18 @@ type EncryptionAtRest struct {
 	// Secrets are encrypted by default and are not part of the list.
 	// See https://github.com/gardener/gardener/blob/master/docs/usage/security/etcd_encryption_config.md for more details.
 	Resources []string
+	// MigrationProgress tracks the progress of rewriting existing data after resources were added to or removed from
+	// the encryption configuration. It is only present while such a storage migration is in progress.
+	MigrationProgress *EncryptionAtRestMigrationProgress
+}
+
+// EncryptionAtRestMigrationProgress tracks the progress of the storage migration which rewrites existing data after
+// the encryption configuration was changed.
+type EncryptionAtRestMigrationProgress struct {
+	// MigratedResourceCount is the number of resource kinds whose objects have already been rewritten.
+	MigratedResourceCount int32
+	// RemainingResourceCount is the number of resource kinds whose objects still have to be rewritten.
+	RemainingResourceCount int32
 }
 
 // CARotation contains information about the certificate authority credential rotation.
@@ -272,6 +311,74 @@ type ManualWorkerPoolRollout struct {
 	PendingWorkersRollouts []PendingWorkersRollout
 }
 
+// ControlPlaneResourceConsumption contains information about the resource consumption of the shoot's control plane.
+type ControlPlaneResourceConsumption struct {
+	// KubeAPIServerCPU is the sum of the current CPU usage of all kube-apiserver replicas.
+	KubeAPIServerCPU *resource.Quantity
+	// KubeAPIServerMemory is the sum of the current memory usage of all kube-apiserver replicas.
+	KubeAPIServerMemory *resource.Quantity
+	// ETCDStorageSize is the storage capacity provisioned for the main etcd.
+	ETCDStorageSize *resource.Quantity
+	// LastUpdateTime is the time when the resource consumption was last measured.
+	LastUpdateTime metav1.Time
+}
+
+// WorkerPoolStatus holds the most recently observed machine counts of a worker pool, aggregated from the machine
+// deployments in the seed cluster.
+type WorkerPoolStatus struct {
+	// Name is the name of the worker pool.
+	Name string
+	// DesiredMachines is the number of machines the worker pool should currently have.
+	DesiredMachines int32
+	// CurrentMachines is the number of machines the worker pool currently has.
+	CurrentMachines int32
+	// UpdatedMachines is the number of machines which already run the desired configuration.
+	UpdatedMachines int32
+	// UnavailableMachines is the number of machines which are not yet available.
+	UnavailableMachines int32
+	// LastRolloutTime is the time when the worker pool rollout last progressed.
+	LastRolloutTime *metav1.Time
+}
+
+// ShootUpgradePaths holds machine-readable upgrade path recommendations for a Shoot, computed from the referenced
+// CloudProfile.
+type ShootUpgradePaths struct {
+	// Kubernetes contains the upgrade path of the shoot's Kubernetes version.
+	Kubernetes VersionUpgradePath
+	// MachineImages contains the upgrade paths of the worker pools' machine image versions.
+	MachineImages []MachineImageUpgradePath
+	// LastUpdateTime is the time when the upgrade paths were last computed.
+	LastUpdateTime metav1.Time
+}
+
+// VersionUpgradePath describes the supported upgrades and the expiration of a version.
+type VersionUpgradePath struct {
+	// Version is the current version.
+	Version string
+	// NextPatch is the latest supported patch version of the current minor version, if any.
+	NextPatch *string
+	// NextMinor is the latest supported patch version of the next higher supported minor version, if any.
+	NextMinor *string
+	// ExpirationDate is the deadline after which the current version is considered expired and a forceful update
+	// becomes necessary, if any.
+	ExpirationDate *metav1.Time
+}
+
+// MachineImageUpgradePath describes the supported upgrade of a worker pool's machine image version.
+type MachineImageUpgradePath struct {
+	// WorkerPool is the name of the worker pool.
+	WorkerPool string
+	// Image is the name of the machine image.
+	Image string
+	// Version is the current version of the machine image.
+	Version string
+	// Next is the version the machine image can be updated to according to its update strategy, if any.
+	Next *string
+	// ExpirationDate is the deadline after which the current version is considered expired and a forceful update
+	// becomes necessary, if any.
+	ExpirationDate *metav1.Time
+}
+
 // ShootKubeconfigRotation contains information about the kubeconfig credential rotation.
 type ShootKubeconfigRotation struct {
 	// LastInitiationTime is the most recent time when the kubeconfig credential rotation was initiated.
@@ -296,6 +403,15 @@ type ObservabilityRotation struct {
 	LastCompletionTime *metav1.Time
 }
 
+// CloudCredentialsRotation contains information about the rollout of rotated cloud provider credentials.
+type CloudCredentialsRotation struct {
+	// LastInitiationTime is the most recent time when the cloud provider credential rollout was initiated.
+	LastInitiationTime *metav1.Time
+	// LastCompletionTime is the most recent time when the rotated cloud provider credentials were successfully rolled
+	// out to all components consuming them.
+	LastCompletionTime *metav1.Time
+}
+
 // ServiceAccountKeyRotation contains information about the service account key credential rotation.
 type ServiceAccountKeyRotation struct {
 	// Phase describes the phase of the service account key credential rotation.
@@ -378,12 +494,41 @@ type ShootAdvertisedAddress struct {
 	URL string
 }
 
+// SeedAffinity contains affinity rules which gardener-scheduler evaluates when selecting a seed for a shoot, similar
+// to pod (anti-)affinity.
+type SeedAffinity struct {
+	// RequiredDuringScheduling is a list of label selectors. At least one of them must match the labels of a seed for
+	// the seed to be considered during scheduling.
+	RequiredDuringScheduling []metav1.LabelSelector
+	// PreferredDuringScheduling is a list of weighted terms. The scheduler prefers the seeds with the highest sum of
+	// weights of the matching terms.
+	PreferredDuringScheduling []WeightedSeedAffinityTerm
+}
+
+// WeightedSeedAffinityTerm is a label selector associated with a weight.
+type WeightedSeedAffinityTerm struct {
+	// Weight associated with matching the term, in the range 1-100.
+	Weight int32
+	// LabelSelector is matched against the labels of a seed.
+	LabelSelector metav1.LabelSelector
+}
+
+// ManagedAddon refers to an addon registered via a ControllerRegistration which shall be enabled for a shoot cluster.
+type ManagedAddon struct {
+	// Name is the name of the addon as registered via the ControllerRegistration.
+	Name string
+	// Config contains configuration for the addon. It is passed as provider config to the backing extension resource.
+	Config *runtime.RawExtension
+}
+
 // Addons is a collection of configuration for specific addons which are managed by the Gardener.
 type Addons struct {
 	// KubernetesDashboard holds configuration settings for the kubernetes dashboard addon.
 	KubernetesDashboard *KubernetesDashboard
 	// NginxIngress holds configuration settings for the nginx-ingress addon.
 	NginxIngress *NginxIngress
+	// ResourceBackup holds configuration settings for the resource-backup addon.
+	ResourceBackup *ResourceBackupAddon
 }
 
 // Addon allows enabling or disabling a specific addon and is used to derive from.
@@ -419,6 +564,23 @@ type NginxIngress struct {
 	ExternalTrafficPolicy *corev1.ServiceExternalTrafficPolicy
 }
 
+// ResourceBackupAddon describes configuration values for the resource-backup addon. The addon periodically exports the
+// Kubernetes resources of the shoot cluster to a prefix scoped to the shoot's backup entry in the backup bucket of the
+// seed.
+type ResourceBackupAddon struct {
+	Addon
+
+	// Schedule is a cron spec in standard format which determines when the resources are exported. Defaults to
+	// `0 */6 * * *` (every six hours).
+	Schedule *string
+	// RetentionPeriod is the duration for which exported resource backups are kept before they are garbage collected.
+	// Defaults to `168h` (seven days).
+	RetentionPeriod *metav1.Duration
+	// IncludedNamespaces is a list of namespaces whose resources are exported. If empty, the resources of all
+	// namespaces are exported.
+	IncludedNamespaces []string
+}
+
 // ControlPlane holds information about the general settings for the control plane of a shoot.
 type ControlPlane struct {
 	// HighAvailability holds the configuration settings for high availability of the
@@ -431,6 +593,11 @@ type DNS struct {
 	// Domain is the external available domain of the Shoot cluster. This domain will be written into the
 	// kubeconfig that is handed out to end-users. This field is immutable.
 	Domain *string
+	// ManagementDomain is an optional domain under which the kube-apiserver is additionally exposed. The
+	// corresponding DNS record is expected to be resolvable from the internal network only (e.g., via a private
+	// DNS zone or an internal load balancer provided by the responsible provider extension), so workloads can
+	// reach the API server without traversing the public internet.
+	ManagementDomain *string
 	// Providers is a list of DNS providers that shall be enabled for this shoot cluster. Only relevant if
 	// not a default domain is used.
 	//
@@ -486,8 +653,24 @@ type Hibernation struct {
 	Enabled *bool
 	// Schedules determine the hibernation schedules.
 	Schedules []HibernationSchedule
+	// Mode determines which parts of the cluster are scaled down during hibernation. Defaults to 'Full'.
+	Mode *HibernationMode
 }
 
+// HibernationMode is a type alias for string denoting which parts of a cluster are scaled down during hibernation.
+type HibernationMode string
+
+const (
+	// HibernationModeFull scales down both the worker nodes and the control plane.
+	HibernationModeFull HibernationMode = "Full"
+	// HibernationModeWorkersOnly scales down only the worker nodes and keeps the control plane running, so that the
+	// API server remains reachable.
+	HibernationModeWorkersOnly HibernationMode = "WorkersOnly"
+	// HibernationModeControlPlaneMinimized scales down the worker nodes and reduces the control plane to a minimal
+	// footprint while keeping the API server reachable.
+	HibernationModeControlPlaneMinimized HibernationMode = "ControlPlaneMinimized"
+)
+
 // HibernationSchedule determines the hibernation schedule of a Shoot.
 // A Shoot will be regularly hibernated at each start time and will be woken up at each end time.
 // Start or End can be omitted, though at least one of each has to be specified.
@@ -756,6 +939,19 @@ type KubeAPIServerConfig struct {
 	StructuredAuthorization *StructuredAuthorization
 	// Autoscaling contains auto-scaling configuration options for the kube-apiserver.
 	Autoscaling *ControlPlaneAutoscaling
+	// RequestBasedAutoscaling contains configuration for horizontally scaling the kube-apiserver on request-based
+	// metrics in addition to its resource metrics.
+	RequestBasedAutoscaling *KubeAPIServerRequestBasedAutoscaling
+}
+
+// KubeAPIServerRequestBasedAutoscaling contains configuration for horizontally scaling the kube-apiserver on
+// request-based metrics. The metrics are collected from the kube-apiserver pods by the shoot's Prometheus in the seed
+// and require a custom metrics adapter to be available in the seed cluster.
+type KubeAPIServerRequestBasedAutoscaling struct {
+	// AverageRequestsPerSecond is the target average number of requests per second per kube-apiserver replica.
+	AverageRequestsPerSecond *int32
+	// AverageInflightRequests is the target average number of inflight requests per kube-apiserver replica.
+	AverageInflightRequests *int32
 }
 
 // ControlPlaneAutoscaling contains auto-scaling configuration options for control-plane components.
@@ -789,6 +985,26 @@ type EncryptionConfig struct {
 	// Wildcards are not supported for now.
 	// See https://github.com/gardener/gardener/blob/master/docs/usage/security/etcd_encryption_config.md for more details.
 	Resources []string
+	// KMS contains configuration for encrypting resources with a customer-managed key via an external KMS v2
+	// provider instead of the Gardener-generated encryption keys. The Gardener-generated keys are kept as
+	// fallback providers for decryption, so the existing credentials rotation flows continue to work.
+	KMS *KMSEncryptionConfiguration
+}
+
+// KMSEncryptionConfiguration contains configuration for encrypting resources with a customer-managed key via an
+// external KMS provider.
+type KMSEncryptionConfiguration struct {
+	// Name is the name of the KMS plugin to be used. It is part of the data storage prefix in etcd, so changing it
+	// triggers a re-encryption of all affected resources.
+	Name string
+	// Endpoint is the gRPC listening address of the KMS plugin, for example "unix:///var/run/kms-provider.sock".
+	Endpoint string
+	// SecretName is the name of a secret in the project namespace containing the credentials or key reference for
+	// the KMS provider. The secret is made available in the shoot's control plane namespace so that the KMS plugin
+	// can consume it.
+	SecretName *string
+	// Timeout is the maximum duration of a gRPC call to the KMS plugin. Defaults to 3s.
+	Timeout *metav1.Duration
 }
 
 // ServiceAccountConfig is the kube-apiserver configuration for service accounts.
@@ -830,7 +1046,67 @@ type AuditPolicy struct {
 type StructuredAuthentication struct {
 	// ConfigMapName is the name of the ConfigMap in the project namespace
 	// which contains AuthenticationConfiguration for the kube-apiserver.
+	// It is mutually exclusive with jwtAuthenticators.
 	ConfigMapName string
+	// JWTAuthenticators is a list of JWT issuers trusted for authenticating to the kube-apiserver. It is rendered by
+	// gardenlet into an AuthenticationConfiguration for the kube-apiserver and is mutually exclusive with
+	// configMapName.
+	JWTAuthenticators []JWTAuthenticator
+}
+
+// JWTAuthenticator configures a JWT issuer trusted for authenticating to the kube-apiserver.
+type JWTAuthenticator struct {
+	// Issuer contains the basic settings of the JWT issuer.
+	Issuer AuthenticationIssuer
+	// ClaimMappings configures how token claims are mapped to user identities.
+	ClaimMappings *AuthenticationClaimMappings
+	// ClaimValidationRules are rules that are applied to validate token claims to authenticate users.
+	ClaimValidationRules []AuthenticationClaimValidationRule
+}
+
+// AuthenticationIssuer provides the configuration for a JWT issuer.
+type AuthenticationIssuer struct {
+	// URL points to the issuer URL in a format https://url or https://url/path. It must use the https:// scheme.
+	URL string
+	// Audiences is the set of acceptable audiences the JWT must be issued to.
+	Audiences []string
+	// CertificateAuthority contains a PEM-encoded certificate authority bundle used to validate the connection when
+	// fetching the issuer's discovery document. If unset, the system trust store is used.
+	CertificateAuthority *string
+}
+
+// AuthenticationClaimMappings configures how token claims are mapped to user identities.
+type AuthenticationClaimMappings struct {
+	// Username represents an option for the username attribute.
+	Username *AuthenticationClaimOrExpression
+	// Groups represents an option for the groups attribute.
+	Groups *AuthenticationClaimOrExpression
+}
+
+// AuthenticationClaimOrExpression configures how a user identity attribute is derived from token claims.
+type AuthenticationClaimOrExpression struct {
+	// Claim is the JWT claim to use. It is mutually exclusive with expression.
+	Claim *string
+	// Prefix is prepended to claim values to prevent clashes with existing names. It may only be set together with
+	// claim.
+	Prefix *string
+	// Expression is a CEL expression that evaluates to the attribute value. It is mutually exclusive with claim.
+	Expression *string
+}
+
+// AuthenticationClaimValidationRule provides the configuration for a single claim validation rule.
+type AuthenticationClaimValidationRule struct {
+	// Claim is the name of a required claim. It is mutually exclusive with expression.
+	Claim *string
+	// RequiredValue is the value of the claim which is required to be present with an exact match. It may only be set
+	// together with claim.
+	RequiredValue *string
+	// Expression is a CEL expression that must evaluate to true for the token to be accepted. It is mutually exclusive
+	// with claim.
+	Expression *string
+	// Message is returned in the authentication failure reason when the expression evaluates to false. It may only be
+	// set together with expression.
+	Message *string
 }
 
 // StructuredAuthorization contains authorization config for kube-apiserver.
@@ -945,6 +1221,20 @@ type KubeControllerManagerConfig struct {
 	PodEvictionTimeout *metav1.Duration
 	// NodeMonitorGracePeriod defines the grace period before an unresponsive node is marked unhealthy.
 	NodeMonitorGracePeriod *metav1.Duration
+	// ConcurrentSyncs allows configuring the number of concurrent workers for selected controllers of the
+	// kube-controller-manager.
+	ConcurrentSyncs *KubeControllerManagerConcurrentSyncs
+}
+
+// KubeControllerManagerConcurrentSyncs contains the number of concurrent workers for selected controllers of the
+// kube-controller-manager.
+type KubeControllerManagerConcurrentSyncs struct {
+	// Deployment is the number of workers for the Deployment controller (default is 50).
+	Deployment *int32
+	// ReplicaSet is the number of workers for the ReplicaSet controller (default is 50).
+	ReplicaSet *int32
+	// StatefulSet is the number of workers for the StatefulSet controller (default is 15).
+	StatefulSet *int32
 }
 
 // HorizontalPodAutoscalerConfig contains horizontal pod autoscaler configuration settings for the kube-controller-manager.
@@ -1255,6 +1545,10 @@ type MaintenanceTimeWindow struct {
 	// End is the end of the time window in the format HHMMSS+ZONE, e.g. "220000+0100".
 	// If not present, the value will be computed based on the "Begin" value.
 	End string
+	// Location is the IANA timezone in which the clock times of Begin and End shall be evaluated, e.g. "Europe/Berlin".
+	// If set, the zone offsets in Begin and End are ignored and the time window follows daylight saving time changes of
+	// the location. If not set, the zone offsets in Begin and End apply.
+	Location *string
 }
 
 // Monitoring contains information about the monitoring configuration for the shoot.
@@ -1347,6 +1641,11 @@ type Worker struct {
 	// ControlPlane specifies that the shoot cluster control plane components should be running in this worker pool.
 	// This is only relevant for self-hosted shoot clusters.
 	ControlPlane *WorkerControlPlane
+	// NodeWorkloadIdentityName is the name of a WorkloadIdentity in the same namespace as the Shoot whose identity
+	// shall be bound to the nodes of this worker pool by the provider extension (e.g., via instance profiles or
+	// managed identities). This allows workloads running on the nodes to access cloud provider APIs without static
+	// credentials being distributed to the machines.
+	NodeWorkloadIdentityName *string
 }
 
 // WorkerControlPlane specifies that the shoot cluster control plane components should be running in this worker pool.
@@ -1475,6 +1774,28 @@ type CRI struct {
 	Name CRIName
 	// ContainerRuntimes is the list of the required container runtimes supported for a worker pool.
 	ContainerRuntimes []ContainerRuntime
+	// Containerd contains configuration options for containerd.
+	Containerd *ContainerdConfig
+}
+
+// ContainerdConfig contains configuration options for containerd.
+type ContainerdConfig struct {
+	// Registries configures the registry hosts used by containerd to pull images from upstream registries.
+	Registries []ContainerdRegistry
+}
+
+// ContainerdRegistry configures the registry hosts for one upstream container registry.
+type ContainerdRegistry struct {
+	// Upstream is the host name of the upstream registry (e.g., "docker.io").
+	Upstream string
+	// Server is the fallback server URL for this upstream.
+	Server *string
+	// Hosts is the list of registry mirror URLs used to pull images of this upstream.
+	Hosts []string
+	// AuthSecretName references a secret in the shoot's project namespace containing credentials for the registry mirrors.
+	AuthSecretName *string
+	// AllowInsecure allows plain HTTP mirror URLs for this upstream.
+	AllowInsecure *bool
 }
 
 // CRIName is a type alias for the CRI name string.
@@ -48,6 +48,9 @@ const (
 	// SecretNameSSHKeyPair is a constant for the name of a Kubernetes secret object that contains the SSH key pair
 	// (public and private key) that can be used to SSH into the shoot nodes.
 	SecretNameSSHKeyPair = "ssh-keypair" // #nosec G101 -- No credential.
+	// SecretNameCASSH is a constant for the name of a Kubernetes secret object that contains the SSH certificate
+	// authority key pair whose signed certificates are trusted by the shoot nodes.
+	SecretNameCASSH = "ca-ssh"
 	// SecretNameServiceAccountKey is a constant for the name of a Kubernetes secret object that contains a
 	// PEM-encoded private RSA or ECDSA key used by the Kube Controller Manager to sign service account tokens.
 	SecretNameServiceAccountKey = "service-account-key"
@@ -181,6 +184,9 @@ const (
 	// ConfigMapNameShootInfo is the name of a ConfigMap in the kube-system namespace of shoot clusters which contains
 	// information about the shoot cluster.
 	ConfigMapNameShootInfo = "shoot-info"
+	// ConfigMapNameEgressEndpoints is the name of a ConfigMap in the kube-system namespace of shoot clusters which
+	// contains the endpoints that shoot worker networks must be able to reach, e.g. for configuring egress firewalls.
+	ConfigMapNameEgressEndpoints = "egress-endpoints"
 
 	// StatefulSetNameAlertManager is a constant for the name of a Kubernetes stateful set object that contains
 	// the alertmanager pod.
@@ -223,6 +229,10 @@ const (
 	// GardenerTimestamp is a constant for an annotation on a resource that describes the timestamp when a reconciliation has been requested.
 	// It is only used to guarantee an update event for watching clients in case the operation-annotation is already present.
 	GardenerTimestamp = "gardener.cloud/timestamp"
+	// AnnotationReconciliationPaused is a constant for an annotation on a resource whose value must be set to "true" in
+	// order to tell Gardener controllers to skip reconciling this specific object, e.g. during an incident. In contrast
+	// to disabling a whole controller, other objects of the same kind are still reconciled regularly.
+	AnnotationReconciliationPaused = "gardener.cloud/reconciliation-paused"
 	// GardenerOperationMigrate is a constant for the value of the operation annotation describing a migration
 	// operation.
 	GardenerOperationMigrate = "migrate"
@@ -277,12 +287,16 @@ const (
 	GardenRoleCAKubelet = "ca-kubelet"
 	// GardenRoleCAClient is the value of the GardenRole key indicating type 'ca-client'.
 	GardenRoleCAClient = "ca-client"
+	// GardenRoleCASSH is the value of the GardenRole key indicating type 'ca-ssh'.
+	GardenRoleCASSH = "ca-ssh"
 	// GardenRoleSSHKeyPair is the value of the GardenRole key indicating type 'ssh-keypair'.
 	GardenRoleSSHKeyPair = "ssh-keypair"
 	// GardenRoleDefaultDomain is the value of the GardenRole key indicating type 'default-domain'.
 	GardenRoleDefaultDomain = "default-domain"
 	// GardenRoleInternalDomain is the value of the GardenRole key indicating type 'internal-domain'.
 	GardenRoleInternalDomain = "internal-domain"
+	// GardenRoleCustomDomain is the value of the GardenRole key indicating type 'custom-domain'.
+	GardenRoleCustomDomain = "custom-domain"
 	// GardenRoleGlobalMonitoring is the value of the GardenRole key indicating type 'global-monitoring'
 	GardenRoleGlobalMonitoring = "global-monitoring"
 	// GardenRoleGlobalShootRemoteWriteMonitoring is the value of the GardenRole key indicating type 'global-shoot-remote-write-monitoring'
@@ -339,6 +353,12 @@ const (
 	// Note that this annotation is alpha and can be removed anytime without further notice. Only use it if you know
 	// what you do.
 	ShootAlphaControlPlaneHAVPN = "alpha.control-plane.shoot.gardener.cloud/high-availability-vpn"
+	// ShootAlphaControlPlaneKubeAPIServerBlueGreenRollout is a constant for an annotation on the Shoot resource to
+	// roll out minor version updates of the kube-apiserver blue-green style: The new-version replicas are brought up
+	// alongside the old ones and only start receiving traffic after their health was verified.
+	// Note that this annotation is alpha and can be removed anytime without further notice. Only use it if you know
+	// what you do.
+	ShootAlphaControlPlaneKubeAPIServerBlueGreenRollout = "alpha.control-plane.shoot.gardener.cloud/kube-apiserver-blue-green-rollout"
 	// ShootAlphaControlPlaneVPNVPAUpdateDisabled is a constant for an annotation on the Shoot resource to enforce
 	// disabling the vertical pod autoscaler update resources related to the VPN connection.
 	ShootAlphaControlPlaneVPNVPAUpdateDisabled = "alpha.control-plane.shoot.gardener.cloud/vpn-vpa-update-disabled"
@@ -346,10 +366,30 @@ const (
 	// is expired. The lifetime can be extended, but at most by the minimal value of the 'clusterLifetimeDays' property
 	// of referenced quotas.
 	ShootExpirationTimestamp = "shoot.gardener.cloud/expiration-timestamp"
+	// ShootExpirationWarningLevel is an annotation on a Shoot resource whose value represents the lead time before the
+	// expiration of the Shoot lifetime for which a warning event has already been emitted. It is maintained by the
+	// shoot quota controller to avoid emitting the same warning repeatedly.
+	ShootExpirationWarningLevel = "shoot.gardener.cloud/expiration-warning-level"
+	// ShootLifetimeExtensionRequested is an annotation on a Shoot resource whose value represents a requested extension
+	// of the Shoot lifetime as duration (e.g. '168h'). The extension is applied by the shoot quota controller once the
+	// request has been confirmed via the 'confirmation.gardener.cloud/lifetime-extension' annotation.
+	ShootLifetimeExtensionRequested = "shoot.gardener.cloud/lifetime-extension-requested"
+	// ConfirmationLifetimeExtension is an annotation on a Shoot resource whose value must be set to "true" in order to
+	// approve a lifetime extension requested via the 'shoot.gardener.cloud/lifetime-extension-requested' annotation.
+	ConfirmationLifetimeExtension = "confirmation.gardener.cloud/lifetime-extension"
 	// ShootStatus is a constant for a label on a Shoot resource indicating that the Shoot's health.
 	ShootStatus = "shoot.gardener.cloud/status"
 	// FailedShootNeedsRetryOperation is a constant for an annotation on a Shoot in a failed state indicating that a retry operation should be triggered during the next maintenance time window.
 	FailedShootNeedsRetryOperation = "maintenance.shoot.gardener.cloud/needs-retry-operation"
+	// ShootSkipControlPlaneRestart is a constant for an annotation on a Shoot which allows opting out of the restarts
+	// of the shoot control plane pods during the maintenance window (see the shoot maintenance controller's
+	// `enableShootControlPlaneRestarter` configuration).
+	ShootSkipControlPlaneRestart = "maintenance.shoot.gardener.cloud/skip-control-plane-restart"
+	// ShootBreakGlassUntil is a constant for an annotation on a Shoot whose value is an RFC3339 timestamp until which
+	// the "break glass" maintenance mode is active. While it is active, gardenlet pauses the drift reversion of the
+	// gardener-resource-manager and the remediating care operations for the shoot's control plane so that operators can
+	// perform emergency manual interventions without racing controllers.
+	ShootBreakGlassUntil = "maintenance.shoot.gardener.cloud/break-glass-until"
 	// LabelExcludeWebhookFromRemediation is a constant for a label on a webhook in the shoot which makes it being
 	// excluded from automatic remediation.
 	LabelExcludeWebhookFromRemediation = "remediation.webhook.shoot.gardener.cloud/exclude"
@@ -365,6 +405,9 @@ const (
 	// ShootTaskDeployDNSRecordExternal is a name for a Shoot's external DNS record deployment task. It indicates that
 	// the external DNSRecord extension resources shall be reconciled.
 	ShootTaskDeployDNSRecordExternal = "deployDNSRecordExternal"
+	// ShootTaskDeployDNSRecordManagement is a name for a Shoot's management DNS record deployment task. It indicates
+	// that the management DNSRecord extension resources shall be reconciled.
+	ShootTaskDeployDNSRecordManagement = "deployDNSRecordManagement"
 	// ShootTaskDeployDNSRecordIngress is a name for a Shoot's ingress DNS record deployment task. It indicates that
 	// the ingress DNSRecord extension resources shall be reconciled.
 	ShootTaskDeployDNSRecordIngress = "deployDNSRecordIngress"
@@ -381,6 +424,18 @@ const (
 	// ShootOperationForceInPlaceUpdate is a constant for the value of the operation annotation that must be set
 	// to forcibly trigger an in-place update when a previous update is still in progress.
 	ShootOperationForceInPlaceUpdate = "force-in-place-update"
+	// ShootOperationReconcileInfrastructure is a constant for an annotation on a Shoot indicating that only the
+	// infrastructure stage of the reconciliation flow shall be re-run.
+	ShootOperationReconcileInfrastructure = "reconcile-infrastructure"
+	// ShootOperationReconcileDNSRecords is a constant for an annotation on a Shoot indicating that only the DNS records
+	// of the Shoot shall be reconciled.
+	ShootOperationReconcileDNSRecords = "reconcile-dns-records"
+	// ShootOperationReconcileControlPlane is a constant for an annotation on a Shoot indicating that only the control
+	// plane stage of the reconciliation flow shall be re-run.
+	ShootOperationReconcileControlPlane = "reconcile-control-plane"
+	// ShootOperationReconcileSystemComponents is a constant for an annotation on a Shoot indicating that only the
+	// system components of the Shoot shall be reconciled.
+	ShootOperationReconcileSystemComponents = "reconcile-system-components"
 	// OperationRotateCredentialsStart is a constant for an annotation indicating that the rotation of all credentials
 	// shall be started. This includes CAs, certificates, kubeconfigs, SSH keypairs, observability credentials, and
 	// ServiceAccount signing key.
@@ -395,6 +450,9 @@ const (
 	// ShootOperationRotateSSHKeypair is a constant for an annotation on a Shoot indicating that the SSH keypair for the
 	// shoot nodes shall be rotated.
 	ShootOperationRotateSSHKeypair = "rotate-ssh-keypair"
+	// ShootOperationRotateCloudCredentials is a constant for an annotation on a Shoot indicating that rotated cloud
+	// provider credentials shall be rolled out to all components consuming them.
+	ShootOperationRotateCloudCredentials = "rotate-cloud-credentials" // #nosec G101 -- No credential.
 	// OperationRotateCAStart is a constant for an annotation indicating that the rotation of the certificate
 	// authorities shall be started.
 	OperationRotateCAStart = "rotate-ca-start"
@@ -702,6 +760,10 @@ const (
 	// AnnotationConfirmationForceDeletion is a constant for an annotation on a Shoot resource whose value must be set to "true" in order to
 	// trigger force-deletion of the cluster. It can only be set if the Shoot has a deletion timestamp and contains an ErrorCode in the Shoot Status.
 	AnnotationConfirmationForceDeletion = "confirmation.gardener.cloud/force-deletion"
+	// AnnotationShootDisableMonitoring is the key for an annotation of a Shoot cluster whose value indicates that the
+	// monitoring stack shall not be deployed for the cluster. It is managed by the ShootPurposePolicy admission plugin
+	// based on the policy bundle of the shoot's custom purpose.
+	AnnotationShootDisableMonitoring = "shoot.gardener.cloud/disable-monitoring"
 	// AnnotationShootIgnoreAlerts is the key for an annotation of a Shoot cluster whose value indicates
 	// if alerts for this cluster should be ignored
 	AnnotationShootIgnoreAlerts = "shoot.gardener.cloud/ignore-alerts"
@@ -860,6 +922,9 @@ const (
 	// skipped by the stale project controller. If the project has already configured stale timestamps in its status
 	// then they will be reset.
 	ProjectSkipStaleCheck = "project.gardener.cloud/skip-stale-check"
+	// ProjectNamespaceOrphanedSince is the key of an annotation on a project namespace whose value holds the timestamp
+	// since when no Project references the namespace anymore. It is maintained by the orphaned namespace controller.
+	ProjectNamespaceOrphanedSince = "project.gardener.cloud/orphaned-since"
 	// NamespaceProject is the key of an annotation on namespace whose value holds the project uid.
 	NamespaceProject = "namespace.gardener.cloud/project"
 	// NamespaceKeepAfterProjectDeletion is a constant for an annotation on a `Namespace` resource that states that it
@@ -908,6 +973,8 @@ const (
 	DNSRecordInternalName = "internal"
 	// DNSRecordExternalName is a constant for DNSRecord objects used for the external domain name.
 	DNSRecordExternalName = "external"
+	// DNSRecordManagementName is a constant for DNSRecord objects used for the management domain name.
+	DNSRecordManagementName = "management"
 
 	// ArchitectureName is a constant for the 'architecture' cloud profile capability name.
 	ArchitectureName = "architecture"
@@ -934,6 +1001,9 @@ const (
 	AdvertisedAddressExternal = "external"
 	// AdvertisedAddressInternal is a constant that represents the name of the internal kube-apiserver address.
 	AdvertisedAddressInternal = "internal"
+	// AdvertisedAddressManagement is a constant that represents the name of the management kube-apiserver address.
+	// It is only resolvable from the internal network of the shoot owner.
+	AdvertisedAddressManagement = "management"
 	// AdvertisedAddressUnmanaged is a constant that represents the name of the unmanaged kube-apiserver address.
 	AdvertisedAddressUnmanaged = "unmanaged"
 	// AdvertisedAddressServiceAccountIssuer is a constant that represents the name of the address that is used as a
@@ -1069,6 +1139,13 @@ const (
 	// to temporarily pause further shoot reconciliations.
 	AnnotationEmergencyStopShootReconciliations = "shoot.gardener.cloud/emergency-stop-reconciliations"
 
+	// AnnotationPrefixSeedHelmValuesOverride is the prefix for an annotation key on a Seed whose suffix is the name of
+	// a ControllerRegistration and whose value is a JSON object with Helm values, e.g.
+	// helm-values-override.controllerinstallation.seed.gardener.cloud/provider-local={"resources":{"limits":{"memory":"256Mi"}}}.
+	// gardenlet merges these values over the values of the referenced ControllerDeployment when rendering the
+	// corresponding ControllerInstallation.
+	AnnotationPrefixSeedHelmValuesOverride = "helm-values-override.controllerinstallation.seed.gardener.cloud/"
+
 	// ConfigMapNameGardenerInfo is the name of the gardener-info ConfigMap.
 	ConfigMapNameGardenerInfo = "gardener-info"
 	// GardenerInfoConfigMapDataKeyGardenerAPIServer is the data key in the gardener-info ConfigMap that contains
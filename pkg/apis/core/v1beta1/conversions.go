@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/conversion"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -255,6 +256,12 @@ func Convert_v1beta1_ControllerDeployment_To_core_ControllerDeployment(in *Contr
 			if err := json.Unmarshal(in.ProviderConfig.Raw, helmDeployment); err != nil {
 				return err
 			}
+
+			// Preserve fields of the providerConfig that this API version does not know (e.g. because they were set
+			// by a newer client), so that they survive the round-trip through the internal version.
+			if err := preserveUnknownHelmProviderConfigFields(in.ProviderConfig.Raw, &out.ObjectMeta); err != nil {
+				return err
+			}
 		}
 
 		out.Helm = &core.HelmControllerDeployment{}
@@ -293,11 +300,72 @@ func Convert_core_ControllerDeployment_To_v1beta1_ControllerDeployment(in *core.
 		if err != nil {
 			return err
 		}
+
+		// Merge back previously preserved providerConfig fields that this API version does not know.
+		out.ProviderConfig.Raw, err = restoreUnknownHelmProviderConfigFields(out.ProviderConfig.Raw, &out.ObjectMeta)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// preservedFieldsKeyHelmProviderConfig is the key under which unknown fields of a helm-type ControllerDeployment
+// providerConfig are recorded in the preserved fields annotation.
+const preservedFieldsKeyHelmProviderConfig = "helmProviderConfig"
+
+// preserveUnknownHelmProviderConfigFields records all fields of the given raw helm providerConfig that are not part
+// of HelmControllerDeployment in the preserved fields annotation of the given object meta.
+func preserveUnknownHelmProviderConfigFields(raw []byte, objectMeta *metav1.ObjectMeta) error {
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return err
+	}
+
+	for _, knownField := range []string{"chart", "values", "ociRepository"} {
+		delete(fields, knownField)
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	unknown, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+
+	return setPreservedFields(objectMeta, preservedFieldsKeyHelmProviderConfig, unknown)
+}
+
+// restoreUnknownHelmProviderConfigFields merges fields previously recorded by
+// preserveUnknownHelmProviderConfigFields back into the given raw helm providerConfig and removes them from the
+// preserved fields annotation of the given object meta. Known fields take precedence over preserved ones.
+func restoreUnknownHelmProviderConfigFields(raw []byte, objectMeta *metav1.ObjectMeta) ([]byte, error) {
+	unknown, err := extractPreservedFields(objectMeta, preservedFieldsKeyHelmProviderConfig)
+	if err != nil {
+		return nil, err
+	}
+	if unknown == nil {
+		return raw, nil
+	}
+
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(unknown, &fields); err != nil {
+		return nil, err
+	}
+
+	knownFields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(raw, &knownFields); err != nil {
+		return nil, err
+	}
+	for k, v := range knownFields {
+		fields[k] = v
+	}
+
+	return json.Marshal(fields)
+}
+
 func Convert_v1beta1_HelmControllerDeployment_To_core_HelmControllerDeployment(in *HelmControllerDeployment, out *core.HelmControllerDeployment, s conversion.Scope) error {
 	if err := autoConvert_v1beta1_HelmControllerDeployment_To_core_HelmControllerDeployment(in, out, s); err != nil {
 		return err
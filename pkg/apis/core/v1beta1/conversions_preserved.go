@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// preservedFieldsAnnotation is the key of an annotation that carries fields which cannot be represented in the
+// converted API version through a conversion, so that they safely round-trip between API versions. Its value is a
+// JSON object mapping a conversion-specific key to the preserved fields.
+const preservedFieldsAnnotation = "core.gardener.cloud/preserved-fields"
+
+// setPreservedFields records the given value under the given key in the preserved fields annotation. The annotations
+// map is copied before it is modified since it may still be shared with the conversion source object.
+func setPreservedFields(objectMeta *metav1.ObjectMeta, key string, value json.RawMessage) error {
+	preserved := map[string]json.RawMessage{}
+	if existing, ok := objectMeta.Annotations[preservedFieldsAnnotation]; ok {
+		if err := json.Unmarshal([]byte(existing), &preserved); err != nil {
+			return err
+		}
+	}
+	preserved[key] = value
+
+	raw, err := json.Marshal(preserved)
+	if err != nil {
+		return err
+	}
+
+	annotations := make(map[string]string, len(objectMeta.Annotations)+1)
+	for k, v := range objectMeta.Annotations {
+		annotations[k] = v
+	}
+	annotations[preservedFieldsAnnotation] = string(raw)
+	objectMeta.Annotations = annotations
+
+	return nil
+}
+
+// extractPreservedFields returns the value recorded under the given key in the preserved fields annotation, if any,
+// and removes it from the annotation. The annotations map is copied before it is modified since it may still be
+// shared with the conversion source object.
+func extractPreservedFields(objectMeta *metav1.ObjectMeta, key string) (json.RawMessage, error) {
+	existing, ok := objectMeta.Annotations[preservedFieldsAnnotation]
+	if !ok {
+		return nil, nil
+	}
+
+	preserved := map[string]json.RawMessage{}
+	if err := json.Unmarshal([]byte(existing), &preserved); err != nil {
+		return nil, err
+	}
+
+	value, ok := preserved[key]
+	if !ok {
+		return nil, nil
+	}
+	delete(preserved, key)
+
+	annotations := make(map[string]string, len(objectMeta.Annotations))
+	for k, v := range objectMeta.Annotations {
+		annotations[k] = v
+	}
+
+	if len(preserved) == 0 {
+		delete(annotations, preservedFieldsAnnotation)
+	} else {
+		raw, err := json.Marshal(preserved)
+		if err != nil {
+			return nil, err
+		}
+		annotations[preservedFieldsAnnotation] = string(raw)
+	}
+
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	objectMeta.Annotations = annotations
+
+	return value, nil
+}
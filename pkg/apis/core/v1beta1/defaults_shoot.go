@@ -394,6 +394,16 @@ func SetDefaults_NginxIngress(obj *NginxIngress) {
 	}
 }
 
+// SetDefaults_ResourceBackupAddon sets default values for ResourceBackupAddon objects.
+func SetDefaults_ResourceBackupAddon(obj *ResourceBackupAddon) {
+	if obj.Schedule == nil {
+		obj.Schedule = ptr.To("0 */6 * * *")
+	}
+	if obj.RetentionPeriod == nil {
+		obj.RetentionPeriod = &metav1.Duration{Duration: 7 * 24 * time.Hour}
+	}
+}
+
 // Helper functions
 
 func calculateDefaultNodeCIDRMaskSize(shoot *ShootSpec) *int32 {
@@ -548,6 +548,30 @@ var _ = Describe("Shoot defaulting", func() {
 			Expect(obj.Spec.Addons.NginxIngress.ExternalTrafficPolicy).To(PointTo(Equal(corev1.ServiceExternalTrafficPolicyLocal)))
 		})
 
+		It("should default the resourceBackup field for shoot with workers", func() {
+			obj.Spec.Addons = &Addons{}
+			obj.Spec.Addons.ResourceBackup = &ResourceBackupAddon{}
+
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.Addons.ResourceBackup).NotTo(BeNil())
+			Expect(obj.Spec.Addons.ResourceBackup.Schedule).To(PointTo(Equal("0 */6 * * *")))
+			Expect(obj.Spec.Addons.ResourceBackup.RetentionPeriod).To(PointTo(Equal(metav1.Duration{Duration: 7 * 24 * time.Hour})))
+		})
+
+		It("should not overwrite the already set values for resourceBackup field", func() {
+			obj.Spec.Addons = &Addons{}
+			obj.Spec.Addons.ResourceBackup = &ResourceBackupAddon{
+				Schedule:        ptr.To("0 0 * * *"),
+				RetentionPeriod: &metav1.Duration{Duration: time.Hour},
+			}
+
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.Addons.ResourceBackup.Schedule).To(PointTo(Equal("0 0 * * *")))
+			Expect(obj.Spec.Addons.ResourceBackup.RetentionPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+		})
+
 		It("should not default the addons field for workerless Shoot", func() {
 			obj.Spec.Provider.Workers = nil
 			obj.Spec.Addons = nil
@@ -323,10 +323,122 @@ func (m *AuditPolicy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AuditPolicy proto.InternalMessageInfo
 
+func (m *AuthenticationClaimMappings) Reset()      { *m = AuthenticationClaimMappings{} }
+func (*AuthenticationClaimMappings) ProtoMessage() {}
+func (*AuthenticationClaimMappings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{10}
+}
+func (m *AuthenticationClaimMappings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthenticationClaimMappings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AuthenticationClaimMappings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthenticationClaimMappings.Merge(m, src)
+}
+func (m *AuthenticationClaimMappings) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthenticationClaimMappings) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthenticationClaimMappings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthenticationClaimMappings proto.InternalMessageInfo
+
+func (m *AuthenticationClaimOrExpression) Reset()      { *m = AuthenticationClaimOrExpression{} }
+func (*AuthenticationClaimOrExpression) ProtoMessage() {}
+func (*AuthenticationClaimOrExpression) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{11}
+}
+func (m *AuthenticationClaimOrExpression) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthenticationClaimOrExpression) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AuthenticationClaimOrExpression) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthenticationClaimOrExpression.Merge(m, src)
+}
+func (m *AuthenticationClaimOrExpression) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthenticationClaimOrExpression) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthenticationClaimOrExpression.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthenticationClaimOrExpression proto.InternalMessageInfo
+
+func (m *AuthenticationClaimValidationRule) Reset()      { *m = AuthenticationClaimValidationRule{} }
+func (*AuthenticationClaimValidationRule) ProtoMessage() {}
+func (*AuthenticationClaimValidationRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{12}
+}
+func (m *AuthenticationClaimValidationRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthenticationClaimValidationRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AuthenticationClaimValidationRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthenticationClaimValidationRule.Merge(m, src)
+}
+func (m *AuthenticationClaimValidationRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthenticationClaimValidationRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthenticationClaimValidationRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthenticationClaimValidationRule proto.InternalMessageInfo
+
+func (m *AuthenticationIssuer) Reset()      { *m = AuthenticationIssuer{} }
+func (*AuthenticationIssuer) ProtoMessage() {}
+func (*AuthenticationIssuer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{13}
+}
+func (m *AuthenticationIssuer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthenticationIssuer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AuthenticationIssuer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthenticationIssuer.Merge(m, src)
+}
+func (m *AuthenticationIssuer) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthenticationIssuer) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthenticationIssuer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthenticationIssuer proto.InternalMessageInfo
+
 func (m *AuthorizerKubeconfigReference) Reset()      { *m = AuthorizerKubeconfigReference{} }
 func (*AuthorizerKubeconfigReference) ProtoMessage() {}
 func (*AuthorizerKubeconfigReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{10}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{14}
 }
 func (m *AuthorizerKubeconfigReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -354,7 +466,7 @@ var xxx_messageInfo_AuthorizerKubeconfigReference proto.InternalMessageInfo
 func (m *AvailabilityZone) Reset()      { *m = AvailabilityZone{} }
 func (*AvailabilityZone) ProtoMessage() {}
 func (*AvailabilityZone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{11}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{15}
 }
 func (m *AvailabilityZone) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -382,7 +494,7 @@ var xxx_messageInfo_AvailabilityZone proto.InternalMessageInfo
 func (m *Backup) Reset()      { *m = Backup{} }
 func (*Backup) ProtoMessage() {}
 func (*Backup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{12}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{16}
 }
 func (m *Backup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -410,7 +522,7 @@ var xxx_messageInfo_Backup proto.InternalMessageInfo
 func (m *BackupBucket) Reset()      { *m = BackupBucket{} }
 func (*BackupBucket) ProtoMessage() {}
 func (*BackupBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{13}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{17}
 }
 func (m *BackupBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -438,7 +550,7 @@ var xxx_messageInfo_BackupBucket proto.InternalMessageInfo
 func (m *BackupBucketList) Reset()      { *m = BackupBucketList{} }
 func (*BackupBucketList) ProtoMessage() {}
 func (*BackupBucketList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{14}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{18}
 }
 func (m *BackupBucketList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -466,7 +578,7 @@ var xxx_messageInfo_BackupBucketList proto.InternalMessageInfo
 func (m *BackupBucketProvider) Reset()      { *m = BackupBucketProvider{} }
 func (*BackupBucketProvider) ProtoMessage() {}
 func (*BackupBucketProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{15}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{19}
 }
 func (m *BackupBucketProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -494,7 +606,7 @@ var xxx_messageInfo_BackupBucketProvider proto.InternalMessageInfo
 func (m *BackupBucketSpec) Reset()      { *m = BackupBucketSpec{} }
 func (*BackupBucketSpec) ProtoMessage() {}
 func (*BackupBucketSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{16}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{20}
 }
 func (m *BackupBucketSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -522,7 +634,7 @@ var xxx_messageInfo_BackupBucketSpec proto.InternalMessageInfo
 func (m *BackupBucketStatus) Reset()      { *m = BackupBucketStatus{} }
 func (*BackupBucketStatus) ProtoMessage() {}
 func (*BackupBucketStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{17}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{21}
 }
 func (m *BackupBucketStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -550,7 +662,7 @@ var xxx_messageInfo_BackupBucketStatus proto.InternalMessageInfo
 func (m *BackupEntry) Reset()      { *m = BackupEntry{} }
 func (*BackupEntry) ProtoMessage() {}
 func (*BackupEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{18}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{22}
 }
 func (m *BackupEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -578,7 +690,7 @@ var xxx_messageInfo_BackupEntry proto.InternalMessageInfo
 func (m *BackupEntryList) Reset()      { *m = BackupEntryList{} }
 func (*BackupEntryList) ProtoMessage() {}
 func (*BackupEntryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{19}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{23}
 }
 func (m *BackupEntryList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -606,7 +718,7 @@ var xxx_messageInfo_BackupEntryList proto.InternalMessageInfo
 func (m *BackupEntrySpec) Reset()      { *m = BackupEntrySpec{} }
 func (*BackupEntrySpec) ProtoMessage() {}
 func (*BackupEntrySpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{20}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{24}
 }
 func (m *BackupEntrySpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -634,7 +746,7 @@ var xxx_messageInfo_BackupEntrySpec proto.InternalMessageInfo
 func (m *BackupEntryStatus) Reset()      { *m = BackupEntryStatus{} }
 func (*BackupEntryStatus) ProtoMessage() {}
 func (*BackupEntryStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{21}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{25}
 }
 func (m *BackupEntryStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -662,7 +774,7 @@ var xxx_messageInfo_BackupEntryStatus proto.InternalMessageInfo
 func (m *Bastion) Reset()      { *m = Bastion{} }
 func (*Bastion) ProtoMessage() {}
 func (*Bastion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{22}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{26}
 }
 func (m *Bastion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -690,7 +802,7 @@ var xxx_messageInfo_Bastion proto.InternalMessageInfo
 func (m *BastionMachineImage) Reset()      { *m = BastionMachineImage{} }
 func (*BastionMachineImage) ProtoMessage() {}
 func (*BastionMachineImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{23}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{27}
 }
 func (m *BastionMachineImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -718,7 +830,7 @@ var xxx_messageInfo_BastionMachineImage proto.InternalMessageInfo
 func (m *BastionMachineType) Reset()      { *m = BastionMachineType{} }
 func (*BastionMachineType) ProtoMessage() {}
 func (*BastionMachineType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{24}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{28}
 }
 func (m *BastionMachineType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -746,7 +858,7 @@ var xxx_messageInfo_BastionMachineType proto.InternalMessageInfo
 func (m *CARotation) Reset()      { *m = CARotation{} }
 func (*CARotation) ProtoMessage() {}
 func (*CARotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{25}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{29}
 }
 func (m *CARotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -774,7 +886,7 @@ var xxx_messageInfo_CARotation proto.InternalMessageInfo
 func (m *CRI) Reset()      { *m = CRI{} }
 func (*CRI) ProtoMessage() {}
 func (*CRI) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{26}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{30}
 }
 func (m *CRI) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -802,7 +914,7 @@ var xxx_messageInfo_CRI proto.InternalMessageInfo
 func (m *CapabilityDefinition) Reset()      { *m = CapabilityDefinition{} }
 func (*CapabilityDefinition) ProtoMessage() {}
 func (*CapabilityDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{27}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{31}
 }
 func (m *CapabilityDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -830,7 +942,7 @@ var xxx_messageInfo_CapabilityDefinition proto.InternalMessageInfo
 func (m *CapabilityValues) Reset()      { *m = CapabilityValues{} }
 func (*CapabilityValues) ProtoMessage() {}
 func (*CapabilityValues) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{28}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{32}
 }
 func (m *CapabilityValues) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -855,10 +967,38 @@ func (m *CapabilityValues) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CapabilityValues proto.InternalMessageInfo
 
+func (m *CloudCredentialsRotation) Reset()      { *m = CloudCredentialsRotation{} }
+func (*CloudCredentialsRotation) ProtoMessage() {}
+func (*CloudCredentialsRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{33}
+}
+func (m *CloudCredentialsRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CloudCredentialsRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CloudCredentialsRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloudCredentialsRotation.Merge(m, src)
+}
+func (m *CloudCredentialsRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *CloudCredentialsRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloudCredentialsRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloudCredentialsRotation proto.InternalMessageInfo
+
 func (m *CloudProfile) Reset()      { *m = CloudProfile{} }
 func (*CloudProfile) ProtoMessage() {}
 func (*CloudProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{29}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{34}
 }
 func (m *CloudProfile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -886,7 +1026,7 @@ var xxx_messageInfo_CloudProfile proto.InternalMessageInfo
 func (m *CloudProfileList) Reset()      { *m = CloudProfileList{} }
 func (*CloudProfileList) ProtoMessage() {}
 func (*CloudProfileList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{30}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{35}
 }
 func (m *CloudProfileList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -914,7 +1054,7 @@ var xxx_messageInfo_CloudProfileList proto.InternalMessageInfo
 func (m *CloudProfileReference) Reset()      { *m = CloudProfileReference{} }
 func (*CloudProfileReference) ProtoMessage() {}
 func (*CloudProfileReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{31}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{36}
 }
 func (m *CloudProfileReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -942,7 +1082,7 @@ var xxx_messageInfo_CloudProfileReference proto.InternalMessageInfo
 func (m *CloudProfileSpec) Reset()      { *m = CloudProfileSpec{} }
 func (*CloudProfileSpec) ProtoMessage() {}
 func (*CloudProfileSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{32}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{37}
 }
 func (m *CloudProfileSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -970,7 +1110,7 @@ var xxx_messageInfo_CloudProfileSpec proto.InternalMessageInfo
 func (m *ClusterAutoscaler) Reset()      { *m = ClusterAutoscaler{} }
 func (*ClusterAutoscaler) ProtoMessage() {}
 func (*ClusterAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{33}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{38}
 }
 func (m *ClusterAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -998,7 +1138,7 @@ var xxx_messageInfo_ClusterAutoscaler proto.InternalMessageInfo
 func (m *ClusterAutoscalerOptions) Reset()      { *m = ClusterAutoscalerOptions{} }
 func (*ClusterAutoscalerOptions) ProtoMessage() {}
 func (*ClusterAutoscalerOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{34}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{39}
 }
 func (m *ClusterAutoscalerOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1026,7 +1166,7 @@ var xxx_messageInfo_ClusterAutoscalerOptions proto.InternalMessageInfo
 func (m *Condition) Reset()      { *m = Condition{} }
 func (*Condition) ProtoMessage() {}
 func (*Condition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{35}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{40}
 }
 func (m *Condition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1054,7 +1194,7 @@ var xxx_messageInfo_Condition proto.InternalMessageInfo
 func (m *ContainerRuntime) Reset()      { *m = ContainerRuntime{} }
 func (*ContainerRuntime) ProtoMessage() {}
 func (*ContainerRuntime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{36}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{41}
 }
 func (m *ContainerRuntime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1079,10 +1219,66 @@ func (m *ContainerRuntime) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ContainerRuntime proto.InternalMessageInfo
 
+func (m *ContainerdConfig) Reset()      { *m = ContainerdConfig{} }
+func (*ContainerdConfig) ProtoMessage() {}
+func (*ContainerdConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{42}
+}
+func (m *ContainerdConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContainerdConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ContainerdConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerdConfig.Merge(m, src)
+}
+func (m *ContainerdConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContainerdConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerdConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerdConfig proto.InternalMessageInfo
+
+func (m *ContainerdRegistry) Reset()      { *m = ContainerdRegistry{} }
+func (*ContainerdRegistry) ProtoMessage() {}
+func (*ContainerdRegistry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{43}
+}
+func (m *ContainerdRegistry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContainerdRegistry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ContainerdRegistry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerdRegistry.Merge(m, src)
+}
+func (m *ContainerdRegistry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContainerdRegistry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerdRegistry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerdRegistry proto.InternalMessageInfo
+
 func (m *ControlPlane) Reset()      { *m = ControlPlane{} }
 func (*ControlPlane) ProtoMessage() {}
 func (*ControlPlane) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{37}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{44}
 }
 func (m *ControlPlane) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1110,7 +1306,7 @@ var xxx_messageInfo_ControlPlane proto.InternalMessageInfo
 func (m *ControlPlaneAutoscaling) Reset()      { *m = ControlPlaneAutoscaling{} }
 func (*ControlPlaneAutoscaling) ProtoMessage() {}
 func (*ControlPlaneAutoscaling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{38}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{45}
 }
 func (m *ControlPlaneAutoscaling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1135,10 +1331,38 @@ func (m *ControlPlaneAutoscaling) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ControlPlaneAutoscaling proto.InternalMessageInfo
 
+func (m *ControlPlaneResourceConsumption) Reset()      { *m = ControlPlaneResourceConsumption{} }
+func (*ControlPlaneResourceConsumption) ProtoMessage() {}
+func (*ControlPlaneResourceConsumption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{46}
+}
+func (m *ControlPlaneResourceConsumption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ControlPlaneResourceConsumption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ControlPlaneResourceConsumption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ControlPlaneResourceConsumption.Merge(m, src)
+}
+func (m *ControlPlaneResourceConsumption) XXX_Size() int {
+	return m.Size()
+}
+func (m *ControlPlaneResourceConsumption) XXX_DiscardUnknown() {
+	xxx_messageInfo_ControlPlaneResourceConsumption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ControlPlaneResourceConsumption proto.InternalMessageInfo
+
 func (m *ControllerDeployment) Reset()      { *m = ControllerDeployment{} }
 func (*ControllerDeployment) ProtoMessage() {}
 func (*ControllerDeployment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{39}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{47}
 }
 func (m *ControllerDeployment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1166,7 +1390,7 @@ var xxx_messageInfo_ControllerDeployment proto.InternalMessageInfo
 func (m *ControllerDeploymentList) Reset()      { *m = ControllerDeploymentList{} }
 func (*ControllerDeploymentList) ProtoMessage() {}
 func (*ControllerDeploymentList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{40}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{48}
 }
 func (m *ControllerDeploymentList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1194,7 +1418,7 @@ var xxx_messageInfo_ControllerDeploymentList proto.InternalMessageInfo
 func (m *ControllerInstallation) Reset()      { *m = ControllerInstallation{} }
 func (*ControllerInstallation) ProtoMessage() {}
 func (*ControllerInstallation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{41}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{49}
 }
 func (m *ControllerInstallation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1222,7 +1446,7 @@ var xxx_messageInfo_ControllerInstallation proto.InternalMessageInfo
 func (m *ControllerInstallationList) Reset()      { *m = ControllerInstallationList{} }
 func (*ControllerInstallationList) ProtoMessage() {}
 func (*ControllerInstallationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{42}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{50}
 }
 func (m *ControllerInstallationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1250,7 +1474,7 @@ var xxx_messageInfo_ControllerInstallationList proto.InternalMessageInfo
 func (m *ControllerInstallationSpec) Reset()      { *m = ControllerInstallationSpec{} }
 func (*ControllerInstallationSpec) ProtoMessage() {}
 func (*ControllerInstallationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{43}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{51}
 }
 func (m *ControllerInstallationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1278,7 +1502,7 @@ var xxx_messageInfo_ControllerInstallationSpec proto.InternalMessageInfo
 func (m *ControllerInstallationStatus) Reset()      { *m = ControllerInstallationStatus{} }
 func (*ControllerInstallationStatus) ProtoMessage() {}
 func (*ControllerInstallationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{44}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{52}
 }
 func (m *ControllerInstallationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1306,7 +1530,7 @@ var xxx_messageInfo_ControllerInstallationStatus proto.InternalMessageInfo
 func (m *ControllerRegistration) Reset()      { *m = ControllerRegistration{} }
 func (*ControllerRegistration) ProtoMessage() {}
 func (*ControllerRegistration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{45}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{53}
 }
 func (m *ControllerRegistration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1334,7 +1558,7 @@ var xxx_messageInfo_ControllerRegistration proto.InternalMessageInfo
 func (m *ControllerRegistrationDeployment) Reset()      { *m = ControllerRegistrationDeployment{} }
 func (*ControllerRegistrationDeployment) ProtoMessage() {}
 func (*ControllerRegistrationDeployment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{46}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{54}
 }
 func (m *ControllerRegistrationDeployment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1362,7 +1586,7 @@ var xxx_messageInfo_ControllerRegistrationDeployment proto.InternalMessageInfo
 func (m *ControllerRegistrationList) Reset()      { *m = ControllerRegistrationList{} }
 func (*ControllerRegistrationList) ProtoMessage() {}
 func (*ControllerRegistrationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{47}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{55}
 }
 func (m *ControllerRegistrationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1390,7 +1614,7 @@ var xxx_messageInfo_ControllerRegistrationList proto.InternalMessageInfo
 func (m *ControllerRegistrationSpec) Reset()      { *m = ControllerRegistrationSpec{} }
 func (*ControllerRegistrationSpec) ProtoMessage() {}
 func (*ControllerRegistrationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{48}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{56}
 }
 func (m *ControllerRegistrationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1418,7 +1642,7 @@ var xxx_messageInfo_ControllerRegistrationSpec proto.InternalMessageInfo
 func (m *ControllerResource) Reset()      { *m = ControllerResource{} }
 func (*ControllerResource) ProtoMessage() {}
 func (*ControllerResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{49}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{57}
 }
 func (m *ControllerResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1443,10 +1667,38 @@ func (m *ControllerResource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ControllerResource proto.InternalMessageInfo
 
+func (m *ControllerResourceAddon) Reset()      { *m = ControllerResourceAddon{} }
+func (*ControllerResourceAddon) ProtoMessage() {}
+func (*ControllerResourceAddon) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{58}
+}
+func (m *ControllerResourceAddon) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ControllerResourceAddon) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ControllerResourceAddon) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ControllerResourceAddon.Merge(m, src)
+}
+func (m *ControllerResourceAddon) XXX_Size() int {
+	return m.Size()
+}
+func (m *ControllerResourceAddon) XXX_DiscardUnknown() {
+	xxx_messageInfo_ControllerResourceAddon.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ControllerResourceAddon proto.InternalMessageInfo
+
 func (m *ControllerResourceLifecycle) Reset()      { *m = ControllerResourceLifecycle{} }
 func (*ControllerResourceLifecycle) ProtoMessage() {}
 func (*ControllerResourceLifecycle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{50}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{59}
 }
 func (m *ControllerResourceLifecycle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1474,7 +1726,7 @@ var xxx_messageInfo_ControllerResourceLifecycle proto.InternalMessageInfo
 func (m *CoreDNS) Reset()      { *m = CoreDNS{} }
 func (*CoreDNS) ProtoMessage() {}
 func (*CoreDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{51}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{60}
 }
 func (m *CoreDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1502,7 +1754,7 @@ var xxx_messageInfo_CoreDNS proto.InternalMessageInfo
 func (m *CoreDNSAutoscaling) Reset()      { *m = CoreDNSAutoscaling{} }
 func (*CoreDNSAutoscaling) ProtoMessage() {}
 func (*CoreDNSAutoscaling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{52}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{61}
 }
 func (m *CoreDNSAutoscaling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1530,7 +1782,7 @@ var xxx_messageInfo_CoreDNSAutoscaling proto.InternalMessageInfo
 func (m *CoreDNSRewriting) Reset()      { *m = CoreDNSRewriting{} }
 func (*CoreDNSRewriting) ProtoMessage() {}
 func (*CoreDNSRewriting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{53}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{62}
 }
 func (m *CoreDNSRewriting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1558,7 +1810,7 @@ var xxx_messageInfo_CoreDNSRewriting proto.InternalMessageInfo
 func (m *DNS) Reset()      { *m = DNS{} }
 func (*DNS) ProtoMessage() {}
 func (*DNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{54}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{63}
 }
 func (m *DNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1586,7 +1838,7 @@ var xxx_messageInfo_DNS proto.InternalMessageInfo
 func (m *DNSIncludeExclude) Reset()      { *m = DNSIncludeExclude{} }
 func (*DNSIncludeExclude) ProtoMessage() {}
 func (*DNSIncludeExclude) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{55}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{64}
 }
 func (m *DNSIncludeExclude) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1614,7 +1866,7 @@ var xxx_messageInfo_DNSIncludeExclude proto.InternalMessageInfo
 func (m *DNSProvider) Reset()      { *m = DNSProvider{} }
 func (*DNSProvider) ProtoMessage() {}
 func (*DNSProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{56}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{65}
 }
 func (m *DNSProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1642,7 +1894,7 @@ var xxx_messageInfo_DNSProvider proto.InternalMessageInfo
 func (m *DataVolume) Reset()      { *m = DataVolume{} }
 func (*DataVolume) ProtoMessage() {}
 func (*DataVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{57}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{66}
 }
 func (m *DataVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1670,7 +1922,7 @@ var xxx_messageInfo_DataVolume proto.InternalMessageInfo
 func (m *DeploymentRef) Reset()      { *m = DeploymentRef{} }
 func (*DeploymentRef) ProtoMessage() {}
 func (*DeploymentRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{58}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{67}
 }
 func (m *DeploymentRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1698,7 +1950,7 @@ var xxx_messageInfo_DeploymentRef proto.InternalMessageInfo
 func (m *DualApprovalForDeletion) Reset()      { *m = DualApprovalForDeletion{} }
 func (*DualApprovalForDeletion) ProtoMessage() {}
 func (*DualApprovalForDeletion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{59}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{68}
 }
 func (m *DualApprovalForDeletion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1726,7 +1978,7 @@ var xxx_messageInfo_DualApprovalForDeletion proto.InternalMessageInfo
 func (m *ETCD) Reset()      { *m = ETCD{} }
 func (*ETCD) ProtoMessage() {}
 func (*ETCD) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{60}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{69}
 }
 func (m *ETCD) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1754,7 +2006,7 @@ var xxx_messageInfo_ETCD proto.InternalMessageInfo
 func (m *ETCDConfig) Reset()      { *m = ETCDConfig{} }
 func (*ETCDConfig) ProtoMessage() {}
 func (*ETCDConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{61}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{70}
 }
 func (m *ETCDConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1782,7 +2034,7 @@ var xxx_messageInfo_ETCDConfig proto.InternalMessageInfo
 func (m *ETCDEncryptionKeyRotation) Reset()      { *m = ETCDEncryptionKeyRotation{} }
 func (*ETCDEncryptionKeyRotation) ProtoMessage() {}
 func (*ETCDEncryptionKeyRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{62}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{71}
 }
 func (m *ETCDEncryptionKeyRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1810,7 +2062,7 @@ var xxx_messageInfo_ETCDEncryptionKeyRotation proto.InternalMessageInfo
 func (m *EncryptionAtRest) Reset()      { *m = EncryptionAtRest{} }
 func (*EncryptionAtRest) ProtoMessage() {}
 func (*EncryptionAtRest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{63}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{72}
 }
 func (m *EncryptionAtRest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1835,10 +2087,38 @@ func (m *EncryptionAtRest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EncryptionAtRest proto.InternalMessageInfo
 
+func (m *EncryptionAtRestMigrationProgress) Reset()      { *m = EncryptionAtRestMigrationProgress{} }
+func (*EncryptionAtRestMigrationProgress) ProtoMessage() {}
+func (*EncryptionAtRestMigrationProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{73}
+}
+func (m *EncryptionAtRestMigrationProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncryptionAtRestMigrationProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EncryptionAtRestMigrationProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptionAtRestMigrationProgress.Merge(m, src)
+}
+func (m *EncryptionAtRestMigrationProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncryptionAtRestMigrationProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptionAtRestMigrationProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncryptionAtRestMigrationProgress proto.InternalMessageInfo
+
 func (m *EncryptionConfig) Reset()      { *m = EncryptionConfig{} }
 func (*EncryptionConfig) ProtoMessage() {}
 func (*EncryptionConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{64}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{74}
 }
 func (m *EncryptionConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1866,7 +2146,7 @@ var xxx_messageInfo_EncryptionConfig proto.InternalMessageInfo
 func (m *ExpirableVersion) Reset()      { *m = ExpirableVersion{} }
 func (*ExpirableVersion) ProtoMessage() {}
 func (*ExpirableVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{65}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{75}
 }
 func (m *ExpirableVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1894,7 +2174,7 @@ var xxx_messageInfo_ExpirableVersion proto.InternalMessageInfo
 func (m *ExposureClass) Reset()      { *m = ExposureClass{} }
 func (*ExposureClass) ProtoMessage() {}
 func (*ExposureClass) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{66}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{76}
 }
 func (m *ExposureClass) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1922,7 +2202,7 @@ var xxx_messageInfo_ExposureClass proto.InternalMessageInfo
 func (m *ExposureClassList) Reset()      { *m = ExposureClassList{} }
 func (*ExposureClassList) ProtoMessage() {}
 func (*ExposureClassList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{67}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{77}
 }
 func (m *ExposureClassList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1950,7 +2230,7 @@ var xxx_messageInfo_ExposureClassList proto.InternalMessageInfo
 func (m *ExposureClassScheduling) Reset()      { *m = ExposureClassScheduling{} }
 func (*ExposureClassScheduling) ProtoMessage() {}
 func (*ExposureClassScheduling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{68}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{78}
 }
 func (m *ExposureClassScheduling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1978,7 +2258,7 @@ var xxx_messageInfo_ExposureClassScheduling proto.InternalMessageInfo
 func (m *Extension) Reset()      { *m = Extension{} }
 func (*Extension) ProtoMessage() {}
 func (*Extension) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{69}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{79}
 }
 func (m *Extension) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2006,7 +2286,7 @@ var xxx_messageInfo_Extension proto.InternalMessageInfo
 func (m *ExtensionResourceState) Reset()      { *m = ExtensionResourceState{} }
 func (*ExtensionResourceState) ProtoMessage() {}
 func (*ExtensionResourceState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{70}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{80}
 }
 func (m *ExtensionResourceState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2034,7 +2314,7 @@ var xxx_messageInfo_ExtensionResourceState proto.InternalMessageInfo
 func (m *FailureTolerance) Reset()      { *m = FailureTolerance{} }
 func (*FailureTolerance) ProtoMessage() {}
 func (*FailureTolerance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{71}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{81}
 }
 func (m *FailureTolerance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2062,7 +2342,7 @@ var xxx_messageInfo_FailureTolerance proto.InternalMessageInfo
 func (m *Gardener) Reset()      { *m = Gardener{} }
 func (*Gardener) ProtoMessage() {}
 func (*Gardener) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{72}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{82}
 }
 func (m *Gardener) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2090,7 +2370,7 @@ var xxx_messageInfo_Gardener proto.InternalMessageInfo
 func (m *GardenerResourceData) Reset()      { *m = GardenerResourceData{} }
 func (*GardenerResourceData) ProtoMessage() {}
 func (*GardenerResourceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{73}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{83}
 }
 func (m *GardenerResourceData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2118,7 +2398,7 @@ var xxx_messageInfo_GardenerResourceData proto.InternalMessageInfo
 func (m *HelmControllerDeployment) Reset()      { *m = HelmControllerDeployment{} }
 func (*HelmControllerDeployment) ProtoMessage() {}
 func (*HelmControllerDeployment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{74}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{84}
 }
 func (m *HelmControllerDeployment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2146,7 +2426,7 @@ var xxx_messageInfo_HelmControllerDeployment proto.InternalMessageInfo
 func (m *Hibernation) Reset()      { *m = Hibernation{} }
 func (*Hibernation) ProtoMessage() {}
 func (*Hibernation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{75}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{85}
 }
 func (m *Hibernation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2174,7 +2454,7 @@ var xxx_messageInfo_Hibernation proto.InternalMessageInfo
 func (m *HibernationSchedule) Reset()      { *m = HibernationSchedule{} }
 func (*HibernationSchedule) ProtoMessage() {}
 func (*HibernationSchedule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{76}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{86}
 }
 func (m *HibernationSchedule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2202,7 +2482,7 @@ var xxx_messageInfo_HibernationSchedule proto.InternalMessageInfo
 func (m *HighAvailability) Reset()      { *m = HighAvailability{} }
 func (*HighAvailability) ProtoMessage() {}
 func (*HighAvailability) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{77}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{87}
 }
 func (m *HighAvailability) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2230,7 +2510,7 @@ var xxx_messageInfo_HighAvailability proto.InternalMessageInfo
 func (m *HorizontalPodAutoscalerConfig) Reset()      { *m = HorizontalPodAutoscalerConfig{} }
 func (*HorizontalPodAutoscalerConfig) ProtoMessage() {}
 func (*HorizontalPodAutoscalerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{78}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{88}
 }
 func (m *HorizontalPodAutoscalerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2258,7 +2538,7 @@ var xxx_messageInfo_HorizontalPodAutoscalerConfig proto.InternalMessageInfo
 func (m *InPlaceUpdates) Reset()      { *m = InPlaceUpdates{} }
 func (*InPlaceUpdates) ProtoMessage() {}
 func (*InPlaceUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{79}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{89}
 }
 func (m *InPlaceUpdates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2286,7 +2566,7 @@ var xxx_messageInfo_InPlaceUpdates proto.InternalMessageInfo
 func (m *InPlaceUpdatesStatus) Reset()      { *m = InPlaceUpdatesStatus{} }
 func (*InPlaceUpdatesStatus) ProtoMessage() {}
 func (*InPlaceUpdatesStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{80}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{90}
 }
 func (m *InPlaceUpdatesStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2314,7 +2594,7 @@ var xxx_messageInfo_InPlaceUpdatesStatus proto.InternalMessageInfo
 func (m *Ingress) Reset()      { *m = Ingress{} }
 func (*Ingress) ProtoMessage() {}
 func (*Ingress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{81}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{91}
 }
 func (m *Ingress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2342,7 +2622,7 @@ var xxx_messageInfo_Ingress proto.InternalMessageInfo
 func (m *IngressController) Reset()      { *m = IngressController{} }
 func (*IngressController) ProtoMessage() {}
 func (*IngressController) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{82}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{92}
 }
 func (m *IngressController) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2370,7 +2650,7 @@ var xxx_messageInfo_IngressController proto.InternalMessageInfo
 func (m *InternalSecret) Reset()      { *m = InternalSecret{} }
 func (*InternalSecret) ProtoMessage() {}
 func (*InternalSecret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{83}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{93}
 }
 func (m *InternalSecret) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2398,7 +2678,7 @@ var xxx_messageInfo_InternalSecret proto.InternalMessageInfo
 func (m *InternalSecretList) Reset()      { *m = InternalSecretList{} }
 func (*InternalSecretList) ProtoMessage() {}
 func (*InternalSecretList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{84}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{94}
 }
 func (m *InternalSecretList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2423,10 +2703,66 @@ func (m *InternalSecretList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_InternalSecretList proto.InternalMessageInfo
 
+func (m *JWTAuthenticator) Reset()      { *m = JWTAuthenticator{} }
+func (*JWTAuthenticator) ProtoMessage() {}
+func (*JWTAuthenticator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{95}
+}
+func (m *JWTAuthenticator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JWTAuthenticator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *JWTAuthenticator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JWTAuthenticator.Merge(m, src)
+}
+func (m *JWTAuthenticator) XXX_Size() int {
+	return m.Size()
+}
+func (m *JWTAuthenticator) XXX_DiscardUnknown() {
+	xxx_messageInfo_JWTAuthenticator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JWTAuthenticator proto.InternalMessageInfo
+
+func (m *KMSEncryptionConfiguration) Reset()      { *m = KMSEncryptionConfiguration{} }
+func (*KMSEncryptionConfiguration) ProtoMessage() {}
+func (*KMSEncryptionConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{96}
+}
+func (m *KMSEncryptionConfiguration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KMSEncryptionConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KMSEncryptionConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KMSEncryptionConfiguration.Merge(m, src)
+}
+func (m *KMSEncryptionConfiguration) XXX_Size() int {
+	return m.Size()
+}
+func (m *KMSEncryptionConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_KMSEncryptionConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KMSEncryptionConfiguration proto.InternalMessageInfo
+
 func (m *KubeAPIServerConfig) Reset()      { *m = KubeAPIServerConfig{} }
 func (*KubeAPIServerConfig) ProtoMessage() {}
 func (*KubeAPIServerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{85}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{97}
 }
 func (m *KubeAPIServerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2451,10 +2787,66 @@ func (m *KubeAPIServerConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_KubeAPIServerConfig proto.InternalMessageInfo
 
+func (m *KubeAPIServerRequestBasedAutoscaling) Reset()      { *m = KubeAPIServerRequestBasedAutoscaling{} }
+func (*KubeAPIServerRequestBasedAutoscaling) ProtoMessage() {}
+func (*KubeAPIServerRequestBasedAutoscaling) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{98}
+}
+func (m *KubeAPIServerRequestBasedAutoscaling) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KubeAPIServerRequestBasedAutoscaling) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KubeAPIServerRequestBasedAutoscaling) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KubeAPIServerRequestBasedAutoscaling.Merge(m, src)
+}
+func (m *KubeAPIServerRequestBasedAutoscaling) XXX_Size() int {
+	return m.Size()
+}
+func (m *KubeAPIServerRequestBasedAutoscaling) XXX_DiscardUnknown() {
+	xxx_messageInfo_KubeAPIServerRequestBasedAutoscaling.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KubeAPIServerRequestBasedAutoscaling proto.InternalMessageInfo
+
+func (m *KubeControllerManagerConcurrentSyncs) Reset()      { *m = KubeControllerManagerConcurrentSyncs{} }
+func (*KubeControllerManagerConcurrentSyncs) ProtoMessage() {}
+func (*KubeControllerManagerConcurrentSyncs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{99}
+}
+func (m *KubeControllerManagerConcurrentSyncs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KubeControllerManagerConcurrentSyncs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KubeControllerManagerConcurrentSyncs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KubeControllerManagerConcurrentSyncs.Merge(m, src)
+}
+func (m *KubeControllerManagerConcurrentSyncs) XXX_Size() int {
+	return m.Size()
+}
+func (m *KubeControllerManagerConcurrentSyncs) XXX_DiscardUnknown() {
+	xxx_messageInfo_KubeControllerManagerConcurrentSyncs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KubeControllerManagerConcurrentSyncs proto.InternalMessageInfo
+
 func (m *KubeControllerManagerConfig) Reset()      { *m = KubeControllerManagerConfig{} }
 func (*KubeControllerManagerConfig) ProtoMessage() {}
 func (*KubeControllerManagerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{86}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{100}
 }
 func (m *KubeControllerManagerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2482,7 +2874,7 @@ var xxx_messageInfo_KubeControllerManagerConfig proto.InternalMessageInfo
 func (m *KubeProxyConfig) Reset()      { *m = KubeProxyConfig{} }
 func (*KubeProxyConfig) ProtoMessage() {}
 func (*KubeProxyConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{87}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{101}
 }
 func (m *KubeProxyConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2510,7 +2902,7 @@ var xxx_messageInfo_KubeProxyConfig proto.InternalMessageInfo
 func (m *KubeSchedulerConfig) Reset()      { *m = KubeSchedulerConfig{} }
 func (*KubeSchedulerConfig) ProtoMessage() {}
 func (*KubeSchedulerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{88}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{102}
 }
 func (m *KubeSchedulerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2538,7 +2930,7 @@ var xxx_messageInfo_KubeSchedulerConfig proto.InternalMessageInfo
 func (m *KubeletConfig) Reset()      { *m = KubeletConfig{} }
 func (*KubeletConfig) ProtoMessage() {}
 func (*KubeletConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{89}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{103}
 }
 func (m *KubeletConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2566,7 +2958,7 @@ var xxx_messageInfo_KubeletConfig proto.InternalMessageInfo
 func (m *KubeletConfigEviction) Reset()      { *m = KubeletConfigEviction{} }
 func (*KubeletConfigEviction) ProtoMessage() {}
 func (*KubeletConfigEviction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{90}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{104}
 }
 func (m *KubeletConfigEviction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2594,7 +2986,7 @@ var xxx_messageInfo_KubeletConfigEviction proto.InternalMessageInfo
 func (m *KubeletConfigEvictionMinimumReclaim) Reset()      { *m = KubeletConfigEvictionMinimumReclaim{} }
 func (*KubeletConfigEvictionMinimumReclaim) ProtoMessage() {}
 func (*KubeletConfigEvictionMinimumReclaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{91}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{105}
 }
 func (m *KubeletConfigEvictionMinimumReclaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2622,7 +3014,7 @@ var xxx_messageInfo_KubeletConfigEvictionMinimumReclaim proto.InternalMessageInf
 func (m *KubeletConfigEvictionSoftGracePeriod) Reset()      { *m = KubeletConfigEvictionSoftGracePeriod{} }
 func (*KubeletConfigEvictionSoftGracePeriod) ProtoMessage() {}
 func (*KubeletConfigEvictionSoftGracePeriod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{92}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{106}
 }
 func (m *KubeletConfigEvictionSoftGracePeriod) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2650,7 +3042,7 @@ var xxx_messageInfo_KubeletConfigEvictionSoftGracePeriod proto.InternalMessageIn
 func (m *KubeletConfigReserved) Reset()      { *m = KubeletConfigReserved{} }
 func (*KubeletConfigReserved) ProtoMessage() {}
 func (*KubeletConfigReserved) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{93}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{107}
 }
 func (m *KubeletConfigReserved) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2678,7 +3070,7 @@ var xxx_messageInfo_KubeletConfigReserved proto.InternalMessageInfo
 func (m *Kubernetes) Reset()      { *m = Kubernetes{} }
 func (*Kubernetes) ProtoMessage() {}
 func (*Kubernetes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{94}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{108}
 }
 func (m *Kubernetes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2706,7 +3098,7 @@ var xxx_messageInfo_Kubernetes proto.InternalMessageInfo
 func (m *KubernetesConfig) Reset()      { *m = KubernetesConfig{} }
 func (*KubernetesConfig) ProtoMessage() {}
 func (*KubernetesConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{95}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{109}
 }
 func (m *KubernetesConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2734,7 +3126,7 @@ var xxx_messageInfo_KubernetesConfig proto.InternalMessageInfo
 func (m *KubernetesDashboard) Reset()      { *m = KubernetesDashboard{} }
 func (*KubernetesDashboard) ProtoMessage() {}
 func (*KubernetesDashboard) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{96}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{110}
 }
 func (m *KubernetesDashboard) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2762,7 +3154,7 @@ var xxx_messageInfo_KubernetesDashboard proto.InternalMessageInfo
 func (m *KubernetesSettings) Reset()      { *m = KubernetesSettings{} }
 func (*KubernetesSettings) ProtoMessage() {}
 func (*KubernetesSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{97}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{111}
 }
 func (m *KubernetesSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2790,7 +3182,7 @@ var xxx_messageInfo_KubernetesSettings proto.InternalMessageInfo
 func (m *LastError) Reset()      { *m = LastError{} }
 func (*LastError) ProtoMessage() {}
 func (*LastError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{98}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{112}
 }
 func (m *LastError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2818,7 +3210,7 @@ var xxx_messageInfo_LastError proto.InternalMessageInfo
 func (m *LastMaintenance) Reset()      { *m = LastMaintenance{} }
 func (*LastMaintenance) ProtoMessage() {}
 func (*LastMaintenance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{99}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{113}
 }
 func (m *LastMaintenance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2846,7 +3238,7 @@ var xxx_messageInfo_LastMaintenance proto.InternalMessageInfo
 func (m *LastOperation) Reset()      { *m = LastOperation{} }
 func (*LastOperation) ProtoMessage() {}
 func (*LastOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{100}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{114}
 }
 func (m *LastOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2874,7 +3266,7 @@ var xxx_messageInfo_LastOperation proto.InternalMessageInfo
 func (m *Limits) Reset()      { *m = Limits{} }
 func (*Limits) ProtoMessage() {}
 func (*Limits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{101}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{115}
 }
 func (m *Limits) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2902,7 +3294,7 @@ var xxx_messageInfo_Limits proto.InternalMessageInfo
 func (m *LoadBalancerServicesProxyProtocol) Reset()      { *m = LoadBalancerServicesProxyProtocol{} }
 func (*LoadBalancerServicesProxyProtocol) ProtoMessage() {}
 func (*LoadBalancerServicesProxyProtocol) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{102}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{116}
 }
 func (m *LoadBalancerServicesProxyProtocol) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2930,7 +3322,7 @@ var xxx_messageInfo_LoadBalancerServicesProxyProtocol proto.InternalMessageInfo
 func (m *Machine) Reset()      { *m = Machine{} }
 func (*Machine) ProtoMessage() {}
 func (*Machine) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{103}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{117}
 }
 func (m *Machine) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2958,7 +3350,7 @@ var xxx_messageInfo_Machine proto.InternalMessageInfo
 func (m *MachineControllerManagerSettings) Reset()      { *m = MachineControllerManagerSettings{} }
 func (*MachineControllerManagerSettings) ProtoMessage() {}
 func (*MachineControllerManagerSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{104}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{118}
 }
 func (m *MachineControllerManagerSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2986,7 +3378,7 @@ var xxx_messageInfo_MachineControllerManagerSettings proto.InternalMessageInfo
 func (m *MachineImage) Reset()      { *m = MachineImage{} }
 func (*MachineImage) ProtoMessage() {}
 func (*MachineImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{105}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{119}
 }
 func (m *MachineImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3014,7 +3406,7 @@ var xxx_messageInfo_MachineImage proto.InternalMessageInfo
 func (m *MachineImageFlavor) Reset()      { *m = MachineImageFlavor{} }
 func (*MachineImageFlavor) ProtoMessage() {}
 func (*MachineImageFlavor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{106}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{120}
 }
 func (m *MachineImageFlavor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3039,10 +3431,38 @@ func (m *MachineImageFlavor) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MachineImageFlavor proto.InternalMessageInfo
 
+func (m *MachineImageUpgradePath) Reset()      { *m = MachineImageUpgradePath{} }
+func (*MachineImageUpgradePath) ProtoMessage() {}
+func (*MachineImageUpgradePath) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{121}
+}
+func (m *MachineImageUpgradePath) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MachineImageUpgradePath) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MachineImageUpgradePath) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MachineImageUpgradePath.Merge(m, src)
+}
+func (m *MachineImageUpgradePath) XXX_Size() int {
+	return m.Size()
+}
+func (m *MachineImageUpgradePath) XXX_DiscardUnknown() {
+	xxx_messageInfo_MachineImageUpgradePath.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MachineImageUpgradePath proto.InternalMessageInfo
+
 func (m *MachineImageVersion) Reset()      { *m = MachineImageVersion{} }
 func (*MachineImageVersion) ProtoMessage() {}
 func (*MachineImageVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{107}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{122}
 }
 func (m *MachineImageVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3070,7 +3490,7 @@ var xxx_messageInfo_MachineImageVersion proto.InternalMessageInfo
 func (m *MachineType) Reset()      { *m = MachineType{} }
 func (*MachineType) ProtoMessage() {}
 func (*MachineType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{108}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{123}
 }
 func (m *MachineType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3098,7 +3518,7 @@ var xxx_messageInfo_MachineType proto.InternalMessageInfo
 func (m *MachineTypeStorage) Reset()      { *m = MachineTypeStorage{} }
 func (*MachineTypeStorage) ProtoMessage() {}
 func (*MachineTypeStorage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{109}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{124}
 }
 func (m *MachineTypeStorage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3126,7 +3546,7 @@ var xxx_messageInfo_MachineTypeStorage proto.InternalMessageInfo
 func (m *Maintenance) Reset()      { *m = Maintenance{} }
 func (*Maintenance) ProtoMessage() {}
 func (*Maintenance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{110}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{125}
 }
 func (m *Maintenance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3154,7 +3574,7 @@ var xxx_messageInfo_Maintenance proto.InternalMessageInfo
 func (m *MaintenanceAutoUpdate) Reset()      { *m = MaintenanceAutoUpdate{} }
 func (*MaintenanceAutoUpdate) ProtoMessage() {}
 func (*MaintenanceAutoUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{111}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{126}
 }
 func (m *MaintenanceAutoUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3182,7 +3602,7 @@ var xxx_messageInfo_MaintenanceAutoUpdate proto.InternalMessageInfo
 func (m *MaintenanceTimeWindow) Reset()      { *m = MaintenanceTimeWindow{} }
 func (*MaintenanceTimeWindow) ProtoMessage() {}
 func (*MaintenanceTimeWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{112}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{127}
 }
 func (m *MaintenanceTimeWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3207,10 +3627,38 @@ func (m *MaintenanceTimeWindow) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MaintenanceTimeWindow proto.InternalMessageInfo
 
+func (m *ManagedAddon) Reset()      { *m = ManagedAddon{} }
+func (*ManagedAddon) ProtoMessage() {}
+func (*ManagedAddon) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{128}
+}
+func (m *ManagedAddon) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManagedAddon) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ManagedAddon) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManagedAddon.Merge(m, src)
+}
+func (m *ManagedAddon) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManagedAddon) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManagedAddon.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManagedAddon proto.InternalMessageInfo
+
 func (m *ManualWorkerPoolRollout) Reset()      { *m = ManualWorkerPoolRollout{} }
 func (*ManualWorkerPoolRollout) ProtoMessage() {}
 func (*ManualWorkerPoolRollout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{113}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{129}
 }
 func (m *ManualWorkerPoolRollout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3238,7 +3686,7 @@ var xxx_messageInfo_ManualWorkerPoolRollout proto.InternalMessageInfo
 func (m *MemorySwapConfiguration) Reset()      { *m = MemorySwapConfiguration{} }
 func (*MemorySwapConfiguration) ProtoMessage() {}
 func (*MemorySwapConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{114}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{130}
 }
 func (m *MemorySwapConfiguration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3266,7 +3714,7 @@ var xxx_messageInfo_MemorySwapConfiguration proto.InternalMessageInfo
 func (m *Monitoring) Reset()      { *m = Monitoring{} }
 func (*Monitoring) ProtoMessage() {}
 func (*Monitoring) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{115}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{131}
 }
 func (m *Monitoring) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3294,7 +3742,7 @@ var xxx_messageInfo_Monitoring proto.InternalMessageInfo
 func (m *NamedResourceReference) Reset()      { *m = NamedResourceReference{} }
 func (*NamedResourceReference) ProtoMessage() {}
 func (*NamedResourceReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{116}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{132}
 }
 func (m *NamedResourceReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3322,7 +3770,7 @@ var xxx_messageInfo_NamedResourceReference proto.InternalMessageInfo
 func (m *NamespacedCloudProfile) Reset()      { *m = NamespacedCloudProfile{} }
 func (*NamespacedCloudProfile) ProtoMessage() {}
 func (*NamespacedCloudProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{117}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{133}
 }
 func (m *NamespacedCloudProfile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3350,7 +3798,7 @@ var xxx_messageInfo_NamespacedCloudProfile proto.InternalMessageInfo
 func (m *NamespacedCloudProfileList) Reset()      { *m = NamespacedCloudProfileList{} }
 func (*NamespacedCloudProfileList) ProtoMessage() {}
 func (*NamespacedCloudProfileList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{118}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{134}
 }
 func (m *NamespacedCloudProfileList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3378,7 +3826,7 @@ var xxx_messageInfo_NamespacedCloudProfileList proto.InternalMessageInfo
 func (m *NamespacedCloudProfileSpec) Reset()      { *m = NamespacedCloudProfileSpec{} }
 func (*NamespacedCloudProfileSpec) ProtoMessage() {}
 func (*NamespacedCloudProfileSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{119}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{135}
 }
 func (m *NamespacedCloudProfileSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3406,7 +3854,7 @@ var xxx_messageInfo_NamespacedCloudProfileSpec proto.InternalMessageInfo
 func (m *NamespacedCloudProfileStatus) Reset()      { *m = NamespacedCloudProfileStatus{} }
 func (*NamespacedCloudProfileStatus) ProtoMessage() {}
 func (*NamespacedCloudProfileStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{120}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{136}
 }
 func (m *NamespacedCloudProfileStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3434,7 +3882,7 @@ var xxx_messageInfo_NamespacedCloudProfileStatus proto.InternalMessageInfo
 func (m *Networking) Reset()      { *m = Networking{} }
 func (*Networking) ProtoMessage() {}
 func (*Networking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{121}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{137}
 }
 func (m *Networking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3462,7 +3910,7 @@ var xxx_messageInfo_Networking proto.InternalMessageInfo
 func (m *NetworkingStatus) Reset()      { *m = NetworkingStatus{} }
 func (*NetworkingStatus) ProtoMessage() {}
 func (*NetworkingStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{122}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{138}
 }
 func (m *NetworkingStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3490,7 +3938,7 @@ var xxx_messageInfo_NetworkingStatus proto.InternalMessageInfo
 func (m *NginxIngress) Reset()      { *m = NginxIngress{} }
 func (*NginxIngress) ProtoMessage() {}
 func (*NginxIngress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{123}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{139}
 }
 func (m *NginxIngress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3518,7 +3966,7 @@ var xxx_messageInfo_NginxIngress proto.InternalMessageInfo
 func (m *NodeLocalDNS) Reset()      { *m = NodeLocalDNS{} }
 func (*NodeLocalDNS) ProtoMessage() {}
 func (*NodeLocalDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{124}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{140}
 }
 func (m *NodeLocalDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3546,7 +3994,7 @@ var xxx_messageInfo_NodeLocalDNS proto.InternalMessageInfo
 func (m *OCIRepository) Reset()      { *m = OCIRepository{} }
 func (*OCIRepository) ProtoMessage() {}
 func (*OCIRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{125}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{141}
 }
 func (m *OCIRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3574,7 +4022,7 @@ var xxx_messageInfo_OCIRepository proto.InternalMessageInfo
 func (m *OIDCConfig) Reset()      { *m = OIDCConfig{} }
 func (*OIDCConfig) ProtoMessage() {}
 func (*OIDCConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{126}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{142}
 }
 func (m *OIDCConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3602,7 +4050,7 @@ var xxx_messageInfo_OIDCConfig proto.InternalMessageInfo
 func (m *ObservabilityRotation) Reset()      { *m = ObservabilityRotation{} }
 func (*ObservabilityRotation) ProtoMessage() {}
 func (*ObservabilityRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{127}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{143}
 }
 func (m *ObservabilityRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3630,7 +4078,7 @@ var xxx_messageInfo_ObservabilityRotation proto.InternalMessageInfo
 func (m *OpenIDConnectClientAuthentication) Reset()      { *m = OpenIDConnectClientAuthentication{} }
 func (*OpenIDConnectClientAuthentication) ProtoMessage() {}
 func (*OpenIDConnectClientAuthentication) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{128}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{144}
 }
 func (m *OpenIDConnectClientAuthentication) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3658,7 +4106,7 @@ var xxx_messageInfo_OpenIDConnectClientAuthentication proto.InternalMessageInfo
 func (m *PendingWorkerUpdates) Reset()      { *m = PendingWorkerUpdates{} }
 func (*PendingWorkerUpdates) ProtoMessage() {}
 func (*PendingWorkerUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{129}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{145}
 }
 func (m *PendingWorkerUpdates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3686,7 +4134,7 @@ var xxx_messageInfo_PendingWorkerUpdates proto.InternalMessageInfo
 func (m *PendingWorkersRollout) Reset()      { *m = PendingWorkersRollout{} }
 func (*PendingWorkersRollout) ProtoMessage() {}
 func (*PendingWorkersRollout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{130}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{146}
 }
 func (m *PendingWorkersRollout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3714,7 +4162,7 @@ var xxx_messageInfo_PendingWorkersRollout proto.InternalMessageInfo
 func (m *Project) Reset()      { *m = Project{} }
 func (*Project) ProtoMessage() {}
 func (*Project) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{131}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{147}
 }
 func (m *Project) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3742,7 +4190,7 @@ var xxx_messageInfo_Project proto.InternalMessageInfo
 func (m *ProjectList) Reset()      { *m = ProjectList{} }
 func (*ProjectList) ProtoMessage() {}
 func (*ProjectList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{132}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{148}
 }
 func (m *ProjectList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3770,7 +4218,7 @@ var xxx_messageInfo_ProjectList proto.InternalMessageInfo
 func (m *ProjectMember) Reset()      { *m = ProjectMember{} }
 func (*ProjectMember) ProtoMessage() {}
 func (*ProjectMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{133}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{149}
 }
 func (m *ProjectMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3798,7 +4246,7 @@ var xxx_messageInfo_ProjectMember proto.InternalMessageInfo
 func (m *ProjectSpec) Reset()      { *m = ProjectSpec{} }
 func (*ProjectSpec) ProtoMessage() {}
 func (*ProjectSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{134}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{150}
 }
 func (m *ProjectSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3826,7 +4274,7 @@ var xxx_messageInfo_ProjectSpec proto.InternalMessageInfo
 func (m *ProjectStatus) Reset()      { *m = ProjectStatus{} }
 func (*ProjectStatus) ProtoMessage() {}
 func (*ProjectStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{135}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{151}
 }
 func (m *ProjectStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3854,7 +4302,7 @@ var xxx_messageInfo_ProjectStatus proto.InternalMessageInfo
 func (m *ProjectTolerations) Reset()      { *m = ProjectTolerations{} }
 func (*ProjectTolerations) ProtoMessage() {}
 func (*ProjectTolerations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{136}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{152}
 }
 func (m *ProjectTolerations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3879,10 +4327,38 @@ func (m *ProjectTolerations) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ProjectTolerations proto.InternalMessageInfo
 
+func (m *ProjectUsageSummary) Reset()      { *m = ProjectUsageSummary{} }
+func (*ProjectUsageSummary) ProtoMessage() {}
+func (*ProjectUsageSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{153}
+}
+func (m *ProjectUsageSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectUsageSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectUsageSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectUsageSummary.Merge(m, src)
+}
+func (m *ProjectUsageSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectUsageSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectUsageSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectUsageSummary proto.InternalMessageInfo
+
 func (m *Provider) Reset()      { *m = Provider{} }
 func (*Provider) ProtoMessage() {}
 func (*Provider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{137}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{154}
 }
 func (m *Provider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3910,7 +4386,7 @@ var xxx_messageInfo_Provider proto.InternalMessageInfo
 func (m *Quota) Reset()      { *m = Quota{} }
 func (*Quota) ProtoMessage() {}
 func (*Quota) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{138}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{155}
 }
 func (m *Quota) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3938,7 +4414,7 @@ var xxx_messageInfo_Quota proto.InternalMessageInfo
 func (m *QuotaList) Reset()      { *m = QuotaList{} }
 func (*QuotaList) ProtoMessage() {}
 func (*QuotaList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{139}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{156}
 }
 func (m *QuotaList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3966,7 +4442,7 @@ var xxx_messageInfo_QuotaList proto.InternalMessageInfo
 func (m *QuotaSpec) Reset()      { *m = QuotaSpec{} }
 func (*QuotaSpec) ProtoMessage() {}
 func (*QuotaSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{140}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{157}
 }
 func (m *QuotaSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3994,7 +4470,7 @@ var xxx_messageInfo_QuotaSpec proto.InternalMessageInfo
 func (m *Region) Reset()      { *m = Region{} }
 func (*Region) ProtoMessage() {}
 func (*Region) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{141}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{158}
 }
 func (m *Region) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4019,10 +4495,66 @@ func (m *Region) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Region proto.InternalMessageInfo
 
+func (m *RegistryMirror) Reset()      { *m = RegistryMirror{} }
+func (*RegistryMirror) ProtoMessage() {}
+func (*RegistryMirror) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{159}
+}
+func (m *RegistryMirror) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegistryMirror) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RegistryMirror) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegistryMirror.Merge(m, src)
+}
+func (m *RegistryMirror) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegistryMirror) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegistryMirror.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegistryMirror proto.InternalMessageInfo
+
+func (m *ResourceBackupAddon) Reset()      { *m = ResourceBackupAddon{} }
+func (*ResourceBackupAddon) ProtoMessage() {}
+func (*ResourceBackupAddon) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{160}
+}
+func (m *ResourceBackupAddon) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceBackupAddon) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ResourceBackupAddon) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceBackupAddon.Merge(m, src)
+}
+func (m *ResourceBackupAddon) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceBackupAddon) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceBackupAddon.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceBackupAddon proto.InternalMessageInfo
+
 func (m *ResourceData) Reset()      { *m = ResourceData{} }
 func (*ResourceData) ProtoMessage() {}
 func (*ResourceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{142}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{161}
 }
 func (m *ResourceData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4050,7 +4582,7 @@ var xxx_messageInfo_ResourceData proto.InternalMessageInfo
 func (m *ResourceWatchCacheSize) Reset()      { *m = ResourceWatchCacheSize{} }
 func (*ResourceWatchCacheSize) ProtoMessage() {}
 func (*ResourceWatchCacheSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{143}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{162}
 }
 func (m *ResourceWatchCacheSize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4078,7 +4610,7 @@ var xxx_messageInfo_ResourceWatchCacheSize proto.InternalMessageInfo
 func (m *SSHAccess) Reset()      { *m = SSHAccess{} }
 func (*SSHAccess) ProtoMessage() {}
 func (*SSHAccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{144}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{163}
 }
 func (m *SSHAccess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4106,7 +4638,7 @@ var xxx_messageInfo_SSHAccess proto.InternalMessageInfo
 func (m *SecretBinding) Reset()      { *m = SecretBinding{} }
 func (*SecretBinding) ProtoMessage() {}
 func (*SecretBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{145}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{164}
 }
 func (m *SecretBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4134,7 +4666,7 @@ var xxx_messageInfo_SecretBinding proto.InternalMessageInfo
 func (m *SecretBindingList) Reset()      { *m = SecretBindingList{} }
 func (*SecretBindingList) ProtoMessage() {}
 func (*SecretBindingList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{146}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{165}
 }
 func (m *SecretBindingList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4162,7 +4694,7 @@ var xxx_messageInfo_SecretBindingList proto.InternalMessageInfo
 func (m *SecretBindingProvider) Reset()      { *m = SecretBindingProvider{} }
 func (*SecretBindingProvider) ProtoMessage() {}
 func (*SecretBindingProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{147}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{166}
 }
 func (m *SecretBindingProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4187,10 +4719,38 @@ func (m *SecretBindingProvider) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SecretBindingProvider proto.InternalMessageInfo
 
+func (m *SecretBindingStatus) Reset()      { *m = SecretBindingStatus{} }
+func (*SecretBindingStatus) ProtoMessage() {}
+func (*SecretBindingStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{167}
+}
+func (m *SecretBindingStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretBindingStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SecretBindingStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretBindingStatus.Merge(m, src)
+}
+func (m *SecretBindingStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretBindingStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretBindingStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecretBindingStatus proto.InternalMessageInfo
+
 func (m *Seed) Reset()      { *m = Seed{} }
 func (*Seed) ProtoMessage() {}
 func (*Seed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{148}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{168}
 }
 func (m *Seed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4215,10 +4775,66 @@ func (m *Seed) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Seed proto.InternalMessageInfo
 
+func (m *SeedAffinity) Reset()      { *m = SeedAffinity{} }
+func (*SeedAffinity) ProtoMessage() {}
+func (*SeedAffinity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{169}
+}
+func (m *SeedAffinity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SeedAffinity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SeedAffinity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SeedAffinity.Merge(m, src)
+}
+func (m *SeedAffinity) XXX_Size() int {
+	return m.Size()
+}
+func (m *SeedAffinity) XXX_DiscardUnknown() {
+	xxx_messageInfo_SeedAffinity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SeedAffinity proto.InternalMessageInfo
+
+func (m *SeedAvailability) Reset()      { *m = SeedAvailability{} }
+func (*SeedAvailability) ProtoMessage() {}
+func (*SeedAvailability) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{170}
+}
+func (m *SeedAvailability) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SeedAvailability) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SeedAvailability) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SeedAvailability.Merge(m, src)
+}
+func (m *SeedAvailability) XXX_Size() int {
+	return m.Size()
+}
+func (m *SeedAvailability) XXX_DiscardUnknown() {
+	xxx_messageInfo_SeedAvailability.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SeedAvailability proto.InternalMessageInfo
+
 func (m *SeedDNS) Reset()      { *m = SeedDNS{} }
 func (*SeedDNS) ProtoMessage() {}
 func (*SeedDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{149}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{171}
 }
 func (m *SeedDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4246,7 +4862,7 @@ var xxx_messageInfo_SeedDNS proto.InternalMessageInfo
 func (m *SeedDNSProvider) Reset()      { *m = SeedDNSProvider{} }
 func (*SeedDNSProvider) ProtoMessage() {}
 func (*SeedDNSProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{150}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{172}
 }
 func (m *SeedDNSProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4274,7 +4890,7 @@ var xxx_messageInfo_SeedDNSProvider proto.InternalMessageInfo
 func (m *SeedDNSProviderConfig) Reset()      { *m = SeedDNSProviderConfig{} }
 func (*SeedDNSProviderConfig) ProtoMessage() {}
 func (*SeedDNSProviderConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{151}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{173}
 }
 func (m *SeedDNSProviderConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4302,7 +4918,7 @@ var xxx_messageInfo_SeedDNSProviderConfig proto.InternalMessageInfo
 func (m *SeedList) Reset()      { *m = SeedList{} }
 func (*SeedList) ProtoMessage() {}
 func (*SeedList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{152}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{174}
 }
 func (m *SeedList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4330,7 +4946,7 @@ var xxx_messageInfo_SeedList proto.InternalMessageInfo
 func (m *SeedNetworks) Reset()      { *m = SeedNetworks{} }
 func (*SeedNetworks) ProtoMessage() {}
 func (*SeedNetworks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{153}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{175}
 }
 func (m *SeedNetworks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4358,7 +4974,7 @@ var xxx_messageInfo_SeedNetworks proto.InternalMessageInfo
 func (m *SeedProvider) Reset()      { *m = SeedProvider{} }
 func (*SeedProvider) ProtoMessage() {}
 func (*SeedProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{154}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{176}
 }
 func (m *SeedProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4386,7 +5002,7 @@ var xxx_messageInfo_SeedProvider proto.InternalMessageInfo
 func (m *SeedSelector) Reset()      { *m = SeedSelector{} }
 func (*SeedSelector) ProtoMessage() {}
 func (*SeedSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{155}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{177}
 }
 func (m *SeedSelector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4411,10 +5027,38 @@ func (m *SeedSelector) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SeedSelector proto.InternalMessageInfo
 
+func (m *SeedSettingDNSCache) Reset()      { *m = SeedSettingDNSCache{} }
+func (*SeedSettingDNSCache) ProtoMessage() {}
+func (*SeedSettingDNSCache) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{178}
+}
+func (m *SeedSettingDNSCache) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SeedSettingDNSCache) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SeedSettingDNSCache) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SeedSettingDNSCache.Merge(m, src)
+}
+func (m *SeedSettingDNSCache) XXX_Size() int {
+	return m.Size()
+}
+func (m *SeedSettingDNSCache) XXX_DiscardUnknown() {
+	xxx_messageInfo_SeedSettingDNSCache.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SeedSettingDNSCache proto.InternalMessageInfo
+
 func (m *SeedSettingDependencyWatchdog) Reset()      { *m = SeedSettingDependencyWatchdog{} }
 func (*SeedSettingDependencyWatchdog) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{156}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{179}
 }
 func (m *SeedSettingDependencyWatchdog) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4442,7 +5086,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdog proto.InternalMessageInfo
 func (m *SeedSettingDependencyWatchdogProber) Reset()      { *m = SeedSettingDependencyWatchdogProber{} }
 func (*SeedSettingDependencyWatchdogProber) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdogProber) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{157}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{180}
 }
 func (m *SeedSettingDependencyWatchdogProber) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4470,7 +5114,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdogProber proto.InternalMessageInf
 func (m *SeedSettingDependencyWatchdogWeeder) Reset()      { *m = SeedSettingDependencyWatchdogWeeder{} }
 func (*SeedSettingDependencyWatchdogWeeder) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdogWeeder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{158}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{181}
 }
 func (m *SeedSettingDependencyWatchdogWeeder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4498,7 +5142,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdogWeeder proto.InternalMessageInf
 func (m *SeedSettingExcessCapacityReservation) Reset()      { *m = SeedSettingExcessCapacityReservation{} }
 func (*SeedSettingExcessCapacityReservation) ProtoMessage() {}
 func (*SeedSettingExcessCapacityReservation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{159}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{182}
 }
 func (m *SeedSettingExcessCapacityReservation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4528,7 +5172,7 @@ func (m *SeedSettingExcessCapacityReservationConfig) Reset() {
 }
 func (*SeedSettingExcessCapacityReservationConfig) ProtoMessage() {}
 func (*SeedSettingExcessCapacityReservationConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{160}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{183}
 }
 func (m *SeedSettingExcessCapacityReservationConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4553,10 +5197,98 @@ func (m *SeedSettingExcessCapacityReservationConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SeedSettingExcessCapacityReservationConfig proto.InternalMessageInfo
 
+func (m *SeedSettingIstioIngressGateway) Reset()      { *m = SeedSettingIstioIngressGateway{} }
+func (*SeedSettingIstioIngressGateway) ProtoMessage() {}
+func (*SeedSettingIstioIngressGateway) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{184}
+}
+func (m *SeedSettingIstioIngressGateway) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SeedSettingIstioIngressGateway) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SeedSettingIstioIngressGateway) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SeedSettingIstioIngressGateway.Merge(m, src)
+}
+func (m *SeedSettingIstioIngressGateway) XXX_Size() int {
+	return m.Size()
+}
+func (m *SeedSettingIstioIngressGateway) XXX_DiscardUnknown() {
+	xxx_messageInfo_SeedSettingIstioIngressGateway.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SeedSettingIstioIngressGateway proto.InternalMessageInfo
+
+func (m *SeedSettingIstioIngressGatewayAutoscaling) Reset() {
+	*m = SeedSettingIstioIngressGatewayAutoscaling{}
+}
+func (*SeedSettingIstioIngressGatewayAutoscaling) ProtoMessage() {}
+func (*SeedSettingIstioIngressGatewayAutoscaling) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{185}
+}
+func (m *SeedSettingIstioIngressGatewayAutoscaling) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SeedSettingIstioIngressGatewayAutoscaling) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SeedSettingIstioIngressGatewayAutoscaling) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SeedSettingIstioIngressGatewayAutoscaling.Merge(m, src)
+}
+func (m *SeedSettingIstioIngressGatewayAutoscaling) XXX_Size() int {
+	return m.Size()
+}
+func (m *SeedSettingIstioIngressGatewayAutoscaling) XXX_DiscardUnknown() {
+	xxx_messageInfo_SeedSettingIstioIngressGatewayAutoscaling.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SeedSettingIstioIngressGatewayAutoscaling proto.InternalMessageInfo
+
+func (m *SeedSettingIstioIngressGatewayExposureClassHandler) Reset() {
+	*m = SeedSettingIstioIngressGatewayExposureClassHandler{}
+}
+func (*SeedSettingIstioIngressGatewayExposureClassHandler) ProtoMessage() {}
+func (*SeedSettingIstioIngressGatewayExposureClassHandler) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{186}
+}
+func (m *SeedSettingIstioIngressGatewayExposureClassHandler) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SeedSettingIstioIngressGatewayExposureClassHandler) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SeedSettingIstioIngressGatewayExposureClassHandler) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SeedSettingIstioIngressGatewayExposureClassHandler.Merge(m, src)
+}
+func (m *SeedSettingIstioIngressGatewayExposureClassHandler) XXX_Size() int {
+	return m.Size()
+}
+func (m *SeedSettingIstioIngressGatewayExposureClassHandler) XXX_DiscardUnknown() {
+	xxx_messageInfo_SeedSettingIstioIngressGatewayExposureClassHandler.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SeedSettingIstioIngressGatewayExposureClassHandler proto.InternalMessageInfo
+
 func (m *SeedSettingLoadBalancerServices) Reset()      { *m = SeedSettingLoadBalancerServices{} }
 func (*SeedSettingLoadBalancerServices) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{161}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{187}
 }
 func (m *SeedSettingLoadBalancerServices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4586,7 +5318,7 @@ func (m *SeedSettingLoadBalancerServicesZonalIngress) Reset() {
 }
 func (*SeedSettingLoadBalancerServicesZonalIngress) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServicesZonalIngress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{162}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{188}
 }
 func (m *SeedSettingLoadBalancerServicesZonalIngress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4614,7 +5346,7 @@ var xxx_messageInfo_SeedSettingLoadBalancerServicesZonalIngress proto.InternalMe
 func (m *SeedSettingLoadBalancerServicesZones) Reset()      { *m = SeedSettingLoadBalancerServicesZones{} }
 func (*SeedSettingLoadBalancerServicesZones) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServicesZones) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{163}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{189}
 }
 func (m *SeedSettingLoadBalancerServicesZones) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4642,7 +5374,7 @@ var xxx_messageInfo_SeedSettingLoadBalancerServicesZones proto.InternalMessageIn
 func (m *SeedSettingScheduling) Reset()      { *m = SeedSettingScheduling{} }
 func (*SeedSettingScheduling) ProtoMessage() {}
 func (*SeedSettingScheduling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{164}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{190}
 }
 func (m *SeedSettingScheduling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4670,7 +5402,7 @@ var xxx_messageInfo_SeedSettingScheduling proto.InternalMessageInfo
 func (m *SeedSettingTopologyAwareRouting) Reset()      { *m = SeedSettingTopologyAwareRouting{} }
 func (*SeedSettingTopologyAwareRouting) ProtoMessage() {}
 func (*SeedSettingTopologyAwareRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{165}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{191}
 }
 func (m *SeedSettingTopologyAwareRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4698,7 +5430,7 @@ var xxx_messageInfo_SeedSettingTopologyAwareRouting proto.InternalMessageInfo
 func (m *SeedSettingVerticalPodAutoscaler) Reset()      { *m = SeedSettingVerticalPodAutoscaler{} }
 func (*SeedSettingVerticalPodAutoscaler) ProtoMessage() {}
 func (*SeedSettingVerticalPodAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{166}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{192}
 }
 func (m *SeedSettingVerticalPodAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4726,7 +5458,7 @@ var xxx_messageInfo_SeedSettingVerticalPodAutoscaler proto.InternalMessageInfo
 func (m *SeedSettings) Reset()      { *m = SeedSettings{} }
 func (*SeedSettings) ProtoMessage() {}
 func (*SeedSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{167}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{193}
 }
 func (m *SeedSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4754,7 +5486,7 @@ var xxx_messageInfo_SeedSettings proto.InternalMessageInfo
 func (m *SeedSpec) Reset()      { *m = SeedSpec{} }
 func (*SeedSpec) ProtoMessage() {}
 func (*SeedSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{168}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{194}
 }
 func (m *SeedSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4782,7 +5514,7 @@ var xxx_messageInfo_SeedSpec proto.InternalMessageInfo
 func (m *SeedStatus) Reset()      { *m = SeedStatus{} }
 func (*SeedStatus) ProtoMessage() {}
 func (*SeedStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{169}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{195}
 }
 func (m *SeedStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4810,7 +5542,7 @@ var xxx_messageInfo_SeedStatus proto.InternalMessageInfo
 func (m *SeedTaint) Reset()      { *m = SeedTaint{} }
 func (*SeedTaint) ProtoMessage() {}
 func (*SeedTaint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{170}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{196}
 }
 func (m *SeedTaint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4838,7 +5570,7 @@ var xxx_messageInfo_SeedTaint proto.InternalMessageInfo
 func (m *SeedTemplate) Reset()      { *m = SeedTemplate{} }
 func (*SeedTemplate) ProtoMessage() {}
 func (*SeedTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{171}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{197}
 }
 func (m *SeedTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4866,7 +5598,7 @@ var xxx_messageInfo_SeedTemplate proto.InternalMessageInfo
 func (m *SeedVolume) Reset()      { *m = SeedVolume{} }
 func (*SeedVolume) ProtoMessage() {}
 func (*SeedVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{172}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{198}
 }
 func (m *SeedVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4894,7 +5626,7 @@ var xxx_messageInfo_SeedVolume proto.InternalMessageInfo
 func (m *SeedVolumeProvider) Reset()      { *m = SeedVolumeProvider{} }
 func (*SeedVolumeProvider) ProtoMessage() {}
 func (*SeedVolumeProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{173}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{199}
 }
 func (m *SeedVolumeProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4922,7 +5654,7 @@ var xxx_messageInfo_SeedVolumeProvider proto.InternalMessageInfo
 func (m *ServiceAccountConfig) Reset()      { *m = ServiceAccountConfig{} }
 func (*ServiceAccountConfig) ProtoMessage() {}
 func (*ServiceAccountConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{174}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{200}
 }
 func (m *ServiceAccountConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4950,7 +5682,7 @@ var xxx_messageInfo_ServiceAccountConfig proto.InternalMessageInfo
 func (m *ServiceAccountKeyRotation) Reset()      { *m = ServiceAccountKeyRotation{} }
 func (*ServiceAccountKeyRotation) ProtoMessage() {}
 func (*ServiceAccountKeyRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{175}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{201}
 }
 func (m *ServiceAccountKeyRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4978,7 +5710,7 @@ var xxx_messageInfo_ServiceAccountKeyRotation proto.InternalMessageInfo
 func (m *Shoot) Reset()      { *m = Shoot{} }
 func (*Shoot) ProtoMessage() {}
 func (*Shoot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{176}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{202}
 }
 func (m *Shoot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5006,7 +5738,7 @@ var xxx_messageInfo_Shoot proto.InternalMessageInfo
 func (m *ShootAdvertisedAddress) Reset()      { *m = ShootAdvertisedAddress{} }
 func (*ShootAdvertisedAddress) ProtoMessage() {}
 func (*ShootAdvertisedAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{177}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{203}
 }
 func (m *ShootAdvertisedAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5034,7 +5766,7 @@ var xxx_messageInfo_ShootAdvertisedAddress proto.InternalMessageInfo
 func (m *ShootCredentials) Reset()      { *m = ShootCredentials{} }
 func (*ShootCredentials) ProtoMessage() {}
 func (*ShootCredentials) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{178}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{204}
 }
 func (m *ShootCredentials) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5062,7 +5794,7 @@ var xxx_messageInfo_ShootCredentials proto.InternalMessageInfo
 func (m *ShootCredentialsRotation) Reset()      { *m = ShootCredentialsRotation{} }
 func (*ShootCredentialsRotation) ProtoMessage() {}
 func (*ShootCredentialsRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{179}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{205}
 }
 func (m *ShootCredentialsRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5090,7 +5822,7 @@ var xxx_messageInfo_ShootCredentialsRotation proto.InternalMessageInfo
 func (m *ShootKubeconfigRotation) Reset()      { *m = ShootKubeconfigRotation{} }
 func (*ShootKubeconfigRotation) ProtoMessage() {}
 func (*ShootKubeconfigRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{180}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{206}
 }
 func (m *ShootKubeconfigRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5118,7 +5850,7 @@ var xxx_messageInfo_ShootKubeconfigRotation proto.InternalMessageInfo
 func (m *ShootList) Reset()      { *m = ShootList{} }
 func (*ShootList) ProtoMessage() {}
 func (*ShootList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{181}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{207}
 }
 func (m *ShootList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5146,7 +5878,7 @@ var xxx_messageInfo_ShootList proto.InternalMessageInfo
 func (m *ShootMachineImage) Reset()      { *m = ShootMachineImage{} }
 func (*ShootMachineImage) ProtoMessage() {}
 func (*ShootMachineImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{182}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{208}
 }
 func (m *ShootMachineImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5174,7 +5906,7 @@ var xxx_messageInfo_ShootMachineImage proto.InternalMessageInfo
 func (m *ShootNetworks) Reset()      { *m = ShootNetworks{} }
 func (*ShootNetworks) ProtoMessage() {}
 func (*ShootNetworks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{183}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{209}
 }
 func (m *ShootNetworks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5202,7 +5934,7 @@ var xxx_messageInfo_ShootNetworks proto.InternalMessageInfo
 func (m *ShootSSHKeypairRotation) Reset()      { *m = ShootSSHKeypairRotation{} }
 func (*ShootSSHKeypairRotation) ProtoMessage() {}
 func (*ShootSSHKeypairRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{184}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{210}
 }
 func (m *ShootSSHKeypairRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5230,7 +5962,7 @@ var xxx_messageInfo_ShootSSHKeypairRotation proto.InternalMessageInfo
 func (m *ShootSpec) Reset()      { *m = ShootSpec{} }
 func (*ShootSpec) ProtoMessage() {}
 func (*ShootSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{185}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{211}
 }
 func (m *ShootSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5258,7 +5990,7 @@ var xxx_messageInfo_ShootSpec proto.InternalMessageInfo
 func (m *ShootState) Reset()      { *m = ShootState{} }
 func (*ShootState) ProtoMessage() {}
 func (*ShootState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{186}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{212}
 }
 func (m *ShootState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5286,7 +6018,7 @@ var xxx_messageInfo_ShootState proto.InternalMessageInfo
 func (m *ShootStateList) Reset()      { *m = ShootStateList{} }
 func (*ShootStateList) ProtoMessage() {}
 func (*ShootStateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{187}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{213}
 }
 func (m *ShootStateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5314,7 +6046,7 @@ var xxx_messageInfo_ShootStateList proto.InternalMessageInfo
 func (m *ShootStateSpec) Reset()      { *m = ShootStateSpec{} }
 func (*ShootStateSpec) ProtoMessage() {}
 func (*ShootStateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{188}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{214}
 }
 func (m *ShootStateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5342,7 +6074,7 @@ var xxx_messageInfo_ShootStateSpec proto.InternalMessageInfo
 func (m *ShootStatus) Reset()      { *m = ShootStatus{} }
 func (*ShootStatus) ProtoMessage() {}
 func (*ShootStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{189}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{215}
 }
 func (m *ShootStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5370,7 +6102,7 @@ var xxx_messageInfo_ShootStatus proto.InternalMessageInfo
 func (m *ShootTemplate) Reset()      { *m = ShootTemplate{} }
 func (*ShootTemplate) ProtoMessage() {}
 func (*ShootTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{190}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{216}
 }
 func (m *ShootTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5395,10 +6127,38 @@ func (m *ShootTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ShootTemplate proto.InternalMessageInfo
 
+func (m *ShootUpgradePaths) Reset()      { *m = ShootUpgradePaths{} }
+func (*ShootUpgradePaths) ProtoMessage() {}
+func (*ShootUpgradePaths) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{217}
+}
+func (m *ShootUpgradePaths) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShootUpgradePaths) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ShootUpgradePaths) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShootUpgradePaths.Merge(m, src)
+}
+func (m *ShootUpgradePaths) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShootUpgradePaths) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShootUpgradePaths.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShootUpgradePaths proto.InternalMessageInfo
+
 func (m *StructuredAuthentication) Reset()      { *m = StructuredAuthentication{} }
 func (*StructuredAuthentication) ProtoMessage() {}
 func (*StructuredAuthentication) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{191}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{218}
 }
 func (m *StructuredAuthentication) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5426,7 +6186,7 @@ var xxx_messageInfo_StructuredAuthentication proto.InternalMessageInfo
 func (m *StructuredAuthorization) Reset()      { *m = StructuredAuthorization{} }
 func (*StructuredAuthorization) ProtoMessage() {}
 func (*StructuredAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{192}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{219}
 }
 func (m *StructuredAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5454,7 +6214,7 @@ var xxx_messageInfo_StructuredAuthorization proto.InternalMessageInfo
 func (m *SystemComponents) Reset()      { *m = SystemComponents{} }
 func (*SystemComponents) ProtoMessage() {}
 func (*SystemComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{193}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{220}
 }
 func (m *SystemComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5482,7 +6242,7 @@ var xxx_messageInfo_SystemComponents proto.InternalMessageInfo
 func (m *Toleration) Reset()      { *m = Toleration{} }
 func (*Toleration) ProtoMessage() {}
 func (*Toleration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{194}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{221}
 }
 func (m *Toleration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5507,10 +6267,38 @@ func (m *Toleration) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Toleration proto.InternalMessageInfo
 
+func (m *VersionUpgradePath) Reset()      { *m = VersionUpgradePath{} }
+func (*VersionUpgradePath) ProtoMessage() {}
+func (*VersionUpgradePath) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{222}
+}
+func (m *VersionUpgradePath) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VersionUpgradePath) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *VersionUpgradePath) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionUpgradePath.Merge(m, src)
+}
+func (m *VersionUpgradePath) XXX_Size() int {
+	return m.Size()
+}
+func (m *VersionUpgradePath) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionUpgradePath.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionUpgradePath proto.InternalMessageInfo
+
 func (m *VerticalPodAutoscaler) Reset()      { *m = VerticalPodAutoscaler{} }
 func (*VerticalPodAutoscaler) ProtoMessage() {}
 func (*VerticalPodAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{195}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{223}
 }
 func (m *VerticalPodAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5538,7 +6326,7 @@ var xxx_messageInfo_VerticalPodAutoscaler proto.InternalMessageInfo
 func (m *Volume) Reset()      { *m = Volume{} }
 func (*Volume) ProtoMessage() {}
 func (*Volume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{196}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{224}
 }
 func (m *Volume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5566,7 +6354,7 @@ var xxx_messageInfo_Volume proto.InternalMessageInfo
 func (m *VolumeType) Reset()      { *m = VolumeType{} }
 func (*VolumeType) ProtoMessage() {}
 func (*VolumeType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{197}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{225}
 }
 func (m *VolumeType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5594,7 +6382,7 @@ var xxx_messageInfo_VolumeType proto.InternalMessageInfo
 func (m *WatchCacheSizes) Reset()      { *m = WatchCacheSizes{} }
 func (*WatchCacheSizes) ProtoMessage() {}
 func (*WatchCacheSizes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{198}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{226}
 }
 func (m *WatchCacheSizes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5619,10 +6407,38 @@ func (m *WatchCacheSizes) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WatchCacheSizes proto.InternalMessageInfo
 
+func (m *WeightedSeedAffinityTerm) Reset()      { *m = WeightedSeedAffinityTerm{} }
+func (*WeightedSeedAffinityTerm) ProtoMessage() {}
+func (*WeightedSeedAffinityTerm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{227}
+}
+func (m *WeightedSeedAffinityTerm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedSeedAffinityTerm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WeightedSeedAffinityTerm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedSeedAffinityTerm.Merge(m, src)
+}
+func (m *WeightedSeedAffinityTerm) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedSeedAffinityTerm) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedSeedAffinityTerm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedSeedAffinityTerm proto.InternalMessageInfo
+
 func (m *Worker) Reset()      { *m = Worker{} }
 func (*Worker) ProtoMessage() {}
 func (*Worker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{199}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{228}
 }
 func (m *Worker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5650,7 +6466,7 @@ var xxx_messageInfo_Worker proto.InternalMessageInfo
 func (m *WorkerControlPlane) Reset()      { *m = WorkerControlPlane{} }
 func (*WorkerControlPlane) ProtoMessage() {}
 func (*WorkerControlPlane) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{200}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{229}
 }
 func (m *WorkerControlPlane) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5678,7 +6494,7 @@ var xxx_messageInfo_WorkerControlPlane proto.InternalMessageInfo
 func (m *WorkerKubernetes) Reset()      { *m = WorkerKubernetes{} }
 func (*WorkerKubernetes) ProtoMessage() {}
 func (*WorkerKubernetes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{201}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{230}
 }
 func (m *WorkerKubernetes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5703,10 +6519,38 @@ func (m *WorkerKubernetes) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WorkerKubernetes proto.InternalMessageInfo
 
+func (m *WorkerPoolStatus) Reset()      { *m = WorkerPoolStatus{} }
+func (*WorkerPoolStatus) ProtoMessage() {}
+func (*WorkerPoolStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{231}
+}
+func (m *WorkerPoolStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkerPoolStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WorkerPoolStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkerPoolStatus.Merge(m, src)
+}
+func (m *WorkerPoolStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkerPoolStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkerPoolStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkerPoolStatus proto.InternalMessageInfo
+
 func (m *WorkerSystemComponents) Reset()      { *m = WorkerSystemComponents{} }
 func (*WorkerSystemComponents) ProtoMessage() {}
 func (*WorkerSystemComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{202}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{232}
 }
 func (m *WorkerSystemComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5734,7 +6578,7 @@ var xxx_messageInfo_WorkerSystemComponents proto.InternalMessageInfo
 func (m *WorkersSettings) Reset()      { *m = WorkersSettings{} }
 func (*WorkersSettings) ProtoMessage() {}
 func (*WorkersSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{203}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{233}
 }
 func (m *WorkersSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5759,6 +6603,34 @@ func (m *WorkersSettings) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WorkersSettings proto.InternalMessageInfo
 
+func (m *ZoneEgressCIDRs) Reset()      { *m = ZoneEgressCIDRs{} }
+func (*ZoneEgressCIDRs) ProtoMessage() {}
+func (*ZoneEgressCIDRs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{234}
+}
+func (m *ZoneEgressCIDRs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ZoneEgressCIDRs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ZoneEgressCIDRs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZoneEgressCIDRs.Merge(m, src)
+}
+func (m *ZoneEgressCIDRs) XXX_Size() int {
+	return m.Size()
+}
+func (m *ZoneEgressCIDRs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZoneEgressCIDRs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZoneEgressCIDRs proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*APIServerLogging)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.APIServerLogging")
 	proto.RegisterType((*APIServerRequests)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.APIServerRequests")
@@ -5771,6 +6643,10 @@ func init() {
 	proto.RegisterType((*Alerting)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Alerting")
 	proto.RegisterType((*AuditConfig)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AuditConfig")
 	proto.RegisterType((*AuditPolicy)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AuditPolicy")
+	proto.RegisterType((*AuthenticationClaimMappings)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AuthenticationClaimMappings")
+	proto.RegisterType((*AuthenticationClaimOrExpression)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AuthenticationClaimOrExpression")
+	proto.RegisterType((*AuthenticationClaimValidationRule)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AuthenticationClaimValidationRule")
+	proto.RegisterType((*AuthenticationIssuer)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AuthenticationIssuer")
 	proto.RegisterType((*AuthorizerKubeconfigReference)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AuthorizerKubeconfigReference")
 	proto.RegisterType((*AvailabilityZone)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AvailabilityZone")
 	proto.RegisterType((*Backup)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Backup")
@@ -5790,6 +6666,7 @@ func init() {
 	proto.RegisterType((*CRI)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CRI")
 	proto.RegisterType((*CapabilityDefinition)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CapabilityDefinition")
 	proto.RegisterType((*CapabilityValues)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CapabilityValues")
+	proto.RegisterType((*CloudCredentialsRotation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CloudCredentialsRotation")
 	proto.RegisterType((*CloudProfile)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CloudProfile")
 	proto.RegisterType((*CloudProfileList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CloudProfileList")
 	proto.RegisterType((*CloudProfileReference)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CloudProfileReference")
@@ -5798,9 +6675,12 @@ func init() {
 	proto.RegisterType((*ClusterAutoscalerOptions)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ClusterAutoscalerOptions")
 	proto.RegisterType((*Condition)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Condition")
 	proto.RegisterType((*ContainerRuntime)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ContainerRuntime")
+	proto.RegisterType((*ContainerdConfig)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ContainerdConfig")
+	proto.RegisterType((*ContainerdRegistry)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ContainerdRegistry")
 	proto.RegisterType((*ControlPlane)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControlPlane")
 	proto.RegisterType((*ControlPlaneAutoscaling)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControlPlaneAutoscaling")
 	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControlPlaneAutoscaling.MinAllowedEntry")
+	proto.RegisterType((*ControlPlaneResourceConsumption)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControlPlaneResourceConsumption")
 	proto.RegisterType((*ControllerDeployment)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControllerDeployment")
 	proto.RegisterType((*ControllerDeploymentList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControllerDeploymentList")
 	proto.RegisterType((*ControllerInstallation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControllerInstallation")
@@ -5812,6 +6692,7 @@ func init() {
 	proto.RegisterType((*ControllerRegistrationList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControllerRegistrationList")
 	proto.RegisterType((*ControllerRegistrationSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControllerRegistrationSpec")
 	proto.RegisterType((*ControllerResource)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControllerResource")
+	proto.RegisterType((*ControllerResourceAddon)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControllerResourceAddon")
 	proto.RegisterType((*ControllerResourceLifecycle)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControllerResourceLifecycle")
 	proto.RegisterType((*CoreDNS)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CoreDNS")
 	proto.RegisterType((*CoreDNSAutoscaling)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CoreDNSAutoscaling")
@@ -5826,6 +6707,7 @@ func init() {
 	proto.RegisterType((*ETCDConfig)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ETCDConfig")
 	proto.RegisterType((*ETCDEncryptionKeyRotation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ETCDEncryptionKeyRotation")
 	proto.RegisterType((*EncryptionAtRest)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.EncryptionAtRest")
+	proto.RegisterType((*EncryptionAtRestMigrationProgress)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.EncryptionAtRestMigrationProgress")
 	proto.RegisterType((*EncryptionConfig)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.EncryptionConfig")
 	proto.RegisterType((*ExpirableVersion)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ExpirableVersion")
 	proto.RegisterType((*ExposureClass)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ExposureClass")
@@ -5850,8 +6732,12 @@ func init() {
 	proto.RegisterMapType((map[string][]byte)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.InternalSecret.DataEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.InternalSecret.StringDataEntry")
 	proto.RegisterType((*InternalSecretList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.InternalSecretList")
+	proto.RegisterType((*JWTAuthenticator)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.JWTAuthenticator")
+	proto.RegisterType((*KMSEncryptionConfiguration)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.KMSEncryptionConfiguration")
 	proto.RegisterType((*KubeAPIServerConfig)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.KubeAPIServerConfig")
 	proto.RegisterMapType((map[string]bool)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.KubeAPIServerConfig.RuntimeConfigEntry")
+	proto.RegisterType((*KubeAPIServerRequestBasedAutoscaling)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.KubeAPIServerRequestBasedAutoscaling")
+	proto.RegisterType((*KubeControllerManagerConcurrentSyncs)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.KubeControllerManagerConcurrentSyncs")
 	proto.RegisterType((*KubeControllerManagerConfig)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.KubeControllerManagerConfig")
 	proto.RegisterType((*KubeProxyConfig)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.KubeProxyConfig")
 	proto.RegisterType((*KubeSchedulerConfig)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.KubeSchedulerConfig")
@@ -5875,6 +6761,7 @@ func init() {
 	proto.RegisterType((*MachineImage)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MachineImage")
 	proto.RegisterType((*MachineImageFlavor)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MachineImageFlavor")
 	proto.RegisterMapType((Capabilities)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MachineImageFlavor.CapabilitiesEntry")
+	proto.RegisterType((*MachineImageUpgradePath)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MachineImageUpgradePath")
 	proto.RegisterType((*MachineImageVersion)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MachineImageVersion")
 	proto.RegisterType((*MachineType)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MachineType")
 	proto.RegisterMapType((Capabilities)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MachineType.CapabilitiesEntry")
@@ -5882,6 +6769,7 @@ func init() {
 	proto.RegisterType((*Maintenance)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Maintenance")
 	proto.RegisterType((*MaintenanceAutoUpdate)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceAutoUpdate")
 	proto.RegisterType((*MaintenanceTimeWindow)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceTimeWindow")
+	proto.RegisterType((*ManagedAddon)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ManagedAddon")
 	proto.RegisterType((*ManualWorkerPoolRollout)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ManualWorkerPoolRollout")
 	proto.RegisterType((*MemorySwapConfiguration)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MemorySwapConfiguration")
 	proto.RegisterType((*Monitoring)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Monitoring")
@@ -5909,6 +6797,7 @@ func init() {
 	proto.RegisterType((*ProjectSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectSpec")
 	proto.RegisterType((*ProjectStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectStatus")
 	proto.RegisterType((*ProjectTolerations)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectTolerations")
+	proto.RegisterType((*ProjectUsageSummary)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectUsageSummary")
 	proto.RegisterType((*Provider)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Provider")
 	proto.RegisterType((*Quota)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Quota")
 	proto.RegisterType((*QuotaList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaList")
@@ -5916,13 +6805,18 @@ func init() {
 	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaSpec.MetricsEntry")
 	proto.RegisterType((*Region)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Region")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Region.LabelsEntry")
+	proto.RegisterType((*RegistryMirror)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.RegistryMirror")
+	proto.RegisterType((*ResourceBackupAddon)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ResourceBackupAddon")
 	proto.RegisterType((*ResourceData)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ResourceData")
 	proto.RegisterType((*ResourceWatchCacheSize)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ResourceWatchCacheSize")
 	proto.RegisterType((*SSHAccess)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SSHAccess")
 	proto.RegisterType((*SecretBinding)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SecretBinding")
 	proto.RegisterType((*SecretBindingList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SecretBindingList")
 	proto.RegisterType((*SecretBindingProvider)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SecretBindingProvider")
+	proto.RegisterType((*SecretBindingStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SecretBindingStatus")
 	proto.RegisterType((*Seed)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Seed")
+	proto.RegisterType((*SeedAffinity)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedAffinity")
+	proto.RegisterType((*SeedAvailability)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedAvailability")
 	proto.RegisterType((*SeedDNS)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedDNS")
 	proto.RegisterType((*SeedDNSProvider)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedDNSProvider")
 	proto.RegisterType((*SeedDNSProviderConfig)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedDNSProviderConfig")
@@ -5930,6 +6824,7 @@ func init() {
 	proto.RegisterType((*SeedNetworks)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedNetworks")
 	proto.RegisterType((*SeedProvider)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedProvider")
 	proto.RegisterType((*SeedSelector)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedSelector")
+	proto.RegisterType((*SeedSettingDNSCache)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingDNSCache")
 	proto.RegisterType((*SeedSettingDependencyWatchdog)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingDependencyWatchdog")
 	proto.RegisterType((*SeedSettingDependencyWatchdogProber)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingDependencyWatchdogProber")
 	proto.RegisterType((*SeedSettingDependencyWatchdogWeeder)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingDependencyWatchdogWeeder")
@@ -5937,6 +6832,10 @@ func init() {
 	proto.RegisterType((*SeedSettingExcessCapacityReservationConfig)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingExcessCapacityReservationConfig")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingExcessCapacityReservationConfig.NodeSelectorEntry")
 	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingExcessCapacityReservationConfig.ResourcesEntry")
+	proto.RegisterType((*SeedSettingIstioIngressGateway)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingIstioIngressGateway")
+	proto.RegisterType((*SeedSettingIstioIngressGatewayAutoscaling)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingIstioIngressGatewayAutoscaling")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingIstioIngressGatewayAutoscaling.RequestsEntry")
+	proto.RegisterType((*SeedSettingIstioIngressGatewayExposureClassHandler)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingIstioIngressGatewayExposureClassHandler")
 	proto.RegisterType((*SeedSettingLoadBalancerServices)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingLoadBalancerServices")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingLoadBalancerServices.AnnotationsEntry")
 	proto.RegisterType((*SeedSettingLoadBalancerServicesZonalIngress)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingLoadBalancerServicesZonalIngress")
@@ -5973,24 +6872,29 @@ func init() {
 	proto.RegisterType((*ShootStateSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootStateSpec")
 	proto.RegisterType((*ShootStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootStatus")
 	proto.RegisterType((*ShootTemplate)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootTemplate")
+	proto.RegisterType((*ShootUpgradePaths)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootUpgradePaths")
 	proto.RegisterType((*StructuredAuthentication)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.StructuredAuthentication")
 	proto.RegisterType((*StructuredAuthorization)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.StructuredAuthorization")
 	proto.RegisterType((*SystemComponents)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SystemComponents")
 	proto.RegisterType((*Toleration)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Toleration")
+	proto.RegisterType((*VersionUpgradePath)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.VersionUpgradePath")
 	proto.RegisterType((*VerticalPodAutoscaler)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.VerticalPodAutoscaler")
 	proto.RegisterMapType((map[string]bool)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.VerticalPodAutoscaler.FeatureGatesEntry")
 	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.VerticalPodAutoscaler.MaxAllowedEntry")
 	proto.RegisterType((*Volume)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Volume")
 	proto.RegisterType((*VolumeType)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.VolumeType")
 	proto.RegisterType((*WatchCacheSizes)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.WatchCacheSizes")
+	proto.RegisterType((*WeightedSeedAffinityTerm)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.WeightedSeedAffinityTerm")
 	proto.RegisterType((*Worker)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Worker")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Worker.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Worker.LabelsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Worker.SysctlsEntry")
 	proto.RegisterType((*WorkerControlPlane)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.WorkerControlPlane")
 	proto.RegisterType((*WorkerKubernetes)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.WorkerKubernetes")
+	proto.RegisterType((*WorkerPoolStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.WorkerPoolStatus")
 	proto.RegisterType((*WorkerSystemComponents)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.WorkerSystemComponents")
 	proto.RegisterType((*WorkersSettings)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.WorkersSettings")
+	proto.RegisterType((*ZoneEgressCIDRs)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ZoneEgressCIDRs")
 }
 
 func init() {
@@ -5998,934 +6902,1093 @@ func init() {
 }
 
 var fileDescriptor_ca37af0df9a5bbd2 = []byte{
-	// 14824 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x70, 0x24, 0xc9,
-	0x55, 0xa0, 0xab, 0xf5, 0xfd, 0xf4, 0x31, 0x52, 0x8e, 0x66, 0x46, 0xa3, 0xf9, 0xe8, 0x71, 0xad,
-	0x6d, 0x66, 0x59, 0x5b, 0xc3, 0xae, 0xd7, 0x5e, 0xef, 0x9a, 0xfd, 0x90, 0xba, 0xa5, 0x99, 0xf6,
-	0x48, 0x1a, 0x6d, 0xb6, 0xb4, 0xbb, 0xde, 0x85, 0x35, 0xa5, 0xea, 0x54, 0xab, 0x76, 0xaa, 0xab,
-	0x7a, 0xab, 0xaa, 0x35, 0xd2, 0xac, 0x8d, 0x3f, 0xc0, 0x9c, 0x6d, 0x0c, 0xc7, 0xb7, 0xcf, 0x36,
-	0x04, 0x8b, 0x09, 0xcc, 0xdd, 0x71, 0x67, 0x2e, 0x20, 0xb8, 0x08, 0x20, 0x20, 0x0e, 0x22, 0x38,
-	0x0c, 0x81, 0x09, 0x02, 0xc3, 0x9d, 0x39, 0xa0, 0xc1, 0x3a, 0x1f, 0x5c, 0xc4, 0x7d, 0xc6, 0x11,
-	0x71, 0x44, 0xcc, 0x11, 0xdc, 0x45, 0x7e, 0x54, 0x55, 0xd6, 0x57, 0xab, 0x55, 0x2d, 0xc9, 0xbb,
-	0x87, 0x7f, 0x49, 0x9d, 0x2f, 0xf3, 0xbd, 0xfc, 0xaa, 0x97, 0xef, 0xbd, 0x7c, 0xf9, 0x1e, 0x2c,
-	0xd4, 0x0d, 0x6f, 0xbb, 0xb5, 0x39, 0xa7, 0xdb, 0x8d, 0x6b, 0x75, 0xcd, 0xa9, 0x11, 0x8b, 0x38,
-	0xe1, 0x3f, 0xcd, 0xdb, 0xf5, 0x6b, 0x5a, 0xd3, 0x70, 0xaf, 0xe9, 0xb6, 0x43, 0xae, 0xed, 0x3c,
-	0xb8, 0x49, 0x3c, 0xed, 0xc1, 0x6b, 0x75, 0x0a, 0xd3, 0x3c, 0x52, 0x9b, 0x6b, 0x3a, 0xb6, 0x67,
-	0xa3, 0x87, 0x42, 0x1c, 0x73, 0x7e, 0xd3, 0xf0, 0x9f, 0xe6, 0xed, 0xfa, 0x1c, 0xc5, 0x31, 0x47,
-	0x71, 0xcc, 0x09, 0x1c, 0xb3, 0x6f, 0x93, 0xe9, 0xda, 0x75, 0xfb, 0x1a, 0x43, 0xb5, 0xd9, 0xda,
-	0x62, 0xbf, 0xd8, 0x0f, 0xf6, 0x1f, 0x27, 0x31, 0x7b, 0xff, 0xed, 0x77, 0xb9, 0x73, 0x86, 0x4d,
-	0x3b, 0x73, 0x4d, 0x6b, 0x79, 0xb6, 0xab, 0x6b, 0xa6, 0x61, 0xd5, 0xaf, 0xed, 0x24, 0x7a, 0x33,
-	0xab, 0x4a, 0x55, 0x45, 0xb7, 0x3b, 0xd6, 0x71, 0x36, 0x35, 0x3d, 0xad, 0xce, 0x8d, 0xb0, 0x0e,
-	0xd9, 0xf5, 0x88, 0xe5, 0x1a, 0xb6, 0xe5, 0xbe, 0x8d, 0x8e, 0x84, 0x38, 0x3b, 0xf2, 0xdc, 0x44,
-	0x2a, 0xa4, 0x61, 0x7a, 0x38, 0xc4, 0xd4, 0xd0, 0xf4, 0x6d, 0xc3, 0x22, 0xce, 0x9e, 0xdf, 0xfc,
-	0x9a, 0x43, 0x5c, 0xbb, 0xe5, 0xe8, 0xe4, 0x50, 0xad, 0xdc, 0x6b, 0x0d, 0xe2, 0x69, 0x69, 0xb4,
-	0xae, 0x65, 0xb5, 0x72, 0x5a, 0x96, 0x67, 0x34, 0x92, 0x64, 0xde, 0x79, 0x50, 0x03, 0x57, 0xdf,
-	0x26, 0x0d, 0x2d, 0xd1, 0xee, 0xed, 0x59, 0xed, 0x5a, 0x9e, 0x61, 0x5e, 0x33, 0x2c, 0xcf, 0xf5,
-	0x9c, 0x78, 0x23, 0xf5, 0x13, 0x0a, 0x4c, 0xce, 0xaf, 0x55, 0xaa, 0x6c, 0x06, 0x97, 0xed, 0x7a,
-	0xdd, 0xb0, 0xea, 0xe8, 0x01, 0x18, 0xd9, 0x21, 0xce, 0xa6, 0xed, 0x1a, 0xde, 0xde, 0x8c, 0x72,
-	0x45, 0xb9, 0x3a, 0xb0, 0x30, 0xbe, 0xdf, 0x2e, 0x8e, 0x3c, 0xe3, 0x17, 0xe2, 0x10, 0x8e, 0x2a,
-	0x70, 0x7a, 0xdb, 0xf3, 0x9a, 0xf3, 0xba, 0x4e, 0x5c, 0x37, 0xa8, 0x31, 0x53, 0x60, 0xcd, 0xce,
-	0xed, 0xb7, 0x8b, 0xa7, 0x6f, 0xac, 0xaf, 0xaf, 0xc5, 0xc0, 0x38, 0xad, 0x8d, 0xfa, 0x0b, 0x0a,
-	0x4c, 0x05, 0x9d, 0xc1, 0xe4, 0xe5, 0x16, 0x71, 0x3d, 0x17, 0x61, 0x38, 0xdb, 0xd0, 0x76, 0x57,
-	0x6d, 0x6b, 0xa5, 0xe5, 0x69, 0x9e, 0x61, 0xd5, 0x2b, 0xd6, 0x96, 0x69, 0xd4, 0xb7, 0x3d, 0xd1,
-	0xb5, 0xd9, 0xfd, 0x76, 0xf1, 0xec, 0x4a, 0x6a, 0x0d, 0x9c, 0xd1, 0x92, 0x76, 0xba, 0xa1, 0xed,
-	0x26, 0x10, 0x4a, 0x9d, 0x5e, 0x49, 0x82, 0x71, 0x5a, 0x1b, 0xf5, 0x1d, 0x30, 0xc5, 0xc7, 0x81,
-	0x89, 0xeb, 0x39, 0x86, 0xee, 0x19, 0xb6, 0x85, 0xae, 0x40, 0xbf, 0xa5, 0x35, 0x08, 0xeb, 0xe1,
-	0xc8, 0xc2, 0xd8, 0x17, 0xdb, 0xc5, 0x37, 0xec, 0xb7, 0x8b, 0xfd, 0xab, 0x5a, 0x83, 0x60, 0x06,
-	0x51, 0xff, 0x77, 0x01, 0x2e, 0x26, 0xda, 0x3d, 0x6b, 0x78, 0xdb, 0xb7, 0x9a, 0xf4, 0x3f, 0x17,
-	0x7d, 0xbf, 0x02, 0x53, 0x5a, 0xbc, 0x02, 0x43, 0x38, 0xfa, 0xd0, 0xe2, 0xdc, 0xe1, 0x3f, 0xf0,
-	0xb9, 0x04, 0xb5, 0x85, 0xf3, 0xa2, 0x5f, 0xc9, 0x01, 0xe0, 0x24, 0x69, 0xf4, 0x31, 0x05, 0x86,
-	0x6c, 0xde, 0xb9, 0x99, 0xc2, 0x95, 0xbe, 0xab, 0xa3, 0x0f, 0x7d, 0xfb, 0x91, 0x74, 0x43, 0x1a,
-	0xf4, 0x9c, 0xf8, 0xbb, 0x68, 0x79, 0xce, 0xde, 0xc2, 0x29, 0xd1, 0xbd, 0x21, 0x51, 0x8a, 0x7d,
-	0xf2, 0xb3, 0x8f, 0xc1, 0x98, 0x5c, 0x13, 0x4d, 0x42, 0xdf, 0x6d, 0xc2, 0xb7, 0xea, 0x08, 0xa6,
-	0xff, 0xa2, 0x69, 0x18, 0xd8, 0xd1, 0xcc, 0x16, 0x61, 0x4b, 0x3a, 0x82, 0xf9, 0x8f, 0xc7, 0x0a,
-	0xef, 0x52, 0xd4, 0x87, 0x60, 0x60, 0xbe, 0x56, 0xb3, 0x2d, 0x74, 0x3f, 0x0c, 0x11, 0x4b, 0xdb,
-	0x34, 0x49, 0x8d, 0x35, 0x1c, 0x0e, 0xe9, 0x2d, 0xf2, 0x62, 0xec, 0xc3, 0xd5, 0x1f, 0x2d, 0xc0,
-	0x20, 0x6b, 0xe4, 0xa2, 0x1f, 0x52, 0xe0, 0xf4, 0xed, 0xd6, 0x26, 0x71, 0x2c, 0xe2, 0x11, 0xb7,
-	0xac, 0xb9, 0xdb, 0x9b, 0xb6, 0xe6, 0xd4, 0xc4, 0xc2, 0x5c, 0xcf, 0x33, 0x23, 0x37, 0x93, 0xe8,
-	0xf8, 0x1e, 0x4c, 0x01, 0xe0, 0x34, 0xe2, 0x68, 0x07, 0xc6, 0xac, 0xba, 0x61, 0xed, 0x56, 0xac,
-	0xba, 0x43, 0x5c, 0x97, 0x0d, 0x7a, 0xf4, 0xa1, 0xa7, 0xf2, 0x74, 0x66, 0x55, 0xc2, 0xb3, 0x30,
-	0xb9, 0xdf, 0x2e, 0x8e, 0xc9, 0x25, 0x38, 0x42, 0x47, 0xfd, 0x7b, 0x05, 0x4e, 0xcd, 0xd7, 0x1a,
-	0x86, 0x4b, 0x39, 0xed, 0x9a, 0xd9, 0xaa, 0x1b, 0x5d, 0x6c, 0x7d, 0xf4, 0x34, 0x0c, 0xea, 0xb6,
-	0xb5, 0x65, 0xd4, 0x45, 0x3f, 0xdf, 0x36, 0xc7, 0x39, 0xd7, 0x9c, 0xcc, 0xb9, 0x58, 0xf7, 0x04,
-	0xc7, 0x9b, 0xc3, 0xda, 0x9d, 0x45, 0x9f, 0xa1, 0x2f, 0xc0, 0x7e, 0xbb, 0x38, 0x58, 0x62, 0x08,
-	0xb0, 0x40, 0x84, 0xae, 0xc2, 0x70, 0xcd, 0x70, 0xf9, 0x62, 0xf6, 0xb1, 0xc5, 0x1c, 0xdb, 0x6f,
-	0x17, 0x87, 0xcb, 0xa2, 0x0c, 0x07, 0x50, 0xb4, 0x0c, 0xd3, 0x74, 0x06, 0x79, 0xbb, 0x2a, 0xd1,
-	0x1d, 0xe2, 0xd1, 0xae, 0xcd, 0xf4, 0xb3, 0xee, 0xce, 0xec, 0xb7, 0x8b, 0xd3, 0x37, 0x53, 0xe0,
-	0x38, 0xb5, 0x95, 0xba, 0x04, 0xc3, 0xf3, 0x26, 0x71, 0x28, 0x43, 0x40, 0x8f, 0xc1, 0x04, 0x69,
-	0x68, 0x86, 0x89, 0x89, 0x4e, 0x8c, 0x1d, 0xe2, 0xb8, 0x33, 0xca, 0x95, 0xbe, 0xab, 0x23, 0x0b,
-	0x68, 0xbf, 0x5d, 0x9c, 0x58, 0x8c, 0x40, 0x70, 0xac, 0xa6, 0xfa, 0x61, 0x05, 0x46, 0xe7, 0x5b,
-	0x35, 0xc3, 0xe3, 0xe3, 0x42, 0x0e, 0x8c, 0x6a, 0xf4, 0xe7, 0x9a, 0x6d, 0x1a, 0xfa, 0x9e, 0xd8,
-	0x5c, 0x4f, 0xe6, 0xfa, 0xdc, 0x42, 0x34, 0x0b, 0xa7, 0xf6, 0xdb, 0xc5, 0x51, 0xa9, 0x00, 0xcb,
-	0x44, 0xd4, 0x6d, 0x90, 0x61, 0xe8, 0xbd, 0x30, 0xc6, 0x87, 0xbb, 0xa2, 0x35, 0x31, 0xd9, 0x12,
-	0x7d, 0xb8, 0x4f, 0x5a, 0x2b, 0x9f, 0xd0, 0xdc, 0xad, 0xcd, 0x97, 0x88, 0xee, 0x61, 0xb2, 0x45,
-	0x1c, 0x62, 0xe9, 0x84, 0x6f, 0x9b, 0x92, 0xd4, 0x18, 0x47, 0x50, 0xa9, 0x3f, 0xac, 0xc0, 0xa5,
-	0xf9, 0x96, 0xb7, 0x6d, 0x3b, 0xc6, 0x5d, 0xe2, 0x84, 0xd3, 0x1d, 0x60, 0x40, 0x4f, 0xc0, 0x84,
-	0x16, 0x54, 0x58, 0x0d, 0xb7, 0xd3, 0x59, 0xb1, 0x9d, 0x26, 0xe6, 0x23, 0x50, 0x1c, 0xab, 0x8d,
-	0x1e, 0x02, 0x70, 0xc3, 0xb5, 0x65, 0x3c, 0x60, 0x01, 0x89, 0xb6, 0x20, 0xad, 0xaa, 0x54, 0x4b,
-	0xfd, 0x0b, 0x7a, 0x14, 0xee, 0x68, 0x86, 0xa9, 0x6d, 0x1a, 0xa6, 0xe1, 0xed, 0x3d, 0x6f, 0x5b,
-	0xa4, 0x8b, 0xdd, 0xbc, 0x01, 0xe7, 0x5a, 0x96, 0xc6, 0xdb, 0x99, 0x64, 0x85, 0xef, 0xdf, 0xf5,
-	0xbd, 0x26, 0xe1, 0x5c, 0x72, 0x64, 0xe1, 0xc2, 0x7e, 0xbb, 0x78, 0x6e, 0x23, 0xbd, 0x0a, 0xce,
-	0x6a, 0x4b, 0x4f, 0x3d, 0x09, 0xf4, 0x8c, 0x6d, 0xb6, 0x1a, 0x02, 0x6b, 0x1f, 0xc3, 0xca, 0x4e,
-	0xbd, 0x8d, 0xd4, 0x1a, 0x38, 0xa3, 0xa5, 0xfa, 0xf9, 0x02, 0x0c, 0x2e, 0x68, 0xfa, 0xed, 0x56,
-	0x13, 0xbd, 0x15, 0x86, 0x9b, 0x8e, 0xbd, 0x63, 0xd4, 0x88, 0x23, 0xc6, 0x36, 0x29, 0xc6, 0x36,
-	0xbc, 0x26, 0xca, 0x71, 0x50, 0x03, 0x19, 0x30, 0xe1, 0xff, 0x5f, 0xea, 0xe1, 0xcb, 0x65, 0x5f,
-	0xc2, 0x5a, 0x04, 0x11, 0x8e, 0x21, 0x46, 0x2a, 0x0c, 0x3a, 0xa4, 0x4e, 0x8f, 0xba, 0x3e, 0xd6,
-	0x2d, 0xf6, 0xb5, 0x63, 0x56, 0x82, 0x05, 0x04, 0xbd, 0x0f, 0x26, 0x74, 0x87, 0xd4, 0x88, 0xe5,
-	0x19, 0x9a, 0xe9, 0xd2, 0xcd, 0x39, 0xd0, 0xfd, 0xe6, 0x64, 0x9d, 0x28, 0x45, 0x9a, 0xe3, 0x18,
-	0x3a, 0xf5, 0x8b, 0x05, 0x18, 0xe3, 0x13, 0xb5, 0xd0, 0xd2, 0x6f, 0x13, 0x0f, 0x7d, 0x07, 0x0c,
-	0x53, 0xf9, 0xae, 0xa6, 0x79, 0x9a, 0xf8, 0x10, 0xbe, 0x25, 0x73, 0xe8, 0xec, 0x1b, 0xa4, 0xb5,
-	0x43, 0xea, 0x2b, 0xc4, 0xd3, 0xc2, 0xfd, 0x17, 0x96, 0xe1, 0x00, 0x2b, 0xda, 0x82, 0x7e, 0xb7,
-	0x49, 0x74, 0x31, 0xb1, 0xe5, 0x3c, 0x9f, 0xba, 0xdc, 0xe3, 0x6a, 0x93, 0xe8, 0xe1, 0x76, 0xa5,
-	0xbf, 0x30, 0xc3, 0x8f, 0x2c, 0x18, 0x74, 0x3d, 0xcd, 0x6b, 0xb9, 0x6c, 0x7e, 0x47, 0x1f, 0x5a,
-	0xea, 0x99, 0x12, 0xc3, 0xb6, 0x30, 0x21, 0x68, 0x0d, 0xf2, 0xdf, 0x58, 0x50, 0x51, 0xff, 0xbd,
-	0x02, 0x93, 0x72, 0xf5, 0x65, 0xc3, 0xf5, 0xd0, 0xb7, 0x25, 0xa6, 0x73, 0xae, 0xbb, 0xe9, 0xa4,
-	0xad, 0xd9, 0x64, 0x06, 0xbb, 0xd5, 0x2f, 0x91, 0xa6, 0x92, 0xc0, 0x80, 0xe1, 0x91, 0x86, 0x2f,
-	0xa5, 0x3c, 0xd5, 0xeb, 0x08, 0x17, 0xc6, 0x05, 0xb1, 0x81, 0x0a, 0x45, 0x8b, 0x39, 0x76, 0xf5,
-	0x3b, 0x60, 0x5a, 0xae, 0xe5, 0xef, 0x6b, 0xca, 0x32, 0xbc, 0xbd, 0x66, 0x82, 0x65, 0xd0, 0x4f,
-	0x10, 0x33, 0x08, 0x7a, 0x4b, 0xb0, 0xc7, 0x39, 0x67, 0x0a, 0xe6, 0x2e, 0xba, 0xcf, 0xd5, 0x0f,
-	0xf5, 0x45, 0xe7, 0x8e, 0x2e, 0x23, 0xda, 0x89, 0x7d, 0xb9, 0xa3, 0x0f, 0xdd, 0xe8, 0x75, 0x80,
-	0x7e, 0xd7, 0x5f, 0x2b, 0x3c, 0xe0, 0x2a, 0x0c, 0xbb, 0x84, 0xd4, 0xa4, 0x73, 0x99, 0x9d, 0xe6,
-	0x55, 0x51, 0x86, 0x03, 0xe8, 0xf1, 0x73, 0x82, 0x57, 0xfb, 0x01, 0x25, 0x77, 0xbb, 0x3c, 0x19,
-	0xbc, 0x44, 0x2c, 0x45, 0x2f, 0x93, 0x21, 0x3e, 0x9c, 0x18, 0x62, 0x74, 0x17, 0xc6, 0x4d, 0xcd,
-	0xf5, 0x6e, 0x35, 0xa9, 0xde, 0xe6, 0xef, 0x99, 0xd1, 0x87, 0xe6, 0xf3, 0x2c, 0xfa, 0xb2, 0x8c,
-	0x68, 0x61, 0x6a, 0xbf, 0x5d, 0x1c, 0x8f, 0x14, 0xe1, 0x28, 0x29, 0xf4, 0x12, 0x8c, 0xd0, 0x82,
-	0x45, 0xc7, 0xb1, 0x1d, 0xc1, 0x2f, 0x1e, 0xcf, 0x4b, 0x97, 0x21, 0xe1, 0x7a, 0x64, 0xf0, 0x13,
-	0x87, 0xe8, 0xd1, 0x7b, 0x00, 0xd9, 0x9b, 0x4c, 0x93, 0xaf, 0x5d, 0xe7, 0x4a, 0x2a, 0x1d, 0x2c,
-	0x5d, 0xfe, 0xbe, 0x85, 0x59, 0xb1, 0x2f, 0xd1, 0xad, 0x44, 0x0d, 0x9c, 0xd2, 0x0a, 0xdd, 0x06,
-	0x14, 0x28, 0xba, 0xfc, 0xb4, 0x3f, 0x60, 0x6b, 0x04, 0x95, 0xc4, 0xd6, 0x38, 0x4b, 0x89, 0x5d,
-	0x4f, 0xa0, 0xc0, 0x29, 0x68, 0xd5, 0xdf, 0x2a, 0xc0, 0x28, 0xdf, 0x22, 0x5c, 0x19, 0x39, 0xfe,
-	0xb3, 0x82, 0x44, 0xce, 0x8a, 0x52, 0xfe, 0xcf, 0x9f, 0x75, 0x38, 0xf3, 0xa8, 0x68, 0xc4, 0x8e,
-	0x8a, 0xc5, 0x5e, 0x09, 0x75, 0x3e, 0x29, 0xfe, 0x58, 0x81, 0x53, 0x52, 0xed, 0x13, 0x38, 0x28,
-	0x6a, 0xd1, 0x83, 0xe2, 0xc9, 0x1e, 0xc7, 0x97, 0x71, 0x4e, 0xd8, 0x91, 0x61, 0x31, 0x1e, 0xfe,
-	0x10, 0xc0, 0x26, 0x63, 0x27, 0x92, 0x68, 0x1b, 0x2c, 0xf9, 0x42, 0x00, 0xc1, 0x52, 0xad, 0x08,
-	0x53, 0x2c, 0x74, 0x62, 0x8a, 0xea, 0x7f, 0xea, 0x83, 0xa9, 0xc4, 0xb4, 0x27, 0xf9, 0x88, 0xf2,
-	0x75, 0xe2, 0x23, 0x85, 0xaf, 0x07, 0x1f, 0xe9, 0xcb, 0xc5, 0x47, 0xba, 0x3f, 0x88, 0x1c, 0x40,
-	0x0d, 0xa3, 0xce, 0x9b, 0x55, 0x3d, 0xcd, 0xf1, 0xd6, 0x8d, 0x06, 0x11, 0x1c, 0xe7, 0x9b, 0xbb,
-	0xdb, 0xb2, 0xb4, 0x05, 0x67, 0x3c, 0x2b, 0x09, 0x4c, 0x38, 0x05, 0xbb, 0xfa, 0x5d, 0x05, 0x18,
-	0x5a, 0xd0, 0x5c, 0xd6, 0xd3, 0x0f, 0xc0, 0x98, 0x40, 0x5d, 0x69, 0x68, 0x75, 0xd2, 0x8b, 0x39,
-	0x42, 0xa0, 0x5c, 0x91, 0xd0, 0x71, 0x8d, 0x4e, 0x2e, 0xc1, 0x11, 0x72, 0x68, 0x0f, 0x46, 0x1b,
-	0xa1, 0xf6, 0x22, 0x96, 0x78, 0xa9, 0x77, 0xea, 0x14, 0x1b, 0x57, 0x5b, 0xa5, 0x02, 0x2c, 0xd3,
-	0x52, 0x5f, 0x84, 0xd3, 0x29, 0x3d, 0xee, 0x42, 0x71, 0x7b, 0x33, 0x0c, 0x51, 0xdd, 0x3b, 0x14,
-	0xc3, 0x46, 0xf7, 0xdb, 0xc5, 0xa1, 0x67, 0x78, 0x11, 0xf6, 0x61, 0xea, 0x3b, 0xa9, 0x00, 0x10,
-	0xef, 0x53, 0x37, 0x06, 0xbe, 0x01, 0x80, 0xd2, 0x3c, 0xb6, 0x3d, 0xbe, 0x95, 0x9e, 0x84, 0x81,
-	0xe6, 0xb6, 0xe6, 0xfa, 0x2d, 0xee, 0xf7, 0x59, 0xc5, 0x1a, 0x2d, 0xbc, 0xd7, 0x2e, 0xce, 0xc8,
-	0x82, 0x88, 0x68, 0xc4, 0x60, 0x98, 0xb7, 0xa3, 0x3b, 0x8c, 0x6e, 0xf2, 0x92, 0xdd, 0x68, 0x9a,
-	0x84, 0x42, 0xd9, 0x0e, 0x2b, 0xe4, 0xdb, 0x61, 0xcb, 0x09, 0x4c, 0x38, 0x05, 0xbb, 0x4f, 0xb3,
-	0x62, 0x19, 0x9e, 0xa1, 0x05, 0x34, 0xfb, 0xf2, 0xd3, 0x8c, 0x62, 0xc2, 0x29, 0xd8, 0xd1, 0x27,
-	0x14, 0x98, 0x8d, 0x16, 0x2f, 0x19, 0x96, 0xe1, 0x6e, 0x93, 0x1a, 0x23, 0xde, 0x7f, 0x68, 0xe2,
-	0x97, 0xf7, 0xdb, 0xc5, 0xd9, 0xe5, 0x4c, 0x8c, 0xb8, 0x03, 0x35, 0xf4, 0x7d, 0x0a, 0x5c, 0x88,
-	0xcd, 0x8b, 0x63, 0xd4, 0xeb, 0xc4, 0x11, 0xbd, 0x39, 0xfc, 0x07, 0x5e, 0xdc, 0x6f, 0x17, 0x2f,
-	0x2c, 0x67, 0xa3, 0xc4, 0x9d, 0xe8, 0xa1, 0x9f, 0x52, 0xe0, 0x6c, 0x93, 0x58, 0x35, 0xc3, 0xaa,
-	0x3f, 0x6b, 0x3b, 0xb7, 0x89, 0xe3, 0x62, 0xdb, 0x34, 0xed, 0x96, 0xe7, 0xce, 0x0c, 0xb2, 0x33,
-	0xac, 0x92, 0xe7, 0x9b, 0x5b, 0x4b, 0xc3, 0xb8, 0x70, 0x59, 0x6c, 0xd1, 0xb3, 0xa9, 0x60, 0x17,
-	0x67, 0x74, 0x44, 0xfd, 0x4d, 0x05, 0xfa, 0x4a, 0xb8, 0x82, 0x1e, 0x88, 0x7c, 0x22, 0xe7, 0xe4,
-	0x4f, 0xe4, 0x5e, 0xbb, 0x38, 0x54, 0xc2, 0x15, 0xe9, 0x63, 0xfc, 0x3e, 0x05, 0xa6, 0x74, 0xdb,
-	0xf2, 0x34, 0x3a, 0x77, 0x98, 0xcb, 0xca, 0xfe, 0xb9, 0x9c, 0x4b, 0x19, 0x2e, 0xc5, 0x90, 0x85,
-	0xc6, 0xee, 0x38, 0xc4, 0xc5, 0x49, 0xca, 0xea, 0xe7, 0x14, 0x98, 0x2e, 0x69, 0x4d, 0x61, 0x0a,
-	0x2a, 0x93, 0x2d, 0x83, 0x6e, 0x90, 0x6e, 0x2c, 0xfb, 0x68, 0x1b, 0x06, 0x99, 0xb5, 0xd9, 0xed,
-	0x45, 0x97, 0x0f, 0x69, 0x3f, 0xc3, 0x70, 0x71, 0x3b, 0x08, 0xff, 0x1f, 0x0b, 0xfc, 0xea, 0xe3,
-	0x30, 0x19, 0xaf, 0x87, 0x8a, 0xbe, 0x4c, 0xc3, 0x8d, 0x8f, 0x23, 0x71, 0x71, 0xe4, 0xb1, 0xe1,
-	0x7f, 0xf2, 0x6a, 0xf1, 0x0d, 0x1f, 0xfa, 0xb3, 0x2b, 0x6f, 0x50, 0xbf, 0xa2, 0xc0, 0x58, 0xc9,
-	0xb4, 0x5b, 0xb5, 0x35, 0xc7, 0xde, 0x32, 0x4c, 0xf2, 0xfa, 0xb0, 0x72, 0xc8, 0x3d, 0xce, 0x12,
-	0x5d, 0x99, 0xd5, 0x41, 0xae, 0xf8, 0x3a, 0xb1, 0x3a, 0xc8, 0x5d, 0xce, 0x90, 0x26, 0x5f, 0x80,
-	0x33, 0x72, 0xad, 0xd0, 0x64, 0x7a, 0x05, 0xfa, 0x6f, 0x1b, 0x56, 0x2d, 0xbe, 0x31, 0x6f, 0x1a,
-	0x56, 0x0d, 0x33, 0x48, 0xb0, 0x75, 0x0b, 0x99, 0x67, 0xd6, 0xdf, 0x8c, 0x44, 0xa7, 0x8d, 0x09,
-	0xab, 0x57, 0x61, 0x58, 0xd7, 0x16, 0x5a, 0x56, 0xcd, 0x0c, 0x76, 0x3d, 0x9d, 0x82, 0xd2, 0x3c,
-	0x2f, 0xc3, 0x01, 0x14, 0xdd, 0x05, 0x08, 0x6f, 0x27, 0x7a, 0x11, 0x02, 0xc2, 0x8b, 0x8f, 0x2a,
-	0xf1, 0x3c, 0xc3, 0xaa, 0xbb, 0xe1, 0xbe, 0x0a, 0x61, 0x58, 0xa2, 0x86, 0x3e, 0x00, 0xe3, 0xb2,
-	0x44, 0xc2, 0xcd, 0xa4, 0x39, 0x97, 0x21, 0x22, 0xfa, 0x9c, 0x11, 0x84, 0xc7, 0xe5, 0x52, 0x17,
-	0x47, 0xa9, 0xa1, 0xbd, 0x40, 0xfe, 0xe2, 0x46, 0xda, 0xfe, 0xfc, 0x1a, 0x85, 0x2c, 0xfa, 0x4c,
-	0x0b, 0xe2, 0x63, 0x11, 0xa3, 0x71, 0x84, 0x54, 0x8a, 0x61, 0x66, 0xe0, 0xb8, 0x0c, 0x33, 0x04,
-	0x86, 0xb8, 0x69, 0xca, 0x3f, 0x6e, 0x1e, 0xcb, 0x33, 0x40, 0x6e, 0xe5, 0x0a, 0xaf, 0xdb, 0xf8,
-	0x6f, 0x17, 0xfb, 0xb8, 0xd1, 0x0e, 0x8c, 0x51, 0xc1, 0xba, 0x4a, 0x4c, 0xa2, 0x7b, 0xb6, 0x33,
-	0x33, 0x94, 0xff, 0x3a, 0xab, 0x2a, 0xe1, 0xe1, 0x52, 0xac, 0x5c, 0x82, 0x23, 0x74, 0x02, 0xcb,
-	0xdd, 0x70, 0xa6, 0xe5, 0xae, 0x05, 0xa3, 0x3b, 0x92, 0x29, 0x7e, 0x84, 0x4d, 0xc2, 0x13, 0x79,
-	0x3a, 0x16, 0xda, 0xe5, 0x17, 0x4e, 0x0b, 0x42, 0xa3, 0xb2, 0x0d, 0x5f, 0xa6, 0x83, 0x36, 0x61,
-	0x68, 0x93, 0xcb, 0xa0, 0x33, 0xc0, 0xe6, 0xe2, 0xdd, 0x3d, 0x88, 0xd6, 0x5c, 0xce, 0x15, 0x3f,
-	0xb0, 0x8f, 0x18, 0xbd, 0x08, 0x83, 0xa6, 0xd1, 0x30, 0x3c, 0x77, 0x66, 0x94, 0x91, 0xc8, 0xb5,
-	0xb4, 0xcb, 0x0c, 0x03, 0x3f, 0xac, 0xf8, 0xff, 0x58, 0x60, 0x45, 0x9f, 0x52, 0xe0, 0xb4, 0xd8,
-	0x86, 0xc1, 0xa1, 0x65, 0x10, 0x77, 0x66, 0x8c, 0xcd, 0xe1, 0x8d, 0xde, 0x0e, 0xc9, 0xf0, 0x80,
-	0x5e, 0xb8, 0x20, 0x66, 0xf3, 0xf4, 0x4a, 0x92, 0x18, 0x4e, 0xeb, 0x81, 0xfa, 0x89, 0x29, 0x98,
-	0x2a, 0x99, 0x2d, 0xd7, 0x23, 0xce, 0xbc, 0xf0, 0x64, 0x21, 0x0e, 0xfa, 0x88, 0x02, 0x67, 0xd9,
-	0xbf, 0x65, 0xfb, 0x8e, 0x55, 0x26, 0xa6, 0xb6, 0x37, 0xbf, 0x45, 0x6b, 0xd4, 0x6a, 0x87, 0x3b,
-	0x3c, 0xca, 0x2d, 0xa1, 0x26, 0xb3, 0x1b, 0x9b, 0x6a, 0x2a, 0x46, 0x9c, 0x41, 0x09, 0x7d, 0xaf,
-	0x02, 0xe7, 0x53, 0x40, 0x65, 0x62, 0x12, 0xcf, 0x17, 0xfe, 0x0f, 0xdb, 0x8f, 0x4b, 0xfb, 0xed,
-	0xe2, 0xf9, 0x6a, 0x16, 0x52, 0x9c, 0x4d, 0x0f, 0x7d, 0xbf, 0x02, 0xb3, 0x29, 0xd0, 0x25, 0xcd,
-	0x30, 0x5b, 0x8e, 0xaf, 0x17, 0x1c, 0xb6, 0x3b, 0x4c, 0x3c, 0xaf, 0x66, 0x62, 0xc5, 0x1d, 0x28,
-	0xa2, 0x0f, 0xc2, 0x99, 0x00, 0xba, 0x61, 0x59, 0x84, 0xd4, 0x22, 0x5a, 0xc2, 0x61, 0xbb, 0x72,
-	0x7e, 0xbf, 0x5d, 0x3c, 0x53, 0x4d, 0x43, 0x88, 0xd3, 0xe9, 0xa0, 0x3a, 0x5c, 0x0a, 0x01, 0x9e,
-	0x61, 0x1a, 0x77, 0xb9, 0x22, 0xb3, 0xed, 0x10, 0x77, 0xdb, 0x36, 0x6b, 0x8c, 0x15, 0x2b, 0x0b,
-	0x6f, 0xdc, 0x6f, 0x17, 0x2f, 0x55, 0x3b, 0x55, 0xc4, 0x9d, 0xf1, 0xa0, 0x1a, 0x8c, 0xb9, 0xba,
-	0x66, 0x55, 0x2c, 0x8f, 0x38, 0x3b, 0x9a, 0x39, 0x33, 0x98, 0x6b, 0x80, 0x9c, 0x01, 0x4a, 0x78,
-	0x70, 0x04, 0x2b, 0x7a, 0x17, 0x0c, 0x93, 0xdd, 0xa6, 0x66, 0xd5, 0x08, 0x67, 0xba, 0x23, 0x0b,
-	0x17, 0xe9, 0x51, 0xbf, 0x28, 0xca, 0xee, 0xb5, 0x8b, 0x63, 0xfe, 0xff, 0x2b, 0x76, 0x8d, 0xe0,
-	0xa0, 0x36, 0x7a, 0x3f, 0x4c, 0x33, 0x57, 0x9b, 0x1a, 0x61, 0x47, 0x88, 0xeb, 0xeb, 0x8a, 0xc3,
-	0xb9, 0xfa, 0xc9, 0xae, 0xe1, 0x57, 0x52, 0xf0, 0xe1, 0x54, 0x2a, 0x74, 0x19, 0x1a, 0xda, 0xee,
-	0x75, 0x47, 0xd3, 0xc9, 0x56, 0xcb, 0x5c, 0x27, 0x4e, 0xc3, 0xb0, 0xb8, 0xb1, 0x84, 0xe8, 0xb6,
-	0x55, 0xa3, 0x8c, 0x5a, 0xb9, 0x3a, 0xc0, 0x97, 0x61, 0xa5, 0x53, 0x45, 0xdc, 0x19, 0x0f, 0x7a,
-	0x18, 0xc6, 0x8c, 0xba, 0x65, 0x3b, 0x64, 0x5d, 0x33, 0x2c, 0xcf, 0x9d, 0x01, 0x26, 0x64, 0xb3,
-	0x69, 0xad, 0x48, 0xe5, 0x38, 0x52, 0x0b, 0xed, 0x00, 0xb2, 0xc8, 0x9d, 0x35, 0xbb, 0xc6, 0xb6,
-	0xc0, 0x46, 0x93, 0x6d, 0x64, 0xc1, 0x66, 0x0f, 0x3b, 0x35, 0x4c, 0x95, 0x5e, 0x4d, 0x60, 0xc3,
-	0x29, 0x14, 0xd0, 0x12, 0xa0, 0x86, 0xb6, 0xbb, 0xd8, 0x68, 0x7a, 0x7b, 0x0b, 0x2d, 0xf3, 0xb6,
-	0xe0, 0x1a, 0x63, 0x6c, 0x2e, 0xb8, 0xa1, 0x29, 0x01, 0xc5, 0x29, 0x2d, 0x90, 0x06, 0x17, 0xf8,
-	0x78, 0xca, 0x1a, 0x69, 0xd8, 0x96, 0x4b, 0x3c, 0x57, 0xda, 0xa4, 0x33, 0xe3, 0xcc, 0xe1, 0x82,
-	0x29, 0xb6, 0x95, 0xec, 0x6a, 0xb8, 0x13, 0x8e, 0xa8, 0xcb, 0xd9, 0xc4, 0x01, 0x2e, 0x67, 0x8f,
-	0xc0, 0xb8, 0xeb, 0x69, 0x8e, 0xd7, 0x6a, 0x8a, 0x65, 0x38, 0xc5, 0x96, 0x81, 0xd9, 0x21, 0xab,
-	0x32, 0x00, 0x47, 0xeb, 0xd1, 0xe5, 0xe3, 0xc6, 0x66, 0xd1, 0x6e, 0x32, 0x5c, 0xbe, 0xaa, 0x54,
-	0x8e, 0x23, 0xb5, 0xd0, 0x06, 0x9c, 0x6b, 0x68, 0xbb, 0xc1, 0xe7, 0xbb, 0xa6, 0x39, 0x9a, 0x69,
-	0x12, 0xd3, 0x70, 0x1b, 0x33, 0x53, 0xac, 0xa7, 0xec, 0x86, 0x7f, 0x25, 0xbd, 0x0a, 0xce, 0x6a,
-	0x2b, 0x7c, 0xd0, 0xca, 0x8e, 0x66, 0x44, 0x50, 0xa2, 0x88, 0x0f, 0x5a, 0x1c, 0x8c, 0xd3, 0xda,
-	0xa0, 0x9f, 0x50, 0xa0, 0xc8, 0xce, 0x3f, 0xcd, 0xa4, 0x1f, 0xc7, 0x75, 0xc7, 0x6e, 0x35, 0x17,
-	0x34, 0xfd, 0xb6, 0xbd, 0xb5, 0xe5, 0x6f, 0x9c, 0x99, 0xd3, 0xb9, 0xb6, 0xdb, 0x7d, 0xfb, 0xed,
-	0x62, 0xb1, 0xd2, 0x19, 0x35, 0x3e, 0x88, 0x36, 0xfa, 0x41, 0x05, 0x2e, 0x88, 0x0f, 0x37, 0xb5,
-	0x6f, 0xd3, 0xb9, 0xfa, 0xc6, 0x76, 0xdc, 0x4a, 0x36, 0x5a, 0xdc, 0x89, 0x26, 0xfa, 0x11, 0x05,
-	0x2e, 0x5a, 0x31, 0x20, 0x26, 0x2e, 0x61, 0xb6, 0x55, 0xbb, 0xe5, 0xcd, 0x9c, 0xc9, 0xd5, 0xa9,
-	0x2b, 0xfb, 0xed, 0xe2, 0xc5, 0xd5, 0x0e, 0x78, 0x71, 0x47, 0xaa, 0xea, 0xff, 0xea, 0x87, 0x99,
-	0x84, 0x30, 0xe2, 0xfb, 0x04, 0x1e, 0x78, 0xdc, 0x28, 0x47, 0x74, 0xdc, 0x34, 0xe1, 0x4a, 0x50,
-	0xe1, 0x7a, 0xb3, 0x95, 0x4a, 0xab, 0xc0, 0x68, 0xbd, 0x69, 0xbf, 0x5d, 0xbc, 0x52, 0x3d, 0xa0,
-	0x2e, 0x3e, 0x10, 0x5b, 0xf6, 0x51, 0xde, 0x77, 0x42, 0x47, 0xf9, 0xfb, 0x61, 0x5a, 0x02, 0x38,
-	0x44, 0xab, 0xed, 0xf5, 0x20, 0x4a, 0xb0, 0x13, 0xac, 0x9a, 0x82, 0x0f, 0xa7, 0x52, 0xc9, 0x3c,
-	0x3f, 0x07, 0x4e, 0xe2, 0xfc, 0x54, 0xdb, 0x7d, 0x30, 0x52, 0xb2, 0xad, 0x1a, 0x37, 0x71, 0x3d,
-	0x18, 0x71, 0x60, 0xb8, 0x24, 0xab, 0x41, 0xf7, 0xda, 0xc5, 0xf1, 0xa0, 0xa2, 0xa4, 0x17, 0x3d,
-	0x1a, 0x5c, 0x15, 0x72, 0xe3, 0xc2, 0x1b, 0xa3, 0x77, 0x7c, 0xf7, 0xda, 0xc5, 0x53, 0x41, 0xb3,
-	0xe8, 0xb5, 0x1f, 0x3d, 0x1c, 0x4d, 0xcd, 0xf5, 0xd6, 0x1d, 0xcd, 0x72, 0x8d, 0x1e, 0x6c, 0xcc,
-	0xc1, 0xdd, 0xce, 0x72, 0x02, 0x1b, 0x4e, 0xa1, 0x80, 0x5e, 0x82, 0x09, 0x5a, 0xba, 0xd1, 0xac,
-	0x69, 0x1e, 0xc9, 0x69, 0x5a, 0x0e, 0xdc, 0xd1, 0x96, 0x23, 0x98, 0x70, 0x0c, 0x33, 0x77, 0xf8,
-	0xd0, 0x5c, 0xdb, 0x62, 0xeb, 0x19, 0x71, 0xf8, 0xa0, 0xa5, 0x58, 0x40, 0xd1, 0xfd, 0x30, 0xd4,
-	0x20, 0xae, 0xab, 0xd5, 0x09, 0x13, 0xf0, 0x46, 0x42, 0x1d, 0x79, 0x85, 0x17, 0x63, 0x1f, 0x8e,
-	0xde, 0x0a, 0x03, 0xba, 0x5d, 0x23, 0xee, 0xcc, 0x10, 0x3b, 0xc3, 0xe8, 0x71, 0x3e, 0x50, 0xa2,
-	0x05, 0xf7, 0xda, 0xc5, 0x11, 0x76, 0x13, 0x46, 0x7f, 0x61, 0x5e, 0x49, 0xfd, 0x49, 0x05, 0x26,
-	0xe3, 0x76, 0xcf, 0x2e, 0x1c, 0x55, 0x4e, 0xce, 0xe7, 0x43, 0xfd, 0x94, 0x02, 0x63, 0xb4, 0x87,
-	0x8e, 0x6d, 0xae, 0x99, 0x9a, 0x45, 0xd0, 0xf7, 0x28, 0x30, 0xb9, 0x6d, 0xd4, 0xb7, 0x65, 0x97,
-	0x3c, 0xa1, 0x79, 0xe5, 0xb2, 0x1b, 0xde, 0x88, 0xe1, 0x5a, 0x98, 0xde, 0x6f, 0x17, 0x27, 0xe3,
-	0xa5, 0x38, 0x41, 0x53, 0xfd, 0xcb, 0x02, 0x9c, 0x93, 0x7b, 0x36, 0x1f, 0xbe, 0x76, 0x40, 0x7f,
-	0xac, 0x00, 0x34, 0x0c, 0x6b, 0xde, 0x34, 0xed, 0x3b, 0xcc, 0x8f, 0x98, 0xea, 0xb2, 0x2f, 0xe4,
-	0xb5, 0x57, 0xa7, 0x50, 0x98, 0x5b, 0x09, 0xb0, 0xf3, 0x3b, 0xe6, 0xe7, 0x7c, 0x3b, 0x58, 0x08,
-	0xb8, 0xd7, 0x2e, 0x16, 0x93, 0x4f, 0x2c, 0xe6, 0xb0, 0x78, 0xc7, 0xb0, 0x6c, 0xb8, 0xde, 0x47,
-	0xfe, 0xa2, 0x63, 0x15, 0x7e, 0xd9, 0x1c, 0x0e, 0x64, 0xb6, 0x01, 0xa7, 0x62, 0x84, 0x53, 0x7c,
-	0xac, 0xcb, 0xb2, 0x8f, 0xf5, 0x01, 0x4c, 0x6a, 0xce, 0x7f, 0x55, 0x31, 0xf7, 0x74, 0x4b, 0xb3,
-	0x3c, 0x3a, 0xd3, 0x92, 0x4f, 0xf6, 0x9f, 0x17, 0x60, 0x5a, 0x4c, 0x80, 0x49, 0xb5, 0xcd, 0xa6,
-	0x69, 0xef, 0x35, 0x88, 0x75, 0x12, 0x7e, 0x77, 0xfe, 0x47, 0x50, 0xc8, 0xfc, 0x08, 0x1a, 0x89,
-	0x8f, 0xa0, 0x2f, 0xcf, 0x47, 0x10, 0xf0, 0x8a, 0x03, 0x6c, 0x6c, 0x18, 0xce, 0x1a, 0x16, 0xed,
-	0xe8, 0x75, 0xb6, 0x61, 0x42, 0xef, 0x58, 0xc6, 0x9f, 0x86, 0xb9, 0x19, 0xa1, 0x92, 0x5a, 0x03,
-	0x67, 0xb4, 0x54, 0xff, 0x5a, 0x81, 0x99, 0xb4, 0xf9, 0x3d, 0x01, 0xb3, 0x78, 0x23, 0x6a, 0x16,
-	0xbf, 0xd1, 0xc3, 0xb7, 0x11, 0xe9, 0x7a, 0x86, 0x79, 0xfc, 0xaf, 0x0a, 0x70, 0x36, 0xac, 0x5e,
-	0xb1, 0x5c, 0x4f, 0x33, 0x4d, 0x2e, 0xf0, 0x1d, 0xff, 0x5e, 0x6a, 0x46, 0x6e, 0x37, 0x56, 0x7b,
-	0x1b, 0xaa, 0xdc, 0xf7, 0x4c, 0x17, 0x9d, 0xdd, 0x98, 0x8b, 0xce, 0xda, 0x11, 0xd2, 0xec, 0xec,
-	0xad, 0xf3, 0x5f, 0x14, 0x98, 0x4d, 0x6f, 0x78, 0x02, 0x9b, 0xca, 0x8e, 0x6e, 0xaa, 0xf7, 0x1c,
-	0xdd, 0xa8, 0x33, 0xb6, 0xd5, 0x2f, 0x14, 0xb2, 0x46, 0xcb, 0xae, 0x48, 0xb6, 0xe0, 0x94, 0x43,
-	0xea, 0x86, 0xeb, 0x09, 0xc5, 0xe3, 0x70, 0xee, 0xf2, 0xfe, 0xd5, 0xe8, 0x29, 0x1c, 0xc5, 0x81,
-	0xe3, 0x48, 0xd1, 0x2a, 0x0c, 0xb9, 0x84, 0xd4, 0x28, 0xfe, 0x42, 0xf7, 0xf8, 0x03, 0x21, 0xa2,
-	0xca, 0xdb, 0x62, 0x1f, 0x09, 0xfa, 0x36, 0x18, 0xaf, 0x05, 0x5f, 0x14, 0xc5, 0xda, 0xd7, 0x3d,
-	0x56, 0xa6, 0x6d, 0x97, 0xe5, 0xd6, 0x38, 0x8a, 0x4c, 0xfd, 0x3b, 0x05, 0x2e, 0x76, 0xda, 0x5b,
-	0xe8, 0x65, 0x00, 0xdd, 0x97, 0x0a, 0x5d, 0x71, 0x78, 0x3e, 0x9e, 0x73, 0x2d, 0x39, 0x96, 0xf0,
-	0x03, 0x0d, 0x8a, 0x5c, 0x2c, 0x11, 0x49, 0x71, 0xdc, 0x2c, 0x1c, 0x93, 0xe3, 0xa6, 0xfa, 0x5f,
-	0x15, 0x99, 0x15, 0xc9, 0x6b, 0xfb, 0x7a, 0x63, 0x45, 0x72, 0xdf, 0x33, 0xaf, 0x5c, 0xbf, 0x5c,
-	0x80, 0x2b, 0xe9, 0x4d, 0xa4, 0xf3, 0xfc, 0x29, 0x18, 0x6c, 0xf2, 0x27, 0x2d, 0xdc, 0xbb, 0xff,
-	0x2a, 0xe5, 0x2c, 0xfc, 0xc1, 0xc9, 0xbd, 0x76, 0x71, 0x36, 0x8d, 0xd1, 0x8b, 0xa7, 0x2a, 0xa2,
-	0x1d, 0x32, 0x62, 0x77, 0x43, 0x5c, 0x68, 0x7f, 0x7b, 0x97, 0xcc, 0x45, 0xdb, 0x24, 0x66, 0xd7,
-	0xd7, 0x41, 0x1f, 0x56, 0x60, 0x22, 0xb2, 0xa3, 0xdd, 0x99, 0x01, 0xb6, 0x47, 0x73, 0xf9, 0xcc,
-	0x45, 0x3e, 0x95, 0x50, 0x1a, 0x88, 0x14, 0xbb, 0x38, 0x46, 0x30, 0xc6, 0x66, 0xe5, 0x59, 0x7d,
-	0xdd, 0xb1, 0x59, 0xb9, 0xf3, 0x19, 0x6c, 0xf6, 0xc7, 0x0b, 0x59, 0xa3, 0x65, 0x6c, 0xf6, 0x0e,
-	0x8c, 0xf8, 0x62, 0xa4, 0xcf, 0x2e, 0x96, 0x7a, 0xed, 0x13, 0x47, 0xb7, 0x30, 0x25, 0xfa, 0x33,
-	0xe2, 0x97, 0xb8, 0x38, 0xa4, 0x85, 0xbe, 0x5b, 0x01, 0x08, 0x17, 0x46, 0x7c, 0x54, 0xeb, 0x47,
-	0x37, 0x1d, 0x92, 0x58, 0x33, 0x41, 0x3f, 0x69, 0x69, 0x53, 0x48, 0x74, 0xd5, 0x7f, 0xd7, 0x0f,
-	0x28, 0xd9, 0xf7, 0xee, 0x6e, 0xfe, 0x0f, 0x10, 0x72, 0x9b, 0x30, 0xe9, 0x50, 0x69, 0x51, 0x37,
-	0x4c, 0xe2, 0x1b, 0xc0, 0xf2, 0x59, 0x3e, 0x98, 0x5a, 0x85, 0x63, 0xb8, 0x70, 0x02, 0x3b, 0x7a,
-	0x33, 0x0c, 0x35, 0x1d, 0xa3, 0xa1, 0x39, 0x7b, 0x4c, 0x29, 0x1e, 0xe6, 0xd7, 0x92, 0x6b, 0xbc,
-	0x08, 0xfb, 0x30, 0xf4, 0x7e, 0x18, 0x31, 0x8d, 0x2d, 0xa2, 0xef, 0xe9, 0x26, 0x11, 0xb7, 0x1e,
-	0xb7, 0x8e, 0x66, 0xcd, 0x97, 0x7d, 0xb4, 0xc2, 0x99, 0xd4, 0xff, 0x89, 0x43, 0x82, 0xa8, 0x02,
-	0xa7, 0xef, 0x30, 0xf7, 0x26, 0x93, 0xb8, 0x6e, 0xb5, 0xd5, 0x6c, 0xda, 0x8e, 0x47, 0x6a, 0xec,
-	0x6e, 0x64, 0x98, 0xdb, 0x68, 0x9f, 0x4d, 0x82, 0x71, 0x5a, 0x1b, 0xf4, 0x38, 0x80, 0xd6, 0xf2,
-	0x6c, 0xfe, 0xb6, 0x74, 0x66, 0x98, 0x69, 0xed, 0x97, 0xe8, 0x62, 0xcf, 0x07, 0xa5, 0xf7, 0xda,
-	0xc5, 0x51, 0x61, 0xfb, 0x63, 0x4b, 0x23, 0x35, 0x40, 0x2f, 0xc0, 0xb4, 0xce, 0x41, 0x25, 0xbb,
-	0xd1, 0xd4, 0x3c, 0x43, 0x68, 0xc4, 0x23, 0x0c, 0xd1, 0x37, 0xed, 0xb7, 0x8b, 0xd3, 0xa5, 0x14,
-	0x78, 0x1c, 0x65, 0x2a, 0x12, 0xf5, 0x13, 0x05, 0xb8, 0xd0, 0x61, 0x82, 0x10, 0xa6, 0x1f, 0x9e,
-	0x58, 0x3f, 0xb1, 0xcd, 0x1e, 0xe6, 0x1f, 0x8b, 0x28, 0xbc, 0xd7, 0x2e, 0xde, 0xd7, 0x01, 0x41,
-	0x95, 0xee, 0x73, 0x52, 0xdf, 0xc3, 0x21, 0x1a, 0x54, 0x81, 0xc1, 0x5a, 0x78, 0x8d, 0x39, 0xb2,
-	0xf0, 0x20, 0x3d, 0x0a, 0xf8, 0x85, 0x43, 0xb7, 0xd8, 0x04, 0x02, 0xb4, 0x0c, 0x43, 0xdc, 0x3d,
-	0x96, 0x88, 0x63, 0xe5, 0x21, 0x66, 0x32, 0xe1, 0x45, 0xdd, 0x22, 0xf3, 0x51, 0xa8, 0x7f, 0xab,
-	0xc0, 0x50, 0xc9, 0x76, 0x48, 0x79, 0xb5, 0x8a, 0xf6, 0x60, 0x54, 0x0a, 0x6e, 0x20, 0x58, 0x6c,
-	0x4e, 0x9e, 0xc3, 0x30, 0x4a, 0xaa, 0xbd, 0xff, 0x1c, 0x33, 0x28, 0xc0, 0x32, 0x2d, 0xf4, 0x32,
-	0x9d, 0xf3, 0x3b, 0x8e, 0xe1, 0x51, 0xc2, 0xbd, 0xf8, 0x4b, 0x71, 0xc2, 0xd8, 0xc7, 0xc5, 0x77,
-	0x7b, 0xf0, 0x13, 0x87, 0x54, 0xd4, 0x35, 0xca, 0x5e, 0xe2, 0xdd, 0x44, 0x8f, 0x41, 0x7f, 0xc3,
-	0xae, 0xf9, 0xeb, 0xfe, 0x16, 0x9f, 0x79, 0xac, 0xd8, 0x35, 0x3a, 0xb7, 0x67, 0x93, 0x2d, 0xd8,
-	0xd5, 0x20, 0x6b, 0xa3, 0xae, 0xc2, 0x64, 0x9c, 0x3e, 0x7a, 0x0c, 0x26, 0x74, 0xbb, 0xd1, 0xb0,
-	0xad, 0x6a, 0x6b, 0x6b, 0xcb, 0xd8, 0x25, 0x91, 0x77, 0xb2, 0xa5, 0x08, 0x04, 0xc7, 0x6a, 0xaa,
-	0x9f, 0x55, 0xa0, 0x8f, 0xae, 0x8b, 0x0a, 0x83, 0x35, 0xbb, 0xa1, 0x19, 0x96, 0xe8, 0x15, 0x73,
-	0x38, 0x28, 0xb3, 0x12, 0x2c, 0x20, 0xa8, 0x09, 0x23, 0xbe, 0x44, 0xd6, 0x93, 0x87, 0x7f, 0x79,
-	0xb5, 0x1a, 0x3c, 0x90, 0x0a, 0x8e, 0x09, 0xbf, 0xc4, 0xc5, 0x21, 0x11, 0x55, 0x83, 0xa9, 0xf2,
-	0x6a, 0xb5, 0x62, 0xe9, 0x66, 0xab, 0x46, 0x16, 0x77, 0xd9, 0x1f, 0xca, 0xe7, 0x0c, 0x5e, 0x22,
-	0xc6, 0xc9, 0xf8, 0x9c, 0xa8, 0x84, 0x7d, 0x18, 0xad, 0x46, 0x78, 0x0b, 0xf1, 0x6c, 0x94, 0x55,
-	0x13, 0x48, 0xb0, 0x0f, 0x53, 0xbf, 0x52, 0x80, 0x51, 0xa9, 0x43, 0xc8, 0x84, 0x21, 0x3e, 0x5c,
-	0xb7, 0x97, 0xd0, 0x00, 0x89, 0x5e, 0x73, 0xea, 0x7c, 0x42, 0x5d, 0xec, 0x93, 0x90, 0x79, 0x76,
-	0xa1, 0x03, 0xcf, 0x9e, 0x8b, 0xbc, 0xbe, 0xe5, 0x9f, 0xe4, 0x44, 0xf6, 0xcb, 0x5b, 0x74, 0x51,
-	0x1c, 0x4f, 0xdc, 0xc5, 0x7e, 0x38, 0x76, 0x34, 0x6d, 0xc1, 0xc0, 0x5d, 0xdb, 0x22, 0xae, 0xb0,
-	0x85, 0x1f, 0xd1, 0x00, 0x99, 0x63, 0xe4, 0xf3, 0x14, 0x2f, 0xe6, 0xe8, 0xd5, 0x9f, 0x52, 0x00,
-	0xca, 0x9a, 0xa7, 0x71, 0x2f, 0x9c, 0x2e, 0x1c, 0x3d, 0x2f, 0x46, 0x4e, 0xd5, 0xe1, 0xc4, 0x23,
-	0xbf, 0x7e, 0xd7, 0xb8, 0xeb, 0x0f, 0x3f, 0x90, 0xd6, 0x39, 0xf6, 0xaa, 0x71, 0x97, 0x60, 0x06,
-	0x47, 0x0f, 0xc0, 0x08, 0xb1, 0x74, 0x67, 0xaf, 0x49, 0x0f, 0x16, 0x6e, 0xe2, 0x61, 0x5f, 0xe8,
-	0xa2, 0x5f, 0x88, 0x43, 0xb8, 0xfa, 0x20, 0x44, 0x55, 0xae, 0x2e, 0xfc, 0xd0, 0xff, 0x5e, 0x81,
-	0x73, 0xe5, 0x96, 0x66, 0xce, 0x37, 0xe9, 0x46, 0xd5, 0xcc, 0x25, 0x9b, 0xbb, 0x73, 0x50, 0x3d,
-	0xe4, 0xad, 0x30, 0xec, 0x0b, 0x39, 0xf1, 0x57, 0xc0, 0x3e, 0xa3, 0xc4, 0x41, 0x0d, 0xa4, 0xc1,
-	0xb0, 0xeb, 0x8b, 0xdd, 0x85, 0x1e, 0xc4, 0x6e, 0x9f, 0x44, 0x20, 0x76, 0x07, 0x68, 0xb9, 0xf1,
-	0x8b, 0x2d, 0x50, 0x95, 0x38, 0x3b, 0x86, 0x4e, 0xe6, 0x75, 0xdd, 0x6e, 0x59, 0x9e, 0x2b, 0x5e,
-	0xf5, 0x0b, 0xe3, 0x57, 0x5a, 0x0d, 0x9c, 0xd1, 0x52, 0xfd, 0xa2, 0x02, 0xfd, 0x8b, 0xeb, 0xa5,
-	0x32, 0xfa, 0x36, 0xe8, 0x0f, 0x58, 0x46, 0x4e, 0xaf, 0x2d, 0x8a, 0x87, 0xdb, 0xe9, 0xf8, 0x7a,
-	0xaf, 0x50, 0x86, 0xc3, 0xb0, 0xa2, 0x4d, 0x18, 0x24, 0x3b, 0x84, 0x76, 0xb5, 0x70, 0x24, 0xf8,
-	0x19, 0x4b, 0x5b, 0x64, 0x18, 0xb1, 0xc0, 0xac, 0x7e, 0x52, 0x01, 0x08, 0xab, 0xa0, 0xef, 0x4c,
-	0x3b, 0x9d, 0x6e, 0x1e, 0xa1, 0xf5, 0xb9, 0xf3, 0x11, 0xa5, 0xfe, 0xfa, 0x00, 0x9c, 0xa7, 0xdd,
-	0x11, 0x5b, 0xd5, 0xb0, 0xad, 0x9b, 0x64, 0xef, 0x1b, 0x2f, 0x1e, 0xbe, 0xf1, 0xe2, 0xe1, 0x08,
-	0x5f, 0x3c, 0xbc, 0x00, 0xe7, 0xe9, 0x96, 0x13, 0x60, 0xc2, 0xdc, 0xbf, 0xd6, 0x1c, 0xd2, 0xd4,
-	0x1c, 0x52, 0x63, 0xfa, 0xc0, 0x30, 0x77, 0x68, 0x9b, 0xcf, 0xaa, 0x84, 0xb3, 0xdb, 0xab, 0x4f,
-	0xc2, 0x64, 0xb8, 0x77, 0xe7, 0x3d, 0x4c, 0x5c, 0x8f, 0xf2, 0xe3, 0xa8, 0x92, 0x39, 0xe2, 0x4b,
-	0x4c, 0x49, 0xc5, 0x30, 0x8a, 0x40, 0x7c, 0x95, 0x87, 0x42, 0x70, 0x4f, 0x81, 0xc9, 0xc5, 0xdd,
-	0xa6, 0xe1, 0xb0, 0x58, 0x0d, 0xfc, 0xc9, 0x11, 0xba, 0x3f, 0x7c, 0x99, 0xa4, 0x44, 0xaf, 0x01,
-	0xe3, 0xaf, 0x93, 0xd0, 0x16, 0x4c, 0x10, 0xd6, 0x9c, 0xa9, 0x91, 0x9a, 0x97, 0xe7, 0xfb, 0xe0,
-	0x01, 0x4a, 0x22, 0x58, 0x70, 0x0c, 0x2b, 0xaa, 0xc2, 0x84, 0x6e, 0x6a, 0xae, 0x6b, 0x6c, 0x19,
-	0x7a, 0xf8, 0xa2, 0x6e, 0x64, 0xe1, 0x01, 0x26, 0xb4, 0x45, 0x20, 0xf7, 0xda, 0xc5, 0x33, 0xa2,
-	0x9f, 0x51, 0x00, 0x8e, 0xa1, 0x50, 0x3f, 0x5d, 0x80, 0xf1, 0xc5, 0xdd, 0xa6, 0xed, 0xb6, 0x1c,
-	0xc2, 0xaa, 0x9e, 0x80, 0x61, 0xec, 0x7e, 0x18, 0xda, 0xd6, 0xac, 0x9a, 0x49, 0x1c, 0x71, 0x6e,
-	0x07, 0x73, 0x7b, 0x83, 0x17, 0x63, 0x1f, 0x8e, 0x5e, 0x01, 0x70, 0xf5, 0x6d, 0x52, 0x6b, 0x31,
-	0xee, 0xda, 0x97, 0x9f, 0xbb, 0x46, 0xc6, 0x58, 0x0d, 0x50, 0x0a, 0x99, 0x28, 0xf8, 0x8d, 0x25,
-	0x72, 0xea, 0x9f, 0x28, 0x30, 0x15, 0x69, 0x77, 0x02, 0xf6, 0x9e, 0xad, 0xa8, 0xbd, 0x67, 0xbe,
-	0xe7, 0xb1, 0x66, 0x98, 0x79, 0x3e, 0x56, 0x80, 0x73, 0x19, 0x73, 0x92, 0xf0, 0xfd, 0x56, 0x4e,
-	0xc8, 0xf7, 0xbb, 0x05, 0xa3, 0x9e, 0x6d, 0x8a, 0x87, 0x9f, 0xfe, 0x0c, 0xe4, 0x3a, 0xc3, 0xd7,
-	0x03, 0x34, 0xa1, 0x67, 0x77, 0x58, 0xe6, 0x62, 0x99, 0x8e, 0xfa, 0x9b, 0x0a, 0x8c, 0x04, 0x66,
-	0xe5, 0xd7, 0xd4, 0x8d, 0x7c, 0xf7, 0x31, 0x95, 0xd4, 0xdf, 0x2b, 0xc0, 0xd9, 0x00, 0xb7, 0xcf,
-	0xe6, 0xaa, 0x1e, 0xe5, 0x1b, 0x07, 0xdb, 0xa6, 0x2e, 0x46, 0x5e, 0xa5, 0x0c, 0x27, 0x1f, 0x69,
-	0x36, 0x5b, 0x4e, 0xd3, 0x76, 0x7d, 0x41, 0x9a, 0x6b, 0x1c, 0xbc, 0x08, 0xfb, 0x30, 0xb4, 0x0a,
-	0x03, 0x2e, 0xa5, 0x27, 0x0e, 0xcb, 0x43, 0xce, 0x06, 0xd3, 0x05, 0x58, 0x7f, 0x31, 0x47, 0x83,
-	0x5e, 0x91, 0x79, 0xf8, 0x40, 0x7e, 0xeb, 0x27, 0x1d, 0x49, 0x2d, 0x10, 0xa5, 0xc3, 0xbb, 0x93,
-	0x4e, 0xd6, 0x46, 0x75, 0x19, 0x26, 0x85, 0x83, 0x33, 0xdf, 0x36, 0x96, 0x4e, 0xd0, 0xbb, 0x22,
-	0x3b, 0xe3, 0x4d, 0x31, 0x9f, 0x9c, 0xe9, 0x78, 0xfd, 0x70, 0xc7, 0xa8, 0x2e, 0x0c, 0x5f, 0x17,
-	0x9d, 0x44, 0xb3, 0x50, 0x30, 0xfc, 0xb5, 0x00, 0x81, 0xa3, 0x50, 0x29, 0xe3, 0x82, 0xd1, 0xc5,
-	0xeb, 0x20, 0xf9, 0x58, 0xea, 0xeb, 0x7c, 0x2c, 0xa9, 0x5f, 0x2b, 0xc0, 0xb4, 0x4f, 0xd5, 0x1f,
-	0x63, 0x59, 0x5c, 0xb7, 0x1f, 0xa0, 0x55, 0x1d, 0x6c, 0xab, 0xbc, 0x05, 0xfd, 0x8c, 0x01, 0xe6,
-	0xba, 0x86, 0x0f, 0x10, 0xd2, 0xee, 0x60, 0x86, 0x08, 0xbd, 0x1f, 0x06, 0x4d, 0xaa, 0xa2, 0xf8,
-	0xcf, 0x76, 0x72, 0x59, 0x76, 0xd3, 0x86, 0xcb, 0x35, 0x1f, 0x11, 0xce, 0x2e, 0xb8, 0x49, 0xe5,
-	0x85, 0x58, 0xd0, 0x9c, 0x7d, 0x14, 0x46, 0xa5, 0x6a, 0x87, 0x8a, 0x65, 0xf7, 0xd9, 0x02, 0xcc,
-	0xdc, 0x20, 0x66, 0x23, 0xd5, 0x77, 0xa2, 0x08, 0x03, 0xfa, 0xb6, 0xe6, 0xf0, 0x30, 0x89, 0x63,
-	0x7c, 0x93, 0x97, 0x68, 0x01, 0xe6, 0xe5, 0x54, 0x63, 0x89, 0x3c, 0x54, 0x7c, 0x42, 0x9a, 0xc9,
-	0x30, 0x7e, 0xe6, 0xfb, 0x82, 0x00, 0x9b, 0xe1, 0xc0, 0x23, 0x15, 0xe8, 0xf1, 0xf2, 0x9e, 0xea,
-	0xad, 0xd5, 0xb4, 0x27, 0x8a, 0xe8, 0x2e, 0x8c, 0xdb, 0xba, 0x81, 0x49, 0xd3, 0x76, 0x0d, 0xcf,
-	0x76, 0xf6, 0xc4, 0xa2, 0xe5, 0x3a, 0x5a, 0x6e, 0x95, 0x2a, 0x21, 0x22, 0x7e, 0xff, 0x18, 0x29,
-	0xc2, 0x51, 0x52, 0xea, 0x17, 0x14, 0x18, 0xbd, 0x61, 0x6c, 0x12, 0x87, 0xfb, 0x70, 0x33, 0x13,
-	0x4b, 0x24, 0xe0, 0xdf, 0x68, 0x5a, 0xb0, 0x3f, 0xb4, 0x0b, 0x23, 0xe2, 0x1c, 0x0e, 0x5e, 0xa0,
-	0x5e, 0xcf, 0xe7, 0x70, 0x14, 0x90, 0x16, 0xe7, 0x9b, 0xf4, 0xe1, 0xfb, 0x25, 0x2e, 0x0e, 0x89,
-	0xa9, 0xaf, 0xc0, 0xe9, 0x94, 0x46, 0x74, 0x21, 0x99, 0x1b, 0xb3, 0xf8, 0x68, 0x7c, 0x6e, 0x45,
-	0x17, 0x92, 0x95, 0xa3, 0xf3, 0xd0, 0x47, 0xac, 0x9a, 0xf8, 0x62, 0x86, 0xf6, 0xdb, 0xc5, 0xbe,
-	0x45, 0xab, 0x86, 0x69, 0x19, 0x65, 0xe2, 0xa6, 0x1d, 0x91, 0xd8, 0x18, 0x13, 0x5f, 0x16, 0x65,
-	0x38, 0x80, 0x32, 0x17, 0xb1, 0xb8, 0x37, 0x14, 0x55, 0x4d, 0x26, 0xb7, 0x62, 0xbc, 0xa5, 0x17,
-	0x27, 0xac, 0x38, 0x9f, 0x5a, 0x98, 0x11, 0x13, 0x92, 0xe0, 0x78, 0x38, 0x41, 0x57, 0xfd, 0x95,
-	0x7e, 0xb8, 0x74, 0xc3, 0x76, 0x8c, 0xbb, 0xb6, 0xe5, 0x69, 0xe6, 0x9a, 0x5d, 0x0b, 0x1d, 0x64,
-	0xc5, 0x91, 0xf5, 0x51, 0x05, 0xce, 0xe9, 0xcd, 0x96, 0xf0, 0x57, 0x16, 0x3e, 0xa6, 0x6b, 0xc4,
-	0x31, 0xec, 0xbc, 0x8f, 0x76, 0x98, 0x6b, 0x77, 0x69, 0x6d, 0x23, 0x0d, 0x25, 0xce, 0xa2, 0xc5,
-	0xde, 0x0e, 0xd5, 0xec, 0x3b, 0x16, 0xeb, 0x5c, 0xd5, 0x63, 0xb3, 0x79, 0x37, 0x5c, 0x84, 0x9c,
-	0x6f, 0x87, 0xca, 0xa9, 0x18, 0x71, 0x06, 0x25, 0xf4, 0x41, 0x38, 0x23, 0xfc, 0xb2, 0x31, 0xd1,
-	0x6a, 0x86, 0x45, 0x5c, 0x97, 0x3f, 0x3c, 0xe8, 0xe1, 0x71, 0x4c, 0x25, 0x0d, 0x21, 0x4e, 0xa7,
-	0x83, 0x5e, 0x04, 0x70, 0xf7, 0x2c, 0x5d, 0xcc, 0x7f, 0x3e, 0x4f, 0x56, 0x2e, 0x22, 0x07, 0x58,
-	0xb0, 0x84, 0x91, 0x2a, 0x5a, 0x5e, 0xb0, 0x29, 0x07, 0x99, 0x37, 0x32, 0x53, 0xb4, 0xc2, 0x3d,
-	0x14, 0xc2, 0xd5, 0x4f, 0x2a, 0x30, 0x51, 0xb1, 0xd6, 0x4c, 0x4d, 0x27, 0xdc, 0x31, 0xd3, 0x45,
-	0xd7, 0x60, 0xc4, 0x0d, 0xae, 0x74, 0x38, 0x47, 0x08, 0xbf, 0xcf, 0xe0, 0x32, 0x27, 0xac, 0xc3,
-	0x3c, 0xf6, 0x0d, 0x4b, 0x1c, 0x76, 0x4b, 0xb6, 0xc3, 0x11, 0x89, 0xef, 0x8e, 0x7b, 0xec, 0x27,
-	0xc1, 0x38, 0xad, 0x8d, 0xfa, 0xf3, 0x0a, 0x4c, 0x47, 0xbb, 0x23, 0x7c, 0x22, 0x7e, 0x4c, 0x81,
-	0xe9, 0xc8, 0xc3, 0x7a, 0x01, 0xee, 0x25, 0xd6, 0xd7, 0x5a, 0x0a, 0x3e, 0xee, 0x23, 0x9c, 0x06,
-	0xc1, 0xa9, 0xf4, 0xd5, 0x7f, 0xa1, 0xc0, 0x90, 0x88, 0xfb, 0x89, 0xde, 0x12, 0x33, 0xbf, 0x07,
-	0x47, 0x5b, 0xcc, 0x04, 0xbf, 0xc7, 0x1c, 0x3c, 0xc4, 0xd1, 0x24, 0x4e, 0x99, 0x5c, 0xf6, 0x5b,
-	0x41, 0x38, 0x3c, 0xe7, 0x22, 0x8e, 0x1e, 0xfe, 0xdd, 0x8e, 0x44, 0x4c, 0x7d, 0x55, 0x81, 0xa9,
-	0x44, 0xab, 0x2e, 0xc4, 0xd1, 0x13, 0x74, 0x79, 0xfd, 0x72, 0x3f, 0xdd, 0x92, 0x1e, 0xe5, 0xf7,
-	0x26, 0xb7, 0x8c, 0x9f, 0x80, 0xfe, 0xfb, 0x00, 0x8c, 0x18, 0x8d, 0x46, 0xcb, 0x63, 0xb7, 0x90,
-	0x03, 0xa1, 0xb9, 0xb9, 0xe2, 0x17, 0xe2, 0x10, 0x8e, 0x2c, 0x21, 0x69, 0xf1, 0x53, 0x70, 0x39,
-	0xdf, 0xca, 0xc9, 0x03, 0x9c, 0xa3, 0x52, 0x11, 0x17, 0x87, 0xd2, 0x04, 0xb1, 0xef, 0x51, 0x00,
-	0x5c, 0xcf, 0x31, 0xac, 0x3a, 0x2d, 0x14, 0xd2, 0x18, 0x3e, 0x02, 0xb2, 0xd5, 0x00, 0x29, 0x27,
-	0x1e, 0xc6, 0x02, 0x0d, 0x00, 0x58, 0xa2, 0x8c, 0xe6, 0x85, 0x10, 0xca, 0x8f, 0xcc, 0xb7, 0xc5,
-	0xc4, 0xed, 0x4b, 0x29, 0xae, 0xb4, 0x9c, 0x50, 0x28, 0xa5, 0xce, 0x3e, 0x02, 0x23, 0x01, 0xbd,
-	0x83, 0x84, 0xba, 0x31, 0x49, 0xa8, 0x9b, 0x7d, 0x1c, 0x4e, 0xc5, 0xba, 0x7b, 0x28, 0x99, 0xf0,
-	0x4f, 0x15, 0x40, 0xd1, 0xd1, 0x9f, 0x80, 0xe5, 0xa0, 0x1e, 0xb5, 0x1c, 0x2c, 0xf4, 0xbe, 0x64,
-	0x19, 0xa6, 0x83, 0x9f, 0x45, 0xc0, 0xc2, 0x22, 0x07, 0x61, 0xc2, 0xc5, 0xc9, 0x4f, 0x05, 0x95,
-	0x30, 0x1a, 0x80, 0xf8, 0x72, 0x7b, 0x10, 0x54, 0x6e, 0xc6, 0x70, 0x85, 0x82, 0x4a, 0x1c, 0x82,
-	0x13, 0x74, 0xd1, 0xc7, 0x15, 0x98, 0xd4, 0xa2, 0x61, 0x91, 0xfd, 0x99, 0xc9, 0x15, 0xac, 0x2d,
-	0x16, 0x62, 0x39, 0xec, 0x4b, 0x0c, 0xe0, 0xe2, 0x04, 0x59, 0xf4, 0x30, 0x8c, 0x69, 0x4d, 0x63,
-	0xbe, 0x55, 0x33, 0xa8, 0xe6, 0xe9, 0x47, 0x8f, 0x65, 0xd6, 0x90, 0xf9, 0xb5, 0x4a, 0x50, 0x8e,
-	0x23, 0xb5, 0x82, 0xf8, 0xc3, 0xa5, 0xd0, 0xf3, 0xb8, 0x97, 0xf8, 0xc3, 0x62, 0x0e, 0xc3, 0xf8,
-	0xc3, 0x62, 0xea, 0x64, 0x22, 0xc8, 0x02, 0xb0, 0x8d, 0x9a, 0x2e, 0x48, 0x0e, 0xe6, 0xbf, 0x44,
-	0xb9, 0x55, 0x29, 0x97, 0x04, 0x45, 0x26, 0x3e, 0x84, 0xbf, 0xb1, 0x44, 0x01, 0x7d, 0x4a, 0x81,
-	0x71, 0xc1, 0xbb, 0x05, 0xcd, 0x21, 0xb6, 0x44, 0xcf, 0xe7, 0xdd, 0x2f, 0xb1, 0x3d, 0x39, 0x87,
-	0x65, 0xe4, 0x9c, 0xef, 0x04, 0xc1, 0x24, 0x22, 0x30, 0x1c, 0xed, 0x07, 0x93, 0x01, 0xdc, 0xc8,
-	0x2d, 0x96, 0xe8, 0xe0, 0x70, 0x7e, 0x19, 0xa0, 0x9a, 0x82, 0x4f, 0xbc, 0x52, 0x4a, 0x81, 0xe0,
-	0x54, 0xfa, 0x54, 0xae, 0x3d, 0x75, 0x47, 0xf3, 0xf4, 0xed, 0x92, 0xa6, 0x6f, 0xb3, 0x4b, 0x4c,
-	0xfe, 0xb4, 0x36, 0xe7, 0xbe, 0x7e, 0x36, 0x8a, 0x6a, 0xe1, 0xf4, 0x7e, 0xbb, 0x78, 0x2a, 0x56,
-	0x88, 0xe3, 0x04, 0x91, 0x0d, 0xc3, 0x8e, 0xc8, 0x0d, 0x20, 0xa2, 0x21, 0xe4, 0x0b, 0x87, 0x1f,
-	0x4f, 0x34, 0xc0, 0x35, 0x23, 0xff, 0x17, 0x0e, 0x88, 0xa0, 0x3a, 0x5c, 0xe2, 0xba, 0xe1, 0xbc,
-	0x65, 0x5b, 0x7b, 0x0d, 0xbb, 0xe5, 0xce, 0xb7, 0xbc, 0x6d, 0x62, 0x79, 0xbe, 0x29, 0x7c, 0x94,
-	0x1d, 0xa3, 0xec, 0xd5, 0xdd, 0x62, 0xa7, 0x8a, 0xb8, 0x33, 0x1e, 0xf4, 0x1c, 0x0c, 0xb3, 0x8b,
-	0xbe, 0xf5, 0xf5, 0x65, 0xf6, 0x4a, 0xf7, 0xf0, 0xe2, 0x32, 0x1b, 0xc2, 0xa2, 0xc0, 0x81, 0x03,
-	0x6c, 0xe8, 0x36, 0x0c, 0x99, 0x3c, 0xb9, 0x03, 0x7b, 0xad, 0x9b, 0x93, 0x29, 0xc6, 0x13, 0x45,
-	0x70, 0x05, 0x5a, 0xfc, 0xc0, 0x3e, 0x05, 0xd4, 0x84, 0x2b, 0x35, 0xb2, 0xa5, 0xb5, 0x4c, 0x6f,
-	0xd5, 0xf6, 0x30, 0x7b, 0xe2, 0x16, 0x58, 0x3c, 0xfd, 0x07, 0xd9, 0x13, 0x2c, 0x1e, 0x1f, 0x7b,
-	0x3c, 0x58, 0x3e, 0xa0, 0x2e, 0x3e, 0x10, 0x1b, 0xda, 0x83, 0xfb, 0x44, 0x1d, 0xf6, 0xa6, 0x4e,
-	0xdf, 0xa6, 0xb3, 0x9c, 0x24, 0x7a, 0x8a, 0x11, 0xfd, 0xa6, 0xfd, 0x76, 0xf1, 0xbe, 0xf2, 0xc1,
-	0xd5, 0x71, 0x37, 0x38, 0xd9, 0x33, 0x25, 0x12, 0xbb, 0x02, 0x9a, 0x99, 0xcc, 0x3f, 0xc7, 0xf1,
-	0xeb, 0x24, 0xee, 0x4f, 0x17, 0x2f, 0xc5, 0x09, 0x9a, 0xe8, 0xf3, 0x0a, 0xcc, 0xb8, 0x9e, 0xd3,
-	0xd2, 0xbd, 0x96, 0x43, 0x6a, 0xb1, 0x1d, 0x3a, 0xc5, 0x3a, 0x94, 0x4b, 0x80, 0xab, 0x66, 0xe0,
-	0x64, 0xa1, 0x01, 0x66, 0xb2, 0xa0, 0x38, 0xb3, 0x2f, 0xe8, 0x73, 0x0a, 0x9c, 0x8b, 0x02, 0xa9,
-	0x4e, 0xcf, 0xfb, 0x89, 0xf2, 0x5f, 0xb2, 0x54, 0xd3, 0x51, 0x72, 0x0d, 0x3e, 0x03, 0x88, 0xb3,
-	0x3a, 0x12, 0xbf, 0x5a, 0x3f, 0x7d, 0xc2, 0x57, 0xeb, 0xb3, 0x4f, 0x01, 0x4a, 0x1e, 0x1f, 0x07,
-	0xc9, 0x81, 0xc3, 0xb2, 0x1c, 0xf8, 0x99, 0x01, 0xb8, 0x40, 0x4f, 0xa5, 0x50, 0xfb, 0x59, 0xd1,
-	0x2c, 0xad, 0xfe, 0xda, 0x94, 0x98, 0xbe, 0xa0, 0xc0, 0xb9, 0xed, 0x74, 0xd3, 0x8e, 0xd0, 0xbf,
-	0x9e, 0xce, 0x65, 0x82, 0xeb, 0x64, 0x2d, 0xe2, 0x0c, 0xbb, 0x63, 0x15, 0x9c, 0xd5, 0x29, 0xf4,
-	0x14, 0x4c, 0x5a, 0x76, 0x8d, 0x94, 0x2a, 0x65, 0xbc, 0xa2, 0xb9, 0xb7, 0xab, 0xbe, 0xa7, 0xcf,
-	0x00, 0xff, 0x5e, 0x57, 0x63, 0x30, 0x9c, 0xa8, 0x8d, 0x76, 0x00, 0x35, 0xed, 0xda, 0xe2, 0x0e,
-	0x4f, 0x82, 0xd2, 0x9b, 0xcf, 0x2d, 0xf3, 0x36, 0x58, 0x4b, 0x60, 0xc3, 0x29, 0x14, 0x98, 0x6d,
-	0x8a, 0x76, 0x66, 0xc5, 0xb6, 0x0c, 0xcf, 0x76, 0x58, 0xb0, 0x8b, 0x9e, 0x4c, 0x34, 0xcc, 0x36,
-	0xb5, 0x9a, 0x8a, 0x11, 0x67, 0x50, 0x52, 0xff, 0xa7, 0x02, 0xa7, 0xe8, 0xb6, 0x58, 0x73, 0xec,
-	0xdd, 0xbd, 0xd7, 0xe2, 0x86, 0xbc, 0x5f, 0x38, 0x3d, 0x72, 0xdb, 0xce, 0x19, 0xc9, 0xe1, 0x71,
-	0x84, 0xf5, 0x39, 0xf4, 0x71, 0x94, 0xcd, 0xca, 0x7d, 0xd9, 0x66, 0x65, 0xf5, 0x53, 0x05, 0xae,
-	0xb9, 0xf8, 0x66, 0xdd, 0xd7, 0xe4, 0x77, 0xf8, 0x08, 0x8c, 0xd3, 0xb2, 0x15, 0x6d, 0x77, 0xad,
-	0xfc, 0x8c, 0x6d, 0xfa, 0xcf, 0xb9, 0x99, 0xad, 0xfd, 0xa6, 0x0c, 0xc0, 0xd1, 0x7a, 0xe8, 0x31,
-	0x18, 0x6a, 0xf2, 0x98, 0x71, 0x42, 0x67, 0xbe, 0xc2, 0x3d, 0x03, 0x59, 0xd1, 0xbd, 0x76, 0x71,
-	0x2a, 0xbc, 0xe2, 0xf5, 0x23, 0xd7, 0xf9, 0x0d, 0xd4, 0x5f, 0x39, 0x0b, 0x0c, 0xb9, 0x49, 0xbc,
-	0xd7, 0xe2, 0x9c, 0x3c, 0x08, 0xa3, 0x7a, 0xb3, 0x55, 0x5a, 0xaa, 0x3e, 0xdd, 0xb2, 0x99, 0x2d,
-	0x84, 0xe5, 0x0a, 0xa2, 0xdc, 0xbb, 0xb4, 0xb6, 0xe1, 0x17, 0x63, 0xb9, 0x0e, 0xe5, 0x0e, 0x7a,
-	0xb3, 0x25, 0xf8, 0xed, 0x9a, 0xfc, 0xe0, 0x85, 0x71, 0x87, 0xd2, 0xda, 0x46, 0x04, 0x86, 0x13,
-	0xb5, 0xd1, 0x07, 0x61, 0x8c, 0x88, 0x0f, 0xf7, 0x86, 0xe6, 0xd4, 0x04, 0x5f, 0xa8, 0xe4, 0x1d,
-	0x7c, 0x30, 0xb5, 0x3e, 0x37, 0xe0, 0x1a, 0xe0, 0xa2, 0x44, 0x02, 0x47, 0x08, 0xa2, 0x17, 0xe0,
-	0xbc, 0xff, 0x9b, 0xae, 0xb2, 0x5d, 0x8b, 0x33, 0x8a, 0x01, 0xee, 0x77, 0xb3, 0x98, 0x55, 0x09,
-	0x67, 0xb7, 0x47, 0x3f, 0xa7, 0xc0, 0xd9, 0x00, 0x6a, 0x58, 0x46, 0xa3, 0xd5, 0xc0, 0x44, 0x37,
-	0x35, 0xa3, 0x21, 0xf4, 0xbe, 0x67, 0x8f, 0x6c, 0xa0, 0x51, 0xf4, 0x9c, 0x59, 0xa5, 0xc3, 0x70,
-	0x46, 0x97, 0xd0, 0xab, 0x0a, 0x5c, 0xf1, 0x41, 0x6b, 0x0e, 0x71, 0xdd, 0x96, 0x43, 0xc2, 0x60,
-	0x02, 0x62, 0x4a, 0x86, 0x72, 0xf1, 0x4e, 0x26, 0x00, 0x2f, 0x1e, 0x80, 0x1b, 0x1f, 0x48, 0x5d,
-	0xde, 0x2e, 0x55, 0x7b, 0xcb, 0x13, 0x8a, 0xe2, 0x71, 0x6d, 0x17, 0x4a, 0x02, 0x47, 0x08, 0xa2,
-	0x9f, 0x57, 0xe0, 0x9c, 0x5c, 0x20, 0xef, 0x16, 0xae, 0x21, 0x3e, 0x77, 0x64, 0x9d, 0x89, 0xe1,
-	0xe7, 0x12, 0x5e, 0x06, 0x10, 0x67, 0xf5, 0x8a, 0xb2, 0xed, 0x06, 0xdb, 0x98, 0x5c, 0x8b, 0x1c,
-	0xe0, 0x6c, 0x9b, 0xef, 0x55, 0x17, 0xfb, 0x30, 0xf4, 0x30, 0x8c, 0x35, 0xed, 0xda, 0x9a, 0x51,
-	0x73, 0x59, 0x3c, 0x3b, 0xa6, 0xeb, 0xf5, 0xf1, 0xe9, 0x58, 0xb3, 0x6b, 0x6b, 0x95, 0x32, 0x2f,
-	0xc7, 0x91, 0x5a, 0x68, 0x0e, 0x60, 0x4b, 0x33, 0xcc, 0xea, 0x1d, 0xad, 0x79, 0xcb, 0x0f, 0x90,
-	0xc4, 0x6c, 0x11, 0x4b, 0x41, 0x29, 0x96, 0x6a, 0xd0, 0xf5, 0xa3, 0x7c, 0x07, 0x13, 0x1e, 0x82,
-	0x9c, 0xa9, 0x47, 0x47, 0xb1, 0x7e, 0x3e, 0x42, 0xde, 0xe1, 0x9b, 0x12, 0x09, 0x1c, 0x21, 0x88,
-	0x3e, 0xaa, 0xc0, 0x84, 0xbb, 0xe7, 0x7a, 0xa4, 0x11, 0xf4, 0xe1, 0xd4, 0x51, 0xf7, 0x81, 0xd9,
-	0xc4, 0xab, 0x11, 0x22, 0x38, 0x46, 0x94, 0x85, 0x9a, 0x6a, 0x68, 0x75, 0x72, 0xbd, 0x74, 0xc3,
-	0xa8, 0x6f, 0x07, 0xe1, 0x61, 0xd6, 0x88, 0xa3, 0x13, 0xcb, 0x63, 0x8a, 0xd5, 0x80, 0x08, 0x35,
-	0x95, 0x5d, 0x0d, 0x77, 0xc2, 0x81, 0x5e, 0x84, 0x59, 0x01, 0x5e, 0xb6, 0xef, 0x24, 0x28, 0xf0,
-	0x88, 0x4e, 0xcc, 0x83, 0xb2, 0x92, 0x59, 0x0b, 0x77, 0xc0, 0x80, 0x2a, 0x70, 0xda, 0x25, 0x0e,
-	0xbb, 0x13, 0xe4, 0xd1, 0x41, 0xd7, 0x5a, 0xa6, 0xe9, 0x32, 0xd5, 0x46, 0xbc, 0x19, 0xaa, 0x26,
-	0xc1, 0x38, 0xad, 0x0d, 0x7a, 0x3c, 0x78, 0x57, 0xbc, 0x47, 0x0b, 0x9e, 0x5e, 0xab, 0x32, 0x4d,
-	0x64, 0x80, 0x5b, 0x4a, 0x70, 0x14, 0x84, 0xe3, 0x75, 0xe9, 0x69, 0xee, 0x17, 0x2d, 0xb4, 0x1c,
-	0xd7, 0x63, 0x71, 0x96, 0x06, 0xf8, 0x69, 0x8e, 0x65, 0x00, 0x8e, 0xd6, 0x43, 0x8f, 0xc1, 0x84,
-	0x4b, 0x74, 0xdd, 0x6e, 0x34, 0x85, 0x9e, 0xcc, 0x82, 0x21, 0x0d, 0x8b, 0x15, 0x8c, 0x40, 0x70,
-	0xac, 0x26, 0xda, 0x83, 0xd3, 0x41, 0x38, 0xe5, 0x65, 0xbb, 0xbe, 0xa2, 0xed, 0x32, 0xe1, 0xf8,
-	0x6c, 0x9e, 0x18, 0x11, 0x7c, 0xba, 0x4a, 0x49, 0x74, 0x38, 0x8d, 0x06, 0x5a, 0x86, 0xe9, 0x58,
-	0xf1, 0x92, 0x61, 0x12, 0x77, 0xe6, 0x1c, 0x1b, 0x36, 0x33, 0x76, 0x95, 0x52, 0xe0, 0x38, 0xb5,
-	0x15, 0xba, 0x05, 0x67, 0x9a, 0x8e, 0xed, 0x11, 0xdd, 0xbb, 0x49, 0x05, 0x02, 0x53, 0x0c, 0xd0,
-	0x9d, 0x99, 0x61, 0x73, 0xc1, 0xee, 0x43, 0xd7, 0xd2, 0x2a, 0xe0, 0xf4, 0x76, 0xe8, 0x33, 0x0a,
-	0x5c, 0x76, 0x3d, 0x87, 0x68, 0x0d, 0xc3, 0xaa, 0x97, 0x6c, 0xcb, 0x22, 0x8c, 0x31, 0x55, 0x6a,
-	0xe1, 0x93, 0xbb, 0xf3, 0xb9, 0x4e, 0x11, 0x75, 0xbf, 0x5d, 0xbc, 0x5c, 0xed, 0x88, 0x19, 0x1f,
-	0x40, 0x19, 0xbd, 0x02, 0xd0, 0x20, 0x0d, 0xdb, 0xd9, 0xa3, 0x1c, 0x69, 0x66, 0x36, 0xbf, 0xbe,
-	0xbb, 0x12, 0x60, 0xe1, 0x9f, 0x7f, 0xe4, 0x26, 0x37, 0x04, 0x62, 0x89, 0x1c, 0x9d, 0x6a, 0xca,
-	0x6f, 0x45, 0x44, 0x33, 0xe9, 0xa3, 0xb9, 0xc0, 0x56, 0x8e, 0x4d, 0xf5, 0x4a, 0x5a, 0x05, 0x9c,
-	0xde, 0x0e, 0xb9, 0x30, 0xc5, 0xbe, 0x50, 0x71, 0x92, 0x5f, 0x2f, 0xcd, 0xd7, 0xc9, 0xcc, 0xc5,
-	0x5c, 0x93, 0x4b, 0x65, 0xff, 0xa9, 0x4a, 0x1c, 0x19, 0x4e, 0xe2, 0x0f, 0x89, 0x6a, 0xbb, 0x21,
-	0xd1, 0x4b, 0xbd, 0x12, 0x95, 0x90, 0xe1, 0x24, 0x7e, 0xb5, 0x5d, 0x80, 0x33, 0xa9, 0xa7, 0x24,
-	0x65, 0x1e, 0x7c, 0x8a, 0xe7, 0xfd, 0x5c, 0x70, 0xe2, 0xda, 0x93, 0x31, 0x8f, 0x95, 0x28, 0x08,
-	0xc7, 0xeb, 0x52, 0x19, 0x96, 0x51, 0x5b, 0xaa, 0x86, 0xed, 0x0b, 0xa1, 0x0c, 0x5b, 0x89, 0xc1,
-	0x70, 0xa2, 0x36, 0x2a, 0x89, 0xf9, 0x58, 0xaa, 0x56, 0xa8, 0x1a, 0xe8, 0x2e, 0x39, 0xc4, 0xd7,
-	0x0e, 0xc2, 0xf1, 0xc9, 0x40, 0x9c, 0xac, 0x4f, 0x47, 0x41, 0x7f, 0xc8, 0xbd, 0xe8, 0x0f, 0x47,
-	0xb1, 0x1a, 0x05, 0xe1, 0x78, 0x5d, 0x5f, 0x4f, 0x8f, 0x74, 0x61, 0x20, 0x1c, 0xc5, 0x6a, 0x0c,
-	0x86, 0x13, 0xb5, 0xd5, 0x3f, 0xeb, 0x87, 0xfb, 0xba, 0x90, 0x2c, 0x51, 0x23, 0x7d, 0xba, 0x0f,
-	0xcf, 0xf3, 0xba, 0x5b, 0x9e, 0x66, 0xc6, 0xf2, 0x1c, 0x9e, 0x5e, 0xb7, 0xcb, 0xe9, 0x66, 0x2d,
-	0xe7, 0xe1, 0x49, 0x76, 0xbf, 0xfc, 0x8d, 0xf4, 0xe5, 0xcf, 0x39, 0xab, 0x07, 0x6e, 0x97, 0x66,
-	0xc6, 0x76, 0xc9, 0x39, 0xab, 0x5d, 0x6c, 0xaf, 0x3f, 0xef, 0x87, 0x37, 0x75, 0x23, 0xe5, 0xe6,
-	0xdc, 0x5f, 0x29, 0xbc, 0xe5, 0x58, 0xf7, 0x57, 0xd6, 0x83, 0xf0, 0x63, 0xdc, 0x5f, 0x1d, 0xd9,
-	0xe7, 0xf1, 0xec, 0xaf, 0xac, 0x59, 0x3d, 0xae, 0xfd, 0x95, 0x35, 0xab, 0x5d, 0xec, 0xaf, 0xbf,
-	0x89, 0x9f, 0x0f, 0x81, 0xa8, 0x5d, 0x81, 0x3e, 0xbd, 0xd9, 0xca, 0xc9, 0xa4, 0x98, 0x97, 0x61,
-	0x69, 0x6d, 0x03, 0x53, 0x1c, 0x08, 0xc3, 0x20, 0xdf, 0x3f, 0x39, 0x59, 0x10, 0xf3, 0x1c, 0xe5,
-	0x5b, 0x12, 0x0b, 0x4c, 0x74, 0xaa, 0x48, 0x73, 0x9b, 0x34, 0x88, 0xa3, 0x99, 0x55, 0xcf, 0x76,
-	0xb4, 0x7a, 0x5e, 0x6e, 0xc3, 0x6f, 0x50, 0x62, 0xb8, 0x70, 0x02, 0x3b, 0x9d, 0x90, 0xa6, 0x51,
-	0xcb, 0xc9, 0x5f, 0xd8, 0x84, 0xac, 0x55, 0xca, 0x98, 0xe2, 0x50, 0x7f, 0x7d, 0x18, 0xa4, 0x44,
-	0x05, 0xe8, 0x13, 0x0a, 0x4c, 0xe9, 0xf1, 0xa0, 0x9e, 0xbd, 0xf8, 0x43, 0x25, 0x22, 0x84, 0xf2,
-	0x2d, 0x9f, 0x28, 0xc6, 0x49, 0xb2, 0xe8, 0x43, 0x0a, 0x37, 0xf2, 0x05, 0xb7, 0x79, 0x62, 0x5a,
-	0xaf, 0x1f, 0xd1, 0xbd, 0x77, 0x68, 0x2d, 0x0c, 0xaf, 0x58, 0xa3, 0x04, 0xd1, 0xab, 0x0a, 0x9c,
-	0xb9, 0x9d, 0x76, 0x37, 0x21, 0x26, 0xff, 0x56, 0xde, 0xae, 0x64, 0x5c, 0x76, 0x70, 0x09, 0x32,
-	0xb5, 0x02, 0x4e, 0xef, 0x48, 0x30, 0x4b, 0x81, 0xb9, 0x56, 0x7c, 0xa7, 0xb9, 0x67, 0x29, 0x66,
-	0xf7, 0x0d, 0x67, 0x29, 0x00, 0xe0, 0x28, 0x41, 0xd4, 0x84, 0x91, 0xdb, 0xbe, 0x8d, 0x5c, 0xd8,
-	0xc5, 0x4a, 0x79, 0xa9, 0x4b, 0x86, 0x76, 0xee, 0xef, 0x15, 0x14, 0xe2, 0x90, 0x08, 0xda, 0x86,
-	0xa1, 0xdb, 0x9c, 0x57, 0x08, 0x7b, 0xd6, 0x7c, 0xcf, 0xda, 0x3f, 0x37, 0xab, 0x88, 0x22, 0xec,
-	0xa3, 0x97, 0xdf, 0x12, 0x0c, 0x1f, 0xf0, 0xc4, 0xed, 0x33, 0x0a, 0x9c, 0xd9, 0x21, 0x8e, 0x67,
-	0xe8, 0xf1, 0x9b, 0xa1, 0x91, 0xfc, 0x16, 0x8a, 0x67, 0xd2, 0x10, 0xf2, 0x6d, 0x92, 0x0a, 0xc2,
-	0xe9, 0x5d, 0x40, 0xcf, 0x40, 0x3f, 0xf1, 0xf4, 0x9a, 0x08, 0xe6, 0xfd, 0xae, 0xbc, 0x6f, 0x7e,
-	0xf9, 0xbb, 0x17, 0xfa, 0x1f, 0x66, 0xf8, 0xd4, 0xbf, 0x52, 0x20, 0x61, 0x9b, 0x46, 0x3f, 0xa0,
-	0xc0, 0xd8, 0x16, 0xd1, 0xbc, 0x96, 0x43, 0xae, 0x0b, 0x9f, 0xd0, 0xbe, 0xab, 0xa3, 0x0f, 0x3d,
-	0x73, 0x14, 0x26, 0xf1, 0xb9, 0x25, 0x09, 0x31, 0x77, 0x56, 0x09, 0x92, 0x8f, 0xc8, 0x20, 0x1c,
-	0xe9, 0xc1, 0xec, 0x93, 0x30, 0x95, 0x68, 0x78, 0xa8, 0x6b, 0xca, 0x5f, 0x53, 0x20, 0x2d, 0xcf,
-	0x3d, 0x7a, 0x11, 0x06, 0xb4, 0x5a, 0x2d, 0x48, 0x77, 0xfa, 0x68, 0x3e, 0xbf, 0xa9, 0x9a, 0x1c,
-	0x6a, 0x88, 0xfd, 0xc4, 0x1c, 0x2d, 0x5a, 0x02, 0xa4, 0x45, 0xee, 0xa5, 0x57, 0xc2, 0x18, 0x17,
-	0xec, 0x3a, 0x6d, 0x3e, 0x01, 0xc5, 0x29, 0x2d, 0xd4, 0x8f, 0x29, 0x80, 0x92, 0xe9, 0x6a, 0x90,
-	0x03, 0xc3, 0x62, 0xff, 0xfa, 0xab, 0x54, 0xce, 0xf9, 0x9a, 0x2e, 0xf2, 0x34, 0x34, 0x74, 0xc2,
-	0x13, 0x05, 0x2e, 0x0e, 0xe8, 0xa8, 0x7f, 0xa7, 0x40, 0x98, 0x12, 0x11, 0xbd, 0x03, 0x46, 0x6b,
-	0xc4, 0xd5, 0x1d, 0xa3, 0xe9, 0x85, 0x0f, 0x49, 0x83, 0x07, 0x69, 0xe5, 0x10, 0x84, 0xe5, 0x7a,
-	0x48, 0x85, 0x41, 0x4f, 0x73, 0x6f, 0x57, 0xca, 0x42, 0xd9, 0x63, 0x47, 0xf3, 0x3a, 0x2b, 0xc1,
-	0x02, 0x12, 0xc6, 0x9e, 0xed, 0xeb, 0x22, 0xf6, 0x2c, 0xda, 0x3a, 0x82, 0x40, 0xbb, 0xe8, 0xe0,
-	0x20, 0xbb, 0xea, 0xcf, 0x14, 0xe0, 0x14, 0xad, 0xb2, 0xa2, 0x19, 0x96, 0x47, 0x2c, 0xf6, 0x6c,
-	0x2a, 0xe7, 0x24, 0xd4, 0x61, 0xdc, 0x8b, 0xbc, 0x7a, 0x3e, 0xfc, 0xa3, 0xda, 0xc0, 0xd3, 0x2b,
-	0xfa, 0xd6, 0x39, 0x8a, 0x17, 0x3d, 0xea, 0xbf, 0x5b, 0xe3, 0x6a, 0xf1, 0x7d, 0xfe, 0x56, 0x65,
-	0x8f, 0xd1, 0xee, 0x89, 0x27, 0xe4, 0x41, 0x1e, 0xcd, 0xc8, 0x13, 0xb5, 0x47, 0x60, 0x5c, 0xbc,
-	0x90, 0xe0, 0x41, 0x84, 0x85, 0x5a, 0xcc, 0x8e, 0x95, 0x25, 0x19, 0x80, 0xa3, 0xf5, 0xd4, 0x3f,
-	0x2c, 0x40, 0x34, 0x5b, 0x67, 0xde, 0x59, 0x4a, 0x46, 0x50, 0x2e, 0x1c, 0x5b, 0x04, 0x65, 0x9e,
-	0xaf, 0x9e, 0xb9, 0x73, 0x8b, 0x7b, 0x76, 0x39, 0x57, 0x35, 0x2b, 0xc7, 0x41, 0x8d, 0x70, 0x5a,
-	0xfb, 0x0f, 0x3d, 0xad, 0xef, 0x10, 0x9e, 0xbf, 0x03, 0x91, 0x38, 0xd6, 0xbe, 0xe7, 0xef, 0x54,
-	0xa4, 0xa1, 0xf4, 0xca, 0x6e, 0x1e, 0x44, 0xbe, 0x1b, 0xba, 0x2e, 0x22, 0xc6, 0xb6, 0xbb, 0x6e,
-	0x7b, 0x9a, 0xc9, 0xa6, 0x53, 0x18, 0x5d, 0x57, 0x64, 0x00, 0x8e, 0xd6, 0x53, 0x57, 0xe1, 0x8d,
-	0xcb, 0xb6, 0x56, 0x5b, 0xd0, 0x4c, 0xba, 0x75, 0x1d, 0xe1, 0x96, 0xe7, 0xb2, 0x93, 0x79, 0xcd,
-	0xb1, 0x3d, 0x5b, 0xb7, 0x4d, 0x7a, 0x6e, 0x6a, 0x41, 0xb0, 0x61, 0x76, 0x11, 0xe9, 0x9f, 0x9b,
-	0x22, 0x58, 0x2f, 0xf6, 0xe1, 0xea, 0xef, 0x28, 0x30, 0x24, 0x72, 0xe0, 0x74, 0xf1, 0xb0, 0x74,
-	0x0b, 0x06, 0x98, 0x76, 0xd4, 0x8b, 0x54, 0x5a, 0xdd, 0xb6, 0x6d, 0x2f, 0x92, 0x3c, 0x8b, 0xa7,
-	0x9f, 0x63, 0x09, 0x43, 0x39, 0x7a, 0xe6, 0x8f, 0xea, 0xe8, 0xdb, 0x86, 0x47, 0x98, 0xdb, 0x8d,
-	0xd8, 0xf8, 0xdc, 0x1f, 0x55, 0x2a, 0xc7, 0x91, 0x5a, 0xea, 0xff, 0x18, 0x80, 0x2b, 0x7e, 0x3e,
-	0x9f, 0xb8, 0xa8, 0x16, 0xf0, 0xdc, 0xbd, 0x20, 0xc1, 0x10, 0x4b, 0x90, 0xe0, 0xdb, 0x54, 0xf3,
-	0x69, 0xc9, 0xe7, 0xa4, 0x14, 0x42, 0x32, 0x3a, 0x9c, 0x46, 0x83, 0x87, 0x6f, 0x67, 0xc5, 0x37,
-	0x88, 0x66, 0x7a, 0xdb, 0x3e, 0xed, 0x42, 0x2f, 0xe1, 0xdb, 0x93, 0xf8, 0x70, 0x2a, 0x15, 0xe6,
-	0xd2, 0xe1, 0x27, 0x36, 0x72, 0x88, 0x26, 0xfb, 0x93, 0xf4, 0xf0, 0xdc, 0x68, 0x25, 0x15, 0x23,
-	0xce, 0xa0, 0xc4, 0xcc, 0x8d, 0xda, 0x2e, 0xb3, 0x5e, 0x60, 0xe2, 0x39, 0x06, 0x4b, 0x82, 0x16,
-	0xdc, 0x55, 0xac, 0x44, 0x41, 0x38, 0x5e, 0x17, 0x3d, 0x06, 0x13, 0xcc, 0x45, 0x26, 0x8c, 0x07,
-	0x3a, 0x10, 0x46, 0x85, 0x5a, 0x8d, 0x40, 0x70, 0xac, 0x26, 0xfa, 0x2e, 0x05, 0xa6, 0x0d, 0xf9,
-	0x31, 0x8d, 0x3f, 0xfa, 0xc1, 0x5e, 0xf2, 0x4a, 0xf0, 0x6d, 0x9c, 0x82, 0x16, 0xa7, 0x12, 0x43,
-	0xcb, 0x30, 0x2d, 0x5e, 0x44, 0x47, 0xf7, 0x00, 0x0f, 0x16, 0xc7, 0xd6, 0xb4, 0x9c, 0x02, 0xc7,
-	0xa9, 0xad, 0xd4, 0x0f, 0x17, 0x60, 0xec, 0x90, 0x09, 0x6d, 0x5b, 0x92, 0xcc, 0xd1, 0xc3, 0xbb,
-	0x45, 0x99, 0x6a, 0x17, 0x62, 0x07, 0x7a, 0x0e, 0x26, 0x5a, 0x6c, 0x22, 0xfc, 0x50, 0x6a, 0xe2,
-	0x9b, 0xfe, 0x16, 0xba, 0x72, 0x1b, 0x11, 0xc8, 0xbd, 0x76, 0x71, 0x56, 0x46, 0x1f, 0x85, 0xe2,
-	0x18, 0x1e, 0xf5, 0x37, 0x0a, 0x80, 0xe4, 0xea, 0x4b, 0xa6, 0xb6, 0x63, 0x3b, 0xe8, 0x73, 0x0a,
-	0x8c, 0xe9, 0x72, 0x0a, 0x31, 0x2e, 0x60, 0x3d, 0xd7, 0xeb, 0x60, 0x39, 0xfa, 0x39, 0x39, 0x37,
-	0x18, 0x17, 0x84, 0xfd, 0xe7, 0xd6, 0x63, 0x32, 0xe8, 0x5e, 0xec, 0x37, 0x8e, 0xf4, 0x69, 0xf6,
-	0xa3, 0x0a, 0x4c, 0x25, 0x30, 0xa5, 0x48, 0xc6, 0xcf, 0x47, 0x83, 0xa8, 0x1f, 0x49, 0xb2, 0x50,
-	0x59, 0xbe, 0xfe, 0xc9, 0x01, 0x38, 0x9d, 0xb2, 0xa2, 0xcc, 0xc5, 0x86, 0xc4, 0xa4, 0xcb, 0x5e,
-	0x5c, 0x6c, 0x12, 0x92, 0x6a, 0xe0, 0x62, 0x13, 0x87, 0xe0, 0x04, 0x5d, 0xf4, 0x0c, 0xf4, 0xe9,
-	0x8e, 0x21, 0x36, 0xed, 0x23, 0xb9, 0xa6, 0x00, 0x57, 0x16, 0x46, 0x05, 0xc5, 0xbe, 0x12, 0xae,
-	0x60, 0x8a, 0x90, 0x9e, 0xc5, 0xf2, 0x31, 0xe2, 0x0b, 0xac, 0xec, 0x2c, 0x96, 0x4f, 0x1b, 0x17,
-	0x47, 0xeb, 0xa1, 0xe7, 0x60, 0x46, 0x68, 0xaa, 0x7e, 0x24, 0x13, 0xdb, 0x72, 0x3d, 0xca, 0xf1,
-	0x3d, 0x21, 0x53, 0x30, 0xef, 0xd7, 0x9b, 0x19, 0x75, 0x70, 0x66, 0x6b, 0xf4, 0x9d, 0x30, 0x11,
-	0xe1, 0x1e, 0x7e, 0x58, 0xb3, 0x9c, 0x4f, 0x66, 0x64, 0x4c, 0x9c, 0x57, 0x46, 0xcb, 0x70, 0x8c,
-	0x1a, 0xfa, 0xc7, 0x0a, 0x4c, 0x05, 0x1b, 0x75, 0x8f, 0x6f, 0x79, 0x3f, 0x9b, 0xe3, 0xd2, 0xd1,
-	0x7c, 0x41, 0x52, 0xaa, 0xdd, 0x38, 0x21, 0x9c, 0xa4, 0xad, 0xfe, 0xc8, 0x20, 0xc8, 0xd9, 0xbd,
-	0xd1, 0x4a, 0x2f, 0x76, 0xc9, 0x70, 0x0f, 0xf8, 0xb6, 0xc9, 0x15, 0xe8, 0xab, 0x37, 0x5b, 0x39,
-	0x0d, 0x93, 0x01, 0xba, 0xeb, 0x14, 0x5d, 0xbd, 0xd9, 0x42, 0xcf, 0x04, 0xa6, 0xce, 0x7c, 0xc6,
-	0xc8, 0xe0, 0xa9, 0x64, 0xcc, 0xdc, 0xe9, 0xb3, 0xf7, 0xfe, 0x4c, 0xf6, 0xde, 0x80, 0x21, 0x57,
-	0xd8, 0x41, 0x07, 0xf2, 0xc7, 0xa1, 0x94, 0x66, 0x5a, 0xd8, 0x3d, 0xb9, 0x85, 0xc6, 0x37, 0x8b,
-	0xfa, 0x34, 0xa8, 0x22, 0xd8, 0x62, 0xe7, 0x92, 0x88, 0xb2, 0xc4, 0x14, 0xc1, 0x0d, 0x56, 0x82,
-	0x05, 0x24, 0x21, 0xcc, 0x0d, 0x75, 0x23, 0xcc, 0xa1, 0xcf, 0xc6, 0xf9, 0xf7, 0x30, 0xdb, 0x7d,
-	0x4f, 0xf7, 0x38, 0x9c, 0xff, 0xcf, 0x18, 0xf7, 0x3f, 0x0a, 0x0f, 0x3f, 0x69, 0xb1, 0xd0, 0x7d,
-	0x30, 0xc0, 0xa2, 0x28, 0x09, 0x39, 0x20, 0x30, 0x6e, 0xb0, 0x38, 0x3a, 0x98, 0xc3, 0x50, 0x55,
-	0xc4, 0x1e, 0xcc, 0xb7, 0xe9, 0x99, 0x6f, 0xa3, 0xa0, 0x27, 0x05, 0x2a, 0xbc, 0x12, 0x79, 0x13,
-	0x99, 0xa6, 0x43, 0x6c, 0xc0, 0x50, 0xc3, 0xb0, 0x98, 0xbb, 0x47, 0x3e, 0x23, 0x3a, 0x77, 0xc1,
-	0xe2, 0x28, 0xb0, 0x8f, 0x4b, 0xfd, 0xf3, 0x02, 0x65, 0x10, 0xa1, 0x52, 0xbf, 0xc7, 0x23, 0xe9,
-	0x8a, 0xd7, 0xd7, 0x4a, 0x7e, 0x23, 0xa0, 0x84, 0x74, 0x3e, 0x40, 0xc8, 0x1d, 0x15, 0xc2, 0xdf,
-	0x58, 0x22, 0x46, 0x49, 0x7b, 0x46, 0x83, 0x3c, 0x6b, 0x58, 0x35, 0xfb, 0x8e, 0x98, 0xde, 0x5e,
-	0x49, 0xaf, 0x07, 0x08, 0x39, 0xe9, 0xf0, 0x37, 0x96, 0x88, 0xd1, 0x23, 0x89, 0x65, 0xf3, 0xb0,
-	0x58, 0x56, 0x66, 0xd1, 0x37, 0x9e, 0x73, 0x5d, 0xf8, 0x1d, 0xb3, 0x23, 0xa9, 0x94, 0x51, 0x07,
-	0x67, 0xb6, 0x56, 0x7f, 0x4e, 0x81, 0x33, 0xa9, 0x53, 0x81, 0xae, 0xc3, 0x54, 0xe8, 0x0e, 0x2b,
-	0x0b, 0x09, 0xc3, 0x21, 0x8f, 0xbf, 0x19, 0xaf, 0x80, 0x93, 0x6d, 0x78, 0xae, 0xbb, 0x84, 0x10,
-	0x22, 0x7c, 0x69, 0x65, 0x55, 0x4b, 0x06, 0xe3, 0xb4, 0x36, 0xea, 0x0b, 0x91, 0xce, 0x86, 0x93,
-	0x45, 0xbf, 0x8c, 0x4d, 0x52, 0x0f, 0xde, 0xa4, 0x07, 0x5f, 0xc6, 0x02, 0x2d, 0xc4, 0x1c, 0x86,
-	0x2e, 0xc9, 0xa1, 0x32, 0x02, 0xee, 0xee, 0x87, 0xcb, 0x50, 0x7f, 0x4d, 0x81, 0x73, 0x2b, 0x9a,
-	0xd5, 0xd2, 0x4c, 0xfe, 0xf8, 0x7d, 0xcd, 0xb6, 0x4d, 0x31, 0x4d, 0x9d, 0x72, 0xef, 0x2b, 0xaf,
-	0x95, 0xdc, 0xfb, 0xef, 0x83, 0x73, 0x19, 0xfe, 0x37, 0xa8, 0x0c, 0x63, 0xee, 0x1d, 0xad, 0xb9,
-	0x40, 0xb6, 0xb5, 0x1d, 0x43, 0x04, 0xd6, 0xe2, 0x6e, 0xda, 0x63, 0x55, 0xa9, 0xfc, 0x5e, 0xec,
-	0x37, 0x8e, 0xb4, 0x52, 0x3d, 0x00, 0xe1, 0xce, 0x6f, 0x58, 0x75, 0xb4, 0x05, 0xc3, 0x9a, 0x49,
-	0x1c, 0x2f, 0x8c, 0x3e, 0xf9, 0xad, 0xb9, 0xec, 0xb4, 0x02, 0x07, 0x7f, 0xbe, 0xe6, 0xff, 0xc2,
-	0x01, 0x6e, 0xf5, 0x9f, 0x29, 0x70, 0x36, 0x3d, 0x94, 0x52, 0x17, 0x6a, 0x51, 0x03, 0x46, 0x9d,
-	0xb0, 0x99, 0xf8, 0x68, 0xdf, 0x29, 0xa7, 0xb8, 0x90, 0x1e, 0xde, 0x50, 0x45, 0xb0, 0xe4, 0xd8,
-	0xae, 0xbf, 0x73, 0xe3, 0x59, 0x2f, 0x02, 0xab, 0x98, 0xd4, 0x13, 0x2c, 0xe3, 0x67, 0x19, 0x68,
-	0x28, 0x75, 0xb7, 0xa9, 0xe9, 0xa4, 0x76, 0xc2, 0xf9, 0xf5, 0x8f, 0x20, 0xed, 0x43, 0x7a, 0xdf,
-	0x8f, 0x37, 0x03, 0x4d, 0x06, 0xcd, 0x83, 0x33, 0xd0, 0xa4, 0x37, 0x7c, 0x9d, 0xa4, 0x46, 0x48,
-	0xef, 0x7c, 0xc6, 0xc3, 0xf7, 0xff, 0x36, 0x98, 0x35, 0xda, 0x43, 0x26, 0xe9, 0xdf, 0x39, 0xc6,
-	0x24, 0xfd, 0x13, 0xdf, 0x48, 0xd0, 0x9f, 0x92, 0xa0, 0x3f, 0x96, 0x34, 0x7e, 0xf0, 0x84, 0x92,
-	0xc6, 0xbf, 0x0c, 0x83, 0x4d, 0xcd, 0x21, 0x96, 0x7f, 0x97, 0x5b, 0xc9, 0xe7, 0x68, 0x10, 0x6e,
-	0xb4, 0x90, 0x0b, 0x06, 0x9f, 0xe4, 0x1a, 0x23, 0x80, 0x05, 0xa1, 0x94, 0xe0, 0x29, 0xc3, 0xc7,
-	0x15, 0x9d, 0x30, 0x4c, 0x57, 0x3f, 0x72, 0x1c, 0xe9, 0xea, 0xd5, 0xbf, 0x55, 0xe0, 0x62, 0x27,
-	0xb6, 0xc4, 0x0c, 0x28, 0x7a, 0xec, 0x33, 0xec, 0xc5, 0x80, 0x92, 0xe0, 0xb6, 0x81, 0x01, 0x25,
-	0x0e, 0xc1, 0x09, 0xba, 0xe8, 0x3d, 0x80, 0xec, 0x4d, 0xee, 0xd7, 0x73, 0x9d, 0xd2, 0xe0, 0xaf,
-	0x69, 0x0b, 0xec, 0xad, 0x42, 0x90, 0x18, 0xf3, 0x56, 0xa2, 0x06, 0x4e, 0x69, 0xa5, 0xfe, 0x4a,
-	0x01, 0x60, 0x95, 0x78, 0x77, 0x6c, 0xe7, 0x36, 0x3d, 0xe3, 0x2f, 0x46, 0xae, 0x0e, 0x86, 0xbf,
-	0x7e, 0xf1, 0x28, 0x2f, 0x42, 0x7f, 0xd3, 0xae, 0xb9, 0x42, 0xff, 0x60, 0x1d, 0x61, 0x4f, 0x35,
-	0x58, 0x29, 0x2a, 0xc2, 0x00, 0x73, 0x7a, 0x12, 0x0a, 0x34, 0xbb, 0x78, 0x60, 0x97, 0x2b, 0x98,
-	0x97, 0x53, 0x0e, 0x29, 0x62, 0x1a, 0xb8, 0xe2, 0x72, 0x67, 0x8c, 0x07, 0x21, 0xe7, 0x65, 0x38,
-	0x80, 0xa2, 0xc7, 0x00, 0x8c, 0xe6, 0x92, 0xd6, 0x30, 0x4c, 0x43, 0x7c, 0xae, 0x23, 0xcc, 0x22,
-	0x0e, 0x95, 0x35, 0xbf, 0xf4, 0x5e, 0xbb, 0x38, 0x2c, 0x7e, 0xed, 0x61, 0xa9, 0xb6, 0xfa, 0x05,
-	0x05, 0x26, 0xc3, 0xc9, 0x13, 0x5b, 0xc5, 0xef, 0x39, 0x0f, 0x06, 0x9c, 0xd9, 0x73, 0x1e, 0xf7,
-	0xbf, 0x73, 0xcf, 0xb9, 0x01, 0x2b, 0xab, 0xe7, 0x0f, 0xc2, 0x28, 0xe1, 0x21, 0x8f, 0x2a, 0x65,
-	0xcc, 0x79, 0xdc, 0x08, 0x57, 0xe7, 0x16, 0xc3, 0x62, 0x2c, 0xd7, 0x51, 0xff, 0xbe, 0x0f, 0xc6,
-	0x56, 0xeb, 0x86, 0xb5, 0xeb, 0xc7, 0x76, 0x0a, 0x2e, 0xde, 0x95, 0xe3, 0xb9, 0x78, 0x7f, 0x0e,
-	0x66, 0x4c, 0xf9, 0x9a, 0x8b, 0x0b, 0x4e, 0x9a, 0x55, 0x0f, 0x66, 0x80, 0xe9, 0x31, 0xcb, 0x19,
-	0x75, 0x70, 0x66, 0x6b, 0xe4, 0xc1, 0xa0, 0xee, 0x27, 0x68, 0xcc, 0x1d, 0xaf, 0x48, 0x9e, 0x8b,
-	0x39, 0x39, 0x74, 0x47, 0xc0, 0xf3, 0xc4, 0xf6, 0x14, 0xb4, 0xd0, 0x47, 0x14, 0x38, 0x43, 0x76,
-	0x79, 0xe8, 0x9a, 0x75, 0x47, 0xdb, 0xda, 0x32, 0x74, 0xf1, 0xe2, 0x8f, 0xef, 0xc4, 0xe5, 0xfd,
-	0x76, 0xf1, 0xcc, 0x62, 0x5a, 0x85, 0x7b, 0xed, 0xe2, 0xb5, 0xd4, 0x48, 0x42, 0x6c, 0x35, 0x53,
-	0x9b, 0xe0, 0x74, 0x52, 0xb3, 0x8f, 0xc2, 0xe8, 0x21, 0xde, 0x89, 0x47, 0xe2, 0x05, 0xfd, 0x6a,
-	0x01, 0xc6, 0xe8, 0x76, 0x5b, 0xb6, 0x75, 0xcd, 0x2c, 0xaf, 0x56, 0xd1, 0xfd, 0xf1, 0x30, 0x89,
-	0xc1, 0x15, 0x63, 0x22, 0x54, 0xe2, 0x32, 0x4c, 0x6f, 0xd9, 0x8e, 0x4e, 0xd6, 0x4b, 0x6b, 0xeb,
-	0xb6, 0x70, 0x3e, 0x2b, 0xaf, 0x56, 0x85, 0x5e, 0xc7, 0xae, 0x3c, 0x96, 0x52, 0xe0, 0x38, 0xb5,
-	0x15, 0xba, 0x05, 0x67, 0xc2, 0xf2, 0x8d, 0x26, 0x7f, 0xb0, 0x40, 0xd1, 0xf5, 0x85, 0x0f, 0x2e,
-	0x96, 0xd2, 0x2a, 0xe0, 0xf4, 0x76, 0x48, 0x83, 0x0b, 0xe2, 0x6e, 0x65, 0xc9, 0x76, 0xee, 0x68,
-	0x4e, 0x2d, 0x8a, 0xb6, 0x3f, 0xcc, 0x5b, 0x5f, 0xce, 0xae, 0x86, 0x3b, 0xe1, 0x50, 0xff, 0x4e,
-	0x81, 0x68, 0x10, 0x4a, 0x74, 0x1e, 0xfa, 0x1c, 0x91, 0xff, 0x4f, 0x04, 0x63, 0xa4, 0x2a, 0x02,
-	0x2d, 0x43, 0x73, 0x00, 0x4e, 0x18, 0x09, 0xb3, 0x10, 0xe6, 0xc5, 0x90, 0x62, 0x58, 0x4a, 0x35,
-	0x28, 0x2a, 0x4f, 0xab, 0x0b, 0x86, 0xc7, 0x50, 0xad, 0x6b, 0x75, 0x4c, 0xcb, 0x58, 0x02, 0x14,
-	0xa3, 0x4e, 0x5c, 0xdf, 0x1c, 0xcd, 0x13, 0xa0, 0xb0, 0x12, 0x2c, 0x20, 0x48, 0x83, 0xf1, 0x66,
-	0xcb, 0x14, 0xf1, 0x94, 0xa8, 0xea, 0xc3, 0xcd, 0x86, 0x57, 0xd3, 0xb2, 0xfb, 0xb1, 0xd5, 0x4f,
-	0x4d, 0xf1, 0xb7, 0x26, 0xa3, 0xc0, 0x51, 0x8c, 0xea, 0x8f, 0x0f, 0x82, 0x14, 0x5e, 0xe7, 0x10,
-	0x52, 0xe8, 0x4f, 0x2b, 0x30, 0xad, 0x9b, 0x06, 0xb1, 0xbc, 0x58, 0xa4, 0x0a, 0x7e, 0x7c, 0x6c,
-	0xe4, 0x8a, 0xfb, 0xd3, 0x24, 0x56, 0xa5, 0x2c, 0x9e, 0xb7, 0x94, 0x52, 0x90, 0x8b, 0x27, 0x40,
-	0x29, 0x10, 0x9c, 0xda, 0x19, 0x36, 0x1e, 0x56, 0x5e, 0x29, 0xcb, 0xd1, 0x33, 0x4b, 0xa2, 0x0c,
-	0x07, 0x50, 0xca, 0x79, 0xeb, 0x8e, 0xdd, 0x6a, 0xba, 0x25, 0xf6, 0x8a, 0x95, 0x2f, 0x0a, 0xe3,
-	0xbc, 0xd7, 0xc3, 0x62, 0x2c, 0xd7, 0x41, 0x0f, 0xc3, 0x18, 0xff, 0xb9, 0xe6, 0x90, 0x2d, 0x63,
-	0x57, 0x1c, 0x4a, 0xcc, 0x78, 0x7a, 0x5d, 0x2a, 0xc7, 0x91, 0x5a, 0x2c, 0x7e, 0x9b, 0xeb, 0xb6,
-	0x88, 0xb3, 0x81, 0x97, 0x45, 0x92, 0x68, 0x1e, 0xbf, 0xcd, 0x2f, 0xc4, 0x21, 0x1c, 0xfd, 0x90,
-	0x02, 0x13, 0x0e, 0x79, 0xb9, 0x65, 0x38, 0x54, 0x84, 0xd1, 0x8c, 0x86, 0x2b, 0x62, 0x1c, 0xe1,
-	0xde, 0xe2, 0x2a, 0xcd, 0xe1, 0x08, 0x52, 0xce, 0x20, 0x03, 0x57, 0x8e, 0x28, 0x10, 0xc7, 0x7a,
-	0x40, 0xa7, 0xca, 0x35, 0xea, 0x96, 0x61, 0xd5, 0xe7, 0xcd, 0xba, 0x2b, 0x32, 0x61, 0x71, 0x9b,
-	0x63, 0x58, 0x8c, 0xe5, 0x3a, 0xe8, 0x11, 0x18, 0x6f, 0xb9, 0x94, 0xed, 0x35, 0x08, 0x9f, 0xdf,
-	0x91, 0xd0, 0xd7, 0x65, 0x43, 0x06, 0xe0, 0x68, 0x3d, 0xf4, 0x18, 0x4c, 0xf8, 0x05, 0x62, 0x96,
-	0x81, 0xa7, 0x63, 0x61, 0x77, 0x93, 0x11, 0x08, 0x8e, 0xd5, 0x9c, 0x9d, 0x87, 0xd3, 0x29, 0xc3,
-	0x3c, 0x14, 0x6f, 0xfd, 0xbf, 0x0a, 0x9c, 0xe1, 0x52, 0x97, 0x9f, 0x5e, 0xda, 0x4f, 0x8e, 0x91,
-	0x9e, 0x67, 0x42, 0x39, 0xd6, 0x3c, 0x13, 0x5f, 0x87, 0x7c, 0x1a, 0xea, 0xcf, 0x16, 0xe0, 0x8d,
-	0x07, 0x7e, 0x97, 0xe8, 0x27, 0x14, 0x18, 0x25, 0xbb, 0x9e, 0xa3, 0x05, 0x4f, 0xfd, 0xe9, 0x26,
-	0xdd, 0x3a, 0x16, 0x26, 0x30, 0xb7, 0x18, 0x12, 0xe2, 0x1b, 0x37, 0x50, 0xa5, 0x24, 0x08, 0x96,
-	0xfb, 0x43, 0xb9, 0x2d, 0x4f, 0x57, 0x24, 0x3b, 0xc5, 0x09, 0x2e, 0x28, 0x20, 0xb3, 0x4f, 0xc0,
-	0x64, 0x1c, 0xf3, 0xa1, 0xf6, 0xca, 0xe7, 0x15, 0x48, 0x0d, 0xc7, 0x89, 0x4a, 0x30, 0xa5, 0xb5,
-	0x3c, 0x3b, 0x72, 0xaf, 0x27, 0x44, 0x49, 0xe6, 0xf4, 0x3d, 0x1f, 0x07, 0xe2, 0x64, 0x7d, 0x6e,
-	0x80, 0xb5, 0x5a, 0x9a, 0x19, 0x45, 0xc3, 0x05, 0x2e, 0x61, 0x80, 0x4d, 0x80, 0x71, 0x5a, 0x1b,
-	0xf5, 0x5f, 0x2a, 0x70, 0x26, 0xd5, 0x2c, 0xd9, 0x85, 0x2d, 0x2e, 0x7d, 0xdb, 0x17, 0x8e, 0x73,
-	0xdb, 0xab, 0xbf, 0x5c, 0x80, 0xa1, 0x35, 0xc7, 0xa6, 0x47, 0xdb, 0x09, 0x58, 0xe0, 0xb4, 0x88,
-	0x05, 0x2e, 0x97, 0x7d, 0x41, 0x74, 0x36, 0xd3, 0xe4, 0x66, 0xc4, 0x4c, 0x6e, 0xf3, 0xbd, 0x10,
-	0xe9, 0x6c, 0x63, 0xfb, 0x92, 0x02, 0xa3, 0xa2, 0xe6, 0x09, 0x18, 0xd5, 0xbe, 0x23, 0x6a, 0x54,
-	0x7b, 0x77, 0x0f, 0xe3, 0xca, 0xb0, 0xa2, 0x7d, 0x46, 0x81, 0x71, 0x51, 0x63, 0x85, 0x34, 0x36,
-	0x89, 0x83, 0x96, 0x60, 0xc8, 0x6d, 0xb1, 0x85, 0x14, 0x03, 0xba, 0x20, 0x8b, 0x47, 0xce, 0xa6,
-	0xa6, 0xd3, 0xee, 0x57, 0x79, 0x15, 0x29, 0x95, 0x32, 0x2f, 0xc0, 0x7e, 0x63, 0xba, 0xf7, 0x1d,
-	0xdb, 0x4c, 0x84, 0xad, 0xc7, 0xb6, 0x49, 0x30, 0x83, 0x50, 0x3d, 0x8f, 0xfe, 0xf5, 0x75, 0x38,
-	0xa6, 0xe7, 0x51, 0xb0, 0x8b, 0x79, 0xb9, 0xfa, 0x85, 0x81, 0x60, 0xb2, 0x99, 0x52, 0x7f, 0x03,
-	0x46, 0x74, 0x87, 0x68, 0x1e, 0xa9, 0x2d, 0xec, 0x75, 0xd3, 0x39, 0x26, 0x07, 0x94, 0xfc, 0x16,
-	0x38, 0x6c, 0x4c, 0x8f, 0x5c, 0xd9, 0xc1, 0xb3, 0x10, 0x4a, 0x27, 0x99, 0xce, 0x9d, 0xdf, 0x0a,
-	0x03, 0xf6, 0x1d, 0x2b, 0x78, 0x1c, 0xd2, 0x91, 0x30, 0x1b, 0xca, 0x2d, 0x5a, 0x1b, 0xf3, 0x46,
-	0x72, 0xda, 0x86, 0xfe, 0x0e, 0x69, 0x1b, 0x4c, 0x18, 0x6a, 0xb0, 0x65, 0xe8, 0x29, 0xb3, 0x6e,
-	0x64, 0x41, 0xc3, 0x25, 0xe2, 0xbf, 0x5d, 0xec, 0x93, 0xa0, 0xa2, 0x93, 0xe5, 0x5b, 0x74, 0x64,
-	0xd1, 0x29, 0x30, 0xf3, 0xe0, 0x10, 0x8e, 0xf6, 0xa2, 0xf9, 0x40, 0x86, 0xf2, 0xdb, 0x49, 0x45,
-	0xf7, 0xa4, 0x14, 0x20, 0x7c, 0xea, 0xb3, 0x72, 0x82, 0xa0, 0xcf, 0x2b, 0x70, 0xae, 0x96, 0x9e,
-	0xb1, 0x4d, 0x5c, 0x95, 0xe7, 0x7a, 0x98, 0x9d, 0x91, 0x04, 0x6e, 0xa1, 0x28, 0x26, 0x2c, 0x2b,
-	0x4b, 0x1c, 0xce, 0xea, 0x8c, 0xfa, 0xbd, 0xfd, 0xc1, 0xd7, 0x24, 0x2c, 0x1d, 0xe9, 0x76, 0x28,
-	0x25, 0x8f, 0x1d, 0x0a, 0xbd, 0xdd, 0xcf, 0x1f, 0xc6, 0xb7, 0xeb, 0xa5, 0x78, 0xfe, 0xb0, 0x31,
-	0x41, 0x3a, 0x92, 0x33, 0xac, 0x05, 0xa7, 0x5d, 0x4f, 0x33, 0x49, 0xd5, 0x10, 0x97, 0x83, 0xae,
-	0xa7, 0x35, 0x9a, 0x39, 0x12, 0x78, 0xf1, 0x40, 0x0d, 0x49, 0x54, 0x38, 0x0d, 0x3f, 0xfa, 0x6e,
-	0x16, 0x7c, 0x4f, 0x33, 0xd9, 0xe5, 0x29, 0x4f, 0x62, 0x1a, 0x12, 0x3f, 0xbc, 0xbb, 0xbb, 0x08,
-	0xad, 0x97, 0x8e, 0x0f, 0x67, 0x52, 0x42, 0xaf, 0xc0, 0x19, 0x7a, 0x00, 0xce, 0xeb, 0x9e, 0xb1,
-	0x63, 0x78, 0x7b, 0x61, 0x17, 0x0e, 0x9f, 0xb5, 0x8b, 0x69, 0xdb, 0xcb, 0x69, 0xc8, 0x70, 0x3a,
-	0x0d, 0xf5, 0x6f, 0x14, 0x40, 0xc9, 0xbd, 0x8e, 0x4c, 0x18, 0xae, 0xf9, 0x91, 0x13, 0x94, 0x23,
-	0xc9, 0xaa, 0x13, 0x1c, 0x21, 0x41, 0xc0, 0x85, 0x80, 0x02, 0xb2, 0x61, 0xe4, 0xce, 0xb6, 0xe1,
-	0x11, 0xd3, 0x70, 0xbd, 0x23, 0x4a, 0xe2, 0x13, 0xc4, 0x84, 0x7f, 0xd6, 0x47, 0x8c, 0x43, 0x1a,
-	0xea, 0x27, 0xfb, 0x61, 0x38, 0xc8, 0xc6, 0x79, 0xb0, 0x9b, 0x75, 0x0b, 0x90, 0x2e, 0x05, 0x04,
-	0xec, 0xc5, 0x66, 0xca, 0x64, 0xa0, 0x52, 0x02, 0x19, 0x4e, 0x21, 0x80, 0x5e, 0x81, 0x69, 0xc3,
-	0xda, 0x72, 0xb4, 0x20, 0xdc, 0x61, 0xc9, 0x37, 0x94, 0xe5, 0x20, 0xcc, 0xb4, 0xe8, 0x4a, 0x0a,
-	0x3a, 0x9c, 0x4a, 0x04, 0x11, 0x18, 0xe2, 0x09, 0x91, 0xfd, 0x5b, 0x97, 0x5c, 0xb6, 0x7a, 0x2e,
-	0x6b, 0x86, 0xec, 0xdd, 0x97, 0x3d, 0x7d, 0xdc, 0x3c, 0x38, 0x2d, 0xff, 0xdf, 0xbf, 0x90, 0x12,
-	0xfb, 0xbe, 0x94, 0x9f, 0x5e, 0x78, 0xb7, 0xc5, 0x83, 0xd3, 0x46, 0x0b, 0x71, 0x9c, 0xa0, 0xfa,
-	0xbb, 0x0a, 0x0c, 0xf0, 0x18, 0x60, 0xc7, 0x2f, 0x6a, 0xbe, 0x2f, 0x22, 0x6a, 0x3e, 0x9e, 0x67,
-	0x90, 0xac, 0xab, 0x99, 0x29, 0xfd, 0x7f, 0x47, 0x81, 0x11, 0x56, 0xe3, 0x04, 0x64, 0xbf, 0x17,
-	0xa3, 0xb2, 0xdf, 0xa3, 0xb9, 0x47, 0x93, 0x21, 0xf9, 0xfd, 0x6e, 0x9f, 0x18, 0x0b, 0x13, 0xad,
-	0x2a, 0x70, 0x5a, 0x3c, 0x8c, 0x5d, 0x36, 0xb6, 0x08, 0xdd, 0xe2, 0x65, 0x6d, 0xcf, 0x15, 0x4f,
-	0x35, 0x78, 0xd0, 0x99, 0x24, 0x18, 0xa7, 0xb5, 0x41, 0xbf, 0xaa, 0x50, 0x21, 0xc6, 0x73, 0x0c,
-	0xbd, 0xa7, 0xcb, 0xe0, 0xa0, 0x6f, 0x73, 0x2b, 0x1c, 0x19, 0xd7, 0x4d, 0x37, 0x42, 0x69, 0x86,
-	0x95, 0xde, 0x6b, 0x17, 0x8b, 0x29, 0x36, 0xe3, 0x30, 0xad, 0xb5, 0xeb, 0x7d, 0xe4, 0x2f, 0x3a,
-	0x56, 0x61, 0xda, 0x98, 0xdf, 0x63, 0x74, 0x03, 0x06, 0x5c, 0xdd, 0x6e, 0xfa, 0x4f, 0xab, 0xef,
-	0x4b, 0xb3, 0x0d, 0xc6, 0xcd, 0x82, 0xc1, 0x04, 0x57, 0x69, 0x4b, 0xcc, 0x11, 0xcc, 0xbe, 0x04,
-	0x63, 0x72, 0xcf, 0x53, 0x74, 0xdf, 0x72, 0xd4, 0x63, 0xee, 0x90, 0xce, 0x61, 0xb2, 0xae, 0xfc,
-	0x47, 0x7d, 0x30, 0x88, 0x49, 0x5d, 0xa4, 0x4c, 0x3b, 0x40, 0xe7, 0x34, 0xfc, 0xfc, 0xc1, 0x85,
-	0xfc, 0xef, 0xf0, 0xe4, 0xa4, 0x38, 0xcf, 0xdb, 0x96, 0x34, 0x07, 0x72, 0x0a, 0x61, 0x64, 0x05,
-	0x89, 0xa4, 0xfa, 0xf2, 0x3b, 0xd4, 0xf2, 0x81, 0x75, 0x93, 0x3a, 0x0a, 0xfd, 0xa0, 0x02, 0x48,
-	0xd3, 0x75, 0xe2, 0xba, 0x98, 0xb8, 0x74, 0xee, 0xb9, 0xb0, 0xca, 0xb9, 0x6c, 0xbe, 0xa8, 0xd8,
-	0x71, 0x6c, 0xa1, 0xd8, 0x96, 0x00, 0xb9, 0x38, 0x85, 0x78, 0x2f, 0xe9, 0xac, 0x7e, 0x5f, 0x81,
-	0xb1, 0x48, 0xb6, 0xb0, 0x46, 0x68, 0x4b, 0xcf, 0xef, 0xb2, 0xe3, 0xbf, 0xfe, 0xba, 0xd0, 0xa1,
-	0x12, 0xb7, 0xcf, 0xdf, 0x0a, 0xd2, 0x5d, 0x1c, 0x4d, 0x62, 0x31, 0xf5, 0x53, 0x0a, 0x9c, 0xf5,
-	0x07, 0x14, 0x8d, 0x6b, 0x8e, 0xae, 0xc2, 0xb0, 0xd6, 0x34, 0x98, 0xa1, 0x57, 0x36, 0x95, 0xcf,
-	0xaf, 0x55, 0x58, 0x19, 0x0e, 0xa0, 0x91, 0x24, 0xcd, 0x85, 0x03, 0x93, 0x34, 0xbf, 0x59, 0x4a,
-	0x3b, 0x3d, 0x10, 0xca, 0x2e, 0x01, 0x61, 0xee, 0xcc, 0xa9, 0xbe, 0x13, 0x46, 0xaa, 0xd5, 0x1b,
-	0x7c, 0x49, 0x0f, 0x71, 0xe3, 0xa3, 0x7e, 0xbc, 0x0f, 0xc6, 0x45, 0x82, 0x06, 0x83, 0x99, 0x80,
-	0x4e, 0xe0, 0x9c, 0x5b, 0x87, 0x11, 0x37, 0xb8, 0xc3, 0x28, 0x64, 0xf3, 0xa9, 0xe0, 0x5a, 0x22,
-	0x9e, 0x65, 0x2f, 0xbc, 0xbe, 0x08, 0x11, 0xa1, 0x9b, 0x30, 0xf8, 0x32, 0xe5, 0xb9, 0xfe, 0xb7,
-	0xda, 0x15, 0xeb, 0x0b, 0x3e, 0x44, 0xc6, 0xae, 0x5d, 0x2c, 0x50, 0x20, 0x97, 0x3d, 0x4f, 0x64,
-	0x42, 0x60, 0x2f, 0xa1, 0x3a, 0x23, 0x33, 0x1b, 0x24, 0x9d, 0x1f, 0x13, 0xaf, 0x1c, 0xd9, 0x2f,
-	0x1c, 0x10, 0x62, 0x29, 0x42, 0x23, 0x2d, 0x5e, 0x27, 0x29, 0x42, 0x23, 0x7d, 0xce, 0x38, 0xae,
-	0x1f, 0x85, 0x33, 0xa9, 0x93, 0x71, 0xb0, 0x88, 0xad, 0xfe, 0xab, 0x02, 0xf4, 0x57, 0x09, 0xa9,
-	0x9d, 0xc0, 0xce, 0x7c, 0x31, 0x22, 0x81, 0x7d, 0x6b, 0xee, 0x24, 0xa5, 0x59, 0x96, 0xbe, 0xad,
-	0x98, 0xa5, 0xef, 0x89, 0xdc, 0x14, 0x3a, 0x9b, 0xf9, 0xfe, 0xaa, 0x00, 0x43, 0xb4, 0x5a, 0x79,
-	0xb5, 0x8a, 0x1a, 0xd2, 0x56, 0x2e, 0xe4, 0x17, 0x9f, 0x05, 0xba, 0x83, 0x36, 0x31, 0xfd, 0x72,
-	0x0c, 0x91, 0xf8, 0x45, 0x0c, 0xb2, 0x72, 0x04, 0xe4, 0x44, 0xec, 0x09, 0x46, 0xd4, 0xcf, 0x2b,
-	0x83, 0x03, 0x42, 0xe8, 0x8e, 0xa4, 0x93, 0xf6, 0xe7, 0xf7, 0xdd, 0x4d, 0x27, 0xda, 0x41, 0x3d,
-	0x55, 0x3f, 0xae, 0xc0, 0xa9, 0x58, 0xab, 0x2e, 0x94, 0xc6, 0x63, 0x61, 0x80, 0xea, 0xd7, 0x14,
-	0xfa, 0x8d, 0xa5, 0x8c, 0xa0, 0x8b, 0x1e, 0x85, 0x09, 0xc0, 0x0a, 0x1d, 0x13, 0x80, 0x5d, 0x84,
-	0x7e, 0x2a, 0x19, 0xc9, 0x3e, 0x3b, 0x54, 0x60, 0xc2, 0xac, 0x14, 0xe9, 0x30, 0xa1, 0x4b, 0x39,
-	0xe0, 0xc9, 0x96, 0xe0, 0x9d, 0x5d, 0xb1, 0xe2, 0xe0, 0xba, 0xb1, 0x14, 0x41, 0x81, 0x63, 0x28,
-	0xd5, 0xdf, 0x56, 0x60, 0x98, 0x0e, 0xf3, 0x04, 0x98, 0xe3, 0xb7, 0x47, 0x99, 0xe3, 0xbb, 0xf2,
-	0xee, 0xa9, 0x0c, 0x9e, 0xf8, 0xd7, 0x05, 0x60, 0x19, 0x8c, 0x85, 0x7f, 0x91, 0xe4, 0x39, 0xa4,
-	0x64, 0xf8, 0x3c, 0x5d, 0x11, 0x8e, 0x47, 0x31, 0xa3, 0xb4, 0xe4, 0x7c, 0xf4, 0xd6, 0x88, 0x6f,
-	0x51, 0x44, 0xb8, 0x48, 0xf1, 0x2f, 0xba, 0x0b, 0xe3, 0xee, 0xb6, 0x6d, 0x7b, 0xe5, 0xf0, 0xe3,
-	0xc9, 0x7d, 0x01, 0xc1, 0x1e, 0x8b, 0xfb, 0x43, 0xe1, 0x57, 0xb9, 0x55, 0x19, 0x37, 0x8e, 0x92,
-	0x42, 0x73, 0x00, 0x9b, 0xa6, 0xad, 0xdf, 0xe6, 0xae, 0x4d, 0xfc, 0x71, 0x30, 0x73, 0x9e, 0x58,
-	0x08, 0x4a, 0xb1, 0x54, 0xa3, 0x27, 0x2f, 0xae, 0xaf, 0x29, 0x7c, 0xa6, 0x0f, 0xf1, 0x8d, 0x9e,
-	0xa0, 0x23, 0xdc, 0x5b, 0x60, 0xd0, 0x61, 0x3a, 0x82, 0x58, 0xb1, 0xe0, 0xe3, 0xe3, 0x9a, 0x03,
-	0x16, 0x50, 0xba, 0x3d, 0xb8, 0xe2, 0xd3, 0x1f, 0x5e, 0x38, 0xc8, 0xea, 0x8a, 0xfa, 0xcb, 0x62,
-	0x98, 0x41, 0x12, 0xec, 0x26, 0x8c, 0x33, 0xcd, 0x22, 0x96, 0x7d, 0xfb, 0xed, 0x5d, 0x7e, 0x23,
-	0x72, 0xd3, 0xd0, 0x2d, 0x37, 0x52, 0x8c, 0xa3, 0x04, 0xd0, 0x23, 0x30, 0xee, 0x8f, 0x8e, 0x7b,
-	0xc7, 0x16, 0xc2, 0x17, 0x9a, 0x6b, 0x32, 0x00, 0x47, 0xeb, 0xa9, 0x9f, 0x2e, 0xc0, 0x25, 0xde,
-	0x77, 0x66, 0x79, 0x29, 0x93, 0x26, 0xb1, 0x6a, 0xc4, 0xd2, 0xf7, 0x98, 0x9c, 0x5d, 0xb3, 0xeb,
-	0xe8, 0x15, 0x18, 0xbc, 0x43, 0x48, 0x2d, 0xb8, 0xc2, 0x78, 0x36, 0x7f, 0x0e, 0xf1, 0x0c, 0x12,
-	0xcf, 0x32, 0xf4, 0xfc, 0x36, 0x98, 0xff, 0x8f, 0x05, 0x49, 0x4a, 0xbc, 0xe9, 0xd8, 0x9b, 0x81,
-	0x38, 0x78, 0xf4, 0xc4, 0xd7, 0x18, 0x7a, 0x4e, 0x9c, 0xff, 0x8f, 0x05, 0x49, 0x75, 0x0d, 0xee,
-	0xeb, 0xa2, 0xe9, 0x61, 0xc4, 0xfe, 0x83, 0x30, 0xf2, 0xd1, 0x1f, 0x06, 0xe3, 0x9f, 0x28, 0xf0,
-	0x26, 0x09, 0xe5, 0xe2, 0x2e, 0xd5, 0x44, 0x4a, 0x5a, 0x53, 0xd3, 0xa9, 0xae, 0xcf, 0x62, 0xd4,
-	0x1d, 0x2a, 0x6b, 0xef, 0xc7, 0x15, 0x18, 0xe2, 0x1e, 0x79, 0x3e, 0xfb, 0x7d, 0xb1, 0xc7, 0x29,
-	0xcf, 0xec, 0x92, 0x9f, 0xcd, 0xcc, 0x1f, 0x1b, 0xff, 0xed, 0x62, 0x9f, 0xbe, 0xfa, 0x6f, 0x07,
-	0xe0, 0x9b, 0xbb, 0x47, 0x84, 0xbe, 0xa6, 0xc8, 0xd9, 0xc6, 0xb9, 0x8d, 0xbc, 0x71, 0xbc, 0x9d,
-	0x0f, 0xac, 0x41, 0xc2, 0xc0, 0xf0, 0x6c, 0x22, 0x21, 0xf9, 0x11, 0x19, 0x9a, 0xc2, 0x81, 0xa1,
-	0x7f, 0xae, 0xc0, 0x18, 0x3d, 0x96, 0x02, 0xe6, 0xc2, 0x97, 0xa9, 0x79, 0xcc, 0x23, 0x5d, 0x95,
-	0x48, 0xc6, 0xe2, 0x5a, 0xc9, 0x20, 0x1c, 0xe9, 0x1b, 0xda, 0x88, 0x5e, 0xff, 0x71, 0x15, 0xf1,
-	0x72, 0x9a, 0x5c, 0x72, 0x98, 0x74, 0xff, 0xb3, 0x26, 0x4c, 0x44, 0x67, 0xfe, 0x38, 0xcd, 0x64,
-	0xb3, 0x4f, 0xc2, 0x54, 0x62, 0xf4, 0x87, 0x32, 0xc8, 0x7c, 0x69, 0x10, 0x8a, 0xd2, 0x54, 0xa7,
-	0x85, 0xa7, 0x41, 0x9f, 0x55, 0x60, 0x54, 0xb3, 0x2c, 0xe1, 0xd8, 0xe4, 0xef, 0xdf, 0x5a, 0x8f,
-	0xab, 0x9a, 0x46, 0x6a, 0x6e, 0x3e, 0x24, 0x13, 0xf3, 0xdc, 0x91, 0x20, 0x58, 0xee, 0x4d, 0x07,
-	0xef, 0xdc, 0xc2, 0x89, 0x79, 0xe7, 0xa2, 0x0f, 0xf8, 0x07, 0x71, 0x5f, 0xfe, 0x40, 0x15, 0x07,
-	0xcc, 0x0d, 0x3b, 0xd7, 0x33, 0xac, 0x92, 0xdf, 0xaf, 0xb0, 0x43, 0x36, 0x8c, 0x22, 0x24, 0xce,
-	0xa4, 0x5c, 0x4e, 0x96, 0x07, 0x86, 0x28, 0x0a, 0xce, 0xee, 0xb0, 0x08, 0x47, 0xc9, 0xa3, 0x1f,
-	0x55, 0x60, 0xec, 0xae, 0x6d, 0x69, 0xa6, 0xf0, 0xb3, 0x16, 0xd7, 0x34, 0xef, 0x3b, 0x9e, 0x79,
-	0x09, 0xc8, 0x70, 0xdf, 0x4a, 0xb9, 0x04, 0x47, 0xba, 0xc1, 0x32, 0xe6, 0xb3, 0xb7, 0xd5, 0x83,
-	0xa1, 0x3c, 0x2d, 0xbf, 0xab, 0x9e, 0x7d, 0x02, 0x26, 0xe3, 0x7b, 0xf0, 0x50, 0xdf, 0xd3, 0x3a,
-	0x3c, 0x70, 0x88, 0xfe, 0x76, 0x79, 0xf4, 0xa9, 0xbf, 0xd1, 0x1f, 0x39, 0x4a, 0x33, 0xb7, 0x47,
-	0x17, 0xb6, 0xf2, 0x57, 0x63, 0x1f, 0x33, 0x67, 0xd1, 0xc6, 0x71, 0x6d, 0xd8, 0xa3, 0xfd, 0xa2,
-	0xfb, 0x4e, 0xee, 0x8b, 0x7e, 0xad, 0x7d, 0x52, 0x3d, 0xef, 0xcc, 0x05, 0x6e, 0x0b, 0x10, 0x0b,
-	0x16, 0x26, 0x6b, 0x62, 0x81, 0x3a, 0x0d, 0xd7, 0xf0, 0xc3, 0x4d, 0x4b, 0x22, 0xdd, 0x33, 0xbc,
-	0x18, 0xfb, 0x70, 0x75, 0x39, 0x72, 0x58, 0xac, 0xdb, 0x4d, 0xdb, 0xb4, 0xeb, 0x7b, 0xf3, 0x77,
-	0x34, 0x87, 0x60, 0xbb, 0xe5, 0x09, 0x6c, 0xdd, 0x0a, 0x88, 0x1f, 0x1e, 0x80, 0x2b, 0x12, 0xba,
-	0xd4, 0xa8, 0x9c, 0x87, 0x79, 0xab, 0xf0, 0x6a, 0x3c, 0x78, 0x66, 0x21, 0xbf, 0x93, 0xe9, 0x41,
-	0xfd, 0xca, 0x17, 0x4c, 0x13, 0xb5, 0x15, 0x80, 0x86, 0xb6, 0x2b, 0x22, 0xb9, 0x89, 0xd3, 0xa2,
-	0x76, 0x2c, 0x1d, 0x5c, 0x09, 0xc8, 0xf0, 0xee, 0x3d, 0xe7, 0xdb, 0x39, 0x43, 0xc0, 0x11, 0x49,
-	0x80, 0xd2, 0x88, 0x7a, 0x8e, 0x16, 0x3a, 0xdb, 0x80, 0x53, 0xb1, 0x9e, 0x1f, 0xeb, 0x3d, 0xe3,
-	0x97, 0x86, 0x7c, 0x05, 0x59, 0x84, 0x98, 0xfb, 0x25, 0x05, 0xce, 0x93, 0x2c, 0x81, 0x53, 0x68,
-	0xcb, 0xcf, 0x1d, 0x97, 0x40, 0x2b, 0x12, 0x6e, 0x65, 0x81, 0x71, 0x76, 0xcf, 0xd0, 0x1e, 0x80,
-	0x1b, 0x7c, 0xd3, 0xbd, 0xc4, 0xad, 0x48, 0x65, 0x12, 0xdc, 0x0e, 0x13, 0xfe, 0xc6, 0x12, 0x31,
-	0xf4, 0x53, 0x0a, 0x4c, 0x9b, 0x29, 0x1c, 0x4e, 0x70, 0xcc, 0xea, 0x31, 0x9c, 0x2d, 0xdc, 0x43,
-	0x25, 0x0d, 0x82, 0x53, 0xbb, 0x82, 0x7e, 0x26, 0x33, 0xc4, 0x30, 0x97, 0x4c, 0xd6, 0x8f, 0xe3,
-	0x1b, 0xcc, 0x11, 0x6d, 0xf8, 0xd3, 0x0a, 0xa0, 0x5a, 0x42, 0xf9, 0x16, 0xce, 0x89, 0x4f, 0x1f,
-	0xb9, 0x89, 0x81, 0xbb, 0x18, 0x25, 0xcb, 0x71, 0x4a, 0x27, 0xd8, 0x3a, 0x7b, 0x29, 0x3c, 0x5f,
-	0x3c, 0x01, 0xee, 0x75, 0x9d, 0xd3, 0x8e, 0x13, 0xbe, 0xce, 0x69, 0x10, 0x9c, 0xda, 0x15, 0xf5,
-	0xb7, 0x46, 0xb8, 0x2d, 0x98, 0xf9, 0x80, 0xbc, 0x08, 0x83, 0x9b, 0x9a, 0x7e, 0x5b, 0xdc, 0xbf,
-	0xe6, 0xf4, 0x4a, 0x5a, 0x60, 0x18, 0xb8, 0x15, 0x86, 0xff, 0x8f, 0x05, 0x56, 0xf4, 0x3c, 0xf4,
-	0xd5, 0x2c, 0xff, 0x85, 0xfd, 0xbb, 0x7b, 0xb8, 0x5f, 0x08, 0xe3, 0x94, 0x94, 0x57, 0xab, 0x98,
-	0x22, 0x45, 0x16, 0x0c, 0x5b, 0xc2, 0x74, 0x2a, 0xac, 0x5b, 0x4f, 0xe5, 0x25, 0x10, 0x98, 0x60,
-	0x03, 0xc3, 0xaf, 0x5f, 0x82, 0x03, 0x1a, 0x94, 0x5e, 0xec, 0x7e, 0x33, 0x37, 0xbd, 0xe0, 0x46,
-	0x48, 0x0e, 0xe0, 0x1a, 0xbf, 0x15, 0x22, 0x30, 0xe8, 0x69, 0x86, 0xe5, 0xf9, 0xaf, 0xe5, 0x1f,
-	0xcf, 0x4b, 0x6d, 0x9d, 0x62, 0x09, 0x2d, 0xa4, 0xec, 0xa7, 0x8b, 0x05, 0x72, 0xb4, 0x09, 0x83,
-	0xfc, 0xc5, 0xbc, 0xf8, 0x84, 0x72, 0xdf, 0xaf, 0xf1, 0x47, 0xf8, 0x7c, 0x1b, 0xf0, 0xff, 0xb1,
-	0xc0, 0x8c, 0x5e, 0x82, 0x61, 0xd7, 0x77, 0x47, 0x1b, 0xee, 0x6d, 0xea, 0x02, 0x5f, 0x34, 0xf1,
-	0xfe, 0x57, 0x38, 0xa1, 0x05, 0xf8, 0xd1, 0x26, 0x0c, 0x19, 0x42, 0xa5, 0x1a, 0xc9, 0xbf, 0xed,
-	0x7c, 0x75, 0x89, 0x69, 0x1b, 0xbe, 0xa6, 0xe4, 0x23, 0xce, 0xf2, 0x39, 0x81, 0xaf, 0xa3, 0xcf,
-	0x09, 0x7a, 0x19, 0x80, 0xf8, 0x26, 0x74, 0x77, 0x66, 0x34, 0xff, 0x96, 0x91, 0x0c, 0xf1, 0xbe,
-	0x40, 0x14, 0x14, 0xb9, 0x58, 0x22, 0x82, 0x5e, 0x91, 0x6d, 0x76, 0x63, 0xbd, 0x05, 0x01, 0x49,
-	0x86, 0xb5, 0x09, 0xaf, 0xee, 0x02, 0x73, 0x91, 0x64, 0x4a, 0x53, 0xbf, 0x04, 0x00, 0xe1, 0xb5,
-	0x2e, 0xda, 0x82, 0x61, 0x9f, 0x4a, 0x2f, 0x61, 0x78, 0xae, 0x0b, 0x30, 0xdf, 0x5e, 0xfe, 0x2f,
-	0x1c, 0xe0, 0x46, 0xa5, 0xb4, 0x70, 0x50, 0x61, 0x86, 0xdc, 0xee, 0x42, 0x41, 0xbd, 0x0c, 0xa0,
-	0x87, 0x41, 0x5e, 0xfb, 0xf2, 0xaf, 0x55, 0x10, 0x00, 0x36, 0x5c, 0x2b, 0x29, 0x46, 0xac, 0x44,
-	0x24, 0xc3, 0x2b, 0xbd, 0x3f, 0x97, 0x57, 0xfa, 0xe3, 0x70, 0x4a, 0x78, 0x01, 0x56, 0xd8, 0x2d,
-	0xa3, 0xb7, 0x27, 0x1e, 0x6e, 0x32, 0xff, 0xd0, 0x52, 0x14, 0x84, 0xe3, 0x75, 0xd1, 0xbf, 0x51,
-	0x60, 0x58, 0x17, 0x02, 0x9a, 0xe0, 0x6d, 0xcb, 0xbd, 0x5d, 0xea, 0xcf, 0xf9, 0xf2, 0x1e, 0x17,
-	0xe4, 0x9f, 0xf1, 0xb9, 0xaa, 0x5f, 0x7c, 0x44, 0x62, 0x7c, 0xd0, 0x6b, 0xf4, 0x7b, 0x0a, 0x8c,
-	0x6a, 0xa6, 0x69, 0xeb, 0x9a, 0xc7, 0xc2, 0x03, 0xf2, 0x17, 0xa5, 0xb7, 0x7a, 0x1c, 0xc5, 0x7c,
-	0x88, 0x91, 0x0f, 0xe4, 0xbd, 0x81, 0x25, 0x20, 0x84, 0x1c, 0xd1, 0x58, 0xe4, 0xee, 0xa3, 0x7f,
-	0xaa, 0xc0, 0x9b, 0xf8, 0x33, 0xde, 0x12, 0x95, 0xb9, 0xb6, 0x0c, 0x5d, 0xf3, 0x08, 0x8f, 0x59,
-	0xea, 0x3f, 0x21, 0xe3, 0x3e, 0xf4, 0xc3, 0x87, 0xf6, 0xa1, 0xbf, 0xba, 0xdf, 0x2e, 0xbe, 0xa9,
-	0xd4, 0x05, 0x6e, 0xdc, 0x55, 0x0f, 0xd0, 0x5d, 0x18, 0x37, 0xe5, 0xf8, 0xe3, 0x82, 0xc9, 0xe7,
-	0xba, 0x7e, 0x8d, 0x04, 0x32, 0xe7, 0x06, 0x86, 0x48, 0x11, 0x8e, 0x92, 0x9a, 0xbd, 0x0d, 0xe3,
-	0x91, 0x8d, 0x76, 0xac, 0x86, 0x6b, 0x0b, 0x26, 0xe3, 0xfb, 0xe1, 0x58, 0xf5, 0xbc, 0x9b, 0x30,
-	0x12, 0x08, 0x0b, 0xe8, 0x92, 0x44, 0x28, 0x14, 0xbd, 0x6e, 0x92, 0x3d, 0x4e, 0xb5, 0x18, 0xb1,
-	0xa1, 0x70, 0x23, 0x21, 0x8b, 0xde, 0x28, 0x10, 0xaa, 0x7f, 0x20, 0x6e, 0x55, 0xd7, 0x49, 0xa3,
-	0x69, 0x6a, 0x1e, 0x79, 0xfd, 0xfb, 0x21, 0xa9, 0xff, 0x59, 0xe1, 0xe7, 0x0d, 0x17, 0x6d, 0x90,
-	0x06, 0xa3, 0x0d, 0x9e, 0xfc, 0x8e, 0xc5, 0x7a, 0x54, 0xf2, 0x47, 0x99, 0x5c, 0x09, 0xd1, 0x60,
-	0x19, 0x27, 0xba, 0x03, 0x23, 0xbe, 0x30, 0xe8, 0x5b, 0x6a, 0x96, 0x7a, 0x13, 0xce, 0x02, 0xb9,
-	0x33, 0x38, 0x5a, 0xfd, 0x12, 0x17, 0x87, 0xb4, 0x54, 0x0d, 0x50, 0xb2, 0x0d, 0xba, 0x3f, 0x7c,
-	0xcf, 0xa6, 0x44, 0xd3, 0xd5, 0x24, 0xde, 0xb4, 0xf9, 0x46, 0xd6, 0x42, 0x96, 0x91, 0x55, 0xfd,
-	0xcd, 0x02, 0x4c, 0x0b, 0xd5, 0x73, 0x5e, 0xd7, 0xed, 0x96, 0xe5, 0x85, 0xcf, 0x8c, 0xf9, 0xdb,
-	0x7d, 0x41, 0x84, 0x89, 0x93, 0xfc, 0x61, 0x3f, 0x16, 0x10, 0x74, 0x8b, 0x5b, 0x3f, 0xad, 0xda,
-	0xba, 0x7d, 0x9b, 0x58, 0x21, 0x97, 0x90, 0x83, 0x64, 0x2c, 0xa6, 0x55, 0xc0, 0xe9, 0xed, 0xd0,
-	0x0e, 0xa0, 0x86, 0xb6, 0x1b, 0xc7, 0x96, 0x2f, 0x09, 0x1a, 0xd3, 0x17, 0x57, 0x12, 0xd8, 0x70,
-	0x0a, 0x05, 0x7a, 0x90, 0x52, 0x49, 0xae, 0xe9, 0x91, 0x1a, 0x1f, 0xa2, 0xef, 0xd4, 0xc1, 0x0e,
-	0xd2, 0xf9, 0x28, 0x08, 0xc7, 0xeb, 0xaa, 0x1f, 0x1d, 0x84, 0xf3, 0xd1, 0x49, 0xa4, 0x5f, 0xa8,
-	0xff, 0xbc, 0xfe, 0x49, 0xff, 0xed, 0x18, 0x9f, 0xc8, 0xfb, 0xe3, 0x6f, 0xc7, 0x66, 0x64, 0xa7,
-	0x21, 0xd1, 0x28, 0xf2, 0x8e, 0xec, 0xeb, 0xf0, 0x56, 0x3e, 0xe3, 0x71, 0x74, 0xdf, 0xb1, 0xc6,
-	0x04, 0xf8, 0x84, 0x02, 0xb3, 0xd1, 0xe2, 0x25, 0xc3, 0x32, 0xdc, 0x6d, 0x91, 0xf7, 0xe4, 0xf0,
-	0x4f, 0xd7, 0x58, 0xe6, 0xe4, 0xe5, 0x4c, 0x8c, 0xb8, 0x03, 0x35, 0xf4, 0x7d, 0x0a, 0x5c, 0x88,
-	0xcd, 0x4b, 0x24, 0x0b, 0xcb, 0xe1, 0x5f, 0xb1, 0xb1, 0xe0, 0x2e, 0xcb, 0xd9, 0x28, 0x71, 0x27,
-	0x7a, 0x9d, 0x22, 0x7e, 0x0e, 0xbe, 0x56, 0x22, 0x7e, 0xfe, 0x62, 0x01, 0x06, 0x98, 0xdf, 0xd4,
-	0xeb, 0xe3, 0xc1, 0x11, 0xeb, 0x6a, 0xa6, 0xbf, 0x6b, 0x3d, 0xe6, 0xef, 0xfa, 0x64, 0x7e, 0x12,
-	0x9d, 0x1d, 0x5e, 0xdf, 0x0b, 0x67, 0x59, 0xb5, 0xf9, 0x1a, 0x33, 0xb4, 0xb9, 0xa4, 0x36, 0x5f,
-	0xab, 0x31, 0xf5, 0xf6, 0xe0, 0x3b, 0xb2, 0x4b, 0xd0, 0xd7, 0x72, 0xcc, 0x78, 0x08, 0xd9, 0x0d,
-	0xbc, 0x8c, 0x69, 0xb9, 0xfa, 0xc3, 0x05, 0x98, 0x64, 0xb8, 0x25, 0x16, 0x83, 0x76, 0x60, 0xd8,
-	0x11, 0x6c, 0x46, 0xac, 0xcd, 0x72, 0xee, 0xa1, 0xa5, 0xb0, 0x2e, 0xae, 0xb1, 0xf9, 0xbf, 0x70,
-	0x40, 0x0b, 0x7d, 0x8f, 0x02, 0x93, 0xc4, 0xd2, 0x9d, 0x3d, 0xf6, 0xa8, 0x7b, 0xde, 0xa3, 0x5a,
-	0x73, 0x2f, 0x01, 0xab, 0x17, 0x63, 0xb8, 0x44, 0x6e, 0xc5, 0x58, 0x29, 0x4e, 0xd0, 0x54, 0xff,
-	0x74, 0x00, 0x66, 0xb2, 0x7a, 0x8f, 0x7e, 0x48, 0x81, 0xb3, 0x7a, 0x28, 0xfa, 0xce, 0xb7, 0xbc,
-	0x6d, 0xdb, 0xf1, 0x53, 0x3b, 0xe4, 0xb6, 0xcb, 0x94, 0xe6, 0x83, 0xe9, 0x61, 0xf9, 0x4c, 0x4a,
-	0xa9, 0x14, 0x70, 0x06, 0x65, 0xf4, 0x0a, 0x80, 0xeb, 0x6e, 0xdf, 0x24, 0x7b, 0x4d, 0xcd, 0xf0,
-	0x5d, 0xc8, 0x6e, 0xe6, 0xdf, 0x8f, 0xd5, 0x1b, 0x02, 0x55, 0xd0, 0x29, 0x6e, 0x34, 0x0f, 0xcb,
-	0x25, 0x72, 0xe8, 0x23, 0x0a, 0x8c, 0xdb, 0x72, 0xe0, 0x98, 0x5e, 0x5e, 0x15, 0xa4, 0x46, 0xa0,
-	0xe1, 0x22, 0x7f, 0x14, 0x14, 0x25, 0x49, 0x97, 0x65, 0xca, 0x8d, 0x1f, 0xb1, 0x82, 0x09, 0xaf,
-	0xe4, 0x13, 0xc6, 0x32, 0xce, 0x6b, 0x6e, 0x3e, 0x48, 0x82, 0x93, 0xe4, 0x59, 0xa7, 0x88, 0xa7,
-	0xd7, 0xc2, 0x3d, 0x47, 0x3b, 0x35, 0x98, 0xbf, 0x53, 0x8b, 0xeb, 0xa5, 0x72, 0x04, 0x59, 0xb4,
-	0x53, 0x49, 0x70, 0x92, 0xbc, 0xfa, 0xe1, 0x02, 0x9c, 0x63, 0xcb, 0x7c, 0xb3, 0xb5, 0x49, 0xb8,
-	0x17, 0xd8, 0x3f, 0xb8, 0x48, 0x3f, 0xbf, 0xa3, 0xc0, 0x08, 0x9b, 0x83, 0xd7, 0xc9, 0x63, 0x51,
-	0xd6, 0xd7, 0x0c, 0x4f, 0xeb, 0xdf, 0x56, 0x60, 0x2a, 0x91, 0xcc, 0xaa, 0xab, 0xa7, 0x86, 0x27,
-	0xe6, 0x04, 0xfc, 0xe6, 0x30, 0x81, 0x66, 0x5f, 0x18, 0x61, 0x23, 0x9e, 0x3c, 0x53, 0x7d, 0x16,
-	0xc6, 0x23, 0x8e, 0xd6, 0x52, 0x2c, 0xca, 0xb4, 0x28, 0x9a, 0x72, 0xa8, 0xc9, 0x42, 0xa7, 0x20,
-	0x99, 0xe1, 0x96, 0x4f, 0x72, 0xb6, 0x7f, 0x38, 0x5b, 0x1e, 0x89, 0x2d, 0xef, 0xdf, 0x27, 0xb1,
-	0x08, 0x97, 0x6e, 0x2f, 0xf7, 0x49, 0x2c, 0x54, 0xa6, 0x88, 0x48, 0xcb, 0xff, 0xc7, 0x02, 0x2b,
-	0x7a, 0x2a, 0x1a, 0x6f, 0x76, 0x35, 0x54, 0x32, 0xa7, 0xe3, 0x51, 0x62, 0xd9, 0x96, 0x4c, 0xd4,
-	0x46, 0x98, 0xdf, 0x48, 0xf1, 0xb3, 0x2c, 0x57, 0x9a, 0x9d, 0xf2, 0x6a, 0x95, 0x07, 0x22, 0x0c,
-	0x6e, 0xa2, 0xa2, 0xa6, 0xf7, 0xfe, 0x93, 0x30, 0xbd, 0x3b, 0x30, 0xba, 0x6d, 0x6c, 0x12, 0xc7,
-	0xe2, 0xf2, 0xd4, 0x40, 0x7e, 0x51, 0xf1, 0x46, 0x88, 0x86, 0xdb, 0x23, 0xa4, 0x02, 0x2c, 0x13,
-	0x41, 0x4e, 0x24, 0x6a, 0xf6, 0x60, 0x7e, 0xa9, 0x24, 0xb4, 0x91, 0x87, 0xe3, 0xcc, 0x88, 0x98,
-	0x6d, 0x01, 0x58, 0x41, 0x28, 0xd9, 0x5e, 0x6e, 0xa8, 0xc2, 0x80, 0xb4, 0x5c, 0xe8, 0x08, 0x7f,
-	0x63, 0x89, 0x02, 0x9d, 0xd7, 0x46, 0x98, 0x59, 0x41, 0xd8, 0x3b, 0x9f, 0xec, 0x31, 0xbb, 0x85,
-	0xb0, 0xf3, 0x84, 0x05, 0x58, 0x26, 0x42, 0xc7, 0xd8, 0x08, 0xf2, 0x09, 0x08, 0x7b, 0x66, 0xae,
-	0x31, 0x86, 0x59, 0x09, 0xf8, 0x18, 0xc3, 0xdf, 0x58, 0xa2, 0x80, 0x5e, 0x92, 0x2e, 0x32, 0x21,
-	0xbf, 0xb5, 0xac, 0xab, 0x4b, 0xcc, 0x77, 0x84, 0x46, 0xa3, 0x51, 0xf6, 0x9d, 0x5e, 0x90, 0x0c,
-	0x46, 0x2c, 0xcf, 0x02, 0xe5, 0x1d, 0x09, 0x03, 0x52, 0xf8, 0xbc, 0x63, 0xac, 0xe3, 0xf3, 0x8e,
-	0x12, 0x95, 0xce, 0xa4, 0x27, 0x92, 0x8c, 0x21, 0x8c, 0x87, 0xb7, 0x31, 0xd5, 0x38, 0x10, 0x27,
-	0xeb, 0x73, 0x86, 0x4f, 0x6a, 0xac, 0xed, 0x84, 0xcc, 0xf0, 0x79, 0x19, 0x0e, 0xa0, 0x68, 0x07,
-	0xc6, 0x5c, 0xe9, 0xad, 0xc8, 0xcc, 0xa9, 0x5e, 0xef, 0x32, 0xc5, 0x3b, 0x11, 0xe6, 0x94, 0x29,
-	0x97, 0xe0, 0x08, 0x9d, 0xe8, 0x45, 0xdb, 0xe4, 0xc9, 0x5e, 0xb4, 0xa1, 0x56, 0xd4, 0x0d, 0x7c,
-	0xea, 0x48, 0x02, 0xca, 0x1c, 0xe8, 0x26, 0x4e, 0x97, 0x96, 0xec, 0x36, 0x6d, 0xb7, 0xe5, 0x10,
-	0xe6, 0x7f, 0xca, 0x96, 0x07, 0x85, 0x4b, 0xbb, 0x18, 0x07, 0xe2, 0x64, 0x7d, 0xa6, 0xfb, 0xb9,
-	0x7b, 0xae, 0x47, 0x1a, 0xf4, 0xd8, 0xb2, 0x2d, 0x62, 0x79, 0xee, 0xcc, 0xe9, 0xfc, 0xba, 0x5f,
-	0x35, 0x86, 0x8b, 0x1f, 0x3b, 0xf1, 0x52, 0x9c, 0xa0, 0x49, 0x77, 0x8e, 0x1c, 0x92, 0x66, 0x66,
-	0x3a, 0xff, 0xce, 0x91, 0xc3, 0xdd, 0xf0, 0x9d, 0x23, 0x97, 0xe0, 0x08, 0x1d, 0xf4, 0x08, 0x8c,
-	0xbb, 0x7e, 0x32, 0x75, 0x36, 0x83, 0x67, 0xc2, 0xa8, 0xa1, 0x55, 0x19, 0x80, 0xa3, 0xf5, 0xd0,
-	0x07, 0x61, 0x4c, 0x3e, 0x3b, 0x67, 0xce, 0x1e, 0x75, 0xfc, 0x7c, 0xde, 0x73, 0x19, 0x14, 0x21,
-	0x88, 0x30, 0x9c, 0x95, 0x5e, 0x31, 0xca, 0xdf, 0xf7, 0x39, 0x36, 0x04, 0xae, 0xcf, 0xa6, 0xd6,
-	0xc0, 0x19, 0x2d, 0xd1, 0x8f, 0xa7, 0xdf, 0xdb, 0xcf, 0xb0, 0x2d, 0xbd, 0x76, 0x24, 0xf7, 0xf6,
-	0xcf, 0x1a, 0xde, 0xf6, 0xad, 0x26, 0x0f, 0x71, 0x76, 0xc8, 0x2b, 0x7c, 0xf5, 0x8f, 0x14, 0x80,
-	0xc0, 0x72, 0x73, 0x12, 0x77, 0x26, 0xb5, 0x88, 0x31, 0x6b, 0xa1, 0x27, 0x4b, 0x53, 0x66, 0x7a,
-	0x14, 0xf5, 0xcb, 0x0a, 0x4c, 0x84, 0xd5, 0x4e, 0x40, 0x35, 0xd2, 0xa3, 0xaa, 0xd1, 0x13, 0xbd,
-	0x8d, 0x2b, 0x43, 0x3f, 0xfa, 0x3f, 0x05, 0x79, 0x54, 0x4c, 0xfa, 0xdd, 0x89, 0xf8, 0x20, 0x50,
-	0xd2, 0x37, 0x7a, 0xf1, 0x41, 0x90, 0xc3, 0x80, 0x84, 0xe3, 0x4d, 0xf1, 0x49, 0xf8, 0xce, 0x88,
-	0xfc, 0xd9, 0x43, 0x00, 0x9e, 0x40, 0xd8, 0xf4, 0x49, 0xf3, 0x09, 0x38, 0x48, 0x18, 0x7d, 0x59,
-	0x3e, 0x9e, 0x7a, 0x48, 0x69, 0x12, 0x19, 0x70, 0x67, 0xef, 0x8f, 0x5f, 0x9c, 0x82, 0x51, 0xc9,
-	0xc8, 0x19, 0xf3, 0xa8, 0x50, 0x4e, 0xc2, 0xa3, 0xc2, 0x83, 0x51, 0x3d, 0xc8, 0x69, 0xe9, 0x4f,
-	0x7b, 0x8f, 0x34, 0x83, 0x63, 0x31, 0xcc, 0x96, 0xe9, 0x62, 0x99, 0x0c, 0x15, 0xde, 0x82, 0x3d,
-	0xd6, 0x77, 0x04, 0x7e, 0x2e, 0x9d, 0xf6, 0xd5, 0xc3, 0x00, 0xbe, 0xfc, 0x4f, 0x6a, 0x22, 0x54,
-	0x7c, 0xe0, 0xc3, 0x5d, 0x71, 0x6f, 0x04, 0x30, 0x2c, 0xd5, 0x4b, 0xde, 0xd0, 0x0f, 0x9c, 0xd8,
-	0x0d, 0x3d, 0xdd, 0x06, 0xa6, 0x9f, 0xfd, 0xbf, 0x27, 0xbf, 0xb9, 0x65, 0x1f, 0x4b, 0xb8, 0x0d,
-	0x82, 0x22, 0x17, 0x4b, 0x44, 0x32, 0x1c, 0x6b, 0x86, 0x72, 0x39, 0xd6, 0xb4, 0xe0, 0xb4, 0x43,
-	0x3c, 0x67, 0xaf, 0xb4, 0xa7, 0xb3, 0x1c, 0x2b, 0x8e, 0xc7, 0x34, 0xf8, 0xe1, 0x7c, 0x91, 0x1b,
-	0x71, 0x12, 0x15, 0x4e, 0xc3, 0x1f, 0x11, 0x80, 0x47, 0x3a, 0x0a, 0xc0, 0xef, 0x80, 0x51, 0x8f,
-	0xe8, 0xdb, 0x96, 0xa1, 0x6b, 0x66, 0xa5, 0x2c, 0x02, 0x89, 0x87, 0xb2, 0x5c, 0x08, 0xc2, 0x72,
-	0x3d, 0xb4, 0x00, 0x7d, 0x2d, 0xa3, 0x26, 0x34, 0x80, 0x6f, 0x09, 0xae, 0x0b, 0x2a, 0xe5, 0x7b,
-	0xed, 0xe2, 0x1b, 0x43, 0x4f, 0x95, 0x60, 0x54, 0xd7, 0x9a, 0xb7, 0xeb, 0xd7, 0xbc, 0xbd, 0x26,
-	0x71, 0xe7, 0x36, 0x2a, 0x65, 0x4c, 0x1b, 0xa7, 0x39, 0x1d, 0x8d, 0x1d, 0xc2, 0xe9, 0xe8, 0xd3,
-	0x0a, 0x9c, 0xd6, 0xe2, 0x37, 0x1d, 0xc4, 0x9d, 0x19, 0xcf, 0xcf, 0x2d, 0xd3, 0x6f, 0x4f, 0x16,
-	0x2e, 0x88, 0xf1, 0x9d, 0x9e, 0x4f, 0x92, 0xc3, 0x69, 0x7d, 0x40, 0x0e, 0xa0, 0x86, 0x51, 0x0f,
-	0x12, 0xf1, 0x8b, 0x55, 0x9f, 0xc8, 0x67, 0xb7, 0x59, 0x49, 0x60, 0xc2, 0x29, 0xd8, 0xd1, 0x1d,
-	0x18, 0x95, 0x84, 0x24, 0xa1, 0xc9, 0x94, 0x8f, 0xe2, 0x42, 0x86, 0x6b, 0xbb, 0xf2, 0x1d, 0x87,
-	0x4c, 0x29, 0xb8, 0x6d, 0x95, 0xcc, 0x0c, 0xe2, 0xc6, 0x91, 0x8d, 0x7a, 0x32, 0xff, 0x6d, 0x6b,
-	0x3a, 0x46, 0xdc, 0x81, 0x1a, 0x8b, 0x97, 0x48, 0xc1, 0x92, 0x6e, 0x3e, 0x33, 0x95, 0x3f, 0xe0,
-	0xcb, 0x72, 0x14, 0x15, 0xdf, 0x9a, 0xb1, 0x42, 0x1c, 0x27, 0x88, 0x96, 0x00, 0x89, 0xbb, 0xa2,
-	0x50, 0x39, 0x73, 0x67, 0x10, 0x73, 0x04, 0x60, 0x4b, 0xba, 0x98, 0x80, 0xe2, 0x94, 0x16, 0xc8,
-	0x8b, 0xd8, 0x4a, 0x7a, 0xd0, 0x72, 0xe2, 0xc9, 0x7b, 0x3a, 0x5a, 0x4c, 0xbe, 0x5b, 0x49, 0x64,
-	0x73, 0xe6, 0xca, 0xcd, 0x8d, 0xde, 0xb3, 0x39, 0x0b, 0xf2, 0xdd, 0xe4, 0x74, 0xfe, 0x9c, 0x02,
-	0xe7, 0x1a, 0xe9, 0x59, 0x2b, 0x99, 0xce, 0x93, 0xf3, 0xe2, 0x2a, 0x23, 0x11, 0x26, 0x33, 0x63,
-	0x64, 0x65, 0xc9, 0xc4, 0x59, 0x1d, 0x51, 0xff, 0x50, 0x11, 0xa6, 0xe8, 0x13, 0xf4, 0x8b, 0x3a,
-	0xee, 0x0b, 0x6b, 0xf5, 0x59, 0x98, 0xa9, 0xfa, 0xd1, 0x4e, 0x6b, 0xb1, 0xa4, 0x06, 0xef, 0x86,
-	0x71, 0x7e, 0x15, 0xb4, 0xa2, 0x35, 0x57, 0xc3, 0x7b, 0x83, 0x20, 0x2e, 0x46, 0x49, 0x06, 0xe2,
-	0x68, 0x5d, 0xf5, 0xab, 0x0a, 0x9c, 0x8b, 0x62, 0xb6, 0x1d, 0xe3, 0x6e, 0xef, 0x88, 0xd1, 0xc7,
-	0x14, 0x18, 0xbd, 0x1d, 0xdc, 0x52, 0xf9, 0xa2, 0x5b, 0xae, 0xb7, 0x23, 0x7e, 0xaf, 0x88, 0x23,
-	0x5d, 0x7b, 0x25, 0x33, 0x65, 0x86, 0x40, 0x17, 0xcb, 0xa4, 0xd5, 0xff, 0xae, 0x40, 0xc2, 0x7c,
-	0x80, 0x36, 0x61, 0x88, 0x12, 0x29, 0xaf, 0x56, 0xc5, 0x9e, 0x78, 0x77, 0x3e, 0xa9, 0x92, 0xa1,
-	0xe0, 0x97, 0x22, 0xe2, 0x07, 0xf6, 0x11, 0xa3, 0x1d, 0x1e, 0x88, 0xc0, 0xcf, 0x78, 0x24, 0xb6,
-	0x47, 0x2e, 0xb1, 0x5d, 0xce, 0x9c, 0xc4, 0xd5, 0x7a, 0xb9, 0x04, 0x47, 0xe8, 0xa8, 0xcb, 0x00,
-	0xa1, 0xc9, 0xa7, 0x67, 0x3f, 0xc3, 0xff, 0x70, 0x1a, 0xce, 0xf4, 0xfc, 0x2a, 0xf2, 0x23, 0x0a,
-	0x9c, 0x25, 0x3b, 0x86, 0xee, 0xcd, 0x6f, 0x79, 0xc4, 0xb9, 0x75, 0x6b, 0x65, 0x7d, 0xdb, 0x21,
-	0xee, 0xb6, 0x6d, 0xd6, 0xba, 0xf1, 0xaa, 0x4c, 0x71, 0x01, 0x63, 0xa6, 0x89, 0xc5, 0x54, 0x8c,
-	0x38, 0x83, 0x12, 0x33, 0x77, 0xed, 0x70, 0x43, 0x00, 0xa6, 0x3a, 0x57, 0xcb, 0x71, 0x3d, 0x11,
-	0xc0, 0x90, 0x9b, 0xbb, 0xe2, 0x40, 0x9c, 0xac, 0x1f, 0x47, 0xc2, 0xd2, 0xf9, 0x31, 0xb9, 0x5d,
-	0x49, 0x22, 0x61, 0x40, 0x9c, 0xac, 0x2f, 0x23, 0xe1, 0x2b, 0x45, 0x0f, 0xc5, 0x81, 0x24, 0x92,
-	0x00, 0x88, 0x93, 0xf5, 0x51, 0x0d, 0x2e, 0x3a, 0x44, 0xb7, 0x1b, 0x0d, 0x62, 0xd5, 0xd8, 0xa4,
-	0xac, 0x68, 0x4e, 0xdd, 0xb0, 0x96, 0x1c, 0x8d, 0x55, 0x64, 0xb7, 0x07, 0x0a, 0xcb, 0xbc, 0x7b,
-	0x11, 0x77, 0xa8, 0x87, 0x3b, 0x62, 0x41, 0x0d, 0x38, 0xd5, 0x62, 0xfc, 0xdf, 0x61, 0x01, 0xce,
-	0x76, 0x34, 0x53, 0x5c, 0x11, 0x1c, 0x76, 0xc5, 0xd8, 0x41, 0xbd, 0x11, 0x45, 0x85, 0xe3, 0xb8,
-	0xd1, 0x1e, 0x15, 0xcf, 0x45, 0x77, 0x24, 0x92, 0xc3, 0xb9, 0x48, 0x0a, 0x11, 0x3d, 0x81, 0x0e,
-	0xa7, 0xd1, 0x40, 0x15, 0x38, 0xed, 0x69, 0x4e, 0x9d, 0x78, 0xa5, 0xb5, 0x8d, 0x35, 0xe2, 0xe8,
-	0x94, 0xc7, 0x9a, 0x5c, 0x5a, 0x57, 0x38, 0xaa, 0xf5, 0x24, 0x18, 0xa7, 0xb5, 0x41, 0x1f, 0x84,
-	0x37, 0x47, 0x27, 0x75, 0xd9, 0xbe, 0x43, 0x9c, 0x05, 0xbb, 0x65, 0xd5, 0xa2, 0xc8, 0x81, 0x21,
-	0xbf, 0x7f, 0xbf, 0x5d, 0x7c, 0x33, 0xee, 0xa6, 0x01, 0xee, 0x0e, 0x6f, 0xb2, 0x03, 0x1b, 0xcd,
-	0x66, 0x6a, 0x07, 0x46, 0xb3, 0x3a, 0x90, 0xd1, 0x00, 0x77, 0x87, 0x17, 0x61, 0x38, 0xcb, 0x27,
-	0x86, 0xe7, 0x89, 0x96, 0x28, 0x8e, 0x31, 0x8a, 0xec, 0xfb, 0x5d, 0x4f, 0xad, 0x81, 0x33, 0x5a,
-	0xd2, 0x33, 0xe5, 0x6a, 0xd6, 0xf0, 0x13, 0x64, 0xc6, 0x19, 0x99, 0xb7, 0xee, 0xb7, 0x8b, 0x57,
-	0x71, 0x97, 0x6d, 0x70, 0xd7, 0xd8, 0x53, 0xba, 0x12, 0x4e, 0x44, 0xa2, 0x2b, 0x13, 0x59, 0x5d,
-	0xc9, 0x6e, 0x83, 0xbb, 0xc6, 0x8e, 0xbe, 0x57, 0x81, 0xf3, 0x7a, 0xb3, 0x75, 0xc3, 0x70, 0x3d,
-	0xbb, 0xee, 0x68, 0x8d, 0x32, 0xd1, 0xb5, 0xbd, 0x1b, 0x9a, 0xb9, 0xb5, 0x6c, 0x6c, 0x11, 0xa1,
-	0x74, 0x1c, 0xf6, 0xc3, 0x61, 0x2f, 0x80, 0x4b, 0x6b, 0x1b, 0xe9, 0x48, 0x71, 0x36, 0x3d, 0xf4,
-	0x23, 0x0a, 0x5c, 0x6c, 0xb0, 0x2e, 0x66, 0x74, 0x68, 0x32, 0x57, 0x87, 0x18, 0x17, 0x5b, 0xe9,
-	0x80, 0x17, 0x77, 0xa4, 0xca, 0x26, 0x89, 0x57, 0x98, 0xaf, 0xd7, 0x1d, 0x52, 0x67, 0x58, 0x03,
-	0xee, 0x32, 0x95, 0x7f, 0x92, 0x56, 0xb2, 0x90, 0xe2, 0x6c, 0x7a, 0xe8, 0x25, 0xb8, 0x9c, 0x09,
-	0x2c, 0xd9, 0x2d, 0xcb, 0x63, 0x97, 0x30, 0x7d, 0x0b, 0xea, 0x7e, 0xbb, 0x78, 0x79, 0xa5, 0x63,
-	0x4d, 0x7c, 0x00, 0x26, 0x16, 0x04, 0x25, 0x12, 0x8f, 0xe0, 0x34, 0x93, 0xc4, 0x5e, 0xc8, 0x95,
-	0x17, 0xf8, 0x08, 0x83, 0x10, 0x7c, 0x39, 0x1a, 0x84, 0x60, 0x9a, 0xf5, 0xea, 0xbd, 0x47, 0xd7,
-	0xab, 0x6f, 0x44, 0x1e, 0xe8, 0xe6, 0x45, 0xca, 0xa7, 0x15, 0x10, 0x0f, 0x49, 0xd1, 0xc5, 0x88,
-	0xdb, 0xd1, 0x70, 0xcc, 0xe5, 0xc8, 0x4f, 0xcf, 0x5b, 0x48, 0x4d, 0xcf, 0xfb, 0x16, 0x29, 0x1a,
-	0xf4, 0x48, 0xa8, 0x2c, 0x71, 0xcc, 0x61, 0x38, 0x68, 0xf4, 0x00, 0x8c, 0x04, 0x4a, 0xb5, 0x30,
-	0x76, 0xb2, 0xd4, 0x38, 0xa1, 0xf6, 0x1d, 0xc2, 0xd5, 0xdf, 0x57, 0x00, 0xc2, 0xac, 0xd3, 0xe8,
-	0x3e, 0x3f, 0x6a, 0x0e, 0xef, 0x60, 0x70, 0x63, 0x20, 0x47, 0xce, 0x39, 0xf8, 0x55, 0x04, 0x52,
-	0x61, 0xb0, 0xc5, 0xf2, 0x6c, 0x8a, 0x97, 0x0c, 0xcc, 0x05, 0x66, 0x83, 0x95, 0x60, 0x01, 0x41,
-	0x1b, 0x30, 0xd4, 0x30, 0x2c, 0xf6, 0xe8, 0xa4, 0x3f, 0xd7, 0xa3, 0x13, 0xa6, 0x0f, 0xac, 0x70,
-	0x14, 0xd8, 0xc7, 0xa5, 0xfe, 0x92, 0x02, 0xa7, 0xa2, 0xe1, 0xb9, 0x59, 0xec, 0x1d, 0x11, 0xb5,
-	0x55, 0x64, 0x05, 0x60, 0x4d, 0x45, 0x34, 0x4a, 0xec, 0xc3, 0xa2, 0xb7, 0xd3, 0x3d, 0xdc, 0x3e,
-	0xa4, 0x47, 0x09, 0x3f, 0xe0, 0x22, 0xe0, 0xc7, 0x4e, 0xc3, 0x20, 0x57, 0xb4, 0xa9, 0x1c, 0x9f,
-	0x12, 0x85, 0xeb, 0x66, 0xfe, 0xc4, 0x17, 0x79, 0x42, 0xf3, 0xc8, 0xe9, 0x3f, 0x0b, 0x1d, 0xd3,
-	0x7f, 0x62, 0xe8, 0xd3, 0x1d, 0xa3, 0x17, 0x4f, 0xa4, 0x12, 0xae, 0x70, 0x4f, 0xa4, 0x12, 0xae,
-	0x60, 0x8a, 0x0c, 0x79, 0x11, 0x17, 0x9d, 0xfe, 0xfc, 0x26, 0x20, 0x3e, 0x01, 0x92, 0xa3, 0xce,
-	0x44, 0x47, 0x27, 0x1d, 0x3f, 0xe4, 0xff, 0x40, 0xfe, 0x57, 0x4a, 0x62, 0xca, 0xbb, 0x09, 0xf9,
-	0xef, 0x7f, 0x48, 0x83, 0x99, 0x1f, 0xd2, 0x16, 0x0c, 0x89, 0x4f, 0x41, 0x28, 0x04, 0xef, 0xee,
-	0x21, 0xc9, 0xbd, 0x94, 0x4e, 0x8b, 0x17, 0x60, 0x1f, 0x39, 0xd5, 0x32, 0x1b, 0xda, 0xae, 0xd1,
-	0x68, 0x35, 0x98, 0x16, 0x30, 0x20, 0x57, 0x65, 0xc5, 0xd8, 0x87, 0xb3, 0xaa, 0xfc, 0x71, 0x17,
-	0x93, 0xda, 0xe5, 0xaa, 0xbc, 0x18, 0xfb, 0x70, 0xf4, 0x3c, 0x0c, 0x37, 0xb4, 0xdd, 0x6a, 0xcb,
-	0xa9, 0x13, 0xe1, 0xa0, 0x93, 0x6d, 0x14, 0x6a, 0x79, 0x86, 0x39, 0x67, 0x58, 0x9e, 0xeb, 0x39,
-	0x73, 0x15, 0xcb, 0xbb, 0xe5, 0x54, 0x3d, 0xe6, 0x00, 0xc4, 0x76, 0xdd, 0x8a, 0xc0, 0x82, 0x03,
-	0x7c, 0xc8, 0x84, 0x89, 0x86, 0xb6, 0xbb, 0x61, 0x69, 0x3c, 0x9b, 0x83, 0x90, 0xb2, 0xf3, 0x50,
-	0x60, 0x56, 0xb9, 0x95, 0x08, 0x2e, 0x1c, 0xc3, 0x9d, 0xe2, 0x0c, 0x3a, 0x76, 0x5c, 0xce, 0xa0,
-	0xf3, 0x41, 0xb8, 0x04, 0x6e, 0xd2, 0x3f, 0x9f, 0x1a, 0xa8, 0xb0, 0x63, 0x28, 0x84, 0x17, 0x83,
-	0x50, 0x08, 0x13, 0xf9, 0xbd, 0x17, 0x3b, 0x84, 0x41, 0x68, 0xc1, 0x68, 0x4d, 0xf3, 0x34, 0x5e,
-	0xea, 0xce, 0x9c, 0xca, 0x7f, 0x3b, 0x5d, 0x0e, 0xd0, 0x84, 0x2c, 0x29, 0x2c, 0x73, 0xb1, 0x4c,
-	0x07, 0xdd, 0x82, 0x33, 0xf4, 0x63, 0x35, 0x89, 0x17, 0x56, 0x61, 0x36, 0xb3, 0x49, 0xf6, 0xfd,
-	0xb0, 0xe7, 0x72, 0x37, 0xd3, 0x2a, 0xe0, 0xf4, 0x76, 0x61, 0x50, 0xdd, 0xa9, 0xf4, 0xa0, 0xba,
-	0xe8, 0x93, 0x69, 0x6e, 0x37, 0x88, 0xcd, 0xe9, 0x7b, 0xf2, 0xf3, 0x86, 0xdc, 0xce, 0x37, 0xff,
-	0x5a, 0x81, 0x19, 0xb1, 0xcb, 0x84, 0xab, 0x8c, 0x49, 0x9c, 0x15, 0xcd, 0xd2, 0xea, 0xc4, 0x11,
-	0x76, 0xf2, 0xf5, 0x1e, 0xf8, 0x43, 0x02, 0x67, 0x10, 0xa3, 0xe2, 0x4d, 0xfb, 0xed, 0xe2, 0x95,
-	0x83, 0x6a, 0xe1, 0xcc, 0xbe, 0x21, 0x07, 0x86, 0xdc, 0x3d, 0x57, 0xf7, 0x4c, 0x57, 0xc8, 0xa0,
-	0xd7, 0x7b, 0xe0, 0xac, 0x55, 0x8e, 0x89, 0xb3, 0xd6, 0x30, 0x89, 0x23, 0x2f, 0xc5, 0x3e, 0x21,
-	0xf4, 0x83, 0x0a, 0x4c, 0x89, 0xcb, 0x33, 0x29, 0x06, 0xd0, 0x99, 0xfc, 0x0f, 0x76, 0x4a, 0x71,
-	0x64, 0xbe, 0x7b, 0x0c, 0xb3, 0x26, 0x25, 0xa0, 0x38, 0x49, 0x9d, 0x1e, 0xaa, 0x4d, 0xc7, 0xb0,
-	0x1d, 0xc3, 0xdb, 0x63, 0x8e, 0x48, 0x03, 0x7e, 0x28, 0x7d, 0x5e, 0x86, 0x03, 0x28, 0xaa, 0xc2,
-	0x04, 0xb7, 0xda, 0x54, 0x3d, 0x47, 0xf3, 0x48, 0x7d, 0x4f, 0x78, 0x0b, 0x3d, 0xc0, 0x92, 0x1d,
-	0x47, 0x20, 0xf7, 0xda, 0xc5, 0x33, 0x62, 0x6d, 0xa2, 0x00, 0x1c, 0x43, 0x81, 0xde, 0x1f, 0x73,
-	0xde, 0x9a, 0xc9, 0x9f, 0x08, 0x91, 0xaf, 0xc5, 0x61, 0x5c, 0xb8, 0x7a, 0x0d, 0x6b, 0xd7, 0x43,
-	0x32, 0x9a, 0xd9, 0xc7, 0x60, 0x4c, 0xde, 0x35, 0x87, 0x8a, 0xa6, 0xe7, 0x01, 0x4a, 0x0e, 0xf6,
-	0xb8, 0x03, 0x0e, 0xa9, 0x3f, 0xad, 0xc0, 0x64, 0x5c, 0x76, 0x41, 0xdb, 0x30, 0x24, 0x18, 0x99,
-	0xa0, 0x3a, 0x9f, 0xd7, 0x6b, 0xd9, 0x24, 0xe2, 0x9d, 0x32, 0x17, 0x85, 0x45, 0x11, 0xf6, 0xd1,
-	0xcb, 0x2f, 0x12, 0x0a, 0x1d, 0x5e, 0x24, 0x3c, 0x0e, 0x67, 0xd3, 0x59, 0x1a, 0x55, 0x24, 0x34,
-	0xaa, 0x5f, 0x09, 0xa3, 0x75, 0xa0, 0x48, 0x30, 0xa5, 0x0b, 0x73, 0x98, 0xfa, 0x01, 0x88, 0x27,
-	0x61, 0x43, 0x2f, 0xc1, 0x88, 0xeb, 0x6e, 0x73, 0x3f, 0x33, 0x31, 0xc8, 0x7c, 0x57, 0x3d, 0x7e,
-	0x42, 0x1c, 0xae, 0xfb, 0x04, 0x3f, 0x71, 0x88, 0x7e, 0xe1, 0xb9, 0x2f, 0x7e, 0xf5, 0xf2, 0x1b,
-	0xfe, 0xe0, 0xab, 0x97, 0xdf, 0xf0, 0x95, 0xaf, 0x5e, 0x7e, 0xc3, 0x87, 0xf6, 0x2f, 0x2b, 0x5f,
-	0xdc, 0xbf, 0xac, 0xfc, 0xc1, 0xfe, 0x65, 0xe5, 0x2b, 0xfb, 0x97, 0x95, 0xbf, 0xdc, 0xbf, 0xac,
-	0xfc, 0xc0, 0x7f, 0xbc, 0xfc, 0x86, 0xe7, 0x1f, 0x0a, 0xa9, 0x5f, 0xf3, 0x89, 0x86, 0xff, 0x34,
-	0x6f, 0xd7, 0xa9, 0xd2, 0xea, 0xfa, 0x5a, 0x2b, 0xa3, 0xfe, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xd6, 0x36, 0x3d, 0x82, 0xf8, 0x27, 0x01, 0x00,
+	// 17376 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6d, 0x70, 0x24, 0xc9,
+	0x75, 0x18, 0xc8, 0x6a, 0x7c, 0x3f, 0x7c, 0x0c, 0x90, 0xf3, 0x85, 0xc1, 0xce, 0x4e, 0x0f, 0x6b,
+	0x49, 0x7a, 0xd7, 0xa4, 0x30, 0xe2, 0x8a, 0x14, 0xc9, 0xa5, 0x96, 0xbb, 0x40, 0x03, 0x33, 0x03,
+	0x0e, 0x80, 0x01, 0x5f, 0x03, 0xbb, 0xab, 0xa5, 0xb4, 0x54, 0x4d, 0x77, 0xa2, 0x51, 0x3b, 0xd5,
+	0x55, 0xbd, 0x55, 0xd5, 0x18, 0x60, 0x97, 0x14, 0x45, 0x4a, 0xe4, 0x51, 0x34, 0xc5, 0x93, 0x64,
+	0xc9, 0x3c, 0xea, 0xe3, 0x4c, 0x49, 0x61, 0xf9, 0xce, 0x3e, 0x7f, 0x84, 0xcf, 0xbe, 0x3b, 0x49,
+	0x21, 0x87, 0xed, 0x38, 0x87, 0x29, 0x87, 0xad, 0x50, 0x48, 0xf2, 0x59, 0xba, 0x93, 0x40, 0x09,
+	0xa7, 0x93, 0xce, 0x71, 0xbe, 0x3b, 0xdf, 0x29, 0xc2, 0x8e, 0x18, 0x2b, 0x74, 0x17, 0xf9, 0x55,
+	0x95, 0xf5, 0x05, 0x34, 0xaa, 0x81, 0x59, 0xae, 0xa5, 0x5f, 0x40, 0xe7, 0xcb, 0x7c, 0x2f, 0x33,
+	0x2b, 0xf3, 0xe5, 0xcb, 0xf7, 0x5e, 0xbe, 0x07, 0x8b, 0x2d, 0x3b, 0xdc, 0xe9, 0xde, 0x9b, 0x6f,
+	0x78, 0xed, 0x1b, 0x2d, 0xcb, 0x6f, 0x52, 0x97, 0xfa, 0xf1, 0x3f, 0x9d, 0xfb, 0xad, 0x1b, 0x56,
+	0xc7, 0x0e, 0x6e, 0x34, 0x3c, 0x9f, 0xde, 0xd8, 0x7d, 0xef, 0x3d, 0x1a, 0x5a, 0xef, 0xbd, 0xd1,
+	0x62, 0x30, 0x2b, 0xa4, 0xcd, 0xf9, 0x8e, 0xef, 0x85, 0x1e, 0x79, 0x3a, 0xc6, 0x31, 0xaf, 0x9a,
+	0xc6, 0xff, 0x74, 0xee, 0xb7, 0xe6, 0x19, 0x8e, 0x79, 0x86, 0x63, 0x5e, 0xe2, 0x98, 0xfb, 0x16,
+	0x9d, 0xae, 0xd7, 0xf2, 0x6e, 0x70, 0x54, 0xf7, 0xba, 0xdb, 0xfc, 0x17, 0xff, 0xc1, 0xff, 0x13,
+	0x24, 0xe6, 0x9e, 0xba, 0xff, 0xc1, 0x60, 0xde, 0xf6, 0x58, 0x67, 0x6e, 0x58, 0xdd, 0xd0, 0x0b,
+	0x1a, 0x96, 0x63, 0xbb, 0xad, 0x1b, 0xbb, 0x99, 0xde, 0xcc, 0x99, 0x5a, 0x55, 0xd9, 0xed, 0x23,
+	0xeb, 0xf8, 0xf7, 0xac, 0x46, 0x5e, 0x9d, 0xdb, 0x71, 0x1d, 0xba, 0x17, 0x52, 0x37, 0xb0, 0x3d,
+	0x37, 0xf8, 0x16, 0x36, 0x12, 0xea, 0xef, 0xea, 0x73, 0x93, 0xa8, 0x90, 0x87, 0xe9, 0x7d, 0x31,
+	0xa6, 0xb6, 0xd5, 0xd8, 0xb1, 0x5d, 0xea, 0xef, 0xab, 0xe6, 0x37, 0x7c, 0x1a, 0x78, 0x5d, 0xbf,
+	0x41, 0x4f, 0xd4, 0x2a, 0xb8, 0xd1, 0xa6, 0xa1, 0x95, 0x47, 0xeb, 0x46, 0x51, 0x2b, 0xbf, 0xeb,
+	0x86, 0x76, 0x3b, 0x4b, 0xe6, 0xdb, 0x8f, 0x6b, 0x10, 0x34, 0x76, 0x68, 0xdb, 0xca, 0xb4, 0xfb,
+	0xb6, 0xa2, 0x76, 0xdd, 0xd0, 0x76, 0x6e, 0xd8, 0x6e, 0x18, 0x84, 0x7e, 0xba, 0x91, 0xf9, 0x45,
+	0x03, 0xa6, 0x17, 0x36, 0x56, 0xea, 0x7c, 0x06, 0x57, 0xbd, 0x56, 0xcb, 0x76, 0x5b, 0xe4, 0xdd,
+	0x30, 0xb6, 0x4b, 0xfd, 0x7b, 0x5e, 0x60, 0x87, 0xfb, 0xb3, 0xc6, 0x75, 0xe3, 0xc9, 0xa1, 0xc5,
+	0xc9, 0xc3, 0x83, 0xea, 0xd8, 0x0b, 0xaa, 0x10, 0x63, 0x38, 0x59, 0x81, 0xf3, 0x3b, 0x61, 0xd8,
+	0x59, 0x68, 0x34, 0x68, 0x10, 0x44, 0x35, 0x66, 0x2b, 0xbc, 0xd9, 0xe5, 0xc3, 0x83, 0xea, 0xf9,
+	0xdb, 0x9b, 0x9b, 0x1b, 0x29, 0x30, 0xe6, 0xb5, 0x31, 0xff, 0x9e, 0x01, 0x33, 0x51, 0x67, 0x90,
+	0xbe, 0xd6, 0xa5, 0x41, 0x18, 0x10, 0x84, 0x4b, 0x6d, 0x6b, 0x6f, 0xdd, 0x73, 0xd7, 0xba, 0xa1,
+	0x15, 0xda, 0x6e, 0x6b, 0xc5, 0xdd, 0x76, 0xec, 0xd6, 0x4e, 0x28, 0xbb, 0x36, 0x77, 0x78, 0x50,
+	0xbd, 0xb4, 0x96, 0x5b, 0x03, 0x0b, 0x5a, 0xb2, 0x4e, 0xb7, 0xad, 0xbd, 0x0c, 0x42, 0xad, 0xd3,
+	0x6b, 0x59, 0x30, 0xe6, 0xb5, 0x31, 0xdf, 0x0f, 0x33, 0x62, 0x1c, 0x48, 0x83, 0xd0, 0xb7, 0x1b,
+	0xa1, 0xed, 0xb9, 0xe4, 0x3a, 0x0c, 0xba, 0x56, 0x9b, 0xf2, 0x1e, 0x8e, 0x2d, 0x4e, 0x7c, 0xfd,
+	0xa0, 0xfa, 0xb6, 0xc3, 0x83, 0xea, 0xe0, 0xba, 0xd5, 0xa6, 0xc8, 0x21, 0xe6, 0xbf, 0xaf, 0xc0,
+	0xd5, 0x4c, 0xbb, 0x17, 0xed, 0x70, 0xe7, 0x6e, 0x87, 0xfd, 0x17, 0x90, 0x2f, 0x1b, 0x30, 0x63,
+	0xa5, 0x2b, 0x70, 0x84, 0xe3, 0x4f, 0x2f, 0xcf, 0x9f, 0x7c, 0x83, 0xcf, 0x67, 0xa8, 0x2d, 0x5e,
+	0x91, 0xfd, 0xca, 0x0e, 0x00, 0xb3, 0xa4, 0xc9, 0x17, 0x0c, 0x18, 0xf1, 0x44, 0xe7, 0x66, 0x2b,
+	0xd7, 0x07, 0x9e, 0x1c, 0x7f, 0xfa, 0xbb, 0x4f, 0xa5, 0x1b, 0xda, 0xa0, 0xe7, 0xe5, 0xdf, 0x65,
+	0x37, 0xf4, 0xf7, 0x17, 0xcf, 0xc9, 0xee, 0x8d, 0xc8, 0x52, 0x54, 0xe4, 0xe7, 0x9e, 0x81, 0x09,
+	0xbd, 0x26, 0x99, 0x86, 0x81, 0xfb, 0x54, 0x2c, 0xd5, 0x31, 0x64, 0xff, 0x92, 0x0b, 0x30, 0xb4,
+	0x6b, 0x39, 0x5d, 0xca, 0x3f, 0xe9, 0x18, 0x8a, 0x1f, 0xcf, 0x54, 0x3e, 0x68, 0x98, 0x4f, 0xc3,
+	0xd0, 0x42, 0xb3, 0xe9, 0xb9, 0xe4, 0x29, 0x18, 0xa1, 0xae, 0x75, 0xcf, 0xa1, 0x4d, 0xde, 0x70,
+	0x34, 0xa6, 0xb7, 0x2c, 0x8a, 0x51, 0xc1, 0xcd, 0x9f, 0x1c, 0x80, 0x61, 0xde, 0x28, 0x20, 0x3f,
+	0x6a, 0xc0, 0xf9, 0xfb, 0xdd, 0x7b, 0xd4, 0x77, 0x69, 0x48, 0x83, 0x25, 0x2b, 0xd8, 0xb9, 0xe7,
+	0x59, 0x7e, 0x53, 0x7e, 0x98, 0x5b, 0x65, 0x66, 0xe4, 0x4e, 0x16, 0x9d, 0x58, 0x83, 0x39, 0x00,
+	0xcc, 0x23, 0x4e, 0x76, 0x61, 0xc2, 0x6d, 0xd9, 0xee, 0xde, 0x8a, 0xdb, 0xf2, 0x69, 0x10, 0xf0,
+	0x41, 0x8f, 0x3f, 0xfd, 0x7c, 0x99, 0xce, 0xac, 0x6b, 0x78, 0x16, 0xa7, 0x0f, 0x0f, 0xaa, 0x13,
+	0x7a, 0x09, 0x26, 0xe8, 0x90, 0xef, 0x37, 0x60, 0x4a, 0xb1, 0xcb, 0x45, 0xab, 0x71, 0xbf, 0xdb,
+	0x99, 0x1d, 0x28, 0x3f, 0x0f, 0x98, 0xc0, 0xc4, 0xe7, 0x7b, 0x91, 0x1c, 0x1e, 0x54, 0xa7, 0x92,
+	0x00, 0x4c, 0x91, 0x34, 0xff, 0xd4, 0x80, 0x73, 0x0b, 0xcd, 0xb6, 0x1d, 0x30, 0x7e, 0xbf, 0xe1,
+	0x74, 0x5b, 0x76, 0x0f, 0x1b, 0x90, 0x7c, 0x0c, 0x86, 0x1b, 0x9e, 0xbb, 0x6d, 0xb7, 0xe4, 0x6c,
+	0x7d, 0xcb, 0xbc, 0xe0, 0x9f, 0xf3, 0x3a, 0xff, 0xe4, 0x3d, 0x95, 0x7c, 0x77, 0x1e, 0xad, 0x07,
+	0xcb, 0xea, 0x58, 0x59, 0x84, 0xc3, 0x83, 0xea, 0x70, 0x8d, 0x23, 0x40, 0x89, 0x88, 0x3c, 0x09,
+	0xa3, 0x4d, 0x3b, 0x10, 0x4b, 0x6a, 0x80, 0x2f, 0xa9, 0x89, 0xc3, 0x83, 0xea, 0xe8, 0x92, 0x2c,
+	0xc3, 0x08, 0x4a, 0x56, 0xe1, 0x02, 0xfb, 0x8e, 0xa2, 0x5d, 0x9d, 0x36, 0x7c, 0x1a, 0xb2, 0xae,
+	0xcd, 0x0e, 0xf2, 0xee, 0xce, 0x1e, 0x1e, 0x54, 0x2f, 0xdc, 0xc9, 0x81, 0x63, 0x6e, 0x2b, 0xf3,
+	0x26, 0x8c, 0x2e, 0x38, 0xd4, 0x67, 0x6c, 0x89, 0x3c, 0x03, 0x53, 0xb4, 0x6d, 0xd9, 0x0e, 0xd2,
+	0x06, 0xb5, 0x77, 0xa9, 0x1f, 0xcc, 0x1a, 0xd7, 0x07, 0x9e, 0x1c, 0x13, 0x13, 0xb9, 0x9c, 0x80,
+	0x60, 0xaa, 0xa6, 0xf9, 0x19, 0x03, 0xc6, 0x17, 0xba, 0x4d, 0x3b, 0x14, 0xe3, 0x22, 0x3e, 0x8c,
+	0x5b, 0xec, 0xe7, 0x86, 0xe7, 0xd8, 0x8d, 0x7d, 0xb9, 0xc4, 0x9f, 0x2b, 0xb5, 0xe9, 0x63, 0x34,
+	0x8b, 0xe7, 0x0e, 0x0f, 0xaa, 0xe3, 0x5a, 0x01, 0xea, 0x44, 0xcc, 0x1d, 0xd0, 0x61, 0xe4, 0x3b,
+	0x61, 0x42, 0x0c, 0x77, 0xcd, 0xea, 0x20, 0xdd, 0x96, 0x7d, 0x78, 0x42, 0xfb, 0x56, 0x8a, 0xd0,
+	0xfc, 0xdd, 0x7b, 0xaf, 0xd2, 0x46, 0x88, 0x74, 0x9b, 0xfa, 0xd4, 0x6d, 0x50, 0xb1, 0x78, 0x6b,
+	0x5a, 0x63, 0x4c, 0xa0, 0x32, 0x7f, 0xbc, 0x02, 0x8f, 0x2d, 0x74, 0xc3, 0x1d, 0xea, 0x86, 0x76,
+	0xc3, 0x62, 0xdc, 0xa4, 0xe6, 0x58, 0x76, 0x7b, 0xcd, 0xea, 0x74, 0x6c, 0xb7, 0x15, 0x90, 0x4f,
+	0xc1, 0x68, 0x37, 0xa0, 0x7e, 0xb4, 0x8c, 0xc6, 0x9f, 0xae, 0x97, 0x1b, 0x7a, 0x86, 0xc4, 0x5d,
+	0x7f, 0x79, 0xaf, 0xc3, 0x36, 0x10, 0x5b, 0x48, 0x7c, 0x89, 0x6c, 0x49, 0x42, 0x18, 0x91, 0x24,
+	0x0f, 0x60, 0xb8, 0xe5, 0x7b, 0xdd, 0x8e, 0xda, 0xcd, 0x67, 0x42, 0x9c, 0xaf, 0xe2, 0x5b, 0x9c,
+	0x0c, 0x4a, 0x72, 0xe6, 0x5f, 0x31, 0xa0, 0x7a, 0x4c, 0x3b, 0x52, 0x85, 0xa1, 0x06, 0x2b, 0x94,
+	0xfb, 0x6b, 0xec, 0xf0, 0xa0, 0x3a, 0xc4, 0x6b, 0xa1, 0x28, 0x27, 0x26, 0x0c, 0x77, 0x7c, 0xba,
+	0x6d, 0xef, 0x09, 0x06, 0x2c, 0x08, 0x6d, 0xf0, 0x12, 0x94, 0x10, 0x32, 0x0f, 0x40, 0x23, 0x94,
+	0x7c, 0xc3, 0x8c, 0x2d, 0x4e, 0x1d, 0x1e, 0x54, 0x21, 0x26, 0x84, 0x5a, 0x0d, 0xf3, 0x37, 0x0d,
+	0x78, 0x7b, 0x4e, 0xc7, 0x5e, 0xb0, 0x1c, 0xbb, 0xc9, 0x7f, 0x62, 0xd7, 0xa1, 0xc7, 0x77, 0xed,
+	0x03, 0x30, 0xe9, 0xd3, 0xd7, 0xba, 0xb6, 0x4f, 0x9b, 0x2f, 0xc4, 0x47, 0xc4, 0xe2, 0xcc, 0xe1,
+	0x41, 0x75, 0x12, 0x75, 0x00, 0x26, 0xeb, 0x9d, 0xb4, 0xbf, 0xe4, 0x9d, 0x30, 0xd2, 0xa6, 0x41,
+	0x60, 0xb5, 0xd4, 0xbe, 0x1e, 0x67, 0x87, 0xcb, 0x9a, 0x28, 0x42, 0x05, 0x33, 0xff, 0xae, 0x01,
+	0x17, 0x92, 0xc3, 0x5a, 0x09, 0x82, 0x2e, 0xf5, 0xc9, 0xe3, 0x30, 0xd0, 0xf5, 0x1d, 0x39, 0x8e,
+	0x71, 0xc9, 0xc2, 0x06, 0xb6, 0x70, 0x15, 0x59, 0x39, 0x93, 0xd2, 0xd8, 0xc6, 0x61, 0x6b, 0x5d,
+	0x1c, 0xc8, 0x63, 0x42, 0x4a, 0x5b, 0x50, 0x85, 0x18, 0xc3, 0x19, 0xc3, 0x69, 0x30, 0x0e, 0xb1,
+	0xcd, 0x48, 0x50, 0x46, 0xce, 0xf3, 0x99, 0x98, 0x36, 0x10, 0x33, 0x9c, 0x5a, 0x0e, 0x1c, 0x73,
+	0x5b, 0x99, 0x7f, 0xd9, 0x80, 0xc7, 0xe5, 0xaf, 0xd7, 0xa9, 0x1f, 0x73, 0xaa, 0x68, 0xf3, 0x91,
+	0x8f, 0xc0, 0x94, 0x15, 0x55, 0x58, 0x8f, 0x39, 0xf1, 0x25, 0x39, 0x8c, 0xa9, 0x85, 0x04, 0x14,
+	0x53, 0xb5, 0xc9, 0xd3, 0x00, 0x41, 0xcc, 0x16, 0xc5, 0x17, 0x22, 0xb2, 0x2d, 0x68, 0x0c, 0x51,
+	0xab, 0x65, 0x7e, 0x83, 0xc9, 0xb2, 0xbb, 0x96, 0xed, 0x58, 0xf7, 0x6c, 0xc7, 0x0e, 0xf7, 0x5f,
+	0xf6, 0x5c, 0xda, 0xc3, 0x41, 0xb0, 0x05, 0x97, 0xbb, 0xae, 0x25, 0xda, 0x39, 0x74, 0x4d, 0xb0,
+	0xfe, 0xcd, 0xfd, 0x4e, 0x34, 0xab, 0x8f, 0x1d, 0x1e, 0x54, 0x2f, 0x6f, 0xe5, 0x57, 0xc1, 0xa2,
+	0xb6, 0x4c, 0x6c, 0xd5, 0x40, 0x2f, 0x78, 0x4e, 0xb7, 0x2d, 0xb1, 0x0e, 0x70, 0xac, 0x5c, 0x6c,
+	0xdd, 0xca, 0xad, 0x81, 0x05, 0x2d, 0xcd, 0x9f, 0xaf, 0xc0, 0xb0, 0x38, 0xf4, 0xc8, 0x7b, 0x60,
+	0xb4, 0xe3, 0x7b, 0xbb, 0x76, 0x93, 0xfa, 0x72, 0x6c, 0xd3, 0x72, 0x6c, 0xa3, 0x1b, 0xb2, 0x1c,
+	0xa3, 0x1a, 0xc4, 0x86, 0x29, 0xf5, 0x7f, 0xad, 0x8f, 0x43, 0x8f, 0x1f, 0x22, 0x1b, 0x09, 0x44,
+	0x98, 0x42, 0xcc, 0x76, 0xbe, 0x4f, 0x5b, 0xf1, 0x0e, 0xe1, 0x3b, 0x1f, 0x79, 0x09, 0x4a, 0x08,
+	0xf9, 0x04, 0x4c, 0x35, 0x7c, 0xda, 0x64, 0x2b, 0xde, 0x72, 0x02, 0xc6, 0xd7, 0x87, 0x7a, 0xe7,
+	0xeb, 0xbc, 0x13, 0xb5, 0x44, 0x73, 0x4c, 0xa1, 0x33, 0xbf, 0x5e, 0x81, 0x09, 0x31, 0x51, 0x8b,
+	0xdd, 0xc6, 0x7d, 0x1a, 0x92, 0xef, 0x81, 0x51, 0x76, 0x41, 0x6b, 0x5a, 0xa1, 0x25, 0x99, 0xf9,
+	0xb7, 0x16, 0x0e, 0x9d, 0xb3, 0x51, 0x56, 0x3b, 0xa6, 0xbe, 0x46, 0x43, 0x2b, 0x5e, 0x7f, 0x71,
+	0x19, 0x46, 0x58, 0xc9, 0x36, 0x0c, 0x06, 0x1d, 0xda, 0x90, 0x13, 0xbb, 0x54, 0x86, 0x5b, 0xeb,
+	0x3d, 0xae, 0x77, 0x68, 0x23, 0x5e, 0xae, 0xec, 0x17, 0x72, 0xfc, 0xc4, 0x85, 0xe1, 0x20, 0xb4,
+	0xc2, 0x6e, 0x20, 0x45, 0xad, 0x9b, 0x7d, 0x53, 0xe2, 0xd8, 0x16, 0xa7, 0x24, 0xad, 0x61, 0xf1,
+	0x1b, 0x25, 0x15, 0xf3, 0x5f, 0x1b, 0x30, 0xad, 0x57, 0x5f, 0xb5, 0x83, 0x90, 0x7c, 0x57, 0x66,
+	0x3a, 0xe7, 0x7b, 0x9b, 0x4e, 0xd6, 0x9a, 0x4f, 0x66, 0xb4, 0x5a, 0x55, 0x89, 0x36, 0x95, 0x14,
+	0x86, 0xec, 0x90, 0xb6, 0xd5, 0x35, 0xe3, 0xf9, 0x7e, 0x47, 0xb8, 0x38, 0x29, 0x89, 0x0d, 0xad,
+	0x30, 0xb4, 0x28, 0xb0, 0x9b, 0xdf, 0x03, 0x17, 0xf4, 0x5a, 0x6a, 0x5d, 0x33, 0x96, 0x11, 0xee,
+	0x77, 0x32, 0x2c, 0x83, 0x6d, 0x41, 0xe4, 0x10, 0xf2, 0xae, 0x68, 0x8d, 0x0b, 0xce, 0x14, 0xcd,
+	0x5d, 0x72, 0x9d, 0x9b, 0xdf, 0x37, 0x90, 0x9c, 0x3b, 0xf6, 0x19, 0xc9, 0x6e, 0x6a, 0xe7, 0x8e,
+	0x3f, 0x7d, 0xbb, 0xdf, 0x01, 0xaa, 0xae, 0x7f, 0xb3, 0xf0, 0x80, 0x27, 0x61, 0x34, 0xa0, 0xb4,
+	0xa9, 0x89, 0xb4, 0x5c, 0xca, 0xa9, 0xcb, 0x32, 0x8c, 0xa0, 0x67, 0xcf, 0x09, 0xbe, 0x36, 0x08,
+	0x24, 0xbb, 0xda, 0xf5, 0xc9, 0x10, 0x25, 0xf2, 0x53, 0xf4, 0x33, 0x19, 0x72, 0xe3, 0xa4, 0x10,
+	0x93, 0xd7, 0x61, 0xd2, 0xb1, 0x82, 0xf0, 0x6e, 0x87, 0xfa, 0xfc, 0x74, 0x97, 0xd3, 0xbe, 0x50,
+	0xe6, 0xa3, 0xaf, 0xea, 0x88, 0x84, 0xc8, 0x92, 0x28, 0xc2, 0x24, 0x29, 0xf2, 0x2a, 0x8c, 0xb1,
+	0x82, 0x65, 0xdf, 0xf7, 0x7c, 0xc9, 0x2f, 0x9e, 0x2d, 0x4b, 0x97, 0x23, 0x11, 0x22, 0x46, 0xf4,
+	0x13, 0x63, 0xf4, 0xe4, 0xa3, 0x40, 0xbc, 0x7b, 0x5c, 0x15, 0xd7, 0xbc, 0x25, 0xb4, 0x4c, 0x6c,
+	0xb0, 0xec, 0xf3, 0x0f, 0x2c, 0xce, 0xc9, 0x75, 0x49, 0xee, 0x66, 0x6a, 0x60, 0x4e, 0x2b, 0x72,
+	0x1f, 0x48, 0xa4, 0xa9, 0x12, 0xa7, 0xfd, 0x31, 0x4b, 0x23, 0xaa, 0x24, 0x97, 0xc6, 0x25, 0x46,
+	0xec, 0x56, 0x06, 0x05, 0xe6, 0xa0, 0x35, 0xff, 0x69, 0x05, 0xc6, 0xc5, 0x12, 0x11, 0xda, 0x84,
+	0xb3, 0x3f, 0x2b, 0x68, 0xe2, 0xac, 0xa8, 0x95, 0xdf, 0xfe, 0xbc, 0xc3, 0x85, 0x47, 0x45, 0x3b,
+	0x75, 0x54, 0x2c, 0xf7, 0x4b, 0xe8, 0xe8, 0x93, 0xe2, 0x5f, 0x19, 0x70, 0x4e, 0xab, 0xfd, 0x08,
+	0x0e, 0x8a, 0x66, 0xf2, 0xa0, 0x78, 0xae, 0xcf, 0xf1, 0x15, 0x9c, 0x13, 0x5e, 0x62, 0x58, 0x9c,
+	0x87, 0x3f, 0x0d, 0x70, 0x8f, 0xb3, 0x13, 0x4d, 0xb4, 0x8d, 0x3e, 0xf9, 0x62, 0x04, 0x41, 0xad,
+	0x56, 0x82, 0x29, 0x56, 0x8e, 0x62, 0x8a, 0xe6, 0xff, 0x36, 0x00, 0x33, 0x99, 0x69, 0xcf, 0xf2,
+	0x11, 0xe3, 0x4d, 0xe2, 0x23, 0x95, 0x37, 0x83, 0x8f, 0x0c, 0x94, 0xe2, 0x23, 0xbd, 0x1f, 0x44,
+	0x3e, 0x90, 0xb6, 0xdd, 0x12, 0xcd, 0xea, 0xa1, 0xe5, 0x87, 0x9b, 0x76, 0x9b, 0x4a, 0x8e, 0xf3,
+	0x17, 0x7b, 0x5b, 0xb2, 0xac, 0x85, 0x60, 0x3c, 0x6b, 0x19, 0x4c, 0x98, 0x83, 0xdd, 0xfc, 0xfe,
+	0x0a, 0x8c, 0x2c, 0x5a, 0x01, 0xef, 0xe9, 0xa7, 0x60, 0x42, 0xa2, 0x5e, 0x69, 0xb3, 0x1b, 0x63,
+	0x1f, 0xfa, 0x44, 0x89, 0x72, 0x4d, 0x43, 0x27, 0x94, 0x21, 0x7a, 0x09, 0x26, 0xc8, 0x91, 0x7d,
+	0x18, 0x6f, 0xc7, 0xb7, 0x17, 0xf9, 0x89, 0x6f, 0xf6, 0x4f, 0x9d, 0x61, 0x13, 0x1a, 0x1f, 0xad,
+	0x00, 0x75, 0x5a, 0xe6, 0x2b, 0x70, 0x3e, 0xa7, 0xc7, 0x3d, 0x5c, 0xdc, 0xde, 0x09, 0x23, 0xbb,
+	0xd4, 0x0f, 0x62, 0x31, 0x8c, 0xdf, 0xaf, 0x5f, 0x10, 0x45, 0xa8, 0x60, 0xe6, 0xb7, 0x33, 0x01,
+	0x20, 0xdd, 0xa7, 0x5e, 0x34, 0xf4, 0x43, 0x00, 0xb5, 0x05, 0xf4, 0x42, 0xb1, 0x94, 0x9e, 0x83,
+	0xa1, 0xce, 0x8e, 0x15, 0xa8, 0x16, 0x4f, 0x29, 0x56, 0xb1, 0xc1, 0x0a, 0x1f, 0x1e, 0x54, 0x67,
+	0x75, 0x41, 0x44, 0x36, 0xe2, 0x30, 0x14, 0xed, 0xd8, 0x0a, 0x63, 0x8b, 0xbc, 0xe6, 0xb5, 0x3b,
+	0x0e, 0x65, 0x50, 0xbe, 0xc2, 0x2a, 0xe5, 0x56, 0xd8, 0x6a, 0x06, 0x13, 0xe6, 0x60, 0x57, 0x34,
+	0x57, 0x5c, 0x3b, 0xb4, 0xad, 0x88, 0xe6, 0x40, 0x79, 0x9a, 0x49, 0x4c, 0x98, 0x83, 0x9d, 0x7c,
+	0xd1, 0x80, 0xb9, 0x64, 0xf1, 0x4d, 0xdb, 0xb5, 0x83, 0x1d, 0xda, 0xe4, 0xc4, 0x07, 0x4f, 0x4c,
+	0xfc, 0xda, 0xe1, 0x41, 0x75, 0x6e, 0xb5, 0x10, 0x23, 0x1e, 0x41, 0x8d, 0xfc, 0x90, 0x01, 0x8f,
+	0xa5, 0xe6, 0xc5, 0xb7, 0x5b, 0x2d, 0xea, 0xcb, 0xde, 0x9c, 0x7c, 0x83, 0x57, 0x0f, 0x0f, 0xaa,
+	0x8f, 0xad, 0x16, 0xa3, 0xc4, 0xa3, 0xe8, 0x91, 0x9f, 0x31, 0xe0, 0x52, 0x87, 0xba, 0x4d, 0xdb,
+	0x6d, 0xbd, 0xe8, 0xf9, 0xf7, 0xa9, 0x1f, 0xa0, 0xe7, 0x38, 0x5e, 0x37, 0x0c, 0x66, 0x87, 0xf9,
+	0x19, 0xb6, 0x52, 0x66, 0xcf, 0x6d, 0xe4, 0x61, 0x5c, 0xbc, 0x26, 0x97, 0xe8, 0xa5, 0x5c, 0x70,
+	0x80, 0x05, 0x1d, 0x31, 0xff, 0x87, 0x0a, 0x0c, 0xd4, 0x70, 0x85, 0xbc, 0x3b, 0xb1, 0x45, 0x2e,
+	0xeb, 0x5b, 0xe4, 0xe1, 0x41, 0x75, 0xa4, 0x86, 0x2b, 0xda, 0x66, 0xfc, 0x21, 0x03, 0x66, 0x1a,
+	0x9e, 0x1b, 0x5a, 0x6c, 0xee, 0x50, 0xc8, 0xca, 0xea, 0x5c, 0x2e, 0x75, 0x19, 0xae, 0xa5, 0x90,
+	0xc5, 0xd6, 0xaa, 0x34, 0x24, 0xc0, 0x2c, 0x65, 0x12, 0x02, 0x44, 0x85, 0x4d, 0xb9, 0xe2, 0xfb,
+	0xeb, 0x47, 0x53, 0x5c, 0x6e, 0x84, 0xca, 0x2f, 0x2e, 0x45, 0x8d, 0x8e, 0xf9, 0xb3, 0x06, 0x5c,
+	0xa8, 0x59, 0x1d, 0xa9, 0x80, 0x5a, 0xa2, 0xdb, 0x36, 0x5b, 0x96, 0xbd, 0x18, 0x04, 0xc9, 0x0e,
+	0x0c, 0x73, 0x23, 0x55, 0xd0, 0x8f, 0x06, 0x21, 0xa6, 0xcd, 0x55, 0x96, 0x81, 0xd0, 0xbe, 0x88,
+	0xff, 0x51, 0xe2, 0x37, 0x9f, 0x85, 0xe9, 0x74, 0x3d, 0x52, 0x55, 0x92, 0x94, 0xb0, 0x16, 0x8c,
+	0xa5, 0x85, 0xa0, 0x67, 0x46, 0xff, 0x8b, 0xaf, 0x55, 0xdf, 0xf6, 0x7d, 0xbf, 0x73, 0xfd, 0x6d,
+	0xe6, 0x67, 0x2b, 0x30, 0x5b, 0x73, 0xbc, 0x6e, 0x33, 0x87, 0xe1, 0x15, 0x30, 0x1c, 0xe3, 0x4c,
+	0x19, 0xce, 0x9b, 0xc0, 0x58, 0xcd, 0xdf, 0x32, 0x60, 0x82, 0x4f, 0xc2, 0x86, 0xef, 0x6d, 0xdb,
+	0x0e, 0x7d, 0x6b, 0x28, 0x98, 0xf4, 0x1e, 0x17, 0xdd, 0x1a, 0xb8, 0xc2, 0x47, 0xaf, 0xf8, 0x16,
+	0x51, 0xf8, 0xe8, 0x5d, 0x2e, 0x10, 0xe4, 0x3f, 0x0e, 0x17, 0xf5, 0x5a, 0xb1, 0xb6, 0xfa, 0x3a,
+	0x0c, 0xde, 0xb7, 0xdd, 0x66, 0x7a, 0x77, 0xde, 0xb1, 0xdd, 0x26, 0x72, 0x48, 0xb4, 0x7f, 0x2b,
+	0x85, 0xe2, 0xc2, 0x7f, 0x84, 0xe4, 0xb4, 0xf1, 0x7b, 0xc2, 0x93, 0x30, 0xda, 0xb0, 0x16, 0xbb,
+	0x6e, 0xd3, 0x89, 0xb6, 0x3e, 0x9b, 0x82, 0xda, 0x82, 0x28, 0xc3, 0x08, 0x4a, 0x5e, 0x07, 0x88,
+	0x2d, 0xbb, 0xfd, 0xc8, 0x5f, 0xb1, 0xd1, 0xb8, 0x4e, 0xc3, 0xd0, 0x76, 0x5b, 0x41, 0xbc, 0xae,
+	0x62, 0x18, 0x6a, 0xd4, 0xc8, 0xa7, 0x60, 0x52, 0x17, 0x06, 0x85, 0x86, 0xba, 0xe4, 0x67, 0x48,
+	0x48, 0x9d, 0x17, 0x25, 0xe1, 0x49, 0xbd, 0x34, 0xc0, 0x24, 0x35, 0xb2, 0x1f, 0x89, 0xbe, 0x42,
+	0x3f, 0x3e, 0x58, 0xfe, 0x32, 0xa7, 0x4b, 0x9d, 0x17, 0x24, 0xf1, 0x89, 0x84, 0xbe, 0x3e, 0x41,
+	0x2a, 0x47, 0x27, 0x36, 0x74, 0x56, 0x3a, 0x31, 0x0a, 0x23, 0x42, 0x2b, 0xa8, 0x4e, 0xfa, 0x67,
+	0xca, 0xd9, 0xc8, 0x19, 0x8a, 0xd8, 0x55, 0x41, 0xfc, 0x0e, 0x50, 0xe1, 0x26, 0xbb, 0x30, 0xc1,
+	0xee, 0x34, 0x75, 0xea, 0xd0, 0x46, 0xe8, 0xf9, 0xb3, 0x23, 0xe5, 0x5d, 0x01, 0xea, 0x1a, 0x1e,
+	0x71, 0x81, 0xd0, 0x4b, 0x30, 0x41, 0x27, 0x52, 0x9a, 0x8e, 0x16, 0x2a, 0x4d, 0xbb, 0x30, 0xbe,
+	0xab, 0x59, 0x41, 0xc6, 0xf8, 0x24, 0x7c, 0xa4, 0x4c, 0xc7, 0x62, 0x93, 0xc8, 0xe2, 0x79, 0x49,
+	0x68, 0x5c, 0x37, 0x9f, 0xe8, 0x74, 0xc8, 0x3d, 0x18, 0xb9, 0x27, 0xc4, 0xff, 0x59, 0xe0, 0x73,
+	0xf1, 0xe1, 0x3e, 0x6e, 0x35, 0xe2, 0x8a, 0x21, 0x7f, 0xa0, 0x42, 0x4c, 0x5e, 0x81, 0x61, 0xc7,
+	0x6e, 0xdb, 0x61, 0x30, 0x3b, 0xce, 0x49, 0x94, 0xfa, 0xb4, 0xab, 0x1c, 0x83, 0x38, 0xb1, 0xc5,
+	0xff, 0x28, 0xb1, 0x92, 0xaf, 0x18, 0x70, 0x5e, 0x2e, 0xc3, 0xe8, 0xe4, 0xb6, 0x69, 0x30, 0x3b,
+	0xc1, 0xe7, 0xf0, 0x76, 0x7f, 0x92, 0x42, 0x2c, 0xa5, 0x2c, 0x3e, 0x26, 0x67, 0xf3, 0xfc, 0x5a,
+	0x96, 0x18, 0xe6, 0xf5, 0x80, 0x7c, 0xce, 0x80, 0x73, 0x6c, 0xe9, 0x05, 0xa1, 0xbf, 0xbf, 0x66,
+	0xb3, 0xfb, 0x7b, 0x30, 0x3b, 0xc9, 0x7b, 0xb5, 0x58, 0x76, 0x79, 0xc7, 0xa8, 0x22, 0x99, 0xf3,
+	0x5c, 0xb2, 0x3c, 0xc0, 0x34, 0x4d, 0xf3, 0x8b, 0x33, 0x30, 0x53, 0x73, 0xba, 0x41, 0x48, 0xfd,
+	0x05, 0xe9, 0x8d, 0x48, 0x7d, 0xf2, 0x59, 0x03, 0x2e, 0xf1, 0x7f, 0x97, 0xbc, 0x07, 0xee, 0x12,
+	0x75, 0xac, 0xfd, 0x85, 0x6d, 0x56, 0xa3, 0xd9, 0x3c, 0xd9, 0x21, 0xb6, 0xd4, 0x95, 0x9a, 0x12,
+	0x6e, 0xb4, 0xab, 0xe7, 0x62, 0xc4, 0x02, 0x4a, 0xe4, 0x2f, 0x19, 0x70, 0x25, 0x07, 0xb4, 0x44,
+	0x1d, 0x1a, 0x2a, 0x31, 0xe5, 0xa4, 0xfd, 0x78, 0xfc, 0xf0, 0xa0, 0x7a, 0xa5, 0x5e, 0x84, 0x14,
+	0x8b, 0xe9, 0x91, 0x2f, 0x1b, 0x30, 0x97, 0x03, 0xbd, 0x69, 0xd9, 0x4e, 0xd7, 0x57, 0x57, 0xc3,
+	0x93, 0x76, 0x87, 0xdf, 0xd0, 0xea, 0x85, 0x58, 0xf1, 0x08, 0x8a, 0xe4, 0xd3, 0x70, 0x31, 0x82,
+	0x6e, 0xb9, 0x2e, 0xa5, 0xcd, 0xc4, 0x45, 0xf1, 0xa4, 0x5d, 0xb9, 0x72, 0x78, 0x50, 0xbd, 0x58,
+	0xcf, 0x43, 0x88, 0xf9, 0x74, 0x48, 0x0b, 0x1e, 0x8f, 0x01, 0xa1, 0xed, 0xd8, 0xaf, 0x0b, 0xd1,
+	0x72, 0xc7, 0xa7, 0xc1, 0x8e, 0xe7, 0x34, 0xf9, 0x91, 0x60, 0x2c, 0xbe, 0xfd, 0xf0, 0xa0, 0xfa,
+	0x78, 0xfd, 0xa8, 0x8a, 0x78, 0x34, 0x1e, 0xd2, 0x84, 0x89, 0xa0, 0x61, 0xb9, 0x2b, 0x6e, 0x48,
+	0xfd, 0x5d, 0xcb, 0x99, 0x1d, 0x2e, 0x35, 0x40, 0xc1, 0x88, 0x35, 0x3c, 0x98, 0xc0, 0x4a, 0x3e,
+	0x08, 0xa3, 0x74, 0xaf, 0x63, 0xb9, 0x4d, 0x2a, 0x98, 0xff, 0xd8, 0xe2, 0x55, 0x26, 0x72, 0x2c,
+	0xcb, 0xb2, 0x87, 0x07, 0xd5, 0x09, 0xf5, 0xff, 0x9a, 0xd7, 0xa4, 0x18, 0xd5, 0x26, 0x9f, 0x84,
+	0x0b, 0xdc, 0x5d, 0xb2, 0x49, 0xf9, 0x51, 0x16, 0x28, 0x49, 0x7a, 0xb4, 0x54, 0x3f, 0xb9, 0x4f,
+	0xc1, 0x5a, 0x0e, 0x3e, 0xcc, 0xa5, 0xc2, 0x3e, 0x43, 0xdb, 0xda, 0xbb, 0xe5, 0x5b, 0x0d, 0xba,
+	0xdd, 0x75, 0x36, 0xa9, 0xdf, 0xb6, 0x5d, 0xa1, 0x2f, 0xa3, 0x0d, 0xcf, 0x6d, 0xb2, 0x03, 0xc3,
+	0x78, 0x72, 0x48, 0x7c, 0x86, 0xb5, 0xa3, 0x2a, 0xe2, 0xd1, 0x78, 0xc8, 0xfb, 0x60, 0xc2, 0x6e,
+	0xb9, 0x9e, 0x4f, 0x37, 0x2d, 0xdb, 0x0d, 0x83, 0x59, 0xe0, 0x37, 0x1e, 0x3e, 0xad, 0x2b, 0x5a,
+	0x39, 0x26, 0x6a, 0x91, 0x5d, 0x20, 0x2e, 0x7d, 0xb0, 0xe1, 0x35, 0xf9, 0x12, 0xd8, 0xea, 0xf0,
+	0x85, 0x2c, 0xd9, 0xfd, 0x49, 0xa7, 0x86, 0x5f, 0x34, 0xd6, 0x33, 0xd8, 0x30, 0x87, 0x02, 0xb9,
+	0x09, 0xa4, 0x6d, 0xed, 0x2d, 0xb7, 0x3b, 0xe1, 0xfe, 0x62, 0xd7, 0xb9, 0x2f, 0xb9, 0xc6, 0x04,
+	0x9f, 0x0b, 0xa1, 0x6b, 0xcc, 0x40, 0x31, 0xa7, 0x05, 0xb1, 0xe0, 0x31, 0x31, 0x9e, 0x25, 0x8b,
+	0xb6, 0x3d, 0x37, 0xa0, 0x61, 0xa0, 0x2d, 0xd2, 0xd9, 0x49, 0xee, 0xae, 0xc6, 0x75, 0x1b, 0x2b,
+	0xc5, 0xd5, 0xf0, 0x28, 0x1c, 0x49, 0xb7, 0xe1, 0xa9, 0x63, 0xdc, 0x86, 0x3f, 0x00, 0x93, 0x41,
+	0x68, 0xf9, 0x61, 0xb7, 0x23, 0x3f, 0xc3, 0x39, 0xfe, 0x19, 0xb8, 0x2a, 0xba, 0xae, 0x03, 0x30,
+	0x59, 0x8f, 0x7d, 0x3e, 0x61, 0x6f, 0x90, 0xed, 0xa6, 0xe3, 0xcf, 0x57, 0xd7, 0xca, 0x31, 0x51,
+	0x8b, 0x6c, 0xc1, 0xe5, 0xb6, 0xb5, 0x17, 0x6d, 0xdf, 0x0d, 0xcb, 0xb7, 0x1c, 0x87, 0x3a, 0x76,
+	0xd0, 0x9e, 0x9d, 0xe1, 0x3d, 0xe5, 0x4e, 0x1e, 0x6b, 0xf9, 0x55, 0xb0, 0xa8, 0xad, 0xf4, 0x23,
+	0x5e, 0xf2, 0x2d, 0x3b, 0x81, 0x92, 0x24, 0xfc, 0x88, 0xd3, 0x60, 0xcc, 0x6b, 0x43, 0x7e, 0xda,
+	0x80, 0x2a, 0x3f, 0x87, 0x2d, 0x87, 0x6d, 0x0e, 0xee, 0x94, 0xb5, 0x68, 0x35, 0xee, 0x7b, 0xdb,
+	0xdb, 0x6a, 0xe1, 0xcc, 0x9e, 0x2f, 0xb5, 0xdc, 0x9e, 0x38, 0x3c, 0xa8, 0x56, 0x57, 0x8e, 0x46,
+	0x8d, 0xc7, 0xd1, 0x26, 0x3f, 0x62, 0xc0, 0x63, 0x72, 0xe3, 0xe6, 0xf6, 0xed, 0x42, 0xa9, 0xbe,
+	0xf1, 0x15, 0xb7, 0x56, 0x8c, 0x16, 0x8f, 0xa2, 0x49, 0x7e, 0xcc, 0x80, 0xab, 0x6e, 0x0a, 0x88,
+	0x34, 0xa0, 0x5c, 0xbd, 0xee, 0x75, 0xc3, 0xd9, 0x8b, 0xa5, 0x3a, 0x75, 0xfd, 0xf0, 0xa0, 0x7a,
+	0x75, 0xfd, 0x08, 0xbc, 0x78, 0x24, 0x55, 0xf3, 0xff, 0x1d, 0x84, 0xd9, 0x8c, 0x30, 0xa2, 0xfc,
+	0xba, 0x8f, 0x3d, 0x6e, 0x8c, 0x53, 0x3a, 0x6e, 0x3a, 0x70, 0x3d, 0xaa, 0x70, 0xab, 0xd3, 0xcd,
+	0xa5, 0x55, 0xe1, 0xb4, 0xde, 0x71, 0x78, 0x50, 0xbd, 0x5e, 0x3f, 0xa6, 0x2e, 0x1e, 0x8b, 0xad,
+	0xf8, 0x28, 0x1f, 0x78, 0x44, 0x47, 0xf9, 0x27, 0xe1, 0x82, 0x06, 0xf0, 0xa9, 0xd5, 0xdc, 0xef,
+	0x43, 0x94, 0xe0, 0x27, 0x58, 0x3d, 0x07, 0x1f, 0xe6, 0x52, 0x29, 0x3c, 0x3f, 0x87, 0x1e, 0xc5,
+	0xf9, 0x69, 0x1e, 0x0c, 0xc0, 0x58, 0xcd, 0x73, 0x9b, 0x42, 0xdf, 0xf8, 0xde, 0x84, 0x0f, 0xcb,
+	0xe3, 0xfa, 0x75, 0xec, 0xe1, 0x41, 0x75, 0x32, 0xaa, 0xa8, 0xdd, 0xcf, 0x3e, 0x14, 0x59, 0x8b,
+	0x85, 0x92, 0xe3, 0xed, 0x49, 0x33, 0xef, 0xc3, 0x83, 0xea, 0xb9, 0xa8, 0x59, 0xd2, 0xf2, 0xcb,
+	0x0e, 0x47, 0xc7, 0x0a, 0xc2, 0x4d, 0xdf, 0x72, 0x03, 0xbb, 0x0f, 0x33, 0x43, 0x64, 0xde, 0x5b,
+	0xcd, 0x60, 0xc3, 0x1c, 0x0a, 0xe4, 0x55, 0x98, 0x62, 0xa5, 0x5b, 0x9d, 0xa6, 0x15, 0xd2, 0x92,
+	0xd6, 0x85, 0xc8, 0x23, 0x71, 0x35, 0x81, 0x09, 0x53, 0x98, 0x85, 0xcf, 0x8f, 0x15, 0x78, 0x2e,
+	0xff, 0x9e, 0x09, 0x9f, 0x1f, 0x56, 0x8a, 0x12, 0x4a, 0x9e, 0x8a, 0xbd, 0x3e, 0x87, 0x79, 0xc5,
+	0xe8, 0xae, 0x9e, 0xf6, 0xfc, 0x24, 0xef, 0x81, 0xa1, 0x86, 0xd7, 0xa4, 0xc1, 0xec, 0x08, 0x3f,
+	0xc3, 0x2e, 0x71, 0x57, 0x55, 0x56, 0xf0, 0xf0, 0xa0, 0x3a, 0xc6, 0x8d, 0xa1, 0xec, 0x17, 0x8a,
+	0x4a, 0xe6, 0x5f, 0x35, 0x60, 0x3a, 0xad, 0xfa, 0xee, 0xc1, 0x57, 0xe9, 0xd1, 0xb9, 0xfd, 0x98,
+	0x5f, 0xd6, 0x7b, 0x28, 0xd5, 0xe5, 0xe4, 0x75, 0x00, 0x79, 0x59, 0x63, 0x37, 0x56, 0x83, 0xdf,
+	0x0d, 0x6f, 0xf6, 0xa7, 0x88, 0x57, 0xb7, 0xc1, 0x58, 0xb1, 0x85, 0x11, 0x05, 0xd4, 0xa8, 0x99,
+	0x7f, 0x62, 0x00, 0xc9, 0x36, 0x23, 0xef, 0x81, 0xd1, 0x6e, 0x27, 0x08, 0x7d, 0x6a, 0xb5, 0xd3,
+	0xbe, 0x93, 0x5b, 0xb2, 0x1c, 0xa3, 0x1a, 0xc4, 0x84, 0x61, 0xf1, 0xc0, 0x4c, 0x77, 0x65, 0x96,
+	0x6f, 0x94, 0x24, 0x84, 0x54, 0x61, 0x68, 0xc7, 0x0b, 0x42, 0xe5, 0xdb, 0xc9, 0xd5, 0xe7, 0xb7,
+	0x59, 0x01, 0x8a, 0x72, 0xf2, 0x8c, 0xf0, 0x87, 0xcd, 0xb8, 0xfa, 0x13, 0xe5, 0x0b, 0xab, 0xf9,
+	0xb4, 0xa6, 0x6a, 0x32, 0x51, 0xc9, 0x72, 0x1c, 0xef, 0xc1, 0x8a, 0x1b, 0xd0, 0x06, 0xbb, 0xa4,
+	0x0d, 0x71, 0x61, 0x8d, 0x8b, 0x4a, 0x0b, 0x3a, 0x00, 0x93, 0xf5, 0xcc, 0xaf, 0x18, 0x30, 0xc1,
+	0x86, 0xef, 0x7b, 0xce, 0x86, 0x63, 0xb9, 0x94, 0x7c, 0xde, 0x80, 0xe9, 0x1d, 0xbb, 0xb5, 0xa3,
+	0x7b, 0xc9, 0xca, 0x9b, 0x70, 0x29, 0x7d, 0xf2, 0xed, 0x14, 0xae, 0xc5, 0x0b, 0x87, 0x07, 0xd5,
+	0xe9, 0x74, 0x29, 0x66, 0x68, 0x9a, 0xbf, 0x57, 0x81, 0xcb, 0x7a, 0xcf, 0x16, 0xe2, 0x17, 0x84,
+	0xe4, 0x5f, 0x19, 0x00, 0x6d, 0xdb, 0xe5, 0x23, 0xe3, 0x6f, 0x73, 0xd8, 0x8a, 0xf9, 0x78, 0xd9,
+	0x15, 0x93, 0x43, 0x61, 0x7e, 0x2d, 0xc2, 0x2e, 0xdc, 0x3e, 0x5e, 0x52, 0xcb, 0x28, 0x06, 0x3c,
+	0x3c, 0xa8, 0x56, 0xb3, 0xcf, 0x16, 0xa3, 0x27, 0x2a, 0xab, 0x76, 0x10, 0x7e, 0xf6, 0x1b, 0x47,
+	0x56, 0x11, 0xfe, 0x1f, 0xf1, 0x40, 0xe6, 0xda, 0x70, 0x2e, 0x45, 0x38, 0xe7, 0xdd, 0xd2, 0x92,
+	0xfe, 0x6e, 0xe9, 0x98, 0x43, 0x63, 0x5e, 0xbd, 0x83, 0x99, 0xff, 0x58, 0xd7, 0x72, 0x43, 0x36,
+	0xd3, 0xda, 0x3b, 0xa7, 0x7f, 0x33, 0x00, 0x55, 0x7d, 0x02, 0x54, 0xbf, 0x6a, 0x9e, 0x1b, 0x74,
+	0xdb, 0x5c, 0x18, 0x21, 0x1d, 0x98, 0xbe, 0xdf, 0xbd, 0x47, 0xa3, 0x37, 0x77, 0xb5, 0x8d, 0xad,
+	0x5e, 0x14, 0x23, 0x59, 0xc2, 0xe2, 0xc3, 0xdf, 0x49, 0xe1, 0xc2, 0x0c, 0x76, 0xb2, 0x2f, 0x5e,
+	0x4f, 0x45, 0x65, 0x6b, 0xb4, 0xed, 0xf9, 0xfb, 0xe5, 0x46, 0x1b, 0x3f, 0x92, 0x4a, 0xa1, 0xc3,
+	0x3c, 0x1a, 0xa4, 0x0d, 0xe7, 0x68, 0xd8, 0x68, 0xd6, 0x43, 0xcf, 0xb7, 0x5a, 0xb4, 0x6e, 0xbf,
+	0xde, 0x93, 0x5c, 0x92, 0x43, 0xf6, 0xfc, 0xe1, 0x41, 0xf5, 0xdc, 0xf2, 0x66, 0x6d, 0x49, 0x43,
+	0x85, 0x69, 0xdc, 0x8f, 0xf2, 0x6c, 0x32, 0x7f, 0xb7, 0x02, 0x17, 0xe4, 0xb7, 0x76, 0xa8, 0xbf,
+	0x44, 0x3b, 0x8e, 0xb7, 0xdf, 0xa6, 0xee, 0xa3, 0x70, 0x7b, 0x56, 0x07, 0x50, 0xa5, 0xf0, 0x00,
+	0x6a, 0x67, 0x0e, 0xa0, 0x81, 0x32, 0x07, 0x50, 0x34, 0x17, 0xc7, 0xe8, 0xd9, 0x11, 0x2e, 0xd9,
+	0x2e, 0xeb, 0xe8, 0x2d, 0xce, 0x1c, 0xe2, 0xc7, 0x09, 0x7c, 0xfe, 0x47, 0x85, 0x0a, 0x6f, 0x25,
+	0xb7, 0x06, 0x16, 0xb4, 0x34, 0xff, 0xc8, 0x80, 0xd9, 0xbc, 0xf9, 0x7d, 0x04, 0xa6, 0xb1, 0x76,
+	0xd2, 0x34, 0x76, 0xbb, 0x0f, 0x3e, 0x98, 0xe8, 0x7a, 0x81, 0x89, 0xec, 0x0f, 0x2b, 0x70, 0x29,
+	0xae, 0xbe, 0xe2, 0x06, 0xa1, 0xe5, 0x38, 0xe2, 0xb2, 0x75, 0xf6, 0x6b, 0xa9, 0x93, 0xb0, 0x70,
+	0xae, 0xf7, 0x37, 0x54, 0xbd, 0xef, 0x85, 0x1e, 0x92, 0x7b, 0x29, 0x0f, 0xc9, 0x8d, 0x53, 0xa4,
+	0x79, 0xb4, 0xb3, 0xe4, 0xff, 0x61, 0xc0, 0x5c, 0x7e, 0xc3, 0x47, 0xb0, 0xa8, 0xbc, 0xe4, 0xa2,
+	0xfa, 0xe8, 0xe9, 0x8d, 0xba, 0x60, 0x59, 0xfd, 0xbd, 0x4a, 0xd1, 0x68, 0xb9, 0x99, 0x74, 0x3b,
+	0x32, 0x22, 0x88, 0x4b, 0xff, 0xc9, 0x1e, 0xfa, 0xa5, 0xad, 0x04, 0x0a, 0x07, 0xa6, 0x91, 0x92,
+	0x75, 0x18, 0x09, 0x28, 0x6d, 0x32, 0xfc, 0x95, 0xde, 0xf1, 0x47, 0x02, 0x7c, 0x5d, 0xb4, 0x45,
+	0x85, 0x84, 0x7c, 0x17, 0x4c, 0x36, 0xa3, 0x1d, 0xc5, 0xb0, 0x0e, 0xf4, 0x8e, 0x95, 0x8b, 0x6f,
+	0x4b, 0x7a, 0x6b, 0x4c, 0x22, 0x63, 0xd2, 0xeb, 0xd5, 0xa3, 0xd6, 0x16, 0x79, 0x8d, 0xfb, 0xb8,
+	0x88, 0x1b, 0x99, 0x12, 0xad, 0x9f, 0x2d, 0xf9, 0x2d, 0x05, 0x96, 0x78, 0x83, 0x46, 0x45, 0x01,
+	0x6a, 0x44, 0x72, 0xfc, 0xe6, 0x2b, 0x67, 0xe4, 0x37, 0x6f, 0xfe, 0x5b, 0x43, 0x67, 0x45, 0xfa,
+	0xb7, 0x7d, 0xab, 0xb1, 0x22, 0xbd, 0xef, 0x85, 0x6e, 0x17, 0xbf, 0x51, 0x81, 0xeb, 0xf9, 0x4d,
+	0xb4, 0xf3, 0xfc, 0x79, 0x18, 0xee, 0x88, 0xc7, 0xb8, 0xe2, 0x71, 0xd5, 0x93, 0xfc, 0x59, 0x25,
+	0x2f, 0x79, 0x78, 0x50, 0x9d, 0xcb, 0x63, 0xf4, 0xf2, 0x91, 0xad, 0x6c, 0x47, 0xec, 0x94, 0x7d,
+	0x58, 0x08, 0x25, 0xdf, 0xd6, 0x23, 0x73, 0xb1, 0xee, 0x51, 0xa7, 0x67, 0x93, 0xf0, 0x67, 0x0c,
+	0x98, 0x4a, 0xac, 0xe8, 0x60, 0x76, 0x88, 0xaf, 0xd1, 0x52, 0x2e, 0xcb, 0x89, 0xad, 0x12, 0x4b,
+	0x03, 0x89, 0xe2, 0x00, 0x53, 0x04, 0x53, 0x6c, 0x56, 0x9f, 0xd5, 0xb7, 0x1c, 0x9b, 0xd5, 0x3b,
+	0x5f, 0xc0, 0x66, 0x7f, 0xaa, 0x52, 0x34, 0x5a, 0xce, 0x66, 0x1f, 0xc0, 0x98, 0x92, 0x66, 0xfb,
+	0xbe, 0x89, 0x2b, 0x12, 0xf2, 0x25, 0xfe, 0x8c, 0xec, 0xcf, 0x98, 0x2a, 0x09, 0x30, 0xa6, 0x45,
+	0x7e, 0xc0, 0x00, 0x88, 0x3f, 0x8c, 0xdc, 0x54, 0x9b, 0xa7, 0x37, 0x1d, 0x9a, 0x58, 0xc3, 0xbd,
+	0xf3, 0xb4, 0x45, 0xa1, 0xd1, 0x35, 0xff, 0xc7, 0x21, 0xa1, 0x0e, 0x48, 0xf6, 0xbd, 0x37, 0xef,
+	0x9f, 0x63, 0x84, 0xdc, 0x0e, 0x4c, 0xfb, 0x4c, 0x5a, 0x6c, 0xd8, 0x0e, 0x55, 0xca, 0xe7, 0x72,
+	0x5a, 0x47, 0x7e, 0x93, 0xc2, 0x14, 0x2e, 0xcc, 0x60, 0x27, 0xef, 0x84, 0x91, 0x8e, 0x6f, 0xb7,
+	0x2d, 0x7f, 0x5f, 0xea, 0x03, 0xb8, 0x6b, 0xc2, 0x86, 0x28, 0x42, 0x05, 0x23, 0x9f, 0x84, 0x31,
+	0xc7, 0xde, 0xa6, 0x8d, 0xfd, 0x86, 0x43, 0xa5, 0xc5, 0xf1, 0xee, 0xe9, 0x7c, 0xf3, 0x55, 0x85,
+	0x56, 0xfa, 0xf2, 0xab, 0x9f, 0x18, 0x13, 0x24, 0x2b, 0x70, 0xfe, 0x01, 0xf7, 0x2e, 0x75, 0x68,
+	0x10, 0xd4, 0xbb, 0x9d, 0x8e, 0xe7, 0x87, 0xb4, 0xc9, 0xed, 0x92, 0xa3, 0xe2, 0xfa, 0xf6, 0x62,
+	0x16, 0x8c, 0x79, 0x6d, 0xc8, 0xb3, 0x00, 0x56, 0x37, 0xf4, 0x44, 0x6c, 0x8e, 0xd9, 0x51, 0xae,
+	0x67, 0x79, 0x9c, 0x7d, 0xec, 0x85, 0xa8, 0xf4, 0xe1, 0x41, 0x75, 0x5c, 0xea, 0xdd, 0xf9, 0xa7,
+	0xd1, 0x1a, 0x90, 0x8f, 0xc3, 0x85, 0x86, 0x00, 0xd5, 0xbc, 0x76, 0xc7, 0x0a, 0x6d, 0xa9, 0xfd,
+	0x18, 0xe3, 0x88, 0xfe, 0x02, 0x7f, 0x00, 0x9d, 0x03, 0x4f, 0xa3, 0xcc, 0x45, 0x42, 0x1c, 0x18,
+	0xb2, 0x9a, 0xcd, 0xc8, 0xc3, 0xe4, 0xce, 0xe9, 0x4c, 0xb0, 0x88, 0x80, 0xc1, 0x75, 0x49, 0xfc,
+	0x5f, 0x14, 0x44, 0xcc, 0x2f, 0x1b, 0x91, 0xf2, 0x24, 0x5d, 0xbb, 0xb7, 0xb8, 0x17, 0x22, 0x80,
+	0x50, 0x1f, 0x71, 0x2f, 0xea, 0x1c, 0x01, 0x4a, 0x44, 0xe6, 0x17, 0x2b, 0xf0, 0xd8, 0x11, 0xeb,
+	0x83, 0x20, 0xe3, 0x3b, 0x72, 0xf9, 0xca, 0x9e, 0xbd, 0x4f, 0xf0, 0x0a, 0x59, 0xf8, 0xf0, 0xa0,
+	0xfa, 0xc4, 0x11, 0x08, 0xea, 0x6c, 0x9b, 0xd3, 0xd6, 0x3e, 0xc6, 0x68, 0xc8, 0x0a, 0x0c, 0x37,
+	0x63, 0x0f, 0x8a, 0xb1, 0xc5, 0xf7, 0xb2, 0x7e, 0x09, 0x5b, 0x67, 0xaf, 0xd8, 0x24, 0x02, 0xb2,
+	0x0a, 0x23, 0xe2, 0x71, 0x06, 0x95, 0xa7, 0xea, 0xd3, 0x5c, 0x5b, 0x2b, 0x8a, 0x7a, 0x45, 0xa6,
+	0x50, 0x98, 0xff, 0xc1, 0x80, 0x91, 0x9a, 0xe7, 0xd3, 0xa5, 0xf5, 0x3a, 0xd9, 0x87, 0x71, 0x2d,
+	0x36, 0x96, 0x3c, 0x61, 0x4a, 0xb2, 0x5c, 0x8e, 0x51, 0xd3, 0x62, 0xa9, 0x38, 0x1a, 0x51, 0x01,
+	0xea, 0xb4, 0xc8, 0x6b, 0x6c, 0xce, 0x1f, 0xf8, 0x76, 0xc8, 0x08, 0xf7, 0xe3, 0x32, 0x2a, 0x08,
+	0xa3, 0xc2, 0x25, 0x36, 0x7b, 0xf4, 0x13, 0x63, 0x2a, 0xe6, 0x06, 0xe3, 0xae, 0xe9, 0x6e, 0x92,
+	0x67, 0x60, 0xb0, 0xed, 0x35, 0xd5, 0x77, 0x7f, 0x97, 0x5a, 0x91, 0x6b, 0x5e, 0x93, 0xcd, 0xed,
+	0xa5, 0x6c, 0x0b, 0xee, 0x95, 0xc0, 0xdb, 0x98, 0xeb, 0x30, 0x9d, 0xa6, 0x4f, 0x9e, 0x81, 0xa9,
+	0x86, 0xd7, 0x6e, 0x7b, 0x6e, 0xbd, 0xbb, 0xbd, 0x6d, 0xef, 0xd1, 0x44, 0x80, 0x93, 0x5a, 0x02,
+	0x82, 0xa9, 0x9a, 0xe6, 0xef, 0x1a, 0x30, 0xc0, 0xbe, 0x8b, 0x09, 0xc3, 0x4d, 0xaf, 0x6d, 0xd9,
+	0xae, 0xec, 0x15, 0x5f, 0xd4, 0x4b, 0xbc, 0x04, 0x25, 0x84, 0x3c, 0x0f, 0xd3, 0x6d, 0xcb, 0xb5,
+	0x5a, 0x94, 0x1d, 0x1d, 0x02, 0x26, 0x97, 0x07, 0xe7, 0xd0, 0x6b, 0x29, 0x18, 0x66, 0x6a, 0x93,
+	0x0e, 0x8c, 0x29, 0x91, 0xb6, 0xaf, 0x17, 0x6a, 0x4b, 0xeb, 0xf5, 0xe8, 0x81, 0x6f, 0x74, 0xce,
+	0xaa, 0x92, 0x00, 0x63, 0x22, 0xa6, 0x05, 0x33, 0x4b, 0xeb, 0xf5, 0x15, 0xb7, 0xe1, 0x74, 0x9b,
+	0x74, 0x79, 0x8f, 0xff, 0x61, 0x07, 0x85, 0x2d, 0x4a, 0xe4, 0x4c, 0xf1, 0x83, 0x42, 0x56, 0x42,
+	0x05, 0x63, 0xd5, 0xa8, 0x68, 0x21, 0xc3, 0x1e, 0xf0, 0x6a, 0x12, 0x09, 0x2a, 0x98, 0xf9, 0x5b,
+	0x15, 0x18, 0xd7, 0x3a, 0x44, 0x1c, 0x18, 0x11, 0x13, 0x16, 0xf4, 0x13, 0x9b, 0x2a, 0xd3, 0x6b,
+	0x41, 0x5d, 0x4c, 0x64, 0x80, 0x8a, 0x84, 0x7e, 0xe8, 0x55, 0x8e, 0x38, 0xf4, 0xe6, 0x13, 0xd1,
+	0x23, 0xb4, 0x48, 0x1d, 0xf9, 0x91, 0x23, 0xc8, 0x55, 0x79, 0xbe, 0x0b, 0x9d, 0xfc, 0x68, 0xea,
+	0x6c, 0xdf, 0x86, 0xa1, 0xd7, 0x3d, 0x97, 0x06, 0xd2, 0x90, 0x77, 0x4a, 0x03, 0xe4, 0x7c, 0xfd,
+	0x65, 0x86, 0x17, 0x05, 0x7a, 0xf3, 0x67, 0x0c, 0x80, 0x25, 0x2b, 0xb4, 0x84, 0x2b, 0x63, 0x0f,
+	0xac, 0xfc, 0x6a, 0x42, 0x2c, 0x19, 0xcd, 0x3c, 0x52, 0x1f, 0x0c, 0x94, 0x92, 0x53, 0x7b, 0x9d,
+	0x28, 0xb0, 0x73, 0x9d, 0x25, 0x87, 0x93, 0x77, 0xc3, 0x18, 0x75, 0x1b, 0xfe, 0x7e, 0x87, 0x9d,
+	0xcc, 0x42, 0x47, 0xc6, 0xf7, 0xf8, 0xb2, 0x2a, 0xc4, 0x18, 0x6e, 0xbe, 0x17, 0x92, 0x77, 0xd6,
+	0x1e, 0xde, 0x51, 0xfd, 0xa9, 0x01, 0x97, 0x97, 0xba, 0x96, 0xb3, 0xd0, 0x61, 0x0b, 0xd5, 0x72,
+	0x6e, 0x7a, 0xc2, 0x17, 0x8d, 0x5d, 0xe4, 0xde, 0x03, 0xa3, 0x4a, 0x4a, 0x4c, 0x5b, 0x62, 0x14,
+	0xab, 0xc5, 0xa8, 0x06, 0xb1, 0x60, 0x34, 0x50, 0xf7, 0x96, 0x4a, 0x1f, 0xf7, 0x16, 0x45, 0x22,
+	0xba, 0xb7, 0x44, 0x68, 0x85, 0xf6, 0x90, 0x7f, 0xa0, 0x3a, 0xf5, 0x77, 0xed, 0x06, 0x5d, 0x68,
+	0x34, 0xbc, 0xae, 0x1b, 0x06, 0x32, 0xa0, 0x93, 0xd4, 0x1e, 0xe6, 0xd5, 0xc0, 0x82, 0x96, 0xe6,
+	0xd7, 0x0d, 0x18, 0x5c, 0xde, 0xac, 0x2d, 0x91, 0xef, 0x82, 0xc1, 0x88, 0xe9, 0x94, 0x74, 0x7d,
+	0x65, 0x78, 0xe4, 0x3b, 0x14, 0xfe, 0xbd, 0xd7, 0x18, 0xeb, 0xe1, 0x58, 0xc9, 0x3d, 0x18, 0xa6,
+	0xbb, 0x94, 0x75, 0xb5, 0x72, 0x2a, 0xf8, 0x39, 0x53, 0x5c, 0xe6, 0x18, 0x51, 0x62, 0x36, 0xbf,
+	0x64, 0x00, 0xc4, 0x55, 0xc8, 0xf7, 0xe6, 0x9d, 0x6f, 0x77, 0x4e, 0xd1, 0x54, 0x73, 0xf4, 0x21,
+	0x67, 0xfe, 0xc3, 0x21, 0xb8, 0xc2, 0xba, 0x23, 0x97, 0xaa, 0xed, 0xb9, 0x77, 0xe8, 0xfe, 0x9f,
+	0xbf, 0xd8, 0xfb, 0xf3, 0x17, 0x7b, 0xa7, 0xf8, 0x62, 0xef, 0xe3, 0x70, 0x85, 0x2d, 0x39, 0x09,
+	0xa6, 0xdc, 0x77, 0x75, 0xc3, 0xa7, 0x1d, 0xcb, 0xa7, 0x4d, 0x7e, 0xa1, 0x1a, 0x15, 0xde, 0xb8,
+	0x0b, 0x45, 0x95, 0xb0, 0xb8, 0xbd, 0xf9, 0x3b, 0x06, 0x4c, 0xc7, 0x8b, 0x77, 0x21, 0x44, 0x1a,
+	0x84, 0x8c, 0x21, 0x27, 0xaf, 0xe9, 0x63, 0x4a, 0xe8, 0xca, 0xb9, 0x5a, 0xff, 0x84, 0x01, 0x33,
+	0xd1, 0xd3, 0xe2, 0x0d, 0xdf, 0xd3, 0x03, 0x00, 0x6e, 0x95, 0xe2, 0x00, 0xa9, 0xee, 0xac, 0xa5,
+	0x91, 0x2f, 0x5e, 0x3c, 0x3c, 0xa8, 0xce, 0x64, 0x8a, 0x31, 0xdb, 0x0d, 0xf3, 0xd7, 0x0c, 0x78,
+	0xfb, 0xb1, 0xf8, 0x48, 0x1d, 0x2e, 0x4a, 0xe1, 0xb9, 0x19, 0x1b, 0x29, 0xbb, 0xae, 0x8a, 0xef,
+	0xa9, 0x9c, 0x57, 0x2e, 0xae, 0xe5, 0x55, 0xc2, 0xfc, 0xb6, 0xe4, 0x05, 0xb8, 0xe4, 0x53, 0xc6,
+	0x17, 0x19, 0xd3, 0x48, 0x60, 0x15, 0x41, 0x3e, 0xa3, 0xc7, 0x91, 0x98, 0x5b, 0x0b, 0x0b, 0x5a,
+	0x9b, 0x7f, 0x23, 0xf1, 0xc5, 0x24, 0x1f, 0x3c, 0xd1, 0x17, 0xb3, 0x61, 0xe0, 0x7e, 0x3b, 0xe8,
+	0x47, 0xb3, 0x78, 0x67, 0xad, 0x9e, 0xee, 0x82, 0xd2, 0x1e, 0x8c, 0x1c, 0x1e, 0x54, 0x07, 0xee,
+	0xac, 0xd5, 0x91, 0xd1, 0x30, 0x1f, 0xb2, 0xce, 0xee, 0x75, 0x6c, 0x9f, 0x07, 0x92, 0x12, 0xef,
+	0xa1, 0xc9, 0x53, 0xf1, 0xb3, 0x69, 0x23, 0xe9, 0xa0, 0x92, 0x7e, 0x3a, 0x4d, 0xb6, 0x61, 0x8a,
+	0xf2, 0xe6, 0x5c, 0xc9, 0x62, 0x85, 0x65, 0x98, 0x9f, 0x08, 0x3c, 0x98, 0xc0, 0x82, 0x29, 0xac,
+	0xa4, 0x0e, 0x53, 0x0d, 0xc7, 0x0a, 0x02, 0x11, 0x68, 0x2c, 0x8e, 0x1d, 0xf5, 0x6e, 0x2e, 0xd3,
+	0x27, 0x20, 0x0f, 0x0f, 0xaa, 0x17, 0x65, 0x3f, 0x93, 0x00, 0x4c, 0xa1, 0x30, 0xbf, 0x5a, 0x81,
+	0xc9, 0xe5, 0xbd, 0x8e, 0x17, 0x74, 0x7d, 0xca, 0xab, 0x3e, 0x02, 0xb5, 0xf1, 0x53, 0x30, 0xb2,
+	0x63, 0xb9, 0x4d, 0x27, 0x72, 0x16, 0x89, 0xe6, 0xf6, 0xb6, 0x28, 0x46, 0x05, 0x27, 0x6f, 0x00,
+	0xb0, 0xeb, 0x73, 0xb3, 0xcb, 0x8f, 0xce, 0x81, 0xf2, 0x47, 0x67, 0x62, 0x8c, 0xf5, 0x08, 0xa5,
+	0x14, 0x78, 0xa3, 0xdf, 0xa8, 0x91, 0x33, 0x7f, 0xdb, 0x80, 0x99, 0x44, 0xbb, 0x47, 0xa0, 0x0d,
+	0xdd, 0x4e, 0x6a, 0x43, 0x17, 0xfa, 0x1e, 0x6b, 0x81, 0x12, 0xf4, 0x0b, 0x15, 0xb8, 0x5c, 0x30,
+	0x27, 0x99, 0xd7, 0x51, 0xc6, 0x23, 0x7a, 0x1d, 0xd5, 0x85, 0xf1, 0xd0, 0x73, 0x64, 0x54, 0x0a,
+	0x35, 0x03, 0xa5, 0x04, 0xb4, 0xcd, 0x08, 0x4d, 0xfc, 0xf6, 0x29, 0x2e, 0x0b, 0x50, 0xa7, 0x63,
+	0xfe, 0x13, 0x03, 0xc6, 0x22, 0xd5, 0xcd, 0x37, 0x95, 0xaf, 0x58, 0xef, 0xb1, 0x52, 0xcd, 0x7f,
+	0x51, 0x81, 0x4b, 0x11, 0x6e, 0xc5, 0x51, 0xeb, 0x21, 0xe3, 0x1b, 0xc7, 0x6b, 0x6e, 0xaf, 0x26,
+	0xde, 0x6d, 0x8e, 0x66, 0x23, 0x48, 0x74, 0xba, 0x7e, 0xc7, 0x0b, 0xd4, 0x2d, 0x49, 0x5c, 0x27,
+	0x45, 0x11, 0x2a, 0x18, 0x59, 0x87, 0xa1, 0x80, 0xd1, 0x93, 0x92, 0xd0, 0x09, 0x67, 0x83, 0x5f,
+	0xf4, 0x78, 0x7f, 0x51, 0xa0, 0x21, 0x6f, 0xe8, 0xc7, 0xc5, 0x50, 0x79, 0xdb, 0x00, 0x1b, 0x49,
+	0x74, 0xec, 0x69, 0x96, 0xc5, 0xa3, 0x74, 0xf1, 0xe6, 0x2a, 0x4c, 0xcb, 0xa7, 0x37, 0x62, 0xd9,
+	0xb8, 0x0d, 0x4a, 0x3e, 0x98, 0x58, 0x19, 0xef, 0x48, 0x79, 0x8b, 0x5e, 0x48, 0xd7, 0x8f, 0x57,
+	0x8c, 0x19, 0xc0, 0xe8, 0x2d, 0xd9, 0x49, 0x32, 0x07, 0x15, 0x5b, 0x7d, 0x0b, 0x90, 0x38, 0x2a,
+	0x2b, 0x4b, 0x58, 0xb1, 0x7b, 0x78, 0x3f, 0xab, 0x1f, 0x4b, 0x03, 0x47, 0x1f, 0x4b, 0xe6, 0x1f,
+	0x54, 0xe0, 0x82, 0xa2, 0xaa, 0xc6, 0xb8, 0x24, 0x9d, 0x51, 0x8e, 0xb9, 0x32, 0x1f, 0xaf, 0xc9,
+	0xbf, 0x0b, 0x83, 0x9c, 0x01, 0x96, 0x72, 0x52, 0x89, 0x10, 0xb2, 0xee, 0x20, 0x47, 0x44, 0x3e,
+	0x09, 0xc3, 0x0e, 0xbb, 0x7f, 0xaa, 0x87, 0xad, 0xa5, 0xec, 0x1e, 0x79, 0xc3, 0x15, 0xd7, 0x5a,
+	0x19, 0x2c, 0x3b, 0xf2, 0x33, 0x10, 0x85, 0x28, 0x69, 0xce, 0x7d, 0x08, 0xc6, 0xb5, 0x6a, 0x27,
+	0x8a, 0x94, 0xfd, 0x93, 0x15, 0x98, 0xbd, 0x4d, 0x9d, 0x76, 0xae, 0x67, 0x51, 0x15, 0x86, 0x1a,
+	0x3b, 0x96, 0x2f, 0x84, 0xb4, 0x09, 0x19, 0x66, 0x95, 0x15, 0xa0, 0x28, 0x67, 0xd7, 0xd1, 0x44,
+	0x3c, 0x83, 0x8f, 0x68, 0x33, 0x19, 0x47, 0xe7, 0xff, 0x44, 0x14, 0xbe, 0x3f, 0x1e, 0x78, 0xa2,
+	0x02, 0x3b, 0x5e, 0x3e, 0x5a, 0xbf, 0xbb, 0x9e, 0x17, 0xc9, 0x80, 0xbc, 0x0e, 0x93, 0x5e, 0xc3,
+	0x46, 0xda, 0xf1, 0x02, 0x3b, 0xf4, 0xfc, 0x7d, 0xf9, 0xd1, 0x4a, 0x1d, 0x2d, 0x77, 0x6b, 0x2b,
+	0x31, 0x22, 0x61, 0x9d, 0x4f, 0x14, 0x61, 0x92, 0x94, 0xf9, 0x0d, 0x03, 0xc6, 0x6f, 0xdb, 0xf7,
+	0xa8, 0x2f, 0x5e, 0x17, 0x71, 0xfd, 0x59, 0x22, 0x9c, 0xf8, 0x78, 0x5e, 0x28, 0x71, 0xb2, 0x07,
+	0x63, 0xf2, 0x1c, 0x8e, 0xc2, 0x63, 0xdc, 0x2a, 0xe7, 0x7a, 0x19, 0x91, 0x96, 0xe7, 0x9b, 0xb6,
+	0xf1, 0x55, 0x49, 0x80, 0x31, 0x31, 0x72, 0x43, 0x2a, 0x62, 0xc5, 0xee, 0x7a, 0x4c, 0x53, 0xc2,
+	0x9e, 0xd3, 0x90, 0x69, 0xda, 0xd7, 0x37, 0xe0, 0x7c, 0x0e, 0x15, 0xf6, 0xe5, 0xf9, 0x8b, 0x1c,
+	0x3d, 0xc0, 0x2e, 0x7f, 0xb1, 0x83, 0xa2, 0x9c, 0x5c, 0x81, 0x01, 0xea, 0x36, 0xe5, 0x16, 0xe3,
+	0x02, 0xe9, 0xb2, 0xdb, 0x44, 0x56, 0xc6, 0xb8, 0xbe, 0xe3, 0x25, 0x44, 0x3c, 0xce, 0xf5, 0x57,
+	0x65, 0x19, 0x46, 0x50, 0xee, 0xed, 0x9c, 0x76, 0x24, 0x65, 0x17, 0xd5, 0xe9, 0xed, 0x14, 0x33,
+	0xea, 0xc7, 0x7f, 0x35, 0xcd, 0xd8, 0x16, 0x67, 0xe5, 0x0c, 0x66, 0x58, 0x24, 0x66, 0xe8, 0x9a,
+	0xbf, 0x38, 0x08, 0x8f, 0xdf, 0xf6, 0x7c, 0xfb, 0x75, 0xcf, 0x0d, 0x2d, 0x67, 0xc3, 0x6b, 0xc6,
+	0x6f, 0x3d, 0xe4, 0x19, 0xf7, 0x39, 0x03, 0x2e, 0x37, 0x3a, 0x5d, 0xf9, 0xf4, 0x46, 0x3e, 0x97,
+	0xd8, 0xa0, 0xbe, 0xed, 0x95, 0x7d, 0x7f, 0xca, 0x5f, 0x29, 0xd5, 0x36, 0xb6, 0xf2, 0x50, 0x62,
+	0x11, 0x2d, 0xfe, 0x0c, 0xb6, 0xe9, 0x3d, 0x70, 0x79, 0xe7, 0xea, 0x21, 0x9f, 0xcd, 0xd7, 0xe3,
+	0x8f, 0x50, 0xf2, 0x19, 0xec, 0x52, 0x2e, 0x46, 0x2c, 0xa0, 0x44, 0x3e, 0x0d, 0x17, 0xe5, 0x13,
+	0x23, 0xa4, 0x56, 0xd3, 0x76, 0x69, 0x10, 0x88, 0x37, 0x74, 0x7d, 0xbc, 0xf3, 0x5c, 0xc9, 0x43,
+	0x88, 0xf9, 0x74, 0xc8, 0x2b, 0x00, 0xc1, 0xbe, 0xdb, 0x90, 0xf3, 0x5f, 0xee, 0x51, 0x86, 0x90,
+	0xa9, 0x23, 0x2c, 0xa8, 0x61, 0x64, 0x97, 0xc0, 0x30, 0x5a, 0x94, 0xc3, 0xfc, 0x61, 0x0d, 0xbf,
+	0x04, 0xc6, 0x6b, 0x28, 0x86, 0x9b, 0x5f, 0x32, 0x60, 0x6a, 0xc5, 0xdd, 0x70, 0xac, 0x06, 0x15,
+	0x7e, 0x9c, 0x6c, 0x7f, 0x8e, 0x05, 0x91, 0x85, 0x54, 0xb0, 0x90, 0x78, 0x43, 0x47, 0xb6, 0xd1,
+	0xb8, 0x0e, 0x7f, 0x7c, 0x66, 0xbb, 0xf2, 0x74, 0xbc, 0xe9, 0xf9, 0x02, 0x91, 0xdc, 0x77, 0xe2,
+	0xf1, 0x59, 0x16, 0x8c, 0x79, 0x6d, 0xcc, 0xbf, 0x63, 0xc0, 0x85, 0x64, 0x77, 0xa4, 0x8b, 0xd1,
+	0x5f, 0x31, 0xe0, 0x42, 0x22, 0x4c, 0x90, 0x04, 0xf7, 0x13, 0xb9, 0x74, 0x23, 0x07, 0x9f, 0x78,
+	0xee, 0x92, 0x07, 0xc1, 0x5c, 0xfa, 0xe6, 0x7f, 0x63, 0xc0, 0x88, 0x4a, 0x43, 0xf0, 0xae, 0x94,
+	0x39, 0x27, 0x3a, 0x0b, 0x53, 0x26, 0x9d, 0x7d, 0x11, 0x13, 0x48, 0x9c, 0x65, 0xf2, 0x58, 0x2a,
+	0xa5, 0xcd, 0x97, 0x84, 0xe3, 0x83, 0x31, 0xe1, 0x37, 0xa5, 0x6c, 0x85, 0x1a, 0x31, 0xf3, 0x6b,
+	0x06, 0xcc, 0x64, 0x5a, 0xf5, 0x20, 0xbf, 0x3e, 0xc2, 0xd7, 0x1b, 0xbf, 0x31, 0xc8, 0x96, 0x64,
+	0xc8, 0xf8, 0xbd, 0x23, 0xec, 0x24, 0x8f, 0xe0, 0xc2, 0xfc, 0x6e, 0x18, 0xb3, 0xdb, 0xed, 0x6e,
+	0xc8, 0x8d, 0xfa, 0x43, 0xb1, 0xf1, 0x61, 0x45, 0x15, 0x62, 0x0c, 0x27, 0xae, 0x14, 0xcd, 0xc4,
+	0xb1, 0xb9, 0x5a, 0xee, 0xcb, 0xe9, 0x03, 0x9c, 0x67, 0x62, 0x94, 0x90, 0x9f, 0xf2, 0x24, 0xb7,
+	0xcf, 0x1b, 0x00, 0x41, 0xe8, 0xdb, 0x6e, 0x8b, 0x15, 0x4a, 0xf1, 0x0d, 0x4f, 0x81, 0x6c, 0x3d,
+	0x42, 0x2a, 0x88, 0xc7, 0x91, 0xcd, 0x23, 0x00, 0x6a, 0x94, 0xc9, 0x82, 0x94, 0x5a, 0xc5, 0x91,
+	0xf9, 0x2d, 0x29, 0xf9, 0xfc, 0xf1, 0x9c, 0x57, 0x08, 0x82, 0x50, 0x2c, 0xd6, 0xce, 0x7d, 0x00,
+	0xc6, 0x22, 0x7a, 0xc7, 0x49, 0x81, 0x13, 0x9a, 0x14, 0x38, 0xf7, 0x2c, 0x9c, 0x4b, 0x75, 0xf7,
+	0x44, 0x42, 0xe4, 0xff, 0x62, 0x00, 0x49, 0x8e, 0xfe, 0x11, 0xa8, 0x1a, 0x5a, 0x49, 0x55, 0xc3,
+	0x62, 0xff, 0x9f, 0xac, 0x40, 0xd7, 0xf0, 0x0b, 0x03, 0x30, 0xfd, 0xd1, 0x17, 0x37, 0xb5, 0xf0,
+	0xfd, 0x9e, 0x4f, 0x3a, 0x30, 0x6c, 0xf3, 0x08, 0xfe, 0xfd, 0x30, 0xc9, 0xbc, 0x8c, 0x00, 0x31,
+	0x63, 0x13, 0xbf, 0x51, 0xd2, 0x21, 0x5f, 0x30, 0x60, 0xb2, 0xa1, 0x27, 0xaf, 0x90, 0x5c, 0xe2,
+	0xee, 0x29, 0xe5, 0x8c, 0x50, 0x68, 0x85, 0x58, 0x9c, 0x28, 0xc2, 0x24, 0x61, 0xf2, 0xd7, 0x0d,
+	0xb8, 0xd0, 0xc8, 0xa6, 0x65, 0x50, 0x31, 0x85, 0xb6, 0x4e, 0xa9, 0x47, 0x49, 0xec, 0x8b, 0x57,
+	0xe5, 0xc4, 0x5c, 0xc8, 0x01, 0x06, 0x98, 0xdb, 0x21, 0xf3, 0x8f, 0x0d, 0x98, 0x2b, 0xd6, 0xa4,
+	0xf6, 0x70, 0x97, 0x7c, 0x0f, 0x8c, 0x52, 0xb7, 0xd9, 0xf1, 0x6c, 0xa9, 0x54, 0xd6, 0x8c, 0x97,
+	0xcb, 0xb2, 0x1c, 0xa3, 0x1a, 0x27, 0xb6, 0x49, 0x6f, 0xc1, 0x48, 0xd8, 0x97, 0x23, 0x19, 0xbf,
+	0x7f, 0x28, 0xff, 0x31, 0x85, 0xcb, 0xfc, 0xed, 0xf3, 0x90, 0x7c, 0x32, 0x23, 0x65, 0x55, 0x26,
+	0x5a, 0xc7, 0x21, 0xa1, 0xe4, 0x59, 0xd3, 0x87, 0x68, 0x7d, 0x27, 0x85, 0x2b, 0x16, 0xad, 0xd3,
+	0x10, 0xcc, 0xd0, 0x25, 0x3f, 0x68, 0xc0, 0xb4, 0x95, 0xcc, 0xe8, 0xa3, 0xf6, 0x72, 0xa9, 0x60,
+	0xc9, 0xa9, 0xec, 0x40, 0x71, 0x5f, 0x52, 0x80, 0x00, 0x33, 0x64, 0xc9, 0xfb, 0x60, 0xc2, 0xea,
+	0xd8, 0x51, 0x52, 0x0d, 0xf9, 0xc2, 0x8f, 0x2b, 0xfc, 0x16, 0x36, 0x56, 0xe2, 0x64, 0x1b, 0x89,
+	0x5a, 0x51, 0xea, 0x9c, 0x5a, 0xfc, 0xf4, 0xa4, 0x9f, 0xd4, 0x39, 0x72, 0x0e, 0xe3, 0xd4, 0x39,
+	0x72, 0xea, 0x74, 0x22, 0xc4, 0x05, 0xf0, 0xec, 0x66, 0x43, 0x92, 0x1c, 0x2e, 0x6f, 0x04, 0xbe,
+	0xbb, 0xb2, 0x54, 0xd3, 0x83, 0x1d, 0xc6, 0xbf, 0x51, 0xa3, 0x40, 0xbe, 0x62, 0xc0, 0xa4, 0x94,
+	0x36, 0x24, 0xcd, 0x11, 0xfe, 0x89, 0x5e, 0x2e, 0xbb, 0x5e, 0x52, 0x6b, 0x72, 0x1e, 0x75, 0xe4,
+	0xe2, 0xa4, 0x8c, 0x22, 0x8a, 0x25, 0x60, 0x98, 0xec, 0x07, 0x97, 0x5a, 0x83, 0x84, 0x15, 0x5e,
+	0x76, 0x70, 0xb4, 0x3c, 0x43, 0xae, 0xe7, 0xe0, 0x93, 0x4f, 0xc4, 0x73, 0x20, 0x98, 0x4b, 0x9f,
+	0xdd, 0xc4, 0xce, 0x3d, 0xb0, 0xc2, 0xc6, 0x4e, 0xcd, 0x6a, 0xec, 0x70, 0x27, 0x0c, 0x11, 0xd7,
+	0xa4, 0xe4, 0xba, 0x7e, 0x31, 0x89, 0x4a, 0xbc, 0x4c, 0x4b, 0x15, 0x62, 0x9a, 0x20, 0xf1, 0x60,
+	0xd4, 0x97, 0xc9, 0xf5, 0xa4, 0xc3, 0x62, 0xb9, 0x7c, 0x72, 0xe9, 0x4c, 0x7d, 0xe2, 0x2e, 0xaf,
+	0x7e, 0x61, 0x44, 0x84, 0xb4, 0xe0, 0x71, 0xa1, 0xfe, 0x58, 0x70, 0x3d, 0x77, 0xbf, 0xed, 0x75,
+	0x83, 0x24, 0x47, 0xe7, 0x61, 0x54, 0x46, 0x45, 0xc8, 0x83, 0xe5, 0xa3, 0x2a, 0xe2, 0xd1, 0x78,
+	0xc8, 0x4b, 0x30, 0xca, 0x1d, 0x15, 0x36, 0x37, 0x57, 0x79, 0x88, 0x94, 0x93, 0x33, 0x4d, 0x3e,
+	0x84, 0x65, 0x89, 0x03, 0x23, 0x6c, 0xe4, 0x3e, 0x8c, 0x38, 0x22, 0x3b, 0x22, 0x0f, 0x95, 0x52,
+	0x92, 0x29, 0xa6, 0x33, 0x2d, 0x0a, 0x1e, 0x2d, 0x7f, 0xa0, 0xa2, 0x40, 0x3a, 0x70, 0xbd, 0x49,
+	0xb7, 0xad, 0xae, 0x13, 0xae, 0x7b, 0x21, 0xf2, 0xf8, 0x02, 0x91, 0x52, 0x5f, 0x45, 0xc3, 0x99,
+	0xe2, 0xf1, 0xb0, 0x79, 0xe4, 0x86, 0xa5, 0x63, 0xea, 0xe2, 0xb1, 0xd8, 0xc8, 0x3e, 0x3c, 0x21,
+	0xeb, 0xf0, 0x80, 0x06, 0x8d, 0x1d, 0x36, 0xcb, 0x59, 0xa2, 0xe7, 0x38, 0xd1, 0xbf, 0x70, 0x78,
+	0x50, 0x7d, 0x62, 0xe9, 0xf8, 0xea, 0xd8, 0x0b, 0x4e, 0xfe, 0x26, 0x99, 0xa6, 0xce, 0xe0, 0xd9,
+	0xe9, 0xf2, 0x73, 0x9c, 0x3e, 0xcf, 0x85, 0xbb, 0x5e, 0xba, 0x14, 0x33, 0x34, 0xc9, 0xcf, 0x1b,
+	0x30, 0x1b, 0x84, 0x7e, 0xb7, 0x11, 0x76, 0x7d, 0xda, 0x4c, 0xad, 0xd0, 0x19, 0xde, 0xa1, 0x52,
+	0x57, 0x8e, 0x7a, 0x01, 0x4e, 0x1e, 0x97, 0x69, 0xb6, 0x08, 0x8a, 0x85, 0x7d, 0x21, 0x3f, 0x6b,
+	0xc0, 0xe5, 0x24, 0xd0, 0xf3, 0x95, 0x3e, 0x87, 0x94, 0xb7, 0x23, 0xd6, 0xf3, 0x51, 0x0a, 0x9d,
+	0x53, 0x01, 0x10, 0x8b, 0x3a, 0x92, 0x76, 0x0d, 0x3a, 0xff, 0x88, 0x5d, 0x83, 0xc8, 0xdf, 0x31,
+	0xe0, 0xb2, 0x64, 0x40, 0x8b, 0x56, 0x40, 0x9b, 0x5a, 0x45, 0x19, 0xaa, 0xe6, 0xa5, 0xbe, 0x8f,
+	0x29, 0xcc, 0xc7, 0x2f, 0x66, 0xac, 0x00, 0x88, 0x45, 0xbd, 0x9a, 0x7b, 0x1e, 0x48, 0xf6, 0xc0,
+	0x3b, 0xee, 0xae, 0x35, 0xaa, 0xdf, 0xb5, 0xfe, 0xb5, 0x01, 0xef, 0xe8, 0xa5, 0x83, 0xe4, 0x25,
+	0x98, 0xb5, 0x76, 0xa9, 0x6f, 0xb5, 0xa8, 0xe2, 0xd6, 0x1b, 0xd4, 0x17, 0x1b, 0x52, 0x3a, 0x5d,
+	0xf0, 0xb5, 0xb9, 0x50, 0x50, 0x07, 0x0b, 0x5b, 0x93, 0x2d, 0xb8, 0x2c, 0x61, 0x51, 0xd6, 0x54,
+	0x75, 0xd4, 0x54, 0xe2, 0x38, 0x4b, 0x0b, 0xf9, 0x55, 0xb0, 0xa8, 0xad, 0xf9, 0x0b, 0x72, 0x64,
+	0xb1, 0xee, 0x44, 0xf8, 0xdf, 0x32, 0x49, 0xa1, 0xd1, 0xf5, 0x7d, 0xea, 0x86, 0xf5, 0x7d, 0xb7,
+	0x11, 0x30, 0x29, 0x5b, 0x7b, 0x68, 0x22, 0xc6, 0x72, 0xc4, 0x93, 0x10, 0x56, 0xdf, 0xa7, 0x1d,
+	0xc7, 0x6e, 0x58, 0x75, 0xaa, 0x5c, 0x43, 0xa6, 0x44, 0x44, 0x09, 0x55, 0x8a, 0x5a, 0x0d, 0xf2,
+	0x5e, 0x18, 0xe7, 0x36, 0xbc, 0xed, 0xae, 0xc3, 0x1a, 0x0c, 0xf0, 0x06, 0x7c, 0x25, 0xd6, 0xe3,
+	0x62, 0xd4, 0xeb, 0x98, 0x7f, 0x7f, 0x18, 0x1e, 0x2b, 0xea, 0xfb, 0x37, 0x9d, 0xe4, 0xfd, 0xb7,
+	0x0d, 0xb8, 0xbc, 0x93, 0xaf, 0xd4, 0x96, 0x77, 0xca, 0x8f, 0x95, 0xb2, 0x56, 0x1c, 0xa5, 0x27,
+	0x17, 0x07, 0xff, 0x91, 0x55, 0xb0, 0xa8, 0x53, 0xe4, 0x79, 0x98, 0x76, 0xbd, 0x26, 0xad, 0xad,
+	0x2c, 0xe1, 0x9a, 0x15, 0xdc, 0x8f, 0x9e, 0xf5, 0x0f, 0x09, 0xbe, 0xbf, 0x9e, 0x82, 0x61, 0xa6,
+	0x36, 0xd9, 0x05, 0xd2, 0xf1, 0x9a, 0xcb, 0xbb, 0x22, 0x1b, 0x6d, 0x7f, 0x8f, 0x77, 0xb8, 0xd7,
+	0xdd, 0x46, 0x06, 0x1b, 0xe6, 0x50, 0xe0, 0x5a, 0x79, 0xd6, 0x99, 0x35, 0xcf, 0xb5, 0x43, 0xcf,
+	0xe7, 0x11, 0xeb, 0xfa, 0x52, 0x4e, 0x73, 0xad, 0xfc, 0x7a, 0x2e, 0x46, 0x2c, 0xa0, 0xc4, 0x64,
+	0xf8, 0x73, 0x8d, 0xe4, 0x1e, 0x92, 0x37, 0x87, 0xd2, 0xec, 0xf1, 0xb8, 0x3d, 0x2a, 0xa4, 0xd4,
+	0x54, 0x21, 0xa6, 0x7b, 0x61, 0xfe, 0x3b, 0x03, 0xce, 0x31, 0x74, 0x1b, 0xbe, 0xb7, 0xb7, 0xff,
+	0xcd, 0xb8, 0x55, 0x9e, 0x92, 0xf6, 0x34, 0x71, 0xf5, 0xbf, 0xa8, 0xd9, 0xd3, 0xc6, 0x78, 0x9f,
+	0x63, 0x4b, 0x9a, 0x6e, 0x1b, 0x1c, 0x28, 0xb6, 0x0d, 0x9a, 0x5f, 0xa9, 0x88, 0xbb, 0xb9, 0x32,
+	0xb5, 0x7d, 0x53, 0x72, 0x88, 0x0f, 0xc0, 0x24, 0x2b, 0x5b, 0xb3, 0xf6, 0x36, 0x96, 0x5e, 0xf0,
+	0x9c, 0x40, 0x4f, 0x9f, 0x79, 0x47, 0x07, 0x60, 0xb2, 0x1e, 0x79, 0x06, 0x46, 0x3a, 0x22, 0x34,
+	0xb6, 0xd4, 0x7e, 0x5c, 0x17, 0xbe, 0xfb, 0xbc, 0xe8, 0xe1, 0x41, 0x75, 0x26, 0xf6, 0xd3, 0x51,
+	0x01, 0xba, 0x55, 0x03, 0xf3, 0x17, 0x2f, 0x01, 0x47, 0xee, 0xd0, 0xf0, 0x9b, 0x71, 0x4e, 0xde,
+	0x0b, 0xe3, 0x8d, 0x4e, 0xb7, 0x76, 0xb3, 0xfe, 0xb1, 0xae, 0x17, 0x5a, 0xf2, 0x69, 0x02, 0x3f,
+	0x15, 0x6a, 0x1b, 0x5b, 0xaa, 0x18, 0xf5, 0x3a, 0x8c, 0x6f, 0x35, 0x3a, 0x5d, 0xb9, 0x43, 0x36,
+	0xf4, 0x37, 0xbd, 0x9c, 0x6f, 0xd5, 0x36, 0xb6, 0x12, 0x30, 0xcc, 0xd4, 0x26, 0x9f, 0x86, 0x09,
+	0x2a, 0x59, 0xca, 0x6d, 0xcb, 0x6f, 0x4a, 0x8e, 0xb5, 0x52, 0x76, 0xf0, 0xd1, 0xd4, 0x2a, 0x3e,
+	0x25, 0x74, 0x1c, 0xcb, 0x1a, 0x09, 0x4c, 0x10, 0x24, 0x1f, 0x87, 0x2b, 0xea, 0x37, 0xfb, 0xca,
+	0x5e, 0x33, 0xcd, 0xc2, 0x86, 0x84, 0x67, 0xec, 0x72, 0x51, 0x25, 0x2c, 0x6e, 0x4f, 0xfe, 0xa6,
+	0x01, 0x97, 0x22, 0xa8, 0xed, 0xda, 0xed, 0x6e, 0x1b, 0xa9, 0x48, 0xec, 0x2a, 0xf8, 0xd3, 0x8b,
+	0xa7, 0x36, 0xd0, 0x24, 0x7a, 0xc1, 0x46, 0xf3, 0x61, 0x58, 0xd0, 0x25, 0xf2, 0x35, 0x03, 0xae,
+	0x2b, 0xd0, 0x86, 0x4f, 0x83, 0xa0, 0xeb, 0xd3, 0x38, 0x56, 0x99, 0x9c, 0x92, 0x91, 0x52, 0x5c,
+	0x9d, 0x5f, 0xf1, 0x96, 0x8f, 0xc1, 0x8d, 0xc7, 0x52, 0xd7, 0x97, 0x4b, 0xdd, 0xdb, 0x0e, 0xa5,
+	0x2a, 0xe4, 0xac, 0x96, 0x0b, 0x23, 0x81, 0x09, 0x82, 0x5c, 0x22, 0xd7, 0x0b, 0xf4, 0xd5, 0x32,
+	0xd6, 0xdf, 0x91, 0x93, 0xe9, 0x4c, 0x0a, 0xbf, 0x90, 0x3a, 0x0b, 0x80, 0x58, 0xd4, 0x2b, 0x9e,
+	0xc0, 0x97, 0x2f, 0x4c, 0xa1, 0x27, 0x19, 0x92, 0x09, 0x7c, 0x45, 0x11, 0x2a, 0x18, 0x79, 0x1f,
+	0x4c, 0x74, 0xbc, 0xe6, 0x86, 0xdd, 0x0c, 0x78, 0xd8, 0x6e, 0xae, 0xcd, 0x18, 0x10, 0xd3, 0xb1,
+	0xe1, 0x35, 0x37, 0x56, 0x96, 0x44, 0x39, 0x26, 0x6a, 0x31, 0xc9, 0x73, 0xdb, 0xb2, 0x9d, 0xfa,
+	0x03, 0xab, 0x73, 0x57, 0xc5, 0x5f, 0xe5, 0x92, 0xe7, 0xcd, 0xa8, 0x14, 0xb5, 0x1a, 0xec, 0xfb,
+	0x31, 0xbe, 0x83, 0x54, 0x24, 0xb9, 0xe2, 0x0a, 0x80, 0xd3, 0xf8, 0x7e, 0x0a, 0xa1, 0xe8, 0xf0,
+	0x1d, 0x8d, 0x04, 0x26, 0x08, 0x92, 0xcf, 0x19, 0x30, 0x15, 0xec, 0x07, 0x21, 0x6d, 0x47, 0x7d,
+	0x38, 0x77, 0xda, 0x7d, 0xe0, 0x76, 0xca, 0x7a, 0x82, 0x08, 0xa6, 0x88, 0xf2, 0x48, 0xb6, 0x6d,
+	0xab, 0x45, 0x6f, 0xd5, 0x6e, 0xdb, 0xad, 0x9d, 0x28, 0xfa, 0xe4, 0x06, 0xf5, 0x1b, 0x4c, 0xe6,
+	0x9f, 0xe6, 0x5f, 0x4a, 0x44, 0xb2, 0x2d, 0xae, 0x86, 0x47, 0xe1, 0x20, 0xaf, 0xc0, 0x9c, 0x04,
+	0xaf, 0x7a, 0x0f, 0x32, 0x14, 0x44, 0xc0, 0x58, 0xfe, 0xc6, 0x61, 0xa5, 0xb0, 0x16, 0x1e, 0x81,
+	0x81, 0xac, 0xc0, 0xf9, 0x80, 0xfa, 0xdc, 0x4f, 0x43, 0x24, 0x41, 0xd8, 0xe8, 0x3a, 0x4e, 0xc0,
+	0x2f, 0xef, 0xf2, 0x59, 0x74, 0x3d, 0x0b, 0xc6, 0xbc, 0x36, 0xe4, 0xd9, 0x38, 0xfe, 0x3a, 0x2b,
+	0xf8, 0xd8, 0x46, 0x9d, 0xdf, 0xb5, 0x87, 0x84, 0x94, 0x85, 0x49, 0x10, 0xa6, 0xeb, 0x8a, 0x64,
+	0xd8, 0x32, 0x9a, 0x5e, 0xd7, 0x0f, 0x42, 0x7e, 0x37, 0x1e, 0x52, 0xc9, 0xb0, 0x35, 0x00, 0x26,
+	0xeb, 0x91, 0x67, 0x60, 0x2a, 0xa0, 0x8d, 0x86, 0xd7, 0xee, 0x48, 0x4d, 0x10, 0x8f, 0xb5, 0x3a,
+	0x2a, 0xbf, 0x60, 0x02, 0x82, 0xa9, 0x9a, 0x64, 0x1f, 0xce, 0x47, 0x39, 0x73, 0x56, 0xbd, 0xd6,
+	0x9a, 0xb5, 0xc7, 0xc5, 0xf6, 0x4b, 0xe5, 0x83, 0x80, 0xd5, 0xb2, 0xe8, 0x30, 0x8f, 0x06, 0xcf,
+	0x83, 0x9d, 0x2c, 0xbe, 0x69, 0x3b, 0x34, 0x98, 0xbd, 0xcc, 0x87, 0x2d, 0xf2, 0x60, 0xe7, 0xc0,
+	0x31, 0xb7, 0x15, 0xb9, 0x0b, 0x17, 0x3b, 0xbe, 0x17, 0xd2, 0x46, 0x78, 0x87, 0x09, 0x04, 0x8e,
+	0x1c, 0x60, 0x30, 0x3b, 0xcb, 0xe7, 0x82, 0xfb, 0xa8, 0x6c, 0xe4, 0x55, 0xc0, 0xfc, 0x76, 0xe4,
+	0x27, 0x0c, 0xb8, 0x26, 0xc2, 0x0e, 0xda, 0x6e, 0xab, 0xe6, 0xb9, 0x2e, 0xe5, 0x8c, 0x69, 0xa5,
+	0x19, 0x47, 0x15, 0xb8, 0x52, 0xea, 0x14, 0x31, 0x0f, 0x0f, 0xaa, 0xd7, 0xea, 0x47, 0x62, 0xc6,
+	0x63, 0x28, 0x93, 0x37, 0x00, 0xda, 0x3c, 0x94, 0x1a, 0xe3, 0x48, 0xb3, 0x73, 0xe5, 0x35, 0x3a,
+	0x6b, 0x11, 0x96, 0xe4, 0xe3, 0x05, 0xce, 0xfe, 0x62, 0x20, 0x6a, 0xe4, 0xd8, 0x54, 0x33, 0x7e,
+	0x2b, 0x03, 0x26, 0x6b, 0x9b, 0xe6, 0x31, 0xfe, 0xe5, 0xf8, 0x54, 0xaf, 0xe5, 0x55, 0xc0, 0xfc,
+	0x76, 0x24, 0x80, 0x19, 0xbe, 0x43, 0xe5, 0x49, 0x7e, 0xab, 0xb6, 0xd0, 0xa2, 0xb3, 0x57, 0x4b,
+	0x4d, 0x2e, 0x7f, 0x10, 0xb3, 0x92, 0x46, 0x86, 0x59, 0xfc, 0x31, 0x51, 0x6b, 0x2f, 0x26, 0xfa,
+	0x78, 0xbf, 0x44, 0x35, 0x64, 0x98, 0xc5, 0x6f, 0x1e, 0x54, 0xe0, 0x62, 0xee, 0x29, 0xc9, 0x98,
+	0x87, 0x98, 0xe2, 0x05, 0x95, 0x6d, 0x5c, 0x9a, 0x3b, 0x39, 0xf3, 0x58, 0x4b, 0x82, 0x30, 0x5d,
+	0x97, 0xc9, 0xb0, 0x9c, 0xda, 0xcd, 0x7a, 0xdc, 0xbe, 0x12, 0xcb, 0xb0, 0x2b, 0x29, 0x18, 0x66,
+	0x6a, 0x93, 0x9a, 0x9c, 0x8f, 0x9b, 0xf5, 0x15, 0x76, 0x41, 0x0d, 0x6e, 0xfa, 0x54, 0xdd, 0x0e,
+	0xe2, 0xf1, 0xe9, 0x40, 0xcc, 0xd6, 0x67, 0xa3, 0x60, 0x3f, 0xf4, 0x5e, 0x0c, 0xc6, 0xa3, 0x58,
+	0x4f, 0x82, 0x30, 0x5d, 0x57, 0x69, 0x10, 0x12, 0x5d, 0x18, 0x8a, 0x47, 0xb1, 0x9e, 0x82, 0x61,
+	0xa6, 0xb6, 0xf9, 0x3b, 0x83, 0xf0, 0x44, 0x0f, 0x92, 0x25, 0x69, 0xe7, 0x4f, 0x77, 0xc9, 0x08,
+	0x84, 0xc7, 0x7e, 0x9e, 0x4e, 0xc1, 0xe7, 0x29, 0x19, 0xdd, 0xb1, 0x87, 0xcf, 0x19, 0x14, 0x7d,
+	0xce, 0x93, 0x93, 0xec, 0xfd, 0xf3, 0xb7, 0xf3, 0x3f, 0x7f, 0xc9, 0x59, 0x3d, 0x76, 0xb9, 0x74,
+	0x0a, 0x96, 0x4b, 0xc9, 0x59, 0xed, 0x61, 0x79, 0xfd, 0xee, 0xa0, 0x50, 0x7e, 0x1e, 0x27, 0xe5,
+	0x96, 0x5c, 0x5f, 0x39, 0xbc, 0xe5, 0x4c, 0xd7, 0x57, 0x51, 0xcc, 0x9b, 0x33, 0x5c, 0x5f, 0x47,
+	0xb2, 0xcf, 0xb3, 0x59, 0x5f, 0x45, 0xb3, 0x7a, 0x56, 0xeb, 0xab, 0x68, 0x56, 0x7b, 0x58, 0x5f,
+	0x7f, 0x9c, 0x3e, 0x1f, 0x22, 0x51, 0x7b, 0x05, 0x06, 0x1a, 0x9d, 0x6e, 0x49, 0x26, 0xc5, 0x3d,
+	0xbf, 0x6b, 0x1b, 0x5b, 0xc8, 0x70, 0x10, 0x84, 0xe1, 0x76, 0x3f, 0xb1, 0x5e, 0xb9, 0xfb, 0xbf,
+	0x0c, 0xef, 0x2a, 0x31, 0xb1, 0xa9, 0xa2, 0x9d, 0x1d, 0xda, 0xa6, 0xbe, 0xe5, 0xc8, 0xd0, 0xab,
+	0x25, 0xb9, 0x8d, 0xb0, 0x11, 0xa6, 0x70, 0x61, 0x06, 0x3b, 0x9b, 0x90, 0x8e, 0xdd, 0x2c, 0xc9,
+	0x5f, 0xf8, 0x84, 0x6c, 0xac, 0x2c, 0x21, 0xc3, 0x61, 0xfe, 0xc3, 0x51, 0xd0, 0xf2, 0xb1, 0x91,
+	0x2f, 0x1a, 0x30, 0xd3, 0x48, 0xe7, 0x0c, 0xe8, 0xc7, 0x47, 0x35, 0x93, 0x80, 0x40, 0x2c, 0xf9,
+	0x4c, 0x31, 0x66, 0xc9, 0x92, 0xef, 0x33, 0x84, 0x92, 0x2f, 0x32, 0x24, 0xc9, 0x69, 0xbd, 0x75,
+	0x4a, 0x9e, 0x1d, 0xb1, 0xb6, 0x30, 0x36, 0x55, 0x25, 0x09, 0x92, 0xaf, 0x19, 0x70, 0xf1, 0x7e,
+	0x9e, 0x36, 0x59, 0x4e, 0xfe, 0xdd, 0xd3, 0x54, 0x4f, 0xb3, 0x2e, 0x71, 0x09, 0x32, 0xb7, 0x02,
+	0xe6, 0x77, 0x24, 0x9a, 0xa5, 0x48, 0x5d, 0x2b, 0xf7, 0x69, 0xe9, 0x59, 0x4a, 0xe9, 0x7d, 0xe3,
+	0x59, 0x8a, 0x00, 0x98, 0x24, 0x48, 0x3a, 0x30, 0x76, 0x5f, 0xe9, 0xc8, 0xa5, 0x5e, 0xac, 0x56,
+	0x96, 0xba, 0xa6, 0x68, 0x17, 0x3e, 0xb8, 0x51, 0x21, 0xc6, 0x44, 0xc8, 0x0e, 0x8c, 0xdc, 0x17,
+	0xbc, 0x42, 0xea, 0xb3, 0x16, 0xfa, 0xbe, 0xfd, 0x0b, 0xb5, 0x8a, 0x2c, 0x42, 0x85, 0x5e, 0x7f,
+	0x10, 0x36, 0x7a, 0xcc, 0x3b, 0xe5, 0x9f, 0x30, 0xe0, 0xe2, 0x2e, 0xf5, 0x43, 0xbb, 0x91, 0xb6,
+	0x59, 0x8d, 0x95, 0xd7, 0x50, 0xbc, 0x90, 0x87, 0x50, 0x2c, 0x93, 0x5c, 0x10, 0xe6, 0x77, 0x81,
+	0xbc, 0x00, 0x83, 0x34, 0x6c, 0x34, 0x65, 0xae, 0xa0, 0x0f, 0x96, 0x8d, 0xca, 0x21, 0x1e, 0x2f,
+	0xb2, 0xff, 0x90, 0xe3, 0x33, 0xff, 0xd0, 0x80, 0x8c, 0x6e, 0x9a, 0xfc, 0xb0, 0x01, 0x13, 0xdb,
+	0xd4, 0x0a, 0xbb, 0x3e, 0xbd, 0x25, 0xfd, 0xf4, 0x07, 0x9e, 0x1c, 0x7f, 0xfa, 0x85, 0xd3, 0x50,
+	0x89, 0xcf, 0xdf, 0xd4, 0x10, 0x0b, 0x77, 0xac, 0x28, 0xc7, 0xa2, 0x0e, 0xc2, 0x44, 0x0f, 0xe6,
+	0x9e, 0x83, 0x99, 0x4c, 0xc3, 0x13, 0x99, 0xb5, 0x7f, 0xd9, 0x10, 0x66, 0x11, 0xd1, 0x97, 0x25,
+	0x2b, 0xd8, 0xb9, 0xe7, 0x59, 0x7e, 0x93, 0xbc, 0xa2, 0xa2, 0xae, 0x09, 0x2e, 0xf9, 0xa1, 0x72,
+	0x9e, 0x81, 0x4d, 0x3d, 0x9a, 0xa2, 0x1e, 0x67, 0x8d, 0xdc, 0x04, 0x62, 0x25, 0x3c, 0x2f, 0xd6,
+	0xe2, 0x38, 0x56, 0xdc, 0xd0, 0xb7, 0x90, 0x81, 0x62, 0x4e, 0x0b, 0xf3, 0x0b, 0x06, 0x90, 0x6c,
+	0x56, 0x4e, 0xe2, 0xc3, 0xa8, 0x5c, 0xbf, 0xea, 0x2b, 0x2d, 0x95, 0x7c, 0x12, 0x9d, 0x78, 0xdf,
+	0x1f, 0x3b, 0xa1, 0xca, 0x82, 0x00, 0x23, 0x3a, 0xe6, 0x9f, 0x18, 0x10, 0x27, 0xdd, 0x27, 0xef,
+	0x87, 0xf1, 0x26, 0x0d, 0x1a, 0xbe, 0xcd, 0xdd, 0x60, 0xd4, 0xd5, 0x4f, 0xbd, 0x2a, 0x5e, 0x8a,
+	0x41, 0xa8, 0xd7, 0x23, 0x26, 0x0c, 0x87, 0x56, 0x70, 0x7f, 0x65, 0x49, 0x4f, 0x88, 0xb0, 0xc9,
+	0x4b, 0x50, 0x42, 0xe2, 0xd4, 0x16, 0x03, 0x3d, 0xa4, 0xb6, 0x20, 0xdb, 0xa7, 0x10, 0x2b, 0x9d,
+	0xf4, 0x10, 0x27, 0xfd, 0xaf, 0x55, 0xe0, 0x1c, 0xab, 0xb2, 0x66, 0xd9, 0x6e, 0x48, 0x5d, 0xfe,
+	0xf6, 0xb5, 0xe4, 0x24, 0xb4, 0x60, 0x32, 0x4c, 0xc4, 0x25, 0x39, 0x79, 0x64, 0x84, 0xc8, 0x97,
+	0x31, 0x19, 0x8d, 0x24, 0x89, 0x97, 0x7c, 0x48, 0x3d, 0x3e, 0x16, 0xd7, 0xe2, 0x27, 0xd4, 0x52,
+	0xe5, 0xfe, 0x06, 0x0f, 0x65, 0x90, 0x97, 0xbb, 0x1d, 0xe5, 0x55, 0xa5, 0xbf, 0x33, 0xfe, 0x00,
+	0x4c, 0xca, 0x57, 0x6b, 0x22, 0x47, 0x89, 0xbc, 0x16, 0xf3, 0x63, 0xe5, 0xa6, 0x0e, 0xc0, 0x64,
+	0x3d, 0xf3, 0xd7, 0x2b, 0x30, 0x99, 0x40, 0x5b, 0x76, 0x96, 0xb2, 0x41, 0xf0, 0x2b, 0x67, 0x96,
+	0xa0, 0xe5, 0x3d, 0x30, 0xda, 0x51, 0xf1, 0x4f, 0x84, 0x07, 0x40, 0xb4, 0x13, 0xa2, 0x18, 0x25,
+	0x51, 0x8d, 0x78, 0x5a, 0x07, 0x4f, 0x3c, 0xad, 0xef, 0x97, 0xaf, 0x31, 0x86, 0x12, 0x69, 0x72,
+	0xd4, 0x6b, 0x8c, 0x99, 0x44, 0x43, 0xed, 0xa9, 0xf4, 0x02, 0xc8, 0xb4, 0x9e, 0xec, 0xbb, 0xc8,
+	0x14, 0x3e, 0xc1, 0xa6, 0x17, 0x5a, 0x8e, 0xf4, 0x53, 0x99, 0x11, 0x99, 0x72, 0x35, 0x00, 0x26,
+	0xeb, 0x99, 0xeb, 0xf0, 0xf6, 0x55, 0xcf, 0x6a, 0x2e, 0x5a, 0x0e, 0x5b, 0xba, 0xbe, 0x74, 0x3c,
+	0x0d, 0xf8, 0xc9, 0xbc, 0xe1, 0x7b, 0xa1, 0xd7, 0xf0, 0x1c, 0x76, 0x6e, 0x5a, 0x51, 0xee, 0x0c,
+	0x6e, 0x88, 0x54, 0xe7, 0xa6, 0xcc, 0x3d, 0x81, 0x0a, 0x6e, 0xfe, 0x8a, 0x01, 0x23, 0x32, 0xd5,
+	0x67, 0x0f, 0xd1, 0x01, 0xb6, 0x61, 0x88, 0xdf, 0x8e, 0xfa, 0x91, 0x4a, 0xeb, 0x3b, 0x9e, 0x17,
+	0x26, 0x72, 0x04, 0x8b, 0x54, 0xe3, 0xec, 0x5f, 0x14, 0xe8, 0xb9, 0xc7, 0xb5, 0xdf, 0xd8, 0xb1,
+	0x43, 0xca, 0x1d, 0xcb, 0xe4, 0xc2, 0x17, 0x1e, 0xd7, 0x5a, 0x39, 0x26, 0x6a, 0x99, 0xff, 0xf7,
+	0x10, 0x5c, 0x57, 0x69, 0x4b, 0xd3, 0xa2, 0x5a, 0xc4, 0x73, 0xf7, 0xa3, 0x3c, 0xaa, 0x3c, 0xff,
+	0x9a, 0xd2, 0xa9, 0x96, 0xbb, 0x25, 0x5f, 0xd6, 0x32, 0xa5, 0xea, 0xe8, 0x30, 0x8f, 0x86, 0xc8,
+	0x0e, 0xc5, 0x8b, 0x6f, 0x53, 0xcb, 0x09, 0x77, 0x14, 0xed, 0x4a, 0x3f, 0xd9, 0xa1, 0xb2, 0xf8,
+	0x30, 0x97, 0x0a, 0x77, 0x36, 0x51, 0xf9, 0x5b, 0x7d, 0x6a, 0xe9, 0x9e, 0x2e, 0x7d, 0x3c, 0x01,
+	0x5d, 0xcb, 0xc5, 0x88, 0x05, 0x94, 0xb8, 0xba, 0xd1, 0xda, 0xe3, 0xda, 0x0b, 0xa4, 0x22, 0x1f,
+	0xd0, 0x60, 0x6c, 0xab, 0x58, 0x4b, 0x82, 0x30, 0x5d, 0x97, 0x3c, 0x03, 0x53, 0xdc, 0x79, 0x27,
+	0x0e, 0x79, 0x3e, 0x14, 0x47, 0x7e, 0x5c, 0x4f, 0x40, 0x30, 0x55, 0x93, 0x7c, 0xbf, 0x01, 0x17,
+	0x6c, 0xfd, 0x81, 0xa3, 0x1a, 0xfd, 0x70, 0x3f, 0x69, 0xeb, 0xc4, 0x32, 0xce, 0x41, 0x8b, 0xb9,
+	0xc4, 0xc8, 0x2a, 0x5c, 0x90, 0x61, 0x2d, 0x92, 0x6b, 0x40, 0xc4, 0xc3, 0xe5, 0xdf, 0x74, 0x29,
+	0x07, 0x8e, 0xb9, 0xad, 0xcc, 0xcf, 0x54, 0x60, 0x42, 0xdf, 0x4a, 0x3d, 0x3c, 0x59, 0xe9, 0x6a,
+	0x32, 0x47, 0x1f, 0x8f, 0xcf, 0x75, 0xaa, 0x3d, 0x88, 0x1d, 0xe4, 0x25, 0x98, 0xea, 0xf2, 0x89,
+	0x50, 0xe1, 0x52, 0xe5, 0x9e, 0xfe, 0x56, 0xf6, 0xe5, 0xb6, 0x12, 0x90, 0x87, 0x07, 0xd5, 0x39,
+	0x1d, 0x7d, 0x12, 0x8a, 0x29, 0x3c, 0xe6, 0x3f, 0xae, 0x00, 0xd1, 0xab, 0xdf, 0x74, 0xac, 0x5d,
+	0xcf, 0x27, 0x3f, 0x6b, 0xc0, 0x44, 0x43, 0xcf, 0x94, 0x2c, 0x04, 0xac, 0x97, 0xfa, 0x1d, 0xac,
+	0x40, 0x3f, 0xaf, 0xa7, 0x40, 0x16, 0x82, 0xb0, 0x8a, 0x99, 0x31, 0xa1, 0x83, 0x1e, 0xa6, 0x7e,
+	0x63, 0xa2, 0x4f, 0x73, 0x9f, 0x33, 0x60, 0x26, 0x83, 0x29, 0x47, 0x32, 0x7e, 0x39, 0x99, 0x13,
+	0x68, 0xa9, 0xbf, 0x74, 0xcf, 0x32, 0x84, 0x82, 0x26, 0x5f, 0xff, 0x5c, 0x05, 0x2e, 0x27, 0xa7,
+	0xbc, 0xe5, 0x5b, 0x4d, 0xba, 0x61, 0x85, 0x3b, 0xe4, 0x69, 0x00, 0x11, 0x8c, 0x79, 0xc3, 0xf3,
+	0x1c, 0xb9, 0xb0, 0xa2, 0xd7, 0x8a, 0x2f, 0x46, 0x10, 0xd4, 0x6a, 0x91, 0x27, 0xf4, 0x73, 0x62,
+	0x4c, 0x7b, 0x39, 0xa7, 0x33, 0xf9, 0xde, 0xc3, 0x7d, 0xf0, 0x18, 0x2e, 0x74, 0x2f, 0xd4, 0xa3,
+	0x73, 0xae, 0xd3, 0xbd, 0x10, 0x79, 0x69, 0x4e, 0x8c, 0xaa, 0xa1, 0xb3, 0x88, 0x51, 0x65, 0xfe,
+	0xd5, 0x21, 0x38, 0x9f, 0xb3, 0xee, 0xb9, 0x23, 0x12, 0x4d, 0xc9, 0xe0, 0xfd, 0x38, 0x22, 0x65,
+	0xe4, 0xf9, 0xc8, 0x11, 0x29, 0x0d, 0xc1, 0x0c, 0x5d, 0xf2, 0x02, 0x0c, 0x34, 0x7c, 0x5b, 0x6e,
+	0xed, 0x0f, 0x94, 0x5a, 0x28, 0xb8, 0xb2, 0x38, 0x2e, 0x29, 0x0e, 0xd4, 0x70, 0x05, 0x19, 0x42,
+	0x9e, 0x82, 0x4c, 0x3b, 0x6c, 0x95, 0x58, 0x2f, 0x52, 0x90, 0xe9, 0x00, 0x4c, 0xd6, 0x23, 0x2f,
+	0xc1, 0xac, 0xbc, 0xcf, 0xab, 0xa0, 0x5d, 0x9e, 0x1b, 0x84, 0xec, 0x5c, 0x54, 0xdf, 0x93, 0x7b,
+	0x1a, 0xdf, 0x29, 0xa8, 0x83, 0x85, 0xad, 0xc9, 0xf7, 0xc2, 0x54, 0x82, 0xc7, 0xaa, 0xf0, 0xac,
+	0x25, 0x1f, 0x7b, 0xea, 0x98, 0xc4, 0x7a, 0x48, 0x96, 0x61, 0x8a, 0x1a, 0xf9, 0xcf, 0x0d, 0x98,
+	0x89, 0xb6, 0xf3, 0xbe, 0x60, 0x0c, 0x2a, 0xb5, 0xff, 0xcd, 0xd3, 0xe1, 0x33, 0x8b, 0x57, 0xe4,
+	0x87, 0x88, 0xd9, 0x84, 0x22, 0x84, 0x59, 0xda, 0xe6, 0x8f, 0x0d, 0xc3, 0xb8, 0x44, 0xc2, 0xa4,
+	0x36, 0xb2, 0xd6, 0x8f, 0xf6, 0x36, 0x5e, 0x03, 0x4a, 0x83, 0xbb, 0x06, 0x03, 0xad, 0x4e, 0xb7,
+	0xa4, 0xfa, 0x36, 0x42, 0x77, 0x8b, 0xa1, 0x6b, 0x75, 0xba, 0xe4, 0x85, 0x48, 0x21, 0x5c, 0x4e,
+	0x65, 0x1b, 0xbd, 0x85, 0x4d, 0x29, 0x85, 0xd5, 0x21, 0x38, 0x58, 0x78, 0x08, 0xb6, 0x61, 0x24,
+	0x90, 0xda, 0xe2, 0xa1, 0xf2, 0x11, 0xb9, 0xb5, 0x99, 0x96, 0xda, 0x61, 0xa1, 0xc7, 0x52, 0xca,
+	0x63, 0x45, 0x83, 0x5d, 0x97, 0xbb, 0xfc, 0xf4, 0x96, 0xd1, 0x22, 0xf9, 0x75, 0x79, 0x8b, 0x97,
+	0xa0, 0x84, 0x64, 0x44, 0xde, 0x91, 0x5e, 0x44, 0x5e, 0xf2, 0x93, 0xe9, 0x53, 0x6e, 0x94, 0xaf,
+	0xbe, 0x8f, 0xf5, 0x39, 0x9c, 0xff, 0xc4, 0x8e, 0xb7, 0xff, 0x2c, 0x16, 0x11, 0xb4, 0x8f, 0xc5,
+	0x4e, 0x29, 0x1e, 0x30, 0x50, 0x1e, 0x6a, 0xd1, 0x29, 0xc5, 0x43, 0xc6, 0xa1, 0x80, 0x91, 0xba,
+	0x8c, 0xa1, 0x5c, 0x6e, 0xd1, 0xcb, 0x77, 0x01, 0x71, 0x92, 0x38, 0x11, 0x70, 0xf9, 0x7a, 0xe2,
+	0x35, 0x7f, 0xde, 0x4d, 0x6b, 0x0b, 0x46, 0xda, 0xb6, 0xcb, 0x9d, 0x62, 0xca, 0x99, 0x1a, 0x84,
+	0xa3, 0x9a, 0x40, 0x81, 0x0a, 0x97, 0xf9, 0xbb, 0x15, 0xc6, 0x20, 0x62, 0xd5, 0xc7, 0xbe, 0x48,
+	0xa9, 0x20, 0xe3, 0x86, 0x18, 0xe5, 0x55, 0xa5, 0x1a, 0xd2, 0x85, 0x08, 0xa1, 0x70, 0xe7, 0x88,
+	0x7f, 0xa3, 0x46, 0x8c, 0x91, 0x0e, 0xed, 0x36, 0x7d, 0xd1, 0x76, 0x9b, 0xde, 0x03, 0x39, 0xbd,
+	0xfd, 0x92, 0xde, 0x8c, 0x10, 0x0a, 0xd2, 0xf1, 0x6f, 0xd4, 0x88, 0xb1, 0x23, 0x89, 0xa7, 0x75,
+	0x73, 0x69, 0xbd, 0x43, 0x1b, 0xb2, 0x6f, 0x9e, 0xe3, 0xa8, 0xbb, 0xd0, 0xa8, 0x38, 0x92, 0x6a,
+	0x05, 0x75, 0xb0, 0xb0, 0xb5, 0xf9, 0x37, 0x0d, 0xb8, 0x98, 0x3b, 0x15, 0xe4, 0x16, 0xcc, 0xc4,
+	0x4e, 0xc3, 0xba, 0x90, 0x30, 0x1a, 0xf3, 0xf8, 0x3b, 0xe9, 0x0a, 0x98, 0x6d, 0x23, 0x12, 0x8e,
+	0x67, 0x84, 0x10, 0xe9, 0x71, 0xac, 0x5f, 0x48, 0x75, 0x30, 0xe6, 0xb5, 0x31, 0x3f, 0x97, 0xec,
+	0x6d, 0x3c, 0x5b, 0x6c, 0x6b, 0xdc, 0xa3, 0xad, 0x28, 0x9c, 0x4a, 0xb4, 0x35, 0x16, 0x59, 0x21,
+	0x0a, 0x18, 0x79, 0x5c, 0x8f, 0xf2, 0x14, 0xb1, 0xf7, 0x12, 0x91, 0x9e, 0xbe, 0xdf, 0x60, 0xd7,
+	0x18, 0x76, 0x4f, 0x6f, 0x9e, 0x20, 0x87, 0x45, 0xa3, 0x8f, 0x68, 0x28, 0x9c, 0x03, 0x4b, 0x53,
+	0xa7, 0x44, 0x64, 0xfe, 0xb2, 0xc1, 0x84, 0x60, 0xb7, 0x6b, 0x39, 0x9a, 0x54, 0x2b, 0xbe, 0x2b,
+	0xf9, 0x19, 0x03, 0x2e, 0x25, 0x82, 0xd0, 0x04, 0x12, 0xa2, 0xee, 0x15, 0x2b, 0x7d, 0x87, 0xc1,
+	0x51, 0x18, 0xe3, 0xb8, 0xb4, 0xb9, 0xe0, 0x00, 0x0b, 0x3a, 0x62, 0x7e, 0x02, 0x2e, 0x17, 0xb8,
+	0x55, 0x91, 0x25, 0x98, 0x08, 0x1e, 0x58, 0x9d, 0x45, 0xba, 0x63, 0xed, 0xda, 0x32, 0xe8, 0xa5,
+	0xf0, 0xbe, 0x9f, 0xa8, 0x6b, 0xe5, 0x0f, 0x53, 0xbf, 0x31, 0xd1, 0xca, 0x0c, 0x01, 0xe4, 0xfb,
+	0x11, 0xdb, 0x6d, 0x91, 0x6d, 0x18, 0xb5, 0x1c, 0xea, 0x87, 0x71, 0xd8, 0xef, 0xef, 0x28, 0xa5,
+	0x7e, 0x97, 0x38, 0xc4, 0xe2, 0x50, 0xbf, 0x30, 0xc2, 0x6d, 0xfe, 0xd7, 0x06, 0x5c, 0xca, 0x0f,
+	0x73, 0xd8, 0xc3, 0x32, 0x69, 0xc3, 0xb8, 0x1f, 0x37, 0x93, 0x6b, 0xe5, 0xdb, 0xf5, 0xe4, 0x6c,
+	0xda, 0x8b, 0x41, 0x76, 0x19, 0xa8, 0xf9, 0x5e, 0xa0, 0xb6, 0x5a, 0x3a, 0x5f, 0x5b, 0xa4, 0xec,
+	0xd4, 0x7a, 0x82, 0x3a, 0x7e, 0x9e, 0x3b, 0x91, 0x51, 0x0f, 0x3a, 0x56, 0x83, 0x36, 0x6b, 0x8e,
+	0xd7, 0x6d, 0xca, 0x87, 0x0c, 0x6f, 0x8d, 0x84, 0x65, 0xf9, 0x7d, 0x3f, 0xdb, 0xdc, 0x89, 0x05,
+	0x34, 0x8f, 0xcf, 0x9d, 0x98, 0xdf, 0xf0, 0x2d, 0x92, 0xd4, 0x2b, 0xbf, 0xf3, 0x05, 0x31, 0x66,
+	0xfe, 0xcf, 0xe1, 0xa2, 0xd1, 0xf2, 0xa4, 0x5e, 0x4f, 0xc2, 0x68, 0xc3, 0x5a, 0xec, 0xba, 0xcd,
+	0xc8, 0x79, 0x8f, 0xef, 0xa5, 0xda, 0x82, 0x28, 0xc3, 0x08, 0x4a, 0x76, 0x01, 0xe2, 0x03, 0x45,
+	0x2e, 0x94, 0x9b, 0xfd, 0x19, 0x06, 0x95, 0x62, 0x55, 0x1c, 0xb8, 0x71, 0x39, 0x6a, 0x94, 0xc8,
+	0xa7, 0x60, 0x52, 0x3f, 0x7f, 0x54, 0x28, 0x98, 0xe7, 0xfb, 0xbd, 0x24, 0xc5, 0x06, 0x14, 0xbd,
+	0x34, 0xc0, 0x24, 0x35, 0xb2, 0x0f, 0x13, 0xed, 0x58, 0xfc, 0x53, 0x51, 0x38, 0x9f, 0xeb, 0x53,
+	0x48, 0x8e, 0x4d, 0x9f, 0x5a, 0x61, 0x80, 0x09, 0x52, 0xa4, 0x0b, 0xe3, 0xbb, 0x3c, 0xdd, 0x86,
+	0xa0, 0x3c, 0x5c, 0x3e, 0xec, 0xef, 0x0b, 0x11, 0x9a, 0x98, 0x11, 0xc5, 0x65, 0x01, 0xea, 0x74,
+	0xc8, 0x6b, 0x30, 0xdc, 0xb1, 0x7c, 0xea, 0x2a, 0x13, 0xfd, 0x4a, 0x39, 0xff, 0x91, 0x78, 0xa1,
+	0xc5, 0x5c, 0x30, 0xda, 0x92, 0x1b, 0x9c, 0x00, 0x4a, 0x42, 0x39, 0x71, 0xca, 0x46, 0xcf, 0x2a,
+	0x72, 0xf0, 0x2b, 0x30, 0xec, 0x70, 0x3b, 0x8a, 0x34, 0xee, 0x3f, 0x53, 0x66, 0x74, 0xc2, 0x12,
+	0x23, 0x24, 0x01, 0xf1, 0x3f, 0x4a, 0xac, 0xe6, 0x7f, 0x30, 0xe0, 0xea, 0x51, 0x6c, 0x89, 0x6b,
+	0x7c, 0x1a, 0xa9, 0x6d, 0xd8, 0x8f, 0xc6, 0x27, 0xc3, 0x6d, 0x23, 0x8d, 0x4f, 0x1a, 0x82, 0x19,
+	0xba, 0xe4, 0xa3, 0x40, 0xbc, 0x7b, 0xc2, 0x5d, 0xeb, 0x16, 0xa3, 0x21, 0x24, 0xae, 0x0a, 0x7f,
+	0x82, 0x32, 0x27, 0xf1, 0x90, 0xbb, 0x99, 0x1a, 0x98, 0xd3, 0xca, 0xfc, 0xc5, 0x0a, 0xc0, 0x3a,
+	0x0d, 0x1f, 0x78, 0xfe, 0x7d, 0x76, 0xc6, 0x5f, 0x4d, 0x58, 0x84, 0x46, 0xdf, 0xbc, 0x58, 0xd1,
+	0x57, 0x61, 0xb0, 0xe3, 0x35, 0x03, 0x29, 0x47, 0xf2, 0x8e, 0xf0, 0x17, 0x38, 0xbc, 0x94, 0x54,
+	0x61, 0x88, 0xfb, 0xb2, 0xc9, 0x1b, 0x3f, 0xb7, 0x27, 0x71, 0x9b, 0x19, 0x8a, 0x72, 0xc6, 0x21,
+	0x65, 0x30, 0x96, 0x40, 0xda, 0xec, 0x26, 0x44, 0xf6, 0x17, 0x51, 0x86, 0x11, 0x94, 0x3c, 0x03,
+	0x60, 0x77, 0x6e, 0x5a, 0x6d, 0xdb, 0xb1, 0xe5, 0x76, 0x1d, 0xe3, 0x86, 0x0e, 0x58, 0xd9, 0x50,
+	0xa5, 0x0f, 0x0f, 0xaa, 0xa3, 0xf2, 0xd7, 0x3e, 0x6a, 0xb5, 0xcd, 0x5f, 0xae, 0xc0, 0x74, 0x3c,
+	0x79, 0x72, 0xa9, 0xa8, 0x9e, 0x8b, 0x9c, 0x00, 0x85, 0x3d, 0x17, 0x09, 0x97, 0x8e, 0xee, 0xb9,
+	0xd0, 0xb8, 0x15, 0xf5, 0xfc, 0xbd, 0x30, 0x4e, 0x45, 0x74, 0xc1, 0x95, 0x25, 0x14, 0x3c, 0x6e,
+	0x4c, 0xdc, 0x3f, 0x97, 0xe3, 0x62, 0xd4, 0xeb, 0x90, 0x2f, 0x19, 0x30, 0xa3, 0xfd, 0x5e, 0xdc,
+	0x7f, 0xd9, 0x73, 0xa9, 0x0c, 0x47, 0x5d, 0xca, 0x89, 0x88, 0xb5, 0xd7, 0xa8, 0xc5, 0x37, 0x9b,
+	0xe5, 0x34, 0x15, 0xcc, 0x12, 0x36, 0xff, 0x74, 0x00, 0x26, 0xd6, 0x5b, 0xb6, 0xbb, 0xa7, 0xa2,
+	0x3a, 0x46, 0xee, 0x1d, 0xc6, 0xd9, 0xb8, 0x77, 0xbc, 0x04, 0xb3, 0x8e, 0x6e, 0x4c, 0x15, 0x72,
+	0x9c, 0xe5, 0xb6, 0xa2, 0x0f, 0xc2, 0xef, 0x81, 0xab, 0x05, 0x75, 0xb0, 0xb0, 0x35, 0x09, 0xa3,
+	0xeb, 0xc9, 0x40, 0xf9, 0x48, 0x85, 0xfa, 0x5c, 0xcc, 0xeb, 0x21, 0x90, 0x22, 0x16, 0x9c, 0xbc,
+	0xc1, 0x90, 0xcf, 0x1a, 0x70, 0x91, 0xee, 0x89, 0xa0, 0x75, 0x9b, 0xbe, 0xb5, 0xbd, 0x6d, 0x37,
+	0xe4, 0xbb, 0x52, 0xb1, 0x31, 0x56, 0x0f, 0x0f, 0xaa, 0x17, 0x97, 0xf3, 0x2a, 0x3c, 0x3c, 0xa8,
+	0xde, 0xc8, 0x8d, 0x21, 0xc8, 0x17, 0x57, 0x6e, 0x13, 0xcc, 0x27, 0x35, 0xf7, 0x21, 0x18, 0x3f,
+	0x41, 0xf4, 0x8a, 0x44, 0xa4, 0xc0, 0x5f, 0xaa, 0xc0, 0x04, 0x5b, 0xfd, 0xec, 0x8a, 0xe8, 0x2c,
+	0xad, 0xd7, 0xc9, 0x53, 0xe9, 0x88, 0xca, 0x91, 0x89, 0x20, 0x13, 0x55, 0x79, 0x15, 0x2e, 0x6c,
+	0x7b, 0x7e, 0x83, 0x6e, 0xd6, 0x36, 0x36, 0x3d, 0xe9, 0xe2, 0xb8, 0xb4, 0x5e, 0x97, 0xf7, 0x62,
+	0x6e, 0x58, 0xbb, 0x99, 0x03, 0xc7, 0xdc, 0x56, 0xe4, 0x2e, 0x5c, 0x8c, 0xcb, 0xb7, 0x3a, 0xe2,
+	0x59, 0x0c, 0x43, 0x37, 0x10, 0x3f, 0xeb, 0xb9, 0x99, 0x57, 0x01, 0xf3, 0xdb, 0x11, 0x0b, 0x1e,
+	0x93, 0x16, 0xbc, 0x9b, 0x9e, 0xff, 0xc0, 0xf2, 0x9b, 0x49, 0xb4, 0x22, 0xe9, 0x16, 0xb7, 0x29,
+	0x2e, 0x15, 0x57, 0xc3, 0xa3, 0x70, 0x98, 0x7f, 0x62, 0x40, 0x32, 0x5e, 0x35, 0xb9, 0x02, 0x03,
+	0xbe, 0x4c, 0xa4, 0x2d, 0xc3, 0x30, 0xb3, 0x1b, 0x0b, 0x2b, 0x93, 0x51, 0x2f, 0x54, 0xd0, 0xec,
+	0x4a, 0x1c, 0x8b, 0x4e, 0x0b, 0x77, 0xad, 0xd5, 0x60, 0xa8, 0x42, 0xab, 0x25, 0xf9, 0x2f, 0x47,
+	0xb5, 0x69, 0xb5, 0x90, 0x95, 0xf1, 0x54, 0x7a, 0x76, 0x8b, 0x06, 0x4a, 0x9d, 0x2f, 0x52, 0xe9,
+	0xf1, 0x12, 0x94, 0x10, 0x62, 0xc1, 0x64, 0xa7, 0xeb, 0xc8, 0x48, 0x8a, 0xec, 0x26, 0x26, 0xd4,
+	0xae, 0x4f, 0xe6, 0xa5, 0xc9, 0xe6, 0x5f, 0x3f, 0x37, 0x57, 0xf6, 0x86, 0x8e, 0x02, 0x93, 0x18,
+	0xcd, 0x9f, 0x1a, 0x06, 0x2d, 0x4c, 0xd9, 0x09, 0x84, 0xe2, 0x9f, 0xe3, 0xf1, 0x0a, 0x6d, 0xea,
+	0x86, 0xa9, 0x88, 0x3f, 0x7d, 0xa4, 0xd0, 0xb9, 0xdb, 0xa1, 0xee, 0xca, 0x92, 0x7c, 0x44, 0x55,
+	0xcb, 0x41, 0x2e, 0x1f, 0x9a, 0xe5, 0x40, 0x30, 0xb7, 0x33, 0x7c, 0x3c, 0xbc, 0x7c, 0x65, 0x49,
+	0xd7, 0xa6, 0xd4, 0x64, 0x19, 0x46, 0x50, 0x76, 0x10, 0xb4, 0x7c, 0xaf, 0xdb, 0x09, 0x78, 0x24,
+	0x44, 0xf9, 0x51, 0xf8, 0x41, 0x70, 0x2b, 0x2e, 0x46, 0xbd, 0x0e, 0x79, 0x1f, 0x4c, 0x88, 0x9f,
+	0x1b, 0x3e, 0xdd, 0xb6, 0xf7, 0xe4, 0x19, 0xc9, 0x95, 0xcf, 0xb7, 0xb4, 0x72, 0x4c, 0xd4, 0xe2,
+	0x91, 0x5b, 0x79, 0xf4, 0xc9, 0x2d, 0x5c, 0xe5, 0x9a, 0x6d, 0x99, 0xf3, 0x66, 0x45, 0x15, 0x62,
+	0x0c, 0x27, 0x3f, 0x6a, 0xc0, 0x94, 0x4f, 0x5f, 0xeb, 0xda, 0x3e, 0x93, 0xa8, 0x2c, 0xbb, 0x1d,
+	0xc8, 0x58, 0x71, 0xd8, 0x5f, 0x7c, 0xba, 0x79, 0x4c, 0x20, 0x15, 0x0c, 0x32, 0x72, 0x18, 0x4a,
+	0x02, 0x31, 0xd5, 0x03, 0x1e, 0xcb, 0xc5, 0x6e, 0xb9, 0xb6, 0xdb, 0x5a, 0x70, 0x5a, 0x81, 0x4c,
+	0x29, 0x2b, 0x74, 0xb6, 0x71, 0x31, 0xea, 0x75, 0xc8, 0x07, 0x60, 0xb2, 0x1b, 0x30, 0xb6, 0xd7,
+	0xa6, 0x62, 0x7e, 0xc7, 0x62, 0x8f, 0xaa, 0x2d, 0x1d, 0x80, 0xc9, 0x7a, 0xe4, 0x19, 0x98, 0x52,
+	0x05, 0x72, 0x96, 0x41, 0xd8, 0x52, 0xb9, 0x05, 0x3c, 0x01, 0xc1, 0x54, 0xcd, 0xb9, 0x05, 0x38,
+	0x9f, 0x33, 0xcc, 0x13, 0xf1, 0xd6, 0xff, 0xcf, 0x80, 0x8b, 0x42, 0x08, 0x94, 0x6a, 0xf2, 0x28,
+	0x49, 0x5a, 0x7e, 0xbe, 0x31, 0xe3, 0x4c, 0xf3, 0x8d, 0xbd, 0x09, 0x79, 0xd5, 0xcc, 0xbf, 0x5e,
+	0x81, 0xb7, 0x1f, 0xbb, 0x2f, 0xc9, 0x4f, 0x1b, 0x30, 0x4e, 0xf7, 0x42, 0xdf, 0x8a, 0x02, 0x4a,
+	0xb0, 0x45, 0xba, 0x7d, 0x26, 0x4c, 0x60, 0x7e, 0x39, 0x26, 0x24, 0x16, 0x6e, 0x74, 0xb3, 0xd3,
+	0x20, 0xa8, 0xf7, 0x87, 0x71, 0x5b, 0x11, 0x22, 0x54, 0x77, 0xbd, 0x94, 0x5c, 0x50, 0x42, 0xe6,
+	0x3e, 0x02, 0xd3, 0x69, 0xcc, 0x27, 0x5a, 0x2b, 0x3f, 0x6f, 0x40, 0x6e, 0x20, 0x6e, 0x52, 0x83,
+	0x19, 0xab, 0x1b, 0x7a, 0x09, 0xbb, 0xa8, 0x94, 0x6c, 0xf9, 0xd3, 0x82, 0x85, 0x34, 0x10, 0xb3,
+	0xf5, 0x85, 0x02, 0xdb, 0xed, 0x5a, 0x4e, 0x12, 0x8d, 0x10, 0xb8, 0xa4, 0x02, 0x3b, 0x03, 0xc6,
+	0xbc, 0x36, 0xe6, 0xdf, 0x32, 0xe0, 0x62, 0xae, 0x96, 0xb4, 0x07, 0xd5, 0x60, 0xfe, 0xb2, 0xaf,
+	0x9c, 0xe5, 0xb2, 0x37, 0x7f, 0xa1, 0x02, 0x23, 0x1b, 0xbe, 0xc7, 0x8e, 0xb6, 0x47, 0xa0, 0x10,
+	0xb4, 0x12, 0x0a, 0xc1, 0x52, 0xea, 0x0e, 0xd9, 0xd9, 0x42, 0x0d, 0xa0, 0x9d, 0xd2, 0x00, 0x2e,
+	0xf4, 0x43, 0xe4, 0x68, 0x95, 0xdf, 0xbf, 0x34, 0x60, 0x5c, 0xd6, 0x7c, 0x04, 0x3a, 0xbe, 0xef,
+	0x49, 0xea, 0xf8, 0x3e, 0xdc, 0xc7, 0xb8, 0x0a, 0x94, 0x7a, 0x3f, 0x61, 0xc0, 0xa4, 0xac, 0xb1,
+	0x46, 0xdb, 0xf7, 0xa8, 0x4f, 0x6e, 0xc2, 0x48, 0xd0, 0xe5, 0x1f, 0x52, 0x0e, 0xe8, 0x31, 0x5d,
+	0x3c, 0xf2, 0xef, 0x59, 0x0d, 0xd6, 0xfd, 0xba, 0xa8, 0x12, 0x8b, 0xc2, 0xb2, 0x00, 0x55, 0x63,
+	0xb6, 0xf6, 0x7d, 0xcf, 0xc9, 0x64, 0xb8, 0x41, 0xcf, 0xa1, 0xc8, 0x21, 0xec, 0xda, 0xc9, 0xfe,
+	0xaa, 0x2b, 0x25, 0xbf, 0x76, 0x32, 0x70, 0x80, 0xa2, 0xdc, 0xfc, 0xdb, 0x43, 0xd1, 0x64, 0x73,
+	0x1d, 0xc3, 0x6d, 0x18, 0x6b, 0xf8, 0xd4, 0x0a, 0x69, 0x73, 0x71, 0xbf, 0x97, 0xce, 0x71, 0x39,
+	0xa0, 0xa6, 0x5a, 0x60, 0xdc, 0x98, 0x1d, 0xb9, 0xba, 0x1b, 0x71, 0x25, 0x96, 0x4e, 0x0a, 0x5d,
+	0x88, 0xbf, 0x03, 0x86, 0xbc, 0x07, 0x6e, 0xf4, 0x04, 0xe9, 0x48, 0xc2, 0x7c, 0x28, 0x77, 0x59,
+	0x6d, 0x14, 0x8d, 0xf4, 0x0c, 0x4f, 0x83, 0x47, 0x64, 0x78, 0x72, 0x60, 0xa4, 0xcd, 0x3f, 0x83,
+	0xca, 0xc7, 0xd4, 0xcf, 0x52, 0x16, 0x1f, 0x34, 0xfe, 0x44, 0xe2, 0x77, 0x80, 0x8a, 0x04, 0x13,
+	0x9d, 0x5c, 0xa5, 0x60, 0xd2, 0x45, 0xa7, 0x48, 0xeb, 0x84, 0x31, 0x9c, 0xec, 0x27, 0x53, 0x87,
+	0x8d, 0x94, 0x57, 0xdb, 0xca, 0xee, 0x69, 0xd9, 0xc2, 0xc4, 0xd4, 0x17, 0xa5, 0x0f, 0x23, 0x3f,
+	0x6f, 0xc0, 0xe5, 0x66, 0x7e, 0xe6, 0x5e, 0xe9, 0x6a, 0x50, 0xea, 0xf9, 0x7f, 0x41, 0x32, 0xe0,
+	0xc5, 0xaa, 0x9c, 0xb0, 0xa2, 0x6c, 0xc1, 0x58, 0xd4, 0x19, 0xf3, 0x8b, 0x43, 0xd1, 0x6e, 0x92,
+	0x8a, 0x97, 0x7c, 0xb5, 0x98, 0x51, 0x46, 0x2d, 0x46, 0xbe, 0x4d, 0xe5, 0x91, 0x15, 0xcb, 0xf5,
+	0xf1, 0x74, 0x1e, 0xd9, 0x09, 0x49, 0x3a, 0x91, 0x3b, 0xb6, 0x0b, 0xe7, 0x83, 0xd0, 0x72, 0x68,
+	0xdd, 0x96, 0xb6, 0xd5, 0x20, 0xb4, 0xda, 0x9d, 0x12, 0x89, 0x5c, 0x45, 0x38, 0x90, 0x2c, 0x2a,
+	0xcc, 0xc3, 0x4f, 0x7e, 0x80, 0x07, 0x31, 0xb5, 0x1c, 0x6e, 0x7c, 0x16, 0xe9, 0xf0, 0x63, 0xe2,
+	0x27, 0x7f, 0x54, 0x21, 0x43, 0x94, 0xe6, 0xe3, 0xc3, 0x42, 0x4a, 0xe4, 0x0d, 0xb8, 0xc8, 0x0e,
+	0xc0, 0x85, 0x46, 0x68, 0xef, 0xda, 0xe1, 0x7e, 0xdc, 0x85, 0x93, 0xfb, 0xe6, 0xf1, 0xdb, 0xf6,
+	0x6a, 0x1e, 0x32, 0xcc, 0xa7, 0x41, 0x3e, 0x05, 0x13, 0xdd, 0xc0, 0x6a, 0xd1, 0x7a, 0xb7, 0xcd,
+	0x33, 0x85, 0x0f, 0x97, 0x7f, 0x95, 0x27, 0xbf, 0xec, 0x96, 0x86, 0x4e, 0x5c, 0x8c, 0xf4, 0x12,
+	0x4c, 0x90, 0x33, 0xff, 0xd8, 0x00, 0x92, 0xdd, 0x6a, 0xc4, 0x81, 0xd1, 0xa6, 0x0a, 0x0f, 0x62,
+	0x9c, 0x4a, 0xfe, 0xbf, 0xe8, 0x04, 0x8b, 0xa2, 0x8a, 0x44, 0x14, 0x88, 0x07, 0x63, 0x0f, 0x76,
+	0xec, 0x90, 0x3a, 0x76, 0x10, 0x9e, 0x52, 0xba, 0xc1, 0x28, 0x19, 0xcd, 0x8b, 0x0a, 0x31, 0xc6,
+	0x34, 0xcc, 0x5f, 0x1a, 0x80, 0xf3, 0x39, 0xb3, 0x45, 0xde, 0x05, 0xc3, 0xc1, 0x8e, 0xe7, 0x85,
+	0x81, 0x0a, 0xc6, 0x19, 0x1d, 0xf0, 0xbc, 0x14, 0x25, 0x94, 0x2c, 0xc1, 0xf4, 0x8e, 0x4c, 0x36,
+	0x45, 0x9b, 0x02, 0x26, 0xc3, 0x71, 0x46, 0xda, 0xf0, 0xdb, 0x29, 0x38, 0x66, 0x5a, 0x90, 0x27,
+	0x94, 0x46, 0x55, 0x3c, 0x00, 0x89, 0xce, 0xde, 0x84, 0x56, 0x55, 0xfa, 0xc5, 0x0d, 0x9e, 0x92,
+	0x5f, 0x5c, 0xec, 0xc8, 0x36, 0x74, 0xaa, 0x8e, 0x6c, 0xd9, 0xb7, 0x33, 0xc3, 0x67, 0xf5, 0x76,
+	0xc6, 0xfc, 0xd2, 0x20, 0x8c, 0x46, 0x29, 0xfd, 0x8f, 0x7f, 0x09, 0xd2, 0x05, 0xd2, 0xd0, 0xa2,
+	0xf2, 0xf6, 0xa3, 0xff, 0xe7, 0x02, 0x74, 0x2d, 0x83, 0x0c, 0x73, 0x08, 0x90, 0x37, 0xe0, 0x82,
+	0xed, 0x6e, 0xfb, 0x56, 0x14, 0x73, 0xb8, 0xa6, 0xb4, 0xac, 0x25, 0x08, 0x73, 0x15, 0xcc, 0x4a,
+	0x0e, 0x3a, 0xcc, 0x25, 0x42, 0x28, 0x8c, 0x08, 0x1f, 0x67, 0x65, 0x41, 0x2c, 0x65, 0x77, 0x12,
+	0x17, 0x95, 0x58, 0x36, 0x50, 0x17, 0x17, 0x85, 0x5b, 0x44, 0x88, 0x17, 0xff, 0x2b, 0xe3, 0xaa,
+	0x5c, 0x57, 0xb5, 0xf2, 0xf4, 0x62, 0x3b, 0xad, 0x88, 0x10, 0x9f, 0x2c, 0xc4, 0x34, 0x41, 0xf3,
+	0x9f, 0x1b, 0x30, 0x24, 0xc2, 0x14, 0x9e, 0xfd, 0x3d, 0xe5, 0x13, 0x89, 0x7b, 0xca, 0xb3, 0x65,
+	0x06, 0xc9, 0xbb, 0x5a, 0x74, 0x4b, 0x31, 0x7f, 0xc5, 0x80, 0x31, 0x5e, 0xe3, 0x11, 0x5c, 0x1c,
+	0x5e, 0x49, 0x5e, 0x1c, 0x3e, 0x54, 0x7a, 0x34, 0x05, 0xd7, 0x86, 0x7f, 0x3e, 0x20, 0xc7, 0xc2,
+	0xe5, 0xf2, 0x15, 0x38, 0x2f, 0xdf, 0xee, 0xaf, 0xda, 0xdb, 0x94, 0x2d, 0xf1, 0x25, 0x6b, 0x5f,
+	0x31, 0x5a, 0x11, 0x17, 0x2b, 0x0b, 0xc6, 0xbc, 0x36, 0xe4, 0x97, 0x0c, 0x26, 0x01, 0x87, 0xbe,
+	0xdd, 0xe8, 0xcb, 0xb1, 0x21, 0xea, 0xdb, 0xfc, 0x9a, 0x40, 0x26, 0x14, 0x1b, 0x5b, 0xb1, 0x28,
+	0xcc, 0x4b, 0x1f, 0x1e, 0x54, 0xab, 0x39, 0x06, 0x07, 0xe5, 0x59, 0xc3, 0x26, 0xf6, 0xb3, 0xdf,
+	0x38, 0xb2, 0x0a, 0xbf, 0xca, 0xab, 0x1e, 0x93, 0xdb, 0x30, 0x14, 0x34, 0xbc, 0x8e, 0x8a, 0xfe,
+	0xf0, 0x44, 0x9e, 0x62, 0x39, 0xad, 0x53, 0x8e, 0x26, 0xb8, 0xce, 0x5a, 0xa2, 0x40, 0x30, 0xf7,
+	0x2a, 0x4c, 0xe8, 0x3d, 0xcf, 0x51, 0x9c, 0x2c, 0x25, 0xdd, 0x55, 0x4f, 0xc8, 0xed, 0x75, 0x45,
+	0xcb, 0x6f, 0x0e, 0xc0, 0x30, 0xd2, 0x56, 0x6f, 0xc9, 0x66, 0x6c, 0x18, 0x7a, 0xdd, 0x73, 0xa3,
+	0x9c, 0x91, 0xe5, 0xd2, 0x0f, 0x68, 0xb9, 0x14, 0x5f, 0xf6, 0x5c, 0x6d, 0x0e, 0xd8, 0xaf, 0x00,
+	0x05, 0x05, 0xe2, 0x46, 0x09, 0x4b, 0x07, 0xca, 0x7b, 0xb3, 0x8b, 0x81, 0xf5, 0x92, 0xa2, 0x94,
+	0xfc, 0x88, 0x01, 0xc4, 0x6a, 0x34, 0x68, 0x10, 0x20, 0x0d, 0xd8, 0xdc, 0x8b, 0x9b, 0x8e, 0xe0,
+	0xb2, 0xe5, 0x52, 0x53, 0xa4, 0xb1, 0xc5, 0x32, 0x7f, 0x06, 0x14, 0x60, 0x0e, 0xf1, 0x7e, 0xd2,
+	0xa6, 0x7e, 0xde, 0x80, 0x29, 0x15, 0x3a, 0x6f, 0xcd, 0xe6, 0x0f, 0xad, 0xdf, 0x03, 0xa3, 0x5d,
+	0x69, 0xab, 0x91, 0x9f, 0x38, 0x62, 0x22, 0xca, 0x86, 0x83, 0x51, 0x0d, 0x76, 0x3f, 0xdf, 0xf1,
+	0x44, 0xc4, 0xf4, 0xe8, 0x7e, 0x7e, 0x9b, 0x15, 0xa0, 0x28, 0x67, 0x97, 0x5a, 0x9f, 0x4f, 0xaf,
+	0xba, 0xc2, 0xf3, 0x4b, 0xad, 0x98, 0xf1, 0x00, 0x15, 0xcc, 0xfc, 0x9f, 0x2a, 0x70, 0x5e, 0xed,
+	0x97, 0x45, 0xab, 0x71, 0xbf, 0xdb, 0x11, 0xfe, 0x95, 0x67, 0x6d, 0x58, 0x7d, 0x12, 0x46, 0x55,
+	0xd6, 0x51, 0xa5, 0x85, 0xe0, 0x56, 0x6b, 0x59, 0x86, 0x11, 0x94, 0xb4, 0xe1, 0x9c, 0x4f, 0x43,
+	0xea, 0x6a, 0x41, 0x52, 0x07, 0xca, 0x87, 0xd6, 0xc1, 0x24, 0x2a, 0x4c, 0xe3, 0x26, 0x37, 0x81,
+	0xd8, 0x6e, 0xc3, 0xe9, 0x36, 0x69, 0x33, 0xba, 0x6a, 0x2b, 0x5b, 0x39, 0x97, 0x43, 0x56, 0x32,
+	0x50, 0xcc, 0x69, 0x61, 0xfe, 0xaa, 0x01, 0x13, 0x89, 0xbc, 0xc3, 0xed, 0xd8, 0xd4, 0x56, 0xde,
+	0xc1, 0x50, 0x3d, 0x41, 0x7e, 0xec, 0x88, 0x4a, 0xc2, 0x7c, 0x77, 0x37, 0xca, 0x83, 0x77, 0x3a,
+	0x29, 0x8a, 0xcd, 0xaf, 0x18, 0x70, 0x49, 0x0d, 0x28, 0x99, 0x3e, 0x86, 0x7d, 0x4c, 0xab, 0x63,
+	0x73, 0x3b, 0x90, 0x6e, 0x49, 0x5b, 0xd8, 0x58, 0xe1, 0x65, 0x18, 0x41, 0xd9, 0x22, 0x57, 0xec,
+	0x2e, 0x9d, 0x0e, 0x2b, 0x72, 0x99, 0x8c, 0x6a, 0x90, 0x77, 0x4a, 0xcf, 0x7a, 0x21, 0xa8, 0x47,
+	0x77, 0x8b, 0x88, 0xb0, 0xf0, 0x95, 0x37, 0xbf, 0x1d, 0xc6, 0xea, 0xf5, 0xdb, 0x62, 0xd3, 0x9e,
+	0xc0, 0x20, 0x6c, 0x3e, 0x1c, 0x80, 0x49, 0x99, 0xb9, 0xcd, 0xe6, 0x1a, 0xe2, 0x47, 0x20, 0xc9,
+	0x6c, 0xc2, 0x58, 0x10, 0x99, 0x38, 0x2b, 0xc5, 0x27, 0x51, 0x64, 0xb5, 0x4c, 0xe7, 0xeb, 0x8e,
+	0xad, 0x9b, 0x31, 0x22, 0x72, 0x07, 0x86, 0x5f, 0x63, 0xa7, 0xaa, 0xe2, 0xc6, 0x3d, 0x1d, 0x6e,
+	0x11, 0xab, 0xe5, 0x07, 0x72, 0x80, 0x12, 0x05, 0x09, 0xf8, 0x1b, 0x79, 0x2e, 0xe6, 0xf7, 0x13,
+	0x2f, 0x3a, 0x31, 0xb3, 0xea, 0xde, 0x20, 0x16, 0x86, 0xfa, 0x85, 0x11, 0x21, 0xe2, 0x45, 0x6a,
+	0xe2, 0x3e, 0xe2, 0xe3, 0x24, 0x48, 0x1e, 0xa3, 0x2c, 0xfe, 0x6d, 0x03, 0x66, 0x12, 0xf5, 0x1f,
+	0x81, 0xe4, 0xb7, 0x9d, 0x94, 0xfc, 0x16, 0xfa, 0x1e, 0x63, 0x81, 0x04, 0xf8, 0x21, 0xb8, 0x98,
+	0x3b, 0xfb, 0xc7, 0xdf, 0xda, 0xcc, 0x2f, 0x18, 0x70, 0x3e, 0x67, 0x1a, 0xc9, 0x6b, 0x3c, 0x2d,
+	0xaa, 0x7a, 0x53, 0x2d, 0x74, 0x13, 0xcf, 0x96, 0xcc, 0xd4, 0x22, 0xb0, 0x24, 0xd2, 0xa1, 0xaa,
+	0x27, 0xd9, 0x1a, 0x11, 0xf3, 0xef, 0x56, 0x60, 0xb0, 0x4e, 0x69, 0xf3, 0x11, 0xec, 0xca, 0x57,
+	0x12, 0xf7, 0x8b, 0xef, 0x28, 0xf7, 0x5d, 0x68, 0xb3, 0xd0, 0x08, 0xb2, 0x9d, 0x32, 0x82, 0x7c,
+	0xa4, 0x34, 0x85, 0xa3, 0x17, 0xf5, 0xaf, 0x57, 0x60, 0x82, 0x55, 0x5b, 0xd8, 0xde, 0xb6, 0x5d,
+	0x3b, 0xdc, 0x67, 0x62, 0xd3, 0xac, 0xb2, 0x68, 0x2f, 0x75, 0x7d, 0xf6, 0x3d, 0xa3, 0x00, 0xfa,
+	0xf2, 0x2b, 0x7e, 0x5b, 0x8f, 0x0b, 0x9c, 0x49, 0x3a, 0x75, 0xea, 0xd0, 0x46, 0xe8, 0xf9, 0x8b,
+	0xd7, 0x65, 0x07, 0x66, 0xb1, 0x00, 0x39, 0x16, 0x92, 0x25, 0x7f, 0xcb, 0x80, 0x2b, 0x1d, 0x9f,
+	0xb1, 0xa1, 0xbc, 0x4e, 0xf5, 0x91, 0xb7, 0xf5, 0x45, 0x6a, 0xb7, 0x76, 0x42, 0xda, 0xd4, 0x67,
+	0x60, 0x93, 0xfa, 0xed, 0x28, 0xfc, 0xc6, 0x95, 0x8d, 0x22, 0xb2, 0x58, 0xdc, 0x23, 0xf3, 0xc7,
+	0x07, 0x60, 0x9a, 0xa3, 0xd4, 0xb3, 0x8c, 0x2f, 0xc0, 0xb9, 0xa6, 0xf7, 0xc0, 0x8d, 0x9f, 0x0c,
+	0x45, 0x57, 0x2a, 0x49, 0xeb, 0xdc, 0x52, 0x12, 0x8c, 0xe9, 0xfa, 0xe4, 0x1e, 0xcc, 0x59, 0x8d,
+	0x46, 0xb7, 0xdd, 0x75, 0xac, 0x90, 0x36, 0x55, 0x75, 0x95, 0x46, 0x4b, 0x78, 0x67, 0x9a, 0x12,
+	0xdb, 0xdc, 0x42, 0x61, 0x4d, 0x3c, 0x02, 0x0b, 0xf1, 0x60, 0xc6, 0xb1, 0x82, 0x50, 0x15, 0xf3,
+	0xc7, 0x39, 0x25, 0xf4, 0xcb, 0xdc, 0x74, 0xbb, 0x9a, 0x46, 0x84, 0x59, 0xdc, 0xc4, 0x86, 0x73,
+	0x7a, 0xe1, 0xb2, 0xdb, 0x2c, 0xa1, 0x51, 0xe6, 0x92, 0xda, 0x6a, 0x12, 0x0d, 0xa6, 0xf1, 0x9a,
+	0x7f, 0x58, 0x81, 0x11, 0xf6, 0x5d, 0x96, 0xd6, 0xeb, 0xa4, 0xad, 0x9d, 0x59, 0x95, 0xf2, 0x9a,
+	0x10, 0x89, 0xee, 0xd8, 0xd3, 0x2a, 0x80, 0x51, 0x5b, 0xa6, 0x7e, 0x95, 0xb3, 0xb9, 0x72, 0x0a,
+	0xe4, 0x64, 0xa4, 0x33, 0x4e, 0x54, 0x65, 0x96, 0xc5, 0x88, 0x10, 0x79, 0xa0, 0x29, 0x87, 0x07,
+	0xcb, 0x3f, 0x29, 0xca, 0x27, 0x7a, 0x84, 0x9e, 0xd8, 0xfc, 0x41, 0x03, 0xce, 0xa5, 0x5a, 0xf5,
+	0xa0, 0xff, 0x3b, 0x13, 0x49, 0xc7, 0xfc, 0x03, 0x83, 0x9d, 0x6d, 0x39, 0x23, 0xe8, 0xa1, 0x47,
+	0x71, 0x0a, 0xf0, 0xca, 0x91, 0x29, 0xc0, 0xaf, 0xc2, 0x20, 0xbb, 0xe4, 0xea, 0xae, 0xc4, 0xdc,
+	0x21, 0x95, 0x97, 0x92, 0x06, 0x4c, 0x35, 0x7c, 0xda, 0x64, 0x97, 0x06, 0xcb, 0x09, 0xd8, 0xe0,
+	0x06, 0x7b, 0x57, 0x28, 0x44, 0xba, 0xd6, 0x5a, 0x02, 0x05, 0xa6, 0x50, 0x9a, 0xff, 0xcc, 0x80,
+	0x51, 0x36, 0xcc, 0x47, 0x20, 0x94, 0x7c, 0x77, 0x52, 0x28, 0xf9, 0x60, 0xd9, 0x35, 0x55, 0x20,
+	0x8b, 0xfc, 0x91, 0x3c, 0x92, 0xa4, 0xdb, 0xb3, 0xe6, 0xd0, 0x6c, 0x14, 0xb8, 0x62, 0x5f, 0x97,
+	0xfe, 0xd0, 0x29, 0xe3, 0xb4, 0xe6, 0x13, 0xfd, 0x9e, 0x84, 0xcb, 0x73, 0xe2, 0x16, 0x91, 0xe3,
+	0xf6, 0xfc, 0x3a, 0x4c, 0x72, 0xfb, 0xc1, 0x52, 0xbc, 0x79, 0x4a, 0x3b, 0x22, 0x70, 0x13, 0x82,
+	0x1a, 0x8a, 0x70, 0xe9, 0xaa, 0xeb, 0xb8, 0x31, 0x49, 0x8a, 0xcc, 0x03, 0xdc, 0x73, 0xbc, 0xc6,
+	0x7d, 0xe1, 0x71, 0x2d, 0x42, 0xd1, 0x70, 0x27, 0xca, 0xc5, 0xa8, 0x14, 0xb5, 0x1a, 0x7d, 0x39,
+	0x97, 0xff, 0x81, 0x21, 0x66, 0xfa, 0x04, 0x7b, 0xf4, 0x11, 0xfa, 0xe7, 0xbf, 0x0b, 0x86, 0x85,
+	0xce, 0x41, 0xa5, 0x35, 0x56, 0x9b, 0x4f, 0xa8, 0x24, 0x50, 0x42, 0xd9, 0xf2, 0x10, 0x3a, 0xac,
+	0xc1, 0x58, 0xb1, 0xa1, 0x6b, 0x9e, 0xcc, 0x5f, 0x90, 0xc3, 0x54, 0xb2, 0x08, 0xe9, 0xc0, 0xa4,
+	0xa3, 0x0b, 0x27, 0x72, 0x8f, 0x94, 0x92, 0x6b, 0xa2, 0xd7, 0x42, 0x89, 0x62, 0x4c, 0x12, 0x20,
+	0x1f, 0x80, 0x49, 0x35, 0x3a, 0xf1, 0x68, 0xa7, 0x12, 0x47, 0xba, 0xd8, 0xd0, 0x01, 0x98, 0xac,
+	0x67, 0x3e, 0xcf, 0x84, 0x6b, 0xd6, 0x75, 0xae, 0x43, 0x5f, 0x5a, 0xaf, 0xf3, 0xab, 0xec, 0x49,
+	0xee, 0xac, 0x5f, 0xad, 0xc0, 0xe3, 0x3a, 0x0a, 0xda, 0xa1, 0x6e, 0x93, 0xba, 0x8d, 0x7d, 0x7e,
+	0x25, 0x6f, 0x7a, 0x2d, 0xf2, 0x06, 0x0c, 0x3f, 0xa0, 0xb4, 0x19, 0x39, 0x43, 0xbc, 0x58, 0x5a,
+	0xd6, 0x2c, 0x22, 0xf1, 0x22, 0x47, 0x2f, 0xfc, 0xca, 0xc4, 0xff, 0x28, 0x49, 0x32, 0xe2, 0x1d,
+	0xdf, 0xbb, 0x17, 0xdd, 0x1c, 0x4f, 0x9f, 0xf8, 0x06, 0x47, 0x2f, 0x88, 0x8b, 0xff, 0x51, 0x92,
+	0x64, 0x2b, 0xe3, 0x89, 0x1e, 0xda, 0x9e, 0xc4, 0x67, 0xbc, 0xc5, 0xbf, 0xf4, 0x3d, 0xca, 0x8f,
+	0xe3, 0x5d, 0xcb, 0x29, 0x19, 0x89, 0x4b, 0xad, 0x8c, 0x18, 0x11, 0x26, 0xf1, 0x1e, 0xdf, 0x77,
+	0x31, 0xd1, 0x27, 0xec, 0x3b, 0xcf, 0xea, 0xab, 0x7a, 0xd1, 0x4f, 0xdf, 0x5f, 0xd4, 0x11, 0x61,
+	0x12, 0x2f, 0xbb, 0x4a, 0xbf, 0x43, 0xeb, 0xfb, 0xf2, 0x5e, 0x83, 0x06, 0x41, 0xcd, 0xea, 0x58,
+	0x0d, 0x3b, 0xdc, 0x17, 0x71, 0xa2, 0x85, 0x93, 0xc4, 0x3b, 0xd3, 0x9d, 0xcf, 0x4d, 0x71, 0x46,
+	0x7e, 0xd0, 0x80, 0x11, 0xf1, 0x5e, 0x41, 0x1d, 0x4a, 0xaf, 0xf4, 0xb9, 0x8c, 0x0a, 0xbb, 0xa4,
+	0x72, 0x66, 0xab, 0x49, 0x14, 0xbf, 0x03, 0x54, 0xf4, 0xcd, 0x7f, 0x3f, 0x04, 0x7f, 0xb1, 0x77,
+	0x44, 0xe4, 0x0f, 0x0c, 0x18, 0x53, 0xea, 0x2b, 0x75, 0x4d, 0x6e, 0x9f, 0x6d, 0xe7, 0x23, 0x73,
+	0x87, 0xd4, 0xa0, 0xbf, 0xa8, 0xe4, 0xa7, 0xa8, 0xfc, 0x94, 0x2c, 0x29, 0xf1, 0xc0, 0xc8, 0xdf,
+	0x30, 0x60, 0x82, 0x1d, 0xd6, 0x11, 0xcb, 0x15, 0x9f, 0xa9, 0x73, 0xc6, 0x23, 0x5d, 0xd7, 0x48,
+	0xa6, 0x62, 0xcb, 0xea, 0x20, 0x4c, 0xf4, 0x8d, 0x6c, 0x25, 0x9d, 0xa3, 0x84, 0x86, 0xec, 0x5a,
+	0x9e, 0xb4, 0xa6, 0x39, 0x32, 0x44, 0x6e, 0xb6, 0x85, 0x8e, 0x4f, 0xf3, 0x00, 0x1d, 0x91, 0xaa,
+	0xc7, 0x6a, 0x51, 0x19, 0xa3, 0x8e, 0x1f, 0xed, 0x1b, 0x51, 0x29, 0x6a, 0x35, 0xe6, 0x1c, 0x98,
+	0x4a, 0x7e, 0xa9, 0xb3, 0xb4, 0x1b, 0xcd, 0x3d, 0x07, 0x33, 0x99, 0xd9, 0x3a, 0x91, 0x85, 0xe2,
+	0xff, 0xa9, 0xc0, 0x35, 0xed, 0xd3, 0xac, 0x04, 0xa1, 0xed, 0xc9, 0x97, 0x46, 0xb7, 0xac, 0x90,
+	0x3e, 0xb0, 0xf6, 0xc9, 0x0f, 0x1b, 0xc9, 0xfc, 0xbd, 0xe2, 0xdc, 0xfd, 0xee, 0x3e, 0x17, 0x41,
+	0x0e, 0xa5, 0xde, 0x33, 0xfa, 0xfe, 0x12, 0x7f, 0xdf, 0xd4, 0xf1, 0x82, 0xae, 0x4f, 0x79, 0x90,
+	0x96, 0xdb, 0x96, 0xdb, 0x74, 0xa8, 0xaf, 0x18, 0xc9, 0xf6, 0xe9, 0x77, 0x6e, 0x39, 0x87, 0x5c,
+	0xe4, 0xff, 0x75, 0x31, 0x0f, 0x1a, 0x60, 0x7e, 0x1f, 0xcd, 0xdf, 0x1b, 0x80, 0xa7, 0x7a, 0x9e,
+	0x09, 0xf2, 0x5e, 0x18, 0x6f, 0xdb, 0xae, 0xcc, 0x41, 0xab, 0xd4, 0x0f, 0x7c, 0x7a, 0xd6, 0xe2,
+	0x62, 0xd4, 0xeb, 0xf0, 0x26, 0xd6, 0x5e, 0xd4, 0xa4, 0xa2, 0x35, 0x89, 0x8b, 0x51, 0xaf, 0x43,
+	0xbe, 0x61, 0x68, 0x99, 0xe0, 0xc5, 0xde, 0xb9, 0x7f, 0xa6, 0x5f, 0x78, 0x5e, 0x25, 0xf4, 0x15,
+	0x3b, 0xfc, 0x85, 0xd8, 0x3e, 0x20, 0x8a, 0x4f, 0x89, 0x9b, 0x45, 0x83, 0x9a, 0xbb, 0x0f, 0x93,
+	0x09, 0x92, 0x67, 0x6a, 0xcf, 0xfd, 0x37, 0x06, 0x3c, 0x7d, 0xf2, 0xf5, 0xd4, 0x83, 0xed, 0x37,
+	0xbd, 0x19, 0x2b, 0x6f, 0xfa, 0x66, 0x34, 0xff, 0xe5, 0x30, 0x54, 0x35, 0x5c, 0x79, 0x51, 0x69,
+	0xc9, 0x4f, 0xb2, 0x6e, 0xbb, 0xae, 0x7c, 0x69, 0xa2, 0x8e, 0xcc, 0x66, 0x9f, 0xdd, 0xce, 0x23,
+	0x35, 0xbf, 0x10, 0x93, 0x49, 0x3d, 0xa5, 0xd0, 0x20, 0xa8, 0xf7, 0xe6, 0x88, 0xe7, 0x92, 0x95,
+	0x47, 0xf6, 0x5c, 0x92, 0x7c, 0x4a, 0xdd, 0x88, 0x06, 0xca, 0xc7, 0xa7, 0x3c, 0x66, 0x6e, 0xf8,
+	0x05, 0xab, 0xc0, 0xd2, 0xff, 0x65, 0x83, 0xcb, 0xc0, 0x71, 0xf0, 0x60, 0x29, 0xda, 0x97, 0x7a,
+	0xf5, 0x76, 0x6c, 0x64, 0xe2, 0x48, 0x54, 0x8e, 0x8b, 0x30, 0x49, 0x9e, 0xfc, 0xb8, 0x01, 0x13,
+	0xaf, 0x7b, 0xae, 0xe5, 0xc8, 0x75, 0x29, 0x2d, 0x46, 0x9f, 0x38, 0x9b, 0x79, 0x89, 0xc8, 0x08,
+	0x9f, 0x4e, 0xbd, 0x04, 0x13, 0xdd, 0x60, 0x37, 0x57, 0x11, 0x2c, 0x6c, 0x38, 0x56, 0x6c, 0xe8,
+	0x81, 0xc2, 0xe6, 0x3e, 0x02, 0xd3, 0xe9, 0x35, 0x78, 0xa2, 0x23, 0x79, 0x13, 0xde, 0x7d, 0x82,
+	0xfe, 0xf6, 0x28, 0x6d, 0x9b, 0xff, 0x78, 0x30, 0x21, 0xbd, 0x17, 0x2e, 0x8f, 0x1e, 0x78, 0xd0,
+	0xd7, 0x52, 0x9b, 0x59, 0x9c, 0xb9, 0xf6, 0x59, 0x2d, 0xd8, 0xd3, 0xdd, 0xd1, 0x03, 0x8f, 0x6e,
+	0x47, 0x7f, 0xb3, 0x6d, 0xa9, 0xbe, 0x57, 0xe6, 0xa2, 0x50, 0xca, 0xca, 0x0f, 0xa6, 0xd9, 0x7a,
+	0x9e, 0x82, 0x91, 0x5d, 0x3b, 0xb0, 0x55, 0x96, 0x29, 0xed, 0xba, 0xfa, 0x82, 0x28, 0x46, 0x05,
+	0x37, 0x57, 0x13, 0x87, 0xc5, 0xa6, 0xd7, 0xf1, 0x1c, 0xaf, 0xb5, 0xbf, 0xf0, 0xc0, 0xf2, 0x29,
+	0x7a, 0xdd, 0x50, 0x62, 0xeb, 0x55, 0x4f, 0xf2, 0x99, 0x21, 0xb8, 0xae, 0xa1, 0xcb, 0x4d, 0xc6,
+	0x71, 0x92, 0xcb, 0xf4, 0xd7, 0xd2, 0x39, 0x33, 0x4e, 0x47, 0x9e, 0xcc, 0xed, 0x57, 0xb9, 0x1c,
+	0x1a, 0xe4, 0xc0, 0x00, 0x68, 0x5b, 0x7b, 0x32, 0x80, 0xbb, 0x3c, 0x2d, 0x9a, 0x67, 0xd2, 0xc1,
+	0xb5, 0x88, 0x8c, 0xe8, 0xde, 0x4b, 0xca, 0xba, 0x1a, 0x03, 0x4e, 0x49, 0x4c, 0xd3, 0x46, 0xd4,
+	0x77, 0x92, 0x90, 0xb9, 0x36, 0x9c, 0x4b, 0xf5, 0xfc, 0x4c, 0x65, 0xbd, 0xdf, 0x1b, 0x53, 0x9a,
+	0x4a, 0x19, 0x59, 0xfe, 0x1f, 0x18, 0x70, 0x85, 0x16, 0xdd, 0x71, 0xe5, 0xf5, 0xe9, 0xa5, 0xb3,
+	0xba, 0x43, 0xcb, 0x3c, 0xdb, 0x45, 0x60, 0x2c, 0xee, 0x19, 0xd9, 0x07, 0x08, 0x74, 0x0b, 0x6d,
+	0x5f, 0x06, 0xaf, 0x0c, 0x93, 0x10, 0xb7, 0x66, 0xcd, 0x16, 0xab, 0x11, 0x23, 0x3f, 0x63, 0xc0,
+	0x05, 0x27, 0x87, 0xc3, 0x49, 0x8e, 0x59, 0x3f, 0x83, 0xb3, 0x45, 0x78, 0x7d, 0xe7, 0x41, 0x30,
+	0xb7, 0x2b, 0xe4, 0xaf, 0x15, 0x66, 0x16, 0x12, 0x92, 0xc9, 0xe6, 0x59, 0xec, 0xc1, 0x12, 0x49,
+	0x86, 0xbe, 0x6a, 0x00, 0x69, 0x66, 0x14, 0x8b, 0xf2, 0xb5, 0xd8, 0xc7, 0x4e, 0x5d, 0x53, 0x2b,
+	0xdc, 0xe5, 0xb2, 0xe5, 0x98, 0xd3, 0x09, 0xfe, 0x9d, 0xc3, 0x1c, 0x9e, 0x2f, 0x43, 0x44, 0xf5,
+	0xfb, 0x9d, 0xf3, 0x8e, 0x13, 0xf1, 0x9d, 0xf3, 0x20, 0x98, 0xdb, 0x15, 0xf2, 0xd3, 0x06, 0x9c,
+	0xb7, 0xb3, 0x17, 0x22, 0x19, 0x62, 0x0a, 0x4f, 0xff, 0xaa, 0x25, 0xfc, 0xb3, 0x73, 0x00, 0x98,
+	0xd7, 0x0f, 0xf2, 0x1a, 0x8c, 0x36, 0xdd, 0x80, 0x9b, 0x14, 0x78, 0xe4, 0x80, 0xd2, 0x5e, 0x54,
+	0x19, 0x0b, 0x85, 0xb0, 0x49, 0xab, 0x5f, 0x18, 0x91, 0x31, 0xff, 0xe9, 0x98, 0xb0, 0x53, 0x72,
+	0x57, 0xf3, 0x57, 0x60, 0xf8, 0x1e, 0x77, 0x21, 0x95, 0xac, 0xac, 0xd4, 0xe3, 0x07, 0xe1, 0x84,
+	0x2a, 0xf4, 0xfb, 0xe2, 0x7f, 0x94, 0x58, 0xc9, 0xcb, 0x30, 0xd0, 0x74, 0x55, 0x50, 0xba, 0x0f,
+	0xf7, 0x61, 0xfb, 0x8e, 0x1f, 0xe8, 0x2c, 0xad, 0xd7, 0x91, 0x21, 0x25, 0x2e, 0x8c, 0xba, 0xd2,
+	0xac, 0x27, 0xed, 0x26, 0xcf, 0x97, 0x25, 0x10, 0x99, 0x07, 0x23, 0xa3, 0xa4, 0x2a, 0xc1, 0x88,
+	0x06, 0xa3, 0x97, 0x72, 0xb2, 0x2b, 0x4d, 0x2f, 0xf2, 0x56, 0xd0, 0x53, 0xd9, 0xa4, 0x3d, 0x16,
+	0x28, 0x0c, 0x87, 0x96, 0xed, 0x86, 0x2a, 0xc0, 0xdc, 0xb3, 0x65, 0xa9, 0x6d, 0x32, 0x2c, 0xb1,
+	0xf5, 0x8e, 0xff, 0x0c, 0x50, 0x22, 0x27, 0xf7, 0x60, 0x58, 0x04, 0x99, 0x93, 0x5c, 0xa5, 0xb4,
+	0xa3, 0x93, 0x88, 0x5b, 0x27, 0x96, 0x81, 0xf8, 0x1f, 0x25, 0x66, 0xf2, 0x2a, 0x8c, 0x06, 0xea,
+	0xd5, 0xcb, 0x68, 0x7f, 0x53, 0x17, 0x3d, 0x79, 0x91, 0x21, 0xb3, 0xe4, 0x5b, 0x97, 0x08, 0x3f,
+	0xb9, 0x07, 0x23, 0xb6, 0xbc, 0x65, 0x8e, 0x95, 0x5f, 0x76, 0xea, 0x06, 0xc9, 0x2f, 0x60, 0xea,
+	0xf2, 0xa8, 0x10, 0x17, 0xb9, 0xb6, 0xc3, 0x9b, 0xe8, 0xda, 0x4e, 0x5e, 0x03, 0xa0, 0xca, 0xbc,
+	0x1b, 0xcc, 0x8e, 0x97, 0x5f, 0x32, 0x9a, 0x91, 0x58, 0xc9, 0x88, 0x51, 0x51, 0x80, 0x1a, 0x11,
+	0xf2, 0x86, 0x6e, 0x39, 0x99, 0xe8, 0x2f, 0x6e, 0x66, 0x36, 0x12, 0x6c, 0xec, 0x56, 0x12, 0x29,
+	0xe1, 0x35, 0x83, 0x86, 0xf9, 0x2b, 0xe3, 0x00, 0xb1, 0x7f, 0x1d, 0xd9, 0x86, 0x51, 0x45, 0xa5,
+	0x9f, 0xc8, 0xb5, 0xb7, 0x24, 0x58, 0x2c, 0x2f, 0xf5, 0x0b, 0x23, 0xdc, 0xa4, 0x96, 0x17, 0xf2,
+	0xb9, 0x12, 0xa7, 0xb6, 0xee, 0x29, 0xdc, 0x73, 0xd2, 0x35, 0x73, 0xe0, 0x11, 0xb8, 0x66, 0x16,
+	0xbc, 0x9c, 0x1e, 0x2c, 0xf5, 0x72, 0xfa, 0x59, 0x38, 0x27, 0x1f, 0x1b, 0xad, 0x70, 0x0f, 0x98,
+	0x70, 0x5f, 0x06, 0x17, 0xe2, 0x5e, 0x60, 0xb5, 0x24, 0x08, 0xd3, 0x75, 0xc9, 0x3f, 0x32, 0x60,
+	0xb4, 0x21, 0x65, 0x56, 0xc9, 0xdb, 0x56, 0xfb, 0xf3, 0xae, 0x9c, 0x57, 0x22, 0x70, 0x4a, 0x01,
+	0xad, 0x8a, 0x4f, 0x4b, 0x01, 0xad, 0x7a, 0x4d, 0xfe, 0x85, 0x01, 0xe3, 0x96, 0xc3, 0x63, 0x5d,
+	0xf3, 0x14, 0x00, 0x22, 0xea, 0xd1, 0xdd, 0x3e, 0x47, 0xb1, 0x10, 0x63, 0x14, 0x03, 0xf9, 0xce,
+	0x48, 0x39, 0x12, 0x43, 0x4e, 0x69, 0x2c, 0x7a, 0xf7, 0xc9, 0x7f, 0x65, 0xc0, 0x3b, 0x44, 0xa8,
+	0xa9, 0x1a, 0x13, 0x43, 0xb7, 0xed, 0x86, 0x15, 0xd2, 0x38, 0x71, 0x4a, 0xfc, 0xce, 0x7b, 0xf4,
+	0xc4, 0x8e, 0x81, 0x4f, 0x1e, 0x1e, 0x54, 0xdf, 0x51, 0xeb, 0x01, 0x37, 0xf6, 0xd4, 0x03, 0xf2,
+	0x3a, 0x4c, 0x3a, 0x7a, 0x26, 0x36, 0xc9, 0xe4, 0x4b, 0xb9, 0x06, 0x25, 0x52, 0xba, 0x09, 0x9d,
+	0x4b, 0xa2, 0x08, 0x93, 0xa4, 0xc8, 0xeb, 0x30, 0x61, 0x69, 0x1e, 0xa5, 0x52, 0x62, 0x5b, 0x2a,
+	0xfb, 0xd5, 0x75, 0xef, 0x54, 0x99, 0x14, 0x42, 0x2b, 0xc1, 0x04, 0xad, 0xb9, 0xfb, 0x30, 0x99,
+	0x58, 0xe4, 0x67, 0x6a, 0x8a, 0x74, 0x61, 0x3a, 0xbd, 0x16, 0xcf, 0xf4, 0xda, 0x7d, 0x07, 0xc6,
+	0x22, 0x41, 0x85, 0x3c, 0xae, 0x11, 0x8a, 0xc5, 0xbe, 0x3b, 0x74, 0x5f, 0x50, 0xad, 0x26, 0x54,
+	0x5a, 0x42, 0x67, 0xcb, 0xb3, 0x43, 0x48, 0x84, 0xe6, 0xaf, 0x49, 0x6f, 0xa3, 0x4d, 0xda, 0xee,
+	0x38, 0x56, 0x48, 0xdf, 0xfa, 0xce, 0xe8, 0xe6, 0xff, 0x6e, 0x88, 0xb3, 0x4e, 0x88, 0x55, 0xc4,
+	0xe2, 0x66, 0x44, 0xbb, 0xdd, 0x6d, 0xf3, 0x5c, 0x12, 0x46, 0xf9, 0x2c, 0x16, 0x6b, 0x31, 0x1a,
+	0xd4, 0x71, 0x92, 0x07, 0x30, 0xa6, 0x04, 0x51, 0xa5, 0x38, 0xbb, 0xd9, 0x9f, 0x60, 0x18, 0xc9,
+	0xbc, 0xd1, 0xb1, 0xae, 0x4a, 0x02, 0x8c, 0x69, 0x99, 0x16, 0x90, 0x6c, 0x1b, 0xf2, 0x54, 0x1c,
+	0xef, 0xc5, 0x48, 0xa6, 0x95, 0xca, 0xc4, 0x7c, 0x51, 0x3a, 0xef, 0x4a, 0x91, 0xce, 0xdb, 0xfc,
+	0x27, 0x15, 0xb8, 0x20, 0x35, 0x01, 0x0b, 0x8d, 0x86, 0xd7, 0x75, 0xc3, 0x38, 0x0c, 0x97, 0x88,
+	0x6d, 0x27, 0x89, 0x70, 0x51, 0x56, 0x04, 0xbe, 0x43, 0x09, 0x21, 0x77, 0x85, 0x32, 0xda, 0x6d,
+	0x6e, 0x7a, 0xf7, 0xa9, 0x1b, 0x73, 0x28, 0x3d, 0x88, 0xe4, 0x72, 0x5e, 0x05, 0xcc, 0x6f, 0x47,
+	0x76, 0x81, 0xb4, 0xad, 0xbd, 0x34, 0xb6, 0x72, 0xa9, 0xe8, 0xf9, 0xf5, 0x7d, 0x2d, 0x83, 0x0d,
+	0x73, 0x28, 0xb0, 0x43, 0x9c, 0x49, 0x91, 0x9d, 0x90, 0x36, 0xc5, 0x10, 0x95, 0xb3, 0x23, 0x3f,
+	0xc4, 0x17, 0x92, 0x20, 0x4c, 0xd7, 0x35, 0x3f, 0x37, 0x0c, 0x57, 0x92, 0x93, 0xc8, 0x76, 0xa8,
+	0x0a, 0x3f, 0xf7, 0x9c, 0x8a, 0xad, 0x22, 0x26, 0xf2, 0xa9, 0x74, 0x6c, 0x95, 0x59, 0xdd, 0x99,
+	0x56, 0x36, 0x4a, 0xc4, 0x59, 0x79, 0x13, 0x62, 0xc9, 0x15, 0x04, 0x0f, 0x1b, 0x38, 0xd3, 0x98,
+	0x79, 0x5f, 0x34, 0x60, 0x2e, 0x59, 0x7c, 0xd3, 0x76, 0xed, 0x60, 0x47, 0x66, 0x9f, 0x3d, 0xb9,
+	0x23, 0xfe, 0xb5, 0xc3, 0x83, 0xea, 0xdc, 0x6a, 0x21, 0x46, 0x3c, 0x82, 0x1a, 0xf9, 0x21, 0x03,
+	0x1e, 0x4b, 0xcd, 0x4b, 0x22, 0x17, 0xee, 0xc9, 0xa3, 0xbc, 0xf0, 0xe0, 0xa7, 0xab, 0xc5, 0x28,
+	0xf1, 0x28, 0x7a, 0x47, 0x25, 0xe8, 0x18, 0xfe, 0x66, 0x49, 0xd0, 0xf1, 0xdf, 0x56, 0x60, 0x88,
+	0xfb, 0x13, 0xbf, 0x35, 0x62, 0x2a, 0xf0, 0xae, 0x16, 0x3e, 0x7a, 0x6a, 0xa5, 0x1e, 0x3d, 0x3d,
+	0x57, 0x9e, 0xc4, 0xd1, 0xaf, 0x9e, 0xbe, 0x13, 0x2e, 0xf1, 0x6a, 0x0b, 0x4d, 0xae, 0xf7, 0x0c,
+	0x78, 0x8e, 0x18, 0x7e, 0xb5, 0x3e, 0xde, 0x64, 0xf9, 0x38, 0x0c, 0x74, 0x7d, 0x27, 0x9d, 0xa1,
+	0x66, 0x0b, 0x57, 0x91, 0x95, 0x9b, 0x7f, 0xb9, 0x02, 0xd3, 0x1c, 0xb7, 0xc6, 0x62, 0xc8, 0x2e,
+	0x8c, 0xfa, 0x92, 0xcd, 0xc8, 0x6f, 0xb3, 0x5a, 0x7a, 0x68, 0x39, 0xac, 0x4b, 0xdc, 0x16, 0xd5,
+	0x2f, 0x8c, 0x68, 0x91, 0xcf, 0x1b, 0x30, 0x4d, 0xdd, 0x86, 0xbf, 0xcf, 0x83, 0x9e, 0x2d, 0x84,
+	0xec, 0xc6, 0xde, 0x4f, 0x42, 0xac, 0xe5, 0x14, 0xae, 0xc5, 0x0b, 0x3c, 0x83, 0x60, 0xaa, 0x14,
+	0x33, 0x34, 0xcd, 0x7f, 0x3b, 0x0c, 0xb3, 0x45, 0xbd, 0x27, 0x3f, 0x6a, 0xc0, 0xa5, 0x46, 0x2c,
+	0x76, 0x2f, 0x74, 0xc3, 0x1d, 0xcf, 0x57, 0x09, 0x36, 0x4b, 0xeb, 0x84, 0x6a, 0x0b, 0xd1, 0xf4,
+	0xf0, 0xac, 0xb2, 0xb5, 0x5c, 0x0a, 0x58, 0x40, 0x99, 0xbc, 0x01, 0x10, 0x04, 0x3b, 0x77, 0xe8,
+	0x7e, 0xc7, 0xb2, 0x95, 0x63, 0xf4, 0x9d, 0xf2, 0xeb, 0xb1, 0x7e, 0x5b, 0xa2, 0x8a, 0x3a, 0x25,
+	0x6c, 0x18, 0x71, 0xb9, 0x46, 0x8e, 0x7c, 0xd6, 0x80, 0x49, 0x4f, 0x0f, 0xac, 0xda, 0xcf, 0xb3,
+	0xda, 0xdc, 0x08, 0xad, 0xe2, 0xba, 0x91, 0x04, 0x25, 0x49, 0xb2, 0xcf, 0x32, 0x13, 0xa4, 0x8f,
+	0x58, 0xc9, 0x84, 0xd7, 0xca, 0x09, 0x63, 0x05, 0xe7, 0xb5, 0x50, 0x5d, 0x64, 0xc1, 0x59, 0xf2,
+	0xbc, 0x53, 0x34, 0x6c, 0x34, 0xe3, 0x35, 0xc7, 0x3a, 0x35, 0x5c, 0xbe, 0x53, 0xcb, 0x9b, 0xb5,
+	0xa5, 0x04, 0xb2, 0x64, 0xa7, 0xb2, 0xe0, 0x2c, 0x79, 0xf2, 0x65, 0x95, 0xba, 0x41, 0x5b, 0xdd,
+	0x52, 0x9d, 0xb9, 0x5a, 0x3a, 0x75, 0x43, 0xde, 0x3e, 0xbf, 0x10, 0xa5, 0x6f, 0xd0, 0xa1, 0x19,
+	0xda, 0xe6, 0x67, 0x2a, 0x70, 0x99, 0xaf, 0xbb, 0x3b, 0xdd, 0x7b, 0x54, 0x38, 0x26, 0xff, 0x99,
+	0x0b, 0xcd, 0xfb, 0x2b, 0x06, 0x8c, 0xf1, 0x39, 0x78, 0x8b, 0x04, 0xe8, 0xe1, 0x7d, 0x2d, 0x78,
+	0x12, 0xf5, 0xcf, 0x0c, 0x98, 0xc9, 0xe4, 0x38, 0xef, 0x29, 0xbc, 0xcb, 0x23, 0x7b, 0xad, 0xf3,
+	0xce, 0x74, 0xe6, 0xdd, 0xf1, 0xbc, 0xac, 0xbb, 0xe6, 0x8b, 0x30, 0x99, 0x78, 0x11, 0xa5, 0xe5,
+	0xb2, 0xc8, 0xcb, 0xc2, 0xa1, 0xa7, 0xaa, 0xa8, 0x1c, 0x95, 0x64, 0x23, 0x5e, 0xf2, 0x59, 0x56,
+	0xfb, 0x67, 0x66, 0xc9, 0xff, 0xa3, 0x0b, 0x72, 0xc9, 0x2b, 0xe3, 0x1a, 0x8f, 0x9c, 0x12, 0xf4,
+	0x63, 0x5c, 0xe3, 0x21, 0x58, 0x64, 0x46, 0x1b, 0xf1, 0x3f, 0x4a, 0xac, 0xe4, 0xf9, 0x64, 0xbe,
+	0x9a, 0xf5, 0xf8, 0xd6, 0x7b, 0x21, 0x9d, 0x65, 0x86, 0x2f, 0xc9, 0x4c, 0x6d, 0x82, 0xc2, 0x3c,
+	0x27, 0x0e, 0xd7, 0x52, 0x79, 0x85, 0x97, 0xd6, 0xeb, 0x22, 0x73, 0x40, 0x64, 0x96, 0x4b, 0xda,
+	0x21, 0x06, 0x1f, 0x85, 0x1d, 0xc2, 0x87, 0x71, 0x15, 0x32, 0x90, 0x2d, 0xff, 0xa1, 0xf2, 0xb2,
+	0xeb, 0xed, 0x18, 0x8d, 0x50, 0x90, 0x68, 0x05, 0xa8, 0x13, 0x21, 0x7e, 0x22, 0xeb, 0xd6, 0x70,
+	0x79, 0x31, 0x29, 0x36, 0x18, 0xc4, 0xe3, 0x2c, 0xc8, 0xb8, 0xe5, 0x02, 0xb8, 0x51, 0x2a, 0x9a,
+	0x7e, 0xcc, 0x75, 0x71, 0x42, 0x1b, 0x21, 0x05, 0xc5, 0xbf, 0x51, 0xa3, 0xc0, 0xe6, 0xb5, 0x1d,
+	0x67, 0x92, 0x94, 0xca, 0xdf, 0xe7, 0xfa, 0x4c, 0xe7, 0xa9, 0x9c, 0xd7, 0xa3, 0x02, 0xd4, 0x89,
+	0xb0, 0x31, 0xb6, 0xa3, 0x7c, 0x84, 0x52, 0xb9, 0x5b, 0x6a, 0x8c, 0x71, 0x56, 0x43, 0x31, 0xc6,
+	0xf8, 0x37, 0x6a, 0x14, 0xc8, 0xab, 0x9a, 0x55, 0x17, 0xca, 0xab, 0xef, 0x7a, 0xb2, 0xe8, 0xbe,
+	0x3f, 0xd6, 0x62, 0x8d, 0xf3, 0x7d, 0xfa, 0x98, 0xa6, 0xc1, 0xe2, 0x79, 0x1a, 0x19, 0xef, 0xc8,
+	0x68, 0xb4, 0xe2, 0x77, 0x98, 0x13, 0x47, 0xbe, 0xc3, 0xac, 0x31, 0x71, 0x51, 0x8b, 0x03, 0xc2,
+	0x19, 0xc2, 0x64, 0x6c, 0x9a, 0xaa, 0xa7, 0x81, 0x98, 0xad, 0x2f, 0x18, 0xbe, 0x08, 0x8b, 0x34,
+	0x3b, 0xa5, 0x33, 0x7c, 0x51, 0x86, 0x11, 0x94, 0xec, 0xc2, 0x44, 0xa0, 0x3d, 0xea, 0x9c, 0x3d,
+	0xd7, 0xaf, 0x61, 0x57, 0x3e, 0xe8, 0xe4, 0x9a, 0x70, 0xbd, 0x04, 0x13, 0x74, 0x92, 0x56, 0xc7,
+	0xe9, 0x47, 0x6b, 0x75, 0x24, 0xdd, 0xe4, 0xcb, 0xa4, 0x99, 0x53, 0x09, 0xc1, 0x7a, 0xfc, 0xcb,
+	0xa5, 0x1a, 0xcc, 0x24, 0xde, 0x9f, 0xf0, 0xcf, 0x43, 0xe2, 0x4f, 0xbb, 0x9c, 0x06, 0x62, 0xb6,
+	0x3e, 0xbf, 0x8c, 0x06, 0xfb, 0x41, 0x48, 0xdb, 0xec, 0xd8, 0xf2, 0x5c, 0xea, 0x86, 0xc1, 0xec,
+	0xf9, 0x3e, 0x6c, 0x18, 0x29, 0x5c, 0xe2, 0xd8, 0x49, 0x97, 0x62, 0x86, 0x26, 0x5b, 0x39, 0x7a,
+	0x18, 0xd0, 0xd9, 0x0b, 0xe5, 0x57, 0x8e, 0x1e, 0x62, 0x54, 0xac, 0x1c, 0xbd, 0x04, 0x13, 0x74,
+	0xc8, 0x07, 0x60, 0x52, 0xc5, 0x28, 0xf3, 0xf9, 0x0c, 0x5e, 0x8c, 0xd3, 0x7c, 0xd4, 0x75, 0x00,
+	0x26, 0xeb, 0x91, 0x4f, 0xc3, 0x84, 0x7e, 0x76, 0xce, 0x5e, 0x3a, 0xed, 0xfc, 0x7b, 0xa2, 0xe7,
+	0x3a, 0x28, 0x41, 0x90, 0x20, 0x5c, 0xd2, 0xc2, 0x0d, 0xe8, 0xfb, 0xfb, 0x32, 0x1f, 0x82, 0xb8,
+	0x60, 0xe7, 0xd6, 0xc0, 0x82, 0x96, 0xe4, 0xa7, 0xf2, 0x9d, 0x18, 0x66, 0xf9, 0x92, 0xde, 0x38,
+	0x15, 0x27, 0x86, 0x17, 0xed, 0x70, 0xe7, 0x6e, 0x47, 0xc4, 0x24, 0x3f, 0xa9, 0x3f, 0x83, 0x64,
+	0x2f, 0x2a, 0x2a, 0xcc, 0xec, 0x95, 0xfe, 0xd8, 0x8b, 0xc2, 0x13, 0xb3, 0x17, 0x55, 0x82, 0x09,
+	0x3a, 0x22, 0xad, 0x65, 0x9c, 0xb6, 0x38, 0x98, 0x9d, 0xeb, 0x27, 0xad, 0x65, 0x8c, 0x48, 0x4f,
+	0x6b, 0xa9, 0xa1, 0xc7, 0x24, 0x35, 0xf3, 0x37, 0x0d, 0x80, 0x48, 0x83, 0xf6, 0x28, 0x6c, 0x57,
+	0xcd, 0x84, 0x52, 0x71, 0xb1, 0x2f, 0x8d, 0x5f, 0x61, 0x56, 0x59, 0xf3, 0x37, 0x0c, 0x98, 0x8a,
+	0xab, 0x3d, 0x82, 0x1b, 0x61, 0x23, 0x79, 0x23, 0xfc, 0x48, 0x7f, 0xe3, 0x2a, 0xb8, 0x16, 0xfe,
+	0xc7, 0x8a, 0x3e, 0x2a, 0x2e, 0xf4, 0xef, 0x26, 0xfc, 0x50, 0x18, 0xe9, 0xdb, 0xfd, 0xf8, 0xa1,
+	0xe8, 0xf1, 0x08, 0xe3, 0xf1, 0xe6, 0xf8, 0xa5, 0x7c, 0x6f, 0x42, 0xec, 0xee, 0x23, 0xd6, 0x6b,
+	0x24, 0x63, 0x2b, 0xd2, 0x62, 0x02, 0x8e, 0x93, 0xc1, 0x5f, 0xd3, 0x4f, 0xe5, 0x3e, 0x32, 0xc1,
+	0x26, 0x06, 0x7c, 0xb4, 0x07, 0xd0, 0xaf, 0x5e, 0x80, 0x71, 0x4d, 0xd9, 0xfc, 0x26, 0x04, 0x3c,
+	0x23, 0x21, 0x8c, 0x37, 0x3c, 0x37, 0x08, 0x7d, 0xe1, 0xa8, 0x57, 0x39, 0x0d, 0x9a, 0x91, 0x34,
+	0x50, 0x8b, 0x31, 0xa3, 0x4e, 0x86, 0xc9, 0xac, 0xd1, 0x1a, 0x1b, 0x38, 0x05, 0x5f, 0xa7, 0xa3,
+	0xd6, 0xd5, 0xfb, 0x00, 0xe2, 0x70, 0xec, 0x32, 0xa5, 0x5d, 0xf4, 0xb4, 0x61, 0x25, 0x88, 0x83,
+	0xb7, 0xa3, 0x56, 0x2f, 0xeb, 0xa5, 0x31, 0xf4, 0xe8, 0xbc, 0x34, 0x5e, 0x03, 0x60, 0x05, 0xcb,
+	0xbe, 0xef, 0xf9, 0x7d, 0xf9, 0x4e, 0xae, 0x2a, 0x2c, 0xf1, 0x32, 0x88, 0x8a, 0x02, 0xd4, 0x88,
+	0x14, 0x38, 0x57, 0x8d, 0x94, 0x72, 0xae, 0xea, 0xc2, 0x79, 0x9f, 0x86, 0xfe, 0x7e, 0x6d, 0xbf,
+	0xc1, 0x53, 0xd3, 0xfa, 0x21, 0x57, 0x5c, 0x8c, 0x96, 0xcb, 0x30, 0x81, 0x59, 0x54, 0x98, 0x87,
+	0x3f, 0x21, 0xf7, 0x8f, 0x1d, 0x29, 0xf7, 0xbf, 0x1f, 0xc6, 0x43, 0xda, 0xd8, 0x71, 0xed, 0x86,
+	0xe5, 0xac, 0x2c, 0xc9, 0x84, 0x67, 0xb1, 0x08, 0x1b, 0x83, 0x50, 0xaf, 0x47, 0x16, 0x61, 0xa0,
+	0x6b, 0x37, 0xe5, 0xc5, 0xe7, 0x5b, 0x23, 0xb3, 0xcd, 0xca, 0xd2, 0xc3, 0x83, 0xea, 0xdb, 0x63,
+	0x6f, 0xa5, 0x68, 0x54, 0x37, 0x3a, 0xf7, 0x5b, 0x37, 0xc2, 0xfd, 0x0e, 0x0d, 0xe6, 0xb7, 0x56,
+	0x96, 0x90, 0x35, 0xce, 0x73, 0x3c, 0x9b, 0x38, 0x81, 0xe3, 0xd9, 0x57, 0x0d, 0x38, 0x6f, 0xa5,
+	0x2d, 0x4e, 0x34, 0x98, 0x9d, 0x2c, 0xcf, 0x2d, 0xf3, 0xad, 0x58, 0x8b, 0x8f, 0xc9, 0xf1, 0x9d,
+	0x5f, 0xc8, 0x92, 0xc3, 0xbc, 0x3e, 0x10, 0x1f, 0x48, 0xdb, 0x6e, 0x89, 0x35, 0x10, 0x7f, 0xf5,
+	0xa9, 0x72, 0xea, 0xaa, 0xb5, 0x0c, 0x26, 0xcc, 0xc1, 0x4e, 0x1e, 0xc0, 0xb8, 0x26, 0x1b, 0xca,
+	0x0b, 0xdc, 0xd2, 0x69, 0x18, 0xc6, 0xc4, 0x25, 0x5f, 0xd7, 0x91, 0xeb, 0x94, 0x22, 0xab, 0xb7,
+	0xa6, 0x5d, 0x91, 0x96, 0x5f, 0x3e, 0xea, 0xe9, 0xf2, 0x56, 0xef, 0x7c, 0x8c, 0x78, 0x04, 0x35,
+	0x1e, 0x9a, 0x9f, 0x81, 0x35, 0x95, 0xc4, 0xec, 0x4c, 0xf9, 0x80, 0x74, 0xab, 0x49, 0x54, 0x71,
+	0x64, 0x3c, 0x5d, 0xe5, 0x91, 0x26, 0x48, 0x6e, 0x02, 0x91, 0x36, 0xbb, 0xf8, 0x4e, 0x1a, 0xcc,
+	0x92, 0x38, 0x86, 0xf1, 0x72, 0x06, 0x8a, 0x39, 0x2d, 0x48, 0x98, 0x50, 0x11, 0xf5, 0x71, 0xb9,
+	0x4b, 0xe7, 0x3c, 0x3e, 0x52, 0x51, 0xf4, 0x03, 0x06, 0x4c, 0xd9, 0x7a, 0x12, 0xb7, 0x40, 0xde,
+	0xe9, 0x6e, 0x97, 0xf3, 0xbd, 0xd6, 0x31, 0x49, 0xf2, 0x5c, 0x05, 0x9e, 0x84, 0x60, 0x8a, 0x26,
+	0xf9, 0x59, 0x03, 0x2e, 0x8b, 0x8c, 0x72, 0xc2, 0x4a, 0xbf, 0xe1, 0x79, 0x8e, 0x34, 0xd4, 0xf3,
+	0xab, 0x5e, 0x49, 0x03, 0xe2, 0x5a, 0x3e, 0x4a, 0xae, 0xbd, 0xb9, 0x5c, 0x00, 0xc4, 0xa2, 0x8e,
+	0x90, 0xff, 0xd2, 0x60, 0x0c, 0x5e, 0x7c, 0x2f, 0x76, 0xc2, 0x77, 0xdb, 0x22, 0x69, 0xd6, 0xa5,
+	0xf2, 0xaf, 0x66, 0x12, 0x57, 0xde, 0x2c, 0x6a, 0x75, 0x12, 0x64, 0x00, 0x98, 0xd7, 0x11, 0xf2,
+	0x06, 0x8c, 0x3f, 0x88, 0x7a, 0x1d, 0xcc, 0x5e, 0x2e, 0x1f, 0x98, 0x3e, 0x1e, 0xbc, 0xfc, 0x88,
+	0xd1, 0x29, 0x11, 0x43, 0x02, 0xd4, 0xa9, 0x91, 0x37, 0x60, 0xa2, 0xdb, 0x69, 0xf9, 0x56, 0x93,
+	0x6e, 0x58, 0xe1, 0x0e, 0xbb, 0x8d, 0x1a, 0x65, 0x5d, 0xea, 0x39, 0x4f, 0xda, 0xd2, 0x90, 0xc9,
+	0x14, 0x3f, 0x5a, 0x09, 0x26, 0x88, 0x99, 0xbf, 0x6e, 0x48, 0xe3, 0xc8, 0x23, 0x74, 0x1d, 0x3c,
+	0x6b, 0x9f, 0x0e, 0xf3, 0xf3, 0x03, 0xd2, 0x74, 0xa5, 0x0f, 0x9c, 0xbc, 0x9e, 0x50, 0x5f, 0x1b,
+	0xe5, 0xb3, 0x8f, 0x49, 0x03, 0x93, 0x86, 0xfc, 0x58, 0x35, 0xf6, 0x17, 0x0c, 0x76, 0xc5, 0x8e,
+	0xed, 0x68, 0x4a, 0x6e, 0x2e, 0xb9, 0x39, 0x63, 0x44, 0x7a, 0x27, 0xb4, 0xdb, 0xb6, 0x46, 0x09,
+	0x93, 0x84, 0x73, 0x92, 0xf1, 0x0c, 0x9c, 0x59, 0x32, 0x9e, 0x3f, 0x32, 0x60, 0xb6, 0xae, 0xb2,
+	0xcf, 0x34, 0x53, 0x19, 0x4a, 0x3f, 0x0c, 0x93, 0xc2, 0x4c, 0xbc, 0x66, 0x75, 0xd6, 0x63, 0x9b,
+	0x62, 0x34, 0x8a, 0x9a, 0x0e, 0xc4, 0x64, 0x5d, 0xf2, 0x43, 0x06, 0xcc, 0xbc, 0xfa, 0x40, 0xcf,
+	0x43, 0xea, 0xf9, 0x7d, 0x65, 0x94, 0xf8, 0xe8, 0x8b, 0x9b, 0x09, 0x64, 0x71, 0xce, 0xf7, 0x34,
+	0x24, 0xc0, 0x2c, 0x65, 0xf3, 0xf7, 0x0d, 0xb8, 0x9c, 0x1c, 0xa9, 0xe7, 0xdb, 0xaf, 0x9f, 0xc2,
+	0x40, 0xbf, 0x60, 0xc0, 0xf8, 0xfd, 0xc8, 0xa2, 0xae, 0x86, 0x58, 0xea, 0x1d, 0xa4, 0xea, 0x15,
+	0xf5, 0x35, 0x13, 0x7d, 0xa4, 0x8f, 0x8b, 0x18, 0x55, 0x0c, 0x0c, 0x50, 0x27, 0x6d, 0xfe, 0x5f,
+	0x06, 0x64, 0x54, 0x9d, 0xe4, 0x1e, 0x8c, 0x30, 0x22, 0x4b, 0xeb, 0x75, 0xb9, 0xa5, 0x3e, 0x5c,
+	0x8e, 0x9d, 0x73, 0x14, 0xc2, 0x80, 0x2b, 0x7f, 0xa0, 0x42, 0x4c, 0x76, 0x45, 0x1c, 0x2f, 0x95,
+	0x4e, 0x5d, 0x32, 0x8e, 0x52, 0x77, 0x6d, 0x3d, 0x2d, 0xbb, 0x60, 0x8e, 0x7a, 0x09, 0x26, 0xe8,
+	0x98, 0xab, 0x00, 0xb1, 0x7a, 0xba, 0x6f, 0x27, 0xed, 0xcf, 0x54, 0x80, 0x64, 0x59, 0x07, 0x0f,
+	0x3e, 0x20, 0x8d, 0xd8, 0x29, 0x3f, 0xdf, 0xb4, 0x21, 0x9b, 0x67, 0x5b, 0xa4, 0x7b, 0xe1, 0x86,
+	0x15, 0x36, 0x76, 0x24, 0x19, 0x91, 0x6d, 0x51, 0x15, 0x62, 0x0c, 0x57, 0x95, 0xd7, 0x6c, 0xd7,
+	0xf3, 0xa5, 0x79, 0x3c, 0xaa, 0xcc, 0x0b, 0x31, 0x86, 0x93, 0x6d, 0x98, 0xa2, 0x91, 0x9f, 0xec,
+	0x92, 0x15, 0x96, 0x71, 0xc3, 0xe4, 0xe2, 0xca, 0x72, 0x02, 0x0b, 0xa6, 0xb0, 0x9a, 0xff, 0xf3,
+	0x79, 0xb8, 0xd8, 0x77, 0x94, 0x83, 0xcf, 0x1a, 0x70, 0x89, 0xee, 0xda, 0x8d, 0x70, 0x61, 0x3b,
+	0xa4, 0xfe, 0xdd, 0xbb, 0x6b, 0x9b, 0x3b, 0x3e, 0x0d, 0x76, 0x3c, 0xa7, 0x59, 0x32, 0x78, 0x20,
+	0x57, 0x25, 0x2f, 0xe7, 0x62, 0xc4, 0x02, 0x4a, 0xdc, 0x3c, 0xb1, 0x2b, 0x14, 0xb7, 0x68, 0x85,
+	0x74, 0xb1, 0xeb, 0x07, 0xa1, 0x4c, 0x01, 0x21, 0xcc, 0x13, 0x69, 0x20, 0x66, 0xeb, 0xa7, 0x91,
+	0xac, 0xda, 0x6d, 0x5b, 0x64, 0x9f, 0x37, 0xb2, 0x48, 0x38, 0x10, 0xb3, 0xf5, 0x75, 0x24, 0x62,
+	0xb5, 0x32, 0x69, 0x7e, 0x28, 0x8b, 0x24, 0x02, 0x62, 0xb6, 0x3e, 0x69, 0xc2, 0x55, 0x9f, 0x36,
+	0xbc, 0x76, 0x9b, 0xba, 0x4d, 0x3e, 0x29, 0x6b, 0x96, 0xdf, 0xb2, 0xdd, 0x9b, 0xbe, 0xc5, 0x2b,
+	0x72, 0x6b, 0xaf, 0xb1, 0x78, 0xfd, 0xf0, 0xa0, 0x7a, 0x15, 0x8f, 0xa8, 0x87, 0x47, 0x62, 0x21,
+	0x6d, 0x38, 0xd7, 0xe5, 0xa7, 0x83, 0x1f, 0x85, 0xaa, 0x1c, 0x29, 0x9f, 0x25, 0x65, 0x2b, 0x89,
+	0x0a, 0xd3, 0xb8, 0xc9, 0x3e, 0x13, 0x3b, 0x65, 0x77, 0x34, 0x92, 0xa3, 0xa5, 0x48, 0x4a, 0x89,
+	0x32, 0x83, 0x0e, 0xf3, 0x68, 0x90, 0x15, 0x38, 0x1f, 0x5a, 0x7e, 0x8b, 0x86, 0xb5, 0x8d, 0x2d,
+	0x19, 0x6a, 0xcf, 0x76, 0x84, 0x9a, 0xc1, 0x10, 0xa8, 0x36, 0xb3, 0x60, 0xcc, 0x6b, 0x43, 0x3e,
+	0x0d, 0xef, 0x4c, 0x4e, 0xea, 0xaa, 0xf7, 0x80, 0xfa, 0x8b, 0x5e, 0xd7, 0x6d, 0x26, 0x91, 0x03,
+	0x47, 0xfe, 0xd4, 0xe1, 0x41, 0xf5, 0x9d, 0xd8, 0x4b, 0x03, 0xec, 0x0d, 0x6f, 0xb6, 0x03, 0x5b,
+	0x9d, 0x4e, 0x6e, 0x07, 0xc6, 0x8b, 0x3a, 0x50, 0xd0, 0x00, 0x7b, 0xc3, 0x4b, 0x10, 0x2e, 0x89,
+	0x89, 0x11, 0x79, 0x05, 0x35, 0x8a, 0x13, 0x9c, 0x22, 0xdf, 0xbf, 0x9b, 0xb9, 0x35, 0xb0, 0xa0,
+	0x25, 0x3b, 0x57, 0x9f, 0x2c, 0x1a, 0x7e, 0x86, 0xcc, 0x24, 0x27, 0xf3, 0x9e, 0xc3, 0x83, 0xea,
+	0x93, 0xd8, 0x63, 0x1b, 0xec, 0x19, 0x7b, 0x4e, 0x57, 0xe2, 0x89, 0xc8, 0x74, 0x65, 0xaa, 0xa8,
+	0x2b, 0xc5, 0x6d, 0xb0, 0x67, 0xec, 0xe4, 0x2f, 0x19, 0x70, 0xa5, 0xd1, 0xe9, 0xde, 0xb6, 0x83,
+	0xd0, 0x6b, 0xf9, 0x56, 0x7b, 0x89, 0x36, 0xac, 0xfd, 0xdb, 0x96, 0xb3, 0xbd, 0x6a, 0x6f, 0x53,
+	0xa9, 0x2d, 0x39, 0xe9, 0xc6, 0xe1, 0x11, 0x3d, 0x6a, 0x1b, 0x5b, 0xf9, 0x48, 0xb1, 0x98, 0x1e,
+	0xf9, 0x31, 0x03, 0xae, 0x8a, 0x14, 0x92, 0x05, 0x1d, 0x9a, 0x2e, 0xd5, 0x21, 0xce, 0xc5, 0xd6,
+	0x8e, 0xc0, 0x8b, 0x47, 0x52, 0xe5, 0x93, 0x24, 0x2a, 0x2c, 0xb4, 0x5a, 0x3e, 0x6d, 0x71, 0xac,
+	0x11, 0x77, 0x99, 0x29, 0x3f, 0x49, 0x6b, 0x45, 0x48, 0xb1, 0x98, 0x1e, 0x79, 0x15, 0xae, 0x15,
+	0x02, 0x6b, 0x5e, 0xd7, 0x0d, 0xb9, 0xd1, 0x7c, 0x60, 0xd1, 0x3c, 0x3c, 0xa8, 0x5e, 0x5b, 0x3b,
+	0xb2, 0x26, 0x1e, 0x83, 0x89, 0x07, 0x35, 0x4b, 0xc4, 0x17, 0x3a, 0xcf, 0xa5, 0xd1, 0x8f, 0x97,
+	0xbc, 0x45, 0x9d, 0x5a, 0x50, 0xa1, 0xdf, 0x48, 0x06, 0x15, 0xba, 0xc0, 0x7b, 0xf5, 0x9d, 0xa7,
+	0xd7, 0xab, 0x3f, 0x8f, 0x24, 0xd4, 0xcb, 0x93, 0xc6, 0xaf, 0x1a, 0x20, 0xa3, 0x20, 0x90, 0xab,
+	0x09, 0x37, 0xd1, 0xd1, 0x94, 0x8b, 0xe8, 0x55, 0x19, 0xf2, 0xbd, 0x12, 0x43, 0x13, 0x09, 0x10,
+	0xe2, 0x7c, 0x5a, 0x63, 0xf1, 0xcd, 0x5a, 0x60, 0x8e, 0x13, 0x6a, 0x31, 0x01, 0x37, 0xd2, 0x06,
+	0x4a, 0x2b, 0x0d, 0x17, 0x70, 0x63, 0xb5, 0x61, 0x0c, 0x37, 0x7f, 0xd5, 0x00, 0x89, 0x81, 0x51,
+	0x22, 0x4f, 0xa8, 0x28, 0x78, 0xa2, 0x83, 0x91, 0xa9, 0x53, 0x8f, 0x84, 0x77, 0xfc, 0xb3, 0x3a,
+	0x62, 0xc2, 0x70, 0x37, 0xe0, 0xaf, 0xa1, 0xc5, 0x53, 0x38, 0xee, 0xb2, 0xb8, 0xc5, 0x4b, 0x50,
+	0x42, 0xc8, 0x16, 0x8c, 0xb4, 0x6d, 0x97, 0xbf, 0x5a, 0x2c, 0x99, 0xa7, 0x97, 0xa7, 0x9b, 0x14,
+	0x28, 0x50, 0xe1, 0x32, 0xff, 0x81, 0x01, 0xe7, 0x92, 0x09, 0xce, 0x78, 0x2c, 0x3d, 0x99, 0x0e,
+	0x43, 0xc6, 0x59, 0xe5, 0x4d, 0x65, 0x98, 0x7f, 0x54, 0xb0, 0xa4, 0x37, 0x51, 0x1f, 0x66, 0xd3,
+	0xfc, 0x3c, 0x6b, 0xc7, 0x58, 0x30, 0xff, 0xbe, 0x01, 0xb3, 0x45, 0x29, 0x70, 0xc8, 0xbb, 0x60,
+	0xf8, 0x01, 0x87, 0xa5, 0x53, 0x2c, 0x8b, 0x16, 0x28, 0xa1, 0xd9, 0x60, 0xfa, 0x95, 0x33, 0x0e,
+	0xa6, 0x6f, 0xfe, 0xbb, 0xf3, 0x30, 0x2c, 0x34, 0x78, 0xec, 0xfa, 0x91, 0x13, 0x0c, 0xf4, 0x4e,
+	0x79, 0x6d, 0x61, 0x99, 0x08, 0x81, 0x4f, 0xc2, 0x68, 0xc3, 0x5a, 0xec, 0xba, 0xcd, 0x64, 0x66,
+	0xc2, 0xda, 0x82, 0x28, 0xc3, 0x08, 0x4a, 0x10, 0x06, 0x1a, 0xbe, 0xdd, 0x8f, 0xc3, 0x6b, 0x0d,
+	0x57, 0x84, 0xc3, 0x6b, 0x0d, 0x57, 0x90, 0x21, 0x23, 0x61, 0x42, 0x95, 0x36, 0x58, 0x5e, 0xe5,
+	0x2e, 0x26, 0x40, 0xf3, 0x07, 0x9d, 0x3a, 0xd2, 0x17, 0x54, 0x65, 0xf3, 0x1c, 0x2a, 0xff, 0x3a,
+	0x57, 0x4e, 0x79, 0x2f, 0xd9, 0x3c, 0xd5, 0xfe, 0x1f, 0x2e, 0xdc, 0xff, 0xdb, 0x30, 0x22, 0xd7,
+	0x99, 0xbc, 0xc7, 0x7c, 0xb8, 0x0f, 0x7d, 0x5e, 0x7c, 0xe3, 0x95, 0x05, 0xa8, 0x90, 0xb3, 0xcb,
+	0x71, 0xdb, 0xda, 0xb3, 0xdb, 0xdd, 0x36, 0xbf, 0xbc, 0x0c, 0xe9, 0x55, 0x79, 0x31, 0x2a, 0x38,
+	0xaf, 0x2a, 0x1e, 0x35, 0xf3, 0xcb, 0x86, 0x5e, 0x55, 0x14, 0xa3, 0x82, 0x93, 0x97, 0x61, 0xb4,
+	0x6d, 0xed, 0xd5, 0xbb, 0x7e, 0x4b, 0x45, 0x62, 0x2a, 0xd6, 0xf4, 0x76, 0x43, 0xdb, 0x99, 0xb7,
+	0xdd, 0x30, 0x08, 0xfd, 0xf9, 0x15, 0x37, 0xbc, 0xeb, 0xd7, 0x43, 0xee, 0x67, 0xca, 0x57, 0xdd,
+	0x9a, 0xc4, 0x82, 0x11, 0x3e, 0xe2, 0xc0, 0x54, 0xdb, 0xda, 0xdb, 0x72, 0xe5, 0x83, 0x7e, 0x79,
+	0x39, 0x28, 0x43, 0x81, 0xab, 0x15, 0xd6, 0x12, 0xb8, 0x30, 0x85, 0x3b, 0xe7, 0xcd, 0xc1, 0xc4,
+	0x59, 0xbd, 0x39, 0x58, 0x88, 0x42, 0x14, 0x09, 0x13, 0xea, 0x95, 0xdc, 0x10, 0xed, 0x47, 0x86,
+	0x1f, 0x7a, 0x25, 0x0a, 0x3f, 0x34, 0x55, 0xde, 0x49, 0xfe, 0x88, 0xd0, 0x43, 0x5d, 0x18, 0x6f,
+	0x5a, 0xa1, 0x25, 0x4a, 0x83, 0xd9, 0x73, 0xe5, 0xbd, 0x81, 0x96, 0x22, 0x34, 0x31, 0x4b, 0x8a,
+	0xcb, 0x02, 0xd4, 0xe9, 0x90, 0xbb, 0x70, 0x91, 0x6d, 0x56, 0x87, 0x86, 0x71, 0x15, 0xae, 0xee,
+	0x9c, 0xe6, 0xfb, 0x87, 0x3f, 0x13, 0xbf, 0x93, 0x57, 0x01, 0xf3, 0xdb, 0xc5, 0x49, 0x56, 0x66,
+	0xf2, 0x93, 0xac, 0x90, 0x2f, 0xe5, 0x79, 0x77, 0x12, 0x3e, 0xa7, 0x1f, 0x2d, 0xcf, 0x1b, 0x4a,
+	0xfb, 0x78, 0xfe, 0x77, 0x06, 0xcc, 0xca, 0x55, 0x26, 0xcd, 0x53, 0x0e, 0xf5, 0x85, 0xe7, 0x9b,
+	0x2f, 0xed, 0x92, 0x9b, 0x7d, 0xf0, 0x87, 0x0c, 0xce, 0x28, 0x2e, 0xd4, 0x3b, 0x0e, 0x0f, 0xaa,
+	0xd7, 0x8f, 0xab, 0x85, 0x85, 0x7d, 0x23, 0x3e, 0x8c, 0x04, 0xfb, 0x41, 0x23, 0x74, 0x02, 0x29,
+	0x3a, 0xdf, 0xea, 0x83, 0xb3, 0xd6, 0x05, 0x26, 0xc1, 0x5a, 0x23, 0xe6, 0x23, 0x4b, 0x51, 0x11,
+	0x22, 0x3f, 0x62, 0xc0, 0x8c, 0x74, 0x56, 0xd0, 0x42, 0x11, 0x5e, 0xec, 0xe7, 0x01, 0x5b, 0x0a,
+	0x99, 0xf2, 0xc2, 0xe4, 0x4a, 0xb0, 0x0c, 0x14, 0xb3, 0xd4, 0xd9, 0xa1, 0xda, 0xf1, 0x6d, 0xcf,
+	0xb7, 0xc3, 0x7d, 0x6e, 0x9b, 0x1c, 0x52, 0xa9, 0xd5, 0x44, 0x19, 0x46, 0x50, 0x52, 0x87, 0x29,
+	0xa1, 0x6c, 0xaa, 0x87, 0xbe, 0x15, 0xd2, 0xd6, 0xbe, 0x74, 0x4a, 0x7d, 0x37, 0xe3, 0x20, 0x5b,
+	0x09, 0xc8, 0xc3, 0x83, 0xea, 0x45, 0xf9, 0x6d, 0x92, 0x00, 0x4c, 0xa1, 0x20, 0x9f, 0x4c, 0xf9,
+	0x08, 0xcf, 0x96, 0x37, 0x51, 0x89, 0x6f, 0x71, 0x22, 0x4f, 0xe1, 0x97, 0x60, 0xd6, 0xf5, 0x9a,
+	0x94, 0xb5, 0x74, 0x3c, 0xab, 0xa9, 0x3c, 0x48, 0xf8, 0x0e, 0xbe, 0xc2, 0x07, 0x77, 0xf5, 0xf0,
+	0xa0, 0x3a, 0xbb, 0x5e, 0x50, 0x07, 0x0b, 0x5b, 0xf7, 0x1b, 0xb7, 0xb7, 0x8f, 0x0c, 0xd6, 0x73,
+	0xcf, 0xc0, 0x84, 0xbe, 0x1e, 0x4f, 0x14, 0x2e, 0x38, 0x04, 0x92, 0x9d, 0xc6, 0xb3, 0x0e, 0x1f,
+	0x68, 0xfe, 0x9c, 0x01, 0xd3, 0x69, 0xa9, 0x88, 0xec, 0xc0, 0x88, 0x64, 0x91, 0x92, 0xea, 0x42,
+	0xd9, 0x67, 0x37, 0x0e, 0x95, 0x91, 0x3f, 0xc4, 0xdd, 0x40, 0x16, 0xa1, 0x42, 0xaf, 0x3f, 0xa9,
+	0xab, 0x1c, 0xf1, 0xa4, 0xee, 0xbf, 0x1f, 0x50, 0xbd, 0x8c, 0x4d, 0xdd, 0x3d, 0xbc, 0x0d, 0x5c,
+	0x80, 0x73, 0x4d, 0x1a, 0xd8, 0x3e, 0x6d, 0xca, 0x1d, 0xa1, 0xb2, 0x3b, 0xc4, 0xf9, 0x28, 0x93,
+	0x60, 0x4c, 0xd7, 0x67, 0x28, 0x1a, 0x5d, 0xdf, 0xa7, 0x6e, 0x18, 0xa1, 0x18, 0x48, 0xa2, 0xa8,
+	0x25, 0xc1, 0x98, 0xae, 0xcf, 0x50, 0x88, 0x9d, 0x17, 0xf7, 0x62, 0x30, 0x89, 0x62, 0x2b, 0x09,
+	0xc6, 0x74, 0x7d, 0xb2, 0x06, 0xe7, 0xbb, 0xb1, 0xfc, 0x11, 0xa1, 0x19, 0xe2, 0x68, 0x22, 0x4f,
+	0xa8, 0xad, 0x6c, 0x15, 0xcc, 0x6b, 0xa7, 0xf2, 0x51, 0x4a, 0x7f, 0x09, 0x6e, 0x94, 0x1d, 0x2e,
+	0x9f, 0x8f, 0x52, 0x43, 0x83, 0x69, 0xbc, 0xe6, 0xb3, 0x70, 0x29, 0xff, 0x98, 0x63, 0x77, 0x62,
+	0xcb, 0x71, 0xbc, 0x07, 0xd2, 0xfe, 0x12, 0x67, 0x44, 0x67, 0x85, 0x28, 0x60, 0xe6, 0xa7, 0xe0,
+	0x9c, 0x0c, 0x07, 0x11, 0xc5, 0x0b, 0x7e, 0x15, 0xc6, 0x82, 0x60, 0x47, 0xb8, 0xb8, 0xcb, 0xe5,
+	0x59, 0xce, 0xa6, 0xaf, 0xb2, 0x63, 0x8b, 0x6b, 0x7c, 0xf4, 0x13, 0x63, 0xf4, 0xe6, 0x26, 0x9c,
+	0x63, 0x12, 0xc0, 0x32, 0x8f, 0x49, 0x28, 0x92, 0xd1, 0x5d, 0x97, 0x79, 0x10, 0x53, 0xab, 0x4e,
+	0xcb, 0x85, 0x58, 0x85, 0xa1, 0x86, 0xdd, 0xf4, 0x13, 0x59, 0xe8, 0x45, 0x52, 0x3b, 0x51, 0xbe,
+	0xf8, 0xd2, 0xd7, 0x7f, 0xff, 0xda, 0xdb, 0x7e, 0xed, 0xf7, 0xaf, 0xbd, 0xed, 0xb7, 0x7e, 0xff,
+	0xda, 0xdb, 0xbe, 0xef, 0xf0, 0x9a, 0xf1, 0xf5, 0xc3, 0x6b, 0xc6, 0xaf, 0x1d, 0x5e, 0x33, 0x7e,
+	0xeb, 0xf0, 0x9a, 0xf1, 0x7b, 0x87, 0xd7, 0x8c, 0x1f, 0xfe, 0x5f, 0xaf, 0xbd, 0xed, 0xe5, 0xa7,
+	0xe3, 0x31, 0xdd, 0x50, 0x43, 0x89, 0xff, 0xe9, 0xdc, 0x6f, 0xdd, 0x60, 0x63, 0x52, 0x6a, 0x1d,
+	0x3e, 0xa6, 0xff, 0x3f, 0x00, 0x00, 0xff, 0xff, 0xd9, 0xff, 0x44, 0x86, 0x03, 0x55, 0x01, 0x00,
 }
 
 func (m *APIServerLogging) Marshal() (dAtA []byte, err error) {
@@ -7130,6 +8193,18 @@ func (m *Addons) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ResourceBackup != nil {
+		{
+			size, err := m.ResourceBackup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.NginxIngress != nil {
 		{
 			size, err := m.NginxIngress.MarshalToSizedBuffer(dAtA[:i])
@@ -7316,6 +8391,192 @@ func (m *AuditPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AuthenticationClaimMappings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthenticationClaimMappings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthenticationClaimMappings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Groups != nil {
+		{
+			size, err := m.Groups.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Username != nil {
+		{
+			size, err := m.Username.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthenticationClaimOrExpression) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthenticationClaimOrExpression) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthenticationClaimOrExpression) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expression != nil {
+		i -= len(*m.Expression)
+		copy(dAtA[i:], *m.Expression)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Expression)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Prefix != nil {
+		i -= len(*m.Prefix)
+		copy(dAtA[i:], *m.Prefix)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Prefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Claim != nil {
+		i -= len(*m.Claim)
+		copy(dAtA[i:], *m.Claim)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Claim)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthenticationClaimValidationRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthenticationClaimValidationRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthenticationClaimValidationRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Message != nil {
+		i -= len(*m.Message)
+		copy(dAtA[i:], *m.Message)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Message)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Expression != nil {
+		i -= len(*m.Expression)
+		copy(dAtA[i:], *m.Expression)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Expression)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RequiredValue != nil {
+		i -= len(*m.RequiredValue)
+		copy(dAtA[i:], *m.RequiredValue)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.RequiredValue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Claim != nil {
+		i -= len(*m.Claim)
+		copy(dAtA[i:], *m.Claim)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Claim)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthenticationIssuer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthenticationIssuer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthenticationIssuer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CertificateAuthority != nil {
+		i -= len(*m.CertificateAuthority)
+		copy(dAtA[i:], *m.CertificateAuthority)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.CertificateAuthority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Audiences) > 0 {
+		for iNdEx := len(m.Audiences) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Audiences[iNdEx])
+			copy(dAtA[i:], m.Audiences[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Audiences[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *AuthorizerKubeconfigReference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8149,6 +9410,18 @@ func (m *CRI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Containerd != nil {
+		{
+			size, err := m.Containerd.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.ContainerRuntimes) > 0 {
 		for iNdEx := len(m.ContainerRuntimes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -8243,6 +9516,53 @@ func (m CapabilityValues) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CloudCredentialsRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CloudCredentialsRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CloudCredentialsRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastCompletionTime != nil {
+		{
+			size, err := m.LastCompletionTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LastInitiationTime != nil {
+		{
+			size, err := m.LastInitiationTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CloudProfile) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8386,6 +9706,20 @@ func (m *CloudProfileSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RegistryMirrors) > 0 {
+		for iNdEx := len(m.RegistryMirrors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegistryMirrors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if len(m.MachineCapabilities) > 0 {
 		for iNdEx := len(m.MachineCapabilities) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -8942,6 +10276,104 @@ func (m *ContainerRuntime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContainerdConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContainerdConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContainerdConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Registries) > 0 {
+		for iNdEx := len(m.Registries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Registries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContainerdRegistry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContainerdRegistry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContainerdRegistry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllowInsecure != nil {
+		i--
+		if *m.AllowInsecure {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AuthSecretName != nil {
+		i -= len(*m.AuthSecretName)
+		copy(dAtA[i:], *m.AuthSecretName)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.AuthSecretName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Hosts) > 0 {
+		for iNdEx := len(m.Hosts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Hosts[iNdEx])
+			copy(dAtA[i:], m.Hosts[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Hosts[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Server != nil {
+		i -= len(*m.Server)
+		copy(dAtA[i:], *m.Server)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Server)))
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Upstream)
+	copy(dAtA[i:], m.Upstream)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Upstream)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ControlPlane) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9029,7 +10461,7 @@ func (m *ControlPlaneAutoscaling) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *ControllerDeployment) Marshal() (dAtA []byte, err error) {
+func (m *ControlPlaneResourceConsumption) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9039,28 +10471,18 @@ func (m *ControllerDeployment) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ControllerDeployment) MarshalTo(dAtA []byte) (int, error) {
+func (m *ControlPlaneResourceConsumption) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ControllerDeployment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ControlPlaneResourceConsumption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.InjectGardenKubeconfig != nil {
-		i--
-		if *m.InjectGardenKubeconfig {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
 	{
-		size, err := m.ProviderConfig.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.LastUpdateTime.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -9068,73 +10490,47 @@ func (m *ControllerDeployment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Type)
-	copy(dAtA[i:], m.Type)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	dAtA[i] = 0x22
+	if m.ETCDStorageSize != nil {
+		{
+			size, err := m.ETCDStorageSize.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *ControllerDeploymentList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		i--
+		dAtA[i] = 0x1a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ControllerDeploymentList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ControllerDeploymentList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
+	if m.KubeAPIServerMemory != nil {
+		{
+			size, err := m.KubeAPIServerMemory.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x12
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.KubeAPIServerCPU != nil {
+		{
+			size, err := m.KubeAPIServerCPU.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ControllerInstallation) Marshal() (dAtA []byte, err error) {
+func (m *ControllerDeployment) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9144,28 +10540,28 @@ func (m *ControllerInstallation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ControllerInstallation) MarshalTo(dAtA []byte) (int, error) {
+func (m *ControllerDeployment) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ControllerInstallation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ControllerDeployment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.InjectGardenKubeconfig != nil {
+		i--
+		if *m.InjectGardenKubeconfig {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x20
 	}
-	i--
-	dAtA[i] = 0x1a
 	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ProviderConfig.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -9173,6 +10569,11 @@ func (m *ControllerInstallation) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
 	dAtA[i] = 0x12
 	{
 		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
@@ -9187,7 +10588,7 @@ func (m *ControllerInstallation) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *ControllerInstallationList) Marshal() (dAtA []byte, err error) {
+func (m *ControllerDeploymentList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9197,12 +10598,112 @@ func (m *ControllerInstallationList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ControllerInstallationList) MarshalTo(dAtA []byte) (int, error) {
+func (m *ControllerDeploymentList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ControllerInstallationList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ControllerDeploymentList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ControllerInstallation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ControllerInstallation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ControllerInstallation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ControllerInstallationList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ControllerInstallationList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ControllerInstallationList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9553,6 +11054,18 @@ func (m *ControllerResource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Addon != nil {
+		{
+			size, err := m.Addon.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if len(m.ClusterCompatibility) > 0 {
 		for iNdEx := len(m.ClusterCompatibility) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ClusterCompatibility[iNdEx])
@@ -9628,6 +11141,46 @@ func (m *ControllerResource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ControllerResourceAddon) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ControllerResourceAddon) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ControllerResourceAddon) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Schema != nil {
+		{
+			size, err := m.Schema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ControllerResourceLifecycle) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9799,6 +11352,13 @@ func (m *DNS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ManagementDomain != nil {
+		i -= len(*m.ManagementDomain)
+		copy(dAtA[i:], *m.ManagementDomain)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.ManagementDomain)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Providers) > 0 {
 		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -10249,6 +11809,18 @@ func (m *EncryptionAtRest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MigrationProgress != nil {
+		{
+			size, err := m.MigrationProgress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Resources) > 0 {
 		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Resources[iNdEx])
@@ -10261,6 +11833,35 @@ func (m *EncryptionAtRest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EncryptionAtRestMigrationProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptionAtRestMigrationProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncryptionAtRestMigrationProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.RemainingResourceCount))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MigratedResourceCount))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *EncryptionConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10281,6 +11882,18 @@ func (m *EncryptionConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.KMS != nil {
+		{
+			size, err := m.KMS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Resources) > 0 {
 		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Resources[iNdEx])
@@ -10811,6 +12424,13 @@ func (m *Hibernation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Mode != nil {
+		i -= len(*m.Mode)
+		copy(dAtA[i:], *m.Mode)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Mode)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Schedules) > 0 {
 		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -11288,6 +12908,117 @@ func (m *InternalSecretList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *JWTAuthenticator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JWTAuthenticator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JWTAuthenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClaimValidationRules) > 0 {
+		for iNdEx := len(m.ClaimValidationRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimValidationRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ClaimMappings != nil {
+		{
+			size, err := m.ClaimMappings.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Issuer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *KMSEncryptionConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KMSEncryptionConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KMSEncryptionConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timeout != nil {
+		{
+			size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SecretName != nil {
+		i -= len(*m.SecretName)
+		copy(dAtA[i:], *m.SecretName)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.SecretName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Endpoint)
+	copy(dAtA[i:], m.Endpoint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *KubeAPIServerConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11308,6 +13039,20 @@ func (m *KubeAPIServerConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RequestBasedAutoscaling != nil {
+		{
+			size, err := m.RequestBasedAutoscaling.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
 	if m.Autoscaling != nil {
 		{
 			size, err := m.Autoscaling.MarshalToSizedBuffer(dAtA[:i])
@@ -11531,6 +13276,77 @@ func (m *KubeAPIServerConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *KubeAPIServerRequestBasedAutoscaling) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KubeAPIServerRequestBasedAutoscaling) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KubeAPIServerRequestBasedAutoscaling) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AverageInflightRequests != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.AverageInflightRequests))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AverageRequestsPerSecond != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.AverageRequestsPerSecond))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KubeControllerManagerConcurrentSyncs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KubeControllerManagerConcurrentSyncs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KubeControllerManagerConcurrentSyncs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StatefulSet != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.StatefulSet))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReplicaSet != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.ReplicaSet))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Deployment != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Deployment))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *KubeControllerManagerConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11551,6 +13367,18 @@ func (m *KubeControllerManagerConfig) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.ConcurrentSyncs != nil {
+		{
+			size, err := m.ConcurrentSyncs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.NodeMonitorGracePeriod != nil {
 		{
 			size, err := m.NodeMonitorGracePeriod.MarshalToSizedBuffer(dAtA[:i])
@@ -13014,6 +14842,63 @@ func (m *MachineImageFlavor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MachineImageUpgradePath) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MachineImageUpgradePath) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MachineImageUpgradePath) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationDate != nil {
+		{
+			size, err := m.ExpirationDate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Next != nil {
+		i -= len(*m.Next)
+		copy(dAtA[i:], *m.Next)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Next)))
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.Version)
+	copy(dAtA[i:], m.Version)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Image)
+	copy(dAtA[i:], m.Image)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Image)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.WorkerPool)
+	copy(dAtA[i:], m.WorkerPool)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.WorkerPool)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MachineImageVersion) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13394,6 +15279,13 @@ func (m *MaintenanceTimeWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Location != nil {
+		i -= len(*m.Location)
+		copy(dAtA[i:], *m.Location)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Location)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	i -= len(m.End)
 	copy(dAtA[i:], m.End)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.End)))
@@ -13407,6 +15299,46 @@ func (m *MaintenanceTimeWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ManagedAddon) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManagedAddon) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManagedAddon) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ManualWorkerPoolRollout) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13893,6 +15825,20 @@ func (m *NetworkingStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EgressCIDRsByZone) > 0 {
+		for iNdEx := len(m.EgressCIDRsByZone) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EgressCIDRsByZone[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.EgressCIDRs) > 0 {
 		for iNdEx := len(m.EgressCIDRs) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.EgressCIDRs[iNdEx])
@@ -14703,6 +16649,18 @@ func (m *ProjectStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UsageSummary != nil {
+		{
+			size, err := m.UsageSummary.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.LastActivityTimestamp != nil {
 		{
 			size, err := m.LastActivityTimestamp.MarshalToSizedBuffer(dAtA[:i])
@@ -14801,6 +16759,68 @@ func (m *ProjectTolerations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProjectUsageSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectUsageSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectUsageSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LastUpdateTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Memory.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.CPU.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Nodes))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.HibernatedShoots))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Shoots))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *Provider) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15116,6 +17136,113 @@ func (m *Region) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RegistryMirror) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegistryMirror) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegistryMirror) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Regions) > 0 {
+		for iNdEx := len(m.Regions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Regions[iNdEx])
+			copy(dAtA[i:], m.Regions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Regions[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Hosts) > 0 {
+		for iNdEx := len(m.Hosts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Hosts[iNdEx])
+			copy(dAtA[i:], m.Hosts[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Hosts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Upstream)
+	copy(dAtA[i:], m.Upstream)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Upstream)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourceBackupAddon) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceBackupAddon) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceBackupAddon) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IncludedNamespaces) > 0 {
+		for iNdEx := len(m.IncludedNamespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IncludedNamespaces[iNdEx])
+			copy(dAtA[i:], m.IncludedNamespaces[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.IncludedNamespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.RetentionPeriod != nil {
+		{
+			size, err := m.RetentionPeriod.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Schedule != nil {
+		i -= len(*m.Schedule)
+		copy(dAtA[i:], *m.Schedule)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Schedule)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Addon.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ResourceData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15248,6 +17375,16 @@ func (m *SecretBinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if m.Provider != nil {
 		{
 			size, err := m.Provider.MarshalToSizedBuffer(dAtA[:i])
@@ -15372,6 +17509,43 @@ func (m *SecretBindingProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SecretBindingStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecretBindingStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretBindingStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Seed) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15425,6 +17599,110 @@ func (m *Seed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SeedAffinity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SeedAffinity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SeedAffinity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PreferredDuringScheduling) > 0 {
+		for iNdEx := len(m.PreferredDuringScheduling) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreferredDuringScheduling[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RequiredDuringScheduling) > 0 {
+		for iNdEx := len(m.RequiredDuringScheduling) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RequiredDuringScheduling[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SeedAvailability) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SeedAvailability) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SeedAvailability) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastDowntimeEnd != nil {
+		{
+			size, err := m.LastDowntimeEnd.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LastDowntimeBegin != nil {
+		{
+			size, err := m.LastDowntimeBegin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.AccumulatedDowntimeSeconds))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.DowntimeWindows))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *SeedDNS) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15787,6 +18065,37 @@ func (m *SeedSelector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SeedSettingDNSCache) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SeedSettingDNSCache) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SeedSettingDNSCache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Enabled {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *SeedSettingDependencyWatchdog) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15854,6 +18163,18 @@ func (m *SeedSettingDependencyWatchdogProber) MarshalToSizedBuffer(dAtA []byte)
 	_ = i
 	var l int
 	_ = l
+	if m.ProbeInterval != nil {
+		{
+			size, err := m.ProbeInterval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	i--
 	if m.Enabled {
 		dAtA[i] = 1
@@ -15885,6 +18206,18 @@ func (m *SeedSettingDependencyWatchdogWeeder) MarshalToSizedBuffer(dAtA []byte)
 	_ = i
 	var l int
 	_ = l
+	if m.WatchDuration != nil {
+		{
+			size, err := m.WatchDuration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	i--
 	if m.Enabled {
 		dAtA[i] = 1
@@ -15963,6 +18296,11 @@ func (m *SeedSettingExcessCapacityReservationConfig) MarshalToSizedBuffer(dAtA [
 	_ = i
 	var l int
 	_ = l
+	if m.Percentage != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Percentage))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Tolerations) > 0 {
 		for iNdEx := len(m.Tolerations) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -16033,6 +18371,157 @@ func (m *SeedSettingExcessCapacityReservationConfig) MarshalToSizedBuffer(dAtA [
 	return len(dAtA) - i, nil
 }
 
+func (m *SeedSettingIstioIngressGateway) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SeedSettingIstioIngressGateway) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SeedSettingIstioIngressGateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExposureClassHandlers) > 0 {
+		for iNdEx := len(m.ExposureClassHandlers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExposureClassHandlers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Autoscaling != nil {
+		{
+			size, err := m.Autoscaling.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SeedSettingIstioIngressGatewayAutoscaling) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SeedSettingIstioIngressGatewayAutoscaling) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SeedSettingIstioIngressGatewayAutoscaling) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		keysForRequests := make([]string, 0, len(m.Requests))
+		for k := range m.Requests {
+			keysForRequests = append(keysForRequests, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForRequests)
+		for iNdEx := len(keysForRequests) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Requests[k8s_io_api_core_v1.ResourceName(keysForRequests[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForRequests[iNdEx])
+			copy(dAtA[i:], keysForRequests[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForRequests[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxReplicas != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxReplicas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinReplicas != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MinReplicas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SeedSettingIstioIngressGatewayExposureClassHandler) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SeedSettingIstioIngressGatewayExposureClassHandler) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SeedSettingIstioIngressGatewayExposureClassHandler) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Autoscaling != nil {
+		{
+			size, err := m.Autoscaling.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SeedSettingLoadBalancerServices) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -16405,6 +18894,30 @@ func (m *SeedSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DNSCache != nil {
+		{
+			size, err := m.DNSCache.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.IstioIngressGateway != nil {
+		{
+			size, err := m.IstioIngressGateway.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.TopologyAwareRouting != nil {
 		{
 			size, err := m.TopologyAwareRouting.MarshalToSizedBuffer(dAtA[:i])
@@ -16657,6 +19170,18 @@ func (m *SeedStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Availability != nil {
+		{
+			size, err := m.Availability.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.LastOperation != nil {
 		{
 			size, err := m.LastOperation.MarshalToSizedBuffer(dAtA[:i])
@@ -17249,6 +19774,18 @@ func (m *ShootCredentialsRotation) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.CloudCredentials != nil {
+		{
+			size, err := m.CloudCredentials.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.ETCDEncryptionKey != nil {
 		{
 			size, err := m.ETCDEncryptionKey.MarshalToSizedBuffer(dAtA[:i])
@@ -17557,6 +20094,36 @@ func (m *ShootSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ManagedAddons) > 0 {
+		for iNdEx := len(m.ManagedAddons) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ManagedAddons[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if m.SeedAffinity != nil {
+		{
+			size, err := m.SeedAffinity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
 	if len(m.AccessRestrictions) > 0 {
 		for iNdEx := len(m.AccessRestrictions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -18003,6 +20570,50 @@ func (m *ShootStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UpgradePaths != nil {
+		{
+			size, err := m.UpgradePaths.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(m.WorkerPools) > 0 {
+		for iNdEx := len(m.WorkerPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WorkerPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if m.ResourceConsumption != nil {
+		{
+			size, err := m.ResourceConsumption.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
 	if m.ManualWorkerPoolRollout != nil {
 		{
 			size, err := m.ManualWorkerPoolRollout.MarshalToSizedBuffer(dAtA[:i])
@@ -18279,6 +20890,63 @@ func (m *ShootTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ShootUpgradePaths) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShootUpgradePaths) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShootUpgradePaths) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LastUpdateTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MachineImages) > 0 {
+		for iNdEx := len(m.MachineImages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MachineImages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Kubernetes.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *StructuredAuthentication) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -18299,6 +20967,20 @@ func (m *StructuredAuthentication) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.JWTAuthenticators) > 0 {
+		for iNdEx := len(m.JWTAuthenticators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JWTAuthenticators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	i -= len(m.ConfigMapName)
 	copy(dAtA[i:], m.ConfigMapName)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ConfigMapName)))
@@ -18431,6 +21113,60 @@ func (m *Toleration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VersionUpgradePath) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersionUpgradePath) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersionUpgradePath) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationDate != nil {
+		{
+			size, err := m.ExpirationDate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NextMinor != nil {
+		i -= len(*m.NextMinor)
+		copy(dAtA[i:], *m.NextMinor)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.NextMinor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NextPatch != nil {
+		i -= len(*m.NextPatch)
+		copy(dAtA[i:], *m.NextPatch)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.NextPatch)))
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Version)
+	copy(dAtA[i:], m.Version)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *VerticalPodAutoscaler) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -18813,6 +21549,42 @@ func (m *WatchCacheSizes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WeightedSeedAffinityTerm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedSeedAffinityTerm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedSeedAffinityTerm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LabelSelector.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Weight))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *Worker) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -18833,6 +21605,15 @@ func (m *Worker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NodeWorkloadIdentityName != nil {
+		i -= len(*m.NodeWorkloadIdentityName)
+		copy(dAtA[i:], *m.NodeWorkloadIdentityName)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.NodeWorkloadIdentityName)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
 	if m.ControlPlane != nil {
 		{
 			size, err := m.ControlPlane.MarshalToSizedBuffer(dAtA[:i])
@@ -19207,6 +21988,58 @@ func (m *WorkerKubernetes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WorkerPoolStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkerPoolStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkerPoolStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastRolloutTime != nil {
+		{
+			size, err := m.LastRolloutTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.UnavailableMachines))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintGenerated(dAtA, i, uint64(m.UpdatedMachines))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintGenerated(dAtA, i, uint64(m.CurrentMachines))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.DesiredMachines))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *WorkerSystemComponents) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -19273,6 +22106,43 @@ func (m *WorkersSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ZoneEgressCIDRs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ZoneEgressCIDRs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ZoneEgressCIDRs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CIDRs) > 0 {
+		for iNdEx := len(m.CIDRs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CIDRs[iNdEx])
+			copy(dAtA[i:], m.CIDRs[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.CIDRs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Zone)
+	copy(dAtA[i:], m.Zone)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Zone)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenerated(v)
 	base := offset
@@ -19368,6 +22238,10 @@ func (m *Addons) Size() (n int) {
 		l = m.NginxIngress.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.ResourceBackup != nil {
+		l = m.ResourceBackup.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -19434,6 +22308,90 @@ func (m *AuditPolicy) Size() (n int) {
 	return n
 }
 
+func (m *AuthenticationClaimMappings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Username != nil {
+		l = m.Username.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Groups != nil {
+		l = m.Groups.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *AuthenticationClaimOrExpression) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Claim != nil {
+		l = len(*m.Claim)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Prefix != nil {
+		l = len(*m.Prefix)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Expression != nil {
+		l = len(*m.Expression)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *AuthenticationClaimValidationRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Claim != nil {
+		l = len(*m.Claim)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.RequiredValue != nil {
+		l = len(*m.RequiredValue)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Expression != nil {
+		l = len(*m.Expression)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Message != nil {
+		l = len(*m.Message)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *AuthenticationIssuer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Audiences) > 0 {
+		for _, s := range m.Audiences {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.CertificateAuthority != nil {
+		l = len(*m.CertificateAuthority)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *AuthorizerKubeconfigReference) Size() (n int) {
 	if m == nil {
 		return 0
@@ -19750,6 +22708,10 @@ func (m *CRI) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.Containerd != nil {
+		l = m.Containerd.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -19783,6 +22745,23 @@ func (m CapabilityValues) Size() (n int) {
 	return n
 }
 
+func (m *CloudCredentialsRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastInitiationTime != nil {
+		l = m.LastInitiationTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.LastCompletionTime != nil {
+		l = m.LastCompletionTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *CloudProfile) Size() (n int) {
 	if m == nil {
 		return 0
@@ -19886,6 +22865,12 @@ func (m *CloudProfileSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.RegistryMirrors) > 0 {
+		for _, e := range m.RegistryMirrors {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -20050,6 +23035,49 @@ func (m *ContainerRuntime) Size() (n int) {
 	return n
 }
 
+func (m *ContainerdConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Registries) > 0 {
+		for _, e := range m.Registries {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContainerdRegistry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Upstream)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Server != nil {
+		l = len(*m.Server)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Hosts) > 0 {
+		for _, s := range m.Hosts {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.AuthSecretName != nil {
+		l = len(*m.AuthSecretName)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.AllowInsecure != nil {
+		n += 2
+	}
+	return n
+}
+
 func (m *ControlPlane) Size() (n int) {
 	if m == nil {
 		return 0
@@ -20081,6 +23109,29 @@ func (m *ControlPlaneAutoscaling) Size() (n int) {
 	return n
 }
 
+func (m *ControlPlaneResourceConsumption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KubeAPIServerCPU != nil {
+		l = m.KubeAPIServerCPU.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.KubeAPIServerMemory != nil {
+		l = m.KubeAPIServerMemory.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ETCDStorageSize != nil {
+		l = m.ETCDStorageSize.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = m.LastUpdateTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ControllerDeployment) Size() (n int) {
 	if m == nil {
 		return 0
@@ -20292,6 +23343,25 @@ func (m *ControllerResource) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.Addon != nil {
+		l = m.Addon.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ControllerResourceAddon) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Schema != nil {
+		l = m.Schema.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -20375,6 +23445,10 @@ func (m *DNS) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.ManagementDomain != nil {
+		l = len(*m.ManagementDomain)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -20546,6 +23620,21 @@ func (m *EncryptionAtRest) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.MigrationProgress != nil {
+		l = m.MigrationProgress.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *EncryptionAtRestMigrationProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.MigratedResourceCount))
+	n += 1 + sovGenerated(uint64(m.RemainingResourceCount))
 	return n
 }
 
@@ -20561,6 +23650,10 @@ func (m *EncryptionConfig) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.KMS != nil {
+		l = m.KMS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -20768,6 +23861,10 @@ func (m *Hibernation) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.Mode != nil {
+		l = len(*m.Mode)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -20939,6 +24036,48 @@ func (m *InternalSecretList) Size() (n int) {
 	return n
 }
 
+func (m *JWTAuthenticator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Issuer.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ClaimMappings != nil {
+		l = m.ClaimMappings.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.ClaimValidationRules) > 0 {
+		for _, e := range m.ClaimValidationRules {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *KMSEncryptionConfiguration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Endpoint)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.SecretName != nil {
+		l = len(*m.SecretName)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Timeout != nil {
+		l = m.Timeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *KubeAPIServerConfig) Size() (n int) {
 	if m == nil {
 		return 0
@@ -21020,6 +24159,43 @@ func (m *KubeAPIServerConfig) Size() (n int) {
 		l = m.Autoscaling.Size()
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if m.RequestBasedAutoscaling != nil {
+		l = m.RequestBasedAutoscaling.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *KubeAPIServerRequestBasedAutoscaling) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AverageRequestsPerSecond != nil {
+		n += 1 + sovGenerated(uint64(*m.AverageRequestsPerSecond))
+	}
+	if m.AverageInflightRequests != nil {
+		n += 1 + sovGenerated(uint64(*m.AverageInflightRequests))
+	}
+	return n
+}
+
+func (m *KubeControllerManagerConcurrentSyncs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Deployment != nil {
+		n += 1 + sovGenerated(uint64(*m.Deployment))
+	}
+	if m.ReplicaSet != nil {
+		n += 1 + sovGenerated(uint64(*m.ReplicaSet))
+	}
+	if m.StatefulSet != nil {
+		n += 1 + sovGenerated(uint64(*m.StatefulSet))
+	}
 	return n
 }
 
@@ -21046,6 +24222,10 @@ func (m *KubeControllerManagerConfig) Size() (n int) {
 		l = m.NodeMonitorGracePeriod.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.ConcurrentSyncs != nil {
+		l = m.ConcurrentSyncs.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -21572,6 +24752,29 @@ func (m *MachineImageFlavor) Size() (n int) {
 	return n
 }
 
+func (m *MachineImageUpgradePath) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WorkerPool)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Image)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Version)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Next != nil {
+		l = len(*m.Next)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ExpirationDate != nil {
+		l = m.ExpirationDate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *MachineImageVersion) Size() (n int) {
 	if m == nil {
 		return 0
@@ -21710,6 +24913,25 @@ func (m *MaintenanceTimeWindow) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.End)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Location != nil {
+		l = len(*m.Location)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ManagedAddon) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -21921,6 +25143,12 @@ func (m *NetworkingStatus) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.EgressCIDRsByZone) > 0 {
+		for _, e := range m.EgressCIDRsByZone {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -22247,6 +25475,10 @@ func (m *ProjectStatus) Size() (n int) {
 		l = m.LastActivityTimestamp.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.UsageSummary != nil {
+		l = m.UsageSummary.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -22271,6 +25503,24 @@ func (m *ProjectTolerations) Size() (n int) {
 	return n
 }
 
+func (m *ProjectUsageSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Shoots))
+	n += 1 + sovGenerated(uint64(m.HibernatedShoots))
+	n += 1 + sovGenerated(uint64(m.Nodes))
+	l = m.CPU.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Memory.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.LastUpdateTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Provider) Size() (n int) {
 	if m == nil {
 		return 0
@@ -22384,6 +25634,54 @@ func (m *Region) Size() (n int) {
 	return n
 }
 
+func (m *RegistryMirror) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Upstream)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Hosts) > 0 {
+		for _, s := range m.Hosts {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Regions) > 0 {
+		for _, s := range m.Regions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ResourceBackupAddon) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Addon.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Schedule != nil {
+		l = len(*m.Schedule)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.RetentionPeriod != nil {
+		l = m.RetentionPeriod.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.IncludedNamespaces) > 0 {
+		for _, s := range m.IncludedNamespaces {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ResourceData) Size() (n int) {
 	if m == nil {
 		return 0
@@ -22443,6 +25741,8 @@ func (m *SecretBinding) Size() (n int) {
 		l = m.Provider.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -22474,6 +25774,21 @@ func (m *SecretBindingProvider) Size() (n int) {
 	return n
 }
 
+func (m *SecretBindingStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Seed) Size() (n int) {
 	if m == nil {
 		return 0
@@ -22489,6 +25804,46 @@ func (m *Seed) Size() (n int) {
 	return n
 }
 
+func (m *SeedAffinity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RequiredDuringScheduling) > 0 {
+		for _, e := range m.RequiredDuringScheduling {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.PreferredDuringScheduling) > 0 {
+		for _, e := range m.PreferredDuringScheduling {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SeedAvailability) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.DowntimeWindows))
+	n += 1 + sovGenerated(uint64(m.AccumulatedDowntimeSeconds))
+	if m.LastDowntimeBegin != nil {
+		l = m.LastDowntimeBegin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.LastDowntimeEnd != nil {
+		l = m.LastDowntimeEnd.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *SeedDNS) Size() (n int) {
 	if m == nil {
 		return 0
@@ -22634,6 +25989,16 @@ func (m *SeedSelector) Size() (n int) {
 	return n
 }
 
+func (m *SeedSettingDNSCache) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	return n
+}
+
 func (m *SeedSettingDependencyWatchdog) Size() (n int) {
 	if m == nil {
 		return 0
@@ -22658,6 +26023,10 @@ func (m *SeedSettingDependencyWatchdogProber) Size() (n int) {
 	var l int
 	_ = l
 	n += 2
+	if m.ProbeInterval != nil {
+		l = m.ProbeInterval.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -22668,6 +26037,10 @@ func (m *SeedSettingDependencyWatchdogWeeder) Size() (n int) {
 	var l int
 	_ = l
 	n += 2
+	if m.WatchDuration != nil {
+		l = m.WatchDuration.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -22718,6 +26091,67 @@ func (m *SeedSettingExcessCapacityReservationConfig) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.Percentage != nil {
+		n += 1 + sovGenerated(uint64(*m.Percentage))
+	}
+	return n
+}
+
+func (m *SeedSettingIstioIngressGateway) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Autoscaling != nil {
+		l = m.Autoscaling.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.ExposureClassHandlers) > 0 {
+		for _, e := range m.ExposureClassHandlers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SeedSettingIstioIngressGatewayAutoscaling) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinReplicas != nil {
+		n += 1 + sovGenerated(uint64(*m.MinReplicas))
+	}
+	if m.MaxReplicas != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxReplicas))
+	}
+	if len(m.Requests) > 0 {
+		for k, v := range m.Requests {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *SeedSettingIstioIngressGatewayExposureClassHandler) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Autoscaling != nil {
+		l = m.Autoscaling.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -22876,6 +26310,14 @@ func (m *SeedSettings) Size() (n int) {
 		l = m.TopologyAwareRouting.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.IstioIngressGateway != nil {
+		l = m.IstioIngressGateway.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.DNSCache != nil {
+		l = m.DNSCache.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -22985,6 +26427,10 @@ func (m *SeedStatus) Size() (n int) {
 		l = m.LastOperation.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Availability != nil {
+		l = m.Availability.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -23178,6 +26624,10 @@ func (m *ShootCredentialsRotation) Size() (n int) {
 		l = m.ETCDEncryptionKey.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.CloudCredentials != nil {
+		l = m.CloudCredentials.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -23372,6 +26822,16 @@ func (m *ShootSpec) Size() (n int) {
 			n += 2 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.SeedAffinity != nil {
+		l = m.SeedAffinity.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.ManagedAddons) > 0 {
+		for _, e := range m.ManagedAddons {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -23520,6 +26980,20 @@ func (m *ShootStatus) Size() (n int) {
 		l = m.ManualWorkerPoolRollout.Size()
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if m.ResourceConsumption != nil {
+		l = m.ResourceConsumption.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.WorkerPools) > 0 {
+		for _, e := range m.WorkerPools {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.UpgradePaths != nil {
+		l = m.UpgradePaths.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -23536,6 +27010,25 @@ func (m *ShootTemplate) Size() (n int) {
 	return n
 }
 
+func (m *ShootUpgradePaths) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Kubernetes.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.MachineImages) > 0 {
+		for _, e := range m.MachineImages {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.LastUpdateTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *StructuredAuthentication) Size() (n int) {
 	if m == nil {
 		return 0
@@ -23544,6 +27037,12 @@ func (m *StructuredAuthentication) Size() (n int) {
 	_ = l
 	l = len(m.ConfigMapName)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.JWTAuthenticators) > 0 {
+		for _, e := range m.JWTAuthenticators {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -23596,6 +27095,29 @@ func (m *Toleration) Size() (n int) {
 	return n
 }
 
+func (m *VersionUpgradePath) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.NextPatch != nil {
+		l = len(*m.NextPatch)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.NextMinor != nil {
+		l = len(*m.NextMinor)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ExpirationDate != nil {
+		l = m.ExpirationDate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *VerticalPodAutoscaler) Size() (n int) {
 	if m == nil {
 		return 0
@@ -23740,6 +27262,18 @@ func (m *WatchCacheSizes) Size() (n int) {
 	return n
 }
 
+func (m *WeightedSeedAffinityTerm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Weight))
+	l = m.LabelSelector.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Worker) Size() (n int) {
 	if m == nil {
 		return 0
@@ -23849,6 +27383,10 @@ func (m *Worker) Size() (n int) {
 		l = m.ControlPlane.Size()
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if m.NodeWorkloadIdentityName != nil {
+		l = len(*m.NodeWorkloadIdentityName)
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -23882,6 +27420,25 @@ func (m *WorkerKubernetes) Size() (n int) {
 	return n
 }
 
+func (m *WorkerPoolStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.DesiredMachines))
+	n += 1 + sovGenerated(uint64(m.CurrentMachines))
+	n += 1 + sovGenerated(uint64(m.UpdatedMachines))
+	n += 1 + sovGenerated(uint64(m.UnavailableMachines))
+	if m.LastRolloutTime != nil {
+		l = m.LastRolloutTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *WorkerSystemComponents) Size() (n int) {
 	if m == nil {
 		return 0
@@ -23905,6 +27462,23 @@ func (m *WorkersSettings) Size() (n int) {
 	return n
 }
 
+func (m *ZoneEgressCIDRs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Zone)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.CIDRs) > 0 {
+		for _, s := range m.CIDRs {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGenerated(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -23981,6 +27555,7 @@ func (this *Addons) String() string {
 	s := strings.Join([]string{`&Addons{`,
 		`KubernetesDashboard:` + strings.Replace(this.KubernetesDashboard.String(), "KubernetesDashboard", "KubernetesDashboard", 1) + `,`,
 		`NginxIngress:` + strings.Replace(this.NginxIngress.String(), "NginxIngress", "NginxIngress", 1) + `,`,
+		`ResourceBackup:` + strings.Replace(this.ResourceBackup.String(), "ResourceBackupAddon", "ResourceBackupAddon", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -24028,6 +27603,54 @@ func (this *AuditPolicy) String() string {
 	}, "")
 	return s
 }
+func (this *AuthenticationClaimMappings) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuthenticationClaimMappings{`,
+		`Username:` + strings.Replace(this.Username.String(), "AuthenticationClaimOrExpression", "AuthenticationClaimOrExpression", 1) + `,`,
+		`Groups:` + strings.Replace(this.Groups.String(), "AuthenticationClaimOrExpression", "AuthenticationClaimOrExpression", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuthenticationClaimOrExpression) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuthenticationClaimOrExpression{`,
+		`Claim:` + valueToStringGenerated(this.Claim) + `,`,
+		`Prefix:` + valueToStringGenerated(this.Prefix) + `,`,
+		`Expression:` + valueToStringGenerated(this.Expression) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuthenticationClaimValidationRule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuthenticationClaimValidationRule{`,
+		`Claim:` + valueToStringGenerated(this.Claim) + `,`,
+		`RequiredValue:` + valueToStringGenerated(this.RequiredValue) + `,`,
+		`Expression:` + valueToStringGenerated(this.Expression) + `,`,
+		`Message:` + valueToStringGenerated(this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuthenticationIssuer) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuthenticationIssuer{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Audiences:` + fmt.Sprintf("%v", this.Audiences) + `,`,
+		`CertificateAuthority:` + valueToStringGenerated(this.CertificateAuthority) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *AuthorizerKubeconfigReference) String() string {
 	if this == nil {
 		return "nil"
@@ -24247,6 +27870,7 @@ func (this *CRI) String() string {
 	s := strings.Join([]string{`&CRI{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`ContainerRuntimes:` + repeatedStringForContainerRuntimes + `,`,
+		`Containerd:` + strings.Replace(this.Containerd.String(), "ContainerdConfig", "ContainerdConfig", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -24262,6 +27886,17 @@ func (this *CapabilityDefinition) String() string {
 	}, "")
 	return s
 }
+func (this *CloudCredentialsRotation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CloudCredentialsRotation{`,
+		`LastInitiationTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationTime), "Time", "v11.Time", 1) + `,`,
+		`LastCompletionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTime), "Time", "v11.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *CloudProfile) String() string {
 	if this == nil {
 		return "nil"
@@ -24329,6 +27964,11 @@ func (this *CloudProfileSpec) String() string {
 		repeatedStringForMachineCapabilities += strings.Replace(strings.Replace(f.String(), "CapabilityDefinition", "CapabilityDefinition", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForMachineCapabilities += "}"
+	repeatedStringForRegistryMirrors := "[]RegistryMirror{"
+	for _, f := range this.RegistryMirrors {
+		repeatedStringForRegistryMirrors += strings.Replace(strings.Replace(f.String(), "RegistryMirror", "RegistryMirror", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRegistryMirrors += "}"
 	s := strings.Join([]string{`&CloudProfileSpec{`,
 		`CABundle:` + valueToStringGenerated(this.CABundle) + `,`,
 		`Kubernetes:` + strings.Replace(strings.Replace(this.Kubernetes.String(), "KubernetesSettings", "KubernetesSettings", 1), `&`, ``, 1) + `,`,
@@ -24342,6 +27982,7 @@ func (this *CloudProfileSpec) String() string {
 		`Bastion:` + strings.Replace(this.Bastion.String(), "Bastion", "Bastion", 1) + `,`,
 		`Limits:` + strings.Replace(this.Limits.String(), "Limits", "Limits", 1) + `,`,
 		`MachineCapabilities:` + repeatedStringForMachineCapabilities + `,`,
+		`RegistryMirrors:` + repeatedStringForRegistryMirrors + `,`,
 		`}`,
 	}, "")
 	return s
@@ -24417,6 +28058,35 @@ func (this *ContainerRuntime) String() string {
 	}, "")
 	return s
 }
+func (this *ContainerdConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRegistries := "[]ContainerdRegistry{"
+	for _, f := range this.Registries {
+		repeatedStringForRegistries += strings.Replace(strings.Replace(f.String(), "ContainerdRegistry", "ContainerdRegistry", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRegistries += "}"
+	s := strings.Join([]string{`&ContainerdConfig{`,
+		`Registries:` + repeatedStringForRegistries + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainerdRegistry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContainerdRegistry{`,
+		`Upstream:` + fmt.Sprintf("%v", this.Upstream) + `,`,
+		`Server:` + valueToStringGenerated(this.Server) + `,`,
+		`Hosts:` + fmt.Sprintf("%v", this.Hosts) + `,`,
+		`AuthSecretName:` + valueToStringGenerated(this.AuthSecretName) + `,`,
+		`AllowInsecure:` + valueToStringGenerated(this.AllowInsecure) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ControlPlane) String() string {
 	if this == nil {
 		return "nil"
@@ -24447,6 +28117,19 @@ func (this *ControlPlaneAutoscaling) String() string {
 	}, "")
 	return s
 }
+func (this *ControlPlaneResourceConsumption) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ControlPlaneResourceConsumption{`,
+		`KubeAPIServerCPU:` + strings.Replace(fmt.Sprintf("%v", this.KubeAPIServerCPU), "Quantity", "resource.Quantity", 1) + `,`,
+		`KubeAPIServerMemory:` + strings.Replace(fmt.Sprintf("%v", this.KubeAPIServerMemory), "Quantity", "resource.Quantity", 1) + `,`,
+		`ETCDStorageSize:` + strings.Replace(fmt.Sprintf("%v", this.ETCDStorageSize), "Quantity", "resource.Quantity", 1) + `,`,
+		`LastUpdateTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ControllerDeployment) String() string {
 	if this == nil {
 		return "nil"
@@ -24605,6 +28288,18 @@ func (this *ControllerResource) String() string {
 		`WorkerlessSupported:` + valueToStringGenerated(this.WorkerlessSupported) + `,`,
 		`AutoEnable:` + fmt.Sprintf("%v", this.AutoEnable) + `,`,
 		`ClusterCompatibility:` + fmt.Sprintf("%v", this.ClusterCompatibility) + `,`,
+		`Addon:` + strings.Replace(this.Addon.String(), "ControllerResourceAddon", "ControllerResourceAddon", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ControllerResourceAddon) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ControllerResourceAddon{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Schema:` + strings.Replace(fmt.Sprintf("%v", this.Schema), "RawExtension", "runtime.RawExtension", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -24664,6 +28359,7 @@ func (this *DNS) String() string {
 	s := strings.Join([]string{`&DNS{`,
 		`Domain:` + valueToStringGenerated(this.Domain) + `,`,
 		`Providers:` + repeatedStringForProviders + `,`,
+		`ManagementDomain:` + valueToStringGenerated(this.ManagementDomain) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -24770,6 +28466,18 @@ func (this *EncryptionAtRest) String() string {
 	}
 	s := strings.Join([]string{`&EncryptionAtRest{`,
 		`Resources:` + fmt.Sprintf("%v", this.Resources) + `,`,
+		`MigrationProgress:` + strings.Replace(this.MigrationProgress.String(), "EncryptionAtRestMigrationProgress", "EncryptionAtRestMigrationProgress", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EncryptionAtRestMigrationProgress) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EncryptionAtRestMigrationProgress{`,
+		`MigratedResourceCount:` + fmt.Sprintf("%v", this.MigratedResourceCount) + `,`,
+		`RemainingResourceCount:` + fmt.Sprintf("%v", this.RemainingResourceCount) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -24780,6 +28488,7 @@ func (this *EncryptionConfig) String() string {
 	}
 	s := strings.Join([]string{`&EncryptionConfig{`,
 		`Resources:` + fmt.Sprintf("%v", this.Resources) + `,`,
+		`KMS:` + strings.Replace(this.KMS.String(), "KMSEncryptionConfiguration", "KMSEncryptionConfiguration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -24940,6 +28649,7 @@ func (this *Hibernation) String() string {
 	s := strings.Join([]string{`&Hibernation{`,
 		`Enabled:` + valueToStringGenerated(this.Enabled) + `,`,
 		`Schedules:` + repeatedStringForSchedules + `,`,
+		`Mode:` + valueToStringGenerated(this.Mode) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -25073,6 +28783,36 @@ func (this *InternalSecretList) String() string {
 	}, "")
 	return s
 }
+func (this *JWTAuthenticator) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForClaimValidationRules := "[]AuthenticationClaimValidationRule{"
+	for _, f := range this.ClaimValidationRules {
+		repeatedStringForClaimValidationRules += strings.Replace(strings.Replace(f.String(), "AuthenticationClaimValidationRule", "AuthenticationClaimValidationRule", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForClaimValidationRules += "}"
+	s := strings.Join([]string{`&JWTAuthenticator{`,
+		`Issuer:` + strings.Replace(strings.Replace(this.Issuer.String(), "AuthenticationIssuer", "AuthenticationIssuer", 1), `&`, ``, 1) + `,`,
+		`ClaimMappings:` + strings.Replace(this.ClaimMappings.String(), "AuthenticationClaimMappings", "AuthenticationClaimMappings", 1) + `,`,
+		`ClaimValidationRules:` + repeatedStringForClaimValidationRules + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KMSEncryptionConfiguration) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KMSEncryptionConfiguration{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
+		`SecretName:` + valueToStringGenerated(this.SecretName) + `,`,
+		`Timeout:` + strings.Replace(fmt.Sprintf("%v", this.Timeout), "Duration", "v11.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *KubeAPIServerConfig) String() string {
 	if this == nil {
 		return "nil"
@@ -25111,6 +28851,30 @@ func (this *KubeAPIServerConfig) String() string {
 		`StructuredAuthentication:` + strings.Replace(this.StructuredAuthentication.String(), "StructuredAuthentication", "StructuredAuthentication", 1) + `,`,
 		`StructuredAuthorization:` + strings.Replace(this.StructuredAuthorization.String(), "StructuredAuthorization", "StructuredAuthorization", 1) + `,`,
 		`Autoscaling:` + strings.Replace(this.Autoscaling.String(), "ControlPlaneAutoscaling", "ControlPlaneAutoscaling", 1) + `,`,
+		`RequestBasedAutoscaling:` + strings.Replace(this.RequestBasedAutoscaling.String(), "KubeAPIServerRequestBasedAutoscaling", "KubeAPIServerRequestBasedAutoscaling", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KubeAPIServerRequestBasedAutoscaling) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KubeAPIServerRequestBasedAutoscaling{`,
+		`AverageRequestsPerSecond:` + valueToStringGenerated(this.AverageRequestsPerSecond) + `,`,
+		`AverageInflightRequests:` + valueToStringGenerated(this.AverageInflightRequests) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KubeControllerManagerConcurrentSyncs) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KubeControllerManagerConcurrentSyncs{`,
+		`Deployment:` + valueToStringGenerated(this.Deployment) + `,`,
+		`ReplicaSet:` + valueToStringGenerated(this.ReplicaSet) + `,`,
+		`StatefulSet:` + valueToStringGenerated(this.StatefulSet) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -25125,6 +28889,7 @@ func (this *KubeControllerManagerConfig) String() string {
 		`NodeCIDRMaskSize:` + valueToStringGenerated(this.NodeCIDRMaskSize) + `,`,
 		`PodEvictionTimeout:` + strings.Replace(fmt.Sprintf("%v", this.PodEvictionTimeout), "Duration", "v11.Duration", 1) + `,`,
 		`NodeMonitorGracePeriod:` + strings.Replace(fmt.Sprintf("%v", this.NodeMonitorGracePeriod), "Duration", "v11.Duration", 1) + `,`,
+		`ConcurrentSyncs:` + strings.Replace(this.ConcurrentSyncs.String(), "KubeControllerManagerConcurrentSyncs", "KubeControllerManagerConcurrentSyncs", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -25434,6 +29199,20 @@ func (this *MachineImageFlavor) String() string {
 	}, "")
 	return s
 }
+func (this *MachineImageUpgradePath) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MachineImageUpgradePath{`,
+		`WorkerPool:` + fmt.Sprintf("%v", this.WorkerPool) + `,`,
+		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Next:` + valueToStringGenerated(this.Next) + `,`,
+		`ExpirationDate:` + strings.Replace(fmt.Sprintf("%v", this.ExpirationDate), "Time", "v11.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *MachineImageVersion) String() string {
 	if this == nil {
 		return "nil"
@@ -25529,6 +29308,18 @@ func (this *MaintenanceTimeWindow) String() string {
 	s := strings.Join([]string{`&MaintenanceTimeWindow{`,
 		`Begin:` + fmt.Sprintf("%v", this.Begin) + `,`,
 		`End:` + fmt.Sprintf("%v", this.End) + `,`,
+		`Location:` + valueToStringGenerated(this.Location) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ManagedAddon) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ManagedAddon{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Config:` + strings.Replace(fmt.Sprintf("%v", this.Config), "RawExtension", "runtime.RawExtension", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -25669,11 +29460,17 @@ func (this *NetworkingStatus) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForEgressCIDRsByZone := "[]ZoneEgressCIDRs{"
+	for _, f := range this.EgressCIDRsByZone {
+		repeatedStringForEgressCIDRsByZone += strings.Replace(strings.Replace(f.String(), "ZoneEgressCIDRs", "ZoneEgressCIDRs", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForEgressCIDRsByZone += "}"
 	s := strings.Join([]string{`&NetworkingStatus{`,
 		`Pods:` + fmt.Sprintf("%v", this.Pods) + `,`,
 		`Nodes:` + fmt.Sprintf("%v", this.Nodes) + `,`,
 		`Services:` + fmt.Sprintf("%v", this.Services) + `,`,
 		`EgressCIDRs:` + fmt.Sprintf("%v", this.EgressCIDRs) + `,`,
+		`EgressCIDRsByZone:` + repeatedStringForEgressCIDRsByZone + `,`,
 		`}`,
 	}, "")
 	return s
@@ -25888,6 +29685,7 @@ func (this *ProjectStatus) String() string {
 		`StaleSinceTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.StaleSinceTimestamp), "Time", "v11.Time", 1) + `,`,
 		`StaleAutoDeleteTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.StaleAutoDeleteTimestamp), "Time", "v11.Time", 1) + `,`,
 		`LastActivityTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.LastActivityTimestamp), "Time", "v11.Time", 1) + `,`,
+		`UsageSummary:` + strings.Replace(this.UsageSummary.String(), "ProjectUsageSummary", "ProjectUsageSummary", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -25913,6 +29711,21 @@ func (this *ProjectTolerations) String() string {
 	}, "")
 	return s
 }
+func (this *ProjectUsageSummary) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectUsageSummary{`,
+		`Shoots:` + fmt.Sprintf("%v", this.Shoots) + `,`,
+		`HibernatedShoots:` + fmt.Sprintf("%v", this.HibernatedShoots) + `,`,
+		`Nodes:` + fmt.Sprintf("%v", this.Nodes) + `,`,
+		`CPU:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CPU), "Quantity", "resource.Quantity", 1), `&`, ``, 1) + `,`,
+		`Memory:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Memory), "Quantity", "resource.Quantity", 1), `&`, ``, 1) + `,`,
+		`LastUpdateTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Provider) String() string {
 	if this == nil {
 		return "nil"
@@ -26014,6 +29827,31 @@ func (this *Region) String() string {
 	}, "")
 	return s
 }
+func (this *RegistryMirror) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RegistryMirror{`,
+		`Upstream:` + fmt.Sprintf("%v", this.Upstream) + `,`,
+		`Hosts:` + fmt.Sprintf("%v", this.Hosts) + `,`,
+		`Regions:` + fmt.Sprintf("%v", this.Regions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceBackupAddon) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceBackupAddon{`,
+		`Addon:` + strings.Replace(strings.Replace(this.Addon.String(), "Addon", "Addon", 1), `&`, ``, 1) + `,`,
+		`Schedule:` + valueToStringGenerated(this.Schedule) + `,`,
+		`RetentionPeriod:` + strings.Replace(fmt.Sprintf("%v", this.RetentionPeriod), "Duration", "v11.Duration", 1) + `,`,
+		`IncludedNamespaces:` + fmt.Sprintf("%v", this.IncludedNamespaces) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ResourceData) String() string {
 	if this == nil {
 		return "nil"
@@ -26061,6 +29899,7 @@ func (this *SecretBinding) String() string {
 		`SecretRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SecretRef), "SecretReference", "v1.SecretReference", 1), `&`, ``, 1) + `,`,
 		`Quotas:` + repeatedStringForQuotas + `,`,
 		`Provider:` + strings.Replace(this.Provider.String(), "SecretBindingProvider", "SecretBindingProvider", 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "SecretBindingStatus", "SecretBindingStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -26091,6 +29930,21 @@ func (this *SecretBindingProvider) String() string {
 	}, "")
 	return s
 }
+func (this *SecretBindingStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForConditions := "[]Condition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "Condition", "Condition", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForConditions += "}"
+	s := strings.Join([]string{`&SecretBindingStatus{`,
+		`Conditions:` + repeatedStringForConditions + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Seed) String() string {
 	if this == nil {
 		return "nil"
@@ -26103,6 +29957,40 @@ func (this *Seed) String() string {
 	}, "")
 	return s
 }
+func (this *SeedAffinity) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRequiredDuringScheduling := "[]LabelSelector{"
+	for _, f := range this.RequiredDuringScheduling {
+		repeatedStringForRequiredDuringScheduling += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForRequiredDuringScheduling += "}"
+	repeatedStringForPreferredDuringScheduling := "[]WeightedSeedAffinityTerm{"
+	for _, f := range this.PreferredDuringScheduling {
+		repeatedStringForPreferredDuringScheduling += strings.Replace(strings.Replace(f.String(), "WeightedSeedAffinityTerm", "WeightedSeedAffinityTerm", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPreferredDuringScheduling += "}"
+	s := strings.Join([]string{`&SeedAffinity{`,
+		`RequiredDuringScheduling:` + repeatedStringForRequiredDuringScheduling + `,`,
+		`PreferredDuringScheduling:` + repeatedStringForPreferredDuringScheduling + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SeedAvailability) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SeedAvailability{`,
+		`DowntimeWindows:` + fmt.Sprintf("%v", this.DowntimeWindows) + `,`,
+		`AccumulatedDowntimeSeconds:` + fmt.Sprintf("%v", this.AccumulatedDowntimeSeconds) + `,`,
+		`LastDowntimeBegin:` + strings.Replace(fmt.Sprintf("%v", this.LastDowntimeBegin), "Time", "v11.Time", 1) + `,`,
+		`LastDowntimeEnd:` + strings.Replace(fmt.Sprintf("%v", this.LastDowntimeEnd), "Time", "v11.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SeedDNS) String() string {
 	if this == nil {
 		return "nil"
@@ -26199,6 +30087,16 @@ func (this *SeedSelector) String() string {
 	}, "")
 	return s
 }
+func (this *SeedSettingDNSCache) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SeedSettingDNSCache{`,
+		`Enabled:` + fmt.Sprintf("%v", this.Enabled) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SeedSettingDependencyWatchdog) String() string {
 	if this == nil {
 		return "nil"
@@ -26216,6 +30114,7 @@ func (this *SeedSettingDependencyWatchdogProber) String() string {
 	}
 	s := strings.Join([]string{`&SeedSettingDependencyWatchdogProber{`,
 		`Enabled:` + fmt.Sprintf("%v", this.Enabled) + `,`,
+		`ProbeInterval:` + strings.Replace(fmt.Sprintf("%v", this.ProbeInterval), "Duration", "v11.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -26226,6 +30125,7 @@ func (this *SeedSettingDependencyWatchdogWeeder) String() string {
 	}
 	s := strings.Join([]string{`&SeedSettingDependencyWatchdogWeeder{`,
 		`Enabled:` + fmt.Sprintf("%v", this.Enabled) + `,`,
+		`WatchDuration:` + strings.Replace(fmt.Sprintf("%v", this.WatchDuration), "Duration", "v11.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -26279,6 +30179,56 @@ func (this *SeedSettingExcessCapacityReservationConfig) String() string {
 		`Resources:` + mapStringForResources + `,`,
 		`NodeSelector:` + mapStringForNodeSelector + `,`,
 		`Tolerations:` + repeatedStringForTolerations + `,`,
+		`Percentage:` + valueToStringGenerated(this.Percentage) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SeedSettingIstioIngressGateway) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForExposureClassHandlers := "[]SeedSettingIstioIngressGatewayExposureClassHandler{"
+	for _, f := range this.ExposureClassHandlers {
+		repeatedStringForExposureClassHandlers += strings.Replace(strings.Replace(f.String(), "SeedSettingIstioIngressGatewayExposureClassHandler", "SeedSettingIstioIngressGatewayExposureClassHandler", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForExposureClassHandlers += "}"
+	s := strings.Join([]string{`&SeedSettingIstioIngressGateway{`,
+		`Autoscaling:` + strings.Replace(this.Autoscaling.String(), "SeedSettingIstioIngressGatewayAutoscaling", "SeedSettingIstioIngressGatewayAutoscaling", 1) + `,`,
+		`ExposureClassHandlers:` + repeatedStringForExposureClassHandlers + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SeedSettingIstioIngressGatewayAutoscaling) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForRequests := make([]string, 0, len(this.Requests))
+	for k := range this.Requests {
+		keysForRequests = append(keysForRequests, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForRequests)
+	mapStringForRequests := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForRequests {
+		mapStringForRequests += fmt.Sprintf("%v: %v,", k, this.Requests[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForRequests += "}"
+	s := strings.Join([]string{`&SeedSettingIstioIngressGatewayAutoscaling{`,
+		`MinReplicas:` + valueToStringGenerated(this.MinReplicas) + `,`,
+		`MaxReplicas:` + valueToStringGenerated(this.MaxReplicas) + `,`,
+		`Requests:` + mapStringForRequests + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SeedSettingIstioIngressGatewayExposureClassHandler) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SeedSettingIstioIngressGatewayExposureClassHandler{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Autoscaling:` + strings.Replace(this.Autoscaling.String(), "SeedSettingIstioIngressGatewayAutoscaling", "SeedSettingIstioIngressGatewayAutoscaling", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -26409,6 +30359,8 @@ func (this *SeedSettings) String() string {
 		`VerticalPodAutoscaler:` + strings.Replace(this.VerticalPodAutoscaler.String(), "SeedSettingVerticalPodAutoscaler", "SeedSettingVerticalPodAutoscaler", 1) + `,`,
 		`DependencyWatchdog:` + strings.Replace(this.DependencyWatchdog.String(), "SeedSettingDependencyWatchdog", "SeedSettingDependencyWatchdog", 1) + `,`,
 		`TopologyAwareRouting:` + strings.Replace(this.TopologyAwareRouting.String(), "SeedSettingTopologyAwareRouting", "SeedSettingTopologyAwareRouting", 1) + `,`,
+		`IstioIngressGateway:` + strings.Replace(this.IstioIngressGateway.String(), "SeedSettingIstioIngressGateway", "SeedSettingIstioIngressGateway", 1) + `,`,
+		`DNSCache:` + strings.Replace(this.DNSCache.String(), "SeedSettingDNSCache", "SeedSettingDNSCache", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -26492,6 +30444,7 @@ func (this *SeedStatus) String() string {
 		`Allocatable:` + mapStringForAllocatable + `,`,
 		`ClientCertificateExpirationTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.ClientCertificateExpirationTimestamp), "Time", "v11.Time", 1) + `,`,
 		`LastOperation:` + strings.Replace(this.LastOperation.String(), "LastOperation", "LastOperation", 1) + `,`,
+		`Availability:` + strings.Replace(this.Availability.String(), "SeedAvailability", "SeedAvailability", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -26622,6 +30575,7 @@ func (this *ShootCredentialsRotation) String() string {
 		`Observability:` + strings.Replace(this.Observability.String(), "ObservabilityRotation", "ObservabilityRotation", 1) + `,`,
 		`ServiceAccountKey:` + strings.Replace(this.ServiceAccountKey.String(), "ServiceAccountKeyRotation", "ServiceAccountKeyRotation", 1) + `,`,
 		`ETCDEncryptionKey:` + strings.Replace(this.ETCDEncryptionKey.String(), "ETCDEncryptionKeyRotation", "ETCDEncryptionKeyRotation", 1) + `,`,
+		`CloudCredentials:` + strings.Replace(this.CloudCredentials.String(), "CloudCredentialsRotation", "CloudCredentialsRotation", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -26711,6 +30665,11 @@ func (this *ShootSpec) String() string {
 		repeatedStringForAccessRestrictions += strings.Replace(strings.Replace(f.String(), "AccessRestrictionWithOptions", "AccessRestrictionWithOptions", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForAccessRestrictions += "}"
+	repeatedStringForManagedAddons := "[]ManagedAddon{"
+	for _, f := range this.ManagedAddons {
+		repeatedStringForManagedAddons += strings.Replace(strings.Replace(f.String(), "ManagedAddon", "ManagedAddon", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForManagedAddons += "}"
 	s := strings.Join([]string{`&ShootSpec{`,
 		`Addons:` + strings.Replace(this.Addons.String(), "Addons", "Addons", 1) + `,`,
 		`CloudProfileName:` + valueToStringGenerated(this.CloudProfileName) + `,`,
@@ -26736,6 +30695,8 @@ func (this *ShootSpec) String() string {
 		`CloudProfile:` + strings.Replace(this.CloudProfile.String(), "CloudProfileReference", "CloudProfileReference", 1) + `,`,
 		`CredentialsBindingName:` + valueToStringGenerated(this.CredentialsBindingName) + `,`,
 		`AccessRestrictions:` + repeatedStringForAccessRestrictions + `,`,
+		`SeedAffinity:` + strings.Replace(this.SeedAffinity.String(), "SeedAffinity", "SeedAffinity", 1) + `,`,
+		`ManagedAddons:` + repeatedStringForManagedAddons + `,`,
 		`}`,
 	}, "")
 	return s
@@ -26818,6 +30779,11 @@ func (this *ShootStatus) String() string {
 		repeatedStringForAdvertisedAddresses += strings.Replace(strings.Replace(f.String(), "ShootAdvertisedAddress", "ShootAdvertisedAddress", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForAdvertisedAddresses += "}"
+	repeatedStringForWorkerPools := "[]WorkerPoolStatus{"
+	for _, f := range this.WorkerPools {
+		repeatedStringForWorkerPools += strings.Replace(strings.Replace(f.String(), "WorkerPoolStatus", "WorkerPoolStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForWorkerPools += "}"
 	s := strings.Join([]string{`&ShootStatus{`,
 		`Conditions:` + repeatedStringForConditions + `,`,
 		`Constraints:` + repeatedStringForConstraints + `,`,
@@ -26840,6 +30806,9 @@ func (this *ShootStatus) String() string {
 		`Networking:` + strings.Replace(this.Networking.String(), "NetworkingStatus", "NetworkingStatus", 1) + `,`,
 		`InPlaceUpdates:` + strings.Replace(this.InPlaceUpdates.String(), "InPlaceUpdatesStatus", "InPlaceUpdatesStatus", 1) + `,`,
 		`ManualWorkerPoolRollout:` + strings.Replace(this.ManualWorkerPoolRollout.String(), "ManualWorkerPoolRollout", "ManualWorkerPoolRollout", 1) + `,`,
+		`ResourceConsumption:` + strings.Replace(this.ResourceConsumption.String(), "ControlPlaneResourceConsumption", "ControlPlaneResourceConsumption", 1) + `,`,
+		`WorkerPools:` + repeatedStringForWorkerPools + `,`,
+		`UpgradePaths:` + strings.Replace(this.UpgradePaths.String(), "ShootUpgradePaths", "ShootUpgradePaths", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -26855,12 +30824,35 @@ func (this *ShootTemplate) String() string {
 	}, "")
 	return s
 }
+func (this *ShootUpgradePaths) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMachineImages := "[]MachineImageUpgradePath{"
+	for _, f := range this.MachineImages {
+		repeatedStringForMachineImages += strings.Replace(strings.Replace(f.String(), "MachineImageUpgradePath", "MachineImageUpgradePath", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMachineImages += "}"
+	s := strings.Join([]string{`&ShootUpgradePaths{`,
+		`Kubernetes:` + strings.Replace(strings.Replace(this.Kubernetes.String(), "VersionUpgradePath", "VersionUpgradePath", 1), `&`, ``, 1) + `,`,
+		`MachineImages:` + repeatedStringForMachineImages + `,`,
+		`LastUpdateTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *StructuredAuthentication) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForJWTAuthenticators := "[]JWTAuthenticator{"
+	for _, f := range this.JWTAuthenticators {
+		repeatedStringForJWTAuthenticators += strings.Replace(strings.Replace(f.String(), "JWTAuthenticator", "JWTAuthenticator", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForJWTAuthenticators += "}"
 	s := strings.Join([]string{`&StructuredAuthentication{`,
 		`ConfigMapName:` + fmt.Sprintf("%v", this.ConfigMapName) + `,`,
+		`JWTAuthenticators:` + repeatedStringForJWTAuthenticators + `,`,
 		`}`,
 	}, "")
 	return s
@@ -26903,6 +30895,19 @@ func (this *Toleration) String() string {
 	}, "")
 	return s
 }
+func (this *VersionUpgradePath) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VersionUpgradePath{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`NextPatch:` + valueToStringGenerated(this.NextPatch) + `,`,
+		`NextMinor:` + valueToStringGenerated(this.NextMinor) + `,`,
+		`ExpirationDate:` + strings.Replace(fmt.Sprintf("%v", this.ExpirationDate), "Time", "v11.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *VerticalPodAutoscaler) String() string {
 	if this == nil {
 		return "nil"
@@ -26994,6 +30999,17 @@ func (this *WatchCacheSizes) String() string {
 	}, "")
 	return s
 }
+func (this *WeightedSeedAffinityTerm) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WeightedSeedAffinityTerm{`,
+		`Weight:` + fmt.Sprintf("%v", this.Weight) + `,`,
+		`LabelSelector:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LabelSelector), "LabelSelector", "v11.LabelSelector", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Worker) String() string {
 	if this == nil {
 		return "nil"
@@ -27063,6 +31079,7 @@ func (this *Worker) String() string {
 		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
 		`UpdateStrategy:` + valueToStringGenerated(this.UpdateStrategy) + `,`,
 		`ControlPlane:` + strings.Replace(this.ControlPlane.String(), "WorkerControlPlane", "WorkerControlPlane", 1) + `,`,
+		`NodeWorkloadIdentityName:` + valueToStringGenerated(this.NodeWorkloadIdentityName) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -27088,6 +31105,21 @@ func (this *WorkerKubernetes) String() string {
 	}, "")
 	return s
 }
+func (this *WorkerPoolStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkerPoolStatus{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`DesiredMachines:` + fmt.Sprintf("%v", this.DesiredMachines) + `,`,
+		`CurrentMachines:` + fmt.Sprintf("%v", this.CurrentMachines) + `,`,
+		`UpdatedMachines:` + fmt.Sprintf("%v", this.UpdatedMachines) + `,`,
+		`UnavailableMachines:` + fmt.Sprintf("%v", this.UnavailableMachines) + `,`,
+		`LastRolloutTime:` + strings.Replace(fmt.Sprintf("%v", this.LastRolloutTime), "Time", "v11.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *WorkerSystemComponents) String() string {
 	if this == nil {
 		return "nil"
@@ -27108,6 +31140,17 @@ func (this *WorkersSettings) String() string {
 	}, "")
 	return s
 }
+func (this *ZoneEgressCIDRs) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ZoneEgressCIDRs{`,
+		`Zone:` + fmt.Sprintf("%v", this.Zone) + `,`,
+		`CIDRs:` + fmt.Sprintf("%v", this.CIDRs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringGenerated(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -27759,6 +31802,42 @@ func (m *Addons) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceBackup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourceBackup == nil {
+				m.ResourceBackup = &ResourceBackupAddon{}
+			}
+			if err := m.ResourceBackup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28206,7 +32285,7 @@ func (m *AuditPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthorizerKubeconfigReference) Unmarshal(dAtA []byte) error {
+func (m *AuthenticationClaimMappings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28229,17 +32308,17 @@ func (m *AuthorizerKubeconfigReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthorizerKubeconfigReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthenticationClaimMappings: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthorizerKubeconfigReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthenticationClaimMappings: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizerName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28249,29 +32328,33 @@ func (m *AuthorizerKubeconfigReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AuthorizerName = string(dAtA[iNdEx:postIndex])
+			if m.Username == nil {
+				m.Username = &AuthenticationClaimOrExpression{}
+			}
+			if err := m.Username.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28281,23 +32364,27 @@ func (m *AuthorizerKubeconfigReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecretName = string(dAtA[iNdEx:postIndex])
+			if m.Groups == nil {
+				m.Groups = &AuthenticationClaimOrExpression{}
+			}
+			if err := m.Groups.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28320,7 +32407,7 @@ func (m *AuthorizerKubeconfigReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
+func (m *AuthenticationClaimOrExpression) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28343,15 +32430,15 @@ func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AvailabilityZone: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthenticationClaimOrExpression: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AvailabilityZone: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthenticationClaimOrExpression: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claim", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28379,11 +32466,12 @@ func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Claim = &s
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnavailableMachineTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28411,11 +32499,12 @@ func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnavailableMachineTypes = append(m.UnavailableMachineTypes, string(dAtA[iNdEx:postIndex]))
+			s := string(dAtA[iNdEx:postIndex])
+			m.Prefix = &s
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnavailableVolumeTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28443,7 +32532,8 @@ func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnavailableVolumeTypes = append(m.UnavailableVolumeTypes, string(dAtA[iNdEx:postIndex]))
+			s := string(dAtA[iNdEx:postIndex])
+			m.Expression = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28466,7 +32556,7 @@ func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Backup) Unmarshal(dAtA []byte) error {
+func (m *AuthenticationClaimValidationRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28489,15 +32579,15 @@ func (m *Backup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Backup: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthenticationClaimValidationRule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Backup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthenticationClaimValidationRule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claim", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28525,13 +32615,14 @@ func (m *Backup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Claim = &s
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredValue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28541,31 +32632,28 @@ func (m *Backup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProviderConfig == nil {
-				m.ProviderConfig = &runtime.RawExtension{}
-			}
-			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.RequiredValue = &s
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28594,13 +32682,13 @@ func (m *Backup) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Region = &s
+			m.Expression = &s
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialsRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28610,27 +32698,24 @@ func (m *Backup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CredentialsRef == nil {
-				m.CredentialsRef = &v1.ObjectReference{}
-			}
-			if err := m.CredentialsRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Message = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28653,7 +32738,7 @@ func (m *Backup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupBucket) Unmarshal(dAtA []byte) error {
+func (m *AuthenticationIssuer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28676,17 +32761,17 @@ func (m *BackupBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthenticationIssuer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthenticationIssuer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28696,30 +32781,29 @@ func (m *BackupBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Audiences", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28729,30 +32813,29 @@ func (m *BackupBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Audiences = append(m.Audiences, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CertificateAuthority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28762,24 +32845,24 @@ func (m *BackupBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.CertificateAuthority = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28802,7 +32885,7 @@ func (m *BackupBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupBucketList) Unmarshal(dAtA []byte) error {
+func (m *AuthorizerKubeconfigReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28825,17 +32908,17 @@ func (m *BackupBucketList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupBucketList: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthorizerKubeconfigReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupBucketList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthorizerKubeconfigReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizerName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28845,30 +32928,29 @@ func (m *BackupBucketList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AuthorizerName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28878,25 +32960,23 @@ func (m *BackupBucketList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, BackupBucket{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SecretName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28919,7 +32999,7 @@ func (m *BackupBucketList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupBucketProvider) Unmarshal(dAtA []byte) error {
+func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28942,47 +33022,15 @@ func (m *BackupBucketProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupBucketProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: AvailabilityZone: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupBucketProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AvailabilityZone: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29010,7 +33058,71 @@ func (m *BackupBucketProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnavailableMachineTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnavailableMachineTypes = append(m.UnavailableMachineTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnavailableVolumeTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnavailableVolumeTypes = append(m.UnavailableVolumeTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29033,7 +33145,7 @@ func (m *BackupBucketProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
+func (m *Backup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29056,17 +33168,17 @@ func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupBucketSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: Backup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupBucketSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Backup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29076,24 +33188,23 @@ func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -29131,9 +33242,9 @@ func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeedName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29162,7 +33273,7 @@ func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.SeedName = &s
+			m.Region = &s
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
@@ -29221,7 +33332,7 @@ func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupBucketStatus) Unmarshal(dAtA []byte) error {
+func (m *BackupBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29244,15 +33355,15 @@ func (m *BackupBucketStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupBucketStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupBucketStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29279,16 +33390,13 @@ func (m *BackupBucketStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProviderStatus == nil {
-				m.ProviderStatus = &runtime.RawExtension{}
-			}
-			if err := m.ProviderStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastOperation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29315,71 +33423,13 @@ func (m *BackupBucketStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastOperation == nil {
-				m.LastOperation = &LastOperation{}
-			}
-			if err := m.LastOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LastError == nil {
-				m.LastError = &LastError{}
-			}
-			if err := m.LastError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
-			}
-			m.ObservedGeneration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ObservedGeneration |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GeneratedSecretRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29406,10 +33456,7 @@ func (m *BackupBucketStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GeneratedSecretRef == nil {
-				m.GeneratedSecretRef = &v1.SecretReference{}
-			}
-			if err := m.GeneratedSecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -29434,7 +33481,7 @@ func (m *BackupBucketStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupEntry) Unmarshal(dAtA []byte) error {
+func (m *BackupBucketList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29457,15 +33504,15 @@ func (m *BackupEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupBucketList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupBucketList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29492,46 +33539,13 @@ func (m *BackupEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29558,7 +33572,8 @@ func (m *BackupEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, BackupBucket{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -29583,7 +33598,7 @@ func (m *BackupEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupEntryList) Unmarshal(dAtA []byte) error {
+func (m *BackupBucketProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29606,17 +33621,17 @@ func (m *BackupEntryList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupEntryList: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupBucketProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupEntryList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupBucketProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29626,30 +33641,29 @@ func (m *BackupEntryList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29659,25 +33673,23 @@ func (m *BackupEntryList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, BackupEntry{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29700,7 +33712,7 @@ func (m *BackupEntryList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupEntrySpec) Unmarshal(dAtA []byte) error {
+func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29723,17 +33735,17 @@ func (m *BackupEntrySpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupEntrySpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupBucketSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupEntrySpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupBucketSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29743,25 +33755,62 @@ func (m *BackupEntrySpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProviderConfig == nil {
+				m.ProviderConfig = &runtime.RawExtension{}
+			}
+			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SeedName", wireType)
 			}
@@ -29794,6 +33843,42 @@ func (m *BackupEntrySpec) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.SeedName = &s
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialsRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CredentialsRef == nil {
+				m.CredentialsRef = &v1.ObjectReference{}
+			}
+			if err := m.CredentialsRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29815,7 +33900,7 @@ func (m *BackupEntrySpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupEntryStatus) Unmarshal(dAtA []byte) error {
+func (m *BackupBucketStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29838,15 +33923,15 @@ func (m *BackupEntryStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupEntryStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupBucketStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupEntryStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupBucketStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastOperation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderStatus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29873,16 +33958,16 @@ func (m *BackupEntryStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastOperation == nil {
-				m.LastOperation = &LastOperation{}
+			if m.ProviderStatus == nil {
+				m.ProviderStatus = &runtime.RawExtension{}
 			}
-			if err := m.LastOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProviderStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOperation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29909,18 +33994,18 @@ func (m *BackupEntryStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastError == nil {
-				m.LastError = &LastError{}
+			if m.LastOperation == nil {
+				m.LastOperation = &LastOperation{}
 			}
-			if err := m.LastError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
-			m.ObservedGeneration = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29930,16 +34015,33 @@ func (m *BackupEntryStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObservedGeneration |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastError == nil {
+				m.LastError = &LastError{}
+			}
+			if err := m.LastError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeedName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
 			}
-			var stringLen uint64
+			m.ObservedGeneration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29949,28 +34051,14 @@ func (m *BackupEntryStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ObservedGeneration |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.SeedName = &s
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MigrationStartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GeneratedSecretRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29997,10 +34085,10 @@ func (m *BackupEntryStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MigrationStartTime == nil {
-				m.MigrationStartTime = &v11.Time{}
+			if m.GeneratedSecretRef == nil {
+				m.GeneratedSecretRef = &v1.SecretReference{}
 			}
-			if err := m.MigrationStartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GeneratedSecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -30025,7 +34113,7 @@ func (m *BackupEntryStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Bastion) Unmarshal(dAtA []byte) error {
+func (m *BackupEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30048,15 +34136,15 @@ func (m *Bastion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Bastion: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Bastion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MachineImage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30083,16 +34171,13 @@ func (m *Bastion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MachineImage == nil {
-				m.MachineImage = &BastionMachineImage{}
-			}
-			if err := m.MachineImage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MachineType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30119,10 +34204,40 @@ func (m *Bastion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MachineType == nil {
-				m.MachineType = &BastionMachineType{}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.MachineType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -30147,7 +34262,7 @@ func (m *Bastion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BastionMachineImage) Unmarshal(dAtA []byte) error {
+func (m *BackupEntryList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30170,17 +34285,17 @@ func (m *BastionMachineImage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BastionMachineImage: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupEntryList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BastionMachineImage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupEntryList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30190,29 +34305,30 @@ func (m *BastionMachineImage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30222,24 +34338,25 @@ func (m *BastionMachineImage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Version = &s
+			m.Items = append(m.Items, BackupEntry{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30262,7 +34379,7 @@ func (m *BastionMachineImage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BastionMachineType) Unmarshal(dAtA []byte) error {
+func (m *BackupEntrySpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30285,15 +34402,15 @@ func (m *BastionMachineType) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BastionMachineType: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupEntrySpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BastionMachineType: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupEntrySpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30321,7 +34438,40 @@ func (m *BastionMachineType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeedName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.SeedName = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30344,7 +34494,7 @@ func (m *BastionMachineType) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CARotation) Unmarshal(dAtA []byte) error {
+func (m *BackupEntryStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30367,17 +34517,17 @@ func (m *CARotation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CARotation: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupEntryStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CARotation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupEntryStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOperation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30387,27 +34537,31 @@ func (m *CARotation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = CredentialsRotationPhase(dAtA[iNdEx:postIndex])
+			if m.LastOperation == nil {
+				m.LastOperation = &LastOperation{}
+			}
+			if err := m.LastOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30434,18 +34588,37 @@ func (m *CARotation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastCompletionTime == nil {
-				m.LastCompletionTime = &v11.Time{}
+			if m.LastError == nil {
+				m.LastError = &LastError{}
 			}
-			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SeedName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30455,31 +34628,28 @@ func (m *CARotation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastInitiationTime == nil {
-				m.LastInitiationTime = &v11.Time{}
-			}
-			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.SeedName = &s
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationFinishedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationStartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30506,16 +34676,66 @@ func (m *CARotation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastInitiationFinishedTime == nil {
-				m.LastInitiationFinishedTime = &v11.Time{}
+			if m.MigrationStartTime == nil {
+				m.MigrationStartTime = &v11.Time{}
 			}
-			if err := m.LastInitiationFinishedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MigrationStartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Bastion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bastion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bastion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTriggeredTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineImage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30542,16 +34762,16 @@ func (m *CARotation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastCompletionTriggeredTime == nil {
-				m.LastCompletionTriggeredTime = &v11.Time{}
+			if m.MachineImage == nil {
+				m.MachineImage = &BastionMachineImage{}
 			}
-			if err := m.LastCompletionTriggeredTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MachineImage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingWorkersRollouts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineType", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30578,8 +34798,10 @@ func (m *CARotation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingWorkersRollouts = append(m.PendingWorkersRollouts, PendingWorkersRollout{})
-			if err := m.PendingWorkersRollouts[len(m.PendingWorkersRollouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MachineType == nil {
+				m.MachineType = &BastionMachineType{}
+			}
+			if err := m.MachineType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -30604,7 +34826,7 @@ func (m *CARotation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CRI) Unmarshal(dAtA []byte) error {
+func (m *BastionMachineImage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30627,10 +34849,10 @@ func (m *CRI) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CRI: wiretype end group for non-group")
+			return fmt.Errorf("proto: BastionMachineImage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CRI: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BastionMachineImage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -30663,13 +34885,13 @@ func (m *CRI) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = CRIName(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerRuntimes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30679,25 +34901,24 @@ func (m *CRI) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerRuntimes = append(m.ContainerRuntimes, ContainerRuntime{})
-			if err := m.ContainerRuntimes[len(m.ContainerRuntimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Version = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30720,7 +34941,7 @@ func (m *CRI) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CapabilityDefinition) Unmarshal(dAtA []byte) error {
+func (m *BastionMachineType) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30743,10 +34964,10 @@ func (m *CapabilityDefinition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CapabilityDefinition: wiretype end group for non-group")
+			return fmt.Errorf("proto: BastionMachineType: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CapabilityDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BastionMachineType: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -30781,42 +35002,6 @@ func (m *CapabilityDefinition) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Values == nil {
-				m.Values = CapabilityValues{}
-			}
-			if err := m.Values.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -30838,7 +35023,7 @@ func (m *CapabilityDefinition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CapabilityValues) Unmarshal(dAtA []byte) error {
+func (m *CARotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30861,15 +35046,15 @@ func (m *CapabilityValues) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CapabilityValues: wiretype end group for non-group")
+			return fmt.Errorf("proto: CARotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CapabilityValues: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CARotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30897,61 +35082,119 @@ func (m *CapabilityValues) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			*m = append(*m, string(dAtA[iNdEx:postIndex]))
+			m.Phase = CredentialsRotationPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastCompletionTime == nil {
+				m.LastCompletionTime = &v11.Time{}
+			}
+			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CloudProfile) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if m.LastInitiationTime == nil {
+				m.LastInitiationTime = &v11.Time{}
 			}
-			if iNdEx >= l {
+			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationFinishedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.LastInitiationFinishedTime == nil {
+				m.LastInitiationFinishedTime = &v11.Time{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CloudProfile: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloudProfile: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.LastInitiationFinishedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTriggeredTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30978,13 +35221,16 @@ func (m *CloudProfile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastCompletionTriggeredTime == nil {
+				m.LastCompletionTriggeredTime = &v11.Time{}
+			}
+			if err := m.LastCompletionTriggeredTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingWorkersRollouts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31011,7 +35257,8 @@ func (m *CloudProfile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingWorkersRollouts = append(m.PendingWorkersRollouts, PendingWorkersRollout{})
+			if err := m.PendingWorkersRollouts[len(m.PendingWorkersRollouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -31036,7 +35283,7 @@ func (m *CloudProfile) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloudProfileList) Unmarshal(dAtA []byte) error {
+func (m *CRI) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31059,15 +35306,47 @@ func (m *CloudProfileList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloudProfileList: wiretype end group for non-group")
+			return fmt.Errorf("proto: CRI: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloudProfileList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CRI: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = CRIName(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerRuntimes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31094,13 +35373,14 @@ func (m *CloudProfileList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ContainerRuntimes = append(m.ContainerRuntimes, ContainerRuntime{})
+			if err := m.ContainerRuntimes[len(m.ContainerRuntimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Containerd", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31127,8 +35407,10 @@ func (m *CloudProfileList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, CloudProfile{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Containerd == nil {
+				m.Containerd = &ContainerdConfig{}
+			}
+			if err := m.Containerd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -31153,7 +35435,7 @@ func (m *CloudProfileList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloudProfileReference) Unmarshal(dAtA []byte) error {
+func (m *CapabilityDefinition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31176,15 +35458,15 @@ func (m *CloudProfileReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloudProfileReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: CapabilityDefinition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloudProfileReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CapabilityDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31212,13 +35494,13 @@ func (m *CloudProfileReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31228,23 +35510,27 @@ func (m *CloudProfileReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Values == nil {
+				m.Values = CapabilityValues{}
+			}
+			if err := m.Values.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31267,7 +35553,7 @@ func (m *CloudProfileReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloudProfileSpec) Unmarshal(dAtA []byte) error {
+func (m *CapabilityValues) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31290,15 +35576,15 @@ func (m *CloudProfileSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloudProfileSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: CapabilityValues: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloudProfileSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CapabilityValues: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CABundle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31326,12 +35612,61 @@ func (m *CloudProfileSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.CABundle = &s
+			*m = append(*m, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloudCredentialsRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloudCredentialsRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloudCredentialsRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kubernetes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31358,13 +35693,16 @@ func (m *CloudProfileSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Kubernetes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastInitiationTime == nil {
+				m.LastInitiationTime = &v11.Time{}
+			}
+			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MachineImages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31391,14 +35729,66 @@ func (m *CloudProfileSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MachineImages = append(m.MachineImages, MachineImage{})
-			if err := m.MachineImages[len(m.MachineImages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastCompletionTime == nil {
+				m.LastCompletionTime = &v11.Time{}
+			}
+			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloudProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloudProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloudProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MachineTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31425,14 +35815,13 @@ func (m *CloudProfileSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MachineTypes = append(m.MachineTypes, MachineType{})
-			if err := m.MachineTypes[len(m.MachineTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31459,16 +35848,63 @@ func (m *CloudProfileSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProviderConfig == nil {
-				m.ProviderConfig = &runtime.RawExtension{}
-			}
-			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloudProfileList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloudProfileList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloudProfileList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31495,14 +35931,13 @@ func (m *CloudProfileSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Regions = append(m.Regions, Region{})
-			if err := m.Regions[len(m.Regions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeedSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31529,16 +35964,64 @@ func (m *CloudProfileSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SeedSelector == nil {
-				m.SeedSelector = &SeedSelector{}
-			}
-			if err := m.SeedSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, CloudProfile{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloudProfileReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloudProfileReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloudProfileReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31566,13 +36049,367 @@ func (m *CloudProfileSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VolumeTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloudProfileSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloudProfileSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloudProfileSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CABundle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.CABundle = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kubernetes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Kubernetes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineImages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MachineImages = append(m.MachineImages, MachineImage{})
+			if err := m.MachineImages[len(m.MachineImages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MachineTypes = append(m.MachineTypes, MachineType{})
+			if err := m.MachineTypes[len(m.MachineTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProviderConfig == nil {
+				m.ProviderConfig = &runtime.RawExtension{}
+			}
+			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Regions = append(m.Regions, Region{})
+			if err := m.Regions[len(m.Regions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeedSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SeedSelector == nil {
+				m.SeedSelector = &SeedSelector{}
+			}
+			if err := m.SeedSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeTypes", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31708,6 +36545,40 @@ func (m *CloudProfileSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistryMirrors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistryMirrors = append(m.RegistryMirrors, RegistryMirror{})
+			if err := m.RegistryMirrors[len(m.RegistryMirrors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32977,6 +37848,291 @@ func (m *ContainerRuntime) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ContainerdConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerdConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerdConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Registries = append(m.Registries, ContainerdRegistry{})
+			if err := m.Registries[len(m.Registries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerdRegistry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerdRegistry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerdRegistry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upstream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Upstream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Server = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hosts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hosts = append(m.Hosts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthSecretName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AuthSecretName = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowInsecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.AllowInsecure = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ControlPlane) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -33242,6 +38398,197 @@ func (m *ControlPlaneAutoscaling) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ControlPlaneResourceConsumption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ControlPlaneResourceConsumption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ControlPlaneResourceConsumption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubeAPIServerCPU", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KubeAPIServerCPU == nil {
+				m.KubeAPIServerCPU = &resource.Quantity{}
+			}
+			if err := m.KubeAPIServerCPU.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubeAPIServerMemory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KubeAPIServerMemory == nil {
+				m.KubeAPIServerMemory = &resource.Quantity{}
+			}
+			if err := m.KubeAPIServerMemory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ETCDStorageSize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ETCDStorageSize == nil {
+				m.ETCDStorageSize = &resource.Quantity{}
+			}
+			if err := m.ETCDStorageSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ControllerDeployment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -34843,6 +40190,160 @@ func (m *ControllerResource) Unmarshal(dAtA []byte) error {
 			}
 			m.ClusterCompatibility = append(m.ClusterCompatibility, ClusterType(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addon", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Addon == nil {
+				m.Addon = &ControllerResourceAddon{}
+			}
+			if err := m.Addon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ControllerResourceAddon) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ControllerResourceAddon: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ControllerResourceAddon: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schema == nil {
+				m.Schema = &runtime.RawExtension{}
+			}
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -35395,91 +40896,9 @@ func (m *DNS) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DNSIncludeExclude) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DNSIncludeExclude: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DNSIncludeExclude: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Include = append(m.Include, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagementDomain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35507,7 +40926,8 @@ func (m *DNSIncludeExclude) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Exclude = append(m.Exclude, string(dAtA[iNdEx:postIndex]))
+			s := string(dAtA[iNdEx:postIndex])
+			m.ManagementDomain = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -35530,7 +40950,7 @@ func (m *DNSIncludeExclude) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DNSProvider) Unmarshal(dAtA []byte) error {
+func (m *DNSIncludeExclude) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35553,17 +40973,17 @@ func (m *DNSProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DNSProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: DNSIncludeExclude: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DNSProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DNSIncludeExclude: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35573,52 +40993,166 @@ func (m *DNSProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Domains == nil {
-				m.Domains = &DNSIncludeExclude{}
-			}
-			if err := m.Domains.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Include = append(m.Include, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Primary", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.Primary = &b
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exclude = append(m.Exclude, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DNSProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DNSProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DNSProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Domains == nil {
+				m.Domains = &DNSIncludeExclude{}
+			}
+			if err := m.Domains.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Primary", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Primary = &b
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36641,6 +42175,42 @@ func (m *EncryptionAtRest) Unmarshal(dAtA []byte) error {
 			}
 			m.Resources = append(m.Resources, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationProgress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MigrationProgress == nil {
+				m.MigrationProgress = &EncryptionAtRestMigrationProgress{}
+			}
+			if err := m.MigrationProgress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -36662,7 +42232,7 @@ func (m *EncryptionAtRest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EncryptionConfig) Unmarshal(dAtA []byte) error {
+func (m *EncryptionAtRestMigrationProgress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36685,17 +42255,17 @@ func (m *EncryptionConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EncryptionConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: EncryptionAtRestMigrationProgress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EncryptionConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EncryptionAtRestMigrationProgress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratedResourceCount", wireType)
 			}
-			var stringLen uint64
+			m.MigratedResourceCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36705,24 +42275,30 @@ func (m *EncryptionConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MigratedResourceCount |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingResourceCount", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.RemainingResourceCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingResourceCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Resources = append(m.Resources, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -36744,7 +42320,7 @@ func (m *EncryptionConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
+func (m *EncryptionConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36767,15 +42343,15 @@ func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExpirableVersion: wiretype end group for non-group")
+			return fmt.Errorf("proto: EncryptionConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExpirableVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EncryptionConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36803,11 +42379,11 @@ func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Resources = append(m.Resources, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KMS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36834,16 +42410,134 @@ func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpirationDate == nil {
-				m.ExpirationDate = &v11.Time{}
+			if m.KMS == nil {
+				m.KMS = &KMSEncryptionConfiguration{}
 			}
-			if err := m.ExpirationDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.KMS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpirableVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpirableVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Classification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationDate == nil {
+				m.ExpirationDate = &v11.Time{}
+			}
+			if err := m.ExpirationDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Classification", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38382,6 +44076,39 @@ func (m *Hibernation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := HibernationMode(dAtA[iNdEx:postIndex])
+			m.Mode = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -39771,7 +45498,7 @@ func (m *InternalSecretList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
+func (m *JWTAuthenticator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39794,15 +45521,15 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KubeAPIServerConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: JWTAuthenticator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KubeAPIServerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JWTAuthenticator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39829,13 +45556,13 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KubernetesConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Issuer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdmissionPlugins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimMappings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39862,14 +45589,100 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AdmissionPlugins = append(m.AdmissionPlugins, AdmissionPlugin{})
-			if err := m.AdmissionPlugins[len(m.AdmissionPlugins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ClaimMappings == nil {
+				m.ClaimMappings = &AuthenticationClaimMappings{}
+			}
+			if err := m.ClaimMappings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIAudiences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimValidationRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimValidationRules = append(m.ClaimValidationRules, AuthenticationClaimValidationRule{})
+			if err := m.ClaimValidationRules[len(m.ClaimValidationRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KMSEncryptionConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KMSEncryptionConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KMSEncryptionConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39897,13 +45710,13 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.APIAudiences = append(m.APIAudiences, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuditConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39913,31 +45726,60 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AuditConfig == nil {
-				m.AuditConfig = &AuditConfig{}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
 			}
-			if err := m.AuditConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.SecretName = &s
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OIDCConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39964,16 +45806,237 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OIDCConfig == nil {
-				m.OIDCConfig = &OIDCConfig{}
+			if m.Timeout == nil {
+				m.Timeout = &v11.Duration{}
 			}
-			if err := m.OIDCConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KubeAPIServerConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KubeAPIServerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KubernetesConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdmissionPlugins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdmissionPlugins = append(m.AdmissionPlugins, AdmissionPlugin{})
+			if err := m.AdmissionPlugins[len(m.AdmissionPlugins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIAudiences", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIAudiences = append(m.APIAudiences, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuditConfig == nil {
+				m.AuditConfig = &AuditConfig{}
+			}
+			if err := m.AuditConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OIDCConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OIDCConfig == nil {
+				m.OIDCConfig = &OIDCConfig{}
+			}
+			if err := m.OIDCConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40471,6 +46534,42 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestBasedAutoscaling", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RequestBasedAutoscaling == nil {
+				m.RequestBasedAutoscaling = &KubeAPIServerRequestBasedAutoscaling{}
+			}
+			if err := m.RequestBasedAutoscaling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -40492,7 +46591,7 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KubeControllerManagerConfig) Unmarshal(dAtA []byte) error {
+func (m *KubeAPIServerRequestBasedAutoscaling) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40515,17 +46614,17 @@ func (m *KubeControllerManagerConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KubeControllerManagerConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: KubeAPIServerRequestBasedAutoscaling: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KubeControllerManagerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KubeAPIServerRequestBasedAutoscaling: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesConfig", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageRequestsPerSecond", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40535,64 +46634,15 @@ func (m *KubeControllerManagerConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.KubernetesConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.AverageRequestsPerSecond = &v
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HorizontalPodAutoscalerConfig", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.HorizontalPodAutoscalerConfig == nil {
-				m.HorizontalPodAutoscalerConfig = &HorizontalPodAutoscalerConfig{}
-			}
-			if err := m.HorizontalPodAutoscalerConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeCIDRMaskSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageInflightRequests", wireType)
 			}
 			var v int32
 			for shift := uint(0); ; shift += 7 {
@@ -40609,79 +46659,364 @@ func (m *KubeControllerManagerConfig) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.NodeCIDRMaskSize = &v
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodEvictionTimeout", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PodEvictionTimeout == nil {
-				m.PodEvictionTimeout = &v11.Duration{}
-			}
-			if err := m.PodEvictionTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeMonitorGracePeriod", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.NodeMonitorGracePeriod == nil {
-				m.NodeMonitorGracePeriod = &v11.Duration{}
-			}
-			if err := m.NodeMonitorGracePeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.AverageInflightRequests = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KubeControllerManagerConcurrentSyncs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KubeControllerManagerConcurrentSyncs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KubeControllerManagerConcurrentSyncs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deployment", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deployment = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaSet", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReplicaSet = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatefulSet", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StatefulSet = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KubeControllerManagerConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KubeControllerManagerConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KubeControllerManagerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KubernetesConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HorizontalPodAutoscalerConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HorizontalPodAutoscalerConfig == nil {
+				m.HorizontalPodAutoscalerConfig = &HorizontalPodAutoscalerConfig{}
+			}
+			if err := m.HorizontalPodAutoscalerConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeCIDRMaskSize", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NodeCIDRMaskSize = &v
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodEvictionTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PodEvictionTimeout == nil {
+				m.PodEvictionTimeout = &v11.Duration{}
+			}
+			if err := m.PodEvictionTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeMonitorGracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeMonitorGracePeriod == nil {
+				m.NodeMonitorGracePeriod = &v11.Duration{}
+			}
+			if err := m.NodeMonitorGracePeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrentSyncs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConcurrentSyncs == nil {
+				m.ConcurrentSyncs = &KubeControllerManagerConcurrentSyncs{}
+			}
+			if err := m.ConcurrentSyncs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -44873,6 +51208,221 @@ func (m *MachineImageFlavor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MachineImageUpgradePath) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MachineImageUpgradePath: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MachineImageUpgradePath: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerPool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Next", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Next = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationDate == nil {
+				m.ExpirationDate = &v11.Time{}
+			}
+			if err := m.ExpirationDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MachineImageVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -46038,61 +52588,11 @@ func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
 			}
 			m.End = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ManualWorkerPoolRollout) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ManualWorkerPoolRollout: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManualWorkerPoolRollout: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingWorkersRollouts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46102,25 +52602,24 @@ func (m *ManualWorkerPoolRollout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingWorkersRollouts = append(m.PendingWorkersRollouts, PendingWorkersRollout{})
-			if err := m.PendingWorkersRollouts[len(m.PendingWorkersRollouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Location = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -46143,7 +52642,7 @@ func (m *ManualWorkerPoolRollout) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemorySwapConfiguration) Unmarshal(dAtA []byte) error {
+func (m *ManagedAddon) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -46166,15 +52665,15 @@ func (m *MemorySwapConfiguration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemorySwapConfiguration: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManagedAddon: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemorySwapConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManagedAddon: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapBehavior", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46202,8 +52701,43 @@ func (m *MemorySwapConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := SwapBehavior(dAtA[iNdEx:postIndex])
-			m.SwapBehavior = &s
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &runtime.RawExtension{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -46226,7 +52760,7 @@ func (m *MemorySwapConfiguration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Monitoring) Unmarshal(dAtA []byte) error {
+func (m *ManualWorkerPoolRollout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -46249,15 +52783,15 @@ func (m *Monitoring) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Monitoring: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManualWorkerPoolRollout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Monitoring: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManualWorkerPoolRollout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alerting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingWorkersRollouts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -46284,10 +52818,8 @@ func (m *Monitoring) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Alerting == nil {
-				m.Alerting = &Alerting{}
-			}
-			if err := m.Alerting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingWorkersRollouts = append(m.PendingWorkersRollouts, PendingWorkersRollout{})
+			if err := m.PendingWorkersRollouts[len(m.PendingWorkersRollouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -46312,7 +52844,7 @@ func (m *Monitoring) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NamedResourceReference) Unmarshal(dAtA []byte) error {
+func (m *MemorySwapConfiguration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -46335,15 +52867,15 @@ func (m *NamedResourceReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NamedResourceReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemorySwapConfiguration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamedResourceReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemorySwapConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapBehavior", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46371,11 +52903,62 @@ func (m *NamedResourceReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			s := SwapBehavior(dAtA[iNdEx:postIndex])
+			m.SwapBehavior = &s
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Monitoring) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Monitoring: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Monitoring: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Alerting", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -46402,7 +52985,10 @@ func (m *NamedResourceReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ResourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Alerting == nil {
+				m.Alerting = &Alerting{}
+			}
+			if err := m.Alerting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -46427,7 +53013,7 @@ func (m *NamedResourceReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NamespacedCloudProfile) Unmarshal(dAtA []byte) error {
+func (m *NamedResourceReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -46450,17 +53036,17 @@ func (m *NamespacedCloudProfile) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NamespacedCloudProfile: wiretype end group for non-group")
+			return fmt.Errorf("proto: NamedResourceReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamespacedCloudProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NamedResourceReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46470,61 +53056,27 @@ func (m *NamespacedCloudProfile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -46551,7 +53103,7 @@ func (m *NamespacedCloudProfile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -46576,7 +53128,7 @@ func (m *NamespacedCloudProfile) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NamespacedCloudProfileList) Unmarshal(dAtA []byte) error {
+func (m *NamespacedCloudProfile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -46599,15 +53151,15 @@ func (m *NamespacedCloudProfileList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NamespacedCloudProfileList: wiretype end group for non-group")
+			return fmt.Errorf("proto: NamespacedCloudProfile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamespacedCloudProfileList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NamespacedCloudProfile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -46634,13 +53186,13 @@ func (m *NamespacedCloudProfileList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -46667,8 +53219,40 @@ func (m *NamespacedCloudProfileList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, NamespacedCloudProfile{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -46693,7 +53277,7 @@ func (m *NamespacedCloudProfileList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NamespacedCloudProfileSpec) Unmarshal(dAtA []byte) error {
+func (m *NamespacedCloudProfileList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -46716,17 +53300,17 @@ func (m *NamespacedCloudProfileSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NamespacedCloudProfileSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: NamespacedCloudProfileList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamespacedCloudProfileSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NamespacedCloudProfileList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CABundle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46736,28 +53320,145 @@ func (m *NamespacedCloudProfileSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.CABundle = &s
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kubernetes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, NamespacedCloudProfile{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespacedCloudProfileSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespacedCloudProfileSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespacedCloudProfileSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CABundle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.CABundle = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kubernetes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -47528,6 +54229,40 @@ func (m *NetworkingStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.EgressCIDRs = append(m.EgressCIDRs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EgressCIDRsByZone", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EgressCIDRsByZone = append(m.EgressCIDRsByZone, ZoneEgressCIDRs{})
+			if err := m.EgressCIDRsByZone[len(m.EgressCIDRsByZone)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -50141,6 +56876,42 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsageSummary", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UsageSummary == nil {
+				m.UsageSummary = &ProjectUsageSummary{}
+			}
+			if err := m.UsageSummary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -50280,7 +57051,7 @@ func (m *ProjectTolerations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Provider) Unmarshal(dAtA []byte) error {
+func (m *ProjectUsageSummary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -50303,17 +57074,74 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Provider: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectUsageSummary: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Provider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectUsageSummary: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shoots", wireType)
+			}
+			m.Shoots = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shoots |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HibernatedShoots", wireType)
+			}
+			m.HibernatedShoots = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HibernatedShoots |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			m.Nodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nodes |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CPU", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50323,27 +57151,28 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			if err := m.CPU.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ControlPlaneConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -50370,16 +57199,164 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ControlPlaneConfig == nil {
-				m.ControlPlaneConfig = &runtime.RawExtension{}
-			}
-			if err := m.ControlPlaneConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Memory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InfrastructureConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Provider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Provider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Provider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControlPlaneConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ControlPlaneConfig == nil {
+				m.ControlPlaneConfig = &runtime.RawExtension{}
+			}
+			if err := m.ControlPlaneConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfrastructureConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -51246,7 +58223,7 @@ func (m *Region) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceData) Unmarshal(dAtA []byte) error {
+func (m *RegistryMirror) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -51269,17 +58246,17 @@ func (m *ResourceData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceData: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegistryMirror: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegistryMirror: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CrossVersionObjectReference", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Upstream", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -51289,111 +58266,27 @@ func (m *ResourceData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CrossVersionObjectReference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Upstream = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceWatchCacheSize) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceWatchCacheSize: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceWatchCacheSize: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIGroup", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hosts", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -51421,12 +58314,11 @@ func (m *ResourceWatchCacheSize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.APIGroup = &s
+			m.Hosts = append(m.Hosts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -51454,97 +58346,8 @@ func (m *ResourceWatchCacheSize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resource = string(dAtA[iNdEx:postIndex])
+			m.Regions = append(m.Regions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacheSize", wireType)
-			}
-			m.CacheSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CacheSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SSHAccess) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SSHAccess: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SSHAccess: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -51566,7 +58369,7 @@ func (m *SSHAccess) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SecretBinding) Unmarshal(dAtA []byte) error {
+func (m *ResourceBackupAddon) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -51589,15 +58392,15 @@ func (m *SecretBinding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SecretBinding: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceBackupAddon: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SecretBinding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceBackupAddon: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addon", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -51624,15 +58427,15 @@ func (m *SecretBinding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Addon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -51642,28 +58445,28 @@ func (m *SecretBinding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Schedule = &s
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quotas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -51690,16 +58493,18 @@ func (m *SecretBinding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Quotas = append(m.Quotas, v1.ObjectReference{})
-			if err := m.Quotas[len(m.Quotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.RetentionPeriod == nil {
+				m.RetentionPeriod = &v11.Duration{}
+			}
+			if err := m.RetentionPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludedNamespaces", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -51709,27 +58514,23 @@ func (m *SecretBinding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Provider == nil {
-				m.Provider = &SecretBindingProvider{}
-			}
-			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IncludedNamespaces = append(m.IncludedNamespaces, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -51752,7 +58553,7 @@ func (m *SecretBinding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SecretBindingList) Unmarshal(dAtA []byte) error {
+func (m *ResourceData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -51775,15 +58576,15 @@ func (m *SecretBindingList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SecretBindingList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SecretBindingList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossVersionObjectReference", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -51810,13 +58611,13 @@ func (m *SecretBindingList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CrossVersionObjectReference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -51843,8 +58644,7 @@ func (m *SecretBindingList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, SecretBinding{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -51869,7 +58669,7 @@ func (m *SecretBindingList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SecretBindingProvider) Unmarshal(dAtA []byte) error {
+func (m *ResourceWatchCacheSize) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -51892,15 +58692,15 @@ func (m *SecretBindingProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SecretBindingProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceWatchCacheSize: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SecretBindingProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceWatchCacheSize: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIGroup", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -51928,8 +58728,60 @@ func (m *SecretBindingProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.APIGroup = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resource = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheSize", wireType)
+			}
+			m.CacheSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CacheSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -51951,7 +58803,7 @@ func (m *SecretBindingProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Seed) Unmarshal(dAtA []byte) error {
+func (m *SSHAccess) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -51974,17 +58826,17 @@ func (m *Seed) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Seed: wiretype end group for non-group")
+			return fmt.Errorf("proto: SSHAccess: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Seed: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SSHAccess: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -51994,28 +58846,65 @@ func (m *Seed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SecretBinding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			iNdEx = postIndex
-		case 2:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecretBinding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecretBinding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -52042,13 +58931,13 @@ func (m *Seed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -52075,63 +58964,13 @@ func (m *Seed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SeedDNS) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SeedDNS: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SeedDNS: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quotas", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -52158,16 +58997,14 @@ func (m *SeedDNS) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Provider == nil {
-				m.Provider = &SeedDNSProvider{}
-			}
-			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Quotas = append(m.Quotas, v1.ObjectReference{})
+			if err := m.Quotas[len(m.Quotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Internal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -52194,16 +59031,16 @@ func (m *SeedDNS) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Internal == nil {
-				m.Internal = &SeedDNSProviderConfig{}
+			if m.Provider == nil {
+				m.Provider = &SecretBindingProvider{}
 			}
-			if err := m.Internal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Defaults", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -52230,8 +59067,7 @@ func (m *SeedDNS) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Defaults = append(m.Defaults, SeedDNSProviderConfig{})
-			if err := m.Defaults[len(m.Defaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -52256,7 +59092,7 @@ func (m *SeedDNS) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SeedDNSProvider) Unmarshal(dAtA []byte) error {
+func (m *SecretBindingList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -52279,17 +59115,17 @@ func (m *SeedDNSProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SeedDNSProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretBindingList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SeedDNSProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretBindingList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -52299,27 +59135,28 @@ func (m *SeedDNSProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -52346,7 +59183,8 @@ func (m *SeedDNSProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, SecretBinding{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -52371,7 +59209,7 @@ func (m *SeedDNSProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SeedDNSProviderConfig) Unmarshal(dAtA []byte) error {
+func (m *SecretBindingProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -52394,10 +59232,10 @@ func (m *SeedDNSProviderConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SeedDNSProviderConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretBindingProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SeedDNSProviderConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretBindingProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -52432,74 +59270,59 @@ func (m *SeedDNSProviderConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SecretBindingStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Zone = &s
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecretBindingStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecretBindingStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialsRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -52526,7 +59349,8 @@ func (m *SeedDNSProviderConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CredentialsRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -52551,7 +59375,7 @@ func (m *SeedDNSProviderConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SeedList) Unmarshal(dAtA []byte) error {
+func (m *Seed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -52574,15 +59398,48 @@ func (m *SeedList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SeedList: wiretype end group for non-group")
+			return fmt.Errorf("proto: Seed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SeedList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Seed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -52609,13 +59466,13 @@ func (m *SeedList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -52642,8 +59499,7 @@ func (m *SeedList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Seed{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -52668,7 +59524,7 @@ func (m *SeedList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SeedNetworks) Unmarshal(dAtA []byte) error {
+func (m *SeedAffinity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -52691,17 +59547,17 @@ func (m *SeedNetworks) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SeedNetworks: wiretype end group for non-group")
+			return fmt.Errorf("proto: SeedAffinity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SeedNetworks: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SeedAffinity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredDuringScheduling", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -52711,30 +59567,31 @@ func (m *SeedNetworks) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Nodes = &s
+			m.RequiredDuringScheduling = append(m.RequiredDuringScheduling, v11.LabelSelector{})
+			if err := m.RequiredDuringScheduling[len(m.RequiredDuringScheduling)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreferredDuringScheduling", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -52744,29 +59601,81 @@ func (m *SeedNetworks) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pods = string(dAtA[iNdEx:postIndex])
+			m.PreferredDuringScheduling = append(m.PreferredDuringScheduling, WeightedSeedAffinityTerm{})
+			if err := m.PreferredDuringScheduling[len(m.PreferredDuringScheduling)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SeedAvailability) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeedAvailability: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeedAvailability: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeWindows", wireType)
+			}
+			m.DowntimeWindows = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -52776,29 +59685,16 @@ func (m *SeedNetworks) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DowntimeWindows |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Services = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShootDefaults", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedDowntimeSeconds", wireType)
 			}
-			var msglen int
+			m.AccumulatedDowntimeSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -52808,33 +59704,16 @@ func (m *SeedNetworks) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.AccumulatedDowntimeSeconds |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ShootDefaults == nil {
-				m.ShootDefaults = &ShootNetworks{}
-			}
-			if err := m.ShootDefaults.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockCIDRs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDowntimeBegin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -52844,29 +59723,33 @@ func (m *SeedNetworks) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockCIDRs = append(m.BlockCIDRs, string(dAtA[iNdEx:postIndex]))
+			if m.LastDowntimeBegin == nil {
+				m.LastDowntimeBegin = &v11.Time{}
+			}
+			if err := m.LastDowntimeBegin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IPFamilies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDowntimeEnd", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -52876,23 +59759,27 @@ func (m *SeedNetworks) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IPFamilies = append(m.IPFamilies, IPFamily(dAtA[iNdEx:postIndex]))
+			if m.LastDowntimeEnd == nil {
+				m.LastDowntimeEnd = &v11.Time{}
+			}
+			if err := m.LastDowntimeEnd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -52915,7 +59802,7 @@ func (m *SeedNetworks) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SeedProvider) Unmarshal(dAtA []byte) error {
+func (m *SeedDNS) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -52938,47 +59825,15 @@ func (m *SeedProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SeedProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: SeedDNS: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SeedProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SeedDNS: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -53005,18 +59860,18 @@ func (m *SeedProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProviderConfig == nil {
-				m.ProviderConfig = &runtime.RawExtension{}
+			if m.Provider == nil {
+				m.Provider = &SeedDNSProvider{}
 			}
-			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Internal", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53026,29 +59881,33 @@ func (m *SeedProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
+			if m.Internal == nil {
+				m.Internal = &SeedDNSProviderConfig{}
+			}
+			if err := m.Internal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Defaults", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53058,23 +59917,25 @@ func (m *SeedProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Zones = append(m.Zones, string(dAtA[iNdEx:postIndex]))
+			m.Defaults = append(m.Defaults, SeedDNSProviderConfig{})
+			if err := m.Defaults[len(m.Defaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -53097,7 +59958,7 @@ func (m *SeedProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SeedSelector) Unmarshal(dAtA []byte) error {
+func (m *SeedDNSProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -53120,17 +59981,17 @@ func (m *SeedSelector) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SeedSelector: wiretype end group for non-group")
+			return fmt.Errorf("proto: SeedDNSProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SeedSelector: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SeedDNSProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53140,30 +60001,29 @@ func (m *SeedSelector) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53173,23 +60033,24 @@ func (m *SeedSelector) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderTypes = append(m.ProviderTypes, string(dAtA[iNdEx:postIndex]))
+			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -53212,6 +60073,917 @@ func (m *SeedSelector) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SeedDNSProviderConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeedDNSProviderConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeedDNSProviderConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Zone = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialsRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CredentialsRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SeedList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeedList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeedList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Seed{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SeedNetworks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeedNetworks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeedNetworks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Nodes = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pods", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pods = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Services = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShootDefaults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ShootDefaults == nil {
+				m.ShootDefaults = &ShootNetworks{}
+			}
+			if err := m.ShootDefaults.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCIDRs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockCIDRs = append(m.BlockCIDRs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IPFamilies", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IPFamilies = append(m.IPFamilies, IPFamily(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SeedProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeedProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeedProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProviderConfig == nil {
+				m.ProviderConfig = &runtime.RawExtension{}
+			}
+			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zones = append(m.Zones, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SeedSelector) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeedSelector: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeedSelector: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderTypes = append(m.ProviderTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SeedSettingDNSCache) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeedSettingDNSCache: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeedSettingDNSCache: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SeedSettingDependencyWatchdog) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -53235,15 +61007,157 @@ func (m *SeedSettingDependencyWatchdog) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SeedSettingDependencyWatchdog: wiretype end group for non-group")
+			return fmt.Errorf("proto: SeedSettingDependencyWatchdog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeedSettingDependencyWatchdog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weeder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Weeder == nil {
+				m.Weeder = &SeedSettingDependencyWatchdogWeeder{}
+			}
+			if err := m.Weeder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prober", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Prober == nil {
+				m.Prober = &SeedSettingDependencyWatchdogProber{}
+			}
+			if err := m.Prober.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SeedSettingDependencyWatchdogProber) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeedSettingDependencyWatchdogProber: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SeedSettingDependencyWatchdog: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SeedSettingDependencyWatchdogProber: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weeder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProbeInterval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -53270,16 +61184,86 @@ func (m *SeedSettingDependencyWatchdog) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Weeder == nil {
-				m.Weeder = &SeedSettingDependencyWatchdogWeeder{}
+			if m.ProbeInterval == nil {
+				m.ProbeInterval = &v11.Duration{}
 			}
-			if err := m.Weeder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProbeInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SeedSettingDependencyWatchdogWeeder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeedSettingDependencyWatchdogWeeder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeedSettingDependencyWatchdogWeeder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prober", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -53306,10 +61290,10 @@ func (m *SeedSettingDependencyWatchdog) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Prober == nil {
-				m.Prober = &SeedSettingDependencyWatchdogProber{}
+			if m.WatchDuration == nil {
+				m.WatchDuration = &v11.Duration{}
 			}
-			if err := m.Prober.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WatchDuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -53334,7 +61318,7 @@ func (m *SeedSettingDependencyWatchdog) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SeedSettingDependencyWatchdogProber) Unmarshal(dAtA []byte) error {
+func (m *SeedSettingExcessCapacityReservation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -53357,10 +61341,10 @@ func (m *SeedSettingDependencyWatchdogProber) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SeedSettingDependencyWatchdogProber: wiretype end group for non-group")
+			return fmt.Errorf("proto: SeedSettingExcessCapacityReservation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SeedSettingDependencyWatchdogProber: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SeedSettingExcessCapacityReservation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -53382,7 +61366,42 @@ func (m *SeedSettingDependencyWatchdogProber) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
+			b := bool(v != 0)
+			m.Enabled = &b
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Configs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Configs = append(m.Configs, SeedSettingExcessCapacityReservationConfig{})
+			if err := m.Configs[len(m.Configs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -53404,7 +61423,7 @@ func (m *SeedSettingDependencyWatchdogProber) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SeedSettingDependencyWatchdogWeeder) Unmarshal(dAtA []byte) error {
+func (m *SeedSettingExcessCapacityReservationConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -53427,17 +61446,307 @@ func (m *SeedSettingDependencyWatchdogWeeder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SeedSettingDependencyWatchdogWeeder: wiretype end group for non-group")
+			return fmt.Errorf("proto: SeedSettingExcessCapacityReservationConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SeedSettingDependencyWatchdogWeeder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SeedSettingExcessCapacityReservationConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resources == nil {
+				m.Resources = make(k8s_io_api_core_v1.ResourceList)
+			}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Resources[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NodeSelector[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tolerations = append(m.Tolerations, v1.Toleration{})
+			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
 			}
-			var v int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53447,12 +61756,12 @@ func (m *SeedSettingDependencyWatchdogWeeder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
+			m.Percentage = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -53474,7 +61783,7 @@ func (m *SeedSettingDependencyWatchdogWeeder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SeedSettingExcessCapacityReservation) Unmarshal(dAtA []byte) error {
+func (m *SeedSettingIstioIngressGateway) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -53497,17 +61806,17 @@ func (m *SeedSettingExcessCapacityReservation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SeedSettingExcessCapacityReservation: wiretype end group for non-group")
+			return fmt.Errorf("proto: SeedSettingIstioIngressGateway: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SeedSettingExcessCapacityReservation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SeedSettingIstioIngressGateway: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Autoscaling", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53517,16 +61826,31 @@ func (m *SeedSettingExcessCapacityReservation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Enabled = &b
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Autoscaling == nil {
+				m.Autoscaling = &SeedSettingIstioIngressGatewayAutoscaling{}
+			}
+			if err := m.Autoscaling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Configs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExposureClassHandlers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -53553,8 +61877,8 @@ func (m *SeedSettingExcessCapacityReservation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Configs = append(m.Configs, SeedSettingExcessCapacityReservationConfig{})
-			if err := m.Configs[len(m.Configs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ExposureClassHandlers = append(m.ExposureClassHandlers, SeedSettingIstioIngressGatewayExposureClassHandler{})
+			if err := m.ExposureClassHandlers[len(m.ExposureClassHandlers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -53579,7 +61903,7 @@ func (m *SeedSettingExcessCapacityReservation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SeedSettingExcessCapacityReservationConfig) Unmarshal(dAtA []byte) error {
+func (m *SeedSettingIstioIngressGatewayAutoscaling) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -53602,15 +61926,55 @@ func (m *SeedSettingExcessCapacityReservationConfig) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SeedSettingExcessCapacityReservationConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: SeedSettingIstioIngressGatewayAutoscaling: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SeedSettingExcessCapacityReservationConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SeedSettingIstioIngressGatewayAutoscaling: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReplicas", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MinReplicas = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReplicas", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxReplicas = &v
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -53637,8 +62001,8 @@ func (m *SeedSettingExcessCapacityReservationConfig) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resources == nil {
-				m.Resources = make(k8s_io_api_core_v1.ResourceList)
+			if m.Requests == nil {
+				m.Requests = make(k8s_io_api_core_v1.ResourceList)
 			}
 			var mapkey k8s_io_api_core_v1.ResourceName
 			mapvalue := &resource.Quantity{}
@@ -53735,13 +62099,63 @@ func (m *SeedSettingExcessCapacityReservationConfig) Unmarshal(dAtA []byte) erro
 					iNdEx += skippy
 				}
 			}
-			m.Resources[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			m.Requests[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SeedSettingIstioIngressGatewayExposureClassHandler) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeedSettingIstioIngressGatewayExposureClassHandler: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeedSettingIstioIngressGatewayExposureClassHandler: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53751,122 +62165,27 @@ func (m *SeedSettingExcessCapacityReservationConfig) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeSelector == nil {
-				m.NodeSelector = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.NodeSelector[mapkey] = mapvalue
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Autoscaling", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -53893,8 +62212,10 @@ func (m *SeedSettingExcessCapacityReservationConfig) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tolerations = append(m.Tolerations, v1.Toleration{})
-			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Autoscaling == nil {
+				m.Autoscaling = &SeedSettingIstioIngressGatewayAutoscaling{}
+			}
+			if err := m.Autoscaling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -55316,6 +63637,78 @@ func (m *SeedSettings) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IstioIngressGateway", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IstioIngressGateway == nil {
+				m.IstioIngressGateway = &SeedSettingIstioIngressGateway{}
+			}
+			if err := m.IstioIngressGateway.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DNSCache", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DNSCache == nil {
+				m.DNSCache = &SeedSettingDNSCache{}
+			}
+			if err := m.DNSCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -56280,6 +64673,42 @@ func (m *SeedStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Availability", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Availability == nil {
+				m.Availability = &SeedAvailability{}
+			}
+			if err := m.Availability.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -57555,210 +65984,246 @@ func (m *ShootCredentials) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EncryptionAtRest == nil {
-				m.EncryptionAtRest = &EncryptionAtRest{}
-			}
-			if err := m.EncryptionAtRest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ShootCredentialsRotation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ShootCredentialsRotation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShootCredentialsRotation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertificateAuthorities", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CertificateAuthorities == nil {
-				m.CertificateAuthorities = &CARotation{}
-			}
-			if err := m.CertificateAuthorities.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SSHKeypair", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SSHKeypair == nil {
-				m.SSHKeypair = &ShootSSHKeypairRotation{}
-			}
-			if err := m.SSHKeypair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Observability", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Observability == nil {
-				m.Observability = &ObservabilityRotation{}
-			}
-			if err := m.Observability.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountKey", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ServiceAccountKey == nil {
-				m.ServiceAccountKey = &ServiceAccountKeyRotation{}
+			if m.EncryptionAtRest == nil {
+				m.EncryptionAtRest = &EncryptionAtRest{}
+			}
+			if err := m.EncryptionAtRest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShootCredentialsRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShootCredentialsRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShootCredentialsRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertificateAuthorities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CertificateAuthorities == nil {
+				m.CertificateAuthorities = &CARotation{}
+			}
+			if err := m.CertificateAuthorities.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHKeypair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SSHKeypair == nil {
+				m.SSHKeypair = &ShootSSHKeypairRotation{}
+			}
+			if err := m.SSHKeypair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Observability", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Observability == nil {
+				m.Observability = &ObservabilityRotation{}
+			}
+			if err := m.Observability.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ServiceAccountKey == nil {
+				m.ServiceAccountKey = &ServiceAccountKeyRotation{}
+			}
+			if err := m.ServiceAccountKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ETCDEncryptionKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ETCDEncryptionKey == nil {
+				m.ETCDEncryptionKey = &ETCDEncryptionKeyRotation{}
 			}
-			if err := m.ServiceAccountKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ETCDEncryptionKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ETCDEncryptionKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CloudCredentials", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -57785,10 +66250,10 @@ func (m *ShootCredentialsRotation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ETCDEncryptionKey == nil {
-				m.ETCDEncryptionKey = &ETCDEncryptionKeyRotation{}
+			if m.CloudCredentials == nil {
+				m.CloudCredentials = &CloudCredentialsRotation{}
 			}
-			if err := m.ETCDEncryptionKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CloudCredentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -59295,6 +67760,76 @@ func (m *ShootSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeedAffinity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SeedAffinity == nil {
+				m.SeedAffinity = &SeedAffinity{}
+			}
+			if err := m.SeedAffinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedAddons", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManagedAddons = append(m.ManagedAddons, ManagedAddon{})
+			if err := m.ManagedAddons[len(m.ManagedAddons)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -60424,6 +68959,112 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceConsumption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourceConsumption == nil {
+				m.ResourceConsumption = &ControlPlaneResourceConsumption{}
+			}
+			if err := m.ResourceConsumption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerPools = append(m.WorkerPools, WorkerPoolStatus{})
+			if err := m.WorkerPools[len(m.WorkerPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradePaths", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpgradePaths == nil {
+				m.UpgradePaths = &ShootUpgradePaths{}
+			}
+			if err := m.UpgradePaths.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -60561,6 +69202,156 @@ func (m *ShootTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ShootUpgradePaths) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShootUpgradePaths: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShootUpgradePaths: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kubernetes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Kubernetes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineImages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MachineImages = append(m.MachineImages, MachineImageUpgradePath{})
+			if err := m.MachineImages[len(m.MachineImages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *StructuredAuthentication) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -60622,6 +69413,40 @@ func (m *StructuredAuthentication) Unmarshal(dAtA []byte) error {
 			}
 			m.ConfigMapName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JWTAuthenticators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JWTAuthenticators = append(m.JWTAuthenticators, JWTAuthenticator{})
+			if err := m.JWTAuthenticators[len(m.JWTAuthenticators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -60996,6 +69821,190 @@ func (m *Toleration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VersionUpgradePath) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersionUpgradePath: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersionUpgradePath: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPatch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.NextPatch = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextMinor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.NextMinor = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationDate == nil {
+				m.ExpirationDate = &v11.Time{}
+			}
+			if err := m.ExpirationDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *VerticalPodAutoscaler) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -62118,6 +71127,108 @@ func (m *WatchCacheSizes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WeightedSeedAffinityTerm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedSeedAffinityTerm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedSeedAffinityTerm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Worker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -63210,6 +72321,39 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeWorkloadIdentityName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.NodeWorkloadIdentityName = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -63436,6 +72580,200 @@ func (m *WorkerKubernetes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WorkerPoolStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkerPoolStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkerPoolStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredMachines", wireType)
+			}
+			m.DesiredMachines = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DesiredMachines |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentMachines", wireType)
+			}
+			m.CurrentMachines = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentMachines |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedMachines", wireType)
+			}
+			m.UpdatedMachines = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedMachines |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnavailableMachines", wireType)
+			}
+			m.UnavailableMachines = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnavailableMachines |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRolloutTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastRolloutTime == nil {
+				m.LastRolloutTime = &v11.Time{}
+			}
+			if err := m.LastRolloutTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *WorkerSystemComponents) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -63592,6 +72930,120 @@ func (m *WorkersSettings) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ZoneEgressCIDRs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ZoneEgressCIDRs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ZoneEgressCIDRs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CIDRs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CIDRs = append(m.CIDRs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenerated(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,8 +5,11 @@
 package helper
 
 import (
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -83,11 +86,57 @@ func SeedSettingDependencyWatchdogProberEnabled(settings *gardencorev1beta1.Seed
 	return settings == nil || settings.DependencyWatchdog == nil || settings.DependencyWatchdog.Prober == nil || settings.DependencyWatchdog.Prober.Enabled
 }
 
+// SeedSettingDependencyWatchdogWeederWatchDuration returns the watch duration configured for the
+// dependency-watchdog-weeder, or the given default if none is configured.
+func SeedSettingDependencyWatchdogWeederWatchDuration(settings *gardencorev1beta1.SeedSettings, defaultDuration time.Duration) *metav1.Duration {
+	if settings != nil && settings.DependencyWatchdog != nil && settings.DependencyWatchdog.Weeder != nil && settings.DependencyWatchdog.Weeder.WatchDuration != nil {
+		return settings.DependencyWatchdog.Weeder.WatchDuration
+	}
+	return &metav1.Duration{Duration: defaultDuration}
+}
+
+// SeedSettingDependencyWatchdogProberProbeInterval returns the probe interval configured for the
+// dependency-watchdog-prober, or the given default if none is configured.
+func SeedSettingDependencyWatchdogProberProbeInterval(settings *gardencorev1beta1.SeedSettings, defaultInterval time.Duration) *metav1.Duration {
+	if settings != nil && settings.DependencyWatchdog != nil && settings.DependencyWatchdog.Prober != nil && settings.DependencyWatchdog.Prober.ProbeInterval != nil {
+		return settings.DependencyWatchdog.Prober.ProbeInterval
+	}
+	return &metav1.Duration{Duration: defaultInterval}
+}
+
+// SeedSettingIstioIngressGatewayAutoscaling returns the autoscaling configuration for the default istio ingress
+// gateway of the seed, or nil if none is configured.
+func SeedSettingIstioIngressGatewayAutoscaling(settings *gardencorev1beta1.SeedSettings) *gardencorev1beta1.SeedSettingIstioIngressGatewayAutoscaling {
+	if settings == nil || settings.IstioIngressGateway == nil {
+		return nil
+	}
+	return settings.IstioIngressGateway.Autoscaling
+}
+
+// SeedSettingIstioIngressGatewayAutoscalingForExposureClassHandler returns the autoscaling configuration for the istio
+// ingress gateway of the exposure class handler with the given name, or nil if none is configured.
+func SeedSettingIstioIngressGatewayAutoscalingForExposureClassHandler(settings *gardencorev1beta1.SeedSettings, name string) *gardencorev1beta1.SeedSettingIstioIngressGatewayAutoscaling {
+	if settings == nil || settings.IstioIngressGateway == nil {
+		return nil
+	}
+	for _, handler := range settings.IstioIngressGateway.ExposureClassHandlers {
+		if handler.Name == name {
+			return handler.Autoscaling
+		}
+	}
+	return nil
+}
+
 // SeedSettingTopologyAwareRoutingEnabled returns true if the topology-aware routing is enabled.
 func SeedSettingTopologyAwareRoutingEnabled(settings *gardencorev1beta1.SeedSettings) bool {
 	return settings != nil && settings.TopologyAwareRouting != nil && settings.TopologyAwareRouting.Enabled
 }
 
+// SeedSettingDNSCacheEnabled returns true if the DNS cache is enabled.
+func SeedSettingDNSCacheEnabled(settings *gardencorev1beta1.SeedSettings) bool {
+	return settings != nil && settings.DNSCache != nil && settings.DNSCache.Enabled
+}
+
 // SeedSettingZonalIngressEnabled returns true if zonal ingress is enabled for the seed.
 func SeedSettingZonalIngressEnabled(settings *gardencorev1beta1.SeedSettings) bool {
 	if settings == nil || settings.LoadBalancerServices == nil || settings.LoadBalancerServices.ZonalIngress == nil {
@@ -6,12 +6,14 @@ package helper_test
 
 import (
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	gomegatypes "github.com/onsi/gomega/types"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -190,6 +192,30 @@ var _ = Describe("Helper", func() {
 		Entry("dwd prober disabled", &gardencorev1beta1.SeedSettings{DependencyWatchdog: &gardencorev1beta1.SeedSettingDependencyWatchdog{Prober: &gardencorev1beta1.SeedSettingDependencyWatchdogProber{Enabled: false}}}, false),
 	)
 
+	DescribeTable("#SeedSettingDependencyWatchdogWeederWatchDuration",
+		func(settings *gardencorev1beta1.SeedSettings, expected *metav1.Duration) {
+			Expect(SeedSettingDependencyWatchdogWeederWatchDuration(settings, 5*time.Minute)).To(Equal(expected))
+		},
+
+		Entry("no settings", nil, &metav1.Duration{Duration: 5 * time.Minute}),
+		Entry("no dwd setting", &gardencorev1beta1.SeedSettings{}, &metav1.Duration{Duration: 5 * time.Minute}),
+		Entry("no dwd weeder setting", &gardencorev1beta1.SeedSettings{DependencyWatchdog: &gardencorev1beta1.SeedSettingDependencyWatchdog{}}, &metav1.Duration{Duration: 5 * time.Minute}),
+		Entry("no dwd weeder watch duration setting", &gardencorev1beta1.SeedSettings{DependencyWatchdog: &gardencorev1beta1.SeedSettingDependencyWatchdog{Weeder: &gardencorev1beta1.SeedSettingDependencyWatchdogWeeder{Enabled: true}}}, &metav1.Duration{Duration: 5 * time.Minute}),
+		Entry("dwd weeder watch duration set", &gardencorev1beta1.SeedSettings{DependencyWatchdog: &gardencorev1beta1.SeedSettingDependencyWatchdog{Weeder: &gardencorev1beta1.SeedSettingDependencyWatchdogWeeder{Enabled: true, WatchDuration: &metav1.Duration{Duration: 2 * time.Minute}}}}, &metav1.Duration{Duration: 2 * time.Minute}),
+	)
+
+	DescribeTable("#SeedSettingDependencyWatchdogProberProbeInterval",
+		func(settings *gardencorev1beta1.SeedSettings, expected *metav1.Duration) {
+			Expect(SeedSettingDependencyWatchdogProberProbeInterval(settings, 10*time.Second)).To(Equal(expected))
+		},
+
+		Entry("no settings", nil, &metav1.Duration{Duration: 10 * time.Second}),
+		Entry("no dwd setting", &gardencorev1beta1.SeedSettings{}, &metav1.Duration{Duration: 10 * time.Second}),
+		Entry("no dwd prober setting", &gardencorev1beta1.SeedSettings{DependencyWatchdog: &gardencorev1beta1.SeedSettingDependencyWatchdog{}}, &metav1.Duration{Duration: 10 * time.Second}),
+		Entry("no dwd prober probe interval setting", &gardencorev1beta1.SeedSettings{DependencyWatchdog: &gardencorev1beta1.SeedSettingDependencyWatchdog{Prober: &gardencorev1beta1.SeedSettingDependencyWatchdogProber{Enabled: true}}}, &metav1.Duration{Duration: 10 * time.Second}),
+		Entry("dwd prober probe interval set", &gardencorev1beta1.SeedSettings{DependencyWatchdog: &gardencorev1beta1.SeedSettingDependencyWatchdog{Prober: &gardencorev1beta1.SeedSettingDependencyWatchdogProber{Enabled: true, ProbeInterval: &metav1.Duration{Duration: 30 * time.Second}}}}, &metav1.Duration{Duration: 30 * time.Second}),
+	)
+
 	DescribeTable("#SeedSettingTopologyAwareRoutingEnabled",
 		func(settings *gardencorev1beta1.SeedSettings, expected bool) {
 			Expect(SeedSettingTopologyAwareRoutingEnabled(settings)).To(Equal(expected))
@@ -201,6 +227,17 @@ var _ = Describe("Helper", func() {
 		Entry("topology-aware routing disabled", &gardencorev1beta1.SeedSettings{TopologyAwareRouting: &gardencorev1beta1.SeedSettingTopologyAwareRouting{Enabled: false}}, false),
 	)
 
+	DescribeTable("#SeedSettingDNSCacheEnabled",
+		func(settings *gardencorev1beta1.SeedSettings, expected bool) {
+			Expect(SeedSettingDNSCacheEnabled(settings)).To(Equal(expected))
+		},
+
+		Entry("no settings", nil, false),
+		Entry("no DNS cache setting", &gardencorev1beta1.SeedSettings{}, false),
+		Entry("DNS cache enabled", &gardencorev1beta1.SeedSettings{DNSCache: &gardencorev1beta1.SeedSettingDNSCache{Enabled: true}}, true),
+		Entry("DNS cache disabled", &gardencorev1beta1.SeedSettings{DNSCache: &gardencorev1beta1.SeedSettingDNSCache{Enabled: false}}, false),
+	)
+
 	DescribeTable("#SeedSettingZonalIngressEnabled",
 		func(settings *gardencorev1beta1.SeedSettings, expectation bool) {
 			Expect(SeedSettingZonalIngressEnabled(settings)).To(Equal(expectation))
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"slices"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	corev1 "k8s.io/api/core/v1"
@@ -27,6 +29,22 @@ func HibernationIsEnabled(shoot *gardencorev1beta1.Shoot) bool {
 	return shoot.Spec.Hibernation != nil && shoot.Spec.Hibernation.Enabled != nil && *shoot.Spec.Hibernation.Enabled
 }
 
+// ShootHibernationMode returns the hibernation mode of the given shoot. If no mode is set, HibernationModeFull is
+// returned.
+func ShootHibernationMode(shoot *gardencorev1beta1.Shoot) gardencorev1beta1.HibernationMode {
+	if shoot.Spec.Hibernation != nil && shoot.Spec.Hibernation.Mode != nil {
+		return *shoot.Spec.Hibernation.Mode
+	}
+	return gardencorev1beta1.HibernationModeFull
+}
+
+// ControlPlaneHibernationIsEnabled checks if the given shoot's desired state is hibernated including its control
+// plane. Only hibernation mode 'Full' scales down the control plane - the other modes keep it running so that the API
+// server remains reachable.
+func ControlPlaneHibernationIsEnabled(shoot *gardencorev1beta1.Shoot) bool {
+	return HibernationIsEnabled(shoot) && ShootHibernationMode(shoot) == gardencorev1beta1.HibernationModeFull
+}
+
 // ShootWantsClusterAutoscaler checks if the given Shoot needs a cluster autoscaler.
 // This is determined by checking whether one of the Shoot workers has a different
 // Maximum than Minimum.
@@ -78,6 +96,23 @@ func ShootNeedsForceDeletion(shoot *gardencorev1beta1.Shoot) bool {
 	return forceDelete
 }
 
+// ShootBreakGlassModeActive returns true if the "break glass" maintenance mode of the given shoot is active at the
+// given point in time, i.e. if the respective annotation contains a timestamp which has not yet passed. Unparseable
+// timestamps are considered as inactive.
+func ShootBreakGlassModeActive(shoot *gardencorev1beta1.Shoot, now time.Time) bool {
+	value, ok := shoot.Annotations[v1beta1constants.ShootBreakGlassUntil]
+	if !ok {
+		return false
+	}
+
+	until, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return false
+	}
+
+	return now.Before(until)
+}
+
 // ShootSchedulingProfile returns the scheduling profile of the given Shoot.
 func ShootSchedulingProfile(shoot *gardencorev1beta1.Shoot) *gardencorev1beta1.SchedulingProfile {
 	if shoot.Spec.Kubernetes.KubeScheduler != nil {
@@ -192,6 +227,41 @@ func IsShootSSHKeypairRotationInitiationTimeAfterLastCompletionTime(credentials
 		credentials.Rotation.SSHKeypair.LastCompletionTime.Before(credentials.Rotation.SSHKeypair.LastInitiationTime)
 }
 
+// MutateShootCloudCredentialsRotation mutates the .status.credentials.rotation.cloudCredentials field based on the
+// provided mutation function. If the field is nil then it is initialized.
+func MutateShootCloudCredentialsRotation(shoot *gardencorev1beta1.Shoot, f func(*gardencorev1beta1.CloudCredentialsRotation)) {
+	if f == nil {
+		return
+	}
+
+	if shoot.Status.Credentials == nil {
+		shoot.Status.Credentials = &gardencorev1beta1.ShootCredentials{}
+	}
+	if shoot.Status.Credentials.Rotation == nil {
+		shoot.Status.Credentials.Rotation = &gardencorev1beta1.ShootCredentialsRotation{}
+	}
+	if shoot.Status.Credentials.Rotation.CloudCredentials == nil {
+		shoot.Status.Credentials.Rotation.CloudCredentials = &gardencorev1beta1.CloudCredentialsRotation{}
+	}
+
+	f(shoot.Status.Credentials.Rotation.CloudCredentials)
+}
+
+// IsShootCloudCredentialsRotationInitiationTimeAfterLastCompletionTime returns true when the lastInitiationTime in the
+// .status.credentials.rotation.cloudCredentials field is newer than the lastCompletionTime. This is also true if the
+// lastCompletionTime is unset.
+func IsShootCloudCredentialsRotationInitiationTimeAfterLastCompletionTime(credentials *gardencorev1beta1.ShootCredentials) bool {
+	if credentials == nil ||
+		credentials.Rotation == nil ||
+		credentials.Rotation.CloudCredentials == nil ||
+		credentials.Rotation.CloudCredentials.LastInitiationTime == nil {
+		return false
+	}
+
+	return credentials.Rotation.CloudCredentials.LastCompletionTime == nil ||
+		credentials.Rotation.CloudCredentials.LastCompletionTime.Before(credentials.Rotation.CloudCredentials.LastInitiationTime)
+}
+
 // MutateObservabilityRotation mutates the .status.credentials.rotation.observability field based on the provided
 // mutation function. If the field is nil then it is initialized.
 func MutateObservabilityRotation(shoot *gardencorev1beta1.Shoot, f func(*gardencorev1beta1.ObservabilityRotation)) {
@@ -445,6 +515,12 @@ func HasManagedIssuer(shoot *gardencorev1beta1.Shoot) bool {
 	return shoot.GetAnnotations()[v1beta1constants.AnnotationAuthenticationIssuer] == v1beta1constants.AnnotationAuthenticationIssuerManaged
 }
 
+// IsShootMonitoringDisabled returns true when the given shoot carries the annotation for disabling the monitoring
+// stack. The annotation is managed by the ShootPurposePolicy admission plugin based on the shoot's custom purpose.
+func IsShootMonitoringDisabled(shoot *gardencorev1beta1.Shoot) bool {
+	return shoot.Annotations[v1beta1constants.AnnotationShootDisableMonitoring] == "true"
+}
+
 // GetPurpose returns the purpose of the shoot or 'evaluation' if it's nil.
 func GetPurpose(s *gardencorev1beta1.Shoot) gardencorev1beta1.ShootPurpose {
 	if v := s.Spec.Purpose; v != nil {
@@ -474,6 +550,11 @@ func NginxIngressEnabled(addons *gardencorev1beta1.Addons) bool {
 	return addons != nil && addons.NginxIngress != nil && addons.NginxIngress.Enabled
 }
 
+// ResourceBackupEnabled returns true if the resource-backup addon is enabled in the Shoot manifest.
+func ResourceBackupEnabled(addons *gardencorev1beta1.Addons) bool {
+	return addons != nil && addons.ResourceBackup != nil && addons.ResourceBackup.Enabled
+}
+
 // KubeProxyEnabled returns true if the kube-proxy is enabled in the Shoot manifest.
 func KubeProxyEnabled(config *gardencorev1beta1.KubeProxyConfig) bool {
 	return config != nil && config.Enabled != nil && *config.Enabled
@@ -776,3 +857,33 @@ func RemoveOperation(operations []string, operationsToRemove ...string) []string
 		return slices.Contains(operationsToRemove, operation)
 	})
 }
+
+// ShootOperation is the structured representation of an operation requested via the gardener.cloud/operation
+// annotation. Operations may carry parameters using the syntax `<name>=<parameter1>,<parameter2>,...`
+// (e.g. `rollout-workers=pool-1,pool-2`).
+type ShootOperation struct {
+	// Name is the name of the operation, e.g. `rollout-workers`.
+	Name string
+	// Parameters is the optional list of parameters of the operation.
+	Parameters []string
+}
+
+// ParseShootOperation parses the given operation string into its structured representation.
+func ParseShootOperation(operation string) ShootOperation {
+	name, parameters, found := strings.Cut(operation, "=")
+
+	parsedOperation := ShootOperation{Name: name}
+	if found {
+		parsedOperation.Parameters = strings.Split(parameters, ",")
+	}
+
+	return parsedOperation
+}
+
+// String returns the string representation of the operation as expected in the gardener.cloud/operation annotation.
+func (o ShootOperation) String() string {
+	if len(o.Parameters) == 0 {
+		return o.Name
+	}
+	return o.Name + "=" + strings.Join(o.Parameters, ",")
+}
@@ -47,6 +47,55 @@ var _ = Describe("Helper", func() {
 		}, true),
 	)
 
+	DescribeTable("#ShootHibernationMode",
+		func(shoot *gardencorev1beta1.Shoot, mode gardencorev1beta1.HibernationMode) {
+			Expect(ShootHibernationMode(shoot)).To(Equal(mode))
+		},
+		Entry("no hibernation section", &gardencorev1beta1.Shoot{}, gardencorev1beta1.HibernationModeFull),
+		Entry("no mode", &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Hibernation: &gardencorev1beta1.Hibernation{},
+			},
+		}, gardencorev1beta1.HibernationModeFull),
+		Entry("mode = WorkersOnly", &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Hibernation: &gardencorev1beta1.Hibernation{Mode: ptr.To(gardencorev1beta1.HibernationModeWorkersOnly)},
+			},
+		}, gardencorev1beta1.HibernationModeWorkersOnly),
+	)
+
+	DescribeTable("#ControlPlaneHibernationIsEnabled",
+		func(shoot *gardencorev1beta1.Shoot, hibernated bool) {
+			Expect(ControlPlaneHibernationIsEnabled(shoot)).To(Equal(hibernated))
+		},
+		Entry("no hibernation section", &gardencorev1beta1.Shoot{}, false),
+		Entry("hibernation.enabled = true without mode", &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Hibernation: &gardencorev1beta1.Hibernation{Enabled: &trueVar},
+			},
+		}, true),
+		Entry("hibernation.enabled = true with mode = Full", &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Hibernation: &gardencorev1beta1.Hibernation{Enabled: &trueVar, Mode: ptr.To(gardencorev1beta1.HibernationModeFull)},
+			},
+		}, true),
+		Entry("hibernation.enabled = true with mode = WorkersOnly", &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Hibernation: &gardencorev1beta1.Hibernation{Enabled: &trueVar, Mode: ptr.To(gardencorev1beta1.HibernationModeWorkersOnly)},
+			},
+		}, false),
+		Entry("hibernation.enabled = true with mode = ControlPlaneMinimized", &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Hibernation: &gardencorev1beta1.Hibernation{Enabled: &trueVar, Mode: ptr.To(gardencorev1beta1.HibernationModeControlPlaneMinimized)},
+			},
+		}, false),
+		Entry("hibernation.enabled = false with mode = Full", &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Hibernation: &gardencorev1beta1.Hibernation{Enabled: &falseVar, Mode: ptr.To(gardencorev1beta1.HibernationModeFull)},
+			},
+		}, false),
+	)
+
 	DescribeTable("#ShootWantsClusterAutoscaler",
 		func(shoot *gardencorev1beta1.Shoot, wantsAutoscaler bool) {
 			actualWantsAutoscaler, err := ShootWantsClusterAutoscaler(shoot)
@@ -203,6 +252,27 @@ var _ = Describe("Helper", func() {
 			BeTrue()),
 	)
 
+	var now = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	DescribeTable("#ShootBreakGlassModeActive",
+		func(shoot *gardencorev1beta1.Shoot, match gomegatypes.GomegaMatcher) {
+			Expect(ShootBreakGlassModeActive(shoot, now)).To(match)
+		},
+
+		Entry("no break-glass annotation present",
+			&gardencorev1beta1.Shoot{},
+			BeFalse()),
+		Entry("break-glass annotation present but value is not a timestamp",
+			&gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{v1beta1constants.ShootBreakGlassUntil: "foo"}}},
+			BeFalse()),
+		Entry("break-glass annotation present but timestamp has passed",
+			&gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{v1beta1constants.ShootBreakGlassUntil: now.Add(-time.Hour).Format(time.RFC3339)}}},
+			BeFalse()),
+		Entry("break-glass annotation present and timestamp is in the future",
+			&gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{v1beta1constants.ShootBreakGlassUntil: now.Add(time.Hour).Format(time.RFC3339)}}},
+			BeTrue()),
+	)
+
 	var profile = gardencorev1beta1.SchedulingProfileBinPacking
 
 	DescribeTable("#ShootSchedulingProfile",
@@ -422,6 +492,43 @@ var _ = Describe("Helper", func() {
 		Entry("lastCompletionTime after lastInitiationTime", &gardencorev1beta1.ShootCredentials{Rotation: &gardencorev1beta1.ShootCredentialsRotation{SSHKeypair: &gardencorev1beta1.ShootSSHKeypairRotation{LastInitiationTime: &metav1.Time{Time: metav1.Now().Time}, LastCompletionTime: &metav1.Time{Time: metav1.Now().Add(time.Minute)}}}}, BeFalse()),
 	)
 
+	Describe("#MutateShootCloudCredentialsRotation", func() {
+		It("should do nothing when mutate function is nil", func() {
+			shoot := &gardencorev1beta1.Shoot{}
+			MutateShootCloudCredentialsRotation(shoot, nil)
+			Expect(shoot.Status.Credentials).To(BeNil())
+		})
+
+		DescribeTable("mutate function not nil",
+			func(shoot *gardencorev1beta1.Shoot, lastInitiationTime metav1.Time) {
+				MutateShootCloudCredentialsRotation(shoot, func(rotation *gardencorev1beta1.CloudCredentialsRotation) {
+					rotation.LastInitiationTime = &lastInitiationTime
+				})
+				Expect(shoot.Status.Credentials.Rotation.CloudCredentials.LastInitiationTime).To(PointTo(Equal(lastInitiationTime)))
+			},
+
+			Entry("credentials nil", &gardencorev1beta1.Shoot{}, metav1.Now()),
+			Entry("rotation nil", &gardencorev1beta1.Shoot{Status: gardencorev1beta1.ShootStatus{Credentials: &gardencorev1beta1.ShootCredentials{}}}, metav1.Now()),
+			Entry("cloudCredentials nil", &gardencorev1beta1.Shoot{Status: gardencorev1beta1.ShootStatus{Credentials: &gardencorev1beta1.ShootCredentials{Rotation: &gardencorev1beta1.ShootCredentialsRotation{}}}}, metav1.Now()),
+			Entry("cloudCredentials non-nil", &gardencorev1beta1.Shoot{Status: gardencorev1beta1.ShootStatus{Credentials: &gardencorev1beta1.ShootCredentials{Rotation: &gardencorev1beta1.ShootCredentialsRotation{CloudCredentials: &gardencorev1beta1.CloudCredentialsRotation{}}}}}, metav1.Now()),
+		)
+	})
+
+	DescribeTable("#IsShootCloudCredentialsRotationInitiationTimeAfterLastCompletionTime",
+		func(credentials *gardencorev1beta1.ShootCredentials, matcher gomegatypes.GomegaMatcher) {
+			Expect(IsShootCloudCredentialsRotationInitiationTimeAfterLastCompletionTime(credentials)).To(matcher)
+		},
+
+		Entry("credentials nil", nil, BeFalse()),
+		Entry("rotation nil", &gardencorev1beta1.ShootCredentials{}, BeFalse()),
+		Entry("cloudCredentials nil", &gardencorev1beta1.ShootCredentials{Rotation: &gardencorev1beta1.ShootCredentialsRotation{}}, BeFalse()),
+		Entry("lastInitiationTime nil", &gardencorev1beta1.ShootCredentials{Rotation: &gardencorev1beta1.ShootCredentialsRotation{CloudCredentials: &gardencorev1beta1.CloudCredentialsRotation{}}}, BeFalse()),
+		Entry("lastCompletionTime nil", &gardencorev1beta1.ShootCredentials{Rotation: &gardencorev1beta1.ShootCredentialsRotation{CloudCredentials: &gardencorev1beta1.CloudCredentialsRotation{LastInitiationTime: &metav1.Time{Time: metav1.Now().Time}}}}, BeTrue()),
+		Entry("lastCompletionTime before lastInitiationTime", &gardencorev1beta1.ShootCredentials{Rotation: &gardencorev1beta1.ShootCredentialsRotation{CloudCredentials: &gardencorev1beta1.CloudCredentialsRotation{LastInitiationTime: &metav1.Time{Time: metav1.Now().Time}, LastCompletionTime: &metav1.Time{Time: metav1.Now().Add(-time.Minute)}}}}, BeTrue()),
+		Entry("lastCompletionTime equal lastInitiationTime", &gardencorev1beta1.ShootCredentials{Rotation: &gardencorev1beta1.ShootCredentialsRotation{CloudCredentials: &gardencorev1beta1.CloudCredentialsRotation{LastInitiationTime: &metav1.Time{Time: metav1.Now().Time}, LastCompletionTime: &metav1.Time{Time: metav1.Now().Time}}}}, BeFalse()),
+		Entry("lastCompletionTime after lastInitiationTime", &gardencorev1beta1.ShootCredentials{Rotation: &gardencorev1beta1.ShootCredentialsRotation{CloudCredentials: &gardencorev1beta1.CloudCredentialsRotation{LastInitiationTime: &metav1.Time{Time: metav1.Now().Time}, LastCompletionTime: &metav1.Time{Time: metav1.Now().Add(time.Minute)}}}}, BeFalse()),
+	)
+
 	Describe("#MutateObservabilityRotation", func() {
 		It("should do nothing when mutate function is nil", func() {
 			shoot := &gardencorev1beta1.Shoot{}
@@ -1733,4 +1840,48 @@ var _ = Describe("Helper", func() {
 			[]string{"rotate-ca-start", "", ""},
 			[]string{"rotate-ca-start"}),
 	)
+
+	DescribeTable("#ParseShootOperation",
+		func(operation string, expectedResult ShootOperation) {
+			Expect(ParseShootOperation(operation)).To(Equal(expectedResult))
+		},
+
+		Entry("operation without parameters",
+			"reconcile",
+			ShootOperation{Name: "reconcile"}),
+
+		Entry("operation with single parameter",
+			"rollout-workers=pool-1",
+			ShootOperation{Name: "rollout-workers", Parameters: []string{"pool-1"}}),
+
+		Entry("operation with multiple parameters",
+			"rollout-workers=pool-1,pool-2",
+			ShootOperation{Name: "rollout-workers", Parameters: []string{"pool-1", "pool-2"}}),
+
+		Entry("operation with empty parameters",
+			"rollout-workers=",
+			ShootOperation{Name: "rollout-workers", Parameters: []string{""}}),
+
+		Entry("empty operation",
+			"",
+			ShootOperation{}),
+	)
+
+	DescribeTable("#ShootOperation.String",
+		func(operation ShootOperation, expectedResult string) {
+			Expect(operation.String()).To(Equal(expectedResult))
+		},
+
+		Entry("operation without parameters",
+			ShootOperation{Name: "reconcile"},
+			"reconcile"),
+
+		Entry("operation with single parameter",
+			ShootOperation{Name: "rollout-workers", Parameters: []string{"pool-1"}},
+			"rollout-workers=pool-1"),
+
+		Entry("operation with multiple parameters",
+			ShootOperation{Name: "rollout-workers", Parameters: []string{"pool-1", "pool-2"}},
+			"rollout-workers=pool-1,pool-2"),
+	)
 })
@@ -95,6 +95,24 @@ type CloudProfileSpec struct {
 	// During maintenance upgrades, the image that matches most capabilities will be selected.
 	// +optional
 	MachineCapabilities []CapabilityDefinition `json:"machineCapabilities,omitempty" protobuf:"bytes,12,rep,name=machineCapabilities"`
+	// RegistryMirrors contains declarations of registry mirrors / alternate image locations that shoot worker nodes
+	// targeting this profile should use when pulling container images, optionally restricted to certain regions.
+	// +patchMergeKey=upstream
+	// +patchStrategy=merge
+	// +optional
+	RegistryMirrors []RegistryMirror `json:"registryMirrors,omitempty" patchStrategy:"merge" patchMergeKey:"upstream" protobuf:"bytes,13,rep,name=registryMirrors"`
+}
+
+// RegistryMirror declares a mirror / alternate image location for an upstream container registry.
+type RegistryMirror struct {
+	// Upstream is the host (and optionally port) of the upstream container registry that is mirrored.
+	Upstream string `json:"upstream" protobuf:"bytes,1,opt,name=upstream"`
+	// Hosts are the URLs of the mirror hosts to be used for the upstream, in the order in which they should be tried.
+	Hosts []string `json:"hosts" protobuf:"bytes,2,rep,name=hosts"`
+	// Regions restricts this mirror declaration to shoots in the given regions.
+	// If empty, the mirror applies to all regions.
+	// +optional
+	Regions []string `json:"regions,omitempty" protobuf:"bytes,3,rep,name=regions"`
 }
 
 // SeedSelector contains constraints for selecting seed to be usable for shoots using a profile
@@ -297,6 +315,7 @@ const (
 )
 
 // VersionClassification is the logical state of a version.
+// +enum
 type VersionClassification string
 
 // IsActive returns whether the version can be used.
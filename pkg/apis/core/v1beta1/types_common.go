@@ -162,6 +162,7 @@ type FailureTolerance struct {
 
 // FailureToleranceType specifies the type of failure that a highly available
 // shoot control plane that can tolerate.
+// +enum
 type FailureToleranceType string
 
 const (
@@ -6,6 +6,7 @@ package v1beta1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // +genclient
@@ -97,6 +98,20 @@ type ControllerResource struct {
 	// This field can only be set for resources of kind "Extension".
 	// +optional
 	ClusterCompatibility []ClusterType `json:"clusterCompatibility,omitempty" protobuf:"bytes,9,rep,name=clusterCompatibility,casttype=ClusterType"`
+	// Addon describes the shoot addon backed by this resource. If set, users can enable the addon in their Shoot
+	// spec via `.spec.managedAddons` using the addon's name.
+	// This field can only be set for resources of kind "Extension".
+	// +optional
+	Addon *ControllerResourceAddon `json:"addon,omitempty" protobuf:"bytes,10,opt,name=addon"`
+}
+
+// ControllerResourceAddon describes a shoot addon backed by a ControllerResource of kind "Extension".
+type ControllerResourceAddon struct {
+	// Name is the name under which the addon can be enabled in the Shoot spec.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Schema is an optional JSON schema describing the configuration options of the addon.
+	// +optional
+	Schema *runtime.RawExtension `json:"schema,omitempty" protobuf:"bytes,2,opt,name=schema"`
 }
 
 // DeploymentRef contains information about `ControllerDeployment` references.
@@ -6,6 +6,7 @@ package v1beta1
 
 import (
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -98,6 +99,29 @@ type ProjectStatus struct {
 	// LastActivityTimestamp contains the timestamp from the last activity performed in this project.
 	// +optional
 	LastActivityTimestamp *metav1.Time `json:"lastActivityTimestamp,omitempty" protobuf:"bytes,5,opt,name=lastActivityTimestamp"`
+	// UsageSummary aggregates the counts and capacity consumption of the shoots in this project. It is maintained by
+	// the "project-usage" controller of the gardener-controller-manager.
+	// +optional
+	UsageSummary *ProjectUsageSummary `json:"usageSummary,omitempty" protobuf:"bytes,6,opt,name=usageSummary"`
+}
+
+// ProjectUsageSummary aggregates the counts and capacity consumption of the shoots in a project.
+type ProjectUsageSummary struct {
+	// Shoots is the total number of shoots in the project.
+	Shoots int32 `json:"shoots" protobuf:"varint,1,opt,name=shoots"`
+	// HibernatedShoots is the number of shoots in the project which are hibernated.
+	HibernatedShoots int32 `json:"hibernatedShoots" protobuf:"varint,2,opt,name=hibernatedShoots"`
+	// Nodes is the maximum number of nodes the worker pools of the non-hibernated shoots in the project may scale up
+	// to.
+	Nodes int32 `json:"nodes" protobuf:"varint,3,opt,name=nodes"`
+	// CPU is the CPU capacity the worker pools of the non-hibernated shoots in the project may consume at their
+	// maximum size.
+	CPU resource.Quantity `json:"cpu" protobuf:"bytes,4,opt,name=cpu"`
+	// Memory is the memory capacity the worker pools of the non-hibernated shoots in the project may consume at their
+	// maximum size.
+	Memory resource.Quantity `json:"memory" protobuf:"bytes,5,opt,name=memory"`
+	// LastUpdateTime is the time when the usage summary was last updated.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,6,opt,name=lastUpdateTime"`
 }
 
 // ProjectMember is a member of a project.
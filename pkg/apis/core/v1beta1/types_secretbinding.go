@@ -32,8 +32,25 @@ type SecretBinding struct {
 	// This field is immutable.
 	// +optional
 	Provider *SecretBindingProvider `json:"provider,omitempty" protobuf:"bytes,4,opt,name=provider"`
+	// Status contains the latest observed status of the SecretBinding.
+	// +optional
+	Status SecretBindingStatus `json:"status,omitempty" protobuf:"bytes,5,opt,name=status"`
+}
+
+// SecretBindingStatus contains the latest observed status of a SecretBinding.
+type SecretBindingStatus struct {
+	// Conditions represents the latest available observations of the referenced credentials' validity.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
 }
 
+const (
+	// SecretBindingCredentialsValid is a constant for a condition type indicating that the referenced credentials are valid.
+	SecretBindingCredentialsValid ConditionType = "CredentialsValid"
+)
+
 // SecretBindingProvider defines the provider type of the SecretBinding.
 //
 // Deprecated: Use CredentialsBindingProvider instead. See https://github.com/gardener/gardener/blob/master/docs/usage/shoot-operations/secretbinding-to-credentialsbinding-migration.md for migration instructions.
@@ -125,6 +125,28 @@ type SeedStatus struct {
 	// LastOperation holds information about the last operation on the Seed.
 	// +optional
 	LastOperation *LastOperation `json:"lastOperation,omitempty" protobuf:"bytes,9,opt,name=lastOperation"`
+	// Availability contains a compact availability history of the seed as observed via the heartbeat Lease of its
+	// gardenlet.
+	// +optional
+	Availability *SeedAvailability `json:"availability,omitempty" protobuf:"bytes,10,opt,name=availability"`
+}
+
+// SeedAvailability contains a compact availability history of a seed.
+type SeedAvailability struct {
+	// DowntimeWindows is the number of downtime windows observed for the seed, i.e. how often its gardenlet stopped
+	// renewing the heartbeat Lease in time.
+	// +optional
+	DowntimeWindows int32 `json:"downtimeWindows,omitempty" protobuf:"varint,1,opt,name=downtimeWindows"`
+	// AccumulatedDowntimeSeconds is the total duration in seconds of all closed downtime windows.
+	// +optional
+	AccumulatedDowntimeSeconds int64 `json:"accumulatedDowntimeSeconds,omitempty" protobuf:"varint,2,opt,name=accumulatedDowntimeSeconds"`
+	// LastDowntimeBegin is the time when the most recent downtime window began.
+	// +optional
+	LastDowntimeBegin *metav1.Time `json:"lastDowntimeBegin,omitempty" protobuf:"bytes,3,opt,name=lastDowntimeBegin"`
+	// LastDowntimeEnd is the time when the most recent downtime window ended. It is not set while a downtime window
+	// is still open.
+	// +optional
+	LastDowntimeEnd *metav1.Time `json:"lastDowntimeEnd,omitempty" protobuf:"bytes,4,opt,name=lastDowntimeEnd"`
 }
 
 // Backup contains the object store configuration for backups for shoot (currently only etcd).
@@ -293,6 +315,14 @@ type SeedSettings struct {
 	// See https://github.com/gardener/gardener/blob/master/docs/operations/topology_aware_routing.md.
 	// +optional
 	TopologyAwareRouting *SeedSettingTopologyAwareRouting `json:"topologyAwareRouting,omitempty" protobuf:"bytes,8,opt,name=topologyAwareRouting"`
+	// IstioIngressGateway controls the autoscaling and resource configuration of the istio ingress gateways deployed
+	// in the seed.
+	// +optional
+	IstioIngressGateway *SeedSettingIstioIngressGateway `json:"istioIngressGateway,omitempty" protobuf:"bytes,9,opt,name=istioIngressGateway"`
+	// DNSCache controls the deployment of a caching DNS resolver in the seed.
+	// See https://github.com/gardener/gardener/blob/master/docs/operations/seed_settings.md#dns-cache.
+	// +optional
+	DNSCache *SeedSettingDNSCache `json:"dnsCache,omitempty" protobuf:"bytes,10,opt,name=dnsCache"`
 }
 
 // SeedSettingExcessCapacityReservation controls the excess capacity reservation for shoot control planes in the seed.
@@ -307,14 +337,21 @@ type SeedSettingExcessCapacityReservation struct {
 
 // SeedSettingExcessCapacityReservationConfig configures excess capacity reservation deployments for shoot control planes in the seed.
 type SeedSettingExcessCapacityReservationConfig struct {
-	// Resources specify the resource requests and limits of the excess-capacity-reservation pod.
-	Resources corev1.ResourceList `json:"resources" protobuf:"bytes,1,rep,name=resources,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+	// Resources specify the resource requests and limits of the excess-capacity-reservation pod. It is mutually
+	// exclusive with percentage and exactly one of both must be set.
+	// +optional
+	Resources corev1.ResourceList `json:"resources,omitempty" protobuf:"bytes,1,rep,name=resources,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
 	// NodeSelector specifies the node where the excess-capacity-reservation pod should run.
 	// +optional
 	NodeSelector map[string]string `json:"nodeSelector,omitempty" protobuf:"bytes,2,rep,name=nodeSelector"`
 	// Tolerations specify the tolerations for the the excess-capacity-reservation pod.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,3,rep,name=tolerations"`
+	// Percentage is the percentage of the seed cluster's allocatable cpu and memory capacity that should be reserved.
+	// The reservation is split evenly across the excess-capacity-reservation pod replicas. It is mutually exclusive
+	// with resources and exactly one of both must be set.
+	// +optional
+	Percentage *int32 `json:"percentage,omitempty" protobuf:"varint,4,opt,name=percentage"`
 }
 
 // SeedSettingScheduling controls settings for scheduling decisions for the seed.
@@ -432,6 +469,10 @@ type SeedSettingDependencyWatchdogWeeder struct {
 	// helps to alleviate the delay where control plane components remain unavailable by finding the respective pods in
 	// CrashLoopBackoff status and restarting them once their dependants become ready and available again.
 	Enabled bool `json:"enabled" protobuf:"bytes,1,opt,name=enabled"`
+	// WatchDuration is the duration for which the weeder watches for dependant pods to transition to CrashLoopBackoff
+	// after the target service has recovered. Defaults to 5m.
+	// +optional
+	WatchDuration *metav1.Duration `json:"watchDuration,omitempty" protobuf:"bytes,2,opt,name=watchDuration"`
 }
 
 // SeedSettingDependencyWatchdogProber controls the prober settings for the dependency-watchdog for the seed.
@@ -440,6 +481,9 @@ type SeedSettingDependencyWatchdogProber struct {
 	// scales down the kube-controller-manager, machine-controller-manager and cluster-autoscaler of shoot clusters in case their respective kube-apiserver is not
 	// reachable via its external ingress in order to avoid melt-down situations.
 	Enabled bool `json:"enabled" protobuf:"bytes,1,opt,name=enabled"`
+	// ProbeInterval is the interval in which the prober probes the kube-apiservers of shoot clusters. Defaults to 30s.
+	// +optional
+	ProbeInterval *metav1.Duration `json:"probeInterval,omitempty" protobuf:"bytes,2,opt,name=probeInterval"`
 }
 
 // SeedSettingTopologyAwareRouting controls certain settings for topology-aware traffic routing in the seed.
@@ -450,6 +494,55 @@ type SeedSettingTopologyAwareRouting struct {
 	Enabled bool `json:"enabled" protobuf:"bytes,1,opt,name=enabled"`
 }
 
+// SeedSettingIstioIngressGateway controls the autoscaling and resource configuration of the istio ingress gateways
+// deployed in the seed.
+type SeedSettingIstioIngressGateway struct {
+	// Autoscaling overrides the default autoscaling and resource configuration of the default istio ingress gateway.
+	// The configuration applies to each of its ingress gateway deployments (including zonal ones) individually.
+	// +optional
+	Autoscaling *SeedSettingIstioIngressGatewayAutoscaling `json:"autoscaling,omitempty" protobuf:"bytes,1,opt,name=autoscaling"`
+	// ExposureClassHandlers contains overrides for the istio ingress gateways of exposure class handlers.
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +optional
+	ExposureClassHandlers []SeedSettingIstioIngressGatewayExposureClassHandler `json:"exposureClassHandlers,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,2,rep,name=exposureClassHandlers"`
+}
+
+// SeedSettingIstioIngressGatewayExposureClassHandler contains overrides for the istio ingress gateway of an exposure
+// class handler.
+type SeedSettingIstioIngressGatewayExposureClassHandler struct {
+	// Name is the name of the exposure class handler as configured in the gardenlet.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Autoscaling overrides the autoscaling and resource configuration of the istio ingress gateway of this handler.
+	// The configuration applies to each of its ingress gateway deployments (including zonal ones) individually.
+	// +optional
+	Autoscaling *SeedSettingIstioIngressGatewayAutoscaling `json:"autoscaling,omitempty" protobuf:"bytes,2,opt,name=autoscaling"`
+}
+
+// SeedSettingIstioIngressGatewayAutoscaling contains the autoscaling and resource configuration of an istio ingress
+// gateway.
+type SeedSettingIstioIngressGatewayAutoscaling struct {
+	// MinReplicas is the minimum number of istio-ingressgateway replicas.
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty" protobuf:"varint,1,opt,name=minReplicas"`
+	// MaxReplicas is the maximum number of istio-ingressgateway replicas.
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty" protobuf:"varint,2,opt,name=maxReplicas"`
+	// Requests overrides the compute resource requests of the istio-proxy container. Only the cpu and memory requests
+	// may be set.
+	// +optional
+	Requests corev1.ResourceList `json:"requests,omitempty" protobuf:"bytes,3,rep,name=requests,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+}
+
+// SeedSettingDNSCache controls the deployment of a caching DNS resolver in the seed.
+// See https://github.com/gardener/gardener/blob/master/docs/operations/seed_settings.md#dns-cache.
+type SeedSettingDNSCache struct {
+	// Enabled controls whether a caching DNS resolver shall be deployed into the garden namespace in the seed cluster.
+	// It caches responses (including negative responses) of the cluster DNS in order to reduce the sensitivity of
+	// high-frequency lookups performed by shoot control plane components to short upstream DNS outages.
+	Enabled bool `json:"enabled" protobuf:"bytes,1,opt,name=enabled"`
+}
+
 // SeedTaint describes a taint on a seed.
 type SeedTaint struct {
 	// Key is the taint key to be applied to a seed.
@@ -494,6 +587,9 @@ const (
 	SeedSystemComponentsHealthy ConditionType = "SeedSystemComponentsHealthy"
 	// SeedEmergencyStopShootReconciliations is a constant for a condition type indicating disabled shoot reconciliations.
 	SeedEmergencyStopShootReconciliations ConditionType = "EmergencyStopShootReconciliations"
+	// SeedSystemRequirementsConformant is a constant for a condition type indicating that the seed nodes conform to
+	// Gardener's documented system requirements.
+	SeedSystemRequirementsConformant ConditionType = "SystemRequirementsConformant"
 )
 
 // Resource constants for Gardener object types
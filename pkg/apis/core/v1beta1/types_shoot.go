@@ -146,6 +146,14 @@ type ShootSpec struct {
 	// AccessRestrictions describe a list of access restrictions for this shoot cluster.
 	// +optional
 	AccessRestrictions []AccessRestrictionWithOptions `json:"accessRestrictions,omitempty" protobuf:"bytes,24,rep,name=accessRestrictions"`
+	// SeedAffinity contains affinity rules which gardener-scheduler evaluates when selecting a seed for this shoot.
+	// It is only respected if no seed name is set.
+	// +optional
+	SeedAffinity *SeedAffinity `json:"seedAffinity,omitempty" protobuf:"bytes,25,opt,name=seedAffinity"`
+	// ManagedAddons contains the addons registered via `ControllerRegistration`s which shall be enabled for this shoot
+	// cluster. In contrast to the legacy `.spec.addons` field, this list is generic and backed by extensions.
+	// +optional
+	ManagedAddons []ManagedAddon `json:"managedAddons,omitempty" protobuf:"bytes,26,rep,name=managedAddons"`
 }
 
 // ShootStatus holds the most recently observed status of the Shoot cluster.
@@ -228,6 +236,18 @@ type ShootStatus struct {
 	// ManualWorkerPoolRollout contains information about the worker pool rollout progress.
 	// +optional
 	ManualWorkerPoolRollout *ManualWorkerPoolRollout `json:"manualWorkerPoolRollout,omitempty" protobuf:"bytes,21,opt,name=manualWorkerPoolRollout"`
+	// ResourceConsumption contains information about the resource consumption of the shoot's control plane.
+	// +optional
+	ResourceConsumption *ControlPlaneResourceConsumption `json:"resourceConsumption,omitempty" protobuf:"bytes,22,opt,name=resourceConsumption"`
+	// WorkerPools contains the most recently observed machine counts per worker pool.
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +optional
+	WorkerPools []WorkerPoolStatus `json:"workerPools,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,23,rep,name=workerPools"`
+	// UpgradePaths contains machine-readable upgrade path recommendations for the Shoot, computed from the referenced
+	// CloudProfile.
+	// +optional
+	UpgradePaths *ShootUpgradePaths `json:"upgradePaths,omitempty" protobuf:"bytes,24,opt,name=upgradePaths"`
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -259,6 +279,19 @@ type NetworkingStatus struct {
 	// extension controller may opt to not populate this field.
 	// +optional
 	EgressCIDRs []string `json:"egressCIDRs,omitempty" protobuf:"bytes,4,rep,name=egressCIDRs"`
+	// EgressCIDRsByZone is a list of CIDRs used by the shoot as the source IP for egress traffic, grouped by the
+	// availability zone the traffic originates from (e.g. the IPs of zonal NAT gateways) as reported by the used
+	// Infrastructure extension controller. It is only populated if the egress IPs differ per zone.
+	// +optional
+	EgressCIDRsByZone []ZoneEgressCIDRs `json:"egressCIDRsByZone,omitempty" protobuf:"bytes,5,rep,name=egressCIDRsByZone"`
+}
+
+// ZoneEgressCIDRs contains the egress CIDRs used by worker nodes in a single availability zone.
+type ZoneEgressCIDRs struct {
+	// Zone is the name of the availability zone.
+	Zone string `json:"zone" protobuf:"bytes,1,opt,name=zone"`
+	// CIDRs is a list of CIDRs used as the source IP for egress traffic originating from this zone.
+	CIDRs []string `json:"cidrs" protobuf:"bytes,2,rep,name=cidrs"`
 }
 
 // InPlaceUpdatesStatus contains information about in-place updates for the Shoot workers.
@@ -309,6 +342,9 @@ type ShootCredentialsRotation struct {
 	// ETCDEncryptionKey contains information about the ETCD encryption key credential rotation.
 	// +optional
 	ETCDEncryptionKey *ETCDEncryptionKeyRotation `json:"etcdEncryptionKey,omitempty" protobuf:"bytes,6,opt,name=etcdEncryptionKey"`
+	// CloudCredentials contains information about the rollout of rotated cloud provider credentials.
+	// +optional
+	CloudCredentials *CloudCredentialsRotation `json:"cloudCredentials,omitempty" protobuf:"bytes,7,opt,name=cloudCredentials"`
 }
 
 // EncryptionAtRest contains information about Shoot data encryption at rest.
@@ -318,6 +354,19 @@ type EncryptionAtRest struct {
 	// See https://github.com/gardener/gardener/blob/master/docs/usage/security/etcd_encryption_config.md for more details.
 	// +optional
 	Resources []string `json:"resources,omitempty" protobuf:"bytes,1,rep,name=resources"`
+	// MigrationProgress tracks the progress of rewriting existing data after resources were added to or removed from
+	// the encryption configuration. It is only present while such a storage migration is in progress.
+	// +optional
+	MigrationProgress *EncryptionAtRestMigrationProgress `json:"migrationProgress,omitempty" protobuf:"bytes,2,opt,name=migrationProgress"`
+}
+
+// EncryptionAtRestMigrationProgress tracks the progress of the storage migration which rewrites existing data after
+// the encryption configuration was changed.
+type EncryptionAtRestMigrationProgress struct {
+	// MigratedResourceCount is the number of resource kinds whose objects have already been rewritten.
+	MigratedResourceCount int32 `json:"migratedResourceCount" protobuf:"varint,1,opt,name=migratedResourceCount"`
+	// RemainingResourceCount is the number of resource kinds whose objects still have to be rewritten.
+	RemainingResourceCount int32 `json:"remainingResourceCount" protobuf:"varint,2,opt,name=remainingResourceCount"`
 }
 
 // CARotation contains information about the certificate authority credential rotation.
@@ -352,6 +401,86 @@ type ManualWorkerPoolRollout struct {
 	PendingWorkersRollouts []PendingWorkersRollout `json:"pendingWorkersRollouts,omitempty" protobuf:"bytes,1,rep,name=pendingWorkersRollouts"`
 }
 
+// ControlPlaneResourceConsumption contains information about the resource consumption of the shoot's control plane.
+type ControlPlaneResourceConsumption struct {
+	// KubeAPIServerCPU is the sum of the current CPU usage of all kube-apiserver replicas.
+	// +optional
+	KubeAPIServerCPU *resource.Quantity `json:"kubeAPIServerCPU,omitempty" protobuf:"bytes,1,opt,name=kubeAPIServerCPU"`
+	// KubeAPIServerMemory is the sum of the current memory usage of all kube-apiserver replicas.
+	// +optional
+	KubeAPIServerMemory *resource.Quantity `json:"kubeAPIServerMemory,omitempty" protobuf:"bytes,2,opt,name=kubeAPIServerMemory"`
+	// ETCDStorageSize is the storage capacity provisioned for the main etcd.
+	// +optional
+	ETCDStorageSize *resource.Quantity `json:"etcdStorageSize,omitempty" protobuf:"bytes,3,opt,name=etcdStorageSize"`
+	// LastUpdateTime is the time when the resource consumption was last measured.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,4,opt,name=lastUpdateTime"`
+}
+
+// WorkerPoolStatus holds the most recently observed machine counts of a worker pool, aggregated from the machine
+// deployments in the seed cluster.
+type WorkerPoolStatus struct {
+	// Name is the name of the worker pool.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// DesiredMachines is the number of machines the worker pool should currently have.
+	DesiredMachines int32 `json:"desiredMachines" protobuf:"varint,2,opt,name=desiredMachines"`
+	// CurrentMachines is the number of machines the worker pool currently has.
+	CurrentMachines int32 `json:"currentMachines" protobuf:"varint,3,opt,name=currentMachines"`
+	// UpdatedMachines is the number of machines which already run the desired configuration.
+	UpdatedMachines int32 `json:"updatedMachines" protobuf:"varint,4,opt,name=updatedMachines"`
+	// UnavailableMachines is the number of machines which are not yet available.
+	UnavailableMachines int32 `json:"unavailableMachines" protobuf:"varint,5,opt,name=unavailableMachines"`
+	// LastRolloutTime is the time when the worker pool rollout last progressed.
+	// +optional
+	LastRolloutTime *metav1.Time `json:"lastRolloutTime,omitempty" protobuf:"bytes,6,opt,name=lastRolloutTime"`
+}
+
+// ShootUpgradePaths holds machine-readable upgrade path recommendations for a Shoot, computed from the referenced
+// CloudProfile.
+type ShootUpgradePaths struct {
+	// Kubernetes contains the upgrade path of the shoot's Kubernetes version.
+	Kubernetes VersionUpgradePath `json:"kubernetes" protobuf:"bytes,1,opt,name=kubernetes"`
+	// MachineImages contains the upgrade paths of the worker pools' machine image versions.
+	// +patchMergeKey=workerPool
+	// +patchStrategy=merge
+	// +optional
+	MachineImages []MachineImageUpgradePath `json:"machineImages,omitempty" patchStrategy:"merge" patchMergeKey:"workerPool" protobuf:"bytes,2,rep,name=machineImages"`
+	// LastUpdateTime is the time when the upgrade paths were last computed.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,3,opt,name=lastUpdateTime"`
+}
+
+// VersionUpgradePath describes the supported upgrades and the expiration of a version.
+type VersionUpgradePath struct {
+	// Version is the current version.
+	Version string `json:"version" protobuf:"bytes,1,opt,name=version"`
+	// NextPatch is the latest supported patch version of the current minor version, if any.
+	// +optional
+	NextPatch *string `json:"nextPatch,omitempty" protobuf:"bytes,2,opt,name=nextPatch"`
+	// NextMinor is the latest supported patch version of the next higher supported minor version, if any.
+	// +optional
+	NextMinor *string `json:"nextMinor,omitempty" protobuf:"bytes,3,opt,name=nextMinor"`
+	// ExpirationDate is the deadline after which the current version is considered expired and a forceful update
+	// becomes necessary, if any.
+	// +optional
+	ExpirationDate *metav1.Time `json:"expirationDate,omitempty" protobuf:"bytes,4,opt,name=expirationDate"`
+}
+
+// MachineImageUpgradePath describes the supported upgrade of a worker pool's machine image version.
+type MachineImageUpgradePath struct {
+	// WorkerPool is the name of the worker pool.
+	WorkerPool string `json:"workerPool" protobuf:"bytes,1,opt,name=workerPool"`
+	// Image is the name of the machine image.
+	Image string `json:"image" protobuf:"bytes,2,opt,name=image"`
+	// Version is the current version of the machine image.
+	Version string `json:"version" protobuf:"bytes,3,opt,name=version"`
+	// Next is the version the machine image can be updated to according to its update strategy, if any.
+	// +optional
+	Next *string `json:"next,omitempty" protobuf:"bytes,4,opt,name=next"`
+	// ExpirationDate is the deadline after which the current version is considered expired and a forceful update
+	// becomes necessary, if any.
+	// +optional
+	ExpirationDate *metav1.Time `json:"expirationDate,omitempty" protobuf:"bytes,5,opt,name=expirationDate"`
+}
+
 // ShootKubeconfigRotation contains information about the kubeconfig credential rotation.
 type ShootKubeconfigRotation struct {
 	// LastInitiationTime is the most recent time when the kubeconfig credential rotation was initiated.
@@ -382,6 +511,17 @@ type ObservabilityRotation struct {
 	LastCompletionTime *metav1.Time `json:"lastCompletionTime,omitempty" protobuf:"bytes,2,opt,name=lastCompletionTime"`
 }
 
+// CloudCredentialsRotation contains information about the rollout of rotated cloud provider credentials.
+type CloudCredentialsRotation struct {
+	// LastInitiationTime is the most recent time when the cloud provider credential rollout was initiated.
+	// +optional
+	LastInitiationTime *metav1.Time `json:"lastInitiationTime,omitempty" protobuf:"bytes,1,opt,name=lastInitiationTime"`
+	// LastCompletionTime is the most recent time when the rotated cloud provider credentials were successfully rolled
+	// out to all components consuming them.
+	// +optional
+	LastCompletionTime *metav1.Time `json:"lastCompletionTime,omitempty" protobuf:"bytes,2,opt,name=lastCompletionTime"`
+}
+
 // ServiceAccountKeyRotation contains information about the service account key credential rotation.
 type ServiceAccountKeyRotation struct {
 	// Phase describes the phase of the service account key credential rotation.
@@ -475,6 +615,36 @@ type ShootAdvertisedAddress struct {
 	URL string `json:"url" protobuf:"bytes,2,opt,name=url"`
 }
 
+// SeedAffinity contains affinity rules which gardener-scheduler evaluates when selecting a seed for a shoot, similar
+// to pod (anti-)affinity.
+type SeedAffinity struct {
+	// RequiredDuringScheduling is a list of label selectors. At least one of them must match the labels of a seed for
+	// the seed to be considered during scheduling.
+	// +optional
+	RequiredDuringScheduling []metav1.LabelSelector `json:"requiredDuringScheduling,omitempty" protobuf:"bytes,1,rep,name=requiredDuringScheduling"`
+	// PreferredDuringScheduling is a list of weighted terms. The scheduler prefers the seeds with the highest sum of
+	// weights of the matching terms.
+	// +optional
+	PreferredDuringScheduling []WeightedSeedAffinityTerm `json:"preferredDuringScheduling,omitempty" protobuf:"bytes,2,rep,name=preferredDuringScheduling"`
+}
+
+// WeightedSeedAffinityTerm is a label selector associated with a weight.
+type WeightedSeedAffinityTerm struct {
+	// Weight associated with matching the term, in the range 1-100.
+	Weight int32 `json:"weight" protobuf:"varint,1,opt,name=weight"`
+	// LabelSelector is matched against the labels of a seed.
+	LabelSelector metav1.LabelSelector `json:"labelSelector" protobuf:"bytes,2,opt,name=labelSelector"`
+}
+
+// ManagedAddon refers to an addon registered via a ControllerRegistration which shall be enabled for a shoot cluster.
+type ManagedAddon struct {
+	// Name is the name of the addon as registered via the ControllerRegistration.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Config contains configuration for the addon. It is passed as provider config to the backing extension resource.
+	// +optional
+	Config *runtime.RawExtension `json:"config,omitempty" protobuf:"bytes,2,opt,name=config"`
+}
+
 // Addons is a collection of configuration for specific addons which are managed by the Gardener.
 type Addons struct {
 	// KubernetesDashboard holds configuration settings for the kubernetes dashboard addon.
@@ -483,6 +653,9 @@ type Addons struct {
 	// NginxIngress holds configuration settings for the nginx-ingress addon.
 	// +optional
 	NginxIngress *NginxIngress `json:"nginxIngress,omitempty" protobuf:"bytes,2,opt,name=nginxIngress"`
+	// ResourceBackup holds configuration settings for the resource-backup addon.
+	// +optional
+	ResourceBackup *ResourceBackupAddon `json:"resourceBackup,omitempty" protobuf:"bytes,3,opt,name=resourceBackup"`
 }
 
 // Addon allows enabling or disabling a specific addon and is used to derive from.
@@ -522,6 +695,26 @@ type NginxIngress struct {
 	ExternalTrafficPolicy *corev1.ServiceExternalTrafficPolicy `json:"externalTrafficPolicy,omitempty" protobuf:"bytes,4,opt,name=externalTrafficPolicy,casttype=k8s.io/api/core/v1.ServiceExternalTrafficPolicy"`
 }
 
+// ResourceBackupAddon describes configuration values for the resource-backup addon. The addon periodically exports the
+// Kubernetes resources of the shoot cluster to a prefix scoped to the shoot's backup entry in the backup bucket of the
+// seed.
+type ResourceBackupAddon struct {
+	Addon `json:",inline" protobuf:"bytes,1,opt,name=addon"`
+
+	// Schedule is a cron spec in standard format which determines when the resources are exported. Defaults to
+	// `0 */6 * * *` (every six hours).
+	// +optional
+	Schedule *string `json:"schedule,omitempty" protobuf:"bytes,2,opt,name=schedule"`
+	// RetentionPeriod is the duration for which exported resource backups are kept before they are garbage collected.
+	// Defaults to `168h` (seven days).
+	// +optional
+	RetentionPeriod *metav1.Duration `json:"retentionPeriod,omitempty" protobuf:"bytes,3,opt,name=retentionPeriod"`
+	// IncludedNamespaces is a list of namespaces whose resources are exported. If empty, the resources of all
+	// namespaces are exported.
+	// +optional
+	IncludedNamespaces []string `json:"includedNamespaces,omitempty" protobuf:"bytes,4,rep,name=includedNamespaces"`
+}
+
 // ControlPlane holds information about the general settings for the control plane of a shoot.
 type ControlPlane struct {
 	// HighAvailability holds the configuration settings for high availability of the
@@ -536,6 +729,12 @@ type DNS struct {
 	// kubeconfig that is handed out to end-users. This field is immutable.
 	// +optional
 	Domain *string `json:"domain,omitempty" protobuf:"bytes,1,opt,name=domain"`
+	// ManagementDomain is an optional domain under which the kube-apiserver is additionally exposed. The
+	// corresponding DNS record is expected to be resolvable from the internal network only (e.g., via a private
+	// DNS zone or an internal load balancer provided by the responsible provider extension), so workloads can
+	// reach the API server without traversing the public internet.
+	// +optional
+	ManagementDomain *string `json:"managementDomain,omitempty" protobuf:"bytes,3,opt,name=managementDomain"`
 	// Providers is a list of DNS providers that shall be enabled for this shoot cluster. Only relevant if
 	// not a default domain is used.
 	//
@@ -603,8 +802,26 @@ type Hibernation struct {
 	// Schedules determine the hibernation schedules.
 	// +optional
 	Schedules []HibernationSchedule `json:"schedules,omitempty" protobuf:"bytes,2,rep,name=schedules"`
+	// Mode determines which parts of the cluster are scaled down during hibernation. Defaults to 'Full'.
+	// +optional
+	Mode *HibernationMode `json:"mode,omitempty" protobuf:"bytes,3,opt,name=mode,casttype=HibernationMode"`
 }
 
+// HibernationMode is a type alias for string denoting which parts of a cluster are scaled down during hibernation.
+// +enum
+type HibernationMode string
+
+const (
+	// HibernationModeFull scales down both the worker nodes and the control plane.
+	HibernationModeFull HibernationMode = "Full"
+	// HibernationModeWorkersOnly scales down only the worker nodes and keeps the control plane running, so that the
+	// API server remains reachable.
+	HibernationModeWorkersOnly HibernationMode = "WorkersOnly"
+	// HibernationModeControlPlaneMinimized scales down the worker nodes and reduces the control plane to a minimal
+	// footprint while keeping the API server reachable.
+	HibernationModeControlPlaneMinimized HibernationMode = "ControlPlaneMinimized"
+)
+
 // HibernationSchedule determines the hibernation schedule of a Shoot.
 // A Shoot will be regularly hibernated at each start time and will be woken up at each end time.
 // Start or End can be omitted, though at least one of each has to be specified.
@@ -1008,6 +1225,22 @@ type KubeAPIServerConfig struct {
 	// Autoscaling contains auto-scaling configuration options for the kube-apiserver.
 	// +optional
 	Autoscaling *ControlPlaneAutoscaling `json:"autoscaling,omitempty" protobuf:"bytes,19,opt,name=autoscaling"`
+	// RequestBasedAutoscaling contains configuration for horizontally scaling the kube-apiserver on request-based
+	// metrics in addition to its resource metrics.
+	// +optional
+	RequestBasedAutoscaling *KubeAPIServerRequestBasedAutoscaling `json:"requestBasedAutoscaling,omitempty" protobuf:"bytes,20,opt,name=requestBasedAutoscaling"`
+}
+
+// KubeAPIServerRequestBasedAutoscaling contains configuration for horizontally scaling the kube-apiserver on
+// request-based metrics. The metrics are collected from the kube-apiserver pods by the shoot's Prometheus in the seed
+// and require a custom metrics adapter to be available in the seed cluster.
+type KubeAPIServerRequestBasedAutoscaling struct {
+	// AverageRequestsPerSecond is the target average number of requests per second per kube-apiserver replica.
+	// +optional
+	AverageRequestsPerSecond *int32 `json:"averageRequestsPerSecond,omitempty" protobuf:"varint,1,opt,name=averageRequestsPerSecond"`
+	// AverageInflightRequests is the target average number of inflight requests per kube-apiserver replica.
+	// +optional
+	AverageInflightRequests *int32 `json:"averageInflightRequests,omitempty" protobuf:"varint,2,opt,name=averageInflightRequests"`
 }
 
 // ControlPlaneAutoscaling contains auto-scaling configuration options for control-plane components.
@@ -1048,6 +1281,29 @@ type EncryptionConfig struct {
 	// Wildcards are not supported for now.
 	// See https://github.com/gardener/gardener/blob/master/docs/usage/security/etcd_encryption_config.md for more details.
 	Resources []string `json:"resources" protobuf:"bytes,1,rep,name=resources"`
+	// KMS contains configuration for encrypting resources with a customer-managed key via an external KMS v2
+	// provider instead of the Gardener-generated encryption keys. The Gardener-generated keys are kept as
+	// fallback providers for decryption, so the existing credentials rotation flows continue to work.
+	// +optional
+	KMS *KMSEncryptionConfiguration `json:"kms,omitempty" protobuf:"bytes,2,opt,name=kms"`
+}
+
+// KMSEncryptionConfiguration contains configuration for encrypting resources with a customer-managed key via an
+// external KMS provider.
+type KMSEncryptionConfiguration struct {
+	// Name is the name of the KMS plugin to be used. It is part of the data storage prefix in etcd, so changing it
+	// triggers a re-encryption of all affected resources.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Endpoint is the gRPC listening address of the KMS plugin, for example "unix:///var/run/kms-provider.sock".
+	Endpoint string `json:"endpoint" protobuf:"bytes,2,opt,name=endpoint"`
+	// SecretName is the name of a secret in the project namespace containing the credentials or key reference for
+	// the KMS provider. The secret is made available in the shoot's control plane namespace so that the KMS plugin
+	// can consume it.
+	// +optional
+	SecretName *string `json:"secretName,omitempty" protobuf:"bytes,3,opt,name=secretName"`
+	// Timeout is the maximum duration of a gRPC call to the KMS plugin. Defaults to 3s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,4,opt,name=timeout"`
 }
 
 // ServiceAccountConfig is the kube-apiserver configuration for service accounts.
@@ -1098,8 +1354,81 @@ type AuditPolicy struct {
 // StructuredAuthentication contains authentication config for kube-apiserver.
 type StructuredAuthentication struct {
 	// ConfigMapName is the name of the ConfigMap in the project namespace which contains AuthenticationConfiguration
-	// for the kube-apiserver.
-	ConfigMapName string `json:"configMapName" protobuf:"bytes,1,opt,name=configMapName"`
+	// for the kube-apiserver. It is mutually exclusive with jwtAuthenticators.
+	// +optional
+	ConfigMapName string `json:"configMapName,omitempty" protobuf:"bytes,1,opt,name=configMapName"`
+	// JWTAuthenticators is a list of JWT issuers trusted for authenticating to the kube-apiserver. It is rendered by
+	// gardenlet into an AuthenticationConfiguration for the kube-apiserver and is mutually exclusive with
+	// configMapName.
+	// +optional
+	JWTAuthenticators []JWTAuthenticator `json:"jwtAuthenticators,omitempty" protobuf:"bytes,2,rep,name=jwtAuthenticators"`
+}
+
+// JWTAuthenticator configures a JWT issuer trusted for authenticating to the kube-apiserver.
+type JWTAuthenticator struct {
+	// Issuer contains the basic settings of the JWT issuer.
+	Issuer AuthenticationIssuer `json:"issuer" protobuf:"bytes,1,opt,name=issuer"`
+	// ClaimMappings configures how token claims are mapped to user identities.
+	// +optional
+	ClaimMappings *AuthenticationClaimMappings `json:"claimMappings,omitempty" protobuf:"bytes,2,opt,name=claimMappings"`
+	// ClaimValidationRules are rules that are applied to validate token claims to authenticate users.
+	// +optional
+	ClaimValidationRules []AuthenticationClaimValidationRule `json:"claimValidationRules,omitempty" protobuf:"bytes,3,rep,name=claimValidationRules"`
+}
+
+// AuthenticationIssuer provides the configuration for a JWT issuer.
+type AuthenticationIssuer struct {
+	// URL points to the issuer URL in a format https://url or https://url/path. It must use the https:// scheme.
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Audiences is the set of acceptable audiences the JWT must be issued to.
+	Audiences []string `json:"audiences" protobuf:"bytes,2,rep,name=audiences"`
+	// CertificateAuthority contains a PEM-encoded certificate authority bundle used to validate the connection when
+	// fetching the issuer's discovery document. If unset, the system trust store is used.
+	// +optional
+	CertificateAuthority *string `json:"certificateAuthority,omitempty" protobuf:"bytes,3,opt,name=certificateAuthority"`
+}
+
+// AuthenticationClaimMappings configures how token claims are mapped to user identities.
+type AuthenticationClaimMappings struct {
+	// Username represents an option for the username attribute.
+	// +optional
+	Username *AuthenticationClaimOrExpression `json:"username,omitempty" protobuf:"bytes,1,opt,name=username"`
+	// Groups represents an option for the groups attribute.
+	// +optional
+	Groups *AuthenticationClaimOrExpression `json:"groups,omitempty" protobuf:"bytes,2,opt,name=groups"`
+}
+
+// AuthenticationClaimOrExpression configures how a user identity attribute is derived from token claims.
+type AuthenticationClaimOrExpression struct {
+	// Claim is the JWT claim to use. It is mutually exclusive with expression.
+	// +optional
+	Claim *string `json:"claim,omitempty" protobuf:"bytes,1,opt,name=claim"`
+	// Prefix is prepended to claim values to prevent clashes with existing names. It may only be set together with
+	// claim.
+	// +optional
+	Prefix *string `json:"prefix,omitempty" protobuf:"bytes,2,opt,name=prefix"`
+	// Expression is a CEL expression that evaluates to the attribute value. It is mutually exclusive with claim.
+	// +optional
+	Expression *string `json:"expression,omitempty" protobuf:"bytes,3,opt,name=expression"`
+}
+
+// AuthenticationClaimValidationRule provides the configuration for a single claim validation rule.
+type AuthenticationClaimValidationRule struct {
+	// Claim is the name of a required claim. It is mutually exclusive with expression.
+	// +optional
+	Claim *string `json:"claim,omitempty" protobuf:"bytes,1,opt,name=claim"`
+	// RequiredValue is the value of the claim which is required to be present with an exact match. It may only be set
+	// together with claim.
+	// +optional
+	RequiredValue *string `json:"requiredValue,omitempty" protobuf:"bytes,2,opt,name=requiredValue"`
+	// Expression is a CEL expression that must evaluate to true for the token to be accepted. It is mutually exclusive
+	// with claim.
+	// +optional
+	Expression *string `json:"expression,omitempty" protobuf:"bytes,3,opt,name=expression"`
+	// Message is returned in the authentication failure reason when the expression evaluates to false. It may only be
+	// set together with expression.
+	// +optional
+	Message *string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
 }
 
 // StructuredAuthorization contains authorization config for kube-apiserver.
@@ -1236,6 +1565,24 @@ type KubeControllerManagerConfig struct {
 	// NodeMonitorGracePeriod defines the grace period before an unresponsive node is marked unhealthy.
 	// +optional
 	NodeMonitorGracePeriod *metav1.Duration `json:"nodeMonitorGracePeriod,omitempty" protobuf:"bytes,5,opt,name=nodeMonitorGracePeriod"`
+	// ConcurrentSyncs allows configuring the number of concurrent workers for selected controllers of the
+	// kube-controller-manager.
+	// +optional
+	ConcurrentSyncs *KubeControllerManagerConcurrentSyncs `json:"concurrentSyncs,omitempty" protobuf:"bytes,6,opt,name=concurrentSyncs"`
+}
+
+// KubeControllerManagerConcurrentSyncs contains the number of concurrent workers for selected controllers of the
+// kube-controller-manager.
+type KubeControllerManagerConcurrentSyncs struct {
+	// Deployment is the number of workers for the Deployment controller (default is 50).
+	// +optional
+	Deployment *int32 `json:"deployment,omitempty" protobuf:"varint,1,opt,name=deployment"`
+	// ReplicaSet is the number of workers for the ReplicaSet controller (default is 50).
+	// +optional
+	ReplicaSet *int32 `json:"replicaSet,omitempty" protobuf:"varint,2,opt,name=replicaSet"`
+	// StatefulSet is the number of workers for the StatefulSet controller (default is 15).
+	// +optional
+	StatefulSet *int32 `json:"statefulSet,omitempty" protobuf:"varint,3,opt,name=statefulSet"`
 }
 
 // HorizontalPodAutoscalerConfig contains horizontal pod autoscaler configuration settings for the kube-controller-manager.
@@ -1641,6 +1988,11 @@ type MaintenanceTimeWindow struct {
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:Pattern=`([0-1][0-9]|2[0-3])[0-5][0-9][0-5][0-9]\+[0-1][0-4]00`
 	End string `json:"end" protobuf:"bytes,2,opt,name=end"`
+	// Location is the IANA timezone in which the clock times of Begin and End shall be evaluated, e.g. "Europe/Berlin".
+	// If set, the zone offsets in Begin and End are ignored and the time window follows daylight saving time changes of
+	// the location. If not set, the zone offsets in Begin and End apply.
+	// +optional
+	Location *string `json:"location,omitempty" protobuf:"bytes,3,opt,name=location"`
 }
 
 // Monitoring contains information about the monitoring configuration for the shoot.
@@ -1761,6 +2113,12 @@ type Worker struct {
 	// This is only relevant for self-hosted shoot clusters.
 	// +optional
 	ControlPlane *WorkerControlPlane `json:"controlPlane,omitempty" protobuf:"bytes,24,opt,name=controlPlane"`
+	// NodeWorkloadIdentityName is the name of a WorkloadIdentity in the same namespace as the Shoot whose identity
+	// shall be bound to the nodes of this worker pool by the provider extension (e.g., via instance profiles or
+	// managed identities). This allows workloads running on the nodes to access cloud provider APIs without static
+	// credentials being distributed to the machines.
+	// +optional
+	NodeWorkloadIdentityName *string `json:"nodeWorkloadIdentityName,omitempty" protobuf:"bytes,25,opt,name=nodeWorkloadIdentityName"`
 }
 
 // WorkerControlPlane specifies that the shoot cluster control plane components should be running in this worker pool.
@@ -1914,6 +2272,35 @@ type CRI struct {
 	// ContainerRuntimes is the list of the required container runtimes supported for a worker pool.
 	// +optional
 	ContainerRuntimes []ContainerRuntime `json:"containerRuntimes,omitempty" protobuf:"bytes,2,rep,name=containerRuntimes"`
+	// Containerd contains configuration options for containerd.
+	// +optional
+	Containerd *ContainerdConfig `json:"containerd,omitempty" protobuf:"bytes,3,opt,name=containerd"`
+}
+
+// ContainerdConfig contains configuration options for containerd.
+type ContainerdConfig struct {
+	// Registries configures the registry hosts used by containerd to pull images from upstream registries.
+	// +patchMergeKey=upstream
+	// +patchStrategy=merge
+	// +optional
+	Registries []ContainerdRegistry `json:"registries,omitempty" patchStrategy:"merge" patchMergeKey:"upstream" protobuf:"bytes,1,rep,name=registries"`
+}
+
+// ContainerdRegistry configures the registry hosts for one upstream container registry.
+type ContainerdRegistry struct {
+	// Upstream is the host name of the upstream registry (e.g., "docker.io").
+	Upstream string `json:"upstream" protobuf:"bytes,1,opt,name=upstream"`
+	// Server is the fallback server URL for this upstream.
+	// +optional
+	Server *string `json:"server,omitempty" protobuf:"bytes,2,opt,name=server"`
+	// Hosts is the list of registry mirror URLs used to pull images of this upstream.
+	Hosts []string `json:"hosts" protobuf:"bytes,3,rep,name=hosts"`
+	// AuthSecretName references a secret in the shoot's project namespace containing credentials for the registry mirrors.
+	// +optional
+	AuthSecretName *string `json:"authSecretName,omitempty" protobuf:"bytes,4,opt,name=authSecretName"`
+	// AllowInsecure allows plain HTTP mirror URLs for this upstream.
+	// +optional
+	AllowInsecure *bool `json:"allowInsecure,omitempty" protobuf:"varint,5,opt,name=allowInsecure"`
 }
 
 // CRIName is a type alias for the CRI name string.
@@ -2065,6 +2452,15 @@ const (
 	// ShootManualInPlaceWorkersUpdated is a constant for a condition type indicating that the Shoot cluster does not have
 	// any worker pools with update strategy "ManualInPlaceUpdate" and pending update.
 	ShootManualInPlaceWorkersUpdated ConditionType = "ManualInPlaceWorkersUpdated"
+	// ShootNoOrphanedCloudResources is a constant for a condition type indicating that the provider extension did not
+	// report any cloud resources which are tagged for the Shoot but no longer tracked by Gardener.
+	ShootNoOrphanedCloudResources ConditionType = "NoOrphanedCloudResources"
+	// ShootNoRemovedAPIsInUse is a constant for a condition type indicating that the Shoot cluster does not use any
+	// APIs which are removed in the Kubernetes version it is about to be upgraded to.
+	ShootNoRemovedAPIsInUse ConditionType = "NoRemovedAPIsInUse"
+	// ShootETCDDatabaseWithinQuota is a constant for a condition type indicating that the database size of the Shoot
+	// cluster's main etcd is within its configured backend quota.
+	ShootETCDDatabaseWithinQuota ConditionType = "ETCDDatabaseWithinQuota"
 	// ShootReadyForMigration is a constant for a condition type indicating whether the Shoot can be migrated.
 	ShootReadyForMigration ConditionType = "ReadyForMigration"
 	// ShootDualStackNodesMigrationReady is a constant for a condition type indicating whether all nodes are migrated to dual-stack .
@@ -2073,9 +2469,13 @@ const (
 	ShootDNSServiceMigrationReady ConditionType = "DNSServiceMigrationReady"
 	// ShootUsesUnifiedHTTPProxyPort is a constant for a condition type indicating whether the new http-proxy port is consumed from istio.
 	ShootUsesUnifiedHTTPProxyPort ConditionType = "UsesUnifiedHTTPProxyPort"
+	// ShootCredentialsValid is a constant for a condition type indicating that the credentials referenced by the
+	// Shoot's binding are valid.
+	ShootCredentialsValid ConditionType = "CredentialsValid"
 )
 
 // ShootPurpose is a type alias for string.
+// +enum
 type ShootPurpose string
 
 const (
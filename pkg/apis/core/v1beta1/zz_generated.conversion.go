@@ -311,6 +311,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CloudCredentialsRotation)(nil), (*core.CloudCredentialsRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CloudCredentialsRotation_To_core_CloudCredentialsRotation(a.(*CloudCredentialsRotation), b.(*core.CloudCredentialsRotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.CloudCredentialsRotation)(nil), (*CloudCredentialsRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_CloudCredentialsRotation_To_v1beta1_CloudCredentialsRotation(a.(*core.CloudCredentialsRotation), b.(*CloudCredentialsRotation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudProfile)(nil), (*core.CloudProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_CloudProfile_To_core_CloudProfile(a.(*CloudProfile), b.(*core.CloudProfile), scope)
 	}); err != nil {
@@ -391,6 +401,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ContainerdConfig)(nil), (*core.ContainerdConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ContainerdConfig_To_core_ContainerdConfig(a.(*ContainerdConfig), b.(*core.ContainerdConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ContainerdConfig)(nil), (*ContainerdConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ContainerdConfig_To_v1beta1_ContainerdConfig(a.(*core.ContainerdConfig), b.(*ContainerdConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ContainerdRegistry)(nil), (*core.ContainerdRegistry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ContainerdRegistry_To_core_ContainerdRegistry(a.(*ContainerdRegistry), b.(*core.ContainerdRegistry), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ContainerdRegistry)(nil), (*ContainerdRegistry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ContainerdRegistry_To_v1beta1_ContainerdRegistry(a.(*core.ContainerdRegistry), b.(*ContainerdRegistry), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControlPlane)(nil), (*core.ControlPlane)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ControlPlane_To_core_ControlPlane(a.(*ControlPlane), b.(*core.ControlPlane), scope)
 	}); err != nil {
@@ -411,6 +441,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControlPlaneResourceConsumption)(nil), (*core.ControlPlaneResourceConsumption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ControlPlaneResourceConsumption_To_core_ControlPlaneResourceConsumption(a.(*ControlPlaneResourceConsumption), b.(*core.ControlPlaneResourceConsumption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControlPlaneResourceConsumption)(nil), (*ControlPlaneResourceConsumption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControlPlaneResourceConsumption_To_v1beta1_ControlPlaneResourceConsumption(a.(*core.ControlPlaneResourceConsumption), b.(*ControlPlaneResourceConsumption), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerDeploymentList)(nil), (*core.ControllerDeploymentList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ControllerDeploymentList_To_core_ControllerDeploymentList(a.(*ControllerDeploymentList), b.(*core.ControllerDeploymentList), scope)
 	}); err != nil {
@@ -511,6 +551,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControllerResourceAddon)(nil), (*core.ControllerResourceAddon)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ControllerResourceAddon_To_core_ControllerResourceAddon(a.(*ControllerResourceAddon), b.(*core.ControllerResourceAddon), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControllerResourceAddon)(nil), (*ControllerResourceAddon)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControllerResourceAddon_To_v1beta1_ControllerResourceAddon(a.(*core.ControllerResourceAddon), b.(*ControllerResourceAddon), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerResourceLifecycle)(nil), (*core.ControllerResourceLifecycle)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ControllerResourceLifecycle_To_core_ControllerResourceLifecycle(a.(*ControllerResourceLifecycle), b.(*core.ControllerResourceLifecycle), scope)
 	}); err != nil {
@@ -651,6 +701,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EncryptionAtRestMigrationProgress)(nil), (*core.EncryptionAtRestMigrationProgress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_EncryptionAtRestMigrationProgress_To_core_EncryptionAtRestMigrationProgress(a.(*EncryptionAtRestMigrationProgress), b.(*core.EncryptionAtRestMigrationProgress), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.EncryptionAtRestMigrationProgress)(nil), (*EncryptionAtRestMigrationProgress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_EncryptionAtRestMigrationProgress_To_v1beta1_EncryptionAtRestMigrationProgress(a.(*core.EncryptionAtRestMigrationProgress), b.(*EncryptionAtRestMigrationProgress), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*EncryptionConfig)(nil), (*core.EncryptionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_EncryptionConfig_To_core_EncryptionConfig(a.(*EncryptionConfig), b.(*core.EncryptionConfig), scope)
 	}); err != nil {
@@ -846,6 +906,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KMSEncryptionConfiguration)(nil), (*core.KMSEncryptionConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KMSEncryptionConfiguration_To_core_KMSEncryptionConfiguration(a.(*KMSEncryptionConfiguration), b.(*core.KMSEncryptionConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.KMSEncryptionConfiguration)(nil), (*KMSEncryptionConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_KMSEncryptionConfiguration_To_v1beta1_KMSEncryptionConfiguration(a.(*core.KMSEncryptionConfiguration), b.(*KMSEncryptionConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KubeAPIServerConfig)(nil), (*core.KubeAPIServerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_KubeAPIServerConfig_To_core_KubeAPIServerConfig(a.(*KubeAPIServerConfig), b.(*core.KubeAPIServerConfig), scope)
 	}); err != nil {
@@ -1066,6 +1136,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineImageUpgradePath)(nil), (*core.MachineImageUpgradePath)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineImageUpgradePath_To_core_MachineImageUpgradePath(a.(*MachineImageUpgradePath), b.(*core.MachineImageUpgradePath), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MachineImageUpgradePath)(nil), (*MachineImageUpgradePath)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MachineImageUpgradePath_To_v1beta1_MachineImageUpgradePath(a.(*core.MachineImageUpgradePath), b.(*MachineImageUpgradePath), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineImageVersion)(nil), (*core.MachineImageVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MachineImageVersion_To_core_MachineImageVersion(a.(*MachineImageVersion), b.(*core.MachineImageVersion), scope)
 	}); err != nil {
@@ -1126,6 +1206,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ManagedAddon)(nil), (*core.ManagedAddon)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ManagedAddon_To_core_ManagedAddon(a.(*ManagedAddon), b.(*core.ManagedAddon), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ManagedAddon)(nil), (*ManagedAddon)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ManagedAddon_To_v1beta1_ManagedAddon(a.(*core.ManagedAddon), b.(*ManagedAddon), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ManualWorkerPoolRollout)(nil), (*core.ManualWorkerPoolRollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ManualWorkerPoolRollout_To_core_ManualWorkerPoolRollout(a.(*ManualWorkerPoolRollout), b.(*core.ManualWorkerPoolRollout), scope)
 	}); err != nil {
@@ -1346,6 +1436,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectUsageSummary)(nil), (*core.ProjectUsageSummary)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectUsageSummary_To_core_ProjectUsageSummary(a.(*ProjectUsageSummary), b.(*core.ProjectUsageSummary), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectUsageSummary)(nil), (*ProjectUsageSummary)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectUsageSummary_To_v1beta1_ProjectUsageSummary(a.(*core.ProjectUsageSummary), b.(*ProjectUsageSummary), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Provider)(nil), (*core.Provider)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Provider_To_core_Provider(a.(*Provider), b.(*core.Provider), scope)
 	}); err != nil {
@@ -1396,6 +1496,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RegistryMirror)(nil), (*core.RegistryMirror)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RegistryMirror_To_core_RegistryMirror(a.(*RegistryMirror), b.(*core.RegistryMirror), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RegistryMirror)(nil), (*RegistryMirror)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RegistryMirror_To_v1beta1_RegistryMirror(a.(*core.RegistryMirror), b.(*RegistryMirror), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ResourceBackupAddon)(nil), (*core.ResourceBackupAddon)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ResourceBackupAddon_To_core_ResourceBackupAddon(a.(*ResourceBackupAddon), b.(*core.ResourceBackupAddon), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ResourceBackupAddon)(nil), (*ResourceBackupAddon)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ResourceBackupAddon_To_v1beta1_ResourceBackupAddon(a.(*core.ResourceBackupAddon), b.(*ResourceBackupAddon), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ResourceData)(nil), (*core.ResourceData)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ResourceData_To_core_ResourceData(a.(*ResourceData), b.(*core.ResourceData), scope)
 	}); err != nil {
@@ -1456,6 +1576,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SecretBindingStatus)(nil), (*core.SecretBindingStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SecretBindingStatus_To_core_SecretBindingStatus(a.(*SecretBindingStatus), b.(*core.SecretBindingStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SecretBindingStatus)(nil), (*SecretBindingStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SecretBindingStatus_To_v1beta1_SecretBindingStatus(a.(*core.SecretBindingStatus), b.(*SecretBindingStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Seed)(nil), (*core.Seed)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Seed_To_core_Seed(a.(*Seed), b.(*core.Seed), scope)
 	}); err != nil {
@@ -1466,6 +1596,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedAffinity)(nil), (*core.SeedAffinity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedAffinity_To_core_SeedAffinity(a.(*SeedAffinity), b.(*core.SeedAffinity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedAffinity)(nil), (*SeedAffinity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedAffinity_To_v1beta1_SeedAffinity(a.(*core.SeedAffinity), b.(*SeedAffinity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedAvailability)(nil), (*core.SeedAvailability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedAvailability_To_core_SeedAvailability(a.(*SeedAvailability), b.(*core.SeedAvailability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedAvailability)(nil), (*SeedAvailability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedAvailability_To_v1beta1_SeedAvailability(a.(*core.SeedAvailability), b.(*SeedAvailability), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedDNS)(nil), (*core.SeedDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedDNS_To_core_SeedDNS(a.(*SeedDNS), b.(*core.SeedDNS), scope)
 	}); err != nil {
@@ -1536,6 +1686,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedSettingDNSCache)(nil), (*core.SeedSettingDNSCache)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedSettingDNSCache_To_core_SeedSettingDNSCache(a.(*SeedSettingDNSCache), b.(*core.SeedSettingDNSCache), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedSettingDNSCache)(nil), (*SeedSettingDNSCache)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedSettingDNSCache_To_v1beta1_SeedSettingDNSCache(a.(*core.SeedSettingDNSCache), b.(*SeedSettingDNSCache), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedSettingDependencyWatchdog)(nil), (*core.SeedSettingDependencyWatchdog)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedSettingDependencyWatchdog_To_core_SeedSettingDependencyWatchdog(a.(*SeedSettingDependencyWatchdog), b.(*core.SeedSettingDependencyWatchdog), scope)
 	}); err != nil {
@@ -1886,6 +2046,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootUpgradePaths)(nil), (*core.ShootUpgradePaths)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootUpgradePaths_To_core_ShootUpgradePaths(a.(*ShootUpgradePaths), b.(*core.ShootUpgradePaths), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootUpgradePaths)(nil), (*ShootUpgradePaths)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootUpgradePaths_To_v1beta1_ShootUpgradePaths(a.(*core.ShootUpgradePaths), b.(*ShootUpgradePaths), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*StructuredAuthentication)(nil), (*core.StructuredAuthentication)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_StructuredAuthentication_To_core_StructuredAuthentication(a.(*StructuredAuthentication), b.(*core.StructuredAuthentication), scope)
 	}); err != nil {
@@ -1926,6 +2096,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VersionUpgradePath)(nil), (*core.VersionUpgradePath)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VersionUpgradePath_To_core_VersionUpgradePath(a.(*VersionUpgradePath), b.(*core.VersionUpgradePath), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.VersionUpgradePath)(nil), (*VersionUpgradePath)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_VersionUpgradePath_To_v1beta1_VersionUpgradePath(a.(*core.VersionUpgradePath), b.(*VersionUpgradePath), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VerticalPodAutoscaler)(nil), (*core.VerticalPodAutoscaler)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_VerticalPodAutoscaler_To_core_VerticalPodAutoscaler(a.(*VerticalPodAutoscaler), b.(*core.VerticalPodAutoscaler), scope)
 	}); err != nil {
@@ -1966,6 +2146,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WeightedSeedAffinityTerm)(nil), (*core.WeightedSeedAffinityTerm)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WeightedSeedAffinityTerm_To_core_WeightedSeedAffinityTerm(a.(*WeightedSeedAffinityTerm), b.(*core.WeightedSeedAffinityTerm), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WeightedSeedAffinityTerm)(nil), (*WeightedSeedAffinityTerm)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WeightedSeedAffinityTerm_To_v1beta1_WeightedSeedAffinityTerm(a.(*core.WeightedSeedAffinityTerm), b.(*WeightedSeedAffinityTerm), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Worker)(nil), (*core.Worker)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Worker_To_core_Worker(a.(*Worker), b.(*core.Worker), scope)
 	}); err != nil {
@@ -1996,6 +2186,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkerPoolStatus)(nil), (*core.WorkerPoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerPoolStatus_To_core_WorkerPoolStatus(a.(*WorkerPoolStatus), b.(*core.WorkerPoolStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WorkerPoolStatus)(nil), (*WorkerPoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerPoolStatus_To_v1beta1_WorkerPoolStatus(a.(*core.WorkerPoolStatus), b.(*WorkerPoolStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerSystemComponents)(nil), (*core.WorkerSystemComponents)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_WorkerSystemComponents_To_core_WorkerSystemComponents(a.(*WorkerSystemComponents), b.(*core.WorkerSystemComponents), scope)
 	}); err != nil {
@@ -2016,6 +2216,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ZoneEgressCIDRs)(nil), (*core.ZoneEgressCIDRs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ZoneEgressCIDRs_To_core_ZoneEgressCIDRs(a.(*ZoneEgressCIDRs), b.(*core.ZoneEgressCIDRs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ZoneEgressCIDRs)(nil), (*ZoneEgressCIDRs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ZoneEgressCIDRs_To_v1beta1_ZoneEgressCIDRs(a.(*core.ZoneEgressCIDRs), b.(*ZoneEgressCIDRs), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*core.ControllerDeployment)(nil), (*ControllerDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_core_ControllerDeployment_To_v1beta1_ControllerDeployment(a.(*core.ControllerDeployment), b.(*ControllerDeployment), scope)
 	}); err != nil {
@@ -2177,6 +2387,7 @@ func Convert_core_Addon_To_v1beta1_Addon(in *core.Addon, out *Addon, s conversio
 func autoConvert_v1beta1_Addons_To_core_Addons(in *Addons, out *core.Addons, s conversion.Scope) error {
 	out.KubernetesDashboard = (*core.KubernetesDashboard)(unsafe.Pointer(in.KubernetesDashboard))
 	out.NginxIngress = (*core.NginxIngress)(unsafe.Pointer(in.NginxIngress))
+	out.ResourceBackup = (*core.ResourceBackupAddon)(unsafe.Pointer(in.ResourceBackup))
 	return nil
 }
 
@@ -2188,6 +2399,7 @@ func Convert_v1beta1_Addons_To_core_Addons(in *Addons, out *core.Addons, s conve
 func autoConvert_core_Addons_To_v1beta1_Addons(in *core.Addons, out *Addons, s conversion.Scope) error {
 	out.KubernetesDashboard = (*KubernetesDashboard)(unsafe.Pointer(in.KubernetesDashboard))
 	out.NginxIngress = (*NginxIngress)(unsafe.Pointer(in.NginxIngress))
+	out.ResourceBackup = (*ResourceBackupAddon)(unsafe.Pointer(in.ResourceBackup))
 	return nil
 }
 
@@ -2689,6 +2901,7 @@ func Convert_core_CARotation_To_v1beta1_CARotation(in *core.CARotation, out *CAR
 func autoConvert_v1beta1_CRI_To_core_CRI(in *CRI, out *core.CRI, s conversion.Scope) error {
 	out.Name = core.CRIName(in.Name)
 	out.ContainerRuntimes = *(*[]core.ContainerRuntime)(unsafe.Pointer(&in.ContainerRuntimes))
+	out.Containerd = (*core.ContainerdConfig)(unsafe.Pointer(in.Containerd))
 	return nil
 }
 
@@ -2700,6 +2913,7 @@ func Convert_v1beta1_CRI_To_core_CRI(in *CRI, out *core.CRI, s conversion.Scope)
 func autoConvert_core_CRI_To_v1beta1_CRI(in *core.CRI, out *CRI, s conversion.Scope) error {
 	out.Name = CRIName(in.Name)
 	out.ContainerRuntimes = *(*[]ContainerRuntime)(unsafe.Pointer(&in.ContainerRuntimes))
+	out.Containerd = (*ContainerdConfig)(unsafe.Pointer(in.Containerd))
 	return nil
 }
 
@@ -2730,6 +2944,28 @@ func Convert_core_CapabilityDefinition_To_v1beta1_CapabilityDefinition(in *core.
 	return autoConvert_core_CapabilityDefinition_To_v1beta1_CapabilityDefinition(in, out, s)
 }
 
+func autoConvert_v1beta1_CloudCredentialsRotation_To_core_CloudCredentialsRotation(in *CloudCredentialsRotation, out *core.CloudCredentialsRotation, s conversion.Scope) error {
+	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	return nil
+}
+
+// Convert_v1beta1_CloudCredentialsRotation_To_core_CloudCredentialsRotation is an autogenerated conversion function.
+func Convert_v1beta1_CloudCredentialsRotation_To_core_CloudCredentialsRotation(in *CloudCredentialsRotation, out *core.CloudCredentialsRotation, s conversion.Scope) error {
+	return autoConvert_v1beta1_CloudCredentialsRotation_To_core_CloudCredentialsRotation(in, out, s)
+}
+
+func autoConvert_core_CloudCredentialsRotation_To_v1beta1_CloudCredentialsRotation(in *core.CloudCredentialsRotation, out *CloudCredentialsRotation, s conversion.Scope) error {
+	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	return nil
+}
+
+// Convert_core_CloudCredentialsRotation_To_v1beta1_CloudCredentialsRotation is an autogenerated conversion function.
+func Convert_core_CloudCredentialsRotation_To_v1beta1_CloudCredentialsRotation(in *core.CloudCredentialsRotation, out *CloudCredentialsRotation, s conversion.Scope) error {
+	return autoConvert_core_CloudCredentialsRotation_To_v1beta1_CloudCredentialsRotation(in, out, s)
+}
+
 func autoConvert_v1beta1_CloudProfile_To_core_CloudProfile(in *CloudProfile, out *core.CloudProfile, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_CloudProfileSpec_To_core_CloudProfileSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -2815,6 +3051,7 @@ func autoConvert_v1beta1_CloudProfileSpec_To_core_CloudProfileSpec(in *CloudProf
 	out.Bastion = (*core.Bastion)(unsafe.Pointer(in.Bastion))
 	out.Limits = (*core.Limits)(unsafe.Pointer(in.Limits))
 	out.MachineCapabilities = *(*[]core.CapabilityDefinition)(unsafe.Pointer(&in.MachineCapabilities))
+	out.RegistryMirrors = *(*[]core.RegistryMirror)(unsafe.Pointer(&in.RegistryMirrors))
 	return nil
 }
 
@@ -2838,6 +3075,7 @@ func autoConvert_core_CloudProfileSpec_To_v1beta1_CloudProfileSpec(in *core.Clou
 	out.Bastion = (*Bastion)(unsafe.Pointer(in.Bastion))
 	out.Limits = (*Limits)(unsafe.Pointer(in.Limits))
 	out.MachineCapabilities = *(*[]CapabilityDefinition)(unsafe.Pointer(&in.MachineCapabilities))
+	out.RegistryMirrors = *(*[]RegistryMirror)(unsafe.Pointer(&in.RegistryMirrors))
 	return nil
 }
 
@@ -2988,6 +3226,54 @@ func Convert_core_ContainerRuntime_To_v1beta1_ContainerRuntime(in *core.Containe
 	return autoConvert_core_ContainerRuntime_To_v1beta1_ContainerRuntime(in, out, s)
 }
 
+func autoConvert_v1beta1_ContainerdConfig_To_core_ContainerdConfig(in *ContainerdConfig, out *core.ContainerdConfig, s conversion.Scope) error {
+	out.Registries = *(*[]core.ContainerdRegistry)(unsafe.Pointer(&in.Registries))
+	return nil
+}
+
+// Convert_v1beta1_ContainerdConfig_To_core_ContainerdConfig is an autogenerated conversion function.
+func Convert_v1beta1_ContainerdConfig_To_core_ContainerdConfig(in *ContainerdConfig, out *core.ContainerdConfig, s conversion.Scope) error {
+	return autoConvert_v1beta1_ContainerdConfig_To_core_ContainerdConfig(in, out, s)
+}
+
+func autoConvert_core_ContainerdConfig_To_v1beta1_ContainerdConfig(in *core.ContainerdConfig, out *ContainerdConfig, s conversion.Scope) error {
+	out.Registries = *(*[]ContainerdRegistry)(unsafe.Pointer(&in.Registries))
+	return nil
+}
+
+// Convert_core_ContainerdConfig_To_v1beta1_ContainerdConfig is an autogenerated conversion function.
+func Convert_core_ContainerdConfig_To_v1beta1_ContainerdConfig(in *core.ContainerdConfig, out *ContainerdConfig, s conversion.Scope) error {
+	return autoConvert_core_ContainerdConfig_To_v1beta1_ContainerdConfig(in, out, s)
+}
+
+func autoConvert_v1beta1_ContainerdRegistry_To_core_ContainerdRegistry(in *ContainerdRegistry, out *core.ContainerdRegistry, s conversion.Scope) error {
+	out.Upstream = in.Upstream
+	out.Server = (*string)(unsafe.Pointer(in.Server))
+	out.Hosts = *(*[]string)(unsafe.Pointer(&in.Hosts))
+	out.AuthSecretName = (*string)(unsafe.Pointer(in.AuthSecretName))
+	out.AllowInsecure = (*bool)(unsafe.Pointer(in.AllowInsecure))
+	return nil
+}
+
+// Convert_v1beta1_ContainerdRegistry_To_core_ContainerdRegistry is an autogenerated conversion function.
+func Convert_v1beta1_ContainerdRegistry_To_core_ContainerdRegistry(in *ContainerdRegistry, out *core.ContainerdRegistry, s conversion.Scope) error {
+	return autoConvert_v1beta1_ContainerdRegistry_To_core_ContainerdRegistry(in, out, s)
+}
+
+func autoConvert_core_ContainerdRegistry_To_v1beta1_ContainerdRegistry(in *core.ContainerdRegistry, out *ContainerdRegistry, s conversion.Scope) error {
+	out.Upstream = in.Upstream
+	out.Server = (*string)(unsafe.Pointer(in.Server))
+	out.Hosts = *(*[]string)(unsafe.Pointer(&in.Hosts))
+	out.AuthSecretName = (*string)(unsafe.Pointer(in.AuthSecretName))
+	out.AllowInsecure = (*bool)(unsafe.Pointer(in.AllowInsecure))
+	return nil
+}
+
+// Convert_core_ContainerdRegistry_To_v1beta1_ContainerdRegistry is an autogenerated conversion function.
+func Convert_core_ContainerdRegistry_To_v1beta1_ContainerdRegistry(in *core.ContainerdRegistry, out *ContainerdRegistry, s conversion.Scope) error {
+	return autoConvert_core_ContainerdRegistry_To_v1beta1_ContainerdRegistry(in, out, s)
+}
+
 func autoConvert_v1beta1_ControlPlane_To_core_ControlPlane(in *ControlPlane, out *core.ControlPlane, s conversion.Scope) error {
 	out.HighAvailability = (*core.HighAvailability)(unsafe.Pointer(in.HighAvailability))
 	return nil
@@ -3028,6 +3314,32 @@ func Convert_core_ControlPlaneAutoscaling_To_v1beta1_ControlPlaneAutoscaling(in
 	return autoConvert_core_ControlPlaneAutoscaling_To_v1beta1_ControlPlaneAutoscaling(in, out, s)
 }
 
+func autoConvert_v1beta1_ControlPlaneResourceConsumption_To_core_ControlPlaneResourceConsumption(in *ControlPlaneResourceConsumption, out *core.ControlPlaneResourceConsumption, s conversion.Scope) error {
+	out.KubeAPIServerCPU = (*resource.Quantity)(unsafe.Pointer(in.KubeAPIServerCPU))
+	out.KubeAPIServerMemory = (*resource.Quantity)(unsafe.Pointer(in.KubeAPIServerMemory))
+	out.ETCDStorageSize = (*resource.Quantity)(unsafe.Pointer(in.ETCDStorageSize))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_v1beta1_ControlPlaneResourceConsumption_To_core_ControlPlaneResourceConsumption is an autogenerated conversion function.
+func Convert_v1beta1_ControlPlaneResourceConsumption_To_core_ControlPlaneResourceConsumption(in *ControlPlaneResourceConsumption, out *core.ControlPlaneResourceConsumption, s conversion.Scope) error {
+	return autoConvert_v1beta1_ControlPlaneResourceConsumption_To_core_ControlPlaneResourceConsumption(in, out, s)
+}
+
+func autoConvert_core_ControlPlaneResourceConsumption_To_v1beta1_ControlPlaneResourceConsumption(in *core.ControlPlaneResourceConsumption, out *ControlPlaneResourceConsumption, s conversion.Scope) error {
+	out.KubeAPIServerCPU = (*resource.Quantity)(unsafe.Pointer(in.KubeAPIServerCPU))
+	out.KubeAPIServerMemory = (*resource.Quantity)(unsafe.Pointer(in.KubeAPIServerMemory))
+	out.ETCDStorageSize = (*resource.Quantity)(unsafe.Pointer(in.ETCDStorageSize))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_core_ControlPlaneResourceConsumption_To_v1beta1_ControlPlaneResourceConsumption is an autogenerated conversion function.
+func Convert_core_ControlPlaneResourceConsumption_To_v1beta1_ControlPlaneResourceConsumption(in *core.ControlPlaneResourceConsumption, out *ControlPlaneResourceConsumption, s conversion.Scope) error {
+	return autoConvert_core_ControlPlaneResourceConsumption_To_v1beta1_ControlPlaneResourceConsumption(in, out, s)
+}
+
 func autoConvert_v1beta1_ControllerDeployment_To_core_ControllerDeployment(in *ControllerDeployment, out *core.ControllerDeployment, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.Type = in.Type
@@ -3294,6 +3606,7 @@ func autoConvert_v1beta1_ControllerResource_To_core_ControllerResource(in *Contr
 	out.WorkerlessSupported = (*bool)(unsafe.Pointer(in.WorkerlessSupported))
 	out.AutoEnable = *(*[]core.ClusterType)(unsafe.Pointer(&in.AutoEnable))
 	out.ClusterCompatibility = *(*[]core.ClusterType)(unsafe.Pointer(&in.ClusterCompatibility))
+	out.Addon = (*core.ControllerResourceAddon)(unsafe.Pointer(in.Addon))
 	return nil
 }
 
@@ -3311,6 +3624,7 @@ func autoConvert_core_ControllerResource_To_v1beta1_ControllerResource(in *core.
 	out.WorkerlessSupported = (*bool)(unsafe.Pointer(in.WorkerlessSupported))
 	out.AutoEnable = *(*[]ClusterType)(unsafe.Pointer(&in.AutoEnable))
 	out.ClusterCompatibility = *(*[]ClusterType)(unsafe.Pointer(&in.ClusterCompatibility))
+	out.Addon = (*ControllerResourceAddon)(unsafe.Pointer(in.Addon))
 	return nil
 }
 
@@ -3319,6 +3633,28 @@ func Convert_core_ControllerResource_To_v1beta1_ControllerResource(in *core.Cont
 	return autoConvert_core_ControllerResource_To_v1beta1_ControllerResource(in, out, s)
 }
 
+func autoConvert_v1beta1_ControllerResourceAddon_To_core_ControllerResourceAddon(in *ControllerResourceAddon, out *core.ControllerResourceAddon, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Schema = (*runtime.RawExtension)(unsafe.Pointer(in.Schema))
+	return nil
+}
+
+// Convert_v1beta1_ControllerResourceAddon_To_core_ControllerResourceAddon is an autogenerated conversion function.
+func Convert_v1beta1_ControllerResourceAddon_To_core_ControllerResourceAddon(in *ControllerResourceAddon, out *core.ControllerResourceAddon, s conversion.Scope) error {
+	return autoConvert_v1beta1_ControllerResourceAddon_To_core_ControllerResourceAddon(in, out, s)
+}
+
+func autoConvert_core_ControllerResourceAddon_To_v1beta1_ControllerResourceAddon(in *core.ControllerResourceAddon, out *ControllerResourceAddon, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Schema = (*runtime.RawExtension)(unsafe.Pointer(in.Schema))
+	return nil
+}
+
+// Convert_core_ControllerResourceAddon_To_v1beta1_ControllerResourceAddon is an autogenerated conversion function.
+func Convert_core_ControllerResourceAddon_To_v1beta1_ControllerResourceAddon(in *core.ControllerResourceAddon, out *ControllerResourceAddon, s conversion.Scope) error {
+	return autoConvert_core_ControllerResourceAddon_To_v1beta1_ControllerResourceAddon(in, out, s)
+}
+
 func autoConvert_v1beta1_ControllerResourceLifecycle_To_core_ControllerResourceLifecycle(in *ControllerResourceLifecycle, out *core.ControllerResourceLifecycle, s conversion.Scope) error {
 	out.Reconcile = (*core.ControllerResourceLifecycleStrategy)(unsafe.Pointer(in.Reconcile))
 	out.Delete = (*core.ControllerResourceLifecycleStrategy)(unsafe.Pointer(in.Delete))
@@ -3407,6 +3743,7 @@ func Convert_core_CoreDNSRewriting_To_v1beta1_CoreDNSRewriting(in *core.CoreDNSR
 
 func autoConvert_v1beta1_DNS_To_core_DNS(in *DNS, out *core.DNS, s conversion.Scope) error {
 	out.Domain = (*string)(unsafe.Pointer(in.Domain))
+	out.ManagementDomain = (*string)(unsafe.Pointer(in.ManagementDomain))
 	out.Providers = *(*[]core.DNSProvider)(unsafe.Pointer(&in.Providers))
 	return nil
 }
@@ -3418,6 +3755,7 @@ func Convert_v1beta1_DNS_To_core_DNS(in *DNS, out *core.DNS, s conversion.Scope)
 
 func autoConvert_core_DNS_To_v1beta1_DNS(in *core.DNS, out *DNS, s conversion.Scope) error {
 	out.Domain = (*string)(unsafe.Pointer(in.Domain))
+	out.ManagementDomain = (*string)(unsafe.Pointer(in.ManagementDomain))
 	out.Providers = *(*[]DNSProvider)(unsafe.Pointer(&in.Providers))
 	return nil
 }
@@ -3621,6 +3959,7 @@ func Convert_core_ETCDEncryptionKeyRotation_To_v1beta1_ETCDEncryptionKeyRotation
 
 func autoConvert_v1beta1_EncryptionAtRest_To_core_EncryptionAtRest(in *EncryptionAtRest, out *core.EncryptionAtRest, s conversion.Scope) error {
 	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.MigrationProgress = (*core.EncryptionAtRestMigrationProgress)(unsafe.Pointer(in.MigrationProgress))
 	return nil
 }
 
@@ -3631,6 +3970,7 @@ func Convert_v1beta1_EncryptionAtRest_To_core_EncryptionAtRest(in *EncryptionAtR
 
 func autoConvert_core_EncryptionAtRest_To_v1beta1_EncryptionAtRest(in *core.EncryptionAtRest, out *EncryptionAtRest, s conversion.Scope) error {
 	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.MigrationProgress = (*EncryptionAtRestMigrationProgress)(unsafe.Pointer(in.MigrationProgress))
 	return nil
 }
 
@@ -3639,8 +3979,31 @@ func Convert_core_EncryptionAtRest_To_v1beta1_EncryptionAtRest(in *core.Encrypti
 	return autoConvert_core_EncryptionAtRest_To_v1beta1_EncryptionAtRest(in, out, s)
 }
 
+func autoConvert_v1beta1_EncryptionAtRestMigrationProgress_To_core_EncryptionAtRestMigrationProgress(in *EncryptionAtRestMigrationProgress, out *core.EncryptionAtRestMigrationProgress, s conversion.Scope) error {
+	out.MigratedResourceCount = in.MigratedResourceCount
+	out.RemainingResourceCount = in.RemainingResourceCount
+	return nil
+}
+
+// Convert_v1beta1_EncryptionAtRestMigrationProgress_To_core_EncryptionAtRestMigrationProgress is an autogenerated conversion function.
+func Convert_v1beta1_EncryptionAtRestMigrationProgress_To_core_EncryptionAtRestMigrationProgress(in *EncryptionAtRestMigrationProgress, out *core.EncryptionAtRestMigrationProgress, s conversion.Scope) error {
+	return autoConvert_v1beta1_EncryptionAtRestMigrationProgress_To_core_EncryptionAtRestMigrationProgress(in, out, s)
+}
+
+func autoConvert_core_EncryptionAtRestMigrationProgress_To_v1beta1_EncryptionAtRestMigrationProgress(in *core.EncryptionAtRestMigrationProgress, out *EncryptionAtRestMigrationProgress, s conversion.Scope) error {
+	out.MigratedResourceCount = in.MigratedResourceCount
+	out.RemainingResourceCount = in.RemainingResourceCount
+	return nil
+}
+
+// Convert_core_EncryptionAtRestMigrationProgress_To_v1beta1_EncryptionAtRestMigrationProgress is an autogenerated conversion function.
+func Convert_core_EncryptionAtRestMigrationProgress_To_v1beta1_EncryptionAtRestMigrationProgress(in *core.EncryptionAtRestMigrationProgress, out *EncryptionAtRestMigrationProgress, s conversion.Scope) error {
+	return autoConvert_core_EncryptionAtRestMigrationProgress_To_v1beta1_EncryptionAtRestMigrationProgress(in, out, s)
+}
+
 func autoConvert_v1beta1_EncryptionConfig_To_core_EncryptionConfig(in *EncryptionConfig, out *core.EncryptionConfig, s conversion.Scope) error {
 	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.KMS = (*core.KMSEncryptionConfiguration)(unsafe.Pointer(in.KMS))
 	return nil
 }
 
@@ -3651,6 +4014,7 @@ func Convert_v1beta1_EncryptionConfig_To_core_EncryptionConfig(in *EncryptionCon
 
 func autoConvert_core_EncryptionConfig_To_v1beta1_EncryptionConfig(in *core.EncryptionConfig, out *EncryptionConfig, s conversion.Scope) error {
 	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.KMS = (*KMSEncryptionConfiguration)(unsafe.Pointer(in.KMS))
 	return nil
 }
 
@@ -3890,6 +4254,7 @@ func autoConvert_core_HelmControllerDeployment_To_v1beta1_HelmControllerDeployme
 func autoConvert_v1beta1_Hibernation_To_core_Hibernation(in *Hibernation, out *core.Hibernation, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.Schedules = *(*[]core.HibernationSchedule)(unsafe.Pointer(&in.Schedules))
+	out.Mode = (*core.HibernationMode)(unsafe.Pointer(in.Mode))
 	return nil
 }
 
@@ -3901,6 +4266,7 @@ func Convert_v1beta1_Hibernation_To_core_Hibernation(in *Hibernation, out *core.
 func autoConvert_core_Hibernation_To_v1beta1_Hibernation(in *core.Hibernation, out *Hibernation, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.Schedules = *(*[]HibernationSchedule)(unsafe.Pointer(&in.Schedules))
+	out.Mode = (*HibernationMode)(unsafe.Pointer(in.Mode))
 	return nil
 }
 
@@ -4139,6 +4505,32 @@ func Convert_core_InternalSecretList_To_v1beta1_InternalSecretList(in *core.Inte
 	return autoConvert_core_InternalSecretList_To_v1beta1_InternalSecretList(in, out, s)
 }
 
+func autoConvert_v1beta1_KMSEncryptionConfiguration_To_core_KMSEncryptionConfiguration(in *KMSEncryptionConfiguration, out *core.KMSEncryptionConfiguration, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Endpoint = in.Endpoint
+	out.SecretName = (*string)(unsafe.Pointer(in.SecretName))
+	out.Timeout = (*metav1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1beta1_KMSEncryptionConfiguration_To_core_KMSEncryptionConfiguration is an autogenerated conversion function.
+func Convert_v1beta1_KMSEncryptionConfiguration_To_core_KMSEncryptionConfiguration(in *KMSEncryptionConfiguration, out *core.KMSEncryptionConfiguration, s conversion.Scope) error {
+	return autoConvert_v1beta1_KMSEncryptionConfiguration_To_core_KMSEncryptionConfiguration(in, out, s)
+}
+
+func autoConvert_core_KMSEncryptionConfiguration_To_v1beta1_KMSEncryptionConfiguration(in *core.KMSEncryptionConfiguration, out *KMSEncryptionConfiguration, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Endpoint = in.Endpoint
+	out.SecretName = (*string)(unsafe.Pointer(in.SecretName))
+	out.Timeout = (*metav1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_core_KMSEncryptionConfiguration_To_v1beta1_KMSEncryptionConfiguration is an autogenerated conversion function.
+func Convert_core_KMSEncryptionConfiguration_To_v1beta1_KMSEncryptionConfiguration(in *core.KMSEncryptionConfiguration, out *KMSEncryptionConfiguration, s conversion.Scope) error {
+	return autoConvert_core_KMSEncryptionConfiguration_To_v1beta1_KMSEncryptionConfiguration(in, out, s)
+}
+
 func autoConvert_v1beta1_KubeAPIServerConfig_To_core_KubeAPIServerConfig(in *KubeAPIServerConfig, out *core.KubeAPIServerConfig, s conversion.Scope) error {
 	if err := Convert_v1beta1_KubernetesConfig_To_core_KubernetesConfig(&in.KubernetesConfig, &out.KubernetesConfig, s); err != nil {
 		return err
@@ -4170,6 +4562,7 @@ func autoConvert_v1beta1_KubeAPIServerConfig_To_core_KubeAPIServerConfig(in *Kub
 	out.StructuredAuthentication = (*core.StructuredAuthentication)(unsafe.Pointer(in.StructuredAuthentication))
 	out.StructuredAuthorization = (*core.StructuredAuthorization)(unsafe.Pointer(in.StructuredAuthorization))
 	out.Autoscaling = (*core.ControlPlaneAutoscaling)(unsafe.Pointer(in.Autoscaling))
+	out.RequestBasedAutoscaling = (*core.KubeAPIServerRequestBasedAutoscaling)(unsafe.Pointer(in.RequestBasedAutoscaling))
 	return nil
 }
 
@@ -4209,6 +4602,7 @@ func autoConvert_core_KubeAPIServerConfig_To_v1beta1_KubeAPIServerConfig(in *cor
 	out.StructuredAuthentication = (*StructuredAuthentication)(unsafe.Pointer(in.StructuredAuthentication))
 	out.StructuredAuthorization = (*StructuredAuthorization)(unsafe.Pointer(in.StructuredAuthorization))
 	out.Autoscaling = (*ControlPlaneAutoscaling)(unsafe.Pointer(in.Autoscaling))
+	out.RequestBasedAutoscaling = (*KubeAPIServerRequestBasedAutoscaling)(unsafe.Pointer(in.RequestBasedAutoscaling))
 	return nil
 }
 
@@ -4225,6 +4619,7 @@ func autoConvert_v1beta1_KubeControllerManagerConfig_To_core_KubeControllerManag
 	out.NodeCIDRMaskSize = (*int32)(unsafe.Pointer(in.NodeCIDRMaskSize))
 	out.PodEvictionTimeout = (*metav1.Duration)(unsafe.Pointer(in.PodEvictionTimeout))
 	out.NodeMonitorGracePeriod = (*metav1.Duration)(unsafe.Pointer(in.NodeMonitorGracePeriod))
+	out.ConcurrentSyncs = (*core.KubeControllerManagerConcurrentSyncs)(unsafe.Pointer(in.ConcurrentSyncs))
 	return nil
 }
 
@@ -4241,6 +4636,7 @@ func autoConvert_core_KubeControllerManagerConfig_To_v1beta1_KubeControllerManag
 	out.NodeCIDRMaskSize = (*int32)(unsafe.Pointer(in.NodeCIDRMaskSize))
 	out.PodEvictionTimeout = (*metav1.Duration)(unsafe.Pointer(in.PodEvictionTimeout))
 	out.NodeMonitorGracePeriod = (*metav1.Duration)(unsafe.Pointer(in.NodeMonitorGracePeriod))
+	out.ConcurrentSyncs = (*KubeControllerManagerConcurrentSyncs)(unsafe.Pointer(in.ConcurrentSyncs))
 	return nil
 }
 
@@ -4879,6 +5275,34 @@ func Convert_core_MachineImageFlavor_To_v1beta1_MachineImageFlavor(in *core.Mach
 	return autoConvert_core_MachineImageFlavor_To_v1beta1_MachineImageFlavor(in, out, s)
 }
 
+func autoConvert_v1beta1_MachineImageUpgradePath_To_core_MachineImageUpgradePath(in *MachineImageUpgradePath, out *core.MachineImageUpgradePath, s conversion.Scope) error {
+	out.WorkerPool = in.WorkerPool
+	out.Image = in.Image
+	out.Version = in.Version
+	out.Next = (*string)(unsafe.Pointer(in.Next))
+	out.ExpirationDate = (*metav1.Time)(unsafe.Pointer(in.ExpirationDate))
+	return nil
+}
+
+// Convert_v1beta1_MachineImageUpgradePath_To_core_MachineImageUpgradePath is an autogenerated conversion function.
+func Convert_v1beta1_MachineImageUpgradePath_To_core_MachineImageUpgradePath(in *MachineImageUpgradePath, out *core.MachineImageUpgradePath, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineImageUpgradePath_To_core_MachineImageUpgradePath(in, out, s)
+}
+
+func autoConvert_core_MachineImageUpgradePath_To_v1beta1_MachineImageUpgradePath(in *core.MachineImageUpgradePath, out *MachineImageUpgradePath, s conversion.Scope) error {
+	out.WorkerPool = in.WorkerPool
+	out.Image = in.Image
+	out.Version = in.Version
+	out.Next = (*string)(unsafe.Pointer(in.Next))
+	out.ExpirationDate = (*metav1.Time)(unsafe.Pointer(in.ExpirationDate))
+	return nil
+}
+
+// Convert_core_MachineImageUpgradePath_To_v1beta1_MachineImageUpgradePath is an autogenerated conversion function.
+func Convert_core_MachineImageUpgradePath_To_v1beta1_MachineImageUpgradePath(in *core.MachineImageUpgradePath, out *MachineImageUpgradePath, s conversion.Scope) error {
+	return autoConvert_core_MachineImageUpgradePath_To_v1beta1_MachineImageUpgradePath(in, out, s)
+}
+
 func autoConvert_v1beta1_MachineImageVersion_To_core_MachineImageVersion(in *MachineImageVersion, out *core.MachineImageVersion, s conversion.Scope) error {
 	if err := Convert_v1beta1_ExpirableVersion_To_core_ExpirableVersion(&in.ExpirableVersion, &out.ExpirableVersion, s); err != nil {
 		return err
@@ -5022,6 +5446,7 @@ func Convert_core_MaintenanceAutoUpdate_To_v1beta1_MaintenanceAutoUpdate(in *cor
 func autoConvert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(in *MaintenanceTimeWindow, out *core.MaintenanceTimeWindow, s conversion.Scope) error {
 	out.Begin = in.Begin
 	out.End = in.End
+	out.Location = (*string)(unsafe.Pointer(in.Location))
 	return nil
 }
 
@@ -5033,6 +5458,7 @@ func Convert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(in *Mai
 func autoConvert_core_MaintenanceTimeWindow_To_v1beta1_MaintenanceTimeWindow(in *core.MaintenanceTimeWindow, out *MaintenanceTimeWindow, s conversion.Scope) error {
 	out.Begin = in.Begin
 	out.End = in.End
+	out.Location = (*string)(unsafe.Pointer(in.Location))
 	return nil
 }
 
@@ -5041,6 +5467,28 @@ func Convert_core_MaintenanceTimeWindow_To_v1beta1_MaintenanceTimeWindow(in *cor
 	return autoConvert_core_MaintenanceTimeWindow_To_v1beta1_MaintenanceTimeWindow(in, out, s)
 }
 
+func autoConvert_v1beta1_ManagedAddon_To_core_ManagedAddon(in *ManagedAddon, out *core.ManagedAddon, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Config = (*runtime.RawExtension)(unsafe.Pointer(in.Config))
+	return nil
+}
+
+// Convert_v1beta1_ManagedAddon_To_core_ManagedAddon is an autogenerated conversion function.
+func Convert_v1beta1_ManagedAddon_To_core_ManagedAddon(in *ManagedAddon, out *core.ManagedAddon, s conversion.Scope) error {
+	return autoConvert_v1beta1_ManagedAddon_To_core_ManagedAddon(in, out, s)
+}
+
+func autoConvert_core_ManagedAddon_To_v1beta1_ManagedAddon(in *core.ManagedAddon, out *ManagedAddon, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Config = (*runtime.RawExtension)(unsafe.Pointer(in.Config))
+	return nil
+}
+
+// Convert_core_ManagedAddon_To_v1beta1_ManagedAddon is an autogenerated conversion function.
+func Convert_core_ManagedAddon_To_v1beta1_ManagedAddon(in *core.ManagedAddon, out *ManagedAddon, s conversion.Scope) error {
+	return autoConvert_core_ManagedAddon_To_v1beta1_ManagedAddon(in, out, s)
+}
+
 func autoConvert_v1beta1_ManualWorkerPoolRollout_To_core_ManualWorkerPoolRollout(in *ManualWorkerPoolRollout, out *core.ManualWorkerPoolRollout, s conversion.Scope) error {
 	out.PendingWorkersRollouts = *(*[]core.PendingWorkersRollout)(unsafe.Pointer(&in.PendingWorkersRollouts))
 	return nil
@@ -5276,6 +5724,7 @@ func autoConvert_v1beta1_NetworkingStatus_To_core_NetworkingStatus(in *Networkin
 	out.Nodes = *(*[]string)(unsafe.Pointer(&in.Nodes))
 	out.Services = *(*[]string)(unsafe.Pointer(&in.Services))
 	out.EgressCIDRs = *(*[]string)(unsafe.Pointer(&in.EgressCIDRs))
+	out.EgressCIDRsByZone = *(*[]core.ZoneEgressCIDRs)(unsafe.Pointer(&in.EgressCIDRsByZone))
 	return nil
 }
 
@@ -5289,6 +5738,7 @@ func autoConvert_core_NetworkingStatus_To_v1beta1_NetworkingStatus(in *core.Netw
 	out.Nodes = *(*[]string)(unsafe.Pointer(&in.Nodes))
 	out.Services = *(*[]string)(unsafe.Pointer(&in.Services))
 	out.EgressCIDRs = *(*[]string)(unsafe.Pointer(&in.EgressCIDRs))
+	out.EgressCIDRsByZone = *(*[]ZoneEgressCIDRs)(unsafe.Pointer(&in.EgressCIDRsByZone))
 	return nil
 }
 
@@ -5644,6 +6094,7 @@ func autoConvert_v1beta1_ProjectStatus_To_core_ProjectStatus(in *ProjectStatus,
 	out.StaleSinceTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
 	out.StaleAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
 	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.UsageSummary = (*core.ProjectUsageSummary)(unsafe.Pointer(in.UsageSummary))
 	return nil
 }
 
@@ -5658,6 +6109,7 @@ func autoConvert_core_ProjectStatus_To_v1beta1_ProjectStatus(in *core.ProjectSta
 	out.StaleSinceTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
 	out.StaleAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
 	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.UsageSummary = (*ProjectUsageSummary)(unsafe.Pointer(in.UsageSummary))
 	return nil
 }
 
@@ -5688,6 +6140,36 @@ func Convert_core_ProjectTolerations_To_v1beta1_ProjectTolerations(in *core.Proj
 	return autoConvert_core_ProjectTolerations_To_v1beta1_ProjectTolerations(in, out, s)
 }
 
+func autoConvert_v1beta1_ProjectUsageSummary_To_core_ProjectUsageSummary(in *ProjectUsageSummary, out *core.ProjectUsageSummary, s conversion.Scope) error {
+	out.Shoots = in.Shoots
+	out.HibernatedShoots = in.HibernatedShoots
+	out.Nodes = in.Nodes
+	out.CPU = in.CPU
+	out.Memory = in.Memory
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_v1beta1_ProjectUsageSummary_To_core_ProjectUsageSummary is an autogenerated conversion function.
+func Convert_v1beta1_ProjectUsageSummary_To_core_ProjectUsageSummary(in *ProjectUsageSummary, out *core.ProjectUsageSummary, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectUsageSummary_To_core_ProjectUsageSummary(in, out, s)
+}
+
+func autoConvert_core_ProjectUsageSummary_To_v1beta1_ProjectUsageSummary(in *core.ProjectUsageSummary, out *ProjectUsageSummary, s conversion.Scope) error {
+	out.Shoots = in.Shoots
+	out.HibernatedShoots = in.HibernatedShoots
+	out.Nodes = in.Nodes
+	out.CPU = in.CPU
+	out.Memory = in.Memory
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_core_ProjectUsageSummary_To_v1beta1_ProjectUsageSummary is an autogenerated conversion function.
+func Convert_core_ProjectUsageSummary_To_v1beta1_ProjectUsageSummary(in *core.ProjectUsageSummary, out *ProjectUsageSummary, s conversion.Scope) error {
+	return autoConvert_core_ProjectUsageSummary_To_v1beta1_ProjectUsageSummary(in, out, s)
+}
+
 func autoConvert_v1beta1_Provider_To_core_Provider(in *Provider, out *core.Provider, s conversion.Scope) error {
 	out.Type = in.Type
 	out.ControlPlaneConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ControlPlaneConfig))
@@ -5834,6 +6316,60 @@ func Convert_core_Region_To_v1beta1_Region(in *core.Region, out *Region, s conve
 	return autoConvert_core_Region_To_v1beta1_Region(in, out, s)
 }
 
+func autoConvert_v1beta1_RegistryMirror_To_core_RegistryMirror(in *RegistryMirror, out *core.RegistryMirror, s conversion.Scope) error {
+	out.Upstream = in.Upstream
+	out.Hosts = *(*[]string)(unsafe.Pointer(&in.Hosts))
+	out.Regions = *(*[]string)(unsafe.Pointer(&in.Regions))
+	return nil
+}
+
+// Convert_v1beta1_RegistryMirror_To_core_RegistryMirror is an autogenerated conversion function.
+func Convert_v1beta1_RegistryMirror_To_core_RegistryMirror(in *RegistryMirror, out *core.RegistryMirror, s conversion.Scope) error {
+	return autoConvert_v1beta1_RegistryMirror_To_core_RegistryMirror(in, out, s)
+}
+
+func autoConvert_core_RegistryMirror_To_v1beta1_RegistryMirror(in *core.RegistryMirror, out *RegistryMirror, s conversion.Scope) error {
+	out.Upstream = in.Upstream
+	out.Hosts = *(*[]string)(unsafe.Pointer(&in.Hosts))
+	out.Regions = *(*[]string)(unsafe.Pointer(&in.Regions))
+	return nil
+}
+
+// Convert_core_RegistryMirror_To_v1beta1_RegistryMirror is an autogenerated conversion function.
+func Convert_core_RegistryMirror_To_v1beta1_RegistryMirror(in *core.RegistryMirror, out *RegistryMirror, s conversion.Scope) error {
+	return autoConvert_core_RegistryMirror_To_v1beta1_RegistryMirror(in, out, s)
+}
+
+func autoConvert_v1beta1_ResourceBackupAddon_To_core_ResourceBackupAddon(in *ResourceBackupAddon, out *core.ResourceBackupAddon, s conversion.Scope) error {
+	if err := Convert_v1beta1_Addon_To_core_Addon(&in.Addon, &out.Addon, s); err != nil {
+		return err
+	}
+	out.Schedule = (*string)(unsafe.Pointer(in.Schedule))
+	out.RetentionPeriod = (*metav1.Duration)(unsafe.Pointer(in.RetentionPeriod))
+	out.IncludedNamespaces = *(*[]string)(unsafe.Pointer(&in.IncludedNamespaces))
+	return nil
+}
+
+// Convert_v1beta1_ResourceBackupAddon_To_core_ResourceBackupAddon is an autogenerated conversion function.
+func Convert_v1beta1_ResourceBackupAddon_To_core_ResourceBackupAddon(in *ResourceBackupAddon, out *core.ResourceBackupAddon, s conversion.Scope) error {
+	return autoConvert_v1beta1_ResourceBackupAddon_To_core_ResourceBackupAddon(in, out, s)
+}
+
+func autoConvert_core_ResourceBackupAddon_To_v1beta1_ResourceBackupAddon(in *core.ResourceBackupAddon, out *ResourceBackupAddon, s conversion.Scope) error {
+	if err := Convert_core_Addon_To_v1beta1_Addon(&in.Addon, &out.Addon, s); err != nil {
+		return err
+	}
+	out.Schedule = (*string)(unsafe.Pointer(in.Schedule))
+	out.RetentionPeriod = (*metav1.Duration)(unsafe.Pointer(in.RetentionPeriod))
+	out.IncludedNamespaces = *(*[]string)(unsafe.Pointer(&in.IncludedNamespaces))
+	return nil
+}
+
+// Convert_core_ResourceBackupAddon_To_v1beta1_ResourceBackupAddon is an autogenerated conversion function.
+func Convert_core_ResourceBackupAddon_To_v1beta1_ResourceBackupAddon(in *core.ResourceBackupAddon, out *ResourceBackupAddon, s conversion.Scope) error {
+	return autoConvert_core_ResourceBackupAddon_To_v1beta1_ResourceBackupAddon(in, out, s)
+}
+
 func autoConvert_v1beta1_ResourceData_To_core_ResourceData(in *ResourceData, out *core.ResourceData, s conversion.Scope) error {
 	out.CrossVersionObjectReference = in.CrossVersionObjectReference
 	out.Data = in.Data
@@ -5905,6 +6441,9 @@ func autoConvert_v1beta1_SecretBinding_To_core_SecretBinding(in *SecretBinding,
 	out.SecretRef = in.SecretRef
 	out.Quotas = *(*[]v1.ObjectReference)(unsafe.Pointer(&in.Quotas))
 	out.Provider = (*core.SecretBindingProvider)(unsafe.Pointer(in.Provider))
+	if err := Convert_v1beta1_SecretBindingStatus_To_core_SecretBindingStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5918,6 +6457,9 @@ func autoConvert_core_SecretBinding_To_v1beta1_SecretBinding(in *core.SecretBind
 	out.SecretRef = in.SecretRef
 	out.Quotas = *(*[]v1.ObjectReference)(unsafe.Pointer(&in.Quotas))
 	out.Provider = (*SecretBindingProvider)(unsafe.Pointer(in.Provider))
+	if err := Convert_core_SecretBindingStatus_To_v1beta1_SecretBindingStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5968,6 +6510,26 @@ func Convert_core_SecretBindingProvider_To_v1beta1_SecretBindingProvider(in *cor
 	return autoConvert_core_SecretBindingProvider_To_v1beta1_SecretBindingProvider(in, out, s)
 }
 
+func autoConvert_v1beta1_SecretBindingStatus_To_core_SecretBindingStatus(in *SecretBindingStatus, out *core.SecretBindingStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1beta1_SecretBindingStatus_To_core_SecretBindingStatus is an autogenerated conversion function.
+func Convert_v1beta1_SecretBindingStatus_To_core_SecretBindingStatus(in *SecretBindingStatus, out *core.SecretBindingStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_SecretBindingStatus_To_core_SecretBindingStatus(in, out, s)
+}
+
+func autoConvert_core_SecretBindingStatus_To_v1beta1_SecretBindingStatus(in *core.SecretBindingStatus, out *SecretBindingStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]Condition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_core_SecretBindingStatus_To_v1beta1_SecretBindingStatus is an autogenerated conversion function.
+func Convert_core_SecretBindingStatus_To_v1beta1_SecretBindingStatus(in *core.SecretBindingStatus, out *SecretBindingStatus, s conversion.Scope) error {
+	return autoConvert_core_SecretBindingStatus_To_v1beta1_SecretBindingStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_Seed_To_core_Seed(in *Seed, out *core.Seed, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_SeedSpec_To_core_SeedSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -6000,6 +6562,54 @@ func Convert_core_Seed_To_v1beta1_Seed(in *core.Seed, out *Seed, s conversion.Sc
 	return autoConvert_core_Seed_To_v1beta1_Seed(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedAffinity_To_core_SeedAffinity(in *SeedAffinity, out *core.SeedAffinity, s conversion.Scope) error {
+	out.RequiredDuringScheduling = *(*[]metav1.LabelSelector)(unsafe.Pointer(&in.RequiredDuringScheduling))
+	out.PreferredDuringScheduling = *(*[]core.WeightedSeedAffinityTerm)(unsafe.Pointer(&in.PreferredDuringScheduling))
+	return nil
+}
+
+// Convert_v1beta1_SeedAffinity_To_core_SeedAffinity is an autogenerated conversion function.
+func Convert_v1beta1_SeedAffinity_To_core_SeedAffinity(in *SeedAffinity, out *core.SeedAffinity, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedAffinity_To_core_SeedAffinity(in, out, s)
+}
+
+func autoConvert_core_SeedAffinity_To_v1beta1_SeedAffinity(in *core.SeedAffinity, out *SeedAffinity, s conversion.Scope) error {
+	out.RequiredDuringScheduling = *(*[]metav1.LabelSelector)(unsafe.Pointer(&in.RequiredDuringScheduling))
+	out.PreferredDuringScheduling = *(*[]WeightedSeedAffinityTerm)(unsafe.Pointer(&in.PreferredDuringScheduling))
+	return nil
+}
+
+// Convert_core_SeedAffinity_To_v1beta1_SeedAffinity is an autogenerated conversion function.
+func Convert_core_SeedAffinity_To_v1beta1_SeedAffinity(in *core.SeedAffinity, out *SeedAffinity, s conversion.Scope) error {
+	return autoConvert_core_SeedAffinity_To_v1beta1_SeedAffinity(in, out, s)
+}
+
+func autoConvert_v1beta1_SeedAvailability_To_core_SeedAvailability(in *SeedAvailability, out *core.SeedAvailability, s conversion.Scope) error {
+	out.DowntimeWindows = in.DowntimeWindows
+	out.AccumulatedDowntimeSeconds = in.AccumulatedDowntimeSeconds
+	out.LastDowntimeBegin = (*metav1.Time)(unsafe.Pointer(in.LastDowntimeBegin))
+	out.LastDowntimeEnd = (*metav1.Time)(unsafe.Pointer(in.LastDowntimeEnd))
+	return nil
+}
+
+// Convert_v1beta1_SeedAvailability_To_core_SeedAvailability is an autogenerated conversion function.
+func Convert_v1beta1_SeedAvailability_To_core_SeedAvailability(in *SeedAvailability, out *core.SeedAvailability, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedAvailability_To_core_SeedAvailability(in, out, s)
+}
+
+func autoConvert_core_SeedAvailability_To_v1beta1_SeedAvailability(in *core.SeedAvailability, out *SeedAvailability, s conversion.Scope) error {
+	out.DowntimeWindows = in.DowntimeWindows
+	out.AccumulatedDowntimeSeconds = in.AccumulatedDowntimeSeconds
+	out.LastDowntimeBegin = (*metav1.Time)(unsafe.Pointer(in.LastDowntimeBegin))
+	out.LastDowntimeEnd = (*metav1.Time)(unsafe.Pointer(in.LastDowntimeEnd))
+	return nil
+}
+
+// Convert_core_SeedAvailability_To_v1beta1_SeedAvailability is an autogenerated conversion function.
+func Convert_core_SeedAvailability_To_v1beta1_SeedAvailability(in *core.SeedAvailability, out *SeedAvailability, s conversion.Scope) error {
+	return autoConvert_core_SeedAvailability_To_v1beta1_SeedAvailability(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedDNS_To_core_SeedDNS(in *SeedDNS, out *core.SeedDNS, s conversion.Scope) error {
 	out.Provider = (*core.SeedDNSProvider)(unsafe.Pointer(in.Provider))
 	out.Internal = (*core.SeedDNSProviderConfig)(unsafe.Pointer(in.Internal))
@@ -6192,6 +6802,26 @@ func Convert_core_SeedSelector_To_v1beta1_SeedSelector(in *core.SeedSelector, ou
 	return autoConvert_core_SeedSelector_To_v1beta1_SeedSelector(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedSettingDNSCache_To_core_SeedSettingDNSCache(in *SeedSettingDNSCache, out *core.SeedSettingDNSCache, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1beta1_SeedSettingDNSCache_To_core_SeedSettingDNSCache is an autogenerated conversion function.
+func Convert_v1beta1_SeedSettingDNSCache_To_core_SeedSettingDNSCache(in *SeedSettingDNSCache, out *core.SeedSettingDNSCache, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedSettingDNSCache_To_core_SeedSettingDNSCache(in, out, s)
+}
+
+func autoConvert_core_SeedSettingDNSCache_To_v1beta1_SeedSettingDNSCache(in *core.SeedSettingDNSCache, out *SeedSettingDNSCache, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_core_SeedSettingDNSCache_To_v1beta1_SeedSettingDNSCache is an autogenerated conversion function.
+func Convert_core_SeedSettingDNSCache_To_v1beta1_SeedSettingDNSCache(in *core.SeedSettingDNSCache, out *SeedSettingDNSCache, s conversion.Scope) error {
+	return autoConvert_core_SeedSettingDNSCache_To_v1beta1_SeedSettingDNSCache(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedSettingDependencyWatchdog_To_core_SeedSettingDependencyWatchdog(in *SeedSettingDependencyWatchdog, out *core.SeedSettingDependencyWatchdog, s conversion.Scope) error {
 	out.Weeder = (*core.SeedSettingDependencyWatchdogWeeder)(unsafe.Pointer(in.Weeder))
 	out.Prober = (*core.SeedSettingDependencyWatchdogProber)(unsafe.Pointer(in.Prober))
@@ -6216,6 +6846,7 @@ func Convert_core_SeedSettingDependencyWatchdog_To_v1beta1_SeedSettingDependency
 
 func autoConvert_v1beta1_SeedSettingDependencyWatchdogProber_To_core_SeedSettingDependencyWatchdogProber(in *SeedSettingDependencyWatchdogProber, out *core.SeedSettingDependencyWatchdogProber, s conversion.Scope) error {
 	out.Enabled = in.Enabled
+	out.ProbeInterval = (*metav1.Duration)(unsafe.Pointer(in.ProbeInterval))
 	return nil
 }
 
@@ -6226,6 +6857,7 @@ func Convert_v1beta1_SeedSettingDependencyWatchdogProber_To_core_SeedSettingDepe
 
 func autoConvert_core_SeedSettingDependencyWatchdogProber_To_v1beta1_SeedSettingDependencyWatchdogProber(in *core.SeedSettingDependencyWatchdogProber, out *SeedSettingDependencyWatchdogProber, s conversion.Scope) error {
 	out.Enabled = in.Enabled
+	out.ProbeInterval = (*metav1.Duration)(unsafe.Pointer(in.ProbeInterval))
 	return nil
 }
 
@@ -6236,6 +6868,7 @@ func Convert_core_SeedSettingDependencyWatchdogProber_To_v1beta1_SeedSettingDepe
 
 func autoConvert_v1beta1_SeedSettingDependencyWatchdogWeeder_To_core_SeedSettingDependencyWatchdogWeeder(in *SeedSettingDependencyWatchdogWeeder, out *core.SeedSettingDependencyWatchdogWeeder, s conversion.Scope) error {
 	out.Enabled = in.Enabled
+	out.WatchDuration = (*metav1.Duration)(unsafe.Pointer(in.WatchDuration))
 	return nil
 }
 
@@ -6246,6 +6879,7 @@ func Convert_v1beta1_SeedSettingDependencyWatchdogWeeder_To_core_SeedSettingDepe
 
 func autoConvert_core_SeedSettingDependencyWatchdogWeeder_To_v1beta1_SeedSettingDependencyWatchdogWeeder(in *core.SeedSettingDependencyWatchdogWeeder, out *SeedSettingDependencyWatchdogWeeder, s conversion.Scope) error {
 	out.Enabled = in.Enabled
+	out.WatchDuration = (*metav1.Duration)(unsafe.Pointer(in.WatchDuration))
 	return nil
 }
 
@@ -6280,6 +6914,7 @@ func autoConvert_v1beta1_SeedSettingExcessCapacityReservationConfig_To_core_Seed
 	out.Resources = *(*v1.ResourceList)(unsafe.Pointer(&in.Resources))
 	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
 	out.Tolerations = *(*[]v1.Toleration)(unsafe.Pointer(&in.Tolerations))
+	out.Percentage = (*int32)(unsafe.Pointer(in.Percentage))
 	return nil
 }
 
@@ -6292,6 +6927,7 @@ func autoConvert_core_SeedSettingExcessCapacityReservationConfig_To_v1beta1_Seed
 	out.Resources = *(*v1.ResourceList)(unsafe.Pointer(&in.Resources))
 	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
 	out.Tolerations = *(*[]v1.Toleration)(unsafe.Pointer(&in.Tolerations))
+	out.Percentage = (*int32)(unsafe.Pointer(in.Percentage))
 	return nil
 }
 
@@ -6447,6 +7083,8 @@ func autoConvert_v1beta1_SeedSettings_To_core_SeedSettings(in *SeedSettings, out
 	out.VerticalPodAutoscaler = (*core.SeedSettingVerticalPodAutoscaler)(unsafe.Pointer(in.VerticalPodAutoscaler))
 	out.DependencyWatchdog = (*core.SeedSettingDependencyWatchdog)(unsafe.Pointer(in.DependencyWatchdog))
 	out.TopologyAwareRouting = (*core.SeedSettingTopologyAwareRouting)(unsafe.Pointer(in.TopologyAwareRouting))
+	out.IstioIngressGateway = (*core.SeedSettingIstioIngressGateway)(unsafe.Pointer(in.IstioIngressGateway))
+	out.DNSCache = (*core.SeedSettingDNSCache)(unsafe.Pointer(in.DNSCache))
 	return nil
 }
 
@@ -6462,6 +7100,8 @@ func autoConvert_core_SeedSettings_To_v1beta1_SeedSettings(in *core.SeedSettings
 	out.VerticalPodAutoscaler = (*SeedSettingVerticalPodAutoscaler)(unsafe.Pointer(in.VerticalPodAutoscaler))
 	out.DependencyWatchdog = (*SeedSettingDependencyWatchdog)(unsafe.Pointer(in.DependencyWatchdog))
 	out.TopologyAwareRouting = (*SeedSettingTopologyAwareRouting)(unsafe.Pointer(in.TopologyAwareRouting))
+	out.IstioIngressGateway = (*SeedSettingIstioIngressGateway)(unsafe.Pointer(in.IstioIngressGateway))
+	out.DNSCache = (*SeedSettingDNSCache)(unsafe.Pointer(in.DNSCache))
 	return nil
 }
 
@@ -6532,6 +7172,7 @@ func autoConvert_v1beta1_SeedStatus_To_core_SeedStatus(in *SeedStatus, out *core
 	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
 	out.ClientCertificateExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
 	out.LastOperation = (*core.LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.Availability = (*core.SeedAvailability)(unsafe.Pointer(in.Availability))
 	return nil
 }
 
@@ -6550,6 +7191,7 @@ func autoConvert_core_SeedStatus_To_v1beta1_SeedStatus(in *core.SeedStatus, out
 	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
 	out.ClientCertificateExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
 	out.LastOperation = (*LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.Availability = (*SeedAvailability)(unsafe.Pointer(in.Availability))
 	return nil
 }
 
@@ -6788,6 +7430,7 @@ func autoConvert_v1beta1_ShootCredentialsRotation_To_core_ShootCredentialsRotati
 	out.Observability = (*core.ObservabilityRotation)(unsafe.Pointer(in.Observability))
 	out.ServiceAccountKey = (*core.ServiceAccountKeyRotation)(unsafe.Pointer(in.ServiceAccountKey))
 	out.ETCDEncryptionKey = (*core.ETCDEncryptionKeyRotation)(unsafe.Pointer(in.ETCDEncryptionKey))
+	out.CloudCredentials = (*core.CloudCredentialsRotation)(unsafe.Pointer(in.CloudCredentials))
 	return nil
 }
 
@@ -6802,6 +7445,7 @@ func autoConvert_core_ShootCredentialsRotation_To_v1beta1_ShootCredentialsRotati
 	out.Observability = (*ObservabilityRotation)(unsafe.Pointer(in.Observability))
 	out.ServiceAccountKey = (*ServiceAccountKeyRotation)(unsafe.Pointer(in.ServiceAccountKey))
 	out.ETCDEncryptionKey = (*ETCDEncryptionKeyRotation)(unsafe.Pointer(in.ETCDEncryptionKey))
+	out.CloudCredentials = (*CloudCredentialsRotation)(unsafe.Pointer(in.CloudCredentials))
 	return nil
 }
 
@@ -6975,6 +7619,8 @@ func autoConvert_v1beta1_ShootSpec_To_core_ShootSpec(in *ShootSpec, out *core.Sh
 	out.CloudProfile = (*core.CloudProfileReference)(unsafe.Pointer(in.CloudProfile))
 	out.CredentialsBindingName = (*string)(unsafe.Pointer(in.CredentialsBindingName))
 	out.AccessRestrictions = *(*[]core.AccessRestrictionWithOptions)(unsafe.Pointer(&in.AccessRestrictions))
+	out.SeedAffinity = (*core.SeedAffinity)(unsafe.Pointer(in.SeedAffinity))
+	out.ManagedAddons = *(*[]core.ManagedAddon)(unsafe.Pointer(&in.ManagedAddons))
 	return nil
 }
 
@@ -7012,6 +7658,8 @@ func autoConvert_core_ShootSpec_To_v1beta1_ShootSpec(in *core.ShootSpec, out *Sh
 	out.CloudProfile = (*CloudProfileReference)(unsafe.Pointer(in.CloudProfile))
 	out.CredentialsBindingName = (*string)(unsafe.Pointer(in.CredentialsBindingName))
 	out.AccessRestrictions = *(*[]AccessRestrictionWithOptions)(unsafe.Pointer(&in.AccessRestrictions))
+	out.SeedAffinity = (*SeedAffinity)(unsafe.Pointer(in.SeedAffinity))
+	out.ManagedAddons = *(*[]ManagedAddon)(unsafe.Pointer(&in.ManagedAddons))
 	return nil
 }
 
@@ -7116,6 +7764,9 @@ func autoConvert_v1beta1_ShootStatus_To_core_ShootStatus(in *ShootStatus, out *c
 	out.Networking = (*core.NetworkingStatus)(unsafe.Pointer(in.Networking))
 	out.InPlaceUpdates = (*core.InPlaceUpdatesStatus)(unsafe.Pointer(in.InPlaceUpdates))
 	out.ManualWorkerPoolRollout = (*core.ManualWorkerPoolRollout)(unsafe.Pointer(in.ManualWorkerPoolRollout))
+	out.ResourceConsumption = (*core.ControlPlaneResourceConsumption)(unsafe.Pointer(in.ResourceConsumption))
+	out.WorkerPools = *(*[]core.WorkerPoolStatus)(unsafe.Pointer(&in.WorkerPools))
+	out.UpgradePaths = (*core.ShootUpgradePaths)(unsafe.Pointer(in.UpgradePaths))
 	return nil
 }
 
@@ -7148,6 +7799,9 @@ func autoConvert_core_ShootStatus_To_v1beta1_ShootStatus(in *core.ShootStatus, o
 	out.Networking = (*NetworkingStatus)(unsafe.Pointer(in.Networking))
 	out.InPlaceUpdates = (*InPlaceUpdatesStatus)(unsafe.Pointer(in.InPlaceUpdates))
 	out.ManualWorkerPoolRollout = (*ManualWorkerPoolRollout)(unsafe.Pointer(in.ManualWorkerPoolRollout))
+	out.ResourceConsumption = (*ControlPlaneResourceConsumption)(unsafe.Pointer(in.ResourceConsumption))
+	out.WorkerPools = *(*[]WorkerPoolStatus)(unsafe.Pointer(&in.WorkerPools))
+	out.UpgradePaths = (*ShootUpgradePaths)(unsafe.Pointer(in.UpgradePaths))
 	return nil
 }
 
@@ -7182,8 +7836,37 @@ func Convert_core_ShootTemplate_To_v1beta1_ShootTemplate(in *core.ShootTemplate,
 	return autoConvert_core_ShootTemplate_To_v1beta1_ShootTemplate(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootUpgradePaths_To_core_ShootUpgradePaths(in *ShootUpgradePaths, out *core.ShootUpgradePaths, s conversion.Scope) error {
+	if err := Convert_v1beta1_VersionUpgradePath_To_core_VersionUpgradePath(&in.Kubernetes, &out.Kubernetes, s); err != nil {
+		return err
+	}
+	out.MachineImages = *(*[]core.MachineImageUpgradePath)(unsafe.Pointer(&in.MachineImages))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_v1beta1_ShootUpgradePaths_To_core_ShootUpgradePaths is an autogenerated conversion function.
+func Convert_v1beta1_ShootUpgradePaths_To_core_ShootUpgradePaths(in *ShootUpgradePaths, out *core.ShootUpgradePaths, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootUpgradePaths_To_core_ShootUpgradePaths(in, out, s)
+}
+
+func autoConvert_core_ShootUpgradePaths_To_v1beta1_ShootUpgradePaths(in *core.ShootUpgradePaths, out *ShootUpgradePaths, s conversion.Scope) error {
+	if err := Convert_core_VersionUpgradePath_To_v1beta1_VersionUpgradePath(&in.Kubernetes, &out.Kubernetes, s); err != nil {
+		return err
+	}
+	out.MachineImages = *(*[]MachineImageUpgradePath)(unsafe.Pointer(&in.MachineImages))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_core_ShootUpgradePaths_To_v1beta1_ShootUpgradePaths is an autogenerated conversion function.
+func Convert_core_ShootUpgradePaths_To_v1beta1_ShootUpgradePaths(in *core.ShootUpgradePaths, out *ShootUpgradePaths, s conversion.Scope) error {
+	return autoConvert_core_ShootUpgradePaths_To_v1beta1_ShootUpgradePaths(in, out, s)
+}
+
 func autoConvert_v1beta1_StructuredAuthentication_To_core_StructuredAuthentication(in *StructuredAuthentication, out *core.StructuredAuthentication, s conversion.Scope) error {
 	out.ConfigMapName = in.ConfigMapName
+	out.JWTAuthenticators = *(*[]core.JWTAuthenticator)(unsafe.Pointer(&in.JWTAuthenticators))
 	return nil
 }
 
@@ -7194,6 +7877,7 @@ func Convert_v1beta1_StructuredAuthentication_To_core_StructuredAuthentication(i
 
 func autoConvert_core_StructuredAuthentication_To_v1beta1_StructuredAuthentication(in *core.StructuredAuthentication, out *StructuredAuthentication, s conversion.Scope) error {
 	out.ConfigMapName = in.ConfigMapName
+	out.JWTAuthenticators = *(*[]JWTAuthenticator)(unsafe.Pointer(&in.JWTAuthenticators))
 	return nil
 }
 
@@ -7268,6 +7952,32 @@ func Convert_core_Toleration_To_v1beta1_Toleration(in *core.Toleration, out *Tol
 	return autoConvert_core_Toleration_To_v1beta1_Toleration(in, out, s)
 }
 
+func autoConvert_v1beta1_VersionUpgradePath_To_core_VersionUpgradePath(in *VersionUpgradePath, out *core.VersionUpgradePath, s conversion.Scope) error {
+	out.Version = in.Version
+	out.NextPatch = (*string)(unsafe.Pointer(in.NextPatch))
+	out.NextMinor = (*string)(unsafe.Pointer(in.NextMinor))
+	out.ExpirationDate = (*metav1.Time)(unsafe.Pointer(in.ExpirationDate))
+	return nil
+}
+
+// Convert_v1beta1_VersionUpgradePath_To_core_VersionUpgradePath is an autogenerated conversion function.
+func Convert_v1beta1_VersionUpgradePath_To_core_VersionUpgradePath(in *VersionUpgradePath, out *core.VersionUpgradePath, s conversion.Scope) error {
+	return autoConvert_v1beta1_VersionUpgradePath_To_core_VersionUpgradePath(in, out, s)
+}
+
+func autoConvert_core_VersionUpgradePath_To_v1beta1_VersionUpgradePath(in *core.VersionUpgradePath, out *VersionUpgradePath, s conversion.Scope) error {
+	out.Version = in.Version
+	out.NextPatch = (*string)(unsafe.Pointer(in.NextPatch))
+	out.NextMinor = (*string)(unsafe.Pointer(in.NextMinor))
+	out.ExpirationDate = (*metav1.Time)(unsafe.Pointer(in.ExpirationDate))
+	return nil
+}
+
+// Convert_core_VersionUpgradePath_To_v1beta1_VersionUpgradePath is an autogenerated conversion function.
+func Convert_core_VersionUpgradePath_To_v1beta1_VersionUpgradePath(in *core.VersionUpgradePath, out *VersionUpgradePath, s conversion.Scope) error {
+	return autoConvert_core_VersionUpgradePath_To_v1beta1_VersionUpgradePath(in, out, s)
+}
+
 func autoConvert_v1beta1_VerticalPodAutoscaler_To_core_VerticalPodAutoscaler(in *VerticalPodAutoscaler, out *core.VerticalPodAutoscaler, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.EvictAfterOOMThreshold = (*metav1.Duration)(unsafe.Pointer(in.EvictAfterOOMThreshold))
@@ -7400,6 +8110,28 @@ func Convert_core_WatchCacheSizes_To_v1beta1_WatchCacheSizes(in *core.WatchCache
 	return autoConvert_core_WatchCacheSizes_To_v1beta1_WatchCacheSizes(in, out, s)
 }
 
+func autoConvert_v1beta1_WeightedSeedAffinityTerm_To_core_WeightedSeedAffinityTerm(in *WeightedSeedAffinityTerm, out *core.WeightedSeedAffinityTerm, s conversion.Scope) error {
+	out.Weight = in.Weight
+	out.LabelSelector = in.LabelSelector
+	return nil
+}
+
+// Convert_v1beta1_WeightedSeedAffinityTerm_To_core_WeightedSeedAffinityTerm is an autogenerated conversion function.
+func Convert_v1beta1_WeightedSeedAffinityTerm_To_core_WeightedSeedAffinityTerm(in *WeightedSeedAffinityTerm, out *core.WeightedSeedAffinityTerm, s conversion.Scope) error {
+	return autoConvert_v1beta1_WeightedSeedAffinityTerm_To_core_WeightedSeedAffinityTerm(in, out, s)
+}
+
+func autoConvert_core_WeightedSeedAffinityTerm_To_v1beta1_WeightedSeedAffinityTerm(in *core.WeightedSeedAffinityTerm, out *WeightedSeedAffinityTerm, s conversion.Scope) error {
+	out.Weight = in.Weight
+	out.LabelSelector = in.LabelSelector
+	return nil
+}
+
+// Convert_core_WeightedSeedAffinityTerm_To_v1beta1_WeightedSeedAffinityTerm is an autogenerated conversion function.
+func Convert_core_WeightedSeedAffinityTerm_To_v1beta1_WeightedSeedAffinityTerm(in *core.WeightedSeedAffinityTerm, out *WeightedSeedAffinityTerm, s conversion.Scope) error {
+	return autoConvert_core_WeightedSeedAffinityTerm_To_v1beta1_WeightedSeedAffinityTerm(in, out, s)
+}
+
 func autoConvert_v1beta1_Worker_To_core_Worker(in *Worker, out *core.Worker, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
@@ -7435,6 +8167,7 @@ func autoConvert_v1beta1_Worker_To_core_Worker(in *Worker, out *core.Worker, s c
 	out.Priority = (*int32)(unsafe.Pointer(in.Priority))
 	out.UpdateStrategy = (*core.MachineUpdateStrategy)(unsafe.Pointer(in.UpdateStrategy))
 	out.ControlPlane = (*core.WorkerControlPlane)(unsafe.Pointer(in.ControlPlane))
+	out.NodeWorkloadIdentityName = (*string)(unsafe.Pointer(in.NodeWorkloadIdentityName))
 	return nil
 }
 
@@ -7478,6 +8211,7 @@ func autoConvert_core_Worker_To_v1beta1_Worker(in *core.Worker, out *Worker, s c
 	out.Priority = (*int32)(unsafe.Pointer(in.Priority))
 	out.UpdateStrategy = (*MachineUpdateStrategy)(unsafe.Pointer(in.UpdateStrategy))
 	out.ControlPlane = (*WorkerControlPlane)(unsafe.Pointer(in.ControlPlane))
+	out.NodeWorkloadIdentityName = (*string)(unsafe.Pointer(in.NodeWorkloadIdentityName))
 	return nil
 }
 
@@ -7544,6 +8278,36 @@ func Convert_core_WorkerKubernetes_To_v1beta1_WorkerKubernetes(in *core.WorkerKu
 	return autoConvert_core_WorkerKubernetes_To_v1beta1_WorkerKubernetes(in, out, s)
 }
 
+func autoConvert_v1beta1_WorkerPoolStatus_To_core_WorkerPoolStatus(in *WorkerPoolStatus, out *core.WorkerPoolStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.DesiredMachines = in.DesiredMachines
+	out.CurrentMachines = in.CurrentMachines
+	out.UpdatedMachines = in.UpdatedMachines
+	out.UnavailableMachines = in.UnavailableMachines
+	out.LastRolloutTime = (*metav1.Time)(unsafe.Pointer(in.LastRolloutTime))
+	return nil
+}
+
+// Convert_v1beta1_WorkerPoolStatus_To_core_WorkerPoolStatus is an autogenerated conversion function.
+func Convert_v1beta1_WorkerPoolStatus_To_core_WorkerPoolStatus(in *WorkerPoolStatus, out *core.WorkerPoolStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerPoolStatus_To_core_WorkerPoolStatus(in, out, s)
+}
+
+func autoConvert_core_WorkerPoolStatus_To_v1beta1_WorkerPoolStatus(in *core.WorkerPoolStatus, out *WorkerPoolStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.DesiredMachines = in.DesiredMachines
+	out.CurrentMachines = in.CurrentMachines
+	out.UpdatedMachines = in.UpdatedMachines
+	out.UnavailableMachines = in.UnavailableMachines
+	out.LastRolloutTime = (*metav1.Time)(unsafe.Pointer(in.LastRolloutTime))
+	return nil
+}
+
+// Convert_core_WorkerPoolStatus_To_v1beta1_WorkerPoolStatus is an autogenerated conversion function.
+func Convert_core_WorkerPoolStatus_To_v1beta1_WorkerPoolStatus(in *core.WorkerPoolStatus, out *WorkerPoolStatus, s conversion.Scope) error {
+	return autoConvert_core_WorkerPoolStatus_To_v1beta1_WorkerPoolStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_WorkerSystemComponents_To_core_WorkerSystemComponents(in *WorkerSystemComponents, out *core.WorkerSystemComponents, s conversion.Scope) error {
 	out.Allow = in.Allow
 	return nil
@@ -7583,3 +8347,25 @@ func autoConvert_core_WorkersSettings_To_v1beta1_WorkersSettings(in *core.Worker
 func Convert_core_WorkersSettings_To_v1beta1_WorkersSettings(in *core.WorkersSettings, out *WorkersSettings, s conversion.Scope) error {
 	return autoConvert_core_WorkersSettings_To_v1beta1_WorkersSettings(in, out, s)
 }
+
+func autoConvert_v1beta1_ZoneEgressCIDRs_To_core_ZoneEgressCIDRs(in *ZoneEgressCIDRs, out *core.ZoneEgressCIDRs, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.CIDRs = *(*[]string)(unsafe.Pointer(&in.CIDRs))
+	return nil
+}
+
+// Convert_v1beta1_ZoneEgressCIDRs_To_core_ZoneEgressCIDRs is an autogenerated conversion function.
+func Convert_v1beta1_ZoneEgressCIDRs_To_core_ZoneEgressCIDRs(in *ZoneEgressCIDRs, out *core.ZoneEgressCIDRs, s conversion.Scope) error {
+	return autoConvert_v1beta1_ZoneEgressCIDRs_To_core_ZoneEgressCIDRs(in, out, s)
+}
+
+func autoConvert_core_ZoneEgressCIDRs_To_v1beta1_ZoneEgressCIDRs(in *core.ZoneEgressCIDRs, out *ZoneEgressCIDRs, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.CIDRs = *(*[]string)(unsafe.Pointer(&in.CIDRs))
+	return nil
+}
+
+// Convert_core_ZoneEgressCIDRs_To_v1beta1_ZoneEgressCIDRs is an autogenerated conversion function.
+func Convert_core_ZoneEgressCIDRs_To_v1beta1_ZoneEgressCIDRs(in *core.ZoneEgressCIDRs, out *ZoneEgressCIDRs, s conversion.Scope) error {
+	return autoConvert_core_ZoneEgressCIDRs_To_v1beta1_ZoneEgressCIDRs(in, out, s)
+}
@@ -146,6 +146,9 @@ func SetObjectDefaults_Shoot(in *Shoot) {
 		if in.Spec.Addons.NginxIngress != nil {
 			SetDefaults_NginxIngress(in.Spec.Addons.NginxIngress)
 		}
+		if in.Spec.Addons.ResourceBackup != nil {
+			SetDefaults_ResourceBackupAddon(in.Spec.Addons.ResourceBackup)
+		}
 	}
 	if in.Spec.Kubernetes.ClusterAutoscaler != nil {
 		SetDefaults_ClusterAutoscaler(in.Spec.Kubernetes.ClusterAutoscaler)
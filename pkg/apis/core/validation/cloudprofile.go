@@ -6,6 +6,7 @@ package validation
 
 import (
 	"fmt"
+	"net/url"
 	"slices"
 	"strings"
 
@@ -72,6 +73,7 @@ func ValidateCloudProfileSpec(spec *core.CloudProfileSpec, fldPath *field.Path)
 	allErrs = append(allErrs, validateCloudProfileRegions(spec.Regions, fldPath.Child("regions"))...)
 	allErrs = append(allErrs, validateCloudProfileBastion(spec, fldPath.Child("bastion"))...)
 	allErrs = append(allErrs, validateCloudProfileLimits(spec.Limits, fldPath.Child("limits"))...)
+	allErrs = append(allErrs, validateRegistryMirrors(spec.RegistryMirrors, fldPath.Child("registryMirrors"))...)
 	if spec.SeedSelector != nil {
 		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&spec.SeedSelector.LabelSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("seedSelector"))...)
 	}
@@ -404,6 +406,37 @@ func validateCloudProfileLimits(limits *core.Limits, fldPath *field.Path) field.
 	return allErrs
 }
 
+func validateRegistryMirrors(registryMirrors []core.RegistryMirror, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	upstreams := sets.New[string]()
+	for i, mirror := range registryMirrors {
+		idxPath := fldPath.Index(i)
+
+		if len(mirror.Upstream) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("upstream"), "must provide an upstream registry host"))
+		} else if strings.Contains(mirror.Upstream, "://") {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("upstream"), mirror.Upstream, "upstream must be a host (and optionally port) without scheme"))
+		}
+
+		if upstreams.Has(mirror.Upstream) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("upstream"), mirror.Upstream))
+		}
+		upstreams.Insert(mirror.Upstream)
+
+		if len(mirror.Hosts) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("hosts"), "must provide at least one mirror host"))
+		}
+		for j, host := range mirror.Hosts {
+			if u, err := url.Parse(host); err != nil || u.Scheme == "" || u.Host == "" {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("hosts").Index(j), host, "must be a valid URL with scheme and host"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 func validateCloudProfileLimitsUpdate(newLimits, oldLimits *core.Limits, fldPath *field.Path) field.ErrorList {
 	if newLimits == nil || oldLimits == nil {
 		// adding and removing limits is allowed
@@ -1292,6 +1292,83 @@ var _ = Describe("CloudProfile Validation Tests ", func() {
 				})
 			})
 
+			Context("registry mirrors validation", func() {
+				It("should allow unset registry mirrors", func() {
+					cloudProfile.Spec.RegistryMirrors = nil
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(BeEmpty())
+				})
+
+				It("should allow valid registry mirrors", func() {
+					cloudProfile.Spec.RegistryMirrors = []core.RegistryMirror{
+						{
+							Upstream: "registry.example.com",
+							Hosts:    []string{"https://mirror.example.com"},
+							Regions:  []string{"region1"},
+						},
+						{
+							Upstream: "quay.io",
+							Hosts:    []string{"https://mirror1.example.com", "https://mirror2.example.com"},
+						},
+					}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(BeEmpty())
+				})
+
+				It("should forbid empty upstream and missing hosts", func() {
+					cloudProfile.Spec.RegistryMirrors = []core.RegistryMirror{{}}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.registryMirrors[0].upstream"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.registryMirrors[0].hosts"),
+						})),
+					))
+				})
+
+				It("should forbid upstreams with scheme and invalid host URLs", func() {
+					cloudProfile.Spec.RegistryMirrors = []core.RegistryMirror{
+						{
+							Upstream: "https://registry.example.com",
+							Hosts:    []string{"mirror.example.com"},
+						},
+					}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.registryMirrors[0].upstream"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.registryMirrors[0].hosts[0]"),
+						})),
+					))
+				})
+
+				It("should forbid duplicate upstreams", func() {
+					cloudProfile.Spec.RegistryMirrors = []core.RegistryMirror{
+						{
+							Upstream: "registry.example.com",
+							Hosts:    []string{"https://mirror1.example.com"},
+						},
+						{
+							Upstream: "registry.example.com",
+							Hosts:    []string{"https://mirror2.example.com"},
+						},
+					}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.registryMirrors[1].upstream"),
+					}))))
+				})
+			})
+
 			It("should forbid unsupported seed selectors", func() {
 				cloudProfile.Spec.SeedSelector.MatchLabels["foo"] = "no/slash/allowed"
 
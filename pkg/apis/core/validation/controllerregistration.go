@@ -14,6 +14,7 @@ import (
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -92,6 +93,7 @@ func ValidateControllerResources(resources []core.ControllerResource, clusterTyp
 	var (
 		allErrs            = field.ErrorList{}
 		resourceKindToType = make(map[string]string)
+		addonNames         = sets.New[string]()
 	)
 
 	for i, resource := range resources {
@@ -127,10 +129,30 @@ func ValidateControllerResources(resources []core.ControllerResource, clusterTyp
 			if resource.Lifecycle != nil {
 				allErrs = append(allErrs, field.Forbidden(idxPath.Child("lifecycle"), fmt.Sprintf("field must not be set when kind != %s", extensionsv1alpha1.ExtensionResource)))
 			}
+			if resource.Addon != nil {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("addon"), fmt.Sprintf("field must not be set when kind != %s", extensionsv1alpha1.ExtensionResource)))
+			}
 
 			continue
 		}
 
+		if addon := resource.Addon; addon != nil {
+			addonPath := idxPath.Child("addon")
+
+			if len(addon.Name) == 0 {
+				allErrs = append(allErrs, field.Required(addonPath.Child("name"), "field is required"))
+			} else {
+				for _, msg := range validation.IsDNS1123Label(addon.Name) {
+					allErrs = append(allErrs, field.Invalid(addonPath.Child("name"), addon.Name, msg))
+				}
+			}
+
+			if addonNames.Has(addon.Name) {
+				allErrs = append(allErrs, field.Duplicate(addonPath.Child("name"), addon.Name))
+			}
+			addonNames.Insert(addon.Name)
+		}
+
 		var (
 			validClusterTypes      = sets.New(clusterTypes...)
 			compatibleClusterTypes = sets.New[core.ClusterType]()
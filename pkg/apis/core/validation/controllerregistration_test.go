@@ -348,6 +348,54 @@ var _ = Describe("validation", func() {
 			}))))
 		})
 
+		It("should allow registering an addon for kind Extension", func() {
+			resources[0].Kind = "Extension"
+			resources[0].Addon = &core.ControllerResourceAddon{Name: "my-addon"}
+
+			Expect(ValidateControllerResources(resources, validModes, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid registering an addon for kind != Extension", func() {
+			ctrlResource.Addon = &core.ControllerResourceAddon{Name: "my-addon"}
+			resources = []core.ControllerResource{ctrlResource}
+
+			errorList := ValidateControllerResources(resources, validModes, fldPath)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("resources[0].addon"),
+			}))))
+		})
+
+		It("should forbid registering an addon with an invalid name", func() {
+			resources[0].Kind = "Extension"
+			resources[0].Addon = &core.ControllerResourceAddon{Name: "Not a valid name"}
+
+			errorList := ValidateControllerResources(resources, validModes, fldPath)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("resources[0].addon.name"),
+			}))))
+		})
+
+		It("should forbid registering duplicate addon names", func() {
+			resources[0].Kind = "Extension"
+			resources[0].Addon = &core.ControllerResourceAddon{Name: "my-addon"}
+			resources = append(resources, core.ControllerResource{
+				Kind:  "Extension",
+				Type:  "arbitrary",
+				Addon: &core.ControllerResourceAddon{Name: "my-addon"},
+			})
+
+			errorList := ValidateControllerResources(resources, validModes, fldPath)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("resources[1].addon.name"),
+			}))))
+		})
+
 		It("should allow setting valid autoEnable modes", func() {
 			resources[0].Kind = "Extension"
 			resources[0].AutoEnable = []core.ClusterType{core.ClusterTypeShoot, core.ClusterTypeSeed}
@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencoreinstall "github.com/gardener/gardener/pkg/apis/core/install"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/core/validation"
+)
+
+// The fuzz targets in this file feed randomly mutated serializations of valid specs through decoding, defaulting,
+// conversion, and validation. They primarily guard against panics in the validation code and against defaulting that
+// turns a valid object into an invalid one. During a regular `go test` run only the seed corpus is executed, run
+// `go test -fuzz FuzzValidateShoot ./pkg/apis/core/validation` (and accordingly for the other targets) for an
+// exploratory fuzzing session.
+
+var fuzzScheme = runtime.NewScheme()
+
+func init() {
+	gardencoreinstall.Install(fuzzScheme)
+}
+
+// fuzzValidate runs a fuzz target that unmarshals the fuzzed data into a fresh object from newExternal, converts it to
+// the internal version, and validates it both before and after defaulting. Inputs that cannot be decoded or converted
+// are skipped. An object that validates successfully before defaulting but no longer does so afterwards fails the
+// target, as do panics in any of the involved code paths.
+func fuzzValidate(f *testing.F, seed runtime.Object, newExternal, newInternal func() runtime.Object, validate func(runtime.Object) field.ErrorList) {
+	seedJSON, err := json.Marshal(seed)
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(seedJSON)
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		obj := newExternal()
+		if err := json.Unmarshal(data, obj); err != nil {
+			t.Skip()
+		}
+
+		internal := newInternal()
+		if err := fuzzScheme.Convert(obj, internal, nil); err != nil {
+			t.Skip()
+		}
+		errsBeforeDefaulting := validate(internal)
+
+		defaulted := obj.DeepCopyObject()
+		fuzzScheme.Default(defaulted)
+
+		internalDefaulted := newInternal()
+		if err := fuzzScheme.Convert(defaulted, internalDefaulted, nil); err != nil {
+			t.Fatalf("object could be converted before defaulting but not afterwards: %v", err)
+		}
+
+		if errsAfterDefaulting := validate(internalDefaulted); len(errsBeforeDefaulting) == 0 && len(errsAfterDefaulting) > 0 {
+			t.Errorf("object was valid before defaulting but invalid afterwards: %v", errsAfterDefaulting)
+		}
+	})
+}
+
+func FuzzValidateShoot(f *testing.F) {
+	seed := &gardencorev1beta1.Shoot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "shoot",
+			Namespace: "garden-project",
+		},
+		Spec: gardencorev1beta1.ShootSpec{
+			CloudProfileName:  ptr.To("profile"),
+			Region:            "region",
+			SecretBindingName: ptr.To("secret-binding"),
+			Provider: gardencorev1beta1.Provider{
+				Type: "provider",
+				Workers: []gardencorev1beta1.Worker{{
+					Name: "worker",
+					Machine: gardencorev1beta1.Machine{
+						Type:         "machine-type",
+						Image:        &gardencorev1beta1.ShootMachineImage{Name: "image", Version: ptr.To("1.0.0")},
+						Architecture: ptr.To("amd64"),
+					},
+					Minimum: 1,
+					Maximum: 2,
+				}},
+			},
+			Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.31.1"},
+			Networking: &gardencorev1beta1.Networking{Type: ptr.To("some-network-plugin")},
+			DNS:        &gardencorev1beta1.DNS{Domain: ptr.To("shoot.example.com")},
+			Maintenance: &gardencorev1beta1.Maintenance{
+				TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"},
+			},
+		},
+	}
+
+	fuzzValidate(f, seed,
+		func() runtime.Object { return &gardencorev1beta1.Shoot{} },
+		func() runtime.Object { return &gardencore.Shoot{} },
+		func(obj runtime.Object) field.ErrorList { return validation.ValidateShoot(obj.(*gardencore.Shoot)) },
+	)
+}
+
+func FuzzValidateSeed(f *testing.F) {
+	seed := &gardencorev1beta1.Seed{
+		ObjectMeta: metav1.ObjectMeta{Name: "seed"},
+		Spec: gardencorev1beta1.SeedSpec{
+			Provider: gardencorev1beta1.SeedProvider{
+				Type:   "provider",
+				Region: "region",
+			},
+			DNS: gardencorev1beta1.SeedDNS{
+				Provider: &gardencorev1beta1.SeedDNSProvider{
+					Type:      "provider",
+					SecretRef: corev1.SecretReference{Name: "dns-secret", Namespace: "garden"},
+				},
+			},
+			Ingress: &gardencorev1beta1.Ingress{
+				Domain:     "ingress.seed.example.com",
+				Controller: gardencorev1beta1.IngressController{Kind: "nginx"},
+			},
+			Networks: gardencorev1beta1.SeedNetworks{
+				Pods:     "10.0.0.0/16",
+				Services: "10.1.0.0/16",
+				Nodes:    ptr.To("10.2.0.0/16"),
+			},
+		},
+	}
+
+	fuzzValidate(f, seed,
+		func() runtime.Object { return &gardencorev1beta1.Seed{} },
+		func() runtime.Object { return &gardencore.Seed{} },
+		func(obj runtime.Object) field.ErrorList { return validation.ValidateSeed(obj.(*gardencore.Seed)) },
+	)
+}
+
+func FuzzValidateCloudProfile(f *testing.F) {
+	seed := &gardencorev1beta1.CloudProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+		Spec: gardencorev1beta1.CloudProfileSpec{
+			Type: "provider",
+			Kubernetes: gardencorev1beta1.KubernetesSettings{
+				Versions: []gardencorev1beta1.ExpirableVersion{{Version: "1.31.1"}},
+			},
+			MachineImages: []gardencorev1beta1.MachineImage{{
+				Name: "image",
+				Versions: []gardencorev1beta1.MachineImageVersion{{
+					ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0"},
+					CRI:              []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRINameContainerD}},
+					Architectures:    []string{"amd64"},
+				}},
+			}},
+			MachineTypes: []gardencorev1beta1.MachineType{{
+				Name:         "machine-type",
+				Architecture: ptr.To("amd64"),
+			}},
+			Regions: []gardencorev1beta1.Region{{Name: "region"}},
+		},
+	}
+
+	fuzzValidate(f, seed,
+		func() runtime.Object { return &gardencorev1beta1.CloudProfile{} },
+		func() runtime.Object { return &gardencore.CloudProfile{} },
+		func(obj runtime.Object) field.ErrorList {
+			return validation.ValidateCloudProfile(obj.(*gardencore.CloudProfile))
+		},
+	)
+}
@@ -146,8 +146,14 @@ func ValidateSeedSpec(seedSpec *core.SeedSpec, fldPath *field.Path, inTemplate b
 	if seedSpec.Settings != nil {
 		if seedSpec.Settings.ExcessCapacityReservation != nil {
 			for i, config := range seedSpec.Settings.ExcessCapacityReservation.Configs {
-				if len(config.Resources) == 0 {
-					allErrs = append(allErrs, field.Required(fldPath.Child("settings", "excessCapacityReservation", "configs").Index(i).Child("resources"), "cannot be empty"))
+				if len(config.Resources) == 0 && config.Percentage == nil {
+					allErrs = append(allErrs, field.Required(fldPath.Child("settings", "excessCapacityReservation", "configs").Index(i).Child("resources"), "either resources or percentage must be set"))
+				}
+				if len(config.Resources) > 0 && config.Percentage != nil {
+					allErrs = append(allErrs, field.Forbidden(fldPath.Child("settings", "excessCapacityReservation", "configs").Index(i).Child("percentage"), "is mutually exclusive with resources"))
+				}
+				if config.Percentage != nil && (*config.Percentage < 1 || *config.Percentage > 90) {
+					allErrs = append(allErrs, field.Invalid(fldPath.Child("settings", "excessCapacityReservation", "configs").Index(i).Child("percentage"), *config.Percentage, "must be between 1 and 90"))
 				}
 				for resource, value := range config.Resources {
 					allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(resource.String(), value, fldPath.Child("settings", "excessCapacityReservation", "configs").Index(i).Child("resources").Child(resource.String()))...)
@@ -198,6 +204,31 @@ func ValidateSeedSpec(seedSpec *core.SeedSpec, fldPath *field.Path, inTemplate b
 		if seedSpec.Settings.VerticalPodAutoscaler != nil {
 			allErrs = append(allErrs, featuresvalidation.ValidateVpaFeatureGates(seedSpec.Settings.VerticalPodAutoscaler.FeatureGates, fldPath.Child("settings", "verticalPodAutoscaler", "featureGates"))...)
 		}
+		if seedSpec.Settings.DependencyWatchdog != nil {
+			if weeder := seedSpec.Settings.DependencyWatchdog.Weeder; weeder != nil && weeder.WatchDuration != nil {
+				allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(weeder.WatchDuration.Duration), fldPath.Child("settings", "dependencyWatchdog", "weeder", "watchDuration"))...)
+			}
+			if prober := seedSpec.Settings.DependencyWatchdog.Prober; prober != nil && prober.ProbeInterval != nil {
+				allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(prober.ProbeInterval.Duration), fldPath.Child("settings", "dependencyWatchdog", "prober", "probeInterval"))...)
+			}
+		}
+		if seedSpec.Settings.IstioIngressGateway != nil {
+			allErrs = append(allErrs, validateIstioIngressGatewayAutoscaling(seedSpec.Settings.IstioIngressGateway.Autoscaling, fldPath.Child("settings", "istioIngressGateway", "autoscaling"))...)
+
+			handlerNames := sets.New[string]()
+			for i, handler := range seedSpec.Settings.IstioIngressGateway.ExposureClassHandlers {
+				idxPath := fldPath.Child("settings", "istioIngressGateway", "exposureClassHandlers").Index(i)
+
+				if len(handler.Name) == 0 {
+					allErrs = append(allErrs, field.Required(idxPath.Child("name"), "cannot be empty"))
+				} else if handlerNames.Has(handler.Name) {
+					allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), handler.Name))
+				}
+				handlerNames.Insert(handler.Name)
+
+				allErrs = append(allErrs, validateIstioIngressGatewayAutoscaling(handler.Autoscaling, idxPath.Child("autoscaling"))...)
+			}
+		}
 	}
 
 	if !inTemplate && seedSpec.Ingress == nil {
@@ -280,6 +311,31 @@ func validateSeedBackup(seedBackup *core.Backup, seedProviderType string, fldPat
 	return allErrs
 }
 
+func validateIstioIngressGatewayAutoscaling(autoscaling *core.SeedSettingIstioIngressGatewayAutoscaling, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if autoscaling == nil {
+		return allErrs
+	}
+
+	if autoscaling.MinReplicas != nil && *autoscaling.MinReplicas < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minReplicas"), *autoscaling.MinReplicas, "must be at least 1"))
+	}
+	if autoscaling.MinReplicas != nil && autoscaling.MaxReplicas != nil && *autoscaling.MaxReplicas < *autoscaling.MinReplicas {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxReplicas"), *autoscaling.MaxReplicas, "must be greater than or equal to minReplicas"))
+	}
+
+	for resource, value := range autoscaling.Requests {
+		if resource != corev1.ResourceCPU && resource != corev1.ResourceMemory {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("requests"), string(resource), []string{string(corev1.ResourceCPU), string(corev1.ResourceMemory)}))
+			continue
+		}
+		allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(resource.String(), value, fldPath.Child("requests").Child(resource.String()))...)
+	}
+
+	return allErrs
+}
+
 func validateSeedNetworks(seedNetworks core.SeedNetworks, fldPath *field.Path, inTemplate bool) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -7,6 +7,7 @@ package validation_test
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -1172,6 +1173,56 @@ var _ = Describe("Seed Validation Tests", func() {
 					))
 				})
 
+				It("should allow configs with a percentage instead of resources", func() {
+					seed.Spec.Settings = &core.SeedSettings{
+						ExcessCapacityReservation: &core.SeedSettingExcessCapacityReservation{
+							Configs: []core.SeedSettingExcessCapacityReservationConfig{{Percentage: ptr.To[int32](20)}},
+						},
+					}
+
+					Expect(ValidateSeed(seed)).To(BeEmpty())
+				})
+
+				It("should not allow configs with both resources and percentage", func() {
+					seed.Spec.Settings = &core.SeedSettings{
+						ExcessCapacityReservation: &core.SeedSettingExcessCapacityReservation{
+							Configs: []core.SeedSettingExcessCapacityReservationConfig{{
+								Resources:  corev1.ResourceList{"cpu": resource.MustParse("1")},
+								Percentage: ptr.To[int32](20),
+							}},
+						},
+					}
+
+					Expect(ValidateSeed(seed)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("spec.settings.excessCapacityReservation.configs[0].percentage"),
+						})),
+					))
+				})
+
+				It("should not allow configs with an out-of-range percentage", func() {
+					seed.Spec.Settings = &core.SeedSettings{
+						ExcessCapacityReservation: &core.SeedSettingExcessCapacityReservation{
+							Configs: []core.SeedSettingExcessCapacityReservationConfig{
+								{Percentage: ptr.To[int32](0)},
+								{Percentage: ptr.To[int32](91)},
+							},
+						},
+					}
+
+					Expect(ValidateSeed(seed)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.settings.excessCapacityReservation.configs[0].percentage"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.settings.excessCapacityReservation.configs[1].percentage"),
+						})),
+					))
+				})
+
 				It("should not allow configs invalid resources", func() {
 					seed.Spec.Settings = &core.SeedSettings{
 						ExcessCapacityReservation: &core.SeedSettingExcessCapacityReservation{
@@ -1515,6 +1566,83 @@ var _ = Describe("Seed Validation Tests", func() {
 				})
 			})
 
+			Context("istioIngressGateway", func() {
+				It("should allow valid istioIngressGateway configurations", func() {
+					seed.Spec.Settings = &core.SeedSettings{
+						IstioIngressGateway: &core.SeedSettingIstioIngressGateway{
+							Autoscaling: &core.SeedSettingIstioIngressGatewayAutoscaling{
+								MinReplicas: ptr.To[int32](3),
+								MaxReplicas: ptr.To[int32](12),
+								Requests: corev1.ResourceList{
+									"cpu":    resource.MustParse("500m"),
+									"memory": resource.MustParse("1Gi"),
+								},
+							},
+							ExposureClassHandlers: []core.SeedSettingIstioIngressGatewayExposureClassHandler{
+								{
+									Name:        "internet",
+									Autoscaling: &core.SeedSettingIstioIngressGatewayAutoscaling{MinReplicas: ptr.To[int32](2)},
+								},
+							},
+						},
+					}
+
+					Expect(ValidateSeed(seed)).To(BeEmpty())
+				})
+
+				It("should not allow invalid autoscaling configurations", func() {
+					seed.Spec.Settings = &core.SeedSettings{
+						IstioIngressGateway: &core.SeedSettingIstioIngressGateway{
+							Autoscaling: &core.SeedSettingIstioIngressGatewayAutoscaling{
+								MinReplicas: ptr.To[int32](0),
+								MaxReplicas: ptr.To[int32](-1),
+								Requests: corev1.ResourceList{
+									"storage": resource.MustParse("1Gi"),
+								},
+							},
+						},
+					}
+
+					Expect(ValidateSeed(seed)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.settings.istioIngressGateway.autoscaling.minReplicas"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.settings.istioIngressGateway.autoscaling.maxReplicas"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("spec.settings.istioIngressGateway.autoscaling.requests"),
+						})),
+					))
+				})
+
+				It("should not allow exposure class handlers without name or with duplicate names", func() {
+					seed.Spec.Settings = &core.SeedSettings{
+						IstioIngressGateway: &core.SeedSettingIstioIngressGateway{
+							ExposureClassHandlers: []core.SeedSettingIstioIngressGatewayExposureClassHandler{
+								{Name: ""},
+								{Name: "internet"},
+								{Name: "internet"},
+							},
+						},
+					}
+
+					Expect(ValidateSeed(seed)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.settings.istioIngressGateway.exposureClassHandlers[0].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.settings.istioIngressGateway.exposureClassHandlers[2].name"),
+						})),
+					))
+				})
+			})
+
 			Context("topology-aware routing", func() {
 				It("should prevent enabling topology-aware routing on single-zone Seed cluster", func() {
 					seed.Spec.Provider.Zones = []string{"a"}
@@ -1566,6 +1694,39 @@ var _ = Describe("Seed Validation Tests", func() {
 					Expect(ValidateSeed(seed)).To(BeEmpty())
 				})
 			})
+
+			Context("dependencyWatchdog", func() {
+				It("should allow valid durations", func() {
+					seed.Spec.Settings = &core.SeedSettings{
+						DependencyWatchdog: &core.SeedSettingDependencyWatchdog{
+							Weeder: &core.SeedSettingDependencyWatchdogWeeder{Enabled: true, WatchDuration: &metav1.Duration{Duration: 2 * time.Minute}},
+							Prober: &core.SeedSettingDependencyWatchdogProber{Enabled: true, ProbeInterval: &metav1.Duration{Duration: 30 * time.Second}},
+						},
+					}
+
+					Expect(ValidateSeed(seed)).To(BeEmpty())
+				})
+
+				It("should not allow negative durations", func() {
+					seed.Spec.Settings = &core.SeedSettings{
+						DependencyWatchdog: &core.SeedSettingDependencyWatchdog{
+							Weeder: &core.SeedSettingDependencyWatchdogWeeder{Enabled: true, WatchDuration: &metav1.Duration{Duration: -2 * time.Minute}},
+							Prober: &core.SeedSettingDependencyWatchdogProber{Enabled: true, ProbeInterval: &metav1.Duration{Duration: -30 * time.Second}},
+						},
+					}
+
+					Expect(ValidateSeed(seed)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.settings.dependencyWatchdog.weeder.watchDuration"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.settings.dependencyWatchdog.prober.probeInterval"),
+						})),
+					))
+				})
+			})
 		})
 
 		It("should fail updating immutable fields", func() {
@@ -67,7 +67,15 @@ var (
 		v1beta1constants.ShootOperationMaintain,
 		v1beta1constants.ShootOperationRetry,
 		v1beta1constants.ShootOperationForceInPlaceUpdate,
-	).Union(availableShootMaintenanceOperations)
+	).Union(availableShootMaintenanceOperations).Union(partialReconcileShootOperations)
+	// partialReconcileShootOperations contains all operations which only re-run a dedicated stage of the shoot
+	// reconciliation flow.
+	partialReconcileShootOperations = sets.New(
+		v1beta1constants.ShootOperationReconcileInfrastructure,
+		v1beta1constants.ShootOperationReconcileDNSRecords,
+		v1beta1constants.ShootOperationReconcileControlPlane,
+		v1beta1constants.ShootOperationReconcileSystemComponents,
+	)
 	availableShootMaintenanceOperations = sets.New(
 		v1beta1constants.GardenerOperationReconcile,
 		v1beta1constants.OperationRotateCAStart,
@@ -75,6 +83,7 @@ var (
 		v1beta1constants.OperationRotateCAComplete,
 		v1beta1constants.OperationRotateObservabilityCredentials,
 		v1beta1constants.ShootOperationRotateSSHKeypair,
+		v1beta1constants.ShootOperationRotateCloudCredentials,
 		v1beta1constants.OperationRotateRolloutWorkers,
 		v1beta1constants.OperationRolloutWorkers,
 	).Union(forbiddenShootOperationsWhenHibernated)
@@ -99,6 +108,12 @@ var (
 		v1beta1constants.OperationRotateETCDEncryptionKey,
 		v1beta1constants.OperationRotateETCDEncryptionKeyStart,
 	)
+	// parameterizedShootOperations contains all operations which may carry parameters using the syntax
+	// `<name>=<parameter1>,<parameter2>,...`.
+	parameterizedShootOperations = sets.New(
+		v1beta1constants.OperationRotateRolloutWorkers,
+		v1beta1constants.OperationRolloutWorkers,
+	)
 	availableShootOperationsToRunInParallel = availableShootMaintenanceOperations
 	incompatibleShootOperations             = map[string][]string{
 		v1beta1constants.OperationRotateCredentialsStart: {
@@ -120,6 +135,11 @@ var (
 			v1beta1constants.OperationRotateETCDEncryptionKeyStart,
 		},
 	}
+	availableHibernationModes = sets.New(
+		string(core.HibernationModeFull),
+		string(core.HibernationModeWorkersOnly),
+		string(core.HibernationModeControlPlaneMinimized),
+	)
 	availableShootPurposes = sets.New(
 		string(core.ShootPurposeEvaluation),
 		string(core.ShootPurposeTesting),
@@ -275,6 +295,7 @@ func ValidateShootSpec(meta metav1.ObjectMeta, spec *core.ShootSpec, fldPath *fi
 	allErrs = append(allErrs, validateAddons(spec.Addons, spec.Purpose, workerless, fldPath.Child("addons"))...)
 	allErrs = append(allErrs, validateDNS(spec.DNS, fldPath.Child("dns"))...)
 	allErrs = append(allErrs, validateExtensions(spec.Extensions, fldPath.Child("extensions"))...)
+	allErrs = append(allErrs, validateManagedAddons(spec.ManagedAddons, fldPath.Child("managedAddons"))...)
 	allErrs = append(allErrs, ValidateResources(spec.Resources, fldPath.Child("resources"))...)
 	allErrs = append(allErrs, validateKubernetes(spec.Kubernetes, spec.Networking, workerless, fldPath.Child("kubernetes"))...)
 	allErrs = append(allErrs, validateNetworking(spec.Networking, workerless, fldPath.Child("networking"))...)
@@ -314,6 +335,9 @@ func ValidateShootSpec(meta metav1.ObjectMeta, spec *core.ShootSpec, fldPath *fi
 	if spec.SeedSelector != nil {
 		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&spec.SeedSelector.LabelSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("seedSelector"))...)
 	}
+	if spec.SeedAffinity != nil {
+		allErrs = append(allErrs, validateSeedAffinity(spec.SeedAffinity, fldPath.Child("seedAffinity"))...)
+	}
 	if purpose := spec.Purpose; purpose != nil {
 		allowedShootPurposes := availableShootPurposes
 		if meta.Namespace == v1beta1constants.GardenNamespace || inTemplate {
@@ -321,7 +345,15 @@ func ValidateShootSpec(meta metav1.ObjectMeta, spec *core.ShootSpec, fldPath *fi
 		}
 
 		if !allowedShootPurposes.Has(string(*purpose)) {
-			allErrs = append(allErrs, field.NotSupported(fldPath.Child("purpose"), *purpose, sets.List(allowedShootPurposes)))
+			if *purpose == core.ShootPurposeInfrastructure {
+				allErrs = append(allErrs, field.NotSupported(fldPath.Child("purpose"), *purpose, sets.List(allowedShootPurposes)))
+			} else {
+				// Operators may declare custom purposes beyond the standard ones via the ShootPurposePolicy admission
+				// plugin which rejects undeclared purposes. Here, we only verify that the purpose is a valid name.
+				for _, msg := range validation.IsDNS1123Label(string(*purpose)) {
+					allErrs = append(allErrs, field.Invalid(fldPath.Child("purpose"), *purpose, msg))
+				}
+			}
 		}
 	}
 	allErrs = append(allErrs, ValidateTolerations(spec.Tolerations, fldPath.Child("tolerations"))...)
@@ -344,6 +376,25 @@ func ValidateShootSpec(meta metav1.ObjectMeta, spec *core.ShootSpec, fldPath *fi
 	return allErrs
 }
 
+func validateSeedAffinity(seedAffinity *core.SeedAffinity, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, labelSelector := range seedAffinity.RequiredDuringScheduling {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&labelSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("requiredDuringScheduling").Index(i))...)
+	}
+
+	for i, term := range seedAffinity.PreferredDuringScheduling {
+		idxPath := fldPath.Child("preferredDuringScheduling").Index(i)
+
+		if term.Weight < 1 || term.Weight > 100 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("weight"), term.Weight, "weight must be in the range 1-100"))
+		}
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&term.LabelSelector, metav1validation.LabelSelectorValidationOptions{}, idxPath.Child("labelSelector"))...)
+	}
+
+	return allErrs
+}
+
 // ValidateShootSpecUpdate validates the specification of a Shoot object.
 func ValidateShootSpecUpdate(newSpec, oldSpec *core.ShootSpec, newObjectMeta metav1.ObjectMeta, fldPath *field.Path) field.ErrorList {
 	var (
@@ -593,7 +644,7 @@ func validateAddons(addons *core.Addons, purpose *core.ShootPurpose, workerless
 		return allErrs
 	}
 
-	if (helper.NginxIngressEnabled(addons) || helper.KubernetesDashboardEnabled(addons)) && (purpose != nil && *purpose != core.ShootPurposeEvaluation) {
+	if (helper.NginxIngressEnabled(addons) || helper.KubernetesDashboardEnabled(addons) || helper.ResourceBackupEnabled(addons)) && (purpose != nil && *purpose != core.ShootPurposeEvaluation) {
 		allErrs = append(allErrs, field.Forbidden(fldPath, "addons can only be enabled on shoots with .spec.purpose=evaluation"))
 	}
 
@@ -630,6 +681,24 @@ func validateAddons(addons *core.Addons, purpose *core.ShootPurpose, workerless
 		}
 	}
 
+	if helper.ResourceBackupEnabled(addons) {
+		if schedule := addons.ResourceBackup.Schedule; schedule != nil {
+			if _, err := cron.ParseStandard(*schedule); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("resourceBackup", "schedule"), *schedule, fmt.Sprintf("not a valid cron spec: %v", err)))
+			}
+		}
+
+		if retentionPeriod := addons.ResourceBackup.RetentionPeriod; retentionPeriod != nil && retentionPeriod.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("resourceBackup", "retentionPeriod"), retentionPeriod.Duration.String(), "must be greater than 0"))
+		}
+
+		for i, namespace := range addons.ResourceBackup.IncludedNamespaces {
+			for _, msg := range apivalidation.ValidateNamespaceName(namespace, false) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("resourceBackup", "includedNamespaces").Index(i), namespace, msg))
+			}
+		}
+	}
+
 	return allErrs
 }
 
@@ -924,6 +993,16 @@ func validateDNS(dns *core.DNS, fldPath *field.Path) field.ErrorList {
 		allErrs = append(allErrs, ValidateDNS1123Subdomain(*dns.Domain, fldPath.Child("domain"))...)
 	}
 
+	if dns.ManagementDomain != nil {
+		allErrs = append(allErrs, ValidateDNS1123Subdomain(*dns.ManagementDomain, fldPath.Child("managementDomain"))...)
+
+		if dns.Domain == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("domain"), "domain must be set when managementDomain is set"))
+		} else if *dns.ManagementDomain == *dns.Domain {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("managementDomain"), *dns.ManagementDomain, "managementDomain must differ from domain"))
+		}
+	}
+
 	primaryDNSProvider := helper.FindPrimaryDNSProvider(dns.Providers)
 	if primaryDNSProvider != nil && primaryDNSProvider.Type != nil {
 		if *primaryDNSProvider.Type != core.DNSUnmanaged && dns.Domain == nil {
@@ -1310,6 +1389,38 @@ func validateEncryptionConfig(encryptionConfig *core.EncryptionConfig, defaultEn
 		seenResources.Insert(gr)
 	}
 
+	allErrs = append(allErrs, validateKMSEncryptionConfiguration(encryptionConfig.KMS, fldPath.Child("encryptionConfig", "kms"))...)
+
+	return allErrs
+}
+
+func validateKMSEncryptionConfiguration(kms *core.KMSEncryptionConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if kms == nil {
+		return allErrs
+	}
+
+	if len(kms.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "name of the KMS plugin is required"))
+	} else if strings.Contains(kms.Name, ":") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), kms.Name, "name must not contain ':'"))
+	}
+
+	if len(kms.Endpoint) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("endpoint"), "endpoint of the KMS plugin is required"))
+	} else if !strings.HasPrefix(kms.Endpoint, "unix://") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("endpoint"), kms.Endpoint, "endpoint must start with 'unix://'"))
+	}
+
+	if kms.SecretName != nil && len(*kms.SecretName) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("secretName"), *kms.SecretName, "secretName must not be empty"))
+	}
+
+	if kms.Timeout != nil && kms.Timeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), kms.Timeout.Duration.String(), "timeout must be positive"))
+	}
+
 	return allErrs
 }
 
@@ -1650,9 +1761,13 @@ func ValidateKubeAPIServer(kubeAPIServer *core.KubeAPIServerConfig, version stri
 		if kubeAPIServer.OIDCConfig != nil {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("oidcConfig"), "is incompatible with structuredAuthentication"))
 		}
-		if len(structuredAuthentication.ConfigMapName) == 0 {
-			allErrs = append(allErrs, field.Forbidden(structAuthPath.Child("configMapName"), "must provide a name"))
+		if len(structuredAuthentication.ConfigMapName) == 0 && len(structuredAuthentication.JWTAuthenticators) == 0 {
+			allErrs = append(allErrs, field.Required(structAuthPath, "must provide either a configMapName or jwtAuthenticators"))
 		}
+		if len(structuredAuthentication.ConfigMapName) > 0 && len(structuredAuthentication.JWTAuthenticators) > 0 {
+			allErrs = append(allErrs, field.Forbidden(structAuthPath.Child("jwtAuthenticators"), "is mutually exclusive with configMapName"))
+		}
+		allErrs = append(allErrs, validateJWTAuthenticators(structuredAuthentication.JWTAuthenticators, structAuthPath.Child("jwtAuthenticators"))...)
 	}
 
 	if structuredAuthorization := kubeAPIServer.StructuredAuthorization; structuredAuthorization != nil {
@@ -1743,6 +1858,18 @@ func ValidateKubeAPIServer(kubeAPIServer *core.KubeAPIServerConfig, version stri
 		fldPath.Child("autoscaling"))...,
 	)
 
+	if requestBasedAutoscaling := kubeAPIServer.RequestBasedAutoscaling; requestBasedAutoscaling != nil {
+		if requestBasedAutoscaling.AverageRequestsPerSecond == nil && requestBasedAutoscaling.AverageInflightRequests == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("requestBasedAutoscaling"), "must provide at least one of averageRequestsPerSecond or averageInflightRequests"))
+		}
+		if requestBasedAutoscaling.AverageRequestsPerSecond != nil && *requestBasedAutoscaling.AverageRequestsPerSecond < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("requestBasedAutoscaling", "averageRequestsPerSecond"), *requestBasedAutoscaling.AverageRequestsPerSecond, "must be at least 1"))
+		}
+		if requestBasedAutoscaling.AverageInflightRequests != nil && *requestBasedAutoscaling.AverageInflightRequests < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("requestBasedAutoscaling", "averageInflightRequests"), *requestBasedAutoscaling.AverageInflightRequests, "must be at least 1"))
+		}
+	}
+
 	allErrs = append(allErrs, featuresvalidation.ValidateFeatureGates(kubeAPIServer.FeatureGates, version, fldPath.Child("featureGates"))...)
 
 	allErrs = append(allErrs, validateAPIAudiences(kubeAPIServer.APIAudiences, fldPath.Child("apiAudiences"))...)
@@ -1823,6 +1950,20 @@ func ValidateKubeControllerManager(kcm *core.KubeControllerManagerConfig, networ
 				allErrs = append(allErrs, field.Invalid(hpaPath.Child("cpuInitializationPeriod"), *hpa.CPUInitializationPeriod, "cpu initialization period must not be less than a second"))
 			}
 		}
+
+		if concurrentSyncs := kcm.ConcurrentSyncs; concurrentSyncs != nil {
+			concurrentSyncsPath := fldPath.Child("concurrentSyncs")
+
+			for fieldName, value := range map[string]*int32{
+				"deployment":  concurrentSyncs.Deployment,
+				"replicaSet":  concurrentSyncs.ReplicaSet,
+				"statefulSet": concurrentSyncs.StatefulSet,
+			} {
+				if value != nil && (*value < 1 || *value > 100) {
+					allErrs = append(allErrs, field.Invalid(concurrentSyncsPath.Child(fieldName), *value, "must be between 1 and 100"))
+				}
+			}
+		}
 	} else {
 		if kcm.NodeCIDRMaskSize != nil {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("nodeCIDRMaskSize"), workerlessErrorMsg))
@@ -1837,6 +1978,9 @@ func ValidateKubeControllerManager(kcm *core.KubeControllerManagerConfig, networ
 		if kcm.NodeMonitorGracePeriod != nil {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("nodeMonitorGracePeriod"), workerlessErrorMsg))
 		}
+		if kcm.ConcurrentSyncs != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("concurrentSyncs"), workerlessErrorMsg))
+		}
 	}
 
 	allErrs = append(allErrs, featuresvalidation.ValidateFeatureGates(kcm.FeatureGates, version, fldPath.Child("featureGates"))...)
@@ -1844,6 +1988,67 @@ func ValidateKubeControllerManager(kcm *core.KubeControllerManagerConfig, networ
 	return allErrs
 }
 
+func validateJWTAuthenticators(authenticators []core.JWTAuthenticator, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	issuerURLs := sets.New[string]()
+
+	for i, authenticator := range authenticators {
+		idxPath := fldPath.Index(i)
+
+		issuerPath := idxPath.Child("issuer")
+		if len(authenticator.Issuer.URL) == 0 {
+			allErrs = append(allErrs, field.Required(issuerPath.Child("url"), "must provide an issuer URL"))
+		} else {
+			if issuerURL, err := url.Parse(authenticator.Issuer.URL); err != nil || issuerURL.Scheme != "https" {
+				allErrs = append(allErrs, field.Invalid(issuerPath.Child("url"), authenticator.Issuer.URL, "must be a valid URL with the https scheme"))
+			}
+			if issuerURLs.Has(authenticator.Issuer.URL) {
+				allErrs = append(allErrs, field.Duplicate(issuerPath.Child("url"), authenticator.Issuer.URL))
+			}
+			issuerURLs.Insert(authenticator.Issuer.URL)
+		}
+		if len(authenticator.Issuer.Audiences) == 0 {
+			allErrs = append(allErrs, field.Required(issuerPath.Child("audiences"), "must provide at least one audience"))
+		}
+
+		if claimMappings := authenticator.ClaimMappings; claimMappings != nil {
+			for fieldName, mapping := range map[string]*core.AuthenticationClaimOrExpression{
+				"username": claimMappings.Username,
+				"groups":   claimMappings.Groups,
+			} {
+				if mapping == nil {
+					continue
+				}
+				mappingPath := idxPath.Child("claimMappings", fieldName)
+
+				if (mapping.Claim == nil) == (mapping.Expression == nil) {
+					allErrs = append(allErrs, field.Invalid(mappingPath, mapping, "must provide exactly one of claim or expression"))
+				}
+				if mapping.Prefix != nil && mapping.Claim == nil {
+					allErrs = append(allErrs, field.Forbidden(mappingPath.Child("prefix"), "may only be set together with claim"))
+				}
+			}
+		}
+
+		for j, rule := range authenticator.ClaimValidationRules {
+			rulePath := idxPath.Child("claimValidationRules").Index(j)
+
+			if (rule.Claim == nil) == (rule.Expression == nil) {
+				allErrs = append(allErrs, field.Invalid(rulePath, rule, "must provide exactly one of claim or expression"))
+			}
+			if rule.RequiredValue != nil && rule.Claim == nil {
+				allErrs = append(allErrs, field.Forbidden(rulePath.Child("requiredValue"), "may only be set together with claim"))
+			}
+			if rule.Message != nil && rule.Expression == nil {
+				allErrs = append(allErrs, field.Forbidden(rulePath.Child("message"), "may only be set together with expression"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 func validateAPIAudiences(audiences []string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -1941,6 +2146,13 @@ func validateMaintenance(maintenance *core.Maintenance, fldPath *field.Path, wor
 	}
 
 	if maintenance.TimeWindow != nil {
+		if location := maintenance.TimeWindow.Location; location != nil {
+			if _, err := time.LoadLocation(*location); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("timeWindow", "location"), *location, fmt.Sprintf("not a valid location: %v", err)))
+				return allErrs
+			}
+		}
+
 		maintenanceTimeWindow, err := timewindow.ParseMaintenanceTimeWindow(maintenance.TimeWindow.Begin, maintenance.TimeWindow.End)
 		if err != nil {
 			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeWindow", "begin/end"), maintenance.TimeWindow, err.Error()))
@@ -2170,6 +2382,10 @@ func ValidateWorker(worker core.Worker, kubernetes core.Kubernetes, shootNamespa
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("priority"), *worker.Priority, "can not be less than -1"))
 	}
 
+	if worker.NodeWorkloadIdentityName != nil && len(*worker.NodeWorkloadIdentityName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("nodeWorkloadIdentityName"), "node workload identity name must not be empty when key is provided"))
+	}
+
 	if worker.ControlPlane != nil {
 		if worker.Minimum != worker.Maximum || worker.Minimum != 1 {
 			allErrs = append(allErrs, field.Invalid(fldPath.Child("minimum"), worker.Minimum, "self-hosted shoots only support minimum=maximum=1 for the control plane worker pool (might change in the future)"))
@@ -2663,6 +2879,10 @@ func ValidateHibernation(annotations map[string]string, hibernation *core.Hibern
 		}
 	}
 
+	if hibernation.Mode != nil && !availableHibernationModes.Has(string(*hibernation.Mode)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), *hibernation.Mode, sets.List(availableHibernationModes)))
+	}
+
 	allErrs = append(allErrs, ValidateHibernationSchedules(hibernation.Schedules, fldPath.Child("schedules"))...)
 
 	return allErrs
@@ -2819,6 +3039,76 @@ func ValidateCRI(CRI *core.CRI, fldPath *field.Path) field.ErrorList {
 		allErrs = append(allErrs, ValidateContainerRuntimes(CRI.ContainerRuntimes, fldPath.Child("containerruntimes"))...)
 	}
 
+	if CRI.Containerd != nil {
+		if CRI.Name != core.CRINameContainerD {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("containerd"), fmt.Sprintf("containerd configuration is only allowed for CRI name %q", core.CRINameContainerD)))
+		}
+		allErrs = append(allErrs, validateContainerdRegistries(CRI.Containerd.Registries, fldPath.Child("containerd", "registries"))...)
+	}
+
+	return allErrs
+}
+
+func validateContainerdRegistries(registries []core.ContainerdRegistry, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	upstreams := sets.New[string]()
+
+	for i, registry := range registries {
+		idxPath := fldPath.Index(i)
+
+		if len(registry.Upstream) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("upstream"), "must provide the host name of the upstream registry"))
+		} else if strings.Contains(registry.Upstream, "://") {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("upstream"), registry.Upstream, "must be a host name without scheme"))
+		} else if upstreams.Has(registry.Upstream) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("upstream"), registry.Upstream))
+		}
+		upstreams.Insert(registry.Upstream)
+
+		if registry.Server != nil {
+			allErrs = append(allErrs, validateContainerdRegistryURL(*registry.Server, registry.AllowInsecure, idxPath.Child("server"))...)
+		}
+
+		if len(registry.Hosts) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("hosts"), "must provide at least one registry mirror URL"))
+		}
+		for j, host := range registry.Hosts {
+			allErrs = append(allErrs, validateContainerdRegistryURL(host, registry.AllowInsecure, idxPath.Child("hosts").Index(j))...)
+		}
+
+		if registry.AuthSecretName != nil {
+			for _, msg := range apivalidation.NameIsDNSSubdomain(*registry.AuthSecretName, false) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("authSecretName"), *registry.AuthSecretName, msg))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateContainerdRegistryURL(registryURL string, allowInsecure *bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	u, err := url.Parse(registryURL)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, registryURL, fmt.Sprintf("must be a valid URL: %v", err)))
+		return allErrs
+	}
+
+	switch u.Scheme {
+	case "https":
+	case "http":
+		if !ptr.Deref(allowInsecure, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath, registryURL, "plain HTTP URLs are only allowed if allowInsecure is set to true"))
+		}
+	default:
+		allErrs = append(allErrs, field.Invalid(fldPath, registryURL, "must have scheme 'https' or 'http'"))
+		return allErrs
+	}
+	if len(u.Host) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, registryURL, "must contain a host"))
+	}
+
 	return allErrs
 }
 
@@ -2959,17 +3249,17 @@ func validateShootOperation(operations, maintenanceOperations []string, shoot *c
 	}
 
 	for _, op := range operations {
-		if !availableShootOperations.Has(op) && !strings.HasPrefix(op, v1beta1constants.OperationRotateRolloutWorkers) && !strings.HasPrefix(op, v1beta1constants.OperationRolloutWorkers) {
+		if !availableShootOperations.Has(op) && !parameterizedShootOperations.Has(v1beta1helper.ParseShootOperation(op).Name) {
 			return append(allErrs, field.NotSupported(fldPathOp, op, sets.List(availableShootOperations)))
-		} else if len(operations) > 1 && !availableShootOperationsToRunInParallel.Has(op) && !strings.HasPrefix(op, v1beta1constants.OperationRotateRolloutWorkers) && !strings.HasPrefix(op, v1beta1constants.OperationRolloutWorkers) {
+		} else if len(operations) > 1 && !availableShootOperationsToRunInParallel.Has(op) && !parameterizedShootOperations.Has(v1beta1helper.ParseShootOperation(op).Name) {
 			return append(allErrs, field.Forbidden(fldPathOp, fmt.Sprintf("operation '%s' is not permitted to be run in parallel with other operations", op)))
 		}
 	}
 
 	for _, op := range maintenanceOperations {
-		if !availableShootOperations.Has(op) && !strings.HasPrefix(op, v1beta1constants.OperationRotateRolloutWorkers) && !strings.HasPrefix(op, v1beta1constants.OperationRolloutWorkers) {
+		if !availableShootOperations.Has(op) && !parameterizedShootOperations.Has(v1beta1helper.ParseShootOperation(op).Name) {
 			return append(allErrs, field.NotSupported(fldPathMaintOp, op, sets.List(availableShootOperations)))
-		} else if len(maintenanceOperations) > 1 && !availableShootOperationsToRunInParallel.Has(op) && !strings.HasPrefix(op, v1beta1constants.OperationRotateRolloutWorkers) && !strings.HasPrefix(op, v1beta1constants.OperationRolloutWorkers) {
+		} else if len(maintenanceOperations) > 1 && !availableShootOperationsToRunInParallel.Has(op) && !parameterizedShootOperations.Has(v1beta1helper.ParseShootOperation(op).Name) {
 			return append(allErrs, field.Forbidden(fldPathMaintOp, fmt.Sprintf("operation '%s' is not permitted to be run in parallel with other operations", op)))
 		}
 	}
@@ -2997,7 +3287,7 @@ func validateShootOperation(operations, maintenanceOperations []string, shoot *c
 			allErrs = append(allErrs, field.Forbidden(fldPathOp, fmt.Sprintf("operation '%s' is not permitted to be run together with %s operations", op, strings.Join(forbiddenOps, ", "))))
 		}
 		if helper.IsShootInHibernation(shoot) &&
-			(forbiddenShootOperationsWhenHibernated.Has(op) || strings.HasPrefix(op, v1beta1constants.OperationRotateRolloutWorkers) || strings.HasPrefix(op, v1beta1constants.OperationRolloutWorkers)) {
+			(forbiddenShootOperationsWhenHibernated.Has(op) || partialReconcileShootOperations.Has(op) || parameterizedShootOperations.Has(v1beta1helper.ParseShootOperation(op).Name)) {
 			allErrs = append(allErrs, field.Forbidden(fldPathOp, fmt.Sprintf("operation '%s' is not permitted when shoot is hibernated or is waking up", op)))
 		}
 		if !encryptedResources.Equal(sets.New(getResourcesForEncryption(shoot.Spec.Kubernetes.KubeAPIServer)...)) &&
@@ -3014,7 +3304,7 @@ func validateShootOperation(operations, maintenanceOperations []string, shoot *c
 			allErrs = append(allErrs, field.Forbidden(fldPathMaintOp, fmt.Sprintf("operation '%s' is not permitted to be run together with %s maintenance operations", op, strings.Join(forbiddenOps, ", "))))
 		}
 		if helper.IsShootInHibernation(shoot) &&
-			(forbiddenShootOperationsWhenHibernated.Has(op) || strings.HasPrefix(op, v1beta1constants.OperationRotateRolloutWorkers) || strings.HasPrefix(op, v1beta1constants.OperationRolloutWorkers)) {
+			(forbiddenShootOperationsWhenHibernated.Has(op) || partialReconcileShootOperations.Has(op) || parameterizedShootOperations.Has(v1beta1helper.ParseShootOperation(op).Name)) {
 			allErrs = append(allErrs, field.Forbidden(fldPathMaintOp, fmt.Sprintf("operation '%s' is not permitted when shoot is hibernated or is waking up", op)))
 		}
 		if !encryptedResources.Equal(sets.New(getResourcesForEncryption(shoot.Spec.Kubernetes.KubeAPIServer)...)) && forbiddenShootOperationsWhenEncryptionChangeIsRollingOut.Has(op) {
@@ -3070,6 +3360,7 @@ func validateShootOperation(operations, maintenanceOperations []string, shoot *c
 func validateShootOperationContext(operation string, shoot *core.Shoot, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	k8sLess134, _ := versionutils.CheckVersionMeetsConstraint(shoot.Spec.Kubernetes.Version, "< 1.34")
+	parsedOperation := v1beta1helper.ParseShootOperation(operation)
 
 	switch operation {
 	case v1beta1constants.OperationRotateCredentialsStart, v1beta1constants.OperationRotateCredentialsStartWithoutWorkersRollout:
@@ -3139,12 +3430,12 @@ func validateShootOperationContext(operation string, shoot *core.Shoot, fldPath
 		}
 	}
 
-	if strings.HasPrefix(operation, v1beta1constants.OperationRotateRolloutWorkers) {
+	if parsedOperation.Name == v1beta1constants.OperationRotateRolloutWorkers {
 		if caPhase, serviceAccountKeyPhase := helper.GetShootCARotationPhase(shoot.Status.Credentials), helper.GetShootServiceAccountKeyRotationPhase(shoot.Status.Credentials); caPhase != core.RotationWaitingForWorkersRollout && serviceAccountKeyPhase != core.RotationWaitingForWorkersRollout {
 			allErrs = append(allErrs, field.Forbidden(fldPath, "either .status.credentials.rotation.certificateAuthorities.phase or .status.credentials.rotation.serviceAccountKey.phase must be in 'WaitingForWorkersRollout' in order to trigger workers rollout"))
 		}
 
-		poolNames := strings.Split(strings.TrimPrefix(operation, v1beta1constants.OperationRotateRolloutWorkers+"="), ",")
+		poolNames := parsedOperation.Parameters
 		if len(poolNames) == 0 || sets.New(poolNames...).Has("") {
 			allErrs = append(allErrs, field.Required(fldPath, "must provide at least one pool name via "+v1beta1constants.OperationRotateRolloutWorkers+"=<poolName1>[,<poolName2>,...]"))
 		}
@@ -3163,18 +3454,16 @@ func validateShootOperationContext(operation string, shoot *core.Shoot, fldPath
 			}
 		}
 	}
-	if strings.HasPrefix(operation, v1beta1constants.OperationRolloutWorkers) {
-		allErrs = append(allErrs, validateOperationRolloutWorkers(operation, shoot, fldPath)...)
+	if parsedOperation.Name == v1beta1constants.OperationRolloutWorkers {
+		allErrs = append(allErrs, validateOperationRolloutWorkers(parsedOperation.Parameters, shoot, fldPath)...)
 	}
 
 	return allErrs
 }
 
-func validateOperationRolloutWorkers(operation string, shoot *core.Shoot, fldPath *field.Path) field.ErrorList {
+func validateOperationRolloutWorkers(poolNames []string, shoot *core.Shoot, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	// Normalise representation of pool names
-	poolNames := strings.Split(strings.TrimPrefix(operation, v1beta1constants.OperationRolloutWorkers+"="), ",")
 	if len(poolNames) == 0 || sets.New(poolNames...).Has("") {
 		allErrs = append(allErrs, field.Required(fldPath, "must provide either '*' or at least one pool name via "+v1beta1constants.OperationRolloutWorkers+"=<poolName1>[,<poolName2>,...]"))
 	}
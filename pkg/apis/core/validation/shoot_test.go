@@ -665,8 +665,9 @@ var _ = Describe("Shoot Validation Tests", func() {
 				"Type":  Equal(field.ErrorTypeNotSupported),
 				"Field": Equal("spec.purpose"),
 			})))),
-			Entry("unknown purpose", core.ShootPurpose("foo"), "dev", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
-				"Type":  Equal(field.ErrorTypeNotSupported),
+			Entry("custom purpose", core.ShootPurpose("foo"), "dev", BeEmpty()),
+			Entry("invalid custom purpose", core.ShootPurpose("Foo Bar"), "dev", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
 				"Field": Equal("spec.purpose"),
 			})))),
 		)
@@ -806,6 +807,61 @@ var _ = Describe("Shoot Validation Tests", func() {
 					"Field": Equal("spec.addons.nginxIngress.externalTrafficPolicy"),
 				}))))
 			})
+
+			It("should allow a valid configuration for resource-backup", func() {
+				shoot.Spec.Addons.ResourceBackup = &core.ResourceBackupAddon{
+					Addon:              core.Addon{Enabled: true},
+					Schedule:           ptr.To("0 */6 * * *"),
+					RetentionPeriod:    &metav1.Duration{Duration: 7 * 24 * time.Hour},
+					IncludedNamespaces: []string{"default", "kube-system"},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid an invalid schedule for resource-backup", func() {
+				shoot.Spec.Addons.ResourceBackup = &core.ResourceBackupAddon{
+					Addon:    core.Addon{Enabled: true},
+					Schedule: ptr.To("not-a-cron-spec"),
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.addons.resourceBackup.schedule"),
+				}))))
+			})
+
+			It("should forbid a non-positive retention period for resource-backup", func() {
+				shoot.Spec.Addons.ResourceBackup = &core.ResourceBackupAddon{
+					Addon:           core.Addon{Enabled: true},
+					RetentionPeriod: &metav1.Duration{Duration: -time.Hour},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.addons.resourceBackup.retentionPeriod"),
+				}))))
+			})
+
+			It("should forbid invalid namespace names for resource-backup", func() {
+				shoot.Spec.Addons.ResourceBackup = &core.ResourceBackupAddon{
+					Addon:              core.Addon{Enabled: true},
+					IncludedNamespaces: []string{"Not/AValidNamespace"},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.addons.resourceBackup.includedNamespaces[0]"),
+				}))))
+			})
 		})
 
 		It("should forbid unsupported specification (provider independent)", func() {
@@ -848,6 +904,60 @@ var _ = Describe("Shoot Validation Tests", func() {
 			))
 		})
 
+		Context("seed affinity validation", func() {
+			It("should allow valid seed affinity configurations", func() {
+				shoot.Spec.SeedAffinity = &core.SeedAffinity{
+					RequiredDuringScheduling: []metav1.LabelSelector{
+						{MatchLabels: map[string]string{"environment": "production"}},
+						{MatchLabels: map[string]string{"environment": "canary"}},
+					},
+					PreferredDuringScheduling: []core.WeightedSeedAffinityTerm{{
+						Weight:        100,
+						LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"region": "europe"}},
+					}},
+				}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid invalid label selectors and weights outside the range 1-100", func() {
+				shoot.Spec.SeedAffinity = &core.SeedAffinity{
+					RequiredDuringScheduling: []metav1.LabelSelector{
+						{MatchLabels: map[string]string{"foo": "no/slash/allowed"}},
+					},
+					PreferredDuringScheduling: []core.WeightedSeedAffinityTerm{
+						{
+							Weight:        0,
+							LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"region": "europe"}},
+						},
+						{
+							Weight:        101,
+							LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"foo": "no/slash/allowed"}},
+						},
+					},
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.seedAffinity.requiredDuringScheduling[0].matchLabels"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.seedAffinity.preferredDuringScheduling[0].weight"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.seedAffinity.preferredDuringScheduling[1].weight"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.seedAffinity.preferredDuringScheduling[1].labelSelector.matchLabels"),
+					})),
+				))
+			})
+		})
+
 		Context("SecretBindingName/CredentialsBinding validation", func() {
 			It("should forbid adding secretBindingName in case of workerless shoot", func() {
 				shoot.Spec.Provider.Workers = nil
@@ -1244,6 +1354,54 @@ var _ = Describe("Shoot Validation Tests", func() {
 			})
 		})
 
+		Context("ManagedAddons validation", func() {
+			It("should forbid passing a managed addon w/o name information", func() {
+				shoot.Spec.ManagedAddons = append(shoot.Spec.ManagedAddons, core.ManagedAddon{})
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.managedAddons[0].name"),
+					}))))
+			})
+
+			It("should forbid passing a managed addon w/ invalid name information", func() {
+				shoot.Spec.ManagedAddons = append(shoot.Spec.ManagedAddons, core.ManagedAddon{Name: "!nvalid"})
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":     Equal(field.ErrorTypeInvalid),
+						"Field":    Equal("spec.managedAddons[0].name"),
+						"BadValue": Equal("!nvalid"),
+					}))))
+			})
+
+			It("should forbid passing a managed addon of same name more than once", func() {
+				addon := core.ManagedAddon{Name: "arbitrary"}
+				shoot.Spec.ManagedAddons = append(shoot.Spec.ManagedAddons, addon, addon)
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.managedAddons[1].name"),
+					}))))
+			})
+
+			It("should allow passing managed addons w/ different names", func() {
+				shoot.Spec.ManagedAddons = append(shoot.Spec.ManagedAddons, core.ManagedAddon{Name: "arbitrary"}, core.ManagedAddon{Name: "arbitrary-2"})
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+		})
+
 		Context("Resources validation", func() {
 			It("should forbid resources w/o names or w/ invalid references", func() {
 				ref := core.NamedResourceReference{}
@@ -1951,6 +2109,49 @@ var _ = Describe("Shoot Validation Tests", func() {
 				}))))
 			})
 
+			It("should allow specifying a management domain", func() {
+				shoot.Spec.DNS.ManagementDomain = ptr.To("management.example.com")
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid specifying an invalid management domain", func() {
+				shoot.Spec.DNS.ManagementDomain = ptr.To("foo/bar.baz")
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.dns.managementDomain"),
+				}))))
+			})
+
+			It("should forbid specifying a management domain equal to the domain", func() {
+				shoot.Spec.DNS.ManagementDomain = shoot.Spec.DNS.Domain
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.dns.managementDomain"),
+				}))))
+			})
+
+			It("should forbid specifying a management domain without a domain", func() {
+				shoot.Spec.DNS.Providers = nil
+				shoot.Spec.DNS.Domain = nil
+				shoot.Spec.DNS.ManagementDomain = ptr.To("management.example.com")
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.dns.domain"),
+				}))))
+			})
+
 			It("should forbid specifying a secret name when provider equals 'unmanaged'", func() {
 				shoot.Spec.DNS.Providers = []core.DNSProvider{
 					{
@@ -2537,6 +2738,71 @@ var _ = Describe("Shoot Validation Tests", func() {
 					))
 				})
 
+				It("should allow specifying a valid KMS configuration", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig = &core.EncryptionConfig{
+						KMS: &core.KMSEncryptionConfiguration{
+							Name:       "customer-kms",
+							Endpoint:   "unix:///var/run/kms-provider.sock",
+							SecretName: ptr.To("kms-credentials"),
+							Timeout:    &metav1.Duration{Duration: 5 * time.Second},
+						},
+					}
+
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
+
+				It("should deny an invalid KMS configuration", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig = &core.EncryptionConfig{
+						KMS: &core.KMSEncryptionConfiguration{
+							SecretName: ptr.To(""),
+							Timeout:    &metav1.Duration{Duration: -1 * time.Second},
+						},
+					}
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.kubernetes.kubeAPIServer.encryptionConfig.kms.name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.kubernetes.kubeAPIServer.encryptionConfig.kms.endpoint"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("spec.kubernetes.kubeAPIServer.encryptionConfig.kms.secretName"),
+							"Detail": Equal("secretName must not be empty"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("spec.kubernetes.kubeAPIServer.encryptionConfig.kms.timeout"),
+							"Detail": Equal("timeout must be positive"),
+						})),
+					))
+				})
+
+				It("should deny a KMS plugin name containing ':' and an endpoint not using the unix scheme", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig = &core.EncryptionConfig{
+						KMS: &core.KMSEncryptionConfiguration{
+							Name:     "customer:kms",
+							Endpoint: "https://kms.example.com",
+						},
+					}
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("spec.kubernetes.kubeAPIServer.encryptionConfig.kms.name"),
+							"Detail": Equal("name must not contain ':'"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("spec.kubernetes.kubeAPIServer.encryptionConfig.kms.endpoint"),
+							"Detail": Equal("endpoint must start with 'unix://'"),
+						})),
+					))
+				})
+
 				It("should deny changing items when resources in the spec and status are not equal", func() {
 					shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig = &core.EncryptionConfig{
 						Resources: []string{"configmaps", "deployments.apps"},
@@ -2989,6 +3255,48 @@ var _ = Describe("Shoot Validation Tests", func() {
 				})
 			})
 
+			Context("RequestBasedAutoscaling validation", func() {
+				It("should succeed defining request-based autoscaling targets", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.RequestBasedAutoscaling = &core.KubeAPIServerRequestBasedAutoscaling{
+						AverageRequestsPerSecond: ptr.To[int32](500),
+						AverageInflightRequests:  ptr.To[int32](100),
+					}
+
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
+
+				It("should not allow request-based autoscaling without any target", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.RequestBasedAutoscaling = &core.KubeAPIServerRequestBasedAutoscaling{}
+
+					errorList := ValidateShoot(shoot)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.requestBasedAutoscaling"),
+					}))))
+				})
+
+				It("should not allow targets below 1", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.RequestBasedAutoscaling = &core.KubeAPIServerRequestBasedAutoscaling{
+						AverageRequestsPerSecond: ptr.To[int32](0),
+						AverageInflightRequests:  ptr.To[int32](-1),
+					}
+
+					errorList := ValidateShoot(shoot)
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.kubernetes.kubeAPIServer.requestBasedAutoscaling.averageRequestsPerSecond"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.kubernetes.kubeAPIServer.requestBasedAutoscaling.averageInflightRequests"),
+						})),
+					))
+				})
+			})
+
 			Context("Audience validation", func() {
 				It("should allow specifying individual audiences", func() {
 					shoot.Spec.Kubernetes.KubeAPIServer.APIAudiences = []string{"foo", "bar"}
@@ -3133,6 +3441,19 @@ var _ = Describe("Shoot Validation Tests", func() {
 						"Detail": ContainSubstring("this field should not be set for workerless Shoot clusters"),
 					}))))
 				})
+
+				It("should prevent setting concurrentSyncs", func() {
+					shoot.Spec.Kubernetes.KubeControllerManager.ConcurrentSyncs = &core.KubeControllerManagerConcurrentSyncs{
+						Deployment: ptr.To[int32](30),
+					}
+
+					errorList := ValidateShoot(shoot)
+					Expect(errorList).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("spec.kubernetes.kubeControllerManager.concurrentSyncs"),
+						"Detail": ContainSubstring("this field should not be set for workerless Shoot clusters"),
+					}))))
+				})
 			})
 
 			It("should forbid unsupported HPA configuration", func() {
@@ -3345,6 +3666,35 @@ var _ = Describe("Shoot Validation Tests", func() {
 					"Field": Equal("spec.kubernetes.kubeControllerManager.nodeMonitorGracePeriod"),
 				}))))
 			})
+
+			It("should allow valid concurrent syncs configurations", func() {
+				shoot.Spec.Kubernetes.KubeControllerManager.ConcurrentSyncs = &core.KubeControllerManagerConcurrentSyncs{
+					Deployment:  ptr.To[int32](30),
+					ReplicaSet:  ptr.To[int32](30),
+					StatefulSet: ptr.To[int32](10),
+				}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should prevent concurrent syncs values outside the allowed range", func() {
+				shoot.Spec.Kubernetes.KubeControllerManager.ConcurrentSyncs = &core.KubeControllerManagerConcurrentSyncs{
+					Deployment: ptr.To[int32](0),
+					ReplicaSet: ptr.To[int32](101),
+				}
+
+				errorList := ValidateShoot(shoot)
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeControllerManager.concurrentSyncs.deployment"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeControllerManager.concurrentSyncs.replicaSet"),
+					})),
+				))
+			})
 		})
 
 		Context("KubeScheduler validation", func() {
@@ -3983,17 +4333,36 @@ var _ = Describe("Shoot Validation Tests", func() {
 				}))))
 			})
 
-			It("should forbid empty name", func() {
+			It("should forbid empty configuration", func() {
 				shoot.Spec.Kubernetes.Version = "v1.30.0"
 				shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig = nil
 				shoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthentication = &core.StructuredAuthentication{}
 				errorList := ValidateShoot(shoot)
 
+				Expect(errorList).ToNot(BeEmpty())
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeRequired),
+					"Field":  Equal("spec.kubernetes.kubeAPIServer.structuredAuthentication"),
+					"Detail": Equal("must provide either a configMapName or jwtAuthenticators"),
+				}))))
+			})
+
+			It("should forbid setting both configMapName and jwtAuthenticators", func() {
+				shoot.Spec.Kubernetes.Version = "v1.30.0"
+				shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig = nil
+				shoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthentication = &core.StructuredAuthentication{
+					ConfigMapName: "foo",
+					JWTAuthenticators: []core.JWTAuthenticator{{
+						Issuer: core.AuthenticationIssuer{URL: "https://foo.bar", Audiences: []string{"foo"}},
+					}},
+				}
+				errorList := ValidateShoot(shoot)
+
 				Expect(errorList).ToNot(BeEmpty())
 				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
 					"Type":   Equal(field.ErrorTypeForbidden),
-					"Field":  Equal("spec.kubernetes.kubeAPIServer.structuredAuthentication.configMapName"),
-					"Detail": Equal("must provide a name"),
+					"Field":  Equal("spec.kubernetes.kubeAPIServer.structuredAuthentication.jwtAuthenticators"),
+					"Detail": Equal("is mutually exclusive with configMapName"),
 				}))))
 			})
 
@@ -4040,6 +4409,85 @@ var _ = Describe("Shoot Validation Tests", func() {
 
 				Expect(ValidateShoot(shoot)).To(BeEmpty())
 			})
+
+			It("should allow valid jwtAuthenticators", func() {
+				shoot.Spec.Kubernetes.Version = "v1.30.0"
+				shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig = nil
+				shoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthentication = &core.StructuredAuthentication{
+					JWTAuthenticators: []core.JWTAuthenticator{{
+						Issuer: core.AuthenticationIssuer{URL: "https://foo.bar", Audiences: []string{"foo"}},
+						ClaimMappings: &core.AuthenticationClaimMappings{
+							Username: &core.AuthenticationClaimOrExpression{Claim: ptr.To("sub"), Prefix: ptr.To("foo:")},
+							Groups:   &core.AuthenticationClaimOrExpression{Expression: ptr.To("claims.groups")},
+						},
+						ClaimValidationRules: []core.AuthenticationClaimValidationRule{
+							{Claim: ptr.To("aud"), RequiredValue: ptr.To("foo")},
+							{Expression: ptr.To("claims.exp > 0"), Message: ptr.To("expiry must be set")},
+						},
+					}},
+				}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid invalid jwtAuthenticators", func() {
+				shoot.Spec.Kubernetes.Version = "v1.30.0"
+				shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig = nil
+				shoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthentication = &core.StructuredAuthentication{
+					JWTAuthenticators: []core.JWTAuthenticator{
+						{
+							Issuer: core.AuthenticationIssuer{URL: "http://foo.bar"},
+							ClaimMappings: &core.AuthenticationClaimMappings{
+								Username: &core.AuthenticationClaimOrExpression{Claim: ptr.To("sub"), Expression: ptr.To("claims.sub")},
+								Groups:   &core.AuthenticationClaimOrExpression{Prefix: ptr.To("foo:"), Expression: ptr.To("claims.groups")},
+							},
+							ClaimValidationRules: []core.AuthenticationClaimValidationRule{
+								{Expression: ptr.To("claims.exp > 0"), RequiredValue: ptr.To("foo")},
+								{Claim: ptr.To("aud"), Message: ptr.To("foo")},
+							},
+						},
+						{
+							Issuer: core.AuthenticationIssuer{URL: "https://foo.bar", Audiences: []string{"foo"}},
+						},
+						{
+							Issuer: core.AuthenticationIssuer{URL: "https://foo.bar", Audiences: []string{"foo"}},
+						},
+					},
+				}
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.structuredAuthentication.jwtAuthenticators[0].issuer.url"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.structuredAuthentication.jwtAuthenticators[0].issuer.audiences"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.kubernetes.kubeAPIServer.structuredAuthentication.jwtAuthenticators[0].claimMappings.username"),
+						"Detail": Equal("must provide exactly one of claim or expression"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.structuredAuthentication.jwtAuthenticators[0].claimMappings.groups.prefix"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.structuredAuthentication.jwtAuthenticators[0].claimValidationRules[0].requiredValue"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.structuredAuthentication.jwtAuthenticators[0].claimValidationRules[1].message"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.structuredAuthentication.jwtAuthenticators[2].issuer.url"),
+					})),
+				))
+			})
 		})
 
 		Context("Authorization validation", func() {
@@ -4736,6 +5184,25 @@ var _ = Describe("Shoot Validation Tests", func() {
 				Expect(errorList).To(BeEmpty())
 			})
 
+			It("should allow time windows with a valid location", func() {
+				shoot.Spec.Maintenance.TimeWindow.Location = ptr.To("Europe/Berlin")
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid time windows with an invalid location", func() {
+				shoot.Spec.Maintenance.TimeWindow.Location = ptr.To("not-a-location")
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.maintenance.timeWindow.location"),
+				}))))
+			})
+
 			It("should not allow setting machineImageVersion for autoUpdate if it's a workerless Shoot", func() {
 				shoot.Spec.Provider.Workers = nil
 				shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion = ptr.To(true)
@@ -6430,6 +6897,28 @@ var _ = Describe("Shoot Validation Tests", func() {
 				Entry("multiple-operations", "rotate-ssh-keypair;rotate-etcd-encryption-key-start", "rotate-etcd-encryption-key-start"),
 			)
 
+			DescribeTable("partial reconcile operations",
+				func(operation string) {
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", operation)
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+
+					shoot.Spec.Hibernation = &core.Hibernation{Enabled: ptr.To(true)}
+					Expect(ValidateShoot(shoot)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("metadata.annotations[gardener.cloud/operation]"),
+						"Detail": ContainSubstring(fmt.Sprintf("operation '%s' is not permitted when shoot is hibernated or is waking up", operation)),
+					}))))
+
+					shoot.Spec.Hibernation = nil
+					delete(shoot.Annotations, "gardener.cloud/operation")
+				},
+
+				Entry("reconcile-infrastructure", "reconcile-infrastructure"),
+				Entry("reconcile-dns-records", "reconcile-dns-records"),
+				Entry("reconcile-control-plane", "reconcile-control-plane"),
+				Entry("reconcile-system-components", "reconcile-system-components"),
+			)
+
 			Context("trigger workers rollout", func() {
 				It("should forbid triggering workers rollout when pool does not exist", func() {
 					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "rotate-rollout-workers=foo")
@@ -6602,6 +7091,23 @@ var _ = Describe("Shoot Validation Tests", func() {
 				Entry("rotate-serviceaccount-key-complete", "rotate-serviceaccount-key-complete"),
 			)
 
+			It("should forbid unsupported hibernation modes", func() {
+				shoot.Spec.Hibernation = &core.Hibernation{Mode: ptr.To(core.HibernationMode("foo"))}
+
+				Expect(ValidateShoot(shoot)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.hibernation.mode"),
+				}))))
+			})
+
+			It("should allow supported hibernation modes", func() {
+				shoot.Spec.Hibernation = &core.Hibernation{Mode: ptr.To(core.HibernationModeWorkersOnly)}
+
+				Expect(ValidateShoot(shoot)).NotTo(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Field": Equal("spec.hibernation.mode"),
+				}))))
+			})
+
 			DescribeTable("forbid hibernating the shoot when certain rotation operations are in progress",
 				func(status core.ShootStatus) {
 					shoot.Spec.Hibernation = &core.Hibernation{Enabled: ptr.To(true)}
@@ -7992,6 +8498,126 @@ var _ = Describe("Shoot Validation Tests", func() {
 			))
 		})
 
+		Describe("containerd registries validation", func() {
+			It("should allow valid containerd registries", func() {
+				cri := &core.CRI{
+					Name: core.CRINameContainerD,
+					Containerd: &core.ContainerdConfig{
+						Registries: []core.ContainerdRegistry{
+							{
+								Upstream:       "docker.io",
+								Server:         ptr.To("https://registry-1.docker.io"),
+								Hosts:          []string{"https://mirror.example.com"},
+								AuthSecretName: ptr.To("docker-io-credentials"),
+							},
+							{
+								Upstream:      "gcr.io",
+								Hosts:         []string{"http://10.0.0.1:5000"},
+								AllowInsecure: ptr.To(true),
+							},
+						},
+					},
+				}
+
+				Expect(ValidateCRI(cri, field.NewPath("cri"))).To(BeEmpty())
+			})
+
+			It("should forbid containerd configuration for other CRI names", func() {
+				cri := &core.CRI{
+					Name:       core.CRIName("docker"),
+					Containerd: &core.ContainerdConfig{},
+				}
+
+				Expect(ValidateCRI(cri, field.NewPath("cri"))).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("cri.name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("cri.containerd"),
+					})),
+				))
+			})
+
+			It("should forbid invalid containerd registries", func() {
+				cri := &core.CRI{
+					Name: core.CRINameContainerD,
+					Containerd: &core.ContainerdConfig{
+						Registries: []core.ContainerdRegistry{
+							{
+								Upstream: "https://docker.io",
+								Hosts:    []string{"mirror.example.com"},
+							},
+							{
+								AuthSecretName: ptr.To("Not_Valid!"),
+							},
+						},
+					},
+				}
+
+				Expect(ValidateCRI(cri, field.NewPath("cri"))).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("cri.containerd.registries[0].upstream"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("cri.containerd.registries[0].hosts[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("cri.containerd.registries[1].upstream"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("cri.containerd.registries[1].hosts"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("cri.containerd.registries[1].authSecretName"),
+					})),
+				))
+			})
+
+			It("should forbid duplicate upstreams", func() {
+				cri := &core.CRI{
+					Name: core.CRINameContainerD,
+					Containerd: &core.ContainerdConfig{
+						Registries: []core.ContainerdRegistry{
+							{Upstream: "docker.io", Hosts: []string{"https://mirror.example.com"}},
+							{Upstream: "docker.io", Hosts: []string{"https://mirror2.example.com"}},
+						},
+					},
+				}
+
+				Expect(ValidateCRI(cri, field.NewPath("cri"))).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("cri.containerd.registries[1].upstream"),
+					})),
+				))
+			})
+
+			It("should forbid plain HTTP mirror URLs if allowInsecure is not set", func() {
+				cri := &core.CRI{
+					Name: core.CRINameContainerD,
+					Containerd: &core.ContainerdConfig{
+						Registries: []core.ContainerdRegistry{
+							{Upstream: "docker.io", Hosts: []string{"http://mirror.example.com"}},
+						},
+					},
+				}
+
+				Expect(ValidateCRI(cri, field.NewPath("cri"))).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("cri.containerd.registries[0].hosts[0]"),
+					})),
+				))
+			})
+		})
+
 		Describe("taint validation", func() {
 			var (
 				worker     core.Worker
@@ -8441,6 +9067,27 @@ var _ = Describe("Shoot Validation Tests", func() {
 			}))))
 		})
 
+		It("should fail when nodeWorkloadIdentityName is set to an empty string", func() {
+			worker := core.Worker{
+				Name: "worker",
+				Machine: core.Machine{
+					Type: "xlarge",
+					Image: &core.ShootMachineImage{
+						Name:    "image-name",
+						Version: "1.0.0",
+					},
+				},
+				MaxUnavailable:           ptr.To(intstr.FromInt(1)),
+				NodeWorkloadIdentityName: ptr.To(""),
+			}
+
+			errList := ValidateWorker(worker, core.Kubernetes{Version: ""}, shootNamespace, providerType, nil, false)
+			Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("nodeWorkloadIdentityName"),
+			}))))
+		})
+
 		DescribeTable("sysctl setting validation", func(sysctls map[string]string, matcher gomegatypes.GomegaMatcher) {
 			errList := ValidateSysctls(sysctls, field.NewPath("sysctls"))
 			Expect(errList).To(matcher)
@@ -532,6 +532,25 @@ func validateExtensions(extensions []core.Extension, fldPath *field.Path) field.
 	return allErrs
 }
 
+func validateManagedAddons(addons []core.ManagedAddon, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := sets.Set[string]{}
+	for i, addon := range addons {
+		if addon.Name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Index(i).Child("name"), "field must not be empty"))
+		} else {
+			allErrs = append(allErrs, validateDNS1123Label(addon.Name, fldPath.Index(i).Child("name"))...)
+		}
+
+		if names.Has(addon.Name) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i).Child("name"), addon.Name))
+		} else {
+			names.Insert(addon.Name)
+		}
+	}
+	return allErrs
+}
+
 // ValidateResources validates the given list of NamedResourceReference for valid values and combinations.
 func ValidateResources(resources []core.NamedResourceReference, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -139,6 +139,11 @@ func (in *Addons) DeepCopyInto(out *Addons) {
 		*out = new(NginxIngress)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ResourceBackup != nil {
+		in, out := &in.ResourceBackup, &out.ResourceBackup
+		*out = new(ResourceBackupAddon)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -246,6 +251,125 @@ func (in *AuditPolicy) DeepCopy() *AuditPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationClaimMappings) DeepCopyInto(out *AuthenticationClaimMappings) {
+	*out = *in
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(AuthenticationClaimOrExpression)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = new(AuthenticationClaimOrExpression)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationClaimMappings.
+func (in *AuthenticationClaimMappings) DeepCopy() *AuthenticationClaimMappings {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationClaimMappings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationClaimOrExpression) DeepCopyInto(out *AuthenticationClaimOrExpression) {
+	*out = *in
+	if in.Claim != nil {
+		in, out := &in.Claim, &out.Claim
+		*out = new(string)
+		**out = **in
+	}
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Expression != nil {
+		in, out := &in.Expression, &out.Expression
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationClaimOrExpression.
+func (in *AuthenticationClaimOrExpression) DeepCopy() *AuthenticationClaimOrExpression {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationClaimOrExpression)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationClaimValidationRule) DeepCopyInto(out *AuthenticationClaimValidationRule) {
+	*out = *in
+	if in.Claim != nil {
+		in, out := &in.Claim, &out.Claim
+		*out = new(string)
+		**out = **in
+	}
+	if in.RequiredValue != nil {
+		in, out := &in.RequiredValue, &out.RequiredValue
+		*out = new(string)
+		**out = **in
+	}
+	if in.Expression != nil {
+		in, out := &in.Expression, &out.Expression
+		*out = new(string)
+		**out = **in
+	}
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationClaimValidationRule.
+func (in *AuthenticationClaimValidationRule) DeepCopy() *AuthenticationClaimValidationRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationClaimValidationRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationIssuer) DeepCopyInto(out *AuthenticationIssuer) {
+	*out = *in
+	if in.Audiences != nil {
+		in, out := &in.Audiences, &out.Audiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CertificateAuthority != nil {
+		in, out := &in.CertificateAuthority, &out.CertificateAuthority
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationIssuer.
+func (in *AuthenticationIssuer) DeepCopy() *AuthenticationIssuer {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationIssuer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthorizerKubeconfigReference) DeepCopyInto(out *AuthorizerKubeconfigReference) {
 	*out = *in
@@ -693,6 +817,11 @@ func (in *CRI) DeepCopyInto(out *CRI) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Containerd != nil {
+		in, out := &in.Containerd, &out.Containerd
+		*out = new(ContainerdConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -777,6 +906,30 @@ func (in CapabilityValues) DeepCopy() CapabilityValues {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudCredentialsRotation) DeepCopyInto(out *CloudCredentialsRotation) {
+	*out = *in
+	if in.LastInitiationTime != nil {
+		in, out := &in.LastInitiationTime, &out.LastInitiationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastCompletionTime != nil {
+		in, out := &in.LastCompletionTime, &out.LastCompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudCredentialsRotation.
+func (in *CloudCredentialsRotation) DeepCopy() *CloudCredentialsRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudCredentialsRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudProfile) DeepCopyInto(out *CloudProfile) {
 	*out = *in
@@ -917,6 +1070,13 @@ func (in *CloudProfileSpec) DeepCopyInto(out *CloudProfileSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RegistryMirrors != nil {
+		in, out := &in.RegistryMirrors, &out.RegistryMirrors
+		*out = make([]RegistryMirror, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1136,6 +1296,65 @@ func (in *ContainerRuntime) DeepCopy() *ContainerRuntime {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerdConfig) DeepCopyInto(out *ContainerdConfig) {
+	*out = *in
+	if in.Registries != nil {
+		in, out := &in.Registries, &out.Registries
+		*out = make([]ContainerdRegistry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerdConfig.
+func (in *ContainerdConfig) DeepCopy() *ContainerdConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerdConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerdRegistry) DeepCopyInto(out *ContainerdRegistry) {
+	*out = *in
+	if in.Server != nil {
+		in, out := &in.Server, &out.Server
+		*out = new(string)
+		**out = **in
+	}
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AuthSecretName != nil {
+		in, out := &in.AuthSecretName, &out.AuthSecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllowInsecure != nil {
+		in, out := &in.AllowInsecure, &out.AllowInsecure
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerdRegistry.
+func (in *ContainerdRegistry) DeepCopy() *ContainerdRegistry {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerdRegistry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControlPlane) DeepCopyInto(out *ControlPlane) {
 	*out = *in
@@ -1180,6 +1399,38 @@ func (in *ControlPlaneAutoscaling) DeepCopy() *ControlPlaneAutoscaling {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneResourceConsumption) DeepCopyInto(out *ControlPlaneResourceConsumption) {
+	*out = *in
+	if in.KubeAPIServerCPU != nil {
+		in, out := &in.KubeAPIServerCPU, &out.KubeAPIServerCPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.KubeAPIServerMemory != nil {
+		in, out := &in.KubeAPIServerMemory, &out.KubeAPIServerMemory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.ETCDStorageSize != nil {
+		in, out := &in.ETCDStorageSize, &out.ETCDStorageSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneResourceConsumption.
+func (in *ControlPlaneResourceConsumption) DeepCopy() *ControlPlaneResourceConsumption {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneResourceConsumption)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerDeployment) DeepCopyInto(out *ControllerDeployment) {
 	*out = *in
@@ -1516,6 +1767,11 @@ func (in *ControllerResource) DeepCopyInto(out *ControllerResource) {
 		*out = make([]ClusterType, len(*in))
 		copy(*out, *in)
 	}
+	if in.Addon != nil {
+		in, out := &in.Addon, &out.Addon
+		*out = new(ControllerResourceAddon)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1529,6 +1785,27 @@ func (in *ControllerResource) DeepCopy() *ControllerResource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerResourceAddon) DeepCopyInto(out *ControllerResourceAddon) {
+	*out = *in
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerResourceAddon.
+func (in *ControllerResourceAddon) DeepCopy() *ControllerResourceAddon {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerResourceAddon)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerResourceLifecycle) DeepCopyInto(out *ControllerResourceLifecycle) {
 	*out = *in
@@ -1631,6 +1908,11 @@ func (in *DNS) DeepCopyInto(out *DNS) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ManagementDomain != nil {
+		in, out := &in.ManagementDomain, &out.ManagementDomain
+		*out = new(string)
+		**out = **in
+	}
 	if in.Providers != nil {
 		in, out := &in.Providers, &out.Providers
 		*out = make([]DNSProvider, len(*in))
@@ -1874,6 +2156,11 @@ func (in *EncryptionAtRest) DeepCopyInto(out *EncryptionAtRest) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.MigrationProgress != nil {
+		in, out := &in.MigrationProgress, &out.MigrationProgress
+		*out = new(EncryptionAtRestMigrationProgress)
+		**out = **in
+	}
 	return
 }
 
@@ -1887,6 +2174,22 @@ func (in *EncryptionAtRest) DeepCopy() *EncryptionAtRest {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionAtRestMigrationProgress) DeepCopyInto(out *EncryptionAtRestMigrationProgress) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionAtRestMigrationProgress.
+func (in *EncryptionAtRestMigrationProgress) DeepCopy() *EncryptionAtRestMigrationProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionAtRestMigrationProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EncryptionConfig) DeepCopyInto(out *EncryptionConfig) {
 	*out = *in
@@ -1895,6 +2198,11 @@ func (in *EncryptionConfig) DeepCopyInto(out *EncryptionConfig) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.KMS != nil {
+		in, out := &in.KMS, &out.KMS
+		*out = new(KMSEncryptionConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2189,6 +2497,11 @@ func (in *Hibernation) DeepCopyInto(out *Hibernation) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(HibernationMode)
+		**out = **in
+	}
 	return
 }
 
@@ -2450,6 +2763,61 @@ func (in *InternalSecretList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTAuthenticator) DeepCopyInto(out *JWTAuthenticator) {
+	*out = *in
+	in.Issuer.DeepCopyInto(&out.Issuer)
+	if in.ClaimMappings != nil {
+		in, out := &in.ClaimMappings, &out.ClaimMappings
+		*out = new(AuthenticationClaimMappings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClaimValidationRules != nil {
+		in, out := &in.ClaimValidationRules, &out.ClaimValidationRules
+		*out = make([]AuthenticationClaimValidationRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTAuthenticator.
+func (in *JWTAuthenticator) DeepCopy() *JWTAuthenticator {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTAuthenticator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KMSEncryptionConfiguration) DeepCopyInto(out *KMSEncryptionConfiguration) {
+	*out = *in
+	if in.SecretName != nil {
+		in, out := &in.SecretName, &out.SecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KMSEncryptionConfiguration.
+func (in *KMSEncryptionConfiguration) DeepCopy() *KMSEncryptionConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KMSEncryptionConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeAPIServerConfig) DeepCopyInto(out *KubeAPIServerConfig) {
 	*out = *in
@@ -2531,7 +2899,7 @@ func (in *KubeAPIServerConfig) DeepCopyInto(out *KubeAPIServerConfig) {
 	if in.StructuredAuthentication != nil {
 		in, out := &in.StructuredAuthentication, &out.StructuredAuthentication
 		*out = new(StructuredAuthentication)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.StructuredAuthorization != nil {
 		in, out := &in.StructuredAuthorization, &out.StructuredAuthorization
@@ -2543,6 +2911,11 @@ func (in *KubeAPIServerConfig) DeepCopyInto(out *KubeAPIServerConfig) {
 		*out = new(ControlPlaneAutoscaling)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RequestBasedAutoscaling != nil {
+		in, out := &in.RequestBasedAutoscaling, &out.RequestBasedAutoscaling
+		*out = new(KubeAPIServerRequestBasedAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2557,12 +2930,69 @@ func (in *KubeAPIServerConfig) DeepCopy() *KubeAPIServerConfig {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubeControllerManagerConfig) DeepCopyInto(out *KubeControllerManagerConfig) {
+func (in *KubeAPIServerRequestBasedAutoscaling) DeepCopyInto(out *KubeAPIServerRequestBasedAutoscaling) {
 	*out = *in
-	in.KubernetesConfig.DeepCopyInto(&out.KubernetesConfig)
-	if in.HorizontalPodAutoscalerConfig != nil {
-		in, out := &in.HorizontalPodAutoscalerConfig, &out.HorizontalPodAutoscalerConfig
-		*out = new(HorizontalPodAutoscalerConfig)
+	if in.AverageRequestsPerSecond != nil {
+		in, out := &in.AverageRequestsPerSecond, &out.AverageRequestsPerSecond
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AverageInflightRequests != nil {
+		in, out := &in.AverageInflightRequests, &out.AverageInflightRequests
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeAPIServerRequestBasedAutoscaling.
+func (in *KubeAPIServerRequestBasedAutoscaling) DeepCopy() *KubeAPIServerRequestBasedAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeAPIServerRequestBasedAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeControllerManagerConcurrentSyncs) DeepCopyInto(out *KubeControllerManagerConcurrentSyncs) {
+	*out = *in
+	if in.Deployment != nil {
+		in, out := &in.Deployment, &out.Deployment
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReplicaSet != nil {
+		in, out := &in.ReplicaSet, &out.ReplicaSet
+		*out = new(int32)
+		**out = **in
+	}
+	if in.StatefulSet != nil {
+		in, out := &in.StatefulSet, &out.StatefulSet
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeControllerManagerConcurrentSyncs.
+func (in *KubeControllerManagerConcurrentSyncs) DeepCopy() *KubeControllerManagerConcurrentSyncs {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeControllerManagerConcurrentSyncs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeControllerManagerConfig) DeepCopyInto(out *KubeControllerManagerConfig) {
+	*out = *in
+	in.KubernetesConfig.DeepCopyInto(&out.KubernetesConfig)
+	if in.HorizontalPodAutoscalerConfig != nil {
+		in, out := &in.HorizontalPodAutoscalerConfig, &out.HorizontalPodAutoscalerConfig
+		*out = new(HorizontalPodAutoscalerConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.NodeCIDRMaskSize != nil {
@@ -2580,6 +3010,11 @@ func (in *KubeControllerManagerConfig) DeepCopyInto(out *KubeControllerManagerCo
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(KubeControllerManagerConcurrentSyncs)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3324,6 +3759,31 @@ func (in *MachineImageFlavor) DeepCopy() *MachineImageFlavor {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImageUpgradePath) DeepCopyInto(out *MachineImageUpgradePath) {
+	*out = *in
+	if in.Next != nil {
+		in, out := &in.Next, &out.Next
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExpirationDate != nil {
+		in, out := &in.ExpirationDate, &out.ExpirationDate
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImageUpgradePath.
+func (in *MachineImageUpgradePath) DeepCopy() *MachineImageUpgradePath {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImageUpgradePath)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineImageVersion) DeepCopyInto(out *MachineImageVersion) {
 	*out = *in
@@ -3456,7 +3916,7 @@ func (in *Maintenance) DeepCopyInto(out *Maintenance) {
 	if in.TimeWindow != nil {
 		in, out := &in.TimeWindow, &out.TimeWindow
 		*out = new(MaintenanceTimeWindow)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ConfineSpecUpdateRollout != nil {
 		in, out := &in.ConfineSpecUpdateRollout, &out.ConfineSpecUpdateRollout
@@ -3500,6 +3960,11 @@ func (in *MaintenanceAutoUpdate) DeepCopy() *MaintenanceAutoUpdate {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MaintenanceTimeWindow) DeepCopyInto(out *MaintenanceTimeWindow) {
 	*out = *in
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -3513,6 +3978,27 @@ func (in *MaintenanceTimeWindow) DeepCopy() *MaintenanceTimeWindow {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedAddon) DeepCopyInto(out *ManagedAddon) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedAddon.
+func (in *ManagedAddon) DeepCopy() *ManagedAddon {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedAddon)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManualWorkerPoolRollout) DeepCopyInto(out *ManualWorkerPoolRollout) {
 	*out = *in
@@ -3800,6 +4286,13 @@ func (in *NetworkingStatus) DeepCopyInto(out *NetworkingStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.EgressCIDRsByZone != nil {
+		in, out := &in.EgressCIDRsByZone, &out.EgressCIDRsByZone
+		*out = make([]ZoneEgressCIDRs, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -4243,6 +4736,11 @@ func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
 		in, out := &in.LastActivityTimestamp, &out.LastActivityTimestamp
 		*out = (*in).DeepCopy()
 	}
+	if in.UsageSummary != nil {
+		in, out := &in.UsageSummary, &out.UsageSummary
+		*out = new(ProjectUsageSummary)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4286,6 +4784,25 @@ func (in *ProjectTolerations) DeepCopy() *ProjectTolerations {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectUsageSummary) DeepCopyInto(out *ProjectUsageSummary) {
+	*out = *in
+	out.CPU = in.CPU.DeepCopy()
+	out.Memory = in.Memory.DeepCopy()
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectUsageSummary.
+func (in *ProjectUsageSummary) DeepCopy() *ProjectUsageSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectUsageSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Provider) DeepCopyInto(out *Provider) {
 	*out = *in
@@ -4448,6 +4965,64 @@ func (in *Region) DeepCopy() *Region {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryMirror) DeepCopyInto(out *RegistryMirror) {
+	*out = *in
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryMirror.
+func (in *RegistryMirror) DeepCopy() *RegistryMirror {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryMirror)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceBackupAddon) DeepCopyInto(out *ResourceBackupAddon) {
+	*out = *in
+	out.Addon = in.Addon
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(string)
+		**out = **in
+	}
+	if in.RetentionPeriod != nil {
+		in, out := &in.RetentionPeriod, &out.RetentionPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.IncludedNamespaces != nil {
+		in, out := &in.IncludedNamespaces, &out.IncludedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceBackupAddon.
+func (in *ResourceBackupAddon) DeepCopy() *ResourceBackupAddon {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceBackupAddon)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceData) DeepCopyInto(out *ResourceData) {
 	*out = *in
@@ -4519,6 +5094,7 @@ func (in *SecretBinding) DeepCopyInto(out *SecretBinding) {
 		*out = new(SecretBindingProvider)
 		**out = **in
 	}
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -4589,6 +5165,29 @@ func (in *SecretBindingProvider) DeepCopy() *SecretBindingProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretBindingStatus) DeepCopyInto(out *SecretBindingStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretBindingStatus.
+func (in *SecretBindingStatus) DeepCopy() *SecretBindingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretBindingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Seed) DeepCopyInto(out *Seed) {
 	*out = *in
@@ -4617,6 +5216,60 @@ func (in *Seed) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedAffinity) DeepCopyInto(out *SeedAffinity) {
+	*out = *in
+	if in.RequiredDuringScheduling != nil {
+		in, out := &in.RequiredDuringScheduling, &out.RequiredDuringScheduling
+		*out = make([]metav1.LabelSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PreferredDuringScheduling != nil {
+		in, out := &in.PreferredDuringScheduling, &out.PreferredDuringScheduling
+		*out = make([]WeightedSeedAffinityTerm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedAffinity.
+func (in *SeedAffinity) DeepCopy() *SeedAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedAvailability) DeepCopyInto(out *SeedAvailability) {
+	*out = *in
+	if in.LastDowntimeBegin != nil {
+		in, out := &in.LastDowntimeBegin, &out.LastDowntimeBegin
+		*out = (*in).DeepCopy()
+	}
+	if in.LastDowntimeEnd != nil {
+		in, out := &in.LastDowntimeEnd, &out.LastDowntimeEnd
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedAvailability.
+func (in *SeedAvailability) DeepCopy() *SeedAvailability {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedAvailability)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedDNS) DeepCopyInto(out *SeedDNS) {
 	*out = *in
@@ -4806,18 +5459,34 @@ func (in *SeedSelector) DeepCopy() *SeedSelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedSettingDNSCache) DeepCopyInto(out *SeedSettingDNSCache) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingDNSCache.
+func (in *SeedSettingDNSCache) DeepCopy() *SeedSettingDNSCache {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedSettingDNSCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedSettingDependencyWatchdog) DeepCopyInto(out *SeedSettingDependencyWatchdog) {
 	*out = *in
 	if in.Weeder != nil {
 		in, out := &in.Weeder, &out.Weeder
 		*out = new(SeedSettingDependencyWatchdogWeeder)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Prober != nil {
 		in, out := &in.Prober, &out.Prober
 		*out = new(SeedSettingDependencyWatchdogProber)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -4835,6 +5504,11 @@ func (in *SeedSettingDependencyWatchdog) DeepCopy() *SeedSettingDependencyWatchd
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedSettingDependencyWatchdogProber) DeepCopyInto(out *SeedSettingDependencyWatchdogProber) {
 	*out = *in
+	if in.ProbeInterval != nil {
+		in, out := &in.ProbeInterval, &out.ProbeInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -4851,6 +5525,11 @@ func (in *SeedSettingDependencyWatchdogProber) DeepCopy() *SeedSettingDependency
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedSettingDependencyWatchdogWeeder) DeepCopyInto(out *SeedSettingDependencyWatchdogWeeder) {
 	*out = *in
+	if in.WatchDuration != nil {
+		in, out := &in.WatchDuration, &out.WatchDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -4916,6 +5595,11 @@ func (in *SeedSettingExcessCapacityReservationConfig) DeepCopyInto(out *SeedSett
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -4929,6 +5613,88 @@ func (in *SeedSettingExcessCapacityReservationConfig) DeepCopy() *SeedSettingExc
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedSettingIstioIngressGateway) DeepCopyInto(out *SeedSettingIstioIngressGateway) {
+	*out = *in
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(SeedSettingIstioIngressGatewayAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExposureClassHandlers != nil {
+		in, out := &in.ExposureClassHandlers, &out.ExposureClassHandlers
+		*out = make([]SeedSettingIstioIngressGatewayExposureClassHandler, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingIstioIngressGateway.
+func (in *SeedSettingIstioIngressGateway) DeepCopy() *SeedSettingIstioIngressGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedSettingIstioIngressGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedSettingIstioIngressGatewayAutoscaling) DeepCopyInto(out *SeedSettingIstioIngressGatewayAutoscaling) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingIstioIngressGatewayAutoscaling.
+func (in *SeedSettingIstioIngressGatewayAutoscaling) DeepCopy() *SeedSettingIstioIngressGatewayAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedSettingIstioIngressGatewayAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedSettingIstioIngressGatewayExposureClassHandler) DeepCopyInto(out *SeedSettingIstioIngressGatewayExposureClassHandler) {
+	*out = *in
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(SeedSettingIstioIngressGatewayAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingIstioIngressGatewayExposureClassHandler.
+func (in *SeedSettingIstioIngressGatewayExposureClassHandler) DeepCopy() *SeedSettingIstioIngressGatewayExposureClassHandler {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedSettingIstioIngressGatewayExposureClassHandler)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedSettingLoadBalancerServices) DeepCopyInto(out *SeedSettingLoadBalancerServices) {
 	*out = *in
@@ -5128,6 +5894,16 @@ func (in *SeedSettings) DeepCopyInto(out *SeedSettings) {
 		*out = new(SeedSettingTopologyAwareRouting)
 		**out = **in
 	}
+	if in.IstioIngressGateway != nil {
+		in, out := &in.IstioIngressGateway, &out.IstioIngressGateway
+		*out = new(SeedSettingIstioIngressGateway)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNSCache != nil {
+		in, out := &in.DNSCache, &out.DNSCache
+		*out = new(SeedSettingDNSCache)
+		**out = **in
+	}
 	return
 }
 
@@ -5252,6 +6028,11 @@ func (in *SeedStatus) DeepCopyInto(out *SeedStatus) {
 		*out = new(LastOperation)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Availability != nil {
+		in, out := &in.Availability, &out.Availability
+		*out = new(SeedAvailability)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5519,6 +6300,11 @@ func (in *ShootCredentialsRotation) DeepCopyInto(out *ShootCredentialsRotation)
 		*out = new(ETCDEncryptionKeyRotation)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CloudCredentials != nil {
+		in, out := &in.CloudCredentials, &out.CloudCredentials
+		*out = new(CloudCredentialsRotation)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5776,6 +6562,18 @@ func (in *ShootSpec) DeepCopyInto(out *ShootSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.SeedAffinity != nil {
+		in, out := &in.SeedAffinity, &out.SeedAffinity
+		*out = new(SeedAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ManagedAddons != nil {
+		in, out := &in.ManagedAddons, &out.ManagedAddons
+		*out = make([]ManagedAddon, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -5973,6 +6771,23 @@ func (in *ShootStatus) DeepCopyInto(out *ShootStatus) {
 		*out = new(ManualWorkerPoolRollout)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ResourceConsumption != nil {
+		in, out := &in.ResourceConsumption, &out.ResourceConsumption
+		*out = new(ControlPlaneResourceConsumption)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkerPools != nil {
+		in, out := &in.WorkerPools, &out.WorkerPools
+		*out = make([]WorkerPoolStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UpgradePaths != nil {
+		in, out := &in.UpgradePaths, &out.UpgradePaths
+		*out = new(ShootUpgradePaths)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6004,9 +6819,42 @@ func (in *ShootTemplate) DeepCopy() *ShootTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootUpgradePaths) DeepCopyInto(out *ShootUpgradePaths) {
+	*out = *in
+	in.Kubernetes.DeepCopyInto(&out.Kubernetes)
+	if in.MachineImages != nil {
+		in, out := &in.MachineImages, &out.MachineImages
+		*out = make([]MachineImageUpgradePath, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootUpgradePaths.
+func (in *ShootUpgradePaths) DeepCopy() *ShootUpgradePaths {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootUpgradePaths)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StructuredAuthentication) DeepCopyInto(out *StructuredAuthentication) {
 	*out = *in
+	if in.JWTAuthenticators != nil {
+		in, out := &in.JWTAuthenticators, &out.JWTAuthenticators
+		*out = make([]JWTAuthenticator, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -6088,6 +6936,36 @@ func (in *Toleration) DeepCopy() *Toleration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VersionUpgradePath) DeepCopyInto(out *VersionUpgradePath) {
+	*out = *in
+	if in.NextPatch != nil {
+		in, out := &in.NextPatch, &out.NextPatch
+		*out = new(string)
+		**out = **in
+	}
+	if in.NextMinor != nil {
+		in, out := &in.NextMinor, &out.NextMinor
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExpirationDate != nil {
+		in, out := &in.ExpirationDate, &out.ExpirationDate
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VersionUpgradePath.
+func (in *VersionUpgradePath) DeepCopy() *VersionUpgradePath {
+	if in == nil {
+		return nil
+	}
+	out := new(VersionUpgradePath)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VerticalPodAutoscaler) DeepCopyInto(out *VerticalPodAutoscaler) {
 	*out = *in
@@ -6288,6 +7166,23 @@ func (in *WatchCacheSizes) DeepCopy() *WatchCacheSizes {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeightedSeedAffinityTerm) DeepCopyInto(out *WeightedSeedAffinityTerm) {
+	*out = *in
+	in.LabelSelector.DeepCopyInto(&out.LabelSelector)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeightedSeedAffinityTerm.
+func (in *WeightedSeedAffinityTerm) DeepCopy() *WeightedSeedAffinityTerm {
+	if in == nil {
+		return nil
+	}
+	out := new(WeightedSeedAffinityTerm)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Worker) DeepCopyInto(out *Worker) {
 	*out = *in
@@ -6402,6 +7297,11 @@ func (in *Worker) DeepCopyInto(out *Worker) {
 		*out = new(WorkerControlPlane)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NodeWorkloadIdentityName != nil {
+		in, out := &in.NodeWorkloadIdentityName, &out.NodeWorkloadIdentityName
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -6462,6 +7362,26 @@ func (in *WorkerKubernetes) DeepCopy() *WorkerKubernetes {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerPoolStatus) DeepCopyInto(out *WorkerPoolStatus) {
+	*out = *in
+	if in.LastRolloutTime != nil {
+		in, out := &in.LastRolloutTime, &out.LastRolloutTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerPoolStatus.
+func (in *WorkerPoolStatus) DeepCopy() *WorkerPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerSystemComponents) DeepCopyInto(out *WorkerSystemComponents) {
 	*out = *in
@@ -6498,3 +7418,24 @@ func (in *WorkersSettings) DeepCopy() *WorkersSettings {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneEgressCIDRs) DeepCopyInto(out *ZoneEgressCIDRs) {
+	*out = *in
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneEgressCIDRs.
+func (in *ZoneEgressCIDRs) DeepCopy() *ZoneEgressCIDRs {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneEgressCIDRs)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -41,6 +41,11 @@ type Status interface {
 	GetLastOperation() *gardencorev1beta1.LastOperation
 	// SetLastOperation sets the LastOperation of a status.
 	SetLastOperation(*gardencorev1beta1.LastOperation)
+	// GetProgress retrieves the structured progress information of the last operation of a status.
+	// Progress may be nil.
+	GetProgress() *OperationProgress
+	// SetProgress sets the structured progress information of the last operation of a status.
+	SetProgress(*OperationProgress)
 	// GetObservedGeneration retrieves the last generation observed by the extension controller.
 	GetObservedGeneration() int64
 	// SetObservedGeneration sets the ObservedGeneration of a status.
@@ -5,6 +5,7 @@
 package v1alpha1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -72,6 +73,10 @@ type DefaultStatus struct {
 	// LastOperation holds information about the last operation on the resource.
 	// +optional
 	LastOperation *gardencorev1beta1.LastOperation `json:"lastOperation,omitempty"`
+	// Progress holds structured information about the progress of the last operation on the resource. It may be
+	// published by the extension controller while an operation is being processed.
+	// +optional
+	Progress *OperationProgress `json:"progress,omitempty"`
 	// ObservedGeneration is the most recent generation observed for this resource.
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// State can be filled by the operating controller with what ever data it needs.
@@ -84,6 +89,19 @@ type DefaultStatus struct {
 	Resources []gardencorev1beta1.NamedResourceReference `json:"resources,omitempty"`
 }
 
+// OperationProgress contains structured information about the progress of the last operation on an extension
+// resource.
+type OperationProgress struct {
+	// Percentage is the completion percentage of the last operation, ranging from 0 to 100.
+	Percentage int32 `json:"percentage"`
+	// CurrentStep describes the step the last operation is currently working on.
+	// +optional
+	CurrentStep string `json:"currentStep,omitempty"`
+	// EstimatedCompletionTime is the estimated time at which the last operation will be completed.
+	// +optional
+	EstimatedCompletionTime *metav1.Time `json:"estimatedCompletionTime,omitempty"`
+}
+
 // GetProviderStatus implements Status.
 func (d *DefaultStatus) GetProviderStatus() *runtime.RawExtension {
 	return d.ProviderStatus
@@ -109,6 +127,16 @@ func (d *DefaultStatus) SetLastOperation(lastOp *gardencorev1beta1.LastOperation
 	d.LastOperation = lastOp
 }
 
+// GetProgress implements Status.
+func (d *DefaultStatus) GetProgress() *OperationProgress {
+	return d.Progress
+}
+
+// SetProgress implements Status.
+func (d *DefaultStatus) SetProgress(progress *OperationProgress) {
+	d.Progress = progress
+}
+
 // GetLastError implements Status.
 func (d *DefaultStatus) GetLastError() *gardencorev1beta1.LastError {
 	return d.LastError
@@ -86,11 +86,24 @@ type InfrastructureStatus struct {
 	// IPs may not be stable in which case the extension controller may opt to not populate this field.
 	// +optional
 	EgressCIDRs []string `json:"egressCIDRs,omitempty"`
+	// EgressCIDRsByZone is a list of CIDRs used by the shoot as the source IP for egress traffic, grouped by the
+	// availability zone the traffic originates from (e.g. the IPs of zonal NAT gateways). Extension controllers may
+	// populate this field in addition to EgressCIDRs if the egress IPs differ per zone.
+	// +optional
+	EgressCIDRsByZone []ZoneEgressCIDRs `json:"egressCIDRsByZone,omitempty"`
 	// Networking contains information about cluster networking such as CIDRs.
 	// +optional
 	Networking *InfrastructureStatusNetworking `json:"networking,omitempty"`
 }
 
+// ZoneEgressCIDRs contains the egress CIDRs used by worker nodes in a single availability zone.
+type ZoneEgressCIDRs struct {
+	// Zone is the name of the availability zone.
+	Zone string `json:"zone"`
+	// CIDRs is a list of CIDRs used as the source IP for egress traffic originating from this zone.
+	CIDRs []string `json:"cidrs"`
+}
+
 // InfrastructureStatusNetworking is a structure containing information about the node, service and pod network ranges.
 type InfrastructureStatusNetworking struct {
 	// Pods are the CIDRs of the pod network.
@@ -174,6 +174,10 @@ type WorkerPool struct {
 	// UpdateStrategy specifies the machine update strategy for the worker pool.
 	// +optional
 	UpdateStrategy *gardencorev1beta1.MachineUpdateStrategy `json:"updateStrategy,omitempty"`
+	// NodeWorkloadIdentityName is the name of a WorkloadIdentity in the shoot's project namespace whose identity
+	// shall be bound to the nodes of this worker pool, e.g. via instance profiles or managed identities.
+	// +optional
+	NodeWorkloadIdentityName *string `json:"nodeWorkloadIdentityName,omitempty"`
 }
 
 // ClusterAutoscalerOptions contains the cluster autoscaler configurations for a worker pool.
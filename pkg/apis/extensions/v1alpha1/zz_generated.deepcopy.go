@@ -1402,6 +1402,13 @@ func (in *InfrastructureStatus) DeepCopyInto(out *InfrastructureStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.EgressCIDRsByZone != nil {
+		in, out := &in.EgressCIDRsByZone, &out.EgressCIDRsByZone
+		*out = make([]ZoneEgressCIDRs, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Networking != nil {
 		in, out := &in.Networking, &out.Networking
 		*out = new(InfrastructureStatusNetworking)
@@ -1787,6 +1794,26 @@ func (in *OperatingSystemConfigStatus) DeepCopy() *OperatingSystemConfigStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationProgress) DeepCopyInto(out *OperationProgress) {
+	*out = *in
+	if in.EstimatedCompletionTime != nil {
+		in, out := &in.EstimatedCompletionTime, &out.EstimatedCompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationProgress.
+func (in *OperationProgress) DeepCopy() *OperationProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PluginConfig) DeepCopyInto(out *PluginConfig) {
 	*out = *in
@@ -2135,6 +2162,11 @@ func (in *WorkerPool) DeepCopyInto(out *WorkerPool) {
 		*out = new(v1beta1.MachineUpdateStrategy)
 		**out = **in
 	}
+	if in.NodeWorkloadIdentityName != nil {
+		in, out := &in.NodeWorkloadIdentityName, &out.NodeWorkloadIdentityName
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -2215,3 +2247,24 @@ func (in *WorkerStatus) DeepCopy() *WorkerStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneEgressCIDRs) DeepCopyInto(out *ZoneEgressCIDRs) {
+	*out = *in
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneEgressCIDRs.
+func (in *ZoneEgressCIDRs) DeepCopy() *ZoneEgressCIDRs {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneEgressCIDRs)
+	in.DeepCopyInto(out)
+	return out
+}
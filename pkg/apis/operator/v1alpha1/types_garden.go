@@ -498,6 +498,13 @@ type GardenerAPIServerConfig struct {
 	// AuditWebhook contains settings related to an audit webhook configuration.
 	// +optional
 	AuditWebhook *AuditWebhook `json:"auditWebhook,omitempty"`
+	// DefaultAuditPolicy enables a built-in audit policy in case no custom audit policy is referenced in auditConfig.
+	// The built-in policy records all requests on Metadata level and additionally records the request bodies of
+	// mutating requests, but redacts the bodies of resources which may contain sensitive data (e.g. secret binding and
+	// credentials binding resources as well as kubeconfig and token subresources). Audit events can be shipped to an
+	// external sink via auditWebhook.
+	// +optional
+	DefaultAuditPolicy *bool `json:"defaultAuditPolicy,omitempty"`
 	// Logging contains configuration for the log level and HTTP access logs.
 	// +optional
 	Logging *gardencorev1beta1.APIServerLogging `json:"logging,omitempty"`
@@ -530,6 +537,23 @@ type GardenerAPIServerConfig struct {
 	// a credential will be issued with a validity duration of this value.
 	// +optional
 	ShootAdminKubeconfigMaxExpiration *metav1.Duration `json:"shootAdminKubeconfigMaxExpiration,omitempty"`
+	// Autoscaling contains auto-scaling configuration options for the gardener-apiserver.
+	// +optional
+	Autoscaling *GardenerAPIServerAutoscaling `json:"autoscaling,omitempty"`
+}
+
+// GardenerAPIServerAutoscaling contains auto-scaling configuration options for the gardener-apiserver.
+type GardenerAPIServerAutoscaling struct {
+	// Replicas is the number of gardener-apiserver replicas. Defaults to 2, or 3 if high availability is enabled for
+	// the virtual cluster control plane.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// MinAllowed configures the minimum allowed resource requests for vertical pod autoscaling.
+	// Configuration of minAllowed resources is an advanced feature that can help clusters to overcome scale-up delays.
+	// Default values are not applied to this field.
+	// +optional
+	MinAllowed corev1.ResourceList `json:"minAllowed,omitempty"`
 }
 
 // GardenerAdmissionControllerConfig contains configuration settings for the gardener-admission-controller.
@@ -592,6 +616,10 @@ type GardenerControllerManagerConfig struct {
 	// +kubebuilder:default=info
 	// +optional
 	LogLevel *string `json:"logLevel,omitempty"`
+	// Resources are the compute resource requirements for the gardener-controller-manager container. Defaults to
+	// requests of 1 CPU and 1Gi memory.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
 }
 
 // ProjectQuotaConfiguration defines quota configurations.
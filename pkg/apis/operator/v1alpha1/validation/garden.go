@@ -42,6 +42,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils/gardener/operator"
 	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
 	featuresvalidation "github.com/gardener/gardener/pkg/utils/validation/features"
+	kubernetescorevalidation "github.com/gardener/gardener/pkg/utils/validation/kubernetes/core"
 	"github.com/gardener/gardener/pkg/utils/validation/kubernetesversion"
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
 	plugin "github.com/gardener/gardener/plugin/pkg"
@@ -526,6 +527,18 @@ func validateGardenerAPIServerConfig(config *operatorv1alpha1.GardenerAPIServerC
 		}
 	}
 
+	if autoscaling := config.Autoscaling; autoscaling != nil {
+		autoscalingPath := fldPath.Child("autoscaling")
+
+		if autoscaling.Replicas != nil && *autoscaling.Replicas < 1 {
+			allErrs = append(allErrs, field.Invalid(autoscalingPath.Child("replicas"), *autoscaling.Replicas, "must be at least 1"))
+		}
+
+		if len(autoscaling.MinAllowed) > 0 {
+			allErrs = append(allErrs, gardencorevalidation.ValidateControlPlaneAutoscaling(&gardencore.ControlPlaneAutoscaling{MinAllowed: autoscaling.MinAllowed}, nil, autoscalingPath)...)
+		}
+	}
+
 	return allErrs
 }
 
@@ -557,6 +570,29 @@ func validateGardenerControllerManagerConfig(config *operatorv1alpha1.GardenerCo
 		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(quota.ProjectSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("defaultProjectQuotas").Index(i).Child("projectSelector"))...)
 	}
 
+	if config.Resources != nil {
+		allErrs = append(allErrs, validateResourceRequirements(*config.Resources, fldPath.Child("resources"))...)
+	}
+
+	return allErrs
+}
+
+func validateResourceRequirements(requirements corev1.ResourceRequirements, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for resourceName, quantity := range requirements.Requests {
+		resourcePath := fldPath.Child("requests", resourceName.String())
+		allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(resourceName.String(), quantity, resourcePath)...)
+
+		if limit, ok := requirements.Limits[resourceName]; ok && quantity.Cmp(limit) > 0 {
+			allErrs = append(allErrs, field.Invalid(resourcePath, quantity.String(), fmt.Sprintf("must be less than or equal to %s limit of %s", resourceName, limit.String())))
+		}
+	}
+
+	for resourceName, quantity := range requirements.Limits {
+		allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(resourceName.String(), quantity, fldPath.Child("limits", resourceName.String()))...)
+	}
+
 	return allErrs
 }
 
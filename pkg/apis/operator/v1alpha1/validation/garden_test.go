@@ -2110,6 +2110,44 @@ var _ = Describe("Validation Tests", func() {
 							}))))
 						})
 					})
+
+					Context("Autoscaling", func() {
+						It("should allow valid autoscaling settings", func() {
+							garden.Spec.VirtualCluster.Gardener.APIServer.Autoscaling = &operatorv1alpha1.GardenerAPIServerAutoscaling{
+								Replicas: ptr.To[int32](4),
+								MinAllowed: corev1.ResourceList{
+									corev1.ResourceCPU:    resource.MustParse("1"),
+									corev1.ResourceMemory: resource.MustParse("1Gi"),
+								},
+							}
+
+							Expect(ValidateGarden(garden, extensions)).To(BeEmpty())
+						})
+
+						It("should forbid less than one replica", func() {
+							garden.Spec.VirtualCluster.Gardener.APIServer.Autoscaling = &operatorv1alpha1.GardenerAPIServerAutoscaling{
+								Replicas: ptr.To[int32](0),
+							}
+
+							Expect(ValidateGarden(garden, extensions)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeInvalid),
+								"Field": Equal("spec.virtualCluster.gardener.gardenerAPIServer.autoscaling.replicas"),
+							}))))
+						})
+
+						It("should forbid unsupported minAllowed resources", func() {
+							garden.Spec.VirtualCluster.Gardener.APIServer.Autoscaling = &operatorv1alpha1.GardenerAPIServerAutoscaling{
+								MinAllowed: corev1.ResourceList{
+									corev1.ResourceStorage: resource.MustParse("1Gi"),
+								},
+							}
+
+							Expect(ValidateGarden(garden, extensions)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeNotSupported),
+								"Field": Equal("spec.virtualCluster.gardener.gardenerAPIServer.autoscaling.minAllowed.storage"),
+							}))))
+						})
+					})
 				})
 
 				Context("AdmissionController", func() {
@@ -2392,6 +2430,50 @@ var _ = Describe("Validation Tests", func() {
 							}))))
 						})
 					})
+
+					Context("Resources", func() {
+						It("should allow valid resource requirements", func() {
+							garden.Spec.VirtualCluster.Gardener.ControllerManager = &operatorv1alpha1.GardenerControllerManagerConfig{
+								Resources: &corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+									Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+								},
+							}
+
+							Expect(ValidateGarden(garden, extensions)).To(BeEmpty())
+						})
+
+						It("should forbid negative resource values", func() {
+							garden.Spec.VirtualCluster.Gardener.ControllerManager = &operatorv1alpha1.GardenerControllerManagerConfig{
+								Resources: &corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("-1")},
+									Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("-1Gi")},
+								},
+							}
+
+							Expect(ValidateGarden(garden, extensions)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeInvalid),
+								"Field": Equal("spec.virtualCluster.gardener.gardenerControllerManager.resources.requests.cpu"),
+							})), PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeInvalid),
+								"Field": Equal("spec.virtualCluster.gardener.gardenerControllerManager.resources.limits.memory"),
+							}))))
+						})
+
+						It("should forbid requests exceeding limits", func() {
+							garden.Spec.VirtualCluster.Gardener.ControllerManager = &operatorv1alpha1.GardenerControllerManagerConfig{
+								Resources: &corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+									Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+								},
+							}
+
+							Expect(ValidateGarden(garden, extensions)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeInvalid),
+								"Field": Equal("spec.virtualCluster.gardener.gardenerControllerManager.resources.requests.memory"),
+							}))))
+						})
+					})
 				})
 
 				Context("Scheduler", func() {
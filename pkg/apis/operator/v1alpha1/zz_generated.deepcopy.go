@@ -997,6 +997,34 @@ func (in *Gardener) DeepCopy() *Gardener {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GardenerAPIServerAutoscaling) DeepCopyInto(out *GardenerAPIServerAutoscaling) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinAllowed != nil {
+		in, out := &in.MinAllowed, &out.MinAllowed
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GardenerAPIServerAutoscaling.
+func (in *GardenerAPIServerAutoscaling) DeepCopy() *GardenerAPIServerAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(GardenerAPIServerAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GardenerAPIServerConfig) DeepCopyInto(out *GardenerAPIServerConfig) {
 	*out = *in
@@ -1018,6 +1046,11 @@ func (in *GardenerAPIServerConfig) DeepCopyInto(out *GardenerAPIServerConfig) {
 		*out = new(AuditWebhook)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DefaultAuditPolicy != nil {
+		in, out := &in.DefaultAuditPolicy, &out.DefaultAuditPolicy
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Logging != nil {
 		in, out := &in.Logging, &out.Logging
 		*out = new(v1beta1.APIServerLogging)
@@ -1048,6 +1081,11 @@ func (in *GardenerAPIServerConfig) DeepCopyInto(out *GardenerAPIServerConfig) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(GardenerAPIServerAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1103,6 +1141,11 @@ func (in *GardenerControllerManagerConfig) DeepCopyInto(out *GardenerControllerM
 		*out = new(string)
 		**out = **in
 	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
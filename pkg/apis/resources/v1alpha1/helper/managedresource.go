@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
@@ -51,3 +52,18 @@ func SplitOrigin(origin string) (string, types.NamespacedName, error) {
 
 	return clusterID, types.NamespacedName{Namespace: parts[0], Name: parts[1]}, nil
 }
+
+// HealthCheckModeFor returns the health check mode the given policies configure for the given group/kind. It returns
+// HealthCheckModeEnforce if no policy matches or the matching policy does not specify a mode.
+func HealthCheckModeFor(policies []resourcesv1alpha1.HealthCheckPolicy, groupKind schema.GroupKind) resourcesv1alpha1.HealthCheckMode {
+	for _, policy := range policies {
+		if policy.GroupKind.Group == groupKind.Group && policy.GroupKind.Kind == groupKind.Kind {
+			if policy.Mode == "" {
+				return resourcesv1alpha1.HealthCheckModeEnforce
+			}
+			return policy.Mode
+		}
+	}
+
+	return resourcesv1alpha1.HealthCheckModeEnforce
+}
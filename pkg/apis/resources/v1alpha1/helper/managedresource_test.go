@@ -8,6 +8,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
@@ -68,3 +69,31 @@ var _ = Describe("Origin", func() {
 		})
 	})
 })
+
+var _ = Describe("HealthCheckPolicies", func() {
+	Describe("#HealthCheckModeFor", func() {
+		var policies []resourcesv1alpha1.HealthCheckPolicy
+
+		BeforeEach(func() {
+			policies = []resourcesv1alpha1.HealthCheckPolicy{
+				{GroupKind: metav1.GroupKind{Group: "apps", Kind: "Deployment"}, Mode: resourcesv1alpha1.HealthCheckModeObserve},
+				{GroupKind: metav1.GroupKind{Group: "monitoring.coreos.com", Kind: "Prometheus"}, Mode: resourcesv1alpha1.HealthCheckModeIgnore},
+				{GroupKind: metav1.GroupKind{Group: "", Kind: "Service"}},
+			}
+		})
+
+		It("should return the mode of the matching policy", func() {
+			Expect(HealthCheckModeFor(policies, schema.GroupKind{Group: "apps", Kind: "Deployment"})).To(Equal(resourcesv1alpha1.HealthCheckModeObserve))
+			Expect(HealthCheckModeFor(policies, schema.GroupKind{Group: "monitoring.coreos.com", Kind: "Prometheus"})).To(Equal(resourcesv1alpha1.HealthCheckModeIgnore))
+		})
+
+		It("should return Enforce if the matching policy does not specify a mode", func() {
+			Expect(HealthCheckModeFor(policies, schema.GroupKind{Group: "", Kind: "Service"})).To(Equal(resourcesv1alpha1.HealthCheckModeEnforce))
+		})
+
+		It("should return Enforce if no policy matches", func() {
+			Expect(HealthCheckModeFor(policies, schema.GroupKind{Group: "apps", Kind: "StatefulSet"})).To(Equal(resourcesv1alpha1.HealthCheckModeEnforce))
+			Expect(HealthCheckModeFor(nil, schema.GroupKind{Group: "apps", Kind: "Deployment"})).To(Equal(resourcesv1alpha1.HealthCheckModeEnforce))
+		})
+	})
+})
@@ -77,6 +77,9 @@ const (
 	ResourceManagerClassGarden = "garden"
 	// ResourceManagerClassShoot is a constant for the 'shoot' class.
 	ResourceManagerClassShoot = "shoot"
+	// ResourceManagerClassControllerManager is a constant for the 'controller-manager' class. Secrets with this class
+	// are handled by the token-requestor of gardener-controller-manager.
+	ResourceManagerClassControllerManager = "controller-manager"
 
 	// ServiceAccountName is the key of an annotation of a secret whose value contains the service account name.
 	ServiceAccountName = "serviceaccount.resources.gardener.cloud/name"
@@ -178,6 +181,11 @@ const (
 	// must be suffixed with NetworkPolicyFromPolicyAnnotationSuffix, and the annotations value must be a list of
 	// container ports (not service ports).
 	NetworkPolicyFromPolicyAnnotationPrefix = "networking.resources.gardener.cloud/from-"
+	// NetworkPolicyFromCIDRGroupAnnotationPrefix is a constant for an annotation key prefix on a Service which contains
+	// the name of a CIDR group defined in the NetworkPolicy controller configuration whose CIDRs shall be allowed as
+	// ingress sources. The annotation key must be suffixed with NetworkPolicyFromPolicyAnnotationSuffix, and the
+	// annotations value must be a list of container ports (not service ports).
+	NetworkPolicyFromCIDRGroupAnnotationPrefix = "networking.resources.gardener.cloud/from-cidr-group-"
 	// NetworkPolicyFromPolicyAnnotationSuffix is a constant for an annotation key suffix on a Service which contains
 	// the label selector alias which is used by pods initiating the communication to this Service. The annotation key
 	// must be prefixed with NetworkPolicyFromPolicyAnnotationPrefix, and the annotations value must be a list of
@@ -195,6 +203,9 @@ const (
 	// it's updateMode field option should not be modified to "InPlaceOrRecreate"
 	// TODO(vitanovs): Remove the VPAInPlaceUpdatesSkip label constant once the VPAInPlaceUpdates feature gates is deprecated.
 	VPAInPlaceUpdatesSkip = "vpa-in-place-updates.resources.gardener.cloud/skip"
+	// PodTopologyInjection is a constant for a label on a Pod which indicates that topology information shall be
+	// injected as environment variables into all its containers.
+	PodTopologyInjection = "pod-topology.resources.gardener.cloud/inject"
 )
 
 // +kubebuilder:resource:shortName="mr"
@@ -257,8 +268,37 @@ type ManagedResourceSpec struct {
 	// resource, should also be deleted when the corresponding StatefulSet is deleted (defaults to false).
 	// +optional
 	DeletePersistentVolumeClaims *bool `json:"deletePersistentVolumeClaims,omitempty"`
+	// HealthCheckPolicies specifies how the health of managed objects of a given group/kind contributes to the
+	// ResourcesHealthy condition. Kinds without an explicit policy are enforced, i.e. unhealthy or missing objects turn
+	// the condition to False.
+	// +optional
+	HealthCheckPolicies []HealthCheckPolicy `json:"healthCheckPolicies,omitempty"`
+}
+
+// HealthCheckPolicy configures how health check results for objects of a given group/kind contribute to the
+// ResourcesHealthy condition of the ManagedResource.
+type HealthCheckPolicy struct {
+	// GroupKind is the group and kind of the objects this policy applies to.
+	GroupKind metav1.GroupKind `json:"groupKind"`
+	// Mode configures how the health of matching objects is handled. Defaults to Enforce.
+	// +optional
+	Mode HealthCheckMode `json:"mode,omitempty"`
 }
 
+// HealthCheckMode describes how health check results for objects of a ManagedResource are handled.
+type HealthCheckMode string
+
+const (
+	// HealthCheckModeEnforce means that unhealthy or missing objects turn the ResourcesHealthy condition to False.
+	// This is the default.
+	HealthCheckModeEnforce HealthCheckMode = "Enforce"
+	// HealthCheckModeObserve means that objects are health checked and failing checks are logged, but neither
+	// unhealthy nor missing objects turn the ResourcesHealthy condition to False.
+	HealthCheckModeObserve HealthCheckMode = "Observe"
+	// HealthCheckModeIgnore means that objects are not health checked at all.
+	HealthCheckModeIgnore HealthCheckMode = "Ignore"
+)
+
 // ManagedResourceStatus is the status of a managed resource.
 type ManagedResourceStatus struct {
 	Conditions []gardencorev1beta1.Condition `json:"conditions,omitempty"`
@@ -16,6 +16,23 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckPolicy) DeepCopyInto(out *HealthCheckPolicy) {
+	*out = *in
+	out.GroupKind = in.GroupKind
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckPolicy.
+func (in *HealthCheckPolicy) DeepCopy() *HealthCheckPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedResource) DeepCopyInto(out *ManagedResource) {
 	*out = *in
@@ -128,6 +145,11 @@ func (in *ManagedResourceSpec) DeepCopyInto(out *ManagedResourceSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.HealthCheckPolicies != nil {
+		in, out := &in.HealthCheckPolicies, &out.HealthCheckPolicies
+		*out = make([]HealthCheckPolicy, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
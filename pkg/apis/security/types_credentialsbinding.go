@@ -7,6 +7,8 @@ package security
 import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
 )
 
 // +genclient
@@ -28,6 +30,14 @@ type CredentialsBinding struct {
 	// Quotas is a list of references to Quota objects in the same or another namespace.
 	// This field is immutable.
 	Quotas []corev1.ObjectReference
+	// Status contains the latest observed status of the CredentialsBinding.
+	Status CredentialsBindingStatus
+}
+
+// CredentialsBindingStatus contains the latest observed status of a CredentialsBinding.
+type CredentialsBindingStatus struct {
+	// Conditions represents the latest available observations of the referenced credentials' validity.
+	Conditions []gardencore.Condition
 }
 
 // CredentialsBindingProvider defines the provider type of the CredentialsBinding.
@@ -10,6 +10,8 @@ package v1alpha1
 import (
 	fmt "fmt"
 
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+
 	io "io"
 
 	proto "github.com/gogo/protobuf/proto"
@@ -147,10 +149,38 @@ func (m *CredentialsBindingProvider) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CredentialsBindingProvider proto.InternalMessageInfo
 
+func (m *CredentialsBindingStatus) Reset()      { *m = CredentialsBindingStatus{} }
+func (*CredentialsBindingStatus) ProtoMessage() {}
+func (*CredentialsBindingStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32adcae6cdc9d73e, []int{4}
+}
+func (m *CredentialsBindingStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CredentialsBindingStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CredentialsBindingStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CredentialsBindingStatus.Merge(m, src)
+}
+func (m *CredentialsBindingStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *CredentialsBindingStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_CredentialsBindingStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CredentialsBindingStatus proto.InternalMessageInfo
+
 func (m *TargetSystem) Reset()      { *m = TargetSystem{} }
 func (*TargetSystem) ProtoMessage() {}
 func (*TargetSystem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32adcae6cdc9d73e, []int{4}
+	return fileDescriptor_32adcae6cdc9d73e, []int{5}
 }
 func (m *TargetSystem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -178,7 +208,7 @@ var xxx_messageInfo_TargetSystem proto.InternalMessageInfo
 func (m *TokenRequest) Reset()      { *m = TokenRequest{} }
 func (*TokenRequest) ProtoMessage() {}
 func (*TokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32adcae6cdc9d73e, []int{5}
+	return fileDescriptor_32adcae6cdc9d73e, []int{6}
 }
 func (m *TokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -206,7 +236,7 @@ var xxx_messageInfo_TokenRequest proto.InternalMessageInfo
 func (m *TokenRequestSpec) Reset()      { *m = TokenRequestSpec{} }
 func (*TokenRequestSpec) ProtoMessage() {}
 func (*TokenRequestSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32adcae6cdc9d73e, []int{6}
+	return fileDescriptor_32adcae6cdc9d73e, []int{7}
 }
 func (m *TokenRequestSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -234,7 +264,7 @@ var xxx_messageInfo_TokenRequestSpec proto.InternalMessageInfo
 func (m *TokenRequestStatus) Reset()      { *m = TokenRequestStatus{} }
 func (*TokenRequestStatus) ProtoMessage() {}
 func (*TokenRequestStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32adcae6cdc9d73e, []int{7}
+	return fileDescriptor_32adcae6cdc9d73e, []int{8}
 }
 func (m *TokenRequestStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -262,7 +292,7 @@ var xxx_messageInfo_TokenRequestStatus proto.InternalMessageInfo
 func (m *WorkloadIdentity) Reset()      { *m = WorkloadIdentity{} }
 func (*WorkloadIdentity) ProtoMessage() {}
 func (*WorkloadIdentity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32adcae6cdc9d73e, []int{8}
+	return fileDescriptor_32adcae6cdc9d73e, []int{9}
 }
 func (m *WorkloadIdentity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -290,7 +320,7 @@ var xxx_messageInfo_WorkloadIdentity proto.InternalMessageInfo
 func (m *WorkloadIdentityList) Reset()      { *m = WorkloadIdentityList{} }
 func (*WorkloadIdentityList) ProtoMessage() {}
 func (*WorkloadIdentityList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32adcae6cdc9d73e, []int{9}
+	return fileDescriptor_32adcae6cdc9d73e, []int{10}
 }
 func (m *WorkloadIdentityList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -318,7 +348,7 @@ var xxx_messageInfo_WorkloadIdentityList proto.InternalMessageInfo
 func (m *WorkloadIdentitySpec) Reset()      { *m = WorkloadIdentitySpec{} }
 func (*WorkloadIdentitySpec) ProtoMessage() {}
 func (*WorkloadIdentitySpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32adcae6cdc9d73e, []int{10}
+	return fileDescriptor_32adcae6cdc9d73e, []int{11}
 }
 func (m *WorkloadIdentitySpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -346,7 +376,7 @@ var xxx_messageInfo_WorkloadIdentitySpec proto.InternalMessageInfo
 func (m *WorkloadIdentityStatus) Reset()      { *m = WorkloadIdentityStatus{} }
 func (*WorkloadIdentityStatus) ProtoMessage() {}
 func (*WorkloadIdentityStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32adcae6cdc9d73e, []int{11}
+	return fileDescriptor_32adcae6cdc9d73e, []int{12}
 }
 func (m *WorkloadIdentityStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -376,6 +406,7 @@ func init() {
 	proto.RegisterType((*CredentialsBinding)(nil), "github.com.gardener.gardener.pkg.apis.security.v1alpha1.CredentialsBinding")
 	proto.RegisterType((*CredentialsBindingList)(nil), "github.com.gardener.gardener.pkg.apis.security.v1alpha1.CredentialsBindingList")
 	proto.RegisterType((*CredentialsBindingProvider)(nil), "github.com.gardener.gardener.pkg.apis.security.v1alpha1.CredentialsBindingProvider")
+	proto.RegisterType((*CredentialsBindingStatus)(nil), "github.com.gardener.gardener.pkg.apis.security.v1alpha1.CredentialsBindingStatus")
 	proto.RegisterType((*TargetSystem)(nil), "github.com.gardener.gardener.pkg.apis.security.v1alpha1.TargetSystem")
 	proto.RegisterType((*TokenRequest)(nil), "github.com.gardener.gardener.pkg.apis.security.v1alpha1.TokenRequest")
 	proto.RegisterType((*TokenRequestSpec)(nil), "github.com.gardener.gardener.pkg.apis.security.v1alpha1.TokenRequestSpec")
@@ -391,69 +422,73 @@ func init() {
 }
 
 var fileDescriptor_32adcae6cdc9d73e = []byte{
-	// 987 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xc6, 0x4e, 0x94, 0x4c, 0xfe, 0x28, 0x99, 0x96, 0xc8, 0x0a, 0xc2, 0x0e, 0xdb, 0x0b,
-	0xa2, 0xea, 0xba, 0x89, 0x10, 0xe1, 0x84, 0xd4, 0x75, 0x03, 0xb2, 0x42, 0xdb, 0x30, 0x4e, 0x41,
-	0x42, 0x20, 0x31, 0xde, 0x7d, 0x71, 0x06, 0x67, 0x77, 0xb6, 0x3b, 0xb3, 0x69, 0x7c, 0x2a, 0x7c,
-	0x03, 0xee, 0x7c, 0x05, 0x8e, 0x7c, 0x04, 0x0e, 0x39, 0x56, 0x9c, 0x72, 0xb2, 0x88, 0xe1, 0xc0,
-	0x47, 0x40, 0x9c, 0xd0, 0x8c, 0x67, 0xb3, 0x6b, 0x3b, 0x6e, 0x23, 0x2b, 0xca, 0xc9, 0x3b, 0xf3,
-	0xde, 0xfb, 0xbd, 0x7f, 0xbf, 0xb7, 0x6f, 0x8d, 0x3e, 0x6f, 0x33, 0x79, 0x94, 0xb4, 0x1c, 0x8f,
-	0x07, 0xb5, 0x36, 0x8d, 0x7d, 0x08, 0x21, 0xce, 0x1e, 0xa2, 0x4e, 0xbb, 0x46, 0x23, 0x26, 0x6a,
-	0x02, 0xbc, 0x24, 0x66, 0xb2, 0x5b, 0x3b, 0xd9, 0xa2, 0xc7, 0xd1, 0x11, 0xdd, 0xaa, 0xb5, 0x95,
-	0x02, 0x95, 0xe0, 0x3b, 0x51, 0xcc, 0x25, 0xc7, 0x3b, 0x19, 0x90, 0x93, 0xda, 0x67, 0x0f, 0x51,
-	0xa7, 0xed, 0x28, 0x20, 0x27, 0x05, 0x72, 0x52, 0xa0, 0x8d, 0x07, 0xf9, 0x08, 0x78, 0x9b, 0xd7,
-	0x34, 0x5e, 0x2b, 0x39, 0xd4, 0x27, 0x7d, 0xd0, 0x4f, 0x03, 0x3f, 0x1b, 0x76, 0xe7, 0x13, 0xe1,
-	0x30, 0xae, 0xc2, 0xaa, 0x79, 0x3c, 0x86, 0xda, 0xc9, 0x58, 0x2c, 0x1b, 0x1f, 0x65, 0x3a, 0x01,
-	0xf5, 0x8e, 0x58, 0x08, 0x71, 0x37, 0xcb, 0x25, 0x00, 0x49, 0xaf, 0xb2, 0xaa, 0x4d, 0xb2, 0x8a,
-	0x93, 0x50, 0xb2, 0x00, 0xc6, 0x0c, 0x3e, 0x7e, 0x9b, 0x81, 0xf0, 0x8e, 0x20, 0xa0, 0xa3, 0x76,
-	0xf6, 0xbf, 0x16, 0x5a, 0xae, 0xf3, 0x50, 0xc2, 0xa9, 0x7c, 0xd6, 0xfa, 0x01, 0x3c, 0x89, 0x37,
-	0x51, 0xa9, 0xc3, 0x42, 0xbf, 0x6c, 0x6d, 0x5a, 0x1f, 0x2c, 0xb8, 0x4b, 0x67, 0xbd, 0x6a, 0xa1,
-	0xdf, 0xab, 0x96, 0xf6, 0x58, 0xe8, 0x13, 0x2d, 0xc1, 0xdb, 0x08, 0xd1, 0x88, 0x7d, 0x05, 0xb1,
-	0x60, 0x3c, 0x2c, 0xcf, 0x68, 0x3d, 0x6c, 0xf4, 0xd0, 0xa3, 0xfd, 0x86, 0x91, 0x90, 0x9c, 0x96,
-	0x42, 0x0d, 0x69, 0x00, 0xe5, 0xe2, 0x30, 0xea, 0x53, 0x1a, 0x00, 0xd1, 0x12, 0x7c, 0x1f, 0x2d,
-	0xa8, 0x5f, 0x11, 0x51, 0x0f, 0xca, 0x25, 0xad, 0xb6, 0xdc, 0xef, 0x55, 0x17, 0x9e, 0xa6, 0x97,
-	0x24, 0x93, 0x63, 0x17, 0x15, 0x13, 0xe6, 0x97, 0x67, 0xb5, 0xda, 0x43, 0x83, 0x56, 0x7c, 0xde,
-	0x78, 0xfc, 0x5f, 0xaf, 0xfa, 0xfe, 0xa4, 0x52, 0xc8, 0x6e, 0x04, 0xc2, 0x79, 0xde, 0x78, 0x4c,
-	0x94, 0xb1, 0xfd, 0x6b, 0x11, 0xe1, 0x7a, 0x0c, 0x3e, 0x84, 0x92, 0xd1, 0x63, 0xe1, 0xb2, 0xd0,
-	0x67, 0x61, 0x1b, 0x7f, 0x8f, 0xe6, 0x55, 0x57, 0x7c, 0x2a, 0xa9, 0xae, 0xc1, 0xe2, 0xf6, 0x43,
-	0x67, 0x80, 0xe8, 0xe4, 0x11, 0x33, 0x1a, 0x29, 0x6d, 0xe7, 0x64, 0xcb, 0x19, 0xd4, 0xef, 0x09,
-	0x48, 0x9a, 0x55, 0x23, 0xbb, 0x23, 0x97, 0xa8, 0xf8, 0x27, 0x0b, 0xcd, 0x47, 0x31, 0x3f, 0x61,
-	0x3e, 0xc4, 0xba, 0x7c, 0x8b, 0xdb, 0x4d, 0x67, 0x4a, 0xca, 0x3a, 0xe3, 0x19, 0xec, 0x1b, 0x68,
-	0x77, 0xd5, 0x44, 0x31, 0x9f, 0xde, 0x90, 0x4b, 0xb7, 0xd8, 0x43, 0x2b, 0x5e, 0x66, 0x49, 0xe0,
-	0x50, 0x77, 0x66, 0x71, 0xfb, 0x5e, 0x2e, 0x57, 0x47, 0x71, 0x3a, 0xcb, 0x8c, 0xc0, 0x21, 0xc4,
-	0x10, 0x7a, 0xe0, 0xae, 0x1b, 0xe0, 0x95, 0xfa, 0x10, 0x04, 0x19, 0x81, 0xc4, 0x7b, 0x68, 0xee,
-	0x45, 0xc2, 0x25, 0x15, 0xe5, 0xd2, 0x66, 0xf1, 0xba, 0xe0, 0x2b, 0x06, 0x7c, 0xee, 0x4b, 0x6d,
-	0x4a, 0x0c, 0x84, 0xfd, 0x8f, 0x85, 0xd6, 0xc7, 0x93, 0xfd, 0x82, 0x09, 0x89, 0xbf, 0x1d, 0x6b,
-	0x99, 0x73, 0xbd, 0x96, 0x29, 0x6b, 0xdd, 0xb0, 0xcb, 0x52, 0xa5, 0x37, 0xb9, 0x76, 0x45, 0x68,
-	0x96, 0x49, 0x08, 0x44, 0x79, 0x46, 0x27, 0xb1, 0x77, 0x83, 0xad, 0x72, 0x97, 0x8d, 0xdf, 0xd9,
-	0x86, 0xf2, 0x40, 0x06, 0x8e, 0xec, 0x4f, 0xd1, 0xc6, 0xe4, 0xb6, 0xaa, 0x51, 0x52, 0x4c, 0x1e,
-	0x1d, 0xd0, 0x83, 0x6e, 0x04, 0x44, 0x4b, 0xec, 0x5f, 0x2c, 0xb4, 0x74, 0x40, 0xe3, 0x36, 0xc8,
-	0x66, 0x57, 0x48, 0x08, 0xde, 0x6e, 0x82, 0x19, 0x5a, 0x49, 0xb9, 0x51, 0xe7, 0xe1, 0x21, 0x6b,
-	0x1b, 0x62, 0x3e, 0x98, 0x58, 0x48, 0xf3, 0x62, 0x71, 0x08, 0x7d, 0xb9, 0x7b, 0x2a, 0x21, 0x54,
-	0x63, 0xee, 0x62, 0xc5, 0x8a, 0xfd, 0x21, 0x20, 0x32, 0x02, 0x6c, 0xff, 0x31, 0x83, 0x96, 0x0e,
-	0x78, 0x07, 0x42, 0x02, 0x2f, 0x12, 0x10, 0xf2, 0x16, 0x26, 0xae, 0x83, 0x4a, 0x22, 0x02, 0xcf,
-	0xe4, 0xd4, 0x98, 0xba, 0x83, 0xf9, 0xb0, 0x9b, 0x11, 0x78, 0x59, 0x29, 0xd5, 0x89, 0x68, 0x27,
-	0x58, 0xa0, 0x39, 0x21, 0xa9, 0x4c, 0x84, 0x19, 0xa9, 0xbd, 0x9b, 0x71, 0xa7, 0x21, 0xb3, 0xe9,
-	0x18, 0x9c, 0x89, 0x71, 0x65, 0x9f, 0x5b, 0x68, 0x75, 0x34, 0x3a, 0xfc, 0x0a, 0x2d, 0x7b, 0xf9,
-	0x77, 0xbb, 0xa9, 0xee, 0x67, 0xd3, 0x33, 0x38, 0x8f, 0xe6, 0xae, 0xf5, 0x7b, 0xd5, 0xe1, 0xe5,
-	0x41, 0x86, 0xfd, 0xe1, 0x3a, 0x5a, 0x83, 0xd3, 0x88, 0xc5, 0x54, 0x32, 0x1e, 0x36, 0xc1, 0xe3,
-	0xa1, 0x2f, 0x74, 0x13, 0x8a, 0xee, 0x3b, 0xfd, 0x5e, 0x75, 0x6d, 0x77, 0x54, 0x48, 0xc6, 0xf5,
-	0xed, 0xdf, 0x2c, 0x84, 0xc7, 0x2b, 0x81, 0xef, 0xa1, 0x59, 0xa9, 0x6e, 0x0d, 0xa9, 0x2f, 0x27,
-	0x69, 0xa0, 0x3a, 0x90, 0xe1, 0x2e, 0xba, 0x93, 0x01, 0x1e, 0xb0, 0x00, 0x84, 0xa4, 0x41, 0x64,
-	0x78, 0xf0, 0xe1, 0xf5, 0x58, 0xa6, 0xcc, 0xdc, 0x77, 0x0d, 0xfc, 0x9d, 0xdd, 0x71, 0x38, 0x72,
-	0x95, 0x0f, 0xbb, 0x37, 0x83, 0x56, 0xbf, 0xe6, 0x71, 0xe7, 0x98, 0x53, 0xbf, 0xa1, 0x67, 0x59,
-	0x76, 0x6f, 0x81, 0xea, 0x7c, 0x88, 0xea, 0x4f, 0xa6, 0x6e, 0xf5, 0x68, 0xe8, 0x13, 0xe9, 0xfe,
-	0x72, 0x84, 0xee, 0xcf, 0x6e, 0xce, 0xe5, 0x9b, 0x29, 0xff, 0xb7, 0x85, 0xee, 0x8e, 0x9a, 0xdc,
-	0xc2, 0x3a, 0x08, 0x87, 0xd7, 0x41, 0xe3, 0xc6, 0xd2, 0x9d, 0xb0, 0x0c, 0x7e, 0xbf, 0x22, 0x4d,
-	0x3d, 0xdd, 0xf7, 0xd1, 0x02, 0x4d, 0x7c, 0xa6, 0x96, 0xa6, 0x28, 0x5b, 0x9b, 0xc5, 0xf4, 0x83,
-	0xe9, 0x51, 0x7a, 0x49, 0x32, 0x39, 0x7e, 0x85, 0x96, 0x64, 0x6e, 0x23, 0x18, 0x7a, 0xec, 0x4e,
-	0xff, 0x6a, 0xca, 0x81, 0xb9, 0x77, 0x4d, 0xe0, 0x43, 0x4b, 0x87, 0x0c, 0x39, 0xb4, 0x77, 0xd0,
-	0xfa, 0xd5, 0xfd, 0xc5, 0xef, 0xa1, 0xa2, 0x48, 0x5a, 0x66, 0x8c, 0x17, 0xd3, 0x6f, 0xb9, 0x66,
-	0xd2, 0x22, 0xea, 0xde, 0xfd, 0xee, 0xec, 0xa2, 0x52, 0x78, 0x7d, 0x51, 0x29, 0x9c, 0x5f, 0x54,
-	0x0a, 0x3f, 0xf6, 0x2b, 0xd6, 0x59, 0xbf, 0x62, 0xbd, 0xee, 0x57, 0xac, 0xf3, 0x7e, 0xc5, 0xfa,
-	0xb3, 0x5f, 0xb1, 0x7e, 0xfe, 0xab, 0x52, 0xf8, 0x66, 0x67, 0xca, 0xff, 0x0e, 0xff, 0x07, 0x00,
-	0x00, 0xff, 0xff, 0x5e, 0x79, 0x81, 0x2e, 0x75, 0x0c, 0x00, 0x00,
+	// 1049 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xdd, 0x6e, 0x1b, 0xc5,
+	0x17, 0xcf, 0xc6, 0x4e, 0x94, 0x4c, 0x3e, 0x94, 0x4c, 0xfb, 0x8f, 0xac, 0xfc, 0x85, 0x1d, 0xb6,
+	0x37, 0x88, 0xaa, 0xeb, 0xc6, 0x42, 0x84, 0x1b, 0x90, 0xba, 0x6e, 0x40, 0x56, 0x68, 0x9b, 0x8e,
+	0x53, 0x90, 0x10, 0x48, 0x8c, 0x77, 0x4f, 0x9c, 0xc1, 0xd9, 0x8f, 0xec, 0xcc, 0xa6, 0xf1, 0x55,
+	0xe1, 0x01, 0x90, 0xb8, 0xe7, 0x01, 0x78, 0x01, 0x1e, 0x81, 0x8b, 0x5c, 0x56, 0x5c, 0xe5, 0xca,
+	0x22, 0x86, 0x0b, 0x1e, 0x01, 0x71, 0x85, 0x66, 0x3c, 0xeb, 0x5d, 0xdb, 0x71, 0x6b, 0x59, 0x56,
+	0xae, 0xbc, 0x7b, 0xe6, 0x9c, 0xdf, 0xf9, 0xfa, 0x9d, 0x39, 0x6b, 0xf4, 0x59, 0x93, 0x89, 0x93,
+	0xb8, 0x61, 0x39, 0x81, 0x57, 0x6e, 0xd2, 0xc8, 0x05, 0x1f, 0xa2, 0xf4, 0x21, 0x6c, 0x35, 0xcb,
+	0x34, 0x64, 0xbc, 0xcc, 0xc1, 0x89, 0x23, 0x26, 0xda, 0xe5, 0xf3, 0x5d, 0x7a, 0x1a, 0x9e, 0xd0,
+	0xdd, 0x72, 0x53, 0x2a, 0x50, 0x01, 0xae, 0x15, 0x46, 0x81, 0x08, 0xf0, 0x5e, 0x0a, 0x64, 0x25,
+	0xf6, 0xe9, 0x43, 0xd8, 0x6a, 0x5a, 0x12, 0xc8, 0x4a, 0x80, 0xac, 0x04, 0x68, 0xdb, 0x9e, 0x2c,
+	0x02, 0x27, 0x88, 0xa0, 0x7c, 0xbe, 0xdb, 0x00, 0x31, 0xea, 0x7c, 0xfb, 0x41, 0x16, 0x23, 0x68,
+	0x06, 0x65, 0x25, 0x6e, 0xc4, 0xc7, 0xea, 0x4d, 0xbd, 0xa8, 0x27, 0xad, 0x6e, 0xb6, 0x3e, 0xe2,
+	0x16, 0x0b, 0x24, 0x70, 0x82, 0x3b, 0x02, 0xf9, 0x41, 0xaa, 0xe3, 0x51, 0xe7, 0x84, 0xf9, 0x10,
+	0xb5, 0xd3, 0x68, 0x3c, 0x10, 0xf4, 0x26, 0xab, 0xf2, 0x38, 0xab, 0x28, 0xf6, 0x05, 0xf3, 0x60,
+	0xc4, 0xe0, 0xc3, 0xb7, 0x19, 0x70, 0xe7, 0x04, 0x3c, 0x3a, 0x6c, 0x67, 0xfe, 0x63, 0xa0, 0xb5,
+	0x6a, 0xe0, 0x0b, 0xb8, 0x10, 0xcf, 0x1a, 0xdf, 0x81, 0x23, 0xf0, 0x0e, 0xca, 0xb7, 0x98, 0xef,
+	0x16, 0x8c, 0x1d, 0xe3, 0xbd, 0x65, 0x7b, 0xf5, 0xb2, 0x53, 0x9a, 0xeb, 0x76, 0x4a, 0xf9, 0x03,
+	0xe6, 0xbb, 0x44, 0x9d, 0xe0, 0x0a, 0x42, 0x34, 0x64, 0x5f, 0x40, 0xc4, 0x59, 0xe0, 0x17, 0xe6,
+	0x95, 0x1e, 0xd6, 0x7a, 0xe8, 0xd1, 0x61, 0x4d, 0x9f, 0x90, 0x8c, 0x96, 0x44, 0xf5, 0xa9, 0x07,
+	0x85, 0xdc, 0x20, 0xea, 0x53, 0xea, 0x01, 0x51, 0x27, 0xf8, 0x3e, 0x5a, 0x96, 0xbf, 0x3c, 0xa4,
+	0x0e, 0x14, 0xf2, 0x4a, 0x6d, 0xad, 0xdb, 0x29, 0x2d, 0x3f, 0x4d, 0x84, 0x24, 0x3d, 0xc7, 0x36,
+	0xca, 0xc5, 0xcc, 0x2d, 0x2c, 0x28, 0xb5, 0x87, 0x1a, 0x2d, 0xf7, 0xa2, 0xf6, 0xf8, 0xdf, 0x4e,
+	0xe9, 0xdd, 0x71, 0xa5, 0x10, 0xed, 0x10, 0xb8, 0xf5, 0xa2, 0xf6, 0x98, 0x48, 0x63, 0xf3, 0x97,
+	0x3c, 0xc2, 0xd5, 0x08, 0x5c, 0xf0, 0x05, 0xa3, 0xa7, 0xdc, 0x66, 0xbe, 0xcb, 0xfc, 0x26, 0xfe,
+	0x16, 0x2d, 0xc9, 0xae, 0xb8, 0x54, 0x50, 0x55, 0x83, 0x95, 0xca, 0x43, 0xab, 0x87, 0x68, 0x65,
+	0x11, 0x53, 0x2a, 0x4a, 0x6d, 0xeb, 0x7c, 0xd7, 0xea, 0xd5, 0xef, 0x09, 0x08, 0x9a, 0x56, 0x23,
+	0x95, 0x91, 0x3e, 0x2a, 0xfe, 0xc1, 0x40, 0x4b, 0x61, 0x14, 0x9c, 0x33, 0x17, 0x22, 0x55, 0xbe,
+	0x95, 0x4a, 0xdd, 0x9a, 0x92, 0xf6, 0xd6, 0x68, 0x06, 0x87, 0x1a, 0xda, 0xde, 0xd0, 0x51, 0x2c,
+	0x25, 0x12, 0xd2, 0x77, 0x8b, 0x1d, 0xb4, 0xee, 0xa4, 0x96, 0x04, 0x8e, 0x55, 0x67, 0x56, 0x2a,
+	0xf7, 0x32, 0xb9, 0x5a, 0x92, 0xd3, 0x69, 0x66, 0x04, 0x8e, 0x21, 0x02, 0xdf, 0x01, 0x7b, 0x4b,
+	0x03, 0xaf, 0x57, 0x07, 0x20, 0xc8, 0x10, 0x24, 0x3e, 0x40, 0x8b, 0x67, 0x71, 0x20, 0x28, 0x2f,
+	0xe4, 0x77, 0x72, 0x93, 0x82, 0xaf, 0x6b, 0xf0, 0xc5, 0xe7, 0xca, 0x94, 0x68, 0x08, 0xdc, 0x46,
+	0x8b, 0x5c, 0x50, 0x11, 0x73, 0xd5, 0xf5, 0x95, 0xca, 0xf3, 0x19, 0x96, 0xac, 0xae, 0x80, 0x53,
+	0xd7, 0xbd, 0x77, 0xa2, 0x1d, 0x9a, 0x7f, 0x1b, 0x68, 0x6b, 0xd4, 0xe8, 0x73, 0xc6, 0x05, 0xfe,
+	0x7a, 0x84, 0x2d, 0xd6, 0x64, 0x6c, 0x91, 0xd6, 0x8a, 0x2b, 0xfd, 0x2e, 0x25, 0x92, 0x0c, 0x53,
+	0x42, 0xb4, 0xc0, 0x04, 0x78, 0xbc, 0x30, 0xaf, 0xea, 0x77, 0x30, 0xc3, 0x94, 0xed, 0x35, 0xed,
+	0x77, 0xa1, 0x26, 0x3d, 0x90, 0x9e, 0x23, 0xf3, 0x13, 0xb4, 0x3d, 0x9e, 0x51, 0x72, 0x8a, 0xe5,
+	0x10, 0x0d, 0xdf, 0x0d, 0x47, 0xed, 0x10, 0x88, 0x3a, 0x31, 0x7f, 0x34, 0x50, 0x61, 0x5c, 0x7d,
+	0xf1, 0x19, 0x42, 0x4e, 0xe0, 0xbb, 0x4c, 0xb0, 0xc0, 0xe7, 0x05, 0x43, 0xe5, 0xf4, 0xf1, 0x84,
+	0x39, 0x69, 0xba, 0xa8, 0x7b, 0xdb, 0xaa, 0x26, 0x28, 0xe9, 0xa4, 0xf5, 0x45, 0x9c, 0x64, 0x9c,
+	0x98, 0x3f, 0x1b, 0x68, 0xf5, 0x88, 0x46, 0x4d, 0x10, 0xf5, 0x36, 0x17, 0xe0, 0xbd, 0x3d, 0x05,
+	0xcc, 0xd0, 0x7a, 0x32, 0x26, 0xd5, 0xc0, 0x3f, 0x66, 0x4d, 0x3d, 0xa3, 0x0f, 0xc6, 0x36, 0x56,
+	0xdf, 0xb1, 0x16, 0xa1, 0x2f, 0xf7, 0x2f, 0x04, 0xf8, 0x5c, 0x45, 0x26, 0x07, 0xe4, 0x70, 0x00,
+	0x88, 0x0c, 0x01, 0x9b, 0xbf, 0xcf, 0xa3, 0xd5, 0xa3, 0xa0, 0x05, 0x3e, 0x81, 0xb3, 0x18, 0xb8,
+	0xb8, 0x85, 0xcb, 0xa7, 0x85, 0xf2, 0x3c, 0x04, 0x47, 0xe7, 0x54, 0x9b, 0x9a, 0x51, 0xd9, 0xb0,
+	0xeb, 0x21, 0x38, 0x69, 0x29, 0xe5, 0x1b, 0x51, 0x4e, 0x30, 0xef, 0xcf, 0x6c, 0xef, 0x76, 0x39,
+	0x98, 0x8d, 0xbb, 0x37, 0x4f, 0xeb, 0x95, 0x81, 0x36, 0x86, 0xa3, 0xc3, 0xaf, 0xd0, 0x9a, 0x93,
+	0x5d, 0x73, 0xba, 0xba, 0x9f, 0x4e, 0x3f, 0x51, 0x59, 0x34, 0x7b, 0xb3, 0xdb, 0x29, 0x0d, 0xee,
+	0x51, 0x32, 0xe8, 0x0f, 0x57, 0xd1, 0x26, 0x5c, 0x84, 0x2c, 0xa2, 0x92, 0x97, 0x75, 0x90, 0x14,
+	0xe5, 0xaa, 0x09, 0x39, 0xfb, 0x7f, 0xdd, 0x4e, 0x69, 0x73, 0x7f, 0xf8, 0x90, 0x8c, 0xea, 0x9b,
+	0xbf, 0x1a, 0x08, 0x8f, 0x56, 0x02, 0xdf, 0x43, 0x0b, 0x42, 0x4a, 0x35, 0xa9, 0xfb, 0x93, 0xdd,
+	0x53, 0xed, 0x9d, 0xe1, 0x36, 0xba, 0x93, 0x02, 0x1e, 0x31, 0x0f, 0xb8, 0xa0, 0x5e, 0xa8, 0x79,
+	0xf0, 0xfe, 0x64, 0x2c, 0x93, 0x66, 0xf6, 0xff, 0x35, 0xfc, 0x9d, 0xfd, 0x51, 0x38, 0x72, 0x93,
+	0x0f, 0xb3, 0x33, 0x8f, 0x36, 0xbe, 0x0c, 0xa2, 0xd6, 0x69, 0x40, 0xdd, 0x9a, 0xba, 0x1a, 0x44,
+	0xfb, 0x16, 0xa8, 0x1e, 0x0c, 0x50, 0xfd, 0xc9, 0xd4, 0xad, 0x1e, 0x0e, 0x7d, 0x2c, 0xdd, 0x5f,
+	0x0e, 0xd1, 0xfd, 0xd9, 0xec, 0x5c, 0xbe, 0x99, 0xf2, 0x7f, 0x19, 0xe8, 0xee, 0xb0, 0xc9, 0x2d,
+	0xac, 0x27, 0x7f, 0x70, 0x3d, 0xd5, 0x66, 0x96, 0xee, 0x98, 0xe5, 0xf4, 0xdb, 0x0d, 0x69, 0xaa,
+	0xe9, 0xbe, 0x8f, 0x96, 0x69, 0xec, 0x32, 0xf9, 0xfd, 0xd0, 0xdb, 0x2b, 0xfa, 0xdb, 0xf1, 0x51,
+	0x22, 0x24, 0xe9, 0x39, 0x7e, 0x85, 0x56, 0x45, 0x66, 0x23, 0x68, 0x7a, 0xec, 0x4f, 0x7f, 0x35,
+	0x65, 0xc0, 0xec, 0xbb, 0x3a, 0xf0, 0x81, 0xa5, 0x43, 0x06, 0x1c, 0x9a, 0x7b, 0x68, 0xeb, 0xe6,
+	0xfe, 0xe2, 0x77, 0x50, 0x8e, 0xc7, 0x0d, 0x3d, 0xc6, 0x2b, 0xc9, 0x67, 0x6d, 0x3d, 0x6e, 0x10,
+	0x29, 0xb7, 0xbf, 0xb9, 0xbc, 0x2e, 0xce, 0xbd, 0xbe, 0x2e, 0xce, 0x5d, 0x5d, 0x17, 0xe7, 0xbe,
+	0xef, 0x16, 0x8d, 0xcb, 0x6e, 0xd1, 0x78, 0xdd, 0x2d, 0x1a, 0x57, 0xdd, 0xa2, 0xf1, 0x47, 0xb7,
+	0x68, 0xfc, 0xf4, 0x67, 0x71, 0xee, 0xab, 0xbd, 0x29, 0xff, 0x8a, 0xfd, 0x17, 0x00, 0x00, 0xff,
+	0xff, 0x9b, 0x2a, 0x07, 0x28, 0xc4, 0x0d, 0x00, 0x00,
 }
 
 func (m *ContextObject) Marshal() (dAtA []byte, err error) {
@@ -526,6 +561,16 @@ func (m *CredentialsBinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if len(m.Quotas) > 0 {
 		for iNdEx := len(m.Quotas) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -648,6 +693,43 @@ func (m *CredentialsBindingProvider) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *CredentialsBindingStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CredentialsBindingStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CredentialsBindingStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TargetSystem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1039,6 +1121,8 @@ func (m *CredentialsBinding) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -1070,6 +1154,21 @@ func (m *CredentialsBindingProvider) Size() (n int) {
 	return n
 }
 
+func (m *CredentialsBindingStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *TargetSystem) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1223,6 +1322,7 @@ func (this *CredentialsBinding) String() string {
 		`Provider:` + strings.Replace(strings.Replace(this.Provider.String(), "CredentialsBindingProvider", "CredentialsBindingProvider", 1), `&`, ``, 1) + `,`,
 		`CredentialsRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CredentialsRef), "ObjectReference", "v11.ObjectReference", 1), `&`, ``, 1) + `,`,
 		`Quotas:` + repeatedStringForQuotas + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "CredentialsBindingStatus", "CredentialsBindingStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1253,6 +1353,21 @@ func (this *CredentialsBindingProvider) String() string {
 	}, "")
 	return s
 }
+func (this *CredentialsBindingStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForConditions := "[]Condition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForConditions += "}"
+	s := strings.Join([]string{`&CredentialsBindingStatus{`,
+		`Conditions:` + repeatedStringForConditions + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *TargetSystem) String() string {
 	if this == nil {
 		return "nil"
@@ -1728,6 +1843,39 @@ func (m *CredentialsBinding) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -1948,6 +2096,90 @@ func (m *CredentialsBindingProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CredentialsBindingStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CredentialsBindingStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CredentialsBindingStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, v1beta1.Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TargetSystem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7,6 +7,8 @@ package v1alpha1
 import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 )
 
 // +genclient
@@ -30,8 +32,25 @@ type CredentialsBinding struct {
 	// This field is immutable.
 	// +optional
 	Quotas []corev1.ObjectReference `json:"quotas,omitempty" protobuf:"bytes,4,rep,name=quotas"`
+	// Status contains the latest observed status of the CredentialsBinding.
+	// +optional
+	Status CredentialsBindingStatus `json:"status,omitempty" protobuf:"bytes,5,opt,name=status"`
 }
 
+// CredentialsBindingStatus contains the latest observed status of a CredentialsBinding.
+type CredentialsBindingStatus struct {
+	// Conditions represents the latest available observations of the referenced credentials' validity.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +optional
+	Conditions []gardencorev1beta1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
+}
+
+const (
+	// CredentialsBindingCredentialsValid is a constant for a condition type indicating that the referenced credentials are valid.
+	CredentialsBindingCredentialsValid gardencorev1beta1.ConditionType = "CredentialsValid"
+)
+
 // CredentialsBindingProvider defines the provider type of the CredentialsBinding.
 type CredentialsBindingProvider struct {
 	// Type is the type of the provider.
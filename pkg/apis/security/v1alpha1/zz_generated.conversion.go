@@ -12,6 +12,8 @@ package v1alpha1
 import (
 	unsafe "unsafe"
 
+	core "github.com/gardener/gardener/pkg/apis/core"
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	security "github.com/gardener/gardener/pkg/apis/security"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -67,6 +69,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CredentialsBindingStatus)(nil), (*security.CredentialsBindingStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CredentialsBindingStatus_To_security_CredentialsBindingStatus(a.(*CredentialsBindingStatus), b.(*security.CredentialsBindingStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*security.CredentialsBindingStatus)(nil), (*CredentialsBindingStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_security_CredentialsBindingStatus_To_v1alpha1_CredentialsBindingStatus(a.(*security.CredentialsBindingStatus), b.(*CredentialsBindingStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*TokenRequest)(nil), (*security.TokenRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_TokenRequest_To_security_TokenRequest(a.(*TokenRequest), b.(*security.TokenRequest), scope)
 	}); err != nil {
@@ -185,6 +197,9 @@ func autoConvert_v1alpha1_CredentialsBinding_To_security_CredentialsBinding(in *
 	}
 	out.CredentialsRef = in.CredentialsRef
 	out.Quotas = *(*[]v1.ObjectReference)(unsafe.Pointer(&in.Quotas))
+	if err := Convert_v1alpha1_CredentialsBindingStatus_To_security_CredentialsBindingStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -200,6 +215,9 @@ func autoConvert_security_CredentialsBinding_To_v1alpha1_CredentialsBinding(in *
 	}
 	out.CredentialsRef = in.CredentialsRef
 	out.Quotas = *(*[]v1.ObjectReference)(unsafe.Pointer(&in.Quotas))
+	if err := Convert_security_CredentialsBindingStatus_To_v1alpha1_CredentialsBindingStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -250,6 +268,26 @@ func Convert_security_CredentialsBindingProvider_To_v1alpha1_CredentialsBindingP
 	return autoConvert_security_CredentialsBindingProvider_To_v1alpha1_CredentialsBindingProvider(in, out, s)
 }
 
+func autoConvert_v1alpha1_CredentialsBindingStatus_To_security_CredentialsBindingStatus(in *CredentialsBindingStatus, out *security.CredentialsBindingStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1alpha1_CredentialsBindingStatus_To_security_CredentialsBindingStatus is an autogenerated conversion function.
+func Convert_v1alpha1_CredentialsBindingStatus_To_security_CredentialsBindingStatus(in *CredentialsBindingStatus, out *security.CredentialsBindingStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CredentialsBindingStatus_To_security_CredentialsBindingStatus(in, out, s)
+}
+
+func autoConvert_security_CredentialsBindingStatus_To_v1alpha1_CredentialsBindingStatus(in *security.CredentialsBindingStatus, out *CredentialsBindingStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]v1beta1.Condition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_security_CredentialsBindingStatus_To_v1alpha1_CredentialsBindingStatus is an autogenerated conversion function.
+func Convert_security_CredentialsBindingStatus_To_v1alpha1_CredentialsBindingStatus(in *security.CredentialsBindingStatus, out *CredentialsBindingStatus, s conversion.Scope) error {
+	return autoConvert_security_CredentialsBindingStatus_To_v1alpha1_CredentialsBindingStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_TargetSystem_To_security_TargetSystem(in *TargetSystem, out *security.TargetSystem, s conversion.Scope) error {
 	out.Type = in.Type
 	// WARNING: in.ProviderConfig requires manual conversion: inconvertible types (*k8s.io/apimachinery/pkg/runtime.RawExtension vs k8s.io/apimachinery/pkg/runtime.Object)
@@ -10,6 +10,7 @@
 package security
 
 import (
+	core "github.com/gardener/gardener/pkg/apis/core"
 	v1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -47,6 +48,7 @@ func (in *CredentialsBinding) DeepCopyInto(out *CredentialsBinding) {
 		*out = make([]v1.ObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -117,6 +119,29 @@ func (in *CredentialsBindingProvider) DeepCopy() *CredentialsBindingProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialsBindingStatus) DeepCopyInto(out *CredentialsBindingStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]core.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialsBindingStatus.
+func (in *CredentialsBindingStatus) DeepCopy() *CredentialsBindingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialsBindingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetSystem) DeepCopyInto(out *TargetSystem) {
 	*out = *in
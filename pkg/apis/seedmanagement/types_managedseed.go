@@ -79,6 +79,10 @@ type GardenletConfig struct {
 	// MergeWithParent specifies whether the GardenletConfiguration of the parent gardenlet
 	// should be merged with the specified GardenletConfiguration. Defaults to true. This field is immutable.
 	MergeWithParent *bool
+	// UpgradeChannel is the name of the upgrade channel from which the gardenlet version for this seed is resolved.
+	// One of Stable, Fast. If specified, the gardenlet image tag is managed by the managedseed controller according
+	// to the channel declarations published in the garden cluster and must not be set in the deployment section.
+	UpgradeChannel *UpgradeChannelName
 }
 
 // GardenletDeployment specifies certain gardenlet deployment parameters, such as the number of replicas,
@@ -130,6 +134,16 @@ const (
 	BootstrapNone Bootstrap = "None"
 )
 
+// UpgradeChannelName is the name of an upgrade channel for gardenlet versions.
+type UpgradeChannelName string
+
+const (
+	// UpgradeChannelStable is the upgrade channel serving versions that have completed their soak time in the fast channel.
+	UpgradeChannelStable UpgradeChannelName = "stable"
+	// UpgradeChannelFast is the upgrade channel serving the latest published gardenlet version.
+	UpgradeChannelFast UpgradeChannelName = "fast"
+)
+
 // ManagedSeedStatus is the status of a ManagedSeed.
 type ManagedSeedStatus struct {
 	// Conditions represents the latest available observations of a ManagedSeed's current state.
@@ -145,4 +159,7 @@ const (
 	// ManagedSeedSeedRegistered is a condition type for indicating whether the ManagedSeed's seed has been registered,
 	// either directly or by deploying gardenlet into the shoot.
 	ManagedSeedSeedRegistered gardencore.ConditionType = "SeedRegistered"
+	// ManagedSeedGardenletRolledBack is a condition type for indicating that a failed gardenlet self-upgrade
+	// has been rolled back to the last known-good version.
+	ManagedSeedGardenletRolledBack gardencore.ConditionType = "GardenletRolledBack"
 )
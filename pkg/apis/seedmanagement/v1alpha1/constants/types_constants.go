@@ -8,4 +8,9 @@ const (
 	// AnnotationProtectFromDeletion is a constant for an annotation on a replica of a ManagedSeedSet
 	// (either ManagedSeed or Shoot) to protect it from deletion..
 	AnnotationProtectFromDeletion = "seedmanagement.gardener.cloud/protect-from-deletion"
+
+	// AnnotationKnownGoodGardenletVersion is a constant for an annotation on a ManagedSeed recording the gardenlet
+	// version that was last observed running healthily in the seed cluster. It is used to roll back failed
+	// gardenlet self-upgrades.
+	AnnotationKnownGoodGardenletVersion = "seedmanagement.gardener.cloud/known-good-gardenlet-version"
 )
@@ -684,131 +684,134 @@ func init() {
 }
 
 var fileDescriptor_d64c05a219673fe5 = []byte{
-	// 1980 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xdd, 0x6f, 0x1c, 0x49,
-	0x11, 0xf7, 0xd8, 0x5e, 0xdb, 0x53, 0x8e, 0xed, 0xb8, 0xed, 0x4b, 0xf6, 0x8c, 0xb2, 0x1b, 0x46,
-	0x02, 0x99, 0x8f, 0x9b, 0x25, 0xe1, 0x84, 0xc2, 0x71, 0x3e, 0xc9, 0xe3, 0x84, 0x24, 0x87, 0x1d,
-	0x9b, 0x5e, 0x3b, 0x48, 0x88, 0x07, 0x7a, 0x67, 0xdb, 0xeb, 0xc1, 0xf3, 0x75, 0x33, 0xbd, 0x9b,
-	0xac, 0x90, 0xe0, 0xc4, 0x1b, 0x27, 0x21, 0x21, 0xfe, 0x03, 0x84, 0xc4, 0xdf, 0x92, 0xc7, 0x08,
-	0x81, 0x74, 0x02, 0xb4, 0xba, 0x2c, 0x08, 0x01, 0xff, 0x00, 0x22, 0x0f, 0x08, 0x75, 0x4f, 0xcf,
-	0xe7, 0xce, 0x26, 0x4e, 0xbc, 0xb1, 0x04, 0x6f, 0x3b, 0xf5, 0xf1, 0xab, 0xea, 0xea, 0xea, 0xae,
-	0xaa, 0x5e, 0xd8, 0xeb, 0x58, 0xec, 0xa4, 0xdb, 0xd2, 0x4d, 0xcf, 0x69, 0x74, 0x48, 0xd0, 0xa6,
-	0x2e, 0x0d, 0xd2, 0x1f, 0xfe, 0x69, 0xa7, 0x41, 0x7c, 0x2b, 0x6c, 0x84, 0x94, 0xb6, 0x1d, 0xe2,
-	0x92, 0x0e, 0x75, 0xa8, 0xcb, 0x1a, 0xbd, 0x1b, 0xc4, 0xf6, 0x4f, 0xc8, 0x8d, 0x46, 0x87, 0x8b,
-	0x11, 0x46, 0xdb, 0xba, 0x1f, 0x78, 0xcc, 0x43, 0x5b, 0x29, 0x9c, 0x1e, 0xa3, 0xa4, 0x3f, 0xfc,
-	0xd3, 0x8e, 0xce, 0xe1, 0xf4, 0x3c, 0x9c, 0x1e, 0xc3, 0x6d, 0x6c, 0x9d, 0xcd, 0x1b, 0xd3, 0x0b,
-	0x68, 0xa3, 0x37, 0x62, 0x7d, 0xc3, 0x78, 0x25, 0xf5, 0x16, 0x65, 0xa3, 0x2b, 0xd8, 0x78, 0x27,
-	0x8b, 0xe1, 0x75, 0xbc, 0x86, 0x20, 0xb7, 0xba, 0xc7, 0xe2, 0x4b, 0x7c, 0x88, 0x5f, 0x52, 0x5c,
-	0x3b, 0xbd, 0x15, 0xea, 0x96, 0xc7, 0x81, 0xc7, 0xba, 0xf5, 0x6e, 0x2a, 0xe3, 0x10, 0xf3, 0xc4,
-	0x72, 0x69, 0xd0, 0x4f, 0xbd, 0x71, 0x28, 0x23, 0x65, 0x5a, 0x8d, 0x71, 0x5a, 0x41, 0xd7, 0x65,
-	0x96, 0x43, 0x47, 0x14, 0xbe, 0xf1, 0x32, 0x85, 0xd0, 0x3c, 0xa1, 0x0e, 0x29, 0xea, 0x69, 0x7f,
-	0x98, 0x06, 0xf5, 0xae, 0x08, 0x92, 0x4d, 0x19, 0xfa, 0x21, 0x2c, 0x70, 0x8f, 0xda, 0x84, 0x91,
-	0xaa, 0x72, 0x5d, 0xd9, 0x5c, 0xbc, 0xf9, 0x35, 0x3d, 0x02, 0xd6, 0xb3, 0xc0, 0xe9, 0x5e, 0x72,
-	0x69, 0xbd, 0x77, 0x43, 0xdf, 0x6f, 0xfd, 0x88, 0x9a, 0x6c, 0x8f, 0x32, 0x62, 0xa0, 0x27, 0x83,
-	0xfa, 0xd4, 0x70, 0x50, 0x87, 0x94, 0x86, 0x13, 0x54, 0xe4, 0xc2, 0x6c, 0xe8, 0x53, 0xb3, 0x3a,
-	0x2d, 0xd0, 0x77, 0xf5, 0x73, 0xa5, 0x8c, 0x9e, 0x78, 0xde, 0xf4, 0xa9, 0x69, 0x5c, 0x92, 0x96,
-	0x67, 0xf9, 0x17, 0x16, 0x76, 0x50, 0x0f, 0xe6, 0x42, 0x46, 0x58, 0x37, 0xac, 0xce, 0x08, 0x8b,
-	0x0f, 0x26, 0x66, 0x51, 0xa0, 0x1a, 0xcb, 0xd2, 0xe6, 0x5c, 0xf4, 0x8d, 0xa5, 0x35, 0xed, 0x6f,
-	0xd3, 0xb0, 0x92, 0xc8, 0xee, 0x78, 0xee, 0xb1, 0xd5, 0x41, 0x3f, 0x53, 0x00, 0xda, 0xd4, 0xb7,
-	0xbd, 0x3e, 0xc7, 0x94, 0x01, 0xc6, 0x93, 0x72, 0xe8, 0x76, 0x82, 0x6c, 0x2c, 0xf3, 0xf0, 0xa7,
-	0xdf, 0x38, 0x63, 0x15, 0x1d, 0xc1, 0x9c, 0x29, 0xdc, 0x91, 0x5b, 0xf0, 0xce, 0xd8, 0x0d, 0x96,
-	0x99, 0xa3, 0x63, 0xf2, 0xe8, 0xce, 0x63, 0x46, 0xdd, 0xd0, 0xf2, 0xdc, 0x74, 0xbd, 0xd1, 0x9a,
-	0xb0, 0x04, 0x43, 0xb7, 0x40, 0x6d, 0x79, 0x1e, 0x0b, 0x59, 0x40, 0x7c, 0x11, 0x6a, 0xd5, 0xd8,
-	0x18, 0x0e, 0xea, 0xaa, 0x11, 0x13, 0x9f, 0x67, 0x3f, 0x70, 0x2a, 0x8c, 0xb6, 0x60, 0xc5, 0xa1,
-	0x41, 0x87, 0x7e, 0xcf, 0x62, 0x27, 0x07, 0x24, 0xe0, 0x91, 0x99, 0xbd, 0xae, 0x6c, 0x2e, 0x18,
-	0x6b, 0xc3, 0x41, 0x7d, 0x65, 0x2f, 0xcf, 0xc2, 0x45, 0x59, 0xed, 0x5f, 0x0b, 0xb0, 0x56, 0x12,
-	0x03, 0xf4, 0x2e, 0x5c, 0x0a, 0xa8, 0x6f, 0x5b, 0x26, 0xd9, 0xf1, 0xba, 0x32, 0xda, 0x15, 0xe3,
-	0xf2, 0x70, 0x50, 0xbf, 0x84, 0x33, 0x74, 0x9c, 0x93, 0x42, 0xbb, 0xb0, 0x1e, 0xd0, 0x9e, 0xc5,
-	0x97, 0x7a, 0xcf, 0x0a, 0x99, 0x17, 0xf4, 0x77, 0x2d, 0xc7, 0x62, 0x22, 0x56, 0x15, 0xa3, 0x3a,
-	0x1c, 0xd4, 0xd7, 0x71, 0x09, 0x1f, 0x97, 0x6a, 0xa1, 0x6f, 0x03, 0x0a, 0x69, 0xd0, 0xb3, 0x4c,
-	0xba, 0x6d, 0x9a, 0x1c, 0xff, 0x01, 0x71, 0xa8, 0x8c, 0xce, 0x95, 0xe1, 0xa0, 0x8e, 0x9a, 0x23,
-	0x5c, 0x5c, 0xa2, 0x81, 0x28, 0x54, 0x2c, 0x87, 0x74, 0xa8, 0x08, 0xcc, 0xe2, 0xcd, 0xdb, 0xe7,
-	0x4c, 0x99, 0xfb, 0x1c, 0xcb, 0x50, 0x87, 0x83, 0x7a, 0x45, 0xfc, 0xc4, 0x11, 0x3a, 0x3a, 0x02,
-	0x35, 0xa0, 0xa1, 0xd7, 0x0d, 0x4c, 0x1a, 0x56, 0x2b, 0xc2, 0xd4, 0x66, 0x26, 0x3b, 0x74, 0x7e,
-	0xc5, 0xf1, 0xc3, 0x8e, 0xa5, 0x10, 0xa6, 0x1f, 0x75, 0xad, 0x40, 0x80, 0x87, 0xc6, 0x12, 0xdf,
-	0xed, 0x98, 0x13, 0xe2, 0x14, 0x09, 0xfd, 0x4a, 0x01, 0xd5, 0xf7, 0xda, 0xbb, 0xa4, 0x45, 0xed,
-	0xb0, 0x3a, 0x77, 0x7d, 0x66, 0x73, 0xf1, 0x26, 0x99, 0x7c, 0xd6, 0xeb, 0x07, 0xb1, 0x8d, 0x3b,
-	0x2e, 0x0b, 0xfa, 0xc6, 0xaa, 0xcc, 0x54, 0x35, 0xa1, 0xe3, 0xd4, 0x0d, 0xf4, 0x5b, 0x05, 0x96,
-	0x7d, 0xaf, 0xbd, 0xed, 0xba, 0x1e, 0x23, 0xcc, 0xf2, 0xdc, 0xb0, 0x3a, 0x2f, 0x3c, 0x3b, 0x7e,
-	0x33, 0x9e, 0x65, 0x0c, 0x45, 0xee, 0x5d, 0x91, 0xee, 0x2d, 0xe7, 0x99, 0xb8, 0xe0, 0x15, 0x32,
-	0x61, 0x95, 0xb4, 0xdb, 0x16, 0xff, 0x20, 0xf6, 0x43, 0xcf, 0xee, 0x3a, 0x34, 0xac, 0x2e, 0x08,
-	0x57, 0x37, 0xca, 0x36, 0x27, 0x12, 0x31, 0xde, 0x96, 0xf0, 0xab, 0xdb, 0x45, 0x65, 0x3c, 0x8a,
-	0x87, 0x1e, 0xc1, 0x95, 0x22, 0x71, 0x8f, 0x67, 0x5f, 0x58, 0x55, 0x85, 0xa5, 0xfa, 0x78, 0x4b,
-	0x42, 0xce, 0xa8, 0x49, 0x73, 0x57, 0xb6, 0x4b, 0x61, 0xf0, 0x18, 0x78, 0xf4, 0x4d, 0x98, 0xa1,
-	0x6e, 0xaf, 0x0a, 0xe3, 0xd7, 0x73, 0xc7, 0xed, 0x3d, 0x24, 0x81, 0xb1, 0x28, 0x0d, 0xcc, 0xdc,
-	0x71, 0x7b, 0x98, 0xeb, 0x6c, 0xbc, 0x0f, 0xcb, 0xf9, 0x1d, 0x47, 0x97, 0x61, 0xe6, 0x94, 0xf6,
-	0xc5, 0x49, 0x57, 0x31, 0xff, 0x89, 0xd6, 0xa1, 0xd2, 0x23, 0x76, 0x97, 0x8a, 0xf3, 0xab, 0xe2,
-	0xe8, 0xe3, 0xbd, 0xe9, 0x5b, 0xca, 0xc6, 0x36, 0xac, 0x95, 0xec, 0xca, 0xab, 0x40, 0x68, 0x9f,
-	0x28, 0xb0, 0x94, 0xec, 0xf6, 0x3d, 0x6a, 0x3b, 0xa8, 0x0f, 0x4b, 0x9e, 0x69, 0x61, 0xea, 0x7b,
-	0xa1, 0xc5, 0x6f, 0x01, 0x79, 0xc5, 0xbf, 0x7f, 0xc6, 0x94, 0x8a, 0x97, 0xbc, 0xbf, 0x73, 0x3f,
-	0xc5, 0x30, 0xde, 0x92, 0x2b, 0x5f, 0xca, 0x91, 0x71, 0xde, 0x92, 0xf6, 0xe7, 0xac, 0x33, 0xbb,
-	0x56, 0xc8, 0xd0, 0x0f, 0x46, 0x6a, 0xb9, 0x7e, 0xb6, 0x5a, 0xce, 0xb5, 0x45, 0x25, 0xbf, 0x2c,
-	0x2d, 0x2f, 0xc4, 0x94, 0x4c, 0x1d, 0x77, 0xa0, 0x62, 0x31, 0xea, 0x84, 0xd5, 0x69, 0xb1, 0x75,
-	0xf7, 0x26, 0x75, 0x6a, 0x8c, 0x25, 0x69, 0xb4, 0x72, 0x9f, 0xc3, 0xe3, 0xc8, 0x8a, 0xf6, 0xd7,
-	0x19, 0xb8, 0x9a, 0x96, 0x5e, 0x6a, 0x1f, 0x67, 0x6e, 0xfa, 0x5f, 0x2b, 0xb0, 0xd6, 0x19, 0x3d,
-	0x75, 0x6f, 0xb0, 0xbe, 0x7e, 0x4e, 0xfa, 0x58, 0x56, 0x78, 0x70, 0x99, 0x2f, 0xbc, 0xed, 0x39,
-	0xa1, 0xb6, 0x33, 0xe9, 0xb6, 0x87, 0x67, 0x5d, 0xda, 0xf6, 0xf0, 0x2f, 0x2c, 0xec, 0xf0, 0x3a,
-	0x26, 0xee, 0xf4, 0x87, 0xd4, 0x64, 0x5e, 0xb0, 0xdf, 0xa3, 0xc1, 0xa3, 0xc0, 0x62, 0x71, 0xed,
-	0x11, 0x75, 0xec, 0x7e, 0x09, 0x1f, 0x97, 0x6a, 0xa1, 0x0e, 0x5c, 0x33, 0x3d, 0xc7, 0xf7, 0x5c,
-	0xea, 0xb2, 0x32, 0x35, 0x51, 0x97, 0x54, 0xe3, 0xf3, 0xc3, 0x41, 0xfd, 0xda, 0xce, 0x8b, 0x04,
-	0xf1, 0x8b, 0x71, 0xb4, 0xbf, 0x4f, 0x67, 0xb2, 0x98, 0x77, 0x71, 0xe8, 0x93, 0xb2, 0x9e, 0xe9,
-	0xe1, 0xc4, 0x9a, 0xb8, 0x5c, 0x26, 0xa5, 0xad, 0xeb, 0xc5, 0xf6, 0x4e, 0x21, 0xac, 0x9d, 0x76,
-	0x5b, 0x34, 0xfa, 0x6a, 0x52, 0x33, 0xa0, 0x0c, 0xd3, 0x63, 0xd9, 0xb0, 0x96, 0x56, 0xe0, 0x5d,
-	0xcf, 0x24, 0x76, 0xd4, 0x5f, 0x63, 0x7a, 0x4c, 0x03, 0xea, 0x9a, 0xd4, 0xb8, 0xca, 0x33, 0xf2,
-	0x3b, 0xa3, 0x40, 0xb8, 0x0c, 0x5d, 0x7b, 0xaa, 0x64, 0x1a, 0xd4, 0xa8, 0x79, 0x45, 0x1f, 0x01,
-	0x98, 0x9e, 0x1b, 0x5d, 0xd4, 0x61, 0x55, 0x11, 0x27, 0x7b, 0xeb, 0xd5, 0x2e, 0x2f, 0x31, 0x57,
-	0xe9, 0x3b, 0x31, 0x4a, 0x1a, 0xd2, 0x84, 0x14, 0xe2, 0x8c, 0x11, 0xf4, 0x21, 0x20, 0xaf, 0xc5,
-	0x5b, 0x1e, 0xda, 0xbe, 0x1b, 0x8d, 0x26, 0x96, 0xe7, 0x8a, 0xf0, 0xce, 0x18, 0x1b, 0x52, 0x17,
-	0xed, 0x8f, 0x48, 0xe0, 0x12, 0x2d, 0xed, 0x37, 0x0a, 0x44, 0x0d, 0x0d, 0xd2, 0x01, 0x82, 0xfc,
-	0x2d, 0xac, 0x46, 0x4d, 0x71, 0xe6, 0x02, 0xcd, 0x48, 0xa0, 0xb7, 0x61, 0x86, 0x91, 0x68, 0x57,
-	0x55, 0x63, 0x9e, 0x97, 0x99, 0x43, 0xd2, 0xc1, 0x9c, 0x86, 0xf6, 0x01, 0xfc, 0xae, 0x6d, 0x1f,
-	0x78, 0xb6, 0x65, 0xf6, 0xe5, 0xf9, 0x69, 0x70, 0xa8, 0x83, 0x84, 0xfa, 0x7c, 0x50, 0xbf, 0x36,
-	0x3a, 0x09, 0xea, 0xa9, 0x00, 0xce, 0x40, 0x68, 0x7f, 0x9a, 0x86, 0xc5, 0x3d, 0x91, 0x94, 0xed,
-	0x26, 0xa5, 0xed, 0x0b, 0x98, 0xb9, 0xfc, 0xdc, 0xcc, 0x75, 0xde, 0x09, 0x28, 0xe3, 0xfb, 0xd8,
-	0xa9, 0xeb, 0x71, 0x61, 0xea, 0x3a, 0x98, 0xa0, 0xcd, 0x17, 0xcf, 0x5d, 0x9f, 0x29, 0xb0, 0x92,
-	0x91, 0xbe, 0x80, 0x4a, 0xe8, 0xe5, 0x2b, 0xe1, 0x87, 0x93, 0x5b, 0xea, 0xb8, 0x5a, 0x38, 0x0d,
-	0xcb, 0xd9, 0x80, 0x5c, 0xc8, 0xdc, 0x1e, 0xe6, 0x72, 0xe8, 0xbb, 0x13, 0xdc, 0xcf, 0x17, 0x0c,
-	0xef, 0x3f, 0x2e, 0xa4, 0x51, 0x73, 0xb2, 0x66, 0x5f, 0x32, 0xc1, 0x2b, 0x80, 0xf2, 0x0a, 0x17,
-	0x90, 0x4c, 0x41, 0x3e, 0x99, 0xf6, 0x26, 0xba, 0xe0, 0x31, 0xf9, 0xf4, 0x9f, 0xd9, 0xe2, 0x42,
-	0x45, 0xe5, 0xdd, 0x84, 0x05, 0x39, 0x1a, 0x87, 0x72, 0x78, 0xbe, 0xc4, 0x9d, 0x96, 0xc3, 0x73,
-	0x88, 0x13, 0x2e, 0x22, 0xb0, 0x10, 0x52, 0x5b, 0x94, 0x72, 0x99, 0x1f, 0x5f, 0x3f, 0x63, 0x48,
-	0x78, 0xf3, 0xde, 0x94, 0xaa, 0x69, 0x5c, 0x62, 0x0a, 0x4e, 0x60, 0xd1, 0xc7, 0x0a, 0x2c, 0x30,
-	0xea, 0xf8, 0x36, 0x91, 0x4d, 0xcc, 0xf9, 0x1b, 0xbb, 0xcc, 0x92, 0x0f, 0x25, 0x72, 0xea, 0x42,
-	0x4c, 0xc1, 0x89, 0x55, 0xf4, 0x13, 0x58, 0x0a, 0x4f, 0x3c, 0x8f, 0xc5, 0x2c, 0x39, 0x8c, 0x6f,
-	0xbf, 0x4e, 0x7d, 0x6c, 0x66, 0x81, 0xd2, 0x0e, 0x3f, 0x47, 0xc6, 0x79, 0x73, 0xe8, 0xe7, 0x0a,
-	0x2c, 0x77, 0xfd, 0x36, 0x61, 0xb4, 0xc9, 0x02, 0xc2, 0x68, 0xa7, 0x2f, 0x67, 0xf4, 0xf3, 0x26,
-	0xc9, 0x51, 0x0e, 0xd4, 0x40, 0x7c, 0x28, 0xcd, 0xd3, 0x70, 0xc1, 0xf0, 0xd8, 0x67, 0x92, 0xb9,
-	0xd7, 0x79, 0x26, 0xd1, 0xfe, 0x38, 0x07, 0xeb, 0x65, 0x47, 0x73, 0x4c, 0x73, 0xa0, 0xbc, 0x4e,
-	0x73, 0x80, 0xbe, 0x9a, 0x49, 0xe7, 0xe8, 0x35, 0x27, 0xd9, 0xec, 0x92, 0x94, 0xfe, 0x16, 0x2c,
-	0x05, 0x94, 0xb4, 0xfb, 0x31, 0x4b, 0xe4, 0x5c, 0x25, 0xdd, 0x29, 0x9c, 0x65, 0xe2, 0xbc, 0x2c,
-	0xba, 0x0b, 0xab, 0x2e, 0x7d, 0xcc, 0xe4, 0xf7, 0x83, 0xae, 0xd3, 0xa2, 0x81, 0xc8, 0x96, 0x4a,
-	0x3a, 0x96, 0x3f, 0x28, 0x0a, 0xe0, 0x51, 0x1d, 0xb4, 0x0d, 0x2b, 0x66, 0x37, 0x10, 0xef, 0x5e,
-	0xb1, 0x1f, 0x15, 0x01, 0x73, 0x55, 0xc2, 0xac, 0xec, 0xe4, 0xd9, 0xb8, 0x28, 0xcf, 0x21, 0xa2,
-	0xbd, 0x6b, 0x27, 0x10, 0x73, 0x79, 0x88, 0xa3, 0x3c, 0x1b, 0x17, 0xe5, 0x73, 0x5e, 0x44, 0xbb,
-	0x57, 0x9d, 0x17, 0x6d, 0xd0, 0xa8, 0x17, 0x11, 0x1b, 0x17, 0xe5, 0xd1, 0x07, 0x71, 0xea, 0x26,
-	0x08, 0x0b, 0xd1, 0x23, 0x58, 0xfc, 0x08, 0x72, 0x94, 0xe3, 0xe2, 0x82, 0x34, 0x7a, 0x0f, 0x96,
-	0x4d, 0xcf, 0xb6, 0xc5, 0x47, 0xf4, 0x9c, 0xa7, 0x8a, 0x45, 0x88, 0x5c, 0xdd, 0xc9, 0x71, 0x70,
-	0x41, 0xb2, 0xd0, 0xd4, 0xc2, 0x45, 0x34, 0xb5, 0xfc, 0xa8, 0xfa, 0xd4, 0x6d, 0x5b, 0x6e, 0x47,
-	0x46, 0xb1, 0xba, 0x38, 0x91, 0xa3, 0x7a, 0x90, 0x03, 0x8d, 0x96, 0x9f, 0xa7, 0xe1, 0x82, 0x61,
-	0xed, 0xdf, 0xf9, 0x86, 0x48, 0x5c, 0xed, 0x14, 0x2a, 0xe2, 0x6e, 0x91, 0x05, 0xec, 0xbc, 0xef,
-	0x89, 0xe2, 0xda, 0x8a, 0xde, 0x13, 0xc5, 0x4f, 0x1c, 0xa1, 0xa3, 0x9f, 0x82, 0x9a, 0xcc, 0xc2,
-	0x93, 0x7e, 0x7e, 0x8f, 0x46, 0xa8, 0xf4, 0x91, 0x2f, 0x61, 0xe0, 0xd4, 0xa6, 0xf6, 0x3b, 0x05,
-	0x56, 0x47, 0x5a, 0xc7, 0xff, 0xf5, 0x29, 0xe7, 0x1f, 0x0a, 0xac, 0x95, 0xd4, 0xae, 0xff, 0xc7,
-	0x39, 0x42, 0xfb, 0xa7, 0x02, 0x85, 0xfc, 0x46, 0xd7, 0x61, 0xd6, 0x25, 0x0e, 0x95, 0x43, 0x5d,
-	0xa2, 0x24, 0xde, 0xce, 0x05, 0x07, 0x7d, 0x00, 0x73, 0x01, 0x25, 0xa1, 0x0c, 0xb0, 0x6a, 0x7c,
-	0x31, 0x6e, 0xf0, 0xb0, 0xa0, 0x3e, 0x1f, 0xd4, 0xd7, 0x0b, 0x67, 0x46, 0xd0, 0xb1, 0xd4, 0x42,
-	0xfb, 0x50, 0x09, 0x2d, 0xd7, 0x8c, 0xfb, 0x8c, 0x2f, 0x9f, 0x2d, 0x8a, 0x87, 0x96, 0x43, 0xd3,
-	0x06, 0xab, 0xc9, 0x01, 0x70, 0x84, 0x83, 0xbe, 0x00, 0xf3, 0x01, 0x65, 0x81, 0x45, 0x43, 0x59,
-	0x05, 0x16, 0x87, 0x83, 0xfa, 0x3c, 0x8e, 0x48, 0x38, 0xe6, 0x69, 0xb7, 0xe1, 0x2d, 0xcc, 0xaf,
-	0x2e, 0xb7, 0x93, 0xaf, 0xbe, 0xe8, 0x2b, 0xa0, 0xfa, 0x24, 0x60, 0x56, 0x52, 0xfd, 0x2a, 0xd1,
-	0x6b, 0xfb, 0x41, 0x4c, 0xc4, 0x29, 0x5f, 0xfb, 0x12, 0x44, 0x87, 0xf0, 0xe5, 0x81, 0xd2, 0x7e,
-	0xaf, 0x40, 0xa1, 0xd0, 0xa3, 0x9b, 0x30, 0xcb, 0xfa, 0x7e, 0xac, 0x54, 0xe3, 0x0a, 0x87, 0x7d,
-	0x9f, 0x3e, 0x1f, 0xd4, 0x51, 0x5e, 0x92, 0x53, 0xb1, 0x90, 0x45, 0xbf, 0x50, 0x60, 0x29, 0xc8,
-	0x3a, 0x2e, 0x13, 0xe4, 0xf0, 0x9c, 0x09, 0x52, 0x1a, 0x0c, 0x63, 0x55, 0x94, 0xdf, 0x2c, 0x0b,
-	0xe7, 0xad, 0x1b, 0xe6, 0x93, 0x67, 0xb5, 0xa9, 0xa7, 0xcf, 0x6a, 0x53, 0x9f, 0x3e, 0xab, 0x4d,
-	0x7d, 0x3c, 0xac, 0x29, 0x4f, 0x86, 0x35, 0xe5, 0xe9, 0xb0, 0xa6, 0x7c, 0x3a, 0xac, 0x29, 0x9f,
-	0x0d, 0x6b, 0xca, 0x2f, 0xff, 0x52, 0x9b, 0xfa, 0xfe, 0xd6, 0xb9, 0xfe, 0xfb, 0xfe, 0x6f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x13, 0x58, 0xd0, 0xd5, 0x3b, 0x1f, 0x00, 0x00,
+	// 2021 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0x6d, 0x6f, 0x1c, 0x49,
+	0xf1, 0xcf, 0xd8, 0x5e, 0xdb, 0x5b, 0x8e, 0xd7, 0x71, 0xdb, 0x97, 0xec, 0xf9, 0xaf, 0xec, 0xe6,
+	0xbf, 0x12, 0xc8, 0x3c, 0xdc, 0x98, 0x98, 0x13, 0x0a, 0xc7, 0xf9, 0x24, 0x8f, 0x13, 0x92, 0x1c,
+	0x76, 0x6c, 0xda, 0x76, 0x90, 0x10, 0x2f, 0x68, 0xcf, 0x96, 0xd7, 0x83, 0xe7, 0xe9, 0x66, 0x7a,
+	0x37, 0x59, 0x21, 0xc1, 0x89, 0x77, 0x9c, 0x84, 0x84, 0xf8, 0x06, 0x08, 0x89, 0xcf, 0xc0, 0x47,
+	0xc8, 0xcb, 0x08, 0x81, 0x74, 0x02, 0xb4, 0xba, 0x2c, 0x08, 0x01, 0x5f, 0x00, 0x91, 0x17, 0x08,
+	0x75, 0x4f, 0xcf, 0xce, 0xc3, 0xce, 0xfa, 0x9c, 0x78, 0xcf, 0x12, 0xbc, 0xdb, 0xa9, 0x87, 0x5f,
+	0x55, 0x57, 0x57, 0x77, 0x55, 0xf5, 0xc2, 0x4e, 0xcb, 0xe2, 0x27, 0xed, 0x23, 0xdd, 0xf4, 0x9c,
+	0xb5, 0x16, 0x0b, 0x9a, 0xe8, 0x62, 0x90, 0xfc, 0xf0, 0x4f, 0x5b, 0x6b, 0xcc, 0xb7, 0xc2, 0xb5,
+	0x10, 0xb1, 0xe9, 0x30, 0x97, 0xb5, 0xd0, 0x41, 0x97, 0xaf, 0x75, 0x6e, 0x33, 0xdb, 0x3f, 0x61,
+	0xb7, 0xd7, 0x5a, 0x42, 0x8c, 0x71, 0x6c, 0xea, 0x7e, 0xe0, 0x71, 0x8f, 0x6c, 0x24, 0x70, 0x7a,
+	0x8c, 0x92, 0xfc, 0xf0, 0x4f, 0x5b, 0xba, 0x80, 0xd3, 0xb3, 0x70, 0x7a, 0x0c, 0xb7, 0xb2, 0x71,
+	0x3e, 0x6f, 0x4c, 0x2f, 0xc0, 0xb5, 0xce, 0x90, 0xf5, 0x15, 0xe3, 0x95, 0xd4, 0x8f, 0x90, 0x0f,
+	0xaf, 0x60, 0xe5, 0xad, 0x34, 0x86, 0xd7, 0xf2, 0xd6, 0x24, 0xf9, 0xa8, 0x7d, 0x2c, 0xbf, 0xe4,
+	0x87, 0xfc, 0xa5, 0xc4, 0x1b, 0xa7, 0x77, 0x42, 0xdd, 0xf2, 0x04, 0xf0, 0x48, 0xb7, 0xde, 0x4e,
+	0x64, 0x1c, 0x66, 0x9e, 0x58, 0x2e, 0x06, 0xdd, 0xc4, 0x1b, 0x07, 0x39, 0x2b, 0xd2, 0x5a, 0x1b,
+	0xa5, 0x15, 0xb4, 0x5d, 0x6e, 0x39, 0x38, 0xa4, 0xf0, 0xb5, 0x4f, 0x53, 0x08, 0xcd, 0x13, 0x74,
+	0x58, 0x5e, 0xaf, 0xf1, 0xfb, 0x09, 0x28, 0xdf, 0x97, 0x41, 0xb2, 0x91, 0x93, 0xef, 0xc3, 0xac,
+	0xf0, 0xa8, 0xc9, 0x38, 0xab, 0x6a, 0xb7, 0xb4, 0xd5, 0xb9, 0xf5, 0xaf, 0xe8, 0x11, 0xb0, 0x9e,
+	0x06, 0x4e, 0xf6, 0x52, 0x48, 0xeb, 0x9d, 0xdb, 0xfa, 0xee, 0xd1, 0x0f, 0xd0, 0xe4, 0x3b, 0xc8,
+	0x99, 0x41, 0x9e, 0xf5, 0xea, 0x57, 0xfa, 0xbd, 0x3a, 0x24, 0x34, 0x3a, 0x40, 0x25, 0x2e, 0x4c,
+	0x85, 0x3e, 0x9a, 0xd5, 0x09, 0x89, 0xbe, 0xad, 0x5f, 0x28, 0x65, 0xf4, 0x81, 0xe7, 0xfb, 0x3e,
+	0x9a, 0xc6, 0x55, 0x65, 0x79, 0x4a, 0x7c, 0x51, 0x69, 0x87, 0x74, 0x60, 0x3a, 0xe4, 0x8c, 0xb7,
+	0xc3, 0xea, 0xa4, 0xb4, 0xf8, 0x68, 0x6c, 0x16, 0x25, 0xaa, 0x51, 0x51, 0x36, 0xa7, 0xa3, 0x6f,
+	0xaa, 0xac, 0x35, 0x7e, 0x33, 0x09, 0x0b, 0x03, 0xd9, 0x2d, 0xcf, 0x3d, 0xb6, 0x5a, 0xe4, 0x27,
+	0x1a, 0x40, 0x13, 0x7d, 0xdb, 0xeb, 0x0a, 0x4c, 0x15, 0x60, 0x3a, 0x2e, 0x87, 0xee, 0x0e, 0x90,
+	0x8d, 0x8a, 0x08, 0x7f, 0xf2, 0x4d, 0x53, 0x56, 0xc9, 0x21, 0x4c, 0x9b, 0xd2, 0x1d, 0xb5, 0x05,
+	0x6f, 0x8d, 0xdc, 0x60, 0x95, 0x39, 0x3a, 0x65, 0x4f, 0xee, 0x3d, 0xe5, 0xe8, 0x86, 0x96, 0xe7,
+	0x26, 0xeb, 0x8d, 0xd6, 0x44, 0x15, 0x18, 0xb9, 0x03, 0xe5, 0x23, 0xcf, 0xe3, 0x21, 0x0f, 0x98,
+	0x2f, 0x43, 0x5d, 0x36, 0x56, 0xfa, 0xbd, 0x7a, 0xd9, 0x88, 0x89, 0x2f, 0xd3, 0x1f, 0x34, 0x11,
+	0x26, 0x1b, 0xb0, 0xe0, 0x60, 0xd0, 0xc2, 0xef, 0x58, 0xfc, 0x64, 0x8f, 0x05, 0x22, 0x32, 0x53,
+	0xb7, 0xb4, 0xd5, 0x59, 0x63, 0xa9, 0xdf, 0xab, 0x2f, 0xec, 0x64, 0x59, 0x34, 0x2f, 0x4b, 0xf6,
+	0xa0, 0xd2, 0xf6, 0x5b, 0x01, 0x6b, 0xe2, 0xd6, 0x09, 0x73, 0x5d, 0xb4, 0xab, 0x25, 0x69, 0x7d,
+	0xb5, 0xdf, 0xab, 0x57, 0x0e, 0x33, 0x9c, 0x97, 0xbd, 0x3a, 0xc9, 0x52, 0x1e, 0x31, 0x07, 0x69,
+	0x4e, 0xbf, 0xf1, 0xcf, 0x59, 0x58, 0x2a, 0x88, 0x2a, 0x79, 0x1b, 0xae, 0x06, 0xe8, 0xdb, 0x96,
+	0xc9, 0xb6, 0xbc, 0xb6, 0xda, 0xbf, 0x92, 0x71, 0xad, 0xdf, 0xab, 0x5f, 0xa5, 0x29, 0x3a, 0xcd,
+	0x48, 0x91, 0x6d, 0x58, 0x0e, 0xb0, 0x63, 0x89, 0xe0, 0x3d, 0xb0, 0x42, 0xee, 0x05, 0xdd, 0x6d,
+	0xcb, 0xb1, 0xb8, 0x8c, 0x7e, 0xc9, 0xa8, 0xf6, 0x7b, 0xf5, 0x65, 0x5a, 0xc0, 0xa7, 0x85, 0x5a,
+	0xe4, 0x9b, 0x40, 0x42, 0x0c, 0x3a, 0x96, 0x89, 0x9b, 0xa6, 0x29, 0xf0, 0xc5, 0x0a, 0x54, 0xbc,
+	0xaf, 0xf7, 0x7b, 0x75, 0xb2, 0x3f, 0xc4, 0xa5, 0x05, 0x1a, 0x04, 0xa1, 0x64, 0x39, 0xac, 0x85,
+	0x32, 0xd4, 0x73, 0xeb, 0x77, 0x2f, 0x98, 0x84, 0x0f, 0x05, 0x96, 0x51, 0xee, 0xf7, 0xea, 0x25,
+	0xf9, 0x93, 0x46, 0xe8, 0xe4, 0x10, 0xca, 0x01, 0x86, 0x5e, 0x3b, 0x30, 0x31, 0x94, 0xfb, 0x32,
+	0xb7, 0xbe, 0x9a, 0xca, 0x37, 0x5d, 0x5c, 0x9a, 0xe2, 0xfa, 0xa0, 0x4a, 0x88, 0xe2, 0x07, 0x6d,
+	0x2b, 0x90, 0xe0, 0xa1, 0x31, 0x2f, 0xf2, 0x27, 0xe6, 0x84, 0x34, 0x41, 0x22, 0xbf, 0xd0, 0xa0,
+	0xec, 0x7b, 0xcd, 0x6d, 0x76, 0x84, 0x76, 0x58, 0x9d, 0xbe, 0x35, 0xb9, 0x3a, 0xb7, 0xce, 0xc6,
+	0x7f, 0x8e, 0xf4, 0xbd, 0xd8, 0xc6, 0x3d, 0x97, 0x07, 0x5d, 0x63, 0x51, 0xe5, 0x7e, 0x79, 0x40,
+	0xa7, 0x89, 0x1b, 0xe4, 0xd7, 0x1a, 0x54, 0x7c, 0xaf, 0xb9, 0xe9, 0xba, 0x1e, 0x67, 0xdc, 0xf2,
+	0xdc, 0xb0, 0x3a, 0x23, 0x3d, 0x3b, 0xfe, 0x6c, 0x3c, 0x4b, 0x19, 0x8a, 0xdc, 0xbb, 0xae, 0xdc,
+	0xab, 0x64, 0x99, 0x34, 0xe7, 0x15, 0x31, 0x61, 0x91, 0x35, 0x9b, 0x96, 0xf8, 0x60, 0xf6, 0x63,
+	0xcf, 0x6e, 0x3b, 0x18, 0x56, 0x67, 0xa5, 0xab, 0x2b, 0x45, 0x9b, 0x13, 0x89, 0x18, 0x6f, 0x2a,
+	0xf8, 0xc5, 0xcd, 0xbc, 0x32, 0x1d, 0xc6, 0x23, 0x4f, 0xe0, 0x7a, 0x9e, 0xb8, 0x23, 0xb2, 0x2f,
+	0xac, 0x96, 0xa5, 0xa5, 0xfa, 0x68, 0x4b, 0x52, 0xce, 0xa8, 0x29, 0x73, 0xd7, 0x37, 0x0b, 0x61,
+	0xe8, 0x08, 0x78, 0xf2, 0x75, 0x98, 0x44, 0xb7, 0x53, 0x85, 0xd1, 0xeb, 0xb9, 0xe7, 0x76, 0x1e,
+	0xb3, 0xc0, 0x98, 0x53, 0x06, 0x26, 0xef, 0xb9, 0x1d, 0x2a, 0x74, 0x56, 0xde, 0x85, 0x4a, 0x76,
+	0xc7, 0xc9, 0x35, 0x98, 0x3c, 0xc5, 0xae, 0x3c, 0xe9, 0x65, 0x2a, 0x7e, 0x92, 0x65, 0x28, 0x75,
+	0x98, 0xdd, 0x46, 0x79, 0x7e, 0xcb, 0x34, 0xfa, 0x78, 0x67, 0xe2, 0x8e, 0xb6, 0xb2, 0x09, 0x4b,
+	0x05, 0xbb, 0xf2, 0x2a, 0x10, 0x8d, 0x8f, 0x34, 0x98, 0x1f, 0xec, 0xf6, 0x03, 0xb4, 0x1d, 0xd2,
+	0x85, 0x79, 0xcf, 0xb4, 0x28, 0xfa, 0x5e, 0x68, 0x89, 0x5b, 0x40, 0x15, 0x8d, 0x77, 0xcf, 0x99,
+	0x52, 0xf1, 0x92, 0x77, 0xb7, 0x1e, 0x26, 0x18, 0xc6, 0x1b, 0x6a, 0xe5, 0xf3, 0x19, 0x32, 0xcd,
+	0x5a, 0x6a, 0xfc, 0x29, 0xed, 0xcc, 0xb6, 0x15, 0x72, 0xf2, 0xbd, 0xa1, 0xee, 0x40, 0x3f, 0x5f,
+	0x77, 0x20, 0xb4, 0x65, 0x6f, 0x70, 0x4d, 0x59, 0x9e, 0x8d, 0x29, 0xa9, 0xce, 0xc0, 0x81, 0x92,
+	0xc5, 0xd1, 0x09, 0xab, 0x13, 0x72, 0xeb, 0x1e, 0x8c, 0xeb, 0xd4, 0x18, 0xf3, 0xca, 0x68, 0xe9,
+	0xa1, 0x80, 0xa7, 0x91, 0x95, 0xc6, 0x5f, 0x26, 0xe1, 0x46, 0x52, 0xcc, 0xd1, 0x3e, 0x4e, 0xdd,
+	0xf4, 0xbf, 0xd4, 0x60, 0xa9, 0x35, 0x7c, 0xea, 0x3e, 0xc3, 0x8a, 0xfd, 0x7f, 0xca, 0xc7, 0xa2,
+	0xc2, 0x43, 0x8b, 0x7c, 0x11, 0x8d, 0xd4, 0x09, 0xda, 0xce, 0xb8, 0x1b, 0x29, 0x91, 0x75, 0x49,
+	0x23, 0x25, 0xbe, 0xa8, 0xb4, 0x23, 0xea, 0x98, 0xbc, 0xd3, 0x1f, 0xa3, 0xc9, 0xbd, 0x60, 0xb7,
+	0x83, 0xc1, 0x93, 0xc0, 0xe2, 0x71, 0xed, 0x91, 0x75, 0xec, 0x61, 0x01, 0x9f, 0x16, 0x6a, 0x91,
+	0x16, 0xdc, 0x34, 0x3d, 0xc7, 0xf7, 0x5c, 0x74, 0x79, 0x91, 0x9a, 0xac, 0x4b, 0x65, 0xe3, 0xff,
+	0xfb, 0xbd, 0xfa, 0xcd, 0xad, 0xb3, 0x04, 0xe9, 0xd9, 0x38, 0x8d, 0xbf, 0x4d, 0xa4, 0xb2, 0x58,
+	0xf4, 0x85, 0xe4, 0xa3, 0xa2, 0x2e, 0xec, 0xf1, 0xd8, 0xda, 0xc2, 0x4c, 0x26, 0x25, 0xcd, 0xf0,
+	0xe5, 0x76, 0x63, 0x21, 0x2c, 0x9d, 0xb6, 0x8f, 0x30, 0xfa, 0xda, 0x47, 0x33, 0x40, 0x4e, 0xf1,
+	0x58, 0xb5, 0xc0, 0x85, 0x15, 0x78, 0xdb, 0x33, 0x99, 0x1d, 0x75, 0xec, 0x14, 0x8f, 0x31, 0x40,
+	0xd7, 0x44, 0xe3, 0x86, 0xc8, 0xc8, 0x6f, 0x0d, 0x03, 0xd1, 0x22, 0xf4, 0xc6, 0x73, 0x2d, 0xd5,
+	0xf2, 0x46, 0xed, 0x30, 0xf9, 0x00, 0xc0, 0xf4, 0xdc, 0xe8, 0xa2, 0x0e, 0xab, 0x9a, 0x3c, 0xd9,
+	0x1b, 0xaf, 0x76, 0x79, 0xc9, 0x49, 0x4d, 0xdf, 0x8a, 0x51, 0x92, 0x90, 0x0e, 0x48, 0x21, 0x4d,
+	0x19, 0x21, 0xef, 0x03, 0xf1, 0x8e, 0x44, 0xcb, 0x83, 0xcd, 0xfb, 0xd1, 0xb0, 0x63, 0x79, 0xae,
+	0x0c, 0xef, 0xa4, 0xb1, 0xa2, 0x74, 0xc9, 0xee, 0x90, 0x04, 0x2d, 0xd0, 0x6a, 0xfc, 0x4a, 0x83,
+	0xa8, 0xa1, 0x21, 0x3a, 0x40, 0x90, 0xbd, 0x85, 0xcb, 0x51, 0x9b, 0x9d, 0xba, 0x40, 0x53, 0x12,
+	0xe4, 0x4d, 0x98, 0xe4, 0x2c, 0xda, 0xd5, 0xb2, 0x31, 0x23, 0xca, 0xcc, 0x01, 0x6b, 0x51, 0x41,
+	0x23, 0xbb, 0x00, 0x7e, 0xdb, 0xb6, 0xf7, 0x3c, 0xdb, 0x32, 0xbb, 0xea, 0xfc, 0xac, 0x09, 0xa8,
+	0xbd, 0x01, 0xf5, 0x65, 0xaf, 0x7e, 0x73, 0x78, 0xb6, 0xd4, 0x13, 0x01, 0x9a, 0x82, 0x68, 0xfc,
+	0x71, 0x02, 0xe6, 0x76, 0x64, 0x52, 0x36, 0xf7, 0x11, 0x9b, 0x97, 0x30, 0xc5, 0xf9, 0x99, 0x29,
+	0xee, 0xa2, 0x33, 0x55, 0xca, 0xf7, 0x91, 0x73, 0xdc, 0xd3, 0xdc, 0x1c, 0xb7, 0x37, 0x46, 0x9b,
+	0x67, 0x4f, 0x72, 0x9f, 0x68, 0xb0, 0x90, 0x92, 0xbe, 0x84, 0x4a, 0xe8, 0x65, 0x2b, 0xe1, 0xfb,
+	0xe3, 0x5b, 0xea, 0xa8, 0x5a, 0x38, 0x01, 0x95, 0x74, 0x40, 0x2e, 0xe5, 0x25, 0x20, 0xcc, 0xe4,
+	0xd0, 0xb7, 0xc7, 0xb8, 0x9f, 0x67, 0x3c, 0x07, 0xfc, 0x30, 0x97, 0x46, 0xfb, 0xe3, 0x35, 0x7b,
+	0x76, 0x26, 0xfd, 0x55, 0x03, 0x92, 0x55, 0xb8, 0x84, 0x64, 0x0a, 0xb2, 0xc9, 0xb4, 0x33, 0xd6,
+	0x05, 0x8f, 0xc8, 0xa7, 0x7f, 0x4f, 0xe5, 0x17, 0x2a, 0x2b, 0xef, 0x2a, 0xcc, 0xaa, 0xd1, 0x38,
+	0x54, 0xc3, 0xf3, 0x55, 0xe1, 0xb4, 0x1a, 0x9e, 0x43, 0x3a, 0xe0, 0x12, 0x06, 0xb3, 0x21, 0xda,
+	0xb2, 0x94, 0xab, 0xfc, 0xf8, 0xea, 0x39, 0x43, 0x22, 0x9a, 0xf7, 0x7d, 0xa5, 0x9a, 0xc4, 0x25,
+	0xa6, 0xd0, 0x01, 0x2c, 0xf9, 0x50, 0x83, 0x59, 0x8e, 0x8e, 0x6f, 0x33, 0xd5, 0xc4, 0x5c, 0xbc,
+	0xb1, 0x4b, 0x2d, 0xf9, 0x40, 0x21, 0x27, 0x2e, 0xc4, 0x14, 0x3a, 0xb0, 0x4a, 0x7e, 0x04, 0xf3,
+	0xe1, 0x89, 0xe7, 0xf1, 0x98, 0xa5, 0x86, 0xf1, 0xcd, 0xd7, 0xa9, 0x8f, 0xfb, 0x69, 0xa0, 0xa4,
+	0xc3, 0xcf, 0x90, 0x69, 0xd6, 0x1c, 0xf9, 0xa9, 0x06, 0x95, 0xb6, 0xdf, 0x64, 0x1c, 0xf7, 0x79,
+	0xc0, 0x38, 0xb6, 0xba, 0x6a, 0x46, 0xbf, 0x68, 0x92, 0x1c, 0x66, 0x40, 0x0d, 0x12, 0x3d, 0xc5,
+	0xa4, 0x69, 0x34, 0x67, 0x78, 0xe4, 0x33, 0xc9, 0xf4, 0xeb, 0x3c, 0x93, 0x34, 0xfe, 0x30, 0x0d,
+	0xcb, 0x45, 0x47, 0x73, 0x44, 0x73, 0xa0, 0xbd, 0x4e, 0x73, 0x40, 0xbe, 0x9c, 0x4a, 0xe7, 0xe8,
+	0x35, 0x67, 0xb0, 0xd9, 0x05, 0x29, 0xfd, 0x0d, 0x98, 0x0f, 0x90, 0x35, 0xbb, 0x31, 0x4b, 0xe6,
+	0x5c, 0x29, 0xd9, 0x29, 0x9a, 0x66, 0xd2, 0xac, 0x2c, 0xb9, 0x0f, 0x8b, 0x2e, 0x3e, 0xe5, 0xea,
+	0xfb, 0x51, 0xdb, 0x39, 0xc2, 0x40, 0x66, 0x4b, 0x29, 0x19, 0xcb, 0x1f, 0xe5, 0x05, 0xe8, 0xb0,
+	0x0e, 0xd9, 0x84, 0x05, 0xb3, 0x1d, 0xc8, 0x97, 0xb4, 0xd8, 0x8f, 0x92, 0x84, 0xb9, 0xa1, 0x60,
+	0x16, 0xb6, 0xb2, 0x6c, 0x9a, 0x97, 0x17, 0x10, 0xd1, 0xde, 0x35, 0x07, 0x10, 0xd3, 0x59, 0x88,
+	0xc3, 0x2c, 0x9b, 0xe6, 0xe5, 0x33, 0x5e, 0x44, 0xbb, 0x57, 0x9d, 0x91, 0x6d, 0xd0, 0xb0, 0x17,
+	0x11, 0x9b, 0xe6, 0xe5, 0xc9, 0x7b, 0x71, 0xea, 0x0e, 0x10, 0x66, 0xa3, 0x47, 0xb0, 0xf8, 0x11,
+	0xe4, 0x30, 0xc3, 0xa5, 0x39, 0x69, 0xf2, 0x0e, 0x54, 0x4c, 0xcf, 0xb6, 0xe5, 0x47, 0xf4, 0x9c,
+	0x57, 0x96, 0x8b, 0x90, 0xb9, 0xba, 0x95, 0xe1, 0xd0, 0x9c, 0x64, 0xae, 0xa9, 0x85, 0xcb, 0x68,
+	0x6a, 0xc5, 0x51, 0xf5, 0xd1, 0x6d, 0x5a, 0x6e, 0x4b, 0x45, 0xb1, 0x3a, 0x37, 0x96, 0xa3, 0xba,
+	0x97, 0x01, 0x8d, 0x96, 0x9f, 0xa5, 0xd1, 0x9c, 0xe1, 0xc6, 0xbf, 0xb2, 0x0d, 0x91, 0xbc, 0xda,
+	0x11, 0x4a, 0xf2, 0x6e, 0x51, 0x05, 0xec, 0xa2, 0xef, 0x89, 0xf2, 0xda, 0x8a, 0xde, 0x13, 0xe5,
+	0x4f, 0x1a, 0xa1, 0x93, 0x1f, 0x43, 0x79, 0x30, 0x0b, 0x8f, 0xfb, 0x41, 0x3f, 0x1a, 0xa1, 0x92,
+	0x47, 0xbe, 0x01, 0x83, 0x26, 0x36, 0x1b, 0xbf, 0xd5, 0x60, 0x71, 0xa8, 0x75, 0xfc, 0x6f, 0x9f,
+	0x72, 0xfe, 0xae, 0xc1, 0x52, 0x41, 0xed, 0xfa, 0x5f, 0x9c, 0x23, 0x1a, 0xff, 0xd0, 0x20, 0x97,
+	0xdf, 0xe4, 0x16, 0x4c, 0xb9, 0xcc, 0x41, 0x35, 0xd4, 0x0d, 0x94, 0xe4, 0xdb, 0xb9, 0xe4, 0x90,
+	0xf7, 0x60, 0x3a, 0x40, 0x16, 0xaa, 0x00, 0x97, 0x8d, 0xcf, 0xc7, 0x0d, 0x1e, 0x95, 0xd4, 0x97,
+	0xbd, 0xfa, 0x72, 0xee, 0xcc, 0x48, 0x3a, 0x55, 0x5a, 0x64, 0x17, 0x4a, 0xa1, 0xe5, 0x9a, 0x71,
+	0x9f, 0xf1, 0xc5, 0xf3, 0x45, 0xf1, 0xc0, 0x72, 0x30, 0x69, 0xb0, 0xf6, 0x05, 0x00, 0x8d, 0x70,
+	0xc8, 0xe7, 0x60, 0x26, 0x40, 0x1e, 0x58, 0x18, 0xaa, 0x2a, 0x30, 0xd7, 0xef, 0xd5, 0x67, 0x68,
+	0x44, 0xa2, 0x31, 0xaf, 0x71, 0x17, 0xde, 0xa0, 0xe2, 0xea, 0x72, 0x5b, 0xd9, 0xea, 0x4b, 0xbe,
+	0x04, 0x65, 0x9f, 0x05, 0xdc, 0x1a, 0x54, 0xbf, 0x52, 0xf4, 0xda, 0xbe, 0x17, 0x13, 0x69, 0xc2,
+	0x6f, 0x7c, 0x01, 0xa2, 0x43, 0xf8, 0xe9, 0x81, 0x6a, 0xfc, 0x4e, 0x83, 0x5c, 0xa1, 0x27, 0xeb,
+	0x30, 0xc5, 0xbb, 0x7e, 0xac, 0x54, 0x13, 0x0a, 0x07, 0x5d, 0x1f, 0xa3, 0xff, 0x62, 0xd2, 0x92,
+	0x82, 0x4a, 0xa5, 0x2c, 0xf9, 0x99, 0x06, 0xf3, 0x41, 0xda, 0x71, 0x95, 0x20, 0x07, 0x17, 0x4c,
+	0x90, 0xc2, 0x60, 0x18, 0x8b, 0xb2, 0xfc, 0xa6, 0x59, 0x34, 0x6b, 0xdd, 0x30, 0x9f, 0xbd, 0xa8,
+	0x5d, 0x79, 0xfe, 0xa2, 0x76, 0xe5, 0xe3, 0x17, 0xb5, 0x2b, 0x1f, 0xf6, 0x6b, 0xda, 0xb3, 0x7e,
+	0x4d, 0x7b, 0xde, 0xaf, 0x69, 0x1f, 0xf7, 0x6b, 0xda, 0x27, 0xfd, 0x9a, 0xf6, 0xf3, 0x3f, 0xd7,
+	0xae, 0x7c, 0x77, 0xe3, 0x42, 0xff, 0xa6, 0xff, 0x27, 0x00, 0x00, 0xff, 0xff, 0xf9, 0x0a, 0x5f,
+	0xf5, 0x8d, 0x1f, 0x00, 0x00,
 }
 
 func (m *Gardenlet) Marshal() (dAtA []byte, err error) {
@@ -884,6 +887,13 @@ func (m *GardenletConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UpgradeChannel != nil {
+		i -= len(*m.UpgradeChannel)
+		copy(dAtA[i:], *m.UpgradeChannel)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.UpgradeChannel)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.MergeWithParent != nil {
 		i--
 		if *m.MergeWithParent {
@@ -2032,6 +2042,10 @@ func (m *GardenletConfig) Size() (n int) {
 	if m.MergeWithParent != nil {
 		n += 2
 	}
+	if m.UpgradeChannel != nil {
+		l = len(*m.UpgradeChannel)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -2449,6 +2463,7 @@ func (this *GardenletConfig) String() string {
 		`Config:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Config), "RawExtension", "runtime.RawExtension", 1), `&`, ``, 1) + `,`,
 		`Bootstrap:` + valueToStringGenerated(this.Bootstrap) + `,`,
 		`MergeWithParent:` + valueToStringGenerated(this.MergeWithParent) + `,`,
+		`UpgradeChannel:` + valueToStringGenerated(this.UpgradeChannel) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3073,6 +3088,39 @@ func (m *GardenletConfig) Unmarshal(dAtA []byte) error {
 			}
 			b := bool(v != 0)
 			m.MergeWithParent = &b
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := UpgradeChannelName(dAtA[iNdEx:postIndex])
+			m.UpgradeChannel = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
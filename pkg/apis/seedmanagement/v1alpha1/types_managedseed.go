@@ -94,6 +94,11 @@ type GardenletConfig struct {
 	// should be merged with the specified GardenletConfiguration. Defaults to true. This field is immutable.
 	// +optional
 	MergeWithParent *bool `json:"mergeWithParent,omitempty" protobuf:"varint,4,opt,name=mergeWithParent"`
+	// UpgradeChannel is the name of the upgrade channel from which the gardenlet version for this seed is resolved.
+	// One of Stable, Fast. If specified, the gardenlet image tag is managed by the managedseed controller according
+	// to the channel declarations published in the garden cluster and must not be set in the deployment section.
+	// +optional
+	UpgradeChannel *UpgradeChannelName `json:"upgradeChannel,omitempty" protobuf:"bytes,5,opt,name=upgradeChannel,casttype=UpgradeChannelName"`
 }
 
 // GardenletDeployment specifies certain gardenlet deployment parameters, such as the number of replicas,
@@ -158,6 +163,16 @@ const (
 	BootstrapNone Bootstrap = "None"
 )
 
+// UpgradeChannelName is the name of an upgrade channel for gardenlet versions.
+type UpgradeChannelName string
+
+const (
+	// UpgradeChannelStable is the upgrade channel serving versions that have completed their soak time in the fast channel.
+	UpgradeChannelStable UpgradeChannelName = "stable"
+	// UpgradeChannelFast is the upgrade channel serving the latest published gardenlet version.
+	UpgradeChannelFast UpgradeChannelName = "fast"
+)
+
 // ManagedSeedStatus is the status of a ManagedSeed.
 type ManagedSeedStatus struct {
 	// Conditions represents the latest available observations of a ManagedSeed's current state.
@@ -175,4 +190,7 @@ const (
 	ManagedSeedShootReconciled gardencorev1beta1.ConditionType = "ShootReconciled"
 	// SeedRegistered is a condition type for indicating whether the seed has been registered by gardenlet.
 	SeedRegistered gardencorev1beta1.ConditionType = "SeedRegistered"
+	// ManagedSeedGardenletRolledBack is a condition type for indicating that a failed gardenlet self-upgrade
+	// has been rolled back to the last known-good version.
+	ManagedSeedGardenletRolledBack gardencorev1beta1.ConditionType = "GardenletRolledBack"
 )
@@ -320,6 +320,7 @@ func autoConvert_v1alpha1_GardenletConfig_To_seedmanagement_GardenletConfig(in *
 	}
 	out.Bootstrap = (*seedmanagement.Bootstrap)(unsafe.Pointer(in.Bootstrap))
 	out.MergeWithParent = (*bool)(unsafe.Pointer(in.MergeWithParent))
+	out.UpgradeChannel = (*seedmanagement.UpgradeChannelName)(unsafe.Pointer(in.UpgradeChannel))
 	return nil
 }
 
@@ -330,6 +331,7 @@ func autoConvert_seedmanagement_GardenletConfig_To_v1alpha1_GardenletConfig(in *
 	}
 	out.Bootstrap = (*Bootstrap)(unsafe.Pointer(in.Bootstrap))
 	out.MergeWithParent = (*bool)(unsafe.Pointer(in.MergeWithParent))
+	out.UpgradeChannel = (*UpgradeChannelName)(unsafe.Pointer(in.UpgradeChannel))
 	return nil
 }
 
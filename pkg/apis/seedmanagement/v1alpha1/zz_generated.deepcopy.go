@@ -62,6 +62,11 @@ func (in *GardenletConfig) DeepCopyInto(out *GardenletConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.UpgradeChannel != nil {
+		in, out := &in.UpgradeChannel, &out.UpgradeChannel
+		*out = new(UpgradeChannelName)
+		**out = **in
+	}
 	return
 }
 
@@ -149,6 +149,16 @@ func validateGardenlet(gardenlet *seedmanagement.GardenletConfig, fldPath *field
 		}
 	}
 
+	if gardenlet.UpgradeChannel != nil {
+		validValues := []string{string(seedmanagement.UpgradeChannelStable), string(seedmanagement.UpgradeChannelFast)}
+		if !slices.Contains(validValues, string(*gardenlet.UpgradeChannel)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("upgradeChannel"), *gardenlet.UpgradeChannel, validValues))
+		}
+		if gardenlet.Deployment != nil && gardenlet.Deployment.Image != nil && gardenlet.Deployment.Image.Tag != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("upgradeChannel"), "upgrade channel must not be set if the gardenlet image tag is set"))
+		}
+	}
+
 	return allErrs
 }
 
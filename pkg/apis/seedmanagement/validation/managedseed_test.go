@@ -344,6 +344,37 @@ var _ = Describe("ManagedSeed Validation Tests", func() {
 					})),
 				))
 			})
+
+			It("should forbid an unsupported upgrade channel", func() {
+				managedSeed.Spec.Gardenlet.UpgradeChannel = ptr.To(seedmanagement.UpgradeChannelName("foo"))
+
+				errorList := ValidateManagedSeed(managedSeed)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.gardenlet.upgradeChannel"),
+					})),
+				))
+			})
+
+			It("should forbid specifying an upgrade channel together with an image tag", func() {
+				managedSeed.Spec.Gardenlet.UpgradeChannel = ptr.To(seedmanagement.UpgradeChannelStable)
+				managedSeed.Spec.Gardenlet.Deployment = &seedmanagement.GardenletDeployment{
+					Image: &seedmanagement.Image{
+						Tag: ptr.To("1.2.3"),
+					},
+				}
+
+				errorList := ValidateManagedSeed(managedSeed)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.gardenlet.upgradeChannel"),
+					})),
+				))
+			})
 		})
 	})
 
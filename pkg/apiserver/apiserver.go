@@ -32,6 +32,8 @@ type ExtraConfig struct {
 	AdminKubeconfigMaxExpiration       time.Duration
 	ViewerKubeconfigMaxExpiration      time.Duration
 	CredentialsRotationInterval        time.Duration
+	ShootSubresourceRateLimitQPS       float32
+	ShootSubresourceRateLimitBurst     int
 	WorkloadIdentityTokenIssuer        string
 	WorkloadIdentityTokenMinExpiration time.Duration
 	WorkloadIdentityTokenMaxExpiration time.Duration
@@ -102,12 +104,14 @@ func (c completedConfig) New() (*GardenerServer, error) {
 	var (
 		s                = &GardenerServer{GenericAPIServer: genericServer}
 		coreAPIGroupInfo = (corerest.StorageProvider{
-			AdminKubeconfigMaxExpiration:  c.ExtraConfig.AdminKubeconfigMaxExpiration,
-			ViewerKubeconfigMaxExpiration: c.ExtraConfig.ViewerKubeconfigMaxExpiration,
-			CredentialsRotationInterval:   c.ExtraConfig.CredentialsRotationInterval,
-			KubeInformerFactory:           c.kubeInformerFactory,
-			CoreInformerFactory:           c.coreInformerFactory,
-			SubjectAccessReviewer:         c.subjectAccessReviewer,
+			AdminKubeconfigMaxExpiration:   c.ExtraConfig.AdminKubeconfigMaxExpiration,
+			ViewerKubeconfigMaxExpiration:  c.ExtraConfig.ViewerKubeconfigMaxExpiration,
+			CredentialsRotationInterval:    c.ExtraConfig.CredentialsRotationInterval,
+			ShootSubresourceRateLimitQPS:   c.ExtraConfig.ShootSubresourceRateLimitQPS,
+			ShootSubresourceRateLimitBurst: c.ExtraConfig.ShootSubresourceRateLimitBurst,
+			KubeInformerFactory:            c.kubeInformerFactory,
+			CoreInformerFactory:            c.coreInformerFactory,
+			SubjectAccessReviewer:          c.subjectAccessReviewer,
 		}).NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
 		seedManagementAPIGroupInfo = (seedmanagementrest.StorageProvider{}).NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
 		settingsAPIGroupInfo       = (settingsrest.StorageProvider{}).NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
@@ -131,6 +135,8 @@ type ExtraOptions struct {
 	AdminKubeconfigMaxExpiration       time.Duration
 	ViewerKubeconfigMaxExpiration      time.Duration
 	CredentialsRotationInterval        time.Duration
+	ShootSubresourceRateLimitQPS       float32
+	ShootSubresourceRateLimitBurst     int
 	WorkloadIdentityTokenIssuer        string
 	WorkloadIdentityTokenMinExpiration time.Duration
 	WorkloadIdentityTokenMaxExpiration time.Duration
@@ -162,6 +168,13 @@ func (o *ExtraOptions) Validate() []error {
 		allErrors = append(allErrors, errors.New("--shoot-credentials-rotation-interval must be between 24 hours and 2^32 seconds"))
 	}
 
+	if o.ShootSubresourceRateLimitQPS < 0 {
+		allErrors = append(allErrors, errors.New("--shoot-subresource-rate-limit-qps must not be negative"))
+	}
+	if o.ShootSubresourceRateLimitQPS > 0 && o.ShootSubresourceRateLimitBurst <= 0 {
+		allErrors = append(allErrors, errors.New("--shoot-subresource-rate-limit-burst must be positive when --shoot-subresource-rate-limit-qps is set"))
+	}
+
 	if len(o.WorkloadIdentityTokenIssuer) != 0 {
 		if _, err := url.Parse(o.WorkloadIdentityTokenIssuer); err != nil {
 			allErrors = append(allErrors, fmt.Errorf("--workload-identity-token-issuer is not a valid URL, err: %w", err))
@@ -200,6 +213,8 @@ func (o *ExtraOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.DurationVar(&o.AdminKubeconfigMaxExpiration, "shoot-admin-kubeconfig-max-expiration", time.Hour*24, "The maximum validity duration of a credential requested to a Shoot by an AdminKubeconfigRequest. If an otherwise valid AdminKubeconfigRequest with a validity duration larger than this value is requested, a credential will be issued with a validity duration of this value.")
 	fs.DurationVar(&o.ViewerKubeconfigMaxExpiration, "shoot-viewer-kubeconfig-max-expiration", time.Hour*24, "The maximum validity duration of a credential requested to a Shoot by an ViewerKubeconfigRequest. If an otherwise valid ViewerKubeconfigRequest with a validity duration larger than this value is requested, a credential will be issued with a validity duration of this value.")
 	fs.DurationVar(&o.CredentialsRotationInterval, "shoot-credentials-rotation-interval", time.Hour*24*90, "The duration after the initial shoot creation or the last credentials rotation when a client warning for the next credentials rotation is issued.")
+	fs.Float32Var(&o.ShootSubresourceRateLimitQPS, "shoot-subresource-rate-limit-qps", 0, "Maximum number of requests per second a single user may perform per project namespace to expensive Shoot subresources (adminkubeconfig, viewerkubeconfig, binding). Operators are not subject to this limit. A value of 0 disables the rate limiting.")
+	fs.IntVar(&o.ShootSubresourceRateLimitBurst, "shoot-subresource-rate-limit-burst", 10, "Maximum burst of requests a single user may perform per project namespace to expensive Shoot subresources before requests are rate limited with --shoot-subresource-rate-limit-qps.")
 	fs.StringVar(&o.WorkloadIdentityTokenIssuer, "workload-identity-token-issuer", o.WorkloadIdentityTokenIssuer, "The issuer identifier of the workload identity tokens set in the 'iss' claim. If set, it must be a valid URL")
 	fs.DurationVar(&o.WorkloadIdentityTokenMinExpiration, "workload-identity-token-min-expiration", time.Hour, "The minimum validity duration of a workload identity token. If an otherwise valid TokenRequest with a validity duration less than this value is requested, a token will be issued with a validity duration of this value.")
 	fs.DurationVar(&o.WorkloadIdentityTokenMaxExpiration, "workload-identity-token-max-expiration", time.Hour*48, "The maximum validity duration of a workload identity token. If an otherwise valid TokenRequest with a validity duration greater than this value is requested, a token will be issued with a validity duration of this value.")
@@ -214,6 +229,8 @@ func (o *ExtraOptions) ApplyTo(c *Config) error {
 	c.ExtraConfig.AdminKubeconfigMaxExpiration = o.AdminKubeconfigMaxExpiration
 	c.ExtraConfig.ViewerKubeconfigMaxExpiration = o.ViewerKubeconfigMaxExpiration
 	c.ExtraConfig.CredentialsRotationInterval = o.CredentialsRotationInterval
+	c.ExtraConfig.ShootSubresourceRateLimitQPS = o.ShootSubresourceRateLimitQPS
+	c.ExtraConfig.ShootSubresourceRateLimitBurst = o.ShootSubresourceRateLimitBurst
 	c.ExtraConfig.WorkloadIdentityTokenIssuer = o.WorkloadIdentityTokenIssuer
 	c.ExtraConfig.WorkloadIdentityTokenMinExpiration = o.WorkloadIdentityTokenMinExpiration
 	c.ExtraConfig.WorkloadIdentityTokenMaxExpiration = o.WorkloadIdentityTokenMaxExpiration
@@ -21,653 +21,688 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.AdminKubeconfigRequest":           schema_pkg_apis_authentication_v1alpha1_AdminKubeconfigRequest(ref),
-		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.AdminKubeconfigRequestSpec":       schema_pkg_apis_authentication_v1alpha1_AdminKubeconfigRequestSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.AdminKubeconfigRequestStatus":     schema_pkg_apis_authentication_v1alpha1_AdminKubeconfigRequestStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.ViewerKubeconfigRequest":          schema_pkg_apis_authentication_v1alpha1_ViewerKubeconfigRequest(ref),
-		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.ViewerKubeconfigRequestSpec":      schema_pkg_apis_authentication_v1alpha1_ViewerKubeconfigRequestSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.ViewerKubeconfigRequestStatus":    schema_pkg_apis_authentication_v1alpha1_ViewerKubeconfigRequestStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1.ControllerDeployment":                             schema_pkg_apis_core_v1_ControllerDeployment(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1.ControllerDeploymentList":                         schema_pkg_apis_core_v1_ControllerDeploymentList(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1.HelmControllerDeployment":                         schema_pkg_apis_core_v1_HelmControllerDeployment(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1.OCIRepository":                                    schema_pkg_apis_core_v1_OCIRepository(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerLogging":                            schema_pkg_apis_core_v1beta1_APIServerLogging(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerRequests":                           schema_pkg_apis_core_v1beta1_APIServerRequests(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AccessRestriction":                           schema_pkg_apis_core_v1beta1_AccessRestriction(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AccessRestrictionWithOptions":                schema_pkg_apis_core_v1beta1_AccessRestrictionWithOptions(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Addon":                                       schema_pkg_apis_core_v1beta1_Addon(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Addons":                                      schema_pkg_apis_core_v1beta1_Addons(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AdmissionPlugin":                             schema_pkg_apis_core_v1beta1_AdmissionPlugin(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Alerting":                                    schema_pkg_apis_core_v1beta1_Alerting(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditConfig":                                 schema_pkg_apis_core_v1beta1_AuditConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditPolicy":                                 schema_pkg_apis_core_v1beta1_AuditPolicy(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuthorizerKubeconfigReference":               schema_pkg_apis_core_v1beta1_AuthorizerKubeconfigReference(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AvailabilityZone":                            schema_pkg_apis_core_v1beta1_AvailabilityZone(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Backup":                                      schema_pkg_apis_core_v1beta1_Backup(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucket":                                schema_pkg_apis_core_v1beta1_BackupBucket(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketList":                            schema_pkg_apis_core_v1beta1_BackupBucketList(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketProvider":                        schema_pkg_apis_core_v1beta1_BackupBucketProvider(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketSpec":                            schema_pkg_apis_core_v1beta1_BackupBucketSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketStatus":                          schema_pkg_apis_core_v1beta1_BackupBucketStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupEntry":                                 schema_pkg_apis_core_v1beta1_BackupEntry(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupEntryList":                             schema_pkg_apis_core_v1beta1_BackupEntryList(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupEntrySpec":                             schema_pkg_apis_core_v1beta1_BackupEntrySpec(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupEntryStatus":                           schema_pkg_apis_core_v1beta1_BackupEntryStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Bastion":                                     schema_pkg_apis_core_v1beta1_Bastion(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BastionMachineImage":                         schema_pkg_apis_core_v1beta1_BastionMachineImage(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BastionMachineType":                          schema_pkg_apis_core_v1beta1_BastionMachineType(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CARotation":                                  schema_pkg_apis_core_v1beta1_CARotation(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CRI":                                         schema_pkg_apis_core_v1beta1_CRI(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CapabilityDefinition":                        schema_pkg_apis_core_v1beta1_CapabilityDefinition(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfile":                                schema_pkg_apis_core_v1beta1_CloudProfile(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileList":                            schema_pkg_apis_core_v1beta1_CloudProfileList(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileReference":                       schema_pkg_apis_core_v1beta1_CloudProfileReference(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileSpec":                            schema_pkg_apis_core_v1beta1_CloudProfileSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscaler":                           schema_pkg_apis_core_v1beta1_ClusterAutoscaler(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscalerOptions":                    schema_pkg_apis_core_v1beta1_ClusterAutoscalerOptions(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition":                                   schema_pkg_apis_core_v1beta1_Condition(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ContainerRuntime":                            schema_pkg_apis_core_v1beta1_ContainerRuntime(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlane":                                schema_pkg_apis_core_v1beta1_ControlPlane(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlaneAutoscaling":                     schema_pkg_apis_core_v1beta1_ControlPlaneAutoscaling(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerDeployment":                        schema_pkg_apis_core_v1beta1_ControllerDeployment(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerDeploymentList":                    schema_pkg_apis_core_v1beta1_ControllerDeploymentList(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerInstallation":                      schema_pkg_apis_core_v1beta1_ControllerInstallation(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerInstallationList":                  schema_pkg_apis_core_v1beta1_ControllerInstallationList(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerInstallationSpec":                  schema_pkg_apis_core_v1beta1_ControllerInstallationSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerInstallationStatus":                schema_pkg_apis_core_v1beta1_ControllerInstallationStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerRegistration":                      schema_pkg_apis_core_v1beta1_ControllerRegistration(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerRegistrationDeployment":            schema_pkg_apis_core_v1beta1_ControllerRegistrationDeployment(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerRegistrationList":                  schema_pkg_apis_core_v1beta1_ControllerRegistrationList(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerRegistrationSpec":                  schema_pkg_apis_core_v1beta1_ControllerRegistrationSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerResource":                          schema_pkg_apis_core_v1beta1_ControllerResource(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerResourceLifecycle":                 schema_pkg_apis_core_v1beta1_ControllerResourceLifecycle(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNS":                                     schema_pkg_apis_core_v1beta1_CoreDNS(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSAutoscaling":                          schema_pkg_apis_core_v1beta1_CoreDNSAutoscaling(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSRewriting":                            schema_pkg_apis_core_v1beta1_CoreDNSRewriting(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DNS":                                         schema_pkg_apis_core_v1beta1_DNS(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DNSIncludeExclude":                           schema_pkg_apis_core_v1beta1_DNSIncludeExclude(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DNSProvider":                                 schema_pkg_apis_core_v1beta1_DNSProvider(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DataVolume":                                  schema_pkg_apis_core_v1beta1_DataVolume(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DeploymentRef":                               schema_pkg_apis_core_v1beta1_DeploymentRef(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DualApprovalForDeletion":                     schema_pkg_apis_core_v1beta1_DualApprovalForDeletion(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ETCD":                                        schema_pkg_apis_core_v1beta1_ETCD(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ETCDConfig":                                  schema_pkg_apis_core_v1beta1_ETCDConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ETCDEncryptionKeyRotation":                   schema_pkg_apis_core_v1beta1_ETCDEncryptionKeyRotation(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.EncryptionAtRest":                            schema_pkg_apis_core_v1beta1_EncryptionAtRest(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.EncryptionConfig":                            schema_pkg_apis_core_v1beta1_EncryptionConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExpirableVersion":                            schema_pkg_apis_core_v1beta1_ExpirableVersion(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExposureClass":                               schema_pkg_apis_core_v1beta1_ExposureClass(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExposureClassList":                           schema_pkg_apis_core_v1beta1_ExposureClassList(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExposureClassScheduling":                     schema_pkg_apis_core_v1beta1_ExposureClassScheduling(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Extension":                                   schema_pkg_apis_core_v1beta1_Extension(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExtensionResourceState":                      schema_pkg_apis_core_v1beta1_ExtensionResourceState(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.FailureTolerance":                            schema_pkg_apis_core_v1beta1_FailureTolerance(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener":                                    schema_pkg_apis_core_v1beta1_Gardener(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.GardenerResourceData":                        schema_pkg_apis_core_v1beta1_GardenerResourceData(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HelmControllerDeployment":                    schema_pkg_apis_core_v1beta1_HelmControllerDeployment(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Hibernation":                                 schema_pkg_apis_core_v1beta1_Hibernation(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationSchedule":                         schema_pkg_apis_core_v1beta1_HibernationSchedule(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HighAvailability":                            schema_pkg_apis_core_v1beta1_HighAvailability(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HorizontalPodAutoscalerConfig":               schema_pkg_apis_core_v1beta1_HorizontalPodAutoscalerConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.InPlaceUpdates":                              schema_pkg_apis_core_v1beta1_InPlaceUpdates(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.InPlaceUpdatesStatus":                        schema_pkg_apis_core_v1beta1_InPlaceUpdatesStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Ingress":                                     schema_pkg_apis_core_v1beta1_Ingress(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.IngressController":                           schema_pkg_apis_core_v1beta1_IngressController(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.InternalSecret":                              schema_pkg_apis_core_v1beta1_InternalSecret(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.InternalSecretList":                          schema_pkg_apis_core_v1beta1_InternalSecretList(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeAPIServerConfig":                         schema_pkg_apis_core_v1beta1_KubeAPIServerConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeControllerManagerConfig":                 schema_pkg_apis_core_v1beta1_KubeControllerManagerConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeProxyConfig":                             schema_pkg_apis_core_v1beta1_KubeProxyConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeSchedulerConfig":                         schema_pkg_apis_core_v1beta1_KubeSchedulerConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfig":                               schema_pkg_apis_core_v1beta1_KubeletConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigEviction":                       schema_pkg_apis_core_v1beta1_KubeletConfigEviction(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigEvictionMinimumReclaim":         schema_pkg_apis_core_v1beta1_KubeletConfigEvictionMinimumReclaim(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigEvictionSoftGracePeriod":        schema_pkg_apis_core_v1beta1_KubeletConfigEvictionSoftGracePeriod(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigReserved":                       schema_pkg_apis_core_v1beta1_KubeletConfigReserved(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Kubernetes":                                  schema_pkg_apis_core_v1beta1_Kubernetes(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesConfig":                            schema_pkg_apis_core_v1beta1_KubernetesConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesDashboard":                         schema_pkg_apis_core_v1beta1_KubernetesDashboard(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesSettings":                          schema_pkg_apis_core_v1beta1_KubernetesSettings(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError":                                   schema_pkg_apis_core_v1beta1_LastError(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.LastMaintenance":                             schema_pkg_apis_core_v1beta1_LastMaintenance(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation":                               schema_pkg_apis_core_v1beta1_LastOperation(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Limits":                                      schema_pkg_apis_core_v1beta1_Limits(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.LoadBalancerServicesProxyProtocol":           schema_pkg_apis_core_v1beta1_LoadBalancerServicesProxyProtocol(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Machine":                                     schema_pkg_apis_core_v1beta1_Machine(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineControllerManagerSettings":            schema_pkg_apis_core_v1beta1_MachineControllerManagerSettings(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImage":                                schema_pkg_apis_core_v1beta1_MachineImage(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImageFlavor":                          schema_pkg_apis_core_v1beta1_MachineImageFlavor(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImageVersion":                         schema_pkg_apis_core_v1beta1_MachineImageVersion(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineType":                                 schema_pkg_apis_core_v1beta1_MachineType(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineTypeStorage":                          schema_pkg_apis_core_v1beta1_MachineTypeStorage(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Maintenance":                                 schema_pkg_apis_core_v1beta1_Maintenance(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceAutoUpdate":                       schema_pkg_apis_core_v1beta1_MaintenanceAutoUpdate(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceTimeWindow":                       schema_pkg_apis_core_v1beta1_MaintenanceTimeWindow(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ManualWorkerPoolRollout":                     schema_pkg_apis_core_v1beta1_ManualWorkerPoolRollout(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MemorySwapConfiguration":                     schema_pkg_apis_core_v1beta1_MemorySwapConfiguration(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Monitoring":                                  schema_pkg_apis_core_v1beta1_Monitoring(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamedResourceReference":                      schema_pkg_apis_core_v1beta1_NamedResourceReference(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespacedCloudProfile":                      schema_pkg_apis_core_v1beta1_NamespacedCloudProfile(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespacedCloudProfileList":                  schema_pkg_apis_core_v1beta1_NamespacedCloudProfileList(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespacedCloudProfileSpec":                  schema_pkg_apis_core_v1beta1_NamespacedCloudProfileSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespacedCloudProfileStatus":                schema_pkg_apis_core_v1beta1_NamespacedCloudProfileStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Networking":                                  schema_pkg_apis_core_v1beta1_Networking(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkingStatus":                            schema_pkg_apis_core_v1beta1_NetworkingStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NginxIngress":                                schema_pkg_apis_core_v1beta1_NginxIngress(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NodeLocalDNS":                                schema_pkg_apis_core_v1beta1_NodeLocalDNS(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.OCIRepository":                               schema_pkg_apis_core_v1beta1_OCIRepository(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.OIDCConfig":                                  schema_pkg_apis_core_v1beta1_OIDCConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ObservabilityRotation":                       schema_pkg_apis_core_v1beta1_ObservabilityRotation(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.OpenIDConnectClientAuthentication":           schema_pkg_apis_core_v1beta1_OpenIDConnectClientAuthentication(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.PendingWorkerUpdates":                        schema_pkg_apis_core_v1beta1_PendingWorkerUpdates(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.PendingWorkersRollout":                       schema_pkg_apis_core_v1beta1_PendingWorkersRollout(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Project":                                     schema_pkg_apis_core_v1beta1_Project(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectList":                                 schema_pkg_apis_core_v1beta1_ProjectList(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMember":                               schema_pkg_apis_core_v1beta1_ProjectMember(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectSpec":                                 schema_pkg_apis_core_v1beta1_ProjectSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectStatus":                               schema_pkg_apis_core_v1beta1_ProjectStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectTolerations":                          schema_pkg_apis_core_v1beta1_ProjectTolerations(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Provider":                                    schema_pkg_apis_core_v1beta1_Provider(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Quota":                                       schema_pkg_apis_core_v1beta1_Quota(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaList":                                   schema_pkg_apis_core_v1beta1_QuotaList(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaSpec":                                   schema_pkg_apis_core_v1beta1_QuotaSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Region":                                      schema_pkg_apis_core_v1beta1_Region(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ResourceData":                                schema_pkg_apis_core_v1beta1_ResourceData(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ResourceWatchCacheSize":                      schema_pkg_apis_core_v1beta1_ResourceWatchCacheSize(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SSHAccess":                                   schema_pkg_apis_core_v1beta1_SSHAccess(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SecretBinding":                               schema_pkg_apis_core_v1beta1_SecretBinding(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SecretBindingList":                           schema_pkg_apis_core_v1beta1_SecretBindingList(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SecretBindingProvider":                       schema_pkg_apis_core_v1beta1_SecretBindingProvider(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Seed":                                        schema_pkg_apis_core_v1beta1_Seed(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedDNS":                                     schema_pkg_apis_core_v1beta1_SeedDNS(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedDNSProvider":                             schema_pkg_apis_core_v1beta1_SeedDNSProvider(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedDNSProviderConfig":                       schema_pkg_apis_core_v1beta1_SeedDNSProviderConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedList":                                    schema_pkg_apis_core_v1beta1_SeedList(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedNetworks":                                schema_pkg_apis_core_v1beta1_SeedNetworks(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedProvider":                                schema_pkg_apis_core_v1beta1_SeedProvider(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSelector":                                schema_pkg_apis_core_v1beta1_SeedSelector(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdog":               schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdog(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdogProber":         schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdogProber(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdogWeeder":         schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdogWeeder(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingExcessCapacityReservation":        schema_pkg_apis_core_v1beta1_SeedSettingExcessCapacityReservation(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingExcessCapacityReservationConfig":  schema_pkg_apis_core_v1beta1_SeedSettingExcessCapacityReservationConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingLoadBalancerServices":             schema_pkg_apis_core_v1beta1_SeedSettingLoadBalancerServices(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingLoadBalancerServicesZonalIngress": schema_pkg_apis_core_v1beta1_SeedSettingLoadBalancerServicesZonalIngress(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingLoadBalancerServicesZones":        schema_pkg_apis_core_v1beta1_SeedSettingLoadBalancerServicesZones(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingScheduling":                       schema_pkg_apis_core_v1beta1_SeedSettingScheduling(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingTopologyAwareRouting":             schema_pkg_apis_core_v1beta1_SeedSettingTopologyAwareRouting(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingVerticalPodAutoscaler":            schema_pkg_apis_core_v1beta1_SeedSettingVerticalPodAutoscaler(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettings":                                schema_pkg_apis_core_v1beta1_SeedSettings(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSpec":                                    schema_pkg_apis_core_v1beta1_SeedSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedStatus":                                  schema_pkg_apis_core_v1beta1_SeedStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedTaint":                                   schema_pkg_apis_core_v1beta1_SeedTaint(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedTemplate":                                schema_pkg_apis_core_v1beta1_SeedTemplate(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedVolume":                                  schema_pkg_apis_core_v1beta1_SeedVolume(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedVolumeProvider":                          schema_pkg_apis_core_v1beta1_SeedVolumeProvider(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceAccountConfig":                        schema_pkg_apis_core_v1beta1_ServiceAccountConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceAccountKeyRotation":                   schema_pkg_apis_core_v1beta1_ServiceAccountKeyRotation(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Shoot":                                       schema_pkg_apis_core_v1beta1_Shoot(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAdvertisedAddress":                      schema_pkg_apis_core_v1beta1_ShootAdvertisedAddress(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentials":                            schema_pkg_apis_core_v1beta1_ShootCredentials(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentialsRotation":                    schema_pkg_apis_core_v1beta1_ShootCredentialsRotation(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootKubeconfigRotation":                     schema_pkg_apis_core_v1beta1_ShootKubeconfigRotation(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootList":                                   schema_pkg_apis_core_v1beta1_ShootList(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootMachineImage":                           schema_pkg_apis_core_v1beta1_ShootMachineImage(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootNetworks":                               schema_pkg_apis_core_v1beta1_ShootNetworks(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSSHKeypairRotation":                     schema_pkg_apis_core_v1beta1_ShootSSHKeypairRotation(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSpec":                                   schema_pkg_apis_core_v1beta1_ShootSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootState":                                  schema_pkg_apis_core_v1beta1_ShootState(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootStateList":                              schema_pkg_apis_core_v1beta1_ShootStateList(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootStateSpec":                              schema_pkg_apis_core_v1beta1_ShootStateSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootStatus":                                 schema_pkg_apis_core_v1beta1_ShootStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootTemplate":                               schema_pkg_apis_core_v1beta1_ShootTemplate(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.StructuredAuthentication":                    schema_pkg_apis_core_v1beta1_StructuredAuthentication(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.StructuredAuthorization":                     schema_pkg_apis_core_v1beta1_StructuredAuthorization(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SystemComponents":                            schema_pkg_apis_core_v1beta1_SystemComponents(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Toleration":                                  schema_pkg_apis_core_v1beta1_Toleration(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.VerticalPodAutoscaler":                       schema_pkg_apis_core_v1beta1_VerticalPodAutoscaler(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Volume":                                      schema_pkg_apis_core_v1beta1_Volume(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.VolumeType":                                  schema_pkg_apis_core_v1beta1_VolumeType(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WatchCacheSizes":                             schema_pkg_apis_core_v1beta1_WatchCacheSizes(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Worker":                                      schema_pkg_apis_core_v1beta1_Worker(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerControlPlane":                          schema_pkg_apis_core_v1beta1_WorkerControlPlane(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerKubernetes":                            schema_pkg_apis_core_v1beta1_WorkerKubernetes(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerSystemComponents":                      schema_pkg_apis_core_v1beta1_WorkerSystemComponents(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkersSettings":                             schema_pkg_apis_core_v1beta1_WorkersSettings(ref),
-		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.Bastion":                              schema_pkg_apis_operations_v1alpha1_Bastion(ref),
-		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionIngressPolicy":                 schema_pkg_apis_operations_v1alpha1_BastionIngressPolicy(ref),
-		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionList":                          schema_pkg_apis_operations_v1alpha1_BastionList(ref),
-		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionSpec":                          schema_pkg_apis_operations_v1alpha1_BastionSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionStatus":                        schema_pkg_apis_operations_v1alpha1_BastionStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.ContextObject":                          schema_pkg_apis_security_v1alpha1_ContextObject(ref),
-		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.CredentialsBinding":                     schema_pkg_apis_security_v1alpha1_CredentialsBinding(ref),
-		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.CredentialsBindingList":                 schema_pkg_apis_security_v1alpha1_CredentialsBindingList(ref),
-		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.CredentialsBindingProvider":             schema_pkg_apis_security_v1alpha1_CredentialsBindingProvider(ref),
-		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.TargetSystem":                           schema_pkg_apis_security_v1alpha1_TargetSystem(ref),
-		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.TokenRequest":                           schema_pkg_apis_security_v1alpha1_TokenRequest(ref),
-		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.TokenRequestSpec":                       schema_pkg_apis_security_v1alpha1_TokenRequestSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.TokenRequestStatus":                     schema_pkg_apis_security_v1alpha1_TokenRequestStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.WorkloadIdentity":                       schema_pkg_apis_security_v1alpha1_WorkloadIdentity(ref),
-		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.WorkloadIdentityList":                   schema_pkg_apis_security_v1alpha1_WorkloadIdentityList(ref),
-		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.WorkloadIdentitySpec":                   schema_pkg_apis_security_v1alpha1_WorkloadIdentitySpec(ref),
-		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.WorkloadIdentityStatus":                 schema_pkg_apis_security_v1alpha1_WorkloadIdentityStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Gardenlet":                        schema_pkg_apis_seedmanagement_v1alpha1_Gardenlet(ref),
-		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletConfig":                  schema_pkg_apis_seedmanagement_v1alpha1_GardenletConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletDeployment":              schema_pkg_apis_seedmanagement_v1alpha1_GardenletDeployment(ref),
-		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletHelm":                    schema_pkg_apis_seedmanagement_v1alpha1_GardenletHelm(ref),
-		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletList":                    schema_pkg_apis_seedmanagement_v1alpha1_GardenletList(ref),
-		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletSelfDeployment":          schema_pkg_apis_seedmanagement_v1alpha1_GardenletSelfDeployment(ref),
-		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletSpec":                    schema_pkg_apis_seedmanagement_v1alpha1_GardenletSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletStatus":                  schema_pkg_apis_seedmanagement_v1alpha1_GardenletStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Image":                            schema_pkg_apis_seedmanagement_v1alpha1_Image(ref),
-		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeed":                      schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeed(ref),
-		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedList":                  schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedList(ref),
-		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSet":                   schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSet(ref),
-		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSetList":               schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSetList(ref),
-		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSetSpec":               schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSetSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSetStatus":             schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSetStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSpec":                  schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedStatus":                schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedTemplate":              schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedTemplate(ref),
-		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.PendingReplica":                   schema_pkg_apis_seedmanagement_v1alpha1_PendingReplica(ref),
-		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.RollingUpdateStrategy":            schema_pkg_apis_seedmanagement_v1alpha1_RollingUpdateStrategy(ref),
-		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Shoot":                            schema_pkg_apis_seedmanagement_v1alpha1_Shoot(ref),
-		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.UpdateStrategy":                   schema_pkg_apis_seedmanagement_v1alpha1_UpdateStrategy(ref),
-		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterOpenIDConnectPreset":             schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPreset(ref),
-		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterOpenIDConnectPresetList":         schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPresetList(ref),
-		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterOpenIDConnectPresetSpec":         schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPresetSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.KubeAPIServerOpenIDConnect":             schema_pkg_apis_settings_v1alpha1_KubeAPIServerOpenIDConnect(ref),
-		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectClientAuthentication":      schema_pkg_apis_settings_v1alpha1_OpenIDConnectClientAuthentication(ref),
-		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectPreset":                    schema_pkg_apis_settings_v1alpha1_OpenIDConnectPreset(ref),
-		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectPresetList":                schema_pkg_apis_settings_v1alpha1_OpenIDConnectPresetList(ref),
-		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectPresetSpec":                schema_pkg_apis_settings_v1alpha1_OpenIDConnectPresetSpec(ref),
-		"k8s.io/api/autoscaling/v1.ContainerResourceMetricSource":                                        schema_k8sio_api_autoscaling_v1_ContainerResourceMetricSource(ref),
-		"k8s.io/api/autoscaling/v1.ContainerResourceMetricStatus":                                        schema_k8sio_api_autoscaling_v1_ContainerResourceMetricStatus(ref),
-		"k8s.io/api/autoscaling/v1.CrossVersionObjectReference":                                          schema_k8sio_api_autoscaling_v1_CrossVersionObjectReference(ref),
-		"k8s.io/api/autoscaling/v1.ExternalMetricSource":                                                 schema_k8sio_api_autoscaling_v1_ExternalMetricSource(ref),
-		"k8s.io/api/autoscaling/v1.ExternalMetricStatus":                                                 schema_k8sio_api_autoscaling_v1_ExternalMetricStatus(ref),
-		"k8s.io/api/autoscaling/v1.HorizontalPodAutoscaler":                                              schema_k8sio_api_autoscaling_v1_HorizontalPodAutoscaler(ref),
-		"k8s.io/api/autoscaling/v1.HorizontalPodAutoscalerCondition":                                     schema_k8sio_api_autoscaling_v1_HorizontalPodAutoscalerCondition(ref),
-		"k8s.io/api/autoscaling/v1.HorizontalPodAutoscalerList":                                          schema_k8sio_api_autoscaling_v1_HorizontalPodAutoscalerList(ref),
-		"k8s.io/api/autoscaling/v1.HorizontalPodAutoscalerSpec":                                          schema_k8sio_api_autoscaling_v1_HorizontalPodAutoscalerSpec(ref),
-		"k8s.io/api/autoscaling/v1.HorizontalPodAutoscalerStatus":                                        schema_k8sio_api_autoscaling_v1_HorizontalPodAutoscalerStatus(ref),
-		"k8s.io/api/autoscaling/v1.MetricSpec":                                                           schema_k8sio_api_autoscaling_v1_MetricSpec(ref),
-		"k8s.io/api/autoscaling/v1.MetricStatus":                                                         schema_k8sio_api_autoscaling_v1_MetricStatus(ref),
-		"k8s.io/api/autoscaling/v1.ObjectMetricSource":                                                   schema_k8sio_api_autoscaling_v1_ObjectMetricSource(ref),
-		"k8s.io/api/autoscaling/v1.ObjectMetricStatus":                                                   schema_k8sio_api_autoscaling_v1_ObjectMetricStatus(ref),
-		"k8s.io/api/autoscaling/v1.PodsMetricSource":                                                     schema_k8sio_api_autoscaling_v1_PodsMetricSource(ref),
-		"k8s.io/api/autoscaling/v1.PodsMetricStatus":                                                     schema_k8sio_api_autoscaling_v1_PodsMetricStatus(ref),
-		"k8s.io/api/autoscaling/v1.ResourceMetricSource":                                                 schema_k8sio_api_autoscaling_v1_ResourceMetricSource(ref),
-		"k8s.io/api/autoscaling/v1.ResourceMetricStatus":                                                 schema_k8sio_api_autoscaling_v1_ResourceMetricStatus(ref),
-		"k8s.io/api/autoscaling/v1.Scale":                                                                schema_k8sio_api_autoscaling_v1_Scale(ref),
-		"k8s.io/api/autoscaling/v1.ScaleSpec":                                                            schema_k8sio_api_autoscaling_v1_ScaleSpec(ref),
-		"k8s.io/api/autoscaling/v1.ScaleStatus":                                                          schema_k8sio_api_autoscaling_v1_ScaleStatus(ref),
-		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                            schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
-		"k8s.io/api/core/v1.Affinity":                                                                    schema_k8sio_api_core_v1_Affinity(ref),
-		"k8s.io/api/core/v1.AppArmorProfile":                                                             schema_k8sio_api_core_v1_AppArmorProfile(ref),
-		"k8s.io/api/core/v1.AttachedVolume":                                                              schema_k8sio_api_core_v1_AttachedVolume(ref),
-		"k8s.io/api/core/v1.AvoidPods":                                                                   schema_k8sio_api_core_v1_AvoidPods(ref),
-		"k8s.io/api/core/v1.AzureDiskVolumeSource":                                                       schema_k8sio_api_core_v1_AzureDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.AzureFilePersistentVolumeSource":                                             schema_k8sio_api_core_v1_AzureFilePersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.AzureFileVolumeSource":                                                       schema_k8sio_api_core_v1_AzureFileVolumeSource(ref),
-		"k8s.io/api/core/v1.Binding":                                                                     schema_k8sio_api_core_v1_Binding(ref),
-		"k8s.io/api/core/v1.CSIPersistentVolumeSource":                                                   schema_k8sio_api_core_v1_CSIPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.CSIVolumeSource":                                                             schema_k8sio_api_core_v1_CSIVolumeSource(ref),
-		"k8s.io/api/core/v1.Capabilities":                                                                schema_k8sio_api_core_v1_Capabilities(ref),
-		"k8s.io/api/core/v1.CephFSPersistentVolumeSource":                                                schema_k8sio_api_core_v1_CephFSPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.CephFSVolumeSource":                                                          schema_k8sio_api_core_v1_CephFSVolumeSource(ref),
-		"k8s.io/api/core/v1.CinderPersistentVolumeSource":                                                schema_k8sio_api_core_v1_CinderPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.CinderVolumeSource":                                                          schema_k8sio_api_core_v1_CinderVolumeSource(ref),
-		"k8s.io/api/core/v1.ClientIPConfig":                                                              schema_k8sio_api_core_v1_ClientIPConfig(ref),
-		"k8s.io/api/core/v1.ClusterTrustBundleProjection":                                                schema_k8sio_api_core_v1_ClusterTrustBundleProjection(ref),
-		"k8s.io/api/core/v1.ComponentCondition":                                                          schema_k8sio_api_core_v1_ComponentCondition(ref),
-		"k8s.io/api/core/v1.ComponentStatus":                                                             schema_k8sio_api_core_v1_ComponentStatus(ref),
-		"k8s.io/api/core/v1.ComponentStatusList":                                                         schema_k8sio_api_core_v1_ComponentStatusList(ref),
-		"k8s.io/api/core/v1.ConfigMap":                                                                   schema_k8sio_api_core_v1_ConfigMap(ref),
-		"k8s.io/api/core/v1.ConfigMapEnvSource":                                                          schema_k8sio_api_core_v1_ConfigMapEnvSource(ref),
-		"k8s.io/api/core/v1.ConfigMapKeySelector":                                                        schema_k8sio_api_core_v1_ConfigMapKeySelector(ref),
-		"k8s.io/api/core/v1.ConfigMapList":                                                               schema_k8sio_api_core_v1_ConfigMapList(ref),
-		"k8s.io/api/core/v1.ConfigMapNodeConfigSource":                                                   schema_k8sio_api_core_v1_ConfigMapNodeConfigSource(ref),
-		"k8s.io/api/core/v1.ConfigMapProjection":                                                         schema_k8sio_api_core_v1_ConfigMapProjection(ref),
-		"k8s.io/api/core/v1.ConfigMapVolumeSource":                                                       schema_k8sio_api_core_v1_ConfigMapVolumeSource(ref),
-		"k8s.io/api/core/v1.Container":                                                                   schema_k8sio_api_core_v1_Container(ref),
-		"k8s.io/api/core/v1.ContainerExtendedResourceRequest":                                            schema_k8sio_api_core_v1_ContainerExtendedResourceRequest(ref),
-		"k8s.io/api/core/v1.ContainerImage":                                                              schema_k8sio_api_core_v1_ContainerImage(ref),
-		"k8s.io/api/core/v1.ContainerPort":                                                               schema_k8sio_api_core_v1_ContainerPort(ref),
-		"k8s.io/api/core/v1.ContainerResizePolicy":                                                       schema_k8sio_api_core_v1_ContainerResizePolicy(ref),
-		"k8s.io/api/core/v1.ContainerRestartRule":                                                        schema_k8sio_api_core_v1_ContainerRestartRule(ref),
-		"k8s.io/api/core/v1.ContainerRestartRuleOnExitCodes":                                             schema_k8sio_api_core_v1_ContainerRestartRuleOnExitCodes(ref),
-		"k8s.io/api/core/v1.ContainerState":                                                              schema_k8sio_api_core_v1_ContainerState(ref),
-		"k8s.io/api/core/v1.ContainerStateRunning":                                                       schema_k8sio_api_core_v1_ContainerStateRunning(ref),
-		"k8s.io/api/core/v1.ContainerStateTerminated":                                                    schema_k8sio_api_core_v1_ContainerStateTerminated(ref),
-		"k8s.io/api/core/v1.ContainerStateWaiting":                                                       schema_k8sio_api_core_v1_ContainerStateWaiting(ref),
-		"k8s.io/api/core/v1.ContainerStatus":                                                             schema_k8sio_api_core_v1_ContainerStatus(ref),
-		"k8s.io/api/core/v1.ContainerUser":                                                               schema_k8sio_api_core_v1_ContainerUser(ref),
-		"k8s.io/api/core/v1.DaemonEndpoint":                                                              schema_k8sio_api_core_v1_DaemonEndpoint(ref),
-		"k8s.io/api/core/v1.DownwardAPIProjection":                                                       schema_k8sio_api_core_v1_DownwardAPIProjection(ref),
-		"k8s.io/api/core/v1.DownwardAPIVolumeFile":                                                       schema_k8sio_api_core_v1_DownwardAPIVolumeFile(ref),
-		"k8s.io/api/core/v1.DownwardAPIVolumeSource":                                                     schema_k8sio_api_core_v1_DownwardAPIVolumeSource(ref),
-		"k8s.io/api/core/v1.EmptyDirVolumeSource":                                                        schema_k8sio_api_core_v1_EmptyDirVolumeSource(ref),
-		"k8s.io/api/core/v1.EndpointAddress":                                                             schema_k8sio_api_core_v1_EndpointAddress(ref),
-		"k8s.io/api/core/v1.EndpointPort":                                                                schema_k8sio_api_core_v1_EndpointPort(ref),
-		"k8s.io/api/core/v1.EndpointSubset":                                                              schema_k8sio_api_core_v1_EndpointSubset(ref),
-		"k8s.io/api/core/v1.Endpoints":                                                                   schema_k8sio_api_core_v1_Endpoints(ref),
-		"k8s.io/api/core/v1.EndpointsList":                                                               schema_k8sio_api_core_v1_EndpointsList(ref),
-		"k8s.io/api/core/v1.EnvFromSource":                                                               schema_k8sio_api_core_v1_EnvFromSource(ref),
-		"k8s.io/api/core/v1.EnvVar":                                                                      schema_k8sio_api_core_v1_EnvVar(ref),
-		"k8s.io/api/core/v1.EnvVarSource":                                                                schema_k8sio_api_core_v1_EnvVarSource(ref),
-		"k8s.io/api/core/v1.EphemeralContainer":                                                          schema_k8sio_api_core_v1_EphemeralContainer(ref),
-		"k8s.io/api/core/v1.EphemeralContainerCommon":                                                    schema_k8sio_api_core_v1_EphemeralContainerCommon(ref),
-		"k8s.io/api/core/v1.EphemeralVolumeSource":                                                       schema_k8sio_api_core_v1_EphemeralVolumeSource(ref),
-		"k8s.io/api/core/v1.Event":                                                                       schema_k8sio_api_core_v1_Event(ref),
-		"k8s.io/api/core/v1.EventList":                                                                   schema_k8sio_api_core_v1_EventList(ref),
-		"k8s.io/api/core/v1.EventSeries":                                                                 schema_k8sio_api_core_v1_EventSeries(ref),
-		"k8s.io/api/core/v1.EventSource":                                                                 schema_k8sio_api_core_v1_EventSource(ref),
-		"k8s.io/api/core/v1.ExecAction":                                                                  schema_k8sio_api_core_v1_ExecAction(ref),
-		"k8s.io/api/core/v1.FCVolumeSource":                                                              schema_k8sio_api_core_v1_FCVolumeSource(ref),
-		"k8s.io/api/core/v1.FileKeySelector":                                                             schema_k8sio_api_core_v1_FileKeySelector(ref),
-		"k8s.io/api/core/v1.FlexPersistentVolumeSource":                                                  schema_k8sio_api_core_v1_FlexPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.FlexVolumeSource":                                                            schema_k8sio_api_core_v1_FlexVolumeSource(ref),
-		"k8s.io/api/core/v1.FlockerVolumeSource":                                                         schema_k8sio_api_core_v1_FlockerVolumeSource(ref),
-		"k8s.io/api/core/v1.GCEPersistentDiskVolumeSource":                                               schema_k8sio_api_core_v1_GCEPersistentDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.GRPCAction":                                                                  schema_k8sio_api_core_v1_GRPCAction(ref),
-		"k8s.io/api/core/v1.GitRepoVolumeSource":                                                         schema_k8sio_api_core_v1_GitRepoVolumeSource(ref),
-		"k8s.io/api/core/v1.GlusterfsPersistentVolumeSource":                                             schema_k8sio_api_core_v1_GlusterfsPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.GlusterfsVolumeSource":                                                       schema_k8sio_api_core_v1_GlusterfsVolumeSource(ref),
-		"k8s.io/api/core/v1.HTTPGetAction":                                                               schema_k8sio_api_core_v1_HTTPGetAction(ref),
-		"k8s.io/api/core/v1.HTTPHeader":                                                                  schema_k8sio_api_core_v1_HTTPHeader(ref),
-		"k8s.io/api/core/v1.HostAlias":                                                                   schema_k8sio_api_core_v1_HostAlias(ref),
-		"k8s.io/api/core/v1.HostIP":                                                                      schema_k8sio_api_core_v1_HostIP(ref),
-		"k8s.io/api/core/v1.HostPathVolumeSource":                                                        schema_k8sio_api_core_v1_HostPathVolumeSource(ref),
-		"k8s.io/api/core/v1.ISCSIPersistentVolumeSource":                                                 schema_k8sio_api_core_v1_ISCSIPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.ISCSIVolumeSource":                                                           schema_k8sio_api_core_v1_ISCSIVolumeSource(ref),
-		"k8s.io/api/core/v1.ImageVolumeSource":                                                           schema_k8sio_api_core_v1_ImageVolumeSource(ref),
-		"k8s.io/api/core/v1.KeyToPath":                                                                   schema_k8sio_api_core_v1_KeyToPath(ref),
-		"k8s.io/api/core/v1.Lifecycle":                                                                   schema_k8sio_api_core_v1_Lifecycle(ref),
-		"k8s.io/api/core/v1.LifecycleHandler":                                                            schema_k8sio_api_core_v1_LifecycleHandler(ref),
-		"k8s.io/api/core/v1.LimitRange":                                                                  schema_k8sio_api_core_v1_LimitRange(ref),
-		"k8s.io/api/core/v1.LimitRangeItem":                                                              schema_k8sio_api_core_v1_LimitRangeItem(ref),
-		"k8s.io/api/core/v1.LimitRangeList":                                                              schema_k8sio_api_core_v1_LimitRangeList(ref),
-		"k8s.io/api/core/v1.LimitRangeSpec":                                                              schema_k8sio_api_core_v1_LimitRangeSpec(ref),
-		"k8s.io/api/core/v1.LinuxContainerUser":                                                          schema_k8sio_api_core_v1_LinuxContainerUser(ref),
-		"k8s.io/api/core/v1.List":                                                                        schema_k8sio_api_core_v1_List(ref),
-		"k8s.io/api/core/v1.LoadBalancerIngress":                                                         schema_k8sio_api_core_v1_LoadBalancerIngress(ref),
-		"k8s.io/api/core/v1.LoadBalancerStatus":                                                          schema_k8sio_api_core_v1_LoadBalancerStatus(ref),
-		"k8s.io/api/core/v1.LocalObjectReference":                                                        schema_k8sio_api_core_v1_LocalObjectReference(ref),
-		"k8s.io/api/core/v1.LocalVolumeSource":                                                           schema_k8sio_api_core_v1_LocalVolumeSource(ref),
-		"k8s.io/api/core/v1.ModifyVolumeStatus":                                                          schema_k8sio_api_core_v1_ModifyVolumeStatus(ref),
-		"k8s.io/api/core/v1.NFSVolumeSource":                                                             schema_k8sio_api_core_v1_NFSVolumeSource(ref),
-		"k8s.io/api/core/v1.Namespace":                                                                   schema_k8sio_api_core_v1_Namespace(ref),
-		"k8s.io/api/core/v1.NamespaceCondition":                                                          schema_k8sio_api_core_v1_NamespaceCondition(ref),
-		"k8s.io/api/core/v1.NamespaceList":                                                               schema_k8sio_api_core_v1_NamespaceList(ref),
-		"k8s.io/api/core/v1.NamespaceSpec":                                                               schema_k8sio_api_core_v1_NamespaceSpec(ref),
-		"k8s.io/api/core/v1.NamespaceStatus":                                                             schema_k8sio_api_core_v1_NamespaceStatus(ref),
-		"k8s.io/api/core/v1.Node":                                                                        schema_k8sio_api_core_v1_Node(ref),
-		"k8s.io/api/core/v1.NodeAddress":                                                                 schema_k8sio_api_core_v1_NodeAddress(ref),
-		"k8s.io/api/core/v1.NodeAffinity":                                                                schema_k8sio_api_core_v1_NodeAffinity(ref),
-		"k8s.io/api/core/v1.NodeCondition":                                                               schema_k8sio_api_core_v1_NodeCondition(ref),
-		"k8s.io/api/core/v1.NodeConfigSource":                                                            schema_k8sio_api_core_v1_NodeConfigSource(ref),
-		"k8s.io/api/core/v1.NodeConfigStatus":                                                            schema_k8sio_api_core_v1_NodeConfigStatus(ref),
-		"k8s.io/api/core/v1.NodeDaemonEndpoints":                                                         schema_k8sio_api_core_v1_NodeDaemonEndpoints(ref),
-		"k8s.io/api/core/v1.NodeFeatures":                                                                schema_k8sio_api_core_v1_NodeFeatures(ref),
-		"k8s.io/api/core/v1.NodeList":                                                                    schema_k8sio_api_core_v1_NodeList(ref),
-		"k8s.io/api/core/v1.NodeProxyOptions":                                                            schema_k8sio_api_core_v1_NodeProxyOptions(ref),
-		"k8s.io/api/core/v1.NodeRuntimeHandler":                                                          schema_k8sio_api_core_v1_NodeRuntimeHandler(ref),
-		"k8s.io/api/core/v1.NodeRuntimeHandlerFeatures":                                                  schema_k8sio_api_core_v1_NodeRuntimeHandlerFeatures(ref),
-		"k8s.io/api/core/v1.NodeSelector":                                                                schema_k8sio_api_core_v1_NodeSelector(ref),
-		"k8s.io/api/core/v1.NodeSelectorRequirement":                                                     schema_k8sio_api_core_v1_NodeSelectorRequirement(ref),
-		"k8s.io/api/core/v1.NodeSelectorTerm":                                                            schema_k8sio_api_core_v1_NodeSelectorTerm(ref),
-		"k8s.io/api/core/v1.NodeSpec":                                                                    schema_k8sio_api_core_v1_NodeSpec(ref),
-		"k8s.io/api/core/v1.NodeStatus":                                                                  schema_k8sio_api_core_v1_NodeStatus(ref),
-		"k8s.io/api/core/v1.NodeSwapStatus":                                                              schema_k8sio_api_core_v1_NodeSwapStatus(ref),
-		"k8s.io/api/core/v1.NodeSystemInfo":                                                              schema_k8sio_api_core_v1_NodeSystemInfo(ref),
-		"k8s.io/api/core/v1.ObjectFieldSelector":                                                         schema_k8sio_api_core_v1_ObjectFieldSelector(ref),
-		"k8s.io/api/core/v1.ObjectReference":                                                             schema_k8sio_api_core_v1_ObjectReference(ref),
-		"k8s.io/api/core/v1.PersistentVolume":                                                            schema_k8sio_api_core_v1_PersistentVolume(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaim":                                                       schema_k8sio_api_core_v1_PersistentVolumeClaim(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimCondition":                                              schema_k8sio_api_core_v1_PersistentVolumeClaimCondition(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimList":                                                   schema_k8sio_api_core_v1_PersistentVolumeClaimList(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimSpec":                                                   schema_k8sio_api_core_v1_PersistentVolumeClaimSpec(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimStatus":                                                 schema_k8sio_api_core_v1_PersistentVolumeClaimStatus(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimTemplate":                                               schema_k8sio_api_core_v1_PersistentVolumeClaimTemplate(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimVolumeSource":                                           schema_k8sio_api_core_v1_PersistentVolumeClaimVolumeSource(ref),
-		"k8s.io/api/core/v1.PersistentVolumeList":                                                        schema_k8sio_api_core_v1_PersistentVolumeList(ref),
-		"k8s.io/api/core/v1.PersistentVolumeSource":                                                      schema_k8sio_api_core_v1_PersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.PersistentVolumeSpec":                                                        schema_k8sio_api_core_v1_PersistentVolumeSpec(ref),
-		"k8s.io/api/core/v1.PersistentVolumeStatus":                                                      schema_k8sio_api_core_v1_PersistentVolumeStatus(ref),
-		"k8s.io/api/core/v1.PhotonPersistentDiskVolumeSource":                                            schema_k8sio_api_core_v1_PhotonPersistentDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.Pod":                                                                         schema_k8sio_api_core_v1_Pod(ref),
-		"k8s.io/api/core/v1.PodAffinity":                                                                 schema_k8sio_api_core_v1_PodAffinity(ref),
-		"k8s.io/api/core/v1.PodAffinityTerm":                                                             schema_k8sio_api_core_v1_PodAffinityTerm(ref),
-		"k8s.io/api/core/v1.PodAntiAffinity":                                                             schema_k8sio_api_core_v1_PodAntiAffinity(ref),
-		"k8s.io/api/core/v1.PodAttachOptions":                                                            schema_k8sio_api_core_v1_PodAttachOptions(ref),
-		"k8s.io/api/core/v1.PodCertificateProjection":                                                    schema_k8sio_api_core_v1_PodCertificateProjection(ref),
-		"k8s.io/api/core/v1.PodCondition":                                                                schema_k8sio_api_core_v1_PodCondition(ref),
-		"k8s.io/api/core/v1.PodDNSConfig":                                                                schema_k8sio_api_core_v1_PodDNSConfig(ref),
-		"k8s.io/api/core/v1.PodDNSConfigOption":                                                          schema_k8sio_api_core_v1_PodDNSConfigOption(ref),
-		"k8s.io/api/core/v1.PodExecOptions":                                                              schema_k8sio_api_core_v1_PodExecOptions(ref),
-		"k8s.io/api/core/v1.PodExtendedResourceClaimStatus":                                              schema_k8sio_api_core_v1_PodExtendedResourceClaimStatus(ref),
-		"k8s.io/api/core/v1.PodIP":                                                                       schema_k8sio_api_core_v1_PodIP(ref),
-		"k8s.io/api/core/v1.PodList":                                                                     schema_k8sio_api_core_v1_PodList(ref),
-		"k8s.io/api/core/v1.PodLogOptions":                                                               schema_k8sio_api_core_v1_PodLogOptions(ref),
-		"k8s.io/api/core/v1.PodOS":                                                                       schema_k8sio_api_core_v1_PodOS(ref),
-		"k8s.io/api/core/v1.PodPortForwardOptions":                                                       schema_k8sio_api_core_v1_PodPortForwardOptions(ref),
-		"k8s.io/api/core/v1.PodProxyOptions":                                                             schema_k8sio_api_core_v1_PodProxyOptions(ref),
-		"k8s.io/api/core/v1.PodReadinessGate":                                                            schema_k8sio_api_core_v1_PodReadinessGate(ref),
-		"k8s.io/api/core/v1.PodResourceClaim":                                                            schema_k8sio_api_core_v1_PodResourceClaim(ref),
-		"k8s.io/api/core/v1.PodResourceClaimStatus":                                                      schema_k8sio_api_core_v1_PodResourceClaimStatus(ref),
-		"k8s.io/api/core/v1.PodSchedulingGate":                                                           schema_k8sio_api_core_v1_PodSchedulingGate(ref),
-		"k8s.io/api/core/v1.PodSecurityContext":                                                          schema_k8sio_api_core_v1_PodSecurityContext(ref),
-		"k8s.io/api/core/v1.PodSignature":                                                                schema_k8sio_api_core_v1_PodSignature(ref),
-		"k8s.io/api/core/v1.PodSpec":                                                                     schema_k8sio_api_core_v1_PodSpec(ref),
-		"k8s.io/api/core/v1.PodStatus":                                                                   schema_k8sio_api_core_v1_PodStatus(ref),
-		"k8s.io/api/core/v1.PodStatusResult":                                                             schema_k8sio_api_core_v1_PodStatusResult(ref),
-		"k8s.io/api/core/v1.PodTemplate":                                                                 schema_k8sio_api_core_v1_PodTemplate(ref),
-		"k8s.io/api/core/v1.PodTemplateList":                                                             schema_k8sio_api_core_v1_PodTemplateList(ref),
-		"k8s.io/api/core/v1.PodTemplateSpec":                                                             schema_k8sio_api_core_v1_PodTemplateSpec(ref),
-		"k8s.io/api/core/v1.PortStatus":                                                                  schema_k8sio_api_core_v1_PortStatus(ref),
-		"k8s.io/api/core/v1.PortworxVolumeSource":                                                        schema_k8sio_api_core_v1_PortworxVolumeSource(ref),
-		"k8s.io/api/core/v1.PreferAvoidPodsEntry":                                                        schema_k8sio_api_core_v1_PreferAvoidPodsEntry(ref),
-		"k8s.io/api/core/v1.PreferredSchedulingTerm":                                                     schema_k8sio_api_core_v1_PreferredSchedulingTerm(ref),
-		"k8s.io/api/core/v1.Probe":                                                                       schema_k8sio_api_core_v1_Probe(ref),
-		"k8s.io/api/core/v1.ProbeHandler":                                                                schema_k8sio_api_core_v1_ProbeHandler(ref),
-		"k8s.io/api/core/v1.ProjectedVolumeSource":                                                       schema_k8sio_api_core_v1_ProjectedVolumeSource(ref),
-		"k8s.io/api/core/v1.QuobyteVolumeSource":                                                         schema_k8sio_api_core_v1_QuobyteVolumeSource(ref),
-		"k8s.io/api/core/v1.RBDPersistentVolumeSource":                                                   schema_k8sio_api_core_v1_RBDPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.RBDVolumeSource":                                                             schema_k8sio_api_core_v1_RBDVolumeSource(ref),
-		"k8s.io/api/core/v1.RangeAllocation":                                                             schema_k8sio_api_core_v1_RangeAllocation(ref),
-		"k8s.io/api/core/v1.ReplicationController":                                                       schema_k8sio_api_core_v1_ReplicationController(ref),
-		"k8s.io/api/core/v1.ReplicationControllerCondition":                                              schema_k8sio_api_core_v1_ReplicationControllerCondition(ref),
-		"k8s.io/api/core/v1.ReplicationControllerList":                                                   schema_k8sio_api_core_v1_ReplicationControllerList(ref),
-		"k8s.io/api/core/v1.ReplicationControllerSpec":                                                   schema_k8sio_api_core_v1_ReplicationControllerSpec(ref),
-		"k8s.io/api/core/v1.ReplicationControllerStatus":                                                 schema_k8sio_api_core_v1_ReplicationControllerStatus(ref),
-		"k8s.io/api/core/v1.ResourceClaim":                                                               schema_k8sio_api_core_v1_ResourceClaim(ref),
-		"k8s.io/api/core/v1.ResourceFieldSelector":                                                       schema_k8sio_api_core_v1_ResourceFieldSelector(ref),
-		"k8s.io/api/core/v1.ResourceHealth":                                                              schema_k8sio_api_core_v1_ResourceHealth(ref),
-		"k8s.io/api/core/v1.ResourceQuota":                                                               schema_k8sio_api_core_v1_ResourceQuota(ref),
-		"k8s.io/api/core/v1.ResourceQuotaList":                                                           schema_k8sio_api_core_v1_ResourceQuotaList(ref),
-		"k8s.io/api/core/v1.ResourceQuotaSpec":                                                           schema_k8sio_api_core_v1_ResourceQuotaSpec(ref),
-		"k8s.io/api/core/v1.ResourceQuotaStatus":                                                         schema_k8sio_api_core_v1_ResourceQuotaStatus(ref),
-		"k8s.io/api/core/v1.ResourceRequirements":                                                        schema_k8sio_api_core_v1_ResourceRequirements(ref),
-		"k8s.io/api/core/v1.ResourceStatus":                                                              schema_k8sio_api_core_v1_ResourceStatus(ref),
-		"k8s.io/api/core/v1.SELinuxOptions":                                                              schema_k8sio_api_core_v1_SELinuxOptions(ref),
-		"k8s.io/api/core/v1.ScaleIOPersistentVolumeSource":                                               schema_k8sio_api_core_v1_ScaleIOPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.ScaleIOVolumeSource":                                                         schema_k8sio_api_core_v1_ScaleIOVolumeSource(ref),
-		"k8s.io/api/core/v1.ScopeSelector":                                                               schema_k8sio_api_core_v1_ScopeSelector(ref),
-		"k8s.io/api/core/v1.ScopedResourceSelectorRequirement":                                           schema_k8sio_api_core_v1_ScopedResourceSelectorRequirement(ref),
-		"k8s.io/api/core/v1.SeccompProfile":                                                              schema_k8sio_api_core_v1_SeccompProfile(ref),
-		"k8s.io/api/core/v1.Secret":                                                                      schema_k8sio_api_core_v1_Secret(ref),
-		"k8s.io/api/core/v1.SecretEnvSource":                                                             schema_k8sio_api_core_v1_SecretEnvSource(ref),
-		"k8s.io/api/core/v1.SecretKeySelector":                                                           schema_k8sio_api_core_v1_SecretKeySelector(ref),
-		"k8s.io/api/core/v1.SecretList":                                                                  schema_k8sio_api_core_v1_SecretList(ref),
-		"k8s.io/api/core/v1.SecretProjection":                                                            schema_k8sio_api_core_v1_SecretProjection(ref),
-		"k8s.io/api/core/v1.SecretReference":                                                             schema_k8sio_api_core_v1_SecretReference(ref),
-		"k8s.io/api/core/v1.SecretVolumeSource":                                                          schema_k8sio_api_core_v1_SecretVolumeSource(ref),
-		"k8s.io/api/core/v1.SecurityContext":                                                             schema_k8sio_api_core_v1_SecurityContext(ref),
-		"k8s.io/api/core/v1.SerializedReference":                                                         schema_k8sio_api_core_v1_SerializedReference(ref),
-		"k8s.io/api/core/v1.Service":                                                                     schema_k8sio_api_core_v1_Service(ref),
-		"k8s.io/api/core/v1.ServiceAccount":                                                              schema_k8sio_api_core_v1_ServiceAccount(ref),
-		"k8s.io/api/core/v1.ServiceAccountList":                                                          schema_k8sio_api_core_v1_ServiceAccountList(ref),
-		"k8s.io/api/core/v1.ServiceAccountTokenProjection":                                               schema_k8sio_api_core_v1_ServiceAccountTokenProjection(ref),
-		"k8s.io/api/core/v1.ServiceList":                                                                 schema_k8sio_api_core_v1_ServiceList(ref),
-		"k8s.io/api/core/v1.ServicePort":                                                                 schema_k8sio_api_core_v1_ServicePort(ref),
-		"k8s.io/api/core/v1.ServiceProxyOptions":                                                         schema_k8sio_api_core_v1_ServiceProxyOptions(ref),
-		"k8s.io/api/core/v1.ServiceSpec":                                                                 schema_k8sio_api_core_v1_ServiceSpec(ref),
-		"k8s.io/api/core/v1.ServiceStatus":                                                               schema_k8sio_api_core_v1_ServiceStatus(ref),
-		"k8s.io/api/core/v1.SessionAffinityConfig":                                                       schema_k8sio_api_core_v1_SessionAffinityConfig(ref),
-		"k8s.io/api/core/v1.SleepAction":                                                                 schema_k8sio_api_core_v1_SleepAction(ref),
-		"k8s.io/api/core/v1.StorageOSPersistentVolumeSource":                                             schema_k8sio_api_core_v1_StorageOSPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.StorageOSVolumeSource":                                                       schema_k8sio_api_core_v1_StorageOSVolumeSource(ref),
-		"k8s.io/api/core/v1.Sysctl":                                                                      schema_k8sio_api_core_v1_Sysctl(ref),
-		"k8s.io/api/core/v1.TCPSocketAction":                                                             schema_k8sio_api_core_v1_TCPSocketAction(ref),
-		"k8s.io/api/core/v1.Taint":                                                                       schema_k8sio_api_core_v1_Taint(ref),
-		"k8s.io/api/core/v1.Toleration":                                                                  schema_k8sio_api_core_v1_Toleration(ref),
-		"k8s.io/api/core/v1.TopologySelectorLabelRequirement":                                            schema_k8sio_api_core_v1_TopologySelectorLabelRequirement(ref),
-		"k8s.io/api/core/v1.TopologySelectorTerm":                                                        schema_k8sio_api_core_v1_TopologySelectorTerm(ref),
-		"k8s.io/api/core/v1.TopologySpreadConstraint":                                                    schema_k8sio_api_core_v1_TopologySpreadConstraint(ref),
-		"k8s.io/api/core/v1.TypedLocalObjectReference":                                                   schema_k8sio_api_core_v1_TypedLocalObjectReference(ref),
-		"k8s.io/api/core/v1.TypedObjectReference":                                                        schema_k8sio_api_core_v1_TypedObjectReference(ref),
-		"k8s.io/api/core/v1.Volume":                                                                      schema_k8sio_api_core_v1_Volume(ref),
-		"k8s.io/api/core/v1.VolumeDevice":                                                                schema_k8sio_api_core_v1_VolumeDevice(ref),
-		"k8s.io/api/core/v1.VolumeMount":                                                                 schema_k8sio_api_core_v1_VolumeMount(ref),
-		"k8s.io/api/core/v1.VolumeMountStatus":                                                           schema_k8sio_api_core_v1_VolumeMountStatus(ref),
-		"k8s.io/api/core/v1.VolumeNodeAffinity":                                                          schema_k8sio_api_core_v1_VolumeNodeAffinity(ref),
-		"k8s.io/api/core/v1.VolumeProjection":                                                            schema_k8sio_api_core_v1_VolumeProjection(ref),
-		"k8s.io/api/core/v1.VolumeResourceRequirements":                                                  schema_k8sio_api_core_v1_VolumeResourceRequirements(ref),
-		"k8s.io/api/core/v1.VolumeSource":                                                                schema_k8sio_api_core_v1_VolumeSource(ref),
-		"k8s.io/api/core/v1.VsphereVirtualDiskVolumeSource":                                              schema_k8sio_api_core_v1_VsphereVirtualDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.WeightedPodAffinityTerm":                                                     schema_k8sio_api_core_v1_WeightedPodAffinityTerm(ref),
-		"k8s.io/api/core/v1.WindowsSecurityContextOptions":                                               schema_k8sio_api_core_v1_WindowsSecurityContextOptions(ref),
-		"k8s.io/api/networking/v1.HTTPIngressPath":                                                       schema_k8sio_api_networking_v1_HTTPIngressPath(ref),
-		"k8s.io/api/networking/v1.HTTPIngressRuleValue":                                                  schema_k8sio_api_networking_v1_HTTPIngressRuleValue(ref),
-		"k8s.io/api/networking/v1.IPAddress":                                                             schema_k8sio_api_networking_v1_IPAddress(ref),
-		"k8s.io/api/networking/v1.IPAddressList":                                                         schema_k8sio_api_networking_v1_IPAddressList(ref),
-		"k8s.io/api/networking/v1.IPAddressSpec":                                                         schema_k8sio_api_networking_v1_IPAddressSpec(ref),
-		"k8s.io/api/networking/v1.IPBlock":                                                               schema_k8sio_api_networking_v1_IPBlock(ref),
-		"k8s.io/api/networking/v1.Ingress":                                                               schema_k8sio_api_networking_v1_Ingress(ref),
-		"k8s.io/api/networking/v1.IngressBackend":                                                        schema_k8sio_api_networking_v1_IngressBackend(ref),
-		"k8s.io/api/networking/v1.IngressClass":                                                          schema_k8sio_api_networking_v1_IngressClass(ref),
-		"k8s.io/api/networking/v1.IngressClassList":                                                      schema_k8sio_api_networking_v1_IngressClassList(ref),
-		"k8s.io/api/networking/v1.IngressClassParametersReference":                                       schema_k8sio_api_networking_v1_IngressClassParametersReference(ref),
-		"k8s.io/api/networking/v1.IngressClassSpec":                                                      schema_k8sio_api_networking_v1_IngressClassSpec(ref),
-		"k8s.io/api/networking/v1.IngressList":                                                           schema_k8sio_api_networking_v1_IngressList(ref),
-		"k8s.io/api/networking/v1.IngressLoadBalancerIngress":                                            schema_k8sio_api_networking_v1_IngressLoadBalancerIngress(ref),
-		"k8s.io/api/networking/v1.IngressLoadBalancerStatus":                                             schema_k8sio_api_networking_v1_IngressLoadBalancerStatus(ref),
-		"k8s.io/api/networking/v1.IngressPortStatus":                                                     schema_k8sio_api_networking_v1_IngressPortStatus(ref),
-		"k8s.io/api/networking/v1.IngressRule":                                                           schema_k8sio_api_networking_v1_IngressRule(ref),
-		"k8s.io/api/networking/v1.IngressRuleValue":                                                      schema_k8sio_api_networking_v1_IngressRuleValue(ref),
-		"k8s.io/api/networking/v1.IngressServiceBackend":                                                 schema_k8sio_api_networking_v1_IngressServiceBackend(ref),
-		"k8s.io/api/networking/v1.IngressSpec":                                                           schema_k8sio_api_networking_v1_IngressSpec(ref),
-		"k8s.io/api/networking/v1.IngressStatus":                                                         schema_k8sio_api_networking_v1_IngressStatus(ref),
-		"k8s.io/api/networking/v1.IngressTLS":                                                            schema_k8sio_api_networking_v1_IngressTLS(ref),
-		"k8s.io/api/networking/v1.NetworkPolicy":                                                         schema_k8sio_api_networking_v1_NetworkPolicy(ref),
-		"k8s.io/api/networking/v1.NetworkPolicyEgressRule":                                               schema_k8sio_api_networking_v1_NetworkPolicyEgressRule(ref),
-		"k8s.io/api/networking/v1.NetworkPolicyIngressRule":                                              schema_k8sio_api_networking_v1_NetworkPolicyIngressRule(ref),
-		"k8s.io/api/networking/v1.NetworkPolicyList":                                                     schema_k8sio_api_networking_v1_NetworkPolicyList(ref),
-		"k8s.io/api/networking/v1.NetworkPolicyPeer":                                                     schema_k8sio_api_networking_v1_NetworkPolicyPeer(ref),
-		"k8s.io/api/networking/v1.NetworkPolicyPort":                                                     schema_k8sio_api_networking_v1_NetworkPolicyPort(ref),
-		"k8s.io/api/networking/v1.NetworkPolicySpec":                                                     schema_k8sio_api_networking_v1_NetworkPolicySpec(ref),
-		"k8s.io/api/networking/v1.ParentReference":                                                       schema_k8sio_api_networking_v1_ParentReference(ref),
-		"k8s.io/api/networking/v1.ServiceBackendPort":                                                    schema_k8sio_api_networking_v1_ServiceBackendPort(ref),
-		"k8s.io/api/networking/v1.ServiceCIDR":                                                           schema_k8sio_api_networking_v1_ServiceCIDR(ref),
-		"k8s.io/api/networking/v1.ServiceCIDRList":                                                       schema_k8sio_api_networking_v1_ServiceCIDRList(ref),
-		"k8s.io/api/networking/v1.ServiceCIDRSpec":                                                       schema_k8sio_api_networking_v1_ServiceCIDRSpec(ref),
-		"k8s.io/api/networking/v1.ServiceCIDRStatus":                                                     schema_k8sio_api_networking_v1_ServiceCIDRStatus(ref),
-		"k8s.io/api/rbac/v1.AggregationRule":                                                             schema_k8sio_api_rbac_v1_AggregationRule(ref),
-		"k8s.io/api/rbac/v1.ClusterRole":                                                                 schema_k8sio_api_rbac_v1_ClusterRole(ref),
-		"k8s.io/api/rbac/v1.ClusterRoleBinding":                                                          schema_k8sio_api_rbac_v1_ClusterRoleBinding(ref),
-		"k8s.io/api/rbac/v1.ClusterRoleBindingList":                                                      schema_k8sio_api_rbac_v1_ClusterRoleBindingList(ref),
-		"k8s.io/api/rbac/v1.ClusterRoleList":                                                             schema_k8sio_api_rbac_v1_ClusterRoleList(ref),
-		"k8s.io/api/rbac/v1.PolicyRule":                                                                  schema_k8sio_api_rbac_v1_PolicyRule(ref),
-		"k8s.io/api/rbac/v1.Role":                                                                        schema_k8sio_api_rbac_v1_Role(ref),
-		"k8s.io/api/rbac/v1.RoleBinding":                                                                 schema_k8sio_api_rbac_v1_RoleBinding(ref),
-		"k8s.io/api/rbac/v1.RoleBindingList":                                                             schema_k8sio_api_rbac_v1_RoleBindingList(ref),
-		"k8s.io/api/rbac/v1.RoleList":                                                                    schema_k8sio_api_rbac_v1_RoleList(ref),
-		"k8s.io/api/rbac/v1.RoleRef":                                                                     schema_k8sio_api_rbac_v1_RoleRef(ref),
-		"k8s.io/api/rbac/v1.Subject":                                                                     schema_k8sio_api_rbac_v1_Subject(ref),
-		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.ConversionRequest":                     schema_pkg_apis_apiextensions_v1_ConversionRequest(ref),
-		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.ConversionResponse":                    schema_pkg_apis_apiextensions_v1_ConversionResponse(ref),
-		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.ConversionReview":                      schema_pkg_apis_apiextensions_v1_ConversionReview(ref),
-		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.CustomResourceColumnDefinition":        schema_pkg_apis_apiextensions_v1_CustomResourceColumnDefinition(ref),
-		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.CustomResourceConversion":              schema_pkg_apis_apiextensions_v1_CustomResourceConversion(ref),
-		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.CustomResourceDefinition":              schema_pkg_apis_apiextensions_v1_CustomResourceDefinition(ref),
-		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.CustomResourceDefinitionCondition":     schema_pkg_apis_apiextensions_v1_CustomResourceDefinitionCondition(ref),
-		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.CustomResourceDefinitionList":          schema_pkg_apis_apiextensions_v1_CustomResourceDefinitionList(ref),
-		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.CustomResourceDefinitionNames":         schema_pkg_apis_apiextensions_v1_CustomResourceDefinitionNames(ref),
-		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.CustomResourceDefinitionSpec":          schema_pkg_apis_apiextensions_v1_CustomResourceDefinitionSpec(ref),
-		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.CustomResourceDefinitionStatus":        schema_pkg_apis_apiextensions_v1_CustomResourceDefinitionStatus(ref),
-		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.CustomResourceDefinitionVersion":       schema_pkg_apis_apiextensions_v1_CustomResourceDefinitionVersion(ref),
-		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.CustomResourceSubresourceScale":        schema_pkg_apis_apiextensions_v1_CustomResourceSubresourceScale(ref),
-		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.CustomResourceSubresourceStatus":       schema_pkg_apis_apiextensions_v1_CustomResourceSubresourceStatus(ref),
-		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.CustomResourceSubresources":            schema_pkg_apis_apiextensions_v1_CustomResourceSubresources(ref),
-		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.CustomResourceValidation":              schema_pkg_apis_apiextensions_v1_CustomResourceValidation(ref),
-		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.ExternalDocumentation":                 schema_pkg_apis_apiextensions_v1_ExternalDocumentation(ref),
-		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.JSON":                                  schema_pkg_apis_apiextensions_v1_JSON(ref),
-		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.JSONSchemaProps":                       schema_pkg_apis_apiextensions_v1_JSONSchemaProps(ref),
-		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.JSONSchemaPropsOrArray":                schema_pkg_apis_apiextensions_v1_JSONSchemaPropsOrArray(ref),
-		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.JSONSchemaPropsOrBool":                 schema_pkg_apis_apiextensions_v1_JSONSchemaPropsOrBool(ref),
-		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.JSONSchemaPropsOrStringArray":          schema_pkg_apis_apiextensions_v1_JSONSchemaPropsOrStringArray(ref),
-		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.SelectableField":                       schema_pkg_apis_apiextensions_v1_SelectableField(ref),
-		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.ServiceReference":                      schema_pkg_apis_apiextensions_v1_ServiceReference(ref),
-		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.ValidationRule":                        schema_pkg_apis_apiextensions_v1_ValidationRule(ref),
-		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.WebhookClientConfig":                   schema_pkg_apis_apiextensions_v1_WebhookClientConfig(ref),
-		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.WebhookConversion":                     schema_pkg_apis_apiextensions_v1_WebhookConversion(ref),
-		"k8s.io/apimachinery/pkg/api/resource.Quantity":                                                  schema_apimachinery_pkg_api_resource_Quantity(ref),
-		"k8s.io/apimachinery/pkg/api/resource.int64Amount":                                               schema_apimachinery_pkg_api_resource_int64Amount(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                                                  schema_pkg_apis_meta_v1_APIGroup(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":                                              schema_pkg_apis_meta_v1_APIGroupList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":                                               schema_pkg_apis_meta_v1_APIResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":                                           schema_pkg_apis_meta_v1_APIResourceList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":                                               schema_pkg_apis_meta_v1_APIVersions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ApplyOptions":                                              schema_pkg_apis_meta_v1_ApplyOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Condition":                                                 schema_pkg_apis_meta_v1_Condition(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.CreateOptions":                                             schema_pkg_apis_meta_v1_CreateOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":                                             schema_pkg_apis_meta_v1_DeleteOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                                                  schema_pkg_apis_meta_v1_Duration(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldSelectorRequirement":                                  schema_pkg_apis_meta_v1_FieldSelectorRequirement(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldsV1":                                                  schema_pkg_apis_meta_v1_FieldsV1(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                                                schema_pkg_apis_meta_v1_GetOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                                                 schema_pkg_apis_meta_v1_GroupKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":                                             schema_pkg_apis_meta_v1_GroupResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":                                              schema_pkg_apis_meta_v1_GroupVersion(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":                                  schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":                                          schema_pkg_apis_meta_v1_GroupVersionKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":                                      schema_pkg_apis_meta_v1_GroupVersionResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":                                             schema_pkg_apis_meta_v1_InternalEvent(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":                                             schema_pkg_apis_meta_v1_LabelSelector(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":                                  schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                                                      schema_pkg_apis_meta_v1_List(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                                                  schema_pkg_apis_meta_v1_ListMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":                                               schema_pkg_apis_meta_v1_ListOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ManagedFieldsEntry":                                        schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                                                 schema_pkg_apis_meta_v1_MicroTime(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                                                schema_pkg_apis_meta_v1_ObjectMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":                                            schema_pkg_apis_meta_v1_OwnerReference(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadata":                                     schema_pkg_apis_meta_v1_PartialObjectMetadata(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadataList":                                 schema_pkg_apis_meta_v1_PartialObjectMetadataList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                                                     schema_pkg_apis_meta_v1_Patch(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PatchOptions":                                              schema_pkg_apis_meta_v1_PatchOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":                                             schema_pkg_apis_meta_v1_Preconditions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                                                 schema_pkg_apis_meta_v1_RootPaths(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR":                                 schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                                                    schema_pkg_apis_meta_v1_Status(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":                                               schema_pkg_apis_meta_v1_StatusCause(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":                                             schema_pkg_apis_meta_v1_StatusDetails(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Table":                                                     schema_pkg_apis_meta_v1_Table(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableColumnDefinition":                                     schema_pkg_apis_meta_v1_TableColumnDefinition(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableOptions":                                              schema_pkg_apis_meta_v1_TableOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRow":                                                  schema_pkg_apis_meta_v1_TableRow(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRowCondition":                                         schema_pkg_apis_meta_v1_TableRowCondition(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                                                      schema_pkg_apis_meta_v1_Time(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                                                 schema_pkg_apis_meta_v1_Timestamp(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                                                  schema_pkg_apis_meta_v1_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.UpdateOptions":                                             schema_pkg_apis_meta_v1_UpdateOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                                                schema_pkg_apis_meta_v1_WatchEvent(ref),
-		"k8s.io/apimachinery/pkg/runtime.RawExtension":                                                   schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
-		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                                                       schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/runtime.Unknown":                                                        schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
-		"k8s.io/apimachinery/pkg/util/intstr.IntOrString":                                                schema_apimachinery_pkg_util_intstr_IntOrString(ref),
-		"k8s.io/apimachinery/pkg/version.Info":                                                           schema_k8sio_apimachinery_pkg_version_Info(ref),
+		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.AdminKubeconfigRequest":                  schema_pkg_apis_authentication_v1alpha1_AdminKubeconfigRequest(ref),
+		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.AdminKubeconfigRequestSpec":              schema_pkg_apis_authentication_v1alpha1_AdminKubeconfigRequestSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.AdminKubeconfigRequestStatus":            schema_pkg_apis_authentication_v1alpha1_AdminKubeconfigRequestStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.AdminSSHConfigRequest":                   schema_pkg_apis_authentication_v1alpha1_AdminSSHConfigRequest(ref),
+		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.AdminSSHConfigRequestSpec":               schema_pkg_apis_authentication_v1alpha1_AdminSSHConfigRequestSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.AdminSSHConfigRequestStatus":             schema_pkg_apis_authentication_v1alpha1_AdminSSHConfigRequestStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.ViewerKubeconfigRequest":                 schema_pkg_apis_authentication_v1alpha1_ViewerKubeconfigRequest(ref),
+		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.ViewerKubeconfigRequestSpec":             schema_pkg_apis_authentication_v1alpha1_ViewerKubeconfigRequestSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.ViewerKubeconfigRequestStatus":           schema_pkg_apis_authentication_v1alpha1_ViewerKubeconfigRequestStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1.ControllerDeployment":                                    schema_pkg_apis_core_v1_ControllerDeployment(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1.ControllerDeploymentList":                                schema_pkg_apis_core_v1_ControllerDeploymentList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1.HelmControllerDeployment":                                schema_pkg_apis_core_v1_HelmControllerDeployment(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1.OCIRepository":                                           schema_pkg_apis_core_v1_OCIRepository(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerLogging":                                   schema_pkg_apis_core_v1beta1_APIServerLogging(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerRequests":                                  schema_pkg_apis_core_v1beta1_APIServerRequests(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AccessRestriction":                                  schema_pkg_apis_core_v1beta1_AccessRestriction(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AccessRestrictionWithOptions":                       schema_pkg_apis_core_v1beta1_AccessRestrictionWithOptions(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Addon":                                              schema_pkg_apis_core_v1beta1_Addon(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Addons":                                             schema_pkg_apis_core_v1beta1_Addons(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AdmissionPlugin":                                    schema_pkg_apis_core_v1beta1_AdmissionPlugin(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Alerting":                                           schema_pkg_apis_core_v1beta1_Alerting(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditConfig":                                        schema_pkg_apis_core_v1beta1_AuditConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditPolicy":                                        schema_pkg_apis_core_v1beta1_AuditPolicy(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuthenticationClaimMappings":                        schema_pkg_apis_core_v1beta1_AuthenticationClaimMappings(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuthenticationClaimOrExpression":                    schema_pkg_apis_core_v1beta1_AuthenticationClaimOrExpression(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuthenticationClaimValidationRule":                  schema_pkg_apis_core_v1beta1_AuthenticationClaimValidationRule(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuthenticationIssuer":                               schema_pkg_apis_core_v1beta1_AuthenticationIssuer(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuthorizerKubeconfigReference":                      schema_pkg_apis_core_v1beta1_AuthorizerKubeconfigReference(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AvailabilityZone":                                   schema_pkg_apis_core_v1beta1_AvailabilityZone(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Backup":                                             schema_pkg_apis_core_v1beta1_Backup(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucket":                                       schema_pkg_apis_core_v1beta1_BackupBucket(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketList":                                   schema_pkg_apis_core_v1beta1_BackupBucketList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketProvider":                               schema_pkg_apis_core_v1beta1_BackupBucketProvider(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketSpec":                                   schema_pkg_apis_core_v1beta1_BackupBucketSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketStatus":                                 schema_pkg_apis_core_v1beta1_BackupBucketStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupEntry":                                        schema_pkg_apis_core_v1beta1_BackupEntry(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupEntryList":                                    schema_pkg_apis_core_v1beta1_BackupEntryList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupEntrySpec":                                    schema_pkg_apis_core_v1beta1_BackupEntrySpec(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupEntryStatus":                                  schema_pkg_apis_core_v1beta1_BackupEntryStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Bastion":                                            schema_pkg_apis_core_v1beta1_Bastion(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BastionMachineImage":                                schema_pkg_apis_core_v1beta1_BastionMachineImage(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BastionMachineType":                                 schema_pkg_apis_core_v1beta1_BastionMachineType(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CARotation":                                         schema_pkg_apis_core_v1beta1_CARotation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CRI":                                                schema_pkg_apis_core_v1beta1_CRI(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CapabilityDefinition":                               schema_pkg_apis_core_v1beta1_CapabilityDefinition(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudCredentialsRotation":                           schema_pkg_apis_core_v1beta1_CloudCredentialsRotation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfile":                                       schema_pkg_apis_core_v1beta1_CloudProfile(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileList":                                   schema_pkg_apis_core_v1beta1_CloudProfileList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileReference":                              schema_pkg_apis_core_v1beta1_CloudProfileReference(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileSpec":                                   schema_pkg_apis_core_v1beta1_CloudProfileSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscaler":                                  schema_pkg_apis_core_v1beta1_ClusterAutoscaler(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscalerOptions":                           schema_pkg_apis_core_v1beta1_ClusterAutoscalerOptions(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition":                                          schema_pkg_apis_core_v1beta1_Condition(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ContainerRuntime":                                   schema_pkg_apis_core_v1beta1_ContainerRuntime(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ContainerdConfig":                                   schema_pkg_apis_core_v1beta1_ContainerdConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ContainerdRegistry":                                 schema_pkg_apis_core_v1beta1_ContainerdRegistry(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlane":                                       schema_pkg_apis_core_v1beta1_ControlPlane(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlaneAutoscaling":                            schema_pkg_apis_core_v1beta1_ControlPlaneAutoscaling(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlaneResourceConsumption":                    schema_pkg_apis_core_v1beta1_ControlPlaneResourceConsumption(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerDeployment":                               schema_pkg_apis_core_v1beta1_ControllerDeployment(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerDeploymentList":                           schema_pkg_apis_core_v1beta1_ControllerDeploymentList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerInstallation":                             schema_pkg_apis_core_v1beta1_ControllerInstallation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerInstallationList":                         schema_pkg_apis_core_v1beta1_ControllerInstallationList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerInstallationSpec":                         schema_pkg_apis_core_v1beta1_ControllerInstallationSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerInstallationStatus":                       schema_pkg_apis_core_v1beta1_ControllerInstallationStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerRegistration":                             schema_pkg_apis_core_v1beta1_ControllerRegistration(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerRegistrationDeployment":                   schema_pkg_apis_core_v1beta1_ControllerRegistrationDeployment(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerRegistrationList":                         schema_pkg_apis_core_v1beta1_ControllerRegistrationList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerRegistrationSpec":                         schema_pkg_apis_core_v1beta1_ControllerRegistrationSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerResource":                                 schema_pkg_apis_core_v1beta1_ControllerResource(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerResourceAddon":                            schema_pkg_apis_core_v1beta1_ControllerResourceAddon(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerResourceLifecycle":                        schema_pkg_apis_core_v1beta1_ControllerResourceLifecycle(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNS":                                            schema_pkg_apis_core_v1beta1_CoreDNS(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSAutoscaling":                                 schema_pkg_apis_core_v1beta1_CoreDNSAutoscaling(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSRewriting":                                   schema_pkg_apis_core_v1beta1_CoreDNSRewriting(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DNS":                                                schema_pkg_apis_core_v1beta1_DNS(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DNSIncludeExclude":                                  schema_pkg_apis_core_v1beta1_DNSIncludeExclude(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DNSProvider":                                        schema_pkg_apis_core_v1beta1_DNSProvider(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DataVolume":                                         schema_pkg_apis_core_v1beta1_DataVolume(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DeploymentRef":                                      schema_pkg_apis_core_v1beta1_DeploymentRef(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DualApprovalForDeletion":                            schema_pkg_apis_core_v1beta1_DualApprovalForDeletion(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ETCD":                                               schema_pkg_apis_core_v1beta1_ETCD(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ETCDConfig":                                         schema_pkg_apis_core_v1beta1_ETCDConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ETCDEncryptionKeyRotation":                          schema_pkg_apis_core_v1beta1_ETCDEncryptionKeyRotation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.EncryptionAtRest":                                   schema_pkg_apis_core_v1beta1_EncryptionAtRest(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.EncryptionAtRestMigrationProgress":                  schema_pkg_apis_core_v1beta1_EncryptionAtRestMigrationProgress(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.EncryptionConfig":                                   schema_pkg_apis_core_v1beta1_EncryptionConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExpirableVersion":                                   schema_pkg_apis_core_v1beta1_ExpirableVersion(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExposureClass":                                      schema_pkg_apis_core_v1beta1_ExposureClass(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExposureClassList":                                  schema_pkg_apis_core_v1beta1_ExposureClassList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExposureClassScheduling":                            schema_pkg_apis_core_v1beta1_ExposureClassScheduling(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Extension":                                          schema_pkg_apis_core_v1beta1_Extension(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExtensionResourceState":                             schema_pkg_apis_core_v1beta1_ExtensionResourceState(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.FailureTolerance":                                   schema_pkg_apis_core_v1beta1_FailureTolerance(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener":                                           schema_pkg_apis_core_v1beta1_Gardener(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.GardenerResourceData":                               schema_pkg_apis_core_v1beta1_GardenerResourceData(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HelmControllerDeployment":                           schema_pkg_apis_core_v1beta1_HelmControllerDeployment(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Hibernation":                                        schema_pkg_apis_core_v1beta1_Hibernation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationSchedule":                                schema_pkg_apis_core_v1beta1_HibernationSchedule(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HighAvailability":                                   schema_pkg_apis_core_v1beta1_HighAvailability(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HorizontalPodAutoscalerConfig":                      schema_pkg_apis_core_v1beta1_HorizontalPodAutoscalerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.InPlaceUpdates":                                     schema_pkg_apis_core_v1beta1_InPlaceUpdates(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.InPlaceUpdatesStatus":                               schema_pkg_apis_core_v1beta1_InPlaceUpdatesStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Ingress":                                            schema_pkg_apis_core_v1beta1_Ingress(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.IngressController":                                  schema_pkg_apis_core_v1beta1_IngressController(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.InternalSecret":                                     schema_pkg_apis_core_v1beta1_InternalSecret(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.InternalSecretList":                                 schema_pkg_apis_core_v1beta1_InternalSecretList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.JWTAuthenticator":                                   schema_pkg_apis_core_v1beta1_JWTAuthenticator(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KMSEncryptionConfiguration":                         schema_pkg_apis_core_v1beta1_KMSEncryptionConfiguration(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeAPIServerConfig":                                schema_pkg_apis_core_v1beta1_KubeAPIServerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeAPIServerRequestBasedAutoscaling":               schema_pkg_apis_core_v1beta1_KubeAPIServerRequestBasedAutoscaling(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeControllerManagerConcurrentSyncs":               schema_pkg_apis_core_v1beta1_KubeControllerManagerConcurrentSyncs(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeControllerManagerConfig":                        schema_pkg_apis_core_v1beta1_KubeControllerManagerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeProxyConfig":                                    schema_pkg_apis_core_v1beta1_KubeProxyConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeSchedulerConfig":                                schema_pkg_apis_core_v1beta1_KubeSchedulerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfig":                                      schema_pkg_apis_core_v1beta1_KubeletConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigEviction":                              schema_pkg_apis_core_v1beta1_KubeletConfigEviction(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigEvictionMinimumReclaim":                schema_pkg_apis_core_v1beta1_KubeletConfigEvictionMinimumReclaim(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigEvictionSoftGracePeriod":               schema_pkg_apis_core_v1beta1_KubeletConfigEvictionSoftGracePeriod(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigReserved":                              schema_pkg_apis_core_v1beta1_KubeletConfigReserved(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Kubernetes":                                         schema_pkg_apis_core_v1beta1_Kubernetes(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesConfig":                                   schema_pkg_apis_core_v1beta1_KubernetesConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesDashboard":                                schema_pkg_apis_core_v1beta1_KubernetesDashboard(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesSettings":                                 schema_pkg_apis_core_v1beta1_KubernetesSettings(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError":                                          schema_pkg_apis_core_v1beta1_LastError(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.LastMaintenance":                                    schema_pkg_apis_core_v1beta1_LastMaintenance(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation":                                      schema_pkg_apis_core_v1beta1_LastOperation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Limits":                                             schema_pkg_apis_core_v1beta1_Limits(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.LoadBalancerServicesProxyProtocol":                  schema_pkg_apis_core_v1beta1_LoadBalancerServicesProxyProtocol(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Machine":                                            schema_pkg_apis_core_v1beta1_Machine(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineControllerManagerSettings":                   schema_pkg_apis_core_v1beta1_MachineControllerManagerSettings(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImage":                                       schema_pkg_apis_core_v1beta1_MachineImage(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImageFlavor":                                 schema_pkg_apis_core_v1beta1_MachineImageFlavor(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImageUpgradePath":                            schema_pkg_apis_core_v1beta1_MachineImageUpgradePath(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImageVersion":                                schema_pkg_apis_core_v1beta1_MachineImageVersion(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineType":                                        schema_pkg_apis_core_v1beta1_MachineType(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineTypeStorage":                                 schema_pkg_apis_core_v1beta1_MachineTypeStorage(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Maintenance":                                        schema_pkg_apis_core_v1beta1_Maintenance(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceAutoUpdate":                              schema_pkg_apis_core_v1beta1_MaintenanceAutoUpdate(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceTimeWindow":                              schema_pkg_apis_core_v1beta1_MaintenanceTimeWindow(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ManagedAddon":                                       schema_pkg_apis_core_v1beta1_ManagedAddon(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ManualWorkerPoolRollout":                            schema_pkg_apis_core_v1beta1_ManualWorkerPoolRollout(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MemorySwapConfiguration":                            schema_pkg_apis_core_v1beta1_MemorySwapConfiguration(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Monitoring":                                         schema_pkg_apis_core_v1beta1_Monitoring(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamedResourceReference":                             schema_pkg_apis_core_v1beta1_NamedResourceReference(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespacedCloudProfile":                             schema_pkg_apis_core_v1beta1_NamespacedCloudProfile(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespacedCloudProfileList":                         schema_pkg_apis_core_v1beta1_NamespacedCloudProfileList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespacedCloudProfileSpec":                         schema_pkg_apis_core_v1beta1_NamespacedCloudProfileSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespacedCloudProfileStatus":                       schema_pkg_apis_core_v1beta1_NamespacedCloudProfileStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Networking":                                         schema_pkg_apis_core_v1beta1_Networking(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkingStatus":                                   schema_pkg_apis_core_v1beta1_NetworkingStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NginxIngress":                                       schema_pkg_apis_core_v1beta1_NginxIngress(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NodeLocalDNS":                                       schema_pkg_apis_core_v1beta1_NodeLocalDNS(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.OCIRepository":                                      schema_pkg_apis_core_v1beta1_OCIRepository(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.OIDCConfig":                                         schema_pkg_apis_core_v1beta1_OIDCConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ObservabilityRotation":                              schema_pkg_apis_core_v1beta1_ObservabilityRotation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.OpenIDConnectClientAuthentication":                  schema_pkg_apis_core_v1beta1_OpenIDConnectClientAuthentication(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.PendingWorkerUpdates":                               schema_pkg_apis_core_v1beta1_PendingWorkerUpdates(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.PendingWorkersRollout":                              schema_pkg_apis_core_v1beta1_PendingWorkersRollout(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Project":                                            schema_pkg_apis_core_v1beta1_Project(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectList":                                        schema_pkg_apis_core_v1beta1_ProjectList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMember":                                      schema_pkg_apis_core_v1beta1_ProjectMember(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectSpec":                                        schema_pkg_apis_core_v1beta1_ProjectSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectStatus":                                      schema_pkg_apis_core_v1beta1_ProjectStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectTolerations":                                 schema_pkg_apis_core_v1beta1_ProjectTolerations(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectUsageSummary":                                schema_pkg_apis_core_v1beta1_ProjectUsageSummary(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Provider":                                           schema_pkg_apis_core_v1beta1_Provider(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Quota":                                              schema_pkg_apis_core_v1beta1_Quota(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaList":                                          schema_pkg_apis_core_v1beta1_QuotaList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaSpec":                                          schema_pkg_apis_core_v1beta1_QuotaSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Region":                                             schema_pkg_apis_core_v1beta1_Region(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.RegistryMirror":                                     schema_pkg_apis_core_v1beta1_RegistryMirror(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ResourceBackupAddon":                                schema_pkg_apis_core_v1beta1_ResourceBackupAddon(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ResourceData":                                       schema_pkg_apis_core_v1beta1_ResourceData(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ResourceWatchCacheSize":                             schema_pkg_apis_core_v1beta1_ResourceWatchCacheSize(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SSHAccess":                                          schema_pkg_apis_core_v1beta1_SSHAccess(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SecretBinding":                                      schema_pkg_apis_core_v1beta1_SecretBinding(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SecretBindingList":                                  schema_pkg_apis_core_v1beta1_SecretBindingList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SecretBindingProvider":                              schema_pkg_apis_core_v1beta1_SecretBindingProvider(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SecretBindingStatus":                                schema_pkg_apis_core_v1beta1_SecretBindingStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Seed":                                               schema_pkg_apis_core_v1beta1_Seed(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedAffinity":                                       schema_pkg_apis_core_v1beta1_SeedAffinity(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedAvailability":                                   schema_pkg_apis_core_v1beta1_SeedAvailability(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedDNS":                                            schema_pkg_apis_core_v1beta1_SeedDNS(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedDNSProvider":                                    schema_pkg_apis_core_v1beta1_SeedDNSProvider(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedDNSProviderConfig":                              schema_pkg_apis_core_v1beta1_SeedDNSProviderConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedList":                                           schema_pkg_apis_core_v1beta1_SeedList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedNetworks":                                       schema_pkg_apis_core_v1beta1_SeedNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedProvider":                                       schema_pkg_apis_core_v1beta1_SeedProvider(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSelector":                                       schema_pkg_apis_core_v1beta1_SeedSelector(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDNSCache":                                schema_pkg_apis_core_v1beta1_SeedSettingDNSCache(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdog":                      schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdog(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdogProber":                schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdogProber(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdogWeeder":                schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdogWeeder(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingExcessCapacityReservation":               schema_pkg_apis_core_v1beta1_SeedSettingExcessCapacityReservation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingExcessCapacityReservationConfig":         schema_pkg_apis_core_v1beta1_SeedSettingExcessCapacityReservationConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingIstioIngressGateway":                     schema_pkg_apis_core_v1beta1_SeedSettingIstioIngressGateway(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingIstioIngressGatewayAutoscaling":          schema_pkg_apis_core_v1beta1_SeedSettingIstioIngressGatewayAutoscaling(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingIstioIngressGatewayExposureClassHandler": schema_pkg_apis_core_v1beta1_SeedSettingIstioIngressGatewayExposureClassHandler(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingLoadBalancerServices":                    schema_pkg_apis_core_v1beta1_SeedSettingLoadBalancerServices(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingLoadBalancerServicesZonalIngress":        schema_pkg_apis_core_v1beta1_SeedSettingLoadBalancerServicesZonalIngress(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingLoadBalancerServicesZones":               schema_pkg_apis_core_v1beta1_SeedSettingLoadBalancerServicesZones(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingScheduling":                              schema_pkg_apis_core_v1beta1_SeedSettingScheduling(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingTopologyAwareRouting":                    schema_pkg_apis_core_v1beta1_SeedSettingTopologyAwareRouting(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingVerticalPodAutoscaler":                   schema_pkg_apis_core_v1beta1_SeedSettingVerticalPodAutoscaler(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettings":                                       schema_pkg_apis_core_v1beta1_SeedSettings(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSpec":                                           schema_pkg_apis_core_v1beta1_SeedSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedStatus":                                         schema_pkg_apis_core_v1beta1_SeedStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedTaint":                                          schema_pkg_apis_core_v1beta1_SeedTaint(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedTemplate":                                       schema_pkg_apis_core_v1beta1_SeedTemplate(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedVolume":                                         schema_pkg_apis_core_v1beta1_SeedVolume(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedVolumeProvider":                                 schema_pkg_apis_core_v1beta1_SeedVolumeProvider(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceAccountConfig":                               schema_pkg_apis_core_v1beta1_ServiceAccountConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceAccountKeyRotation":                          schema_pkg_apis_core_v1beta1_ServiceAccountKeyRotation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Shoot":                                              schema_pkg_apis_core_v1beta1_Shoot(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAdvertisedAddress":                             schema_pkg_apis_core_v1beta1_ShootAdvertisedAddress(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentials":                                   schema_pkg_apis_core_v1beta1_ShootCredentials(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentialsRotation":                           schema_pkg_apis_core_v1beta1_ShootCredentialsRotation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootKubeconfigRotation":                            schema_pkg_apis_core_v1beta1_ShootKubeconfigRotation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootList":                                          schema_pkg_apis_core_v1beta1_ShootList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootMachineImage":                                  schema_pkg_apis_core_v1beta1_ShootMachineImage(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootNetworks":                                      schema_pkg_apis_core_v1beta1_ShootNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSSHKeypairRotation":                            schema_pkg_apis_core_v1beta1_ShootSSHKeypairRotation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSpec":                                          schema_pkg_apis_core_v1beta1_ShootSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootState":                                         schema_pkg_apis_core_v1beta1_ShootState(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootStateList":                                     schema_pkg_apis_core_v1beta1_ShootStateList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootStateSpec":                                     schema_pkg_apis_core_v1beta1_ShootStateSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootStatus":                                        schema_pkg_apis_core_v1beta1_ShootStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootTemplate":                                      schema_pkg_apis_core_v1beta1_ShootTemplate(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootUpgradePaths":                                  schema_pkg_apis_core_v1beta1_ShootUpgradePaths(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.StructuredAuthentication":                           schema_pkg_apis_core_v1beta1_StructuredAuthentication(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.StructuredAuthorization":                            schema_pkg_apis_core_v1beta1_StructuredAuthorization(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SystemComponents":                                   schema_pkg_apis_core_v1beta1_SystemComponents(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Toleration":                                         schema_pkg_apis_core_v1beta1_Toleration(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.VersionUpgradePath":                                 schema_pkg_apis_core_v1beta1_VersionUpgradePath(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.VerticalPodAutoscaler":                              schema_pkg_apis_core_v1beta1_VerticalPodAutoscaler(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Volume":                                             schema_pkg_apis_core_v1beta1_Volume(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.VolumeType":                                         schema_pkg_apis_core_v1beta1_VolumeType(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WatchCacheSizes":                                    schema_pkg_apis_core_v1beta1_WatchCacheSizes(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WeightedSeedAffinityTerm":                           schema_pkg_apis_core_v1beta1_WeightedSeedAffinityTerm(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Worker":                                             schema_pkg_apis_core_v1beta1_Worker(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerControlPlane":                                 schema_pkg_apis_core_v1beta1_WorkerControlPlane(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerKubernetes":                                   schema_pkg_apis_core_v1beta1_WorkerKubernetes(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerPoolStatus":                                   schema_pkg_apis_core_v1beta1_WorkerPoolStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerSystemComponents":                             schema_pkg_apis_core_v1beta1_WorkerSystemComponents(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkersSettings":                                    schema_pkg_apis_core_v1beta1_WorkersSettings(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ZoneEgressCIDRs":                                    schema_pkg_apis_core_v1beta1_ZoneEgressCIDRs(ref),
+		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.Bastion":                                     schema_pkg_apis_operations_v1alpha1_Bastion(ref),
+		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionIngressPolicy":                        schema_pkg_apis_operations_v1alpha1_BastionIngressPolicy(ref),
+		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionList":                                 schema_pkg_apis_operations_v1alpha1_BastionList(ref),
+		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionSpec":                                 schema_pkg_apis_operations_v1alpha1_BastionSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionStatus":                               schema_pkg_apis_operations_v1alpha1_BastionStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.ContextObject":                                 schema_pkg_apis_security_v1alpha1_ContextObject(ref),
+		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.CredentialsBinding":                            schema_pkg_apis_security_v1alpha1_CredentialsBinding(ref),
+		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.CredentialsBindingList":                        schema_pkg_apis_security_v1alpha1_CredentialsBindingList(ref),
+		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.CredentialsBindingProvider":                    schema_pkg_apis_security_v1alpha1_CredentialsBindingProvider(ref),
+		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.CredentialsBindingStatus":                      schema_pkg_apis_security_v1alpha1_CredentialsBindingStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.TargetSystem":                                  schema_pkg_apis_security_v1alpha1_TargetSystem(ref),
+		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.TokenRequest":                                  schema_pkg_apis_security_v1alpha1_TokenRequest(ref),
+		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.TokenRequestSpec":                              schema_pkg_apis_security_v1alpha1_TokenRequestSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.TokenRequestStatus":                            schema_pkg_apis_security_v1alpha1_TokenRequestStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.WorkloadIdentity":                              schema_pkg_apis_security_v1alpha1_WorkloadIdentity(ref),
+		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.WorkloadIdentityList":                          schema_pkg_apis_security_v1alpha1_WorkloadIdentityList(ref),
+		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.WorkloadIdentitySpec":                          schema_pkg_apis_security_v1alpha1_WorkloadIdentitySpec(ref),
+		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.WorkloadIdentityStatus":                        schema_pkg_apis_security_v1alpha1_WorkloadIdentityStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Gardenlet":                               schema_pkg_apis_seedmanagement_v1alpha1_Gardenlet(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletConfig":                         schema_pkg_apis_seedmanagement_v1alpha1_GardenletConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletDeployment":                     schema_pkg_apis_seedmanagement_v1alpha1_GardenletDeployment(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletHelm":                           schema_pkg_apis_seedmanagement_v1alpha1_GardenletHelm(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletList":                           schema_pkg_apis_seedmanagement_v1alpha1_GardenletList(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletSelfDeployment":                 schema_pkg_apis_seedmanagement_v1alpha1_GardenletSelfDeployment(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletSpec":                           schema_pkg_apis_seedmanagement_v1alpha1_GardenletSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletStatus":                         schema_pkg_apis_seedmanagement_v1alpha1_GardenletStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Image":                                   schema_pkg_apis_seedmanagement_v1alpha1_Image(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeed":                             schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeed(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedList":                         schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedList(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSet":                          schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSet(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSetList":                      schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSetList(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSetSpec":                      schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSetSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSetStatus":                    schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSetStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSpec":                         schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedStatus":                       schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedTemplate":                     schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedTemplate(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.PendingReplica":                          schema_pkg_apis_seedmanagement_v1alpha1_PendingReplica(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.RollingUpdateStrategy":                   schema_pkg_apis_seedmanagement_v1alpha1_RollingUpdateStrategy(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Shoot":                                   schema_pkg_apis_seedmanagement_v1alpha1_Shoot(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.UpdateStrategy":                          schema_pkg_apis_seedmanagement_v1alpha1_UpdateStrategy(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterOpenIDConnectPreset":                    schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPreset(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterOpenIDConnectPresetList":                schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPresetList(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterOpenIDConnectPresetSpec":                schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPresetSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.KubeAPIServerOpenIDConnect":                    schema_pkg_apis_settings_v1alpha1_KubeAPIServerOpenIDConnect(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectClientAuthentication":             schema_pkg_apis_settings_v1alpha1_OpenIDConnectClientAuthentication(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectPreset":                           schema_pkg_apis_settings_v1alpha1_OpenIDConnectPreset(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectPresetList":                       schema_pkg_apis_settings_v1alpha1_OpenIDConnectPresetList(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectPresetSpec":                       schema_pkg_apis_settings_v1alpha1_OpenIDConnectPresetSpec(ref),
+		"k8s.io/api/autoscaling/v1.ContainerResourceMetricSource":                                               schema_k8sio_api_autoscaling_v1_ContainerResourceMetricSource(ref),
+		"k8s.io/api/autoscaling/v1.ContainerResourceMetricStatus":                                               schema_k8sio_api_autoscaling_v1_ContainerResourceMetricStatus(ref),
+		"k8s.io/api/autoscaling/v1.CrossVersionObjectReference":                                                 schema_k8sio_api_autoscaling_v1_CrossVersionObjectReference(ref),
+		"k8s.io/api/autoscaling/v1.ExternalMetricSource":                                                        schema_k8sio_api_autoscaling_v1_ExternalMetricSource(ref),
+		"k8s.io/api/autoscaling/v1.ExternalMetricStatus":                                                        schema_k8sio_api_autoscaling_v1_ExternalMetricStatus(ref),
+		"k8s.io/api/autoscaling/v1.HorizontalPodAutoscaler":                                                     schema_k8sio_api_autoscaling_v1_HorizontalPodAutoscaler(ref),
+		"k8s.io/api/autoscaling/v1.HorizontalPodAutoscalerCondition":                                            schema_k8sio_api_autoscaling_v1_HorizontalPodAutoscalerCondition(ref),
+		"k8s.io/api/autoscaling/v1.HorizontalPodAutoscalerList":                                                 schema_k8sio_api_autoscaling_v1_HorizontalPodAutoscalerList(ref),
+		"k8s.io/api/autoscaling/v1.HorizontalPodAutoscalerSpec":                                                 schema_k8sio_api_autoscaling_v1_HorizontalPodAutoscalerSpec(ref),
+		"k8s.io/api/autoscaling/v1.HorizontalPodAutoscalerStatus":                                               schema_k8sio_api_autoscaling_v1_HorizontalPodAutoscalerStatus(ref),
+		"k8s.io/api/autoscaling/v1.MetricSpec":                                                                  schema_k8sio_api_autoscaling_v1_MetricSpec(ref),
+		"k8s.io/api/autoscaling/v1.MetricStatus":                                                                schema_k8sio_api_autoscaling_v1_MetricStatus(ref),
+		"k8s.io/api/autoscaling/v1.ObjectMetricSource":                                                          schema_k8sio_api_autoscaling_v1_ObjectMetricSource(ref),
+		"k8s.io/api/autoscaling/v1.ObjectMetricStatus":                                                          schema_k8sio_api_autoscaling_v1_ObjectMetricStatus(ref),
+		"k8s.io/api/autoscaling/v1.PodsMetricSource":                                                            schema_k8sio_api_autoscaling_v1_PodsMetricSource(ref),
+		"k8s.io/api/autoscaling/v1.PodsMetricStatus":                                                            schema_k8sio_api_autoscaling_v1_PodsMetricStatus(ref),
+		"k8s.io/api/autoscaling/v1.ResourceMetricSource":                                                        schema_k8sio_api_autoscaling_v1_ResourceMetricSource(ref),
+		"k8s.io/api/autoscaling/v1.ResourceMetricStatus":                                                        schema_k8sio_api_autoscaling_v1_ResourceMetricStatus(ref),
+		"k8s.io/api/autoscaling/v1.Scale":                                                                       schema_k8sio_api_autoscaling_v1_Scale(ref),
+		"k8s.io/api/autoscaling/v1.ScaleSpec":                                                                   schema_k8sio_api_autoscaling_v1_ScaleSpec(ref),
+		"k8s.io/api/autoscaling/v1.ScaleStatus":                                                                 schema_k8sio_api_autoscaling_v1_ScaleStatus(ref),
+		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                                   schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
+		"k8s.io/api/core/v1.Affinity":                                                                           schema_k8sio_api_core_v1_Affinity(ref),
+		"k8s.io/api/core/v1.AppArmorProfile":                                                                    schema_k8sio_api_core_v1_AppArmorProfile(ref),
+		"k8s.io/api/core/v1.AttachedVolume":                                                                     schema_k8sio_api_core_v1_AttachedVolume(ref),
+		"k8s.io/api/core/v1.AvoidPods":                                                                          schema_k8sio_api_core_v1_AvoidPods(ref),
+		"k8s.io/api/core/v1.AzureDiskVolumeSource":                                                              schema_k8sio_api_core_v1_AzureDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.AzureFilePersistentVolumeSource":                                                    schema_k8sio_api_core_v1_AzureFilePersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.AzureFileVolumeSource":                                                              schema_k8sio_api_core_v1_AzureFileVolumeSource(ref),
+		"k8s.io/api/core/v1.Binding":                                                                            schema_k8sio_api_core_v1_Binding(ref),
+		"k8s.io/api/core/v1.CSIPersistentVolumeSource":                                                          schema_k8sio_api_core_v1_CSIPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.CSIVolumeSource":                                                                    schema_k8sio_api_core_v1_CSIVolumeSource(ref),
+		"k8s.io/api/core/v1.Capabilities":                                                                       schema_k8sio_api_core_v1_Capabilities(ref),
+		"k8s.io/api/core/v1.CephFSPersistentVolumeSource":                                                       schema_k8sio_api_core_v1_CephFSPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.CephFSVolumeSource":                                                                 schema_k8sio_api_core_v1_CephFSVolumeSource(ref),
+		"k8s.io/api/core/v1.CinderPersistentVolumeSource":                                                       schema_k8sio_api_core_v1_CinderPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.CinderVolumeSource":                                                                 schema_k8sio_api_core_v1_CinderVolumeSource(ref),
+		"k8s.io/api/core/v1.ClientIPConfig":                                                                     schema_k8sio_api_core_v1_ClientIPConfig(ref),
+		"k8s.io/api/core/v1.ClusterTrustBundleProjection":                                                       schema_k8sio_api_core_v1_ClusterTrustBundleProjection(ref),
+		"k8s.io/api/core/v1.ComponentCondition":                                                                 schema_k8sio_api_core_v1_ComponentCondition(ref),
+		"k8s.io/api/core/v1.ComponentStatus":                                                                    schema_k8sio_api_core_v1_ComponentStatus(ref),
+		"k8s.io/api/core/v1.ComponentStatusList":                                                                schema_k8sio_api_core_v1_ComponentStatusList(ref),
+		"k8s.io/api/core/v1.ConfigMap":                                                                          schema_k8sio_api_core_v1_ConfigMap(ref),
+		"k8s.io/api/core/v1.ConfigMapEnvSource":                                                                 schema_k8sio_api_core_v1_ConfigMapEnvSource(ref),
+		"k8s.io/api/core/v1.ConfigMapKeySelector":                                                               schema_k8sio_api_core_v1_ConfigMapKeySelector(ref),
+		"k8s.io/api/core/v1.ConfigMapList":                                                                      schema_k8sio_api_core_v1_ConfigMapList(ref),
+		"k8s.io/api/core/v1.ConfigMapNodeConfigSource":                                                          schema_k8sio_api_core_v1_ConfigMapNodeConfigSource(ref),
+		"k8s.io/api/core/v1.ConfigMapProjection":                                                                schema_k8sio_api_core_v1_ConfigMapProjection(ref),
+		"k8s.io/api/core/v1.ConfigMapVolumeSource":                                                              schema_k8sio_api_core_v1_ConfigMapVolumeSource(ref),
+		"k8s.io/api/core/v1.Container":                                                                          schema_k8sio_api_core_v1_Container(ref),
+		"k8s.io/api/core/v1.ContainerExtendedResourceRequest":                                                   schema_k8sio_api_core_v1_ContainerExtendedResourceRequest(ref),
+		"k8s.io/api/core/v1.ContainerImage":                                                                     schema_k8sio_api_core_v1_ContainerImage(ref),
+		"k8s.io/api/core/v1.ContainerPort":                                                                      schema_k8sio_api_core_v1_ContainerPort(ref),
+		"k8s.io/api/core/v1.ContainerResizePolicy":                                                              schema_k8sio_api_core_v1_ContainerResizePolicy(ref),
+		"k8s.io/api/core/v1.ContainerRestartRule":                                                               schema_k8sio_api_core_v1_ContainerRestartRule(ref),
+		"k8s.io/api/core/v1.ContainerRestartRuleOnExitCodes":                                                    schema_k8sio_api_core_v1_ContainerRestartRuleOnExitCodes(ref),
+		"k8s.io/api/core/v1.ContainerState":                                                                     schema_k8sio_api_core_v1_ContainerState(ref),
+		"k8s.io/api/core/v1.ContainerStateRunning":                                                              schema_k8sio_api_core_v1_ContainerStateRunning(ref),
+		"k8s.io/api/core/v1.ContainerStateTerminated":                                                           schema_k8sio_api_core_v1_ContainerStateTerminated(ref),
+		"k8s.io/api/core/v1.ContainerStateWaiting":                                                              schema_k8sio_api_core_v1_ContainerStateWaiting(ref),
+		"k8s.io/api/core/v1.ContainerStatus":                                                                    schema_k8sio_api_core_v1_ContainerStatus(ref),
+		"k8s.io/api/core/v1.ContainerUser":                                                                      schema_k8sio_api_core_v1_ContainerUser(ref),
+		"k8s.io/api/core/v1.DaemonEndpoint":                                                                     schema_k8sio_api_core_v1_DaemonEndpoint(ref),
+		"k8s.io/api/core/v1.DownwardAPIProjection":                                                              schema_k8sio_api_core_v1_DownwardAPIProjection(ref),
+		"k8s.io/api/core/v1.DownwardAPIVolumeFile":                                                              schema_k8sio_api_core_v1_DownwardAPIVolumeFile(ref),
+		"k8s.io/api/core/v1.DownwardAPIVolumeSource":                                                            schema_k8sio_api_core_v1_DownwardAPIVolumeSource(ref),
+		"k8s.io/api/core/v1.EmptyDirVolumeSource":                                                               schema_k8sio_api_core_v1_EmptyDirVolumeSource(ref),
+		"k8s.io/api/core/v1.EndpointAddress":                                                                    schema_k8sio_api_core_v1_EndpointAddress(ref),
+		"k8s.io/api/core/v1.EndpointPort":                                                                       schema_k8sio_api_core_v1_EndpointPort(ref),
+		"k8s.io/api/core/v1.EndpointSubset":                                                                     schema_k8sio_api_core_v1_EndpointSubset(ref),
+		"k8s.io/api/core/v1.Endpoints":                                                                          schema_k8sio_api_core_v1_Endpoints(ref),
+		"k8s.io/api/core/v1.EndpointsList":                                                                      schema_k8sio_api_core_v1_EndpointsList(ref),
+		"k8s.io/api/core/v1.EnvFromSource":                                                                      schema_k8sio_api_core_v1_EnvFromSource(ref),
+		"k8s.io/api/core/v1.EnvVar":                                                                             schema_k8sio_api_core_v1_EnvVar(ref),
+		"k8s.io/api/core/v1.EnvVarSource":                                                                       schema_k8sio_api_core_v1_EnvVarSource(ref),
+		"k8s.io/api/core/v1.EphemeralContainer":                                                                 schema_k8sio_api_core_v1_EphemeralContainer(ref),
+		"k8s.io/api/core/v1.EphemeralContainerCommon":                                                           schema_k8sio_api_core_v1_EphemeralContainerCommon(ref),
+		"k8s.io/api/core/v1.EphemeralVolumeSource":                                                              schema_k8sio_api_core_v1_EphemeralVolumeSource(ref),
+		"k8s.io/api/core/v1.Event":                                                                              schema_k8sio_api_core_v1_Event(ref),
+		"k8s.io/api/core/v1.EventList":                                                                          schema_k8sio_api_core_v1_EventList(ref),
+		"k8s.io/api/core/v1.EventSeries":                                                                        schema_k8sio_api_core_v1_EventSeries(ref),
+		"k8s.io/api/core/v1.EventSource":                                                                        schema_k8sio_api_core_v1_EventSource(ref),
+		"k8s.io/api/core/v1.ExecAction":                                                                         schema_k8sio_api_core_v1_ExecAction(ref),
+		"k8s.io/api/core/v1.FCVolumeSource":                                                                     schema_k8sio_api_core_v1_FCVolumeSource(ref),
+		"k8s.io/api/core/v1.FileKeySelector":                                                                    schema_k8sio_api_core_v1_FileKeySelector(ref),
+		"k8s.io/api/core/v1.FlexPersistentVolumeSource":                                                         schema_k8sio_api_core_v1_FlexPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.FlexVolumeSource":                                                                   schema_k8sio_api_core_v1_FlexVolumeSource(ref),
+		"k8s.io/api/core/v1.FlockerVolumeSource":                                                                schema_k8sio_api_core_v1_FlockerVolumeSource(ref),
+		"k8s.io/api/core/v1.GCEPersistentDiskVolumeSource":                                                      schema_k8sio_api_core_v1_GCEPersistentDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.GRPCAction":                                                                         schema_k8sio_api_core_v1_GRPCAction(ref),
+		"k8s.io/api/core/v1.GitRepoVolumeSource":                                                                schema_k8sio_api_core_v1_GitRepoVolumeSource(ref),
+		"k8s.io/api/core/v1.GlusterfsPersistentVolumeSource":                                                    schema_k8sio_api_core_v1_GlusterfsPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.GlusterfsVolumeSource":                                                              schema_k8sio_api_core_v1_GlusterfsVolumeSource(ref),
+		"k8s.io/api/core/v1.HTTPGetAction":                                                                      schema_k8sio_api_core_v1_HTTPGetAction(ref),
+		"k8s.io/api/core/v1.HTTPHeader":                                                                         schema_k8sio_api_core_v1_HTTPHeader(ref),
+		"k8s.io/api/core/v1.HostAlias":                                                                          schema_k8sio_api_core_v1_HostAlias(ref),
+		"k8s.io/api/core/v1.HostIP":                                                                             schema_k8sio_api_core_v1_HostIP(ref),
+		"k8s.io/api/core/v1.HostPathVolumeSource":                                                               schema_k8sio_api_core_v1_HostPathVolumeSource(ref),
+		"k8s.io/api/core/v1.ISCSIPersistentVolumeSource":                                                        schema_k8sio_api_core_v1_ISCSIPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.ISCSIVolumeSource":                                                                  schema_k8sio_api_core_v1_ISCSIVolumeSource(ref),
+		"k8s.io/api/core/v1.ImageVolumeSource":                                                                  schema_k8sio_api_core_v1_ImageVolumeSource(ref),
+		"k8s.io/api/core/v1.KeyToPath":                                                                          schema_k8sio_api_core_v1_KeyToPath(ref),
+		"k8s.io/api/core/v1.Lifecycle":                                                                          schema_k8sio_api_core_v1_Lifecycle(ref),
+		"k8s.io/api/core/v1.LifecycleHandler":                                                                   schema_k8sio_api_core_v1_LifecycleHandler(ref),
+		"k8s.io/api/core/v1.LimitRange":                                                                         schema_k8sio_api_core_v1_LimitRange(ref),
+		"k8s.io/api/core/v1.LimitRangeItem":                                                                     schema_k8sio_api_core_v1_LimitRangeItem(ref),
+		"k8s.io/api/core/v1.LimitRangeList":                                                                     schema_k8sio_api_core_v1_LimitRangeList(ref),
+		"k8s.io/api/core/v1.LimitRangeSpec":                                                                     schema_k8sio_api_core_v1_LimitRangeSpec(ref),
+		"k8s.io/api/core/v1.LinuxContainerUser":                                                                 schema_k8sio_api_core_v1_LinuxContainerUser(ref),
+		"k8s.io/api/core/v1.List":                                                                               schema_k8sio_api_core_v1_List(ref),
+		"k8s.io/api/core/v1.LoadBalancerIngress":                                                                schema_k8sio_api_core_v1_LoadBalancerIngress(ref),
+		"k8s.io/api/core/v1.LoadBalancerStatus":                                                                 schema_k8sio_api_core_v1_LoadBalancerStatus(ref),
+		"k8s.io/api/core/v1.LocalObjectReference":                                                               schema_k8sio_api_core_v1_LocalObjectReference(ref),
+		"k8s.io/api/core/v1.LocalVolumeSource":                                                                  schema_k8sio_api_core_v1_LocalVolumeSource(ref),
+		"k8s.io/api/core/v1.ModifyVolumeStatus":                                                                 schema_k8sio_api_core_v1_ModifyVolumeStatus(ref),
+		"k8s.io/api/core/v1.NFSVolumeSource":                                                                    schema_k8sio_api_core_v1_NFSVolumeSource(ref),
+		"k8s.io/api/core/v1.Namespace":                                                                          schema_k8sio_api_core_v1_Namespace(ref),
+		"k8s.io/api/core/v1.NamespaceCondition":                                                                 schema_k8sio_api_core_v1_NamespaceCondition(ref),
+		"k8s.io/api/core/v1.NamespaceList":                                                                      schema_k8sio_api_core_v1_NamespaceList(ref),
+		"k8s.io/api/core/v1.NamespaceSpec":                                                                      schema_k8sio_api_core_v1_NamespaceSpec(ref),
+		"k8s.io/api/core/v1.NamespaceStatus":                                                                    schema_k8sio_api_core_v1_NamespaceStatus(ref),
+		"k8s.io/api/core/v1.Node":                                                                               schema_k8sio_api_core_v1_Node(ref),
+		"k8s.io/api/core/v1.NodeAddress":                                                                        schema_k8sio_api_core_v1_NodeAddress(ref),
+		"k8s.io/api/core/v1.NodeAffinity":                                                                       schema_k8sio_api_core_v1_NodeAffinity(ref),
+		"k8s.io/api/core/v1.NodeCondition":                                                                      schema_k8sio_api_core_v1_NodeCondition(ref),
+		"k8s.io/api/core/v1.NodeConfigSource":                                                                   schema_k8sio_api_core_v1_NodeConfigSource(ref),
+		"k8s.io/api/core/v1.NodeConfigStatus":                                                                   schema_k8sio_api_core_v1_NodeConfigStatus(ref),
+		"k8s.io/api/core/v1.NodeDaemonEndpoints":                                                                schema_k8sio_api_core_v1_NodeDaemonEndpoints(ref),
+		"k8s.io/api/core/v1.NodeFeatures":                                                                       schema_k8sio_api_core_v1_NodeFeatures(ref),
+		"k8s.io/api/core/v1.NodeList":                                                                           schema_k8sio_api_core_v1_NodeList(ref),
+		"k8s.io/api/core/v1.NodeProxyOptions":                                                                   schema_k8sio_api_core_v1_NodeProxyOptions(ref),
+		"k8s.io/api/core/v1.NodeRuntimeHandler":                                                                 schema_k8sio_api_core_v1_NodeRuntimeHandler(ref),
+		"k8s.io/api/core/v1.NodeRuntimeHandlerFeatures":                                                         schema_k8sio_api_core_v1_NodeRuntimeHandlerFeatures(ref),
+		"k8s.io/api/core/v1.NodeSelector":                                                                       schema_k8sio_api_core_v1_NodeSelector(ref),
+		"k8s.io/api/core/v1.NodeSelectorRequirement":                                                            schema_k8sio_api_core_v1_NodeSelectorRequirement(ref),
+		"k8s.io/api/core/v1.NodeSelectorTerm":                                                                   schema_k8sio_api_core_v1_NodeSelectorTerm(ref),
+		"k8s.io/api/core/v1.NodeSpec":                                                                           schema_k8sio_api_core_v1_NodeSpec(ref),
+		"k8s.io/api/core/v1.NodeStatus":                                                                         schema_k8sio_api_core_v1_NodeStatus(ref),
+		"k8s.io/api/core/v1.NodeSwapStatus":                                                                     schema_k8sio_api_core_v1_NodeSwapStatus(ref),
+		"k8s.io/api/core/v1.NodeSystemInfo":                                                                     schema_k8sio_api_core_v1_NodeSystemInfo(ref),
+		"k8s.io/api/core/v1.ObjectFieldSelector":                                                                schema_k8sio_api_core_v1_ObjectFieldSelector(ref),
+		"k8s.io/api/core/v1.ObjectReference":                                                                    schema_k8sio_api_core_v1_ObjectReference(ref),
+		"k8s.io/api/core/v1.PersistentVolume":                                                                   schema_k8sio_api_core_v1_PersistentVolume(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaim":                                                              schema_k8sio_api_core_v1_PersistentVolumeClaim(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimCondition":                                                     schema_k8sio_api_core_v1_PersistentVolumeClaimCondition(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimList":                                                          schema_k8sio_api_core_v1_PersistentVolumeClaimList(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimSpec":                                                          schema_k8sio_api_core_v1_PersistentVolumeClaimSpec(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimStatus":                                                        schema_k8sio_api_core_v1_PersistentVolumeClaimStatus(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimTemplate":                                                      schema_k8sio_api_core_v1_PersistentVolumeClaimTemplate(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimVolumeSource":                                                  schema_k8sio_api_core_v1_PersistentVolumeClaimVolumeSource(ref),
+		"k8s.io/api/core/v1.PersistentVolumeList":                                                               schema_k8sio_api_core_v1_PersistentVolumeList(ref),
+		"k8s.io/api/core/v1.PersistentVolumeSource":                                                             schema_k8sio_api_core_v1_PersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.PersistentVolumeSpec":                                                               schema_k8sio_api_core_v1_PersistentVolumeSpec(ref),
+		"k8s.io/api/core/v1.PersistentVolumeStatus":                                                             schema_k8sio_api_core_v1_PersistentVolumeStatus(ref),
+		"k8s.io/api/core/v1.PhotonPersistentDiskVolumeSource":                                                   schema_k8sio_api_core_v1_PhotonPersistentDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.Pod":                                                                                schema_k8sio_api_core_v1_Pod(ref),
+		"k8s.io/api/core/v1.PodAffinity":                                                                        schema_k8sio_api_core_v1_PodAffinity(ref),
+		"k8s.io/api/core/v1.PodAffinityTerm":                                                                    schema_k8sio_api_core_v1_PodAffinityTerm(ref),
+		"k8s.io/api/core/v1.PodAntiAffinity":                                                                    schema_k8sio_api_core_v1_PodAntiAffinity(ref),
+		"k8s.io/api/core/v1.PodAttachOptions":                                                                   schema_k8sio_api_core_v1_PodAttachOptions(ref),
+		"k8s.io/api/core/v1.PodCertificateProjection":                                                           schema_k8sio_api_core_v1_PodCertificateProjection(ref),
+		"k8s.io/api/core/v1.PodCondition":                                                                       schema_k8sio_api_core_v1_PodCondition(ref),
+		"k8s.io/api/core/v1.PodDNSConfig":                                                                       schema_k8sio_api_core_v1_PodDNSConfig(ref),
+		"k8s.io/api/core/v1.PodDNSConfigOption":                                                                 schema_k8sio_api_core_v1_PodDNSConfigOption(ref),
+		"k8s.io/api/core/v1.PodExecOptions":                                                                     schema_k8sio_api_core_v1_PodExecOptions(ref),
+		"k8s.io/api/core/v1.PodExtendedResourceClaimStatus":                                                     schema_k8sio_api_core_v1_PodExtendedResourceClaimStatus(ref),
+		"k8s.io/api/core/v1.PodIP":                                                                              schema_k8sio_api_core_v1_PodIP(ref),
+		"k8s.io/api/core/v1.PodList":                                                                            schema_k8sio_api_core_v1_PodList(ref),
+		"k8s.io/api/core/v1.PodLogOptions":                                                                      schema_k8sio_api_core_v1_PodLogOptions(ref),
+		"k8s.io/api/core/v1.PodOS":                                                                              schema_k8sio_api_core_v1_PodOS(ref),
+		"k8s.io/api/core/v1.PodPortForwardOptions":                                                              schema_k8sio_api_core_v1_PodPortForwardOptions(ref),
+		"k8s.io/api/core/v1.PodProxyOptions":                                                                    schema_k8sio_api_core_v1_PodProxyOptions(ref),
+		"k8s.io/api/core/v1.PodReadinessGate":                                                                   schema_k8sio_api_core_v1_PodReadinessGate(ref),
+		"k8s.io/api/core/v1.PodResourceClaim":                                                                   schema_k8sio_api_core_v1_PodResourceClaim(ref),
+		"k8s.io/api/core/v1.PodResourceClaimStatus":                                                             schema_k8sio_api_core_v1_PodResourceClaimStatus(ref),
+		"k8s.io/api/core/v1.PodSchedulingGate":                                                                  schema_k8sio_api_core_v1_PodSchedulingGate(ref),
+		"k8s.io/api/core/v1.PodSecurityContext":                                                                 schema_k8sio_api_core_v1_PodSecurityContext(ref),
+		"k8s.io/api/core/v1.PodSignature":                                                                       schema_k8sio_api_core_v1_PodSignature(ref),
+		"k8s.io/api/core/v1.PodSpec":                                                                            schema_k8sio_api_core_v1_PodSpec(ref),
+		"k8s.io/api/core/v1.PodStatus":                                                                          schema_k8sio_api_core_v1_PodStatus(ref),
+		"k8s.io/api/core/v1.PodStatusResult":                                                                    schema_k8sio_api_core_v1_PodStatusResult(ref),
+		"k8s.io/api/core/v1.PodTemplate":                                                                        schema_k8sio_api_core_v1_PodTemplate(ref),
+		"k8s.io/api/core/v1.PodTemplateList":                                                                    schema_k8sio_api_core_v1_PodTemplateList(ref),
+		"k8s.io/api/core/v1.PodTemplateSpec":                                                                    schema_k8sio_api_core_v1_PodTemplateSpec(ref),
+		"k8s.io/api/core/v1.PortStatus":                                                                         schema_k8sio_api_core_v1_PortStatus(ref),
+		"k8s.io/api/core/v1.PortworxVolumeSource":                                                               schema_k8sio_api_core_v1_PortworxVolumeSource(ref),
+		"k8s.io/api/core/v1.PreferAvoidPodsEntry":                                                               schema_k8sio_api_core_v1_PreferAvoidPodsEntry(ref),
+		"k8s.io/api/core/v1.PreferredSchedulingTerm":                                                            schema_k8sio_api_core_v1_PreferredSchedulingTerm(ref),
+		"k8s.io/api/core/v1.Probe":                                                                              schema_k8sio_api_core_v1_Probe(ref),
+		"k8s.io/api/core/v1.ProbeHandler":                                                                       schema_k8sio_api_core_v1_ProbeHandler(ref),
+		"k8s.io/api/core/v1.ProjectedVolumeSource":                                                              schema_k8sio_api_core_v1_ProjectedVolumeSource(ref),
+		"k8s.io/api/core/v1.QuobyteVolumeSource":                                                                schema_k8sio_api_core_v1_QuobyteVolumeSource(ref),
+		"k8s.io/api/core/v1.RBDPersistentVolumeSource":                                                          schema_k8sio_api_core_v1_RBDPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.RBDVolumeSource":                                                                    schema_k8sio_api_core_v1_RBDVolumeSource(ref),
+		"k8s.io/api/core/v1.RangeAllocation":                                                                    schema_k8sio_api_core_v1_RangeAllocation(ref),
+		"k8s.io/api/core/v1.ReplicationController":                                                              schema_k8sio_api_core_v1_ReplicationController(ref),
+		"k8s.io/api/core/v1.ReplicationControllerCondition":                                                     schema_k8sio_api_core_v1_ReplicationControllerCondition(ref),
+		"k8s.io/api/core/v1.ReplicationControllerList":                                                          schema_k8sio_api_core_v1_ReplicationControllerList(ref),
+		"k8s.io/api/core/v1.ReplicationControllerSpec":                                                          schema_k8sio_api_core_v1_ReplicationControllerSpec(ref),
+		"k8s.io/api/core/v1.ReplicationControllerStatus":                                                        schema_k8sio_api_core_v1_ReplicationControllerStatus(ref),
+		"k8s.io/api/core/v1.ResourceClaim":                                                                      schema_k8sio_api_core_v1_ResourceClaim(ref),
+		"k8s.io/api/core/v1.ResourceFieldSelector":                                                              schema_k8sio_api_core_v1_ResourceFieldSelector(ref),
+		"k8s.io/api/core/v1.ResourceHealth":                                                                     schema_k8sio_api_core_v1_ResourceHealth(ref),
+		"k8s.io/api/core/v1.ResourceQuota":                                                                      schema_k8sio_api_core_v1_ResourceQuota(ref),
+		"k8s.io/api/core/v1.ResourceQuotaList":                                                                  schema_k8sio_api_core_v1_ResourceQuotaList(ref),
+		"k8s.io/api/core/v1.ResourceQuotaSpec":                                                                  schema_k8sio_api_core_v1_ResourceQuotaSpec(ref),
+		"k8s.io/api/core/v1.ResourceQuotaStatus":                                                                schema_k8sio_api_core_v1_ResourceQuotaStatus(ref),
+		"k8s.io/api/core/v1.ResourceRequirements":                                                               schema_k8sio_api_core_v1_ResourceRequirements(ref),
+		"k8s.io/api/core/v1.ResourceStatus":                                                                     schema_k8sio_api_core_v1_ResourceStatus(ref),
+		"k8s.io/api/core/v1.SELinuxOptions":                                                                     schema_k8sio_api_core_v1_SELinuxOptions(ref),
+		"k8s.io/api/core/v1.ScaleIOPersistentVolumeSource":                                                      schema_k8sio_api_core_v1_ScaleIOPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.ScaleIOVolumeSource":                                                                schema_k8sio_api_core_v1_ScaleIOVolumeSource(ref),
+		"k8s.io/api/core/v1.ScopeSelector":                                                                      schema_k8sio_api_core_v1_ScopeSelector(ref),
+		"k8s.io/api/core/v1.ScopedResourceSelectorRequirement":                                                  schema_k8sio_api_core_v1_ScopedResourceSelectorRequirement(ref),
+		"k8s.io/api/core/v1.SeccompProfile":                                                                     schema_k8sio_api_core_v1_SeccompProfile(ref),
+		"k8s.io/api/core/v1.Secret":                                                                             schema_k8sio_api_core_v1_Secret(ref),
+		"k8s.io/api/core/v1.SecretEnvSource":                                                                    schema_k8sio_api_core_v1_SecretEnvSource(ref),
+		"k8s.io/api/core/v1.SecretKeySelector":                                                                  schema_k8sio_api_core_v1_SecretKeySelector(ref),
+		"k8s.io/api/core/v1.SecretList":                                                                         schema_k8sio_api_core_v1_SecretList(ref),
+		"k8s.io/api/core/v1.SecretProjection":                                                                   schema_k8sio_api_core_v1_SecretProjection(ref),
+		"k8s.io/api/core/v1.SecretReference":                                                                    schema_k8sio_api_core_v1_SecretReference(ref),
+		"k8s.io/api/core/v1.SecretVolumeSource":                                                                 schema_k8sio_api_core_v1_SecretVolumeSource(ref),
+		"k8s.io/api/core/v1.SecurityContext":                                                                    schema_k8sio_api_core_v1_SecurityContext(ref),
+		"k8s.io/api/core/v1.SerializedReference":                                                                schema_k8sio_api_core_v1_SerializedReference(ref),
+		"k8s.io/api/core/v1.Service":                                                                            schema_k8sio_api_core_v1_Service(ref),
+		"k8s.io/api/core/v1.ServiceAccount":                                                                     schema_k8sio_api_core_v1_ServiceAccount(ref),
+		"k8s.io/api/core/v1.ServiceAccountList":                                                                 schema_k8sio_api_core_v1_ServiceAccountList(ref),
+		"k8s.io/api/core/v1.ServiceAccountTokenProjection":                                                      schema_k8sio_api_core_v1_ServiceAccountTokenProjection(ref),
+		"k8s.io/api/core/v1.ServiceList":                                                                        schema_k8sio_api_core_v1_ServiceList(ref),
+		"k8s.io/api/core/v1.ServicePort":                                                                        schema_k8sio_api_core_v1_ServicePort(ref),
+		"k8s.io/api/core/v1.ServiceProxyOptions":                                                                schema_k8sio_api_core_v1_ServiceProxyOptions(ref),
+		"k8s.io/api/core/v1.ServiceSpec":                                                                        schema_k8sio_api_core_v1_ServiceSpec(ref),
+		"k8s.io/api/core/v1.ServiceStatus":                                                                      schema_k8sio_api_core_v1_ServiceStatus(ref),
+		"k8s.io/api/core/v1.SessionAffinityConfig":                                                              schema_k8sio_api_core_v1_SessionAffinityConfig(ref),
+		"k8s.io/api/core/v1.SleepAction":                                                                        schema_k8sio_api_core_v1_SleepAction(ref),
+		"k8s.io/api/core/v1.StorageOSPersistentVolumeSource":                                                    schema_k8sio_api_core_v1_StorageOSPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.StorageOSVolumeSource":                                                              schema_k8sio_api_core_v1_StorageOSVolumeSource(ref),
+		"k8s.io/api/core/v1.Sysctl":                                                                             schema_k8sio_api_core_v1_Sysctl(ref),
+		"k8s.io/api/core/v1.TCPSocketAction":                                                                    schema_k8sio_api_core_v1_TCPSocketAction(ref),
+		"k8s.io/api/core/v1.Taint":                                                                              schema_k8sio_api_core_v1_Taint(ref),
+		"k8s.io/api/core/v1.Toleration":                                                                         schema_k8sio_api_core_v1_Toleration(ref),
+		"k8s.io/api/core/v1.TopologySelectorLabelRequirement":                                                   schema_k8sio_api_core_v1_TopologySelectorLabelRequirement(ref),
+		"k8s.io/api/core/v1.TopologySelectorTerm":                                                               schema_k8sio_api_core_v1_TopologySelectorTerm(ref),
+		"k8s.io/api/core/v1.TopologySpreadConstraint":                                                           schema_k8sio_api_core_v1_TopologySpreadConstraint(ref),
+		"k8s.io/api/core/v1.TypedLocalObjectReference":                                                          schema_k8sio_api_core_v1_TypedLocalObjectReference(ref),
+		"k8s.io/api/core/v1.TypedObjectReference":                                                               schema_k8sio_api_core_v1_TypedObjectReference(ref),
+		"k8s.io/api/core/v1.Volume":                                                                             schema_k8sio_api_core_v1_Volume(ref),
+		"k8s.io/api/core/v1.VolumeDevice":                                                                       schema_k8sio_api_core_v1_VolumeDevice(ref),
+		"k8s.io/api/core/v1.VolumeMount":                                                                        schema_k8sio_api_core_v1_VolumeMount(ref),
+		"k8s.io/api/core/v1.VolumeMountStatus":                                                                  schema_k8sio_api_core_v1_VolumeMountStatus(ref),
+		"k8s.io/api/core/v1.VolumeNodeAffinity":                                                                 schema_k8sio_api_core_v1_VolumeNodeAffinity(ref),
+		"k8s.io/api/core/v1.VolumeProjection":                                                                   schema_k8sio_api_core_v1_VolumeProjection(ref),
+		"k8s.io/api/core/v1.VolumeResourceRequirements":                                                         schema_k8sio_api_core_v1_VolumeResourceRequirements(ref),
+		"k8s.io/api/core/v1.VolumeSource":                                                                       schema_k8sio_api_core_v1_VolumeSource(ref),
+		"k8s.io/api/core/v1.VsphereVirtualDiskVolumeSource":                                                     schema_k8sio_api_core_v1_VsphereVirtualDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.WeightedPodAffinityTerm":                                                            schema_k8sio_api_core_v1_WeightedPodAffinityTerm(ref),
+		"k8s.io/api/core/v1.WindowsSecurityContextOptions":                                                      schema_k8sio_api_core_v1_WindowsSecurityContextOptions(ref),
+		"k8s.io/api/networking/v1.HTTPIngressPath":                                                              schema_k8sio_api_networking_v1_HTTPIngressPath(ref),
+		"k8s.io/api/networking/v1.HTTPIngressRuleValue":                                                         schema_k8sio_api_networking_v1_HTTPIngressRuleValue(ref),
+		"k8s.io/api/networking/v1.IPAddress":                                                                    schema_k8sio_api_networking_v1_IPAddress(ref),
+		"k8s.io/api/networking/v1.IPAddressList":                                                                schema_k8sio_api_networking_v1_IPAddressList(ref),
+		"k8s.io/api/networking/v1.IPAddressSpec":                                                                schema_k8sio_api_networking_v1_IPAddressSpec(ref),
+		"k8s.io/api/networking/v1.IPBlock":                                                                      schema_k8sio_api_networking_v1_IPBlock(ref),
+		"k8s.io/api/networking/v1.Ingress":                                                                      schema_k8sio_api_networking_v1_Ingress(ref),
+		"k8s.io/api/networking/v1.IngressBackend":                                                               schema_k8sio_api_networking_v1_IngressBackend(ref),
+		"k8s.io/api/networking/v1.IngressClass":                                                                 schema_k8sio_api_networking_v1_IngressClass(ref),
+		"k8s.io/api/networking/v1.IngressClassList":                                                             schema_k8sio_api_networking_v1_IngressClassList(ref),
+		"k8s.io/api/networking/v1.IngressClassParametersReference":                                              schema_k8sio_api_networking_v1_IngressClassParametersReference(ref),
+		"k8s.io/api/networking/v1.IngressClassSpec":                                                             schema_k8sio_api_networking_v1_IngressClassSpec(ref),
+		"k8s.io/api/networking/v1.IngressList":                                                                  schema_k8sio_api_networking_v1_IngressList(ref),
+		"k8s.io/api/networking/v1.IngressLoadBalancerIngress":                                                   schema_k8sio_api_networking_v1_IngressLoadBalancerIngress(ref),
+		"k8s.io/api/networking/v1.IngressLoadBalancerStatus":                                                    schema_k8sio_api_networking_v1_IngressLoadBalancerStatus(ref),
+		"k8s.io/api/networking/v1.IngressPortStatus":                                                            schema_k8sio_api_networking_v1_IngressPortStatus(ref),
+		"k8s.io/api/networking/v1.IngressRule":                                                                  schema_k8sio_api_networking_v1_IngressRule(ref),
+		"k8s.io/api/networking/v1.IngressRuleValue":                                                             schema_k8sio_api_networking_v1_IngressRuleValue(ref),
+		"k8s.io/api/networking/v1.IngressServiceBackend":                                                        schema_k8sio_api_networking_v1_IngressServiceBackend(ref),
+		"k8s.io/api/networking/v1.IngressSpec":                                                                  schema_k8sio_api_networking_v1_IngressSpec(ref),
+		"k8s.io/api/networking/v1.IngressStatus":                                                                schema_k8sio_api_networking_v1_IngressStatus(ref),
+		"k8s.io/api/networking/v1.IngressTLS":                                                                   schema_k8sio_api_networking_v1_IngressTLS(ref),
+		"k8s.io/api/networking/v1.NetworkPolicy":                                                                schema_k8sio_api_networking_v1_NetworkPolicy(ref),
+		"k8s.io/api/networking/v1.NetworkPolicyEgressRule":                                                      schema_k8sio_api_networking_v1_NetworkPolicyEgressRule(ref),
+		"k8s.io/api/networking/v1.NetworkPolicyIngressRule":                                                     schema_k8sio_api_networking_v1_NetworkPolicyIngressRule(ref),
+		"k8s.io/api/networking/v1.NetworkPolicyList":                                                            schema_k8sio_api_networking_v1_NetworkPolicyList(ref),
+		"k8s.io/api/networking/v1.NetworkPolicyPeer":                                                            schema_k8sio_api_networking_v1_NetworkPolicyPeer(ref),
+		"k8s.io/api/networking/v1.NetworkPolicyPort":                                                            schema_k8sio_api_networking_v1_NetworkPolicyPort(ref),
+		"k8s.io/api/networking/v1.NetworkPolicySpec":                                                            schema_k8sio_api_networking_v1_NetworkPolicySpec(ref),
+		"k8s.io/api/networking/v1.ParentReference":                                                              schema_k8sio_api_networking_v1_ParentReference(ref),
+		"k8s.io/api/networking/v1.ServiceBackendPort":                                                           schema_k8sio_api_networking_v1_ServiceBackendPort(ref),
+		"k8s.io/api/networking/v1.ServiceCIDR":                                                                  schema_k8sio_api_networking_v1_ServiceCIDR(ref),
+		"k8s.io/api/networking/v1.ServiceCIDRList":                                                              schema_k8sio_api_networking_v1_ServiceCIDRList(ref),
+		"k8s.io/api/networking/v1.ServiceCIDRSpec":                                                              schema_k8sio_api_networking_v1_ServiceCIDRSpec(ref),
+		"k8s.io/api/networking/v1.ServiceCIDRStatus":                                                            schema_k8sio_api_networking_v1_ServiceCIDRStatus(ref),
+		"k8s.io/api/rbac/v1.AggregationRule":                                                                    schema_k8sio_api_rbac_v1_AggregationRule(ref),
+		"k8s.io/api/rbac/v1.ClusterRole":                                                                        schema_k8sio_api_rbac_v1_ClusterRole(ref),
+		"k8s.io/api/rbac/v1.ClusterRoleBinding":                                                                 schema_k8sio_api_rbac_v1_ClusterRoleBinding(ref),
+		"k8s.io/api/rbac/v1.ClusterRoleBindingList":                                                             schema_k8sio_api_rbac_v1_ClusterRoleBindingList(ref),
+		"k8s.io/api/rbac/v1.ClusterRoleList":                                                                    schema_k8sio_api_rbac_v1_ClusterRoleList(ref),
+		"k8s.io/api/rbac/v1.PolicyRule":                                                                         schema_k8sio_api_rbac_v1_PolicyRule(ref),
+		"k8s.io/api/rbac/v1.Role":                                                                               schema_k8sio_api_rbac_v1_Role(ref),
+		"k8s.io/api/rbac/v1.RoleBinding":                                                                        schema_k8sio_api_rbac_v1_RoleBinding(ref),
+		"k8s.io/api/rbac/v1.RoleBindingList":                                                                    schema_k8sio_api_rbac_v1_RoleBindingList(ref),
+		"k8s.io/api/rbac/v1.RoleList":                                                                           schema_k8sio_api_rbac_v1_RoleList(ref),
+		"k8s.io/api/rbac/v1.RoleRef":                                                                            schema_k8sio_api_rbac_v1_RoleRef(ref),
+		"k8s.io/api/rbac/v1.Subject":                                                                            schema_k8sio_api_rbac_v1_Subject(ref),
+		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.ConversionRequest":                            schema_pkg_apis_apiextensions_v1_ConversionRequest(ref),
+		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.ConversionResponse":                           schema_pkg_apis_apiextensions_v1_ConversionResponse(ref),
+		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.ConversionReview":                             schema_pkg_apis_apiextensions_v1_ConversionReview(ref),
+		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.CustomResourceColumnDefinition":               schema_pkg_apis_apiextensions_v1_CustomResourceColumnDefinition(ref),
+		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.CustomResourceConversion":                     schema_pkg_apis_apiextensions_v1_CustomResourceConversion(ref),
+		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.CustomResourceDefinition":                     schema_pkg_apis_apiextensions_v1_CustomResourceDefinition(ref),
+		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.CustomResourceDefinitionCondition":            schema_pkg_apis_apiextensions_v1_CustomResourceDefinitionCondition(ref),
+		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.CustomResourceDefinitionList":                 schema_pkg_apis_apiextensions_v1_CustomResourceDefinitionList(ref),
+		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.CustomResourceDefinitionNames":                schema_pkg_apis_apiextensions_v1_CustomResourceDefinitionNames(ref),
+		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.CustomResourceDefinitionSpec":                 schema_pkg_apis_apiextensions_v1_CustomResourceDefinitionSpec(ref),
+		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.CustomResourceDefinitionStatus":               schema_pkg_apis_apiextensions_v1_CustomResourceDefinitionStatus(ref),
+		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.CustomResourceDefinitionVersion":              schema_pkg_apis_apiextensions_v1_CustomResourceDefinitionVersion(ref),
+		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.CustomResourceSubresourceScale":               schema_pkg_apis_apiextensions_v1_CustomResourceSubresourceScale(ref),
+		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.CustomResourceSubresourceStatus":              schema_pkg_apis_apiextensions_v1_CustomResourceSubresourceStatus(ref),
+		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.CustomResourceSubresources":                   schema_pkg_apis_apiextensions_v1_CustomResourceSubresources(ref),
+		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.CustomResourceValidation":                     schema_pkg_apis_apiextensions_v1_CustomResourceValidation(ref),
+		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.ExternalDocumentation":                        schema_pkg_apis_apiextensions_v1_ExternalDocumentation(ref),
+		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.JSON":                                         schema_pkg_apis_apiextensions_v1_JSON(ref),
+		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.JSONSchemaProps":                              schema_pkg_apis_apiextensions_v1_JSONSchemaProps(ref),
+		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.JSONSchemaPropsOrArray":                       schema_pkg_apis_apiextensions_v1_JSONSchemaPropsOrArray(ref),
+		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.JSONSchemaPropsOrBool":                        schema_pkg_apis_apiextensions_v1_JSONSchemaPropsOrBool(ref),
+		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.JSONSchemaPropsOrStringArray":                 schema_pkg_apis_apiextensions_v1_JSONSchemaPropsOrStringArray(ref),
+		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.SelectableField":                              schema_pkg_apis_apiextensions_v1_SelectableField(ref),
+		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.ServiceReference":                             schema_pkg_apis_apiextensions_v1_ServiceReference(ref),
+		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.ValidationRule":                               schema_pkg_apis_apiextensions_v1_ValidationRule(ref),
+		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.WebhookClientConfig":                          schema_pkg_apis_apiextensions_v1_WebhookClientConfig(ref),
+		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.WebhookConversion":                            schema_pkg_apis_apiextensions_v1_WebhookConversion(ref),
+		"k8s.io/apimachinery/pkg/api/resource.Quantity":                                                         schema_apimachinery_pkg_api_resource_Quantity(ref),
+		"k8s.io/apimachinery/pkg/api/resource.int64Amount":                                                      schema_apimachinery_pkg_api_resource_int64Amount(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                                                         schema_pkg_apis_meta_v1_APIGroup(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":                                                     schema_pkg_apis_meta_v1_APIGroupList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":                                                      schema_pkg_apis_meta_v1_APIResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":                                                  schema_pkg_apis_meta_v1_APIResourceList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":                                                      schema_pkg_apis_meta_v1_APIVersions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ApplyOptions":                                                     schema_pkg_apis_meta_v1_ApplyOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Condition":                                                        schema_pkg_apis_meta_v1_Condition(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.CreateOptions":                                                    schema_pkg_apis_meta_v1_CreateOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":                                                    schema_pkg_apis_meta_v1_DeleteOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                                                         schema_pkg_apis_meta_v1_Duration(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldSelectorRequirement":                                         schema_pkg_apis_meta_v1_FieldSelectorRequirement(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldsV1":                                                         schema_pkg_apis_meta_v1_FieldsV1(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                                                       schema_pkg_apis_meta_v1_GetOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                                                        schema_pkg_apis_meta_v1_GroupKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":                                                    schema_pkg_apis_meta_v1_GroupResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":                                                     schema_pkg_apis_meta_v1_GroupVersion(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":                                         schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":                                                 schema_pkg_apis_meta_v1_GroupVersionKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":                                             schema_pkg_apis_meta_v1_GroupVersionResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":                                                    schema_pkg_apis_meta_v1_InternalEvent(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":                                                    schema_pkg_apis_meta_v1_LabelSelector(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":                                         schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                                                             schema_pkg_apis_meta_v1_List(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                                                         schema_pkg_apis_meta_v1_ListMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":                                                      schema_pkg_apis_meta_v1_ListOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ManagedFieldsEntry":                                               schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                                                        schema_pkg_apis_meta_v1_MicroTime(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                                                       schema_pkg_apis_meta_v1_ObjectMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":                                                   schema_pkg_apis_meta_v1_OwnerReference(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadata":                                            schema_pkg_apis_meta_v1_PartialObjectMetadata(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadataList":                                        schema_pkg_apis_meta_v1_PartialObjectMetadataList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                                                            schema_pkg_apis_meta_v1_Patch(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PatchOptions":                                                     schema_pkg_apis_meta_v1_PatchOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":                                                    schema_pkg_apis_meta_v1_Preconditions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                                                        schema_pkg_apis_meta_v1_RootPaths(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR":                                        schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                                                           schema_pkg_apis_meta_v1_Status(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":                                                      schema_pkg_apis_meta_v1_StatusCause(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":                                                    schema_pkg_apis_meta_v1_StatusDetails(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Table":                                                            schema_pkg_apis_meta_v1_Table(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableColumnDefinition":                                            schema_pkg_apis_meta_v1_TableColumnDefinition(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableOptions":                                                     schema_pkg_apis_meta_v1_TableOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRow":                                                         schema_pkg_apis_meta_v1_TableRow(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRowCondition":                                                schema_pkg_apis_meta_v1_TableRowCondition(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                                                             schema_pkg_apis_meta_v1_Time(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                                                        schema_pkg_apis_meta_v1_Timestamp(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                                                         schema_pkg_apis_meta_v1_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.UpdateOptions":                                                    schema_pkg_apis_meta_v1_UpdateOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                                                       schema_pkg_apis_meta_v1_WatchEvent(ref),
+		"k8s.io/apimachinery/pkg/runtime.RawExtension":                                                          schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
+		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                                                              schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/runtime.Unknown":                                                               schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
+		"k8s.io/apimachinery/pkg/util/intstr.IntOrString":                                                       schema_apimachinery_pkg_util_intstr_IntOrString(ref),
+		"k8s.io/apimachinery/pkg/version.Info":                                                                  schema_k8sio_apimachinery_pkg_version_Info(ref),
 	}
 }
 
@@ -771,6 +806,114 @@ func schema_pkg_apis_authentication_v1alpha1_AdminKubeconfigRequestStatus(ref co
 	}
 }
 
+func schema_pkg_apis_authentication_v1alpha1_AdminSSHConfigRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AdminSSHConfigRequest can be used to request a short-lived SSH certificate for the nodes of a Shoot cluster. The certificate is signed by the shoot's SSH certificate authority which the shoot's nodes trust, so the SSH session can be established through the Gardener-managed reverse tunnel without a bastion host.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the specification of the AdminSSHConfigRequest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.AdminSSHConfigRequestSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the status of the AdminSSHConfigRequest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.AdminSSHConfigRequestStatus"),
+						},
+					},
+				},
+				Required: []string{"spec", "status"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.AdminSSHConfigRequestSpec", "github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.AdminSSHConfigRequestStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_authentication_v1alpha1_AdminSSHConfigRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AdminSSHConfigRequestSpec contains the SSH public key to be signed and the expiration time of the certificate.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"publicKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PublicKey is the SSH public key (in OpenSSH `authorized_keys` format) which shall be signed.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"expirationSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationSeconds is the requested validity duration of the credential. The credential issuer may return a credential with a different validity duration so a client needs to check the 'expirationTimestamp' field in a response. Defaults to 1 hour.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"publicKey"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_authentication_v1alpha1_AdminSSHConfigRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AdminSSHConfigRequestStatus is the status of the AdminSSHConfigRequest containing the SSH certificate and expiration of the credential.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"certificate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Certificate contains the signed SSH certificate (in OpenSSH `authorized_keys` format) for the requested public key.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"expirationTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationTimestamp is the expiration timestamp of the returned credential.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"certificate", "expirationTimestamp"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_authentication_v1alpha1_ViewerKubeconfigRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1209,11 +1352,17 @@ func schema_pkg_apis_core_v1beta1_Addons(ref common.ReferenceCallback) common.Op
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NginxIngress"),
 						},
 					},
+					"resourceBackup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceBackup holds configuration settings for the resource-backup addon.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ResourceBackupAddon"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesDashboard", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NginxIngress"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesDashboard", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NginxIngress", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ResourceBackupAddon"},
 	}
 }
 
@@ -1331,6 +1480,152 @@ func schema_pkg_apis_core_v1beta1_AuditPolicy(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_AuthenticationClaimMappings(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuthenticationClaimMappings configures how token claims are mapped to user identities.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Username represents an option for the username attribute.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AuthenticationClaimOrExpression"),
+						},
+					},
+					"groups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Groups represents an option for the groups attribute.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AuthenticationClaimOrExpression"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuthenticationClaimOrExpression"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_AuthenticationClaimOrExpression(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuthenticationClaimOrExpression configures how a user identity attribute is derived from token claims.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"claim": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Claim is the JWT claim to use. It is mutually exclusive with expression.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefix is prepended to claim values to prevent clashes with existing names. It may only be set together with claim.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expression is a CEL expression that evaluates to the attribute value. It is mutually exclusive with claim.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_AuthenticationClaimValidationRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuthenticationClaimValidationRule provides the configuration for a single claim validation rule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"claim": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Claim is the name of a required claim. It is mutually exclusive with expression.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"requiredValue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequiredValue is the value of the claim which is required to be present with an exact match. It may only be set together with claim.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expression is a CEL expression that must evaluate to true for the token to be accepted. It is mutually exclusive with claim.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is returned in the authentication failure reason when the expression evaluates to false. It may only be set together with expression.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_AuthenticationIssuer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuthenticationIssuer provides the configuration for a JWT issuer.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL points to the issuer URL in a format https://url or https://url/path. It must use the https:// scheme.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"audiences": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Audiences is the set of acceptable audiences the JWT must be issued to.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"certificateAuthority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CertificateAuthority contains a PEM-encoded certificate authority bundle used to validate the connection when fetching the issuer's discovery document. If unset, the system trust store is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"url", "audiences"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_AuthorizerKubeconfigReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2023,12 +2318,18 @@ func schema_pkg_apis_core_v1beta1_CRI(ref common.ReferenceCallback) common.OpenA
 							},
 						},
 					},
+					"containerd": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Containerd contains configuration options for containerd.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ContainerdConfig"),
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ContainerRuntime"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ContainerRuntime", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ContainerdConfig"},
 	}
 }
 
@@ -2067,6 +2368,33 @@ func schema_pkg_apis_core_v1beta1_CapabilityDefinition(ref common.ReferenceCallb
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_CloudCredentialsRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CloudCredentialsRotation contains information about the rollout of rotated cloud provider credentials.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastInitiationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastInitiationTime is the most recent time when the cloud provider credential rollout was initiated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastCompletionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastCompletionTime is the most recent time when the rotated cloud provider credentials were successfully rolled out to all components consuming them.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_CloudProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2338,12 +2666,32 @@ func schema_pkg_apis_core_v1beta1_CloudProfileSpec(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"registryMirrors": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "upstream",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "RegistryMirrors contains declarations of registry mirrors / alternate image locations that shoot worker nodes targeting this profile should use when pulling container images, optionally restricted to certain regions.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.RegistryMirror"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"kubernetes", "machineImages", "machineTypes", "regions", "type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Bastion", "github.com/gardener/gardener/pkg/apis/core/v1beta1.CapabilityDefinition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesSettings", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Limits", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImage", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineType", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Region", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSelector", "github.com/gardener/gardener/pkg/apis/core/v1beta1.VolumeType", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Bastion", "github.com/gardener/gardener/pkg/apis/core/v1beta1.CapabilityDefinition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesSettings", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Limits", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImage", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineType", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Region", "github.com/gardener/gardener/pkg/apis/core/v1beta1.RegistryMirror", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSelector", "github.com/gardener/gardener/pkg/apis/core/v1beta1.VolumeType", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
@@ -2675,16 +3023,109 @@ func schema_pkg_apis_core_v1beta1_ContainerRuntime(ref common.ReferenceCallback)
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ControlPlane(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_ContainerdConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ControlPlane holds information about the general settings for the control plane of a shoot.",
+				Description: "ContainerdConfig contains configuration options for containerd.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"highAvailability": {
-						SchemaProps: spec.SchemaProps{
-							Description: "HighAvailability holds the configuration settings for high availability of the control plane of a shoot.",
+					"registries": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "upstream",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Registries configures the registry hosts used by containerd to pull images from upstream registries.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ContainerdRegistry"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ContainerdRegistry"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ContainerdRegistry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ContainerdRegistry configures the registry hosts for one upstream container registry.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"upstream": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Upstream is the host name of the upstream registry (e.g., \"docker.io\").",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"server": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Server is the fallback server URL for this upstream.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hosts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hosts is the list of registry mirror URLs used to pull images of this upstream.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"authSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuthSecretName references a secret in the shoot's project namespace containing credentials for the registry mirrors.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"allowInsecure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowInsecure allows plain HTTP mirror URLs for this upstream.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"upstream", "hosts"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ControlPlane(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControlPlane holds information about the general settings for the control plane of a shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"highAvailability": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HighAvailability holds the configuration settings for high availability of the control plane of a shoot.",
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.HighAvailability"),
 						},
 					},
@@ -2726,6 +3167,46 @@ func schema_pkg_apis_core_v1beta1_ControlPlaneAutoscaling(ref common.ReferenceCa
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ControlPlaneResourceConsumption(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControlPlaneResourceConsumption contains information about the resource consumption of the shoot's control plane.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kubeAPIServerCPU": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeAPIServerCPU is the sum of the current CPU usage of all kube-apiserver replicas.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"kubeAPIServerMemory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeAPIServerMemory is the sum of the current memory usage of all kube-apiserver replicas.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"etcdStorageSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ETCDStorageSize is the storage capacity provisioned for the main etcd.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the time when the resource consumption was last measured.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"lastUpdateTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ControllerDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3263,12 +3744,48 @@ func schema_pkg_apis_core_v1beta1_ControllerResource(ref common.ReferenceCallbac
 							},
 						},
 					},
+					"addon": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Addon describes the shoot addon backed by this resource. If set, users can enable the addon in their Shoot spec via `.spec.managedAddons` using the addon's name. This field can only be set for resources of kind \"Extension\".",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerResourceAddon"),
+						},
+					},
 				},
 				Required: []string{"kind", "type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerResourceLifecycle", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerResourceAddon", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerResourceLifecycle", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ControllerResourceAddon(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControllerResourceAddon describes a shoot addon backed by a ControllerResource of kind \"Extension\".",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name under which the addon can be enabled in the Shoot spec.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema is an optional JSON schema describing the configuration options of the addon.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
@@ -3397,6 +3914,13 @@ func schema_pkg_apis_core_v1beta1_DNS(ref common.ReferenceCallback) common.OpenA
 							Format:      "",
 						},
 					},
+					"managementDomain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ManagementDomain is an optional domain under which the kube-apiserver is additionally exposed. The corresponding DNS record is expected to be resolvable from the internal network only (e.g., via a private DNS zone or an internal load balancer provided by the responsible provider extension), so workloads can reach the API server without traversing the public internet.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"providers": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -3745,7 +4269,45 @@ func schema_pkg_apis_core_v1beta1_EncryptionAtRest(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"migrationProgress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MigrationProgress tracks the progress of rewriting existing data after resources were added to or removed from the encryption configuration. It is only present while such a storage migration is in progress.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.EncryptionAtRestMigrationProgress"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.EncryptionAtRestMigrationProgress"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_EncryptionAtRestMigrationProgress(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EncryptionAtRestMigrationProgress tracks the progress of the storage migration which rewrites existing data after the encryption configuration was changed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"migratedResourceCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MigratedResourceCount is the number of resource kinds whose objects have already been rewritten.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"remainingResourceCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemainingResourceCount is the number of resource kinds whose objects still have to be rewritten.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
+				Required: []string{"migratedResourceCount", "remainingResourceCount"},
 			},
 		},
 	}
@@ -3773,10 +4335,18 @@ func schema_pkg_apis_core_v1beta1_EncryptionConfig(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"kms": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KMS contains configuration for encrypting resources with a customer-managed key via an external KMS v2 provider instead of the Gardener-generated encryption keys. The Gardener-generated keys are kept as fallback providers for decryption, so the existing credentials rotation flows continue to work.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KMSEncryptionConfiguration"),
+						},
+					},
 				},
 				Required: []string{"resources"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.KMSEncryptionConfiguration"},
 	}
 }
 
@@ -3803,9 +4373,10 @@ func schema_pkg_apis_core_v1beta1_ExpirableVersion(ref common.ReferenceCallback)
 					},
 					"classification": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Classification defines the state of a version (preview, supported, deprecated). To get the currently valid classification, use CurrentLifecycleClassification().",
+							Description: "Classification defines the state of a version (preview, supported, deprecated). To get the currently valid classification, use CurrentLifecycleClassification().\n\nPossible enum values:\n - `\"deprecated\"` indicates that a patch version should not be used anymore, should be updated to a new version and will eventually expire.\n - `\"expired\"` indicates that a version has expired. New entities with that version cannot be created and existing entities are forcefully migrated to a higher version during the maintenance time.\n - `\"preview\"` indicates that a version has recently been added and not promoted to \"Supported\" yet. ClassificationPreview versions will not be considered for automatic Kubernetes and Machine Image patch version updates.\n - `\"supported\"` indicates that a patch version is the recommended version for a shoot. Only one \"supported\" version is allowed per minor version. Supported versions are eligible for the automated Kubernetes and Machine image patch version update for shoot clusters in Gardener.\n - `\"unavailable\"` indicates that a version is currently not available and is planned to become available depending on the classification lifecycle.",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"deprecated", "expired", "preview", "supported", "unavailable"},
 						},
 					},
 				},
@@ -4064,10 +4635,11 @@ func schema_pkg_apis_core_v1beta1_FailureTolerance(ref common.ReferenceCallback)
 				Properties: map[string]spec.Schema{
 					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type specifies the type of failure that the highly available resource can tolerate",
+							Description: "Type specifies the type of failure that the highly available resource can tolerate\n\nPossible enum values:\n - `\"node\"` specifies that a highly available resource can tolerate the failure of one or more nodes within a single-zone setup and still be available.\n - `\"zone\"` specifies that a highly available resource can tolerate the failure of one or more zones within a multi-zone setup and still be available.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"node", "zone"},
 						},
 					},
 				},
@@ -4231,6 +4803,14 @@ func schema_pkg_apis_core_v1beta1_Hibernation(ref common.ReferenceCallback) comm
 							},
 						},
 					},
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode determines which parts of the cluster are scaled down during hibernation. Defaults to 'Full'.\n\nPossible enum values:\n - `\"ControlPlaneMinimized\"` scales down the worker nodes and reduces the control plane to a minimal footprint while keeping the API server reachable.\n - `\"Full\"` scales down both the worker nodes and the control plane.\n - `\"WorkersOnly\"` scales down only the worker nodes and keeps the control plane running, so that the API server remains reachable.",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"ControlPlaneMinimized", "Full", "WorkersOnly"},
+						},
+					},
 				},
 			},
 		},
@@ -4585,6 +5165,94 @@ func schema_pkg_apis_core_v1beta1_InternalSecretList(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_JWTAuthenticator(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JWTAuthenticator configures a JWT issuer trusted for authenticating to the kube-apiserver.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"issuer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Issuer contains the basic settings of the JWT issuer.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AuthenticationIssuer"),
+						},
+					},
+					"claimMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClaimMappings configures how token claims are mapped to user identities.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AuthenticationClaimMappings"),
+						},
+					},
+					"claimValidationRules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClaimValidationRules are rules that are applied to validate token claims to authenticate users.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AuthenticationClaimValidationRule"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"issuer"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuthenticationClaimMappings", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AuthenticationClaimValidationRule", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AuthenticationIssuer"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_KMSEncryptionConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KMSEncryptionConfiguration contains configuration for encrypting resources with a customer-managed key via an external KMS provider.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the KMS plugin to be used. It is part of the data storage prefix in etcd, so changing it triggers a re-encryption of all affected resources.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoint is the gRPC listening address of the KMS plugin, for example \"unix:///var/run/kms-provider.sock\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretName is the name of a secret in the project namespace containing the credentials or key reference for the KMS provider. The secret is made available in the shoot's control plane namespace so that the KMS plugin can consume it.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the maximum duration of a gRPC call to the KMS plugin. Defaults to 3s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"name", "endpoint"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_KubeAPIServerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4746,26 +5414,93 @@ func schema_pkg_apis_core_v1beta1_KubeAPIServerConfig(ref common.ReferenceCallba
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlaneAutoscaling"),
 						},
 					},
+					"requestBasedAutoscaling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequestBasedAutoscaling contains configuration for horizontally scaling the kube-apiserver on request-based metrics in addition to its resource metrics.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeAPIServerRequestBasedAutoscaling"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerLogging", "github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerRequests", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AdmissionPlugin", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlaneAutoscaling", "github.com/gardener/gardener/pkg/apis/core/v1beta1.EncryptionConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.OIDCConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceAccountConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.StructuredAuthentication", "github.com/gardener/gardener/pkg/apis/core/v1beta1.StructuredAuthorization", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WatchCacheSizes", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerLogging", "github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerRequests", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AdmissionPlugin", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlaneAutoscaling", "github.com/gardener/gardener/pkg/apis/core/v1beta1.EncryptionConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeAPIServerRequestBasedAutoscaling", "github.com/gardener/gardener/pkg/apis/core/v1beta1.OIDCConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceAccountConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.StructuredAuthentication", "github.com/gardener/gardener/pkg/apis/core/v1beta1.StructuredAuthorization", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WatchCacheSizes", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_KubeControllerManagerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_KubeAPIServerRequestBasedAutoscaling(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "KubeControllerManagerConfig contains configuration settings for the kube-controller-manager.",
+				Description: "KubeAPIServerRequestBasedAutoscaling contains configuration for horizontally scaling the kube-apiserver on request-based metrics. The metrics are collected from the kube-apiserver pods by the shoot's Prometheus in the seed and require a custom metrics adapter to be available in the seed cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"featureGates": {
+					"averageRequestsPerSecond": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FeatureGates contains information about enabled feature gates.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
+							Description: "AverageRequestsPerSecond is the target average number of requests per second per kube-apiserver replica.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"averageInflightRequests": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AverageInflightRequests is the target average number of inflight requests per kube-apiserver replica.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_KubeControllerManagerConcurrentSyncs(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeControllerManagerConcurrentSyncs contains the number of concurrent workers for selected controllers of the kube-controller-manager.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"deployment": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deployment is the number of workers for the Deployment controller (default is 50).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"replicaSet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReplicaSet is the number of workers for the ReplicaSet controller (default is 50).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"statefulSet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StatefulSet is the number of workers for the StatefulSet controller (default is 15).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_KubeControllerManagerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeControllerManagerConfig contains configuration settings for the kube-controller-manager.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"featureGates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FeatureGates contains information about enabled feature gates.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -4802,11 +5537,17 @@ func schema_pkg_apis_core_v1beta1_KubeControllerManagerConfig(ref common.Referen
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"concurrentSyncs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConcurrentSyncs allows configuring the number of concurrent workers for selected controllers of the kube-controller-manager.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeControllerManagerConcurrentSyncs"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.HorizontalPodAutoscalerConfig", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.HorizontalPodAutoscalerConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeControllerManagerConcurrentSyncs", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -5804,6 +6545,59 @@ func schema_pkg_apis_core_v1beta1_MachineImageFlavor(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_MachineImageUpgradePath(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineImageUpgradePath describes the supported upgrade of a worker pool's machine image version.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"workerPool": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkerPool is the name of the worker pool.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image is the name of the machine image.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the current version of the machine image.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"next": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Next is the version the machine image can be updated to according to its update strategy, if any.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expirationDate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationDate is the deadline after which the current version is considered expired and a forceful update becomes necessary, if any.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"workerPool", "image", "version"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_MachineImageVersion(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5827,9 +6621,10 @@ func schema_pkg_apis_core_v1beta1_MachineImageVersion(ref common.ReferenceCallba
 					},
 					"classification": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Classification defines the state of a version (preview, supported, deprecated). To get the currently valid classification, use CurrentLifecycleClassification().",
+							Description: "Classification defines the state of a version (preview, supported, deprecated). To get the currently valid classification, use CurrentLifecycleClassification().\n\nPossible enum values:\n - `\"deprecated\"` indicates that a patch version should not be used anymore, should be updated to a new version and will eventually expire.\n - `\"expired\"` indicates that a version has expired. New entities with that version cannot be created and existing entities are forcefully migrated to a higher version during the maintenance time.\n - `\"preview\"` indicates that a version has recently been added and not promoted to \"Supported\" yet. ClassificationPreview versions will not be considered for automatic Kubernetes and Machine Image patch version updates.\n - `\"supported\"` indicates that a patch version is the recommended version for a shoot. Only one \"supported\" version is allowed per minor version. Supported versions are eligible for the automated Kubernetes and Machine image patch version update for shoot clusters in Gardener.\n - `\"unavailable\"` indicates that a version is currently not available and is planned to become available depending on the classification lifecycle.",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"deprecated", "expired", "preview", "supported", "unavailable"},
 						},
 					},
 					"cri": {
@@ -6111,6 +6906,13 @@ func schema_pkg_apis_core_v1beta1_MaintenanceTimeWindow(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
+					"location": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Location is the IANA timezone in which the clock times of Begin and End shall be evaluated, e.g. \"Europe/Berlin\". If set, the zone offsets in Begin and End are ignored and the time window follows daylight saving time changes of the location. If not set, the zone offsets in Begin and End apply.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"begin", "end"},
 			},
@@ -6118,6 +6920,36 @@ func schema_pkg_apis_core_v1beta1_MaintenanceTimeWindow(ref common.ReferenceCall
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ManagedAddon(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ManagedAddon refers to an addon registered via a ControllerRegistration which shall be enabled for a shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the addon as registered via the ControllerRegistration.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Config contains configuration for the addon. It is passed as provider config to the backing extension resource.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ManualWorkerPoolRollout(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6588,9 +7420,25 @@ func schema_pkg_apis_core_v1beta1_NetworkingStatus(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"egressCIDRsByZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EgressCIDRsByZone is a list of CIDRs used by the shoot as the source IP for egress traffic, grouped by the availability zone the traffic originates from (e.g. the IPs of zonal NAT gateways) as reported by the used Infrastructure extension controller. It is only populated if the egress IPs differ per zone.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ZoneEgressCIDRs"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ZoneEgressCIDRs"},
 	}
 }
 
@@ -7273,11 +8121,17 @@ func schema_pkg_apis_core_v1beta1_ProjectStatus(ref common.ReferenceCallback) co
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"usageSummary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UsageSummary aggregates the counts and capacity consumption of the shoots in this project. It is maintained by the \"project-usage\" controller of the gardener-controller-manager.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectUsageSummary"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectUsageSummary", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -7336,6 +8190,64 @@ func schema_pkg_apis_core_v1beta1_ProjectTolerations(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ProjectUsageSummary(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectUsageSummary aggregates the counts and capacity consumption of the shoots in a project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"shoots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Shoots is the total number of shoots in the project.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"hibernatedShoots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HibernatedShoots is the number of shoots in the project which are hibernated.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"nodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nodes is the maximum number of nodes the worker pools of the non-hibernated shoots in the project may scale up to.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPU is the CPU capacity the worker pools of the non-hibernated shoots in the project may consume at their maximum size.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"memory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Memory is the memory capacity the worker pools of the non-hibernated shoots in the project may consume at their maximum size.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the time when the usage summary was last updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"shoots", "hibernatedShoots", "nodes", "cpu", "memory", "lastUpdateTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_Provider(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7610,79 +8522,183 @@ func schema_pkg_apis_core_v1beta1_Region(ref common.ReferenceCallback) common.Op
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ResourceData(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_RegistryMirror(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ResourceData holds the data of a resource referred to by an extension controller state.",
+				Description: "RegistryMirror declares a mirror / alternate image location for an upstream container registry.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "kind is the kind of the referent; More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"name": {
+					"upstream": {
 						SchemaProps: spec.SchemaProps{
-							Description: "name is the name of the referent; More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names",
+							Description: "Upstream is the host (and optionally port) of the upstream container registry that is mirrored.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"hosts": {
 						SchemaProps: spec.SchemaProps{
-							Description: "apiVersion is the API version of the referent",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Hosts are the URLs of the mirror hosts to be used for the upstream, in the order in which they should be tried.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"data": {
+					"regions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data of the resource",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+							Description: "Regions restricts this mirror declaration to shoots in the given regions. If empty, the mirror applies to all regions.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"kind", "name", "data"},
+				Required: []string{"upstream", "hosts"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ResourceWatchCacheSize(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_ResourceBackupAddon(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ResourceWatchCacheSize contains configuration of the API server's watch cache size for one specific resource.",
+				Description: "ResourceBackupAddon describes configuration values for the resource-backup addon. The addon periodically exports the Kubernetes resources of the shoot cluster to a prefix scoped to the shoot's backup entry in the backup bucket of the seed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"apiGroup": {
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIGroup is the API group of the resource for which the watch cache size should be configured. An unset value is used to specify the legacy core API (e.g. for `secrets`).",
-							Type:        []string{"string"},
+							Description: "Enabled indicates whether the addon is enabled or not.",
+							Default:     false,
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"resource": {
+					"schedule": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Resource is the name of the resource for which the watch cache size should be configured (in lowercase plural form, e.g. `secrets`).",
-							Default:     "",
+							Description: "Schedule is a cron spec in standard format which determines when the resources are exported. Defaults to `0 */6 * * *` (every six hours).",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"size": {
+					"retentionPeriod": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CacheSize specifies the watch cache size that should be configured for the specified resource.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "RetentionPeriod is the duration for which exported resource backups are kept before they are garbage collected. Defaults to `168h` (seven days).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"includedNamespaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IncludedNamespaces is a list of namespaces whose resources are exported. If empty, the resources of all namespaces are exported.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"enabled"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ResourceData(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceData holds the data of a resource referred to by an extension controller state.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "kind is the kind of the referent; More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name is the name of the referent; More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "apiVersion is the API version of the referent",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"data": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Data of the resource",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+				},
+				Required: []string{"kind", "name", "data"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ResourceWatchCacheSize(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceWatchCacheSize contains configuration of the API server's watch cache size for one specific resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiGroup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIGroup is the API group of the resource for which the watch cache size should be configured. An unset value is used to specify the legacy core API (e.g. for `secrets`).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resource is the name of the resource for which the watch cache size should be configured (in lowercase plural form, e.g. `secrets`).",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CacheSize specifies the watch cache size that should be configured for the specified resource.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
@@ -7769,12 +8785,19 @@ func schema_pkg_apis_core_v1beta1_SecretBinding(ref common.ReferenceCallback) co
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SecretBindingProvider"),
 						},
 					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the latest observed status of the SecretBinding.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SecretBindingStatus"),
+						},
+					},
 				},
 				Required: []string{"secretRef"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SecretBindingProvider", "k8s.io/api/core/v1.ObjectReference", "k8s.io/api/core/v1.SecretReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SecretBindingProvider", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SecretBindingStatus", "k8s.io/api/core/v1.ObjectReference", "k8s.io/api/core/v1.SecretReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -7851,6 +8874,41 @@ func schema_pkg_apis_core_v1beta1_SecretBindingProvider(ref common.ReferenceCall
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_SecretBindingStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecretBindingStatus contains the latest observed status of a SecretBinding.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represents the latest available observations of the referenced credentials' validity.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_Seed(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7901,6 +8959,90 @@ func schema_pkg_apis_core_v1beta1_Seed(ref common.ReferenceCallback) common.Open
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_SeedAffinity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedAffinity contains affinity rules which gardener-scheduler evaluates when selecting a seed for a shoot, similar to pod (anti-)affinity.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"requiredDuringScheduling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequiredDuringScheduling is a list of label selectors. At least one of them must match the labels of a seed for the seed to be considered during scheduling.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+									},
+								},
+							},
+						},
+					},
+					"preferredDuringScheduling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreferredDuringScheduling is a list of weighted terms. The scheduler prefers the seeds with the highest sum of weights of the matching terms.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WeightedSeedAffinityTerm"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.WeightedSeedAffinityTerm", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_SeedAvailability(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedAvailability contains a compact availability history of a seed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"downtimeWindows": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DowntimeWindows is the number of downtime windows observed for the seed, i.e. how often its gardenlet stopped renewing the heartbeat Lease in time.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"accumulatedDowntimeSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AccumulatedDowntimeSeconds is the total duration in seconds of all closed downtime windows.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastDowntimeBegin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastDowntimeBegin is the time when the most recent downtime window began.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastDowntimeEnd": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastDowntimeEnd is the time when the most recent downtime window ended. It is not set while a downtime window is still open.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_SeedDNS(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8263,6 +9405,28 @@ func schema_pkg_apis_core_v1beta1_SeedSelector(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_SeedSettingDNSCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedSettingDNSCache controls the deployment of a caching DNS resolver in the seed. See https://github.com/gardener/gardener/blob/master/docs/operations/seed_settings.md#dns-cache.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled controls whether a caching DNS resolver shall be deployed into the garden namespace in the seed cluster. It caches responses (including negative responses) of the cluster DNS in order to reduce the sensitivity of high-frequency lookups performed by shoot control plane components to short upstream DNS outages.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"enabled"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdog(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8305,10 +9469,18 @@ func schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdogProber(ref common
 							Format:      "",
 						},
 					},
+					"probeInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProbeInterval is the interval in which the prober probes the kube-apiservers of shoot clusters. Defaults to 30s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 				},
 				Required: []string{"enabled"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -8327,10 +9499,18 @@ func schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdogWeeder(ref common
 							Format:      "",
 						},
 					},
+					"watchDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WatchDuration is the duration for which the weeder watches for dependant pods to transition to CrashLoopBackoff after the target service has recovered. Defaults to 5m.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 				},
 				Required: []string{"enabled"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -8379,7 +9559,7 @@ func schema_pkg_apis_core_v1beta1_SeedSettingExcessCapacityReservationConfig(ref
 				Properties: map[string]spec.Schema{
 					"resources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Resources specify the resource requests and limits of the excess-capacity-reservation pod.",
+							Description: "Resources specify the resource requests and limits of the excess-capacity-reservation pod. It is mutually exclusive with percentage and exactly one of both must be set.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -8421,8 +9601,14 @@ func schema_pkg_apis_core_v1beta1_SeedSettingExcessCapacityReservationConfig(ref
 							},
 						},
 					},
+					"percentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Percentage is the percentage of the seed cluster's allocatable cpu and memory capacity that should be reserved. The reservation is split evenly across the excess-capacity-reservation pod replicas. It is mutually exclusive with resources and exactly one of both must be set.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
-				Required: []string{"resources"},
 			},
 		},
 		Dependencies: []string{
@@ -8430,6 +9616,120 @@ func schema_pkg_apis_core_v1beta1_SeedSettingExcessCapacityReservationConfig(ref
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_SeedSettingIstioIngressGateway(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedSettingIstioIngressGateway controls the autoscaling and resource configuration of the istio ingress gateways deployed in the seed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"autoscaling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Autoscaling overrides the default autoscaling and resource configuration of the default istio ingress gateway. The configuration applies to each of its ingress gateway deployments (including zonal ones) individually.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingIstioIngressGatewayAutoscaling"),
+						},
+					},
+					"exposureClassHandlers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ExposureClassHandlers contains overrides for the istio ingress gateways of exposure class handlers.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingIstioIngressGatewayExposureClassHandler"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingIstioIngressGatewayAutoscaling", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingIstioIngressGatewayExposureClassHandler"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_SeedSettingIstioIngressGatewayAutoscaling(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedSettingIstioIngressGatewayAutoscaling contains the autoscaling and resource configuration of an istio ingress gateway.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinReplicas is the minimum number of istio-ingressgateway replicas.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxReplicas is the maximum number of istio-ingressgateway replicas.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"requests": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Requests overrides the compute resource requests of the istio-proxy container. Only the cpu and memory requests may be set.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_SeedSettingIstioIngressGatewayExposureClassHandler(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedSettingIstioIngressGatewayExposureClassHandler contains overrides for the istio ingress gateway of an exposure class handler.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the exposure class handler as configured in the gardenlet.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"autoscaling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Autoscaling overrides the autoscaling and resource configuration of the istio ingress gateway of this handler. The configuration applies to each of its ingress gateway deployments (including zonal ones) individually.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingIstioIngressGatewayAutoscaling"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingIstioIngressGatewayAutoscaling"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_SeedSettingLoadBalancerServices(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8717,11 +10017,23 @@ func schema_pkg_apis_core_v1beta1_SeedSettings(ref common.ReferenceCallback) com
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingTopologyAwareRouting"),
 						},
 					},
+					"istioIngressGateway": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IstioIngressGateway controls the autoscaling and resource configuration of the istio ingress gateways deployed in the seed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingIstioIngressGateway"),
+						},
+					},
+					"dnsCache": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSCache controls the deployment of a caching DNS resolver in the seed. See https://github.com/gardener/gardener/blob/master/docs/operations/seed_settings.md#dns-cache.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDNSCache"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdog", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingExcessCapacityReservation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingLoadBalancerServices", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingScheduling", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingTopologyAwareRouting", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingVerticalPodAutoscaler"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDNSCache", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdog", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingExcessCapacityReservation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingIstioIngressGateway", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingLoadBalancerServices", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingScheduling", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingTopologyAwareRouting", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingVerticalPodAutoscaler"},
 	}
 }
 
@@ -8936,11 +10248,17 @@ func schema_pkg_apis_core_v1beta1_SeedStatus(ref common.ReferenceCallback) commo
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation"),
 						},
 					},
+					"availability": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Availability contains a compact availability history of the seed as observed via the heartbeat Lease of its gardenlet.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedAvailability"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedAvailability", "k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -9329,11 +10647,17 @@ func schema_pkg_apis_core_v1beta1_ShootCredentialsRotation(ref common.ReferenceC
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ETCDEncryptionKeyRotation"),
 						},
 					},
+					"cloudCredentials": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CloudCredentials contains information about the rollout of rotated cloud provider credentials.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudCredentialsRotation"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CARotation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ETCDEncryptionKeyRotation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ObservabilityRotation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceAccountKeyRotation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSSHKeypairRotation"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CARotation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudCredentialsRotation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ETCDEncryptionKeyRotation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ObservabilityRotation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceAccountKeyRotation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSSHKeypairRotation"},
 	}
 }
 
@@ -9586,9 +10910,10 @@ func schema_pkg_apis_core_v1beta1_ShootSpec(ref common.ReferenceCallback) common
 					},
 					"purpose": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Purpose is the purpose class for this cluster.",
+							Description: "Purpose is the purpose class for this cluster.\n\nPossible enum values:\n - `\"development\"` is a constant for the development purpose.\n - `\"evaluation\"` is a constant for the evaluation purpose.\n - `\"infrastructure\"` is a constant for the infrastructure purpose.\n - `\"production\"` is a constant for the production purpose.\n - `\"testing\"` is a constant for the testing purpose.",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"development", "evaluation", "infrastructure", "production", "testing"},
 						},
 					},
 					"region": {
@@ -9706,12 +11031,32 @@ func schema_pkg_apis_core_v1beta1_ShootSpec(ref common.ReferenceCallback) common
 							},
 						},
 					},
+					"seedAffinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SeedAffinity contains affinity rules which gardener-scheduler evaluates when selecting a seed for this shoot. It is only respected if no seed name is set.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedAffinity"),
+						},
+					},
+					"managedAddons": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ManagedAddons contains the addons registered via `ControllerRegistration`s which shall be enabled for this shoot cluster. In contrast to the legacy `.spec.addons` field, this list is generic and backed by extensions.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ManagedAddon"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"kubernetes", "provider", "region"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AccessRestrictionWithOptions", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Addons", "github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileReference", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlane", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DNS", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Extension", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Hibernation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Kubernetes", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Maintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Monitoring", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NamedResourceReference", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Networking", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Provider", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSelector", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SystemComponents", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Toleration"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AccessRestrictionWithOptions", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Addons", "github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileReference", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlane", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DNS", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Extension", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Hibernation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Kubernetes", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Maintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ManagedAddon", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Monitoring", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NamedResourceReference", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Networking", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Provider", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedAffinity", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSelector", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SystemComponents", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Toleration"},
 	}
 }
 
@@ -10056,22 +11401,54 @@ func schema_pkg_apis_core_v1beta1_ShootStatus(ref common.ReferenceCallback) comm
 							},
 						},
 					},
-					"networking": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Networking contains information about cluster networking such as CIDRs.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkingStatus"),
-						},
-					},
-					"inPlaceUpdates": {
-						SchemaProps: spec.SchemaProps{
-							Description: "InPlaceUpdates contains information about in-place updates for the Shoot workers.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.InPlaceUpdatesStatus"),
-						},
-					},
-					"manualWorkerPoolRollout": {
+					"networking": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Networking contains information about cluster networking such as CIDRs.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkingStatus"),
+						},
+					},
+					"inPlaceUpdates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InPlaceUpdates contains information about in-place updates for the Shoot workers.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.InPlaceUpdatesStatus"),
+						},
+					},
+					"manualWorkerPoolRollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ManualWorkerPoolRollout contains information about the worker pool rollout progress.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ManualWorkerPoolRollout"),
+						},
+					},
+					"resourceConsumption": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceConsumption contains information about the resource consumption of the shoot's control plane.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlaneResourceConsumption"),
+						},
+					},
+					"workerPools": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkerPools contains the most recently observed machine counts per worker pool.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerPoolStatus"),
+									},
+								},
+							},
+						},
+					},
+					"upgradePaths": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ManualWorkerPoolRollout contains information about the worker pool rollout progress.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ManualWorkerPoolRollout"),
+							Description: "UpgradePaths contains machine-readable upgrade path recommendations for the Shoot, computed from the referenced CloudProfile.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootUpgradePaths"),
 						},
 					},
 				},
@@ -10079,7 +11456,7 @@ func schema_pkg_apis_core_v1beta1_ShootStatus(ref common.ReferenceCallback) comm
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.InPlaceUpdatesStatus", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastMaintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ManualWorkerPoolRollout", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkingStatus", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAdvertisedAddress", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentials", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlaneResourceConsumption", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.InPlaceUpdatesStatus", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastMaintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ManualWorkerPoolRollout", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkingStatus", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAdvertisedAddress", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentials", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootUpgradePaths", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerPoolStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -10112,6 +11489,55 @@ func schema_pkg_apis_core_v1beta1_ShootTemplate(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ShootUpgradePaths(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootUpgradePaths holds machine-readable upgrade path recommendations for a Shoot, computed from the referenced CloudProfile.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kubernetes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubernetes contains the upgrade path of the shoot's Kubernetes version.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.VersionUpgradePath"),
+						},
+					},
+					"machineImages": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "workerPool",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineImages contains the upgrade paths of the worker pools' machine image versions.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImageUpgradePath"),
+									},
+								},
+							},
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the time when the upgrade paths were last computed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"kubernetes", "lastUpdateTime"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImageUpgradePath", "github.com/gardener/gardener/pkg/apis/core/v1beta1.VersionUpgradePath", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_StructuredAuthentication(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -10121,16 +11547,30 @@ func schema_pkg_apis_core_v1beta1_StructuredAuthentication(ref common.ReferenceC
 				Properties: map[string]spec.Schema{
 					"configMapName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ConfigMapName is the name of the ConfigMap in the project namespace which contains AuthenticationConfiguration for the kube-apiserver.",
-							Default:     "",
+							Description: "ConfigMapName is the name of the ConfigMap in the project namespace which contains AuthenticationConfiguration for the kube-apiserver. It is mutually exclusive with jwtAuthenticators.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"jwtAuthenticators": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JWTAuthenticators is a list of JWT issuers trusted for authenticating to the kube-apiserver. It is rendered by gardenlet into an AuthenticationConfiguration for the kube-apiserver and is mutually exclusive with configMapName.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.JWTAuthenticator"),
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"configMapName"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.JWTAuthenticator"},
 	}
 }
 
@@ -10228,6 +11668,50 @@ func schema_pkg_apis_core_v1beta1_Toleration(ref common.ReferenceCallback) commo
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_VersionUpgradePath(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VersionUpgradePath describes the supported upgrades and the expiration of a version.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the current version.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nextPatch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextPatch is the latest supported patch version of the current minor version, if any.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nextMinor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextMinor is the latest supported patch version of the next higher supported minor version, if any.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expirationDate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationDate is the deadline after which the current version is considered expired and a forceful update becomes necessary, if any.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"version"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_VerticalPodAutoscaler(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -10519,6 +12003,37 @@ func schema_pkg_apis_core_v1beta1_WatchCacheSizes(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_WeightedSeedAffinityTerm(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WeightedSeedAffinityTerm is a label selector associated with a weight.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"weight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Weight associated with matching the term, in the range 1-100.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"labelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LabelSelector is matched against the labels of a seed.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+				},
+				Required: []string{"weight", "labelSelector"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_Worker(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -10736,6 +12251,13 @@ func schema_pkg_apis_core_v1beta1_Worker(ref common.ReferenceCallback) common.Op
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerControlPlane"),
 						},
 					},
+					"nodeWorkloadIdentityName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeWorkloadIdentityName is the name of a WorkloadIdentity in the same namespace as the Shoot whose identity shall be bound to the nodes of this worker pool by the provider extension (e.g., via instance profiles or managed identities). This allows workloads running on the nodes to access cloud provider APIs without static credentials being distributed to the machines.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"name", "machine", "maximum", "minimum"},
 			},
@@ -10794,6 +12316,68 @@ func schema_pkg_apis_core_v1beta1_WorkerKubernetes(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_WorkerPoolStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerPoolStatus holds the most recently observed machine counts of a worker pool, aggregated from the machine deployments in the seed cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the worker pool.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"desiredMachines": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DesiredMachines is the number of machines the worker pool should currently have.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"currentMachines": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentMachines is the number of machines the worker pool currently has.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"updatedMachines": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdatedMachines is the number of machines which already run the desired configuration.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"unavailableMachines": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UnavailableMachines is the number of machines which are not yet available.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastRolloutTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRolloutTime is the time when the worker pool rollout last progressed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"name", "desiredMachines", "currentMachines", "updatedMachines", "unavailableMachines"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_WorkerSystemComponents(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -10837,6 +12421,43 @@ func schema_pkg_apis_core_v1beta1_WorkersSettings(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ZoneEgressCIDRs(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ZoneEgressCIDRs contains the egress CIDRs used by worker nodes in a single availability zone.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"zone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zone is the name of the availability zone.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cidrs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CIDRs is a list of CIDRs used as the source IP for egress traffic originating from this zone.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"zone", "cidrs"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_operations_v1alpha1_Bastion(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -11190,12 +12811,19 @@ func schema_pkg_apis_security_v1alpha1_CredentialsBinding(ref common.ReferenceCa
 							},
 						},
 					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the latest observed status of the CredentialsBinding.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/security/v1alpha1.CredentialsBindingStatus"),
+						},
+					},
 				},
 				Required: []string{"provider", "credentialsRef"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/security/v1alpha1.CredentialsBindingProvider", "k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/security/v1alpha1.CredentialsBindingProvider", "github.com/gardener/gardener/pkg/apis/security/v1alpha1.CredentialsBindingStatus", "k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -11272,6 +12900,41 @@ func schema_pkg_apis_security_v1alpha1_CredentialsBindingProvider(ref common.Ref
 	}
 }
 
+func schema_pkg_apis_security_v1alpha1_CredentialsBindingStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CredentialsBindingStatus contains the latest observed status of a CredentialsBinding.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represents the latest available observations of the referenced credentials' validity.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition"},
+	}
+}
+
 func schema_pkg_apis_security_v1alpha1_TargetSystem(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -11656,6 +13319,13 @@ func schema_pkg_apis_seedmanagement_v1alpha1_GardenletConfig(ref common.Referenc
 							Format:      "",
 						},
 					},
+					"upgradeChannel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpgradeChannel is the name of the upgrade channel from which the gardenlet version for this seed is resolved. One of Stable, Fast. If specified, the gardenlet image tag is managed by the managedseed controller according to the channel declarations published in the garden cluster and must not be set in the deployment section.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
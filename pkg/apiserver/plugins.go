@@ -26,11 +26,15 @@ import (
 	shootdns "github.com/gardener/gardener/plugin/pkg/shoot/dns"
 	shootdnsrewriting "github.com/gardener/gardener/plugin/pkg/shoot/dnsrewriting"
 	shootexposureclass "github.com/gardener/gardener/plugin/pkg/shoot/exposureclass"
+	shootfieldimmutability "github.com/gardener/gardener/plugin/pkg/shoot/fieldimmutability"
+	shootmaintenancewindow "github.com/gardener/gardener/plugin/pkg/shoot/maintenancewindow"
 	shootmanagedseed "github.com/gardener/gardener/plugin/pkg/shoot/managedseed"
 	shootmutator "github.com/gardener/gardener/plugin/pkg/shoot/mutator"
 	shootnodelocaldns "github.com/gardener/gardener/plugin/pkg/shoot/nodelocaldns"
 	"github.com/gardener/gardener/plugin/pkg/shoot/oidc/clusteropenidconnectpreset"
 	"github.com/gardener/gardener/plugin/pkg/shoot/oidc/openidconnectpreset"
+	shootpodsecurity "github.com/gardener/gardener/plugin/pkg/shoot/podsecurity"
+	shootpurposepolicy "github.com/gardener/gardener/plugin/pkg/shoot/purposepolicy"
 	shootquotavalidator "github.com/gardener/gardener/plugin/pkg/shoot/quotavalidator"
 	shootresourcereservation "github.com/gardener/gardener/plugin/pkg/shoot/resourcereservation"
 	shoottolerationrestriction "github.com/gardener/gardener/plugin/pkg/shoot/tolerationrestriction"
@@ -52,6 +56,8 @@ func RegisterAllAdmissionPlugins(plugins *admission.Plugins) {
 	shootdns.Register(plugins)
 	shootmanagedseed.Register(plugins)
 	shootnodelocaldns.Register(plugins)
+	shootpodsecurity.Register(plugins)
+	shootpurposepolicy.Register(plugins)
 	shootdnsrewriting.Register(plugins)
 	shootmutator.Register(plugins)
 	shootvalidator.Register(plugins)
@@ -69,5 +75,7 @@ func RegisterAllAdmissionPlugins(plugins *admission.Plugins) {
 	resourcequota.Register(plugins)
 	shootvpa.Register(plugins)
 	shootresourcereservation.Register(plugins)
+	shootfieldimmutability.Register(plugins)
+	shootmaintenancewindow.Register(plugins)
 	backupbucketvalidator.Register(plugins)
 }
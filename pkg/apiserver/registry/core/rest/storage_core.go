@@ -38,12 +38,14 @@ import (
 
 // StorageProvider contains configurations related to the core resources.
 type StorageProvider struct {
-	AdminKubeconfigMaxExpiration  time.Duration
-	ViewerKubeconfigMaxExpiration time.Duration
-	CredentialsRotationInterval   time.Duration
-	KubeInformerFactory           kubeinformers.SharedInformerFactory
-	CoreInformerFactory           gardencoreinformers.SharedInformerFactory
-	SubjectAccessReviewer         clientauthorizationv1.SubjectAccessReviewInterface
+	AdminKubeconfigMaxExpiration   time.Duration
+	ViewerKubeconfigMaxExpiration  time.Duration
+	CredentialsRotationInterval    time.Duration
+	ShootSubresourceRateLimitQPS   float32
+	ShootSubresourceRateLimitBurst int
+	KubeInformerFactory            kubeinformers.SharedInformerFactory
+	CoreInformerFactory            gardencoreinformers.SharedInformerFactory
+	SubjectAccessReviewer          clientauthorizationv1.SubjectAccessReviewInterface
 }
 
 // NewRESTStorage creates a new API group info object and registers the v1beta1 core storage.
@@ -127,12 +129,15 @@ func (p StorageProvider) v1beta1Storage(restOptionsGetter generic.RESTOptionsGet
 		p.ViewerKubeconfigMaxExpiration,
 		p.CredentialsRotationInterval,
 		p.SubjectAccessReviewer,
+		p.ShootSubresourceRateLimitQPS,
+		p.ShootSubresourceRateLimitBurst,
 	)
 	storage["shoots"] = shootStorage.Shoot
 	storage["shoots/status"] = shootStorage.Status
 	storage["shoots/binding"] = shootStorage.Binding
 	storage["shoots/adminkubeconfig"] = shootStorage.AdminKubeconfig
 	storage["shoots/viewerkubeconfig"] = shootStorage.ViewerKubeconfig
+	storage["shoots/adminsshconfig"] = shootStorage.AdminSSHConfig
 
 	return storage
 }
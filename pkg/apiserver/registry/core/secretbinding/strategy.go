@@ -34,6 +34,8 @@ func (s secretBindingStrategy) PrepareForCreate(_ context.Context, obj runtime.O
 	if binding.GetName() == "" {
 		binding.SetName(s.GenerateName(binding.GetGenerateName()))
 	}
+
+	binding.Status = core.SecretBindingStatus{}
 }
 
 func (secretBindingStrategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
@@ -14,6 +14,7 @@ import (
 
 	"github.com/gardener/gardener/pkg/api"
 	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/core/deprecation"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/apis/core/validation"
 )
@@ -114,13 +115,13 @@ func (Strategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object)
 }
 
 // WarningsOnCreate returns warnings to the client performing a create.
-func (Strategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []string {
-	return nil
+func (Strategy) WarningsOnCreate(_ context.Context, obj runtime.Object) []string {
+	return deprecation.Warnings(obj.(*core.Seed), deprecation.SeedDeprecations)
 }
 
 // WarningsOnUpdate returns warnings to the client performing the update.
-func (Strategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
-	return nil
+func (Strategy) WarningsOnUpdate(_ context.Context, obj, _ runtime.Object) []string {
+	return deprecation.Warnings(obj.(*core.Seed), deprecation.SeedDeprecations)
 }
 
 // StatusStrategy defines the strategy for storing seeds statuses.
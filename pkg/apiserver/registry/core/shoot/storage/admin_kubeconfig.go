@@ -29,6 +29,7 @@ func NewAdminKubeconfigREST(
 	configMapLister kubecorev1listers.ConfigMapLister,
 	maxExpiration time.Duration,
 	subjectAccessReviewer clientauthorizationv1.SubjectAccessReviewInterface,
+	rateLimiter *SubresourceRateLimiter,
 ) *KubeconfigREST {
 	return &KubeconfigREST{
 		secretLister:          secretLister,
@@ -37,12 +38,14 @@ func NewAdminKubeconfigREST(
 		subjectAccessReviewer: subjectAccessReviewer,
 		shootStorage:          shootGetter,
 		maxExpirationSeconds:  int64(maxExpiration.Seconds()),
+		rateLimiter:           rateLimiter,
 
 		gvk: schema.GroupVersionKind{
 			Group:   authenticationv1alpha1.SchemeGroupVersion.Group,
 			Version: authenticationv1alpha1.SchemeGroupVersion.Version,
 			Kind:    "AdminKubeconfigRequest",
 		},
+		subresource: "adminkubeconfig",
 		newObjectFunc: func() runtime.Object {
 			return &authenticationv1alpha1.AdminKubeconfigRequest{}
 		},
@@ -0,0 +1,205 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"slices"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	clientauthorizationv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
+
+	"github.com/gardener/gardener/pkg/api"
+	authenticationapi "github.com/gardener/gardener/pkg/apis/authentication"
+	authenticationv1alpha1 "github.com/gardener/gardener/pkg/apis/authentication/v1alpha1"
+	authenticationvalidation "github.com/gardener/gardener/pkg/apis/authentication/validation"
+	"github.com/gardener/gardener/pkg/apis/core"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+// SSHCertificatePrincipal is the principal the issued SSH certificates are valid for. It matches the user which is
+// created on the shoot nodes and whose authorized keys contain the shoot's SSH certificate authority.
+const SSHCertificatePrincipal = "gardener"
+
+// SSHConfigREST implements a RESTStorage for an SSH config request.
+type SSHConfigREST struct {
+	internalSecretLister  gardencorev1beta1listers.InternalSecretLister
+	shootStorage          getter
+	maxExpirationSeconds  int64
+	subjectAccessReviewer clientauthorizationv1.SubjectAccessReviewInterface
+	rateLimiter           *SubresourceRateLimiter
+
+	gvk         schema.GroupVersionKind
+	subresource string
+}
+
+var (
+	_ = rest.NamedCreater(&SSHConfigREST{})
+	_ = rest.GroupVersionKindProvider(&SSHConfigREST{})
+)
+
+// NewAdminSSHConfigREST returns a new SSHConfigREST for admin SSH configs.
+func NewAdminSSHConfigREST(
+	shootGetter getter,
+	internalSecretLister gardencorev1beta1listers.InternalSecretLister,
+	maxExpiration time.Duration,
+	subjectAccessReviewer clientauthorizationv1.SubjectAccessReviewInterface,
+	rateLimiter *SubresourceRateLimiter,
+) *SSHConfigREST {
+	return &SSHConfigREST{
+		internalSecretLister:  internalSecretLister,
+		shootStorage:          shootGetter,
+		maxExpirationSeconds:  int64(maxExpiration.Seconds()),
+		subjectAccessReviewer: subjectAccessReviewer,
+		rateLimiter:           rateLimiter,
+
+		gvk: schema.GroupVersionKind{
+			Group:   authenticationv1alpha1.SchemeGroupVersion.Group,
+			Version: authenticationv1alpha1.SchemeGroupVersion.Version,
+			Kind:    "AdminSSHConfigRequest",
+		},
+		subresource: "adminsshconfig",
+	}
+}
+
+// New returns an instance of the object.
+func (r *SSHConfigREST) New() runtime.Object {
+	return &authenticationv1alpha1.AdminSSHConfigRequest{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *SSHConfigREST) Destroy() {
+	// Given that underlying store is shared with REST, we don't destroy it here explicitly.
+}
+
+// Create returns an SSH config request with a short-lived SSH certificate based on
+// - the public key provided in the request
+// - the shoot's SSH certificate authority
+// - user making the request
+// The shoot's nodes trust certificates signed by the shoot's SSH certificate authority, so the session can be
+// established through the Gardener-managed reverse tunnel without a bastion host.
+func (r *SSHConfigREST) Create(ctx context.Context, name string, obj runtime.Object, createValidation rest.ValidateObjectFunc, _ *metav1.CreateOptions) (runtime.Object, error) {
+	if createValidation != nil {
+		if err := createValidation(ctx, obj.DeepCopyObject()); err != nil {
+			return nil, err
+		}
+	}
+
+	sshConfigRequest := &authenticationapi.SSHConfigRequest{}
+	if err := api.Scheme.Convert(obj, sshConfigRequest, nil); err != nil {
+		return nil, fmt.Errorf("failed converting %T to %T: %w", obj, sshConfigRequest, err)
+	}
+
+	if errs := authenticationvalidation.ValidateSSHConfigRequest(sshConfigRequest); len(errs) != 0 {
+		return nil, apierrors.NewInvalid(r.gvk.GroupKind(), "", errs)
+	}
+
+	userInfo, ok := genericapirequest.UserFrom(ctx)
+	if !ok {
+		return nil, apierrors.NewBadRequest("no user in context")
+	}
+
+	groups, err := getAdminUserGroups(ctx, userInfo, r.subjectAccessReviewer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user groups: %w", err)
+	}
+
+	// system administrators get priority over project users and are not subject to the rate limits
+	if !slices.Contains(groups, v1beta1constants.ShootSystemAdminsGroupName) {
+		if err := r.rateLimiter.Accept(ctx, r.subresource, userInfo); err != nil {
+			return nil, err
+		}
+	}
+
+	// prepare: get shoot object
+	shootObj, err := r.shootStorage.Get(ctx, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	shoot, ok := shootObj.(*core.Shoot)
+	if !ok {
+		return nil, apierrors.NewInternalError(fmt.Errorf("cannot convert to *core.Shoot object - got type %T", shootObj))
+	}
+
+	// prepare: get SSH CA
+	caSSHSecret, err := r.internalSecretLister.InternalSecrets(shoot.Namespace).Get(gardenerutils.ComputeShootProjectResourceName(shoot.Name, gardenerutils.ShootProjectSecretSuffixCASSH))
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("could not get SSH CA secret: %w", err))
+	}
+
+	caSigner, err := ssh.ParsePrivateKey(caSSHSecret.Data[secrets.DataKeyRSAPrivateKey])
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("could not parse SSH CA private key from secret: %w", err))
+	}
+
+	publicKey, _, _, _, err := ssh.ParseAuthorizedKey(sshConfigRequest.Spec.PublicKey)
+	if err != nil {
+		return nil, apierrors.NewInvalid(r.gvk.GroupKind(), shoot.Name, field.ErrorList{field.Invalid(field.NewPath("spec", "publicKey"), string(sshConfigRequest.Spec.PublicKey), fmt.Sprintf("cannot parse SSH public key: %v", err))})
+	}
+
+	// sign a short-lived certificate for the provided public key
+	if r.maxExpirationSeconds > 0 && sshConfigRequest.Spec.ExpirationSeconds > r.maxExpirationSeconds {
+		sshConfigRequest.Spec.ExpirationSeconds = r.maxExpirationSeconds
+	}
+
+	var serial uint64
+	if err := binary.Read(rand.Reader, binary.BigEndian, &serial); err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("failed to generate certificate serial number: %w", err))
+	}
+
+	var (
+		now            = time.Now().Truncate(time.Second)
+		expirationTime = now.Add(time.Duration(sshConfigRequest.Spec.ExpirationSeconds) * time.Second)
+		certificate    = &ssh.Certificate{
+			Key:             publicKey,
+			Serial:          serial,
+			CertType:        ssh.UserCert,
+			KeyId:           userInfo.GetName(),
+			ValidPrincipals: []string{SSHCertificatePrincipal},
+			ValidAfter:      uint64(now.Add(-5 * time.Minute).Unix()),
+			ValidBefore:     uint64(expirationTime.Unix()),
+			Permissions: ssh.Permissions{
+				Extensions: map[string]string{
+					"permit-port-forwarding": "",
+					"permit-pty":             "",
+				},
+			},
+		}
+	)
+
+	if err := certificate.SignCert(rand.Reader, caSigner); err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("could not sign SSH certificate: %w", err))
+	}
+
+	// return signed certificate in status
+	sshConfigRequest.Status.Certificate = ssh.MarshalAuthorizedKey(certificate)
+	sshConfigRequest.Status.ExpirationTimestamp = metav1.Time{Time: expirationTime}
+
+	if err := api.Scheme.Convert(sshConfigRequest, obj, nil); err != nil {
+		return nil, fmt.Errorf("failed converting %T to %T: %w", sshConfigRequest, obj, err)
+	}
+
+	return obj, nil
+}
+
+// GroupVersionKind returns the GVK for the SSH config request type.
+func (r *SSHConfigREST) GroupVersionKind(schema.GroupVersion) schema.GroupVersionKind {
+	return r.gvk
+}
@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	registryrest "k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/utils/ptr"
+
+	authenticationv1alpha1 "github.com/gardener/gardener/pkg/apis/authentication/v1alpha1"
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+var _ = Describe("Admin SSH Config", func() {
+	var (
+		ctx context.Context
+		obj *authenticationv1alpha1.AdminSSHConfigRequest
+
+		shoot       *gardencore.Shoot
+		caSSHSecret *gardencorev1beta1.InternalSecret
+
+		scREST           *SSHConfigREST
+		createValidation registryrest.ValidateObjectFunc
+
+		shootGetter           *fakeGetter
+		internalSecretLister  *fakeInternalSecretLister
+		subjectAccessReviewer *fakeSubjectAccessReviewer
+
+		caPublicKey ssh.PublicKey
+	)
+
+	const (
+		name      = "test"
+		userName  = "foo"
+		namespace = "baz"
+	)
+
+	BeforeEach(func() {
+		caPrivateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+
+		caSigner, err := ssh.NewSignerFromKey(caPrivateKey)
+		Expect(err).NotTo(HaveOccurred())
+		caPublicKey = caSigner.PublicKey()
+
+		caSSHSecret = &gardencorev1beta1.InternalSecret{
+			ObjectMeta: metav1.ObjectMeta{Name: name + ".ca-ssh", Namespace: namespace},
+			Data: map[string][]byte{
+				"id_rsa": pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(caPrivateKey)}),
+			},
+		}
+
+		clientPrivateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+		clientPublicKey, err := ssh.NewPublicKey(&clientPrivateKey.PublicKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		obj = &authenticationv1alpha1.AdminSSHConfigRequest{
+			Spec: authenticationv1alpha1.AdminSSHConfigRequestSpec{
+				PublicKey:         ssh.MarshalAuthorizedKey(clientPublicKey),
+				ExpirationSeconds: ptr.To(int64(time.Minute.Seconds() * 11)),
+			},
+		}
+
+		createValidation = func(_ context.Context, _ runtime.Object) error { return nil }
+		shoot = &gardencore.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		}
+
+		shootGetter = &fakeGetter{obj: shoot}
+		internalSecretLister = &fakeInternalSecretLister{obj: caSSHSecret}
+		subjectAccessReviewer = &fakeSubjectAccessReviewer{allowed: true, reason: "allowed"}
+
+		scREST = NewAdminSSHConfigREST(shootGetter, internalSecretLister, time.Hour, subjectAccessReviewer, nil)
+
+		ctx = request.WithUser(context.Background(), &user.DefaultInfo{
+			Name: userName,
+		})
+	})
+
+	Context("request fails", func() {
+		var (
+			actual runtime.Object
+			err    error
+		)
+
+		AfterEach(func() {
+			actual, err = scREST.Create(ctx, name, obj, createValidation, nil)
+
+			Expect(err).To(HaveOccurred())
+			Expect(actual).To(BeNil())
+		})
+
+		It("returns an error if create validation fails", func() {
+			createValidation = func(_ context.Context, _ runtime.Object) error {
+				return errors.New("some error")
+			}
+		})
+
+		It("returns an error if validation fails", func() {
+			obj.Spec.ExpirationSeconds = ptr.To(int64(-1))
+		})
+
+		It("returns an error if there is no user in the context", func() {
+			ctx = context.TODO()
+		})
+
+		It("returns an error if it cannot get the shoot", func() {
+			shootGetter.err = errors.New("can't get shoot")
+		})
+
+		It("returns an error if it cannot get the ca-ssh secret", func() {
+			internalSecretLister.err = errors.New("fake")
+		})
+
+		It("returns an error if the ca-ssh secret doesn't exist", func() {
+			internalSecretLister.err = apierrors.NewNotFound(gardencore.Resource("internalsecrets"), caSSHSecret.Name)
+		})
+
+		It("returns an error if the ca-ssh secret is missing the private key", func() {
+			delete(caSSHSecret.Data, "id_rsa")
+		})
+
+		It("returns an error if the public key cannot be parsed", func() {
+			obj.Spec.PublicKey = []byte("not-a-valid-public-key")
+		})
+	})
+
+	Context("request succeeds", func() {
+		var create = func() (*authenticationv1alpha1.AdminSSHConfigRequest, *ssh.Certificate) {
+			actual, err := scREST.Create(ctx, name, obj, createValidation, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual).NotTo(BeNil())
+
+			req, ok := actual.(*authenticationv1alpha1.AdminSSHConfigRequest)
+			Expect(ok).To(BeTrue())
+
+			parsed, _, _, _, err := ssh.ParseAuthorizedKey(req.Status.Certificate)
+			Expect(err).NotTo(HaveOccurred())
+			certificate, ok := parsed.(*ssh.Certificate)
+			Expect(ok).To(BeTrue())
+
+			Expect(certificate.SignatureKey.Marshal()).To(Equal(caPublicKey.Marshal()))
+			Expect(certificate.CertType).To(Equal(uint32(ssh.UserCert)))
+			Expect(certificate.KeyId).To(Equal(userName))
+			Expect(certificate.ValidPrincipals).To(ConsistOf(SSHCertificatePrincipal))
+			Expect(req.Status.ExpirationTimestamp.Time).To(Equal(time.Unix(int64(certificate.ValidBefore), 0)))
+
+			return req, certificate
+		}
+
+		It("issues a certificate with the requested validity", func() {
+			req, _ := create()
+			Expect(req.Status.ExpirationTimestamp.Time).To(BeTemporally("~", time.Now().Add(11*time.Minute), time.Minute))
+		})
+
+		It("caps the validity at the configured maximum", func() {
+			obj.Spec.ExpirationSeconds = ptr.To(int64((24 * time.Hour).Seconds()))
+
+			req, _ := create()
+			Expect(req.Status.ExpirationTimestamp.Time).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+		})
+	})
+})
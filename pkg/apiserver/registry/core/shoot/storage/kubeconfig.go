@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"slices"
 	"time"
 
 	authorizationv1 "k8s.io/api/authorization/v1"
@@ -42,8 +43,10 @@ type KubeconfigREST struct {
 	shootStorage          getter
 	maxExpirationSeconds  int64
 	subjectAccessReviewer clientauthorizationv1.SubjectAccessReviewInterface
+	rateLimiter           *SubresourceRateLimiter
 
 	gvk            schema.GroupVersionKind
+	subresource    string
 	newObjectFunc  func() runtime.Object
 	userGroupsFunc func(context.Context, user.Info, clientauthorizationv1.SubjectAccessReviewInterface) ([]string, error)
 }
@@ -94,6 +97,13 @@ func (r *KubeconfigREST) Create(ctx context.Context, name string, obj runtime.Ob
 		return nil, fmt.Errorf("failed to get user groups: %w", err)
 	}
 
+	// system administrators and viewers get priority over project users and are not subject to the rate limits
+	if !slices.Contains(groups, v1beta1constants.ShootSystemAdminsGroupName) && !slices.Contains(groups, v1beta1constants.ShootSystemViewersGroupName) {
+		if err := r.rateLimiter.Accept(ctx, r.subresource, userInfo); err != nil {
+			return nil, err
+		}
+	}
+
 	// prepare: get shoot object
 	shootObj, err := r.shootStorage.Get(ctx, name, &metav1.GetOptions{})
 	if err != nil {
@@ -37,7 +37,7 @@ import (
 )
 
 func kubeconfigTests(
-	newKubeconfigREST func(getter, kubecorev1listers.SecretLister, gardencorev1beta1listers.InternalSecretLister, kubecorev1listers.ConfigMapLister, time.Duration, clientauthorizationv1.SubjectAccessReviewInterface) *KubeconfigREST,
+	newKubeconfigREST func(getter, kubecorev1listers.SecretLister, gardencorev1beta1listers.InternalSecretLister, kubecorev1listers.ConfigMapLister, time.Duration, clientauthorizationv1.SubjectAccessReviewInterface, *SubresourceRateLimiter) *KubeconfigREST,
 	newObjectFunc func() runtime.Object,
 	setExpirationSeconds func(runtime.Object, *int64),
 	getExpirationTimestamp func(runtime.Object) metav1.Time,
@@ -177,7 +177,7 @@ lIwEl8tStnO9u1JUK4w1e+lC37zI2v5k4WMQmJcolUEMwmZjnCR/
 
 		obj = newObjectFunc()
 
-		kcREST = newKubeconfigREST(shootGetter, secretLister, internalSecretLister, configMapLister, time.Hour, subjectAccessReviewer)
+		kcREST = newKubeconfigREST(shootGetter, secretLister, internalSecretLister, configMapLister, time.Hour, subjectAccessReviewer, nil)
 
 		ctx = request.WithUser(context.Background(), &user.DefaultInfo{
 			Name: userName,
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+
+	"golang.org/x/time/rate"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+)
+
+// SubresourceRateLimiter limits requests to expensive Shoot subresources like adminkubeconfig, viewerkubeconfig and
+// binding. Requests are limited per user and project namespace so that a single automated client cannot overwhelm the
+// credential issuance paths, while operators and gardenlets are exempted from the limits.
+type SubresourceRateLimiter struct {
+	qps   rate.Limit
+	burst int
+
+	mutex    sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+// NewSubresourceRateLimiter returns a new SubresourceRateLimiter for the given configuration. It returns nil if the
+// given QPS is not positive, i.e., if rate limiting is disabled. A nil SubresourceRateLimiter accepts all requests.
+func NewSubresourceRateLimiter(qps float32, burst int) *SubresourceRateLimiter {
+	if qps <= 0 {
+		return nil
+	}
+
+	return &SubresourceRateLimiter{
+		qps:      rate.Limit(qps),
+		burst:    burst,
+		limiters: map[string]*rate.Limiter{},
+	}
+}
+
+// Accept checks whether the given user is allowed to perform another request to the given subresource in the project
+// namespace of the request context. It returns a "too many requests" error when the rate limit for the user and
+// namespace is exhausted.
+func (r *SubresourceRateLimiter) Accept(ctx context.Context, subresource string, userInfo user.Info) error {
+	if r == nil {
+		return nil
+	}
+
+	// Operators and gardenlets get priority over (potentially automated) project users and are not subject to the
+	// rate limits.
+	if slices.Contains(userInfo.GetGroups(), user.SystemPrivilegedGroup) ||
+		slices.Contains(userInfo.GetGroups(), v1beta1constants.SeedsGroup) {
+		return nil
+	}
+
+	namespace, _ := genericapirequest.NamespaceFrom(ctx)
+	key := namespace + "/" + userInfo.GetName()
+
+	r.mutex.Lock()
+	limiter, ok := r.limiters[key]
+	if !ok {
+		limiter = rate.NewLimiter(r.qps, r.burst)
+		r.limiters[key] = limiter
+	}
+	r.mutex.Unlock()
+
+	if !limiter.Allow() {
+		return apierrors.NewTooManyRequests(
+			fmt.Sprintf("rate limit for shoots/%s exceeded for user %q in namespace %q, try again later", subresource, userInfo.GetName(), namespace),
+			int(math.Ceil(1/float64(r.qps))),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+)
+
+var _ = Describe("SubresourceRateLimiter", func() {
+	var (
+		ctx      context.Context
+		userInfo *user.DefaultInfo
+	)
+
+	BeforeEach(func() {
+		ctx = genericapirequest.WithNamespace(context.Background(), "garden-foo")
+		userInfo = &user.DefaultInfo{Name: "bot"}
+	})
+
+	Describe("#NewSubresourceRateLimiter", func() {
+		It("should return nil if the QPS is not positive", func() {
+			Expect(NewSubresourceRateLimiter(0, 10)).To(BeNil())
+			Expect(NewSubresourceRateLimiter(-1, 10)).To(BeNil())
+		})
+	})
+
+	Describe("#Accept", func() {
+		It("should accept all requests if the rate limiter is nil", func() {
+			var rateLimiter *SubresourceRateLimiter
+
+			for i := 0; i < 10; i++ {
+				Expect(rateLimiter.Accept(ctx, "adminkubeconfig", userInfo)).To(Succeed())
+			}
+		})
+
+		It("should reject requests exceeding the rate limit with a 'too many requests' error", func() {
+			rateLimiter := NewSubresourceRateLimiter(0.001, 1)
+
+			Expect(rateLimiter.Accept(ctx, "adminkubeconfig", userInfo)).To(Succeed())
+
+			err := rateLimiter.Accept(ctx, "adminkubeconfig", userInfo)
+			Expect(apierrors.IsTooManyRequests(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring(`rate limit for shoots/adminkubeconfig exceeded for user "bot" in namespace "garden-foo"`)))
+		})
+
+		It("should limit requests per user and namespace", func() {
+			rateLimiter := NewSubresourceRateLimiter(0.001, 1)
+
+			Expect(rateLimiter.Accept(ctx, "adminkubeconfig", userInfo)).To(Succeed())
+			Expect(rateLimiter.Accept(ctx, "adminkubeconfig", userInfo)).NotTo(Succeed())
+
+			Expect(rateLimiter.Accept(ctx, "adminkubeconfig", &user.DefaultInfo{Name: "other"})).To(Succeed())
+			Expect(rateLimiter.Accept(genericapirequest.WithNamespace(context.Background(), "garden-bar"), "adminkubeconfig", userInfo)).To(Succeed())
+		})
+
+		It("should not limit operators and gardenlets", func() {
+			rateLimiter := NewSubresourceRateLimiter(0.001, 1)
+
+			for i := 0; i < 10; i++ {
+				Expect(rateLimiter.Accept(ctx, "binding", &user.DefaultInfo{Name: "admin", Groups: []string{user.SystemPrivilegedGroup}})).To(Succeed())
+				Expect(rateLimiter.Accept(ctx, "binding", &user.DefaultInfo{Name: "gardenlet", Groups: []string{"gardener.cloud:system:seeds"}})).To(Succeed())
+			}
+		})
+	})
+})
@@ -10,6 +10,7 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
 	"k8s.io/apiserver/pkg/registry/generic"
 	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
 	"k8s.io/apiserver/pkg/registry/rest"
@@ -33,6 +34,7 @@ type ShootStorage struct {
 	Status           *StatusREST
 	AdminKubeconfig  *KubeconfigREST
 	ViewerKubeconfig *KubeconfigREST
+	AdminSSHConfig   *SSHConfigREST
 	Binding          *BindingREST
 }
 
@@ -46,15 +48,20 @@ func NewStorage(
 	viewerKubeconfigMaxExpiration time.Duration,
 	credentialsRotationInterval time.Duration,
 	subjectAccessReviewer clientauthorizationv1.SubjectAccessReviewInterface,
+	subresourceRateLimitQPS float32,
+	subresourceRateLimitBurst int,
 ) ShootStorage {
 	shootRest, shootStatusRest, bindingREST := NewREST(optsGetter, credentialsRotationInterval)
+	rateLimiter := NewSubresourceRateLimiter(subresourceRateLimitQPS, subresourceRateLimitBurst)
+	bindingREST.rateLimiter = rateLimiter
 
 	return ShootStorage{
 		Shoot:            shootRest,
 		Status:           shootStatusRest,
 		Binding:          bindingREST,
-		AdminKubeconfig:  NewAdminKubeconfigREST(shootRest, secretLister, internalSecretLister, configMapLister, adminKubeconfigMaxExpiration, subjectAccessReviewer),
-		ViewerKubeconfig: NewViewerKubeconfigREST(shootRest, secretLister, internalSecretLister, configMapLister, viewerKubeconfigMaxExpiration, subjectAccessReviewer),
+		AdminKubeconfig:  NewAdminKubeconfigREST(shootRest, secretLister, internalSecretLister, configMapLister, adminKubeconfigMaxExpiration, subjectAccessReviewer, rateLimiter),
+		ViewerKubeconfig: NewViewerKubeconfigREST(shootRest, secretLister, internalSecretLister, configMapLister, viewerKubeconfigMaxExpiration, subjectAccessReviewer, rateLimiter),
+		AdminSSHConfig:   NewAdminSSHConfigREST(shootRest, internalSecretLister, adminKubeconfigMaxExpiration, subjectAccessReviewer, rateLimiter),
 	}
 }
 
@@ -137,7 +144,8 @@ func (r *StatusREST) Update(ctx context.Context, name string, objInfo rest.Updat
 
 // BindingREST implements the REST endpoint for changing the binding of a Shoot.
 type BindingREST struct {
-	store *genericregistry.Store
+	store       *genericregistry.Store
+	rateLimiter *SubresourceRateLimiter
 }
 
 var (
@@ -164,6 +172,12 @@ func (r *BindingREST) Get(ctx context.Context, name string, options *metav1.GetO
 
 // Update alters the binding subset of an object.
 func (r *BindingREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	if userInfo, ok := genericapirequest.UserFrom(ctx); ok {
+		if err := r.rateLimiter.Accept(ctx, "binding", userInfo); err != nil {
+			return nil, false, err
+		}
+	}
+
 	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, forceAllowCreate, options)
 }
 
@@ -29,6 +29,7 @@ func NewViewerKubeconfigREST(
 	configMapLister kubecorev1listers.ConfigMapLister,
 	maxExpiration time.Duration,
 	subjectAccessReviewer clientauthorizationv1.SubjectAccessReviewInterface,
+	rateLimiter *SubresourceRateLimiter,
 ) *KubeconfigREST {
 	return &KubeconfigREST{
 		secretLister:          secretLister,
@@ -37,12 +38,14 @@ func NewViewerKubeconfigREST(
 		shootStorage:          shootGetter,
 		maxExpirationSeconds:  int64(maxExpiration.Seconds()),
 		subjectAccessReviewer: subjectAccessReviewer,
+		rateLimiter:           rateLimiter,
 
 		gvk: schema.GroupVersionKind{
 			Group:   authenticationv1alpha1.SchemeGroupVersion.Group,
 			Version: authenticationv1alpha1.SchemeGroupVersion.Version,
 			Kind:    "ViewerKubeconfigRequest",
 		},
+		subresource: "viewerkubeconfig",
 		newObjectFunc: func() runtime.Object {
 			return &authenticationv1alpha1.ViewerKubeconfigRequest{}
 		},
@@ -143,10 +143,19 @@ func mustIncreaseGeneration(oldShoot, newShoot *core.Shoot) bool {
 					v1beta1constants.OperationRotateETCDEncryptionKey,
 					v1beta1constants.OperationRotateETCDEncryptionKeyStart,
 					v1beta1constants.OperationRotateETCDEncryptionKeyComplete,
-					v1beta1constants.OperationRotateObservabilityCredentials:
+					v1beta1constants.OperationRotateObservabilityCredentials,
+					v1beta1constants.ShootOperationRotateCloudCredentials:
 					// We don't want to remove the annotation so that the gardenlet can pick it up and perform
 					// the rotation. It has to remove the annotation after it is done.
 					mustIncrease = true
+
+				case v1beta1constants.ShootOperationReconcileInfrastructure,
+					v1beta1constants.ShootOperationReconcileDNSRecords,
+					v1beta1constants.ShootOperationReconcileControlPlane,
+					v1beta1constants.ShootOperationReconcileSystemComponents:
+					// We don't want to remove the annotation so that the gardenlet can pick it up and run the partial
+					// reconciliation flow. It has to remove the annotation after it is done.
+					mustIncrease = true
 				case v1beta1constants.OperationRotateCredentialsStart:
 					// We remove operations that are covered by rotate-credentials-start
 					mustIncrease = true
@@ -189,8 +198,8 @@ func mustIncreaseGeneration(oldShoot, newShoot *core.Shoot) bool {
 					mustIncrease, mustRemoveOperationAnnotation = false, false
 				}
 
-				if strings.HasPrefix(operation, v1beta1constants.OperationRotateRolloutWorkers) ||
-					strings.HasPrefix(operation, v1beta1constants.OperationRolloutWorkers) {
+				if operationName := v1beta1helper.ParseShootOperation(operation).Name; operationName == v1beta1constants.OperationRotateRolloutWorkers ||
+					operationName == v1beta1constants.OperationRolloutWorkers {
 					// We don't want to remove the annotation so that the gardenlet can pick it up and perform
 					// the rotation/rollout. It has to remove the annotation after it is done.
 					mustIncrease, mustRemoveOperationAnnotation = true, false
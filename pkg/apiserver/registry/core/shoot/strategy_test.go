@@ -678,6 +678,31 @@ var _ = Describe("Strategy", func() {
 					[]string{v1beta1constants.ShootOperationForceInPlaceUpdate},
 				),
 
+				Entry("reconcile-infrastructure",
+					v1beta1constants.ShootOperationReconcileInfrastructure,
+					nil,
+					true,
+					[]string{v1beta1constants.ShootOperationReconcileInfrastructure},
+				),
+				Entry("reconcile-dns-records",
+					v1beta1constants.ShootOperationReconcileDNSRecords,
+					nil,
+					true,
+					[]string{v1beta1constants.ShootOperationReconcileDNSRecords},
+				),
+				Entry("reconcile-control-plane",
+					v1beta1constants.ShootOperationReconcileControlPlane,
+					nil,
+					true,
+					[]string{v1beta1constants.ShootOperationReconcileControlPlane},
+				),
+				Entry("reconcile-system-components",
+					v1beta1constants.ShootOperationReconcileSystemComponents,
+					nil,
+					true,
+					[]string{v1beta1constants.ShootOperationReconcileSystemComponents},
+				),
+
 				Entry("reconcile and rotate-etcd-encryption-key",
 					fmt.Sprintf("%s;%s", v1beta1constants.GardenerOperationReconcile, v1beta1constants.OperationRotateETCDEncryptionKey),
 					nil,
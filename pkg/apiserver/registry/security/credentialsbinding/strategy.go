@@ -34,6 +34,8 @@ func (c credentialsBindingStrategy) PrepareForCreate(_ context.Context, obj runt
 	if credentialsbinding.GetName() == "" {
 		credentialsbinding.SetName(c.GenerateName(credentialsbinding.GetGenerateName()))
 	}
+
+	credentialsbinding.Status = security.CredentialsBindingStatus{}
 }
 
 func (credentialsBindingStrategy) PrepareForUpdate(_ context.Context, _, _ runtime.Object) {
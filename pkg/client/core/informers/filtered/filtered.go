@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package filtered contains constructors for commonly filtered informers on top of the generated clientset. The
+// informers are backed by server-side selectors so that consumers do not have to maintain a full cache of all objects.
+package filtered
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	versioned "github.com/gardener/gardener/pkg/client/core/clientset/versioned"
+	gardencorev1beta1informers "github.com/gardener/gardener/pkg/client/core/informers/externalversions/core/v1beta1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// NewShootInformerForSeed constructs a new informer for Shoots scheduled to the seed with the given name. The filtering
+// happens server-side via a field selector on `spec.seedName`, so only the matching Shoots are kept in the cache.
+func NewShootInformerForSeed(client versioned.Interface, seedName string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return gardencorev1beta1informers.NewFilteredShootInformer(client, metav1.NamespaceAll, resyncPeriod, indexers, func(options *metav1.ListOptions) {
+		options.FieldSelector = fields.OneTermEqualSelector(core.ShootSeedName, seedName).String()
+	})
+}
+
+// NewShootInformerForProject constructs a new informer for Shoots in the project with the given name. The informer is
+// scoped to the project namespace (which is derived from the project name by convention), so only the matching Shoots
+// are kept in the cache.
+func NewShootInformerForProject(client versioned.Interface, projectName string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return gardencorev1beta1informers.NewFilteredShootInformer(client, gardenerutils.ProjectNamespacePrefix+projectName, resyncPeriod, indexers, nil)
+}
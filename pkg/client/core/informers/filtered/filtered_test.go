@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package filtered_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clienttesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	fakeclientset "github.com/gardener/gardener/pkg/client/core/clientset/versioned/fake"
+	. "github.com/gardener/gardener/pkg/client/core/informers/filtered"
+)
+
+var _ = Describe("Filtered", func() {
+	var (
+		fakeClient *fakeclientset.Clientset
+		stopCh     chan struct{}
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclientset.NewSimpleClientset()
+		stopCh = make(chan struct{})
+		DeferCleanup(func() { close(stopCh) })
+	})
+
+	runAndWaitForSync := func(informer cache.SharedIndexInformer) {
+		go informer.Run(stopCh)
+		Expect(cache.WaitForCacheSync(stopCh, informer.HasSynced)).To(BeTrue())
+	}
+
+	Describe("#NewShootInformerForSeed", func() {
+		It("should list and watch shoots with a field selector on the seed name in all namespaces", func() {
+			runAndWaitForSync(NewShootInformerForSeed(fakeClient, "test-seed", 0, cache.Indexers{}))
+
+			var listActions, watchActions int
+			for _, action := range fakeClient.Actions() {
+				switch a := action.(type) {
+				case clienttesting.ListActionImpl:
+					listActions++
+					Expect(a.GetNamespace()).To(BeEmpty())
+					Expect(a.GetListRestrictions().Fields.String()).To(Equal("spec.seedName=test-seed"))
+				case clienttesting.WatchActionImpl:
+					watchActions++
+					Expect(a.GetNamespace()).To(BeEmpty())
+					Expect(a.GetWatchRestrictions().Fields.String()).To(Equal("spec.seedName=test-seed"))
+				}
+			}
+
+			Expect(listActions).To(Equal(1))
+			Expect(watchActions).To(Equal(1))
+		})
+	})
+
+	Describe("#NewShootInformerForProject", func() {
+		It("should list and watch shoots in the project namespace without additional selectors", func() {
+			runAndWaitForSync(NewShootInformerForProject(fakeClient, "test-project", 0, cache.Indexers{}))
+
+			var listActions, watchActions int
+			for _, action := range fakeClient.Actions() {
+				switch a := action.(type) {
+				case clienttesting.ListActionImpl:
+					listActions++
+					Expect(a.GetNamespace()).To(Equal("garden-test-project"))
+					Expect(a.GetListRestrictions().Fields.String()).To(BeEmpty())
+					Expect(a.GetListRestrictions().Labels.String()).To(BeEmpty())
+				case clienttesting.WatchActionImpl:
+					watchActions++
+					Expect(a.GetNamespace()).To(Equal("garden-test-project"))
+					Expect(a.GetWatchRestrictions().Fields.String()).To(BeEmpty())
+					Expect(a.GetWatchRestrictions().Labels.String()).To(BeEmpty())
+				}
+			}
+
+			Expect(listActions).To(Equal(1))
+			Expect(watchActions).To(Equal(1))
+		})
+
+		It("should only cache shoots in the project namespace", func() {
+			projectShoot := &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "test-shoot", Namespace: "garden-test-project"}}
+			otherShoot := &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "other-shoot", Namespace: "garden-other-project"}}
+			fakeClient = fakeclientset.NewSimpleClientset(projectShoot, otherShoot)
+
+			informer := NewShootInformerForProject(fakeClient, "test-project", 0, cache.Indexers{})
+			runAndWaitForSync(informer)
+
+			Expect(informer.GetStore().ListKeys()).To(ConsistOf("garden-test-project/test-shoot"))
+		})
+	})
+})
@@ -6,6 +6,9 @@ package kubernetes
 
 import (
 	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
 	"time"
 
 	"k8s.io/client-go/rest"
@@ -119,6 +122,19 @@ func WithNewCacheFunc(fn cache.NewCacheFunc) ConfigFunc {
 	}
 }
 
+// WithProxyURL returns a ConfigFunc that configures the given proxy URL on the REST config of the Config object.
+// It must be applied after the REST config has been set.
+func WithProxyURL(proxyURL string) ConfigFunc {
+	return func(config *Config) error {
+		u, err := url.Parse(proxyURL)
+		if err != nil {
+			return fmt.Errorf("invalid proxy URL %q: %w", proxyURL, err)
+		}
+		config.restConfig.Proxy = http.ProxyURL(u)
+		return nil
+	}
+}
+
 // WithAllowedUserFields allows to specify additional kubeconfig.user fields allowed during validation.
 func WithAllowedUserFields(allowedUserFields []string) ConfigFunc {
 	return func(config *Config) error {
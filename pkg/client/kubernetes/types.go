@@ -117,6 +117,7 @@ var (
 		persesv1alpha1.AddToScheme,
 		opentelemetryv1alpha1.AddToScheme,
 		opentelemetryv1beta1.AddToScheme,
+		metricsv1beta1.AddToScheme,
 	)
 
 	shootSchemeBuilder = runtime.NewSchemeBuilder(
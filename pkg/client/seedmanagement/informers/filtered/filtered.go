@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package filtered contains constructors for commonly filtered informers on top of the generated clientset. The
+// informers are backed by server-side selectors so that consumers do not have to maintain a full cache of all objects.
+package filtered
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/tools/cache"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	versioned "github.com/gardener/gardener/pkg/client/seedmanagement/clientset/versioned"
+	seedmanagementv1alpha1informers "github.com/gardener/gardener/pkg/client/seedmanagement/informers/externalversions/seedmanagement/v1alpha1"
+)
+
+// NewManagedSeedInformerForSeed constructs a new informer for the ManagedSeed for the seed with the given name. The
+// ManagedSeed shares its name with the Seed it creates, so the filtering happens server-side via a field selector on
+// `metadata.name` in the `garden` namespace.
+func NewManagedSeedInformerForSeed(client versioned.Interface, seedName string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return seedmanagementv1alpha1informers.NewFilteredManagedSeedInformer(client, v1beta1constants.GardenNamespace, resyncPeriod, indexers, func(options *metav1.ListOptions) {
+		options.FieldSelector = fields.OneTermEqualSelector("metadata.name", seedName).String()
+	})
+}
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package filtered_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	clienttesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+
+	fakeclientset "github.com/gardener/gardener/pkg/client/seedmanagement/clientset/versioned/fake"
+	. "github.com/gardener/gardener/pkg/client/seedmanagement/informers/filtered"
+)
+
+var _ = Describe("Filtered", func() {
+	Describe("#NewManagedSeedInformerForSeed", func() {
+		It("should list and watch the managed seed with a field selector on the name in the garden namespace", func() {
+			fakeClient := fakeclientset.NewSimpleClientset()
+			stopCh := make(chan struct{})
+			DeferCleanup(func() { close(stopCh) })
+
+			informer := NewManagedSeedInformerForSeed(fakeClient, "test-seed", 0, cache.Indexers{})
+			go informer.Run(stopCh)
+			Expect(cache.WaitForCacheSync(stopCh, informer.HasSynced)).To(BeTrue())
+
+			var listActions, watchActions int
+			for _, action := range fakeClient.Actions() {
+				switch a := action.(type) {
+				case clienttesting.ListActionImpl:
+					listActions++
+					Expect(a.GetNamespace()).To(Equal("garden"))
+					Expect(a.GetListRestrictions().Fields.String()).To(Equal("metadata.name=test-seed"))
+				case clienttesting.WatchActionImpl:
+					watchActions++
+					Expect(a.GetNamespace()).To(Equal("garden"))
+					Expect(a.GetWatchRestrictions().Fields.String()).To(Equal("metadata.name=test-seed"))
+				}
+			}
+
+			Expect(listActions).To(Equal(1))
+			Expect(watchActions).To(Equal(1))
+		})
+	})
+})
@@ -71,20 +71,27 @@ func ReconcileSecretWebhookKubeconfig(ctx context.Context, c client.Client, secr
 	return client.IgnoreAlreadyExists(c.Create(ctx, secret))
 }
 
+// RenderAuditPolicy encodes the given audit policy to YAML.
+func RenderAuditPolicy(policy *auditv1.Policy) (string, error) {
+	data, err := runtime.Encode(auditCodec, policy)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
 // ReconcileConfigMapAuditPolicy reconciles the ConfigMap containing the audit policy.
 func ReconcileConfigMapAuditPolicy(ctx context.Context, c client.Client, configMap *corev1.ConfigMap, auditConfig *AuditConfig) error {
-	defaultPolicy := &auditv1.Policy{
+	policy, err := RenderAuditPolicy(&auditv1.Policy{
 		Rules: []auditv1.PolicyRule{
 			{Level: auditv1.LevelNone},
 		},
-	}
-
-	data, err := runtime.Encode(auditCodec, defaultPolicy)
+	})
 	if err != nil {
 		return err
 	}
 
-	policy := string(data)
 	if auditConfig != nil && auditConfig.Policy != nil {
 		policy = *auditConfig.Policy
 	}
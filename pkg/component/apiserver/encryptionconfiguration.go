@@ -87,20 +87,14 @@ func ReconcileSecretETCDEncryptionConfiguration(
 	var (
 		keySecretOld, _         = secretsManager.Get(secretNameETCDEncryptionKey, secretsmanager.Old)
 		encryptionKeys          = etcdEncryptionAESKeys(keySecret, keySecretOld, config.EncryptWithCurrentKey)
+		encryptionProviders     = etcdEncryptionProviders(config.KMS, encryptionKeys)
 		encryptionConfiguration = &apiserverconfigv1.EncryptionConfiguration{
 			Resources: []apiserverconfigv1.ResourceConfiguration{
 				{
 					Resources: config.ResourcesToEncrypt,
-					Providers: []apiserverconfigv1.ProviderConfiguration{
-						{
-							AESCBC: &apiserverconfigv1.AESConfiguration{
-								Keys: encryptionKeys,
-							},
-						},
-						{
-							Identity: &apiserverconfigv1.IdentityConfiguration{},
-						},
-					},
+					Providers: append(encryptionProviders, apiserverconfigv1.ProviderConfiguration{
+						Identity: &apiserverconfigv1.IdentityConfiguration{},
+					}),
 				},
 			},
 		}
@@ -111,16 +105,9 @@ func ReconcileSecretETCDEncryptionConfiguration(
 		if removedResources.Len() > 0 {
 			encryptionConfiguration.Resources = append(encryptionConfiguration.Resources, apiserverconfigv1.ResourceConfiguration{
 				Resources: sets.List(removedResources),
-				Providers: []apiserverconfigv1.ProviderConfiguration{
-					{
-						Identity: &apiserverconfigv1.IdentityConfiguration{},
-					},
-					{
-						AESCBC: &apiserverconfigv1.AESConfiguration{
-							Keys: encryptionKeys,
-						},
-					},
-				},
+				Providers: append([]apiserverconfigv1.ProviderConfiguration{{
+					Identity: &apiserverconfigv1.IdentityConfiguration{},
+				}}, encryptionProviders...),
 			})
 		}
 	}
@@ -148,6 +135,30 @@ func ReconcileSecretETCDEncryptionConfiguration(
 	return c.Patch(ctx, secretETCDEncryptionConfiguration, patch)
 }
 
+// etcdEncryptionProviders returns the providers which are able to both encrypt and decrypt data. If a KMS provider
+// is configured it takes precedence for encryption, while the generated AES keys are kept for decrypting data which
+// was written before the KMS provider was introduced.
+func etcdEncryptionProviders(kmsConfig *KMSEncryptionConfig, encryptionKeys []apiserverconfigv1.Key) []apiserverconfigv1.ProviderConfiguration {
+	var providers []apiserverconfigv1.ProviderConfiguration
+
+	if kmsConfig != nil {
+		providers = append(providers, apiserverconfigv1.ProviderConfiguration{
+			KMS: &apiserverconfigv1.KMSConfiguration{
+				APIVersion: "v2",
+				Name:       kmsConfig.Name,
+				Endpoint:   kmsConfig.Endpoint,
+				Timeout:    kmsConfig.Timeout,
+			},
+		})
+	}
+
+	return append(providers, apiserverconfigv1.ProviderConfiguration{
+		AESCBC: &apiserverconfigv1.AESConfiguration{
+			Keys: encryptionKeys,
+		},
+	})
+}
+
 func etcdEncryptionAESKeys(keySecretCurrent, keySecretOld *corev1.Secret, encryptWithCurrentKey bool) []apiserverconfigv1.Key {
 	if keySecretOld == nil {
 		return []apiserverconfigv1.Key{
@@ -6,6 +6,7 @@ package apiserver_test
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -174,6 +175,75 @@ resources:
 			Expect(secretList.Items[0].Labels).To(HaveKeyWithValue("persist", "true"))
 		})
 
+		It("should successfully deploy the ETCD encryption configuration secret resource with a KMS provider", func() {
+			config = ETCDEncryptionConfig{
+				ResourcesToEncrypt: []string{"foo"},
+				EncryptedResources: []string{"foo", "bar"},
+				KMS: &KMSEncryptionConfig{
+					Name:     "customer-kms",
+					Endpoint: "unix:///var/run/kms-provider.sock",
+					Timeout:  &metav1.Duration{Duration: 5 * time.Second},
+				},
+			}
+
+			etcdEncryptionConfiguration := `apiVersion: apiserver.config.k8s.io/v1
+kind: EncryptionConfiguration
+resources:
+- providers:
+  - kms:
+      apiVersion: v2
+      endpoint: unix:///var/run/kms-provider.sock
+      name: customer-kms
+      timeout: 5s
+  - aescbc:
+      keys:
+      - name: key-62135596800
+        secret: X19fX19fX19fX19fX19fX19fX19fX19fX19fX19fX18=
+  - identity: {}
+  resources:
+  - foo
+- providers:
+  - identity: {}
+  - kms:
+      apiVersion: v2
+      endpoint: unix:///var/run/kms-provider.sock
+      name: customer-kms
+      timeout: 5s
+  - aescbc:
+      keys:
+      - name: key-62135596800
+        secret: X19fX19fX19fX19fX19fX19fX19fX19fX19fX19fX18=
+  resources:
+  - bar
+`
+
+			By("Verify encryption config secret")
+			expectedSecretETCDEncryptionConfiguration := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "apiserver-encryption-config", Namespace: namespace},
+				Data:       map[string][]byte{"encryption-configuration.yaml": []byte(etcdEncryptionConfiguration)},
+			}
+			Expect(kubernetesutils.MakeUnique(expectedSecretETCDEncryptionConfiguration)).To(Succeed())
+
+			actualSecretETCDEncryptionConfiguration := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "apiserver-encryption-config", Namespace: namespace}}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(actualSecretETCDEncryptionConfiguration), actualSecretETCDEncryptionConfiguration)).To(BeNotFoundError())
+
+			Expect(ReconcileSecretETCDEncryptionConfiguration(ctx, fakeClient, fakeSecretManager, config, actualSecretETCDEncryptionConfiguration, secretNameETCDEncryptionKey, encryptionRoleLabel)).To(Succeed())
+
+			Expect(actualSecretETCDEncryptionConfiguration).To(Equal(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      expectedSecretETCDEncryptionConfiguration.Name,
+					Namespace: expectedSecretETCDEncryptionConfiguration.Namespace,
+					Labels: map[string]string{
+						"resources.gardener.cloud/garbage-collectable-reference": "true",
+						"role": encryptionRoleLabel,
+					},
+					ResourceVersion: "1",
+				},
+				Immutable: ptr.To(true),
+				Data:      expectedSecretETCDEncryptionConfiguration.Data,
+			}))
+		})
+
 		DescribeTable("successfully deploy the ETCD encryption configuration secret resource w/ old key",
 			func(encryptWithCurrentKey bool) {
 				config.EncryptWithCurrentKey = encryptWithCurrentKey
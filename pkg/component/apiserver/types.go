@@ -7,6 +7,7 @@ package apiserver
 import (
 	"github.com/Masterminds/semver/v3"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/component"
@@ -89,4 +90,19 @@ type ETCDEncryptionConfig struct {
 	ResourcesToEncrypt []string
 	// EncryptedResources are the resources which are currently encrypted.
 	EncryptedResources []string
+	// KMS contains optional configuration for encrypting the resources with a customer-managed key via an external
+	// KMS provider instead of the generated encryption keys. The generated keys are kept as fallback providers for
+	// decryption.
+	KMS *KMSEncryptionConfig
+}
+
+// KMSEncryptionConfig contains configuration for encrypting resources with a customer-managed key via an external
+// KMS provider.
+type KMSEncryptionConfig struct {
+	// Name is the name of the KMS plugin.
+	Name string
+	// Endpoint is the gRPC listening address of the KMS plugin.
+	Endpoint string
+	// Timeout is the maximum duration of a gRPC call to the KMS plugin.
+	Timeout *metav1.Duration
 }
@@ -29,6 +29,20 @@ const (
 	// DefaultTimeout is the default timeout and defines how long Gardener should wait
 	// for a successful reconciliation of an EtcdCopyBackupsTasks resource.
 	DefaultTimeout = 5 * time.Minute
+
+	// AnnotationKeySourceProvider is the key for an annotation on the EtcdCopyBackupsTask stating the provider of the
+	// source object store. It is only set when the source and target object store providers differ.
+	AnnotationKeySourceProvider = "copybackupstask.etcd.gardener.cloud/source-provider"
+	// AnnotationKeyTargetProvider is the key for an annotation on the EtcdCopyBackupsTask stating the provider of the
+	// target object store. It is only set when the source and target object store providers differ.
+	AnnotationKeyTargetProvider = "copybackupstask.etcd.gardener.cloud/target-provider"
+	// AnnotationKeyVerifySnapshotIntegrity is the key for an annotation on the EtcdCopyBackupsTask requesting that the
+	// integrity of the copied snapshots is verified after they have been converted to the target provider's format.
+	AnnotationKeyVerifySnapshotIntegrity = "copybackupstask.etcd.gardener.cloud/verify-snapshot-integrity"
+	// AnnotationKeySnapshotIntegrityVerified is the key for an annotation on the EtcdCopyBackupsTask via which
+	// provider-specific tooling reports the result of the snapshot integrity verification ("true" or "false"). If
+	// verification was requested, Wait only succeeds after this annotation has been set to "true".
+	AnnotationKeySnapshotIntegrityVerified = "copybackupstask.etcd.gardener.cloud/snapshot-integrity-verified"
 )
 
 // Interface contains functions to manage EtcdCopyBackupsTasks.
@@ -105,6 +119,17 @@ func (e *etcdCopyBackupsTask) Deploy(ctx context.Context) error {
 		v1beta1constants.LabelNetworkPolicyToDNS:            v1beta1constants.LabelNetworkPolicyAllowed,
 		v1beta1constants.LabelNetworkPolicyToPublicNetworks: v1beta1constants.LabelNetworkPolicyAllowed,
 	}
+
+	// If the source and target object stores are backed by different providers, the copied snapshots have to be
+	// converted to the target provider's format. Announce the conversion via annotations so that provider-specific
+	// tooling can hook into the copy operation and verify the integrity of the converted snapshots.
+	if sourceProvider, targetProvider := e.values.SourceStore.Provider, e.values.TargetStore.Provider; sourceProvider != nil && targetProvider != nil && *sourceProvider != *targetProvider {
+		e.log.Info("Source and target object store providers differ, snapshot conversion is required", "sourceProvider", *sourceProvider, "targetProvider", *targetProvider)
+		metav1.SetMetaDataAnnotation(&e.task.ObjectMeta, AnnotationKeySourceProvider, string(*sourceProvider))
+		metav1.SetMetaDataAnnotation(&e.task.ObjectMeta, AnnotationKeyTargetProvider, string(*targetProvider))
+		metav1.SetMetaDataAnnotation(&e.task.ObjectMeta, AnnotationKeyVerifySnapshotIntegrity, "true")
+	}
+
 	return e.client.Create(ctx, e.task)
 }
 
@@ -172,12 +197,27 @@ func waitForConditions(obj client.Object) error {
 	for _, condition := range task.Status.Conditions {
 		if (condition.Type == druidcorev1alpha1.EtcdCopyBackupsTaskSucceeded || condition.Type == druidcorev1alpha1.EtcdCopyBackupsTaskFailed) &&
 			condition.Status == druidcorev1alpha1.ConditionTrue {
+			// If snapshot conversion and integrity verification was requested, the copied snapshots must not be used
+			// before the provider-specific tooling has reported the verification result.
+			if condition.Type == druidcorev1alpha1.EtcdCopyBackupsTaskSucceeded && verificationPending(task) {
+				return retry.RetriableError(fmt.Errorf("snapshot integrity verification result has not been reported yet via the %q annotation", AnnotationKeySnapshotIntegrityVerified))
+			}
 			return nil
 		}
 	}
 	return fmt.Errorf("expected condition %s or %s, has not been reported yet", druidcorev1alpha1.EtcdCopyBackupsTaskSucceeded, druidcorev1alpha1.EtcdCopyBackupsTaskFailed)
 }
 
+// verificationPending returns true if snapshot integrity verification was requested for the given task but its result
+// has not been reported yet.
+func verificationPending(task *druidcorev1alpha1.EtcdCopyBackupsTask) bool {
+	if task.Annotations[AnnotationKeyVerifySnapshotIntegrity] != "true" {
+		return false
+	}
+	_, ok := task.Annotations[AnnotationKeySnapshotIntegrityVerified]
+	return !ok
+}
+
 // checkConditions checks the EtcdCopyBackupsTask conditions to determine if the copy operation has completed successfully or not.
 func (e *etcdCopyBackupsTask) checkConditions() error {
 	for _, condition := range e.task.Status.Conditions {
@@ -185,5 +225,11 @@ func (e *etcdCopyBackupsTask) checkConditions() error {
 			return fmt.Errorf("condition %s has status %s: %s", condition.Type, condition.Status, condition.Message)
 		}
 	}
+
+	if e.task.Annotations[AnnotationKeyVerifySnapshotIntegrity] == "true" {
+		if verified := e.task.Annotations[AnnotationKeySnapshotIntegrityVerified]; verified != "true" {
+			return fmt.Errorf("snapshot integrity verification failed: annotation %q has value %q", AnnotationKeySnapshotIntegrityVerified, verified)
+		}
+	}
 	return nil
 }
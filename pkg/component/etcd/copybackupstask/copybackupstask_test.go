@@ -75,6 +75,42 @@ var _ = Describe("CopyBackupsTask", func() {
 			c.EXPECT().Create(ctx, expected)
 			Expect(etcdCopyBackupsTask.Deploy(ctx)).To(Succeed())
 		})
+
+		It("should not annotate the EtcdCopyBackupsTask for snapshot conversion if the providers are equal", func() {
+			provider := druidcorev1alpha1.StorageProvider("aws")
+			etcdCopyBackupsTask.SetSourceStore(druidcorev1alpha1.StoreSpec{Provider: &provider})
+			etcdCopyBackupsTask.SetTargetStore(druidcorev1alpha1.StoreSpec{Provider: &provider})
+
+			expected.Spec.SourceStore = druidcorev1alpha1.StoreSpec{Provider: &provider}
+			expected.Spec.TargetStore = druidcorev1alpha1.StoreSpec{Provider: &provider}
+			expected.Spec.PodLabels = map[string]string{
+				"networking.gardener.cloud/to-dns":             "allowed",
+				"networking.gardener.cloud/to-public-networks": "allowed",
+			}
+			c.EXPECT().Create(ctx, expected)
+			Expect(etcdCopyBackupsTask.Deploy(ctx)).To(Succeed())
+		})
+
+		It("should annotate the EtcdCopyBackupsTask for snapshot conversion if the providers differ", func() {
+			sourceProvider := druidcorev1alpha1.StorageProvider("aws")
+			targetProvider := druidcorev1alpha1.StorageProvider("gcp")
+			etcdCopyBackupsTask.SetSourceStore(druidcorev1alpha1.StoreSpec{Provider: &sourceProvider})
+			etcdCopyBackupsTask.SetTargetStore(druidcorev1alpha1.StoreSpec{Provider: &targetProvider})
+
+			expected.Annotations = map[string]string{
+				AnnotationKeySourceProvider:          "aws",
+				AnnotationKeyTargetProvider:          "gcp",
+				AnnotationKeyVerifySnapshotIntegrity: "true",
+			}
+			expected.Spec.SourceStore = druidcorev1alpha1.StoreSpec{Provider: &sourceProvider}
+			expected.Spec.TargetStore = druidcorev1alpha1.StoreSpec{Provider: &targetProvider}
+			expected.Spec.PodLabels = map[string]string{
+				"networking.gardener.cloud/to-dns":             "allowed",
+				"networking.gardener.cloud/to-public-networks": "allowed",
+			}
+			c.EXPECT().Create(ctx, expected)
+			Expect(etcdCopyBackupsTask.Deploy(ctx)).To(Succeed())
+		})
 	})
 
 	Describe("#Destroy", func() {
@@ -182,6 +218,55 @@ var _ = Describe("CopyBackupsTask", func() {
 			Expect(etcdCopyBackupsTask.Wait(ctx)).To(Succeed())
 		})
 
+		Context("snapshot integrity verification", func() {
+			BeforeEach(func() {
+				sourceProvider := druidcorev1alpha1.StorageProvider("aws")
+				targetProvider := druidcorev1alpha1.StorageProvider("gcp")
+				etcdCopyBackupsTask.SetSourceStore(druidcorev1alpha1.StoreSpec{Provider: &sourceProvider})
+				etcdCopyBackupsTask.SetTargetStore(druidcorev1alpha1.StoreSpec{Provider: &targetProvider})
+			})
+
+			taskWithVerificationResult := func(result *string) func(_ context.Context, _ client.ObjectKey, task *druidcorev1alpha1.EtcdCopyBackupsTask, _ ...client.GetOption) error {
+				return func(_ context.Context, _ client.ObjectKey, task *druidcorev1alpha1.EtcdCopyBackupsTask, _ ...client.GetOption) error {
+					task.Annotations = map[string]string{AnnotationKeyVerifySnapshotIntegrity: "true"}
+					if result != nil {
+						task.Annotations[AnnotationKeySnapshotIntegrityVerified] = *result
+					}
+					task.Status.ObservedGeneration = &expected.Generation
+					task.Status.Conditions = []druidcorev1alpha1.Condition{
+						{
+							Type:    druidcorev1alpha1.EtcdCopyBackupsTaskSucceeded,
+							Status:  druidcorev1alpha1.ConditionTrue,
+							Reason:  "reason",
+							Message: "message",
+						},
+					}
+					return nil
+				}
+			}
+
+			It("should return error if the verification result has not been reported yet", func() {
+				c.EXPECT().
+					Get(gomock.AssignableToTypeOf(timeoutCtx), client.ObjectKeyFromObject(expected), gomock.AssignableToTypeOf(expected)).
+					DoAndReturn(taskWithVerificationResult(nil)).AnyTimes()
+				Expect(etcdCopyBackupsTask.Wait(ctx)).To(MatchError(ContainSubstring("snapshot integrity verification result has not been reported yet")))
+			})
+
+			It("should return error if the verification failed", func() {
+				c.EXPECT().
+					Get(gomock.AssignableToTypeOf(timeoutCtx), client.ObjectKeyFromObject(expected), gomock.AssignableToTypeOf(expected)).
+					DoAndReturn(taskWithVerificationResult(ptr.To("false"))).AnyTimes()
+				Expect(etcdCopyBackupsTask.Wait(ctx)).To(MatchError(ContainSubstring("snapshot integrity verification failed")))
+			})
+
+			It("should be successful if the verification succeeded", func() {
+				c.EXPECT().
+					Get(gomock.AssignableToTypeOf(timeoutCtx), client.ObjectKeyFromObject(expected), gomock.AssignableToTypeOf(expected)).
+					DoAndReturn(taskWithVerificationResult(ptr.To("true"))).AnyTimes()
+				Expect(etcdCopyBackupsTask.Wait(ctx)).To(Succeed())
+			})
+		})
+
 		It("should eventually return success when Successful condition is reported with status True", func() {
 			gomock.InOrder(
 				c.EXPECT().Get(gomock.AssignableToTypeOf(timeoutCtx), client.ObjectKeyFromObject(expected), gomock.AssignableToTypeOf(&druidcorev1alpha1.EtcdCopyBackupsTask{})).Return(notFoundErr),
@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	etcdconstants "github.com/gardener/gardener/pkg/component/etcd/etcd/constants"
+)
+
+// databaseClientDialTimeout is the timeout for establishing a connection to the etcd database.
+const databaseClientDialTimeout = 15 * time.Second
+
+// DatabaseState contains information about the size of the etcd database in relation to its configured backend quota.
+type DatabaseState struct {
+	// SizeBytes is the physically allocated size of the etcd database in bytes.
+	SizeBytes int64
+	// SizeInUseBytes is the logically in-use size of the etcd database in bytes.
+	SizeInUseBytes int64
+	// QuotaBytes is the backend quota configured for the etcd in bytes.
+	QuotaBytes int64
+	// NoSpaceAlarm states whether the etcd cluster has raised a NOSPACE alarm because the database size exceeded the
+	// backend quota.
+	NoSpaceAlarm bool
+}
+
+// ExceedsThreshold returns true if the database size exceeds the given fraction of the backend quota or if a NOSPACE
+// alarm has been raised.
+func (d *DatabaseState) ExceedsThreshold(fraction float64) bool {
+	return d.NoSpaceAlarm || (d.QuotaBytes > 0 && float64(d.SizeBytes) >= fraction*float64(d.QuotaBytes))
+}
+
+// DatabaseClient is the subset of the etcd client API used for database size monitoring and maintenance.
+type DatabaseClient interface {
+	Status(ctx context.Context, endpoint string) (*clientv3.StatusResponse, error)
+	MemberList(ctx context.Context, opts ...clientv3.OpOption) (*clientv3.MemberListResponse, error)
+	Compact(ctx context.Context, rev int64, opts ...clientv3.CompactOption) (*clientv3.CompactResponse, error)
+	Defragment(ctx context.Context, endpoint string) (*clientv3.DefragmentResponse, error)
+	AlarmList(ctx context.Context) (*clientv3.AlarmResponse, error)
+	AlarmDisarm(ctx context.Context, m *clientv3.AlarmMember) (*clientv3.AlarmResponse, error)
+	Close() error
+}
+
+// NewDatabaseClient creates a new client for the etcd database reachable at the given endpoint. Exposed for testing.
+var NewDatabaseClient = func(endpoint string, tlsConfig *tls.Config) (DatabaseClient, error) {
+	return clientv3.New(clientv3.Config{
+		Endpoints:   []string{endpoint},
+		DialTimeout: databaseClientDialTimeout,
+		TLS:         tlsConfig,
+	})
+}
+
+// GetDatabaseState returns the current size of the etcd database in relation to its configured backend quota as well
+// as whether a NOSPACE alarm has been raised.
+func (e *etcd) GetDatabaseState(ctx context.Context, tlsConfig *tls.Config) (*DatabaseState, error) {
+	databaseClient, err := NewDatabaseClient(e.databaseEndpoint(), tlsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating client for etcd database %q: %w", e.databaseEndpoint(), err)
+	}
+	defer func() { _ = databaseClient.Close() }()
+
+	status, err := databaseClient.Status(ctx, e.databaseEndpoint())
+	if err != nil {
+		return nil, fmt.Errorf("failed reading the status of etcd database %q: %w", e.databaseEndpoint(), err)
+	}
+
+	state := &DatabaseState{
+		SizeBytes:      status.DbSize,
+		SizeInUseBytes: status.DbSizeInUse,
+		QuotaBytes:     status.DbSizeQuota,
+	}
+
+	if state.QuotaBytes == 0 {
+		// Older etcd versions do not report the quota in their status, so fall back to the quota configured in the
+		// Etcd resource.
+		etcdResource, err := e.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if quota := etcdResource.Spec.Etcd.Quota; quota != nil {
+			state.QuotaBytes = quota.Value()
+		}
+	}
+
+	alarms, err := databaseClient.AlarmList(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading the alarms of etcd database %q: %w", e.databaseEndpoint(), err)
+	}
+
+	for _, alarm := range alarms.Alarms {
+		if alarm.Alarm == etcdserverpb.AlarmType_NOSPACE {
+			state.NoSpaceAlarm = true
+		}
+	}
+
+	return state, nil
+}
+
+// PerformDatabaseMaintenance compacts the etcd history up to the latest revision, defragments the database of all
+// members, and disarms raised NOSPACE alarms afterwards.
+func (e *etcd) PerformDatabaseMaintenance(ctx context.Context, tlsConfig *tls.Config) error {
+	databaseClient, err := NewDatabaseClient(e.databaseEndpoint(), tlsConfig)
+	if err != nil {
+		return fmt.Errorf("failed creating client for etcd database %q: %w", e.databaseEndpoint(), err)
+	}
+	defer func() { _ = databaseClient.Close() }()
+
+	status, err := databaseClient.Status(ctx, e.databaseEndpoint())
+	if err != nil {
+		return fmt.Errorf("failed reading the status of etcd database %q: %w", e.databaseEndpoint(), err)
+	}
+
+	if _, err := databaseClient.Compact(ctx, status.Header.Revision, clientv3.WithCompactPhysical()); err != nil {
+		return fmt.Errorf("failed compacting etcd database %q: %w", e.databaseEndpoint(), err)
+	}
+
+	for _, endpoint := range e.databaseMemberEndpoints(ctx, databaseClient) {
+		if _, err := databaseClient.Defragment(ctx, endpoint); err != nil {
+			return fmt.Errorf("failed defragmenting etcd database member %q: %w", endpoint, err)
+		}
+	}
+
+	// Passing an empty alarm member disarms all raised alarms of all members.
+	if _, err := databaseClient.AlarmDisarm(ctx, &clientv3.AlarmMember{}); err != nil {
+		return fmt.Errorf("failed disarming the alarms of etcd database %q: %w", e.databaseEndpoint(), err)
+	}
+
+	e.log.Info("Performed etcd database maintenance (compaction, defragmentation, alarm disarming)")
+	return nil
+}
+
+// databaseEndpoint returns the endpoint of the client service under which the etcd database is reachable.
+func (e *etcd) databaseEndpoint() string {
+	return fmt.Sprintf("https://%s%s.%s:%d", e.values.NamePrefix, etcdconstants.ServiceName(e.values.Role), e.namespace, etcdconstants.PortEtcdClient)
+}
+
+// databaseMemberEndpoints returns the client endpoints of all etcd cluster members. If the members cannot be listed,
+// it falls back to the client service endpoint.
+func (e *etcd) databaseMemberEndpoints(ctx context.Context, databaseClient DatabaseClient) []string {
+	members, err := databaseClient.MemberList(ctx)
+	if err != nil {
+		e.log.Error(err, "Failed listing the etcd cluster members, falling back to the client service endpoint")
+		return []string{e.databaseEndpoint()}
+	}
+
+	var endpoints []string
+	for _, member := range members.Members {
+		if len(member.ClientURLs) > 0 {
+			endpoints = append(endpoints, member.ClientURLs[0])
+		}
+	}
+
+	if len(endpoints) == 0 {
+		return []string{e.databaseEndpoint()}
+	}
+	return endpoints
+}
@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd_test
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+
+	druidcorev1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	etcdserverpb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener/pkg/component/etcd/etcd"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+type fakeDatabaseClient struct {
+	status       *clientv3.StatusResponse
+	alarms       []*etcdserverpb.AlarmMember
+	members      []*etcdserverpb.Member
+	memberErr    error
+	compacted    bool
+	defragmented []string
+	disarmed     bool
+	closed       bool
+}
+
+func (f *fakeDatabaseClient) Status(_ context.Context, _ string) (*clientv3.StatusResponse, error) {
+	return f.status, nil
+}
+
+func (f *fakeDatabaseClient) MemberList(_ context.Context, _ ...clientv3.OpOption) (*clientv3.MemberListResponse, error) {
+	if f.memberErr != nil {
+		return nil, f.memberErr
+	}
+	return &clientv3.MemberListResponse{Members: f.members}, nil
+}
+
+func (f *fakeDatabaseClient) Compact(_ context.Context, _ int64, _ ...clientv3.CompactOption) (*clientv3.CompactResponse, error) {
+	f.compacted = true
+	return &clientv3.CompactResponse{}, nil
+}
+
+func (f *fakeDatabaseClient) Defragment(_ context.Context, endpoint string) (*clientv3.DefragmentResponse, error) {
+	f.defragmented = append(f.defragmented, endpoint)
+	return &clientv3.DefragmentResponse{}, nil
+}
+
+func (f *fakeDatabaseClient) AlarmList(_ context.Context) (*clientv3.AlarmResponse, error) {
+	return &clientv3.AlarmResponse{Alarms: f.alarms}, nil
+}
+
+func (f *fakeDatabaseClient) AlarmDisarm(_ context.Context, _ *clientv3.AlarmMember) (*clientv3.AlarmResponse, error) {
+	f.disarmed = true
+	return &clientv3.AlarmResponse{}, nil
+}
+
+func (f *fakeDatabaseClient) Close() error {
+	f.closed = true
+	return nil
+}
+
+var _ = Describe("DatabaseSize", func() {
+	var (
+		ctx = context.Background()
+
+		namespace  = "shoot--foo--bar"
+		fakeClient client.Client
+		etcd       Interface
+
+		databaseClient *fakeDatabaseClient
+		usedEndpoint   string
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(druidcorev1alpha1.AddToScheme(scheme))
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(scheme).Build()
+
+		etcd = New(logr.Discard(), fakeClient, namespace, nil, Values{Role: "main"})
+
+		databaseClient = &fakeDatabaseClient{
+			status: &clientv3.StatusResponse{
+				Header:      &etcdserverpb.ResponseHeader{Revision: 42},
+				DbSize:      7 << 30,
+				DbSizeInUse: 3 << 30,
+				DbSizeQuota: 8 << 30,
+			},
+		}
+
+		DeferCleanup(test.WithVar(&NewDatabaseClient, func(endpoint string, _ *tls.Config) (DatabaseClient, error) {
+			usedEndpoint = endpoint
+			return databaseClient, nil
+		}))
+	})
+
+	Describe("#DatabaseState", func() {
+		DescribeTable("#ExceedsThreshold",
+			func(state *DatabaseState, fraction float64, expected bool) {
+				Expect(state.ExceedsThreshold(fraction)).To(Equal(expected))
+			},
+
+			Entry("size below threshold", &DatabaseState{SizeBytes: 100, QuotaBytes: 1000}, 0.8, false),
+			Entry("size at threshold", &DatabaseState{SizeBytes: 800, QuotaBytes: 1000}, 0.8, true),
+			Entry("size above threshold", &DatabaseState{SizeBytes: 900, QuotaBytes: 1000}, 0.8, true),
+			Entry("no quota known", &DatabaseState{SizeBytes: 900}, 0.8, false),
+			Entry("NOSPACE alarm raised", &DatabaseState{SizeBytes: 100, QuotaBytes: 1000, NoSpaceAlarm: true}, 0.8, true),
+		)
+	})
+
+	Describe("#GetDatabaseState", func() {
+		It("should return the state reported by the etcd status", func() {
+			state, err := etcd.GetDatabaseState(ctx, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(state.SizeBytes).To(Equal(int64(7 << 30)))
+			Expect(state.SizeInUseBytes).To(Equal(int64(3 << 30)))
+			Expect(state.QuotaBytes).To(Equal(int64(8 << 30)))
+			Expect(state.NoSpaceAlarm).To(BeFalse())
+			Expect(usedEndpoint).To(Equal("https://etcd-main-client." + namespace + ":2379"))
+			Expect(databaseClient.closed).To(BeTrue())
+		})
+
+		It("should fall back to the quota configured in the Etcd resource if the status does not report it", func() {
+			databaseClient.status.DbSizeQuota = 0
+			Expect(fakeClient.Create(ctx, &druidcorev1alpha1.Etcd{
+				ObjectMeta: metav1.ObjectMeta{Name: "etcd-main", Namespace: namespace},
+				Spec:       druidcorev1alpha1.EtcdSpec{Etcd: druidcorev1alpha1.EtcdConfig{Quota: ptr.To(resource.MustParse("8Gi"))}},
+			})).To(Succeed())
+
+			state, err := etcd.GetDatabaseState(ctx, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(state.QuotaBytes).To(Equal(int64(8 << 30)))
+		})
+
+		It("should report a raised NOSPACE alarm", func() {
+			databaseClient.alarms = []*etcdserverpb.AlarmMember{{Alarm: etcdserverpb.AlarmType_NOSPACE}}
+
+			state, err := etcd.GetDatabaseState(ctx, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(state.NoSpaceAlarm).To(BeTrue())
+		})
+	})
+
+	Describe("#PerformDatabaseMaintenance", func() {
+		It("should compact, defragment all members, and disarm the alarms", func() {
+			databaseClient.members = []*etcdserverpb.Member{
+				{ClientURLs: []string{"https://etcd-main-0:2379"}},
+				{ClientURLs: []string{"https://etcd-main-1:2379"}},
+			}
+
+			Expect(etcd.PerformDatabaseMaintenance(ctx, nil)).To(Succeed())
+
+			Expect(databaseClient.compacted).To(BeTrue())
+			Expect(databaseClient.defragmented).To(ConsistOf("https://etcd-main-0:2379", "https://etcd-main-1:2379"))
+			Expect(databaseClient.disarmed).To(BeTrue())
+			Expect(databaseClient.closed).To(BeTrue())
+		})
+
+		It("should fall back to the client service endpoint if the members cannot be listed", func() {
+			databaseClient.memberErr = errors.New("fake err")
+
+			Expect(etcd.PerformDatabaseMaintenance(ctx, nil)).To(Succeed())
+
+			Expect(databaseClient.defragmented).To(ConsistOf("https://etcd-main-client." + namespace + ":2379"))
+		})
+	})
+})
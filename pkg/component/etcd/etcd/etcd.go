@@ -6,6 +6,7 @@ package etcd
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"maps"
 	"net"
@@ -91,6 +92,12 @@ type Interface interface {
 	component.DeployWaiter
 	// Snapshot triggers the backup-restore sidecar to perform a full snapshot in case backup configuration is provided.
 	Snapshot(context.Context, rest.HTTPClient) error
+	// GetDatabaseState returns the current size of the etcd database in relation to its configured backend quota as
+	// well as whether a NOSPACE alarm has been raised.
+	GetDatabaseState(context.Context, *tls.Config) (*DatabaseState, error)
+	// PerformDatabaseMaintenance compacts the etcd history, defragments the database of all members, and disarms
+	// raised NOSPACE alarms afterwards.
+	PerformDatabaseMaintenance(context.Context, *tls.Config) error
 	// SetBackupConfig sets the backup configuration.
 	SetBackupConfig(config *BackupConfig)
 	// Get retrieves the Etcd resource
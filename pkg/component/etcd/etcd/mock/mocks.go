@@ -11,6 +11,7 @@ package mock
 
 import (
 	context "context"
+	tls "crypto/tls"
 	reflect "reflect"
 
 	v1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
@@ -86,6 +87,21 @@ func (mr *MockInterfaceMockRecorder) Get(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockInterface)(nil).Get), arg0)
 }
 
+// GetDatabaseState mocks base method.
+func (m *MockInterface) GetDatabaseState(arg0 context.Context, arg1 *tls.Config) (*etcd.DatabaseState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDatabaseState", arg0, arg1)
+	ret0, _ := ret[0].(*etcd.DatabaseState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDatabaseState indicates an expected call of GetDatabaseState.
+func (mr *MockInterfaceMockRecorder) GetDatabaseState(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatabaseState", reflect.TypeOf((*MockInterface)(nil).GetDatabaseState), arg0, arg1)
+}
+
 // GetReplicas mocks base method.
 func (m *MockInterface) GetReplicas() *int32 {
 	m.ctrl.T.Helper()
@@ -114,6 +130,20 @@ func (mr *MockInterfaceMockRecorder) GetValues() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValues", reflect.TypeOf((*MockInterface)(nil).GetValues))
 }
 
+// PerformDatabaseMaintenance mocks base method.
+func (m *MockInterface) PerformDatabaseMaintenance(arg0 context.Context, arg1 *tls.Config) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PerformDatabaseMaintenance", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PerformDatabaseMaintenance indicates an expected call of PerformDatabaseMaintenance.
+func (mr *MockInterfaceMockRecorder) PerformDatabaseMaintenance(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PerformDatabaseMaintenance", reflect.TypeOf((*MockInterface)(nil).PerformDatabaseMaintenance), arg0, arg1)
+}
+
 // RolloutPeerCA mocks base method.
 func (m *MockInterface) RolloutPeerCA(arg0 context.Context) error {
 	m.ctrl.T.Helper()
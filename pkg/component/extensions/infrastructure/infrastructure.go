@@ -53,6 +53,9 @@ type Interface interface {
 	PodsCIDRs() []string
 	// EgressCIDRs returns a list of CIDRs used as source IP by any traffic originating from the shoot's worker nodes.
 	EgressCIDRs() []string
+	// EgressCIDRsByZone returns the CIDRs used as source IP by egress traffic from the shoot's worker nodes, grouped
+	// by the availability zone the traffic originates from.
+	EgressCIDRsByZone() []extensionsv1alpha1.ZoneEgressCIDRs
 }
 
 // Values contains the values used to create an Infrastructure resources.
@@ -110,12 +113,13 @@ type infrastructure struct {
 	waitSevereThreshold time.Duration
 	waitTimeout         time.Duration
 
-	infrastructure *extensionsv1alpha1.Infrastructure
-	providerStatus *runtime.RawExtension
-	nodesCIDRs     []string
-	servicesCIDRs  []string
-	podsCIDRs      []string
-	egressCIDRs    []string
+	infrastructure    *extensionsv1alpha1.Infrastructure
+	providerStatus    *runtime.RawExtension
+	nodesCIDRs        []string
+	servicesCIDRs     []string
+	podsCIDRs         []string
+	egressCIDRs       []string
+	egressCIDRsByZone []extensionsv1alpha1.ZoneEgressCIDRs
 }
 
 // Deploy uses the seed client to create or update the Infrastructure resource.
@@ -269,6 +273,12 @@ func (i *infrastructure) EgressCIDRs() []string {
 	return i.egressCIDRs
 }
 
+// EgressCIDRsByZone returns the CIDRs used as source IP by egress traffic from the shoot's worker nodes, grouped
+// by the availability zone the traffic originates from.
+func (i *infrastructure) EgressCIDRsByZone() []extensionsv1alpha1.ZoneEgressCIDRs {
+	return i.egressCIDRsByZone
+}
+
 func (i *infrastructure) extractStatus(status extensionsv1alpha1.InfrastructureStatus) {
 	i.providerStatus = status.ProviderStatus
 	if status.NodesCIDR != nil {
@@ -289,6 +299,10 @@ func (i *infrastructure) extractStatus(status extensionsv1alpha1.InfrastructureS
 	}
 	i.egressCIDRs = make([]string, len(status.EgressCIDRs))
 	copy(i.egressCIDRs, status.EgressCIDRs)
+	i.egressCIDRsByZone = make([]extensionsv1alpha1.ZoneEgressCIDRs, 0, len(status.EgressCIDRsByZone))
+	for _, zoneEgressCIDRs := range status.EgressCIDRsByZone {
+		i.egressCIDRsByZone = append(i.egressCIDRsByZone, *zoneEgressCIDRs.DeepCopy())
+	}
 }
 
 func (i *infrastructure) lastOperationNotSuccessful() bool {
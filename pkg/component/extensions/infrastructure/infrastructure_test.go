@@ -54,14 +54,15 @@ var _ = Describe("#Interface", func() {
 		mockNow *mocktime.MockNow
 		now     time.Time
 
-		region         string
-		sshPublicKey   []byte
-		providerConfig *runtime.RawExtension
-		providerStatus *runtime.RawExtension
-		nodesCIDRs     []string
-		servicesCIDRs  []string
-		podsCIDRs      []string
-		egressCIDRs    []string
+		region            string
+		sshPublicKey      []byte
+		providerConfig    *runtime.RawExtension
+		providerStatus    *runtime.RawExtension
+		nodesCIDRs        []string
+		servicesCIDRs     []string
+		podsCIDRs         []string
+		egressCIDRs       []string
+		egressCIDRsByZone []extensionsv1alpha1.ZoneEgressCIDRs
 
 		empty, expected *extensionsv1alpha1.Infrastructure
 		values          *infrastructure.Values
@@ -92,6 +93,11 @@ var _ = Describe("#Interface", func() {
 		podsCIDRs = []string{"10.11.12.13/14", "11.12.13.14/15"}
 		egressCIDRs = []string{"1.2.3.4/5", "5.6.7.8/9"}
 
+		egressCIDRsByZone = []extensionsv1alpha1.ZoneEgressCIDRs{
+			{Zone: "zone-a", CIDRs: []string{"1.2.3.4/5"}},
+			{Zone: "zone-b", CIDRs: []string{"5.6.7.8/9"}},
+		}
+
 		values = &infrastructure.Values{
 			Namespace:      namespace,
 			Name:           name,
@@ -330,6 +336,7 @@ var _ = Describe("#Interface", func() {
 			}
 			expected.Status.ProviderStatus = providerStatus
 			expected.Status.EgressCIDRs = egressCIDRs
+			expected.Status.EgressCIDRsByZone = egressCIDRsByZone
 			Expect(c.Patch(ctx, expected, patch)).To(Succeed(), "patching infrastructure succeeds")
 
 			By("Wait")
@@ -341,6 +348,7 @@ var _ = Describe("#Interface", func() {
 			Expect(deployWaiter.ServicesCIDRs()).To(Equal(servicesCIDRs))
 			Expect(deployWaiter.PodsCIDRs()).To(Equal(podsCIDRs))
 			Expect(deployWaiter.EgressCIDRs()).To(Equal(egressCIDRs))
+			Expect(deployWaiter.EgressCIDRsByZone()).To(Equal(egressCIDRsByZone))
 		})
 
 		It("should return no error when is ready (AnnotateOperation == false)", func() {
@@ -358,6 +366,7 @@ var _ = Describe("#Interface", func() {
 			}
 			expected.Status.ProviderStatus = providerStatus
 			expected.Status.EgressCIDRs = egressCIDRs
+			expected.Status.EgressCIDRsByZone = egressCIDRsByZone
 
 			Expect(c.Create(ctx, expected)).To(Succeed(), "creating infrastructure succeeds")
 			Expect(deployWaiter.Wait(ctx)).To(Succeed())
@@ -366,6 +375,7 @@ var _ = Describe("#Interface", func() {
 			Expect(deployWaiter.ServicesCIDRs()).To(Equal(servicesCIDRs))
 			Expect(deployWaiter.PodsCIDRs()).To(Equal(podsCIDRs))
 			Expect(deployWaiter.EgressCIDRs()).To(Equal(egressCIDRs))
+			Expect(deployWaiter.EgressCIDRsByZone()).To(Equal(egressCIDRsByZone))
 		})
 	})
 
@@ -85,6 +85,20 @@ func (mr *MockInterfaceMockRecorder) EgressCIDRs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EgressCIDRs", reflect.TypeOf((*MockInterface)(nil).EgressCIDRs))
 }
 
+// EgressCIDRsByZone mocks base method.
+func (m *MockInterface) EgressCIDRsByZone() []v1alpha1.ZoneEgressCIDRs {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EgressCIDRsByZone")
+	ret0, _ := ret[0].([]v1alpha1.ZoneEgressCIDRs)
+	return ret0
+}
+
+// EgressCIDRsByZone indicates an expected call of EgressCIDRsByZone.
+func (mr *MockInterfaceMockRecorder) EgressCIDRsByZone() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EgressCIDRsByZone", reflect.TypeOf((*MockInterface)(nil).EgressCIDRsByZone))
+}
+
 // Get mocks base method.
 func (m *MockInterface) Get(arg0 context.Context) (*v1alpha1.Infrastructure, error) {
 	m.ctrl.T.Helper()
@@ -981,6 +981,10 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 			if version.ConstraintK8sGreaterEqual131.Check(d.kubernetesVersion) {
 				d.osc.Spec.CRIConfig.CgroupDriver = ptr.To(extensionsv1alpha1.CgroupDriverSystemd)
 			}
+
+			if d.worker.CRI.Containerd != nil {
+				d.osc.Spec.CRIConfig.Containerd.Registries = containerdRegistryConfigs(d.worker.CRI.Containerd.Registries)
+			}
 		}
 
 		return nil
@@ -988,6 +992,25 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 	return d.osc, err
 }
 
+func containerdRegistryConfigs(registries []gardencorev1beta1.ContainerdRegistry) []extensionsv1alpha1.RegistryConfig {
+	registryConfigs := make([]extensionsv1alpha1.RegistryConfig, 0, len(registries))
+
+	for _, registry := range registries {
+		registryConfig := extensionsv1alpha1.RegistryConfig{
+			Upstream: registry.Upstream,
+			Server:   registry.Server,
+		}
+
+		for _, host := range registry.Hosts {
+			registryConfig.Hosts = append(registryConfig.Hosts, extensionsv1alpha1.RegistryHost{URL: host})
+		}
+
+		registryConfigs = append(registryConfigs, registryConfig)
+	}
+
+	return registryConfigs
+}
+
 func (o *operatingSystemConfig) calculateKey(version int, worker *gardencorev1beta1.Worker) (string, error) {
 	if v1beta1helper.IsUpdateStrategyInPlace(worker.UpdateStrategy) {
 		return fmt.Sprintf("gardener-node-agent-%s", worker.Name), nil
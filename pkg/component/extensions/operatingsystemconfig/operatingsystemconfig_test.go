@@ -178,6 +178,19 @@ var _ = Describe("OperatingSystemConfig", func() {
 						criConfig.CgroupDriver = ptr.To(extensionsv1alpha1.CgroupDriverSystemd)
 					}
 
+					if worker.CRI.Containerd != nil {
+						for _, registry := range worker.CRI.Containerd.Registries {
+							registryConfig := extensionsv1alpha1.RegistryConfig{
+								Upstream: registry.Upstream,
+								Server:   registry.Server,
+							}
+							for _, host := range registry.Hosts {
+								registryConfig.Hosts = append(registryConfig.Hosts, extensionsv1alpha1.RegistryHost{URL: host})
+							}
+							criConfig.Containerd.Registries = append(criConfig.Containerd.Registries, registryConfig)
+						}
+					}
+
 					criConfigProvisioning = &extensionsv1alpha1.CRIConfig{
 						Name: extensionsv1alpha1.CRIName(worker.CRI.Name),
 					}
@@ -389,6 +402,13 @@ var _ = Describe("OperatingSystemConfig", func() {
 					},
 					CRI: &gardencorev1beta1.CRI{
 						Name: gardencorev1beta1.CRINameContainerD,
+						Containerd: &gardencorev1beta1.ContainerdConfig{
+							Registries: []gardencorev1beta1.ContainerdRegistry{{
+								Upstream: "docker.io",
+								Server:   ptr.To("https://registry-1.docker.io"),
+								Hosts:    []string{"https://mirror.example.com"},
+							}},
+						},
 					},
 					KubeletDataVolumeName: &kubeletDataVolumeName,
 					Kubernetes: &gardencorev1beta1.WorkerKubernetes{
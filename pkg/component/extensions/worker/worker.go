@@ -267,6 +267,7 @@ func (w *worker) deploy(ctx context.Context, operation string) (extensionsv1alph
 			ClusterAutoscaler:                autoscalerOptions,
 			Priority:                         workerPool.Priority,
 			UpdateStrategy:                   workerPool.UpdateStrategy,
+			NodeWorkloadIdentityName:         workerPool.NodeWorkloadIdentityName,
 		})
 	}
 
@@ -231,6 +231,7 @@ var _ = Describe("Worker", func() {
 					Zones:                            []string{worker1Zone1, worker1Zone2},
 					ClusterAutoscaler:                &gardencorev1beta1.ClusterAutoscalerOptions{},
 					UpdateStrategy:                   ptr.To(gardencorev1beta1.AutoRollingUpdate),
+					NodeWorkloadIdentityName:         ptr.To("node-identity"),
 				},
 				{
 					Name:           worker2Name,
@@ -329,6 +330,7 @@ var _ = Describe("Worker", func() {
 					Architecture:                     worker1Arch,
 					ClusterAutoscaler:                emptyAutoscalerOptions,
 					UpdateStrategy:                   ptr.To(gardencorev1beta1.AutoRollingUpdate),
+					NodeWorkloadIdentityName:         ptr.To("node-identity"),
 				},
 				{
 					Name:           worker2Name,
@@ -470,6 +470,7 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 					Resources: []string{
 						"shoots/adminkubeconfig",
 						"shoots/viewerkubeconfig",
+						"shoots/adminsshconfig",
 					},
 					Verbs: []string{"create"},
 				},
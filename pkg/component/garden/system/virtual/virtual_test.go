@@ -490,6 +490,7 @@ var _ = Describe("Virtual", func() {
 					Resources: []string{
 						"shoots/adminkubeconfig",
 						"shoots/viewerkubeconfig",
+						"shoots/adminsshconfig",
 					},
 					Verbs: []string{"create"},
 				},
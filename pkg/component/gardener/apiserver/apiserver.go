@@ -96,6 +96,8 @@ type AutoscalingConfig struct {
 	APIServerResources corev1.ResourceRequirements
 	// Replicas is the number of pod replicas for the API server.
 	Replicas *int32
+	// MinAllowed are the minimum allowed resources for vertical autoscaling.
+	MinAllowed corev1.ResourceList
 }
 
 // New creates a new instance of DeployWaiter for the gardener-apiserver.
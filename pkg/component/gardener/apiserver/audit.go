@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	"github.com/gardener/gardener/pkg/component/apiserver"
+)
+
+// DefaultAuditPolicy returns the built-in audit policy for the gardener-apiserver. The policy records all requests on
+// Metadata level and additionally records the request bodies of mutating requests, but redacts the bodies of resources
+// which may contain sensitive data (secret binding and credentials binding resources as well as kubeconfig and token
+// subresources).
+func DefaultAuditPolicy() (string, error) {
+	return apiserver.RenderAuditPolicy(&auditv1.Policy{
+		OmitStages: []auditv1.Stage{auditv1.StageRequestReceived},
+		Rules: []auditv1.PolicyRule{
+			// Never record request or response bodies of resources which may contain sensitive data.
+			{
+				Level: auditv1.LevelMetadata,
+				Resources: []auditv1.GroupResources{
+					{
+						Group:     gardencorev1beta1.GroupName,
+						Resources: []string{"secretbindings", "shoots/adminkubeconfig", "shoots/viewerkubeconfig", "shoots/adminsshconfig"},
+					},
+					{
+						Group:     securityv1alpha1.GroupName,
+						Resources: []string{"credentialsbindings", "workloadidentities", "workloadidentities/token"},
+					},
+				},
+			},
+			// Record the request bodies of all other mutating requests.
+			{
+				Level: auditv1.LevelRequest,
+				Verbs: []string{"create", "update", "patch", "delete"},
+			},
+			// Record everything else on Metadata level.
+			{
+				Level: auditv1.LevelMetadata,
+			},
+		},
+	})
+}
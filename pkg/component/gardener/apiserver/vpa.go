@@ -5,6 +5,8 @@
 package apiserver
 
 import (
+	"maps"
+
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -15,6 +17,12 @@ import (
 )
 
 func (g *gardenerAPIServer) verticalPodAutoscaler() *vpaautoscalingv1.VerticalPodAutoscaler {
+	minAllowed := corev1.ResourceList{
+		corev1.ResourceMemory: resource.MustParse("200M"),
+	}
+
+	maps.Insert(minAllowed, maps.All(g.values.Autoscaling.MinAllowed))
+
 	return &vpaautoscalingv1.VerticalPodAutoscaler{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      DeploymentName + "-vpa",
@@ -34,9 +42,7 @@ func (g *gardenerAPIServer) verticalPodAutoscaler() *vpaautoscalingv1.VerticalPo
 				ContainerPolicies: []vpaautoscalingv1.ContainerResourcePolicy{
 					{
 						ContainerName: containerName,
-						MinAllowed: corev1.ResourceList{
-							corev1.ResourceMemory: resource.MustParse("200M"),
-						},
+						MinAllowed:    minAllowed,
 						MaxAllowed: corev1.ResourceList{
 							// The CPU and memory are aligned to the machine ration of 1:4.
 							corev1.ResourceCPU:    resource.MustParse("12"),
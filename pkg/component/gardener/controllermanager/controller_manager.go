@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -49,6 +50,9 @@ type Values struct {
 	Quotas []controllermanagerconfigv1alpha1.QuotaConfiguration
 	// FeatureGates is the set of feature gates.
 	FeatureGates map[string]bool
+	// Resources are the resource requirements for the gardener-controller-manager container. If not set, default
+	// requests of 1 CPU and 1Gi memory are used.
+	Resources *corev1.ResourceRequirements
 }
 
 // New creates a new instance of DeployWaiter for the gardener-controller-manager.
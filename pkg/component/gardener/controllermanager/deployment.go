@@ -69,12 +69,7 @@ func (g *gardenerControllerManager) deployment(secretGenericTokenKubeconfig, sec
 							Args: []string{
 								fmt.Sprintf("--config=%s/%s", volumeMountConfig, dataConfigKey),
 							},
-							Resources: corev1.ResourceRequirements{
-								Requests: map[corev1.ResourceName]resource.Quantity{
-									corev1.ResourceCPU:    resource.MustParse("1"),
-									corev1.ResourceMemory: resource.MustParse("1Gi"),
-								},
-							},
+							Resources: g.containerResources(),
 							LivenessProbe: &corev1.Probe{
 								ProbeHandler: corev1.ProbeHandler{
 									HTTPGet: &corev1.HTTPGetAction{
@@ -128,3 +123,16 @@ func (g *gardenerControllerManager) deployment(secretGenericTokenKubeconfig, sec
 
 	return deployment
 }
+
+func (g *gardenerControllerManager) containerResources() corev1.ResourceRequirements {
+	if g.values.Resources != nil {
+		return *g.values.Resources
+	}
+
+	return corev1.ResourceRequirements{
+		Requests: map[corev1.ResourceName]resource.Quantity{
+			corev1.ResourceCPU:    resource.MustParse("1"),
+			corev1.ResourceMemory: resource.MustParse("1Gi"),
+		},
+	}
+}
@@ -81,6 +81,8 @@ type Interface interface {
 	SetServiceAccountConfig(ServiceAccountConfig)
 	// SetSNIConfig sets the SNI field in the Values of the deployer.
 	SetSNIConfig(SNIConfig)
+	// SetBlueGreenRollout sets the BlueGreenRollout field in the Values of the deployer.
+	SetBlueGreenRollout(bool)
 }
 
 // Values contains configuration values for the kube-apiserver resources.
@@ -100,6 +102,9 @@ type Values struct {
 	AuthorizationWebhooks []AuthorizationWebhook
 	// Autoscaling contains information for configuring autoscaling settings for the API server.
 	Autoscaling AutoscalingConfig
+	// BlueGreenRollout states whether minor version updates shall be rolled out blue-green style: The new-version pods
+	// are brought up alongside the old-version pods and only start receiving traffic after their health was verified.
+	BlueGreenRollout bool
 	// DefaultNotReadyTolerationSeconds indicates the tolerationSeconds of the toleration for notReady:NoExecute
 	// that is added by default to every pod that does not already have such a toleration (flag `--default-not-ready-toleration-seconds`).
 	DefaultNotReadyTolerationSeconds *int64
@@ -178,6 +183,12 @@ type AutoscalingConfig struct {
 	ScaleDownDisabled bool
 	// MinAllowed are the minimum allowed resources for vertical autoscaling.
 	MinAllowed corev1.ResourceList
+	// AverageRequestsPerSecond is the target average number of requests per second per replica for request-based
+	// horizontal autoscaling.
+	AverageRequestsPerSecond *int32
+	// AverageInflightRequests is the target average number of inflight requests per replica for request-based
+	// horizontal autoscaling.
+	AverageInflightRequests *int32
 }
 
 // Images is a set of container images used for the containers of the kube-apiserver pods.
@@ -520,7 +531,18 @@ func (k *kubeAPIServer) Wait(ctx context.Context) error {
 
 	deployment := k.emptyDeployment()
 
-	if err := Until(timeoutCtx, IntervalWaitForDeployment, health.IsDeploymentUpdated(k.client.APIReader(), deployment)); err != nil {
+	waitFn := health.IsDeploymentUpdated(k.client.APIReader(), deployment)
+	if k.values.BlueGreenRollout {
+		isDeploymentUpdated := waitFn
+		waitFn = func(ctx context.Context) (bool, error) {
+			if err := k.approveBlueGreenPods(ctx); err != nil {
+				return retry.SevereError(err)
+			}
+			return isDeploymentUpdated(ctx)
+		}
+	}
+
+	if err := Until(timeoutCtx, IntervalWaitForDeployment, waitFn); err != nil {
 		var (
 			retryError *retry.Error
 			headBytes  *int64
@@ -550,6 +572,68 @@ func (k *kubeAPIServer) Wait(ctx context.Context) error {
 	return nil
 }
 
+// approveBlueGreenPods verifies the health of new-version pods during a blue-green style rollout and approves their
+// readiness gate. The approval only happens once the full set of new-version pods passed the kube-apiserver health
+// checks, so that traffic switches over to the new version as a group and the old-version pods are only terminated
+// afterwards.
+func (k *kubeAPIServer) approveBlueGreenPods(ctx context.Context) error {
+	podList := &corev1.PodList{}
+	if err := k.client.Client().List(ctx, podList, client.InNamespace(k.namespace), client.MatchingLabels(getLabels())); err != nil {
+		return err
+	}
+
+	var gatedPods []*corev1.Pod
+	for i, pod := range podList.Items {
+		if hasBlueGreenReadinessGate(&pod) && !isBlueGreenVerified(&pod) {
+			gatedPods = append(gatedPods, &podList.Items[i])
+		}
+	}
+
+	if len(gatedPods) == 0 || int32(len(gatedPods)) < ptr.Deref(k.values.Autoscaling.Replicas, 0) {
+		return nil
+	}
+
+	for _, pod := range gatedPods {
+		if !isKubeAPIServerContainerReady(pod) {
+			return nil
+		}
+	}
+
+	for _, pod := range gatedPods {
+		patch := client.MergeFrom(pod.DeepCopy())
+		pod.Status.Conditions = append(pod.Status.Conditions, corev1.PodCondition{
+			Type:               readinessGateBlueGreenVerified,
+			Status:             corev1.ConditionTrue,
+			LastTransitionTime: metav1.Now(),
+			Reason:             "HealthVerified",
+			Message:            "All new-version kube-apiserver pods passed their health checks",
+		})
+		if err := k.client.Client().Status().Patch(ctx, pod, patch); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func hasBlueGreenReadinessGate(pod *corev1.Pod) bool {
+	return slices.ContainsFunc(pod.Spec.ReadinessGates, func(gate corev1.PodReadinessGate) bool {
+		return gate.ConditionType == readinessGateBlueGreenVerified
+	})
+}
+
+func isBlueGreenVerified(pod *corev1.Pod) bool {
+	return slices.ContainsFunc(pod.Status.Conditions, func(condition corev1.PodCondition) bool {
+		return condition.Type == readinessGateBlueGreenVerified && condition.Status == corev1.ConditionTrue
+	})
+}
+
+func isKubeAPIServerContainerReady(pod *corev1.Pod) bool {
+	return slices.ContainsFunc(pod.Status.ContainerStatuses, func(status corev1.ContainerStatus) bool {
+		return status.Name == ContainerNameKubeAPIServer && status.Ready
+	})
+}
+
 func (k *kubeAPIServer) WaitCleanup(ctx context.Context) error {
 	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForDeployment)
 	defer cancel()
@@ -638,6 +722,10 @@ func (k *kubeAPIServer) SetSNIConfig(config SNIConfig) {
 	k.values.SNI = config
 }
 
+func (k *kubeAPIServer) SetBlueGreenRollout(enabled bool) {
+	k.values.BlueGreenRollout = enabled
+}
+
 func (k *kubeAPIServer) prometheusAccessSecretName() string {
 	if k.values.NamePrefix != "" {
 		return garden.AccessSecretName
@@ -317,6 +317,51 @@ var _ = Describe("KubeAPIServer", func() {
 					},
 				}))
 			})
+
+			It("should successfully deploy the HPA resource with request-based metrics", func() {
+				autoscalingConfig := AutoscalingConfig{
+					Replicas:                 ptr.To[int32](2),
+					MinReplicas:              4,
+					MaxReplicas:              6,
+					AverageRequestsPerSecond: ptr.To[int32](500),
+					AverageInflightRequests:  ptr.To[int32](100),
+				}
+
+				kapi = New(kubernetesInterface, namespace, sm, Values{
+					Values: apiserver.Values{
+						RuntimeVersion: runtimeVersion,
+					},
+					Autoscaling: autoscalingConfig,
+					Version:     version},
+				)
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(horizontalPodAutoscaler), horizontalPodAutoscaler)).To(BeNotFoundError())
+				Expect(kapi.Deploy(ctx)).To(Succeed())
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(horizontalPodAutoscaler), horizontalPodAutoscaler)).To(Succeed())
+				Expect(horizontalPodAutoscaler.Spec.Metrics).To(ContainElements(
+					autoscalingv2.MetricSpec{
+						Type: "Pods",
+						Pods: &autoscalingv2.PodsMetricSource{
+							Metric: autoscalingv2.MetricIdentifier{Name: "apiserver_request_total"},
+							Target: autoscalingv2.MetricTarget{
+								Type:         autoscalingv2.AverageValueMetricType,
+								AverageValue: ptr.To(resource.MustParse("500")),
+							},
+						},
+					},
+					autoscalingv2.MetricSpec{
+						Type: "Pods",
+						Pods: &autoscalingv2.PodsMetricSource{
+							Metric: autoscalingv2.MetricIdentifier{Name: "apiserver_current_inflight_requests"},
+							Target: autoscalingv2.MetricTarget{
+								Type:         autoscalingv2.AverageValueMetricType,
+								AverageValue: ptr.To(resource.MustParse("100")),
+							},
+						},
+					},
+				))
+				Expect(horizontalPodAutoscaler.Spec.Behavior.ScaleDown.StabilizationWindowSeconds).To(PointTo(Equal(int32(3600))))
+			})
 		})
 
 		Describe("VerticalPodAutoscaler", func() {
@@ -4904,6 +4949,65 @@ kind: AuthenticationConfiguration
 
 			Expect(kapi.Wait(ctx)).To(Succeed())
 		})
+
+		It("should verify and approve the readiness gates of new-version pods when blue-green rollout is enabled", func() {
+			fakeClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+			fakeKubernetesInterface := fakekubernetes.NewClientSetBuilder().WithAPIReader(fakeClient).WithClient(fakeClient).Build()
+			kapi = New(fakeKubernetesInterface, namespace, nil, Values{
+				Values: apiserver.Values{
+					RuntimeVersion: runtimeVersion,
+				},
+				Autoscaling:      AutoscalingConfig{Replicas: ptr.To[int32](2)},
+				BlueGreenRollout: true,
+				Version:          version,
+			})
+			deploy := deployment.DeepCopy()
+
+			defer test.WithVars(&IntervalWaitForDeployment, time.Millisecond)()
+			defer test.WithVars(&TimeoutWaitForDeployment, 100*time.Millisecond)()
+
+			Expect(fakeClient.Create(ctx, deploy)).To(Succeed())
+			deploy.Spec.Replicas = ptr.To[int32](2)
+			Expect(fakeClient.Update(ctx, deploy)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(deploy), deploy)).To(Succeed())
+
+			deploy.Status.Conditions = []appsv1.DeploymentCondition{
+				{Type: appsv1.DeploymentProgressing, Status: "True", Reason: "NewReplicaSetAvailable"},
+				{Type: appsv1.DeploymentAvailable, Status: "True"},
+			}
+			deploy.Status.ObservedGeneration = deploy.Generation
+			deploy.Status.Replicas = *deploy.Spec.Replicas
+			deploy.Status.UpdatedReplicas = *deploy.Spec.Replicas
+			deploy.Status.AvailableReplicas = *deploy.Spec.Replicas
+			Expect(fakeClient.Status().Update(ctx, deploy)).To(Succeed())
+
+			for _, name := range []string{"pod1", "pod2"} {
+				Expect(fakeClient.Create(ctx, &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      name,
+						Namespace: deployment.Namespace,
+						Labels:    GetLabels(),
+					},
+					Spec: corev1.PodSpec{
+						ReadinessGates: []corev1.PodReadinessGate{{ConditionType: "kubernetes.gardener.cloud/blue-green-verified"}},
+					},
+					Status: corev1.PodStatus{
+						ContainerStatuses: []corev1.ContainerStatus{{Name: ContainerNameKubeAPIServer, Ready: true}},
+					},
+				})).To(Succeed())
+			}
+
+			Expect(kapi.Wait(ctx)).To(Succeed())
+
+			for _, name := range []string{"pod1", "pod2"} {
+				pod := &corev1.Pod{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: deployment.Namespace, Name: name}, pod)).To(Succeed())
+				Expect(pod.Status.Conditions).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(corev1.PodConditionType("kubernetes.gardener.cloud/blue-green-verified")),
+					"Status": Equal(corev1.ConditionTrue),
+				})))
+			}
+		})
 	})
 
 	Describe("#WaitCleanup", func() {
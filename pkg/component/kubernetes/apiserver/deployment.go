@@ -11,6 +11,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/Masterminds/semver/v3"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -51,6 +52,10 @@ const (
 	containerNameVPNPathController = "vpn-path-controller"
 	containerNameVPNSeedClient     = "vpn-client"
 
+	// readinessGateBlueGreenVerified is the pod condition type used as readiness gate for new-version pods during
+	// blue-green style rollouts. It is only approved once the health of the full set of new-version pods was verified.
+	readinessGateBlueGreenVerified corev1.PodConditionType = "kubernetes.gardener.cloud/blue-green-verified"
+
 	// EnvoyPortHAVPN is the port exposed by the envoy proxy on which it receives http proxy/connect requests.
 	EnvoyPortHAVPN = 9443
 	// EnvoyHostHAVPN is the hostname of the envoy proxy when running in HA-VPN mode.
@@ -186,6 +191,8 @@ func (k *kubeAPIServer) reconcileDeployment(
 	}
 
 	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, k.client.Client(), deployment, func() error {
+		readinessGates := k.computeBlueGreenReadinessGates(deployment)
+
 		deployment.Labels = utils.MergeStringMaps(GetLabels(), map[string]string{
 			resourcesv1alpha1.HighAvailabilityConfigType:                        resourcesv1alpha1.HighAvailabilityConfigTypeServer,
 			v1beta1constants.LabelExtensionProviderMutatedByControlplaneWebhook: "true",
@@ -216,6 +223,7 @@ func (k *kubeAPIServer) reconcileDeployment(
 				},
 				Spec: corev1.PodSpec{
 					AutomountServiceAccountToken:  ptr.To(false),
+					ReadinessGates:                readinessGates,
 					PriorityClassName:             k.values.PriorityClassName,
 					DNSPolicy:                     corev1.DNSClusterFirst,
 					RestartPolicy:                 corev1.RestartPolicyAlways,
@@ -403,6 +411,45 @@ func (k *kubeAPIServer) reconcileDeployment(
 	return err
 }
 
+// computeBlueGreenReadinessGates returns the readiness gates for the kube-apiserver pods. When blue-green style
+// rollouts are enabled and the minor version changes, the new-version pods get a readiness gate so that they neither
+// receive traffic nor cause the old-version pods to be terminated before their health was verified (see Wait). The
+// gate is kept until the rollout completed and removed again with a regular rolling update afterwards.
+func (k *kubeAPIServer) computeBlueGreenReadinessGates(deployment *appsv1.Deployment) []corev1.PodReadinessGate {
+	if !k.values.BlueGreenRollout {
+		return nil
+	}
+
+	gates := []corev1.PodReadinessGate{{ConditionType: readinessGateBlueGreenVerified}}
+
+	if existingVersion := kubeAPIServerVersionFromPodTemplate(&deployment.Spec.Template); existingVersion != nil &&
+		(existingVersion.Major() != k.values.Version.Major() || existingVersion.Minor() != k.values.Version.Minor()) {
+		return gates
+	}
+
+	if hasBlueGreenReadinessGate(&corev1.Pod{Spec: deployment.Spec.Template.Spec}) &&
+		(deployment.Status.UpdatedReplicas < deployment.Status.Replicas || deployment.Status.UnavailableReplicas > 0) {
+		return gates
+	}
+
+	return nil
+}
+
+// kubeAPIServerVersionFromPodTemplate returns the Kubernetes version of the kube-apiserver container image in the
+// given pod template, or nil if it cannot be determined.
+func kubeAPIServerVersionFromPodTemplate(template *corev1.PodTemplateSpec) *semver.Version {
+	for _, container := range template.Spec.Containers {
+		if container.Name == ContainerNameKubeAPIServer {
+			if idx := strings.LastIndex(container.Image, ":"); idx != -1 {
+				if version, err := semver.NewVersion(strings.TrimPrefix(container.Image[idx+1:], "v")); err == nil {
+					return version
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func (k *kubeAPIServer) computeKubeAPIServerArgs() []string {
 	var out []string
 
@@ -20,6 +20,16 @@ import (
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
+const (
+	// metricNameRequestsPerSecond is the name of the custom metric exposing the per-second request rate of a
+	// kube-apiserver pod. It is computed from the `apiserver_request_total` metric scraped by the shoot's Prometheus.
+	metricNameRequestsPerSecond = "apiserver_request_total"
+	// metricNameInflightRequests is the name of the custom metric exposing the number of inflight requests of a
+	// kube-apiserver pod. It is based on the `apiserver_current_inflight_requests` metric scraped by the shoot's
+	// Prometheus.
+	metricNameInflightRequests = "apiserver_current_inflight_requests"
+)
+
 func (k *kubeAPIServer) emptyHorizontalPodAutoscaler() *autoscalingv2.HorizontalPodAutoscaler {
 	return &autoscalingv2.HorizontalPodAutoscaler{
 		ObjectMeta: metav1.ObjectMeta{
@@ -45,6 +55,64 @@ func (k *kubeAPIServer) reconcileHorizontalPodAutoscaler(ctx context.Context, hp
 			minReplicas = max(*hpa.Spec.MinReplicas, hpa.Status.DesiredReplicas)
 		}
 
+		metrics := []autoscalingv2.MetricSpec{
+			{
+				Type: autoscalingv2.ResourceMetricSourceType,
+				Resource: &autoscalingv2.ResourceMetricSource{
+					Name: corev1.ResourceCPU,
+					Target: autoscalingv2.MetricTarget{
+						Type: autoscalingv2.AverageValueMetricType,
+						// The chosen value of 6 CPU is aligned with the average value for memory - 24G. Preserve the cpu:memory ratio of 1:4.
+						AverageValue: ptr.To(resource.MustParse("6")),
+					},
+				},
+			},
+			{
+				Type: autoscalingv2.ResourceMetricSourceType,
+				Resource: &autoscalingv2.ResourceMetricSource{
+					Name: corev1.ResourceMemory,
+					Target: autoscalingv2.MetricTarget{
+						Type: autoscalingv2.AverageValueMetricType,
+						// The chosen value of 24G is aligned with the average value for cpu - 6 CPU cores. Preserve the cpu:memory ratio of 1:4.
+						AverageValue: ptr.To(resource.MustParse("24G")),
+					},
+				},
+			},
+		}
+
+		scaleDownStabilizationSeconds := int32(1800)
+
+		if k.values.Autoscaling.AverageRequestsPerSecond != nil {
+			metrics = append(metrics, autoscalingv2.MetricSpec{
+				Type: autoscalingv2.PodsMetricSourceType,
+				Pods: &autoscalingv2.PodsMetricSource{
+					Metric: autoscalingv2.MetricIdentifier{Name: metricNameRequestsPerSecond},
+					Target: autoscalingv2.MetricTarget{
+						Type:         autoscalingv2.AverageValueMetricType,
+						AverageValue: resource.NewQuantity(int64(*k.values.Autoscaling.AverageRequestsPerSecond), resource.DecimalSI),
+					},
+				},
+			})
+		}
+		if k.values.Autoscaling.AverageInflightRequests != nil {
+			metrics = append(metrics, autoscalingv2.MetricSpec{
+				Type: autoscalingv2.PodsMetricSourceType,
+				Pods: &autoscalingv2.PodsMetricSource{
+					Metric: autoscalingv2.MetricIdentifier{Name: metricNameInflightRequests},
+					Target: autoscalingv2.MetricTarget{
+						Type:         autoscalingv2.AverageValueMetricType,
+						AverageValue: resource.NewQuantity(int64(*k.values.Autoscaling.AverageInflightRequests), resource.DecimalSI),
+					},
+				},
+			})
+		}
+		if k.values.Autoscaling.AverageRequestsPerSecond != nil || k.values.Autoscaling.AverageInflightRequests != nil {
+			// Request-based metrics are more volatile than resource metrics. Use a longer scale-down stabilization
+			// window so that replicas of highly-available API servers are only removed once the request load has
+			// reliably settled.
+			scaleDownStabilizationSeconds = 3600
+		}
+
 		metav1.SetMetaDataLabel(&hpa.ObjectMeta, resourcesv1alpha1.HighAvailabilityConfigType, resourcesv1alpha1.HighAvailabilityConfigTypeServer)
 		hpa.Spec = autoscalingv2.HorizontalPodAutoscalerSpec{
 			MinReplicas: &minReplicas,
@@ -54,30 +122,7 @@ func (k *kubeAPIServer) reconcileHorizontalPodAutoscaler(ctx context.Context, hp
 				Kind:       "Deployment",
 				Name:       deployment.Name,
 			},
-			Metrics: []autoscalingv2.MetricSpec{
-				{
-					Type: autoscalingv2.ResourceMetricSourceType,
-					Resource: &autoscalingv2.ResourceMetricSource{
-						Name: corev1.ResourceCPU,
-						Target: autoscalingv2.MetricTarget{
-							Type: autoscalingv2.AverageValueMetricType,
-							// The chosen value of 6 CPU is aligned with the average value for memory - 24G. Preserve the cpu:memory ratio of 1:4.
-							AverageValue: ptr.To(resource.MustParse("6")),
-						},
-					},
-				},
-				{
-					Type: autoscalingv2.ResourceMetricSourceType,
-					Resource: &autoscalingv2.ResourceMetricSource{
-						Name: corev1.ResourceMemory,
-						Target: autoscalingv2.MetricTarget{
-							Type: autoscalingv2.AverageValueMetricType,
-							// The chosen value of 24G is aligned with the average value for cpu - 6 CPU cores. Preserve the cpu:memory ratio of 1:4.
-							AverageValue: ptr.To(resource.MustParse("24G")),
-						},
-					},
-				},
-			},
+			Metrics: metrics,
 			Behavior: &autoscalingv2.HorizontalPodAutoscalerBehavior{
 				ScaleUp: &autoscalingv2.HPAScalingRules{
 					StabilizationWindowSeconds: ptr.To[int32](60),
@@ -91,7 +136,7 @@ func (k *kubeAPIServer) reconcileHorizontalPodAutoscaler(ctx context.Context, hp
 					},
 				},
 				ScaleDown: &autoscalingv2.HPAScalingRules{
-					StabilizationWindowSeconds: ptr.To[int32](1800),
+					StabilizationWindowSeconds: &scaleDownStabilizationSeconds,
 					Policies: []autoscalingv2.HPAScalingPolicy{
 						// Allow to downscale one pod every 5 minutes to see whether any downscale recommendation will still hold true after the cluster has settled (conservatively)
 						{
@@ -149,6 +149,18 @@ func (mr *MockInterfaceMockRecorder) SetAutoscalingReplicas(arg0 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAutoscalingReplicas", reflect.TypeOf((*MockInterface)(nil).SetAutoscalingReplicas), arg0)
 }
 
+// SetBlueGreenRollout mocks base method.
+func (m *MockInterface) SetBlueGreenRollout(arg0 bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetBlueGreenRollout", arg0)
+}
+
+// SetBlueGreenRollout indicates an expected call of SetBlueGreenRollout.
+func (mr *MockInterfaceMockRecorder) SetBlueGreenRollout(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBlueGreenRollout", reflect.TypeOf((*MockInterface)(nil).SetBlueGreenRollout), arg0)
+}
+
 // SetETCDEncryptionConfig mocks base method.
 func (m *MockInterface) SetETCDEncryptionConfig(arg0 apiserver.ETCDEncryptionConfig) {
 	m.ctrl.T.Helper()
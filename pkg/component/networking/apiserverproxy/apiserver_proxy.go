@@ -126,15 +126,21 @@ func (a *apiserverProxy) Deploy(ctx context.Context) error {
 			"envoy_cluster_lb_healthy_panic",
 			"envoy_cluster_update_attempt",
 			"envoy_cluster_update_failure",
+			"envoy_cluster_upstream_cx_active",
+			"envoy_cluster_upstream_cx_connect_fail",
 			"envoy_cluster_upstream_cx_connect_ms_bucket",
+			"envoy_cluster_upstream_cx_connect_timeout",
 			"envoy_cluster_upstream_cx_length_ms_bucket",
 			"envoy_cluster_upstream_cx_none_healthy",
 			"envoy_cluster_upstream_cx_rx_bytes_total",
 			"envoy_cluster_upstream_cx_tx_bytes_total",
+			"envoy_listener_downstream_cx_active",
 			"envoy_listener_downstream_cx_destroy",
 			"envoy_listener_downstream_cx_length_ms_bucket",
 			"envoy_listener_downstream_cx_overflow",
 			"envoy_listener_downstream_cx_total",
+			"envoy_listener_ssl_connection_error",
+			"envoy_listener_ssl_fail_verify_san",
 			"envoy_tcp_downstream_cx_no_route",
 			"envoy_tcp_downstream_cx_rx_bytes_total",
 			"envoy_tcp_downstream_cx_total",
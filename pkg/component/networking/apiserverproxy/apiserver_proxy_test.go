@@ -154,7 +154,7 @@ var _ = Describe("APIServerProxy", func() {
 					{
 						SourceLabels: []monitoringv1.LabelName{"__name__"},
 						Action:       "keep",
-						Regex:        `^(envoy_cluster_bind_errors|envoy_cluster_lb_healthy_panic|envoy_cluster_update_attempt|envoy_cluster_update_failure|envoy_cluster_upstream_cx_connect_ms_bucket|envoy_cluster_upstream_cx_length_ms_bucket|envoy_cluster_upstream_cx_none_healthy|envoy_cluster_upstream_cx_rx_bytes_total|envoy_cluster_upstream_cx_tx_bytes_total|envoy_listener_downstream_cx_destroy|envoy_listener_downstream_cx_length_ms_bucket|envoy_listener_downstream_cx_overflow|envoy_listener_downstream_cx_total|envoy_tcp_downstream_cx_no_route|envoy_tcp_downstream_cx_rx_bytes_total|envoy_tcp_downstream_cx_total|envoy_tcp_downstream_cx_tx_bytes_total)$`,
+						Regex:        `^(envoy_cluster_bind_errors|envoy_cluster_lb_healthy_panic|envoy_cluster_update_attempt|envoy_cluster_update_failure|envoy_cluster_upstream_cx_active|envoy_cluster_upstream_cx_connect_fail|envoy_cluster_upstream_cx_connect_ms_bucket|envoy_cluster_upstream_cx_connect_timeout|envoy_cluster_upstream_cx_length_ms_bucket|envoy_cluster_upstream_cx_none_healthy|envoy_cluster_upstream_cx_rx_bytes_total|envoy_cluster_upstream_cx_tx_bytes_total|envoy_listener_downstream_cx_active|envoy_listener_downstream_cx_destroy|envoy_listener_downstream_cx_length_ms_bucket|envoy_listener_downstream_cx_overflow|envoy_listener_downstream_cx_total|envoy_listener_ssl_connection_error|envoy_listener_ssl_fail_verify_san|envoy_tcp_downstream_cx_no_route|envoy_tcp_downstream_cx_rx_bytes_total|envoy_tcp_downstream_cx_total|envoy_tcp_downstream_cx_tx_bytes_total)$`,
 					},
 					{
 						SourceLabels: []monitoringv1.LabelName{"envoy_cluster_name"},
@@ -272,7 +272,7 @@ var _ = Describe("APIServerProxy", func() {
 
 		Context("IPv4", func() {
 			It("should deploy the managed resource successfully", func() {
-				testFunc("cb9301ef")
+				testFunc("e6b2dfa6")
 			})
 		})
 
@@ -283,7 +283,7 @@ var _ = Describe("APIServerProxy", func() {
 			})
 
 			It("should deploy the managed resource successfully", func() {
-				testFunc("5a33f6dd")
+				testFunc("cbfffc14")
 			})
 		})
 
@@ -294,7 +294,7 @@ var _ = Describe("APIServerProxy", func() {
 			})
 
 			It("should deploy the managed resource successfully", func() {
-				testFunc("9e555b69")
+				testFunc("c234915c")
 			})
 		})
 	})
@@ -540,6 +540,13 @@ static_resources:
                   path: /ready
                 route:
                   cluster: uds_admin
+              # Debug endpoint for introspecting the current connection state of the proxy, e.g. active connections
+              # to the kube-apiserver. It forwards to the admin interface's /clusters output.
+              - match:
+                  path: /debug/connections
+                route:
+                  cluster: uds_admin
+                  prefix_rewrite: /clusters
           http_filters:
           - name: envoy.filters.http.router
             typed_config:
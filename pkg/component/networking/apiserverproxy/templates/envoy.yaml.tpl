@@ -114,6 +114,13 @@ static_resources:
                   path: /ready
                 route:
                   cluster: uds_admin
+              # Debug endpoint for introspecting the current connection state of the proxy, e.g. active connections
+              # to the kube-apiserver. It forwards to the admin interface's /clusters output.
+              - match:
+                  path: /debug/connections
+                route:
+                  cluster: uds_admin
+                  prefix_rewrite: /clusters
           http_filters:
           - name: envoy.filters.http.router
             typed_config:
@@ -0,0 +1,305 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dnscache
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/utils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+)
+
+const (
+	// ManagedResourceName is the name of the ManagedResource containing the resource specifications.
+	ManagedResourceName = "dns-cache"
+	// ServiceName is the name of the Service exposing the caching DNS resolver.
+	ServiceName = name
+	// PortServiceServer is the service port used for the DNS server.
+	PortServiceServer = 53
+	// PortServer is the target port used for the DNS server.
+	PortServer = 8053
+
+	name        = "dns-cache"
+	portMetrics = 9153
+
+	configDataKey         = "Corefile"
+	volumeNameConfig      = "config-volume"
+	volumeMountPathConfig = "/etc/coredns"
+)
+
+// Values is a set of configuration values for the dns-cache component.
+type Values struct {
+	// Image is the container image used for the caching DNS resolver.
+	Image string
+}
+
+// New creates a new instance of DeployWaiter for the dns-cache component.
+func New(client client.Client, namespace string, values Values) component.DeployWaiter {
+	return &dnsCache{
+		client:    client,
+		namespace: namespace,
+		values:    values,
+	}
+}
+
+type dnsCache struct {
+	client    client.Client
+	namespace string
+	values    Values
+}
+
+func (d *dnsCache) Deploy(ctx context.Context) error {
+	data, err := d.computeResourcesData()
+	if err != nil {
+		return err
+	}
+
+	return managedresources.CreateForSeed(ctx, d.client, d.namespace, ManagedResourceName, false, data)
+}
+
+func (d *dnsCache) Destroy(ctx context.Context) error {
+	return managedresources.DeleteForSeed(ctx, d.client, d.namespace, ManagedResourceName)
+}
+
+// TimeoutWaitForManagedResource is the timeout used while waiting for the ManagedResources to become healthy
+// or deleted.
+var TimeoutWaitForManagedResource = 2 * time.Minute
+
+func (d *dnsCache) Wait(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilHealthy(timeoutCtx, d.client, d.namespace, ManagedResourceName)
+}
+
+func (d *dnsCache) WaitCleanup(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilDeleted(timeoutCtx, d.client, d.namespace, ManagedResourceName)
+}
+
+func (d *dnsCache) computeResourcesData() (map[string][]byte, error) {
+	var (
+		registry = managedresources.NewRegistry(kubernetes.SeedScheme, kubernetes.SeedCodec, kubernetes.SeedSerializer)
+
+		// We don't need to make this ConfigMap immutable since CoreDNS provides the "reload" plugin which does an
+		// auto-reload if the config changes.
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: d.namespace,
+				Labels:    getLabels(),
+			},
+			Data: map[string]string{
+				configDataKey: `.:` + strconv.Itoa(PortServer) + ` {
+  errors
+  log . {
+      class error
+  }
+  health {
+      lameduck 15s
+  }
+  ready
+  prometheus :` + strconv.Itoa(portMetrics) + `
+  cache 30 {
+      denial 9984 30
+  }
+  forward . /etc/resolv.conf
+  loop
+  reload
+  loadbalance round_robin
+}
+`,
+			},
+		}
+
+		service = &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ServiceName,
+				Namespace: d.namespace,
+				Labels:    getLabels(),
+			},
+			Spec: corev1.ServiceSpec{
+				Type:     corev1.ServiceTypeClusterIP,
+				Selector: getLabels(),
+				Ports: []corev1.ServicePort{
+					{
+						Name:       "dns",
+						Port:       PortServiceServer,
+						TargetPort: intstr.FromInt32(PortServer),
+						Protocol:   corev1.ProtocolUDP,
+					},
+					{
+						Name:       "dns-tcp",
+						Port:       PortServiceServer,
+						TargetPort: intstr.FromInt32(PortServer),
+						Protocol:   corev1.ProtocolTCP,
+					},
+					{
+						Name:       "metrics",
+						Port:       portMetrics,
+						TargetPort: intstr.FromInt32(portMetrics),
+					},
+				},
+			},
+		}
+
+		deployment = &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: d.namespace,
+				Labels: utils.MergeStringMaps(getLabels(), map[string]string{
+					resourcesv1alpha1.HighAvailabilityConfigType: resourcesv1alpha1.HighAvailabilityConfigTypeServer,
+				}),
+			},
+			Spec: appsv1.DeploymentSpec{
+				Replicas:             ptr.To[int32](2),
+				RevisionHistoryLimit: ptr.To[int32](2),
+				Selector:             &metav1.LabelSelector{MatchLabels: getLabels()},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: utils.MergeStringMaps(getLabels(), map[string]string{
+							v1beta1constants.LabelNetworkPolicyToDNS: v1beta1constants.LabelNetworkPolicyAllowed,
+						}),
+					},
+					Spec: corev1.PodSpec{
+						PriorityClassName: v1beta1constants.PriorityClassNameSeedSystem700,
+						SecurityContext: &corev1.PodSecurityContext{
+							RunAsNonRoot: ptr.To(true),
+							RunAsUser:    ptr.To[int64](65534),
+							SeccompProfile: &corev1.SeccompProfile{
+								Type: corev1.SeccompProfileTypeRuntimeDefault,
+							},
+						},
+						Containers: []corev1.Container{{
+							Name:            name,
+							Image:           d.values.Image,
+							ImagePullPolicy: corev1.PullIfNotPresent,
+							Args: []string{
+								"-conf",
+								volumeMountPathConfig + "/" + configDataKey,
+							},
+							Ports: []corev1.ContainerPort{
+								{
+									Name:          "dns-udp",
+									Protocol:      corev1.ProtocolUDP,
+									ContainerPort: PortServer,
+								},
+								{
+									Name:          "dns-tcp",
+									Protocol:      corev1.ProtocolTCP,
+									ContainerPort: PortServer,
+								},
+								{
+									Name:          "metrics",
+									Protocol:      corev1.ProtocolTCP,
+									ContainerPort: portMetrics,
+								},
+							},
+							LivenessProbe: &corev1.Probe{
+								ProbeHandler: corev1.ProbeHandler{
+									HTTPGet: &corev1.HTTPGetAction{
+										Path:   "/health",
+										Scheme: corev1.URISchemeHTTP,
+										Port:   intstr.FromInt32(8080),
+									},
+								},
+								SuccessThreshold:    1,
+								FailureThreshold:    5,
+								InitialDelaySeconds: 60,
+								TimeoutSeconds:      5,
+							},
+							ReadinessProbe: &corev1.Probe{
+								ProbeHandler: corev1.ProbeHandler{
+									HTTPGet: &corev1.HTTPGetAction{
+										Path:   "/ready",
+										Scheme: corev1.URISchemeHTTP,
+										Port:   intstr.FromInt32(8181),
+									},
+								},
+								SuccessThreshold:    1,
+								FailureThreshold:    1,
+								InitialDelaySeconds: 30,
+								TimeoutSeconds:      2,
+								PeriodSeconds:       10,
+							},
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceCPU:    resource.MustParse("20m"),
+									corev1.ResourceMemory: resource.MustParse("30Mi"),
+								},
+								Limits: corev1.ResourceList{
+									corev1.ResourceMemory: resource.MustParse("300Mi"),
+								},
+							},
+							SecurityContext: &corev1.SecurityContext{
+								AllowPrivilegeEscalation: ptr.To(false),
+								Capabilities: &corev1.Capabilities{
+									Drop: []corev1.Capability{"ALL"},
+								},
+								ReadOnlyRootFilesystem: ptr.To(true),
+							},
+							VolumeMounts: []corev1.VolumeMount{{
+								Name:      volumeNameConfig,
+								MountPath: volumeMountPathConfig,
+								ReadOnly:  true,
+							}},
+						}},
+						Volumes: []corev1.Volume{{
+							Name: volumeNameConfig,
+							VolumeSource: corev1.VolumeSource{
+								ConfigMap: &corev1.ConfigMapVolumeSource{
+									LocalObjectReference: corev1.LocalObjectReference{Name: configMap.Name},
+									Items: []corev1.KeyToPath{{
+										Key:  configDataKey,
+										Path: configDataKey,
+									}},
+								},
+							},
+						}},
+					},
+				},
+			},
+		}
+	)
+
+	// Allow shoot control plane components labeled accordingly to resolve DNS names via the cache.
+	utilruntime.Must(gardenerutils.InjectNetworkPolicyNamespaceSelectors(service, metav1.LabelSelector{MatchLabels: map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleShoot}}))
+	utilruntime.Must(gardenerutils.InjectNetworkPolicyAnnotationsForSeedScrapeTargets(service, networkingv1.NetworkPolicyPort{
+		Port:     ptr.To(intstr.FromInt32(portMetrics)),
+		Protocol: ptr.To(corev1.ProtocolTCP),
+	}))
+
+	return registry.AddAllAndSerialize(
+		configMap,
+		service,
+		deployment,
+	)
+}
+
+func getLabels() map[string]string {
+	return map[string]string{
+		v1beta1constants.LabelApp:  name,
+		v1beta1constants.LabelRole: "dns-cache",
+	}
+}
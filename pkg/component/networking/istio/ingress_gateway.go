@@ -32,16 +32,19 @@ var (
 // IngressGatewayValues holds values for the istio-ingress chart.
 // The only opened port is 15021.
 type IngressGatewayValues struct {
-	Annotations                        map[string]string
-	Labels                             map[string]string
-	NetworkPolicyLabels                map[string]string
-	LoadBalancerClass                  *string
-	ExternalTrafficPolicy              *corev1.ServiceExternalTrafficPolicy
-	Image                              string
-	IstiodNamespace                    string
-	LoadBalancerIP                     *string
-	MaxReplicas                        *int
-	MinReplicas                        *int
+	Annotations           map[string]string
+	Labels                map[string]string
+	NetworkPolicyLabels   map[string]string
+	LoadBalancerClass     *string
+	ExternalTrafficPolicy *corev1.ServiceExternalTrafficPolicy
+	Image                 string
+	IstiodNamespace       string
+	LoadBalancerIP        *string
+	MaxReplicas           *int
+	MinReplicas           *int
+	// Requests overrides the default compute resource requests of the istio-proxy container. Only cpu and memory
+	// requests are evaluated.
+	Requests                           corev1.ResourceList
 	Namespace                          string
 	PriorityClassName                  string
 	TrustDomain                        string
@@ -82,6 +85,13 @@ func (i *istiod) generateIstioIngressGatewayChart(ctx context.Context) (*chartre
 		if enableAPIServerTLSTermination {
 			cpuRequests = "450m"
 		}
+		memoryRequests := "600Mi"
+		if quantity, ok := istioIngressGateway.Requests[corev1.ResourceCPU]; ok {
+			cpuRequests = quantity.String()
+		}
+		if quantity, ok := istioIngressGateway.Requests[corev1.ResourceMemory]; ok {
+			memoryRequests = quantity.String()
+		}
 
 		vpnValues := map[string]any{
 			"enabled": istioIngressGateway.VPNEnabled,
@@ -124,6 +134,7 @@ func (i *istiod) generateIstioIngressGatewayChart(ctx context.Context) (*chartre
 			"apiServerRequestHeaderGroup":        kubeapiserverconstants.RequestHeaderGroup,
 			"apiServerAuthenticationDynamicMetadataKey": apiserverexposure.AuthenticationDynamicMetadataKey,
 			"cpuRequests":       cpuRequests,
+			"memoryRequests":    memoryRequests,
 			"kubernetesVersion": istioIngressGateway.KubernetesVersion,
 		}
 
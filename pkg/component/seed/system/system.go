@@ -12,6 +12,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	schedulingv1 "k8s.io/api/scheduling/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -65,7 +66,7 @@ type seedSystem struct {
 }
 
 func (s *seedSystem) Deploy(ctx context.Context) error {
-	data, err := s.computeResourcesData()
+	data, err := s.computeResourcesData(ctx)
 	if err != nil {
 		return err
 	}
@@ -95,11 +96,17 @@ func (s *seedSystem) WaitCleanup(ctx context.Context) error {
 	return managedresources.WaitUntilDeleted(timeoutCtx, s.client, s.namespace, ManagedResourceName)
 }
 
-func (s *seedSystem) computeResourcesData() (map[string][]byte, error) {
+func (s *seedSystem) computeResourcesData(ctx context.Context) (map[string][]byte, error) {
 	var registry = managedresources.NewRegistry(kubernetes.SeedScheme, kubernetes.SeedCodec, kubernetes.SeedSerializer)
 
 	if s.values.ReserveExcessCapacity.Enabled {
 		for i, config := range s.values.ReserveExcessCapacity.Configs {
+			resources, err := s.resolveReserveExcessCapacityResources(ctx, config)
+			if err != nil {
+				return nil, err
+			}
+			config.Resources = resources
+
 			name := fmt.Sprintf("reserve-excess-capacity-%d", i)
 			if err := s.addReserveExcessCapacityDeployment(registry, name, config); err != nil {
 				return nil, err
@@ -114,6 +121,36 @@ func (s *seedSystem) computeResourcesData() (map[string][]byte, error) {
 	return registry.SerializedObjects()
 }
 
+// resolveReserveExcessCapacityResources computes the resource requests and limits of a percentage-based excess
+// capacity reservation config from the sum of the allocatable cpu and memory of the seed's nodes. The reservation is
+// split evenly across the replicas of the excess-capacity-reservation deployment.
+func (s *seedSystem) resolveReserveExcessCapacityResources(ctx context.Context, config gardencorev1beta1.SeedSettingExcessCapacityReservationConfig) (corev1.ResourceList, error) {
+	if config.Percentage == nil {
+		return config.Resources, nil
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := s.client.List(ctx, nodeList); err != nil {
+		return nil, fmt.Errorf("unable to list nodes for computing the excess capacity reservation: %w", err)
+	}
+
+	var totalCPU, totalMemory int64
+	for _, node := range nodeList.Items {
+		totalCPU += node.Status.Allocatable.Cpu().MilliValue()
+		totalMemory += node.Status.Allocatable.Memory().Value()
+	}
+
+	replicas := int64(s.values.ReserveExcessCapacity.Replicas)
+	if replicas < 1 {
+		replicas = 1
+	}
+
+	return corev1.ResourceList{
+		corev1.ResourceCPU:    *resource.NewMilliQuantity(totalCPU*int64(*config.Percentage)/100/replicas, resource.DecimalSI),
+		corev1.ResourceMemory: *resource.NewQuantity(totalMemory*int64(*config.Percentage)/100/replicas, resource.BinarySI),
+	}, nil
+}
+
 func (s *seedSystem) addReserveExcessCapacityDeployment(registry *managedresources.Registry, name string, config gardencorev1beta1.SeedSettingExcessCapacityReservationConfig) error {
 	return registry.Add(
 		&appsv1.Deployment{
@@ -133,6 +133,47 @@ spec:
         operator: Equal
         value: foo
 status: {}
+`
+		deploymentPercentageYAML = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  annotations:
+    resources.gardener.cloud/skip-health-check: "true"
+  labels:
+    app: kubernetes
+    role: reserve-excess-capacity
+  name: reserve-excess-capacity-0
+  namespace: ` + namespace + `
+spec:
+  replicas: 2
+  revisionHistoryLimit: 2
+  selector:
+    matchLabels:
+      app: kubernetes
+      role: reserve-excess-capacity
+  strategy: {}
+  template:
+    metadata:
+      labels:
+        app: kubernetes
+        role: reserve-excess-capacity
+    spec:
+      containers:
+      - image: ` + reserveExcessCapacityImage + `
+        imagePullPolicy: IfNotPresent
+        name: pause-container
+        resources:
+          limits:
+            cpu: "1"
+            memory: 2Gi
+          requests:
+            cpu: "1"
+            memory: 2Gi
+        securityContext:
+          allowPrivilegeEscalation: false
+      priorityClassName: gardener-reserve-excess-capacity
+      terminationGracePeriodSeconds: 5
+status: {}
 `
 	)
 
@@ -232,6 +273,28 @@ status: {}
 			})
 		})
 
+		Context("in case of a percentage-based reserve-excess-capacity config", func() {
+			BeforeEach(func() {
+				for i, allocatableCPU := range []string{"6", "2"} {
+					Expect(c.Create(ctx, &corev1.Node{
+						ObjectMeta: metav1.ObjectMeta{Name: "node-" + strconv.Itoa(i)},
+						Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse(allocatableCPU),
+							corev1.ResourceMemory: resource.MustParse("8Gi"),
+						}},
+					})).To(Succeed())
+				}
+
+				values.ReserveExcessCapacity.Configs = []gardencorev1beta1.SeedSettingExcessCapacityReservationConfig{{Percentage: ptr.To[int32](25)}}
+				component = New(c, namespace, values)
+			})
+
+			It("should successfully deploy the resources", func() {
+				expectedManifets := append(expectedPriorityClasses(), deploymentPercentageYAML)
+				Expect(manifests).To(ConsistOf(expectedManifets))
+			})
+		})
+
 		Context("in case reserve-excess-capacity is disabled", func() {
 			BeforeEach(func() {
 				values.ReserveExcessCapacity.Enabled = false
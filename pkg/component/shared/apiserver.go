@@ -76,7 +76,15 @@ func computeAPIServerAuthenticationConfig(
 	*string,
 	error,
 ) {
-	if structuredAuthentication == nil || len(structuredAuthentication.ConfigMapName) == 0 {
+	if structuredAuthentication == nil {
+		return nil, nil
+	}
+
+	if len(structuredAuthentication.JWTAuthenticators) > 0 {
+		return renderAuthenticationConfiguration(structuredAuthentication.JWTAuthenticators)
+	}
+
+	if len(structuredAuthentication.ConfigMapName) == 0 {
 		return nil, nil
 	}
 
@@ -100,6 +108,77 @@ func computeAPIServerAuthenticationConfig(
 	return out, nil
 }
 
+// renderAuthenticationConfiguration renders the given JWT authenticators into an AuthenticationConfiguration for the
+// kube-apiserver.
+func renderAuthenticationConfiguration(jwtAuthenticators []gardencorev1beta1.JWTAuthenticator) (*string, error) {
+	config := &apiserverv1beta1.AuthenticationConfiguration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: apiserverv1beta1.ConfigSchemeGroupVersion.String(),
+			Kind:       "AuthenticationConfiguration",
+		},
+	}
+
+	for _, authenticator := range jwtAuthenticators {
+		jwt := apiserverv1beta1.JWTAuthenticator{
+			Issuer: apiserverv1beta1.Issuer{
+				URL:       authenticator.Issuer.URL,
+				Audiences: authenticator.Issuer.Audiences,
+			},
+		}
+
+		if v := authenticator.Issuer.CertificateAuthority; v != nil {
+			jwt.Issuer.CertificateAuthority = *v
+		}
+
+		if claimMappings := authenticator.ClaimMappings; claimMappings != nil && claimMappings.Username != nil {
+			jwt.ClaimMappings.Username = convertPrefixedClaimOrExpression(claimMappings.Username)
+		} else {
+			// The kube-apiserver requires a username mapping, so fall back to the 'sub' claim prefixed with the issuer
+			// URL to prevent clashes with other authentication methods.
+			jwt.ClaimMappings.Username = apiserverv1beta1.PrefixedClaimOrExpression{
+				Claim:  "sub",
+				Prefix: ptr.To(fmt.Sprintf("%s#", authenticator.Issuer.URL)),
+			}
+		}
+
+		if claimMappings := authenticator.ClaimMappings; claimMappings != nil && claimMappings.Groups != nil {
+			jwt.ClaimMappings.Groups = convertPrefixedClaimOrExpression(claimMappings.Groups)
+		}
+
+		for _, rule := range authenticator.ClaimValidationRules {
+			jwt.ClaimValidationRules = append(jwt.ClaimValidationRules, apiserverv1beta1.ClaimValidationRule{
+				Claim:         ptr.Deref(rule.Claim, ""),
+				RequiredValue: ptr.Deref(rule.RequiredValue, ""),
+				Expression:    ptr.Deref(rule.Expression, ""),
+				Message:       ptr.Deref(rule.Message, ""),
+			})
+		}
+
+		config.JWT = append(config.JWT, jwt)
+	}
+
+	data, err := runtime.Encode(kubeapiserver.ConfigCodec, config)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode authentication configuration: %w", err)
+	}
+
+	return ptr.To(string(data)), nil
+}
+
+func convertPrefixedClaimOrExpression(mapping *gardencorev1beta1.AuthenticationClaimOrExpression) apiserverv1beta1.PrefixedClaimOrExpression {
+	out := apiserverv1beta1.PrefixedClaimOrExpression{
+		Claim:      ptr.Deref(mapping.Claim, ""),
+		Expression: ptr.Deref(mapping.Expression, ""),
+	}
+
+	// The kube-apiserver requires an explicit prefix when mapping a claim.
+	if mapping.Claim != nil {
+		out.Prefix = ptr.To(ptr.Deref(mapping.Prefix, ""))
+	}
+
+	return out
+}
+
 func computeAPIServerAuthorizationConfig(
 	ctx context.Context,
 	cl client.Client,
@@ -247,6 +326,7 @@ func computeAPIServerETCDEncryptionConfig(
 	etcdEncryptionKeyRotationPhase gardencorev1beta1.CredentialsRotationPhase,
 	resourcesToEncrypt []string,
 	encryptedResources []string,
+	kmsEncryptionConfig *apiserver.KMSEncryptionConfig,
 ) (
 	apiserver.ETCDEncryptionConfig,
 	error,
@@ -256,6 +336,7 @@ func computeAPIServerETCDEncryptionConfig(
 		EncryptWithCurrentKey: true,
 		ResourcesToEncrypt:    resourcesToEncrypt,
 		EncryptedResources:    encryptedResources,
+		KMS:                   kmsEncryptionConfig,
 	}
 
 	if etcdEncryptionKeyRotationPhase == gardencorev1beta1.RotationPreparing {
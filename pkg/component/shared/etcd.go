@@ -38,3 +38,54 @@ func SnapshotEtcd(ctx context.Context, secretsManager secretsmanager.Interface,
 
 	return etcdMain.Snapshot(ctx, httpClient)
 }
+
+// EtcdDatabaseMaintenance checks the database size of ETCD main against its configured backend quota. If the size
+// exceeds the given fraction of the quota or a NOSPACE alarm has been raised, it compacts and defragments the
+// database and disarms the alarms afterwards. It returns the database state after a potential maintenance.
+func EtcdDatabaseMaintenance(ctx context.Context, secretsManager secretsmanager.Interface, etcdMain etcd.Interface, thresholdFraction float64) (*etcd.DatabaseState, error) {
+	tlsConfig, err := etcdClientTLSConfig(secretsManager)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := etcdMain.GetDatabaseState(ctx, tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if !state.ExceedsThreshold(thresholdFraction) {
+		return state, nil
+	}
+
+	if err := etcdMain.PerformDatabaseMaintenance(ctx, tlsConfig); err != nil {
+		return nil, err
+	}
+
+	return etcdMain.GetDatabaseState(ctx, tlsConfig)
+}
+
+func etcdClientTLSConfig(secretsManager secretsmanager.Interface) (*tls.Config, error) {
+	etcdCASecret, found := secretsManager.Get(v1beta1constants.SecretNameCAETCD)
+	if !found {
+		return nil, fmt.Errorf("secret %q not found", v1beta1constants.SecretNameCAETCD)
+	}
+
+	caCerts := x509.NewCertPool()
+	caCerts.AppendCertsFromPEM(etcdCASecret.Data[secretsutils.DataKeyCertificateBundle])
+
+	clientSecret, found := secretsManager.Get(etcd.SecretNameClient)
+	if !found {
+		return nil, fmt.Errorf("secret %q not found", etcd.SecretNameClient)
+	}
+
+	clientCert, err := tls.X509KeyPair(clientSecret.Data[secretsutils.DataKeyCertificate], clientSecret.Data[secretsutils.DataKeyPrivateKey])
+	if err != nil {
+		return nil, fmt.Errorf("failed loading the etcd client certificate: %w", err)
+	}
+
+	return &tls.Config{
+		RootCAs:      caCerts,
+		Certificates: []tls.Certificate{clientCert},
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
@@ -27,6 +27,7 @@ func NewExtension(
 	namespace string,
 	class extensionsv1alpha1.ExtensionClass,
 	extensions []gardencorev1beta1.Extension,
+	addons []gardencorev1beta1.ManagedAddon,
 	workerlessSupported bool,
 ) (extension.Interface, error) {
 	controllerRegistrations := &gardencorev1beta1.ControllerRegistrationList{}
@@ -46,7 +47,7 @@ func NewExtension(
 		&extension.Values{
 			Class:      extensionClass,
 			Namespace:  namespace,
-			Extensions: mergeExtensions(controllerRegistrations.Items, extensions, namespace, class, workerlessSupported),
+			Extensions: mergeExtensions(controllerRegistrations.Items, extensions, addons, namespace, class, workerlessSupported),
 		},
 		extension.DefaultInterval,
 		extension.DefaultSevereThreshold,
@@ -54,10 +55,11 @@ func NewExtension(
 	), nil
 }
 
-func mergeExtensions(registrations []gardencorev1beta1.ControllerRegistration, extensions []gardencorev1beta1.Extension, namespace string, class extensionsv1alpha1.ExtensionClass, workerlessShoot bool) map[string]extension.Extension {
+func mergeExtensions(registrations []gardencorev1beta1.ControllerRegistration, extensions []gardencorev1beta1.Extension, addons []gardencorev1beta1.ManagedAddon, namespace string, class extensionsv1alpha1.ExtensionClass, workerlessShoot bool) map[string]extension.Extension {
 	var (
 		typeToExtension    = make(map[string]extension.Extension)
 		requiredExtensions = make(map[string]extension.Extension)
+		addonNameToType    = make(map[string]string)
 	)
 
 	// Extensions enabled by default for all Shoot clusters.
@@ -88,6 +90,10 @@ func mergeExtensions(registrations []gardencorev1beta1.ControllerRegistration, e
 				Lifecycle: res.Lifecycle,
 			}
 
+			if res.Addon != nil {
+				addonNameToType[res.Addon.Name] = res.Type
+			}
+
 			switch class {
 			case extensionsv1alpha1.ExtensionClassShoot:
 				if !slices.Contains(res.AutoEnable, gardencorev1beta1.ClusterTypeShoot) {
@@ -119,5 +125,17 @@ func mergeExtensions(registrations []gardencorev1beta1.ControllerRegistration, e
 		}
 	}
 
+	// Extensions backing addons which are enabled in the Shoot spec.
+	for _, addon := range addons {
+		extensionType, ok := addonNameToType[addon.Name]
+		if !ok {
+			continue
+		}
+
+		obj := typeToExtension[extensionType]
+		obj.Spec.ProviderConfig = addon.Config
+		requiredExtensions[extensionType] = obj
+	}
+
 	return requiredExtensions
 }
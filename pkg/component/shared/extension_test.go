@@ -135,14 +135,14 @@ var _ = Describe("Extension", func() {
 			ctrl.Finish()
 		})
 
-		test := func(registrations []gardencorev1beta1.ControllerRegistration, extensions []gardencorev1beta1.Extension, class extensionsv1alpha1.ExtensionClass, workerless bool, conditionMatcher gomegatypes.GomegaMatcher) {
+		testWithAddons := func(registrations []gardencorev1beta1.ControllerRegistration, extensions []gardencorev1beta1.Extension, addons []gardencorev1beta1.ManagedAddon, class extensionsv1alpha1.ExtensionClass, workerless bool, conditionMatcher gomegatypes.GomegaMatcher) {
 			GinkgoHelper()
 
 			for _, registration := range registrations {
 				Expect(gardenClient.Create(ctx, &registration)).To(Succeed())
 			}
 
-			ext, err := NewExtension(ctx, log, gardenClient, seedClient, "test", class, extensions, workerless)
+			ext, err := NewExtension(ctx, log, gardenClient, seedClient, "test", class, extensions, addons, workerless)
 			Expect(err).NotTo(HaveOccurred())
 
 			extensionObjs := ext.Extensions()
@@ -153,6 +153,12 @@ var _ = Describe("Extension", func() {
 			Expect(extensionObjs).To(conditionMatcher)
 		}
 
+		test := func(registrations []gardencorev1beta1.ControllerRegistration, extensions []gardencorev1beta1.Extension, class extensionsv1alpha1.ExtensionClass, workerless bool, conditionMatcher gomegatypes.GomegaMatcher) {
+			GinkgoHelper()
+
+			testWithAddons(registrations, extensions, nil, class, workerless, conditionMatcher)
+		}
+
 		It("should return no extensions when no extensions are configured and registered", func() {
 			test(nil, nil, extensionsv1alpha1.ExtensionClassShoot, false, BeEmpty())
 		})
@@ -484,6 +490,51 @@ var _ = Describe("Extension", func() {
 			})
 		})
 
+		Context("Addons", func() {
+			BeforeEach(func() {
+				fooRegistration.Spec.Resources[0].Addon = &gardencorev1beta1.ControllerResourceAddon{Name: "foo-addon"}
+			})
+
+			It("should return the extension backing an enabled addon", func() {
+				testWithAddons(
+					[]gardencorev1beta1.ControllerRegistration{fooRegistration},
+					nil,
+					[]gardencorev1beta1.ManagedAddon{{Name: "foo-addon", Config: &providerConfig}},
+					extensionsv1alpha1.ExtensionClassShoot,
+					false,
+					HaveKeyWithValue(
+						Equal(fooExtensionType),
+						MatchAllFields(
+							Fields{
+								"Extension": MatchFields(IgnoreExtras, Fields{
+									"Spec": MatchAllFields(Fields{
+										"DefaultSpec": MatchAllFields(Fields{
+											"Type":           Equal(fooExtensionType),
+											"ProviderConfig": PointTo(Equal(providerConfig)),
+											"Class":          BeNil(),
+										}),
+									}),
+								}),
+								"Timeout":   Equal(fooReconciliationTimeout.Duration),
+								"Lifecycle": Equal(lifecycle),
+							},
+						),
+					),
+				)
+			})
+
+			It("should return no extension when the addon is not registered", func() {
+				testWithAddons(
+					[]gardencorev1beta1.ControllerRegistration{fooRegistration},
+					nil,
+					[]gardencorev1beta1.ManagedAddon{{Name: "unknown-addon"}},
+					extensionsv1alpha1.ExtensionClassShoot,
+					false,
+					BeEmpty(),
+				)
+			})
+		})
+
 		Context("Workerless", func() {
 			It("should return automatically enabled for workerless", func() {
 				test(
@@ -66,6 +66,14 @@ func NewGardenerAPIServer(
 			return nil, err
 		}
 
+		if auditConfig == nil && ptr.Deref(apiServerConfig.DefaultAuditPolicy, false) {
+			policy, err := gardenerapiserver.DefaultAuditPolicy()
+			if err != nil {
+				return nil, err
+			}
+			auditConfig = &apiserver.AuditConfig{Policy: &policy, Webhook: auditWebhookConfig}
+		}
+
 		enabledAdmissionPlugins = computeEnabledAPIServerAdmissionPlugins(enabledAdmissionPlugins, apiServerConfig.AdmissionPlugins)
 		disabledAdmissionPlugins = computeDisabledAPIServerAdmissionPlugins(apiServerConfig.AdmissionPlugins)
 		featureGates = apiServerConfig.FeatureGates
@@ -132,6 +140,7 @@ func DeployGardenerAPIServer(
 		etcdEncryptionKeyRotationPhase,
 		append(resourcesToEncrypt, sets.List(gardenerutils.DefaultGardenerResourcesForEncryption())...),
 		append(encryptedResources, sets.List(gardenerutils.DefaultGardenerResourcesForEncryption())...),
+		nil,
 	)
 	if err != nil {
 		return err
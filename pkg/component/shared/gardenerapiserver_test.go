@@ -176,10 +176,15 @@ var _ = Describe("GardenerAPIServer", func() {
 		Describe("AuditConfig", func() {
 			var (
 				policy               = "some-policy"
+				defaultPolicy        string
 				auditPolicyConfigMap *corev1.ConfigMap
 			)
 
 			BeforeEach(func() {
+				var err error
+				defaultPolicy, err = gardenerapiserver.DefaultAuditPolicy()
+				Expect(err).NotTo(HaveOccurred())
+
 				auditPolicyConfigMap = &corev1.ConfigMap{
 					ObjectMeta: metav1.ObjectMeta{
 						Name:      "my-audit-policy",
@@ -323,6 +328,39 @@ var _ = Describe("GardenerAPIServer", func() {
 					},
 					Not(HaveOccurred()),
 				),
+				Entry("DefaultAuditPolicy is enabled and no custom policy is referenced",
+					func() {
+						apiServerConfig = &operatorv1alpha1.GardenerAPIServerConfig{
+							DefaultAuditPolicy: ptr.To(true),
+						}
+						auditWebhookConfig = &apiserver.AuditWebhook{Version: ptr.To("audit-version")}
+					},
+					&apiserver.AuditConfig{
+						Policy:  &defaultPolicy,
+						Webhook: &apiserver.AuditWebhook{Version: ptr.To("audit-version")},
+					},
+					Not(HaveOccurred()),
+				),
+				Entry("DefaultAuditPolicy is enabled but a custom policy is referenced",
+					func() {
+						Expect(runtimeClient.Create(ctx, auditPolicyConfigMap)).To(Succeed())
+
+						apiServerConfig = &operatorv1alpha1.GardenerAPIServerConfig{
+							AuditConfig: &gardencorev1beta1.AuditConfig{
+								AuditPolicy: &gardencorev1beta1.AuditPolicy{
+									ConfigMapRef: &corev1.ObjectReference{
+										Name: auditPolicyConfigMap.Name,
+									},
+								},
+							},
+							DefaultAuditPolicy: ptr.To(true),
+						}
+					},
+					&apiserver.AuditConfig{
+						Policy: &policy,
+					},
+					Not(HaveOccurred()),
+				),
 			)
 		})
 
@@ -53,6 +53,7 @@ func NewIstio(
 	vpnEnabled bool,
 	zones []string,
 	dualStack bool,
+	autoscaling *gardencorev1beta1.SeedSettingIstioIngressGatewayAutoscaling,
 	kubernetesVersion *semver.Version,
 ) (
 	istio.Interface,
@@ -94,6 +95,17 @@ func NewIstio(
 	// istio ingress gateway needs to be able to directly forward traffic to the runtime API server.
 	policyLabels[v1beta1constants.LabelNetworkPolicyToRuntimeAPIServer] = v1beta1constants.LabelNetworkPolicyAllowed
 
+	var requests corev1.ResourceList
+	if autoscaling != nil {
+		if autoscaling.MinReplicas != nil {
+			minReplicas = ptr.To(int(*autoscaling.MinReplicas))
+		}
+		if autoscaling.MaxReplicas != nil {
+			maxReplicas = ptr.To(int(*autoscaling.MaxReplicas))
+		}
+		requests = autoscaling.Requests
+	}
+
 	enforceSpreadAcrossHosts, err := ShouldEnforceSpreadAcrossHosts(ctx, cl, zones)
 	if err != nil {
 		return nil, err
@@ -108,6 +120,7 @@ func NewIstio(
 		ExternalTrafficPolicy:              externalTrafficPolicy,
 		MinReplicas:                        minReplicas,
 		MaxReplicas:                        maxReplicas,
+		Requests:                           requests,
 		Ports:                              servicePorts,
 		LoadBalancerIP:                     serviceExternalIP,
 		Labels:                             labels,
@@ -158,6 +171,7 @@ func AddIstioIngressGateway(
 	zone *string,
 	dualStack bool,
 	terminateLoadBalancerProxyProtocol *bool,
+	autoscaling *gardencorev1beta1.SeedSettingIstioIngressGatewayAutoscaling,
 	kubernetesVersion *semver.Version,
 ) error {
 	gatewayValues := istioDeployer.GetValues().IngressGateway
@@ -172,6 +186,7 @@ func AddIstioIngressGateway(
 		zones                    []string
 		minReplicas              *int
 		maxReplicas              *int
+		requests                 corev1.ResourceList
 		enforceSpreadAcrossHosts bool
 		err                      error
 	)
@@ -179,6 +194,7 @@ func AddIstioIngressGateway(
 	if zone == nil {
 		minReplicas = templateValues.MinReplicas
 		maxReplicas = templateValues.MaxReplicas
+		requests = templateValues.Requests
 		enforceSpreadAcrossHosts = templateValues.EnforceSpreadAcrossHosts
 	} else {
 		zones = []string{*zone}
@@ -194,6 +210,18 @@ func AddIstioIngressGateway(
 		}
 	}
 
+	if autoscaling != nil {
+		if autoscaling.MinReplicas != nil {
+			minReplicas = ptr.To(int(*autoscaling.MinReplicas))
+		}
+		if autoscaling.MaxReplicas != nil {
+			maxReplicas = ptr.To(int(*autoscaling.MaxReplicas))
+		}
+		if autoscaling.Requests != nil {
+			requests = autoscaling.Requests
+		}
+	}
+
 	istioDeployer.AddIngressGateway(istio.IngressGatewayValues{
 		Annotations:                        utils.MergeStringMaps(annotations),
 		Labels:                             utils.MergeStringMaps(labels),
@@ -201,6 +229,7 @@ func AddIstioIngressGateway(
 		Namespace:                          namespace,
 		MinReplicas:                        minReplicas,
 		MaxReplicas:                        maxReplicas,
+		Requests:                           requests,
 		LoadBalancerClass:                  loadBalancerClass,
 		ExternalTrafficPolicy:              externalTrafficPolicy,
 		LoadBalancerIP:                     serviceExternalIP,
@@ -81,6 +81,7 @@ func createIstio(testValues istioTestValues) istio.Interface {
 		testValues.vpnEnabled,
 		testValues.zones,
 		testValues.dualStack,
+		nil,
 		testValues.kubernetesVersion,
 	)
 
@@ -375,6 +376,7 @@ var _ = Describe("Istio", func() {
 				zone,
 				false,
 				&proxyProtocolLB,
+				nil,
 				semver.MustParse("1.31.0"))).To(MatchError("at least one ingress gateway must be present before adding further ones"))
 		})
 
@@ -397,6 +399,7 @@ var _ = Describe("Istio", func() {
 					zone,
 					false,
 					&proxyProtocolLB,
+					nil,
 					semver.MustParse("1.31.0"))).To(Succeed())
 
 				checkAdditionalIstioGateway(
@@ -433,6 +436,7 @@ var _ = Describe("Istio", func() {
 					zone,
 					false,
 					&proxyProtocolLB,
+					nil,
 					semver.MustParse("1.31.0"))).To(Succeed())
 
 				checkAdditionalIstioGateway(
@@ -472,6 +476,7 @@ var _ = Describe("Istio", func() {
 						zone,
 						false,
 						&proxyProtocolLB,
+						nil,
 						semver.MustParse("1.31.0"))).To(Succeed())
 
 					checkAdditionalIstioGateway(
@@ -509,6 +514,7 @@ var _ = Describe("Istio", func() {
 					zone,
 					true,
 					&proxyProtocolLB,
+					nil,
 					semver.MustParse("1.31.0"))).To(Succeed())
 
 				checkAdditionalIstioGateway(
@@ -234,6 +234,7 @@ func DeployKubeAPIServer(
 	seedPodNetwork *net.IPNet,
 	resourcesToEncrypt []string,
 	encryptedResources []string,
+	kmsEncryptionConfig *gardencorev1beta1.KMSEncryptionConfiguration,
 	etcdEncryptionKeyRotationPhase gardencorev1beta1.CredentialsRotationPhase,
 	wantScaleDown bool,
 ) error {
@@ -276,6 +277,15 @@ func DeployKubeAPIServer(
 	kubeAPIServer.SetPodNetworkCIDRs(podNetworkCIDRs)
 	kubeAPIServer.SetSeedPodNetwork(seedPodNetwork)
 
+	var kmsConfig *apiserver.KMSEncryptionConfig
+	if kmsEncryptionConfig != nil {
+		kmsConfig = &apiserver.KMSEncryptionConfig{
+			Name:     kmsEncryptionConfig.Name,
+			Endpoint: kmsEncryptionConfig.Endpoint,
+			Timeout:  kmsEncryptionConfig.Timeout,
+		}
+	}
+
 	etcdEncryptionConfig, err := computeAPIServerETCDEncryptionConfig(
 		ctx,
 		runtimeClient,
@@ -284,6 +294,7 @@ func DeployKubeAPIServer(
 		etcdEncryptionKeyRotationPhase,
 		append(resourcesToEncrypt, sets.List(gardenerutils.DefaultResourcesForEncryption())...),
 		append(encryptedResources, sets.List(gardenerutils.DefaultResourcesForEncryption())...),
+		kmsConfig,
 	)
 	if err != nil {
 		return err
@@ -94,6 +94,18 @@ func (mr *MockInterfaceMockRecorder) SetEgressCIDRs(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEgressCIDRs", reflect.TypeOf((*MockInterface)(nil).SetEgressCIDRs), arg0)
 }
 
+// SetEgressEndpoints mocks base method.
+func (m *MockInterface) SetEgressEndpoints(arg0 map[string][]string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetEgressEndpoints", arg0)
+}
+
+// SetEgressEndpoints indicates an expected call of SetEgressEndpoints.
+func (mr *MockInterfaceMockRecorder) SetEgressEndpoints(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEgressEndpoints", reflect.TypeOf((*MockInterface)(nil).SetEgressEndpoints), arg0)
+}
+
 // SetNodeNetworkCIDRs mocks base method.
 func (m *MockInterface) SetNodeNetworkCIDRs(arg0 []net.IPNet) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,193 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package system
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubeapiserverconstants "github.com/gardener/gardener/pkg/component/kubernetes/apiserver/constants"
+	corednsconstants "github.com/gardener/gardener/pkg/component/networking/coredns/constants"
+	nodelocaldnsconstants "github.com/gardener/gardener/pkg/component/networking/nodelocaldns/constants"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	versionutils "github.com/gardener/gardener/pkg/utils/version"
+)
+
+// networkPolicyRule is the declarative model from which the NetworkPolicy resources for the shoot's kube-system
+// namespace are generated. Components only declare the traffic they require, and the manifests are computed centrally
+// (similar to the NetworkPolicy controller for the seed), so that newly added components automatically get
+// least-privilege policies.
+type networkPolicyRule struct {
+	// name is the name of the generated NetworkPolicy.
+	name string
+	// description explains the purpose of the policy and is added as annotation to the generated NetworkPolicy.
+	description string
+	// podSelector selects the pods the policy applies to. An empty selector matches all pods in the namespace.
+	podSelector metav1.LabelSelector
+	// ingress is the list of allowed ingress rules. If it is non-nil (also if empty), the generated NetworkPolicy
+	// restricts ingress traffic.
+	ingress []networkingv1.NetworkPolicyIngressRule
+	// egress is the list of allowed egress rules. If it is non-nil (also if empty), the generated NetworkPolicy
+	// restricts egress traffic.
+	egress []networkingv1.NetworkPolicyEgressRule
+}
+
+// networkPolicy generates the NetworkPolicy resource for the rule.
+func (r networkPolicyRule) networkPolicy() *networkingv1.NetworkPolicy {
+	var policyTypes []networkingv1.PolicyType
+	if r.ingress != nil {
+		policyTypes = append(policyTypes, networkingv1.PolicyTypeIngress)
+	}
+	if r.egress != nil {
+		policyTypes = append(policyTypes, networkingv1.PolicyTypeEgress)
+	}
+
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      r.name,
+			Namespace: metav1.NamespaceSystem,
+			Annotations: map[string]string{
+				v1beta1constants.GardenerDescription: r.description,
+			},
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: r.podSelector,
+			Ingress:     r.ingress,
+			Egress:      r.egress,
+			PolicyTypes: policyTypes,
+		},
+	}
+}
+
+// networkPolicyRules computes the policy model for the shoot's kube-system namespace based on the configured values.
+func (s *shootSystem) networkPolicyRules() []networkPolicyRule {
+	var rules []networkPolicyRule
+
+	if versionutils.ConstraintK8sGreaterEqual133.Check(s.values.KubernetesVersion) {
+		rules = append(rules, networkPolicyRule{
+			name:        "gardener.cloud--deny-all",
+			description: "Disables all ingress and egress traffic into/from this namespace.",
+			ingress:     []networkingv1.NetworkPolicyIngressRule{},
+			egress:      []networkingv1.NetworkPolicyEgressRule{},
+		})
+	}
+
+	if s.values.IsWorkerless {
+		return rules
+	}
+
+	var (
+		port53      = intstr.FromInt32(53)
+		port443     = intstr.FromInt32(kubeapiserverconstants.Port)
+		port8053    = intstr.FromInt32(corednsconstants.PortServer)
+		port10250   = intstr.FromInt32(10250)
+		protocolUDP = corev1.ProtocolUDP
+		protocolTCP = corev1.ProtocolTCP
+	)
+
+	return append(rules,
+		networkPolicyRule{
+			name: "gardener.cloud--allow-to-apiserver",
+			description: fmt.Sprintf("Allows traffic to the API server in TCP "+
+				"port 443 for pods labeled with '%s=%s'.", v1beta1constants.LabelNetworkPolicyShootToAPIServer,
+				v1beta1constants.LabelNetworkPolicyAllowed),
+			podSelector: metav1.LabelSelector{MatchLabels: map[string]string{v1beta1constants.LabelNetworkPolicyShootToAPIServer: v1beta1constants.LabelNetworkPolicyAllowed}},
+			egress:      []networkingv1.NetworkPolicyEgressRule{{Ports: []networkingv1.NetworkPolicyPort{{Port: &port443, Protocol: &protocolTCP}}}},
+		},
+		networkPolicyRule{
+			name: "gardener.cloud--allow-to-dns",
+			description: fmt.Sprintf("Allows egress traffic from pods labeled "+
+				"with '%s=%s' to DNS running in the '%s' namespace.", v1beta1constants.LabelNetworkPolicyToDNS,
+				v1beta1constants.LabelNetworkPolicyAllowed, metav1.NamespaceSystem),
+			podSelector: metav1.LabelSelector{MatchLabels: map[string]string{v1beta1constants.LabelNetworkPolicyToDNS: v1beta1constants.LabelNetworkPolicyAllowed}},
+			egress: []networkingv1.NetworkPolicyEgressRule{
+				{
+					To: []networkingv1.NetworkPolicyPeer{{
+						PodSelector: &metav1.LabelSelector{
+							MatchExpressions: []metav1.LabelSelectorRequirement{{
+								Key:      corednsconstants.LabelKey,
+								Operator: metav1.LabelSelectorOpIn,
+								Values:   []string{corednsconstants.LabelValue},
+							}},
+						},
+					}},
+					Ports: []networkingv1.NetworkPolicyPort{
+						{Protocol: &protocolUDP, Port: &port8053},
+						{Protocol: &protocolTCP, Port: &port8053},
+					},
+				},
+				// this allows Pods with 'dnsPolicy: Default' to talk to the node's DNS provider.
+				{
+					To: []networkingv1.NetworkPolicyPeer{
+						{
+							IPBlock: &networkingv1.IPBlock{CIDR: "0.0.0.0/0"},
+						},
+						{
+							IPBlock: &networkingv1.IPBlock{CIDR: "::/0"},
+						},
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchExpressions: []metav1.LabelSelectorRequirement{{
+									Key:      corednsconstants.LabelKey,
+									Operator: metav1.LabelSelectorOpIn,
+									Values:   []string{nodelocaldnsconstants.LabelValue},
+								}},
+							},
+						},
+					},
+					Ports: []networkingv1.NetworkPolicyPort{
+						{Protocol: &protocolUDP, Port: &port53},
+						{Protocol: &protocolTCP, Port: &port53},
+					},
+				},
+			},
+		},
+		networkPolicyRule{
+			name: "gardener.cloud--allow-to-kubelet",
+			description: fmt.Sprintf("Allows egress traffic to kubelet in TCP "+
+				"port 10250 for pods labeled with '%s=%s'.", v1beta1constants.LabelNetworkPolicyShootToKubelet,
+				v1beta1constants.LabelNetworkPolicyAllowed),
+			podSelector: metav1.LabelSelector{MatchLabels: map[string]string{v1beta1constants.LabelNetworkPolicyShootToKubelet: v1beta1constants.LabelNetworkPolicyAllowed}},
+			egress:      []networkingv1.NetworkPolicyEgressRule{{Ports: []networkingv1.NetworkPolicyPort{{Port: &port10250, Protocol: &protocolTCP}}}},
+		},
+		networkPolicyRule{
+			name: "gardener.cloud--allow-to-public-networks",
+			description: fmt.Sprintf("Allows egress traffic to all networks for "+
+				"pods labeled with '%s=%s'.", v1beta1constants.LabelNetworkPolicyToPublicNetworks,
+				v1beta1constants.LabelNetworkPolicyAllowed),
+			podSelector: metav1.LabelSelector{MatchLabels: map[string]string{v1beta1constants.LabelNetworkPolicyToPublicNetworks: v1beta1constants.LabelNetworkPolicyAllowed}},
+			egress: []networkingv1.NetworkPolicyEgressRule{{To: []networkingv1.NetworkPolicyPeer{
+				{IPBlock: &networkingv1.IPBlock{CIDR: "0.0.0.0/0"}},
+				{IPBlock: &networkingv1.IPBlock{CIDR: "::/0"}},
+			}}},
+		},
+	)
+}
+
+// RenderNetworkPolicies renders the NetworkPolicy manifests which would be generated from the policy model for the
+// given values without deploying anything. The manifests are rendered in a deterministic order so that the effective
+// policy changes can be reviewed, e.g. by diffing the output for two configurations or Gardener versions.
+func RenderNetworkPolicies(values Values) (string, error) {
+	s := &shootSystem{values: values}
+
+	var manifests []string
+	for _, rule := range s.networkPolicyRules() {
+		manifest, err := kubernetesutils.Serialize(rule.networkPolicy(), kubernetes.ShootScheme)
+		if err != nil {
+			return "", fmt.Errorf("failed serializing NetworkPolicy %q: %w", rule.name, err)
+		}
+
+		manifests = append(manifests, manifest)
+	}
+
+	return strings.Join(manifests, "---\n"), nil
+}
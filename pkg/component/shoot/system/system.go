@@ -14,11 +14,9 @@ import (
 
 	"github.com/Masterminds/semver/v3"
 	corev1 "k8s.io/api/core/v1"
-	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	schedulingv1 "k8s.io/api/scheduling/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -26,12 +24,8 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component"
-	kubeapiserverconstants "github.com/gardener/gardener/pkg/component/kubernetes/apiserver/constants"
-	corednsconstants "github.com/gardener/gardener/pkg/component/networking/coredns/constants"
-	nodelocaldnsconstants "github.com/gardener/gardener/pkg/component/networking/nodelocaldns/constants"
 	"github.com/gardener/gardener/pkg/utils/managedresources"
 	netutils "github.com/gardener/gardener/pkg/utils/net"
-	versionutils "github.com/gardener/gardener/pkg/utils/version"
 )
 
 // ManagedResourceName is the name of the ManagedResource containing the resource specifications.
@@ -45,6 +39,7 @@ type Interface interface {
 	SetServiceNetworkCIDRs([]net.IPNet)
 	SetNodeNetworkCIDRs([]net.IPNet)
 	SetEgressCIDRs([]net.IPNet)
+	SetEgressEndpoints(map[string][]string)
 }
 
 // Values is a set of configuration values for the system resources.
@@ -75,6 +70,10 @@ type Values struct {
 	NodeNetworkCIDRs []net.IPNet
 	// EgressCIDRs are the egress CIDRs of the cluster, actual presence of this field depends on the implementation of the provider extension.
 	EgressCIDRs []net.IPNet
+	// EgressEndpoints are the endpoints that shoot worker networks must be able to reach, grouped by category
+	// (e.g. "apiServer"). They are published in the kube-system namespace so that customers with restrictive egress
+	// firewalls can automate their rules.
+	EgressEndpoints map[string][]string
 }
 
 // New creates a new instance of DeployWaiter for shoot system resources.
@@ -129,6 +128,10 @@ func (s *shootSystem) SetEgressCIDRs(cidrs []net.IPNet) {
 	s.values.EgressCIDRs = cidrs
 }
 
+func (s *shootSystem) SetEgressEndpoints(endpoints map[string][]string) {
+	s.values.EgressEndpoints = endpoints
+}
+
 // TimeoutWaitForManagedResource is the timeout used while waiting for the ManagedResources to become healthy
 // or deleted.
 var TimeoutWaitForManagedResource = 2 * time.Minute
@@ -150,158 +153,13 @@ func (s *shootSystem) WaitCleanup(ctx context.Context) error {
 func (s *shootSystem) computeResourcesData() (map[string][]byte, error) {
 	registry := managedresources.NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer)
 
-	if versionutils.ConstraintK8sGreaterEqual133.Check(s.values.KubernetesVersion) {
-		networkPolicyDenyAll := &networkingv1.NetworkPolicy{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      "gardener.cloud--deny-all",
-				Namespace: metav1.NamespaceSystem,
-				Annotations: map[string]string{
-					v1beta1constants.GardenerDescription: "Disables all ingress and egress traffic into/from this namespace.",
-				},
-			},
-			Spec: networkingv1.NetworkPolicySpec{
-				PodSelector: metav1.LabelSelector{},
-				Egress:      []networkingv1.NetworkPolicyEgressRule{},
-				Ingress:     []networkingv1.NetworkPolicyIngressRule{},
-				PolicyTypes: []networkingv1.PolicyType{
-					networkingv1.PolicyTypeIngress,
-					networkingv1.PolicyTypeEgress,
-				},
-			},
-		}
-
-		if err := registry.Add(networkPolicyDenyAll); err != nil {
+	for _, rule := range s.networkPolicyRules() {
+		if err := registry.Add(rule.networkPolicy()); err != nil {
 			return nil, err
 		}
 	}
 
 	if !s.values.IsWorkerless {
-		var (
-			port53      = intstr.FromInt32(53)
-			port443     = intstr.FromInt32(kubeapiserverconstants.Port)
-			port8053    = intstr.FromInt32(corednsconstants.PortServer)
-			port10250   = intstr.FromInt32(10250)
-			protocolUDP = corev1.ProtocolUDP
-			protocolTCP = corev1.ProtocolTCP
-
-			networkPolicyAllowToShootAPIServer = &networkingv1.NetworkPolicy{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "gardener.cloud--allow-to-apiserver",
-					Namespace: metav1.NamespaceSystem,
-					Annotations: map[string]string{
-						v1beta1constants.GardenerDescription: fmt.Sprintf("Allows traffic to the API server in TCP "+
-							"port 443 for pods labeled with '%s=%s'.", v1beta1constants.LabelNetworkPolicyShootToAPIServer,
-							v1beta1constants.LabelNetworkPolicyAllowed),
-					},
-				},
-				Spec: networkingv1.NetworkPolicySpec{
-					PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{v1beta1constants.LabelNetworkPolicyShootToAPIServer: v1beta1constants.LabelNetworkPolicyAllowed}},
-					Egress:      []networkingv1.NetworkPolicyEgressRule{{Ports: []networkingv1.NetworkPolicyPort{{Port: &port443, Protocol: &protocolTCP}}}},
-					PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
-				},
-			}
-			networkPolicyAllowToDNS = &networkingv1.NetworkPolicy{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "gardener.cloud--allow-to-dns",
-					Namespace: metav1.NamespaceSystem,
-					Annotations: map[string]string{
-						v1beta1constants.GardenerDescription: fmt.Sprintf("Allows egress traffic from pods labeled "+
-							"with '%s=%s' to DNS running in the '%s' namespace.", v1beta1constants.LabelNetworkPolicyToDNS,
-							v1beta1constants.LabelNetworkPolicyAllowed, metav1.NamespaceSystem),
-					},
-				},
-				Spec: networkingv1.NetworkPolicySpec{
-					PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{v1beta1constants.LabelNetworkPolicyToDNS: v1beta1constants.LabelNetworkPolicyAllowed}},
-					PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
-					Egress: []networkingv1.NetworkPolicyEgressRule{
-						{
-							To: []networkingv1.NetworkPolicyPeer{{
-								PodSelector: &metav1.LabelSelector{
-									MatchExpressions: []metav1.LabelSelectorRequirement{{
-										Key:      corednsconstants.LabelKey,
-										Operator: metav1.LabelSelectorOpIn,
-										Values:   []string{corednsconstants.LabelValue},
-									}},
-								},
-							}},
-							Ports: []networkingv1.NetworkPolicyPort{
-								{Protocol: &protocolUDP, Port: &port8053},
-								{Protocol: &protocolTCP, Port: &port8053},
-							},
-						},
-						// this allows Pods with 'dnsPolicy: Default' to talk to the node's DNS provider.
-						{
-							To: []networkingv1.NetworkPolicyPeer{
-								{
-									IPBlock: &networkingv1.IPBlock{CIDR: "0.0.0.0/0"},
-								},
-								{
-									IPBlock: &networkingv1.IPBlock{CIDR: "::/0"},
-								},
-								{
-									PodSelector: &metav1.LabelSelector{
-										MatchExpressions: []metav1.LabelSelectorRequirement{{
-											Key:      corednsconstants.LabelKey,
-											Operator: metav1.LabelSelectorOpIn,
-											Values:   []string{nodelocaldnsconstants.LabelValue},
-										}},
-									},
-								},
-							},
-							Ports: []networkingv1.NetworkPolicyPort{
-								{Protocol: &protocolUDP, Port: &port53},
-								{Protocol: &protocolTCP, Port: &port53},
-							},
-						},
-					},
-				},
-			}
-			networkPolicyAllowToKubelet = &networkingv1.NetworkPolicy{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "gardener.cloud--allow-to-kubelet",
-					Namespace: metav1.NamespaceSystem,
-					Annotations: map[string]string{
-						v1beta1constants.GardenerDescription: fmt.Sprintf("Allows egress traffic to kubelet in TCP "+
-							"port 10250 for pods labeled with '%s=%s'.", v1beta1constants.LabelNetworkPolicyShootToKubelet,
-							v1beta1constants.LabelNetworkPolicyAllowed),
-					},
-				},
-				Spec: networkingv1.NetworkPolicySpec{
-					PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{v1beta1constants.LabelNetworkPolicyShootToKubelet: v1beta1constants.LabelNetworkPolicyAllowed}},
-					Egress:      []networkingv1.NetworkPolicyEgressRule{{Ports: []networkingv1.NetworkPolicyPort{{Port: &port10250, Protocol: &protocolTCP}}}},
-					PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
-				},
-			}
-			networkPolicyAllowToPublicNetworks = &networkingv1.NetworkPolicy{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "gardener.cloud--allow-to-public-networks",
-					Namespace: metav1.NamespaceSystem,
-					Annotations: map[string]string{
-						v1beta1constants.GardenerDescription: fmt.Sprintf("Allows egress traffic to all networks for "+
-							"pods labeled with '%s=%s'.", v1beta1constants.LabelNetworkPolicyToPublicNetworks,
-							v1beta1constants.LabelNetworkPolicyAllowed),
-					},
-				},
-				Spec: networkingv1.NetworkPolicySpec{
-					PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{v1beta1constants.LabelNetworkPolicyToPublicNetworks: v1beta1constants.LabelNetworkPolicyAllowed}},
-					Egress: []networkingv1.NetworkPolicyEgressRule{{To: []networkingv1.NetworkPolicyPeer{
-						{IPBlock: &networkingv1.IPBlock{CIDR: "0.0.0.0/0"}},
-						{IPBlock: &networkingv1.IPBlock{CIDR: "::/0"}},
-					}}},
-					PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
-				},
-			}
-		)
-
-		if err := registry.Add(
-			networkPolicyAllowToShootAPIServer,
-			networkPolicyAllowToDNS,
-			networkPolicyAllowToKubelet,
-			networkPolicyAllowToPublicNetworks,
-		); err != nil {
-			return nil, err
-		}
-
 		if err := registry.Add(priorityClassResources()...); err != nil {
 			return nil, err
 		}
@@ -321,6 +179,22 @@ func (s *shootSystem) computeResourcesData() (map[string][]byte, error) {
 		return nil, err
 	}
 
+	if len(s.values.EgressEndpoints) > 0 {
+		egressEndpointsConfigMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      v1beta1constants.ConfigMapNameEgressEndpoints,
+				Namespace: metav1.NamespaceSystem,
+			},
+			Data: s.egressEndpointsData(),
+		}
+
+		if err := registry.Add(
+			egressEndpointsConfigMap,
+		); err != nil {
+			return nil, err
+		}
+	}
+
 	if len(s.values.APIResourceList) > 0 {
 		if err := registry.Add(s.readOnlyClusterRole()); err != nil {
 			return nil, err
@@ -397,6 +271,14 @@ func (s *shootSystem) shootInfoData() map[string]string {
 	return data
 }
 
+func (s *shootSystem) egressEndpointsData() map[string]string {
+	data := make(map[string]string, len(s.values.EgressEndpoints))
+	for category, endpoints := range s.values.EgressEndpoints {
+		data[category] = strings.Join(endpoints, ",")
+	}
+	return data
+}
+
 func (s *shootSystem) readOnlyClusterRole() client.Object {
 	allowedSubResources := map[string]map[string][]string{
 		corev1.GroupName: {
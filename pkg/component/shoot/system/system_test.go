@@ -7,6 +7,7 @@ package system_test
 import (
 	"context"
 	"net"
+	"strings"
 
 	"github.com/Masterminds/semver/v3"
 	. "github.com/onsi/ginkgo/v2"
@@ -205,6 +206,26 @@ var _ = Describe("ShootSystem", func() {
 			})
 		})
 
+		Context("egress-endpoints ConfigMap", func() {
+			BeforeEach(func() {
+				values.EgressEndpoints = map[string][]string{"apiServer": {"https://api.foo.bar", "https://api.internal.foo.bar"}}
+			})
+
+			It("should successfully deploy the ConfigMap", func() {
+				configMap := &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "egress-endpoints",
+						Namespace: "kube-system",
+					},
+					Data: map[string]string{
+						"apiServer": "https://api.foo.bar,https://api.internal.foo.bar",
+					},
+				}
+
+				Expect(managedResource).To(contain(configMap))
+			})
+		})
+
 		Context("PriorityClasses", func() {
 			When("shoot is workerless", func() {
 				BeforeEach(func() {
@@ -461,6 +482,23 @@ var _ = Describe("ShootSystem", func() {
 					))
 				})
 			})
+
+			It("should render exactly the deployed NetworkPolicies from the policy model", func() {
+				rendered, err := RenderNetworkPolicies(values)
+				Expect(err).NotTo(HaveOccurred())
+
+				manifests, err := test.ExtractManifestsFromManagedResourceData(managedResourceSecret.Data)
+				Expect(err).NotTo(HaveOccurred())
+
+				var networkPolicyMatchers []any
+				for _, manifest := range manifests {
+					if strings.Contains(manifest, "kind: NetworkPolicy") {
+						networkPolicyMatchers = append(networkPolicyMatchers, MatchYAML(manifest))
+					}
+				}
+
+				Expect(strings.Split(rendered, "---\n")).To(ConsistOf(networkPolicyMatchers...))
+			})
 		})
 
 		Context("RBAC resources", func() {
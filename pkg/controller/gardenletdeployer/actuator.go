@@ -78,6 +78,9 @@ type Actuator struct {
 	Recorder                 record.EventRecorder
 	GardenletNamespaceTarget string
 	BootstrapToken           string
+	// ConfigTemplateValues are substituted for ${<name>} variable references in the gardenlet component configuration
+	// before it is interpreted, allowing a single template to inject per-seed values such as region or zone names.
+	ConfigTemplateValues map[string]string
 }
 
 // Reconcile deploys or updates gardenlets.
@@ -110,7 +113,7 @@ func (a *Actuator) Reconcile(
 	}
 
 	// Extract seed template and gardenlet config
-	seedTemplate, componentConfig, err := helper.ExtractSeedTemplateAndGardenletConfig(obj.GetName(), rawComponentConfig)
+	seedTemplate, componentConfig, err := helper.ExtractSeedTemplateAndGardenletConfig(obj.GetName(), RenderConfigTemplate(rawComponentConfig, a.ConfigTemplateValues))
 	if err != nil {
 		a.Recorder.Eventf(obj, corev1.EventTypeWarning, gardencorev1beta1.EventReconcileError, err.Error())
 		return updateCondition(a.Clock, conditions, gardencorev1beta1.ConditionFalse, gardencorev1beta1.EventReconcileError, err.Error()), err
@@ -177,7 +180,7 @@ func (a *Actuator) Delete(
 	}
 
 	// Extract seed template and gardenlet config
-	seedTemplate, componentConfig, err := helper.ExtractSeedTemplateAndGardenletConfig(obj.GetName(), rawComponentConfig)
+	seedTemplate, componentConfig, err := helper.ExtractSeedTemplateAndGardenletConfig(obj.GetName(), RenderConfigTemplate(rawComponentConfig, a.ConfigTemplateValues))
 	if err != nil {
 		a.Recorder.Eventf(obj, corev1.EventTypeWarning, gardencorev1beta1.EventDeleteError, err.Error())
 		return updateCondition(a.Clock, conditions, gardencorev1beta1.ConditionFalse, gardencorev1beta1.EventDeleteError, err.Error()), false, false, err
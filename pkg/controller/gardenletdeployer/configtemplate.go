@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardenletdeployer
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// RenderConfigTemplate substitutes the given variables in the raw gardenlet component configuration. Variables are
+// referenced as ${<name>}. References to variables that are not contained in the given map are left untouched, so
+// configurations that do not use templating remain unchanged.
+func RenderConfigTemplate(rawConfig *runtime.RawExtension, values map[string]string) *runtime.RawExtension {
+	if rawConfig == nil || len(rawConfig.Raw) == 0 || len(values) == 0 {
+		return rawConfig
+	}
+
+	replacements := make([]string, 0, 2*len(values))
+	for name, value := range values {
+		replacements = append(replacements, "${"+name+"}", value)
+	}
+
+	return &runtime.RawExtension{Raw: []byte(strings.NewReplacer(replacements...).Replace(string(rawConfig.Raw)))}
+}
@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardenletdeployer_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	. "github.com/gardener/gardener/pkg/controller/gardenletdeployer"
+)
+
+var _ = Describe("ConfigTemplate", func() {
+	Describe("#RenderConfigTemplate", func() {
+		var (
+			rawConfig *runtime.RawExtension
+			values    map[string]string
+		)
+
+		BeforeEach(func() {
+			rawConfig = &runtime.RawExtension{Raw: []byte(`{"seedConfig":{"spec":{"ingress":{"domain":"ingress.${region}.example.com"},"provider":{"region":"${region}","zones":["${zones}"]}}}}`)}
+			values = map[string]string{
+				"region": "eu-west-1",
+				"zones":  "eu-west-1a",
+			}
+		})
+
+		It("should substitute all referenced variables", func() {
+			result := RenderConfigTemplate(rawConfig, values)
+			Expect(string(result.Raw)).To(Equal(`{"seedConfig":{"spec":{"ingress":{"domain":"ingress.eu-west-1.example.com"},"provider":{"region":"eu-west-1","zones":["eu-west-1a"]}}}}`))
+		})
+
+		It("should leave references to unknown variables untouched", func() {
+			rawConfig.Raw = []byte(`{"foo":"${bar}"}`)
+			result := RenderConfigTemplate(rawConfig, values)
+			Expect(string(result.Raw)).To(Equal(`{"foo":"${bar}"}`))
+		})
+
+		It("should return the raw config unchanged if no values are given", func() {
+			Expect(RenderConfigTemplate(rawConfig, nil)).To(BeIdenticalTo(rawConfig))
+		})
+
+		It("should return nil if the raw config is nil", func() {
+			Expect(RenderConfigTemplate(nil, values)).To(BeNil())
+		})
+	})
+})
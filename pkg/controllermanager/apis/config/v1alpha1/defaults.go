@@ -26,6 +26,16 @@ func SetDefaults_ControllerManagerConfiguration(obj *ControllerManagerConfigurat
 	}
 }
 
+// SetDefaults_LogSampling sets defaults for the log sampling configuration.
+func SetDefaults_LogSampling(obj *LogSampling) {
+	if obj.Initial == nil {
+		obj.Initial = ptr.To[int32](100)
+	}
+	if obj.Thereafter == nil {
+		obj.Thereafter = ptr.To[int32](100)
+	}
+}
+
 // SetDefaults_ClientConnectionConfiguration sets defaults for the garden client connection.
 func SetDefaults_ClientConnectionConfiguration(obj *componentbaseconfigv1alpha1.ClientConnectionConfiguration) {
 	if obj.QPS == 0.0 {
@@ -102,6 +112,19 @@ func SetDefaults_ProjectControllerConfiguration(obj *ProjectControllerConfigurat
 			Duration: 12 * time.Hour,
 		}
 	}
+	if obj.UsageSyncPeriod == nil {
+		obj.UsageSyncPeriod = &metav1.Duration{
+			Duration: time.Hour,
+		}
+	}
+	if obj.OrphanedNamespaceGracePeriod == nil {
+		obj.OrphanedNamespaceGracePeriod = &metav1.Duration{
+			Duration: 24 * time.Hour,
+		}
+	}
+	if obj.OrphanedNamespaceDeletion == nil {
+		obj.OrphanedNamespaceDeletion = ptr.To(false)
+	}
 
 	for i, quota := range obj.Quotas {
 		if quota.ProjectSelector == nil {
@@ -179,6 +202,26 @@ func SetDefaults_ExposureClassControllerConfiguration(obj *ExposureClassControll
 	}
 }
 
+// SetDefaults_GardenBackupControllerConfiguration sets defaults for the GardenBackupControllerConfiguration.
+func SetDefaults_GardenBackupControllerConfiguration(obj *GardenBackupControllerConfiguration) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 24 * time.Hour}
+	}
+	if obj.RetainedSnapshots == nil {
+		obj.RetainedSnapshots = ptr.To[int32](7)
+	}
+}
+
+// SetDefaults_LandscapeConfigControllerConfiguration sets defaults for the LandscapeConfigControllerConfiguration.
+func SetDefaults_LandscapeConfigControllerConfiguration(obj *LandscapeConfigControllerConfiguration) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Hour}
+	}
+	if obj.DryRun == nil {
+		obj.DryRun = ptr.To(false)
+	}
+}
+
 // SetDefaults_QuotaControllerConfiguration sets defaults for the QuotaControllerConfiguration.
 func SetDefaults_QuotaControllerConfiguration(obj *QuotaControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -200,6 +243,16 @@ func SetDefaults_CredentialsBindingControllerConfiguration(obj *CredentialsBindi
 	}
 }
 
+// SetDefaults_CredentialsValidityControllerConfiguration sets defaults for the CredentialsValidityControllerConfiguration.
+func SetDefaults_CredentialsValidityControllerConfiguration(obj *CredentialsValidityControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 6 * time.Hour}
+	}
+}
+
 // SetDefaults_SeedExtensionsCheckControllerConfiguration sets defaults for the SeedExtensionsCheckControllerConfiguration.
 func SetDefaults_SeedExtensionsCheckControllerConfiguration(obj *SeedExtensionsCheckControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -257,6 +310,9 @@ func SetDefaults_ShootQuotaControllerConfiguration(obj *ShootQuotaControllerConf
 			Duration: 60 * time.Minute,
 		}
 	}
+	if obj.ExpirationWarningLeadTimes == nil {
+		obj.ExpirationWarningLeadTimes = []metav1.Duration{{Duration: 7 * 24 * time.Hour}, {Duration: 24 * time.Hour}}
+	}
 }
 
 // SetDefaults_ShootReferenceControllerConfiguration sets defaults for the ShootReferenceControllerConfiguration.
@@ -273,6 +329,29 @@ func SetDefaults_ShootConditionsControllerConfiguration(obj *ShootConditionsCont
 	}
 }
 
+// SetDefaults_ShootCostRecommendationControllerConfiguration sets defaults for the ShootCostRecommendationControllerConfiguration.
+func SetDefaults_ShootCostRecommendationControllerConfiguration(obj *ShootCostRecommendationControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 12 * time.Hour}
+	}
+	if obj.WorkerPoolSizeThreshold == nil {
+		obj.WorkerPoolSizeThreshold = ptr.To[int32](5)
+	}
+}
+
+// SetDefaults_ShootUpgradePathControllerConfiguration sets defaults for the ShootUpgradePathControllerConfiguration.
+func SetDefaults_ShootUpgradePathControllerConfiguration(obj *ShootUpgradePathControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 6 * time.Hour}
+	}
+}
+
 // SetDefaults_EventControllerConfiguration sets defaults for the EventControllerConfiguration.
 func SetDefaults_EventControllerConfiguration(obj *EventControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -305,6 +384,9 @@ func SetDefaults_ManagedSeedSetControllerConfiguration(obj *ManagedSeedSetContro
 	if obj.MaxShootRetries == nil {
 		obj.MaxShootRetries = ptr.To(3)
 	}
+	if obj.ReplicaReplacement != nil && obj.ReplicaReplacement.MinInterval == nil {
+		obj.ReplicaReplacement.MinInterval = &metav1.Duration{Duration: time.Hour}
+	}
 }
 
 // SetDefaults_ShootStateControllerConfiguration sets defaults for the ShootStateControllerConfiguration.
@@ -314,6 +396,13 @@ func SetDefaults_ShootStateControllerConfiguration(obj *ShootStateControllerConf
 	}
 }
 
+// SetDefaults_TokenRequestorControllerConfiguration sets defaults for the TokenRequestorControllerConfiguration.
+func SetDefaults_TokenRequestorControllerConfiguration(obj *TokenRequestorControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+}
+
 // SetDefaults_ControllerManagerControllerConfiguration sets defaults for the ControllerManagerControllerConfiguration.
 func SetDefaults_ControllerManagerControllerConfiguration(obj *ControllerManagerControllerConfiguration) {
 	if obj.Bastion == nil {
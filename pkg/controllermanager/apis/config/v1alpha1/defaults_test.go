@@ -9,6 +9,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 	"k8s.io/utils/ptr"
@@ -44,6 +45,33 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("LogSampling defaulting", func() {
+		It("should not default LogSampling if it is unset", func() {
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.LogSampling).To(BeNil())
+		})
+
+		It("should default LogSampling correctly", func() {
+			obj.LogSampling = &LogSampling{}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.LogSampling.Initial).To(PointTo(Equal(int32(100))))
+			Expect(obj.LogSampling.Thereafter).To(PointTo(Equal(int32(100))))
+		})
+
+		It("should not default fields that are set", func() {
+			obj.LogSampling = &LogSampling{
+				Initial:    ptr.To[int32](10),
+				Thereafter: ptr.To[int32](50),
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.LogSampling.Initial).To(PointTo(Equal(int32(10))))
+			Expect(obj.LogSampling.Thereafter).To(PointTo(Equal(int32(50))))
+		})
+	})
+
 	Describe("ClientConnectionConfiguration defaulting", func() {
 		It("should default ClientConnectionConfiguration correctly", func() {
 			expected := &componentbaseconfigv1alpha1.ClientConnectionConfiguration{
@@ -183,6 +211,13 @@ var _ = Describe("Defaults", func() {
 				StaleSyncPeriod: &metav1.Duration{
 					Duration: 12 * time.Hour,
 				},
+				UsageSyncPeriod: &metav1.Duration{
+					Duration: time.Hour,
+				},
+				OrphanedNamespaceGracePeriod: &metav1.Duration{
+					Duration: 24 * time.Hour,
+				},
+				OrphanedNamespaceDeletion: ptr.To(false),
 			}
 			SetObjectDefaults_ControllerManagerConfiguration(obj)
 
@@ -224,6 +259,13 @@ var _ = Describe("Defaults", func() {
 						StaleSyncPeriod: &metav1.Duration{
 							Duration: 12 * time.Hour,
 						},
+						UsageSyncPeriod: &metav1.Duration{
+							Duration: 2 * time.Hour,
+						},
+						OrphanedNamespaceGracePeriod: &metav1.Duration{
+							Duration: 48 * time.Hour,
+						},
+						OrphanedNamespaceDeletion: ptr.To(true),
 					},
 				},
 			}
@@ -636,6 +678,7 @@ var _ = Describe("Defaults", func() {
 				SyncPeriod: &metav1.Duration{
 					Duration: 60 * time.Minute,
 				},
+				ExpirationWarningLeadTimes: []metav1.Duration{{Duration: 7 * 24 * time.Hour}, {Duration: 24 * time.Hour}},
 			}
 			SetObjectDefaults_ControllerManagerConfiguration(obj)
 
@@ -650,6 +693,7 @@ var _ = Describe("Defaults", func() {
 						SyncPeriod: &metav1.Duration{
 							Duration: 120 * time.Minute,
 						},
+						ExpirationWarningLeadTimes: []metav1.Duration{{Duration: 48 * time.Hour}},
 					},
 				},
 			}
@@ -852,6 +896,21 @@ var _ = Describe("Defaults", func() {
 
 			Expect(obj.Controllers.ManagedSeedSet).To(Equal(expected))
 		})
+
+		It("should default the replica replacement minimum interval if replica replacement is configured", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ManagedSeedSet: &ManagedSeedSetControllerConfiguration{
+						ReplicaReplacement: &ReplicaReplacementConfiguration{
+							Enabled: true,
+						},
+					},
+				},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ManagedSeedSet.ReplicaReplacement.MinInterval).To(Equal(&metav1.Duration{Duration: time.Hour}))
+		})
 	})
 
 	Describe("ShootStateControllerConfiguration defaulting", func() {
@@ -892,6 +951,43 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Controllers.ShootState).To(Equal(expected))
 		})
 	})
+
+	Describe("TokenRequestorControllerConfiguration defaulting", func() {
+		It("should default TokenRequestorControllerConfiguration correctly if set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					TokenRequestor: &TokenRequestorControllerConfiguration{},
+				},
+			}
+			expected := &TokenRequestorControllerConfiguration{
+				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.TokenRequestor).To(Equal(expected))
+		})
+
+		It("should not default TokenRequestorControllerConfiguration if not set", func() {
+			var expected *TokenRequestorControllerConfiguration
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.TokenRequestor).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					TokenRequestor: &TokenRequestorControllerConfiguration{
+						ConcurrentSyncs: ptr.To(10),
+					},
+				},
+			}
+			expected := obj.Controllers.TokenRequestor.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.TokenRequestor).To(Equal(expected))
+		})
+	})
 })
 
 var _ = Describe("Constants", func() {
@@ -8,6 +8,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -28,6 +30,9 @@ type ControllerManagerConfiguration struct {
 	LogLevel string `json:"logLevel"`
 	// LogFormat is the output format for the logs. Must be one of [text,json].
 	LogFormat string `json:"logFormat"`
+	// LogSampling contains configuration for rate-limiting repeated log entries. If unset, no sampling is performed.
+	// +optional
+	LogSampling *LogSampling `json:"logSampling,omitempty"`
 	// Server defines the configuration of the HTTP server.
 	Server ServerConfiguration `json:"server"`
 	// Debugging holds configuration for Debugging related features.
@@ -41,6 +46,18 @@ type ControllerManagerConfiguration struct {
 	FeatureGates map[string]bool `json:"featureGates,omitempty"`
 }
 
+// LogSampling contains configuration for rate-limiting repeated log entries.
+type LogSampling struct {
+	// Initial is the number of log entries with identical level and message which are emitted per second before the
+	// sampler starts dropping entries. Defaults to 100.
+	// +optional
+	Initial *int32 `json:"initial,omitempty"`
+	// Thereafter causes every thereafter-th log entry with identical level and message to still be emitted within a
+	// second once Initial entries have been dropped. Defaults to 100.
+	// +optional
+	Thereafter *int32 `json:"thereafter,omitempty"`
+}
+
 // ControllerManagerControllerConfiguration defines the configuration of the controllers.
 type ControllerManagerControllerConfiguration struct {
 	// Bastion defines the configuration of the Bastion controller.
@@ -67,6 +84,14 @@ type ControllerManagerControllerConfiguration struct {
 	// ExposureClass defines the configuration of the ExposureClass controller.
 	// +optional
 	ExposureClass *ExposureClassControllerConfiguration `json:"exposureClass,omitempty"`
+	// GardenBackup defines the configuration of the GardenBackup controller. If unspecified, the controller is
+	// disabled.
+	// +optional
+	GardenBackup *GardenBackupControllerConfiguration `json:"gardenBackup,omitempty"`
+	// LandscapeConfig defines the configuration of the LandscapeConfig controller. If unspecified, the controller is
+	// disabled.
+	// +optional
+	LandscapeConfig *LandscapeConfigControllerConfiguration `json:"landscapeConfig,omitempty"`
 	// Project defines the configuration of the Project controller.
 	// +optional
 	Project *ProjectControllerConfiguration `json:"project,omitempty"`
@@ -79,6 +104,10 @@ type ControllerManagerControllerConfiguration struct {
 	// CredentialsBinding defines the configuration of the CredentialsBinding controller.
 	// +optional
 	CredentialsBinding *CredentialsBindingControllerConfiguration `json:"credentialsBinding,omitempty"`
+	// CredentialsValidity defines the configuration of the CredentialsValidity controller. If unspecified, the
+	// controller is disabled.
+	// +optional
+	CredentialsValidity *CredentialsValidityControllerConfiguration `json:"credentialsValidity,omitempty"`
 	// Seed defines the configuration of the Seed lifecycle controller.
 	// +optional
 	Seed *SeedControllerConfiguration `json:"seed,omitempty"`
@@ -107,9 +136,17 @@ type ControllerManagerControllerConfiguration struct {
 	// ShootConditions defines the configuration of the ShootConditions controller. If unspecified, it is defaulted with `concurrentSyncs=5`.
 	// +optional
 	ShootConditions *ShootConditionsControllerConfiguration `json:"shootConditions,omitempty"`
+	// ShootCostRecommendation defines the configuration of the ShootCostRecommendation controller. If unspecified, the
+	// controller is disabled.
+	// +optional
+	ShootCostRecommendation *ShootCostRecommendationControllerConfiguration `json:"shootCostRecommendation,omitempty"`
 	// ShootStatusLabel defines the configuration of the ShootStatusLabel controller.
 	// +optional
 	ShootStatusLabel *ShootStatusLabelControllerConfiguration `json:"shootStatusLabel,omitempty"`
+	// ShootUpgradePath defines the configuration of the ShootUpgradePath controller. If unspecified, the controller is
+	// disabled.
+	// +optional
+	ShootUpgradePath *ShootUpgradePathControllerConfiguration `json:"shootUpgradePath,omitempty"`
 	// ShootMigration defines the configuration of the ShootMigration controller. If unspecified, it is defaulted with `concurrentSyncs=5`.
 	// +optional
 	ShootMigration *ShootMigrationControllerConfiguration `json:"shootMigration,omitempty"`
@@ -119,6 +156,10 @@ type ControllerManagerControllerConfiguration struct {
 	// ShootState defines the configuration of the ShootState finalizer controller.
 	// +optional
 	ShootState *ShootStateControllerConfiguration `json:"shootState,omitempty"`
+	// TokenRequestor defines the configuration of the TokenRequestor controller. If unspecified, the controller is
+	// disabled.
+	// +optional
+	TokenRequestor *TokenRequestorControllerConfiguration `json:"tokenRequestor,omitempty"`
 }
 
 // BastionControllerConfiguration defines the configuration of the Bastion
@@ -199,6 +240,41 @@ type ExposureClassControllerConfiguration struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// GardenBackupControllerConfiguration defines the configuration of the
+// GardenBackup controller.
+type GardenBackupControllerConfiguration struct {
+	// SyncPeriod is the duration how often a snapshot of the garden cluster resources
+	// is exported (defaults to '24h').
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// ExportDirectory is the directory to which the snapshots are written, typically a
+	// mounted backup bucket.
+	ExportDirectory string `json:"exportDirectory"`
+	// EncryptionKeyFile is the path to a file containing the 32 byte key with which the
+	// snapshots are encrypted.
+	EncryptionKeyFile string `json:"encryptionKeyFile"`
+	// RetainedSnapshots is the number of snapshots which are kept in the export
+	// directory before the oldest ones are deleted (defaults to 7).
+	// +optional
+	RetainedSnapshots *int32 `json:"retainedSnapshots,omitempty"`
+}
+
+// LandscapeConfigControllerConfiguration defines the configuration of the
+// LandscapeConfig controller.
+type LandscapeConfigControllerConfiguration struct {
+	// SyncPeriod is the duration how often the declared landscape configuration is synced with the garden cluster
+	// (defaults to '1h').
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// OCIRepository is the reference to the OCI artifact containing the declared landscape configuration. The artifact
+	// is expected to contain a gzipped tarball with YAML manifests for CloudProfile, ControllerRegistration,
+	// ControllerDeployment, ExposureClass, and Seed resources.
+	OCIRepository gardencorev1.OCIRepository `json:"ociRepository"`
+	// DryRun means that configuration drift is only reported but not corrected (defaults to false).
+	// +optional
+	DryRun *bool `json:"dryRun,omitempty"`
+}
+
 // ProjectControllerConfiguration defines the configuration of the
 // Project controller.
 type ProjectControllerConfiguration struct {
@@ -224,6 +300,17 @@ type ProjectControllerConfiguration struct {
 	// StaleSyncPeriod is the duration how often the reconciliation loop for stale Projects is executed.
 	// +optional
 	StaleSyncPeriod *metav1.Duration `json:"staleSyncPeriod,omitempty"`
+	// UsageSyncPeriod is the duration how often the usage summaries in the Project statuses are refreshed.
+	// +optional
+	UsageSyncPeriod *metav1.Duration `json:"usageSyncPeriod,omitempty"`
+	// OrphanedNamespaceGracePeriod is the duration a namespace labeled as project namespace may exist without a
+	// corresponding Project before it is eligible for deletion by the orphaned namespace controller.
+	// +optional
+	OrphanedNamespaceGracePeriod *metav1.Duration `json:"orphanedNamespaceGracePeriod,omitempty"`
+	// OrphanedNamespaceDeletion specifies whether orphaned project namespaces are deleted once the grace period has
+	// passed. If disabled, orphaned namespaces are only reported via events and metrics. Defaults to false.
+	// +optional
+	OrphanedNamespaceDeletion *bool `json:"orphanedNamespaceDeletion,omitempty"`
 }
 
 // QuotaConfiguration defines quota configurations.
@@ -262,6 +349,19 @@ type CredentialsBindingControllerConfiguration struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// CredentialsValidityControllerConfiguration defines the configuration of the
+// CredentialsValidity controller.
+type CredentialsValidityControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the validity of the credentials referenced by SecretBindings and
+	// CredentialsBindings is probed (defaults to '6h').
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+}
+
 // SeedControllerConfiguration defines the configuration of the
 // Seed controller.
 type SeedControllerConfiguration struct {
@@ -350,6 +450,10 @@ type ShootQuotaControllerConfiguration struct {
 	// (how often Shoots referenced Quota is checked).
 	// +optional
 	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// ExpirationWarningLeadTimes is a list of lead times before the expiration of a shoot's cluster lifetime at which
+	// warning events are emitted for the shoot. Defaults to [168h, 24h].
+	// +optional
+	ExpirationWarningLeadTimes []metav1.Duration `json:"expirationWarningLeadTimes,omitempty"`
 }
 
 // ShootHibernationControllerConfiguration defines the configuration of the
@@ -401,6 +505,24 @@ type ShootConditionsControllerConfiguration struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// ShootCostRecommendationControllerConfiguration defines the configuration of the
+// ShootCostRecommendation controller.
+type ShootCostRecommendationControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often shoots are analyzed for cost-saving potential
+	// (defaults to '12h').
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// WorkerPoolSizeThreshold is the minimum node count of a worker pool at or above which the
+	// controller recommends reviewing the pool size if the pool cannot be scaled down by the
+	// cluster-autoscaler (i.e., its minimum equals its maximum). Defaults to 5.
+	// +optional
+	WorkerPoolSizeThreshold *int32 `json:"workerPoolSizeThreshold,omitempty"`
+}
+
 // ShootStatusLabelControllerConfiguration defines the configuration of the
 // ShootStatusLabel controller.
 type ShootStatusLabelControllerConfiguration struct {
@@ -410,6 +532,19 @@ type ShootStatusLabelControllerConfiguration struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// ShootUpgradePathControllerConfiguration defines the configuration of the
+// ShootUpgradePath controller.
+type ShootUpgradePathControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the upgrade paths of shoots are recomputed
+	// (defaults to '6h').
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+}
+
 // ShootMigrationControllerConfiguration defines the configuration of the
 // ShootMigration controller.
 type ShootMigrationControllerConfiguration struct {
@@ -431,6 +566,22 @@ type ManagedSeedSetControllerConfiguration struct {
 	MaxShootRetries *int `json:"maxShootRetries,omitempty"`
 	// SyncPeriod is the duration how often the existing resources are reconciled.
 	SyncPeriod metav1.Duration `json:"syncPeriod"`
+	// ReplicaReplacement configures the automated replacement of replicas whose shoot reconciliation has permanently
+	// failed, i.e. MaxShootRetries has been exhausted. If not specified, such replicas are kept pending operator
+	// intervention.
+	// +optional
+	ReplicaReplacement *ReplicaReplacementConfiguration `json:"replicaReplacement,omitempty"`
+}
+
+// ReplicaReplacementConfiguration defines the automated replacement of permanently failed ManagedSeedSet replicas.
+type ReplicaReplacementConfiguration struct {
+	// Enabled specifies whether replicas whose shoot reconciliation has permanently failed are automatically deleted,
+	// so that the ManagedSeedSet recreates them from its template.
+	Enabled bool `json:"enabled"`
+	// MinInterval is the minimum duration between two automated replacements per ManagedSeedSet, limiting the rate at
+	// which failed replicas are replaced. Defaults to 1h.
+	// +optional
+	MinInterval *metav1.Duration `json:"minInterval,omitempty"`
 }
 
 // ShootStateControllerConfiguration defines the configuration of the
@@ -442,6 +593,15 @@ type ShootStateControllerConfiguration struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// TokenRequestorControllerConfiguration defines the configuration of the
+// TokenRequestor controller.
+type TokenRequestorControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+}
+
 // ConditionThreshold defines the duration how long a flappy condition stays in progressing state.
 type ConditionThreshold struct {
 	// Type is the type of the condition to define the threshold for.
@@ -34,10 +34,29 @@ func ValidateControllerManagerConfiguration(conf *controllermanagerconfigv1alpha
 		}
 	}
 
+	allErrs = append(allErrs, validateLogSampling(conf.LogSampling, field.NewPath("logSampling"))...)
+
 	allErrs = append(allErrs, validateControllerManagerControllerConfiguration(conf.Controllers, field.NewPath("controllers"))...)
 	return allErrs
 }
 
+func validateLogSampling(logSampling *controllermanagerconfigv1alpha1.LogSampling, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if logSampling == nil {
+		return allErrs
+	}
+
+	if logSampling.Initial != nil && *logSampling.Initial < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("initial"), *logSampling.Initial, "must be at least 1"))
+	}
+	if logSampling.Thereafter != nil && *logSampling.Thereafter < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("thereafter"), *logSampling.Thereafter, "must be at least 1"))
+	}
+
+	return allErrs
+}
+
 func validateControllerManagerControllerConfiguration(conf controllermanagerconfigv1alpha1.ControllerManagerControllerConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -56,6 +56,39 @@ var _ = Describe("#ValidateControllerManagerConfiguration", func() {
 		})
 	})
 
+	Context("log sampling configuration", func() {
+		It("should allow omitting the log sampling config", func() {
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should allow a valid log sampling config", func() {
+			conf.LogSampling = &controllermanagerconfigv1alpha1.LogSampling{
+				Initial:    ptr.To[int32](10),
+				Thereafter: ptr.To[int32](50),
+			}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should reject non-positive values", func() {
+			conf.LogSampling = &controllermanagerconfigv1alpha1.LogSampling{
+				Initial:    ptr.To[int32](0),
+				Thereafter: ptr.To[int32](-1),
+			}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("logSampling.initial"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("logSampling.thereafter"),
+				})),
+			))
+		})
+	})
+
 	Context("leader election configuration", func() {
 		BeforeEach(func() {
 			controllermanagerconfigv1alpha1.SetObjectDefaults_ControllerManagerConfiguration(conf)
@@ -132,6 +132,11 @@ func (in *ControllerManagerConfiguration) DeepCopyInto(out *ControllerManagerCon
 		*out = new(configv1alpha1.LeaderElectionConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LogSampling != nil {
+		in, out := &in.LogSampling, &out.LogSampling
+		*out = new(LogSampling)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Server.DeepCopyInto(&out.Server)
 	if in.Debugging != nil {
 		in, out := &in.Debugging, &out.Debugging
@@ -209,6 +214,16 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(ExposureClassControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GardenBackup != nil {
+		in, out := &in.GardenBackup, &out.GardenBackup
+		*out = new(GardenBackupControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LandscapeConfig != nil {
+		in, out := &in.LandscapeConfig, &out.LandscapeConfig
+		*out = new(LandscapeConfigControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Project != nil {
 		in, out := &in.Project, &out.Project
 		*out = new(ProjectControllerConfiguration)
@@ -229,6 +244,11 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(CredentialsBindingControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CredentialsValidity != nil {
+		in, out := &in.CredentialsValidity, &out.CredentialsValidity
+		*out = new(CredentialsValidityControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Seed != nil {
 		in, out := &in.Seed, &out.Seed
 		*out = new(SeedControllerConfiguration)
@@ -271,11 +291,21 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(ShootConditionsControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootCostRecommendation != nil {
+		in, out := &in.ShootCostRecommendation, &out.ShootCostRecommendation
+		*out = new(ShootCostRecommendationControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ShootStatusLabel != nil {
 		in, out := &in.ShootStatusLabel, &out.ShootStatusLabel
 		*out = new(ShootStatusLabelControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootUpgradePath != nil {
+		in, out := &in.ShootUpgradePath, &out.ShootUpgradePath
+		*out = new(ShootUpgradePathControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ShootMigration != nil {
 		in, out := &in.ShootMigration, &out.ShootMigration
 		*out = new(ShootMigrationControllerConfiguration)
@@ -291,6 +321,11 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(ShootStateControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TokenRequestor != nil {
+		in, out := &in.TokenRequestor, &out.TokenRequestor
+		*out = new(TokenRequestorControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -346,6 +381,32 @@ func (in *CredentialsBindingControllerConfiguration) DeepCopy() *CredentialsBind
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialsValidityControllerConfiguration) DeepCopyInto(out *CredentialsValidityControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialsValidityControllerConfiguration.
+func (in *CredentialsValidityControllerConfiguration) DeepCopy() *CredentialsValidityControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialsValidityControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EventControllerConfiguration) DeepCopyInto(out *EventControllerConfiguration) {
 	*out = *in
@@ -393,6 +454,85 @@ func (in *ExposureClassControllerConfiguration) DeepCopy() *ExposureClassControl
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GardenBackupControllerConfiguration) DeepCopyInto(out *GardenBackupControllerConfiguration) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RetainedSnapshots != nil {
+		in, out := &in.RetainedSnapshots, &out.RetainedSnapshots
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GardenBackupControllerConfiguration.
+func (in *GardenBackupControllerConfiguration) DeepCopy() *GardenBackupControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(GardenBackupControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LandscapeConfigControllerConfiguration) DeepCopyInto(out *LandscapeConfigControllerConfiguration) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	in.OCIRepository.DeepCopyInto(&out.OCIRepository)
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LandscapeConfigControllerConfiguration.
+func (in *LandscapeConfigControllerConfiguration) DeepCopy() *LandscapeConfigControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(LandscapeConfigControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogSampling) DeepCopyInto(out *LogSampling) {
+	*out = *in
+	if in.Initial != nil {
+		in, out := &in.Initial, &out.Initial
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Thereafter != nil {
+		in, out := &in.Thereafter, &out.Thereafter
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogSampling.
+func (in *LogSampling) DeepCopy() *LogSampling {
+	if in == nil {
+		return nil
+	}
+	out := new(LogSampling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedSeedSetControllerConfiguration) DeepCopyInto(out *ManagedSeedSetControllerConfiguration) {
 	*out = *in
@@ -407,6 +547,11 @@ func (in *ManagedSeedSetControllerConfiguration) DeepCopyInto(out *ManagedSeedSe
 		**out = **in
 	}
 	out.SyncPeriod = in.SyncPeriod
+	if in.ReplicaReplacement != nil {
+		in, out := &in.ReplicaReplacement, &out.ReplicaReplacement
+		*out = new(ReplicaReplacementConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -476,6 +621,21 @@ func (in *ProjectControllerConfiguration) DeepCopyInto(out *ProjectControllerCon
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.UsageSyncPeriod != nil {
+		in, out := &in.UsageSyncPeriod, &out.UsageSyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.OrphanedNamespaceGracePeriod != nil {
+		in, out := &in.OrphanedNamespaceGracePeriod, &out.OrphanedNamespaceGracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.OrphanedNamespaceDeletion != nil {
+		in, out := &in.OrphanedNamespaceDeletion, &out.OrphanedNamespaceDeletion
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -532,6 +692,27 @@ func (in *QuotaControllerConfiguration) DeepCopy() *QuotaControllerConfiguration
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicaReplacementConfiguration) DeepCopyInto(out *ReplicaReplacementConfiguration) {
+	*out = *in
+	if in.MinInterval != nil {
+		in, out := &in.MinInterval, &out.MinInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaReplacementConfiguration.
+func (in *ReplicaReplacementConfiguration) DeepCopy() *ReplicaReplacementConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaReplacementConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretBindingControllerConfiguration) DeepCopyInto(out *SecretBindingControllerConfiguration) {
 	*out = *in
@@ -735,6 +916,37 @@ func (in *ShootConditionsControllerConfiguration) DeepCopy() *ShootConditionsCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootCostRecommendationControllerConfiguration) DeepCopyInto(out *ShootCostRecommendationControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.WorkerPoolSizeThreshold != nil {
+		in, out := &in.WorkerPoolSizeThreshold, &out.WorkerPoolSizeThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootCostRecommendationControllerConfiguration.
+func (in *ShootCostRecommendationControllerConfiguration) DeepCopy() *ShootCostRecommendationControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootCostRecommendationControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootHibernationControllerConfiguration) DeepCopyInto(out *ShootHibernationControllerConfiguration) {
 	*out = *in
@@ -826,6 +1038,11 @@ func (in *ShootQuotaControllerConfiguration) DeepCopyInto(out *ShootQuotaControl
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.ExpirationWarningLeadTimes != nil {
+		in, out := &in.ExpirationWarningLeadTimes, &out.ExpirationWarningLeadTimes
+		*out = make([]v1.Duration, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -932,3 +1149,50 @@ func (in *ShootStatusLabelControllerConfiguration) DeepCopy() *ShootStatusLabelC
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootUpgradePathControllerConfiguration) DeepCopyInto(out *ShootUpgradePathControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootUpgradePathControllerConfiguration.
+func (in *ShootUpgradePathControllerConfiguration) DeepCopy() *ShootUpgradePathControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootUpgradePathControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenRequestorControllerConfiguration) DeepCopyInto(out *TokenRequestorControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenRequestorControllerConfiguration.
+func (in *TokenRequestorControllerConfiguration) DeepCopy() *TokenRequestorControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenRequestorControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -51,6 +51,12 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	if in.Controllers.ExposureClass != nil {
 		SetDefaults_ExposureClassControllerConfiguration(in.Controllers.ExposureClass)
 	}
+	if in.Controllers.GardenBackup != nil {
+		SetDefaults_GardenBackupControllerConfiguration(in.Controllers.GardenBackup)
+	}
+	if in.Controllers.LandscapeConfig != nil {
+		SetDefaults_LandscapeConfigControllerConfiguration(in.Controllers.LandscapeConfig)
+	}
 	if in.Controllers.Project != nil {
 		SetDefaults_ProjectControllerConfiguration(in.Controllers.Project)
 	}
@@ -63,6 +69,9 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	if in.Controllers.CredentialsBinding != nil {
 		SetDefaults_CredentialsBindingControllerConfiguration(in.Controllers.CredentialsBinding)
 	}
+	if in.Controllers.CredentialsValidity != nil {
+		SetDefaults_CredentialsValidityControllerConfiguration(in.Controllers.CredentialsValidity)
+	}
 	if in.Controllers.Seed != nil {
 		SetDefaults_SeedControllerConfiguration(in.Controllers.Seed)
 	}
@@ -89,9 +98,15 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	if in.Controllers.ShootConditions != nil {
 		SetDefaults_ShootConditionsControllerConfiguration(in.Controllers.ShootConditions)
 	}
+	if in.Controllers.ShootCostRecommendation != nil {
+		SetDefaults_ShootCostRecommendationControllerConfiguration(in.Controllers.ShootCostRecommendation)
+	}
 	if in.Controllers.ShootStatusLabel != nil {
 		SetDefaults_ShootStatusLabelControllerConfiguration(in.Controllers.ShootStatusLabel)
 	}
+	if in.Controllers.ShootUpgradePath != nil {
+		SetDefaults_ShootUpgradePathControllerConfiguration(in.Controllers.ShootUpgradePath)
+	}
 	if in.Controllers.ShootMigration != nil {
 		SetDefaults_ShootMigrationControllerConfiguration(in.Controllers.ShootMigration)
 	}
@@ -101,8 +116,14 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	if in.Controllers.ShootState != nil {
 		SetDefaults_ShootStateControllerConfiguration(in.Controllers.ShootState)
 	}
+	if in.Controllers.TokenRequestor != nil {
+		SetDefaults_TokenRequestorControllerConfiguration(in.Controllers.TokenRequestor)
+	}
 	if in.LeaderElection != nil {
 		SetDefaults_LeaderElectionConfiguration(in.LeaderElection)
 	}
+	if in.LogSampling != nil {
+		SetDefaults_LogSampling(in.LogSampling)
+	}
 	SetDefaults_ServerConfiguration(&in.Server)
 }
@@ -9,9 +9,12 @@ import (
 	"fmt"
 
 	kubernetesclientset "k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/controller/tokenrequestor"
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/bastion"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/certificatesigningrequest"
@@ -19,9 +22,13 @@ import (
 	"github.com/gardener/gardener/pkg/controllermanager/controller/controllerdeployment"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/controllerregistration"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/credentialsbinding"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/credentialsvalidity"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/event"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/exposureclass"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/gardenbackup"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/gardenletlifecycle"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/landscapeconfig"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/landscapemetrics"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/managedseedset"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/namespacedcloudprofile"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project"
@@ -80,6 +87,12 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg *controllermanag
 		return fmt.Errorf("failed adding CredentialsBinding controller: %w", err)
 	}
 
+	if cfg.Controllers.CredentialsValidity != nil {
+		if err := credentialsvalidity.AddToManager(mgr, *cfg); err != nil {
+			return fmt.Errorf("failed adding CredentialsValidity controller: %w", err)
+		}
+	}
+
 	if config := cfg.Controllers.Event; config != nil {
 		if err := (&event.Reconciler{
 			Config: *config,
@@ -94,6 +107,26 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg *controllermanag
 		return fmt.Errorf("failed adding ExposureClass controller: %w", err)
 	}
 
+	if config := cfg.Controllers.GardenBackup; config != nil {
+		if err := (&gardenbackup.Exporter{
+			Config: *config,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding GardenBackup exporter: %w", err)
+		}
+	}
+
+	if config := cfg.Controllers.LandscapeConfig; config != nil {
+		if err := (&landscapeconfig.Syncer{
+			Config: *config,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding LandscapeConfig syncer: %w", err)
+		}
+	}
+
+	if err := (&landscapemetrics.Collector{}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding landscape metrics collector: %w", err)
+	}
+
 	if err := (&gardenletlifecycle.Reconciler{
 		Config:         *cfg.Controllers.Seed,
 		LeaseNamespace: gardencorev1beta1.GardenerSeedLeaseNamespace,
@@ -137,5 +170,14 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg *controllermanag
 		return fmt.Errorf("failed adding Shoot controller: %w", err)
 	}
 
+	if config := cfg.Controllers.TokenRequestor; config != nil {
+		if err := (&tokenrequestor.Reconciler{
+			ConcurrentSyncs: ptr.Deref(config.ConcurrentSyncs, 0),
+			Class:           ptr.To(resourcesv1alpha1.ResourceManagerClassControllerManager),
+		}).AddToManager(mgr, mgr, mgr); err != nil {
+			return fmt.Errorf("failed adding TokenRequestor controller: %w", err)
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package credentialsvalidity
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "credentials-validity"
+
+// AddToManager adds all credentials validity reconcilers to the given manager.
+func AddToManager(mgr manager.Manager, cfg controllermanagerconfigv1alpha1.ControllerManagerConfiguration) error {
+	if err := (&SecretBindingReconciler{
+		Config: *cfg.Controllers.CredentialsValidity,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding SecretBinding reconciler: %w", err)
+	}
+
+	if err := (&CredentialsBindingReconciler{
+		Config: *cfg.Controllers.CredentialsValidity,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding CredentialsBinding reconciler: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package credentialsvalidity
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+)
+
+// CredentialsBindingReconciler reconciles CredentialsBindings and maintains the CredentialsValid condition on them and
+// the referencing Shoots by probing the referenced credentials against the validation of the responsible provider
+// extension.
+type CredentialsBindingReconciler struct {
+	Client client.Client
+	Config controllermanagerconfigv1alpha1.CredentialsValidityControllerConfiguration
+	Clock  clock.Clock
+}
+
+// AddToManager adds CredentialsBindingReconciler to the given manager.
+func (r *CredentialsBindingReconciler) AddToManager(mgr manager.Manager) error {
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName + "-credentialsbinding").
+		For(&securityv1alpha1.CredentialsBinding{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+			ReconciliationTimeout:   r.Config.SyncPeriod.Duration,
+		}).
+		Complete(r)
+}
+
+// Reconcile probes the credentials referenced by the CredentialsBinding and maintains the CredentialsValid condition.
+func (r *CredentialsBindingReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	credentialsBinding := &securityv1alpha1.CredentialsBinding{}
+	if err := r.Client.Get(ctx, request.NamespacedName, credentialsBinding); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, credentialsBinding.Status.Conditions, securityv1alpha1.CredentialsBindingCredentialsValid)
+
+	if credentialsBinding.CredentialsRef.Kind == "Secret" {
+		secretKey := client.ObjectKey{Namespace: credentialsBinding.CredentialsRef.Namespace, Name: credentialsBinding.CredentialsRef.Name}
+		if secretKey.Namespace == "" {
+			secretKey.Namespace = credentialsBinding.Namespace
+		}
+
+		condition = probeCredentials(ctx, r.Client, r.Clock, condition, secretKey)
+	} else {
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionUnknown, "ProbeNotSupported", fmt.Sprintf("Credentials of kind %q cannot be probed for validity.", credentialsBinding.CredentialsRef.Kind))
+	}
+
+	patch := client.StrategicMergeFrom(credentialsBinding.DeepCopy())
+	if conditions := v1beta1helper.MergeConditions(credentialsBinding.Status.Conditions, condition); v1beta1helper.ConditionsNeedUpdate(credentialsBinding.Status.Conditions, conditions) {
+		credentialsBinding.Status.Conditions = conditions
+		if err := r.Client.Patch(ctx, credentialsBinding, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed patching CredentialsValid condition of CredentialsBinding: %w", err)
+		}
+		log.Info("Successfully patched condition", "conditionType", condition.Type, "conditionStatus", condition.Status)
+	}
+
+	if err := mirrorConditionToShoots(ctx, r.Client, r.Clock, credentialsBinding.Namespace, condition, func(shoot *gardencorev1beta1.Shoot) bool {
+		return ptr.Deref(shoot.Spec.CredentialsBindingName, "") == credentialsBinding.Name
+	}); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package credentialsvalidity_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/credentialsvalidity"
+)
+
+var _ = Describe("CredentialsBindingReconciler", func() {
+	const (
+		namespace          = "garden-dev"
+		syncPeriodDuration = time.Hour
+	)
+
+	var (
+		ctx context.Context
+		c   client.Client
+
+		secret             *corev1.Secret
+		credentialsBinding *securityv1alpha1.CredentialsBinding
+		shoot              *gardencorev1beta1.Shoot
+
+		fakeClock *testclock.FakeClock
+
+		reconciler reconcile.Reconciler
+		request    reconcile.Request
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-secret", Namespace: namespace},
+		}
+		credentialsBinding = &securityv1alpha1.CredentialsBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-binding", Namespace: namespace},
+			CredentialsRef: corev1.ObjectReference{
+				APIVersion: "v1",
+				Kind:       "Secret",
+				Name:       secret.Name,
+				Namespace:  secret.Namespace,
+			},
+		}
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-shoot", Namespace: namespace},
+			Spec:       gardencorev1beta1.ShootSpec{CredentialsBindingName: ptr.To(credentialsBinding.Name)},
+		}
+
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(credentialsBinding)}
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+	})
+
+	JustBeforeEach(func() {
+		c = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithObjects(secret, credentialsBinding, shoot).
+			WithStatusSubresource(&gardencorev1beta1.Shoot{}).
+			Build()
+
+		reconciler = &CredentialsBindingReconciler{
+			Client: c,
+			Config: controllermanagerconfigv1alpha1.CredentialsValidityControllerConfiguration{
+				SyncPeriod: &metav1.Duration{Duration: syncPeriodDuration},
+			},
+			Clock: fakeClock,
+		}
+	})
+
+	It("should set CredentialsValid to True on binding and referencing shoots if the probe succeeds", func() {
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(credentialsBinding), credentialsBinding)).To(Succeed())
+		Expect(credentialsBinding.Status.Conditions).To(ConsistOf(matchCondition(securityv1alpha1.CredentialsBindingCredentialsValid, gardencorev1beta1.ConditionTrue, "CredentialsValid")))
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.Status.Conditions).To(ConsistOf(matchCondition(gardencorev1beta1.ShootCredentialsValid, gardencorev1beta1.ConditionTrue, "CredentialsValid")))
+	})
+
+	Context("referenced secret does not exist", func() {
+		BeforeEach(func() {
+			credentialsBinding.CredentialsRef.Name = "non-existing"
+		})
+
+		It("should set CredentialsValid to False", func() {
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(credentialsBinding), credentialsBinding)).To(Succeed())
+			Expect(credentialsBinding.Status.Conditions).To(ConsistOf(matchCondition(securityv1alpha1.CredentialsBindingCredentialsValid, gardencorev1beta1.ConditionFalse, "CredentialsNotFound")))
+		})
+	})
+
+	Context("referenced credentials are a WorkloadIdentity", func() {
+		BeforeEach(func() {
+			credentialsBinding.CredentialsRef = corev1.ObjectReference{
+				APIVersion: securityv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "WorkloadIdentity",
+				Name:       "my-workload-identity",
+				Namespace:  namespace,
+			}
+		})
+
+		It("should set CredentialsValid to Unknown", func() {
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(credentialsBinding), credentialsBinding)).To(Succeed())
+			Expect(credentialsBinding.Status.Conditions).To(ConsistOf(matchCondition(securityv1alpha1.CredentialsBindingCredentialsValid, gardencorev1beta1.ConditionUnknown, "ProbeNotSupported")))
+		})
+	})
+})
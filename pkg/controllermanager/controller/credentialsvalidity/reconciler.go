@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package credentialsvalidity
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+)
+
+// probeCredentials reads the referenced secret and performs a dry-run update on it. The dry-run update triggers the
+// validation of the responsible provider extension's admission webhook in the garden cluster without persisting any
+// change, so it reports whether the credentials are still accepted by the provider.
+func probeCredentials(ctx context.Context, c client.Client, clock clock.Clock, condition gardencorev1beta1.Condition, secretKey client.ObjectKey) gardencorev1beta1.Condition {
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, secretKey, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return v1beta1helper.UpdatedConditionWithClock(clock, condition, gardencorev1beta1.ConditionFalse, "CredentialsNotFound", fmt.Sprintf("Referenced secret %s does not exist.", secretKey))
+		}
+		return v1beta1helper.UpdatedConditionUnknownErrorMessageWithClock(clock, condition, fmt.Sprintf("Failed reading referenced secret %s: %s", secretKey, err.Error()))
+	}
+
+	probe := secret.DeepCopy()
+	metav1.SetMetaDataAnnotation(&probe.ObjectMeta, v1beta1constants.GardenerTimestamp, clock.Now().UTC().Format(time.RFC3339Nano))
+	if err := c.Update(ctx, probe, client.DryRunAll); err != nil {
+		return v1beta1helper.UpdatedConditionWithClock(clock, condition, gardencorev1beta1.ConditionFalse, "CredentialsInvalid", fmt.Sprintf("Provider validation of referenced secret %s failed: %s", secretKey, err.Error()))
+	}
+
+	return v1beta1helper.UpdatedConditionWithClock(clock, condition, gardencorev1beta1.ConditionTrue, "CredentialsValid", fmt.Sprintf("Referenced secret %s was accepted by the provider validation.", secretKey))
+}
+
+// mirrorConditionToShoots copies the given condition to the CredentialsValid condition of all shoots in the given
+// namespace matching the given predicate.
+func mirrorConditionToShoots(ctx context.Context, c client.Client, clock clock.Clock, namespace string, condition gardencorev1beta1.Condition, matches func(shoot *gardencorev1beta1.Shoot) bool) error {
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := c.List(ctx, shootList, client.InNamespace(namespace)); err != nil {
+		return fmt.Errorf("failed listing shoots in namespace %s: %w", namespace, err)
+	}
+
+	for i := range shootList.Items {
+		shoot := &shootList.Items[i]
+		if !matches(shoot) {
+			continue
+		}
+
+		shootCondition := v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Conditions, gardencorev1beta1.ShootCredentialsValid)
+		shootCondition = v1beta1helper.UpdatedConditionWithClock(clock, shootCondition, condition.Status, condition.Reason, condition.Message)
+
+		patch := client.StrategicMergeFrom(shoot.DeepCopy())
+
+		conditions := v1beta1helper.MergeConditions(shoot.Status.Conditions, shootCondition)
+		if !v1beta1helper.ConditionsNeedUpdate(shoot.Status.Conditions, conditions) {
+			continue
+		}
+
+		shoot.Status.Conditions = conditions
+		if err := c.Status().Patch(ctx, shoot, patch); err != nil {
+			return fmt.Errorf("failed patching CredentialsValid condition of shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+		}
+	}
+
+	return nil
+}
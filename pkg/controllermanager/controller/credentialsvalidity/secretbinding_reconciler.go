@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package credentialsvalidity
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+)
+
+// SecretBindingReconciler reconciles SecretBindings and maintains the CredentialsValid condition on them and the
+// referencing Shoots by probing the referenced credentials against the validation of the responsible provider
+// extension.
+type SecretBindingReconciler struct {
+	Client client.Client
+	Config controllermanagerconfigv1alpha1.CredentialsValidityControllerConfiguration
+	Clock  clock.Clock
+}
+
+// AddToManager adds SecretBindingReconciler to the given manager.
+func (r *SecretBindingReconciler) AddToManager(mgr manager.Manager) error {
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName + "-secretbinding").
+		For(&gardencorev1beta1.SecretBinding{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+			ReconciliationTimeout:   r.Config.SyncPeriod.Duration,
+		}).
+		Complete(r)
+}
+
+// Reconcile probes the credentials referenced by the SecretBinding and maintains the CredentialsValid condition.
+func (r *SecretBindingReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	secretBinding := &gardencorev1beta1.SecretBinding{}
+	if err := r.Client.Get(ctx, request.NamespacedName, secretBinding); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	secretKey := client.ObjectKey{Namespace: secretBinding.SecretRef.Namespace, Name: secretBinding.SecretRef.Name}
+	if secretKey.Namespace == "" {
+		secretKey.Namespace = secretBinding.Namespace
+	}
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, secretBinding.Status.Conditions, gardencorev1beta1.SecretBindingCredentialsValid)
+	condition = probeCredentials(ctx, r.Client, r.Clock, condition, secretKey)
+
+	patch := client.StrategicMergeFrom(secretBinding.DeepCopy())
+	if conditions := v1beta1helper.MergeConditions(secretBinding.Status.Conditions, condition); v1beta1helper.ConditionsNeedUpdate(secretBinding.Status.Conditions, conditions) {
+		secretBinding.Status.Conditions = conditions
+		if err := r.Client.Patch(ctx, secretBinding, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed patching CredentialsValid condition of SecretBinding: %w", err)
+		}
+		log.Info("Successfully patched condition", "conditionType", condition.Type, "conditionStatus", condition.Status)
+	}
+
+	if err := mirrorConditionToShoots(ctx, r.Client, r.Clock, secretBinding.Namespace, condition, func(shoot *gardencorev1beta1.Shoot) bool {
+		return ptr.Deref(shoot.Spec.SecretBindingName, "") == secretBinding.Name
+	}); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
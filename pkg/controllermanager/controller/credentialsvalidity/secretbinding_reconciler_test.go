@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package credentialsvalidity_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/credentialsvalidity"
+)
+
+var _ = Describe("SecretBindingReconciler", func() {
+	const (
+		namespace          = "garden-dev"
+		syncPeriodDuration = time.Hour
+	)
+
+	var (
+		ctx context.Context
+		c   client.Client
+
+		secret        *corev1.Secret
+		secretBinding *gardencorev1beta1.SecretBinding
+		shoot         *gardencorev1beta1.Shoot
+
+		interceptorFuncs interceptor.Funcs
+
+		fakeClock *testclock.FakeClock
+
+		reconciler reconcile.Reconciler
+		request    reconcile.Request
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-secret", Namespace: namespace},
+		}
+		secretBinding = &gardencorev1beta1.SecretBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-binding", Namespace: namespace},
+			SecretRef:  corev1.SecretReference{Name: secret.Name, Namespace: secret.Namespace},
+		}
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-shoot", Namespace: namespace},
+			Spec:       gardencorev1beta1.ShootSpec{SecretBindingName: ptr.To(secretBinding.Name)},
+		}
+
+		interceptorFuncs = interceptor.Funcs{}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(secretBinding)}
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+	})
+
+	JustBeforeEach(func() {
+		c = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithObjects(secret, secretBinding, shoot).
+			WithStatusSubresource(&gardencorev1beta1.Shoot{}).
+			WithInterceptorFuncs(interceptorFuncs).
+			Build()
+
+		reconciler = &SecretBindingReconciler{
+			Client: c,
+			Config: controllermanagerconfigv1alpha1.CredentialsValidityControllerConfiguration{
+				SyncPeriod: &metav1.Duration{Duration: syncPeriodDuration},
+			},
+			Clock: fakeClock,
+		}
+	})
+
+	It("should do nothing if SecretBinding is gone", func() {
+		request.Name = "some-other-binding"
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+	})
+
+	It("should set CredentialsValid to True if the probe succeeds", func() {
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(secretBinding), secretBinding)).To(Succeed())
+		Expect(secretBinding.Status.Conditions).To(ConsistOf(matchCondition(gardencorev1beta1.SecretBindingCredentialsValid, gardencorev1beta1.ConditionTrue, "CredentialsValid")))
+	})
+
+	It("should mirror the condition to referencing shoots", func() {
+		otherShoot := shoot.DeepCopy()
+		otherShoot.Name = "other-shoot"
+		otherShoot.ResourceVersion = ""
+		otherShoot.Spec.SecretBindingName = ptr.To("other-binding")
+		Expect(c.Create(ctx, otherShoot)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.Status.Conditions).To(ConsistOf(matchCondition(gardencorev1beta1.ShootCredentialsValid, gardencorev1beta1.ConditionTrue, "CredentialsValid")))
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(otherShoot), otherShoot)).To(Succeed())
+		Expect(otherShoot.Status.Conditions).To(BeEmpty())
+	})
+
+	Context("referenced secret does not exist", func() {
+		BeforeEach(func() {
+			secretBinding.SecretRef.Name = "non-existing"
+		})
+
+		It("should set CredentialsValid to False", func() {
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(secretBinding), secretBinding)).To(Succeed())
+			Expect(secretBinding.Status.Conditions).To(ConsistOf(matchCondition(gardencorev1beta1.SecretBindingCredentialsValid, gardencorev1beta1.ConditionFalse, "CredentialsNotFound")))
+		})
+	})
+
+	Context("provider validation rejects the credentials", func() {
+		BeforeEach(func() {
+			interceptorFuncs.Update = func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.UpdateOption) error {
+				if _, ok := obj.(*corev1.Secret); ok {
+					return fmt.Errorf("admission webhook denied the request")
+				}
+				return nil
+			}
+		})
+
+		It("should set CredentialsValid to False on the binding and referencing shoots", func() {
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(secretBinding), secretBinding)).To(Succeed())
+			Expect(secretBinding.Status.Conditions).To(ConsistOf(matchCondition(gardencorev1beta1.SecretBindingCredentialsValid, gardencorev1beta1.ConditionFalse, "CredentialsInvalid")))
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.Conditions).To(ConsistOf(matchCondition(gardencorev1beta1.ShootCredentialsValid, gardencorev1beta1.ConditionFalse, "CredentialsInvalid")))
+		})
+	})
+})
+
+func matchCondition(conditionType gardencorev1beta1.ConditionType, status gardencorev1beta1.ConditionStatus, reason string) gomegatypes.GomegaMatcher {
+	return MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(conditionType),
+		"Status": Equal(status),
+		"Reason": Equal(reason),
+	})
+}
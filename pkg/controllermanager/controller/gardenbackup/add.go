@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardenbackup
+
+import (
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "garden-backup"
+
+// AddToManager adds the exporter to the given manager.
+func (e *Exporter) AddToManager(mgr manager.Manager) error {
+	if e.Client == nil {
+		e.Client = mgr.GetClient()
+	}
+	if e.Clock == nil {
+		e.Clock = clock.RealClock{}
+	}
+	if e.Log.GetSink() == nil {
+		e.Log = mgr.GetLogger().WithName(ControllerName)
+	}
+
+	return mgr.Add(e)
+}
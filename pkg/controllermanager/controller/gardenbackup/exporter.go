@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardenbackup
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/gardener/backup"
+)
+
+const (
+	// snapshotFilePrefix is the prefix of the snapshot file names written by the exporter.
+	snapshotFilePrefix = "garden-"
+	// snapshotFileSuffix is the suffix of the snapshot file names written by the exporter.
+	snapshotFileSuffix = ".snapshot"
+	// snapshotTimestampLayout is the layout of the timestamp embedded in the snapshot file names. It sorts
+	// lexicographically so that older snapshots can be pruned by file name.
+	snapshotTimestampLayout = "20060102-150405"
+)
+
+// Exporter periodically exports an encrypted snapshot of the garden cluster resources to the configured export
+// directory, typically a mounted backup bucket.
+type Exporter struct {
+	Client client.Reader
+	Config controllermanagerconfigv1alpha1.GardenBackupControllerConfiguration
+	Clock  clock.Clock
+	Log    logr.Logger
+}
+
+// Start runs the exporter until the given context is cancelled.
+func (e *Exporter) Start(ctx context.Context) error {
+	wait.Until(func() {
+		if err := e.export(ctx); err != nil {
+			e.Log.Error(err, "Failed exporting garden snapshot")
+			return
+		}
+
+		if err := e.pruneOldSnapshots(); err != nil {
+			e.Log.Error(err, "Failed pruning old garden snapshots")
+		}
+	}, e.Config.SyncPeriod.Duration, ctx.Done())
+
+	return nil
+}
+
+func (e *Exporter) export(ctx context.Context) error {
+	encryptionKey, err := os.ReadFile(e.Config.EncryptionKeyFile)
+	if err != nil {
+		return fmt.Errorf("failed reading encryption key file: %w", err)
+	}
+
+	data, err := backup.Export(ctx, e.Client, bytes.TrimSpace(encryptionKey))
+	if err != nil {
+		return err
+	}
+
+	fileName := snapshotFilePrefix + e.Clock.Now().UTC().Format(snapshotTimestampLayout) + snapshotFileSuffix
+	if err := os.WriteFile(filepath.Join(e.Config.ExportDirectory, fileName), data, 0o600); err != nil {
+		return fmt.Errorf("failed writing snapshot file: %w", err)
+	}
+
+	e.Log.Info("Successfully exported garden snapshot", "fileName", fileName)
+	return nil
+}
+
+func (e *Exporter) pruneOldSnapshots() error {
+	entries, err := os.ReadDir(e.Config.ExportDirectory)
+	if err != nil {
+		return fmt.Errorf("failed reading export directory: %w", err)
+	}
+
+	var snapshots []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasPrefix(entry.Name(), snapshotFilePrefix) && strings.HasSuffix(entry.Name(), snapshotFileSuffix) {
+			snapshots = append(snapshots, entry.Name())
+		}
+	}
+
+	retained := int(*e.Config.RetainedSnapshots)
+	if len(snapshots) <= retained {
+		return nil
+	}
+
+	sort.Strings(snapshots)
+	for _, fileName := range snapshots[:len(snapshots)-retained] {
+		if err := os.Remove(filepath.Join(e.Config.ExportDirectory, fileName)); err != nil {
+			return fmt.Errorf("failed removing snapshot file %q: %w", fileName, err)
+		}
+		e.Log.Info("Removed old garden snapshot", "fileName", fileName)
+	}
+
+	return nil
+}
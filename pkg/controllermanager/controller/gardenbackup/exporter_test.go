@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardenbackup_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/gardenbackup"
+	"github.com/gardener/gardener/pkg/utils/gardener/backup"
+)
+
+var _ = Describe("Exporter", func() {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+
+		exportDirectory   string
+		encryptionKey     = []byte("01234567890123456789012345678901")
+		encryptionKeyFile string
+
+		exporter *Exporter
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		DeferCleanup(cancel)
+
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC))
+
+		exportDirectory = GinkgoT().TempDir()
+		encryptionKeyFile = filepath.Join(GinkgoT().TempDir(), "key")
+		Expect(os.WriteFile(encryptionKeyFile, encryptionKey, 0o600)).To(Succeed())
+
+		exporter = &Exporter{
+			Client: fakeClient,
+			Config: controllermanagerconfigv1alpha1.GardenBackupControllerConfiguration{
+				SyncPeriod:        &metav1.Duration{Duration: 50 * time.Millisecond},
+				ExportDirectory:   exportDirectory,
+				EncryptionKeyFile: encryptionKeyFile,
+				RetainedSnapshots: ptr.To[int32](2),
+			},
+			Clock: fakeClock,
+			Log:   logr.Discard(),
+		}
+	})
+
+	start := func() {
+		go func() {
+			defer GinkgoRecover()
+			Expect(exporter.Start(ctx)).To(Succeed())
+		}()
+	}
+
+	It("should write a decodable snapshot to the export directory", func() {
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.Project{ObjectMeta: metav1.ObjectMeta{Name: "test-project"}})).To(Succeed())
+
+		start()
+
+		fileName := filepath.Join(exportDirectory, "garden-20240501-120000.snapshot")
+		Eventually(func() error {
+			_, err := os.Stat(fileName)
+			return err
+		}).Should(Succeed())
+		cancel()
+
+		data, err := os.ReadFile(fileName)
+		Expect(err).NotTo(HaveOccurred())
+
+		snapshot, err := backup.Decode(data, encryptionKey)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(snapshot.Projects).To(HaveLen(1))
+	})
+
+	It("should prune the oldest snapshots beyond the configured retention", func() {
+		Expect(os.WriteFile(filepath.Join(exportDirectory, "garden-20240401-120000.snapshot"), []byte("old"), 0o600)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(exportDirectory, "garden-20240402-120000.snapshot"), []byte("old"), 0o600)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(exportDirectory, "unrelated-file"), []byte("keep"), 0o600)).To(Succeed())
+
+		start()
+
+		Eventually(func() error {
+			_, err := os.Stat(filepath.Join(exportDirectory, "garden-20240501-120000.snapshot"))
+			return err
+		}).Should(Succeed())
+		Eventually(func() error {
+			_, err := os.Stat(filepath.Join(exportDirectory, "garden-20240401-120000.snapshot"))
+			return err
+		}).Should(MatchError(os.IsNotExist, "IsNotExist"))
+		cancel()
+
+		Expect(filepath.Join(exportDirectory, "garden-20240402-120000.snapshot")).To(BeAnExistingFile())
+		Expect(filepath.Join(exportDirectory, "unrelated-file")).To(BeAnExistingFile())
+	})
+})
@@ -7,6 +7,7 @@ package gardenletlifecycle
 import (
 	"context"
 	"fmt"
+	"time"
 
 	coordinationv1 "k8s.io/api/coordination/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -21,6 +22,7 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllermanager/metrics"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/gardener/gardenlet"
@@ -67,6 +69,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, req Request) (reconcile.Resu
 
 	if lease.Spec.RenewTime != nil {
 		if lease.Spec.RenewTime.UTC().Add(r.Config.MonitorPeriod.Duration).After(r.Clock.Now().UTC()) {
+			if !req.IsSelfHostedShoot {
+				if err := r.closeDowntimeWindow(ctx, obj.(*gardencorev1beta1.Seed)); err != nil {
+					return reconcile.Result{}, err
+				}
+			}
 			return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
 		}
 
@@ -88,12 +95,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, req Request) (reconcile.Resu
 	if conditionGardenletReady != nil {
 		bldr.WithOldCondition(*conditionGardenletReady)
 	}
+	wasUnknown := conditionGardenletReady != nil && conditionGardenletReady.Status == gardencorev1beta1.ConditionUnknown
 
 	bldr.WithStatus(gardencorev1beta1.ConditionUnknown)
 	bldr.WithReason("StatusUnknown")
 	bldr.WithMessage("Gardenlet stopped posting status updates.")
 	if newCondition, update := bldr.WithClock(r.Clock).Build(); update {
 		setConditions(obj, v1beta1helper.MergeConditions(conditions(obj), newCondition))
+		if seed, ok := obj.(*gardencorev1beta1.Seed); ok && !wasUnknown {
+			openDowntimeWindow(seed, r.Clock.Now())
+		}
 		if err := r.Client.Status().Update(ctx, obj); err != nil {
 			return reconcile.Result{}, err
 		}
@@ -194,6 +205,41 @@ func setShootStatusToUnknown(ctx context.Context, clock clock.Clock, c client.St
 	return c.Status().Patch(ctx, shoot, patch)
 }
 
+// openDowntimeWindow records the begin of a new downtime window in the seed's availability history and updates the
+// availability metrics.
+func openDowntimeWindow(seed *gardencorev1beta1.Seed, now time.Time) {
+	if seed.Status.Availability == nil {
+		seed.Status.Availability = &gardencorev1beta1.SeedAvailability{}
+	}
+
+	seed.Status.Availability.DowntimeWindows++
+	seed.Status.Availability.LastDowntimeBegin = &metav1.Time{Time: now.UTC()}
+	seed.Status.Availability.LastDowntimeEnd = nil
+
+	metrics.SeedDowntimeWindows.WithLabelValues(seed.Name).Set(float64(seed.Status.Availability.DowntimeWindows))
+}
+
+// closeDowntimeWindow closes an open downtime window in the seed's availability history, if any, and updates the
+// availability metrics.
+func (r *Reconciler) closeDowntimeWindow(ctx context.Context, seed *gardencorev1beta1.Seed) error {
+	availability := seed.Status.Availability
+	if availability == nil || availability.LastDowntimeBegin == nil || availability.LastDowntimeEnd != nil {
+		return nil
+	}
+
+	now := r.Clock.Now().UTC()
+	patch := client.MergeFrom(seed.DeepCopy())
+	availability.LastDowntimeEnd = &metav1.Time{Time: now}
+	availability.AccumulatedDowntimeSeconds += int64(now.Sub(availability.LastDowntimeBegin.Time).Seconds())
+	if err := r.Client.Status().Patch(ctx, seed, patch); err != nil {
+		return err
+	}
+
+	metrics.SeedDowntimeWindows.WithLabelValues(seed.Name).Set(float64(availability.DowntimeWindows))
+	metrics.SeedDowntimeSeconds.WithLabelValues(seed.Name).Set(float64(availability.AccumulatedDowntimeSeconds))
+	return nil
+}
+
 func conditionMapToConditions(m map[gardencorev1beta1.ConditionType]gardencorev1beta1.Condition) []gardencorev1beta1.Condition {
 	output := make([]gardencorev1beta1.Condition, 0, len(m))
 
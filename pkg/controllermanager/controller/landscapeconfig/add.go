@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package landscapeconfig
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/gardener/gardener/pkg/utils/oci"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "landscape-config"
+
+// AddToManager adds the syncer to the given manager.
+func (s *Syncer) AddToManager(mgr manager.Manager) error {
+	if s.Client == nil {
+		s.Client = mgr.GetClient()
+	}
+	if s.Registry == nil {
+		s.Registry = oci.NewHelmRegistry(mgr.GetClient())
+	}
+	if s.Recorder == nil {
+		s.Recorder = mgr.GetEventRecorderFor(ControllerName + "-controller")
+	}
+	if s.Log.GetSink() == nil {
+		s.Log = mgr.GetLogger().WithName(ControllerName)
+	}
+
+	return mgr.Add(s)
+}
@@ -0,0 +1,244 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package landscapeconfig
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/oci"
+)
+
+const (
+	// EventConfigurationDrift is an event reason to describe that a resource differs from the declared landscape
+	// configuration.
+	EventConfigurationDrift = "ConfigurationDrift"
+	// EventConfigurationApplied is an event reason to describe that the declared landscape configuration was applied
+	// to a resource.
+	EventConfigurationApplied = "ConfigurationApplied"
+)
+
+// supportedKinds is the set of resource kinds which may be declared in the landscape configuration.
+var supportedKinds = map[schema.GroupVersionKind]struct{}{
+	gardencorev1beta1.SchemeGroupVersion.WithKind("CloudProfile"):           {},
+	gardencorev1beta1.SchemeGroupVersion.WithKind("ControllerRegistration"): {},
+	gardencorev1beta1.SchemeGroupVersion.WithKind("ExposureClass"):          {},
+	gardencorev1beta1.SchemeGroupVersion.WithKind("Seed"):                   {},
+	gardencorev1.SchemeGroupVersion.WithKind("ControllerDeployment"):        {},
+}
+
+// Syncer periodically syncs the landscape configuration declared in the configured OCI artifact with the garden
+// cluster and reports drift.
+type Syncer struct {
+	Client   client.Client
+	Config   controllermanagerconfigv1alpha1.LandscapeConfigControllerConfiguration
+	Registry oci.Interface
+	Recorder record.EventRecorder
+	Log      logr.Logger
+}
+
+// Start runs the syncer until the given context is cancelled.
+func (s *Syncer) Start(ctx context.Context) error {
+	wait.Until(func() {
+		if err := s.Sync(ctx); err != nil {
+			s.Log.Error(err, "Failed syncing landscape configuration")
+		}
+	}, s.Config.SyncPeriod.Duration, ctx.Done())
+
+	return nil
+}
+
+// Sync pulls the declared landscape configuration and syncs it with the garden cluster.
+func (s *Syncer) Sync(ctx context.Context) error {
+	blob, err := s.Registry.Pull(ctx, &s.Config.OCIRepository)
+	if err != nil {
+		return fmt.Errorf("failed pulling landscape configuration artifact: %w", err)
+	}
+
+	objects, err := decodeManifests(blob)
+	if err != nil {
+		return err
+	}
+
+	for _, obj := range objects {
+		if err := s.syncObject(ctx, obj); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Syncer) syncObject(ctx context.Context, desired client.Object) error {
+	var (
+		log = s.Log.WithValues("kind", desired.GetObjectKind().GroupVersionKind().Kind, "object", client.ObjectKeyFromObject(desired))
+
+		live = desired.DeepCopyObject().(client.Object)
+	)
+
+	if err := s.Client.Get(ctx, client.ObjectKeyFromObject(desired), live); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed getting %T %s: %w", desired, client.ObjectKeyFromObject(desired), err)
+		}
+
+		s.Recorder.Event(desired, corev1.EventTypeWarning, EventConfigurationDrift, "Resource is declared in the landscape configuration but does not exist")
+		if ptr.Deref(s.Config.DryRun, false) {
+			log.Info("Resource is declared in the landscape configuration but does not exist (dry run)")
+			return nil
+		}
+
+		if err := s.Client.Create(ctx, desired); err != nil {
+			return fmt.Errorf("failed creating %T %s: %w", desired, client.ObjectKeyFromObject(desired), err)
+		}
+
+		log.Info("Created resource from declared landscape configuration")
+		s.Recorder.Event(desired, corev1.EventTypeNormal, EventConfigurationApplied, "Resource was created from the declared landscape configuration")
+		return nil
+	}
+
+	drifted, err := hasDrift(live, desired)
+	if err != nil {
+		return err
+	}
+	if !drifted {
+		return nil
+	}
+
+	s.Recorder.Event(live, corev1.EventTypeWarning, EventConfigurationDrift, "Resource differs from the declared landscape configuration")
+	if ptr.Deref(s.Config.DryRun, false) {
+		log.Info("Resource differs from the declared landscape configuration (dry run)")
+		return nil
+	}
+
+	desired.SetResourceVersion(live.GetResourceVersion())
+	desired.SetUID(live.GetUID())
+	desired.SetFinalizers(live.GetFinalizers())
+
+	if err := s.Client.Update(ctx, desired); err != nil {
+		return fmt.Errorf("failed updating %T %s: %w", desired, client.ObjectKeyFromObject(desired), err)
+	}
+
+	log.Info("Applied declared landscape configuration to resource")
+	s.Recorder.Event(desired, corev1.EventTypeNormal, EventConfigurationApplied, "Resource was updated to match the declared landscape configuration")
+	return nil
+}
+
+// hasDrift returns true if the live object differs from the desired object in any field except the metadata and the
+// status.
+func hasDrift(live, desired client.Object) (bool, error) {
+	liveContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(live)
+	if err != nil {
+		return false, fmt.Errorf("failed converting live object to unstructured: %w", err)
+	}
+
+	desiredContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(desired)
+	if err != nil {
+		return false, fmt.Errorf("failed converting desired object to unstructured: %w", err)
+	}
+
+	for _, content := range []map[string]any{liveContent, desiredContent} {
+		delete(content, "apiVersion")
+		delete(content, "kind")
+		delete(content, "metadata")
+		delete(content, "status")
+	}
+
+	return !apiequality.Semantic.DeepEqual(liveContent, desiredContent), nil
+}
+
+// decodeManifests decodes the given gzipped tarball into the declared objects. Only the kinds listed in supportedKinds
+// may be declared.
+func decodeManifests(blob []byte) ([]client.Object, error) {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(blob))
+	if err != nil {
+		return nil, fmt.Errorf("failed creating gzip reader: %w", err)
+	}
+
+	var (
+		tarReader = tar.NewReader(gzipReader)
+		objects   []client.Object
+	)
+
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed reading tarball: %w", err)
+		}
+
+		if header.Typeflag != tar.TypeReg || (!strings.HasSuffix(header.Name, ".yaml") && !strings.HasSuffix(header.Name, ".yml")) {
+			continue
+		}
+
+		decoded, err := decodeManifestFile(tarReader, header.Name)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, decoded...)
+	}
+
+	return objects, nil
+}
+
+func decodeManifestFile(r io.Reader, fileName string) ([]client.Object, error) {
+	var (
+		yamlReader = utilyaml.NewYAMLReader(bufio.NewReader(r))
+		objects    []client.Object
+	)
+
+	for {
+		doc, err := yamlReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed reading manifest file %q: %w", fileName, err)
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+
+		obj, gvk, err := kubernetes.GardenCodec.UniversalDeserializer().Decode(doc, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed decoding manifest in file %q: %w", fileName, err)
+		}
+
+		if _, ok := supportedKinds[*gvk]; !ok {
+			return nil, fmt.Errorf("unsupported kind %s declared in file %q", gvk, fileName)
+		}
+
+		clientObject, ok := obj.(client.Object)
+		if !ok {
+			return nil, fmt.Errorf("cannot use object of kind %s declared in file %q as client.Object", gvk, fileName)
+		}
+		objects = append(objects, clientObject)
+	}
+
+	return objects, nil
+}
@@ -0,0 +1,213 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package landscapeconfig_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/landscapeconfig"
+	ocifake "github.com/gardener/gardener/pkg/utils/oci/fake"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Syncer", func() {
+	var (
+		ctx = context.TODO()
+
+		fakeClient   client.Client
+		fakeRegistry *ocifake.Registry
+		recorder     *record.FakeRecorder
+
+		ociRepository gardencorev1.OCIRepository
+
+		syncer *Syncer
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		fakeRegistry = ocifake.NewRegistry()
+		recorder = record.NewFakeRecorder(8)
+
+		ociRepository = gardencorev1.OCIRepository{Ref: ptr.To("registry.example.com/landscape:v1")}
+
+		syncer = &Syncer{
+			Client: fakeClient,
+			Config: controllermanagerconfigv1alpha1.LandscapeConfigControllerConfiguration{
+				SyncPeriod:    &metav1.Duration{Duration: time.Hour},
+				OCIRepository: ociRepository,
+			},
+			Registry: fakeRegistry,
+			Recorder: recorder,
+			Log:      logr.Discard(),
+		}
+	})
+
+	addArtifact := func(manifests ...string) {
+		var buffer bytes.Buffer
+
+		gzipWriter := gzip.NewWriter(&buffer)
+		tarWriter := tar.NewWriter(gzipWriter)
+
+		for i, manifest := range manifests {
+			ExpectWithOffset(1, tarWriter.WriteHeader(&tar.Header{
+				Name:     fmt.Sprintf("manifest-%d.yaml", i),
+				Typeflag: tar.TypeReg,
+				Mode:     0o600,
+				Size:     int64(len(manifest)),
+			})).To(Succeed())
+			_, err := tarWriter.Write([]byte(manifest))
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		}
+
+		ExpectWithOffset(1, tarWriter.Close()).To(Succeed())
+		ExpectWithOffset(1, gzipWriter.Close()).To(Succeed())
+
+		fakeRegistry.AddArtifact(&ociRepository, buffer.Bytes())
+	}
+
+	expectEvent := func(substring string) {
+		EventuallyWithOffset(1, recorder.Events).Should(Receive(ContainSubstring(substring)))
+	}
+
+	expectNoEvent := func() {
+		ConsistentlyWithOffset(1, recorder.Events).ShouldNot(Receive())
+	}
+
+	It("should create resources which are declared but do not exist", func() {
+		addArtifact(`apiVersion: core.gardener.cloud/v1beta1
+kind: CloudProfile
+metadata:
+  name: test-profile
+spec:
+  type: test
+`)
+
+		Expect(syncer.Sync(ctx)).To(Succeed())
+
+		cloudProfile := &gardencorev1beta1.CloudProfile{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "test-profile"}, cloudProfile)).To(Succeed())
+		Expect(cloudProfile.Spec.Type).To(Equal("test"))
+
+		expectEvent("ConfigurationDrift")
+		expectEvent("ConfigurationApplied")
+	})
+
+	It("should do nothing when the resources match the declared configuration", func() {
+		addArtifact(`apiVersion: core.gardener.cloud/v1beta1
+kind: CloudProfile
+metadata:
+  name: test-profile
+spec:
+  type: test
+`)
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-profile"},
+			Spec:       gardencorev1beta1.CloudProfileSpec{Type: "test"},
+		})).To(Succeed())
+
+		Expect(syncer.Sync(ctx)).To(Succeed())
+
+		expectNoEvent()
+	})
+
+	It("should report drift and update the resource", func() {
+		addArtifact(`apiVersion: core.gardener.cloud/v1beta1
+kind: CloudProfile
+metadata:
+  name: test-profile
+spec:
+  type: test
+`)
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-profile", Finalizers: []string{"gardener"}},
+			Spec:       gardencorev1beta1.CloudProfileSpec{Type: "changed"},
+		})).To(Succeed())
+
+		Expect(syncer.Sync(ctx)).To(Succeed())
+
+		cloudProfile := &gardencorev1beta1.CloudProfile{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "test-profile"}, cloudProfile)).To(Succeed())
+		Expect(cloudProfile.Spec.Type).To(Equal("test"))
+		Expect(cloudProfile.Finalizers).To(ConsistOf("gardener"))
+
+		expectEvent("ConfigurationDrift")
+		expectEvent("ConfigurationApplied")
+	})
+
+	It("should only report drift in dry run mode", func() {
+		syncer.Config.DryRun = ptr.To(true)
+
+		addArtifact(`apiVersion: core.gardener.cloud/v1beta1
+kind: CloudProfile
+metadata:
+  name: test-profile
+spec:
+  type: test
+`)
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-profile"},
+			Spec:       gardencorev1beta1.CloudProfileSpec{Type: "changed"},
+		})).To(Succeed())
+
+		Expect(syncer.Sync(ctx)).To(Succeed())
+
+		cloudProfile := &gardencorev1beta1.CloudProfile{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "test-profile"}, cloudProfile)).To(Succeed())
+		Expect(cloudProfile.Spec.Type).To(Equal("changed"))
+
+		expectEvent("ConfigurationDrift")
+	})
+
+	It("should not create resources in dry run mode", func() {
+		syncer.Config.DryRun = ptr.To(true)
+
+		addArtifact(`apiVersion: core.gardener.cloud/v1beta1
+kind: CloudProfile
+metadata:
+  name: test-profile
+spec:
+  type: test
+`)
+
+		Expect(syncer.Sync(ctx)).To(Succeed())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "test-profile"}, &gardencorev1beta1.CloudProfile{})).To(BeNotFoundError())
+
+		expectEvent("ConfigurationDrift")
+	})
+
+	It("should fail for unsupported kinds", func() {
+		addArtifact(`apiVersion: core.gardener.cloud/v1beta1
+kind: Shoot
+metadata:
+  name: test-shoot
+  namespace: garden-test
+`)
+
+		Expect(syncer.Sync(ctx)).To(MatchError(ContainSubstring("unsupported kind")))
+	})
+
+	It("should fail when the artifact cannot be pulled", func() {
+		Expect(syncer.Sync(ctx)).To(MatchError(ContainSubstring("failed pulling landscape configuration artifact")))
+	})
+})
@@ -0,0 +1,25 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package landscapemetrics
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "landscape-metrics"
+
+// AddToManager adds the collector to the given manager.
+func (c *Collector) AddToManager(mgr manager.Manager) error {
+	if c.Client == nil {
+		c.Client = mgr.GetClient()
+	}
+	if c.Log.GetSink() == nil {
+		c.Log = mgr.GetLogger().WithName(ControllerName)
+	}
+
+	return runtimemetrics.Registry.Register(c)
+}
@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package landscapemetrics
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/metrics"
+)
+
+var (
+	shootsDesc = prometheus.NewDesc(
+		metrics.Namespace+"_landscape_shoots",
+		"Number of shoots per last operation state, seed, Kubernetes version, and purpose.",
+		[]string{"state", "seed", "version", "purpose"},
+		nil,
+	)
+	projectsDesc = prometheus.NewDesc(
+		metrics.Namespace+"_landscape_projects",
+		"Number of projects per phase.",
+		[]string{"phase"},
+		nil,
+	)
+	seedCapacityDesc = prometheus.NewDesc(
+		metrics.Namespace+"_landscape_seed_capacity",
+		"Reported capacity of a seed per resource.",
+		[]string{"seed", "resource"},
+		nil,
+	)
+	seedAllocatableDesc = prometheus.NewDesc(
+		metrics.Namespace+"_landscape_seed_allocatable",
+		"Reported allocatable of a seed per resource.",
+		[]string{"seed", "resource"},
+		nil,
+	)
+	rotationDueDesc = prometheus.NewDesc(
+		metrics.Namespace+"_landscape_shoots_credentials_rotation_due",
+		"Number of shoots per credential whose rotation is due, i.e. it was either never initiated or its completion is still outstanding.",
+		[]string{"credential"},
+		nil,
+	)
+)
+
+// Collector computes aggregated landscape metrics from the shoots, projects, and seeds in the garden cluster at scrape
+// time. It reads from the manager's cache, so no additional watches or caches are required.
+type Collector struct {
+	Client client.Reader
+	Log    logr.Logger
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- shootsDesc
+	ch <- projectsDesc
+	ch <- seedCapacityDesc
+	ch <- seedAllocatableDesc
+	ch <- rotationDueDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	ctx := context.Background()
+
+	c.collectShoots(ctx, ch)
+	c.collectProjects(ctx, ch)
+	c.collectSeeds(ctx, ch)
+}
+
+type shootKey struct {
+	state   string
+	seed    string
+	version string
+	purpose string
+}
+
+func (c *Collector) collectShoots(ctx context.Context, ch chan<- prometheus.Metric) {
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := c.Client.List(ctx, shootList); err != nil {
+		c.Log.Error(err, "Failed listing shoots for landscape metrics")
+		return
+	}
+
+	var (
+		shoots      = map[shootKey]int{}
+		rotationDue = map[string]int{}
+	)
+
+	for _, shoot := range shootList.Items {
+		state := "Unknown"
+		if shoot.Status.LastOperation != nil {
+			state = string(shoot.Status.LastOperation.State)
+		}
+
+		shoots[shootKey{
+			state:   state,
+			seed:    ptr.Deref(shoot.Spec.SeedName, ""),
+			version: shoot.Spec.Kubernetes.Version,
+			purpose: string(ptr.Deref(shoot.Spec.Purpose, gardencorev1beta1.ShootPurposeEvaluation)),
+		}]++
+
+		for credential, status := range rotationStatuses(&shoot) {
+			if status.lastInitiationTime == nil || status.phase == gardencorev1beta1.RotationPrepared {
+				rotationDue[credential]++
+			}
+		}
+	}
+
+	for key, count := range shoots {
+		ch <- prometheus.MustNewConstMetric(shootsDesc, prometheus.GaugeValue, float64(count), key.state, key.seed, key.version, key.purpose)
+	}
+	for credential, count := range rotationDue {
+		ch <- prometheus.MustNewConstMetric(rotationDueDesc, prometheus.GaugeValue, float64(count), credential)
+	}
+}
+
+func (c *Collector) collectProjects(ctx context.Context, ch chan<- prometheus.Metric) {
+	projectList := &gardencorev1beta1.ProjectList{}
+	if err := c.Client.List(ctx, projectList); err != nil {
+		c.Log.Error(err, "Failed listing projects for landscape metrics")
+		return
+	}
+
+	projects := map[string]int{}
+	for _, project := range projectList.Items {
+		phase := string(project.Status.Phase)
+		if phase == "" {
+			phase = string(gardencorev1beta1.ProjectPending)
+		}
+		projects[phase]++
+	}
+
+	for phase, count := range projects {
+		ch <- prometheus.MustNewConstMetric(projectsDesc, prometheus.GaugeValue, float64(count), phase)
+	}
+}
+
+func (c *Collector) collectSeeds(ctx context.Context, ch chan<- prometheus.Metric) {
+	seedList := &gardencorev1beta1.SeedList{}
+	if err := c.Client.List(ctx, seedList); err != nil {
+		c.Log.Error(err, "Failed listing seeds for landscape metrics")
+		return
+	}
+
+	for _, seed := range seedList.Items {
+		for resourceName, quantity := range seed.Status.Capacity {
+			ch <- prometheus.MustNewConstMetric(seedCapacityDesc, prometheus.GaugeValue, quantity.AsApproximateFloat64(), seed.Name, string(resourceName))
+		}
+		for resourceName, quantity := range seed.Status.Allocatable {
+			ch <- prometheus.MustNewConstMetric(seedAllocatableDesc, prometheus.GaugeValue, quantity.AsApproximateFloat64(), seed.Name, string(resourceName))
+		}
+	}
+}
+
+// rotationStatus is the subset of the per-credential rotation information relevant for the rotation due metric.
+type rotationStatus struct {
+	lastInitiationTime *metav1.Time
+	phase              gardencorev1beta1.CredentialsRotationPhase
+}
+
+// rotationStatuses normalizes the differently typed per-credential rotation information of a shoot. Credentials which
+// were never rotated yield a zero rotationStatus.
+func rotationStatuses(shoot *gardencorev1beta1.Shoot) map[string]rotationStatus {
+	statuses := map[string]rotationStatus{
+		"certificateAuthorities": {},
+		"serviceAccountKey":      {},
+		"etcdEncryptionKey":      {},
+		"sshKeypair":             {},
+		"observability":          {},
+	}
+
+	if shoot.Status.Credentials == nil || shoot.Status.Credentials.Rotation == nil {
+		return statuses
+	}
+	rotation := shoot.Status.Credentials.Rotation
+
+	if v := rotation.CertificateAuthorities; v != nil {
+		statuses["certificateAuthorities"] = rotationStatus{lastInitiationTime: v.LastInitiationTime, phase: v.Phase}
+	}
+	if v := rotation.ServiceAccountKey; v != nil {
+		statuses["serviceAccountKey"] = rotationStatus{lastInitiationTime: v.LastInitiationTime, phase: v.Phase}
+	}
+	if v := rotation.ETCDEncryptionKey; v != nil {
+		statuses["etcdEncryptionKey"] = rotationStatus{lastInitiationTime: v.LastInitiationTime, phase: v.Phase}
+	}
+	if v := rotation.SSHKeypair; v != nil {
+		statuses["sshKeypair"] = rotationStatus{lastInitiationTime: v.LastInitiationTime}
+	}
+	if v := rotation.Observability; v != nil {
+		statuses["observability"] = rotationStatus{lastInitiationTime: v.LastInitiationTime}
+	}
+
+	return statuses
+}
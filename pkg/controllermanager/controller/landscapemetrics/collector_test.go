@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package landscapemetrics_test
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/landscapemetrics"
+)
+
+var _ = Describe("Collector", func() {
+	var (
+		ctx = context.TODO()
+
+		fakeClient client.Client
+		collector  *Collector
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		collector = &Collector{Client: fakeClient, Log: logr.Discard()}
+	})
+
+	It("should aggregate shoots by state, seed, version, and purpose", func() {
+		now := metav1.Now()
+		rotation := &gardencorev1beta1.ShootCredentials{Rotation: &gardencorev1beta1.ShootCredentialsRotation{
+			CertificateAuthorities: &gardencorev1beta1.CARotation{Phase: gardencorev1beta1.RotationCompleted, LastInitiationTime: &now},
+			ServiceAccountKey:      &gardencorev1beta1.ServiceAccountKeyRotation{Phase: gardencorev1beta1.RotationCompleted, LastInitiationTime: &now},
+			ETCDEncryptionKey:      &gardencorev1beta1.ETCDEncryptionKeyRotation{Phase: gardencorev1beta1.RotationCompleted, LastInitiationTime: &now},
+			SSHKeypair:             &gardencorev1beta1.ShootSSHKeypairRotation{LastInitiationTime: &now},
+			Observability:          &gardencorev1beta1.ObservabilityRotation{LastInitiationTime: &now},
+		}}
+
+		createShoot(ctx, fakeClient, "foo", "seed-1", "1.31.2", gardencorev1beta1.ShootPurposeProduction, gardencorev1beta1.LastOperationStateSucceeded, rotation)
+		createShoot(ctx, fakeClient, "bar", "seed-1", "1.31.2", gardencorev1beta1.ShootPurposeProduction, gardencorev1beta1.LastOperationStateSucceeded, rotation)
+		createShoot(ctx, fakeClient, "baz", "seed-2", "1.32.0", "", gardencorev1beta1.LastOperationStateError, rotation)
+
+		Expect(testutil.CollectAndCompare(collector, strings.NewReader(`
+# HELP gardener_controller_manager_landscape_shoots Number of shoots per last operation state, seed, Kubernetes version, and purpose.
+# TYPE gardener_controller_manager_landscape_shoots gauge
+gardener_controller_manager_landscape_shoots{purpose="evaluation",seed="seed-2",state="Error",version="1.32.0"} 1
+gardener_controller_manager_landscape_shoots{purpose="production",seed="seed-1",state="Succeeded",version="1.31.2"} 2
+`), "gardener_controller_manager_landscape_shoots")).To(Succeed())
+	})
+
+	It("should count shoots whose credentials rotation is due", func() {
+		now := metav1.Now()
+
+		// never rotated at all
+		createShoot(ctx, fakeClient, "foo", "seed-1", "1.31.2", gardencorev1beta1.ShootPurposeProduction, gardencorev1beta1.LastOperationStateSucceeded, nil)
+		// CA rotation prepared but not completed, others never initiated
+		createShoot(ctx, fakeClient, "bar", "seed-1", "1.31.2", gardencorev1beta1.ShootPurposeProduction, gardencorev1beta1.LastOperationStateSucceeded, &gardencorev1beta1.ShootCredentials{
+			Rotation: &gardencorev1beta1.ShootCredentialsRotation{
+				CertificateAuthorities: &gardencorev1beta1.CARotation{Phase: gardencorev1beta1.RotationPrepared, LastInitiationTime: &now},
+				ServiceAccountKey:      &gardencorev1beta1.ServiceAccountKeyRotation{Phase: gardencorev1beta1.RotationCompleted, LastInitiationTime: &now},
+				ETCDEncryptionKey:      &gardencorev1beta1.ETCDEncryptionKeyRotation{Phase: gardencorev1beta1.RotationCompleted, LastInitiationTime: &now},
+				SSHKeypair:             &gardencorev1beta1.ShootSSHKeypairRotation{LastInitiationTime: &now},
+				Observability:          &gardencorev1beta1.ObservabilityRotation{LastInitiationTime: &now},
+			},
+		})
+
+		Expect(testutil.CollectAndCompare(collector, strings.NewReader(`
+# HELP gardener_controller_manager_landscape_shoots_credentials_rotation_due Number of shoots per credential whose rotation is due, i.e. it was either never initiated or its completion is still outstanding.
+# TYPE gardener_controller_manager_landscape_shoots_credentials_rotation_due gauge
+gardener_controller_manager_landscape_shoots_credentials_rotation_due{credential="certificateAuthorities"} 2
+gardener_controller_manager_landscape_shoots_credentials_rotation_due{credential="etcdEncryptionKey"} 1
+gardener_controller_manager_landscape_shoots_credentials_rotation_due{credential="observability"} 1
+gardener_controller_manager_landscape_shoots_credentials_rotation_due{credential="serviceAccountKey"} 1
+gardener_controller_manager_landscape_shoots_credentials_rotation_due{credential="sshKeypair"} 1
+`), "gardener_controller_manager_landscape_shoots_credentials_rotation_due")).To(Succeed())
+	})
+
+	It("should aggregate projects by phase", func() {
+		for name, phase := range map[string]gardencorev1beta1.ProjectPhase{"foo": gardencorev1beta1.ProjectReady, "bar": gardencorev1beta1.ProjectReady, "baz": ""} {
+			project := &gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Status:     gardencorev1beta1.ProjectStatus{Phase: phase},
+			}
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+		}
+
+		Expect(testutil.CollectAndCompare(collector, strings.NewReader(`
+# HELP gardener_controller_manager_landscape_projects Number of projects per phase.
+# TYPE gardener_controller_manager_landscape_projects gauge
+gardener_controller_manager_landscape_projects{phase="Pending"} 1
+gardener_controller_manager_landscape_projects{phase="Ready"} 2
+`), "gardener_controller_manager_landscape_projects")).To(Succeed())
+	})
+
+	It("should report seed capacity and allocatable", func() {
+		seed := &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: "seed-1"},
+			Status: gardencorev1beta1.SeedStatus{
+				Capacity:    corev1.ResourceList{"shoots": resource.MustParse("250")},
+				Allocatable: corev1.ResourceList{"shoots": resource.MustParse("200")},
+			},
+		}
+		Expect(fakeClient.Create(ctx, seed)).To(Succeed())
+
+		Expect(testutil.CollectAndCompare(collector, strings.NewReader(`
+# HELP gardener_controller_manager_landscape_seed_allocatable Reported allocatable of a seed per resource.
+# TYPE gardener_controller_manager_landscape_seed_allocatable gauge
+gardener_controller_manager_landscape_seed_allocatable{resource="shoots",seed="seed-1"} 200
+# HELP gardener_controller_manager_landscape_seed_capacity Reported capacity of a seed per resource.
+# TYPE gardener_controller_manager_landscape_seed_capacity gauge
+gardener_controller_manager_landscape_seed_capacity{resource="shoots",seed="seed-1"} 250
+`), "gardener_controller_manager_landscape_seed_capacity", "gardener_controller_manager_landscape_seed_allocatable")).To(Succeed())
+	})
+})
+
+func createShoot(ctx context.Context, c client.Client, name, seedName, version string, purpose gardencorev1beta1.ShootPurpose, state gardencorev1beta1.LastOperationState, credentials *gardencorev1beta1.ShootCredentials) {
+	GinkgoHelper()
+
+	shoot := &gardencorev1beta1.Shoot{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-test"},
+		Spec: gardencorev1beta1.ShootSpec{
+			SeedName:   &seedName,
+			Kubernetes: gardencorev1beta1.Kubernetes{Version: version},
+		},
+		Status: gardencorev1beta1.ShootStatus{
+			LastOperation: &gardencorev1beta1.LastOperation{State: state},
+			Credentials:   credentials,
+		},
+	}
+	if purpose != "" {
+		shoot.Spec.Purpose = ptr.To(purpose)
+	}
+
+	Expect(c.Create(ctx, shoot)).To(Succeed())
+}
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -36,6 +38,9 @@ type actuator struct {
 	replicaFactory ReplicaFactory
 	cfg            *controllermanagerconfigv1alpha1.ManagedSeedSetControllerConfiguration
 	recorder       record.EventRecorder
+	// replacementTimes tracks the time of the last automated replica replacement per ManagedSeedSet in order to
+	// rate-limit replacements according to the configured minimum interval.
+	replacementTimes sync.Map
 }
 
 // NewActuator creates and returns a new Actuator with the given parameters.
@@ -182,6 +187,7 @@ const (
 	EventWaitingForManagedSeedRegistered = "WaitingForManagedSeedRegistered"
 	EventWaitingForManagedSeedDeleted    = "WaitingForManagedSeedDeleted"
 	EventWaitingForSeedReady             = "WaitingForSeedReady"
+	EventReplacingReplica                = "ReplacingReplica"
 )
 
 func (a *actuator) reconcileReplica(
@@ -206,6 +212,15 @@ func (a *actuator) reconcileReplica(
 				return false, err
 			}
 			updatePendingReplica(status, r.GetName(), seedmanagementv1alpha1.ShootReconcilingReason, ptr.To(retries+1))
+		} else if a.replicaReplacementAllowed(managedSeedSet) {
+			// The replica has permanently failed, delete its shoot so that it's recreated from the template
+			log.Info("Replacing replica since max retries have been reached", "maxRetries", *a.cfg.MaxShootRetries)
+			a.infoEventf(managedSeedSet, EventReplacingReplica, "Replacing replica %s since max retries have been reached", r.GetFullName())
+			if err := r.DeleteShoot(ctx, a.gardenClient); err != nil {
+				return false, err
+			}
+			a.replacementTimes.Store(client.ObjectKeyFromObject(managedSeedSet), Now().Time)
+			updatePendingReplica(status, r.GetName(), seedmanagementv1alpha1.ShootDeletingReason, nil)
 		} else {
 			log.Info("Not retrying Shoot reconciliation since max retries have been reached", "maxRetries", *a.cfg.MaxShootRetries)
 			a.infoEventf(managedSeedSet, EventNotRetryingShootReconciliation, "Not retrying Shoot %s reconciliation since max retries have been reached", r.GetFullName())
@@ -296,6 +311,19 @@ func (a *actuator) reconcileReplica(
 	return false, nil
 }
 
+// replicaReplacementAllowed returns true if automated replacement of permanently failed replicas is enabled and the
+// minimum interval since the last replacement for the given ManagedSeedSet has elapsed.
+func (a *actuator) replicaReplacementAllowed(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet) bool {
+	replacement := a.cfg.ReplicaReplacement
+	if replacement == nil || !replacement.Enabled {
+		return false
+	}
+	if last, ok := a.replacementTimes.Load(client.ObjectKeyFromObject(managedSeedSet)); ok {
+		return !Now().Time.Before(last.(time.Time).Add(replacement.MinInterval.Duration))
+	}
+	return true
+}
+
 func (a *actuator) createReplica(
 	ctx context.Context,
 	log logr.Logger,
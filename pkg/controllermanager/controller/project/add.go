@@ -11,8 +11,10 @@ import (
 
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project/activity"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/project/orphanednamespace"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project/project"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project/stale"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/project/usage"
 )
 
 // AddToManager adds all Project controllers to the given manager.
@@ -29,11 +31,23 @@ func AddToManager(mgr manager.Manager, cfg controllermanagerconfigv1alpha1.Contr
 		return fmt.Errorf("failed adding main reconciler: %w", err)
 	}
 
+	if err := (&orphanednamespace.Reconciler{
+		Config: *cfg.Controllers.Project,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding orphaned namespace reconciler: %w", err)
+	}
+
 	if err := (&stale.Reconciler{
 		Config: *cfg.Controllers.Project,
 	}).AddToManager(mgr); err != nil {
 		return fmt.Errorf("failed adding stale reconciler: %w", err)
 	}
 
+	if err := (&usage.Reconciler{
+		Config: *cfg.Controllers.Project,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding usage reconciler: %w", err)
+	}
+
 	return nil
 }
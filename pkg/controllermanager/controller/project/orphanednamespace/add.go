@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package orphanednamespace
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "project-orphaned-namespace"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(mgr manager.Manager) error {
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor(ControllerName + "-controller")
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&corev1.Namespace{}, builder.WithPredicates(r.ProjectNamespacePredicate())).
+		Watches(&gardencorev1beta1.Project{}, handler.EnqueueRequestsFromMapFunc(r.MapProjectToNamespace)).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+		}).
+		Complete(r)
+}
+
+// ProjectNamespacePredicate returns a predicate that filters for namespaces labeled as project namespaces.
+func (r *Reconciler) ProjectNamespacePredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return obj.GetLabels()[v1beta1constants.GardenRole] == v1beta1constants.GardenRoleProject
+	})
+}
+
+// MapProjectToNamespace maps a Project to the namespace it references, so that deleting a Project triggers a
+// reconciliation of its former namespace.
+func (r *Reconciler) MapProjectToNamespace(_ context.Context, obj client.Object) []reconcile.Request {
+	project, ok := obj.(*gardencorev1beta1.Project)
+	if !ok || project.Spec.Namespace == nil {
+		return nil
+	}
+
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: *project.Spec.Namespace}}}
+}
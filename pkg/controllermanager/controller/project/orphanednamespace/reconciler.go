@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package orphanednamespace
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllermanager/metrics"
+)
+
+// Reconciler reconciles namespaces labeled as project namespaces, reports namespaces which are not referenced by any
+// Project anymore via events and metrics, and optionally deletes them after a grace period.
+type Reconciler struct {
+	Client   client.Client
+	Config   controllermanagerconfigv1alpha1.ProjectControllerConfiguration
+	Clock    clock.Clock
+	Recorder record.EventRecorder
+}
+
+// Reconcile reconciles namespaces labeled as project namespaces, reports namespaces which are not referenced by any
+// Project anymore via events and metrics, and optionally deletes them after a grace period.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	namespace := &corev1.Namespace{}
+	if err := r.Client.Get(ctx, request.NamespacedName, namespace); err != nil {
+		if apierrors.IsNotFound(err) {
+			metrics.ProjectNamespaceOrphaned.DeleteLabelValues(request.Name)
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	// Namespaces which are being deleted, are not labeled as project namespaces (anymore), or are marked to be kept
+	// after project deletion are not considered orphaned.
+	if namespace.DeletionTimestamp != nil ||
+		namespace.Labels[v1beta1constants.GardenRole] != v1beta1constants.GardenRoleProject ||
+		namespace.Annotations[v1beta1constants.NamespaceKeepAfterProjectDeletion] == "true" {
+		return reconcile.Result{}, r.markNamespaceAsNotOrphaned(ctx, namespace)
+	}
+
+	orphaned, err := r.isNamespaceOrphaned(ctx, namespace)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !orphaned {
+		return reconcile.Result{}, r.markNamespaceAsNotOrphaned(ctx, namespace)
+	}
+
+	return r.reconcileOrphanedNamespace(ctx, log, namespace)
+}
+
+// isNamespaceOrphaned determines whether the given namespace is orphaned, i.e. neither any Project references it in
+// its spec nor does the project in its project name label still exist.
+func (r *Reconciler) isNamespaceOrphaned(ctx context.Context, namespace *corev1.Namespace) (bool, error) {
+	projectList := &gardencorev1beta1.ProjectList{}
+	if err := r.Client.List(ctx, projectList); err != nil {
+		return false, err
+	}
+
+	for _, project := range projectList.Items {
+		if project.Spec.Namespace != nil && *project.Spec.Namespace == namespace.Name {
+			return false, nil
+		}
+		if projectName := namespace.Labels[v1beta1constants.ProjectName]; projectName != "" && project.Name == projectName {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (r *Reconciler) markNamespaceAsNotOrphaned(ctx context.Context, namespace *corev1.Namespace) error {
+	metrics.ProjectNamespaceOrphaned.DeleteLabelValues(namespace.Name)
+
+	if _, ok := namespace.Annotations[v1beta1constants.ProjectNamespaceOrphanedSince]; !ok {
+		return nil
+	}
+
+	patch := client.MergeFrom(namespace.DeepCopy())
+	delete(namespace.Annotations, v1beta1constants.ProjectNamespaceOrphanedSince)
+	return r.Client.Patch(ctx, namespace, patch)
+}
+
+func (r *Reconciler) reconcileOrphanedNamespace(ctx context.Context, log logr.Logger, namespace *corev1.Namespace) (reconcile.Result, error) {
+	metrics.ProjectNamespaceOrphaned.WithLabelValues(namespace.Name).Set(1)
+
+	orphanedSince := r.Clock.Now().UTC()
+	if value, ok := namespace.Annotations[v1beta1constants.ProjectNamespaceOrphanedSince]; ok {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not parse orphaned-since annotation value %q: %w", value, err)
+		}
+		orphanedSince = parsed
+	} else {
+		log.Info("Namespace is labeled as project namespace but no Project references it, marking it as orphaned")
+		patch := client.MergeFrom(namespace.DeepCopy())
+		metav1.SetMetaDataAnnotation(&namespace.ObjectMeta, v1beta1constants.ProjectNamespaceOrphanedSince, orphanedSince.Format(time.RFC3339))
+		if err := r.Client.Patch(ctx, namespace, patch); err != nil {
+			return reconcile.Result{}, err
+		}
+		r.Recorder.Event(namespace, corev1.EventTypeWarning, "OrphanedNamespace", "Namespace is labeled as project namespace but no Project references it")
+	}
+
+	if !ptr.Deref(r.Config.OrphanedNamespaceDeletion, false) {
+		return reconcile.Result{}, nil
+	}
+
+	if remaining := orphanedSince.Add(r.Config.OrphanedNamespaceGracePeriod.Duration).Sub(r.Clock.Now().UTC()); remaining > 0 {
+		log.Info("Namespace is orphaned but the grace period has not passed yet, requeuing", "requeueAfter", remaining)
+		return reconcile.Result{RequeueAfter: remaining}, nil
+	}
+
+	log.Info("Deleting orphaned namespace since the grace period has passed", "gracePeriod", r.Config.OrphanedNamespaceGracePeriod.Duration)
+	r.Recorder.Event(namespace, corev1.EventTypeWarning, "DeletingOrphanedNamespace", "Deleting orphaned namespace since the grace period has passed")
+	return reconcile.Result{}, client.IgnoreNotFound(r.Client.Delete(ctx, namespace))
+}
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package orphanednamespace_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project/orphanednamespace"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testing.FakeClock
+
+		projectName   = "foo"
+		namespaceName = "garden-foo"
+		gracePeriod   = 24 * time.Hour
+
+		project    *gardencorev1beta1.Project
+		namespace  *corev1.Namespace
+		request    reconcile.Request
+		reconciler *Reconciler
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		fakeClock = testing.NewFakeClock(time.Now())
+
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: projectName},
+			Spec: gardencorev1beta1.ProjectSpec{
+				Namespace: ptr.To(namespaceName),
+			},
+		}
+		namespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: namespaceName,
+				Labels: map[string]string{
+					v1beta1constants.GardenRole:  v1beta1constants.GardenRoleProject,
+					v1beta1constants.ProjectName: projectName,
+				},
+			},
+		}
+
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: controllermanagerconfigv1alpha1.ProjectControllerConfiguration{
+				OrphanedNamespaceGracePeriod: &metav1.Duration{Duration: gracePeriod},
+				OrphanedNamespaceDeletion:    ptr.To(false),
+			},
+			Clock:    fakeClock,
+			Recorder: &record.FakeRecorder{},
+		}
+
+		request = reconcile.Request{NamespacedName: types.NamespacedName{Name: namespaceName}}
+	})
+
+	It("should do nothing if a Project references the namespace", func() {
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+		Expect(fakeClient.Create(ctx, namespace)).To(Succeed())
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(namespace), namespace)).To(Succeed())
+		Expect(namespace.Annotations).NotTo(HaveKey(v1beta1constants.ProjectNamespaceOrphanedSince))
+	})
+
+	It("should remove the orphaned-since annotation if a Project references the namespace again", func() {
+		metav1.SetMetaDataAnnotation(&namespace.ObjectMeta, v1beta1constants.ProjectNamespaceOrphanedSince, fakeClock.Now().UTC().Format(time.RFC3339))
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+		Expect(fakeClient.Create(ctx, namespace)).To(Succeed())
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(namespace), namespace)).To(Succeed())
+		Expect(namespace.Annotations).NotTo(HaveKey(v1beta1constants.ProjectNamespaceOrphanedSince))
+	})
+
+	It("should mark the namespace as orphaned but not delete it if deletion is disabled", func() {
+		Expect(fakeClient.Create(ctx, namespace)).To(Succeed())
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(namespace), namespace)).To(Succeed())
+		Expect(namespace.Annotations).To(HaveKeyWithValue(v1beta1constants.ProjectNamespaceOrphanedSince, fakeClock.Now().UTC().Format(time.RFC3339)))
+	})
+
+	It("should requeue an orphaned namespace until the grace period has passed if deletion is enabled", func() {
+		reconciler.Config.OrphanedNamespaceDeletion = ptr.To(true)
+		Expect(fakeClient.Create(ctx, namespace)).To(Succeed())
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{RequeueAfter: gracePeriod}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(namespace), namespace)).To(Succeed())
+		Expect(namespace.Annotations).To(HaveKeyWithValue(v1beta1constants.ProjectNamespaceOrphanedSince, fakeClock.Now().UTC().Format(time.RFC3339)))
+	})
+
+	It("should delete an orphaned namespace once the grace period has passed if deletion is enabled", func() {
+		reconciler.Config.OrphanedNamespaceDeletion = ptr.To(true)
+		metav1.SetMetaDataAnnotation(&namespace.ObjectMeta, v1beta1constants.ProjectNamespaceOrphanedSince, fakeClock.Now().UTC().Format(time.RFC3339))
+		Expect(fakeClient.Create(ctx, namespace)).To(Succeed())
+
+		fakeClock.Step(gracePeriod + time.Minute)
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(namespace), namespace)).To(BeNotFoundError())
+	})
+
+	It("should not consider namespaces marked to be kept after project deletion as orphaned", func() {
+		reconciler.Config.OrphanedNamespaceDeletion = ptr.To(true)
+		metav1.SetMetaDataAnnotation(&namespace.ObjectMeta, v1beta1constants.NamespaceKeepAfterProjectDeletion, "true")
+		Expect(fakeClient.Create(ctx, namespace)).To(Succeed())
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(namespace), namespace)).To(Succeed())
+		Expect(namespace.Annotations).NotTo(HaveKey(v1beta1constants.ProjectNamespaceOrphanedSince))
+	})
+})
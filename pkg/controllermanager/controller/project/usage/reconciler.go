@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package usage
+
+import (
+	"context"
+	"fmt"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// Reconciler aggregates the counts and capacity consumption of the shoots in a project and writes the summary into the
+// Project status.
+type Reconciler struct {
+	Client client.Client
+	Config controllermanagerconfigv1alpha1.ProjectControllerConfiguration
+	Clock  clock.Clock
+}
+
+// Reconcile aggregates the counts and capacity consumption of the shoots in a project and writes the summary into the
+// Project status.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	project := &gardencorev1beta1.Project{}
+	if err := r.Client.Get(ctx, request.NamespacedName, project); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if project.DeletionTimestamp != nil || project.Spec.Namespace == nil {
+		return reconcile.Result{}, nil
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList, client.InNamespace(*project.Spec.Namespace)); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing the shoots in the project namespace: %w", err)
+	}
+
+	usageSummary, err := r.computeUsageSummary(ctx, shootList.Items)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed computing the usage summary: %w", err)
+	}
+	usageSummary.LastUpdateTime = metav1.Time{Time: r.Clock.Now()}
+
+	if !usageSummariesEqual(project.Status.UsageSummary, usageSummary) {
+		patch := client.MergeFrom(project.DeepCopy())
+		project.Status.UsageSummary = usageSummary
+		if err := r.Client.Status().Patch(ctx, project, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed patching the usage summary in the Project status: %w", err)
+		}
+		log.Info("Updated usage summary in Project status")
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.UsageSyncPeriod.Duration}, nil
+}
+
+// computeUsageSummary computes the usage summary from the given shoots. The capacity consumption only accounts for
+// non-hibernated shoots and is based on the maximum size of the worker pools. Worker pools whose machine type is not
+// contained in the shoot's CloudProfile are skipped.
+func (r *Reconciler) computeUsageSummary(ctx context.Context, shoots []gardencorev1beta1.Shoot) (*gardencorev1beta1.ProjectUsageSummary, error) {
+	var (
+		usageSummary = &gardencorev1beta1.ProjectUsageSummary{}
+		cpu          = resource.Quantity{}
+		memory       = resource.Quantity{}
+	)
+
+	for _, shoot := range shoots {
+		usageSummary.Shoots++
+
+		if v1beta1helper.HibernationIsEnabled(&shoot) {
+			usageSummary.HibernatedShoots++
+			continue
+		}
+
+		if len(shoot.Spec.Provider.Workers) == 0 {
+			continue
+		}
+
+		cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, &shoot)
+		if err != nil {
+			return nil, fmt.Errorf("failed reading the CloudProfile referenced by shoot %q: %w", shoot.Name, err)
+		}
+
+		for _, worker := range shoot.Spec.Provider.Workers {
+			usageSummary.Nodes += worker.Maximum
+
+			if machineType, ok := findMachineType(cloudProfile.Spec.MachineTypes, worker.Machine.Type); ok {
+				cpu.Add(multiplyQuantity(machineType.CPU, worker.Maximum))
+				memory.Add(multiplyQuantity(machineType.Memory, worker.Maximum))
+			}
+		}
+	}
+
+	usageSummary.CPU = cpu
+	usageSummary.Memory = memory
+
+	return usageSummary, nil
+}
+
+func findMachineType(machineTypes []gardencorev1beta1.MachineType, name string) (gardencorev1beta1.MachineType, bool) {
+	for _, machineType := range machineTypes {
+		if machineType.Name == name {
+			return machineType, true
+		}
+	}
+	return gardencorev1beta1.MachineType{}, false
+}
+
+func multiplyQuantity(quantity resource.Quantity, multiplier int32) resource.Quantity {
+	res := resource.Quantity{}
+	for i := 0; i < int(multiplier); i++ {
+		res.Add(quantity)
+	}
+	return res
+}
+
+// usageSummariesEqual checks whether the two given usage summaries are semantically equal, ignoring the last update
+// time.
+func usageSummariesEqual(old, new *gardencorev1beta1.ProjectUsageSummary) bool {
+	if old == nil || new == nil {
+		return old == new
+	}
+
+	oldCopy := old.DeepCopy()
+	oldCopy.LastUpdateTime = new.LastUpdateTime
+
+	return apiequality.Semantic.DeepEqual(oldCopy, new)
+}
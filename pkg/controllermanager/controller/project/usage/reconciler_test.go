@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package usage_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	projectusage "github.com/gardener/gardener/pkg/controllermanager/controller/project/usage"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler reconcile.Reconciler
+
+		syncPeriod  = time.Hour
+		projectName = "test"
+		namespace   = "garden-test"
+
+		cloudProfile *gardencorev1beta1.CloudProfile
+		project      *gardencorev1beta1.Project
+		shoot        *gardencorev1beta1.Shoot
+		request      reconcile.Request
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Project{}).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2026, 8, 31, 12, 0, 0, 0, time.UTC))
+
+		reconciler = &projectusage.Reconciler{
+			Client: fakeClient,
+			Config: controllermanagerconfigv1alpha1.ProjectControllerConfiguration{
+				ConcurrentSyncs: ptr.To(1),
+				UsageSyncPeriod: &metav1.Duration{Duration: syncPeriod},
+			},
+			Clock: fakeClock,
+		}
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-profile"},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				MachineTypes: []gardencorev1beta1.MachineType{{
+					Name:   "large",
+					CPU:    resource.MustParse("4"),
+					Memory: resource.MustParse("16Gi"),
+				}},
+			},
+		}
+
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: projectName},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: &namespace},
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-shoot",
+				Namespace: namespace,
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfile: &gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: cloudProfile.Name},
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{{
+						Name:    "worker-a",
+						Machine: gardencorev1beta1.Machine{Type: "large"},
+						Maximum: 3,
+					}},
+				},
+			},
+		}
+
+		Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		request = reconcile.Request{NamespacedName: types.NamespacedName{Name: projectName}}
+	})
+
+	It("should write the usage summary into the Project status", func() {
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(syncPeriod))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		Expect(project.Status.UsageSummary).NotTo(BeNil())
+		Expect(project.Status.UsageSummary.Shoots).To(Equal(int32(1)))
+		Expect(project.Status.UsageSummary.HibernatedShoots).To(BeZero())
+		Expect(project.Status.UsageSummary.Nodes).To(Equal(int32(3)))
+		Expect(project.Status.UsageSummary.CPU.Cmp(resource.MustParse("12"))).To(BeZero())
+		Expect(project.Status.UsageSummary.Memory.Cmp(resource.MustParse("48Gi"))).To(BeZero())
+		Expect(project.Status.UsageSummary.LastUpdateTime.Time).To(Equal(fakeClock.Now().Local()))
+	})
+
+	It("should not account the capacity of hibernated shoots", func() {
+		shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		Expect(project.Status.UsageSummary.Shoots).To(Equal(int32(1)))
+		Expect(project.Status.UsageSummary.HibernatedShoots).To(Equal(int32(1)))
+		Expect(project.Status.UsageSummary.Nodes).To(BeZero())
+		Expect(project.Status.UsageSummary.CPU.IsZero()).To(BeTrue())
+		Expect(project.Status.UsageSummary.Memory.IsZero()).To(BeTrue())
+	})
+
+	It("should skip worker pools with unknown machine types when computing the capacity", func() {
+		shoot.Spec.Provider.Workers[0].Machine.Type = "unknown"
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		Expect(project.Status.UsageSummary.Nodes).To(Equal(int32(3)))
+		Expect(project.Status.UsageSummary.CPU.IsZero()).To(BeTrue())
+		Expect(project.Status.UsageSummary.Memory.IsZero()).To(BeTrue())
+	})
+
+	It("should not patch the status if the usage summary did not change", func() {
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		lastUpdateTime := project.Status.UsageSummary.LastUpdateTime
+
+		fakeClock.Step(time.Hour)
+
+		_, err = reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		Expect(project.Status.UsageSummary.LastUpdateTime).To(Equal(lastUpdateTime))
+	})
+
+	It("should do nothing if the project namespace is not yet set", func() {
+		project.Spec.Namespace = nil
+		Expect(fakeClient.Update(ctx, project)).To(Succeed())
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		Expect(project.Status.UsageSummary).To(BeNil())
+	})
+})
@@ -11,6 +11,7 @@ import (
 
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/conditions"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/costrecommendation"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/hibernation"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/maintenance"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/migration"
@@ -18,6 +19,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/reference"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/retry"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/statuslabel"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/upgradepath"
 )
 
 // AddToManager adds all Shoot controllers to the given manager.
@@ -28,6 +30,14 @@ func AddToManager(mgr manager.Manager, cfg controllermanagerconfigv1alpha1.Contr
 		return fmt.Errorf("failed adding conditions reconciler: %w", err)
 	}
 
+	if config := cfg.Controllers.ShootCostRecommendation; config != nil {
+		if err := (&costrecommendation.Reconciler{
+			Config: *config,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding cost recommendation reconciler: %w", err)
+		}
+	}
+
 	if err := (&hibernation.Reconciler{
 		Config: cfg.Controllers.ShootHibernation,
 	}).AddToManager(mgr); err != nil {
@@ -68,5 +78,13 @@ func AddToManager(mgr manager.Manager, cfg controllermanagerconfigv1alpha1.Contr
 		return fmt.Errorf("failed adding statuslabel reconciler: %w", err)
 	}
 
+	if config := cfg.Controllers.ShootUpgradePath; config != nil {
+		if err := (&upgradepath.Reconciler{
+			Config: *config,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding upgrade path reconciler: %w", err)
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package costrecommendation
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+)
+
+// EventCostSavingRecommendation is the reason for events which carry a cost-saving recommendation.
+const EventCostSavingRecommendation = "CostSavingRecommendation"
+
+// Reconciler analyzes Shoots for cost-saving potential and reports recommendations as events.
+type Reconciler struct {
+	Client   client.Client
+	Config   controllermanagerconfigv1alpha1.ShootCostRecommendationControllerConfiguration
+	Recorder record.EventRecorder
+}
+
+// Reconcile analyzes Shoots for cost-saving potential and reports recommendations as events.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if shoot.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	recommendations := r.computeRecommendations(shoot)
+	if len(recommendations) > 0 {
+		log.Info("Found cost-saving potential", "recommendations", len(recommendations))
+	}
+	for _, recommendation := range recommendations {
+		r.Recorder.Event(shoot, corev1.EventTypeNormal, EventCostSavingRecommendation, recommendation)
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+// computeRecommendations derives cost-saving recommendations from the given shoot's specification. Shoots which are
+// already hibernated do not produce recommendations since they do not consume worker node resources.
+func (r *Reconciler) computeRecommendations(shoot *gardencorev1beta1.Shoot) []string {
+	if v1beta1helper.HibernationIsEnabled(shoot) {
+		return nil
+	}
+
+	var recommendations []string
+
+	if (shoot.Spec.Hibernation == nil || len(shoot.Spec.Hibernation.Schedules) == 0) &&
+		ptr.Deref(shoot.Spec.Purpose, gardencorev1beta1.ShootPurposeEvaluation) != gardencorev1beta1.ShootPurposeProduction {
+		recommendations = append(recommendations, "No hibernation schedule is configured. Consider configuring one in .spec.hibernation.schedules so that the cluster is hibernated while it is not needed.")
+	}
+
+	threshold := ptr.Deref(r.Config.WorkerPoolSizeThreshold, 0)
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if threshold > 0 && worker.Minimum >= threshold && worker.Minimum == worker.Maximum {
+			recommendations = append(recommendations, fmt.Sprintf("Worker pool %q permanently reserves %d node(s) because its minimum equals its maximum. Consider lowering the minimum so that the cluster-autoscaler can remove underutilized nodes.", worker.Name, worker.Minimum))
+		}
+	}
+
+	return recommendations
+}
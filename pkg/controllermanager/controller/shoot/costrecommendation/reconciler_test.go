@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package costrecommendation_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	shootcostrecommendation "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/costrecommendation"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		recorder   *record.FakeRecorder
+		reconciler reconcile.Reconciler
+
+		syncPeriod = 12 * time.Hour
+		namespace  = "test-namespace"
+		shootName  = "test-shoot"
+		shoot      *gardencorev1beta1.Shoot
+		request    reconcile.Request
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		recorder = record.NewFakeRecorder(8)
+
+		reconciler = &shootcostrecommendation.Reconciler{
+			Client: fakeClient,
+			Config: controllermanagerconfigv1alpha1.ShootCostRecommendationControllerConfiguration{
+				ConcurrentSyncs:         ptr.To(1),
+				SyncPeriod:              &metav1.Duration{Duration: syncPeriod},
+				WorkerPoolSizeThreshold: ptr.To[int32](5),
+			},
+			Recorder: recorder,
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      shootName,
+				Namespace: namespace,
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				Purpose: ptr.To(gardencorev1beta1.ShootPurposeDevelopment),
+				Hibernation: &gardencorev1beta1.Hibernation{
+					Schedules: []gardencorev1beta1.HibernationSchedule{{Start: ptr.To("0 20 * * *")}},
+				},
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{{Name: "worker-a", Minimum: 1, Maximum: 3}},
+				},
+			},
+		}
+
+		request = reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: shootName}}
+	})
+
+	expectEvents := func(messages ...string) {
+		for _, message := range messages {
+			Eventually(recorder.Events).Should(Receive(ContainSubstring(message)))
+		}
+		Consistently(recorder.Events).ShouldNot(Receive())
+	}
+
+	It("should not emit any event for a shoot without cost-saving potential", func() {
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		expectEvents()
+	})
+
+	It("should do nothing if the shoot is gone", func() {
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+
+		expectEvents()
+	})
+
+	It("should recommend a hibernation schedule if none is configured", func() {
+		shoot.Spec.Hibernation = nil
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		expectEvents("No hibernation schedule is configured")
+	})
+
+	It("should not recommend a hibernation schedule for production shoots", func() {
+		shoot.Spec.Hibernation = nil
+		shoot.Spec.Purpose = ptr.To(gardencorev1beta1.ShootPurposeProduction)
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		expectEvents()
+	})
+
+	It("should recommend reviewing worker pools which cannot be scaled down", func() {
+		shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "worker-b", Minimum: 5, Maximum: 5})
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		expectEvents(`Worker pool "worker-b" permanently reserves 5 node(s)`)
+	})
+
+	It("should not flag worker pools below the configured threshold", func() {
+		shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "worker-b", Minimum: 4, Maximum: 4})
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		expectEvents()
+	})
+
+	It("should not emit any event for hibernated shoots", func() {
+		shoot.Spec.Hibernation.Enabled = ptr.To(true)
+		shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "worker-b", Minimum: 5, Maximum: 5})
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		expectEvents()
+	})
+
+	It("should do nothing if the shoot is marked for deletion", func() {
+		shoot.Spec.Hibernation = nil
+		shoot.Finalizers = []string{"gardener"}
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		Expect(fakeClient.Delete(ctx, shoot)).To(Succeed())
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+
+		expectEvents()
+	})
+})
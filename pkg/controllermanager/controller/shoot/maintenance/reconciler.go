@@ -533,10 +533,11 @@ func maintainTasks(shoot *gardencorev1beta1.Shoot, config controllermanagerconfi
 		v1beta1constants.ShootTaskDeployInfrastructure,
 		v1beta1constants.ShootTaskDeployDNSRecordInternal,
 		v1beta1constants.ShootTaskDeployDNSRecordExternal,
+		v1beta1constants.ShootTaskDeployDNSRecordManagement,
 		v1beta1constants.ShootTaskDeployDNSRecordIngress,
 	)
 
-	if ptr.Deref(config.EnableShootControlPlaneRestarter, false) {
+	if ptr.Deref(config.EnableShootControlPlaneRestarter, false) && !controlPlaneRestartSkipped(shoot) {
 		controllerutils.AddTasks(shoot.Annotations, v1beta1constants.ShootTaskRestartControlPlanePods)
 	}
 
@@ -545,6 +546,13 @@ func maintainTasks(shoot *gardencorev1beta1.Shoot, config controllermanagerconfi
 	}
 }
 
+// controlPlaneRestartSkipped returns true if the shoot opted out of the control plane pod restarts during maintenance
+// via the respective annotation.
+func controlPlaneRestartSkipped(shoot *gardencorev1beta1.Shoot) bool {
+	skipped, _ := strconv.ParseBool(shoot.Annotations[v1beta1constants.ShootSkipControlPlaneRestart])
+	return skipped
+}
+
 // maintainMachineImages updates the machine images of a Shoot's worker pools if necessary
 func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile) (map[string]updateResult, error) {
 	maintenanceResults := make(map[string]updateResult)
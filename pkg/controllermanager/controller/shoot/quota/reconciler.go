@@ -9,8 +9,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -23,11 +26,26 @@ import (
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
+const (
+	// EventClusterLifetimeExpiring is an event reason for warning events emitted before the cluster lifetime expires.
+	EventClusterLifetimeExpiring = "ClusterLifetimeExpiring"
+	// EventClusterLifetimeExtensionPending is an event reason for events emitted while a requested lifetime extension
+	// awaits its confirmation.
+	EventClusterLifetimeExtensionPending = "ClusterLifetimeExtensionPending"
+	// EventClusterLifetimeExtensionInvalid is an event reason for events emitted when a requested lifetime extension
+	// cannot be parsed.
+	EventClusterLifetimeExtensionInvalid = "ClusterLifetimeExtensionInvalid"
+	// EventClusterLifetimeExtended is an event reason for events emitted when a confirmed lifetime extension has been
+	// applied.
+	EventClusterLifetimeExtended = "ClusterLifetimeExtended"
+)
+
 // Reconciler reconciles Shoots and auto-deletes them if they are bound to a Quota with a configured cluster lifetime.
 type Reconciler struct {
-	Client client.Client
-	Config controllermanagerconfigv1alpha1.ShootQuotaControllerConfiguration
-	Clock  clock.Clock
+	Client   client.Client
+	Config   controllermanagerconfigv1alpha1.ShootQuotaControllerConfiguration
+	Clock    clock.Clock
+	Recorder record.EventRecorder
 }
 
 // Reconcile reconciles Shoots and auto-deletes them if they are bound to a Quota with a configured cluster lifetime.
@@ -84,6 +102,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 			patch := client.MergeFrom(shoot.DeepCopy())
 			delete(shoot.Annotations, v1beta1constants.ShootExpirationTimestamp)
+			delete(shoot.Annotations, v1beta1constants.ShootExpirationWarningLevel)
 			if err := r.Client.Patch(ctx, shoot, patch); err != nil {
 				return reconcile.Result{}, err
 			}
@@ -108,6 +127,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, err
 	}
 
+	expirationTimeParsed, err = r.handleLifetimeExtensionRequest(ctx, log, shoot, expirationTimeParsed, *clusterLifeTime)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := r.warnBeforeExpiration(ctx, shoot, expirationTimeParsed); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	if r.Clock.Now().UTC().After(expirationTimeParsed.UTC()) {
 		log.Info("Shoot cluster lifetime expired, deleting Shoot", "expirationTime", expirationTime)
 
@@ -125,3 +153,72 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
 }
+
+// handleLifetimeExtensionRequest applies a lifetime extension requested via the
+// 'shoot.gardener.cloud/lifetime-extension-requested' annotation once it has been confirmed via the
+// 'confirmation.gardener.cloud/lifetime-extension' annotation. The new expiration time is capped at the maximum
+// lifetime admitted by the referenced quotas. It returns the (possibly extended) expiration time.
+func (r *Reconciler) handleLifetimeExtensionRequest(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, expirationTime time.Time, clusterLifeTime int32) (time.Time, error) {
+	requested, ok := shoot.Annotations[v1beta1constants.ShootLifetimeExtensionRequested]
+	if !ok {
+		return expirationTime, nil
+	}
+
+	extension, err := time.ParseDuration(requested)
+	if err != nil || extension <= 0 {
+		r.Recorder.Eventf(shoot, corev1.EventTypeWarning, EventClusterLifetimeExtensionInvalid, "Requested lifetime extension %q is not a valid positive duration", requested)
+		return expirationTime, nil
+	}
+
+	if shoot.Annotations[v1beta1constants.ConfirmationLifetimeExtension] != "true" {
+		r.Recorder.Eventf(shoot, corev1.EventTypeNormal, EventClusterLifetimeExtensionPending, "Lifetime extension of %s requested, awaiting confirmation via the %q annotation", extension, v1beta1constants.ConfirmationLifetimeExtension)
+		return expirationTime, nil
+	}
+
+	newExpirationTime := expirationTime.Add(extension)
+	if maxExpirationTime := r.Clock.Now().UTC().Add(time.Duration(clusterLifeTime*24) * time.Hour); newExpirationTime.After(maxExpirationTime) {
+		newExpirationTime = maxExpirationTime
+	}
+
+	log.Info("Extending shoot lifetime", "newExpirationTime", newExpirationTime)
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.ShootExpirationTimestamp, newExpirationTime.Format(time.RFC3339))
+	delete(shoot.Annotations, v1beta1constants.ShootLifetimeExtensionRequested)
+	delete(shoot.Annotations, v1beta1constants.ConfirmationLifetimeExtension)
+	delete(shoot.Annotations, v1beta1constants.ShootExpirationWarningLevel)
+	if err := r.Client.Patch(ctx, shoot, patch); err != nil {
+		return expirationTime, err
+	}
+
+	r.Recorder.Eventf(shoot, corev1.EventTypeNormal, EventClusterLifetimeExtended, "Cluster lifetime extended until %s", newExpirationTime.Format(time.RFC3339))
+	return newExpirationTime, nil
+}
+
+// warnBeforeExpiration emits a warning event when the remaining cluster lifetime falls below one of the configured
+// lead times. The emitted warning level is tracked in an annotation so that each level is only signaled once.
+func (r *Reconciler) warnBeforeExpiration(ctx context.Context, shoot *gardencorev1beta1.Shoot, expirationTime time.Time) error {
+	remaining := expirationTime.UTC().Sub(r.Clock.Now().UTC())
+	if remaining <= 0 {
+		return nil
+	}
+
+	var leadTime time.Duration
+	for _, candidate := range r.Config.ExpirationWarningLeadTimes {
+		if remaining <= candidate.Duration && (leadTime == 0 || candidate.Duration < leadTime) {
+			leadTime = candidate.Duration
+		}
+	}
+	if leadTime == 0 || shoot.Annotations[v1beta1constants.ShootExpirationWarningLevel] == leadTime.String() {
+		return nil
+	}
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.ShootExpirationWarningLevel, leadTime.String())
+	if err := r.Client.Patch(ctx, shoot, patch); err != nil {
+		return err
+	}
+
+	r.Recorder.Eventf(shoot, corev1.EventTypeWarning, EventClusterLifetimeExpiring, "Cluster lifetime expires at %s, the shoot will be deleted automatically unless its lifetime is extended", expirationTime.Format(time.RFC3339))
+	return nil
+}
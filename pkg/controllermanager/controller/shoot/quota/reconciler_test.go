@@ -13,6 +13,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -31,6 +32,7 @@ var _ = Describe("Reconciler", func() {
 	var (
 		ctx        = context.TODO()
 		fakeClient client.Client
+		recorder   *record.FakeRecorder
 		reconciler reconcile.Reconciler
 
 		lifetime           = ptr.To[int32](1)
@@ -45,6 +47,7 @@ var _ = Describe("Reconciler", func() {
 	BeforeEach(func() {
 		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
 
+		recorder = record.NewFakeRecorder(8)
 		reconciler = &shootquota.Reconciler{
 			Client: fakeClient,
 			Clock:  clock.RealClock{},
@@ -52,6 +55,7 @@ var _ = Describe("Reconciler", func() {
 				ConcurrentSyncs: ptr.To(1),
 				SyncPeriod:      &metav1.Duration{},
 			},
+			Recorder: recorder,
 		}
 		quota = &gardencorev1beta1.Quota{
 			ObjectMeta: metav1.ObjectMeta{
@@ -171,4 +175,116 @@ var _ = Describe("Reconciler", func() {
 		_, ok := shoot.Annotations["shoot.gardener.cloud/expiration-timestamp"]
 		Expect(ok).To(BeTrue())
 	})
+
+	Context("expiration warnings and lifetime extensions", func() {
+		BeforeEach(func() {
+			reconciler = &shootquota.Reconciler{
+				Client: fakeClient,
+				Clock:  clock.RealClock{},
+				Config: controllermanagerconfigv1alpha1.ShootQuotaControllerConfiguration{
+					ConcurrentSyncs:            ptr.To(1),
+					SyncPeriod:                 &metav1.Duration{},
+					ExpirationWarningLeadTimes: []metav1.Duration{{Duration: 7 * 24 * time.Hour}, {Duration: 24 * time.Hour}},
+				},
+				Recorder: recorder,
+			}
+
+			Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+			Expect(fakeClient.Create(ctx, secretBinding)).To(Succeed())
+		})
+
+		It("should emit a warning event once when the remaining lifetime falls below a lead time", func() {
+			shoot.Annotations = map[string]string{
+				"shoot.gardener.cloud/expiration-timestamp": time.Now().Add(5 * time.Hour).Format(time.RFC3339),
+			}
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: shoot.Name, Namespace: shoot.Namespace}})
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(recorder.Events).Should(Receive(ContainSubstring("ClusterLifetimeExpiring")))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).To(HaveKeyWithValue("shoot.gardener.cloud/expiration-warning-level", "24h0m0s"))
+
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: shoot.Name, Namespace: shoot.Namespace}})
+			Expect(err).NotTo(HaveOccurred())
+			Consistently(recorder.Events).ShouldNot(Receive())
+		})
+
+		It("should not emit a warning event if the remaining lifetime exceeds all lead times", func() {
+			shoot.Annotations = map[string]string{
+				"shoot.gardener.cloud/expiration-timestamp": time.Now().Add(14 * 24 * time.Hour).Format(time.RFC3339),
+			}
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: shoot.Name, Namespace: shoot.Namespace}})
+			Expect(err).NotTo(HaveOccurred())
+			Consistently(recorder.Events).ShouldNot(Receive())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).NotTo(HaveKey("shoot.gardener.cloud/expiration-warning-level"))
+		})
+
+		It("should keep a requested lifetime extension pending until it is confirmed", func() {
+			expirationTime := time.Now().Add(5 * time.Hour).UTC().Format(time.RFC3339)
+			shoot.Annotations = map[string]string{
+				"shoot.gardener.cloud/expiration-timestamp":         expirationTime,
+				"shoot.gardener.cloud/lifetime-extension-requested": "12h",
+			}
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: shoot.Name, Namespace: shoot.Namespace}})
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(recorder.Events).Should(Receive(ContainSubstring("ClusterLifetimeExtensionPending")))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).To(HaveKeyWithValue("shoot.gardener.cloud/expiration-timestamp", expirationTime))
+		})
+
+		It("should apply a confirmed lifetime extension and remove the request annotations", func() {
+			expirationTime := time.Now().Add(5 * time.Hour).UTC()
+			shoot.Annotations = map[string]string{
+				"shoot.gardener.cloud/expiration-timestamp":         expirationTime.Format(time.RFC3339),
+				"shoot.gardener.cloud/lifetime-extension-requested": "12h",
+				"confirmation.gardener.cloud/lifetime-extension":    "true",
+			}
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: shoot.Name, Namespace: shoot.Namespace}})
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(recorder.Events).Should(Receive(ContainSubstring("ClusterLifetimeExtended")))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).To(HaveKeyWithValue("shoot.gardener.cloud/expiration-timestamp", expirationTime.Add(12*time.Hour).Format(time.RFC3339)))
+			Expect(shoot.Annotations).NotTo(HaveKey("shoot.gardener.cloud/lifetime-extension-requested"))
+			Expect(shoot.Annotations).NotTo(HaveKey("confirmation.gardener.cloud/lifetime-extension"))
+		})
+
+		It("should cap a confirmed lifetime extension at the maximum lifetime admitted by the quota", func() {
+			expirationTime := time.Now().Add(5 * time.Hour).UTC()
+			shoot.Annotations = map[string]string{
+				"shoot.gardener.cloud/expiration-timestamp":         expirationTime.Format(time.RFC3339),
+				"shoot.gardener.cloud/lifetime-extension-requested": "2160h",
+				"confirmation.gardener.cloud/lifetime-extension":    "true",
+			}
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: shoot.Name, Namespace: shoot.Namespace}})
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(recorder.Events).Should(Receive(ContainSubstring("ClusterLifetimeExtended")))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+
+			newExpirationTime, err := time.Parse(time.RFC3339, shoot.Annotations["shoot.gardener.cloud/expiration-timestamp"])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(newExpirationTime).To(BeTemporally("~", time.Now().Add(time.Duration(*lifetime*24)*time.Hour), time.Minute))
+		})
+
+		It("should emit a warning event for an invalid lifetime extension request", func() {
+			shoot.Annotations = map[string]string{
+				"shoot.gardener.cloud/expiration-timestamp":         time.Now().Add(14 * 24 * time.Hour).Format(time.RFC3339),
+				"shoot.gardener.cloud/lifetime-extension-requested": "foo",
+			}
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: shoot.Name, Namespace: shoot.Namespace}})
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(recorder.Events).Should(Receive(ContainSubstring("ClusterLifetimeExtensionInvalid")))
+		})
+	})
 })
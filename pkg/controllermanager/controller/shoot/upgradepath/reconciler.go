@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package upgradepath
+
+import (
+	"context"
+	"fmt"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// Reconciler computes the supported upgrade paths of Shoots from the referenced CloudProfile and writes them into the
+// Shoot status.
+type Reconciler struct {
+	Client client.Client
+	Config controllermanagerconfigv1alpha1.ShootUpgradePathControllerConfiguration
+	Clock  clock.Clock
+}
+
+// Reconcile computes the supported upgrade paths of Shoots from the referenced CloudProfile and writes them into the
+// Shoot status.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if shoot.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed reading the CloudProfile referenced by the Shoot: %w", err)
+	}
+
+	upgradePaths, err := computeUpgradePaths(shoot, cloudProfile)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed computing the upgrade paths: %w", err)
+	}
+	upgradePaths.LastUpdateTime = metav1.Time{Time: r.Clock.Now()}
+
+	if !upgradePathsEqual(shoot.Status.UpgradePaths, upgradePaths) {
+		patch := client.StrategicMergeFrom(shoot.DeepCopy())
+		shoot.Status.UpgradePaths = upgradePaths
+		if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed patching the upgrade paths in the Shoot status: %w", err)
+		}
+		log.Info("Updated upgrade paths in Shoot status")
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+// computeUpgradePaths computes the upgrade paths of the shoot's Kubernetes version and the worker pools' machine image
+// versions from the given CloudProfile. Worker pools whose machine image is not contained in the CloudProfile are
+// skipped.
+func computeUpgradePaths(shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile) (*gardencorev1beta1.ShootUpgradePaths, error) {
+	kubernetes, err := computeKubernetesUpgradePath(cloudProfile.Spec.Kubernetes.Versions, shoot.Spec.Kubernetes.Version)
+	if err != nil {
+		return nil, fmt.Errorf("failed computing the Kubernetes version upgrade path: %w", err)
+	}
+
+	upgradePaths := &gardencorev1beta1.ShootUpgradePaths{Kubernetes: *kubernetes}
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if worker.Machine.Image == nil || worker.Machine.Image.Version == nil {
+			continue
+		}
+
+		found, machineImage := v1beta1helper.DetermineMachineImageForName(cloudProfile, worker.Machine.Image.Name)
+		if !found {
+			continue
+		}
+
+		path := gardencorev1beta1.MachineImageUpgradePath{
+			WorkerPool: worker.Name,
+			Image:      machineImage.Name,
+			Version:    *worker.Machine.Image.Version,
+		}
+
+		getHigherVersion := v1beta1helper.GetOverallLatestVersionForAutoUpdate
+		switch ptr.Deref(machineImage.UpdateStrategy, gardencorev1beta1.UpdateStrategyMajor) {
+		case gardencorev1beta1.UpdateStrategyPatch:
+			getHigherVersion = v1beta1helper.GetLatestVersionForPatchAutoUpdate
+		case gardencorev1beta1.UpdateStrategyMinor:
+			getHigherVersion = v1beta1helper.GetLatestVersionForMinorAutoUpdate
+		}
+
+		if found, version, err := getHigherVersion(v1beta1helper.ToExpirableVersions(machineImage.Versions), path.Version); err != nil {
+			return nil, fmt.Errorf("failed determining the next machine image version for worker pool %q: %w", worker.Name, err)
+		} else if found {
+			path.Next = &version
+		}
+
+		if version, ok := v1beta1helper.FindMachineImageVersion(cloudProfile.Spec.MachineImages, path.Image, path.Version); ok {
+			path.ExpirationDate = version.ExpirationDate
+		}
+
+		upgradePaths.MachineImages = append(upgradePaths.MachineImages, path)
+	}
+
+	return upgradePaths, nil
+}
+
+// computeKubernetesUpgradePath computes the upgrade path of the given current Kubernetes version from the given
+// versions of the CloudProfile.
+func computeKubernetesUpgradePath(versions []gardencorev1beta1.ExpirableVersion, currentVersion string) (*gardencorev1beta1.VersionUpgradePath, error) {
+	path := &gardencorev1beta1.VersionUpgradePath{Version: currentVersion}
+
+	if found, version, err := v1beta1helper.GetLatestVersionForPatchAutoUpdate(versions, currentVersion); err != nil {
+		return nil, fmt.Errorf("failed determining the next patch version: %w", err)
+	} else if found {
+		path.NextPatch = &version
+	}
+
+	if found, version, err := v1beta1helper.GetVersionForForcefulUpdateToNextHigherMinor(versions, currentVersion); err != nil {
+		return nil, fmt.Errorf("failed determining the next minor version: %w", err)
+	} else if found {
+		path.NextMinor = &version
+	}
+
+	for _, version := range versions {
+		if version.Version == currentVersion {
+			path.ExpirationDate = version.ExpirationDate
+			break
+		}
+	}
+
+	return path, nil
+}
+
+// upgradePathsEqual checks whether the two given upgrade paths are semantically equal, ignoring the last update time.
+func upgradePathsEqual(old, new *gardencorev1beta1.ShootUpgradePaths) bool {
+	if old == nil || new == nil {
+		return old == new
+	}
+
+	oldCopy := old.DeepCopy()
+	oldCopy.LastUpdateTime = new.LastUpdateTime
+
+	return apiequality.Semantic.DeepEqual(oldCopy, new)
+}
@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package upgradepath_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	shootupgradepath "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/upgradepath"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler reconcile.Reconciler
+
+		syncPeriod     = 6 * time.Hour
+		namespace      = "garden-test"
+		shootName      = "test-shoot"
+		expirationDate = metav1.Time{Time: time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)}
+
+		cloudProfile *gardencorev1beta1.CloudProfile
+		shoot        *gardencorev1beta1.Shoot
+		request      reconcile.Request
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2026, 8, 31, 12, 0, 0, 0, time.UTC))
+
+		reconciler = &shootupgradepath.Reconciler{
+			Client: fakeClient,
+			Config: controllermanagerconfigv1alpha1.ShootUpgradePathControllerConfiguration{
+				ConcurrentSyncs: ptr.To(1),
+				SyncPeriod:      &metav1.Duration{Duration: syncPeriod},
+			},
+			Clock: fakeClock,
+		}
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-profile"},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				Kubernetes: gardencorev1beta1.KubernetesSettings{
+					Versions: []gardencorev1beta1.ExpirableVersion{
+						{Version: "1.31.1", ExpirationDate: &expirationDate},
+						{Version: "1.31.4"},
+						{Version: "1.32.2"},
+					},
+				},
+				MachineImages: []gardencorev1beta1.MachineImage{{
+					Name:           "gardenlinux",
+					UpdateStrategy: ptr.To(gardencorev1beta1.UpdateStrategyPatch),
+					Versions: []gardencorev1beta1.MachineImageVersion{
+						{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.1.0", ExpirationDate: &expirationDate}},
+						{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.1.1"}},
+						{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.2.0"}},
+					},
+				}},
+			},
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      shootName,
+				Namespace: namespace,
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfile: &gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: cloudProfile.Name},
+				Kubernetes:   gardencorev1beta1.Kubernetes{Version: "1.31.1"},
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{
+						{
+							Name: "worker-a",
+							Machine: gardencorev1beta1.Machine{
+								Image: &gardencorev1beta1.ShootMachineImage{Name: "gardenlinux", Version: ptr.To("1.1.0")},
+							},
+						},
+						{
+							Name: "worker-b",
+							Machine: gardencorev1beta1.Machine{
+								Image: &gardencorev1beta1.ShootMachineImage{Name: "unknown-image", Version: ptr.To("1.0.0")},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		request = reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: shootName}}
+	})
+
+	It("should write the upgrade paths into the Shoot status", func() {
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(syncPeriod))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		// the status patch roundtrips through JSON, so compare the times location-agnostically
+		localTime := func(t time.Time) metav1.Time { return metav1.Time{Time: t.Local()} }
+		Expect(shoot.Status.UpgradePaths).To(Equal(&gardencorev1beta1.ShootUpgradePaths{
+			Kubernetes: gardencorev1beta1.VersionUpgradePath{
+				Version:        "1.31.1",
+				NextPatch:      ptr.To("1.31.4"),
+				NextMinor:      ptr.To("1.32.2"),
+				ExpirationDate: ptr.To(localTime(expirationDate.Time)),
+			},
+			MachineImages: []gardencorev1beta1.MachineImageUpgradePath{{
+				WorkerPool:     "worker-a",
+				Image:          "gardenlinux",
+				Version:        "1.1.0",
+				Next:           ptr.To("1.1.1"),
+				ExpirationDate: ptr.To(localTime(expirationDate.Time)),
+			}},
+			LastUpdateTime: localTime(fakeClock.Now()),
+		}))
+	})
+
+	It("should not patch the status if the upgrade paths did not change", func() {
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		lastUpdateTime := shoot.Status.UpgradePaths.LastUpdateTime
+
+		fakeClock.Step(time.Hour)
+
+		_, err = reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.Status.UpgradePaths.LastUpdateTime).To(Equal(lastUpdateTime))
+	})
+
+	It("should recompute the upgrade paths when the Kubernetes version changed", func() {
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		patch := client.MergeFrom(shoot.DeepCopy())
+		shoot.Spec.Kubernetes.Version = "1.31.4"
+		Expect(fakeClient.Patch(ctx, shoot, patch)).To(Succeed())
+
+		_, err = reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.Status.UpgradePaths.Kubernetes).To(Equal(gardencorev1beta1.VersionUpgradePath{
+			Version:   "1.31.4",
+			NextMinor: ptr.To("1.32.2"),
+		}))
+	})
+
+	It("should do nothing if the Shoot is being deleted", func() {
+		shoot.Finalizers = []string{"gardener"}
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+		Expect(fakeClient.Delete(ctx, shoot)).To(Succeed())
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.Status.UpgradePaths).To(BeNil())
+	})
+})
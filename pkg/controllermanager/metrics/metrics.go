@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Namespace is the metric namespace for the gardener-controller-manager.
+const Namespace = "gardener_controller_manager"
+
+var (
+	// factory is used for registering metrics in the controller-runtime metrics registry.
+	factory = promauto.With(runtimemetrics.Registry)
+
+	// SeedDowntimeWindows defines the gauge seed_downtime_windows.
+	SeedDowntimeWindows = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "seed_downtime_windows",
+			Help:      "Number of downtime windows observed for a seed, i.e. how often its gardenlet stopped renewing the heartbeat Lease in time.",
+		},
+		[]string{
+			"seed",
+		},
+	)
+	// SeedDowntimeSeconds defines the gauge seed_downtime_seconds.
+	SeedDowntimeSeconds = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "seed_downtime_seconds",
+			Help:      "Accumulated duration in seconds of all closed downtime windows observed for a seed.",
+		},
+		[]string{
+			"seed",
+		},
+	)
+	// ProjectNamespaceOrphaned defines the gauge project_namespace_orphaned.
+	ProjectNamespaceOrphaned = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "project_namespace_orphaned",
+			Help:      "Set to 1 for namespaces labeled as project namespaces which are not referenced by any Project anymore.",
+		},
+		[]string{
+			"namespace",
+		},
+	)
+)
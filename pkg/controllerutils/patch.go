@@ -85,7 +85,9 @@ func GetAndCreateOrStrategicMergePatch(ctx context.Context, c client.Client, obj
 	return getAndCreateOrPatch(ctx, c, obj, strategicMergeFrom, f, opts...)
 }
 
-func isEmptyPatch(data []byte, optimisticLocking bool, resourceVersion string) bool {
+// IsEmptyPatch returns true in case the given patch data would not change an object when being sent to the API server,
+// i.e., in case it is an effective no-op.
+func IsEmptyPatch(data []byte, optimisticLocking bool, resourceVersion string) bool {
 	if optimisticLocking {
 		// Resource version is always set when optimistic locking is used
 		// see https://github.com/kubernetes-sigs/controller-runtime/blob/e54088c8c7da82111b4508bdaf189c45d1344f00/pkg/client/patch.go#L104
@@ -125,7 +127,7 @@ func getAndCreateOrPatch(ctx context.Context, c client.Client, obj client.Object
 		return controllerutil.OperationResultNone, err
 	}
 
-	if patchOpts.skipEmptyPatch && isEmptyPatch(patchData, patchOpts.optimisticLock, obj.GetResourceVersion()) {
+	if patchOpts.skipEmptyPatch && IsEmptyPatch(patchData, patchOpts.optimisticLock, obj.GetResourceVersion()) {
 		logf.Log.V(1).Info("Skip sending empty patch", "objectKey", client.ObjectKeyFromObject(obj))
 		return controllerutil.OperationResultNone, nil
 	}
@@ -183,7 +185,7 @@ func createOrGetAndPatch(ctx context.Context, c client.Client, obj client.Object
 			return controllerutil.OperationResultNone, err
 		}
 
-		if patchOpts.skipEmptyPatch && isEmptyPatch(patchData, patchOpts.optimisticLock, obj.GetResourceVersion()) {
+		if patchOpts.skipEmptyPatch && IsEmptyPatch(patchData, patchOpts.optimisticLock, obj.GetResourceVersion()) {
 			logf.Log.V(1).Info("Skip sending empty patch", "objectKey", client.ObjectKeyFromObject(obj))
 			return controllerutil.OperationResultNone, nil
 		}
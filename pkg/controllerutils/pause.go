@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controllerutils
+
+import (
+	"fmt"
+	"strconv"
+
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+)
+
+// ReasonReconciliationPaused is the reason used in conditions noting that reconciliation of an object is paused.
+const ReasonReconciliationPaused = "ReconciliationPaused"
+
+// ReconciliationPaused returns true if the object is annotated with the reconciliation-paused annotation, i.e.
+// controllers shall skip reconciling it until the annotation is removed again.
+func ReconciliationPaused(obj client.Object) bool {
+	paused, _ := strconv.ParseBool(obj.GetAnnotations()[v1beta1constants.AnnotationReconciliationPaused])
+	return paused
+}
+
+// PausedReconciliationCondition returns the given condition updated with a note that reconciliation of the object is
+// currently paused. The condition status and transition time are only changed if the condition does not already report
+// the pause.
+func PausedReconciliationCondition(clock clock.Clock, condition gardencorev1beta1.Condition) gardencorev1beta1.Condition {
+	return v1beta1helper.UpdatedConditionWithClock(clock, condition, gardencorev1beta1.ConditionFalse, ReasonReconciliationPaused,
+		fmt.Sprintf("Reconciliation of this object has been paused via the %q annotation.", v1beta1constants.AnnotationReconciliationPaused))
+}
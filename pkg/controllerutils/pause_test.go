@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controllerutils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	. "github.com/gardener/gardener/pkg/controllerutils"
+)
+
+var _ = Describe("Pause", func() {
+	Describe("#ReconciliationPaused", func() {
+		var obj *corev1.Secret
+
+		BeforeEach(func() {
+			obj = &corev1.Secret{}
+		})
+
+		It("should return false if the annotation is not set", func() {
+			Expect(ReconciliationPaused(obj)).To(BeFalse())
+		})
+
+		It("should return false if the annotation is set to false", func() {
+			metav1.SetMetaDataAnnotation(&obj.ObjectMeta, v1beta1constants.AnnotationReconciliationPaused, "false")
+			Expect(ReconciliationPaused(obj)).To(BeFalse())
+		})
+
+		It("should return false if the annotation has an invalid value", func() {
+			metav1.SetMetaDataAnnotation(&obj.ObjectMeta, v1beta1constants.AnnotationReconciliationPaused, "foo")
+			Expect(ReconciliationPaused(obj)).To(BeFalse())
+		})
+
+		It("should return true if the annotation is set to true", func() {
+			metav1.SetMetaDataAnnotation(&obj.ObjectMeta, v1beta1constants.AnnotationReconciliationPaused, "true")
+			Expect(ReconciliationPaused(obj)).To(BeTrue())
+		})
+	})
+
+	Describe("#PausedReconciliationCondition", func() {
+		It("should set the condition to false with a reason noting the pause", func() {
+			condition := PausedReconciliationCondition(testclock.NewFakeClock(metav1.Now().Time), gardencorev1beta1.Condition{
+				Type:   "SomethingHealthy",
+				Status: gardencorev1beta1.ConditionTrue,
+			})
+
+			Expect(condition.Type).To(Equal(gardencorev1beta1.ConditionType("SomethingHealthy")))
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(ReasonReconciliationPaused))
+			Expect(condition.Message).To(ContainSubstring(v1beta1constants.AnnotationReconciliationPaused))
+		})
+	})
+})
@@ -19,6 +19,7 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
 )
 
 // IsDeleting is a predicate for objects having a deletion timestamp.
@@ -35,6 +36,14 @@ func HasName(name string) predicate.Predicate {
 	})
 }
 
+// ReconciliationNotPaused is a predicate for objects whose reconciliation is not paused via the
+// gardener.cloud/reconciliation-paused annotation.
+func ReconciliationNotPaused() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return !controllerutils.ReconciliationPaused(obj)
+	})
+}
+
 // EventType is an alias for byte.
 type EventType byte
 
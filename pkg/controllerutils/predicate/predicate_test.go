@@ -17,6 +17,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	. "github.com/gardener/gardener/pkg/controllerutils/predicate"
@@ -139,6 +140,61 @@ var _ = Describe("Predicate", func() {
 		})
 	})
 
+	Describe("#ReconciliationNotPaused", func() {
+		var (
+			shoot        *gardencorev1beta1.Shoot
+			predicate    predicate.Predicate
+			createEvent  event.CreateEvent
+			updateEvent  event.UpdateEvent
+			deleteEvent  event.DeleteEvent
+			genericEvent event.GenericEvent
+		)
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{},
+			}
+
+			predicate = ReconciliationNotPaused()
+
+			createEvent = event.CreateEvent{
+				Object: shoot,
+			}
+			updateEvent = event.UpdateEvent{
+				ObjectOld: shoot,
+				ObjectNew: shoot,
+			}
+			deleteEvent = event.DeleteEvent{
+				Object: shoot,
+			}
+			genericEvent = event.GenericEvent{
+				Object: shoot,
+			}
+		})
+
+		Context("shoot does not have the reconciliation-paused annotation", func() {
+			It("should be true", func() {
+				Expect(predicate.Create(createEvent)).To(BeTrue())
+				Expect(predicate.Update(updateEvent)).To(BeTrue())
+				Expect(predicate.Delete(deleteEvent)).To(BeTrue())
+				Expect(predicate.Generic(genericEvent)).To(BeTrue())
+			})
+		})
+
+		Context("shoot has the reconciliation-paused annotation", func() {
+			BeforeEach(func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationReconciliationPaused, "true")
+			})
+
+			It("should be false", func() {
+				Expect(predicate.Create(createEvent)).To(BeFalse())
+				Expect(predicate.Update(updateEvent)).To(BeFalse())
+				Expect(predicate.Delete(deleteEvent)).To(BeFalse())
+				Expect(predicate.Generic(genericEvent)).To(BeFalse())
+			})
+		})
+	})
+
 	DescribeTable("#ForEventTypes",
 		func(events []EventType, createMatcher, updateMatcher, deleteMatcher, genericMatcher gomegatypes.GomegaMatcher) {
 			p := ForEventTypes(events...)
@@ -101,7 +101,15 @@ func WaitUntilObjectReadyWithHealthFunction(
 
 		if err := healthFunc(obj); err != nil {
 			lastObservedError = err
-			log.Info("Object did not get ready yet", "reason", err.Error())
+
+			keysAndValues := []any{"reason", err.Error()}
+			if extensionObj, ok := obj.(extensionsv1alpha1.Object); ok {
+				if progress := extensionObj.GetExtensionStatus().GetProgress(); progress != nil {
+					flow.ReportTaskProgress(ctx, progress.Percentage)
+					keysAndValues = append(keysAndValues, "percentage", progress.Percentage, "currentStep", progress.CurrentStep)
+				}
+			}
+			log.Info("Object did not get ready yet", keysAndValues...)
 
 			if retry.IsRetriable(err) {
 				return retry.MinorOrSevereError(retryCountUntilSevere, int(severeThreshold.Nanoseconds()/interval.Nanoseconds()), err)
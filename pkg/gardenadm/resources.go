@@ -14,10 +14,12 @@ import (
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
@@ -25,6 +27,7 @@ import (
 	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
 	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/gardener/operator"
 )
 
@@ -155,16 +158,47 @@ func ReadManifests(log logr.Logger, fsys fs.FS) (Resources, error) {
 	if resources.CloudProfile == nil {
 		return Resources{}, fmt.Errorf("must provide a *gardencorev1beta1.CloudProfile resource, but did not find any")
 	}
-	if resources.Project == nil {
-		return Resources{}, fmt.Errorf("must provide a *gardencorev1beta1.Project resource, but did not find any")
-	}
 	if resources.Shoot == nil {
 		return Resources{}, fmt.Errorf("must provide a *gardencorev1beta1.Shoot resource, but did not find any")
 	}
 
+	if resources.Project == nil {
+		resources.Project = defaultProject(log, resources.Shoot)
+	}
+	if resources.Shoot.Namespace == "" && resources.Project.Spec.Namespace != nil {
+		resources.Shoot.Namespace = *resources.Project.Spec.Namespace
+	}
+
 	return resources, nil
 }
 
+// defaultProjectName is the name of the Project resource defaulted by ReadManifests in case the manifests do not
+// contain one and the Shoot's namespace does not allow deriving a project name.
+const defaultProjectName = "gardenadm"
+
+// defaultProject derives a minimal Project resource from the Shoot's namespace. This allows bootstrapping an autonomous
+// shoot cluster from a minimal bundle which only consists of a Shoot and a CloudProfile manifest.
+func defaultProject(log logr.Logger, shoot *gardencorev1beta1.Shoot) *gardencorev1beta1.Project {
+	var (
+		name      = defaultProjectName
+		namespace = shoot.Namespace
+	)
+
+	switch {
+	case namespace == "":
+		namespace = gardenerutils.ProjectNamespacePrefix + name
+	case strings.HasPrefix(namespace, gardenerutils.ProjectNamespacePrefix) && namespace != gardenerutils.ProjectNamespacePrefix:
+		name = strings.TrimPrefix(namespace, gardenerutils.ProjectNamespacePrefix)
+	}
+
+	log.Info("No Project resource found in manifests, defaulting it based on the Shoot namespace", "projectName", name, "projectNamespace", namespace)
+
+	return &gardencorev1beta1.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To(namespace)},
+	}
+}
+
 // VisitManifestFiles calls the visit func for all manifest files in the given file system.
 // It ignores hidden files and directories (starting with a dot).
 func VisitManifestFiles(fsys fs.FS, visit func(path string, file fs.File) error) error {
@@ -10,6 +10,7 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 
 	"github.com/gardener/gardener/pkg/gardenadm"
 )
@@ -183,12 +184,26 @@ var _ = Describe("Resources", func() {
 		})
 
 		Describe("Project", func() {
-			It("should return an error", func() {
+			It("should default the Project if the Shoot has no namespace", func() {
 				createCloudProfile(fsys, "cpfl")
 				createShoot(fsys, "shoot")
 
-				_, err := gardenadm.ReadManifests(log, fsys)
-				Expect(err).To(MatchError(ContainSubstring("must provide a *gardencorev1beta1.Project resource, but did not find any")))
+				resources, err := gardenadm.ReadManifests(log, fsys)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resources.Project.Name).To(Equal("gardenadm"))
+				Expect(resources.Project.Spec.Namespace).To(PointTo(Equal("garden-gardenadm")))
+				Expect(resources.Shoot.Namespace).To(Equal("garden-gardenadm"))
+			})
+
+			It("should derive the Project from the Shoot namespace", func() {
+				createCloudProfile(fsys, "cpfl")
+				createShootInNamespace(fsys, "shoot", "garden-myproject")
+
+				resources, err := gardenadm.ReadManifests(log, fsys)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resources.Project.Name).To(Equal("myproject"))
+				Expect(resources.Project.Spec.Namespace).To(PointTo(Equal("garden-myproject")))
+				Expect(resources.Shoot.Namespace).To(Equal("garden-myproject"))
 			})
 		})
 
@@ -228,6 +243,15 @@ metadata:
 `)}
 }
 
+func createShootInNamespace(fsys fstest.MapFS, name, namespace string) {
+	fsys["shoot-"+name+".yaml"] = &fstest.MapFile{Data: []byte(`apiVersion: core.gardener.cloud/v1beta1
+kind: Shoot
+metadata:
+  name: ` + name + `
+  namespace: ` + namespace + `
+`)}
+}
+
 func createShootState(fsys fstest.MapFS, name string) {
 	fsys["shootstate-"+name+".yaml"] = &fstest.MapFile{Data: []byte(`apiVersion: core.gardener.cloud/v1beta1
 kind: ShootState
@@ -65,6 +65,16 @@ func SetDefaults_GardenletConfiguration(obj *GardenletConfiguration) {
 	SetDefaults_ExposureClassHandler(obj.ExposureClassHandlers)
 }
 
+// SetDefaults_LogSampling sets defaults for the log sampling configuration.
+func SetDefaults_LogSampling(obj *LogSampling) {
+	if obj.Initial == nil {
+		obj.Initial = ptr.To[int32](100)
+	}
+	if obj.Thereafter == nil {
+		obj.Thereafter = ptr.To[int32](100)
+	}
+}
+
 // SetDefaults_ServerConfiguration sets defaults for the configuration of the HTTP server.
 func SetDefaults_ServerConfiguration(obj *ServerConfiguration) {
 	if obj.HealthProbes == nil {
@@ -219,6 +229,10 @@ func SetDefaults_BackupBucketControllerConfiguration(obj *BackupBucketController
 
 // SetDefaults_BackupEntryControllerConfiguration sets defaults for the backup entry controller.
 func SetDefaults_BackupEntryControllerConfiguration(obj *BackupEntryControllerConfiguration) {
+	if obj.Enabled == nil {
+		obj.Enabled = ptr.To(true)
+	}
+
 	if obj.ConcurrentSyncs == nil {
 		v := DefaultControllerConcurrentSyncs
 		obj.ConcurrentSyncs = &v
@@ -247,6 +261,10 @@ func SetDefaults_ShootMonitoringConfig(obj *ShootMonitoringConfig) {
 
 // SetDefaults_BastionControllerConfiguration sets defaults for the bastion controller.
 func SetDefaults_BastionControllerConfiguration(obj *BastionControllerConfiguration) {
+	if obj.Enabled == nil {
+		obj.Enabled = ptr.To(true)
+	}
+
 	if obj.ConcurrentSyncs == nil {
 		v := DefaultControllerConcurrentSyncs
 		obj.ConcurrentSyncs = &v
@@ -272,6 +290,19 @@ func SetDefaults_ControllerInstallationCareControllerConfiguration(obj *Controll
 		v := metav1.Duration{Duration: 30 * time.Second}
 		obj.SyncPeriod = &v
 	}
+
+	if obj.ExtensionHeartbeats == nil {
+		v := ExtensionHeartbeats{Enabled: true}
+		obj.ExtensionHeartbeats = &v
+	}
+}
+
+// SetDefaults_ExtensionHeartbeats sets defaults for the extension heartbeats.
+func SetDefaults_ExtensionHeartbeats(obj *ExtensionHeartbeats) {
+	if obj.DefaultStaleThreshold == nil {
+		v := metav1.Duration{Duration: 5 * time.Minute}
+		obj.DefaultStaleThreshold = &v
+	}
 }
 
 // SetDefaults_ControllerInstallationRequiredControllerConfiguration sets defaults for the ControllerInstallationRequired controller.
@@ -345,6 +376,20 @@ func SetDefaults_ShootControllerConfiguration(obj *ShootControllerConfiguration)
 	if obj.DNSEntryTTLSeconds == nil {
 		obj.DNSEntryTTLSeconds = ptr.To[int64](120)
 	}
+
+	if obj.ReconciliationThrottling != nil {
+		if obj.ReconciliationThrottling.InitialRatePerMinute == nil {
+			obj.ReconciliationThrottling.InitialRatePerMinute = ptr.To[int32](10)
+		}
+
+		if obj.ReconciliationThrottling.RampUpPeriod == nil {
+			obj.ReconciliationThrottling.RampUpPeriod = &metav1.Duration{Duration: 2 * time.Minute}
+		}
+
+		if obj.ReconciliationThrottling.ThrottlingDuration == nil {
+			obj.ReconciliationThrottling.ThrottlingDuration = &metav1.Duration{Duration: 10 * time.Minute}
+		}
+	}
 }
 
 // SetDefaults_ShootCareControllerConfiguration sets defaults for the shoot care controller.
@@ -385,6 +430,10 @@ func SetDefaults_ShootStateControllerConfiguration(obj *ShootStateControllerConf
 
 // SetDefaults_NetworkPolicyControllerConfiguration sets defaults for the network policy controller.
 func SetDefaults_NetworkPolicyControllerConfiguration(obj *NetworkPolicyControllerConfiguration) {
+	if obj.Enabled == nil {
+		obj.Enabled = ptr.To(true)
+	}
+
 	if obj.ConcurrentSyncs == nil {
 		v := 5
 		obj.ConcurrentSyncs = &v
@@ -420,6 +469,10 @@ func SetDefaults_ManagedSeedControllerConfiguration(obj *ManagedSeedControllerCo
 
 // SetDefaults_TokenRequestorServiceAccountControllerConfiguration sets defaults for the TokenRequestorServiceAccount controller.
 func SetDefaults_TokenRequestorServiceAccountControllerConfiguration(obj *TokenRequestorServiceAccountControllerConfiguration) {
+	if obj.Enabled == nil {
+		obj.Enabled = ptr.To(true)
+	}
+
 	if obj.ConcurrentSyncs == nil {
 		obj.ConcurrentSyncs = ptr.To(5)
 	}
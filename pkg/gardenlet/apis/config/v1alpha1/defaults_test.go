@@ -65,6 +65,33 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("LogSampling defaulting", func() {
+		It("should not default the log sampling config if unset", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.LogSampling).To(BeNil())
+		})
+
+		It("should default the log sampling config if set", func() {
+			obj.LogSampling = &LogSampling{}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.LogSampling.Initial).To(PointTo(Equal(int32(100))))
+			Expect(obj.LogSampling.Thereafter).To(PointTo(Equal(int32(100))))
+		})
+
+		It("should not overwrite already set values for the log sampling config", func() {
+			obj.LogSampling = &LogSampling{
+				Initial:    ptr.To[int32](10),
+				Thereafter: ptr.To[int32](50),
+			}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.LogSampling.Initial).To(PointTo(Equal(int32(10))))
+			Expect(obj.LogSampling.Thereafter).To(PointTo(Equal(int32(50))))
+		})
+	})
+
 	Describe("GardenClientConnection defaulting", func() {
 		It("should default the garden client connection", func() {
 			SetObjectDefaults_GardenletConfiguration(obj)
@@ -195,6 +222,7 @@ var _ = Describe("Defaults", func() {
 		It("should default the backup entry controller configuration", func() {
 			SetObjectDefaults_GardenletConfiguration(obj)
 
+			Expect(obj.Controllers.BackupEntry.Enabled).To(PointTo(BeTrue()))
 			Expect(obj.Controllers.BackupEntry.ConcurrentSyncs).To(PointTo(Equal(20)))
 			Expect(obj.Controllers.BackupEntry.DeletionGracePeriodHours).To(PointTo(Equal(0)))
 			Expect(obj.Controllers.BackupEntry.DeletionGracePeriodShootPurposes).To(BeEmpty())
@@ -204,6 +232,7 @@ var _ = Describe("Defaults", func() {
 			deletionGracePeriodShootPurposes := []gardencorev1beta1.ShootPurpose{gardencorev1beta1.ShootPurposeEvaluation}
 			obj.Controllers = &GardenletControllerConfiguration{
 				BackupEntry: &BackupEntryControllerConfiguration{
+					Enabled:                          ptr.To(false),
 					ConcurrentSyncs:                  ptr.To(10),
 					DeletionGracePeriodHours:         ptr.To(1),
 					DeletionGracePeriodShootPurposes: deletionGracePeriodShootPurposes,
@@ -211,6 +240,7 @@ var _ = Describe("Defaults", func() {
 			}
 			SetObjectDefaults_GardenletConfiguration(obj)
 
+			Expect(obj.Controllers.BackupEntry.Enabled).To(PointTo(BeFalse()))
 			Expect(obj.Controllers.BackupEntry.ConcurrentSyncs).To(PointTo(Equal(10)))
 			Expect(obj.Controllers.BackupEntry.DeletionGracePeriodHours).To(PointTo(Equal(1)))
 			Expect(obj.Controllers.BackupEntry.DeletionGracePeriodShootPurposes).To(Equal(deletionGracePeriodShootPurposes))
@@ -221,15 +251,17 @@ var _ = Describe("Defaults", func() {
 		It("should default the bastion controller configuration", func() {
 			SetObjectDefaults_GardenletConfiguration(obj)
 
+			Expect(obj.Controllers.Bastion.Enabled).To(PointTo(BeTrue()))
 			Expect(obj.Controllers.Bastion.ConcurrentSyncs).To(PointTo(Equal(20)))
 		})
 
 		It("should not overwrite already set values for the bastion controller configuration", func() {
 			obj.Controllers = &GardenletControllerConfiguration{
-				Bastion: &BastionControllerConfiguration{ConcurrentSyncs: ptr.To(10)},
+				Bastion: &BastionControllerConfiguration{Enabled: ptr.To(false), ConcurrentSyncs: ptr.To(10)},
 			}
 			SetObjectDefaults_GardenletConfiguration(obj)
 
+			Expect(obj.Controllers.Bastion.Enabled).To(PointTo(BeFalse()))
 			Expect(obj.Controllers.Bastion.ConcurrentSyncs).To(PointTo(Equal(10)))
 		})
 	})
@@ -467,15 +499,17 @@ var _ = Describe("Defaults", func() {
 		It("should default the network policy controller configuration", func() {
 			SetObjectDefaults_GardenletConfiguration(obj)
 
+			Expect(obj.Controllers.NetworkPolicy.Enabled).To(PointTo(BeTrue()))
 			Expect(obj.Controllers.NetworkPolicy.ConcurrentSyncs).To(PointTo(Equal(5)))
 		})
 
 		It("should not overwrite already set values for the network policy controller configuration", func() {
 			obj.Controllers = &GardenletControllerConfiguration{
-				NetworkPolicy: &NetworkPolicyControllerConfiguration{ConcurrentSyncs: ptr.To(10)},
+				NetworkPolicy: &NetworkPolicyControllerConfiguration{Enabled: ptr.To(false), ConcurrentSyncs: ptr.To(10)},
 			}
 			SetObjectDefaults_GardenletConfiguration(obj)
 
+			Expect(obj.Controllers.NetworkPolicy.Enabled).To(PointTo(BeFalse()))
 			Expect(obj.Controllers.NetworkPolicy.ConcurrentSyncs).To(PointTo(Equal(10)))
 		})
 	})
@@ -516,15 +550,17 @@ var _ = Describe("Defaults", func() {
 		It("should default the token requestor service account controller configuration", func() {
 			SetObjectDefaults_GardenletConfiguration(obj)
 
+			Expect(obj.Controllers.TokenRequestorServiceAccount.Enabled).To(PointTo(BeTrue()))
 			Expect(obj.Controllers.TokenRequestorServiceAccount.ConcurrentSyncs).To(PointTo(Equal(5)))
 		})
 
 		It("should not overwrite already set values for the token requestor controller configuration", func() {
 			obj.Controllers = &GardenletControllerConfiguration{
-				TokenRequestorServiceAccount: &TokenRequestorServiceAccountControllerConfiguration{ConcurrentSyncs: ptr.To(10)},
+				TokenRequestorServiceAccount: &TokenRequestorServiceAccountControllerConfiguration{Enabled: ptr.To(false), ConcurrentSyncs: ptr.To(10)},
 			}
 			SetObjectDefaults_GardenletConfiguration(obj)
 
+			Expect(obj.Controllers.TokenRequestorServiceAccount.Enabled).To(PointTo(BeFalse()))
 			Expect(obj.Controllers.TokenRequestorServiceAccount.ConcurrentSyncs).To(PointTo(Equal(10)))
 		})
 	})
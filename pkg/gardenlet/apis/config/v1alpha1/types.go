@@ -50,6 +50,9 @@ type GardenletConfiguration struct {
 	LogLevel string `json:"logLevel"`
 	// LogFormat is the output format for the logs. Must be one of [text,json].
 	LogFormat string `json:"logFormat"`
+	// LogSampling contains configuration for rate-limiting repeated log entries. If unset, no sampling is performed.
+	// +optional
+	LogSampling *LogSampling `json:"logSampling,omitempty"`
 	// Server defines the configuration of the HTTP server.
 	Server ServerConfiguration `json:"server"`
 	// Debugging holds configuration for Debugging related features.
@@ -85,6 +88,25 @@ type GardenletConfiguration struct {
 	// NodeToleration contains optional settings for default tolerations.
 	// +optional
 	NodeToleration *NodeToleration `json:"nodeToleration,omitempty"`
+	// ImageVectorOverride contains optional image vector override settings applied by this gardenlet at start-up.
+	// +optional
+	ImageVectorOverride *ImageVectorOverride `json:"imageVectorOverride,omitempty"`
+	// ImageVectorVerification contains optional settings for verifying the cosign signatures of all images in the
+	// image vectors at start-up.
+	// +optional
+	ImageVectorVerification *ImageVectorVerification `json:"imageVectorVerification,omitempty"`
+}
+
+// LogSampling contains configuration for rate-limiting repeated log entries.
+type LogSampling struct {
+	// Initial is the number of log entries with identical level and message which are emitted per second before the
+	// sampler starts dropping entries. Defaults to 100.
+	// +optional
+	Initial *int32 `json:"initial,omitempty"`
+	// Thereafter causes every thereafter-th log entry with identical level and message to still be emitted within a
+	// second once Initial entries have been dropped. Defaults to 100.
+	// +optional
+	Thereafter *int32 `json:"thereafter,omitempty"`
 }
 
 // GardenClientConnection specifies the kubeconfig file and the client connection settings
@@ -116,6 +138,11 @@ type GardenClientConnection struct {
 	// secrets.
 	// +optional
 	KubeconfigValidity *KubeconfigValidity `json:"kubeconfigValidity,omitempty"`
+	// ProxyURL is the URL of a tunnel/proxy server (e.g. a reverse konnectivity endpoint) through which all
+	// connections to the garden cluster are brokered, including the bootstrap flow. This enables running gardenlets
+	// in seeds without direct network access to the garden cluster. If not set, connections are established directly.
+	// +optional
+	ProxyURL *string `json:"proxyURL,omitempty"`
 }
 
 // KubeconfigValidity allows configuring certain settings related to the validity and rotation of kubeconfig secrets.
@@ -220,6 +247,10 @@ type BackupBucketControllerConfiguration struct {
 // BackupEntryControllerConfiguration defines the configuration of the BackupEntry
 // controller.
 type BackupEntryControllerConfiguration struct {
+	// Enabled is used to enable or disable the BackupEntry controller.
+	// Defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
 	// ConcurrentSyncs is the number of workers used for the controller to work on events.
 	// +optional
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
@@ -236,6 +267,10 @@ type BackupEntryControllerConfiguration struct {
 // BastionControllerConfiguration defines the configuration of the Bastion
 // controller.
 type BastionControllerConfiguration struct {
+	// Enabled is used to enable or disable the Bastion controller.
+	// Defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
 	// ConcurrentSyncs is the number of workers used for the controller to work on events.
 	// +optional
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
@@ -261,6 +296,25 @@ type ControllerInstallationCareControllerConfiguration struct {
 	// often the health check of ControllerInstallations is performed.
 	// +optional
 	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// ExtensionHeartbeats defines the configuration of the check for stale extension heartbeats.
+	// +optional
+	ExtensionHeartbeats *ExtensionHeartbeats `json:"extensionHeartbeats,omitempty"`
+}
+
+// ExtensionHeartbeats defines the configuration of the check for stale extension heartbeats.
+type ExtensionHeartbeats struct {
+	// Enabled specifies whether the check for stale extension heartbeats is enabled.
+	// Defaults to true.
+	Enabled bool `json:"enabled"`
+	// DefaultStaleThreshold configures the threshold when gardenlet considers the heartbeat lease of an extension as
+	// stale if it has not been renewed in the meantime.
+	// Defaults to 5m.
+	// +optional
+	DefaultStaleThreshold *metav1.Duration `json:"defaultStaleThreshold,omitempty"`
+	// StaleThresholds contains optional thresholds for individual extensions, keyed by the name of the extension's
+	// ControllerRegistration. They take precedence over the default stale threshold.
+	// +optional
+	StaleThresholds map[string]metav1.Duration `json:"staleThresholds,omitempty"`
 }
 
 // ControllerInstallationRequiredControllerConfiguration defines the configuration of the ControllerInstallationRequired
@@ -320,8 +374,97 @@ type ShootControllerConfiguration struct {
 	// Default: 120s
 	// +optional
 	DNSEntryTTLSeconds *int64 `json:"dnsEntryTTLSeconds,omitempty"`
+	// ComponentWaitTimeouts allows overriding the default wait timeouts for individual shoot components, e.g. when
+	// providers routinely exceed the defaults.
+	// +optional
+	ComponentWaitTimeouts *ComponentWaitTimeouts `json:"componentWaitTimeouts,omitempty"`
+	// ReconciliationThrottling contains configuration for adaptively throttling regular shoot reconciliations after
+	// a gardenlet restart (e.g., when recovering from a seed outage) in order to stagger wake-up storms. If not set,
+	// reconciliations are not throttled.
+	// +optional
+	ReconciliationThrottling *ShootReconciliationThrottling `json:"reconciliationThrottling,omitempty"`
+	// OperationConcurrency caps how many shoot operations may run simultaneously across the whole landscape in order
+	// to protect shared infrastructure (e.g., cloud provider or DNS APIs) from burst load. The cap is coordinated via
+	// Lease objects in the garden cluster, so the gardenlets of all seeds compete for the same set of operation slots.
+	// If not set, operations are not limited landscape-wide.
+	// +optional
+	OperationConcurrency *ShootOperationConcurrency `json:"operationConcurrency,omitempty"`
+	// DeprecatedAPIScan contains configuration for scanning the shoot cluster for usage of APIs which are removed in
+	// the target Kubernetes version before a minor version upgrade is performed. If not set, no scan is performed.
+	// +optional
+	DeprecatedAPIScan *ShootDeprecatedAPIScan `json:"deprecatedAPIScan,omitempty"`
+}
+
+// ComponentWaitTimeouts specifies the wait timeouts for individual shoot components.
+type ComponentWaitTimeouts struct {
+	// Infrastructure is the wait timeout for the Infrastructure resource (defaults to 10m).
+	// +optional
+	Infrastructure *metav1.Duration `json:"infrastructure,omitempty"`
+	// ControlPlane is the wait timeout for the ControlPlane resource (defaults to 3m).
+	// +optional
+	ControlPlane *metav1.Duration `json:"controlPlane,omitempty"`
+	// Worker is the wait timeout for the Worker resource (defaults to 10m).
+	// +optional
+	Worker *metav1.Duration `json:"worker,omitempty"`
 }
 
+// ShootReconciliationThrottling contains configuration for adaptively throttling regular shoot reconciliations after
+// a gardenlet restart (e.g., when recovering from a seed outage).
+type ShootReconciliationThrottling struct {
+	// InitialRatePerMinute is the number of shoot reconciliations per minute that are admitted right after gardenlet
+	// startup. Defaults to 10.
+	// +optional
+	InitialRatePerMinute *int32 `json:"initialRatePerMinute,omitempty"`
+	// RampUpPeriod is the period after which the admitted rate is doubled. The rate is not ramped up while the seed
+	// is under resource pressure. Defaults to 2m.
+	// +optional
+	RampUpPeriod *metav1.Duration `json:"rampUpPeriod,omitempty"`
+	// ThrottlingDuration is the duration after gardenlet startup after which reconciliations are no longer throttled.
+	// Defaults to 10m.
+	// +optional
+	ThrottlingDuration *metav1.Duration `json:"throttlingDuration,omitempty"`
+}
+
+// ShootOperationConcurrency contains landscape-wide concurrency limits for shoot operations.
+type ShootOperationConcurrency struct {
+	// MaxReconciles is the maximum number of shoot reconciliations (including creations and restorations) that may
+	// run simultaneously across all seeds. If not set, reconciliations are not limited.
+	// +optional
+	MaxReconciles *int32 `json:"maxReconciles,omitempty"`
+	// MaxMigrations is the maximum number of shoot control plane migration preparations that may run simultaneously
+	// across all seeds. If not set, migrations are not limited.
+	// +optional
+	MaxMigrations *int32 `json:"maxMigrations,omitempty"`
+	// MaxCredentialsRotations is the maximum number of shoot credentials rotations that may run simultaneously across
+	// all seeds. If not set, credentials rotations are not limited.
+	// +optional
+	MaxCredentialsRotations *int32 `json:"maxCredentialsRotations,omitempty"`
+	// LeaseDuration is the duration after which an operation slot held by a crashed gardenlet is considered free
+	// again. Defaults to 2m.
+	// +optional
+	LeaseDuration *metav1.Duration `json:"leaseDuration,omitempty"`
+}
+
+// ShootDeprecatedAPIScan contains configuration for scanning the shoot cluster for usage of APIs which are removed
+// in the target Kubernetes version before a minor version upgrade is performed.
+type ShootDeprecatedAPIScan struct {
+	// Policy determines how findings of the scan are handled. With policy "Warn", findings are only reported in the
+	// shoot's `NoRemovedAPIsInUse` condition. With policy "Block", findings additionally fail the reconciliation
+	// before the kube-apiserver is upgraded. Defaults to "Warn".
+	// +optional
+	Policy *ShootDeprecatedAPIScanPolicy `json:"policy,omitempty"`
+}
+
+// ShootDeprecatedAPIScanPolicy determines how findings of the pre-upgrade deprecated API scan are handled.
+type ShootDeprecatedAPIScanPolicy string
+
+const (
+	// ShootDeprecatedAPIScanPolicyWarn reports findings in the shoot's `NoRemovedAPIsInUse` condition only.
+	ShootDeprecatedAPIScanPolicyWarn ShootDeprecatedAPIScanPolicy = "Warn"
+	// ShootDeprecatedAPIScanPolicyBlock additionally fails the reconciliation before the kube-apiserver is upgraded.
+	ShootDeprecatedAPIScanPolicyBlock ShootDeprecatedAPIScanPolicy = "Block"
+)
+
 // ShootCareControllerConfiguration defines the configuration of the ShootCare
 // controller.
 type ShootCareControllerConfiguration struct {
@@ -345,11 +488,20 @@ type ShootCareControllerConfiguration struct {
 	// ConditionThresholds defines the condition threshold per condition type.
 	// +optional
 	ConditionThresholds []ConditionThreshold `json:"conditionThresholds,omitempty"`
+	// ConditionThresholdsPerPurpose contains optional condition thresholds per condition type for shoots with the
+	// respective purpose, keyed by the purpose. They take precedence over the thresholds in ConditionThresholds.
+	// +optional
+	ConditionThresholdsPerPurpose map[gardencorev1beta1.ShootPurpose][]ConditionThreshold `json:"conditionThresholdsPerPurpose,omitempty"`
 	// WebhookRemediatorEnabled specifies whether the remediator for webhooks not following the Kubernetes best
 	// practices (https://kubernetes.io/docs/reference/access-authn-authz/extensible-admission-controllers/#best-practices-and-warnings)
 	// is enabled.
 	// +optional
 	WebhookRemediatorEnabled *bool `json:"webhookRemediatorEnabled,omitempty"`
+	// ResourceConsumptionEnabled specifies whether the resource consumption of the shoot's control plane
+	// (CPU/memory usage of the kube-apiserver, storage size of the main etcd) shall be measured and published
+	// in the shoot status.
+	// +optional
+	ResourceConsumptionEnabled *bool `json:"resourceConsumptionEnabled,omitempty"`
 }
 
 // SeedCareControllerConfiguration defines the configuration of the SeedCare
@@ -392,6 +544,10 @@ type StaleExtensionHealthChecks struct {
 	// Defaults to 5m.
 	// +optional
 	Threshold *metav1.Duration `json:"threshold,omitempty"`
+	// ThresholdsPerExtension contains optional thresholds for individual extensions, keyed by the name of the
+	// extension's ControllerRegistration. They take precedence over the default threshold.
+	// +optional
+	ThresholdsPerExtension map[string]metav1.Duration `json:"thresholdsPerExtension,omitempty"`
 }
 
 // ConditionThreshold defines the duration how long a flappy condition stays in progressing state.
@@ -405,6 +561,10 @@ type ConditionThreshold struct {
 // NetworkPolicyControllerConfiguration defines the configuration of the NetworkPolicy
 // controller.
 type NetworkPolicyControllerConfiguration struct {
+	// Enabled is used to enable or disable the NetworkPolicy controller.
+	// Defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
 	// ConcurrentSyncs is the number of workers used for the controller to work on events.
 	// +optional
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
@@ -446,6 +606,10 @@ type ManagedSeedControllerConfiguration struct {
 
 // TokenRequestorServiceAccountControllerConfiguration defines the configuration of the TokenRequestorServiceAccount controller.
 type TokenRequestorServiceAccountControllerConfiguration struct {
+	// Enabled is used to enable or disable the TokenRequestorServiceAccount controller.
+	// Defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
 	// ConcurrentSyncs is the number of workers used for the controller to work on events.
 	// +optional
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
@@ -741,6 +905,29 @@ var DefaultControllerSyncPeriod = metav1.Duration{Duration: time.Minute}
 // DefaultCentralValiStorage is a default value for garden/vali's storage.
 var DefaultCentralValiStorage = resource.MustParse("100Gi")
 
+// ImageVectorOverride contains image vector override settings applied by the gardenlet at start-up.
+type ImageVectorOverride struct {
+	// Containers is a raw image vector (in the well-known `images` format) which is merged into the embedded
+	// containers image vector.
+	// +optional
+	Containers *string `json:"containers,omitempty"`
+	// Charts is a raw image vector (in the well-known `images` format) which is merged into the embedded charts image
+	// vector.
+	// +optional
+	Charts *string `json:"charts,omitempty"`
+	// ResolveTagsToDigests specifies whether image tags of the containers image vector are resolved to their digests
+	// by querying the respective registries. Resolved digests are cached in memory.
+	// +optional
+	ResolveTagsToDigests *bool `json:"resolveTagsToDigests,omitempty"`
+}
+
+// ImageVectorVerification contains settings for verifying the cosign signatures of all images in the image vectors.
+type ImageVectorVerification struct {
+	// PublicKeys is a list of PEM-encoded public keys. For each image, at least one signature must verify against one
+	// of the configured keys.
+	PublicKeys []string `json:"publicKeys,omitempty"`
+}
+
 // NodeToleration contains information about node toleration options.
 type NodeToleration struct {
 	// DefaultNotReadyTolerationSeconds specifies the seconds for the `node.kubernetes.io/not-ready` toleration that
@@ -7,6 +7,7 @@ package validation
 import (
 	"fmt"
 	"net"
+	"net/url"
 	"time"
 
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -21,6 +22,7 @@ import (
 	gardencorevalidation "github.com/gardener/gardener/pkg/apis/core/validation"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
 	kubernetescorevalidation "github.com/gardener/gardener/pkg/utils/validation/kubernetes/core"
 )
@@ -53,6 +55,9 @@ func ValidateGardenletConfiguration(cfg *gardenletconfigv1alpha1.GardenletConfig
 		if cfg.Controllers.Shoot != nil {
 			allErrs = append(allErrs, validateShootControllerConfiguration(cfg.Controllers.Shoot, fldPath.Child("controllers", "shoot"))...)
 		}
+		if cfg.Controllers.ControllerInstallationCare != nil {
+			allErrs = append(allErrs, validateControllerInstallationCareControllerConfiguration(cfg.Controllers.ControllerInstallationCare, fldPath.Child("controllers", "controllerInstallationCare"))...)
+		}
 		if cfg.Controllers.ShootCare != nil {
 			allErrs = append(allErrs, validateShootCareControllerConfiguration(cfg.Controllers.ShootCare, fldPath.Child("controllers", "shootCare"))...)
 		}
@@ -76,6 +81,8 @@ func ValidateGardenletConfiguration(cfg *gardenletconfigv1alpha1.GardenletConfig
 		}
 	}
 
+	allErrs = append(allErrs, validateLogSampling(cfg.LogSampling, field.NewPath("logSampling"))...)
+
 	if cfg.SeedConfig != nil {
 		seedTemplate, err := gardencorehelper.ConvertSeedTemplate(&cfg.SeedConfig.SeedTemplate)
 		if err != nil {
@@ -116,6 +123,37 @@ func ValidateGardenletConfiguration(cfg *gardenletconfigv1alpha1.GardenletConfig
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(ptr.Deref(nodeTolerationCfg.DefaultUnreachableTolerationSeconds, 0), nodeTolerationConfigPath.Child("defaultUnreachableTolerationSeconds"))...)
 	}
 
+	if imageVectorOverride := cfg.ImageVectorOverride; imageVectorOverride != nil {
+		imageVectorOverridePath := fldPath.Child("imageVectorOverride")
+
+		for fieldName, value := range map[string]*string{
+			"containers": imageVectorOverride.Containers,
+			"charts":     imageVectorOverride.Charts,
+		} {
+			if value == nil {
+				continue
+			}
+
+			if _, err := imagevector.Read([]byte(*value)); err != nil {
+				allErrs = append(allErrs, field.Invalid(imageVectorOverridePath.Child(fieldName), *value, fmt.Sprintf("must contain a valid image vector: %v", err)))
+			}
+		}
+	}
+
+	if imageVectorVerification := cfg.ImageVectorVerification; imageVectorVerification != nil {
+		publicKeysPath := fldPath.Child("imageVectorVerification", "publicKeys")
+
+		if len(imageVectorVerification.PublicKeys) == 0 {
+			allErrs = append(allErrs, field.Required(publicKeysPath, "must provide at least one public key"))
+		}
+
+		for i, publicKey := range imageVectorVerification.PublicKeys {
+			if _, err := imagevector.ParsePublicKey(publicKey); err != nil {
+				allErrs = append(allErrs, field.Invalid(publicKeysPath.Index(i), publicKey, fmt.Sprintf("must contain a valid PEM-encoded public key: %v", err)))
+			}
+		}
+	}
+
 	return allErrs
 }
 
@@ -152,6 +190,14 @@ func validateGardenClientConnection(conf *gardenletconfigv1alpha1.GardenClientCo
 		allErrs = append(allErrs, validateKubeconfigValidity(conf.KubeconfigValidity, fldPath.Child("kubeconfigValidity"))...)
 	}
 
+	if conf.ProxyURL != nil {
+		if u, err := url.Parse(*conf.ProxyURL); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("proxyURL"), *conf.ProxyURL, fmt.Sprintf("must be a valid URL: %v", err)))
+		} else if u.Scheme == "" || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("proxyURL"), *conf.ProxyURL, "must be an absolute URL with scheme and host"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -193,6 +239,23 @@ func validateShootClientConnection(conf *gardenletconfigv1alpha1.ShootClientConn
 	return allErrs
 }
 
+func validateLogSampling(logSampling *gardenletconfigv1alpha1.LogSampling, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if logSampling == nil {
+		return allErrs
+	}
+
+	if logSampling.Initial != nil && *logSampling.Initial < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("initial"), *logSampling.Initial, "must be at least 1"))
+	}
+	if logSampling.Thereafter != nil && *logSampling.Thereafter < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("thereafter"), *logSampling.Thereafter, "must be at least 1"))
+	}
+
+	return allErrs
+}
+
 func validateShootControllerConfiguration(cfg *gardenletconfigv1alpha1.ShootControllerConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -212,6 +275,30 @@ func validateShootControllerConfiguration(cfg *gardenletconfigv1alpha1.ShootCont
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.SyncPeriod.Duration), fldPath.Child("syncPeriod"))...)
 	}
 
+	if cfg.ReconciliationThrottling != nil {
+		if cfg.ReconciliationThrottling.InitialRatePerMinute != nil && *cfg.ReconciliationThrottling.InitialRatePerMinute < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("reconciliationThrottling", "initialRatePerMinute"), *cfg.ReconciliationThrottling.InitialRatePerMinute, "must be at least 1"))
+		}
+		if cfg.ReconciliationThrottling.RampUpPeriod != nil && cfg.ReconciliationThrottling.RampUpPeriod.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("reconciliationThrottling", "rampUpPeriod"), cfg.ReconciliationThrottling.RampUpPeriod.Duration, "must be greater than 0"))
+		}
+		if cfg.ReconciliationThrottling.ThrottlingDuration != nil {
+			allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.ReconciliationThrottling.ThrottlingDuration.Duration), fldPath.Child("reconciliationThrottling", "throttlingDuration"))...)
+		}
+	}
+
+	if cfg.ComponentWaitTimeouts != nil {
+		if cfg.ComponentWaitTimeouts.Infrastructure != nil {
+			allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.ComponentWaitTimeouts.Infrastructure.Duration), fldPath.Child("componentWaitTimeouts", "infrastructure"))...)
+		}
+		if cfg.ComponentWaitTimeouts.ControlPlane != nil {
+			allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.ComponentWaitTimeouts.ControlPlane.Duration), fldPath.Child("componentWaitTimeouts", "controlPlane"))...)
+		}
+		if cfg.ComponentWaitTimeouts.Worker != nil {
+			allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.ComponentWaitTimeouts.Worker.Duration), fldPath.Child("componentWaitTimeouts", "worker"))...)
+		}
+	}
+
 	if cfg.DNSEntryTTLSeconds != nil {
 		const (
 			dnsEntryTTLSecondsMin = 30
@@ -226,6 +313,29 @@ func validateShootControllerConfiguration(cfg *gardenletconfigv1alpha1.ShootCont
 	return allErrs
 }
 
+func validateControllerInstallationCareControllerConfiguration(cfg *gardenletconfigv1alpha1.ControllerInstallationCareControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.ConcurrentSyncs != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*cfg.ConcurrentSyncs), fldPath.Child("concurrentSyncs"))...)
+	}
+
+	if cfg.SyncPeriod != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.SyncPeriod.Duration), fldPath.Child("syncPeriod"))...)
+	}
+
+	if cfg.ExtensionHeartbeats != nil {
+		if cfg.ExtensionHeartbeats.DefaultStaleThreshold != nil {
+			allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.ExtensionHeartbeats.DefaultStaleThreshold.Duration), fldPath.Child("extensionHeartbeats", "defaultStaleThreshold"))...)
+		}
+		for name, threshold := range cfg.ExtensionHeartbeats.StaleThresholds {
+			allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(threshold.Duration), fldPath.Child("extensionHeartbeats", "staleThresholds").Key(name))...)
+		}
+	}
+
+	return allErrs
+}
+
 func validateShootCareControllerConfiguration(cfg *gardenletconfigv1alpha1.ShootCareControllerConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -239,6 +349,9 @@ func validateShootCareControllerConfiguration(cfg *gardenletconfigv1alpha1.Shoot
 
 	if cfg.StaleExtensionHealthChecks != nil {
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.StaleExtensionHealthChecks.Threshold.Duration), fldPath.Child("staleExtensionHealthChecks", "threshold"))...)
+		for name, threshold := range cfg.StaleExtensionHealthChecks.ThresholdsPerExtension {
+			allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(threshold.Duration), fldPath.Child("staleExtensionHealthChecks", "thresholdsPerExtension").Key(name))...)
+		}
 	}
 
 	if cfg.ManagedResourceProgressingThreshold != nil {
@@ -249,6 +362,12 @@ func validateShootCareControllerConfiguration(cfg *gardenletconfigv1alpha1.Shoot
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.ConditionThresholds[i].Duration.Duration), fldPath.Child("conditionThresholds").Index(i).Child("duration"))...)
 	}
 
+	for purpose, thresholds := range cfg.ConditionThresholdsPerPurpose {
+		for i := range thresholds {
+			allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(thresholds[i].Duration.Duration), fldPath.Child("conditionThresholdsPerPurpose").Key(string(purpose)).Index(i).Child("duration"))...)
+		}
+	}
+
 	return allErrs
 }
 
@@ -115,6 +115,35 @@ var _ = Describe("GardenletConfiguration", func() {
 			Expect(errorList).To(BeEmpty())
 		})
 
+		Context("log sampling configuration", func() {
+			It("should allow a valid log sampling config", func() {
+				cfg.LogSampling = &gardenletconfigv1alpha1.LogSampling{
+					Initial:    ptr.To[int32](10),
+					Thereafter: ptr.To[int32](50),
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should reject non-positive values", func() {
+				cfg.LogSampling = &gardenletconfigv1alpha1.LogSampling{
+					Initial:    ptr.To[int32](0),
+					Thereafter: ptr.To[int32](-1),
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("logSampling.initial"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("logSampling.thereafter"),
+					})),
+				))
+			})
+		})
+
 		Context("client connection configuration", func() {
 			var (
 				clientConnection *componentbaseconfigv1alpha1.ClientConnectionConfiguration
@@ -239,6 +268,28 @@ var _ = Describe("GardenletConfiguration", func() {
 						}))))
 					})
 				})
+
+				Context("proxy URL", func() {
+					It("should allow a valid proxy URL", func() {
+						cfg.GardenClientConnection = &gardenletconfigv1alpha1.GardenClientConnection{
+							ProxyURL: ptr.To("https://proxy.example.com:8443"),
+						}
+
+						Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+					})
+
+					It("should forbid a proxy URL without scheme and host", func() {
+						cfg.GardenClientConnection = &gardenletconfigv1alpha1.GardenClientConnection{
+							ProxyURL: ptr.To("proxy.example.com"),
+						}
+
+						Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("gardenClientConnection.proxyURL"),
+							"Detail": ContainSubstring("must be an absolute URL"),
+						}))))
+					})
+				})
 			})
 
 			Context("seed client connection", func() {
@@ -345,6 +396,36 @@ var _ = Describe("GardenletConfiguration", func() {
 					"Field": Equal("controllers.shoot.dnsEntryTTLSeconds"),
 				}))))
 			})
+
+			It("should allow valid component wait timeouts", func() {
+				cfg.Controllers.Shoot.ComponentWaitTimeouts = &gardenletconfigv1alpha1.ComponentWaitTimeouts{
+					Infrastructure: &metav1.Duration{Duration: 20 * time.Minute},
+					ControlPlane:   &metav1.Duration{Duration: 5 * time.Minute},
+					Worker:         &metav1.Duration{Duration: 20 * time.Minute},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should forbid negative component wait timeouts", func() {
+				cfg.Controllers.Shoot.ComponentWaitTimeouts = &gardenletconfigv1alpha1.ComponentWaitTimeouts{
+					Infrastructure: &metav1.Duration{Duration: -time.Minute},
+					Worker:         &metav1.Duration{Duration: -time.Minute},
+				}
+
+				errorList := ValidateGardenletConfiguration(cfg, nil)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shoot.componentWaitTimeouts.infrastructure"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shoot.componentWaitTimeouts.worker"),
+					})),
+				))
+			})
 		})
 
 		Context("shootCare controller", func() {
@@ -356,6 +437,9 @@ var _ = Describe("GardenletConfiguration", func() {
 				cfg.Controllers.ShootCare.StaleExtensionHealthChecks = &gardenletconfigv1alpha1.StaleExtensionHealthChecks{Threshold: &metav1.Duration{Duration: -1}}
 				cfg.Controllers.ShootCare.ManagedResourceProgressingThreshold = &metav1.Duration{Duration: -1}
 				cfg.Controllers.ShootCare.ConditionThresholds = []gardenletconfigv1alpha1.ConditionThreshold{{Duration: metav1.Duration{Duration: -1}}}
+				cfg.Controllers.ShootCare.ConditionThresholdsPerPurpose = map[gardencorev1beta1.ShootPurpose][]gardenletconfigv1alpha1.ConditionThreshold{
+					gardencorev1beta1.ShootPurposeProduction: {{Duration: metav1.Duration{Duration: -1}}},
+				}
 
 				errorList := ValidateGardenletConfiguration(cfg, nil)
 
@@ -380,6 +464,10 @@ var _ = Describe("GardenletConfiguration", func() {
 						"Type":  Equal(field.ErrorTypeInvalid),
 						"Field": Equal("controllers.shootCare.conditionThresholds[0].duration"),
 					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootCare.conditionThresholdsPerPurpose[production][0].duration"),
+					})),
 				))
 			})
 		})
@@ -757,6 +845,91 @@ var _ = Describe("GardenletConfiguration", func() {
 				)
 			})
 		})
+
+		Context("imageVectorOverride", func() {
+			It("should pass with unset image vector override", func() {
+				cfg.ImageVectorOverride = nil
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should pass with valid image vector overrides", func() {
+				cfg.ImageVectorOverride = &gardenletconfigv1alpha1.ImageVectorOverride{
+					Containers: ptr.To(`images:
+- name: foo
+  repository: example.com/foo
+  tag: v1.2.3
+`),
+					Charts: ptr.To(`images:
+- name: bar
+  repository: example.com/charts/bar
+  tag: v4.5.6
+`),
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should fail with invalid image vector overrides", func() {
+				cfg.ImageVectorOverride = &gardenletconfigv1alpha1.ImageVectorOverride{
+					Containers: ptr.To("foo"),
+					Charts:     ptr.To("bar"),
+				}
+
+				errorList := ValidateGardenletConfiguration(cfg, nil)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("imageVectorOverride.containers"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("imageVectorOverride.charts"),
+					}))),
+				)
+			})
+		})
+
+		Context("imageVectorVerification", func() {
+			It("should pass with unset image vector verification", func() {
+				cfg.ImageVectorVerification = nil
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should pass with a valid public key", func() {
+				cfg.ImageVectorVerification = &gardenletconfigv1alpha1.ImageVectorVerification{
+					PublicKeys: []string{validPublicKey},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should fail without public keys", func() {
+				cfg.ImageVectorVerification = &gardenletconfigv1alpha1.ImageVectorVerification{}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("imageVectorVerification.publicKeys"),
+					})),
+				))
+			})
+
+			It("should fail with an invalid public key", func() {
+				cfg.ImageVectorVerification = &gardenletconfigv1alpha1.ImageVectorVerification{
+					PublicKeys: []string{validPublicKey, "foo"},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("imageVectorVerification.publicKeys[1]"),
+					})),
+				))
+			})
+		})
 	})
 
 	Describe("#ValidateGardenletConfigurationUpdate", func() {
@@ -782,3 +955,9 @@ var _ = Describe("GardenletConfiguration", func() {
 		})
 	})
 })
+
+const validPublicKey = `-----BEGIN PUBLIC KEY-----
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAENTjPyB4Je5fEmXwHwWtDjMiLJTSR
+vlPLHf3ihCU3ZZ4jtyBj3rRS6ZGQQZRk/lSSLA8gho2s/habeFa2UVSjIQ==
+-----END PUBLIC KEY-----
+`
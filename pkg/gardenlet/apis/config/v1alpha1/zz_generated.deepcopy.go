@@ -82,6 +82,11 @@ func (in *BackupCompactionController) DeepCopy() *BackupCompactionController {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupEntryControllerConfiguration) DeepCopyInto(out *BackupEntryControllerConfiguration) {
 	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
 	if in.ConcurrentSyncs != nil {
 		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
 		*out = new(int)
@@ -113,6 +118,11 @@ func (in *BackupEntryControllerConfiguration) DeepCopy() *BackupEntryControllerC
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BastionControllerConfiguration) DeepCopyInto(out *BastionControllerConfiguration) {
 	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
 	if in.ConcurrentSyncs != nil {
 		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
 		*out = new(int)
@@ -131,6 +141,37 @@ func (in *BastionControllerConfiguration) DeepCopy() *BastionControllerConfigura
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentWaitTimeouts) DeepCopyInto(out *ComponentWaitTimeouts) {
+	*out = *in
+	if in.Infrastructure != nil {
+		in, out := &in.Infrastructure, &out.Infrastructure
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ControlPlane != nil {
+		in, out := &in.ControlPlane, &out.ControlPlane
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Worker != nil {
+		in, out := &in.Worker, &out.Worker
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentWaitTimeouts.
+func (in *ComponentWaitTimeouts) DeepCopy() *ComponentWaitTimeouts {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentWaitTimeouts)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConditionThreshold) DeepCopyInto(out *ConditionThreshold) {
 	*out = *in
@@ -161,6 +202,11 @@ func (in *ControllerInstallationCareControllerConfiguration) DeepCopyInto(out *C
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.ExtensionHeartbeats != nil {
+		in, out := &in.ExtensionHeartbeats, &out.ExtensionHeartbeats
+		*out = new(ExtensionHeartbeats)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -354,6 +400,34 @@ func (in *ExposureClassHandler) DeepCopy() *ExposureClassHandler {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtensionHeartbeats) DeepCopyInto(out *ExtensionHeartbeats) {
+	*out = *in
+	if in.DefaultStaleThreshold != nil {
+		in, out := &in.DefaultStaleThreshold, &out.DefaultStaleThreshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.StaleThresholds != nil {
+		in, out := &in.StaleThresholds, &out.StaleThresholds
+		*out = make(map[string]v1.Duration, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionHeartbeats.
+func (in *ExtensionHeartbeats) DeepCopy() *ExtensionHeartbeats {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtensionHeartbeats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GardenClientConnection) DeepCopyInto(out *GardenClientConnection) {
 	*out = *in
@@ -383,6 +457,11 @@ func (in *GardenClientConnection) DeepCopyInto(out *GardenClientConnection) {
 		*out = new(KubeconfigValidity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ProxyURL != nil {
+		in, out := &in.ProxyURL, &out.ProxyURL
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -451,6 +530,11 @@ func (in *GardenletConfiguration) DeepCopyInto(out *GardenletConfiguration) {
 		*out = new(configv1alpha1.LeaderElectionConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LogSampling != nil {
+		in, out := &in.LogSampling, &out.LogSampling
+		*out = new(LogSampling)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Server.DeepCopyInto(&out.Server)
 	if in.Debugging != nil {
 		in, out := &in.Debugging, &out.Debugging
@@ -501,6 +585,16 @@ func (in *GardenletConfiguration) DeepCopyInto(out *GardenletConfiguration) {
 		*out = new(NodeToleration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ImageVectorOverride != nil {
+		in, out := &in.ImageVectorOverride, &out.ImageVectorOverride
+		*out = new(ImageVectorOverride)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageVectorVerification != nil {
+		in, out := &in.ImageVectorVerification, &out.ImageVectorVerification
+		*out = new(ImageVectorVerification)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -649,6 +743,58 @@ func (in *GardenletObjectControllerConfiguration) DeepCopy() *GardenletObjectCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageVectorOverride) DeepCopyInto(out *ImageVectorOverride) {
+	*out = *in
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = new(string)
+		**out = **in
+	}
+	if in.Charts != nil {
+		in, out := &in.Charts, &out.Charts
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResolveTagsToDigests != nil {
+		in, out := &in.ResolveTagsToDigests, &out.ResolveTagsToDigests
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageVectorOverride.
+func (in *ImageVectorOverride) DeepCopy() *ImageVectorOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageVectorOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageVectorVerification) DeepCopyInto(out *ImageVectorVerification) {
+	*out = *in
+	if in.PublicKeys != nil {
+		in, out := &in.PublicKeys, &out.PublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageVectorVerification.
+func (in *ImageVectorVerification) DeepCopy() *ImageVectorVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageVectorVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeconfigValidity) DeepCopyInto(out *KubeconfigValidity) {
 	*out = *in
@@ -708,6 +854,32 @@ func (in *LoadBalancerServiceConfig) DeepCopy() *LoadBalancerServiceConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogSampling) DeepCopyInto(out *LogSampling) {
+	*out = *in
+	if in.Initial != nil {
+		in, out := &in.Initial, &out.Initial
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Thereafter != nil {
+		in, out := &in.Thereafter, &out.Thereafter
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogSampling.
+func (in *LogSampling) DeepCopy() *LogSampling {
+	if in == nil {
+		return nil
+	}
+	out := new(LogSampling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Logging) DeepCopyInto(out *Logging) {
 	*out = *in
@@ -809,6 +981,11 @@ func (in *MonitoringConfig) DeepCopy() *MonitoringConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkPolicyControllerConfiguration) DeepCopyInto(out *NetworkPolicyControllerConfiguration) {
 	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
 	if in.ConcurrentSyncs != nil {
 		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
 		*out = new(int)
@@ -1131,11 +1308,32 @@ func (in *ShootCareControllerConfiguration) DeepCopyInto(out *ShootCareControlle
 		*out = make([]ConditionThreshold, len(*in))
 		copy(*out, *in)
 	}
+	if in.ConditionThresholdsPerPurpose != nil {
+		in, out := &in.ConditionThresholdsPerPurpose, &out.ConditionThresholdsPerPurpose
+		*out = make(map[v1beta1.ShootPurpose][]ConditionThreshold, len(*in))
+		for key, val := range *in {
+			var outVal []ConditionThreshold
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]ConditionThreshold, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
 	if in.WebhookRemediatorEnabled != nil {
 		in, out := &in.WebhookRemediatorEnabled, &out.WebhookRemediatorEnabled
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ResourceConsumptionEnabled != nil {
+		in, out := &in.ResourceConsumptionEnabled, &out.ResourceConsumptionEnabled
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1204,6 +1402,26 @@ func (in *ShootControllerConfiguration) DeepCopyInto(out *ShootControllerConfigu
 		*out = new(int64)
 		**out = **in
 	}
+	if in.ComponentWaitTimeouts != nil {
+		in, out := &in.ComponentWaitTimeouts, &out.ComponentWaitTimeouts
+		*out = new(ComponentWaitTimeouts)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReconciliationThrottling != nil {
+		in, out := &in.ReconciliationThrottling, &out.ReconciliationThrottling
+		*out = new(ShootReconciliationThrottling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OperationConcurrency != nil {
+		in, out := &in.OperationConcurrency, &out.OperationConcurrency
+		*out = new(ShootOperationConcurrency)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeprecatedAPIScan != nil {
+		in, out := &in.DeprecatedAPIScan, &out.DeprecatedAPIScan
+		*out = new(ShootDeprecatedAPIScan)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1217,6 +1435,27 @@ func (in *ShootControllerConfiguration) DeepCopy() *ShootControllerConfiguration
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootDeprecatedAPIScan) DeepCopyInto(out *ShootDeprecatedAPIScan) {
+	*out = *in
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(ShootDeprecatedAPIScanPolicy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootDeprecatedAPIScan.
+func (in *ShootDeprecatedAPIScan) DeepCopy() *ShootDeprecatedAPIScan {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootDeprecatedAPIScan)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootEventLogging) DeepCopyInto(out *ShootEventLogging) {
 	*out = *in
@@ -1292,6 +1531,73 @@ func (in *ShootNodeLogging) DeepCopy() *ShootNodeLogging {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootOperationConcurrency) DeepCopyInto(out *ShootOperationConcurrency) {
+	*out = *in
+	if in.MaxReconciles != nil {
+		in, out := &in.MaxReconciles, &out.MaxReconciles
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxMigrations != nil {
+		in, out := &in.MaxMigrations, &out.MaxMigrations
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxCredentialsRotations != nil {
+		in, out := &in.MaxCredentialsRotations, &out.MaxCredentialsRotations
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LeaseDuration != nil {
+		in, out := &in.LeaseDuration, &out.LeaseDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootOperationConcurrency.
+func (in *ShootOperationConcurrency) DeepCopy() *ShootOperationConcurrency {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootOperationConcurrency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootReconciliationThrottling) DeepCopyInto(out *ShootReconciliationThrottling) {
+	*out = *in
+	if in.InitialRatePerMinute != nil {
+		in, out := &in.InitialRatePerMinute, &out.InitialRatePerMinute
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RampUpPeriod != nil {
+		in, out := &in.RampUpPeriod, &out.RampUpPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ThrottlingDuration != nil {
+		in, out := &in.ThrottlingDuration, &out.ThrottlingDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootReconciliationThrottling.
+func (in *ShootReconciliationThrottling) DeepCopy() *ShootReconciliationThrottling {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootReconciliationThrottling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootStateControllerConfiguration) DeepCopyInto(out *ShootStateControllerConfiguration) {
 	*out = *in
@@ -1347,6 +1653,13 @@ func (in *StaleExtensionHealthChecks) DeepCopyInto(out *StaleExtensionHealthChec
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.ThresholdsPerExtension != nil {
+		in, out := &in.ThresholdsPerExtension, &out.ThresholdsPerExtension
+		*out = make(map[string]v1.Duration, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -1363,6 +1676,11 @@ func (in *StaleExtensionHealthChecks) DeepCopy() *StaleExtensionHealthChecks {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TokenRequestorServiceAccountControllerConfiguration) DeepCopyInto(out *TokenRequestorServiceAccountControllerConfiguration) {
 	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
 	if in.ConcurrentSyncs != nil {
 		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
 		*out = new(int)
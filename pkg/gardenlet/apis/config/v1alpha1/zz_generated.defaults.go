@@ -52,6 +52,9 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 		}
 		if in.Controllers.ControllerInstallationCare != nil {
 			SetDefaults_ControllerInstallationCareControllerConfiguration(in.Controllers.ControllerInstallationCare)
+			if in.Controllers.ControllerInstallationCare.ExtensionHeartbeats != nil {
+				SetDefaults_ExtensionHeartbeats(in.Controllers.ControllerInstallationCare.ExtensionHeartbeats)
+			}
 		}
 		if in.Controllers.ControllerInstallationRequired != nil {
 			SetDefaults_ControllerInstallationRequiredControllerConfiguration(in.Controllers.ControllerInstallationRequired)
@@ -96,6 +99,9 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 	if in.LeaderElection != nil {
 		SetDefaults_LeaderElectionConfiguration(in.LeaderElection)
 	}
+	if in.LogSampling != nil {
+		SetDefaults_LogSampling(in.LogSampling)
+	}
 	SetDefaults_ServerConfiguration(&in.Server)
 	if in.Logging != nil {
 		SetDefaults_Logging(in.Logging)
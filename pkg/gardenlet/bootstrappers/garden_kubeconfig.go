@@ -75,7 +75,7 @@ func (g *GardenKubeconfig) Start(ctx context.Context) (err error) {
 
 	var gardenAPIReader client.Reader
 	if len(g.Config.GardenClientConnection.GardenClusterCACert) == 0 {
-		gardenClient, err := kubernetes.NewClientFromBytes(g.Result.Kubeconfig)
+		gardenClient, err := kubernetes.NewClientFromBytes(g.Result.Kubeconfig, g.clientOptions()...)
 		if err != nil {
 			return fmt.Errorf("unable to create garden client from kubeconfig: %w", err)
 		}
@@ -99,6 +99,16 @@ var (
 	NewClientFromBytes = kubernetes.NewClientFromBytes
 )
 
+// clientOptions returns the ConfigFuncs used for creating garden clients during the bootstrap flow, e.g. for
+// brokering the connection via the configured tunnel/proxy server.
+func (g *GardenKubeconfig) clientOptions() []kubernetes.ConfigFunc {
+	var opts []kubernetes.ConfigFunc
+	if proxyURL := g.Config.GardenClientConnection.ProxyURL; proxyURL != nil {
+		opts = append(opts, kubernetes.WithProxyURL(*proxyURL))
+	}
+	return opts
+}
+
 // getOrBootstrapKubeconfig retrieves an already existing kubeconfig for the Garden cluster from the Seed or bootstraps a new one
 func (g *GardenKubeconfig) getOrBootstrapKubeconfig(ctx context.Context) ([]byte, string, error) {
 	kubeconfigKey := kubernetesutils.ObjectKeyFromSecretRef(*g.Config.GardenClientConnection.KubeconfigSecret)
@@ -133,7 +143,7 @@ func (g *GardenKubeconfig) getOrBootstrapKubeconfig(ctx context.Context) ([]byte
 		return nil, "", errors.New("bootstrap secret does not contain a kubeconfig, cannot bootstrap")
 	}
 
-	bootstrapClientSet, err := NewClientFromBytes(bootstrapKubeconfig)
+	bootstrapClientSet, err := NewClientFromBytes(bootstrapKubeconfig, g.clientOptions()...)
 	if err != nil {
 		return nil, "", fmt.Errorf("unable to bootstrap client from bootstrap kubeconfig: %w", err)
 	}
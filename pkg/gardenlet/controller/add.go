@@ -116,17 +116,25 @@ func AddToManager(
 		return fmt.Errorf("failed adding BackupBucket controller: %w", err)
 	}
 
-	if err := (&backupentry.Reconciler{
-		Config:   *cfg.Controllers.BackupEntry,
-		SeedName: cfg.SeedConfig.Name,
-	}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
-		return fmt.Errorf("failed adding BackupEntry controller: %w", err)
+	if ptr.Deref(cfg.Controllers.BackupEntry.Enabled, true) {
+		if err := (&backupentry.Reconciler{
+			Config:   *cfg.Controllers.BackupEntry,
+			SeedName: cfg.SeedConfig.Name,
+		}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
+			return fmt.Errorf("failed adding BackupEntry controller: %w", err)
+		}
+	} else {
+		mgr.GetLogger().Info("Skipping BackupEntry controller since it is disabled in the configuration")
 	}
 
-	if err := (&bastion.Reconciler{
-		Config: *cfg.Controllers.Bastion,
-	}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
-		return fmt.Errorf("failed adding Bastion controller: %w", err)
+	if ptr.Deref(cfg.Controllers.Bastion.Enabled, true) {
+		if err := (&bastion.Reconciler{
+			Config: *cfg.Controllers.Bastion,
+		}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
+			return fmt.Errorf("failed adding Bastion controller: %w", err)
+		}
+	} else {
+		mgr.GetLogger().Info("Skipping Bastion controller since it is disabled in the configuration")
 	}
 
 	if err := controllerinstallation.AddToManager(ctx, mgr, gardenCluster, seedCluster, seedClientSet, *cfg, identity, gardenClusterIdentity); err != nil {
@@ -146,8 +154,12 @@ func AddToManager(
 		return fmt.Errorf("failed adding ManagedSeed controller: %w", err)
 	}
 
-	if err := networkpolicy.AddToManager(ctx, mgr, gardenletCancel, seedCluster, *cfg.Controllers.NetworkPolicy, cfg.SeedConfig.Spec.Networks, nil); err != nil {
-		return fmt.Errorf("failed adding NetworkPolicy controller: %w", err)
+	if ptr.Deref(cfg.Controllers.NetworkPolicy.Enabled, true) {
+		if err := networkpolicy.AddToManager(ctx, mgr, gardenletCancel, seedCluster, *cfg.Controllers.NetworkPolicy, cfg.SeedConfig.Spec.Networks, nil); err != nil {
+			return fmt.Errorf("failed adding NetworkPolicy controller: %w", err)
+		}
+	} else {
+		mgr.GetLogger().Info("Skipping NetworkPolicy controller since it is disabled in the configuration")
 	}
 
 	if err := seed.AddToManager(mgr, gardenCluster, seedCluster, seedClientSet, *cfg, identity, healthManager); err != nil {
@@ -162,12 +174,16 @@ func AddToManager(
 		return fmt.Errorf("failed adding VPAEvictionRequirements controller: %w", err)
 	}
 
-	if err := (&tokenrequestor.Reconciler{
-		ConcurrentSyncs: ptr.Deref(cfg.Controllers.TokenRequestorServiceAccount.ConcurrentSyncs, 0),
-		Class:           ptr.To(resourcesv1alpha1.ResourceManagerClassGarden),
-		TargetNamespace: gardenerutils.ComputeGardenNamespace(cfg.SeedConfig.Name),
-	}).AddToManager(mgr, seedCluster, gardenCluster); err != nil {
-		return fmt.Errorf("failed adding TokenRequestorServiceAccount controller: %w", err)
+	if ptr.Deref(cfg.Controllers.TokenRequestorServiceAccount.Enabled, true) {
+		if err := (&tokenrequestor.Reconciler{
+			ConcurrentSyncs: ptr.Deref(cfg.Controllers.TokenRequestorServiceAccount.ConcurrentSyncs, 0),
+			Class:           ptr.To(resourcesv1alpha1.ResourceManagerClassGarden),
+			TargetNamespace: gardenerutils.ComputeGardenNamespace(cfg.SeedConfig.Name),
+		}).AddToManager(mgr, seedCluster, gardenCluster); err != nil {
+			return fmt.Errorf("failed adding TokenRequestorServiceAccount controller: %w", err)
+		}
+	} else {
+		mgr.GetLogger().Info("Skipping TokenRequestorServiceAccount controller since it is disabled in the configuration")
 	}
 
 	if err := (&workloadidentity.Reconciler{
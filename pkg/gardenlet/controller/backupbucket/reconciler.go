@@ -135,6 +135,10 @@ func (r *Reconciler) reconcileBackupBucket(
 		// the timestamp in the secret to reconcile the extension.
 		mustReconcileExtensionSecret = false
 
+		// credentialsRotated indicates that the data of the referenced backup credentials changed compared to the
+		// credentials currently deployed to the seed, i.e. the credentials have been rotated
+		credentialsRotated = false
+
 		lastObservedError         error
 		extensionSecret           = r.emptyExtensionSecret(backupBucket.Name)
 		extensionBackupBucketSpec = extensionsv1alpha1.BackupBucketSpec{
@@ -163,6 +167,7 @@ func (r *Reconciler) reconcileBackupBucket(
 			if !reflect.DeepEqual(extensionSecret.Data, credentials.Data) {
 				mustReconcileExtensionBackupBucket = true
 				mustReconcileExtensionSecret = true
+				credentialsRotated = true
 			}
 		case *securityv1alpha1.WorkloadIdentity:
 			if secretChanged, err := workloadIdentitySecretChanged(backupBucket, extensionSecret, credentials); err != nil {
@@ -170,6 +175,7 @@ func (r *Reconciler) reconcileBackupBucket(
 			} else if secretChanged {
 				mustReconcileExtensionBackupBucket = true
 				mustReconcileExtensionSecret = true
+				credentialsRotated = true
 			}
 		}
 
@@ -179,6 +185,12 @@ func (r *Reconciler) reconcileBackupBucket(
 		}
 	}
 
+	if credentialsRotated {
+		if err := r.preserveOldBackupCredentials(seedCtx, log, backupBucket.Name, extensionSecret); err != nil {
+			return err
+		}
+	}
+
 	if mustReconcileExtensionSecret {
 		if err := r.reconcileBackupBucketExtensionSecret(seedCtx, extensionSecret, backupCredentials, backupBucket); err != nil {
 			return err
@@ -266,6 +278,10 @@ func (r *Reconciler) reconcileBackupBucket(
 	}
 
 	if extensionBackupBucket.Status.LastOperation != nil && extensionBackupBucket.Status.LastOperation.State == gardencorev1beta1.LastOperationStateSucceeded {
+		if err := r.completeBackupCredentialsRotation(seedCtx, log, backupBucket); err != nil {
+			return fmt.Errorf("could not complete backup credentials rotation: %w", err)
+		}
+
 		if updateErr := r.updateBackupBucketStatusSucceeded(gardenCtx, backupBucket, "Backup Bucket has been successfully reconciled."); updateErr != nil {
 			return fmt.Errorf("could not update status after reconciliation success: %w", updateErr)
 		}
@@ -332,6 +348,10 @@ func (r *Reconciler) deleteBackupBucket(
 		return reconcile.Result{}, err
 	}
 
+	if err := client.IgnoreNotFound(r.SeedClient.Delete(seedCtx, r.emptyOldBackupCredentialsSecret(backupBucket.Name))); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	if updateErr := r.updateBackupBucketStatusSucceeded(gardenCtx, backupBucket, "Backup Bucket has been successfully deleted."); updateErr != nil {
 		return reconcile.Result{}, fmt.Errorf("could not update status after deletion success: %w", updateErr)
 	}
@@ -332,6 +332,64 @@ var _ = Describe("Controller", func() {
 			Expect(extensionSecret.Annotations).To(Equal(map[string]string{v1beta1constants.GardenerTimestamp: fakeClock.Now().UTC().Format(time.RFC3339Nano)}))
 			Expect(extensionSecret.Labels).To(BeEmpty())
 		})
+
+		Context("credentials rotation", func() {
+			var oldCredentialsSecret *corev1.Secret
+
+			BeforeEach(func() {
+				oldCredentialsSecret = &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      generateBackupBucketSecretName(backupBucket.Name) + "-old",
+						Namespace: gardenNamespaceName,
+					},
+				}
+			})
+
+			It("should preserve the old credentials in a separate secret if the secret data has changed", func() {
+				extensionSecret.Data = map[string][]byte{"dash": []byte("bash")}
+				Expect(seedClient.Create(ctx, extensionSecret)).To(Succeed())
+				Expect(seedClient.Create(ctx, extensionBackupBucket)).To(Succeed())
+
+				result, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(reconcile.Result{}))
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(oldCredentialsSecret), oldCredentialsSecret)).To(Succeed())
+				Expect(oldCredentialsSecret.Data).To(Equal(map[string][]byte{"dash": []byte("bash")}))
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extensionSecret), extensionSecret)).To(Succeed())
+				Expect(extensionSecret.Data).To(Equal(gardenSecret.Data))
+			})
+
+			It("should trigger the extension BackupEntries of the bucket and discard the old credentials once the extension BackupBucket has succeeded", func() {
+				oldCredentialsSecret.Data = map[string][]byte{"dash": []byte("bash")}
+				Expect(seedClient.Create(ctx, oldCredentialsSecret)).To(Succeed())
+				Expect(seedClient.Create(ctx, extensionSecret)).To(Succeed())
+				Expect(seedClient.Create(ctx, extensionBackupBucket)).To(Succeed())
+
+				extensionBackupEntry := &extensionsv1alpha1.BackupEntry{
+					ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar"},
+					Spec:       extensionsv1alpha1.BackupEntrySpec{BucketName: backupBucket.Name},
+				}
+				otherExtensionBackupEntry := &extensionsv1alpha1.BackupEntry{
+					ObjectMeta: metav1.ObjectMeta{Name: "shoot--other--bar"},
+					Spec:       extensionsv1alpha1.BackupEntrySpec{BucketName: "other-bucket"},
+				}
+				Expect(seedClient.Create(ctx, extensionBackupEntry)).To(Succeed())
+				Expect(seedClient.Create(ctx, otherExtensionBackupEntry)).To(Succeed())
+
+				result, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(reconcile.Result{}))
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extensionBackupEntry), extensionBackupEntry)).To(Succeed())
+				Expect(extensionBackupEntry.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(otherExtensionBackupEntry), otherExtensionBackupEntry)).To(Succeed())
+				Expect(otherExtensionBackupEntry.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(oldCredentialsSecret), oldCredentialsSecret)).To(BeNotFoundError())
+			})
+		})
 	})
 
 	Describe("#WorkloadIdentity Credentials", func() {
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+// preserveOldBackupCredentials copies the credentials currently deployed to the seed into a separate secret before
+// they are overwritten with rotated credentials. The old credentials are only discarded once the provider extension
+// has verified the new ones, see completeBackupCredentialsRotation.
+func (r *Reconciler) preserveOldBackupCredentials(ctx context.Context, log logr.Logger, backupBucketName string, extensionSecret *corev1.Secret) error {
+	if len(extensionSecret.Data) == 0 {
+		return nil
+	}
+
+	oldCredentialsSecret := r.emptyOldBackupCredentialsSecret(backupBucketName)
+	log.Info("Backup credentials have been rotated, preserving old credentials until the new ones are verified", "secret", client.ObjectKeyFromObject(oldCredentialsSecret))
+
+	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, r.SeedClient, oldCredentialsSecret, func() error {
+		// if a rotation is already in progress, keep the credentials that were last verified
+		if oldCredentialsSecret.Data == nil {
+			oldCredentialsSecret.Data = extensionSecret.Data
+			metav1.SetMetaDataAnnotation(&oldCredentialsSecret.ObjectMeta, v1beta1constants.GardenerTimestamp, r.Clock.Now().UTC().Format(time.RFC3339Nano))
+		}
+		return nil
+	})
+	return err
+}
+
+// completeBackupCredentialsRotation finishes a rotation of the backup bucket credentials after the provider extension
+// has successfully reconciled the bucket with the new credentials (which verifies that snapshots can be uploaded with
+// them). All extension BackupEntries of the bucket are triggered to reconcile so that the etcd-backup-restore sidecars
+// of all hosted shoots reload the rotated credentials, and only then the preserved old credentials are discarded.
+func (r *Reconciler) completeBackupCredentialsRotation(ctx context.Context, log logr.Logger, backupBucket *gardencorev1beta1.BackupBucket) error {
+	oldCredentialsSecret := r.emptyOldBackupCredentialsSecret(backupBucket.Name)
+	if err := r.SeedClient.Get(ctx, client.ObjectKeyFromObject(oldCredentialsSecret), oldCredentialsSecret); err != nil {
+		if apierrors.IsNotFound(err) {
+			// no rotation is in progress
+			return nil
+		}
+		return err
+	}
+
+	backupEntryList := &extensionsv1alpha1.BackupEntryList{}
+	if err := r.SeedClient.List(ctx, backupEntryList); err != nil {
+		return fmt.Errorf("could not list extension BackupEntries: %w", err)
+	}
+
+	for _, backupEntry := range backupEntryList.Items {
+		if backupEntry.Spec.BucketName != backupBucket.Name {
+			continue
+		}
+
+		log.Info("Triggering reconciliation of extension BackupEntry so that etcd-backup-restore reloads the rotated credentials", "backupEntry", client.ObjectKeyFromObject(&backupEntry))
+		patch := client.MergeFrom(backupEntry.DeepCopy())
+		metav1.SetMetaDataAnnotation(&backupEntry.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
+		metav1.SetMetaDataAnnotation(&backupEntry.ObjectMeta, v1beta1constants.GardenerTimestamp, r.Clock.Now().UTC().Format(time.RFC3339Nano))
+		if err := r.SeedClient.Patch(ctx, &backupEntry, patch); err != nil {
+			return fmt.Errorf("could not trigger reconciliation of extension BackupEntry %q: %w", backupEntry.Name, err)
+		}
+	}
+
+	log.Info("Backup credentials rotation completed, discarding old credentials", "secret", client.ObjectKeyFromObject(oldCredentialsSecret))
+	return kubernetesutils.DeleteObject(ctx, r.SeedClient, oldCredentialsSecret)
+}
+
+func (r *Reconciler) emptyOldBackupCredentialsSecret(backupBucketName string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      generateBackupBucketSecretName(backupBucketName) + "-old",
+			Namespace: r.GardenNamespace,
+		},
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"time"
 
+	coordinationv1 "k8s.io/api/coordination/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/clock"
@@ -20,7 +21,9 @@ import (
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/extensions"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 )
 
@@ -59,8 +62,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		conditionControllerInstallationInstalled   = v1beta1helper.GetOrInitConditionWithClock(r.Clock, controllerInstallation.Status.Conditions, gardencorev1beta1.ControllerInstallationInstalled)
 		conditionControllerInstallationHealthy     = v1beta1helper.GetOrInitConditionWithClock(r.Clock, controllerInstallation.Status.Conditions, gardencorev1beta1.ControllerInstallationHealthy)
 		conditionControllerInstallationProgressing = v1beta1helper.GetOrInitConditionWithClock(r.Clock, controllerInstallation.Status.Conditions, gardencorev1beta1.ControllerInstallationProgressing)
+
+		heartbeatConditions []gardencorev1beta1.Condition
 	)
 
+	if r.Config.ExtensionHeartbeats != nil && r.Config.ExtensionHeartbeats.Enabled {
+		conditionControllerInstallationHeartbeatUpdated := v1beta1helper.GetOrInitConditionWithClock(r.Clock, controllerInstallation.Status.Conditions, gardencorev1beta1.ControllerInstallationHeartbeatUpdated)
+		heartbeatConditions = append(heartbeatConditions, r.checkExtensionHeartbeat(seedCtx, controllerInstallation, conditionControllerInstallationHeartbeatUpdated))
+	}
+
 	managedResource := &resourcesv1alpha1.ManagedResource{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      controllerInstallation.Name,
@@ -75,7 +85,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		conditionControllerInstallationProgressing = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionControllerInstallationProgressing, gardencorev1beta1.ConditionUnknown, "SeedReadError", msg)
 
 		patch := client.StrategicMergeFrom(controllerInstallation.DeepCopy())
-		controllerInstallation.Status.Conditions = v1beta1helper.MergeConditions(controllerInstallation.Status.Conditions, conditionControllerInstallationHealthy, conditionControllerInstallationInstalled, conditionControllerInstallationProgressing)
+		controllerInstallation.Status.Conditions = v1beta1helper.MergeConditions(controllerInstallation.Status.Conditions, append([]gardencorev1beta1.Condition{conditionControllerInstallationHealthy, conditionControllerInstallationInstalled, conditionControllerInstallationProgressing}, heartbeatConditions...)...)
 		if err := r.GardenClient.Status().Patch(gardenCtx, controllerInstallation, patch); err != nil {
 			return reconcile.Result{}, fmt.Errorf("failed to patch conditions: %w", err)
 		}
@@ -107,10 +117,52 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	patch := client.StrategicMergeFrom(controllerInstallation.DeepCopy())
-	controllerInstallation.Status.Conditions = v1beta1helper.MergeConditions(controllerInstallation.Status.Conditions, conditionControllerInstallationHealthy, conditionControllerInstallationInstalled, conditionControllerInstallationProgressing)
+	controllerInstallation.Status.Conditions = v1beta1helper.MergeConditions(controllerInstallation.Status.Conditions, append([]gardencorev1beta1.Condition{conditionControllerInstallationHealthy, conditionControllerInstallationInstalled, conditionControllerInstallationProgressing}, heartbeatConditions...)...)
 	if err := r.GardenClient.Status().Patch(gardenCtx, controllerInstallation, patch); err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed to patch conditions: %w", err)
 	}
 
 	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
 }
+
+// checkExtensionHeartbeat checks whether the extension controller belonging to the given ControllerInstallation has
+// recently renewed its heartbeat lease in the seed cluster and returns an accordingly updated condition.
+func (r *Reconciler) checkExtensionHeartbeat(ctx context.Context, controllerInstallation *gardencorev1beta1.ControllerInstallation, condition gardencorev1beta1.Condition) gardencorev1beta1.Condition {
+	heartbeatLease := &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      extensions.HeartBeatResourceName,
+			Namespace: gardenerutils.NamespaceNameForControllerInstallation(controllerInstallation),
+		},
+	}
+
+	if err := r.SeedClient.Get(ctx, client.ObjectKeyFromObject(heartbeatLease), heartbeatLease); err != nil {
+		if apierrors.IsNotFound(err) {
+			return v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionUnknown, "HeartbeatLeaseNotFound", fmt.Sprintf("The heartbeat lease %q was not found in the seed cluster.", client.ObjectKeyFromObject(heartbeatLease).String()))
+		}
+		return v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionUnknown, "SeedReadError", fmt.Sprintf("Failed to get heartbeat lease %q: %s", client.ObjectKeyFromObject(heartbeatLease).String(), err.Error()))
+	}
+
+	staleThreshold := r.staleThresholdForControllerInstallation(controllerInstallation)
+
+	if heartbeatLease.Spec.RenewTime == nil || r.Clock.Now().UTC().Sub(heartbeatLease.Spec.RenewTime.UTC()) > staleThreshold {
+		lastRenewTime := "never"
+		if heartbeatLease.Spec.RenewTime != nil {
+			lastRenewTime = heartbeatLease.Spec.RenewTime.UTC().Round(time.Second).String()
+		}
+		return v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionFalse, "HeartbeatStale", fmt.Sprintf("The extension controller did not renew its heartbeat lease within the last %s (last renewal: %s). It is probably not running, so errors in dependent shoots can be attributed to this extension.", staleThreshold.String(), lastRenewTime))
+	}
+
+	return v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionTrue, "HeartbeatUpdated", "The extension controller regularly renews its heartbeat lease.")
+}
+
+// staleThresholdForControllerInstallation returns the configured stale threshold for the ControllerInstallation's
+// ControllerRegistration, falling back to the default stale threshold.
+func (r *Reconciler) staleThresholdForControllerInstallation(controllerInstallation *gardencorev1beta1.ControllerInstallation) time.Duration {
+	if threshold, ok := r.Config.ExtensionHeartbeats.StaleThresholds[controllerInstallation.Spec.RegistrationRef.Name]; ok {
+		return threshold.Duration
+	}
+	if r.Config.ExtensionHeartbeats.DefaultStaleThreshold != nil {
+		return r.Config.ExtensionHeartbeats.DefaultStaleThreshold.Duration
+	}
+	return 5 * time.Minute
+}
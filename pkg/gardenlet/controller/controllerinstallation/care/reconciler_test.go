@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	gomegatypes "github.com/onsi/gomega/types"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -22,6 +23,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/extensions"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	. "github.com/gardener/gardener/pkg/gardenlet/controller/controllerinstallation/care"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
@@ -29,6 +31,7 @@ import (
 
 const (
 	controllerInstallationName = "foo"
+	registrationName           = "foo-registration"
 	gardenNamespace            = "garden"
 	syncPeriodDuration         = 2 * time.Second
 )
@@ -55,6 +58,9 @@ var _ = Describe("Reconciler", func() {
 				Name: controllerInstallationName,
 			},
 			Spec: gardencorev1beta1.ControllerInstallationSpec{
+				RegistrationRef: corev1.ObjectReference{
+					Name: registrationName,
+				},
 				SeedRef: corev1.ObjectReference{
 					Name: "foo-seed",
 				},
@@ -152,6 +158,76 @@ var _ = Describe("Reconciler", func() {
 				),
 			),
 		)
+
+		Context("when extension heartbeats are enabled", func() {
+			BeforeEach(func() {
+				reconciler = &Reconciler{
+					GardenClient: gardenClient,
+					SeedClient:   seedClient,
+					Config: gardenletconfigv1alpha1.ControllerInstallationCareControllerConfiguration{
+						SyncPeriod: &metav1.Duration{Duration: syncPeriodDuration},
+						ExtensionHeartbeats: &gardenletconfigv1alpha1.ExtensionHeartbeats{
+							Enabled:               true,
+							DefaultStaleThreshold: &metav1.Duration{Duration: 5 * time.Minute},
+						},
+					},
+					Clock:           fakeClock,
+					GardenNamespace: gardenNamespace,
+				}
+
+				Expect(seedClient.Create(ctx, healthyManagedResource())).To(Succeed())
+			})
+
+			It("should set the HeartbeatUpdated condition to Unknown if the heartbeat lease does not exist", func() {
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(controllerInstallation), controllerInstallation)).To(Succeed())
+				Expect(controllerInstallation.Status.Conditions).To(ContainElement(
+					conditionWithTypeStatusReasonAndMessage(gardencorev1beta1.ControllerInstallationHeartbeatUpdated, gardencorev1beta1.ConditionUnknown, "HeartbeatLeaseNotFound", "was not found in the seed cluster"),
+				))
+			})
+
+			It("should set the HeartbeatUpdated condition to True if the heartbeat lease was renewed recently", func() {
+				Expect(seedClient.Create(ctx, heartbeatLease(fakeClock.Now().Add(-time.Minute)))).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(controllerInstallation), controllerInstallation)).To(Succeed())
+				Expect(controllerInstallation.Status.Conditions).To(ContainElement(
+					conditionWithTypeStatusReasonAndMessage(gardencorev1beta1.ControllerInstallationHeartbeatUpdated, gardencorev1beta1.ConditionTrue, "HeartbeatUpdated", "regularly renews its heartbeat lease"),
+				))
+			})
+
+			It("should set the HeartbeatUpdated condition to False if the heartbeat lease was not renewed within the default stale threshold", func() {
+				Expect(seedClient.Create(ctx, heartbeatLease(fakeClock.Now().Add(-10*time.Minute)))).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(controllerInstallation), controllerInstallation)).To(Succeed())
+				Expect(controllerInstallation.Status.Conditions).To(ContainElement(
+					conditionWithTypeStatusReasonAndMessage(gardencorev1beta1.ControllerInstallationHeartbeatUpdated, gardencorev1beta1.ConditionFalse, "HeartbeatStale", "did not renew its heartbeat lease within the last 5m0s"),
+				))
+			})
+
+			It("should prefer the stale threshold configured for the extension over the default stale threshold", func() {
+				reconciler.(*Reconciler).Config.ExtensionHeartbeats.StaleThresholds = map[string]metav1.Duration{
+					registrationName: {Duration: 30 * time.Second},
+				}
+
+				Expect(seedClient.Create(ctx, heartbeatLease(fakeClock.Now().Add(-time.Minute)))).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(controllerInstallation), controllerInstallation)).To(Succeed())
+				Expect(controllerInstallation.Status.Conditions).To(ContainElement(
+					conditionWithTypeStatusReasonAndMessage(gardencorev1beta1.ControllerInstallationHeartbeatUpdated, gardencorev1beta1.ConditionFalse, "HeartbeatStale", "did not renew its heartbeat lease within the last 30s"),
+				))
+			})
+		})
 	})
 })
 
@@ -213,6 +289,18 @@ func notHealthyManagedResource() *resourcesv1alpha1.ManagedResource {
 		})
 }
 
+func heartbeatLease(renewTime time.Time) *coordinationv1.Lease {
+	return &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      extensions.HeartBeatResourceName,
+			Namespace: "extension-" + controllerInstallationName,
+		},
+		Spec: coordinationv1.LeaseSpec{
+			RenewTime: &metav1.MicroTime{Time: renewTime},
+		},
+	}
+}
+
 func managedResource(conditions []gardencorev1beta1.Condition) *resourcesv1alpha1.ManagedResource {
 	return &resourcesv1alpha1.ManagedResource{
 		ObjectMeta: metav1.ObjectMeta{
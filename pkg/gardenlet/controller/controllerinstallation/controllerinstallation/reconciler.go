@@ -169,6 +169,13 @@ func (r *Reconciler) reconcile(
 		}
 	}
 
+	overrideValues, err := SeedHelmValuesOverride(seed, controllerRegistration.Name)
+	if err != nil {
+		conditionValid = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionValid, gardencorev1beta1.ConditionFalse, "ChartInformationInvalid", fmt.Sprintf("seed-specific chart value overrides cannot be unmarshalled: %+v", err))
+		return reconcile.Result{}, err
+	}
+	helmValues = utils.MergeMaps(helmValues, overrideValues)
+
 	seedIsGarden, err := gardenletutils.SeedIsGarden(seedCtx, r.SeedClientSet.Client())
 	if err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed checking whether the seed is the garden cluster at the same time: %w", err)
@@ -472,6 +479,23 @@ func patchConditions(ctx context.Context, c client.StatusClient, controllerInsta
 	return c.Status().Patch(ctx, controllerInstallation, patch)
 }
 
+// SeedHelmValuesOverride returns the seed-specific Helm values override for the ControllerRegistration with the given
+// name. The override is read from the respective `helm-values-override.controllerinstallation.seed.gardener.cloud/`
+// annotation on the Seed, whose value must be a JSON object. It returns nil if no such annotation is present.
+func SeedHelmValuesOverride(seed *gardencorev1beta1.Seed, controllerRegistrationName string) (map[string]interface{}, error) {
+	rawValues, ok := seed.Annotations[v1beta1constants.AnnotationPrefixSeedHelmValuesOverride+controllerRegistrationName]
+	if !ok {
+		return nil, nil
+	}
+
+	var overrideValues map[string]interface{}
+	if err := json.Unmarshal([]byte(rawValues), &overrideValues); err != nil {
+		return nil, fmt.Errorf("failed unmarshalling Helm values override for ControllerRegistration %q from seed annotation: %w", controllerRegistrationName, err)
+	}
+
+	return overrideValues, nil
+}
+
 func getNamespaceForControllerInstallation(controllerInstallation *gardencorev1beta1.ControllerInstallation) *corev1.Namespace {
 	return &corev1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
@@ -13,9 +13,12 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	. "github.com/gardener/gardener/pkg/gardenlet/controller/controllerinstallation/controllerinstallation"
 )
 
@@ -161,4 +164,42 @@ var _ = Describe("Reconciler", func() {
 			}
 		})
 	})
+
+	Describe("#SeedHelmValuesOverride", func() {
+		var seed *gardencorev1beta1.Seed
+
+		BeforeEach(func() {
+			seed = &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "test"}}
+		})
+
+		It("should return nil if the seed has no override annotation", func() {
+			values, err := SeedHelmValuesOverride(seed, "provider-local")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(BeNil())
+		})
+
+		It("should return nil if the override annotation targets another ControllerRegistration", func() {
+			metav1.SetMetaDataAnnotation(&seed.ObjectMeta, v1beta1constants.AnnotationPrefixSeedHelmValuesOverride+"provider-other", `{"foo":"bar"}`)
+
+			values, err := SeedHelmValuesOverride(seed, "provider-local")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(BeNil())
+		})
+
+		It("should return the unmarshalled override values", func() {
+			metav1.SetMetaDataAnnotation(&seed.ObjectMeta, v1beta1constants.AnnotationPrefixSeedHelmValuesOverride+"provider-local", `{"resources":{"limits":{"memory":"256Mi"}}}`)
+
+			values, err := SeedHelmValuesOverride(seed, "provider-local")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{"resources": map[string]interface{}{"limits": map[string]interface{}{"memory": "256Mi"}}}))
+		})
+
+		It("should return an error if the override annotation value is no valid JSON object", func() {
+			metav1.SetMetaDataAnnotation(&seed.ObjectMeta, v1beta1constants.AnnotationPrefixSeedHelmValuesOverride+"provider-local", `{invalid`)
+
+			values, err := SeedHelmValuesOverride(seed, "provider-local")
+			Expect(err).To(MatchError(ContainSubstring("failed unmarshalling Helm values override")))
+			Expect(values).To(BeNil())
+		})
+	})
 })
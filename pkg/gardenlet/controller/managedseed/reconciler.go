@@ -7,6 +7,8 @@ package managedseed
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
@@ -163,9 +165,39 @@ func (r *Reconciler) newActuator(ctx context.Context, shoot *gardencorev1beta1.S
 		ValuesHelper:             gardenletdeployer.NewValuesHelper(&r.Config),
 		Recorder:                 r.Recorder,
 		GardenletNamespaceTarget: r.GardenNamespaceShoot,
+		ConfigTemplateValues:     configTemplateValues(shoot),
 	}, nil
 }
 
+// configTemplateValues computes the per-seed values that may be referenced as ${<name>} variables in the gardenlet
+// component configuration of the ManagedSeed, e.g. ${region} or ${zones}. This allows a single ManagedSeedSet or
+// ManagedSeed template to inject per-replica values without maintaining divergent specs.
+func configTemplateValues(shoot *gardencorev1beta1.Shoot) map[string]string {
+	values := map[string]string{
+		"name":      shoot.Name,
+		"namespace": shoot.Namespace,
+		"region":    shoot.Spec.Region,
+	}
+
+	if shoot.Spec.DNS != nil && shoot.Spec.DNS.Domain != nil {
+		values["domain"] = *shoot.Spec.DNS.Domain
+	}
+
+	var zones []string
+	for _, worker := range shoot.Spec.Provider.Workers {
+		for _, zone := range worker.Zones {
+			if !slices.Contains(zones, zone) {
+				zones = append(zones, zone)
+			}
+		}
+	}
+	if len(zones) > 0 {
+		values["zones"] = strings.Join(zones, ",")
+	}
+
+	return values
+}
+
 func (r *Reconciler) reconcile(
 	ctx context.Context,
 	log logr.Logger,
@@ -201,9 +233,40 @@ func (r *Reconciler) reconcile(
 	updateCondition(r.Clock, status, seedmanagementv1alpha1.ManagedSeedShootReconciled, gardencorev1beta1.ConditionTrue, gardencorev1beta1.EventReconciled,
 		fmt.Sprintf("Shoot %q has been reconciled", client.ObjectKeyFromObject(shoot).String()))
 
+	// Resolve the upgrade channel, if specified, and override the gardenlet image tag with the resolved version
+	deployment := ms.Spec.Gardenlet.Deployment
+	if channel := ms.Spec.Gardenlet.UpgradeChannel; channel != nil {
+		version, err := r.resolveUpgradeChannelVersion(ctx, *channel)
+		if err != nil {
+			if updateErr := r.updateStatus(ctx, ms, status); updateErr != nil {
+				log.Error(updateErr, "Could not update status", "status", status)
+			}
+			return reconcile.Result{}, fmt.Errorf("could not resolve upgrade channel %q for ManagedSeed %s: %w", *channel, client.ObjectKeyFromObject(ms), err)
+		}
+
+		log.V(1).Info("Resolved upgrade channel", "channel", *channel, "version", version)
+		deployment = deployment.DeepCopy()
+		if deployment == nil {
+			deployment = &seedmanagementv1alpha1.GardenletDeployment{}
+		}
+		if deployment.Image == nil {
+			deployment.Image = &seedmanagementv1alpha1.Image{}
+		}
+		deployment.Image.Tag = &version
+	}
+
+	// Watch over gardenlet rollouts and roll back failed self-upgrades to the last known-good version
+	deployment, err = r.rollBackGardenletIfUnhealthy(ctx, log, ms, shoot, status, deployment)
+	if err != nil {
+		if updateErr := r.updateStatus(ctx, ms, status); updateErr != nil {
+			log.Error(updateErr, "Could not update status", "status", status)
+		}
+		return reconcile.Result{}, fmt.Errorf("could not check gardenlet health for ManagedSeed %s: %w", client.ObjectKeyFromObject(ms), err)
+	}
+
 	// Reconcile creation or update
 	log.V(1).Info("Reconciling")
-	status.Conditions, err = actuator.Reconcile(ctx, log, ms, status.Conditions, ms.Spec.Gardenlet.Deployment, &ms.Spec.Gardenlet.Config, helper.GetBootstrap(ms.Spec.Gardenlet.Bootstrap), ptr.Deref(ms.Spec.Gardenlet.MergeWithParent, false))
+	status.Conditions, err = actuator.Reconcile(ctx, log, ms, status.Conditions, deployment, &ms.Spec.Gardenlet.Config, helper.GetBootstrap(ms.Spec.Gardenlet.Bootstrap), ptr.Deref(ms.Spec.Gardenlet.MergeWithParent, false))
 	if err != nil {
 		if updateErr := r.updateStatus(ctx, ms, status); updateErr != nil {
 			log.Error(updateErr, "Could not update status", "status", status)
@@ -11,18 +11,28 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/apis/seedmanagement/encoding"
 	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	seedmanagementv1alpha1constants "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakeclientmap "github.com/gardener/gardener/pkg/client/kubernetes/clientmap/fake"
+	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/keys"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	mockgardenletdeployer "github.com/gardener/gardener/pkg/controller/gardenletdeployer/mock"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	. "github.com/gardener/gardener/pkg/gardenlet/controller/managedseed"
@@ -44,6 +54,7 @@ var _ = Describe("Reconciler", func() {
 		actuator           *mockgardenletdeployer.MockInterface
 		gardenClient       *mockclient.MockClient
 		gardenStatusWriter *mockclient.MockStatusWriter
+		shootClient        client.Client
 
 		cfg       gardenletconfigv1alpha1.GardenletConfiguration
 		fakeClock clock.Clock
@@ -77,12 +88,19 @@ var _ = Describe("Reconciler", func() {
 		fakeClock = testclock.NewFakeClock(time.Time{})
 
 		gardenClusterAddress := "foobar"
+		shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+		shootClientSet := fakekubernetes.NewClientSetBuilder().WithClient(shootClient).Build()
+		shootClientMap := fakeclientmap.NewClientMapBuilder().WithClientSetForKey(keys.ForShoot(&gardencorev1beta1.Shoot{}), shootClientSet).Build()
+
 		reconciler = &Reconciler{
-			GardenAPIReader: gardenClient,
-			GardenClient:    gardenClient,
-			Config:          cfg,
-			Clock:           fakeClock,
-			Recorder:        &record.FakeRecorder{},
+			GardenAPIReader:       gardenClient,
+			GardenClient:          gardenClient,
+			Config:                cfg,
+			Clock:                 fakeClock,
+			Recorder:              &record.FakeRecorder{},
+			ShootClientMap:        shootClientMap,
+			GardenNamespaceGarden: namespace,
+			GardenNamespaceShoot:  namespace,
 		}
 		Actuator = actuator
 		DeferCleanup(func() { Actuator = nil })
@@ -213,6 +231,263 @@ var _ = Describe("Reconciler", func() {
 			})
 		})
 
+		Context("reconcile with upgrade channel", func() {
+			var expectGetUpgradeChannels func(data map[string]string)
+
+			BeforeEach(func() {
+				managedSeed.Finalizers = []string{gardencorev1beta1.GardenerName}
+				managedSeed.Spec.Gardenlet.UpgradeChannel = ptr.To(seedmanagementv1alpha1.UpgradeChannelStable)
+				managedSeed.Status.Conditions = []gardencorev1beta1.Condition{{
+					Type:               seedmanagementv1alpha1.ManagedSeedShootReconciled,
+					Status:             gardencorev1beta1.ConditionTrue,
+					LastTransitionTime: metav1.Time{Time: fakeClock.Now()},
+					LastUpdateTime:     metav1.Time{Time: fakeClock.Now()},
+					Reason:             gardencorev1beta1.EventReconciled,
+					Message:            `Shoot "/" has been reconciled`,
+				}}
+
+				expectGetUpgradeChannels = func(data map[string]string) {
+					gardenClient.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: namespace, Name: "gardenlet-upgrade-channels"}, gomock.AssignableToTypeOf(&corev1.ConfigMap{})).DoAndReturn(
+						func(_ context.Context, _ client.ObjectKey, cm *corev1.ConfigMap, _ ...client.GetOption) error {
+							cm.Data = data
+							return nil
+						},
+					)
+				}
+			})
+
+			expectReconcileWithImageTag := func(tag string) {
+				actuator.EXPECT().Reconcile(gomock.Any(), gomock.AssignableToTypeOf(logr.Logger{}), managedSeed, gomock.Any(), gomock.AssignableToTypeOf(&seedmanagementv1alpha1.GardenletDeployment{}), &managedSeed.Spec.Gardenlet.Config, seedmanagementv1alpha1.BootstrapNone, false).DoAndReturn(
+					func(_ context.Context, _ logr.Logger, _ client.Object, _ []gardencorev1beta1.Condition, deployment *seedmanagementv1alpha1.GardenletDeployment, _ *runtime.RawExtension, _ seedmanagementv1alpha1.Bootstrap, _ bool) ([]gardencorev1beta1.Condition, error) {
+						Expect(deployment.Image).NotTo(BeNil())
+						Expect(deployment.Image.Tag).To(Equal(ptr.To(tag)))
+						return nil, nil
+					},
+				)
+			}
+
+			It("should resolve the channel and override the gardenlet image tag with the published version", func() {
+				expectGetManagedSeed()
+				expectGetShoot()
+				expectGetUpgradeChannels(map[string]string{
+					"stable.version": "v1.2.3",
+				})
+				expectReconcileWithImageTag("v1.2.3")
+				expectPatchManagedSeedStatus(func(ms *seedmanagementv1alpha1.ManagedSeed) {
+					Expect(&ms.Status).To(Equal(status))
+				})
+
+				result, err := reconciler.Reconcile(ctx, request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			})
+
+			It("should keep the previously published version while the latest version is still soaking", func() {
+				expectGetManagedSeed()
+				expectGetShoot()
+				expectGetUpgradeChannels(map[string]string{
+					"stable.version":         "v1.2.3",
+					"stable.previousVersion": "v1.2.2",
+					"stable.publishedAt":     fakeClock.Now().UTC().Format(time.RFC3339),
+					"stable.soakDuration":    "24h",
+				})
+				expectReconcileWithImageTag("v1.2.2")
+				expectPatchManagedSeedStatus(func(ms *seedmanagementv1alpha1.ManagedSeed) {
+					Expect(&ms.Status).To(Equal(status))
+				})
+
+				result, err := reconciler.Reconcile(ctx, request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			})
+
+			It("should roll out the latest version once its soak time has elapsed", func() {
+				fakeClock.(*testclock.FakeClock).SetTime(fakeClock.Now().Add(25 * time.Hour))
+
+				expectGetManagedSeed()
+				expectGetShoot()
+				expectGetUpgradeChannels(map[string]string{
+					"stable.version":         "v1.2.3",
+					"stable.previousVersion": "v1.2.2",
+					"stable.publishedAt":     time.Time{}.UTC().Format(time.RFC3339),
+					"stable.soakDuration":    "24h",
+				})
+				expectReconcileWithImageTag("v1.2.3")
+				expectPatchManagedSeedStatus(func(ms *seedmanagementv1alpha1.ManagedSeed) {
+					Expect(&ms.Status).To(Equal(status))
+				})
+
+				result, err := reconciler.Reconcile(ctx, request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			})
+
+			It("should fail if the channel does not publish a version", func() {
+				expectGetManagedSeed()
+				expectGetShoot()
+				expectGetUpgradeChannels(nil)
+				expectPatchManagedSeedStatus(func(ms *seedmanagementv1alpha1.ManagedSeed) {
+					Expect(ms.Status.ObservedGeneration).To(Equal(int64(1)))
+				})
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).To(MatchError(ContainSubstring(`no version published for upgrade channel "stable"`)))
+			})
+		})
+
+		Context("reconcile with gardenlet rollout watchdog", func() {
+			var gardenletDeployment *appsv1.Deployment
+
+			BeforeEach(func() {
+				managedSeed.Finalizers = []string{gardencorev1beta1.GardenerName}
+				managedSeed.Status.Conditions = []gardencorev1beta1.Condition{{
+					Type:               seedmanagementv1alpha1.ManagedSeedShootReconciled,
+					Status:             gardencorev1beta1.ConditionTrue,
+					LastTransitionTime: metav1.Time{Time: fakeClock.Now()},
+					LastUpdateTime:     metav1.Time{Time: fakeClock.Now()},
+					Reason:             gardencorev1beta1.EventReconciled,
+					Message:            `Shoot "/" has been reconciled`,
+				}}
+
+				gardenletDeployment = &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "gardenlet",
+						Namespace:  namespace,
+						Generation: 1,
+					},
+					Spec: appsv1.DeploymentSpec{
+						Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "gardener", "role": "gardenlet"}},
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{{
+									Name:  "gardenlet",
+									Image: "example.com/gardener/gardenlet:v1.2.3",
+								}},
+							},
+						},
+					},
+					Status: appsv1.DeploymentStatus{
+						ObservedGeneration: 1,
+						Conditions: []appsv1.DeploymentCondition{{
+							Type:   appsv1.DeploymentAvailable,
+							Status: corev1.ConditionTrue,
+						}},
+					},
+				}
+			})
+
+			It("should record the version of a healthy gardenlet as known-good", func() {
+				Expect(shootClient.Create(ctx, gardenletDeployment)).To(Succeed())
+
+				expectGetManagedSeed()
+				expectGetShoot()
+				expectPatchManagedSeed(func(ms *seedmanagementv1alpha1.ManagedSeed) {
+					Expect(ms.Annotations).To(HaveKeyWithValue(seedmanagementv1alpha1constants.AnnotationKnownGoodGardenletVersion, "v1.2.3"))
+				})
+				actuator.EXPECT().Reconcile(gomock.Any(), gomock.AssignableToTypeOf(logr.Logger{}), managedSeed, managedSeed.Status.Conditions, managedSeed.Spec.Gardenlet.Deployment, &managedSeed.Spec.Gardenlet.Config, seedmanagementv1alpha1.BootstrapNone, false).Return(nil, nil)
+				expectPatchManagedSeedStatus(func(ms *seedmanagementv1alpha1.ManagedSeed) {
+					Expect(&ms.Status).To(Equal(status))
+				})
+
+				result, err := reconciler.Reconcile(ctx, request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			})
+
+			It("should roll back a crash-looping gardenlet to the last known-good version", func() {
+				gardenletDeployment.Status.Conditions = []appsv1.DeploymentCondition{{
+					Type:               appsv1.DeploymentAvailable,
+					Status:             corev1.ConditionFalse,
+					LastTransitionTime: metav1.Time{Time: fakeClock.Now()},
+				}}
+				Expect(shootClient.Create(ctx, gardenletDeployment)).To(Succeed())
+				Expect(shootClient.Create(ctx, &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "gardenlet-766b774f64-b2j6q",
+						Namespace: namespace,
+						Labels:    map[string]string{"app": "gardener", "role": "gardenlet"},
+					},
+					Status: corev1.PodStatus{
+						ContainerStatuses: []corev1.ContainerStatus{{
+							State: corev1.ContainerState{
+								Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+							},
+						}},
+					},
+				})).To(Succeed())
+				managedSeed.Annotations = map[string]string{seedmanagementv1alpha1constants.AnnotationKnownGoodGardenletVersion: "v1.2.2"}
+
+				expectGetManagedSeed()
+				expectGetShoot()
+				actuator.EXPECT().Reconcile(gomock.Any(), gomock.AssignableToTypeOf(logr.Logger{}), managedSeed, gomock.Any(), gomock.AssignableToTypeOf(&seedmanagementv1alpha1.GardenletDeployment{}), &managedSeed.Spec.Gardenlet.Config, seedmanagementv1alpha1.BootstrapNone, false).DoAndReturn(
+					func(_ context.Context, _ logr.Logger, _ client.Object, conditions []gardencorev1beta1.Condition, deployment *seedmanagementv1alpha1.GardenletDeployment, _ *runtime.RawExtension, _ seedmanagementv1alpha1.Bootstrap, _ bool) ([]gardencorev1beta1.Condition, error) {
+						Expect(deployment.Image).NotTo(BeNil())
+						Expect(deployment.Image.Tag).To(Equal(ptr.To("v1.2.2")))
+						Expect(conditions).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(seedmanagementv1alpha1.ManagedSeedGardenletRolledBack),
+							"Status": Equal(gardencorev1beta1.ConditionTrue),
+						})))
+						return nil, nil
+					},
+				)
+				expectPatchManagedSeedStatus(func(ms *seedmanagementv1alpha1.ManagedSeed) {
+					Expect(&ms.Status).To(Equal(status))
+				})
+
+				result, err := reconciler.Reconcile(ctx, request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			})
+
+			It("should roll back a gardenlet that has been unavailable beyond the threshold", func() {
+				gardenletDeployment.Status.Conditions = []appsv1.DeploymentCondition{{
+					Type:               appsv1.DeploymentAvailable,
+					Status:             corev1.ConditionFalse,
+					LastTransitionTime: metav1.Time{Time: fakeClock.Now().Add(-11 * time.Minute)},
+				}}
+				Expect(shootClient.Create(ctx, gardenletDeployment)).To(Succeed())
+				managedSeed.Annotations = map[string]string{seedmanagementv1alpha1constants.AnnotationKnownGoodGardenletVersion: "v1.2.2"}
+
+				expectGetManagedSeed()
+				expectGetShoot()
+				actuator.EXPECT().Reconcile(gomock.Any(), gomock.AssignableToTypeOf(logr.Logger{}), managedSeed, gomock.Any(), gomock.AssignableToTypeOf(&seedmanagementv1alpha1.GardenletDeployment{}), &managedSeed.Spec.Gardenlet.Config, seedmanagementv1alpha1.BootstrapNone, false).DoAndReturn(
+					func(_ context.Context, _ logr.Logger, _ client.Object, _ []gardencorev1beta1.Condition, deployment *seedmanagementv1alpha1.GardenletDeployment, _ *runtime.RawExtension, _ seedmanagementv1alpha1.Bootstrap, _ bool) ([]gardencorev1beta1.Condition, error) {
+						Expect(deployment.Image).NotTo(BeNil())
+						Expect(deployment.Image.Tag).To(Equal(ptr.To("v1.2.2")))
+						return nil, nil
+					},
+				)
+				expectPatchManagedSeedStatus(func(ms *seedmanagementv1alpha1.ManagedSeed) {
+					Expect(&ms.Status).To(Equal(status))
+				})
+
+				result, err := reconciler.Reconcile(ctx, request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			})
+
+			It("should not roll back while the gardenlet has been unavailable for less than the threshold", func() {
+				gardenletDeployment.Status.Conditions = []appsv1.DeploymentCondition{{
+					Type:               appsv1.DeploymentAvailable,
+					Status:             corev1.ConditionFalse,
+					LastTransitionTime: metav1.Time{Time: fakeClock.Now()},
+				}}
+				Expect(shootClient.Create(ctx, gardenletDeployment)).To(Succeed())
+				managedSeed.Annotations = map[string]string{seedmanagementv1alpha1constants.AnnotationKnownGoodGardenletVersion: "v1.2.2"}
+
+				expectGetManagedSeed()
+				expectGetShoot()
+				actuator.EXPECT().Reconcile(gomock.Any(), gomock.AssignableToTypeOf(logr.Logger{}), managedSeed, managedSeed.Status.Conditions, managedSeed.Spec.Gardenlet.Deployment, &managedSeed.Spec.Gardenlet.Config, seedmanagementv1alpha1.BootstrapNone, false).Return(nil, nil)
+				expectPatchManagedSeedStatus(func(ms *seedmanagementv1alpha1.ManagedSeed) {
+					Expect(&ms.Status).To(Equal(status))
+				})
+
+				result, err := reconciler.Reconcile(ctx, request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			})
+		})
+
 		Context("delete", func() {
 			BeforeEach(func() {
 				ts := metav1.Now()
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedseed
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	seedmanagementv1alpha1constants "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/keys"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+)
+
+// gardenletUnavailableThreshold is the duration for which the gardenlet deployment in the seed cluster must have been
+// unavailable before a failed self-upgrade is rolled back to the last known-good version.
+const gardenletUnavailableThreshold = 10 * time.Minute
+
+// rollBackGardenletIfUnhealthy watches over gardenlet rollouts in the seed cluster. While the gardenlet deployment is
+// healthy, its version is recorded in the seedmanagement.gardener.cloud/known-good-gardenlet-version annotation of the
+// ManagedSeed. If the deployment is crash-looping or has been unavailable longer than gardenletUnavailableThreshold,
+// the returned deployment overrides the gardenlet image tag with the recorded version and the
+// seedmanagementv1alpha1.ManagedSeedGardenletRolledBack condition is added to the status, so that a failed
+// self-upgrade does not take out the seed.
+func (r *Reconciler) rollBackGardenletIfUnhealthy(
+	ctx context.Context,
+	log logr.Logger,
+	ms *seedmanagementv1alpha1.ManagedSeed,
+	shoot *gardencorev1beta1.Shoot,
+	status *seedmanagementv1alpha1.ManagedSeedStatus,
+	deployment *seedmanagementv1alpha1.GardenletDeployment,
+) (*seedmanagementv1alpha1.GardenletDeployment, error) {
+	shootClientSet, err := r.ShootClientMap.GetClient(ctx, keys.ForShoot(shoot))
+	if err != nil {
+		return nil, fmt.Errorf("could not get shoot client set: %w", err)
+	}
+
+	gardenletDeployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.DeploymentNameGardenlet, Namespace: r.GardenNamespaceShoot}}
+	if err := shootClientSet.Client().Get(ctx, client.ObjectKeyFromObject(gardenletDeployment), gardenletDeployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			return deployment, nil
+		}
+		return nil, fmt.Errorf("could not get gardenlet deployment: %w", err)
+	}
+
+	version := gardenletImageTag(gardenletDeployment)
+
+	unhealthy, err := r.isGardenletUnhealthy(ctx, shootClientSet.Client(), gardenletDeployment)
+	if err != nil {
+		return nil, err
+	}
+
+	if !unhealthy {
+		// Remember the running version as known-good so that later failed upgrades can be rolled back to it,
+		// but only while the deployment is fully healthy
+		if health.CheckDeployment(gardenletDeployment) == nil && version != "" &&
+			ms.Annotations[seedmanagementv1alpha1constants.AnnotationKnownGoodGardenletVersion] != version {
+			patch := client.MergeFrom(ms.DeepCopy())
+			metav1.SetMetaDataAnnotation(&ms.ObjectMeta, seedmanagementv1alpha1constants.AnnotationKnownGoodGardenletVersion, version)
+			if err := r.GardenClient.Patch(ctx, ms, patch); err != nil {
+				return nil, fmt.Errorf("could not record known-good gardenlet version: %w", err)
+			}
+		}
+		return deployment, nil
+	}
+
+	knownGoodVersion := ms.Annotations[seedmanagementv1alpha1constants.AnnotationKnownGoodGardenletVersion]
+	if knownGoodVersion == "" || knownGoodVersion == version {
+		return deployment, nil
+	}
+
+	log.Info("Rolling back unhealthy gardenlet to last known-good version", "unhealthyVersion", version, "version", knownGoodVersion)
+	msg := fmt.Sprintf("Gardenlet version %q is unhealthy, rolling back to last known-good version %q", version, knownGoodVersion)
+	r.Recorder.Event(ms, corev1.EventTypeWarning, gardencorev1beta1.EventReconciling, msg)
+	updateCondition(r.Clock, status, seedmanagementv1alpha1.ManagedSeedGardenletRolledBack, gardencorev1beta1.ConditionTrue, gardencorev1beta1.EventReconciling, msg)
+
+	deployment = deployment.DeepCopy()
+	if deployment == nil {
+		deployment = &seedmanagementv1alpha1.GardenletDeployment{}
+	}
+	if deployment.Image == nil {
+		deployment.Image = &seedmanagementv1alpha1.Image{}
+	}
+	deployment.Image.Tag = &knownGoodVersion
+	return deployment, nil
+}
+
+// isGardenletUnhealthy determines whether the given gardenlet deployment is considered a failed rollout.
+// Crash-looping gardenlet pods fail the deployment immediately, while an unavailable deployment is tolerated until
+// the unavailable threshold has passed.
+func (r *Reconciler) isGardenletUnhealthy(ctx context.Context, c client.Client, deployment *appsv1.Deployment) (bool, error) {
+	if deployment.Spec.Selector != nil {
+		podList := &corev1.PodList{}
+		if err := c.List(ctx, podList, client.InNamespace(deployment.Namespace), client.MatchingLabels(deployment.Spec.Selector.MatchLabels)); err != nil {
+			return false, fmt.Errorf("could not list gardenlet pods: %w", err)
+		}
+
+		for _, pod := range podList.Items {
+			for _, containerStatus := range pod.Status.ContainerStatuses {
+				if containerStatus.State.Waiting != nil && containerStatus.State.Waiting.Reason == "CrashLoopBackOff" {
+					return true, nil
+				}
+			}
+		}
+	}
+
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == appsv1.DeploymentAvailable && condition.Status == corev1.ConditionFalse &&
+			r.Clock.Now().After(condition.LastTransitionTime.Add(gardenletUnavailableThreshold)) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// gardenletImageTag returns the image tag of the gardenlet container of the given deployment, if any.
+func gardenletImageTag(deployment *appsv1.Deployment) string {
+	for _, container := range deployment.Spec.Template.Spec.Containers {
+		if container.Name == v1beta1constants.DeploymentNameGardenlet {
+			if i := strings.LastIndex(container.Image, ":"); i >= 0 && !strings.Contains(container.Image[i+1:], "/") {
+				return container.Image[i+1:]
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedseed
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+)
+
+// upgradeChannelsConfigMapName is the name of the ConfigMap in the garden namespace of the garden cluster that
+// declares the gardenlet versions published per upgrade channel. For each channel, the ConfigMap may contain the
+// following keys (where `<channel>` is the channel name, e.g. `stable` or `fast`):
+//   - `<channel>.version`:         the most recently published gardenlet version (required)
+//   - `<channel>.publishedAt`:     the RFC3339 time at which the version was published
+//   - `<channel>.soakDuration`:    the duration for which the version must have been published before it is rolled out
+//   - `<channel>.previousVersion`: the version to use while the most recently published version is still soaking
+const upgradeChannelsConfigMapName = "gardenlet-upgrade-channels"
+
+// resolveUpgradeChannelVersion resolves the given upgrade channel to a concrete gardenlet version by reading the
+// channel declarations published in the garden cluster. A newly published version is only returned once it has
+// soaked for the duration declared for the channel; until then, the previously published version is returned.
+func (r *Reconciler) resolveUpgradeChannelVersion(ctx context.Context, channel seedmanagementv1alpha1.UpgradeChannelName) (string, error) {
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: upgradeChannelsConfigMapName, Namespace: r.GardenNamespaceGarden}}
+	if err := r.GardenClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap); err != nil {
+		return "", fmt.Errorf("could not get ConfigMap %s: %w", client.ObjectKeyFromObject(configMap), err)
+	}
+
+	version, ok := configMap.Data[string(channel)+".version"]
+	if !ok || version == "" {
+		return "", fmt.Errorf("no version published for upgrade channel %q", channel)
+	}
+
+	publishedAtRaw := configMap.Data[string(channel)+".publishedAt"]
+	soakDurationRaw := configMap.Data[string(channel)+".soakDuration"]
+	if publishedAtRaw == "" || soakDurationRaw == "" {
+		return version, nil
+	}
+
+	publishedAt, err := time.Parse(time.RFC3339, publishedAtRaw)
+	if err != nil {
+		return "", fmt.Errorf("could not parse publish time of upgrade channel %q: %w", channel, err)
+	}
+
+	soakDuration, err := time.ParseDuration(soakDurationRaw)
+	if err != nil {
+		return "", fmt.Errorf("could not parse soak duration of upgrade channel %q: %w", channel, err)
+	}
+
+	if r.Clock.Now().Before(publishedAt.Add(soakDuration)) {
+		if previousVersion := configMap.Data[string(channel)+".previousVersion"]; previousVersion != "" {
+			return previousVersion, nil
+		}
+		return "", fmt.Errorf("version %q of upgrade channel %q has not yet completed its soak time and no previous version is published", version, channel)
+	}
+
+	return version, nil
+}
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	versionutils "github.com/gardener/gardener/pkg/utils/version"
+)
+
+const (
+	// minimumKernelVersion is the minimum kernel version Gardener requires on seed worker nodes, e.g. for the CNI and
+	// the SNI-based routing of the istio ingress gateway.
+	minimumKernelVersion = "5.4"
+
+	// AnnotationCgroupVersion is a constant for an annotation on a Node object whose value is the cgroup version the
+	// node runs with. It is published by a conformance reporting agent on the node (typically deployed by the
+	// respective OS extension). Gardener requires seed nodes to run with cgroup v2.
+	AnnotationCgroupVersion = "conformance.seed.gardener.cloud/cgroup-version"
+	// AnnotationMissingKernelModules is a constant for an annotation on a Node object whose value is a comma-separated
+	// list of kernel modules which are required on seed nodes but not loaded. It is published by a conformance
+	// reporting agent on the node.
+	AnnotationMissingKernelModules = "conformance.seed.gardener.cloud/missing-kernel-modules"
+	// AnnotationSysctlViolations is a constant for an annotation on a Node object whose value is a comma-separated
+	// list of sysctls which deviate from Gardener's documented requirements for seed nodes. It is published by a
+	// conformance reporting agent on the node.
+	AnnotationSysctlViolations = "conformance.seed.gardener.cloud/sysctl-violations"
+)
+
+var kernelVersionRegexp = regexp.MustCompile(`^\d+\.\d+(\.\d+)?`)
+
+func (h *health) checkSystemRequirements(ctx context.Context, condition gardencorev1beta1.Condition) (*gardencorev1beta1.Condition, error) {
+	nodeList := &corev1.NodeList{}
+	if err := h.seedClient.List(ctx, nodeList); err != nil {
+		return nil, fmt.Errorf("failed listing nodes: %w", err)
+	}
+
+	violationToNodes := map[string][]string{}
+	for _, node := range nodeList.Items {
+		for _, violation := range checkNodeSystemRequirements(node) {
+			violationToNodes[violation] = append(violationToNodes[violation], node.Name)
+		}
+	}
+
+	if len(violationToNodes) > 0 {
+		violations := make([]string, 0, len(violationToNodes))
+		for violation, nodes := range violationToNodes {
+			sort.Strings(nodes)
+			violations = append(violations, fmt.Sprintf("%s (nodes: %s)", violation, strings.Join(nodes, ", ")))
+		}
+		sort.Strings(violations)
+
+		return ptr.To(v1beta1helper.UpdatedConditionWithClock(
+			h.clock,
+			condition,
+			gardencorev1beta1.ConditionFalse,
+			"SystemRequirementsViolated",
+			"The following system requirement violations were detected: "+strings.Join(violations, "; ")+".",
+		)), nil
+	}
+
+	return ptr.To(v1beta1helper.UpdatedConditionWithClock(
+		h.clock,
+		condition,
+		gardencorev1beta1.ConditionTrue,
+		"SystemRequirementsConformant",
+		"All nodes conform to the system requirements.",
+	)), nil
+}
+
+func checkNodeSystemRequirements(node corev1.Node) []string {
+	var violations []string
+
+	if operatingSystem := node.Status.NodeInfo.OperatingSystem; operatingSystem != "" && operatingSystem != "linux" {
+		violations = append(violations, fmt.Sprintf("operating system %q is used instead of the required operating system \"linux\"", operatingSystem))
+	}
+
+	if kernelVersion := kernelVersionRegexp.FindString(node.Status.NodeInfo.KernelVersion); kernelVersion != "" {
+		if atLeastMinimum, err := versionutils.CompareVersions(kernelVersion, ">=", minimumKernelVersion); err == nil && !atLeastMinimum {
+			violations = append(violations, fmt.Sprintf("kernel version %s is lower than the required minimum version %s", kernelVersion, minimumKernelVersion))
+		}
+	}
+
+	if cgroupVersion, ok := node.Annotations[AnnotationCgroupVersion]; ok && cgroupVersion != "2" {
+		violations = append(violations, fmt.Sprintf("cgroup version %s is used instead of the required version 2", cgroupVersion))
+	}
+
+	if missingKernelModules := node.Annotations[AnnotationMissingKernelModules]; missingKernelModules != "" {
+		violations = append(violations, "required kernel modules are not loaded: "+missingKernelModules)
+	}
+
+	if sysctlViolations := node.Annotations[AnnotationSysctlViolations]; sysctlViolations != "" {
+		violations = append(violations, "sysctls deviate from the requirements: "+sysctlViolations)
+	}
+
+	return violations
+}
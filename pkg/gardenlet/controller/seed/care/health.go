@@ -62,6 +62,8 @@ func (h *health) Check(
 	if newEmergencyStopShootReconciliations := h.checkEmergencyStopShootReconciliations(conditions.emergencyStopShootReconciliations); newEmergencyStopShootReconciliations != nil {
 		checkedConditions = append(checkedConditions, v1beta1helper.NewConditionOrError(h.clock, conditions.emergencyStopShootReconciliations, newEmergencyStopShootReconciliations, nil))
 	}
+	newSystemRequirementsConformant, err := h.checkSystemRequirements(ctx, conditions.systemRequirementsConformant)
+	checkedConditions = append(checkedConditions, v1beta1helper.NewConditionOrError(h.clock, conditions.systemRequirementsConformant, newSystemRequirementsConformant, err))
 	return checkedConditions
 }
 
@@ -105,6 +107,7 @@ func (h *health) checkEmergencyStopShootReconciliations(condition gardencorev1be
 type SeedConditions struct {
 	systemComponentsHealthy           gardencorev1beta1.Condition
 	emergencyStopShootReconciliations gardencorev1beta1.Condition
+	systemRequirementsConformant      gardencorev1beta1.Condition
 }
 
 // ConvertToSlice returns the seed conditions as a slice.
@@ -112,6 +115,7 @@ func (s SeedConditions) ConvertToSlice() []gardencorev1beta1.Condition {
 	return []gardencorev1beta1.Condition{
 		s.systemComponentsHealthy,
 		s.emergencyStopShootReconciliations,
+		s.systemRequirementsConformant,
 	}
 }
 
@@ -120,6 +124,7 @@ func (s SeedConditions) ConditionTypes() []gardencorev1beta1.ConditionType {
 	return []gardencorev1beta1.ConditionType{
 		s.systemComponentsHealthy.Type,
 		s.emergencyStopShootReconciliations.Type,
+		s.systemRequirementsConformant.Type,
 	}
 }
 
@@ -129,5 +134,6 @@ func NewSeedConditions(clock clock.Clock, status gardencorev1beta1.SeedStatus) S
 	return SeedConditions{
 		systemComponentsHealthy:           v1beta1helper.GetOrInitConditionWithClock(clock, status.Conditions, gardencorev1beta1.SeedSystemComponentsHealthy),
 		emergencyStopShootReconciliations: v1beta1helper.GetOrInitConditionWithClock(clock, status.Conditions, gardencorev1beta1.SeedEmergencyStopShootReconciliations),
+		systemRequirementsConformant:      v1beta1helper.GetOrInitConditionWithClock(clock, status.Conditions, gardencorev1beta1.SeedSystemRequirementsConformant),
 	}
 }
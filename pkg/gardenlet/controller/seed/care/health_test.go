@@ -12,6 +12,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
@@ -204,6 +205,59 @@ var _ = Describe("Seed health", func() {
 				tests("MissingManagedResourceCondition", "is missing the following condition(s)")
 			})
 		})
+
+		Context("system requirements", func() {
+			JustBeforeEach(func() {
+				Expect(c.Create(ctx, healthyManagedResource(managedResourceName))).To(Succeed())
+			})
+
+			It("should set SystemRequirementsConformant condition to true if all nodes conform", func() {
+				Expect(c.Create(ctx, node("node-0", "6.6.0-generic", nil))).To(Succeed())
+
+				healthCheck := NewHealth(seed, c, fakeClock, nil, nil)
+				updatedConditions := healthCheck.Check(ctx, NewSeedConditions(fakeClock, gardencorev1beta1.SeedStatus{}))
+
+				Expect(updatedConditions).To(ContainCondition(
+					OfType(gardencorev1beta1.SeedSystemRequirementsConformant),
+					WithStatus(gardencorev1beta1.ConditionTrue),
+					WithReason("SystemRequirementsConformant"),
+					WithMessage("All nodes conform to the system requirements."),
+				))
+			})
+
+			It("should set SystemRequirementsConformant condition to false and list the violating nodes", func() {
+				Expect(c.Create(ctx, node("node-0", "4.19.0-generic", nil))).To(Succeed())
+				Expect(c.Create(ctx, node("node-1", "6.6.0-generic", map[string]string{
+					AnnotationCgroupVersion:        "1",
+					AnnotationMissingKernelModules: "ip_tables",
+				}))).To(Succeed())
+				Expect(c.Create(ctx, node("node-2", "6.6.0-generic", map[string]string{
+					AnnotationSysctlViolations: "net.ipv4.ip_forward=0",
+				}))).To(Succeed())
+
+				healthCheck := NewHealth(seed, c, fakeClock, nil, nil)
+				updatedConditions := healthCheck.Check(ctx, NewSeedConditions(fakeClock, gardencorev1beta1.SeedStatus{}))
+
+				Expect(updatedConditions).To(ContainCondition(
+					OfType(gardencorev1beta1.SeedSystemRequirementsConformant),
+					WithStatus(gardencorev1beta1.ConditionFalse),
+					WithReason("SystemRequirementsViolated"),
+					WithMessage("cgroup version 1 is used instead of the required version 2 (nodes: node-1)"),
+				))
+				Expect(updatedConditions).To(ContainCondition(
+					OfType(gardencorev1beta1.SeedSystemRequirementsConformant),
+					WithMessage("kernel version 4.19.0 is lower than the required minimum version 5.4 (nodes: node-0)"),
+				))
+				Expect(updatedConditions).To(ContainCondition(
+					OfType(gardencorev1beta1.SeedSystemRequirementsConformant),
+					WithMessage("required kernel modules are not loaded: ip_tables (nodes: node-1)"),
+				))
+				Expect(updatedConditions).To(ContainCondition(
+					OfType(gardencorev1beta1.SeedSystemRequirementsConformant),
+					WithMessage("sysctls deviate from the requirements: net.ipv4.ip_forward=0 (nodes: node-2)"),
+				))
+			})
+		})
 	})
 
 	Describe("SeedConditions", func() {
@@ -214,6 +268,7 @@ var _ = Describe("Seed health", func() {
 				Expect(conditions.ConvertToSlice()).To(ConsistOf(
 					beConditionWithStatusReasonAndMessage("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusReasonAndMessage("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusReasonAndMessage("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 				))
 			})
 
@@ -222,6 +277,7 @@ var _ = Describe("Seed health", func() {
 					Conditions: []gardencorev1beta1.Condition{
 						{Type: "SeedSystemComponentsHealthy"},
 						{Type: "EmergencyStopShootReconciliations"},
+						{Type: "SystemRequirementsConformant"},
 						{Type: "Foo"},
 					},
 				})
@@ -229,6 +285,7 @@ var _ = Describe("Seed health", func() {
 				Expect(conditions.ConvertToSlice()).To(HaveExactElements(
 					OfType("SeedSystemComponentsHealthy"),
 					OfType("EmergencyStopShootReconciliations"),
+					OfType("SystemRequirementsConformant"),
 				))
 			})
 		})
@@ -240,6 +297,7 @@ var _ = Describe("Seed health", func() {
 				Expect(conditions.ConvertToSlice()).To(HaveExactElements(
 					OfType("SeedSystemComponentsHealthy"),
 					OfType("EmergencyStopShootReconciliations"),
+					OfType("SystemRequirementsConformant"),
 				))
 			})
 		})
@@ -251,6 +309,7 @@ var _ = Describe("Seed health", func() {
 				Expect(conditions.ConditionTypes()).To(HaveExactElements(
 					gardencorev1beta1.ConditionType("SeedSystemComponentsHealthy"),
 					gardencorev1beta1.ConditionType("EmergencyStopShootReconciliations"),
+					gardencorev1beta1.ConditionType("SystemRequirementsConformant"),
 				))
 			})
 		})
@@ -343,6 +402,21 @@ func progressingManagedResource(name string) *resourcesv1alpha1.ManagedResource
 		})
 }
 
+func node(name, kernelVersion string, annotations map[string]string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Annotations: annotations,
+		},
+		Status: corev1.NodeStatus{
+			NodeInfo: corev1.NodeSystemInfo{
+				KernelVersion:   kernelVersion,
+				OperatingSystem: "linux",
+			},
+		},
+	}
+}
+
 func managedResource(name string, conditions []gardencorev1beta1.Condition) *resourcesv1alpha1.ManagedResource {
 	namespace := v1beta1constants.GardenNamespace
 	if name == "istio-system" || strings.HasSuffix(name, "istio") {
@@ -223,6 +223,8 @@ var _ = Describe("Seed Care Control", func() {
 						"Status":  BeEquivalentTo("True"),
 						"Reason":  Equal("EmergencyStopShootReconciliations"),
 						"Message": Equal("Reconciliations of Shoots managed by this Seed cluster are currently disabled by annotation."),
+					}), MatchFields(IgnoreExtras, Fields{
+						"Type": BeEquivalentTo("SystemRequirementsConformant"),
 					}),
 				))
 			})
@@ -244,6 +246,8 @@ var _ = Describe("Seed Care Control", func() {
 				Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(seed), updatedSeed)).To(Succeed())
 				Expect(updatedSeed.Status.Conditions).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
 					"Type": BeEquivalentTo("SeedSystemComponentsHealthy"),
+				}), MatchFields(IgnoreExtras, Fields{
+					"Type": BeEquivalentTo("SystemRequirementsConformant"),
 				})))
 			})
 		})
@@ -44,6 +44,7 @@ import (
 	kubeproxy "github.com/gardener/gardener/pkg/component/kubernetes/proxy"
 	kubescheduler "github.com/gardener/gardener/pkg/component/kubernetes/scheduler"
 	"github.com/gardener/gardener/pkg/component/networking/coredns"
+	"github.com/gardener/gardener/pkg/component/networking/dnscache"
 	"github.com/gardener/gardener/pkg/component/networking/istio"
 	vpnseedserver "github.com/gardener/gardener/pkg/component/networking/vpn/seedserver"
 	vpnshoot "github.com/gardener/gardener/pkg/component/networking/vpn/shoot"
@@ -99,6 +100,7 @@ type components struct {
 	istioDefaultLabels      map[string]string
 	istioDefaultNamespace   string
 	nginxIngressController  component.DeployWaiter
+	dnsCache                component.DeployWaiter
 	verticalPodAutoscaler   component.DeployWaiter
 	etcdDruid               component.DeployWaiter
 	clusterAutoscaler       component.DeployWaiter
@@ -199,6 +201,10 @@ func (r *Reconciler) instantiateComponents(
 	if err != nil {
 		return
 	}
+	c.dnsCache, err = r.newDNSCache(seed.GetInfo().Spec.Settings)
+	if err != nil {
+		return
+	}
 	c.verticalPodAutoscaler, err = r.newVerticalPodAutoscaler(seed.GetInfo().Spec.Settings, secretsManager, seedIsGarden)
 	if err != nil {
 		return
@@ -349,6 +355,7 @@ func (r *Reconciler) newIstio(ctx context.Context, seed *seedpkg.Seed, isGardenC
 		true,
 		seed.GetInfo().Spec.Provider.Zones,
 		seed.IsDualStack(),
+		v1beta1helper.SeedSettingIstioIngressGatewayAutoscaling(seed.GetInfo().Spec.Settings),
 		r.SeedVersion,
 	)
 	if err != nil {
@@ -376,6 +383,7 @@ func (r *Reconciler) newIstio(ctx context.Context, seed *seedpkg.Seed, isGardenC
 				&zone,
 				seed.IsDualStack(),
 				seed.GetZonalLoadBalancerServiceProxyProtocolTermination(zone),
+				v1beta1helper.SeedSettingIstioIngressGatewayAutoscaling(seed.GetInfo().Spec.Settings),
 				r.SeedVersion,
 			); err != nil {
 				return nil, nil, "", err
@@ -399,6 +407,7 @@ func (r *Reconciler) newIstio(ctx context.Context, seed *seedpkg.Seed, isGardenC
 			nil,
 			seed.IsDualStack(),
 			seed.GetLoadBalancerServiceProxyProtocolTermination(),
+			v1beta1helper.SeedSettingIstioIngressGatewayAutoscalingForExposureClassHandler(seed.GetInfo().Spec.Settings, handler.Name),
 			r.SeedVersion,
 		); err != nil {
 			return nil, nil, "", err
@@ -422,6 +431,7 @@ func (r *Reconciler) newIstio(ctx context.Context, seed *seedpkg.Seed, isGardenC
 					&zone,
 					seed.IsDualStack(),
 					seed.GetZonalLoadBalancerServiceProxyProtocolTermination(zone),
+					v1beta1helper.SeedSettingIstioIngressGatewayAutoscalingForExposureClassHandler(seed.GetInfo().Spec.Settings, handler.Name),
 					r.SeedVersion,
 				); err != nil {
 					return nil, nil, "", err
@@ -457,7 +467,7 @@ func (r *Reconciler) newDependencyWatchdogs(seedSettings *gardencorev1beta1.Seed
 				kubeapiserver.NewDependencyWatchdogWeederConfiguration,
 			}
 			dependencyWatchdogWeederConfiguration = weederapi.Config{
-				WatchDuration:                 &metav1.Duration{Duration: dependencywatchdog.DefaultWatchDuration},
+				WatchDuration:                 v1beta1helper.SeedSettingDependencyWatchdogWeederWatchDuration(seedSettings, dependencywatchdog.DefaultWatchDuration),
 				ServicesAndDependantSelectors: make(map[string]weederapi.DependantSelectors, len(dependencyWatchdogWeederConfigurationFuncs)),
 			}
 		)
@@ -482,7 +492,7 @@ func (r *Reconciler) newDependencyWatchdogs(seedSettings *gardencorev1beta1.Seed
 			}
 			dependencyWatchdogProberConfiguration = proberapi.Config{
 				KubeConfigSecretName:   dependencywatchdog.KubeConfigSecretName,
-				ProbeInterval:          &metav1.Duration{Duration: dependencywatchdog.DefaultProbeInterval},
+				ProbeInterval:          v1beta1helper.SeedSettingDependencyWatchdogProberProbeInterval(seedSettings, dependencywatchdog.DefaultProbeInterval),
 				DependentResourceInfos: make([]proberapi.DependentResourceInfo, 0, len(dependencyWatchdogProberConfigurationFuncs)),
 			}
 		)
@@ -527,6 +537,21 @@ func (r *Reconciler) newSystem(seed *gardencorev1beta1.Seed) (component.DeployWa
 	), nil
 }
 
+func (r *Reconciler) newDNSCache(seedSettings *gardencorev1beta1.SeedSettings) (component.DeployWaiter, error) {
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameCoredns, imagevectorutils.RuntimeVersion(r.SeedVersion.String()), imagevectorutils.TargetVersion(r.SeedVersion.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	dnsCache := dnscache.New(r.SeedClientSet.Client(), r.GardenNamespace, dnscache.Values{Image: image.String()})
+
+	if !v1beta1helper.SeedSettingDNSCacheEnabled(seedSettings) {
+		return component.OpDestroyWithoutWait(dnsCache), nil
+	}
+
+	return dnsCache, nil
+}
+
 func (r *Reconciler) newVali() (component.Deployer, error) {
 	var storage *resource.Quantity
 	if r.Config.Logging != nil && r.Config.Logging.Vali != nil && r.Config.Logging.Vali.Garden != nil {
@@ -932,5 +957,5 @@ func (r *Reconciler) newKubeAPIServerIngress(seed *seedpkg.Seed, wildCardCertSec
 }
 
 func (r *Reconciler) newExtensions(ctx context.Context, log logr.Logger, seed *seedpkg.Seed) (extension.Interface, error) {
-	return sharedcomponent.NewExtension(ctx, log, r.GardenClient, r.SeedClientSet.Client(), r.GardenNamespace, extensionsv1alpha1.ExtensionClassSeed, seed.GetInfo().Spec.Extensions, true)
+	return sharedcomponent.NewExtension(ctx, log, r.GardenClient, r.SeedClientSet.Client(), r.GardenNamespace, extensionsv1alpha1.ExtensionClassSeed, seed.GetInfo().Spec.Extensions, nil, true)
 }
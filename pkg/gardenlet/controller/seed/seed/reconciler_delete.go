@@ -148,6 +148,10 @@ func (r *Reconciler) runDeleteSeedFlow(
 			Name: "Destroying cluster-autoscaler resources",
 			Fn:   component.OpDestroyAndWait(c.clusterAutoscaler).Destroy,
 		})
+		destroyDNSCache = g.Add(flow.Task{
+			Name: "Destroying DNS cache",
+			Fn:   component.OpDestroyAndWait(c.dnsCache).Destroy,
+		})
 		destroyNginxIngress = g.Add(flow.Task{
 			Name: "Destroying nginx-ingress",
 			Fn:   component.OpDestroyAndWait(c.nginxIngressController).Destroy,
@@ -253,6 +257,7 @@ func (r *Reconciler) runDeleteSeedFlow(
 			destroyAlertManager,
 			destroyNginxIngress,
 			destroyClusterAutoscaler,
+			destroyDNSCache,
 			destroyDWDWeeder,
 			destroyDWDProber,
 			destroyKubeAPIServerIngress,
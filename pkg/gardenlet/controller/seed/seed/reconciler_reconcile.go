@@ -347,6 +347,11 @@ func (r *Reconciler) runReconcileSeedFlow(
 			Fn:           c.clusterAutoscaler.Deploy,
 			Dependencies: flow.NewTaskIDs(syncPointReadyForSystemComponents),
 		})
+		_ = g.Add(flow.Task{
+			Name:         "Deploying DNS cache",
+			Fn:           c.dnsCache.Deploy,
+			Dependencies: flow.NewTaskIDs(syncPointReadyForSystemComponents),
+		})
 		_ = g.Add(flow.Task{
 			Name:         "Deploying dependency-watchdog-weeder",
 			Fn:           c.dwdWeeder.Deploy,
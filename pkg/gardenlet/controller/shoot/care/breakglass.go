@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+)
+
+// annotationBreakGlassIgnored is set on ManagedResources whose 'resources.gardener.cloud/ignore' annotation was added
+// by the break-glass maintenance mode. It distinguishes them from ManagedResources which were ignored manually, so
+// that only the former are re-enabled once the mode expires.
+const annotationBreakGlassIgnored = "maintenance.shoot.gardener.cloud/break-glass-ignored"
+
+// reconcileBreakGlassMode pauses the drift reversion of the gardener-resource-manager for all ManagedResources in the
+// shoot's control plane namespace while the break-glass maintenance mode is active. Once the mode has expired, the
+// ignore annotations are removed again and the annotation is removed from the shoot. Activation and deactivation are
+// audited via events on the shoot.
+func (r *Reconciler) reconcileBreakGlassMode(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, namespace string) error {
+	if _, ok := shoot.Annotations[v1beta1constants.ShootBreakGlassUntil]; !ok {
+		return nil
+	}
+
+	if v1beta1helper.ShootBreakGlassModeActive(shoot, r.Clock.Now()) {
+		toggled, err := r.pauseManagedResources(ctx, namespace)
+		if err != nil {
+			return err
+		}
+
+		if toggled {
+			log.Info("Break-glass maintenance mode is active, paused drift reversion of ManagedResources", "namespace", namespace, "until", shoot.Annotations[v1beta1constants.ShootBreakGlassUntil])
+			r.Recorder.Eventf(shoot, corev1.EventTypeNormal, "BreakGlassModeActivated", "Break-glass maintenance mode is active until %s, drift reversion and care remediation are paused", shoot.Annotations[v1beta1constants.ShootBreakGlassUntil])
+		}
+		return nil
+	}
+
+	if _, err := r.resumeManagedResources(ctx, namespace); err != nil {
+		return err
+	}
+
+	log.Info("Break-glass maintenance mode has expired, resumed drift reversion of ManagedResources", "namespace", namespace)
+	r.Recorder.Event(shoot, corev1.EventTypeNormal, "BreakGlassModeDeactivated", "Break-glass maintenance mode has expired, drift reversion and care remediation are active again")
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	delete(shoot.Annotations, v1beta1constants.ShootBreakGlassUntil)
+	return r.GardenClient.Patch(ctx, shoot, patch)
+}
+
+func (r *Reconciler) pauseManagedResources(ctx context.Context, namespace string) (bool, error) {
+	managedResourceList := &resourcesv1alpha1.ManagedResourceList{}
+	if err := r.SeedClientSet.Client().List(ctx, managedResourceList, client.InNamespace(namespace)); err != nil {
+		return false, fmt.Errorf("failed listing ManagedResources in namespace %s: %w", namespace, err)
+	}
+
+	var toggled bool
+
+	for i := range managedResourceList.Items {
+		managedResource := &managedResourceList.Items[i]
+		if metav1.HasAnnotation(managedResource.ObjectMeta, resourcesv1alpha1.Ignore) {
+			continue
+		}
+
+		patch := client.MergeFrom(managedResource.DeepCopy())
+		metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, resourcesv1alpha1.Ignore, "true")
+		metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, annotationBreakGlassIgnored, "true")
+		if err := r.SeedClientSet.Client().Patch(ctx, managedResource, patch); err != nil {
+			return toggled, fmt.Errorf("failed annotating ManagedResource %s: %w", client.ObjectKeyFromObject(managedResource), err)
+		}
+
+		toggled = true
+	}
+
+	return toggled, nil
+}
+
+func (r *Reconciler) resumeManagedResources(ctx context.Context, namespace string) (bool, error) {
+	managedResourceList := &resourcesv1alpha1.ManagedResourceList{}
+	if err := r.SeedClientSet.Client().List(ctx, managedResourceList, client.InNamespace(namespace)); err != nil {
+		return false, fmt.Errorf("failed listing ManagedResources in namespace %s: %w", namespace, err)
+	}
+
+	var toggled bool
+
+	for i := range managedResourceList.Items {
+		managedResource := &managedResourceList.Items[i]
+		if !metav1.HasAnnotation(managedResource.ObjectMeta, annotationBreakGlassIgnored) {
+			continue
+		}
+
+		patch := client.MergeFrom(managedResource.DeepCopy())
+		delete(managedResource.Annotations, resourcesv1alpha1.Ignore)
+		delete(managedResource.Annotations, annotationBreakGlassIgnored)
+		if err := r.SeedClientSet.Client().Patch(ctx, managedResource, patch); err != nil {
+			return toggled, fmt.Errorf("failed removing annotations from ManagedResource %s: %w", client.ObjectKeyFromObject(managedResource), err)
+		}
+
+		toggled = true
+	}
+
+	return toggled, nil
+}
@@ -25,6 +25,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/component/gardener/resourcemanager"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/botanist/matchers"
@@ -125,6 +126,13 @@ func (c *Constraint) constraintsChecks(
 	status, reason, message = c.checkIfManualInPlaceWorkersUpdated()
 	constraints.manualInPlaceWorkersUpdated = v1beta1helper.UpdatedConditionWithClock(c.clock, constraints.manualInPlaceWorkersUpdated, status, reason, message)
 
+	status, reason, message, err = c.checkForOrphanedCloudResources(ctx)
+	if err != nil {
+		constraints.noOrphanedCloudResources = v1beta1helper.UpdatedConditionUnknownErrorWithClock(c.clock, constraints.noOrphanedCloudResources, err)
+	} else {
+		constraints.noOrphanedCloudResources = v1beta1helper.UpdatedConditionWithClock(c.clock, constraints.noOrphanedCloudResources, status, reason, message)
+	}
+
 	// Now check constraints depending on the shoot's kube-apiserver to be up and running
 	shootClient, apiServerRunning, err := c.initializeShootClients()
 	if err != nil {
@@ -136,14 +144,14 @@ func (c *Constraint) constraintsChecks(
 
 		return filterOptionalConstraints(
 			[]gardencorev1beta1.Condition{constraints.hibernationPossible, constraints.maintenancePreconditionsSatisfied},
-			[]gardencorev1beta1.Condition{constraints.caCertificateValiditiesAcceptable, constraints.manualInPlaceWorkersUpdated},
+			[]gardencorev1beta1.Condition{constraints.caCertificateValiditiesAcceptable, constraints.manualInPlaceWorkersUpdated, constraints.noOrphanedCloudResources},
 		)
 	}
 	if !apiServerRunning {
 		// don't check constraints if API server has already been deleted or has not been created yet
 		return filterOptionalConstraints(
 			shootControlPlaneNotRunningConstraints(c.clock, constraints.hibernationPossible, constraints.maintenancePreconditionsSatisfied),
-			[]gardencorev1beta1.Condition{constraints.caCertificateValiditiesAcceptable, constraints.manualInPlaceWorkersUpdated},
+			[]gardencorev1beta1.Condition{constraints.caCertificateValiditiesAcceptable, constraints.manualInPlaceWorkersUpdated, constraints.noOrphanedCloudResources},
 		)
 	}
 	c.shootClient = shootClient.Client()
@@ -166,7 +174,7 @@ func (c *Constraint) constraintsChecks(
 
 	return filterOptionalConstraints(
 		[]gardencorev1beta1.Condition{constraints.hibernationPossible, constraints.maintenancePreconditionsSatisfied},
-		[]gardencorev1beta1.Condition{constraints.caCertificateValiditiesAcceptable, constraints.crdsWithProblematicConversionWebhooks, constraints.manualInPlaceWorkersUpdated},
+		[]gardencorev1beta1.Condition{constraints.caCertificateValiditiesAcceptable, constraints.crdsWithProblematicConversionWebhooks, constraints.manualInPlaceWorkersUpdated, constraints.noOrphanedCloudResources},
 	)
 }
 
@@ -265,6 +273,53 @@ func (c *Constraint) checkIfManualInPlaceWorkersUpdated() (gardencorev1beta1.Con
 			strings.Join(c.shoot.GetInfo().Status.InPlaceUpdates.PendingWorkerUpdates.ManualInPlaceUpdate, ", "))
 }
 
+// checkForOrphanedCloudResources checks whether the provider extension reported cloud resources which are tagged for
+// the shoot but no longer tracked by Gardener. Extensions optionally perform a periodic orphan scan and publish its
+// result as a condition of type NoOrphanedCloudResources on the Infrastructure resource in the seed cluster.
+func (c *Constraint) checkForOrphanedCloudResources(ctx context.Context) (gardencorev1beta1.ConditionStatus, string, string, error) {
+	infrastructureList := &extensionsv1alpha1.InfrastructureList{}
+	if err := c.seedClient.List(ctx, infrastructureList, client.InNamespace(c.shoot.ControlPlaneNamespace)); err != nil {
+		return "", "", "", fmt.Errorf("could not list Infrastructure resources in shoot namespace in seed to check for orphaned cloud resources: %w", err)
+	}
+
+	var (
+		reported       bool
+		orphanMessages []string
+	)
+
+	for _, infrastructure := range infrastructureList.Items {
+		for _, condition := range infrastructure.Status.Conditions {
+			if condition.Type != gardencorev1beta1.ShootNoOrphanedCloudResources {
+				continue
+			}
+
+			reported = true
+			if condition.Status == gardencorev1beta1.ConditionFalse {
+				orphanMessages = append(orphanMessages, fmt.Sprintf("Infrastructure %q reports orphaned cloud resources: %s", infrastructure.Name, condition.Message))
+			}
+		}
+	}
+
+	if len(orphanMessages) > 0 {
+		return gardencorev1beta1.ConditionFalse,
+			"OrphanedCloudResourcesDetected",
+			fmt.Sprintf("The provider extension detected cloud resources which are tagged for this shoot but no longer tracked by Gardener, you should clean them up: %s", strings.Join(orphanMessages, ", ")),
+			nil
+	}
+
+	if !reported {
+		return gardencorev1beta1.ConditionTrue,
+			"NoOrphanScanReport",
+			"The provider extension did not report an orphaned cloud resources scan result.",
+			nil
+	}
+
+	return gardencorev1beta1.ConditionTrue,
+		"NoOrphanedCloudResourcesDetected",
+		"The provider extension did not detect any orphaned cloud resources.",
+		nil
+}
+
 // checkIfCRDsWithProblematicConversionWebhooksPresent checks whether there are CRDs with multiple stored versions and
 // conversion webhooks are present in the cluster.
 func (c *Constraint) checkIfCRDsWithProblematicConversionWebhooksPresent(ctx context.Context) (gardencorev1beta1.ConditionStatus, string, string, error) {
@@ -453,6 +508,7 @@ type ShootConstraints struct {
 	caCertificateValiditiesAcceptable     gardencorev1beta1.Condition
 	crdsWithProblematicConversionWebhooks gardencorev1beta1.Condition
 	manualInPlaceWorkersUpdated           gardencorev1beta1.Condition
+	noOrphanedCloudResources              gardencorev1beta1.Condition
 }
 
 // ConvertToSlice returns the shoot constraints as a slice.
@@ -463,6 +519,7 @@ func (g ShootConstraints) ConvertToSlice() []gardencorev1beta1.Condition {
 		g.caCertificateValiditiesAcceptable,
 		g.crdsWithProblematicConversionWebhooks,
 		g.manualInPlaceWorkersUpdated,
+		g.noOrphanedCloudResources,
 	}
 }
 
@@ -474,6 +531,7 @@ func (g ShootConstraints) ConstraintTypes() []gardencorev1beta1.ConditionType {
 		g.caCertificateValiditiesAcceptable.Type,
 		g.crdsWithProblematicConversionWebhooks.Type,
 		g.manualInPlaceWorkersUpdated.Type,
+		g.noOrphanedCloudResources.Type,
 	}
 }
 
@@ -486,5 +544,6 @@ func NewShootConstraints(clock clock.Clock, shoot *gardencorev1beta1.Shoot) Shoo
 		caCertificateValiditiesAcceptable:     v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootCACertificateValiditiesAcceptable),
 		crdsWithProblematicConversionWebhooks: v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootCRDsWithProblematicConversionWebhooks),
 		manualInPlaceWorkersUpdated:           v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootManualInPlaceWorkersUpdated),
+		noOrphanedCloudResources:              v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootNoOrphanedCloudResources),
 	}
 }
@@ -43,6 +43,7 @@ import (
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
@@ -563,6 +564,56 @@ var _ = Describe("Constraints", func() {
 				))
 			})
 
+			Context("#NoOrphanedCloudResources", func() {
+				infrastructure := func(conditions []gardencorev1beta1.Condition) *extensionsv1alpha1.Infrastructure {
+					return &extensionsv1alpha1.Infrastructure{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "infra",
+							Namespace: controlPlaneNamespace,
+						},
+						Status: extensionsv1alpha1.InfrastructureStatus{
+							DefaultStatus: extensionsv1alpha1.DefaultStatus{
+								Conditions: conditions,
+							},
+						},
+					}
+				}
+
+				It("should remove the 'NoOrphanedCloudResources' constraint because no scan result was reported", func() {
+					Expect(seedClient.Create(ctx, infrastructure(nil))).To(Succeed())
+
+					Expect(constraint.Check(ctx, constraints)).NotTo(ContainCondition(
+						OfType(gardencorev1beta1.ShootNoOrphanedCloudResources),
+					))
+				})
+
+				It("should remove the 'NoOrphanedCloudResources' constraint because no orphaned resources were reported", func() {
+					Expect(seedClient.Create(ctx, infrastructure([]gardencorev1beta1.Condition{{
+						Type:   gardencorev1beta1.ShootNoOrphanedCloudResources,
+						Status: gardencorev1beta1.ConditionTrue,
+					}}))).To(Succeed())
+
+					Expect(constraint.Check(ctx, constraints)).NotTo(ContainCondition(
+						OfType(gardencorev1beta1.ShootNoOrphanedCloudResources),
+					))
+				})
+
+				It("should keep the 'NoOrphanedCloudResources' constraint because orphaned resources were reported", func() {
+					Expect(seedClient.Create(ctx, infrastructure([]gardencorev1beta1.Condition{{
+						Type:    gardencorev1beta1.ShootNoOrphanedCloudResources,
+						Status:  gardencorev1beta1.ConditionFalse,
+						Message: "loadbalancer lb-1234, disk disk-5678",
+					}}))).To(Succeed())
+
+					Expect(constraint.Check(ctx, constraints)).To(ContainCondition(
+						OfType(gardencorev1beta1.ShootNoOrphanedCloudResources),
+						WithStatus(gardencorev1beta1.ConditionProgressing),
+						WithReason("OrphanedCloudResourcesDetected"),
+						WithMessage(`Infrastructure "infra" reports orphaned cloud resources: loadbalancer lb-1234, disk disk-5678`),
+					))
+				})
+			})
+
 			Context("#ManualInPlaceWorkersUpdated", func() {
 				BeforeEach(func() {
 					shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{
@@ -758,6 +809,7 @@ var _ = Describe("Constraints", func() {
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 				))
 			})
 
@@ -778,6 +830,7 @@ var _ = Describe("Constraints", func() {
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 				))
 			})
 		})
@@ -792,6 +845,7 @@ var _ = Describe("Constraints", func() {
 					OfType("CACertificateValiditiesAcceptable"),
 					OfType("CRDsWithProblematicConversionWebhooks"),
 					OfType("ManualInPlaceWorkersUpdated"),
+					OfType("NoOrphanedCloudResources"),
 				))
 			})
 		})
@@ -806,6 +860,7 @@ var _ = Describe("Constraints", func() {
 					gardencorev1beta1.ConditionType("CACertificateValiditiesAcceptable"),
 					gardencorev1beta1.ConditionType("CRDsWithProblematicConversionWebhooks"),
 					gardencorev1beta1.ConditionType("ManualInPlaceWorkersUpdated"),
+					gardencorev1beta1.ConditionType("NoOrphanedCloudResources"),
 				))
 			})
 		})
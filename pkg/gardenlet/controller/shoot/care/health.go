@@ -187,9 +187,9 @@ func (h *Health) Check(
 		}
 
 		conditions.apiServerAvailable = v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, conditions.apiServerAvailable, "APIServerDown", "Could not reach API server during client initialization.")
-		conditions.systemComponentsHealthy = v1beta1helper.UpdatedConditionUnknownErrorMessageWithClock(h.clock, conditions.systemComponentsHealthy, message)
+		conditions.systemComponentsHealthy = dependencyDegradedCondition(h.clock, conditions.systemComponentsHealthy, gardencorev1beta1.ShootAPIServerAvailable, message)
 		if conditions.everyNodeReady != nil {
-			nodeCondition := v1beta1helper.UpdatedConditionUnknownErrorMessageWithClock(h.clock, *conditions.everyNodeReady, message)
+			nodeCondition := dependencyDegradedCondition(h.clock, *conditions.everyNodeReady, gardencorev1beta1.ShootAPIServerAvailable, message)
 			conditions.everyNodeReady = &nodeCondition
 		}
 	}
@@ -197,9 +197,31 @@ func (h *Health) Check(
 	// Execute all relevant health checks.
 	_ = flow.Parallel(taskFns...)(ctx)
 
+	// If the API server turned out to be unavailable, the dependent health checks can only fail as a consequence.
+	// Point the dependent conditions to the root cause instead of reporting every cascading failure individually.
+	if conditions.apiServerAvailable.Status == gardencorev1beta1.ConditionFalse {
+		if conditions.systemComponentsHealthy.Status != gardencorev1beta1.ConditionTrue && conditions.systemComponentsHealthy.Reason != DependencyUnhealthyReason {
+			conditions.systemComponentsHealthy = dependencyDegradedCondition(h.clock, conditions.systemComponentsHealthy, gardencorev1beta1.ShootAPIServerAvailable, conditions.apiServerAvailable.Message)
+		}
+		if conditions.everyNodeReady != nil && conditions.everyNodeReady.Status != gardencorev1beta1.ConditionTrue && conditions.everyNodeReady.Reason != DependencyUnhealthyReason {
+			nodeCondition := dependencyDegradedCondition(h.clock, *conditions.everyNodeReady, gardencorev1beta1.ShootAPIServerAvailable, conditions.apiServerAvailable.Message)
+			conditions.everyNodeReady = &nodeCondition
+		}
+	}
+
 	return PardonConditions(h.clock, conditions.ConvertToSlice(), lastOp, lastErrors)
 }
 
+// DependencyUnhealthyReason is the reason used for conditions which are degraded only because one of the components
+// they depend on is unhealthy. It allows identifying the root cause of a cascading failure.
+const DependencyUnhealthyReason = "DependencyUnhealthy"
+
+// dependencyDegradedCondition updates the given condition to reflect that it is degraded only because the component
+// behind the given root-cause condition is unhealthy.
+func dependencyDegradedCondition(clock clock.Clock, condition gardencorev1beta1.Condition, rootCause gardencorev1beta1.ConditionType, message string) gardencorev1beta1.Condition {
+	return v1beta1helper.UpdatedConditionWithClock(clock, condition, gardencorev1beta1.ConditionUnknown, DependencyUnhealthyReason, fmt.Sprintf("The check cannot be evaluated because its dependency %q is unhealthy: %s", rootCause, message))
+}
+
 func (h *Health) getAllExtensionConditions(ctx context.Context) ([]healthchecker.ExtensionCondition, []healthchecker.ExtensionCondition, []healthchecker.ExtensionCondition, []healthchecker.ExtensionCondition, error) {
 	objs, err := h.retrieveExtensions(ctx)
 	if err != nil {
@@ -239,11 +261,13 @@ func (h *Health) getAllExtensionConditions(ctx context.Context) ([]healthchecker
 		extensionType := acc.GetExtensionSpec().GetExtensionType()
 		namespace := acc.GetNamespace()
 
-		lastHeartbeatTime, err := h.getLastHeartbeatTimeForExtension(ctx, controllerInstallations, controllerRegistrations, kind, extensionType)
+		lastHeartbeatTime, controllerRegistrationName, err := h.getLastHeartbeatTimeForExtension(ctx, controllerInstallations, controllerRegistrations, kind, extensionType)
 		if err != nil {
 			return nil, nil, nil, nil, err
 		}
 
+		staleThreshold := h.staleThresholdForExtension(controllerRegistrationName)
+
 		for _, condition := range acc.GetExtensionStatus().GetConditions() {
 			switch condition.Type {
 			case gardencorev1beta1.ShootControlPlaneHealthy:
@@ -253,6 +277,7 @@ func (h *Health) getAllExtensionConditions(ctx context.Context) ([]healthchecker
 					ExtensionName:      name,
 					ExtensionNamespace: namespace,
 					LastHeartbeatTime:  lastHeartbeatTime,
+					StaleThreshold:     staleThreshold,
 				})
 			case gardencorev1beta1.ShootEveryNodeReady:
 				conditionsEveryNodeReady = append(conditionsEveryNodeReady, healthchecker.ExtensionCondition{
@@ -261,6 +286,7 @@ func (h *Health) getAllExtensionConditions(ctx context.Context) ([]healthchecker
 					ExtensionName:      name,
 					ExtensionNamespace: namespace,
 					LastHeartbeatTime:  lastHeartbeatTime,
+					StaleThreshold:     staleThreshold,
 				})
 			case gardencorev1beta1.ShootSystemComponentsHealthy:
 				conditionsSystemComponentsHealthy = append(conditionsSystemComponentsHealthy, healthchecker.ExtensionCondition{
@@ -269,6 +295,7 @@ func (h *Health) getAllExtensionConditions(ctx context.Context) ([]healthchecker
 					ExtensionName:      name,
 					ExtensionNamespace: namespace,
 					LastHeartbeatTime:  lastHeartbeatTime,
+					StaleThreshold:     staleThreshold,
 				})
 			case gardencorev1beta1.ShootObservabilityComponentsHealthy:
 				conditionsObservabilityComponentsHealthy = append(conditionsObservabilityComponentsHealthy, healthchecker.ExtensionCondition{
@@ -277,6 +304,7 @@ func (h *Health) getAllExtensionConditions(ctx context.Context) ([]healthchecker
 					ExtensionName:      name,
 					ExtensionNamespace: namespace,
 					LastHeartbeatTime:  lastHeartbeatTime,
+					StaleThreshold:     staleThreshold,
 				})
 			}
 		}
@@ -329,19 +357,19 @@ func (h *Health) retrieveExtensions(ctx context.Context) ([]runtime.Object, erro
 	return allExtensions, nil
 }
 
-func (h *Health) getLastHeartbeatTimeForExtension(ctx context.Context, controllerInstallations *gardencorev1beta1.ControllerInstallationList, controllerRegistrations *gardencorev1beta1.ControllerRegistrationList, extensionKind, extensionType string) (*metav1.MicroTime, error) {
+func (h *Health) getLastHeartbeatTimeForExtension(ctx context.Context, controllerInstallations *gardencorev1beta1.ControllerInstallationList, controllerRegistrations *gardencorev1beta1.ControllerRegistrationList, extensionKind, extensionType string) (*metav1.MicroTime, string, error) {
 	controllerRegistration, err := getControllerRegistrationForExtensionKindAndType(controllerRegistrations, extensionKind, extensionType)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	if lastHeartbeatTime, exists := h.controllerRegistrationToLastHeartbeatTime[controllerRegistration.Name]; exists {
-		return lastHeartbeatTime, nil
+		return lastHeartbeatTime, controllerRegistration.Name, nil
 	}
 
 	controllerInstallation, err := getControllerInstallationForControllerRegistration(controllerInstallations, controllerRegistration)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	heartBeatLease := &coordinationv1.Lease{
@@ -354,13 +382,29 @@ func (h *Health) getLastHeartbeatTimeForExtension(ctx context.Context, controlle
 	if err := h.seedClient.Client().Get(ctx, client.ObjectKeyFromObject(heartBeatLease), heartBeatLease); err != nil {
 		if apierrors.IsNotFound(err) {
 			h.controllerRegistrationToLastHeartbeatTime[controllerRegistration.Name] = nil
-			return nil, nil
+			return nil, controllerRegistration.Name, nil
 		}
-		return nil, err
+		return nil, "", err
 	}
 
 	h.controllerRegistrationToLastHeartbeatTime[controllerRegistration.Name] = heartBeatLease.Spec.RenewTime
-	return heartBeatLease.Spec.RenewTime, nil
+	return heartBeatLease.Spec.RenewTime, controllerRegistration.Name, nil
+}
+
+// staleThresholdForExtension returns the stale extension health check threshold configured for the given
+// ControllerRegistration, or nil if no extension-specific threshold is configured.
+func (h *Health) staleThresholdForExtension(controllerRegistrationName string) *metav1.Duration {
+	if h.gardenletConfiguration == nil ||
+		h.gardenletConfiguration.Controllers == nil ||
+		h.gardenletConfiguration.Controllers.ShootCare == nil ||
+		h.gardenletConfiguration.Controllers.ShootCare.StaleExtensionHealthChecks == nil {
+		return nil
+	}
+
+	if threshold, ok := h.gardenletConfiguration.Controllers.ShootCare.StaleExtensionHealthChecks.ThresholdsPerExtension[controllerRegistrationName]; ok {
+		return &threshold
+	}
+	return nil
 }
 
 func getControllerRegistrationForExtensionKindAndType(controllerRegistrations *gardencorev1beta1.ControllerRegistrationList, extensionKind, extensionType string) (*gardencorev1beta1.ControllerRegistration, error) {
@@ -482,7 +526,7 @@ func (h *Health) checkObservabilityComponents(
 		return exitCondition, nil
 	}
 
-	if h.shoot.Purpose != gardencorev1beta1.ShootPurposeTesting && gardenlethelper.IsMonitoringEnabled(h.gardenletConfiguration) {
+	if h.shoot.Purpose != gardencorev1beta1.ShootPurposeTesting && !v1beta1helper.IsShootMonitoringDisabled(h.shoot.GetInfo()) && gardenlethelper.IsMonitoringEnabled(h.gardenletConfiguration) {
 		if exitCondition, err := h.healthChecker.CheckMonitoringControlPlane(
 			ctx,
 			h.shoot.ControlPlaneNamespace,
@@ -557,6 +601,12 @@ func (h *Health) checkSystemComponents(
 			c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "TunnelConnectionBroken", msg)
 			return &c, nil
 		}
+
+		if exitCondition, err := h.CheckKubeSystemResourcePressure(ctx, shootClient, condition); err != nil {
+			return nil, err
+		} else if exitCondition != nil {
+			return exitCondition, nil
+		}
 	}
 
 	c := v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, "SystemComponentsRunning", "All system components are healthy.")
@@ -7,6 +7,9 @@ package care_test
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
@@ -22,7 +25,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/client-go/kubernetes/scheme"
+	fakerestclient "k8s.io/client-go/rest/fake"
 	"k8s.io/client-go/testing"
 	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
@@ -33,6 +38,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
 	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
@@ -85,6 +91,105 @@ var _ = Describe("health check", func() {
 		}
 	})
 
+	Describe("#Check", func() {
+		var (
+			shootObj     *shootpkg.Shoot
+			seedObj      *seedpkg.Seed
+			gardenClient client.Client
+
+			newHealth func(ShootClientInit) *Health
+		)
+
+		BeforeEach(func() {
+			shootObj = &shootpkg.Shoot{
+				ControlPlaneNamespace: controlPlaneNamespace,
+			}
+			shootObj.SetInfo(&gardencorev1beta1.Shoot{
+				Spec: shoot.Spec,
+				Status: gardencorev1beta1.ShootStatus{
+					LastOperation: &gardencorev1beta1.LastOperation{
+						Type:  gardencorev1beta1.LastOperationTypeReconcile,
+						State: gardencorev1beta1.LastOperationStateSucceeded,
+					},
+				},
+			})
+
+			seedObj = &seedpkg.Seed{}
+			seedObj.SetInfo(&gardencorev1beta1.Seed{})
+
+			gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+
+			newHealth = func(shootClientInit ShootClientInit) *Health {
+				return NewHealth(
+					logr.Discard(),
+					shootObj,
+					seedObj,
+					fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build(),
+					gardenClient,
+					shootClientInit,
+					fakeClock,
+					&gardenletconfigv1alpha1.GardenletConfiguration{SeedConfig: &gardenletconfigv1alpha1.SeedConfig{}},
+					nil,
+				)
+			}
+		})
+
+		conditionWithType := func(conditions []gardencorev1beta1.Condition, conditionType gardencorev1beta1.ConditionType) gardencorev1beta1.Condition {
+			for _, condition := range conditions {
+				if condition.Type == conditionType {
+					return condition
+				}
+			}
+
+			Fail(fmt.Sprintf("condition %q not found", conditionType))
+			return gardencorev1beta1.Condition{}
+		}
+
+		It("should point dependent conditions to the root cause if the shoot client cannot be initialized", func() {
+			health := newHealth(func() (kubernetes.Interface, bool, error) { return nil, false, nil })
+
+			updatedConditions := health.Check(ctx, nil, NewShootConditions(fakeClock, shootObj.GetInfo()))
+
+			apiServerAvailable := conditionWithType(updatedConditions, gardencorev1beta1.ShootAPIServerAvailable)
+			Expect(apiServerAvailable.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(apiServerAvailable.Reason).To(Equal("APIServerDown"))
+
+			systemComponentsHealthy := conditionWithType(updatedConditions, gardencorev1beta1.ShootSystemComponentsHealthy)
+			Expect(systemComponentsHealthy.Status).To(Equal(gardencorev1beta1.ConditionUnknown))
+			Expect(systemComponentsHealthy.Reason).To(Equal(DependencyUnhealthyReason))
+			Expect(systemComponentsHealthy.Message).To(ContainSubstring(string(gardencorev1beta1.ShootAPIServerAvailable)))
+
+			everyNodeReady := conditionWithType(updatedConditions, gardencorev1beta1.ShootEveryNodeReady)
+			Expect(everyNodeReady.Status).To(Equal(gardencorev1beta1.ConditionUnknown))
+			Expect(everyNodeReady.Reason).To(Equal(DependencyUnhealthyReason))
+			Expect(everyNodeReady.Message).To(ContainSubstring(string(gardencorev1beta1.ShootAPIServerAvailable)))
+		})
+
+		It("should point dependent conditions to the root cause if the API server availability check fails", func() {
+			shootClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+			restClient := &fakerestclient.RESTClient{
+				NegotiatedSerializer: serializer.NewCodecFactory(kubernetes.ShootScheme).WithoutConversion(),
+				Resp: &http.Response{
+					StatusCode: http.StatusInternalServerError,
+					Body:       io.NopCloser(strings.NewReader("")),
+				},
+			}
+			health := newHealth(func() (kubernetes.Interface, bool, error) {
+				return fakekubernetes.NewClientSetBuilder().WithClient(shootClient).WithRESTClient(restClient).Build(), true, nil
+			})
+
+			updatedConditions := health.Check(ctx, nil, NewShootConditions(fakeClock, shootObj.GetInfo()))
+
+			apiServerAvailable := conditionWithType(updatedConditions, gardencorev1beta1.ShootAPIServerAvailable)
+			Expect(apiServerAvailable.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+
+			systemComponentsHealthy := conditionWithType(updatedConditions, gardencorev1beta1.ShootSystemComponentsHealthy)
+			Expect(systemComponentsHealthy.Status).To(Equal(gardencorev1beta1.ConditionUnknown))
+			Expect(systemComponentsHealthy.Reason).To(Equal(DependencyUnhealthyReason))
+			Expect(systemComponentsHealthy.Message).To(ContainSubstring(string(gardencorev1beta1.ShootAPIServerAvailable)))
+		})
+	})
+
 	Describe("#ComputeRequiredControlPlaneDeployments", func() {
 		var (
 			workerlessDeploymentNames = []any{
@@ -14,6 +14,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -43,6 +44,10 @@ var (
 	NewGarbageCollector = defaultNewGarbageCollector
 	// NewWebhookRemediator is used to create a new webhook remediation instance.
 	NewWebhookRemediator = defaultNewWebhookRemediator
+	// NewResourceConsumptionMeasurer is used to create a new control plane resource consumption measurement instance.
+	NewResourceConsumptionMeasurer = defaultNewResourceConsumptionMeasurer
+	// NewWorkerPoolAggregator is used to create a new worker pool machine count aggregation instance.
+	NewWorkerPoolAggregator = defaultNewWorkerPoolAggregator
 )
 
 // Reconciler reconciles Shoot resources and executes care operations, e.g. health checks or garbage collection.
@@ -51,6 +56,7 @@ type Reconciler struct {
 	SeedClientSet         kubernetes.Interface
 	ShootClientMap        clientmap.ClientMap
 	Config                gardenletconfigv1alpha1.GardenletConfiguration
+	Recorder              record.EventRecorder
 	Clock                 clock.Clock
 	Identity              *gardencorev1beta1.Gardener
 	GardenClusterIdentity string
@@ -166,6 +172,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	var (
 		staleExtensionHealthCheckThreshold    = gardenlethelper.StaleExtensionHealthChecksThreshold(r.Config.Controllers.ShootCare.StaleExtensionHealthChecks)
 		initializeShootClients                = shootClientInitializer(careCtx, o)
+		breakGlassModeActive                  = v1beta1helper.ShootBreakGlassModeActive(shoot, r.Clock.Now())
 		updatedConditions, updatedConstraints []gardencorev1beta1.Condition
 	)
 
@@ -181,7 +188,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 				initializeShootClients,
 				r.Clock,
 				&r.Config,
-				r.conditionThresholdsToProgressingMapping(),
+				r.conditionThresholdsToProgressingMapping(shoot.Spec.Purpose),
 			).Check(
 				ctx,
 				staleExtensionHealthCheckThreshold,
@@ -205,18 +212,46 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		},
 		// Trigger garbage collection
 		func(ctx context.Context) error {
-			NewGarbageCollector(o, initializeShootClients).Collect(ctx)
-			// errors during garbage collection are only being logged and do not cause the care operation to fail
+			if !breakGlassModeActive {
+				NewGarbageCollector(o, initializeShootClients).Collect(ctx)
+				// errors during garbage collection are only being logged and do not cause the care operation to fail
+			}
 			return nil
 		},
 		// Trigger webhook remediation
 		func(ctx context.Context) error {
-			if ptr.Deref(r.Config.Controllers.ShootCare.WebhookRemediatorEnabled, false) {
+			if ptr.Deref(r.Config.Controllers.ShootCare.WebhookRemediatorEnabled, false) && !breakGlassModeActive {
 				_ = NewWebhookRemediator(log, shoot, initializeShootClients).Remediate(ctx)
 				// errors during webhook remediation are only being logged and do not cause the care operation to fail
 			}
 			return nil
 		},
+		// Reconcile break-glass maintenance mode
+		func(ctx context.Context) error {
+			if err := r.reconcileBreakGlassMode(ctx, log, shoot, o.Shoot.ControlPlaneNamespace); err != nil {
+				// errors during the break-glass mode reconciliation are only being logged and do not cause the care operation to fail
+				log.Error(err, "Error when reconciling the break-glass maintenance mode")
+			}
+			return nil
+		},
+		// Trigger control plane resource consumption measurement
+		func(ctx context.Context) error {
+			if ptr.Deref(r.Config.Controllers.ShootCare.ResourceConsumptionEnabled, false) {
+				if err := NewResourceConsumptionMeasurer(o, r.Clock).Measure(ctx); err != nil {
+					// errors during the measurement are only being logged and do not cause the care operation to fail
+					log.Error(err, "Error when measuring the control plane resource consumption")
+				}
+			}
+			return nil
+		},
+		// Trigger worker pool machine count aggregation
+		func(ctx context.Context) error {
+			if err := NewWorkerPoolAggregator(o).Aggregate(ctx); err != nil {
+				// errors during the aggregation are only being logged and do not cause the care operation to fail
+				log.Error(err, "Error when aggregating the worker pool machine counts")
+			}
+			return nil
+		},
 	)(careCtx); err != nil {
 		return reconcile.Result{}, err
 	}
@@ -229,11 +264,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	return reconcile.Result{RequeueAfter: r.Config.Controllers.ShootCare.SyncPeriod.Duration}, nil
 }
 
-func (r *Reconciler) conditionThresholdsToProgressingMapping() map[gardencorev1beta1.ConditionType]time.Duration {
+func (r *Reconciler) conditionThresholdsToProgressingMapping(purpose *gardencorev1beta1.ShootPurpose) map[gardencorev1beta1.ConditionType]time.Duration {
 	out := make(map[gardencorev1beta1.ConditionType]time.Duration)
 	for _, threshold := range r.Config.Controllers.ShootCare.ConditionThresholds {
 		out[gardencorev1beta1.ConditionType(threshold.Type)] = threshold.Duration.Duration
 	}
+
+	if purpose != nil {
+		for _, threshold := range r.Config.Controllers.ShootCare.ConditionThresholdsPerPurpose[*purpose] {
+			out[gardencorev1beta1.ConditionType(threshold.Type)] = threshold.Duration.Duration
+		}
+	}
+
 	return out
 }
 
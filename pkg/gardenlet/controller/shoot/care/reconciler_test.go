@@ -15,6 +15,7 @@ import (
 	"github.com/onsi/gomega/types"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
@@ -25,6 +26,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
@@ -264,6 +266,7 @@ var _ = Describe("Shoot Care Control", func() {
 				DeferCleanup(test.WithVars(
 					&NewOperation, operationFunc,
 					&NewGarbageCollector, nopGarbageCollectorFunc(),
+					&NewWorkerPoolAggregator, nopWorkerPoolAggregatorFunc(),
 				))
 				reconciler = &Reconciler{
 					GardenClient:   gardenClient,
@@ -542,6 +545,117 @@ var _ = Describe("Shoot Care Control", func() {
 					})
 				})
 			})
+
+			Context("when break-glass maintenance mode is configured", func() {
+				const annotationBreakGlassIgnored = "maintenance.shoot.gardener.cloud/break-glass-ignored"
+
+				var (
+					controlPlaneNamespace string
+					seedClient            client.Client
+					fakeRecorder          *record.FakeRecorder
+					managedResource       *resourcesv1alpha1.ManagedResource
+				)
+
+				BeforeEach(func() {
+					controlPlaneNamespace = "shoot--project--shoot"
+
+					DeferCleanup(test.WithVars(
+						&NewHealthCheck, healthCheckFunc(func(_ ShootConditions) []gardencorev1beta1.Condition { return nil }),
+						&NewConstraintCheck, constraintCheckFunc(func(_ ShootConstraints) []gardencorev1beta1.Condition { return nil }),
+					))
+				})
+
+				JustBeforeEach(func() {
+					seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+					fakeRecorder = record.NewFakeRecorder(8)
+
+					op := &operation.Operation{
+						GardenClient:  gardenClient,
+						SeedClientSet: fakekubernetes.NewClientSetBuilder().WithClient(seedClient).Build(),
+						Shoot:         &shootpkg.Shoot{ControlPlaneNamespace: controlPlaneNamespace},
+						Logger:        logr.Discard(),
+					}
+					op.Shoot.SetInfo(shoot)
+					DeferCleanup(test.WithVars(&NewOperation, opFunc(op, nil)))
+
+					reconciler = &Reconciler{
+						GardenClient:   gardenClient,
+						SeedClientSet:  fakekubernetes.NewClientSetBuilder().WithClient(seedClient).Build(),
+						ShootClientMap: shootClientMap,
+						Config:         gardenletConf,
+						Recorder:       fakeRecorder,
+						Clock:          fakeClock,
+						SeedName:       seedName,
+					}
+
+					managedResource = &resourcesv1alpha1.ManagedResource{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "managedresource",
+							Namespace: controlPlaneNamespace,
+						},
+					}
+					Expect(seedClient.Create(ctx, managedResource)).To(Succeed())
+				})
+
+				Context("when the mode is active", func() {
+					BeforeEach(func() {
+						metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.ShootBreakGlassUntil, fakeClock.Now().Add(time.Hour).Format(time.RFC3339))
+					})
+
+					It("should pause drift reversion of ManagedResources and emit an event", func() {
+						Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+						Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+						Expect(managedResource.Annotations).To(HaveKeyWithValue(resourcesv1alpha1.Ignore, "true"))
+						Expect(managedResource.Annotations).To(HaveKeyWithValue(annotationBreakGlassIgnored, "true"))
+						Expect(fakeRecorder.Events).To(Receive(ContainSubstring("BreakGlassModeActivated")))
+					})
+
+					It("should not touch ManagedResources which are already ignored", func() {
+						metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, resourcesv1alpha1.Ignore, "true")
+						Expect(seedClient.Update(ctx, managedResource)).To(Succeed())
+
+						Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+						Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+						Expect(managedResource.Annotations).NotTo(HaveKey(annotationBreakGlassIgnored))
+						Expect(fakeRecorder.Events).NotTo(Receive())
+					})
+				})
+
+				Context("when the mode has expired", func() {
+					BeforeEach(func() {
+						metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.ShootBreakGlassUntil, fakeClock.Now().Add(-time.Hour).Format(time.RFC3339))
+					})
+
+					It("should resume drift reversion and remove the annotation from the shoot", func() {
+						metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, resourcesv1alpha1.Ignore, "true")
+						metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, annotationBreakGlassIgnored, "true")
+						Expect(seedClient.Update(ctx, managedResource)).To(Succeed())
+
+						Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+						Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+						Expect(managedResource.Annotations).NotTo(HaveKey(resourcesv1alpha1.Ignore))
+						Expect(managedResource.Annotations).NotTo(HaveKey(annotationBreakGlassIgnored))
+
+						updatedShoot := &gardencorev1beta1.Shoot{}
+						Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+						Expect(updatedShoot.Annotations).NotTo(HaveKey(v1beta1constants.ShootBreakGlassUntil))
+						Expect(fakeRecorder.Events).To(Receive(ContainSubstring("BreakGlassModeDeactivated")))
+					})
+
+					It("should keep manually ignored ManagedResources untouched", func() {
+						metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, resourcesv1alpha1.Ignore, "true")
+						Expect(seedClient.Update(ctx, managedResource)).To(Succeed())
+
+						Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+						Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+						Expect(managedResource.Annotations).To(HaveKeyWithValue(resourcesv1alpha1.Ignore, "true"))
+					})
+				})
+			})
 		})
 	})
 })
@@ -614,6 +728,16 @@ func nopGarbageCollectorFunc() NewGarbageCollectorFunc {
 	}
 }
 
+type nopWorkerPoolAggregator struct{}
+
+func (n *nopWorkerPoolAggregator) Aggregate(_ context.Context) error { return nil }
+
+func nopWorkerPoolAggregatorFunc() NewWorkerPoolAggregatorFunc {
+	return func(_ *operation.Operation) WorkerPoolAggregator {
+		return &nopWorkerPoolAggregator{}
+	}
+}
+
 func containConditionsInUnknownStatus(message string, isWorkerless bool) types.GomegaMatcher {
 	var expectedLength = 5
 	matcher := And(
@@ -653,7 +777,7 @@ func containConditionsInUnknownStatus(message string, isWorkerless bool) types.G
 }
 
 func containConstraintsInUnknownStatus(message string) types.GomegaMatcher {
-	var expectedLength = 6
+	var expectedLength = 7
 	matcher := And(
 		ContainCondition(
 			OfType(gardencorev1beta1.ShootHibernationPossible),
@@ -677,6 +801,10 @@ func containConstraintsInUnknownStatus(message string) types.GomegaMatcher {
 			OfType(gardencorev1beta1.ShootManualInPlaceWorkersUpdated),
 			WithStatus(gardencorev1beta1.ConditionUnknown),
 			WithMessage(message),
+		), ContainCondition(
+			OfType(gardencorev1beta1.ShootNoOrphanedCloudResources),
+			WithStatus(gardencorev1beta1.ConditionUnknown),
+			WithMessage(message),
 		),
 	)
 
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"fmt"
+
+	druidcorev1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+)
+
+// ResourceConsumption measures the resource consumption of a shoot's control plane and publishes it in the
+// shoot status.
+type ResourceConsumption struct {
+	shoot        *shoot.Shoot
+	gardenClient client.Client
+	seedClient   client.Client
+	clock        clock.Clock
+}
+
+// NewResourceConsumption creates a new instance for measuring the control plane resource consumption.
+func NewResourceConsumption(op *operation.Operation, clock clock.Clock) *ResourceConsumption {
+	return &ResourceConsumption{
+		shoot:        op.Shoot,
+		gardenClient: op.GardenClient,
+		seedClient:   op.SeedClientSet.Client(),
+		clock:        clock,
+	}
+}
+
+// Measure reads the current CPU/memory usage of the kube-apiserver pods and the storage capacity of the main etcd
+// and publishes the measurement in the shoot status.
+func (r *ResourceConsumption) Measure(ctx context.Context) error {
+	consumption := &gardencorev1beta1.ControlPlaneResourceConsumption{LastUpdateTime: metav1.Time{Time: r.clock.Now()}}
+
+	podMetricsList := &metricsv1beta1.PodMetricsList{}
+	if err := r.seedClient.List(ctx, podMetricsList, client.InNamespace(r.shoot.ControlPlaneNamespace), client.MatchingLabels{
+		v1beta1constants.LabelApp:  v1beta1constants.LabelKubernetes,
+		v1beta1constants.LabelRole: v1beta1constants.LabelAPIServer,
+	}); err != nil {
+		return fmt.Errorf("failed reading the pod metrics of the kube-apiserver: %w", err)
+	}
+
+	if len(podMetricsList.Items) > 0 {
+		var cpu, memory resource.Quantity
+
+		for _, podMetrics := range podMetricsList.Items {
+			for _, container := range podMetrics.Containers {
+				cpu.Add(container.Usage[corev1.ResourceCPU])
+				memory.Add(container.Usage[corev1.ResourceMemory])
+			}
+		}
+
+		consumption.KubeAPIServerCPU = &cpu
+		consumption.KubeAPIServerMemory = &memory
+	}
+
+	etcd := &druidcorev1alpha1.Etcd{}
+	if err := r.seedClient.Get(ctx, client.ObjectKey{Namespace: r.shoot.ControlPlaneNamespace, Name: v1beta1constants.ETCDMain}, etcd); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed reading the main etcd: %w", err)
+		}
+	} else if etcd.Spec.StorageCapacity != nil {
+		storageSize := etcd.Spec.StorageCapacity.DeepCopy()
+		consumption.ETCDStorageSize = &storageSize
+	}
+
+	return r.shoot.UpdateInfoStatus(ctx, r.gardenClient, false, false, func(shoot *gardencorev1beta1.Shoot) error {
+		shoot.Status.ResourceConsumption = consumption
+		return nil
+	})
+}
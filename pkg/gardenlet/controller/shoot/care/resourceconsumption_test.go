@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+	"time"
+
+	druidcorev1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+)
+
+var _ = Describe("ResourceConsumption", func() {
+	var (
+		ctx = context.Background()
+
+		gardenClient client.Client
+		seedClient   client.Client
+		fakeClock    *testclock.FakeClock
+
+		controlPlaneNamespace = "shoot--project--foo"
+		shoot                 *gardencorev1beta1.Shoot
+		op                    *operation.Operation
+
+		measurement *ResourceConsumption
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Now())
+
+		shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-project"}}
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+		op = &operation.Operation{
+			GardenClient:  gardenClient,
+			SeedClientSet: fakekubernetes.NewClientSetBuilder().WithClient(seedClient).Build(),
+			Shoot:         &shootpkg.Shoot{ControlPlaneNamespace: controlPlaneNamespace},
+		}
+		op.Shoot.SetInfo(shoot)
+
+		measurement = NewResourceConsumption(op, fakeClock)
+	})
+
+	Describe("#Measure", func() {
+		It("should publish an empty measurement if neither pod metrics nor the main etcd exist", func() {
+			Expect(measurement.Measure(ctx)).To(Succeed())
+
+			consumption := op.Shoot.GetInfo().Status.ResourceConsumption
+			Expect(consumption).NotTo(BeNil())
+			Expect(consumption.KubeAPIServerCPU).To(BeNil())
+			Expect(consumption.KubeAPIServerMemory).To(BeNil())
+			Expect(consumption.ETCDStorageSize).To(BeNil())
+			Expect(consumption.LastUpdateTime.Time).To(BeTemporally("~", fakeClock.Now(), time.Second))
+		})
+
+		It("should sum up the kube-apiserver pod metrics and report the etcd storage capacity", func() {
+			for _, podName := range []string{"kube-apiserver-1", "kube-apiserver-2"} {
+				Expect(seedClient.Create(ctx, &metricsv1beta1.PodMetrics{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      podName,
+						Namespace: controlPlaneNamespace,
+						Labels:    map[string]string{"app": "kubernetes", "role": "apiserver"},
+					},
+					Containers: []metricsv1beta1.ContainerMetrics{{
+						Name: "kube-apiserver",
+						Usage: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("100m"),
+							corev1.ResourceMemory: resource.MustParse("1Gi"),
+						},
+					}},
+				})).To(Succeed())
+			}
+
+			Expect(seedClient.Create(ctx, &metricsv1beta1.PodMetrics{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "etcd-main-0",
+					Namespace: controlPlaneNamespace,
+					Labels:    map[string]string{"app": "etcd-statefulset"},
+				},
+				Containers: []metricsv1beta1.ContainerMetrics{{
+					Name:  "etcd",
+					Usage: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+				}},
+			})).To(Succeed())
+
+			Expect(seedClient.Create(ctx, &druidcorev1alpha1.Etcd{
+				ObjectMeta: metav1.ObjectMeta{Name: "etcd-main", Namespace: controlPlaneNamespace},
+				Spec:       druidcorev1alpha1.EtcdSpec{StorageCapacity: ptr.To(resource.MustParse("25Gi"))},
+			})).To(Succeed())
+
+			Expect(measurement.Measure(ctx)).To(Succeed())
+
+			consumption := op.Shoot.GetInfo().Status.ResourceConsumption
+			Expect(consumption).NotTo(BeNil())
+			Expect(consumption.KubeAPIServerCPU.String()).To(Equal("200m"))
+			Expect(consumption.KubeAPIServerMemory.String()).To(Equal("2Gi"))
+			Expect(consumption.ETCDStorageSize.String()).To(Equal("25Gi"))
+		})
+	})
+})
@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubeproxy "github.com/gardener/gardener/pkg/component/kubernetes/proxy"
+	corednsconstants "github.com/gardener/gardener/pkg/component/networking/coredns/constants"
+)
+
+const (
+	// corednsMetricsPort is the port on which the CoreDNS pods expose their metrics.
+	corednsMetricsPort = 9153
+	// kubeProxyMetricsPort is the port on which the kube-proxy pods expose their metrics.
+	kubeProxyMetricsPort = 10249
+	// nodeExporterMetricsPort is the port on which the node-exporter pods expose their metrics.
+	nodeExporterMetricsPort = 16909
+
+	// metricNameCoreDNSConcurrentRejects is the name of the CoreDNS metric counting queries which were rejected because
+	// the configured maximum number of concurrent queries was reached.
+	metricNameCoreDNSConcurrentRejects = "coredns_forward_max_concurrent_rejects_total"
+	// metricNameKubeProxySyncDuration is the name of the kube-proxy metric measuring the proxy rules sync duration.
+	metricNameKubeProxySyncDuration = "kubeproxy_sync_proxy_rules_duration_seconds"
+	// metricNameConntrackEntries is the name of the node-exporter metric exposing the number of conntrack entries.
+	metricNameConntrackEntries = "node_nf_conntrack_entries"
+	// metricNameConntrackEntriesLimit is the name of the node-exporter metric exposing the size of the conntrack table.
+	metricNameConntrackEntriesLimit = "node_nf_conntrack_entries_limit"
+
+	// conntrackUsageThreshold is the ratio of used conntrack entries above which the conntrack table of a node is
+	// considered exhausted.
+	conntrackUsageThreshold = 0.9
+	// kubeProxySyncDurationThreshold is the mean proxy rules sync duration above which kube-proxy is considered
+	// unhealthy.
+	kubeProxySyncDurationThreshold = 10 * time.Second
+)
+
+// ScrapePodMetrics scrapes the metrics endpoint of the given pod in the kube-system namespace via the API server proxy
+// and parses the response. Exposed for testing.
+var ScrapePodMetrics = func(ctx context.Context, shootClient kubernetes.Interface, podName string, port int32) (map[string]*dto.MetricFamily, error) {
+	raw, err := shootClient.Kubernetes().CoreV1().Pods(metav1.NamespaceSystem).ProxyGet("http", podName, strconv.Itoa(int(port)), "/metrics", nil).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var parser expfmt.TextParser
+	return parser.TextToMetricFamilies(bytes.NewReader(raw))
+}
+
+// CheckKubeSystemResourcePressure checks common data-plane failure modes of the system components in the kube-system
+// namespace based on the metrics they expose: CoreDNS query saturation, conntrack table exhaustion, and slow kube-proxy
+// rules syncs. Pods whose metrics cannot be scraped are skipped because their endpoints might be briefly unavailable,
+// e.g. while they are being rolled.
+func (h *Health) CheckKubeSystemResourcePressure(
+	ctx context.Context,
+	shootClient kubernetes.Interface,
+	condition gardencorev1beta1.Condition,
+) (
+	*gardencorev1beta1.Condition,
+	error,
+) {
+	checks := []struct {
+		selector client.MatchingLabels
+		port     int32
+		evaluate func(pod corev1.Pod, metricFamilies map[string]*dto.MetricFamily) (string, string)
+	}{
+		{
+			selector: client.MatchingLabels{corednsconstants.LabelKey: corednsconstants.LabelValue},
+			port:     corednsMetricsPort,
+			evaluate: evaluateCoreDNSPressure,
+		},
+		{
+			selector: client.MatchingLabels(kubeproxy.GetLabels()),
+			port:     kubeProxyMetricsPort,
+			evaluate: evaluateKubeProxyPressure,
+		},
+		{
+			selector: client.MatchingLabels{"component": "node-exporter"},
+			port:     nodeExporterMetricsPort,
+			evaluate: evaluateConntrackPressure,
+		},
+	}
+
+	for _, check := range checks {
+		podList := &corev1.PodList{}
+		if err := shootClient.Client().List(ctx, podList, client.InNamespace(metav1.NamespaceSystem), check.selector); err != nil {
+			return nil, err
+		}
+
+		for _, pod := range podList.Items {
+			if pod.Status.Phase != corev1.PodRunning {
+				continue
+			}
+
+			metricFamilies, err := ScrapePodMetrics(ctx, shootClient, pod.Name, check.port)
+			if err != nil {
+				h.log.Info("Failed scraping pod metrics for resource pressure check", "pod", client.ObjectKeyFromObject(&pod), "reason", err.Error())
+				continue
+			}
+
+			if reason, message := check.evaluate(pod, metricFamilies); reason != "" {
+				c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, reason, message)
+				return &c, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func evaluateCoreDNSPressure(pod corev1.Pod, metricFamilies map[string]*dto.MetricFamily) (string, string) {
+	if rejects := sumMetricValues(metricFamilies[metricNameCoreDNSConcurrentRejects]); rejects > 0 {
+		return "CoreDNSOverloaded", fmt.Sprintf("CoreDNS pod %q has rejected %d queries because the configured maximum number of concurrent queries was reached.", pod.Name, int64(rejects))
+	}
+	return "", ""
+}
+
+func evaluateKubeProxyPressure(pod corev1.Pod, metricFamilies map[string]*dto.MetricFamily) (string, string) {
+	if mean, ok := histogramMean(metricFamilies[metricNameKubeProxySyncDuration]); ok && mean > kubeProxySyncDurationThreshold.Seconds() {
+		return "KubeProxySyncSlow", fmt.Sprintf("kube-proxy pod %q needs %.1fs on average to sync the proxy rules.", pod.Name, mean)
+	}
+	return "", ""
+}
+
+func evaluateConntrackPressure(pod corev1.Pod, metricFamilies map[string]*dto.MetricFamily) (string, string) {
+	var (
+		entries = sumMetricValues(metricFamilies[metricNameConntrackEntries])
+		limit   = sumMetricValues(metricFamilies[metricNameConntrackEntriesLimit])
+	)
+
+	if limit > 0 && entries/limit >= conntrackUsageThreshold {
+		return "ConntrackTableAlmostFull", fmt.Sprintf("Node %q uses %d of %d available conntrack entries (%.0f%%).", pod.Spec.NodeName, int64(entries), int64(limit), entries/limit*100)
+	}
+	return "", ""
+}
+
+// sumMetricValues sums up the values of all metrics in the given family (supporting counters, gauges, and untyped
+// metrics).
+func sumMetricValues(metricFamily *dto.MetricFamily) float64 {
+	if metricFamily == nil {
+		return 0
+	}
+
+	var sum float64
+	for _, metric := range metricFamily.GetMetric() {
+		sum += metric.GetCounter().GetValue() + metric.GetGauge().GetValue() + metric.GetUntyped().GetValue()
+	}
+	return sum
+}
+
+// histogramMean computes the mean observation of all histogram metrics in the given family. It returns false if the
+// family does not contain any observations.
+func histogramMean(metricFamily *dto.MetricFamily) (float64, bool) {
+	if metricFamily == nil {
+		return 0, false
+	}
+
+	var sum, count float64
+	for _, metric := range metricFamily.GetMetric() {
+		sum += metric.GetHistogram().GetSampleSum()
+		count += float64(metric.GetHistogram().GetSampleCount())
+	}
+
+	if count == 0 {
+		return 0, false
+	}
+	return sum / count, true
+}
@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	dto "github.com/prometheus/client_model/go"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("resource pressure checks", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+
+		shootClient kubernetes.Interface
+		health      *Health
+		condition   gardencorev1beta1.Condition
+
+		podMetrics map[string]map[string]*dto.MetricFamily
+	)
+
+	counterFamily := func(name string, value float64) *dto.MetricFamily {
+		return &dto.MetricFamily{
+			Name:   ptr.To(name),
+			Type:   dto.MetricType_COUNTER.Enum(),
+			Metric: []*dto.Metric{{Counter: &dto.Counter{Value: ptr.To(value)}}},
+		}
+	}
+
+	gaugeFamily := func(name string, value float64) *dto.MetricFamily {
+		return &dto.MetricFamily{
+			Name:   ptr.To(name),
+			Type:   dto.MetricType_GAUGE.Enum(),
+			Metric: []*dto.Metric{{Gauge: &dto.Gauge{Value: ptr.To(value)}}},
+		}
+	}
+
+	histogramFamily := func(name string, sampleSum float64, sampleCount uint64) *dto.MetricFamily {
+		return &dto.MetricFamily{
+			Name:   ptr.To(name),
+			Type:   dto.MetricType_HISTOGRAM.Enum(),
+			Metric: []*dto.Metric{{Histogram: &dto.Histogram{SampleSum: ptr.To(sampleSum), SampleCount: ptr.To(sampleCount)}}},
+		}
+	}
+
+	newPod := func(name, nodeName string, labels map[string]string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceSystem, Labels: labels},
+			Spec:       corev1.PodSpec{NodeName: nodeName},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+	}
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Now())
+		condition = gardencorev1beta1.Condition{Type: gardencorev1beta1.ShootSystemComponentsHealthy}
+
+		shootObj := &shootpkg.Shoot{}
+		shootObj.SetInfo(&gardencorev1beta1.Shoot{})
+		seedObj := &seedpkg.Seed{}
+		seedObj.SetInfo(&gardencorev1beta1.Seed{})
+
+		shootClient = fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build()
+		health = NewHealth(
+			logr.Discard(),
+			shootObj,
+			seedObj,
+			fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build(),
+			nil,
+			nil,
+			fakeClock,
+			nil,
+			nil,
+		)
+
+		podMetrics = map[string]map[string]*dto.MetricFamily{}
+		DeferCleanup(test.WithVar(&ScrapePodMetrics, func(_ context.Context, _ kubernetes.Interface, podName string, _ int32) (map[string]*dto.MetricFamily, error) {
+			metricFamilies, ok := podMetrics[podName]
+			if !ok {
+				return nil, fmt.Errorf("no metrics for pod %q", podName)
+			}
+			return metricFamilies, nil
+		}))
+	})
+
+	It("should return nil if no relevant pods exist", func() {
+		exitCondition, err := health.CheckKubeSystemResourcePressure(ctx, shootClient, condition)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exitCondition).To(BeNil())
+	})
+
+	It("should return nil if all metrics are within their thresholds", func() {
+		Expect(fakeClient.Create(ctx, newPod("coredns-0", "node-1", map[string]string{"k8s-app": "kube-dns"}))).To(Succeed())
+		Expect(fakeClient.Create(ctx, newPod("kube-proxy-0", "node-1", map[string]string{"app": "kubernetes", "role": "proxy"}))).To(Succeed())
+		Expect(fakeClient.Create(ctx, newPod("node-exporter-0", "node-1", map[string]string{"component": "node-exporter"}))).To(Succeed())
+
+		podMetrics["coredns-0"] = map[string]*dto.MetricFamily{
+			"coredns_forward_max_concurrent_rejects_total": counterFamily("coredns_forward_max_concurrent_rejects_total", 0),
+		}
+		podMetrics["kube-proxy-0"] = map[string]*dto.MetricFamily{
+			"kubeproxy_sync_proxy_rules_duration_seconds": histogramFamily("kubeproxy_sync_proxy_rules_duration_seconds", 5, 100),
+		}
+		podMetrics["node-exporter-0"] = map[string]*dto.MetricFamily{
+			"node_nf_conntrack_entries":       gaugeFamily("node_nf_conntrack_entries", 1000),
+			"node_nf_conntrack_entries_limit": gaugeFamily("node_nf_conntrack_entries_limit", 65536),
+		}
+
+		exitCondition, err := health.CheckKubeSystemResourcePressure(ctx, shootClient, condition)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exitCondition).To(BeNil())
+	})
+
+	It("should skip pods whose metrics cannot be scraped", func() {
+		Expect(fakeClient.Create(ctx, newPod("coredns-0", "node-1", map[string]string{"k8s-app": "kube-dns"}))).To(Succeed())
+
+		exitCondition, err := health.CheckKubeSystemResourcePressure(ctx, shootClient, condition)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exitCondition).To(BeNil())
+	})
+
+	It("should return a failed condition if CoreDNS rejects queries", func() {
+		Expect(fakeClient.Create(ctx, newPod("coredns-0", "node-1", map[string]string{"k8s-app": "kube-dns"}))).To(Succeed())
+
+		podMetrics["coredns-0"] = map[string]*dto.MetricFamily{
+			"coredns_forward_max_concurrent_rejects_total": counterFamily("coredns_forward_max_concurrent_rejects_total", 42),
+		}
+
+		exitCondition, err := health.CheckKubeSystemResourcePressure(ctx, shootClient, condition)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exitCondition).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(gardencorev1beta1.ConditionFalse),
+			"Reason":  Equal("CoreDNSOverloaded"),
+			"Message": ContainSubstring("rejected 42 queries"),
+		})))
+	})
+
+	It("should return a failed condition if kube-proxy syncs the proxy rules slowly", func() {
+		Expect(fakeClient.Create(ctx, newPod("kube-proxy-0", "node-1", map[string]string{"app": "kubernetes", "role": "proxy"}))).To(Succeed())
+
+		podMetrics["kube-proxy-0"] = map[string]*dto.MetricFamily{
+			"kubeproxy_sync_proxy_rules_duration_seconds": histogramFamily("kubeproxy_sync_proxy_rules_duration_seconds", 1500, 100),
+		}
+
+		exitCondition, err := health.CheckKubeSystemResourcePressure(ctx, shootClient, condition)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exitCondition).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(gardencorev1beta1.ConditionFalse),
+			"Reason":  Equal("KubeProxySyncSlow"),
+			"Message": ContainSubstring(`kube-proxy pod "kube-proxy-0"`),
+		})))
+	})
+
+	It("should return a failed condition if the conntrack table of a node is almost full", func() {
+		Expect(fakeClient.Create(ctx, newPod("node-exporter-0", "node-1", map[string]string{"component": "node-exporter"}))).To(Succeed())
+
+		podMetrics["node-exporter-0"] = map[string]*dto.MetricFamily{
+			"node_nf_conntrack_entries":       gaugeFamily("node_nf_conntrack_entries", 60000),
+			"node_nf_conntrack_entries_limit": gaugeFamily("node_nf_conntrack_entries_limit", 65536),
+		}
+
+		exitCondition, err := health.CheckKubeSystemResourcePressure(ctx, shootClient, condition)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exitCondition).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(gardencorev1beta1.ConditionFalse),
+			"Reason":  Equal("ConntrackTableAlmostFull"),
+			"Message": ContainSubstring(`Node "node-1" uses 60000 of 65536 available conntrack entries`),
+		})))
+	})
+
+	It("should ignore pods which are not running", func() {
+		pod := newPod("coredns-0", "node-1", map[string]string{"k8s-app": "kube-dns"})
+		pod.Status.Phase = corev1.PodPending
+		Expect(fakeClient.Create(ctx, pod)).To(Succeed())
+
+		podMetrics["coredns-0"] = map[string]*dto.MetricFamily{
+			"coredns_forward_max_concurrent_rejects_total": counterFamily("coredns_forward_max_concurrent_rejects_total", 42),
+		}
+
+		exitCondition, err := health.CheckKubeSystemResourcePressure(ctx, shootClient, condition)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exitCondition).To(BeNil())
+	})
+})
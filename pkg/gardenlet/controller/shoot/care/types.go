@@ -124,6 +124,32 @@ var defaultNewWebhookRemediator = func(log logr.Logger, shoot *gardencorev1beta1
 	return NewWebhookRemediation(log, shoot, init)
 }
 
+// ResourceConsumptionMeasurer is an interface used to measure the control plane resource consumption.
+type ResourceConsumptionMeasurer interface {
+	Measure(ctx context.Context) error
+}
+
+// NewResourceConsumptionMeasurerFunc is a function used to create a new instance to measure the control plane resource consumption.
+type NewResourceConsumptionMeasurerFunc func(op *operation.Operation, clock clock.Clock) ResourceConsumptionMeasurer
+
+// defaultNewResourceConsumptionMeasurer is the default function to create a new instance to measure the control plane resource consumption.
+var defaultNewResourceConsumptionMeasurer = func(op *operation.Operation, clock clock.Clock) ResourceConsumptionMeasurer {
+	return NewResourceConsumption(op, clock)
+}
+
+// WorkerPoolAggregator is an interface used to aggregate the worker pool machine counts.
+type WorkerPoolAggregator interface {
+	Aggregate(ctx context.Context) error
+}
+
+// NewWorkerPoolAggregatorFunc is a function used to create a new instance to aggregate the worker pool machine counts.
+type NewWorkerPoolAggregatorFunc func(op *operation.Operation) WorkerPoolAggregator
+
+// defaultNewWorkerPoolAggregator is the default function to create a new instance to aggregate the worker pool machine counts.
+var defaultNewWorkerPoolAggregator = func(op *operation.Operation) WorkerPoolAggregator {
+	return NewWorkerPools(op)
+}
+
 // NewOperationFunc is a function used to create a new `operation.Operation` instance.
 type NewOperationFunc func(
 	ctx context.Context,
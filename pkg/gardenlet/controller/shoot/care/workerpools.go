@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+)
+
+// WorkerPools aggregates the machine counts of the shoot's worker pools from the machine deployments in the seed
+// cluster and publishes them in the shoot status.
+type WorkerPools struct {
+	shoot        *shoot.Shoot
+	gardenClient client.Client
+	seedClient   client.Client
+}
+
+// NewWorkerPools creates a new instance for aggregating the worker pool machine counts.
+func NewWorkerPools(op *operation.Operation) *WorkerPools {
+	return &WorkerPools{
+		shoot:        op.Shoot,
+		gardenClient: op.GardenClient,
+		seedClient:   op.SeedClientSet.Client(),
+	}
+}
+
+// Aggregate sums up the machine counts of the machine deployments per worker pool and publishes the result in the
+// shoot status.
+func (w *WorkerPools) Aggregate(ctx context.Context) error {
+	if w.shoot.IsWorkerless {
+		return nil
+	}
+
+	machineDeploymentList := &machinev1alpha1.MachineDeploymentList{}
+	if err := w.seedClient.List(ctx, machineDeploymentList, client.InNamespace(w.shoot.ControlPlaneNamespace)); err != nil {
+		return fmt.Errorf("failed listing the machine deployments: %w", err)
+	}
+
+	poolNameToStatus := map[string]*gardencorev1beta1.WorkerPoolStatus{}
+	for _, machineDeployment := range machineDeploymentList.Items {
+		poolName, ok := machineDeployment.Spec.Template.Spec.NodeTemplateSpec.Labels[v1beta1constants.LabelWorkerPool]
+		if !ok {
+			continue
+		}
+
+		poolStatus := poolNameToStatus[poolName]
+		if poolStatus == nil {
+			poolStatus = &gardencorev1beta1.WorkerPoolStatus{Name: poolName}
+			poolNameToStatus[poolName] = poolStatus
+		}
+
+		poolStatus.DesiredMachines += machineDeployment.Spec.Replicas
+		poolStatus.CurrentMachines += machineDeployment.Status.Replicas
+		poolStatus.UpdatedMachines += machineDeployment.Status.UpdatedReplicas
+		poolStatus.UnavailableMachines += machineDeployment.Status.UnavailableReplicas
+
+		for _, condition := range machineDeployment.Status.Conditions {
+			if condition.Type == machinev1alpha1.MachineDeploymentProgressing &&
+				(poolStatus.LastRolloutTime == nil || poolStatus.LastRolloutTime.Before(&condition.LastUpdateTime)) {
+				poolStatus.LastRolloutTime = condition.LastUpdateTime.DeepCopy()
+			}
+		}
+	}
+
+	workerPools := make([]gardencorev1beta1.WorkerPoolStatus, 0, len(poolNameToStatus))
+	for _, poolStatus := range poolNameToStatus {
+		workerPools = append(workerPools, *poolStatus)
+	}
+	slices.SortFunc(workerPools, func(a, b gardencorev1beta1.WorkerPoolStatus) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	return w.shoot.UpdateInfoStatus(ctx, w.gardenClient, false, false, func(shoot *gardencorev1beta1.Shoot) error {
+		shoot.Status.WorkerPools = workerPools
+		return nil
+	})
+}
@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+	"time"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+)
+
+var _ = Describe("WorkerPools", func() {
+	var (
+		ctx = context.Background()
+
+		gardenClient client.Client
+		seedClient   client.Client
+
+		controlPlaneNamespace = "shoot--project--foo"
+		shoot                 *gardencorev1beta1.Shoot
+		op                    *operation.Operation
+
+		aggregator *WorkerPools
+
+		machineDeployment = func(name, poolName string, desired, current, updated, unavailable int32, lastRolloutTime *metav1.Time) *machinev1alpha1.MachineDeployment {
+			deployment := &machinev1alpha1.MachineDeployment{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: controlPlaneNamespace},
+				Spec:       machinev1alpha1.MachineDeploymentSpec{Replicas: desired},
+				Status: machinev1alpha1.MachineDeploymentStatus{
+					Replicas:            current,
+					UpdatedReplicas:     updated,
+					UnavailableReplicas: unavailable,
+				},
+			}
+
+			if poolName != "" {
+				deployment.Spec.Template.Spec.NodeTemplateSpec.Labels = map[string]string{v1beta1constants.LabelWorkerPool: poolName}
+			}
+			if lastRolloutTime != nil {
+				deployment.Status.Conditions = []machinev1alpha1.MachineDeploymentCondition{{
+					Type:           machinev1alpha1.MachineDeploymentProgressing,
+					Status:         machinev1alpha1.ConditionTrue,
+					LastUpdateTime: *lastRolloutTime,
+				}}
+			}
+
+			return deployment
+		}
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+		objectTracker := testing.NewObjectTracker(kubernetes.SeedScheme, scheme.Codecs.UniversalDecoder())
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjectTracker(objectTracker).Build()
+
+		shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-project"}}
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+		op = &operation.Operation{
+			GardenClient:  gardenClient,
+			SeedClientSet: fakekubernetes.NewClientSetBuilder().WithClient(seedClient).Build(),
+			Shoot:         &shootpkg.Shoot{ControlPlaneNamespace: controlPlaneNamespace},
+		}
+		op.Shoot.SetInfo(shoot)
+
+		aggregator = NewWorkerPools(op)
+	})
+
+	Describe("#Aggregate", func() {
+		It("should do nothing for workerless shoots", func() {
+			op.Shoot.IsWorkerless = true
+
+			Expect(aggregator.Aggregate(ctx)).To(Succeed())
+			Expect(op.Shoot.GetInfo().Status.WorkerPools).To(BeEmpty())
+		})
+
+		It("should publish an empty worker pool status if no machine deployments exist", func() {
+			Expect(aggregator.Aggregate(ctx)).To(Succeed())
+			Expect(op.Shoot.GetInfo().Status.WorkerPools).To(BeEmpty())
+		})
+
+		It("should sum up the machine counts of the machine deployments per worker pool", func() {
+			var (
+				olderRolloutTime = metav1.Time{Time: time.Date(2025, 8, 30, 10, 0, 0, 0, time.UTC)}
+				newerRolloutTime = metav1.Time{Time: time.Date(2025, 8, 30, 12, 0, 0, 0, time.UTC)}
+			)
+
+			expectedRolloutTime := newerRolloutTime.Rfc3339Copy()
+			expectedRolloutTime.Time = expectedRolloutTime.Local()
+
+			Expect(seedClient.Create(ctx, machineDeployment("pool-a-z1", "pool-a", 2, 2, 1, 0, &olderRolloutTime))).To(Succeed())
+			Expect(seedClient.Create(ctx, machineDeployment("pool-a-z2", "pool-a", 3, 2, 2, 1, &newerRolloutTime))).To(Succeed())
+			Expect(seedClient.Create(ctx, machineDeployment("pool-b-z1", "pool-b", 1, 1, 1, 0, nil))).To(Succeed())
+			Expect(seedClient.Create(ctx, machineDeployment("unrelated", "", 1, 1, 1, 0, nil))).To(Succeed())
+
+			Expect(aggregator.Aggregate(ctx)).To(Succeed())
+
+			Expect(op.Shoot.GetInfo().Status.WorkerPools).To(Equal([]gardencorev1beta1.WorkerPoolStatus{
+				{
+					Name:                "pool-a",
+					DesiredMachines:     5,
+					CurrentMachines:     4,
+					UpdatedMachines:     3,
+					UnavailableMachines: 1,
+					LastRolloutTime:     &expectedRolloutTime,
+				},
+				{
+					Name:            "pool-b",
+					DesiredMachines: 1,
+					CurrentMachines: 1,
+					UpdatedMachines: 1,
+				},
+			}))
+		})
+	})
+})
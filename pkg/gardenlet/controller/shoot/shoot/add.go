@@ -41,6 +41,12 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster cluster.Clu
 	if r.Clock == nil {
 		r.Clock = clock.RealClock{}
 	}
+	if r.ReconciliationThrottler == nil && r.Config.Controllers.Shoot.ReconciliationThrottling != nil {
+		r.ReconciliationThrottler = NewReconciliationThrottler(r.Clock, r.SeedClientSet.Client(), r.Config.Controllers.Shoot.ReconciliationThrottling)
+	}
+	if r.OperationSlots == nil && r.Config.Controllers.Shoot.OperationConcurrency != nil {
+		r.OperationSlots = NewOperationSlots(r.Clock, gardenCluster.GetClient(), r.Config.Controllers.Shoot.OperationConcurrency, r.Config.SeedConfig.Name)
+	}
 
 	return builder.
 		ControllerManagedBy(mgr).
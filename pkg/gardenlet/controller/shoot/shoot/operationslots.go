@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+)
+
+const (
+	// operationSlotLeasePrefix is the name prefix of the Lease objects backing the operation slots.
+	operationSlotLeasePrefix = "shoot-operation-slot-"
+	// operationSlotRetryInterval is the interval after which a shoot operation is retried when all slots for its
+	// operation kind are currently occupied.
+	operationSlotRetryInterval = 30 * time.Second
+)
+
+// Kinds of operation slots. Each kind is backed by its own set of Lease objects, so the limits for the kinds are
+// independent of each other.
+const (
+	slotKindReconcile         = "reconcile"
+	slotKindMigrate           = "migrate"
+	slotKindRotateCredentials = "rotate-credentials"
+)
+
+// OperationSlots caps how many shoot operations may run simultaneously across the whole landscape. The slots are
+// backed by Lease objects in the `gardener-system-seed-lease` namespace of the garden cluster, so the gardenlets of
+// all seeds compete for the same set of slots. A nil OperationSlots admits all operations immediately.
+type OperationSlots struct {
+	clock        clock.Clock
+	gardenClient client.Client
+	config       gardenletconfigv1alpha1.ShootOperationConcurrency
+	seedName     string
+}
+
+// NewOperationSlots creates a new OperationSlots for the given configuration. It returns nil if the configuration is
+// nil, i.e., if landscape-wide operation concurrency limits are disabled.
+func NewOperationSlots(clk clock.Clock, gardenClient client.Client, config *gardenletconfigv1alpha1.ShootOperationConcurrency, seedName string) *OperationSlots {
+	if config == nil {
+		return nil
+	}
+
+	return &OperationSlots{
+		clock:        clk,
+		gardenClient: gardenClient,
+		config:       *config,
+		seedName:     seedName,
+	}
+}
+
+// TryAcquire attempts to acquire a free operation slot for the given shoot and operation type. If all slots for the
+// operation kind are occupied, it returns false. On success, it returns a release function which must be called once
+// the operation has finished. Until then, the slot's Lease is renewed periodically in the background so that
+// long-running operations do not lose their slot.
+func (s *OperationSlots) TryAcquire(ctx context.Context, shoot *gardencorev1beta1.Shoot, operationType gardencorev1beta1.LastOperationType) (func(), bool) {
+	if s == nil {
+		return func() {}, true
+	}
+
+	kind, limit := s.slotKindAndLimit(shoot, operationType)
+	if limit == nil {
+		return func() {}, true
+	}
+
+	holderIdentity := s.seedName + "/" + shoot.Namespace + "/" + shoot.Name
+	for i := int32(0); i < *limit; i++ {
+		if release, ok := s.tryAcquireSlot(ctx, fmt.Sprintf("%s%s-%d", operationSlotLeasePrefix, kind, i), holderIdentity); ok {
+			return release, true
+		}
+	}
+
+	return nil, false
+}
+
+// slotKindAndLimit returns the kind of operation slot required for the given shoot and operation type as well as the
+// configured limit for this kind. A nil limit means that the kind is not limited.
+func (s *OperationSlots) slotKindAndLimit(shoot *gardencorev1beta1.Shoot, operationType gardencorev1beta1.LastOperationType) (string, *int32) {
+	switch {
+	case operationType == gardencorev1beta1.LastOperationTypeMigrate:
+		return slotKindMigrate, s.config.MaxMigrations
+	case credentialsRotationInProgress(shoot):
+		return slotKindRotateCredentials, s.config.MaxCredentialsRotations
+	default:
+		return slotKindReconcile, s.config.MaxReconciles
+	}
+}
+
+// credentialsRotationInProgress returns true if a credentials rotation was requested for the shoot or if one of its
+// credentials rotations is currently in the Preparing or Completing phase.
+func credentialsRotationInProgress(shoot *gardencorev1beta1.Shoot) bool {
+	if strings.HasPrefix(shoot.Annotations[v1beta1constants.GardenerOperation], "rotate-") {
+		return true
+	}
+
+	for _, phase := range []gardencorev1beta1.CredentialsRotationPhase{
+		v1beta1helper.GetShootCARotationPhase(shoot.Status.Credentials),
+		v1beta1helper.GetShootServiceAccountKeyRotationPhase(shoot.Status.Credentials),
+		v1beta1helper.GetShootETCDEncryptionKeyRotationPhase(shoot.Status.Credentials),
+	} {
+		switch phase {
+		case gardencorev1beta1.RotationPreparing, gardencorev1beta1.RotationPreparingWithoutWorkersRollout, gardencorev1beta1.RotationCompleting:
+			return true
+		}
+	}
+
+	return false
+}
+
+// tryAcquireSlot attempts to acquire the slot backed by the Lease with the given name. A slot is free if its Lease
+// does not exist, has no holder, or was not renewed within the configured lease duration (e.g., because the holding
+// gardenlet crashed). Conflicting updates mean that another gardenlet acquired the slot first.
+func (s *OperationSlots) tryAcquireSlot(ctx context.Context, name, holderIdentity string) (func(), bool) {
+	var (
+		now           = s.clock.Now()
+		leaseDuration = ptr.Deref(s.config.LeaseDuration, metav1.Duration{Duration: 2 * time.Minute}).Duration
+		lease         = &coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: gardencorev1beta1.GardenerSeedLeaseNamespace}}
+	)
+
+	if err := s.gardenClient.Get(ctx, client.ObjectKeyFromObject(lease), lease); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, false
+		}
+
+		s.setHolder(lease, holderIdentity, now, leaseDuration)
+		if err := s.gardenClient.Create(ctx, lease); err != nil {
+			return nil, false
+		}
+		return s.hold(lease, leaseDuration), true
+	}
+
+	if holder := ptr.Deref(lease.Spec.HolderIdentity, ""); holder != "" && holder != holderIdentity &&
+		lease.Spec.RenewTime != nil && lease.Spec.RenewTime.Add(leaseDuration).After(now) {
+		return nil, false
+	}
+
+	s.setHolder(lease, holderIdentity, now, leaseDuration)
+	if err := s.gardenClient.Update(ctx, lease); err != nil {
+		return nil, false
+	}
+	return s.hold(lease, leaseDuration), true
+}
+
+func (s *OperationSlots) setHolder(lease *coordinationv1.Lease, holderIdentity string, now time.Time, leaseDuration time.Duration) {
+	lease.Spec.HolderIdentity = ptr.To(holderIdentity)
+	lease.Spec.LeaseDurationSeconds = ptr.To(int32(leaseDuration / time.Second))
+	lease.Spec.AcquireTime = &metav1.MicroTime{Time: now}
+	lease.Spec.RenewTime = &metav1.MicroTime{Time: now}
+}
+
+// hold starts renewing the Lease of an acquired slot in the background and returns the function releasing the slot
+// again. The release function stops the renewals and clears the Lease's holder so that the slot becomes free for
+// other operations.
+func (s *OperationSlots) hold(lease *coordinationv1.Lease, leaseDuration time.Duration) func() {
+	stop := make(chan struct{})
+
+	go func() {
+		ticker := time.NewTicker(leaseDuration / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				patch := client.MergeFrom(lease.DeepCopy())
+				lease.Spec.RenewTime = &metav1.MicroTime{Time: s.clock.Now()}
+				// Renewals are best-effort - if they fail, the slot is eventually considered free again and another
+				// gardenlet might acquire it. This is preferred over failing the running operation.
+				_ = s.gardenClient.Patch(ctx, lease, patch)
+				cancel()
+			}
+		}
+	}()
+
+	return func() {
+		close(stop)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		patch := client.MergeFrom(lease.DeepCopy())
+		lease.Spec.HolderIdentity = nil
+		_ = s.gardenClient.Patch(ctx, lease, patch)
+	}
+}
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot"
+)
+
+var _ = Describe("OperationSlots", func() {
+	var (
+		ctx = context.Background()
+
+		fakeClock    *testclock.FakeClock
+		gardenClient client.Client
+		slots        *OperationSlots
+
+		shoot      *gardencorev1beta1.Shoot
+		otherShoot *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Now())
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		slots = NewOperationSlots(fakeClock, gardenClient, &gardenletconfigv1alpha1.ShootOperationConcurrency{
+			MaxReconciles: ptr.To[int32](1),
+			MaxMigrations: ptr.To[int32](1),
+			LeaseDuration: &metav1.Duration{Duration: 2 * time.Minute},
+		}, "seed")
+
+		shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-project", UID: "1"}}
+		otherShoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-project", UID: "2"}}
+	})
+
+	It("should return nil if the configuration is nil", func() {
+		Expect(NewOperationSlots(fakeClock, gardenClient, nil, "seed")).To(BeNil())
+	})
+
+	It("should admit all operations if the slots are nil", func() {
+		var nilSlots *OperationSlots
+
+		release, acquired := nilSlots.TryAcquire(ctx, shoot, gardencorev1beta1.LastOperationTypeReconcile)
+		Expect(acquired).To(BeTrue())
+		Expect(release).NotTo(BeNil())
+	})
+
+	It("should admit operation kinds without configured limit immediately", func() {
+		slots = NewOperationSlots(fakeClock, gardenClient, &gardenletconfigv1alpha1.ShootOperationConcurrency{}, "seed")
+
+		_, acquired := slots.TryAcquire(ctx, shoot, gardencorev1beta1.LastOperationTypeReconcile)
+		Expect(acquired).To(BeTrue())
+
+		leaseList := &coordinationv1.LeaseList{}
+		Expect(gardenClient.List(ctx, leaseList)).To(Succeed())
+		Expect(leaseList.Items).To(BeEmpty())
+	})
+
+	It("should acquire a slot by creating a Lease in the garden cluster", func() {
+		release, acquired := slots.TryAcquire(ctx, shoot, gardencorev1beta1.LastOperationTypeReconcile)
+		Expect(acquired).To(BeTrue())
+		defer release()
+
+		lease := &coordinationv1.Lease{}
+		Expect(gardenClient.Get(ctx, client.ObjectKey{Namespace: "gardener-system-seed-lease", Name: "shoot-operation-slot-reconcile-0"}, lease)).To(Succeed())
+		Expect(lease.Spec.HolderIdentity).To(PointTo(Equal("seed/garden-project/foo")))
+	})
+
+	It("should not admit an operation when all slots of its kind are occupied", func() {
+		_, acquired := slots.TryAcquire(ctx, shoot, gardencorev1beta1.LastOperationTypeReconcile)
+		Expect(acquired).To(BeTrue())
+
+		_, acquired = slots.TryAcquire(ctx, otherShoot, gardencorev1beta1.LastOperationTypeReconcile)
+		Expect(acquired).To(BeFalse())
+	})
+
+	It("should use independent slots per operation kind", func() {
+		_, acquired := slots.TryAcquire(ctx, shoot, gardencorev1beta1.LastOperationTypeReconcile)
+		Expect(acquired).To(BeTrue())
+
+		_, acquired = slots.TryAcquire(ctx, otherShoot, gardencorev1beta1.LastOperationTypeMigrate)
+		Expect(acquired).To(BeTrue())
+	})
+
+	It("should admit an operation again after the slot was released", func() {
+		release, acquired := slots.TryAcquire(ctx, shoot, gardencorev1beta1.LastOperationTypeReconcile)
+		Expect(acquired).To(BeTrue())
+		release()
+
+		_, acquired = slots.TryAcquire(ctx, otherShoot, gardencorev1beta1.LastOperationTypeReconcile)
+		Expect(acquired).To(BeTrue())
+	})
+
+	It("should take over a slot whose Lease expired", func() {
+		_, acquired := slots.TryAcquire(ctx, shoot, gardencorev1beta1.LastOperationTypeReconcile)
+		Expect(acquired).To(BeTrue())
+
+		fakeClock.Step(3 * time.Minute)
+
+		_, acquired = slots.TryAcquire(ctx, otherShoot, gardencorev1beta1.LastOperationTypeReconcile)
+		Expect(acquired).To(BeTrue())
+
+		lease := &coordinationv1.Lease{}
+		Expect(gardenClient.Get(ctx, client.ObjectKey{Namespace: "gardener-system-seed-lease", Name: "shoot-operation-slot-reconcile-0"}, lease)).To(Succeed())
+		Expect(lease.Spec.HolderIdentity).To(PointTo(Equal("seed/garden-project/bar")))
+	})
+
+	It("should use the credentials rotation slots for shoots with ongoing credentials rotation", func() {
+		slots = NewOperationSlots(fakeClock, gardenClient, &gardenletconfigv1alpha1.ShootOperationConcurrency{
+			MaxCredentialsRotations: ptr.To[int32](1),
+		}, "seed")
+
+		shoot.Status.Credentials = &gardencorev1beta1.ShootCredentials{Rotation: &gardencorev1beta1.ShootCredentialsRotation{
+			CertificateAuthorities: &gardencorev1beta1.CARotation{Phase: gardencorev1beta1.RotationPreparing},
+		}}
+
+		_, acquired := slots.TryAcquire(ctx, shoot, gardencorev1beta1.LastOperationTypeReconcile)
+		Expect(acquired).To(BeTrue())
+
+		lease := &coordinationv1.Lease{}
+		Expect(gardenClient.Get(ctx, client.ObjectKey{Namespace: "gardener-system-seed-lease", Name: "shoot-operation-slot-rotate-credentials-0"}, lease)).To(Succeed())
+		Expect(lease.Spec.HolderIdentity).To(PointTo(Equal("seed/garden-project/foo")))
+	})
+})
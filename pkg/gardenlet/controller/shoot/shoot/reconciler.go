@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
@@ -69,6 +70,11 @@ type Reconciler struct {
 	GardenClusterIdentity       string
 	Clock                       clock.Clock
 	ShootStateControllerEnabled bool
+	ReconciliationThrottler     *ReconciliationThrottler
+	OperationSlots              *OperationSlots
+
+	// activeOperationSlots tracks the release functions of acquired operation slots per shoot UID.
+	activeOperationSlots sync.Map
 }
 
 // Reconcile implements the main shoot reconciliation logic, i.e., creation, hibernation, migration and deletion.
@@ -120,12 +126,22 @@ func (r *Reconciler) reconcileShoot(ctx context.Context, log logr.Logger, shoot
 	}
 
 	o, result, err := r.prepareOperation(ctx, log, shoot)
+	defer r.releaseOperationSlot(shoot)
 	if err != nil || o == nil {
 		return result, err
 	}
 
 	r.Recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1beta1.EventReconciling, fmt.Sprintf("%s Shoot cluster", utils.IifString(isRestoring, "Restoring", "Reconciling")))
-	if flowErr := r.runReconcileShootFlow(ctx, o, operationType); flowErr != nil {
+
+	var flowErr *v1beta1helper.WrappedLastErrors
+	if requestedOperation := getPartialReconcileOperation(shoot); requestedOperation != "" && operationType == gardencorev1beta1.LastOperationTypeReconcile {
+		log.Info("Only running the requested stage of the reconciliation flow", "operation", requestedOperation)
+		flowErr = r.runPartialReconcileShootFlow(ctx, o, requestedOperation)
+	} else {
+		flowErr = r.runReconcileShootFlow(ctx, o, operationType)
+	}
+
+	if flowErr != nil {
 		r.Recorder.Event(shoot, corev1.EventTypeWarning, gardencorev1beta1.EventReconcileError, flowErr.Description)
 		updateErr := r.patchShootStatusOperationError(ctx, shoot, flowErr.Description, operationType, flowErr.LastErrors...)
 		return reconcile.Result{}, errorsutils.WithSuppressed(errors.New(flowErr.Description), updateErr)
@@ -183,6 +199,7 @@ func (r *Reconciler) migrateShoot(ctx context.Context, log logr.Logger, shoot *g
 	}
 
 	o, result, err := r.prepareOperation(ctx, log, shoot)
+	defer r.releaseOperationSlot(shoot)
 	if err != nil || o == nil {
 		return result, err
 	}
@@ -242,6 +259,7 @@ func (r *Reconciler) deleteShoot(ctx context.Context, log logr.Logger, shoot *ga
 	}
 
 	o, result, err := r.prepareOperation(ctx, log, shoot)
+	defer r.releaseOperationSlot(shoot)
 	if err != nil || o == nil {
 		return result, err
 	}
@@ -326,6 +344,18 @@ func (r *Reconciler) prepareOperation(ctx context.Context, log logr.Logger, shoo
 		return nil, i.RequeueAfter, nil
 	}
 
+	if delay := r.ReconciliationThrottler.AdmissionDelay(ctx, i.OperationType); delay > 0 {
+		log.Info("Delaying shoot reconciliation because reconciliations are currently throttled after gardenlet startup", "requeueAfter", delay)
+		return nil, reconcile.Result{RequeueAfter: delay}, nil
+	}
+
+	release, acquired := r.OperationSlots.TryAcquire(ctx, shoot, i.OperationType)
+	if !acquired {
+		log.Info("Delaying shoot operation because all landscape-wide operation slots for this operation kind are currently occupied", "requeueAfter", operationSlotRetryInterval)
+		return nil, reconcile.Result{RequeueAfter: operationSlotRetryInterval}, nil
+	}
+	r.activeOperationSlots.Store(shoot.UID, release)
+
 	technicalID := gardenerutils.ComputeTechnicalID(project.Name, shoot)
 	if err := r.updateShootStatusOperationStart(ctx, shoot, technicalID, i.OperationType); err != nil {
 		return nil, reconcile.Result{}, err
@@ -353,6 +383,13 @@ func (r *Reconciler) prepareOperation(ctx context.Context, log logr.Logger, shoo
 	return o, reconcile.Result{}, nil
 }
 
+// releaseOperationSlot releases the landscape-wide operation slot acquired for the given shoot, if any.
+func (r *Reconciler) releaseOperationSlot(shoot *gardencorev1beta1.Shoot) {
+	if release, ok := r.activeOperationSlots.LoadAndDelete(shoot.UID); ok {
+		release.(func())()
+	}
+}
+
 func (r *Reconciler) initializeOperation(
 	ctx context.Context,
 	log logr.Logger,
@@ -628,8 +665,9 @@ func (r *Reconciler) updateShootStatusOperationStart(
 	}
 
 	var (
-		operations        = v1beta1helper.GetShootGardenerOperations(shoot.Annotations)
-		updatedOperations = slices.Clone(operations)
+		operations                  = v1beta1helper.GetShootGardenerOperations(shoot.Annotations)
+		updatedOperations           = slices.Clone(operations)
+		mustRestartControlPlanePods bool
 	)
 
 	for _, operation := range operations {
@@ -675,6 +713,14 @@ func (r *Reconciler) updateShootStatusOperationStart(
 				startRotationSSHKeypair(shoot, &now)
 			}
 
+		case v1beta1constants.ShootOperationRotateCloudCredentials:
+			updatedOperations = v1beta1helper.RemoveOperation(updatedOperations, operation)
+			startRotationCloudCredentials(shoot, &now)
+			// The control plane pods consuming the cloud provider credentials must be restarted in a coordinated way
+			// together with the reconciliation of the extension resources, so the respective task is added for the
+			// upcoming flow.
+			mustRestartControlPlanePods = true
+
 		case v1beta1constants.OperationRotateObservabilityCredentials:
 			updatedOperations = v1beta1helper.RemoveOperation(updatedOperations, operation)
 			startRotationObservability(shoot, &now)
@@ -700,9 +746,11 @@ func (r *Reconciler) updateShootStatusOperationStart(
 			completeRotationETCDEncryptionKey(shoot, &now)
 		}
 
-		if strings.HasPrefix(operation, v1beta1constants.OperationRotateRolloutWorkers) {
+		parsedOperation := v1beta1helper.ParseShootOperation(operation)
+
+		if parsedOperation.Name == v1beta1constants.OperationRotateRolloutWorkers {
 			updatedOperations = v1beta1helper.RemoveOperation(updatedOperations, operation)
-			poolNames := sets.NewString(strings.Split(strings.TrimPrefix(operation, v1beta1constants.OperationRotateRolloutWorkers+"="), ",")...)
+			poolNames := sets.NewString(parsedOperation.Parameters...)
 
 			if v1beta1helper.GetShootCARotationPhase(shoot.Status.Credentials) == gardencorev1beta1.RotationWaitingForWorkersRollout {
 				v1beta1helper.MutateShootCARotation(shoot, func(rotation *gardencorev1beta1.CARotation) {
@@ -720,9 +768,9 @@ func (r *Reconciler) updateShootStatusOperationStart(
 				})
 			}
 		}
-		if strings.HasPrefix(operation, v1beta1constants.OperationRolloutWorkers) {
+		if parsedOperation.Name == v1beta1constants.OperationRolloutWorkers {
 			updatedOperations = v1beta1helper.RemoveOperation(updatedOperations, operation)
-			poolNames := sets.NewString(strings.Split(strings.TrimPrefix(operation, v1beta1constants.OperationRolloutWorkers+"="), ",")...)
+			poolNames := sets.NewString(parsedOperation.Parameters...)
 
 			if poolNames.Has("*") {
 				poolNames = sets.NewString()
@@ -791,6 +839,9 @@ func (r *Reconciler) updateShootStatusOperationStart(
 		} else {
 			shoot.Annotations[v1beta1constants.GardenerOperation] = strings.Join(updatedOperations, v1beta1constants.GardenerOperationsSeparator)
 		}
+		if mustRestartControlPlanePods {
+			controllerutils.AddTasks(shoot.Annotations, v1beta1constants.ShootTaskRestartControlPlanePods)
+		}
 		return r.GardenClient.Patch(ctx, shoot, patch)
 	}
 
@@ -974,6 +1025,12 @@ func (r *Reconciler) patchShootStatusOperationSuccess(
 		})
 	}
 
+	if v1beta1helper.IsShootCloudCredentialsRotationInitiationTimeAfterLastCompletionTime(shoot.Status.Credentials) {
+		v1beta1helper.MutateShootCloudCredentialsRotation(shoot, func(rotation *gardencorev1beta1.CloudCredentialsRotation) {
+			rotation.LastCompletionTime = &now
+		})
+	}
+
 	if shoot.Status.Credentials != nil && shoot.Status.Credentials.Rotation != nil {
 		if !v1beta1helper.ShootEnablesSSHAccess(shoot) {
 			shoot.Status.Credentials.Rotation.SSHKeypair = nil
@@ -1288,6 +1345,12 @@ func startRotationSSHKeypair(shoot *gardencorev1beta1.Shoot, now *metav1.Time) {
 	})
 }
 
+func startRotationCloudCredentials(shoot *gardencorev1beta1.Shoot, now *metav1.Time) {
+	v1beta1helper.MutateShootCloudCredentialsRotation(shoot, func(rotation *gardencorev1beta1.CloudCredentialsRotation) {
+		rotation.LastInitiationTime = now
+	})
+}
+
 func startRotationObservability(shoot *gardencorev1beta1.Shoot, now *metav1.Time) {
 	v1beta1helper.MutateObservabilityRotation(shoot, func(rotation *gardencorev1beta1.ObservabilityRotation) {
 		rotation.LastInitiationTime = now
@@ -652,6 +652,12 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			SkipIf:       !nonTerminatingNamespace,
 			Dependencies: flow.NewTaskIDs(syncPointCleaned, waitUntilKubeAPIServerDeleted),
 		})
+		destroyManagementDomainDNSRecord = g.Add(flow.Task{
+			Name:         "Destroying management domain DNS record",
+			Fn:           botanist.DestroyManagementDNSRecord,
+			SkipIf:       !nonTerminatingNamespace,
+			Dependencies: flow.NewTaskIDs(syncPointCleaned, waitUntilKubeAPIServerDeleted),
+		})
 		deletePlutono = g.Add(flow.Task{
 			Name:         "Deleting Plutono in Seed",
 			Fn:           flow.TaskFn(botanist.Shoot.Components.ControlPlane.Plutono.Destroy).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -675,6 +681,7 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			waitUntilExtensionResourcesDeleted,
 			destroyIngressDomainDNSRecord,
 			destroyExternalDomainDNSRecord,
+			destroyManagementDomainDNSRecord,
 			waitUntilInfrastructureDeleted,
 		)
 
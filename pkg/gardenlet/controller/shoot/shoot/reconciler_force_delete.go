@@ -96,6 +96,11 @@ func (r *Reconciler) runForceDeleteShootFlow(ctx context.Context, log logr.Logge
 			Fn:     botanist.DestroyExternalDNSRecord,
 			SkipIf: !nonTerminatingNamespace,
 		})
+		destroyManagementDomainDNSRecord = g.Add(flow.Task{
+			Name:   "Destroying management domain DNS record",
+			Fn:     botanist.DestroyManagementDNSRecord,
+			SkipIf: !nonTerminatingNamespace,
+		})
 		destroyInternalDomainDNSRecord = g.Add(flow.Task{
 			Name:   "Destroying internal domain DNS record",
 			Fn:     botanist.DestroyInternalDNSRecord,
@@ -146,7 +151,7 @@ func (r *Reconciler) runForceDeleteShootFlow(ctx context.Context, log logr.Logge
 		deleteCluster = g.Add(flow.Task{
 			Name:         "Deleting Cluster resource",
 			Fn:           flow.TaskFn(cleaner.DeleteCluster).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			Dependencies: flow.NewTaskIDs(waitUntilExtensionObjectsDeleted, destroyIngressDomainDNSRecord, destroyExternalDomainDNSRecord, destroyInternalDomainDNSRecord, waitUntilManagedResourcesDeleted),
+			Dependencies: flow.NewTaskIDs(waitUntilExtensionObjectsDeleted, destroyIngressDomainDNSRecord, destroyExternalDomainDNSRecord, destroyManagementDomainDNSRecord, destroyInternalDomainDNSRecord, waitUntilManagedResourcesDeleted),
 		})
 
 		syncPoint = flow.NewTaskIDs(
@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	retryutils "github.com/gardener/gardener/pkg/utils/retry"
+)
+
+// partialReconcileOperations contains all operation annotation values which only re-run a dedicated stage of the shoot
+// reconciliation flow.
+var partialReconcileOperations = sets.New(
+	v1beta1constants.ShootOperationReconcileInfrastructure,
+	v1beta1constants.ShootOperationReconcileDNSRecords,
+	v1beta1constants.ShootOperationReconcileControlPlane,
+	v1beta1constants.ShootOperationReconcileSystemComponents,
+)
+
+// getPartialReconcileOperation returns the requested partial reconcile operation if the Shoot's operation annotation
+// contains exactly one operation and it only targets a dedicated stage of the reconciliation flow.
+func getPartialReconcileOperation(shoot *gardencorev1beta1.Shoot) string {
+	operations := v1beta1helper.GetShootGardenerOperations(shoot.Annotations)
+	if len(operations) != 1 || !partialReconcileOperations.Has(operations[0]) {
+		return ""
+	}
+	return operations[0]
+}
+
+// runPartialReconcileShootFlow only re-runs the stage of the reconciliation flow which is requested via the given
+// operation annotation value. It must only be used for Shoots which have already been reconciled completely
+// successfully, i.e., it assumes that all components deployed by the regular reconciliation flow are present.
+// It receives an Operation object <o> which stores the Shoot object.
+func (r *Reconciler) runPartialReconcileShootFlow(ctx context.Context, o *operation.Operation, requestedOperation string) *v1beta1helper.WrappedLastErrors {
+	// We create the botanist (which will do the actual work).
+	var (
+		botanist *botanistpkg.Botanist
+		err      error
+	)
+
+	if err = retryutils.UntilTimeout(ctx, 10*time.Second, 10*time.Minute, func(context.Context) (done bool, err error) {
+		botanist, err = botanistpkg.New(ctx, o)
+		if err != nil {
+			return retryutils.MinorError(err)
+		}
+		return retryutils.Ok()
+	}); err != nil {
+		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), err)
+	}
+
+	const (
+		defaultTimeout  = 30 * time.Second
+		defaultInterval = 5 * time.Second
+	)
+
+	var (
+		reconcileInfrastructure   = requestedOperation == v1beta1constants.ShootOperationReconcileInfrastructure
+		reconcileDNSRecords       = requestedOperation == v1beta1constants.ShootOperationReconcileDNSRecords
+		reconcileControlPlane     = requestedOperation == v1beta1constants.ShootOperationReconcileControlPlane
+		reconcileSystemComponents = requestedOperation == v1beta1constants.ShootOperationReconcileSystemComponents
+
+		g = flow.NewGraph(fmt.Sprintf("Shoot cluster partial reconciliation (%s)", requestedOperation))
+
+		deployNamespace = g.Add(flow.Task{
+			Name: "Deploying Shoot namespace in Seed",
+			Fn:   flow.TaskFn(botanist.DeployControlPlaneNamespace).RetryUntilTimeout(defaultInterval, defaultTimeout),
+		})
+		ensureShootClusterIdentity = g.Add(flow.Task{
+			Name:         "Ensuring Shoot cluster identity",
+			Fn:           flow.TaskFn(botanist.EnsureShootClusterIdentity).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(deployNamespace),
+		})
+		deployCloudProviderSecret = g.Add(flow.Task{
+			Name:         "Deploying cloud provider account secret",
+			Fn:           flow.TaskFn(botanist.DeployCloudProviderSecret).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       o.Shoot.IsWorkerless || !(reconcileInfrastructure || reconcileControlPlane),
+			Dependencies: flow.NewTaskIDs(deployNamespace),
+		})
+		initializeSecretsManagement = g.Add(flow.Task{
+			Name:         "Initializing secrets management",
+			Fn:           flow.TaskFn(botanist.InitializeSecretsManagement).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(deployNamespace),
+		})
+		deployReferencedResources = g.Add(flow.Task{
+			Name:         "Deploying referenced resources",
+			Fn:           flow.TaskFn(botanist.DeployReferencedResources).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(deployNamespace),
+		})
+		deployInfrastructure = g.Add(flow.Task{
+			Name:         "Deploying Shoot infrastructure",
+			Fn:           flow.TaskFn(botanist.DeployInfrastructure).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       !reconcileInfrastructure || o.Shoot.IsWorkerless,
+			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, deployCloudProviderSecret, deployReferencedResources),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Waiting until shoot infrastructure has been reconciled",
+			Fn:           botanist.WaitForInfrastructure,
+			SkipIf:       !reconcileInfrastructure || o.Shoot.IsWorkerless,
+			Dependencies: flow.NewTaskIDs(deployInfrastructure),
+		})
+		deployKubeAPIServerService = g.Add(flow.Task{
+			Name:         "Deploying Kubernetes API server service in the Seed cluster",
+			Fn:           flow.TaskFn(botanist.Shoot.Components.ControlPlane.KubeAPIServerService.Deploy).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       !reconcileDNSRecords,
+			Dependencies: flow.NewTaskIDs(deployNamespace, ensureShootClusterIdentity),
+		})
+		waitUntilKubeAPIServerServiceIsReady = g.Add(flow.Task{
+			Name:         "Waiting until Kubernetes API server service in the Seed cluster has reported readiness",
+			Fn:           botanist.Shoot.Components.ControlPlane.KubeAPIServerService.Wait,
+			SkipIf:       !reconcileDNSRecords,
+			Dependencies: flow.NewTaskIDs(deployKubeAPIServerService),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Deploying internal domain DNS record",
+			Fn:           botanist.DeployOrDestroyInternalDNSRecord,
+			SkipIf:       !reconcileDNSRecords,
+			Dependencies: flow.NewTaskIDs(deployReferencedResources, waitUntilKubeAPIServerServiceIsReady),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Deploying external domain DNS record",
+			Fn:           botanist.DeployOrDestroyExternalDNSRecord,
+			SkipIf:       !reconcileDNSRecords,
+			Dependencies: flow.NewTaskIDs(deployReferencedResources, waitUntilKubeAPIServerServiceIsReady),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Deploying management domain DNS record",
+			Fn:           botanist.DeployOrDestroyManagementDNSRecord,
+			SkipIf:       !reconcileDNSRecords,
+			Dependencies: flow.NewTaskIDs(deployReferencedResources, waitUntilKubeAPIServerServiceIsReady),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Deploying nginx ingress DNS record",
+			Fn:           botanist.DeployOrDestroyIngressDNSRecord,
+			SkipIf:       !reconcileDNSRecords || o.Shoot.IsWorkerless,
+			Dependencies: flow.NewTaskIDs(deployReferencedResources, waitUntilKubeAPIServerServiceIsReady),
+		})
+		deployControlPlane = g.Add(flow.Task{
+			Name:         "Deploying shoot control plane components",
+			Fn:           flow.TaskFn(botanist.DeployControlPlane).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       !reconcileControlPlane || o.Shoot.IsWorkerless,
+			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, deployCloudProviderSecret, deployReferencedResources),
+		})
+		_ = g.Add(flow.Task{
+			Name: "Waiting until shoot control plane has been reconciled",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				return botanist.Shoot.Components.Extensions.ControlPlane.Wait(ctx)
+			}),
+			SkipIf:       !reconcileControlPlane || o.Shoot.IsWorkerless,
+			Dependencies: flow.NewTaskIDs(deployControlPlane),
+		})
+		initializeShootClients = g.Add(flow.Task{
+			Name:         "Initializing connection to Shoot",
+			Fn:           flow.TaskFn(botanist.InitializeDesiredShootClients).RetryUntilTimeout(defaultInterval, 2*time.Minute),
+			SkipIf:       !reconcileSystemComponents,
+			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, ensureShootClusterIdentity),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Deploying shoot system resources",
+			Fn:           flow.TaskFn(botanist.DeployShootSystem).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       !reconcileSystemComponents,
+			Dependencies: flow.NewTaskIDs(initializeShootClients),
+		})
+	)
+
+	f := g.Compile()
+
+	if err := f.Run(ctx, flow.Opts{
+		Log:              o.Logger,
+		ProgressReporter: r.newProgressReporter(o.ReportShootProgress),
+	}); err != nil {
+		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), flow.Errors(err))
+	}
+
+	o.Logger.Info("Removing operation annotation after successful partial reconciliation", "operation", requestedOperation)
+	if err := o.Shoot.UpdateInfo(ctx, o.GardenClient, false, false, func(shoot *gardencorev1beta1.Shoot) error {
+		updatedOperations := v1beta1helper.RemoveOperation(v1beta1helper.GetShootGardenerOperations(shoot.Annotations), requestedOperation)
+		if len(updatedOperations) == 0 {
+			delete(shoot.Annotations, v1beta1constants.GardenerOperation)
+		} else {
+			shoot.Annotations[v1beta1constants.GardenerOperation] = strings.Join(updatedOperations, v1beta1constants.GardenerOperationsSeparator)
+		}
+		return nil
+	}); err != nil {
+		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), err)
+	}
+
+	o.Logger.Info("Successfully reconciled Shoot cluster partially", "operation", requestedOperation)
+	return nil
+}
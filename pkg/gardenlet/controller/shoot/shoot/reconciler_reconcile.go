@@ -250,6 +250,17 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       o.Shoot.HibernationEnabled,
 			Dependencies: flow.NewTaskIDs(deployReferencedResources, waitUntilKubeAPIServerServiceIsReady),
 		})
+		_ = g.Add(flow.Task{
+			Name: "Deploying management domain DNS record",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				if err := botanist.DeployOrDestroyManagementDNSRecord(ctx); err != nil {
+					return err
+				}
+				return removeTaskAnnotation(ctx, o, generation, v1beta1constants.ShootTaskDeployDNSRecordManagement)
+			}),
+			SkipIf:       o.Shoot.HibernationEnabled,
+			Dependencies: flow.NewTaskIDs(deployReferencedResources, waitUntilKubeAPIServerServiceIsReady),
+		})
 		deploySourceBackupEntry = g.Add(flow.Task{
 			Name:         "Deploying source backup entry",
 			Fn:           botanist.DeploySourceBackupEntry,
@@ -315,6 +326,12 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       (!isRestoringHAControlPlane && o.Shoot.HibernationEnabled) || skipReadiness,
 			Dependencies: flow.NewTaskIDs(deployETCD),
 		})
+		reconcileEtcdDatabaseSize = g.Add(flow.Task{
+			Name:         "Ensuring etcd database size is within its backend quota",
+			Fn:           flow.TaskFn(botanist.ReconcileEtcdDatabaseSize).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       o.Shoot.HibernationEnabled || skipReadiness,
+			Dependencies: flow.NewTaskIDs(waitUntilEtcdReady),
+		})
 		deployExtensionResourcesBeforeKAPI = g.Add(flow.Task{
 			Name:         "Deploying extension resources before kube-apiserver",
 			Fn:           flow.TaskFn(botanist.DeployExtensionsBeforeKubeAPIServer).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -327,6 +344,12 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       o.Shoot.HibernationEnabled || skipReadiness,
 			Dependencies: flow.NewTaskIDs(deployExtensionResourcesBeforeKAPI),
 		})
+		scanForRemovedAPIUsage = g.Add(flow.Task{
+			Name:         "Scanning shoot cluster for usage of APIs removed in the target Kubernetes version",
+			Fn:           flow.TaskFn(botanist.ScanForRemovedAPIUsage).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       o.Shoot.HibernationEnabled || o.Config.Controllers.Shoot.DeprecatedAPIScan == nil,
+			Dependencies: flow.NewTaskIDs(waitUntilExtensionResourcesBeforeKAPIReady),
+		})
 		deployKubeAPIServer = g.Add(flow.Task{
 			Name: "Deploying Kubernetes API server",
 			Fn: flow.TaskFn(func(ctx context.Context) error {
@@ -336,8 +359,10 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 				initializeSecretsManagement,
 				deployETCD,
 				waitUntilEtcdReady,
+				reconcileEtcdDatabaseSize,
 				waitUntilKubeAPIServerServiceIsReady,
 				waitUntilExtensionResourcesBeforeKAPIReady,
+				scanForRemovedAPIUsage,
 			).InsertIf(!hasNodesCIDR, waitUntilInfrastructureReady),
 		})
 		waitUntilKubeAPIServerIsReady = g.Add(flow.Task{
@@ -462,7 +487,27 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		rewriteResourcesAddLabel = g.Add(flow.Task{
 			Name: "Labeling resources after modification of encryption config or to encrypt them with new ETCD encryption key",
 			Fn: flow.TaskFn(func(ctx context.Context) error {
-				return secretsrotation.RewriteEncryptedDataAddLabel(ctx, o.Logger, o.SeedClientSet.Client(), o.ShootClientSet, o.SecretsManager, o.Shoot.ControlPlaneNamespace, v1beta1constants.DeploymentNameKubeAPIServer, o.Shoot.ResourcesToEncrypt, o.Shoot.EncryptedResources, gardenerutils.DefaultGVKsForEncryption())
+				var reportProgress secretsrotation.ProgressReporterFn
+				if !sets.New(o.Shoot.ResourcesToEncrypt...).Equal(sets.New(o.Shoot.EncryptedResources...)) {
+					reportProgress = func(ctx context.Context, migratedResourceCount, remainingResourceCount int) error {
+						return o.Shoot.UpdateInfoStatus(ctx, o.GardenClient, false, false, func(shoot *gardencorev1beta1.Shoot) error {
+							if shoot.Status.Credentials == nil {
+								shoot.Status.Credentials = &gardencorev1beta1.ShootCredentials{}
+							}
+							if shoot.Status.Credentials.EncryptionAtRest == nil {
+								shoot.Status.Credentials.EncryptionAtRest = &gardencorev1beta1.EncryptionAtRest{}
+							}
+
+							shoot.Status.Credentials.EncryptionAtRest.MigrationProgress = &gardencorev1beta1.EncryptionAtRestMigrationProgress{
+								MigratedResourceCount:  int32(migratedResourceCount),  // #nosec G115 -- count of resource kinds.
+								RemainingResourceCount: int32(remainingResourceCount), // #nosec G115 -- count of resource kinds.
+							}
+							return nil
+						})
+					}
+				}
+
+				return secretsrotation.RewriteEncryptedDataAddLabel(ctx, o.Logger, o.SeedClientSet.Client(), o.ShootClientSet, o.SecretsManager, o.Shoot.ControlPlaneNamespace, v1beta1constants.DeploymentNameKubeAPIServer, o.Shoot.ResourcesToEncrypt, o.Shoot.EncryptedResources, gardenerutils.DefaultGVKsForEncryption(), reportProgress)
 			}).RetryUntilTimeout(30*time.Second, 10*time.Minute),
 			SkipIf: v1beta1helper.GetShootETCDEncryptionKeyRotationPhase(o.Shoot.GetInfo().Status.Credentials) != gardencorev1beta1.RotationPreparing &&
 				sets.New(o.Shoot.ResourcesToEncrypt...).Equal(sets.New(o.Shoot.EncryptedResources...)),
@@ -508,6 +553,10 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 							shoot.Status.Credentials.EncryptionAtRest.Resources = nil
 						}
 
+						if shoot.Status.Credentials != nil && shoot.Status.Credentials.EncryptionAtRest != nil {
+							shoot.Status.Credentials.EncryptionAtRest.MigrationProgress = nil
+						}
+
 						return nil
 					}); err != nil {
 						return err
@@ -937,7 +986,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		hibernateControlPlane = g.Add(flow.Task{
 			Name:         "Hibernating control plane",
 			Fn:           flow.TaskFn(botanist.HibernateControlPlane).RetryUntilTimeout(defaultInterval, 2*time.Minute),
-			SkipIf:       !o.Shoot.HibernationEnabled,
+			SkipIf:       !o.Shoot.ControlPlaneHibernationEnabled,
 			Dependencies: flow.NewTaskIDs(initializeShootClients, deployPrometheus, deployAlertmanager, deploySeedLogging, deployClusterAutoscaler, waitUntilWorkerReady, waitUntilExtensionResourcesAfterKAPIReady, waitUntilEtcdScaledAfterRestore),
 		})
 
@@ -946,31 +995,37 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		hibernateExtensionResourcesAfterKAPIHibernation = g.Add(flow.Task{
 			Name:         "Hibernating extension resources after kube-apiserver hibernation",
 			Fn:           flow.TaskFn(botanist.DeployExtensionsBeforeKubeAPIServer).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			SkipIf:       !o.Shoot.HibernationEnabled,
+			SkipIf:       !o.Shoot.ControlPlaneHibernationEnabled,
 			Dependencies: flow.NewTaskIDs(hibernateControlPlane),
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Waiting until extension resources hibernated after kube-apiserver hibernation are ready",
 			Fn:           botanist.Shoot.Components.Extensions.Extension.WaitBeforeKubeAPIServer,
-			SkipIf:       skipReadiness || !o.Shoot.HibernationEnabled,
+			SkipIf:       skipReadiness || !o.Shoot.ControlPlaneHibernationEnabled,
 			Dependencies: flow.NewTaskIDs(hibernateExtensionResourcesAfterKAPIHibernation),
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Destroying ingress domain DNS record if hibernated",
 			Fn:           botanist.DestroyIngressDNSRecord,
-			SkipIf:       !o.Shoot.HibernationEnabled,
+			SkipIf:       !o.Shoot.ControlPlaneHibernationEnabled,
 			Dependencies: flow.NewTaskIDs(hibernateControlPlane),
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Destroying external domain DNS record if hibernated",
 			Fn:           botanist.DestroyExternalDNSRecord,
-			SkipIf:       !o.Shoot.HibernationEnabled,
+			SkipIf:       !o.Shoot.ControlPlaneHibernationEnabled,
+			Dependencies: flow.NewTaskIDs(hibernateControlPlane),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Destroying management domain DNS record if hibernated",
+			Fn:           botanist.DestroyManagementDNSRecord,
+			SkipIf:       !o.Shoot.ControlPlaneHibernationEnabled,
 			Dependencies: flow.NewTaskIDs(hibernateControlPlane),
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Destroying internal domain DNS record if hibernated",
 			Fn:           botanist.DestroyInternalDNSRecord,
-			SkipIf:       !o.Shoot.HibernationEnabled,
+			SkipIf:       !o.Shoot.ControlPlaneHibernationEnabled,
 			Dependencies: flow.NewTaskIDs(hibernateControlPlane),
 		})
 		deleteStaleExtensionResources = g.Add(flow.Task{
@@ -1053,7 +1108,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 	}
 
 	// ensure that shoot client is invalidated after it has been hibernated
-	if o.Shoot.HibernationEnabled {
+	if o.Shoot.ControlPlaneHibernationEnabled {
 		if err := o.ShootClientMap.InvalidateClient(keys.ForShoot(o.Shoot.GetInfo())); err != nil {
 			err = fmt.Errorf("failed to invalidate shoot client: %w", err)
 			return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), err)
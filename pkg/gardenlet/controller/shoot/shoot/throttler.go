@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+)
+
+// seedPressureCheckInterval is the minimum interval between two checks whether the seed is under resource pressure.
+const seedPressureCheckInterval = 30 * time.Second
+
+// ReconciliationThrottler staggers regular shoot reconciliations after a gardenlet restart (e.g., when recovering
+// from a seed outage) in order to prevent wake-up storms from overloading the seed. It admits reconciliations at the
+// configured initial rate which is doubled every ramp-up period as long as none of the seed's nodes reports resource
+// pressure. Once the configured throttling duration has passed, reconciliations are no longer throttled.
+type ReconciliationThrottler struct {
+	clock      clock.Clock
+	seedClient client.Client
+	config     gardenletconfigv1alpha1.ShootReconciliationThrottling
+
+	lock              sync.Mutex
+	startedAt         time.Time
+	nextAdmission     time.Time
+	lastPressureCheck time.Time
+	underPressure     bool
+}
+
+// NewReconciliationThrottler creates a new ReconciliationThrottler for the given configuration. It returns nil if the
+// configuration is nil, i.e., if throttling is disabled. A nil ReconciliationThrottler admits all reconciliations
+// immediately.
+func NewReconciliationThrottler(clk clock.Clock, seedClient client.Client, config *gardenletconfigv1alpha1.ShootReconciliationThrottling) *ReconciliationThrottler {
+	if config == nil {
+		return nil
+	}
+
+	return &ReconciliationThrottler{
+		clock:      clk,
+		seedClient: seedClient,
+		config:     *config,
+		startedAt:  clk.Now(),
+	}
+}
+
+// AdmissionDelay returns the duration for which the reconciliation of a shoot should be delayed. A zero duration
+// means that the reconciliation is admitted. Only regular reconcile operations (which also include hibernation
+// wake-ups) are throttled, all other operations are admitted immediately.
+func (t *ReconciliationThrottler) AdmissionDelay(ctx context.Context, operationType gardencorev1beta1.LastOperationType) time.Duration {
+	if t == nil || operationType != gardencorev1beta1.LastOperationTypeReconcile {
+		return 0
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := t.clock.Now()
+	elapsed := now.Sub(t.startedAt)
+	if elapsed >= ptr.Deref(t.config.ThrottlingDuration, metav1.Duration{Duration: 10 * time.Minute}).Duration {
+		return 0
+	}
+
+	if now.Before(t.nextAdmission) {
+		return t.nextAdmission.Sub(now)
+	}
+
+	rate := time.Duration(ptr.Deref(t.config.InitialRatePerMinute, 10))
+	if !t.seedUnderPressure(ctx, now) {
+		rampUpPeriod := ptr.Deref(t.config.RampUpPeriod, metav1.Duration{Duration: 2 * time.Minute}).Duration
+		for rampUps := elapsed / rampUpPeriod; rampUps > 0; rampUps-- {
+			rate *= 2
+		}
+	}
+
+	t.nextAdmission = now.Add(time.Minute / rate)
+	return 0
+}
+
+// seedUnderPressure checks whether any node of the seed reports memory, disk, or PID pressure. The result is cached
+// for seedPressureCheckInterval to not flood the seed API server.
+func (t *ReconciliationThrottler) seedUnderPressure(ctx context.Context, now time.Time) bool {
+	if now.Sub(t.lastPressureCheck) < seedPressureCheckInterval && !t.lastPressureCheck.IsZero() {
+		return t.underPressure
+	}
+	t.lastPressureCheck = now
+
+	nodeList := &corev1.NodeList{}
+	if err := t.seedClient.List(ctx, nodeList); err != nil {
+		// If the seed cannot be checked, be conservative and keep the last known pressure state.
+		return t.underPressure
+	}
+
+	t.underPressure = false
+	for _, node := range nodeList.Items {
+		for _, condition := range node.Status.Conditions {
+			switch condition.Type {
+			case corev1.NodeMemoryPressure, corev1.NodeDiskPressure, corev1.NodePIDPressure:
+				if condition.Status == corev1.ConditionTrue {
+					t.underPressure = true
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot"
+)
+
+var _ = Describe("ReconciliationThrottler", func() {
+	var (
+		ctx = context.Background()
+
+		fakeClock  *testclock.FakeClock
+		seedClient client.Client
+		throttler  *ReconciliationThrottler
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Now())
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		throttler = NewReconciliationThrottler(fakeClock, seedClient, &gardenletconfigv1alpha1.ShootReconciliationThrottling{
+			InitialRatePerMinute: ptr.To[int32](2),
+			RampUpPeriod:         &metav1.Duration{Duration: 2 * time.Minute},
+			ThrottlingDuration:   &metav1.Duration{Duration: 10 * time.Minute},
+		})
+	})
+
+	It("should return nil if the configuration is nil", func() {
+		Expect(NewReconciliationThrottler(fakeClock, seedClient, nil)).To(BeNil())
+	})
+
+	It("should admit all reconciliations if the throttler is nil", func() {
+		var nilThrottler *ReconciliationThrottler
+		Expect(nilThrottler.AdmissionDelay(ctx, gardencorev1beta1.LastOperationTypeReconcile)).To(BeZero())
+	})
+
+	It("should admit operations other than reconcile immediately", func() {
+		Expect(throttler.AdmissionDelay(ctx, gardencorev1beta1.LastOperationTypeReconcile)).To(BeZero())
+		Expect(throttler.AdmissionDelay(ctx, gardencorev1beta1.LastOperationTypeCreate)).To(BeZero())
+		Expect(throttler.AdmissionDelay(ctx, gardencorev1beta1.LastOperationTypeMigrate)).To(BeZero())
+	})
+
+	It("should admit reconciliations at the initial rate", func() {
+		Expect(throttler.AdmissionDelay(ctx, gardencorev1beta1.LastOperationTypeReconcile)).To(BeZero())
+		Expect(throttler.AdmissionDelay(ctx, gardencorev1beta1.LastOperationTypeReconcile)).To(Equal(30 * time.Second))
+
+		fakeClock.Step(30 * time.Second)
+		Expect(throttler.AdmissionDelay(ctx, gardencorev1beta1.LastOperationTypeReconcile)).To(BeZero())
+	})
+
+	It("should ramp up the admitted rate over time", func() {
+		fakeClock.Step(4 * time.Minute)
+
+		// after two ramp-up periods the rate is quadrupled, i.e., 8 per minute
+		Expect(throttler.AdmissionDelay(ctx, gardencorev1beta1.LastOperationTypeReconcile)).To(BeZero())
+		Expect(throttler.AdmissionDelay(ctx, gardencorev1beta1.LastOperationTypeReconcile)).To(Equal(time.Minute / 8))
+	})
+
+	It("should not ramp up the admitted rate while the seed is under resource pressure", func() {
+		Expect(seedClient.Create(ctx, &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node"},
+			Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeMemoryPressure, Status: corev1.ConditionTrue},
+			}},
+		})).To(Succeed())
+
+		fakeClock.Step(4 * time.Minute)
+
+		Expect(throttler.AdmissionDelay(ctx, gardencorev1beta1.LastOperationTypeReconcile)).To(BeZero())
+		Expect(throttler.AdmissionDelay(ctx, gardencorev1beta1.LastOperationTypeReconcile)).To(Equal(30 * time.Second))
+	})
+
+	It("should no longer throttle reconciliations after the throttling duration has passed", func() {
+		fakeClock.Step(10 * time.Minute)
+
+		Expect(throttler.AdmissionDelay(ctx, gardencorev1beta1.LastOperationTypeReconcile)).To(BeZero())
+		Expect(throttler.AdmissionDelay(ctx, gardencorev1beta1.LastOperationTypeReconcile)).To(BeZero())
+	})
+})
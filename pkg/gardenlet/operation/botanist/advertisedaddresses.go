@@ -53,6 +53,13 @@ func (b *Botanist) ToAdvertisedAddresses(ctx context.Context) ([]gardencorev1bet
 		})
 	}
 
+	if b.NeedsManagementDNS() {
+		addresses = append(addresses, gardencorev1beta1.ShootAdvertisedAddress{
+			Name: v1beta1constants.AdvertisedAddressManagement,
+			URL:  "https://" + v1beta1helper.GetAPIServerDomain(*b.Shoot.GetInfo().Spec.DNS.ManagementDomain),
+		})
+	}
+
 	if b.Shoot.InternalClusterDomain != nil {
 		addresses = append(addresses, gardencorev1beta1.ShootAdvertisedAddress{
 			Name: v1beta1constants.AdvertisedAddressInternal,
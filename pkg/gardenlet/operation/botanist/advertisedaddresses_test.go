@@ -25,6 +25,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/operation/garden"
 	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
 var _ = Describe("AdvertisedAddresses", func() {
@@ -70,6 +71,29 @@ var _ = Describe("AdvertisedAddresses", func() {
 			}))
 		})
 
+		It("returns external and management addresses", func() {
+			botanist.Shoot.ExternalClusterDomain = ptr.To("foo.bar")
+			botanist.Shoot.ExternalDomain = &gardenerutils.Domain{Provider: "some-provider"}
+			botanist.Shoot.GetInfo().Spec.DNS = &gardencorev1beta1.DNS{
+				Domain:           ptr.To("foo.bar"),
+				ManagementDomain: ptr.To("management.foo.bar"),
+			}
+
+			addresses, err := botanist.ToAdvertisedAddresses(ctx)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(addresses).To(Equal([]gardencorev1beta1.ShootAdvertisedAddress{
+				{
+					Name: "external",
+					URL:  "https://api.foo.bar",
+				},
+				{
+					Name: "management",
+					URL:  "https://api.management.foo.bar",
+				},
+			}))
+		})
+
 		It("returns internal and service-account-issuer addresses", func() {
 			botanist.Shoot.InternalClusterDomain = ptr.To("baz.foo")
 
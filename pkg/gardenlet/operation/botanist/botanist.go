@@ -12,6 +12,7 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
@@ -22,6 +23,7 @@ import (
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/component/etcd/etcd"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
@@ -57,6 +59,7 @@ func New(ctx context.Context, o *operation.Operation) (*Botanist, error) {
 	// extension components
 	o.Shoot.Components.Extensions.ExternalDNSRecord = b.DefaultExternalDNSRecord()
 	o.Shoot.Components.Extensions.InternalDNSRecord = b.DefaultInternalDNSRecord()
+	o.Shoot.Components.Extensions.ManagementDNSRecord = b.DefaultManagementDNSRecord()
 	o.Shoot.Components.Extensions.IngressDNSRecord = b.DefaultIngressDNSRecord()
 
 	o.Shoot.Components.Extensions.Extension, err = b.DefaultExtension(ctx)
@@ -272,6 +275,22 @@ func (b *Botanist) outOfClusterAPIServerFQDN() string {
 	return fmt.Sprintf("%s.", b.Shoot.ComputeOutOfClusterAPIServerAddress(true))
 }
 
+// componentWaitTimeouts returns the component wait timeout overrides from the gardenlet configuration, if any.
+func (b *Botanist) componentWaitTimeouts() gardenletconfigv1alpha1.ComponentWaitTimeouts {
+	if b.Config != nil && b.Config.Controllers != nil && b.Config.Controllers.Shoot != nil && b.Config.Controllers.Shoot.ComponentWaitTimeouts != nil {
+		return *b.Config.Controllers.Shoot.ComponentWaitTimeouts
+	}
+	return gardenletconfigv1alpha1.ComponentWaitTimeouts{}
+}
+
+// waitTimeout returns the given timeout override if set, otherwise the given default timeout.
+func waitTimeout(override *metav1.Duration, defaultTimeout time.Duration) time.Duration {
+	if override != nil {
+		return override.Duration
+	}
+	return defaultTimeout
+}
+
 // SetInPlaceUpdatePendingWorkers sets the Shoot status with the name of worker pools which are undergoing an in-place update.
 func (b *Botanist) SetInPlaceUpdatePendingWorkers(ctx context.Context, worker *extensionsv1alpha1.Worker) error {
 	var (
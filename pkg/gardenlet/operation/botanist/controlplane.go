@@ -23,6 +23,8 @@ import (
 	extensionscontrolplane "github.com/gardener/gardener/pkg/component/extensions/controlplane"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	retryutils "github.com/gardener/gardener/pkg/utils/retry"
 )
 
 func (b *Botanist) determineControllerReplicas(ctx context.Context, deploymentName string, defaultReplicas int32) (int32, error) {
@@ -30,8 +32,8 @@ func (b *Botanist) determineControllerReplicas(ctx context.Context, deploymentNa
 		(b.Shoot.GetInfo().Status.LastOperation.Type == gardencorev1beta1.LastOperationTypeCreate ||
 			b.Shoot.GetInfo().Status.LastOperation.Type == gardencorev1beta1.LastOperationTypeRestore)
 
-	if (isCreateOrRestoreOperation && b.Shoot.HibernationEnabled) ||
-		(!isCreateOrRestoreOperation && b.Shoot.HibernationEnabled && b.Shoot.GetInfo().Status.IsHibernated) {
+	if (isCreateOrRestoreOperation && b.Shoot.ControlPlaneHibernationEnabled) ||
+		(!isCreateOrRestoreOperation && b.Shoot.ControlPlaneHibernationEnabled && b.Shoot.GetInfo().Status.IsHibernated) {
 		// Shoot is being created or restored with .spec.hibernation.enabled=true or
 		// Shoot is being reconciled with .spec.hibernation.enabled=.status.isHibernated=true,
 		// so keep the replicas which are already available.
@@ -42,7 +44,7 @@ func (b *Botanist) determineControllerReplicas(ctx context.Context, deploymentNa
 	if err != nil {
 		return 0, fmt.Errorf("failed to check if deployment %q is controlled by dependency-watchdog: %w", client.ObjectKey{Namespace: b.Shoot.ControlPlaneNamespace, Name: deploymentName}, err)
 	}
-	if isControlledByDWD && !isCreateOrRestoreOperation && !b.Shoot.HibernationEnabled && !b.Shoot.GetInfo().Status.IsHibernated {
+	if isControlledByDWD && !isCreateOrRestoreOperation && !b.Shoot.ControlPlaneHibernationEnabled && !b.Shoot.GetInfo().Status.IsHibernated {
 		// The replicas of the component are controlled by dependency-watchdog and
 		// Shoot is being reconciled with .spec.hibernation.enabled=.status.isHibernated=false,
 		// so keep the replicas which are already available.
@@ -166,7 +168,7 @@ func (b *Botanist) DefaultControlPlane() extensionscontrolplane.Interface {
 		},
 		extensionscontrolplane.DefaultInterval,
 		extensionscontrolplane.DefaultSevereThreshold,
-		extensionscontrolplane.DefaultTimeout,
+		waitTimeout(b.componentWaitTimeouts().ControlPlane, extensionscontrolplane.DefaultTimeout),
 	)
 }
 
@@ -189,8 +191,46 @@ func (b *Botanist) RestoreControlPlane(ctx context.Context) error {
 	return b.Shoot.Components.Extensions.ControlPlane.Restore(ctx, b.Shoot.GetShootState())
 }
 
-// RestartControlPlanePods restarts (deletes) pods of the shoot control plane.
+var (
+	// RestartControlPlanePodsHealthCheckInterval is the interval at which the health of a restarted control plane
+	// deployment is checked. Exposed for testing.
+	RestartControlPlanePodsHealthCheckInterval = 5 * time.Second
+	// RestartControlPlanePodsHealthCheckTimeout is the timeout after which a restarted control plane deployment is
+	// considered unhealthy. Exposed for testing.
+	RestartControlPlanePodsHealthCheckTimeout = 5 * time.Minute
+)
+
+// RestartControlPlanePods restarts (deletes) pods of the shoot control plane. Pods belonging to deployments whose pod
+// template carries the maintenance restart label are restarted one deployment after another, and the next deployment is
+// only restarted once the previously restarted one reports healthiness again. All remaining pods carrying the label are
+// deleted at once afterwards.
 func (b *Botanist) RestartControlPlanePods(ctx context.Context) error {
+	deploymentList := &appsv1.DeploymentList{}
+	if err := b.SeedClientSet.Client().List(ctx, deploymentList, client.InNamespace(b.Shoot.ControlPlaneNamespace)); err != nil {
+		return fmt.Errorf("failed listing deployments in namespace %q: %w", b.Shoot.ControlPlaneNamespace, err)
+	}
+
+	for _, deployment := range deploymentList.Items {
+		if deployment.Spec.Template.Labels[v1beta1constants.LabelPodMaintenanceRestart] != "true" {
+			continue
+		}
+
+		b.Logger.Info("Restarting control plane pods of deployment", "deployment", client.ObjectKeyFromObject(&deployment))
+		if err := b.SeedClientSet.Client().DeleteAllOf(
+			ctx,
+			&corev1.Pod{},
+			client.InNamespace(b.Shoot.ControlPlaneNamespace),
+			client.MatchingLabels(deployment.Spec.Selector.MatchLabels),
+		); err != nil {
+			return fmt.Errorf("failed deleting pods of deployment %q: %w", client.ObjectKeyFromObject(&deployment), err)
+		}
+
+		if err := b.waitUntilDeploymentHealthyAfterRestart(ctx, deployment.Name); err != nil {
+			return err
+		}
+	}
+
+	// Delete all remaining pods carrying the restart label which do not belong to one of the deployments restarted above.
 	return b.SeedClientSet.Client().DeleteAllOf(
 		ctx,
 		&corev1.Pod{},
@@ -199,6 +239,22 @@ func (b *Botanist) RestartControlPlanePods(ctx context.Context) error {
 	)
 }
 
+func (b *Botanist) waitUntilDeploymentHealthyAfterRestart(ctx context.Context, name string) error {
+	return retryutils.UntilTimeout(ctx, RestartControlPlanePodsHealthCheckInterval, RestartControlPlanePodsHealthCheckTimeout, func(ctx context.Context) (bool, error) {
+		deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: b.Shoot.ControlPlaneNamespace}}
+		if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKeyFromObject(deployment), deployment); err != nil {
+			return retryutils.SevereError(err)
+		}
+
+		if err := health.CheckDeployment(deployment); err != nil {
+			return retryutils.MinorError(fmt.Errorf("deployment %q is not healthy yet after restarting its pods: %w", client.ObjectKeyFromObject(deployment), err))
+		}
+
+		b.Logger.Info("Deployment is healthy again after restarting its pods", "deployment", client.ObjectKeyFromObject(deployment))
+		return retryutils.Ok()
+	})
+}
+
 func waitUntilNoPodsExistAnymore(ctx context.Context, c client.Client, namespace string, deployments []string) error {
 	fns := make([]flow.TaskFn, 0, len(deployments))
 	for _, deploymentName := range deployments {
@@ -7,19 +7,31 @@ package botanist_test
 import (
 	"context"
 	"errors"
+	"time"
 
+	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	mockcontrolplane "github.com/gardener/gardener/pkg/component/extensions/controlplane/mock"
 	mockdnsrecord "github.com/gardener/gardener/pkg/component/extensions/dnsrecord/mock"
 	mockinfrastructure "github.com/gardener/gardener/pkg/component/extensions/infrastructure/mock"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
 var _ = Describe("controlplane", func() {
@@ -110,4 +122,66 @@ var _ = Describe("controlplane", func() {
 			})
 		})
 	})
+
+	Describe("#RestartControlPlanePods", func() {
+		var (
+			namespace      = "shoot--foo--bar"
+			fakeSeedClient client.Client
+
+			deployment                          *appsv1.Deployment
+			deploymentPod, labeledPod, otherPod *corev1.Pod
+		)
+
+		BeforeEach(func() {
+			fakeSeedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+			botanist.SeedClientSet = fakekubernetes.NewClientSetBuilder().WithClient(fakeSeedClient).Build()
+			botanist.Logger = logr.Discard()
+			botanist.Shoot.ControlPlaneNamespace = namespace
+
+			DeferCleanup(test.WithVars(
+				&RestartControlPlanePodsHealthCheckInterval, time.Millisecond,
+				&RestartControlPlanePodsHealthCheckTimeout, 50*time.Millisecond,
+			))
+
+			deployment = &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver", Namespace: namespace},
+				Spec: appsv1.DeploymentSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "kubernetes", "role": "apiserver"}},
+					Template: corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{
+						"app":  "kubernetes",
+						"role": "apiserver",
+						v1beta1constants.LabelPodMaintenanceRestart: "true",
+					}}},
+				},
+				Status: appsv1.DeploymentStatus{Conditions: []appsv1.DeploymentCondition{{
+					Type:   appsv1.DeploymentAvailable,
+					Status: corev1.ConditionTrue,
+				}}},
+			}
+
+			deploymentPod = &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-abc", Namespace: namespace, Labels: map[string]string{"app": "kubernetes", "role": "apiserver"}}}
+			labeledPod = &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "some-pod", Namespace: namespace, Labels: map[string]string{v1beta1constants.LabelPodMaintenanceRestart: "true"}}}
+			otherPod = &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "other-pod", Namespace: namespace}}
+
+			Expect(fakeSeedClient.Create(ctx, deployment)).To(Succeed())
+			Expect(fakeSeedClient.Create(ctx, deploymentPod)).To(Succeed())
+			Expect(fakeSeedClient.Create(ctx, labeledPod)).To(Succeed())
+			Expect(fakeSeedClient.Create(ctx, otherPod)).To(Succeed())
+		})
+
+		It("should restart the labeled pods and spare unrelated pods", func() {
+			Expect(botanist.RestartControlPlanePods(ctx)).To(Succeed())
+
+			Expect(fakeSeedClient.Get(ctx, client.ObjectKeyFromObject(deploymentPod), &corev1.Pod{})).To(BeNotFoundError())
+			Expect(fakeSeedClient.Get(ctx, client.ObjectKeyFromObject(labeledPod), &corev1.Pod{})).To(BeNotFoundError())
+			Expect(fakeSeedClient.Get(ctx, client.ObjectKeyFromObject(otherPod), &corev1.Pod{})).To(Succeed())
+		})
+
+		It("should fail if a restarted deployment does not become healthy again", func() {
+			deployment.Status.Conditions[0].Status = corev1.ConditionFalse
+			Expect(fakeSeedClient.Status().Update(ctx, deployment)).To(Succeed())
+
+			Expect(botanist.RestartControlPlanePods(ctx)).To(MatchError(ContainSubstring("is not healthy yet after restarting its pods")))
+		})
+	})
 })
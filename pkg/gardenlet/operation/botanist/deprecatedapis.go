@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/keys"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+)
+
+// deprecatedAPIMetricName is the name of the kube-apiserver metric reporting requests to deprecated APIs.
+const deprecatedAPIMetricName = "apiserver_requested_deprecated_apis"
+
+var deprecatedAPIMetricLabelsRegexp = regexp.MustCompile(`([a-z_]+)="([^"]*)"`)
+
+// ScanForRemovedAPIUsage scans the shoot cluster for usage of APIs which are removed in the target Kubernetes version
+// before the kube-apiserver is upgraded to a new minor version. It evaluates the `apiserver_requested_deprecated_apis`
+// metric of the still-running old kube-apiserver and reports its findings in the shoot's `NoRemovedAPIsInUse`
+// condition. With policy "Block", findings additionally fail the reconciliation so that the upgrade is not performed.
+func (b *Botanist) ScanForRemovedAPIUsage(ctx context.Context) error {
+	scanConfig := b.Config.Controllers.Shoot.DeprecatedAPIScan
+	if scanConfig == nil {
+		return nil
+	}
+
+	shootClient, err := b.ShootClientMap.GetClient(ctx, keys.ForShoot(b.Shoot.GetInfo()))
+	if err != nil {
+		// Without a reachable shoot API server (e.g., during cluster creation or wake-up) there is nothing to scan.
+		b.Logger.Info("Skipping scan for removed API usage because the shoot API server is not reachable", "reason", err.Error())
+		return nil
+	}
+
+	runningVersion, err := semver.NewVersion(strings.TrimPrefix(shootClient.Version(), "v"))
+	if err != nil {
+		return fmt.Errorf("failed parsing the running Kubernetes version %q of the shoot API server: %w", shootClient.Version(), err)
+	}
+
+	targetVersion := b.Shoot.KubernetesVersion
+	if targetVersion.Major() == runningVersion.Major() && targetVersion.Minor() <= runningVersion.Minor() {
+		return nil
+	}
+
+	rawMetrics, err := shootClient.RESTClient().Get().AbsPath("/metrics").Do(ctx).Raw()
+	if err != nil {
+		return fmt.Errorf("failed reading the metrics of the shoot API server: %w", err)
+	}
+
+	findings := findRemovedAPIsInUse(string(rawMetrics), targetVersion)
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(b.Clock, b.Shoot.GetInfo().Status.Conditions, gardencorev1beta1.ShootNoRemovedAPIsInUse)
+	if len(findings) == 0 {
+		condition = v1beta1helper.UpdatedConditionWithClock(b.Clock, condition, gardencorev1beta1.ConditionTrue, "NoRemovedAPIsInUse",
+			fmt.Sprintf("No usage of APIs removed in Kubernetes %d.%d detected", targetVersion.Major(), targetVersion.Minor()))
+	} else {
+		condition = v1beta1helper.UpdatedConditionWithClock(b.Clock, condition, gardencorev1beta1.ConditionFalse, "RemovedAPIsInUse",
+			fmt.Sprintf("Detected usage of APIs removed in Kubernetes %d.%d: %s", targetVersion.Major(), targetVersion.Minor(), strings.Join(findings, ", ")))
+	}
+
+	if err := b.Shoot.UpdateInfoStatus(ctx, b.GardenClient, false, false, func(shoot *gardencorev1beta1.Shoot) error {
+		shoot.Status.Conditions = v1beta1helper.MergeConditions(shoot.Status.Conditions, condition)
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed updating the %q condition: %w", gardencorev1beta1.ShootNoRemovedAPIsInUse, err)
+	}
+
+	if len(findings) > 0 {
+		if policy := scanConfig.Policy; policy != nil && *policy == gardenletconfigv1alpha1.ShootDeprecatedAPIScanPolicyBlock {
+			return fmt.Errorf("refusing to upgrade the Kubernetes version to %s: the cluster still uses APIs which are removed in the target version: %s", targetVersion, strings.Join(findings, ", "))
+		}
+		b.Logger.Info("Detected usage of APIs which are removed in the target Kubernetes version", "targetVersion", targetVersion, "findings", findings)
+	}
+
+	return nil
+}
+
+// findRemovedAPIsInUse parses the given raw kube-apiserver metrics and returns the group/version/resource identifiers
+// of all requested deprecated APIs whose removal release is at or below the given target version.
+func findRemovedAPIsInUse(rawMetrics string, targetVersion *semver.Version) []string {
+	var findings []string
+
+	for _, line := range strings.Split(rawMetrics, "\n") {
+		if !strings.HasPrefix(line, deprecatedAPIMetricName+"{") {
+			continue
+		}
+
+		labels := map[string]string{}
+		for _, match := range deprecatedAPIMetricLabelsRegexp.FindAllStringSubmatch(line, -1) {
+			labels[match[1]] = match[2]
+		}
+
+		removedRelease, err := semver.NewVersion(labels["removed_release"])
+		if err != nil || removedRelease.Major() > targetVersion.Major() ||
+			(removedRelease.Major() == targetVersion.Major() && removedRelease.Minor() > targetVersion.Minor()) {
+			continue
+		}
+
+		group := labels["group"]
+		if group == "" {
+			group = "core"
+		}
+
+		finding := group + "/" + labels["version"] + "/" + labels["resource"]
+		if !slices.Contains(findings, finding) {
+			findings = append(findings, finding)
+		}
+	}
+
+	slices.Sort(findings)
+	return findings
+}
@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	fakerest "k8s.io/client-go/rest/fake"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakeclientmap "github.com/gardener/gardener/pkg/client/kubernetes/clientmap/fake"
+	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/keys"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+)
+
+var _ = Describe("DeprecatedAPIs", func() {
+	var (
+		ctx = context.TODO()
+
+		gardenClient client.Client
+		fakeClock    *testclock.FakeClock
+		botanist     *Botanist
+
+		shoot      *gardencorev1beta1.Shoot
+		rawMetrics string
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+		fakeClock = testclock.NewFakeClock(time.Now())
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-project"},
+			Spec: gardencorev1beta1.ShootSpec{
+				Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.32.0"},
+			},
+		}
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+		rawMetrics = `# HELP apiserver_requested_deprecated_apis [STABLE] Gauge of deprecated APIs that have been requested, broken out by API group, version, resource, subresource, and removed_release.
+apiserver_requested_deprecated_apis{group="policy",removed_release="1.25",resource="podsecuritypolicies",subresource="",version="v1beta1"} 1
+apiserver_requested_deprecated_apis{group="flowcontrol.apiserver.k8s.io",removed_release="1.32",resource="flowschemas",subresource="",version="v1beta3"} 3
+apiserver_requested_deprecated_apis{group="",removed_release="1.99",resource="examples",subresource="",version="v1"} 1
+`
+
+		fakeShootRESTClient := &fakerest.RESTClient{
+			NegotiatedSerializer: scheme.Codecs,
+			Client: fakerest.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+				if req.URL.Path == "/metrics" {
+					return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader([]byte(rawMetrics)))}, nil
+				}
+				return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(&bytes.Buffer{})}, nil
+			}),
+		}
+		shootClientSet := fakekubernetes.NewClientSetBuilder().WithVersion("v1.31.4").WithRESTClient(fakeShootRESTClient).Build()
+
+		botanist = &Botanist{
+			Operation: &operation.Operation{
+				GardenClient:   gardenClient,
+				ShootClientMap: fakeclientmap.NewClientMap().AddClient(keys.ForShoot(shoot), shootClientSet),
+				Clock:          fakeClock,
+				Logger:         logr.Discard(),
+				Config: &gardenletconfigv1alpha1.GardenletConfiguration{
+					Controllers: &gardenletconfigv1alpha1.GardenletControllerConfiguration{
+						Shoot: &gardenletconfigv1alpha1.ShootControllerConfiguration{
+							DeprecatedAPIScan: &gardenletconfigv1alpha1.ShootDeprecatedAPIScan{},
+						},
+					},
+				},
+				Shoot: &shootpkg.Shoot{
+					KubernetesVersion: semver.MustParse("1.32.0"),
+				},
+			},
+		}
+		botanist.Shoot.SetInfo(shoot)
+	})
+
+	Describe("#ScanForRemovedAPIUsage", func() {
+		It("should do nothing if the scan is not configured", func() {
+			botanist.Config.Controllers.Shoot.DeprecatedAPIScan = nil
+
+			Expect(botanist.ScanForRemovedAPIUsage(ctx)).To(Succeed())
+			Expect(botanist.Shoot.GetInfo().Status.Conditions).To(BeEmpty())
+		})
+
+		It("should do nothing if no minor version upgrade is pending", func() {
+			botanist.Shoot.KubernetesVersion = semver.MustParse("1.31.4")
+
+			Expect(botanist.ScanForRemovedAPIUsage(ctx)).To(Succeed())
+			Expect(botanist.Shoot.GetInfo().Status.Conditions).To(BeEmpty())
+		})
+
+		It("should report findings in the condition and only warn with the default policy", func() {
+			Expect(botanist.ScanForRemovedAPIUsage(ctx)).To(Succeed())
+
+			Expect(botanist.Shoot.GetInfo().Status.Conditions).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(gardencorev1beta1.ShootNoRemovedAPIsInUse),
+				"Status":  Equal(gardencorev1beta1.ConditionFalse),
+				"Reason":  Equal("RemovedAPIsInUse"),
+				"Message": Equal("Detected usage of APIs removed in Kubernetes 1.32: flowcontrol.apiserver.k8s.io/v1beta3/flowschemas, policy/v1beta1/podsecuritypolicies"),
+			})))
+		})
+
+		It("should fail the reconciliation for findings with policy Block", func() {
+			botanist.Config.Controllers.Shoot.DeprecatedAPIScan.Policy = ptr.To(gardenletconfigv1alpha1.ShootDeprecatedAPIScanPolicyBlock)
+
+			Expect(botanist.ScanForRemovedAPIUsage(ctx)).To(MatchError(ContainSubstring("refusing to upgrade the Kubernetes version")))
+
+			Expect(botanist.Shoot.GetInfo().Status.Conditions).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(gardencorev1beta1.ShootNoRemovedAPIsInUse),
+				"Status": Equal(gardencorev1beta1.ConditionFalse),
+			})))
+		})
+
+		It("should set the condition to true if no removed APIs are in use", func() {
+			rawMetrics = `apiserver_requested_deprecated_apis{group="",removed_release="1.99",resource="examples",subresource="",version="v1"} 1
+`
+
+			Expect(botanist.ScanForRemovedAPIUsage(ctx)).To(Succeed())
+
+			Expect(botanist.Shoot.GetInfo().Status.Conditions).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(gardencorev1beta1.ShootNoRemovedAPIsInUse),
+				"Status":  Equal(gardencorev1beta1.ConditionTrue),
+				"Reason":  Equal("NoRemovedAPIsInUse"),
+				"Message": Equal("No usage of APIs removed in Kubernetes 1.32 detected"),
+			})))
+		})
+	})
+})
@@ -22,6 +22,11 @@ func (b *Botanist) NeedsExternalDNS() bool {
 		b.Shoot.ExternalDomain.Provider != "unmanaged"
 }
 
+// NeedsManagementDNS returns true if the Shoot cluster needs a management DNS record for its kube-apiserver.
+func (b *Botanist) NeedsManagementDNS() bool {
+	return b.NeedsExternalDNS() && b.Shoot.GetInfo().Spec.DNS.ManagementDomain != nil
+}
+
 // NeedsInternalDNS returns true if the Shoot cluster needs internal DNS.
 func (b *Botanist) NeedsInternalDNS() bool {
 	return b.Garden != nil &&
@@ -39,4 +44,9 @@ func (b *Botanist) newDNSComponentsTargetingAPIServerAddress() {
 		b.Shoot.Components.Extensions.ExternalDNSRecord.SetRecordType(extensionsv1alpha1helper.GetDNSRecordType(b.APIServerAddress))
 		b.Shoot.Components.Extensions.ExternalDNSRecord.SetValues([]string{b.APIServerAddress})
 	}
+
+	if b.NeedsManagementDNS() {
+		b.Shoot.Components.Extensions.ManagementDNSRecord.SetRecordType(extensionsv1alpha1helper.GetDNSRecordType(b.APIServerAddress))
+		b.Shoot.Components.Extensions.ManagementDNSRecord.SetValues([]string{b.APIServerAddress})
+	}
 }
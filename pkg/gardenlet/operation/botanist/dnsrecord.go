@@ -47,6 +47,36 @@ func (b *Botanist) DefaultExternalDNSRecord() extensionsdnsrecord.Interface {
 	)
 }
 
+// DefaultManagementDNSRecord creates the default deployer for the management DNSRecord resource. The record shares
+// the provider credentials with the external DNSRecord, but is expected to be created in a private DNS zone (and/or
+// pointed to an internal load balancer) by the responsible provider extension, so it resolves from the internal
+// network only.
+func (b *Botanist) DefaultManagementDNSRecord() extensionsdnsrecord.Interface {
+	values := &extensionsdnsrecord.Values{
+		Name:              b.Shoot.GetInfo().Name + "-" + v1beta1constants.DNSRecordManagementName,
+		SecretName:        DNSRecordSecretPrefix + "-" + b.Shoot.GetInfo().Name + "-" + v1beta1constants.DNSRecordManagementName,
+		Namespace:         b.Shoot.ControlPlaneNamespace,
+		TTL:               b.dnsRecordTTLSeconds(),
+		AnnotateOperation: controllerutils.HasTask(b.Shoot.GetInfo().Annotations, v1beta1constants.ShootTaskDeployDNSRecordManagement) || b.IsRestorePhase(),
+		IPStack:           gardenerutils.GetIPStackForShoot(b.Shoot.GetInfo()),
+	}
+
+	if b.NeedsManagementDNS() {
+		values.Type = b.Shoot.ExternalDomain.Provider
+		values.SecretData = b.Shoot.ExternalDomain.SecretData
+		values.DNSName = v1beta1helper.GetAPIServerDomain(*b.Shoot.GetInfo().Spec.DNS.ManagementDomain)
+	}
+
+	return extensionsdnsrecord.New(
+		b.Logger,
+		b.SeedClientSet.Client(),
+		values,
+		extensionsdnsrecord.DefaultInterval,
+		extensionsdnsrecord.DefaultSevereThreshold,
+		extensionsdnsrecord.DefaultTimeout,
+	)
+}
+
 // DefaultInternalDNSRecord creates the default deployer for the internal DNSRecord resource.
 func (b *Botanist) DefaultInternalDNSRecord() extensionsdnsrecord.Interface {
 	values := &extensionsdnsrecord.Values{
@@ -88,6 +118,14 @@ func (b *Botanist) DeployOrDestroyExternalDNSRecord(ctx context.Context) error {
 	return b.DestroyExternalDNSRecord(ctx)
 }
 
+// DeployOrDestroyManagementDNSRecord deploys, restores, or destroys the management DNSRecord and waits for the operation to complete.
+func (b *Botanist) DeployOrDestroyManagementDNSRecord(ctx context.Context) error {
+	if b.NeedsManagementDNS() {
+		return b.deployManagementDNSRecord(ctx)
+	}
+	return b.DestroyManagementDNSRecord(ctx)
+}
+
 // DeployOrDestroyInternalDNSRecord deploys, restores, or destroys the internal DNSRecord and waits for the operation to complete.
 func (b *Botanist) DeployOrDestroyInternalDNSRecord(ctx context.Context) error {
 	if b.NeedsInternalDNS() {
@@ -104,6 +142,14 @@ func (b *Botanist) deployExternalDNSRecord(ctx context.Context) error {
 	return b.Shoot.Components.Extensions.ExternalDNSRecord.Wait(ctx)
 }
 
+// deployManagementDNSRecord deploys or restores the management DNSRecord and waits for the operation to complete.
+func (b *Botanist) deployManagementDNSRecord(ctx context.Context) error {
+	if err := b.deployOrRestoreDNSRecord(ctx, b.Shoot.Components.Extensions.ManagementDNSRecord); err != nil {
+		return err
+	}
+	return b.Shoot.Components.Extensions.ManagementDNSRecord.Wait(ctx)
+}
+
 // deployInternalDNSRecord deploys or restores the internal DNSRecord and waits for the operation to complete.
 func (b *Botanist) deployInternalDNSRecord(ctx context.Context) error {
 	if err := b.deployOrRestoreDNSRecord(ctx, b.Shoot.Components.Extensions.InternalDNSRecord); err != nil {
@@ -120,6 +166,14 @@ func (b *Botanist) DestroyExternalDNSRecord(ctx context.Context) error {
 	return b.Shoot.Components.Extensions.ExternalDNSRecord.WaitCleanup(ctx)
 }
 
+// DestroyManagementDNSRecord destroys the management DNSRecord and waits for the operation to complete.
+func (b *Botanist) DestroyManagementDNSRecord(ctx context.Context) error {
+	if err := b.Shoot.Components.Extensions.ManagementDNSRecord.Destroy(ctx); err != nil {
+		return err
+	}
+	return b.Shoot.Components.Extensions.ManagementDNSRecord.WaitCleanup(ctx)
+}
+
 // DestroyInternalDNSRecord destroys the internal DNSRecord and waits for the operation to complete.
 func (b *Botanist) DestroyInternalDNSRecord(ctx context.Context) error {
 	if err := b.Shoot.Components.Extensions.InternalDNSRecord.Destroy(ctx); err != nil {
@@ -136,6 +190,14 @@ func (b *Botanist) MigrateExternalDNSRecord(ctx context.Context) error {
 	return b.Shoot.Components.Extensions.ExternalDNSRecord.WaitMigrate(ctx)
 }
 
+// MigrateManagementDNSRecord migrates the management DNSRecord and waits for the operation to complete.
+func (b *Botanist) MigrateManagementDNSRecord(ctx context.Context) error {
+	if err := b.Shoot.Components.Extensions.ManagementDNSRecord.Migrate(ctx); err != nil {
+		return err
+	}
+	return b.Shoot.Components.Extensions.ManagementDNSRecord.WaitMigrate(ctx)
+}
+
 // MigrateInternalDNSRecord migrates the internal DNSRecord and waits for the operation to complete.
 func (b *Botanist) MigrateInternalDNSRecord(ctx context.Context) error {
 	if err := b.Shoot.Components.Extensions.InternalDNSRecord.Migrate(ctx); err != nil {
@@ -6,9 +6,11 @@ package botanist
 
 import (
 	"context"
+	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
@@ -29,6 +31,13 @@ import (
 // NewEtcd is a function exposed for testing.
 var NewEtcd = etcd.New
 
+// EtcdDatabaseMaintenance is a function exposed for testing.
+var EtcdDatabaseMaintenance = shared.EtcdDatabaseMaintenance
+
+// etcdDatabaseSizeThresholdFraction is the fraction of the backend quota at which the database of the main etcd is
+// proactively compacted and defragmented.
+const etcdDatabaseSizeThresholdFraction = 0.8
+
 // DefaultEtcd returns a deployer for the etcd.
 func (b *Botanist) DefaultEtcd(role string, class etcd.Class) (etcd.Interface, error) {
 	defragmentationSchedule, err := determineDefragmentationSchedule(b.Shoot.GetInfo(), b.ManagedSeed, class)
@@ -37,7 +46,7 @@ func (b *Botanist) DefaultEtcd(role string, class etcd.Class) (etcd.Interface, e
 	}
 
 	var replicas *int32
-	if !b.Shoot.HibernationEnabled {
+	if !b.Shoot.ControlPlaneHibernationEnabled {
 		replicas = ptr.To(getEtcdReplicas(b.Shoot.GetInfo()))
 	}
 
@@ -175,6 +184,40 @@ func (b *Botanist) SnapshotEtcd(ctx context.Context) error {
 	return shared.SnapshotEtcd(ctx, b.SecretsManager, b.Shoot.Components.ControlPlane.EtcdMain)
 }
 
+// ReconcileEtcdDatabaseSize checks the database size of etcd-main against its configured backend quota and triggers a
+// compaction and defragmentation if it exceeds the threshold. It reports the resulting situation in the
+// `ETCDDatabaseWithinQuota` condition of the shoot so that operators and owners are not left with opaque
+// kube-apiserver failures when the quota is exhausted.
+func (b *Botanist) ReconcileEtcdDatabaseSize(ctx context.Context) error {
+	state, err := EtcdDatabaseMaintenance(ctx, b.SecretsManager, b.Shoot.Components.ControlPlane.EtcdMain, etcdDatabaseSizeThresholdFraction)
+	if err != nil {
+		return err
+	}
+
+	var (
+		size  = resource.NewQuantity(state.SizeBytes, resource.BinarySI)
+		quota = resource.NewQuantity(state.QuotaBytes, resource.BinarySI)
+	)
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(b.Clock, b.Shoot.GetInfo().Status.Conditions, gardencorev1beta1.ShootETCDDatabaseWithinQuota)
+	if state.NoSpaceAlarm || (state.QuotaBytes > 0 && state.SizeBytes >= state.QuotaBytes) {
+		condition = v1beta1helper.UpdatedConditionWithClock(b.Clock, condition, gardencorev1beta1.ConditionFalse, "DatabaseSizeExceedsQuota",
+			fmt.Sprintf("The database size of the main etcd (%s) still exceeds its backend quota (%s) after compaction and defragmentation, the quota must be increased", size, quota))
+	} else {
+		condition = v1beta1helper.UpdatedConditionWithClock(b.Clock, condition, gardencorev1beta1.ConditionTrue, "DatabaseSizeWithinQuota",
+			fmt.Sprintf("The database size of the main etcd (%s) is within its backend quota (%s)", size, quota))
+	}
+
+	if err := b.Shoot.UpdateInfoStatus(ctx, b.GardenClient, false, false, func(shoot *gardencorev1beta1.Shoot) error {
+		shoot.Status.Conditions = v1beta1helper.MergeConditions(shoot.Status.Conditions, condition)
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed updating the %q condition: %w", gardencorev1beta1.ShootETCDDatabaseWithinQuota, err)
+	}
+
+	return nil
+}
+
 // ScaleETCDToZero scales ETCD main and events replicas to zero.
 func (b *Botanist) ScaleETCDToZero(ctx context.Context) error {
 	return b.scaleETCD(ctx, 0)
@@ -224,7 +267,7 @@ func (b *Botanist) isRestorationOfMultiNodeMainEtcdRequired(ctx context.Context)
 	// The etcd has already been scaled up to the desired number of replicas
 	// and therefore has been restored, then it has been scaled down to 0
 	// replicas as part of the reconciliation flow for hibernated shoots.
-	if b.Shoot.HibernationEnabled && etcd.Spec.Replicas == 0 {
+	if b.Shoot.ControlPlaneHibernationEnabled && etcd.Spec.Replicas == 0 {
 		return false, nil
 	}
 	// The etcd has already been scaled up to the desired number of replicas
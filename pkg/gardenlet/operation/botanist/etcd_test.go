@@ -25,6 +25,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -44,6 +45,7 @@ import (
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
 	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
 )
 
@@ -461,6 +463,7 @@ var _ = Describe("Etcd", func() {
 
 				It("should not try to restore multi-node etcd from backup if it has already been scaled down and the shoot is hibernated", func() {
 					botanist.Shoot.HibernationEnabled = true
+					botanist.Shoot.ControlPlaneHibernationEnabled = true
 
 					etcdMain.EXPECT().Get(ctx).DoAndReturn(func(_ context.Context) (*druidcorev1alpha1.Etcd, error) {
 						return &druidcorev1alpha1.Etcd{
@@ -523,6 +526,70 @@ var _ = Describe("Etcd", func() {
 			Expect(botanist.DestroyEtcd(ctx)).To(Succeed())
 		})
 	})
+
+	Describe("#ReconcileEtcdDatabaseSize", func() {
+		var (
+			gardenClient client.Client
+			etcdMain     *mocketcd.MockInterface
+			shoot        *gardencorev1beta1.Shoot
+			state        *etcd.DatabaseState
+		)
+
+		BeforeEach(func() {
+			gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+			etcdMain = mocketcd.NewMockInterface(ctrl)
+
+			shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-project"}}
+			Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+			botanist.GardenClient = gardenClient
+			botanist.SecretsManager = sm
+			botanist.Clock = testclock.NewFakeClock(time.Now())
+			botanist.Shoot = &shootpkg.Shoot{
+				Components: &shootpkg.Components{
+					ControlPlane: &shootpkg.ControlPlane{
+						EtcdMain: etcdMain,
+					},
+				},
+			}
+			botanist.Shoot.SetInfo(shoot)
+
+			DeferCleanup(test.WithVar(&EtcdDatabaseMaintenance, func(_ context.Context, _ secretsmanager.Interface, _ etcd.Interface, _ float64) (*etcd.DatabaseState, error) {
+				return state, nil
+			}))
+		})
+
+		It("should report a true condition if the database size is within the quota", func() {
+			state = &etcd.DatabaseState{SizeBytes: 4 << 30, QuotaBytes: 8 << 30}
+
+			Expect(botanist.ReconcileEtcdDatabaseSize(ctx)).To(Succeed())
+
+			condition := v1beta1helper.GetCondition(botanist.Shoot.GetInfo().Status.Conditions, gardencorev1beta1.ShootETCDDatabaseWithinQuota)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+			Expect(condition.Reason).To(Equal("DatabaseSizeWithinQuota"))
+		})
+
+		It("should report a false condition if the database size still exceeds the quota after maintenance", func() {
+			state = &etcd.DatabaseState{SizeBytes: 8 << 30, QuotaBytes: 8 << 30, NoSpaceAlarm: true}
+
+			Expect(botanist.ReconcileEtcdDatabaseSize(ctx)).To(Succeed())
+
+			condition := v1beta1helper.GetCondition(botanist.Shoot.GetInfo().Status.Conditions, gardencorev1beta1.ShootETCDDatabaseWithinQuota)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("DatabaseSizeExceedsQuota"))
+			Expect(condition.Message).To(ContainSubstring("the quota must be increased"))
+		})
+
+		It("should fail if the maintenance fails", func() {
+			DeferCleanup(test.WithVar(&EtcdDatabaseMaintenance, func(_ context.Context, _ secretsmanager.Interface, _ etcd.Interface, _ float64) (*etcd.DatabaseState, error) {
+				return nil, fakeErr
+			}))
+
+			Expect(botanist.ReconcileEtcdDatabaseSize(ctx)).To(MatchError(fakeErr))
+		})
+	})
 })
 
 type newEtcdValidator struct {
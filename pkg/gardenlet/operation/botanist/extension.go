@@ -14,7 +14,7 @@ import (
 
 // DefaultExtension creates the default deployer for the Extension custom resources.
 func (b *Botanist) DefaultExtension(ctx context.Context) (extension.Interface, error) {
-	return shared.NewExtension(ctx, b.Logger, b.GardenClient, b.SeedClientSet.Client(), b.Shoot.ControlPlaneNamespace, extensionsv1alpha1.ExtensionClassShoot, b.Shoot.GetInfo().Spec.Extensions, b.Shoot.IsWorkerless)
+	return shared.NewExtension(ctx, b.Logger, b.GardenClient, b.SeedClientSet.Client(), b.Shoot.ControlPlaneNamespace, extensionsv1alpha1.ExtensionClassShoot, b.Shoot.GetInfo().Spec.Extensions, b.Shoot.GetInfo().Spec.ManagedAddons, b.Shoot.IsWorkerless)
 }
 
 // DeployExtensionsAfterKubeAPIServer deploys the Extension custom resources and triggers the restore operation in case
@@ -33,7 +33,7 @@ func (b *Botanist) DefaultInfrastructure() infrastructure.Interface {
 		},
 		infrastructure.DefaultInterval,
 		infrastructure.DefaultSevereThreshold,
-		infrastructure.DefaultTimeout,
+		waitTimeout(b.componentWaitTimeouts().Infrastructure, infrastructure.DefaultTimeout),
 	)
 }
 
@@ -86,6 +86,12 @@ func (b *Botanist) WaitForInfrastructure(ctx context.Context) error {
 	if egressCIDRs := b.Shoot.Components.Extensions.Infrastructure.EgressCIDRs(); len(egressCIDRs) > 0 {
 		networkingStatus.EgressCIDRs = egressCIDRs
 	}
+	for _, zoneEgressCIDRs := range b.Shoot.Components.Extensions.Infrastructure.EgressCIDRsByZone() {
+		networkingStatus.EgressCIDRsByZone = append(networkingStatus.EgressCIDRsByZone, gardencorev1beta1.ZoneEgressCIDRs{
+			Zone:  zoneEgressCIDRs.Zone,
+			CIDRs: zoneEgressCIDRs.CIDRs,
+		})
+	}
 
 	if err := b.Shoot.UpdateInfoStatus(ctx, b.GardenClient, true, false, func(shoot *gardencorev1beta1.Shoot) error {
 		shoot.Status.Networking = networkingStatus
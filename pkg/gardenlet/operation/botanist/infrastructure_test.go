@@ -138,7 +138,9 @@ var _ = Describe("Infrastructure", func() {
 			podsCIDRs     = []string{"2.3.4.5/6"}
 			servicesCIDRs = []string{"3.4.5.6/7"}
 			egressCIDRs   = []string{"4.5.6.7/8"}
-			shoot         = &gardencorev1beta1.Shoot{
+
+			egressCIDRsByZone = []extensionsv1alpha1.ZoneEgressCIDRs{{Zone: "zone-a", CIDRs: []string{"4.5.6.7/8"}}}
+			shoot             = &gardencorev1beta1.Shoot{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      name,
 					Namespace: namespace,
@@ -171,6 +173,7 @@ var _ = Describe("Infrastructure", func() {
 			infrastructure.EXPECT().PodsCIDRs().Return(podsCIDRs)
 			infrastructure.EXPECT().ServicesCIDRs().Return(servicesCIDRs)
 			infrastructure.EXPECT().EgressCIDRs().Return(egressCIDRs)
+			infrastructure.EXPECT().EgressCIDRsByZone().Return(egressCIDRsByZone)
 
 			updatedShoot := shoot.DeepCopy()
 			updatedShoot.Spec.Networking.Nodes = ptr.To(nodesCIDRs[0])
@@ -178,10 +181,11 @@ var _ = Describe("Infrastructure", func() {
 
 			updatedShoot2 := updatedShoot.DeepCopy()
 			updatedShoot2.Status.Networking = &gardencorev1beta1.NetworkingStatus{
-				Nodes:       nodesCIDRs,
-				Pods:        podsCIDRs,
-				Services:    servicesCIDRs,
-				EgressCIDRs: egressCIDRs,
+				Nodes:             nodesCIDRs,
+				Pods:              podsCIDRs,
+				Services:          servicesCIDRs,
+				EgressCIDRs:       egressCIDRs,
+				EgressCIDRsByZone: []gardencorev1beta1.ZoneEgressCIDRs{{Zone: "zone-a", CIDRs: []string{"4.5.6.7/8"}}},
 			}
 			gardenClient.EXPECT().Status().Return(mockStatusWriter)
 			test.EXPECTStatusPatch(ctx, mockStatusWriter, updatedShoot2, updatedShoot, types.StrategicMergePatchType)
@@ -201,6 +205,7 @@ var _ = Describe("Infrastructure", func() {
 			infrastructure.EXPECT().PodsCIDRs()
 			infrastructure.EXPECT().ServicesCIDRs()
 			infrastructure.EXPECT().EgressCIDRs()
+			infrastructure.EXPECT().EgressCIDRsByZone()
 
 			updatedShoot := shoot.DeepCopy()
 			updatedShoot.Spec.Networking.Nodes = ptr.To(nodesCIDRs[0])
@@ -28,6 +28,7 @@ import (
 	resourcemanagerconstants "github.com/gardener/gardener/pkg/component/gardener/resourcemanager/constants"
 	kubeapiserver "github.com/gardener/gardener/pkg/component/kubernetes/apiserver"
 	"github.com/gardener/gardener/pkg/component/shared"
+	"github.com/gardener/gardener/pkg/controllerutils"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 )
@@ -83,14 +84,27 @@ func (b *Botanist) computeKubeAPIServerAutoscalingConfig() kubeapiserver.Autosca
 		minReplicas int32 = 2
 		maxReplicas int32 = 6
 		minAllowed  corev1.ResourceList
+
+		averageRequestsPerSecond, averageInflightRequests *int32
 	)
 
-	if apiServerConfig := b.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer; apiServerConfig != nil && apiServerConfig.Autoscaling != nil {
-		minAllowed = apiServerConfig.Autoscaling.MinAllowed
+	if apiServerConfig := b.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer; apiServerConfig != nil {
+		if apiServerConfig.Autoscaling != nil {
+			minAllowed = apiServerConfig.Autoscaling.MinAllowed
+		}
+		if apiServerConfig.RequestBasedAutoscaling != nil {
+			averageRequestsPerSecond = apiServerConfig.RequestBasedAutoscaling.AverageRequestsPerSecond
+			averageInflightRequests = apiServerConfig.RequestBasedAutoscaling.AverageInflightRequests
+		}
 	}
 	if v1beta1helper.IsHAControlPlaneConfigured(b.Shoot.GetInfo()) {
 		minReplicas = 3
 	}
+	if b.Shoot.HibernationEnabled && v1beta1helper.ShootHibernationMode(b.Shoot.GetInfo()) == gardencorev1beta1.HibernationModeControlPlaneMinimized {
+		// Keep the API server reachable with a minimal footprint while the workers are hibernated.
+		minReplicas = 1
+		maxReplicas = 1
+	}
 	if metav1.HasAnnotation(b.Shoot.GetInfo().ObjectMeta, v1beta1constants.ShootAlphaControlPlaneScaleDownDisabled) {
 		minReplicas = 4
 		scaleDownDisabled = true
@@ -106,10 +120,12 @@ func (b *Botanist) computeKubeAPIServerAutoscalingConfig() kubeapiserver.Autosca
 				minAllowed,
 			),
 		},
-		MinReplicas:       minReplicas,
-		MaxReplicas:       maxReplicas,
-		ScaleDownDisabled: scaleDownDisabled,
-		MinAllowed:        minAllowed,
+		MinReplicas:              minReplicas,
+		MaxReplicas:              maxReplicas,
+		ScaleDownDisabled:        scaleDownDisabled,
+		MinAllowed:               minAllowed,
+		AverageRequestsPerSecond: averageRequestsPerSecond,
+		AverageInflightRequests:  averageInflightRequests,
 	}
 }
 
@@ -207,6 +223,8 @@ func (b *Botanist) DeployKubeAPIServer(ctx context.Context, enableNodeAgentAutho
 			}, b.Logger)
 	}
 
+	b.Shoot.Components.ControlPlane.KubeAPIServer.SetBlueGreenRollout(b.Shoot.GetInfo().Annotations[v1beta1constants.ShootAlphaControlPlaneKubeAPIServerBlueGreenRollout] == "true")
+
 	var seedPods *net.IPNet
 	seedPodSpec := b.Seed.GetInfo().Spec.Networks.Pods
 	if seedPodSpec != "" {
@@ -216,6 +234,15 @@ func (b *Botanist) DeployKubeAPIServer(ctx context.Context, enableNodeAgentAutho
 		}
 	}
 
+	var kmsEncryptionConfig *gardencorev1beta1.KMSEncryptionConfiguration
+	if apiServerConfig := b.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer; apiServerConfig != nil && apiServerConfig.EncryptionConfig != nil {
+		kmsEncryptionConfig = apiServerConfig.EncryptionConfig.KMS
+	}
+
+	if err := b.deployKMSProviderSecret(ctx, kmsEncryptionConfig); err != nil {
+		return err
+	}
+
 	if err := shared.DeployKubeAPIServer(
 		ctx,
 		b.SeedClientSet.Client(),
@@ -231,8 +258,9 @@ func (b *Botanist) DeployKubeAPIServer(ctx context.Context, enableNodeAgentAutho
 		seedPods,
 		b.Shoot.ResourcesToEncrypt,
 		b.Shoot.EncryptedResources,
+		kmsEncryptionConfig,
 		v1beta1helper.GetShootETCDEncryptionKeyRotationPhase(b.Shoot.GetInfo().Status.Credentials),
-		b.Shoot.HibernationEnabled,
+		b.Shoot.ControlPlaneHibernationEnabled,
 	); err != nil {
 		return err
 	}
@@ -240,6 +268,31 @@ func (b *Botanist) DeployKubeAPIServer(ctx context.Context, enableNodeAgentAutho
 	return nil
 }
 
+// secretNameKMSProviderCredentials is the name of the secret in the shoot's control plane namespace into which the
+// KMS provider credentials referenced in the shoot spec are copied.
+const secretNameKMSProviderCredentials = "kube-apiserver-kms-credentials" // #nosec G101 -- No credential.
+
+// deployKMSProviderSecret copies the KMS provider credentials secret referenced in the shoot spec from the project
+// namespace into the shoot's control plane namespace so that the KMS plugin can consume it.
+func (b *Botanist) deployKMSProviderSecret(ctx context.Context, kmsEncryptionConfig *gardencorev1beta1.KMSEncryptionConfiguration) error {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretNameKMSProviderCredentials, Namespace: b.Shoot.ControlPlaneNamespace}}
+
+	if kmsEncryptionConfig == nil || kmsEncryptionConfig.SecretName == nil {
+		return kubernetesutils.DeleteObject(ctx, b.SeedClientSet.Client(), secret)
+	}
+
+	referencedSecret := &corev1.Secret{}
+	if err := b.GardenClient.Get(ctx, client.ObjectKey{Namespace: b.Shoot.GetInfo().Namespace, Name: *kmsEncryptionConfig.SecretName}, referencedSecret); err != nil {
+		return fmt.Errorf("failed reading the referenced KMS provider secret: %w", err)
+	}
+
+	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, b.SeedClientSet.Client(), secret, func() error {
+		secret.Data = referencedSecret.Data
+		return nil
+	})
+	return err
+}
+
 func (b *Botanist) computeKubeAPIServerServiceAccountConfig(externalHostname string) (kubeapiserver.ServiceAccountConfig, error) {
 	var config *gardencorev1beta1.ServiceAccountConfig
 	if b.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer != nil && b.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer.ServiceAccountConfig != nil {
@@ -8,6 +8,7 @@ import (
 	"context"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -18,6 +19,21 @@ import (
 
 // DefaultKubeControllerManager returns a deployer for the kube-controller-manager.
 func (b *Botanist) DefaultKubeControllerManager() (kubecontrollermanager.Interface, error) {
+	config := b.Shoot.GetInfo().Spec.Kubernetes.KubeControllerManager
+
+	controllerWorkers := kubecontrollermanager.ControllerWorkers{}
+	if config != nil && config.ConcurrentSyncs != nil {
+		if v := config.ConcurrentSyncs.Deployment; v != nil {
+			controllerWorkers.Deployment = ptr.To(int(*v))
+		}
+		if v := config.ConcurrentSyncs.ReplicaSet; v != nil {
+			controllerWorkers.ReplicaSet = ptr.To(int(*v))
+		}
+		if v := config.ConcurrentSyncs.StatefulSet; v != nil {
+			controllerWorkers.StatefulSet = ptr.To(int(*v))
+		}
+	}
+
 	return shared.NewKubeControllerManager(
 		b.Logger,
 		b.SeedClientSet,
@@ -26,12 +42,12 @@ func (b *Botanist) DefaultKubeControllerManager() (kubecontrollermanager.Interfa
 		b.Shoot.KubernetesVersion,
 		b.SecretsManager,
 		"",
-		b.Shoot.GetInfo().Spec.Kubernetes.KubeControllerManager,
+		config,
 		v1beta1constants.PriorityClassNameShootControlPlane300,
 		b.Shoot.IsWorkerless,
 		metav1.HasAnnotation(b.Shoot.GetInfo().ObjectMeta, v1beta1constants.ShootAlphaControlPlaneScaleDownDisabled),
 		nil,
-		kubecontrollermanager.ControllerWorkers{},
+		controllerWorkers,
 		kubecontrollermanager.ControllerSyncPeriods{},
 		nil,
 	)
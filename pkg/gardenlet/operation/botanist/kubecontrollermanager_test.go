@@ -121,6 +121,7 @@ var _ = Describe("KubeControllerManager", func() {
 
 				It("hibernation status unequal (true/false) and Kube-Apiserver is already scaled down", func() {
 					botanist.Shoot.HibernationEnabled = true
+					botanist.Shoot.ControlPlaneHibernationEnabled = true
 					botanist.Shoot.GetInfo().Status.IsHibernated = false
 
 					kubeControllerManager.EXPECT().SetReplicaCount(int32(0))
@@ -139,6 +140,7 @@ var _ = Describe("KubeControllerManager", func() {
 
 				It("hibernation status unequal (true/false)", func() {
 					botanist.Shoot.HibernationEnabled = true
+					botanist.Shoot.ControlPlaneHibernationEnabled = true
 					botanist.Shoot.GetInfo().Status.IsHibernated = false
 
 					kubeControllerManager.EXPECT().SetReplicaCount(int32(1))
@@ -160,6 +162,7 @@ var _ = Describe("KubeControllerManager", func() {
 
 				It("hibernation status equal (true/true)", func() {
 					botanist.Shoot.HibernationEnabled = true
+					botanist.Shoot.ControlPlaneHibernationEnabled = true
 					botanist.Shoot.GetInfo().Status.IsHibernated = true
 
 					var replicas int32 = 4
@@ -202,6 +205,7 @@ var _ = Describe("KubeControllerManager", func() {
 
 				It("hibernation enabled", func() {
 					botanist.Shoot.HibernationEnabled = true
+					botanist.Shoot.ControlPlaneHibernationEnabled = true
 					kubernetesClient.EXPECT().Client().Return(c)
 					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "kube-controller-manager"}, gomock.AssignableToTypeOf(&appsv1.Deployment{})).DoAndReturn(func(_ context.Context, _ types.NamespacedName, obj *appsv1.Deployment, _ ...client.GetOption) error {
 						obj.Spec.Replicas = ptr.To[int32](0)
@@ -214,6 +218,7 @@ var _ = Describe("KubeControllerManager", func() {
 
 				It("hibernation enabled and kube-controller-manager deployment does not exist", func() {
 					botanist.Shoot.HibernationEnabled = true
+					botanist.Shoot.ControlPlaneHibernationEnabled = true
 					kubernetesClient.EXPECT().Client().Return(c)
 					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "kube-controller-manager"}, gomock.AssignableToTypeOf(&appsv1.Deployment{})).Return(apierrors.NewNotFound(appsv1.Resource("Deployment"), "kube-controller-manager"))
 					kubeControllerManager.EXPECT().SetReplicaCount(int32(0))
@@ -223,6 +228,7 @@ var _ = Describe("KubeControllerManager", func() {
 
 				It("hibernation enabled and kube-controller-manager is already scaled up", func() {
 					botanist.Shoot.HibernationEnabled = true
+					botanist.Shoot.ControlPlaneHibernationEnabled = true
 					kubernetesClient.EXPECT().Client().Return(c)
 					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "kube-controller-manager"}, gomock.AssignableToTypeOf(&appsv1.Deployment{})).DoAndReturn(func(_ context.Context, _ types.NamespacedName, obj *appsv1.Deployment, _ ...client.GetOption) error {
 						obj.Spec.Replicas = ptr.To[int32](1)
@@ -251,6 +257,7 @@ var _ = Describe("KubeControllerManager", func() {
 
 				It("hibernation enabled", func() {
 					botanist.Shoot.HibernationEnabled = true
+					botanist.Shoot.ControlPlaneHibernationEnabled = true
 					kubernetesClient.EXPECT().Client().Return(c)
 					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "kube-controller-manager"}, gomock.AssignableToTypeOf(&appsv1.Deployment{})).DoAndReturn(func(_ context.Context, _ types.NamespacedName, obj *appsv1.Deployment, _ ...client.GetOption) error {
 						obj.Spec.Replicas = ptr.To[int32](0)
@@ -263,6 +270,7 @@ var _ = Describe("KubeControllerManager", func() {
 
 				It("hibernation enabled and kube-controller-manager deployment does not exist", func() {
 					botanist.Shoot.HibernationEnabled = true
+					botanist.Shoot.ControlPlaneHibernationEnabled = true
 					kubernetesClient.EXPECT().Client().Return(c)
 					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "kube-controller-manager"}, gomock.AssignableToTypeOf(&appsv1.Deployment{})).Return(apierrors.NewNotFound(appsv1.Resource("Deployment"), "kube-controller-manager"))
 					kubeControllerManager.EXPECT().SetReplicaCount(int32(0))
@@ -272,6 +280,7 @@ var _ = Describe("KubeControllerManager", func() {
 
 				It("hibernation enabled and kube-controller-manager is already scaled up", func() {
 					botanist.Shoot.HibernationEnabled = true
+					botanist.Shoot.ControlPlaneHibernationEnabled = true
 					kubernetesClient.EXPECT().Client().Return(c)
 					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "kube-controller-manager"}, gomock.AssignableToTypeOf(&appsv1.Deployment{})).DoAndReturn(func(_ context.Context, _ types.NamespacedName, obj *appsv1.Deployment, _ ...client.GetOption) error {
 						obj.Spec.Replicas = ptr.To[int32](1)
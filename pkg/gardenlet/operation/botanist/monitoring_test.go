@@ -68,6 +68,8 @@ var _ = Describe("Monitoring", func() {
 			},
 		}
 
+		botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{})
+
 		ingressAuthSecret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "observability-ingress-users", Namespace: controlPlaneNamespace}}
 		ingressWildcardSecret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "wildcard"}}
 
@@ -121,6 +121,17 @@ func (b *Botanist) DeployOperatingSystemConfig(ctx context.Context) error {
 			publicKeys = append(publicKeys, string(sshKeypairSecretOld.Data[secretsutils.DataKeySSHAuthorizedKeys]))
 		}
 
+		// Make the nodes trust SSH certificates signed by the shoot's SSH certificate authority so that sessions with
+		// short-lived certificates (issued via the shoots/adminsshconfig subresource) can be established without
+		// distributing individual public keys.
+		if sshCASecret, found := b.SecretsManager.Get(v1beta1constants.SecretNameCASSH); found {
+			publicKeys = append(publicKeys, "cert-authority "+string(sshCASecret.Data[secretsutils.DataKeySSHAuthorizedKeys]))
+		}
+
+		if sshCASecretOld, found := b.SecretsManager.Get(v1beta1constants.SecretNameCASSH, secretsmanager.Old); found {
+			publicKeys = append(publicKeys, "cert-authority "+string(sshCASecretOld.Data[secretsutils.DataKeySSHAuthorizedKeys]))
+		}
+
 		b.Shoot.Components.Extensions.OperatingSystemConfig.SetSSHPublicKeys(publicKeys)
 	}
 
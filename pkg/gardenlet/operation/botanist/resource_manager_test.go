@@ -307,6 +307,7 @@ var _ = Describe("ResourceManager", func() {
 
 				It("due to shoot reconciling and hibernated", func() {
 					botanist.Shoot.HibernationEnabled = true
+					botanist.Shoot.ControlPlaneHibernationEnabled = true
 					botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
 						Spec: gardencorev1beta1.ShootSpec{
 							Hibernation: &gardencorev1beta1.Hibernation{
@@ -347,6 +348,7 @@ var _ = Describe("ResourceManager", func() {
 
 				It("due to shoot creation and hibernated", func() {
 					botanist.Shoot.HibernationEnabled = true
+					botanist.Shoot.ControlPlaneHibernationEnabled = true
 					botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
 						Spec: gardencorev1beta1.ShootSpec{
 							Hibernation: &gardencorev1beta1.Hibernation{
@@ -370,6 +372,7 @@ var _ = Describe("ResourceManager", func() {
 
 				It("due to shoot restoration and hibernated", func() {
 					botanist.Shoot.HibernationEnabled = true
+					botanist.Shoot.ControlPlaneHibernationEnabled = true
 					botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
 						Spec: gardencorev1beta1.ShootSpec{
 							Hibernation: &gardencorev1beta1.Hibernation{
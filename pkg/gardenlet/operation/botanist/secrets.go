@@ -55,9 +55,9 @@ func (b *Botanist) InitializeSecretsManagement(ctx context.Context) error {
 	}
 
 	if v1beta1helper.ShootEnablesSSHAccess(b.Shoot.GetInfo()) {
-		taskFns = append(taskFns, b.generateSSHKeypair)
+		taskFns = append(taskFns, b.generateSSHKeypair, b.generateSSHCertificateAuthority)
 	} else {
-		taskFns = append(taskFns, b.deleteSSHKeypair)
+		taskFns = append(taskFns, b.deleteSSHKeypair, b.deleteSSHCertificateAuthority)
 	}
 
 	if b.WantsObservabilityComponents() {
@@ -348,6 +348,25 @@ func (b *Botanist) generateSSHKeypair(ctx context.Context) error {
 	return nil
 }
 
+func (b *Botanist) generateSSHCertificateAuthority(ctx context.Context) error {
+	sshCASecret, err := b.SecretsManager.Generate(ctx, &secretsutils.RSASecretConfig{
+		Name:       v1beta1constants.SecretNameCASSH,
+		Bits:       4096,
+		UsedForSSH: true,
+	}, secretsmanager.Persist(), secretsmanager.Rotate(secretsmanager.KeepOld))
+	if err != nil {
+		return err
+	}
+
+	return b.syncInternalSecretToGarden(
+		ctx,
+		gardenerutils.ShootProjectSecretSuffixCASSH,
+		map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleCASSH},
+		nil,
+		sshCASecret.Data,
+	)
+}
+
 func (b *Botanist) generateObservabilityIngressPassword(ctx context.Context) error {
 	secret, err := b.SecretsManager.Generate(ctx, &secretsutils.BasicAuthSecretConfig{
 		Name:           v1beta1constants.SecretNameObservabilityIngressUsers,
@@ -477,6 +496,15 @@ func (b *Botanist) deleteSSHKeypair(ctx context.Context) error {
 	return b.deleteShootCredentialFromGarden(ctx, gardenerutils.ShootProjectSecretSuffixSSHKeypair, gardenerutils.ShootProjectSecretSuffixOldSSHKeypair)
 }
 
+func (b *Botanist) deleteSSHCertificateAuthority(ctx context.Context) error {
+	return kubernetesutils.DeleteObjects(ctx, b.GardenClient, &gardencorev1beta1.InternalSecret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      gardenerutils.ComputeShootProjectResourceName(b.Shoot.GetInfo().Name, gardenerutils.ShootProjectSecretSuffixCASSH),
+			Namespace: b.Shoot.GetInfo().Namespace,
+		},
+	})
+}
+
 func (b *Botanist) deleteShootCredentialFromGarden(ctx context.Context, nameSuffixes ...string) error {
 	var secretsToDelete []client.Object
 	for _, nameSuffix := range nameSuffixes {
@@ -58,5 +58,23 @@ func (b *Botanist) DeployShootSystem(ctx context.Context) error {
 	b.Shoot.Components.SystemComponents.Resources.SetServiceNetworkCIDRs(b.Shoot.Networks.Services)
 	b.Shoot.Components.SystemComponents.Resources.SetNodeNetworkCIDRs(b.Shoot.Networks.Nodes)
 	b.Shoot.Components.SystemComponents.Resources.SetEgressCIDRs(b.Shoot.Networks.EgressCIDRs)
+	b.Shoot.Components.SystemComponents.Resources.SetEgressEndpoints(b.computeEgressEndpoints())
 	return b.Shoot.Components.SystemComponents.Resources.Deploy(ctx)
 }
+
+// computeEgressEndpoints computes the endpoints that shoot worker networks must be able to reach, grouped by
+// category. They are published in the kube-system namespace of the shoot so that customers with restrictive egress
+// firewalls can automate their rules.
+func (b *Botanist) computeEgressEndpoints() map[string][]string {
+	endpoints := map[string][]string{}
+
+	var apiServer []string
+	for _, address := range b.Shoot.GetInfo().Status.AdvertisedAddresses {
+		apiServer = append(apiServer, address.URL)
+	}
+	if len(apiServer) > 0 {
+		endpoints["apiServer"] = apiServer
+	}
+
+	return endpoints
+}
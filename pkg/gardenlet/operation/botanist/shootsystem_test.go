@@ -15,6 +15,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	fakediscovery "k8s.io/client-go/discovery/fake"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	"github.com/gardener/gardener/pkg/client/kubernetes/test"
 	mockshootsystem "github.com/gardener/gardener/pkg/component/shoot/system/mock"
@@ -103,11 +104,21 @@ var _ = Describe("ShootSystem", func() {
 				},
 			}
 
+			botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+				Status: gardencorev1beta1.ShootStatus{
+					AdvertisedAddresses: []gardencorev1beta1.ShootAdvertisedAddress{
+						{Name: "external", URL: "https://api.external.foo.bar"},
+						{Name: "internal", URL: "https://api.internal.foo.bar"},
+					},
+				},
+			})
+
 			shootSystem.EXPECT().SetAPIResourceList(apiResourceList)
 			shootSystem.EXPECT().SetNodeNetworkCIDRs(botanist.Shoot.Networks.Nodes)
 			shootSystem.EXPECT().SetServiceNetworkCIDRs(botanist.Shoot.Networks.Services)
 			shootSystem.EXPECT().SetPodNetworkCIDRs(botanist.Shoot.Networks.Pods)
 			shootSystem.EXPECT().SetEgressCIDRs(botanist.Shoot.Networks.EgressCIDRs)
+			shootSystem.EXPECT().SetEgressEndpoints(map[string][]string{"apiServer": {"https://api.external.foo.bar", "https://api.internal.foo.bar"}})
 		})
 
 		It("should discover the API and deploy", func() {
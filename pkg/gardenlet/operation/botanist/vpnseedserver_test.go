@@ -91,6 +91,7 @@ var _ = Describe("VPNSeedServer", func() {
 				kubernetesClient.EXPECT().Client()
 				kubernetesClient.EXPECT().Version()
 				botanist.Shoot.HibernationEnabled = hibernated
+				botanist.Shoot.ControlPlaneHibernationEnabled = hibernated
 				if highAvailable {
 					botanist.Shoot.VPNHighAvailabilityEnabled = highAvailable
 					botanist.Shoot.VPNHighAvailabilityNumberOfSeedServers = 2
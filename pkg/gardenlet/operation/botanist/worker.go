@@ -51,7 +51,7 @@ func (b *Botanist) DefaultWorker() worker.Interface {
 		},
 		worker.DefaultInterval,
 		worker.DefaultSevereThreshold,
-		worker.DefaultTimeout,
+		waitTimeout(b.componentWaitTimeouts().Worker, worker.DefaultTimeout),
 	)
 }
 
@@ -428,14 +428,15 @@ func (o *Operation) DeleteClusterResourceFromSeed(ctx context.Context) error {
 	return client.IgnoreNotFound(o.SeedClientSet.Client().Delete(ctx, &extensionsv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: o.Shoot.ControlPlaneNamespace}}))
 }
 
-// IsShootMonitoringEnabled returns true if shoot monitoring is enabled and shoot is not of purpose testing.
+// IsShootMonitoringEnabled returns true if shoot monitoring is enabled and neither the shoot's purpose (testing) nor
+// its annotations disable the monitoring stack.
 func (o *Operation) IsShootMonitoringEnabled() bool {
-	return helper.IsMonitoringEnabled(o.Config) && o.Shoot.Purpose != gardencorev1beta1.ShootPurposeTesting
+	return helper.IsMonitoringEnabled(o.Config) && o.Shoot.Purpose != gardencorev1beta1.ShootPurposeTesting && !v1beta1helper.IsShootMonitoringDisabled(o.Shoot.GetInfo())
 }
 
-// WantsObservabilityComponents returns true if shoot is not of purpose testing and either shoot monitoring or vali is enabled.
+// WantsObservabilityComponents returns true if shoot monitoring is not disabled for the shoot and either shoot monitoring or vali is enabled.
 func (o *Operation) WantsObservabilityComponents() bool {
-	return o.Shoot.Purpose != gardencorev1beta1.ShootPurposeTesting && (helper.IsMonitoringEnabled(o.Config) || helper.IsValiEnabled(o.Config))
+	return o.Shoot.Purpose != gardencorev1beta1.ShootPurposeTesting && !v1beta1helper.IsShootMonitoringDisabled(o.Shoot.GetInfo()) && (helper.IsMonitoringEnabled(o.Config) || helper.IsValiEnabled(o.Config))
 }
 
 // ComputeKubeAPIServerHost computes the host with a TLS certificate from a trusted origin for KubeAPIServer.
@@ -28,6 +28,7 @@ import (
 	"github.com/gardener/gardener/pkg/component"
 	vpnseedserver "github.com/gardener/gardener/pkg/component/networking/vpn/seedserver"
 	sharedcomponent "github.com/gardener/gardener/pkg/component/shared"
+	"github.com/gardener/gardener/pkg/controllerutils"
 	gardenerextensions "github.com/gardener/gardener/pkg/extensions"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1/helper"
@@ -224,6 +225,7 @@ func (b *Builder) Build(ctx context.Context, c client.Reader) (*Shoot, error) {
 	}
 
 	shoot.HibernationEnabled = v1beta1helper.HibernationIsEnabled(shootObject)
+	shoot.ControlPlaneHibernationEnabled = v1beta1helper.ControlPlaneHibernationIsEnabled(shootObject)
 	shoot.ControlPlaneNamespace = v1beta1helper.ControlPlaneNamespaceForShoot(shootObject)
 	shoot.InternalClusterDomain = gardenerutils.ConstructInternalClusterDomain(shootObject.Name, b.projectName, b.internalDomain)
 	shoot.ExternalClusterDomain = gardenerutils.ConstructExternalClusterDomain(shootObject)
@@ -367,6 +369,7 @@ func (s *Shoot) SetShootState(shootState *gardencorev1beta1.ShootState) {
 // using the given context, client, and mutate function.
 // It copies the current shoot resource and then uses the copy to patch the resource in the cluster
 // using either client.MergeFrom or client.StrategicMergeFrom depending on useStrategicMerge.
+// Empty patches are not sent to the API server in order to reduce unnecessary write requests.
 // This method is protected by a mutex, so only a single UpdateInfo or UpdateInfoStatus operation can be
 // executed at any point in time.
 func (s *Shoot) UpdateInfo(ctx context.Context, c client.Client, useStrategicMerge, mergeWithOptimisticLock bool, f func(*gardencorev1beta1.Shoot) error) error {
@@ -389,7 +392,15 @@ func (s *Shoot) UpdateInfo(ctx context.Context, c client.Client, useStrategicMer
 	if err := f(shoot); err != nil {
 		return err
 	}
-	if err := c.Patch(ctx, shoot, patch); err != nil {
+	patchData, err := patch.Data(shoot)
+	if err != nil {
+		return err
+	}
+	if controllerutils.IsEmptyPatch(patchData, mergeWithOptimisticLock, shoot.ResourceVersion) {
+		s.info.Store(shoot)
+		return nil
+	}
+	if err := c.Patch(ctx, shoot, client.RawPatch(patch.Type(), patchData)); err != nil {
 		return err
 	}
 	s.info.Store(shoot)
@@ -400,6 +411,7 @@ func (s *Shoot) UpdateInfo(ctx context.Context, c client.Client, useStrategicMer
 // using the given context, client, and mutate function.
 // It copies the current shoot resource and then uses the copy to patch the resource in the cluster
 // using either client.MergeFrom or client.StrategicMergeFrom depending on useStrategicMerge.
+// Empty patches are not sent to the API server in order to reduce unnecessary write requests.
 // This method is protected by a mutex, so only a single UpdateInfo or UpdateInfoStatus operation can be
 // executed at any point in time.
 func (s *Shoot) UpdateInfoStatus(ctx context.Context, c client.Client, useStrategicMerge, mergeWithOptimisticLock bool, f func(*gardencorev1beta1.Shoot) error) error {
@@ -422,7 +434,15 @@ func (s *Shoot) UpdateInfoStatus(ctx context.Context, c client.Client, useStrate
 	if err := f(shoot); err != nil {
 		return err
 	}
-	if err := c.Status().Patch(ctx, shoot, patch); err != nil {
+	patchData, err := patch.Data(shoot)
+	if err != nil {
+		return err
+	}
+	if controllerutils.IsEmptyPatch(patchData, mergeWithOptimisticLock, shoot.ResourceVersion) {
+		s.info.Store(shoot)
+		return nil
+	}
+	if err := c.Status().Patch(ctx, shoot, client.RawPatch(patch.Type(), patchData)); err != nil {
 		return err
 	}
 	s.info.Store(shoot)
@@ -452,6 +472,7 @@ func (s *Shoot) GetDNSRecordComponentsForMigration() []component.DeployMigrateWa
 		s.Components.Extensions.IngressDNSRecord,
 		s.Components.Extensions.ExternalDNSRecord,
 		s.Components.Extensions.InternalDNSRecord,
+		s.Components.Extensions.ManagementDNSRecord,
 	}
 }
 
@@ -474,9 +495,10 @@ func (s *Shoot) GetMaxNodeCount() int32 {
 	return nodeCount
 }
 
-// GetReplicas returns the given <wokenUp> number if the shoot is not hibernated, or zero otherwise.
+// GetReplicas returns the given <wokenUp> number if the shoot's control plane is not hibernated, or zero otherwise.
+// Hibernation modes which keep the control plane running do not scale down the control plane components.
 func (s *Shoot) GetReplicas(wokenUp int32) int32 {
-	if s.HibernationEnabled {
+	if s.ControlPlaneHibernationEnabled {
 		return 0
 	}
 	return wokenUp
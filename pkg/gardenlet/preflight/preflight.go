@@ -0,0 +1,296 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package preflight contains checks which validate a prospective seed cluster against the requirements of gardenlet
+// before the cluster is registered as a seed. The checks produce a machine-readable report which operators can inspect
+// (or feed into automation) to find out whether the cluster is suitable.
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	kubernetesversionvalidation "github.com/gardener/gardener/pkg/utils/validation/kubernetesversion"
+)
+
+// CheckStatus is the status of a single preflight check.
+type CheckStatus string
+
+const (
+	// CheckStatusPassed indicates that the check passed.
+	CheckStatusPassed CheckStatus = "Passed"
+	// CheckStatusFailed indicates that the check failed.
+	CheckStatusFailed CheckStatus = "Failed"
+	// CheckStatusSkipped indicates that the check could not be performed, e.g. because required input is missing.
+	CheckStatusSkipped CheckStatus = "Skipped"
+)
+
+// CheckResult is the result of a single preflight check.
+type CheckResult struct {
+	// Name is the name of the check.
+	Name string `json:"name"`
+	// Status is the status of the check.
+	Status CheckStatus `json:"status"`
+	// Message explains the status in a human-readable way.
+	Message string `json:"message,omitempty"`
+}
+
+// Report is the aggregated result of all preflight checks.
+type Report struct {
+	// Succeeded is true if no check failed. Skipped checks do not cause the report to fail.
+	Succeeded bool `json:"succeeded"`
+	// Checks contains the results of the individual checks.
+	Checks []CheckResult `json:"checks"`
+}
+
+// Checker validates a prospective seed cluster against the requirements of gardenlet.
+type Checker struct {
+	// Client is a client for the prospective seed cluster.
+	Client client.Client
+	// DiscoveryClient is a discovery client for the prospective seed cluster.
+	DiscoveryClient discovery.DiscoveryInterface
+	// IngressDomain is the ingress domain the seed shall be registered with. If set, the wildcard DNS record for the
+	// domain is checked for resolvability.
+	IngressDomain string
+	// LookupHost resolves the given host name. It is usually net.DefaultResolver.LookupHost and exposed for testing.
+	LookupHost func(ctx context.Context, host string) ([]string, error)
+}
+
+// RunChecks runs all preflight checks and returns the aggregated report.
+func (c *Checker) RunChecks(ctx context.Context) Report {
+	report := Report{Succeeded: true}
+
+	for _, check := range []func(ctx context.Context) CheckResult{
+		c.checkKubernetesVersion,
+		c.checkNetworking,
+		c.checkLoadBalancerSupport,
+		c.checkRequiredCRDs,
+		c.checkLeftoverGardenerCRDs,
+		c.checkDNSWildcard,
+	} {
+		result := check(ctx)
+		if result.Status == CheckStatusFailed {
+			report.Succeeded = false
+		}
+		report.Checks = append(report.Checks, result)
+	}
+
+	return report
+}
+
+func (c *Checker) checkKubernetesVersion(_ context.Context) CheckResult {
+	result := CheckResult{Name: "kubernetes-version"}
+
+	version, err := c.DiscoveryClient.ServerVersion()
+	if err != nil {
+		result.Status = CheckStatusFailed
+		result.Message = fmt.Sprintf("failed discovering the Kubernetes version: %v", err)
+		return result
+	}
+
+	if err := kubernetesversionvalidation.CheckIfSupported(version.GitVersion); err != nil {
+		result.Status = CheckStatusFailed
+		result.Message = err.Error()
+		return result
+	}
+
+	result.Status = CheckStatusPassed
+	result.Message = fmt.Sprintf("kubernetes version %s is supported", version.GitVersion)
+	return result
+}
+
+func (c *Checker) checkNetworking(ctx context.Context) CheckResult {
+	result := CheckResult{Name: "networking"}
+
+	resources, err := c.DiscoveryClient.ServerResourcesForGroupVersion("networking.k8s.io/v1")
+	if err != nil && !apierrors.IsNotFound(err) {
+		result.Status = CheckStatusFailed
+		result.Message = fmt.Sprintf("failed discovering resources in networking.k8s.io/v1: %v", err)
+		return result
+	}
+
+	networkPolicySupported := false
+	if resources != nil {
+		for _, resource := range resources.APIResources {
+			if resource.Name == "networkpolicies" {
+				networkPolicySupported = true
+				break
+			}
+		}
+	}
+
+	if !networkPolicySupported {
+		result.Status = CheckStatusFailed
+		result.Message = "the cluster does not serve networkpolicies.networking.k8s.io/v1, but gardenlet requires a CNI plugin with NetworkPolicy support"
+		return result
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := c.Client.List(ctx, nodeList); err != nil {
+		result.Status = CheckStatusFailed
+		result.Message = fmt.Sprintf("failed listing nodes: %v", err)
+		return result
+	}
+
+	if len(nodeList.Items) == 0 {
+		result.Status = CheckStatusFailed
+		result.Message = "the cluster does not have any nodes"
+		return result
+	}
+
+	var notReadyNodes []string
+	for _, node := range nodeList.Items {
+		if err := health.CheckNode(&node); err != nil {
+			notReadyNodes = append(notReadyNodes, node.Name)
+		}
+	}
+
+	if len(notReadyNodes) > 0 {
+		result.Status = CheckStatusFailed
+		result.Message = fmt.Sprintf("the following nodes are not ready (the CNI plugin might not be functional): %s", strings.Join(notReadyNodes, ", "))
+		return result
+	}
+
+	result.Status = CheckStatusPassed
+	result.Message = fmt.Sprintf("all %d nodes are ready and NetworkPolicy is supported", len(nodeList.Items))
+	return result
+}
+
+func (c *Checker) checkLoadBalancerSupport(ctx context.Context) CheckResult {
+	result := CheckResult{Name: "load-balancer-support"}
+
+	serviceList := &corev1.ServiceList{}
+	if err := c.Client.List(ctx, serviceList); err != nil {
+		result.Status = CheckStatusFailed
+		result.Message = fmt.Sprintf("failed listing services: %v", err)
+		return result
+	}
+
+	var loadBalancerServices, pendingServices int
+	for _, service := range serviceList.Items {
+		if service.Spec.Type != corev1.ServiceTypeLoadBalancer {
+			continue
+		}
+
+		loadBalancerServices++
+		if len(service.Status.LoadBalancer.Ingress) == 0 {
+			pendingServices++
+		}
+	}
+
+	switch {
+	case loadBalancerServices == 0:
+		result.Status = CheckStatusSkipped
+		result.Message = "the cluster does not have any services of type LoadBalancer, cannot verify that the cloud environment supports load balancers"
+	case pendingServices > 0:
+		result.Status = CheckStatusFailed
+		result.Message = fmt.Sprintf("%d of %d services of type LoadBalancer did not get an address assigned", pendingServices, loadBalancerServices)
+	default:
+		result.Status = CheckStatusPassed
+		result.Message = fmt.Sprintf("all %d services of type LoadBalancer got an address assigned", loadBalancerServices)
+	}
+
+	return result
+}
+
+func (c *Checker) checkRequiredCRDs(ctx context.Context) CheckResult {
+	var (
+		result = CheckResult{Name: "required-crds"}
+
+		// gardenlet assumes that a VPA deployment (including its CustomResourceDefinitions) exists in the seed cluster
+		// unless it deploys the VPA itself based on the seed specification. The remaining CustomResourceDefinitions
+		// required for seeds are deployed by gardenlet during the seed reconciliation.
+		requiredCRDNames = []string{"verticalpodautoscalers.autoscaling.k8s.io"}
+	)
+
+	var missingCRDs []string
+	for _, name := range requiredCRDNames {
+		if err := c.Client.Get(ctx, client.ObjectKey{Name: name}, &apiextensionsv1.CustomResourceDefinition{}); err != nil {
+			if !apierrors.IsNotFound(err) {
+				result.Status = CheckStatusFailed
+				result.Message = fmt.Sprintf("failed getting CustomResourceDefinition %s: %v", name, err)
+				return result
+			}
+
+			missingCRDs = append(missingCRDs, name)
+		}
+	}
+
+	if len(missingCRDs) > 0 {
+		result.Status = CheckStatusFailed
+		result.Message = fmt.Sprintf("the following required CustomResourceDefinitions are missing: %s (deploy the vertical-pod-autoscaler or enable .spec.settings.verticalPodAutoscaler in the Seed specification)", strings.Join(missingCRDs, ", "))
+		return result
+	}
+
+	result.Status = CheckStatusPassed
+	result.Message = "all required CustomResourceDefinitions are present"
+	return result
+}
+
+func (c *Checker) checkLeftoverGardenerCRDs(ctx context.Context) CheckResult {
+	result := CheckResult{Name: "leftover-gardener-crds"}
+
+	crdList := &apiextensionsv1.CustomResourceDefinitionList{}
+	if err := c.Client.List(ctx, crdList); err != nil {
+		result.Status = CheckStatusFailed
+		result.Message = fmt.Sprintf("failed listing CustomResourceDefinitions: %v", err)
+		return result
+	}
+
+	var leftoverCRDs []string
+	for _, crd := range crdList.Items {
+		if strings.HasSuffix(crd.Spec.Group, ".gardener.cloud") {
+			leftoverCRDs = append(leftoverCRDs, crd.Name)
+		}
+	}
+
+	if len(leftoverCRDs) > 0 {
+		result.Status = CheckStatusFailed
+		result.Message = fmt.Sprintf("found CustomResourceDefinitions from a previous Gardener installation which must be cleaned up before registration: %s", strings.Join(leftoverCRDs, ", "))
+		return result
+	}
+
+	result.Status = CheckStatusPassed
+	result.Message = "no CustomResourceDefinitions from a previous Gardener installation found"
+	return result
+}
+
+func (c *Checker) checkDNSWildcard(ctx context.Context) CheckResult {
+	result := CheckResult{Name: "dns-wildcard"}
+
+	if c.IngressDomain == "" {
+		result.Status = CheckStatusSkipped
+		result.Message = "no ingress domain provided, cannot verify that the wildcard DNS record resolves"
+		return result
+	}
+
+	// Resolve a random subdomain so that only a wildcard DNS record (and no accidentally existing specific record) can
+	// satisfy the check.
+	randomSubdomain, err := utils.GenerateRandomStringFromCharset(16, "0123456789abcdefghijklmnopqrstuvwxyz")
+	if err != nil {
+		result.Status = CheckStatusFailed
+		result.Message = fmt.Sprintf("failed generating a random subdomain: %v", err)
+		return result
+	}
+
+	host := randomSubdomain + "." + c.IngressDomain
+	if _, err := c.LookupHost(ctx, host); err != nil {
+		result.Status = CheckStatusFailed
+		result.Message = fmt.Sprintf("failed resolving %s, the wildcard DNS record for the ingress domain does not seem to exist: %v", host, err)
+		return result
+	}
+
+	result.Status = CheckStatusPassed
+	result.Message = fmt.Sprintf("the wildcard DNS record for %s resolves", c.IngressDomain)
+	return result
+}
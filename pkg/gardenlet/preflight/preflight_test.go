@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/preflight"
+)
+
+var _ = Describe("Preflight", func() {
+	var (
+		ctx = context.Background()
+
+		fakeClient          client.Client
+		fakeDiscoveryClient *fakediscovery.FakeDiscovery
+		checker             *preflight.Checker
+
+		node    *corev1.Node
+		vpaCRD  *apiextensionsv1.CustomResourceDefinition
+		service *corev1.Service
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeDiscoveryClient = &fakediscovery.FakeDiscovery{Fake: &testing.Fake{}}
+		fakeDiscoveryClient.FakedServerVersion = &version.Info{GitVersion: "v1.31.4"}
+		fakeDiscoveryClient.Resources = []*metav1.APIResourceList{{
+			GroupVersion: "networking.k8s.io/v1",
+			APIResources: []metav1.APIResource{{Name: "networkpolicies"}},
+		}}
+
+		checker = &preflight.Checker{
+			Client:          fakeClient,
+			DiscoveryClient: fakeDiscoveryClient,
+			IngressDomain:   "ingress.seed.example.com",
+			LookupHost: func(_ context.Context, _ string) ([]string, error) {
+				return []string{"1.2.3.4"}, nil
+			},
+		}
+
+		node = &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+			Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+			}},
+		}
+		vpaCRD = &apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "verticalpodautoscalers.autoscaling.k8s.io"},
+			Spec:       apiextensionsv1.CustomResourceDefinitionSpec{Group: "autoscaling.k8s.io"},
+		}
+		service = &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "ingress", Namespace: "default"},
+			Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+			Status: corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{
+				Ingress: []corev1.LoadBalancerIngress{{IP: "1.2.3.4"}},
+			}},
+		}
+	})
+
+	checkResult := func(report preflight.Report, name string, status preflight.CheckStatus) preflight.CheckResult {
+		GinkgoHelper()
+
+		for _, result := range report.Checks {
+			if result.Name == name {
+				Expect(result.Status).To(Equal(status), "check %s should have status %s, message: %s", name, status, result.Message)
+				return result
+			}
+		}
+
+		Fail(fmt.Sprintf("report does not contain a result for check %s", name))
+		return preflight.CheckResult{}
+	}
+
+	Describe("#RunChecks", func() {
+		It("should succeed if the cluster fulfills all requirements", func() {
+			Expect(fakeClient.Create(ctx, node)).To(Succeed())
+			Expect(fakeClient.Create(ctx, vpaCRD)).To(Succeed())
+			Expect(fakeClient.Create(ctx, service)).To(Succeed())
+
+			report := checker.RunChecks(ctx)
+
+			Expect(report.Succeeded).To(BeTrue())
+			checkResult(report, "kubernetes-version", preflight.CheckStatusPassed)
+			checkResult(report, "networking", preflight.CheckStatusPassed)
+			checkResult(report, "load-balancer-support", preflight.CheckStatusPassed)
+			checkResult(report, "required-crds", preflight.CheckStatusPassed)
+			checkResult(report, "leftover-gardener-crds", preflight.CheckStatusPassed)
+			checkResult(report, "dns-wildcard", preflight.CheckStatusPassed)
+		})
+
+		It("should fail if the kubernetes version is not supported", func() {
+			fakeDiscoveryClient.FakedServerVersion.GitVersion = "v1.20.0"
+
+			report := checker.RunChecks(ctx)
+
+			Expect(report.Succeeded).To(BeFalse())
+			result := checkResult(report, "kubernetes-version", preflight.CheckStatusFailed)
+			Expect(result.Message).To(ContainSubstring("unsupported kubernetes version"))
+		})
+
+		It("should fail if the cluster does not support NetworkPolicy", func() {
+			fakeDiscoveryClient.Resources = nil
+
+			report := checker.RunChecks(ctx)
+
+			Expect(report.Succeeded).To(BeFalse())
+			result := checkResult(report, "networking", preflight.CheckStatusFailed)
+			Expect(result.Message).To(ContainSubstring("NetworkPolicy"))
+		})
+
+		It("should fail if a node is not ready", func() {
+			node.Status.Conditions[0].Status = corev1.ConditionFalse
+			Expect(fakeClient.Create(ctx, node)).To(Succeed())
+
+			report := checker.RunChecks(ctx)
+
+			Expect(report.Succeeded).To(BeFalse())
+			result := checkResult(report, "networking", preflight.CheckStatusFailed)
+			Expect(result.Message).To(ContainSubstring("node-1"))
+		})
+
+		It("should skip the load balancer check if there are no LoadBalancer services", func() {
+			report := checker.RunChecks(ctx)
+
+			checkResult(report, "load-balancer-support", preflight.CheckStatusSkipped)
+		})
+
+		It("should fail if a LoadBalancer service did not get an address assigned", func() {
+			service.Status.LoadBalancer.Ingress = nil
+			Expect(fakeClient.Create(ctx, service)).To(Succeed())
+
+			report := checker.RunChecks(ctx)
+
+			Expect(report.Succeeded).To(BeFalse())
+			result := checkResult(report, "load-balancer-support", preflight.CheckStatusFailed)
+			Expect(result.Message).To(ContainSubstring("did not get an address assigned"))
+		})
+
+		It("should fail if a required CRD is missing", func() {
+			report := checker.RunChecks(ctx)
+
+			Expect(report.Succeeded).To(BeFalse())
+			result := checkResult(report, "required-crds", preflight.CheckStatusFailed)
+			Expect(result.Message).To(ContainSubstring("verticalpodautoscalers.autoscaling.k8s.io"))
+		})
+
+		It("should fail if CRDs from a previous Gardener installation exist", func() {
+			Expect(fakeClient.Create(ctx, &apiextensionsv1.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: "extensions.extensions.gardener.cloud"},
+				Spec:       apiextensionsv1.CustomResourceDefinitionSpec{Group: "extensions.gardener.cloud"},
+			})).To(Succeed())
+
+			report := checker.RunChecks(ctx)
+
+			Expect(report.Succeeded).To(BeFalse())
+			result := checkResult(report, "leftover-gardener-crds", preflight.CheckStatusFailed)
+			Expect(result.Message).To(ContainSubstring("extensions.extensions.gardener.cloud"))
+		})
+
+		It("should skip the DNS wildcard check if no ingress domain is provided", func() {
+			checker.IngressDomain = ""
+
+			report := checker.RunChecks(ctx)
+
+			checkResult(report, "dns-wildcard", preflight.CheckStatusSkipped)
+		})
+
+		It("should fail if the wildcard DNS record does not resolve", func() {
+			checker.LookupHost = func(_ context.Context, host string) ([]string, error) {
+				return nil, fmt.Errorf("no such host %s", host)
+			}
+
+			report := checker.RunChecks(ctx)
+
+			Expect(report.Succeeded).To(BeFalse())
+			result := checkResult(report, "dns-wildcard", preflight.CheckStatusFailed)
+			Expect(result.Message).To(ContainSubstring("ingress.seed.example.com"))
+		})
+	})
+})
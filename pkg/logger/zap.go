@@ -6,6 +6,7 @@ package logger
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	"go.uber.org/zap"
@@ -59,3 +60,22 @@ func NewZapLogger(level string, format string, additionalOpts ...logzap.Opts) (l
 
 	return logzap.New(append(opts, additionalOpts...)...), nil
 }
+
+// SamplingConfig contains settings for sampling repeated log entries.
+type SamplingConfig struct {
+	// Initial is the number of log entries with identical level and message which are emitted per second before the
+	// sampler starts dropping entries.
+	Initial int
+	// Thereafter causes every thereafter-th log entry with identical level and message to still be emitted within a
+	// second once Initial entries have been dropped.
+	Thereafter int
+}
+
+// Sampling returns an option for NewZapLogger which rate-limits log entries with identical level and message. This
+// keeps high-frequency controllers from flooding the logging stack when they repeatedly log the same message for many
+// objects, while still letting a sample of the entries through.
+func Sampling(config SamplingConfig) logzap.Opts {
+	return logzap.RawZapOpts(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return zapcore.NewSamplerWithOptions(core, time.Second, config.Initial, config.Thereafter)
+	}))
+}
@@ -45,5 +45,12 @@ var _ = Describe("zap", func() {
 			_, err := NewZapLogger("invalid", FormatText)
 			Expect(err).To(HaveOccurred())
 		})
+
+		It("should return a working logger when sampling is enabled", func() {
+			logger, err := NewZapLogger(InfoLevel, FormatText, Sampling(SamplingConfig{Initial: 1, Thereafter: 10}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(logger.V(0).Enabled()).To(BeTrue())
+			Expect(logger.V(1).Enabled()).To(BeFalse())
+		})
 	})
 })
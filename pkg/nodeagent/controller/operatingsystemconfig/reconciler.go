@@ -219,6 +219,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		)
 	}
 
+	staticPodFiles := changedStaticPodFiles(oscChanges)
+
 	log.Info("Applying new or changed inline files")
 	if err := r.applyChangedInlineFiles(log, oscChanges); err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed applying changed inline files: %w", err)
@@ -280,6 +282,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("failed removing deleted files: %w", err)
 	}
 
+	if node != nil && len(staticPodFiles) > 0 {
+		log.Info("Verifying health of changed static pods", "count", len(staticPodFiles))
+		if err := r.verifyStaticPods(ctx, log, node, staticPodFiles); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed verifying static pods: %w", err)
+		}
+	}
+
 	if err := r.performInPlaceUpdate(ctx, log, osc, oscChanges, node, osVersion); err != nil {
 		// If the error is retriable, we requeue with a delay.
 		if retriableErrorPatternRegex.MatchString(err.Error()) {
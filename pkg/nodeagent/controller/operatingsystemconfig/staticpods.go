@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
+	kubeletcomponent "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
+	"github.com/gardener/gardener/pkg/gardenadm/staticpod"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	retryutils "github.com/gardener/gardener/pkg/utils/retry"
+)
+
+var (
+	// StaticPodHealthCheckRetryInterval is the interval at which the static pod health check is retried. Exposed for testing.
+	StaticPodHealthCheckRetryInterval = 5 * time.Second
+	// StaticPodHealthCheckRetryTimeout is the timeout after which the static pod health check is considered failed. Exposed for testing.
+	StaticPodHealthCheckRetryTimeout = 5 * time.Minute
+)
+
+// changedStaticPodFiles returns the changed files which declare static pod manifests, i.e., inline files below the
+// kubelet's static pod path. They must be collected before the file changes are applied (applying a change removes it
+// from the changes).
+func changedStaticPodFiles(changes *operatingSystemConfigChanges) []extensionsv1alpha1.File {
+	var files []extensionsv1alpha1.File
+
+	for _, file := range changes.Files.Changed {
+		if filepath.Dir(file.Path) == kubeletcomponent.FilePathKubernetesManifests && file.Content.Inline != nil {
+			files = append(files, file)
+		}
+	}
+
+	return files
+}
+
+// verifyStaticPods waits until the kubelet has picked up the changed static pod manifests, i.e., until the mirror pods
+// run with the desired manifest hashes and report ready.
+func (r *Reconciler) verifyStaticPods(ctx context.Context, log logr.Logger, node *corev1.Node, files []extensionsv1alpha1.File) error {
+	for _, file := range files {
+		pod, err := decodeStaticPodManifest(file)
+		if err != nil {
+			return fmt.Errorf("failed decoding static pod manifest %q: %w", file.Path, err)
+		}
+
+		if err := r.waitForStaticPodHealthy(ctx, log, node, pod); err != nil {
+			return fmt.Errorf("failed waiting for static pod of manifest %q to become healthy: %w", file.Path, err)
+		}
+	}
+
+	return nil
+}
+
+func decodeStaticPodManifest(file extensionsv1alpha1.File) (*corev1.Pod, error) {
+	data, err := extensionsv1alpha1helper.Decode(file.Content.Inline.Encoding, []byte(file.Content.Inline.Data))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode data: %w", err)
+	}
+
+	pod := &corev1.Pod{}
+	if err := yaml.Unmarshal(data, pod); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal manifest into pod: %w", err)
+	}
+
+	if pod.Namespace == "" {
+		// the kubelet places mirror pods for manifests without a namespace into the "default" namespace
+		pod.Namespace = metav1.NamespaceDefault
+	}
+
+	return pod, nil
+}
+
+func (r *Reconciler) waitForStaticPodHealthy(ctx context.Context, log logr.Logger, node *corev1.Node, pod *corev1.Pod) error {
+	var (
+		mirrorPodKey = client.ObjectKey{Namespace: pod.Namespace, Name: pod.Name + "-" + node.Name}
+		expectedHash = pod.Annotations[staticpod.AnnotationKeyHash]
+	)
+
+	log.Info("Waiting for mirror pod of static pod to become healthy", "mirrorPod", mirrorPodKey)
+
+	return retryutils.UntilTimeout(ctx, StaticPodHealthCheckRetryInterval, StaticPodHealthCheckRetryTimeout, func(ctx context.Context) (bool, error) {
+		mirrorPod := &corev1.Pod{}
+		if err := r.Client.Get(ctx, mirrorPodKey, mirrorPod); err != nil {
+			// The kube-apiserver itself might run as static pod on this node and restart while its manifest is updated,
+			// hence, we should tolerate that it is temporarily not available.
+			return retryutils.MinorError(fmt.Errorf("failed getting mirror pod %s: %w", mirrorPodKey, err))
+		}
+
+		if expectedHash != "" && mirrorPod.Annotations[staticpod.AnnotationKeyHash] != expectedHash {
+			return retryutils.MinorError(fmt.Errorf("mirror pod %s does not yet run with the desired manifest hash", mirrorPodKey))
+		}
+
+		if err := health.CheckPod(mirrorPod); err != nil {
+			return retryutils.MinorError(fmt.Errorf("mirror pod %s is not healthy yet: %w", mirrorPodKey, err))
+		}
+
+		if !health.IsPodReady(mirrorPod) {
+			return retryutils.MinorError(fmt.Errorf("mirror pod %s is not ready yet", mirrorPodKey))
+		}
+
+		log.Info("Mirror pod of static pod is healthy", "mirrorPod", mirrorPodKey)
+		return retryutils.Ok()
+	})
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenadm/staticpod"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("StaticPods", func() {
+	var (
+		ctx        context.Context
+		log        logr.Logger
+		fakeClient client.Client
+		reconciler *Reconciler
+
+		node *corev1.Node
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		log = logr.Discard()
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+		reconciler = &Reconciler{Client: fakeClient}
+
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-0"}}
+
+		DeferCleanup(test.WithVars(
+			&StaticPodHealthCheckRetryInterval, time.Millisecond,
+			&StaticPodHealthCheckRetryTimeout, 50*time.Millisecond,
+		))
+	})
+
+	Describe("#changedStaticPodFiles", func() {
+		It("should only return inline files below the static pod path", func() {
+			changes := &operatingSystemConfigChanges{Files: files{Changed: []extensionsv1alpha1.File{
+				{Path: "/etc/kubernetes/manifests/kube-apiserver.yaml", Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{}}},
+				{Path: "/etc/kubernetes/manifests/etcd.yaml", Content: extensionsv1alpha1.FileContent{ImageRef: &extensionsv1alpha1.FileContentImageRef{}}},
+				{Path: "/var/lib/foo/bar.yaml", Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{}}},
+			}}}
+
+			Expect(changedStaticPodFiles(changes)).To(HaveExactElements(changes.Files.Changed[0]))
+		})
+	})
+
+	Describe("#verifyStaticPods", func() {
+		var (
+			manifest  extensionsv1alpha1.File
+			mirrorPod *corev1.Pod
+		)
+
+		BeforeEach(func() {
+			manifest = extensionsv1alpha1.File{
+				Path: "/etc/kubernetes/manifests/kube-apiserver.yaml",
+				Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: `apiVersion: v1
+kind: Pod
+metadata:
+  name: kube-apiserver
+  namespace: kube-system
+  annotations:
+    gardener.cloud/config.mirror: hash123
+spec:
+  containers:
+  - name: kube-apiserver
+    image: kube-apiserver
+`}},
+			}
+
+			mirrorPod = &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "kube-apiserver-node-0",
+					Namespace:   "kube-system",
+					Annotations: map[string]string{staticpod.AnnotationKeyHash: "hash123"},
+				},
+				Status: corev1.PodStatus{
+					Phase:      corev1.PodRunning,
+					Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+				},
+			}
+		})
+
+		It("should succeed if the mirror pod runs with the desired hash and is ready", func() {
+			Expect(fakeClient.Create(ctx, mirrorPod)).To(Succeed())
+
+			Expect(reconciler.verifyStaticPods(ctx, log, node, []extensionsv1alpha1.File{manifest})).To(Succeed())
+		})
+
+		It("should fail if the mirror pod does not exist", func() {
+			Expect(reconciler.verifyStaticPods(ctx, log, node, []extensionsv1alpha1.File{manifest})).To(MatchError(ContainSubstring("failed getting mirror pod")))
+		})
+
+		It("should fail if the mirror pod does not run with the desired hash", func() {
+			mirrorPod.Annotations[staticpod.AnnotationKeyHash] = "outdated"
+			Expect(fakeClient.Create(ctx, mirrorPod)).To(Succeed())
+
+			Expect(reconciler.verifyStaticPods(ctx, log, node, []extensionsv1alpha1.File{manifest})).To(MatchError(ContainSubstring("does not yet run with the desired manifest hash")))
+		})
+
+		It("should fail if the mirror pod is not ready", func() {
+			mirrorPod.Status.Conditions[0].Status = corev1.ConditionFalse
+			Expect(fakeClient.Create(ctx, mirrorPod)).To(Succeed())
+
+			Expect(reconciler.verifyStaticPods(ctx, log, node, []extensionsv1alpha1.File{manifest})).To(MatchError(ContainSubstring("is not ready yet")))
+		})
+
+		It("should fail if the manifest cannot be decoded", func() {
+			manifest.Content.Inline.Data = "{invalid"
+
+			Expect(reconciler.verifyStaticPods(ctx, log, node, []extensionsv1alpha1.File{manifest})).To(MatchError(ContainSubstring("failed decoding static pod manifest")))
+		})
+	})
+})
@@ -44,6 +44,10 @@ type OperatorConfiguration struct {
 	// NodeToleration contains optional settings for default tolerations.
 	// +optional
 	NodeToleration *NodeTolerationConfiguration `json:"nodeToleration,omitempty"`
+	// ImageVectorVerification contains optional settings for verifying the cosign signatures of all images in the
+	// image vectors at start-up.
+	// +optional
+	ImageVectorVerification *ImageVectorVerification `json:"imageVectorVerification,omitempty"`
 }
 
 // ConditionThreshold defines the threshold of the given condition type.
@@ -181,6 +185,13 @@ type Server struct {
 	Port int `json:"port"`
 }
 
+// ImageVectorVerification contains settings for verifying the cosign signatures of all images in the image vectors.
+type ImageVectorVerification struct {
+	// PublicKeys is a list of PEM-encoded public keys. For each image, at least one signature must verify against one
+	// of the configured keys.
+	PublicKeys []string `json:"publicKeys,omitempty"`
+}
+
 // NodeTolerationConfiguration contains information about node toleration options.
 type NodeTolerationConfiguration struct {
 	// DefaultNotReadyTolerationSeconds specifies the seconds for the `node.kubernetes.io/not-ready` toleration that
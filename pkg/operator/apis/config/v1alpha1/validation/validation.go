@@ -5,6 +5,7 @@
 package validation
 
 import (
+	"fmt"
 	"time"
 
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -16,6 +17,7 @@ import (
 
 	"github.com/gardener/gardener/pkg/logger"
 	operatorconfigv1alpha1 "github.com/gardener/gardener/pkg/operator/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
 )
 
@@ -37,6 +39,7 @@ func ValidateOperatorConfiguration(conf *operatorconfigv1alpha1.OperatorConfigur
 
 	allErrs = append(allErrs, validateControllerConfiguration(conf.Controllers, field.NewPath("controllers"))...)
 	allErrs = append(allErrs, validateNodeTolerationConfiguration(conf.NodeToleration, field.NewPath("nodeToleration"))...)
+	allErrs = append(allErrs, validateImageVectorVerification(conf.ImageVectorVerification, field.NewPath("imageVectorVerification"))...)
 
 	return allErrs
 }
@@ -122,3 +125,23 @@ func validateNodeTolerationConfiguration(conf *operatorconfigv1alpha1.NodeTolera
 
 	return allErrs
 }
+
+func validateImageVectorVerification(conf *operatorconfigv1alpha1.ImageVectorVerification, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf == nil {
+		return allErrs
+	}
+
+	if len(conf.PublicKeys) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("publicKeys"), "must provide at least one public key"))
+	}
+
+	for i, publicKey := range conf.PublicKeys {
+		if _, err := imagevector.ParsePublicKey(publicKey); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("publicKeys").Index(i), publicKey, fmt.Sprintf("must contain a valid PEM-encoded public key: %v", err)))
+		}
+	}
+
+	return allErrs
+}
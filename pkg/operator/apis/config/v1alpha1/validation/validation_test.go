@@ -292,4 +292,50 @@ var _ = Describe("#ValidateOperatorConfiguration", func() {
 			)
 		})
 	})
+
+	Context("imageVectorVerification", func() {
+		It("should pass with unset image vector verification", func() {
+			conf.ImageVectorVerification = nil
+
+			Expect(ValidateOperatorConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should pass with a valid public key", func() {
+			conf.ImageVectorVerification = &operatorconfigv1alpha1.ImageVectorVerification{
+				PublicKeys: []string{validPublicKey},
+			}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should fail without public keys", func() {
+			conf.ImageVectorVerification = &operatorconfigv1alpha1.ImageVectorVerification{}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("imageVectorVerification.publicKeys"),
+				})),
+			))
+		})
+
+		It("should fail with an invalid public key", func() {
+			conf.ImageVectorVerification = &operatorconfigv1alpha1.ImageVectorVerification{
+				PublicKeys: []string{validPublicKey, "foo"},
+			}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("imageVectorVerification.publicKeys[1]"),
+				})),
+			))
+		})
+	})
 })
+
+const validPublicKey = `-----BEGIN PUBLIC KEY-----
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAENTjPyB4Je5fEmXwHwWtDjMiLJTSR
+vlPLHf3ihCU3ZZ4jtyBj3rRS6ZGQQZRk/lSSLA8gho2s/habeFa2UVSjIQ==
+-----END PUBLIC KEY-----
+`
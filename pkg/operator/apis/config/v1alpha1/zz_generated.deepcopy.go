@@ -230,6 +230,27 @@ func (in *GardenletDeployerControllerConfig) DeepCopy() *GardenletDeployerContro
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageVectorVerification) DeepCopyInto(out *ImageVectorVerification) {
+	*out = *in
+	if in.PublicKeys != nil {
+		in, out := &in.PublicKeys, &out.PublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageVectorVerification.
+func (in *ImageVectorVerification) DeepCopy() *ImageVectorVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageVectorVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkPolicyControllerConfiguration) DeepCopyInto(out *NetworkPolicyControllerConfiguration) {
 	*out = *in
@@ -310,6 +331,11 @@ func (in *OperatorConfiguration) DeepCopyInto(out *OperatorConfiguration) {
 		*out = new(NodeTolerationConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ImageVectorVerification != nil {
+		in, out := &in.ImageVectorVerification, &out.ImageVectorVerification
+		*out = new(ImageVectorVerification)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -755,14 +755,28 @@ func gardenerAPIServerAutoscalingConfig(garden *operatorv1alpha1.Garden) gardene
 		replicas = 3
 	}
 
+	var minAllowed corev1.ResourceList
+
+	if apiServer := garden.Spec.VirtualCluster.Gardener.APIServer; apiServer != nil && apiServer.Autoscaling != nil {
+		autoscaling := apiServer.Autoscaling
+		if autoscaling.Replicas != nil {
+			replicas = *autoscaling.Replicas
+		}
+
+		minAllowed = autoscaling.MinAllowed
+	}
+
 	return gardenerapiserver.AutoscalingConfig{
 		APIServerResources: corev1.ResourceRequirements{
-			Requests: corev1.ResourceList{
+			Requests: kubernetesutils.MaximumResourcesFromResourceList(corev1.ResourceList{
 				corev1.ResourceCPU:    resource.MustParse("600m"),
 				corev1.ResourceMemory: resource.MustParse("512Mi"),
 			},
+				minAllowed,
+			),
 		},
-		Replicas: ptr.To(replicas),
+		Replicas:   ptr.To(replicas),
+		MinAllowed: minAllowed,
 	}
 }
 
@@ -917,6 +931,7 @@ func (r *Reconciler) newIstio(ctx context.Context, garden *operatorv1alpha1.Gard
 		false,
 		garden.Spec.RuntimeCluster.Provider.Zones,
 		len(garden.Spec.RuntimeCluster.Networking.IPFamilies) == 2,
+		nil,
 		r.RuntimeVersion,
 	)
 }
@@ -1204,6 +1219,7 @@ func (r *Reconciler) newGardenerControllerManager(garden *operatorv1alpha1.Garde
 
 	if config := garden.Spec.VirtualCluster.Gardener.ControllerManager; config != nil {
 		values.FeatureGates = config.FeatureGates
+		values.Resources = config.Resources
 		if config.LogLevel != nil {
 			values.LogLevel = *config.LogLevel
 		}
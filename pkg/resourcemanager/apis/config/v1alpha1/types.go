@@ -182,6 +182,33 @@ type NetworkPolicyControllerConfig struct {
 	// the respective ingress/egress traffic for the backends exposed by the Ingresses.
 	// +optional
 	IngressControllerSelector *IngressControllerSelector `json:"ingressControllerSelector,omitempty"`
+	// CIDRGroups is a list of named CIDR groups which services can reference via the
+	// `networking.resources.gardener.cloud/from-cidr-group-<name>-allowed-ports` annotation in order to allow ingress
+	// traffic from the grouped CIDRs. This way, frequently used source ranges (e.g., corporate proxies) can be
+	// maintained centrally in this configuration instead of in every component.
+	// +optional
+	CIDRGroups []CIDRGroup `json:"cidrGroups,omitempty"`
+	// AdditionalIngressSources is a list of pod selectors and namespaces whose pods shall be allowed as additional
+	// ingress sources in all NetworkPolicies created by this controller. This way, operators can register custom
+	// clients (e.g., audit or scanning appliances) without editing every component.
+	// +optional
+	AdditionalIngressSources []NetworkPolicySource `json:"additionalIngressSources,omitempty"`
+}
+
+// CIDRGroup is a named group of CIDRs.
+type CIDRGroup struct {
+	// Name is the name of the CIDR group.
+	Name string `json:"name"`
+	// CIDRs is the list of CIDRs in this group.
+	CIDRs []string `json:"cidrs"`
+}
+
+// NetworkPolicySource contains the pod selector and namespace for an additional ingress source.
+type NetworkPolicySource struct {
+	// Namespace is the name of the namespace in which the source pods run.
+	Namespace string `json:"namespace"`
+	// PodSelector is the selector for the source pods.
+	PodSelector metav1.LabelSelector `json:"podSelector"`
 }
 
 // IngressControllerSelector contains the pod selector and namespace for an ingress controller.
@@ -211,6 +238,10 @@ type NodeCriticalComponentsControllerConfig struct {
 	// Backoff is the duration to use as backoff when Nodes have non-ready node-critical pods (defaults to 10s).
 	// +optional
 	Backoff *metav1.Duration `json:"backoff,omitempty"`
+	// AdditionalNamespaces is a list of namespaces besides kube-system in which node-critical DaemonSets and Pods are
+	// considered, e.g. for registering node-critical components managed by extensions.
+	// +optional
+	AdditionalNamespaces []string `json:"additionalNamespaces,omitempty"`
 }
 
 // NodeAgentReconciliationDelayControllerConfig is the configuration for the node-agent reconciliation delay controller.
@@ -243,6 +274,8 @@ type ResourceManagerWebhookConfiguration struct {
 	PodKubeAPIServerLoadBalancing PodKubeAPIServerLoadBalancingWebhookConfig `json:"podKubeAPIServerLoadBalancing"`
 	// PodSchedulerName is the configuration for the pod-scheduler-name webhook.
 	PodSchedulerName PodSchedulerNameWebhookConfig `json:"podSchedulerName"`
+	// PodTopology is the configuration for the pod-topology webhook.
+	PodTopology PodTopologyWebhookConfig `json:"podTopology"`
 	// PodTopologySpreadConstraints is the configuration for the pod-topology-spread-constraints webhook.
 	PodTopologySpreadConstraints PodTopologySpreadConstraintsWebhookConfig `json:"podTopologySpreadConstraints"`
 	// ProjectedTokenMount is the configuration for the projected-token-mount webhook.
@@ -285,6 +318,10 @@ type HighAvailabilityConfigWebhookConfig struct {
 	// should be added to pods not already tolerating this taint.
 	// +optional
 	DefaultUnreachableTolerationSeconds *int64 `json:"defaultUnreachableTolerationSeconds,omitempty"`
+	// CustomFailureDomains is a list of additional node topology keys (e.g. hypervisor anti-affinity labels provided
+	// by providers) over which replicas of high-availability components should be spread.
+	// +optional
+	CustomFailureDomains []string `json:"customFailureDomains,omitempty"`
 }
 
 // KubernetesServiceHostWebhookConfig is the configuration for the kubernetes-service-host webhook.
@@ -325,6 +362,21 @@ type PodSchedulerNameWebhookConfig struct {
 	SchedulerName *string `json:"schedulerName,omitempty"`
 }
 
+// PodTopologyWebhookConfig is the configuration for the pod-topology webhook.
+type PodTopologyWebhookConfig struct {
+	// Enabled defines whether this webhook is enabled.
+	Enabled bool `json:"enabled"`
+	// Zone is the availability zone that is injected into pods.
+	// +optional
+	Zone *string `json:"zone,omitempty"`
+	// Region is the region that is injected into pods.
+	// +optional
+	Region *string `json:"region,omitempty"`
+	// SeedName is the name of the seed that is injected into pods.
+	// +optional
+	SeedName *string `json:"seedName,omitempty"`
+}
+
 // PodTopologySpreadConstraintsWebhookConfig is the configuration for the pod-topology-spread-constraints webhook.
 type PodTopologySpreadConstraintsWebhookConfig struct {
 	// Enabled defines whether this webhook is enabled.
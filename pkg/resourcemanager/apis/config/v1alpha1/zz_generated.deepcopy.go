@@ -16,6 +16,27 @@ import (
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CIDRGroup) DeepCopyInto(out *CIDRGroup) {
+	*out = *in
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CIDRGroup.
+func (in *CIDRGroup) DeepCopy() *CIDRGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(CIDRGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CRDDeletionProtection) DeepCopyInto(out *CRDDeletionProtection) {
 	*out = *in
@@ -195,6 +216,11 @@ func (in *HighAvailabilityConfigWebhookConfig) DeepCopyInto(out *HighAvailabilit
 		*out = new(int64)
 		**out = **in
 	}
+	if in.CustomFailureDomains != nil {
+		in, out := &in.CustomFailureDomains, &out.CustomFailureDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -297,6 +323,20 @@ func (in *NetworkPolicyControllerConfig) DeepCopyInto(out *NetworkPolicyControll
 		*out = new(IngressControllerSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CIDRGroups != nil {
+		in, out := &in.CIDRGroups, &out.CIDRGroups
+		*out = make([]CIDRGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdditionalIngressSources != nil {
+		in, out := &in.AdditionalIngressSources, &out.AdditionalIngressSources
+		*out = make([]NetworkPolicySource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -310,6 +350,23 @@ func (in *NetworkPolicyControllerConfig) DeepCopy() *NetworkPolicyControllerConf
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicySource) DeepCopyInto(out *NetworkPolicySource) {
+	*out = *in
+	in.PodSelector.DeepCopyInto(&out.PodSelector)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicySource.
+func (in *NetworkPolicySource) DeepCopy() *NetworkPolicySource {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicySource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeAgentAuthorizerWebhookConfig) DeepCopyInto(out *NodeAgentAuthorizerWebhookConfig) {
 	*out = *in
@@ -375,6 +432,11 @@ func (in *NodeCriticalComponentsControllerConfig) DeepCopyInto(out *NodeCritical
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.AdditionalNamespaces != nil {
+		in, out := &in.AdditionalNamespaces, &out.AdditionalNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -441,6 +503,37 @@ func (in *PodTopologySpreadConstraintsWebhookConfig) DeepCopy() *PodTopologySpre
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodTopologyWebhookConfig) DeepCopyInto(out *PodTopologyWebhookConfig) {
+	*out = *in
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.SeedName != nil {
+		in, out := &in.SeedName, &out.SeedName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodTopologyWebhookConfig.
+func (in *PodTopologyWebhookConfig) DeepCopy() *PodTopologyWebhookConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PodTopologyWebhookConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectedTokenMountWebhookConfig) DeepCopyInto(out *ProjectedTokenMountWebhookConfig) {
 	*out = *in
@@ -547,6 +640,7 @@ func (in *ResourceManagerWebhookConfiguration) DeepCopyInto(out *ResourceManager
 	in.SystemComponentsConfig.DeepCopyInto(&out.SystemComponentsConfig)
 	out.PodKubeAPIServerLoadBalancing = in.PodKubeAPIServerLoadBalancing
 	in.PodSchedulerName.DeepCopyInto(&out.PodSchedulerName)
+	in.PodTopology.DeepCopyInto(&out.PodTopology)
 	out.PodTopologySpreadConstraints = in.PodTopologySpreadConstraints
 	in.ProjectedTokenMount.DeepCopyInto(&out.ProjectedTokenMount)
 	in.NodeAgentAuthorizer.DeepCopyInto(&out.NodeAgentAuthorizer)
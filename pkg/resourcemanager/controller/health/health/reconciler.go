@@ -23,6 +23,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	resourcesv1alpha1helper "github.com/gardener/gardener/pkg/apis/resources/v1alpha1/helper"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/resourcemanager/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/health/utils"
@@ -99,8 +100,15 @@ func (r *Reconciler) executeHealthChecks(ctx context.Context, log logr.Logger, m
 			objectGVK = ref.GroupVersionKind()
 			objectKey = client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}
 			objectLog = log.WithValues("object", objectKey, "objectGVK", objectGVK)
+
+			healthCheckMode = resourcesv1alpha1helper.HealthCheckModeFor(mr.Spec.HealthCheckPolicies, objectGVK.GroupKind())
 		)
 
+		if healthCheckMode == resourcesv1alpha1.HealthCheckModeIgnore {
+			objectLog.V(1).Info("Skipping health check because of health check policy", "mode", healthCheckMode)
+			continue
+		}
+
 		obj, err := newObjectForHealthCheck(objectLog, r.TargetScheme, objectGVK)
 		if err != nil {
 			return reconcile.Result{}, fmt.Errorf("failed to construct new object for reference: %w", err)
@@ -123,6 +131,12 @@ func (r *Reconciler) executeHealthChecks(ctx context.Context, log logr.Logger, m
 			if meta.IsNoMatchError(err) {
 				message = fmt.Sprintf("%s: %v", message, err)
 			}
+
+			if healthCheckMode == resourcesv1alpha1.HealthCheckModeObserve {
+				objectLog.Info("Not reflecting missing object in condition because of health check policy", "mode", healthCheckMode, "reason", reason, "message", message)
+				continue
+			}
+
 			objectLog.Info("Finished ManagedResource health checks", "status", "unhealthy", "reason", reason, "message", message)
 
 			conditionResourcesHealthy = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesHealthy, gardencorev1beta1.ConditionFalse, reason, message)
@@ -158,6 +172,11 @@ func (r *Reconciler) executeHealthChecks(ctx context.Context, log logr.Logger, m
 				objectLog.Error(err, "Error executing health check for object")
 			}
 
+			if healthCheckMode == resourcesv1alpha1.HealthCheckModeObserve {
+				objectLog.Info("Not reflecting unhealthy object in condition because of health check policy", "mode", healthCheckMode, "reason", reason, "message", message)
+				continue
+			}
+
 			conditionResourcesHealthy = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesHealthy, gardencorev1beta1.ConditionFalse, reason, message)
 			mr.Status.Conditions = v1beta1helper.MergeConditions(mr.Status.Conditions, conditionResourcesHealthy)
 			if err := r.SourceClient.Status().Update(ctx, mr); err != nil {
@@ -7,8 +7,6 @@ package utils
 import (
 	"context"
 
-	certv1alpha1 "github.com/gardener/cert-management/pkg/apis/cert/v1alpha1"
-	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -17,7 +15,6 @@ import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
-	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
@@ -47,7 +44,8 @@ func CheckHealth(obj client.Object) (bool, error) {
 	// As we don't use unstructured objects in the health controller we don't need to convert to typed objects anymore and
 	// can use the typed objects directly.
 
-	// When adding new types for dedicated health checks here, make sure that they are registered in the scheme for the
+	// Health checks for additional types (in particular custom resources) can be registered via health.RegisterFunc
+	// instead of adding new cases here. In both cases, make sure that the types are registered in the scheme for the
 	// target cluster client, see pkg/resourcemanager/cmd/target.go
 	switch o := obj.(type) {
 	case *apiextensionsv1.CustomResourceDefinition:
@@ -80,19 +78,9 @@ func CheckHealth(obj client.Object) (bool, error) {
 		return true, health.CheckService(o)
 	case *appsv1.StatefulSet:
 		return true, health.CheckStatefulSet(o)
-	case *monitoringv1.Prometheus:
-		return true, health.CheckPrometheus(o)
-	case *monitoringv1.Alertmanager:
-		return true, health.CheckAlertmanager(o)
-	case *vpaautoscalingv1.VerticalPodAutoscaler:
-		return true, health.CheckVerticalPodAutoscaler(o)
-	case *certv1alpha1.Certificate:
-		return true, health.CheckCertificate(o)
-	case *certv1alpha1.Issuer:
-		return true, health.CheckCertificateIssuer(o)
 	}
 
-	return false, nil
+	return health.CheckRegisteredObject(obj)
 }
 
 // FetchAdditionalFailureMessage fetches warning event messages for some objects as additional failure information.
@@ -32,7 +32,10 @@ import (
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
-var fromPolicyRegexp = regexp.MustCompile(resourcesv1alpha1.NetworkPolicyFromPolicyAnnotationPrefix + "(.*)" + resourcesv1alpha1.NetworkPolicyFromPolicyAnnotationSuffix)
+var (
+	fromPolicyRegexp    = regexp.MustCompile(resourcesv1alpha1.NetworkPolicyFromPolicyAnnotationPrefix + "(.*)" + resourcesv1alpha1.NetworkPolicyFromPolicyAnnotationSuffix)
+	fromCIDRGroupRegexp = regexp.MustCompile(resourcesv1alpha1.NetworkPolicyFromCIDRGroupAnnotationPrefix + "(.*)" + resourcesv1alpha1.NetworkPolicyFromPolicyAnnotationSuffix)
+)
 
 // Reconciler reconciles Service objects and creates NetworkPolicy objects.
 type Reconciler struct {
@@ -214,6 +217,28 @@ func (r *Reconciler) reconcileDesiredPolicies(ctx context.Context, log logr.Logg
 	}
 
 	for k, allowedPorts := range service.Annotations {
+		if match := fromCIDRGroupRegexp.FindStringSubmatch(k); len(match) == 2 {
+			groupName := match[1]
+
+			group, err := r.cidrGroup(groupName)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			var ports []networkingv1.NetworkPolicyPort
+			if err := json.Unmarshal([]byte(allowedPorts), &ports); err != nil {
+				return nil, nil, fmt.Errorf("failed unmarshalling %s: %w", allowedPorts, err)
+			}
+
+			objectMeta := metav1.ObjectMeta{Name: "ingress-to-" + service.Name + "-from-cidr-group-" + groupName, Namespace: service.Namespace}
+			desiredObjectMetaKeys = append(desiredObjectMetaKeys, key(objectMeta))
+			taskFns = append(taskFns, func(ctx context.Context) error {
+				return r.reconcileIngressFromCIDRGroupPolicy(ctx, service, group, ports, objectMeta)
+			})
+
+			continue
+		}
+
 		match := fromPolicyRegexp.FindStringSubmatch(k)
 		if len(match) != 2 {
 			continue
@@ -286,11 +311,20 @@ func (r *Reconciler) reconcileIngressPolicy(
 			"ingress %s traffic to port %s for pods selected by the %s service selector from pods running in namespace %s labeled "+
 			"with %s.", *port.Protocol, port.Port.String(), client.ObjectKeyFromObject(service), namespaceName, podLabelSelector))
 
+		from := []networkingv1.NetworkPolicyPeer{{
+			PodSelector:       &podLabelSelector,
+			NamespaceSelector: ingressNamespaceSelectorFor(service.Namespace, namespaceName),
+		}}
+
+		for _, source := range r.Config.AdditionalIngressSources {
+			from = append(from, networkingv1.NetworkPolicyPeer{
+				PodSelector:       source.PodSelector.DeepCopy(),
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{corev1.LabelMetadataName: source.Namespace}},
+			})
+		}
+
 		networkPolicy.Spec.Ingress = []networkingv1.NetworkPolicyIngressRule{{
-			From: []networkingv1.NetworkPolicyPeer{{
-				PodSelector:       &podLabelSelector,
-				NamespaceSelector: ingressNamespaceSelectorFor(service.Namespace, namespaceName),
-			}},
+			From:  from,
 			Ports: []networkingv1.NetworkPolicyPort{port},
 		}}
 		networkPolicy.Spec.Egress = nil
@@ -379,6 +413,40 @@ func (r *Reconciler) reconcileIngressFromWorldPolicy(ctx context.Context, servic
 	return err
 }
 
+func (r *Reconciler) reconcileIngressFromCIDRGroupPolicy(ctx context.Context, service *corev1.Service, group resourcemanagerconfigv1alpha1.CIDRGroup, ports []networkingv1.NetworkPolicyPort, networkPolicyObjectMeta metav1.ObjectMeta) error {
+	var peers []networkingv1.NetworkPolicyPeer
+	for _, cidr := range group.CIDRs {
+		peers = append(peers, networkingv1.NetworkPolicyPeer{IPBlock: &networkingv1.IPBlock{CIDR: cidr}})
+	}
+
+	networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: networkPolicyObjectMeta}
+	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, r.TargetClient, networkPolicy, func() error {
+		metav1.SetMetaDataLabel(&networkPolicy.ObjectMeta, resourcesv1alpha1.NetworkingServiceName, service.Name)
+		metav1.SetMetaDataLabel(&networkPolicy.ObjectMeta, resourcesv1alpha1.NetworkingServiceNamespace, service.Namespace)
+
+		metav1.SetMetaDataAnnotation(&networkPolicy.ObjectMeta, v1beta1constants.GardenerDescription, fmt.Sprintf("Allows "+
+			"ingress traffic from CIDR group %q (%v) to ports %v for pods selected by the %s service selector.", group.Name,
+			group.CIDRs, portAndProtocolOf(ports), client.ObjectKeyFromObject(service)))
+
+		networkPolicy.Spec.Ingress = []networkingv1.NetworkPolicyIngressRule{{From: peers, Ports: ports}}
+		networkPolicy.Spec.Egress = nil
+		networkPolicy.Spec.PodSelector = metav1.LabelSelector{MatchLabels: service.Spec.Selector}
+		networkPolicy.Spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}
+
+		return nil
+	}, controllerutils.SkipEmptyPatch{})
+	return err
+}
+
+func (r *Reconciler) cidrGroup(name string) (resourcemanagerconfigv1alpha1.CIDRGroup, error) {
+	for _, group := range r.Config.CIDRGroups {
+		if group.Name == name {
+			return group, nil
+		}
+	}
+	return resourcemanagerconfigv1alpha1.CIDRGroup{}, fmt.Errorf("CIDR group %q is not defined in the NetworkPolicy controller configuration", name)
+}
+
 func portAndProtocolOf(ports []networkingv1.NetworkPolicyPort) []string {
 	var result []string
 	for _, v := range ports {
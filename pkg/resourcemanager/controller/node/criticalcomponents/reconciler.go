@@ -59,18 +59,27 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	}
 
 	// prep for checks: list all DaemonSets and all node-critical pods on the given node
-	daemonSetList := &appsv1.DaemonSetList{}
-	if err := r.TargetClient.List(ctx, daemonSetList, client.InNamespace(metav1.NamespaceSystem), client.MatchingLabels{v1beta1constants.LabelNodeCriticalComponent: "true"}); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed listing node-critical DaemonSets on node: %w", err)
-	}
+	var (
+		daemonSets []appsv1.DaemonSet
+		pods       []corev1.Pod
+	)
+
+	for _, namespace := range r.namespaces() {
+		daemonSetList := &appsv1.DaemonSetList{}
+		if err := r.TargetClient.List(ctx, daemonSetList, client.InNamespace(namespace), client.MatchingLabels{v1beta1constants.LabelNodeCriticalComponent: "true"}); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed listing node-critical DaemonSets on node: %w", err)
+		}
+		daemonSets = append(daemonSets, daemonSetList.Items...)
 
-	podList := &corev1.PodList{}
-	if err := r.TargetClient.List(ctx, podList, client.InNamespace(metav1.NamespaceSystem), client.MatchingFields{indexer.PodNodeName: node.Name}, client.MatchingLabels{v1beta1constants.LabelNodeCriticalComponent: "true"}); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed listing node-critical Pods on node: %w", err)
+		podList := &corev1.PodList{}
+		if err := r.TargetClient.List(ctx, podList, client.InNamespace(namespace), client.MatchingFields{indexer.PodNodeName: node.Name}, client.MatchingLabels{v1beta1constants.LabelNodeCriticalComponent: "true"}); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed listing node-critical Pods on node: %w", err)
+		}
+		pods = append(pods, podList.Items...)
 	}
 
 	var (
-		requiredDrivers = GetRequiredDrivers(podList.Items)
+		requiredDrivers = GetRequiredDrivers(pods)
 		existingDrivers sets.Set[string]
 	)
 
@@ -87,8 +96,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	// - for all node-critical DaemonSets: check whether a daemon pod has already been scheduled to the node
 	// - for all scheduled node-critical Pods on the node: check their readiness
 	// - for all drivers required by csi-driver-node pods: check if they exist
-	if !AllNodeCriticalDaemonPodsAreScheduled(log, r.Recorder, node, daemonSetList.Items, podList.Items) ||
-		!AllNodeCriticalPodsAreReady(log, r.Recorder, node, podList.Items) ||
+	if !AllNodeCriticalDaemonPodsAreScheduled(log, r.Recorder, node, daemonSets, pods) ||
+		!AllNodeCriticalPodsAreReady(log, r.Recorder, node, pods) ||
 		!AllCSINodeDriversAreReady(log, r.Recorder, node, requiredDrivers, existingDrivers) {
 		backoff := r.Config.Backoff.Duration
 		log.V(1).Info("Checking node again after backoff", "backoff", backoff)
@@ -100,6 +109,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	return reconcile.Result{}, RemoveTaint(ctx, r.TargetClient, node)
 }
 
+// namespaces returns the namespaces in which node-critical DaemonSets and Pods are considered, i.e. kube-system and
+// all additional namespaces from the controller configuration.
+func (r *Reconciler) namespaces() []string {
+	return append([]string{metav1.NamespaceSystem}, r.Config.AdditionalNamespaces...)
+}
+
 var daemonSetGVK = appsv1.SchemeGroupVersion.WithKind("DaemonSet")
 
 // AllNodeCriticalDaemonPodsAreScheduled returns true if all node-critical DaemonSets that should be scheduled to the
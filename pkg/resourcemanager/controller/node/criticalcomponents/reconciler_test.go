@@ -6,6 +6,7 @@ package criticalcomponents_test
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
@@ -26,8 +27,11 @@ import (
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/gardener/gardener/pkg/api/indexer"
 	"github.com/gardener/gardener/pkg/logger"
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/resourcemanager/apis/config/v1alpha1"
 	. "github.com/gardener/gardener/pkg/resourcemanager/controller/node/criticalcomponents"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/test"
@@ -70,6 +74,98 @@ var _ = Describe("Reconciler", func() {
 		}
 	})
 
+	Describe("Reconcile", func() {
+		var (
+			ctx        context.Context
+			reconciler *Reconciler
+			request    reconcile.Request
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			fakeClient = fakeclient.NewClientBuilder().
+				WithScheme(scheme).
+				WithIndex(&corev1.Pod{}, indexer.PodNodeName, indexer.PodNodeNameIndexerFunc).
+				Build()
+
+			node.Spec.Taints = []corev1.Taint{{
+				Key:    "node.gardener.cloud/critical-components-not-ready",
+				Effect: corev1.TaintEffectNoSchedule,
+			}}
+			Expect(fakeClient.Create(ctx, node)).To(Succeed())
+
+			reconciler = &Reconciler{
+				TargetClient: fakeClient,
+				Config: resourcemanagerconfigv1alpha1.NodeCriticalComponentsControllerConfig{
+					Backoff:              &metav1.Duration{Duration: 10 * time.Second},
+					AdditionalNamespaces: []string{"custom-namespace"},
+				},
+				Recorder: recorder,
+			}
+			request = reconcile.Request{NamespacedName: types.NamespacedName{Name: node.Name}}
+		})
+
+		nodeCriticalPod := func(namespace string, ready bool) *corev1.Pod {
+			readyStatus := corev1.ConditionFalse
+			if ready {
+				readyStatus = corev1.ConditionTrue
+			}
+
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pod",
+					Namespace: namespace,
+					Labels: map[string]string{
+						"node.gardener.cloud/critical-component": "true",
+					},
+				},
+				Spec: corev1.PodSpec{
+					NodeName:   node.Name,
+					Containers: []corev1.Container{{Name: "app", Image: "app"}},
+				},
+				Status: corev1.PodStatus{
+					Conditions: []corev1.PodCondition{{
+						Type:   corev1.PodReady,
+						Status: readyStatus,
+					}},
+				},
+			}
+		}
+
+		It("should keep the taint if a node-critical pod in an additional namespace is not ready", func() {
+			Expect(fakeClient.Create(ctx, nodeCriticalPod("custom-namespace", false))).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{RequeueAfter: 10 * time.Second}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Spec.Taints).NotTo(BeEmpty())
+		})
+
+		It("should remove the taint once node-critical pods in additional namespaces are ready", func() {
+			Expect(fakeClient.Create(ctx, nodeCriticalPod("custom-namespace", true))).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Spec.Taints).To(BeEmpty())
+		})
+
+		It("should ignore node-critical pods outside kube-system and the additional namespaces", func() {
+			Expect(fakeClient.Create(ctx, nodeCriticalPod("other-namespace", false))).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Spec.Taints).To(BeEmpty())
+		})
+	})
+
 	Describe("AllNodeCriticalDaemonPodsAreScheduled", func() {
 		var (
 			criticalDaemonSets, nonCriticalDaemonSets []appsv1.DaemonSet
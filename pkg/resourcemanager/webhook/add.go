@@ -7,6 +7,7 @@ package webhook
 import (
 	"fmt"
 
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -20,6 +21,7 @@ import (
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/nodeagentauthorizer"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/podkubeapiserverloadbalancing"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/podschedulername"
+	"github.com/gardener/gardener/pkg/resourcemanager/webhook/podtopology"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/podtopologyspreadconstraints"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/projectedtokenmount"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/seccompprofile"
@@ -102,6 +104,17 @@ func AddToManager(mgr manager.Manager, sourceCluster, targetCluster cluster.Clus
 		}
 	}
 
+	if cfg.Webhooks.PodTopology.Enabled {
+		if err := (&podtopology.Handler{
+			Logger:   mgr.GetLogger().WithName("webhook").WithName(podtopology.HandlerName),
+			Zone:     ptr.Deref(cfg.Webhooks.PodTopology.Zone, ""),
+			Region:   ptr.Deref(cfg.Webhooks.PodTopology.Region, ""),
+			SeedName: ptr.Deref(cfg.Webhooks.PodTopology.SeedName, ""),
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding %s webhook handler: %w", podtopology.HandlerName, err)
+		}
+	}
+
 	if cfg.Webhooks.PodTopologySpreadConstraints.Enabled {
 		if err := (&podtopologyspreadconstraints.Handler{
 			Logger: mgr.GetLogger().WithName("webhook").WithName(podtopologyspreadconstraints.HandlerName),
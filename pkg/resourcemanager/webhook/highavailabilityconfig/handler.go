@@ -410,11 +410,21 @@ func (h *Handler) mutateTopologySpreadConstraints(
 		failureToleranceType,
 		enforceSpreadAcrossHosts,
 	); constraints != nil {
+		customFailureDomains := sets.New(h.Config.CustomFailureDomains...)
+		for _, topologyKey := range h.Config.CustomFailureDomains {
+			constraints = append(constraints, corev1.TopologySpreadConstraint{
+				TopologyKey:       topologyKey,
+				MaxSkew:           1,
+				WhenUnsatisfiable: corev1.ScheduleAnyway,
+				LabelSelector:     &labelSelector,
+			})
+		}
+
 		// Filter existing constraints with the same topology key to prevent that we are trying to add a constraint with
 		// the same key multiple times.
 		var filteredConstraints []corev1.TopologySpreadConstraint
 		for _, constraint := range podTemplateSpec.Spec.TopologySpreadConstraints {
-			if constraint.TopologyKey != corev1.LabelHostname && constraint.TopologyKey != corev1.LabelTopologyZone {
+			if constraint.TopologyKey != corev1.LabelHostname && constraint.TopologyKey != corev1.LabelTopologyZone && !customFailureDomains.Has(constraint.TopologyKey) {
 				filteredConstraints = append(filteredConstraints, constraint)
 			}
 		}
@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package highavailabilityconfig_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/resourcemanager/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/resourcemanager/webhook/highavailabilityconfig"
+)
+
+var _ = Describe("Handler", func() {
+	var (
+		ctx = context.Background()
+
+		fakeClient client.Client
+		handler    *Handler
+
+		namespace  *corev1.Namespace
+		deployment *appsv1.Deployment
+
+		labels = map[string]string{"app": "foo"}
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(appsv1.AddToScheme(scheme)).To(Succeed())
+		Expect(autoscalingv2.AddToScheme(scheme)).To(Succeed())
+
+		namespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "some-namespace"}}
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(namespace).Build()
+
+		handler = &Handler{
+			Logger:       logr.Discard(),
+			TargetClient: fakeClient,
+			Config:       resourcemanagerconfigv1alpha1.HighAvailabilityConfigWebhookConfig{},
+			Decoder:      admission.NewDecoder(scheme),
+		}
+
+		deployment = &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "foo",
+				Namespace: namespace.Name,
+			},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: ptr.To[int32](2),
+				Selector: &metav1.LabelSelector{MatchLabels: labels},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				},
+			},
+		}
+	})
+
+	requestFor := func(deployment *appsv1.Deployment) admission.Request {
+		objData, err := json.Marshal(deployment)
+		Expect(err).NotTo(HaveOccurred())
+
+		return admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				Name:      deployment.Name,
+				Namespace: deployment.Namespace,
+				Kind:      metav1.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+				Object:    runtime.RawExtension{Raw: objData},
+			},
+		}
+	}
+
+	topologySpreadConstraintsFromResponse := func(response admission.Response) []corev1.TopologySpreadConstraint {
+		for _, patch := range response.Patches {
+			if patch.Operation == "add" && patch.Path == "/spec/template/spec/topologySpreadConstraints" {
+				valueData, err := json.Marshal(patch.Value)
+				Expect(err).NotTo(HaveOccurred())
+
+				var constraints []corev1.TopologySpreadConstraint
+				Expect(json.Unmarshal(valueData, &constraints)).To(Succeed())
+				return constraints
+			}
+		}
+		return nil
+	}
+
+	Describe("#Handle", func() {
+		Context("topology spread constraints with custom failure domains", func() {
+			It("should not add constraints for custom failure domains if none are configured", func() {
+				response := handler.Handle(ctx, requestFor(deployment))
+				Expect(response.Allowed).To(BeTrue())
+
+				Expect(topologySpreadConstraintsFromResponse(response)).To(ConsistOf(corev1.TopologySpreadConstraint{
+					TopologyKey:       corev1.LabelHostname,
+					MaxSkew:           1,
+					WhenUnsatisfiable: corev1.ScheduleAnyway,
+					LabelSelector:     &metav1.LabelSelector{MatchLabels: labels},
+					MatchLabelKeys:    []string{appsv1.DefaultDeploymentUniqueLabelKey},
+				}))
+			})
+
+			It("should add a constraint for each configured custom failure domain", func() {
+				handler.Config.CustomFailureDomains = []string{"example.com/failure-domain"}
+
+				response := handler.Handle(ctx, requestFor(deployment))
+				Expect(response.Allowed).To(BeTrue())
+
+				Expect(topologySpreadConstraintsFromResponse(response)).To(ConsistOf(
+					corev1.TopologySpreadConstraint{
+						TopologyKey:       corev1.LabelHostname,
+						MaxSkew:           1,
+						WhenUnsatisfiable: corev1.ScheduleAnyway,
+						LabelSelector:     &metav1.LabelSelector{MatchLabels: labels},
+						MatchLabelKeys:    []string{appsv1.DefaultDeploymentUniqueLabelKey},
+					},
+					corev1.TopologySpreadConstraint{
+						TopologyKey:       "example.com/failure-domain",
+						MaxSkew:           1,
+						WhenUnsatisfiable: corev1.ScheduleAnyway,
+						LabelSelector:     &metav1.LabelSelector{MatchLabels: labels},
+						MatchLabelKeys:    []string{appsv1.DefaultDeploymentUniqueLabelKey},
+					},
+				))
+			})
+
+			It("should replace existing constraints with a custom failure domain key", func() {
+				handler.Config.CustomFailureDomains = []string{"example.com/failure-domain"}
+
+				deployment.Spec.Template.Spec.TopologySpreadConstraints = []corev1.TopologySpreadConstraint{
+					{
+						TopologyKey:       corev1.LabelHostname,
+						MaxSkew:           1,
+						WhenUnsatisfiable: corev1.ScheduleAnyway,
+						LabelSelector:     &metav1.LabelSelector{MatchLabels: labels},
+						MatchLabelKeys:    []string{appsv1.DefaultDeploymentUniqueLabelKey},
+					},
+					{
+						TopologyKey:       "example.com/failure-domain",
+						MaxSkew:           5,
+						WhenUnsatisfiable: corev1.ScheduleAnyway,
+						LabelSelector:     &metav1.LabelSelector{MatchLabels: labels},
+						MatchLabelKeys:    []string{appsv1.DefaultDeploymentUniqueLabelKey},
+					},
+				}
+
+				response := handler.Handle(ctx, requestFor(deployment))
+				Expect(response.Allowed).To(BeTrue())
+
+				// The existing constraint for the custom failure domain is replaced, so the only difference to the
+				// object in the request is the maxSkew of the webhook-managed constraint.
+				Expect(response.Patches).To(HaveLen(1))
+				Expect(response.Patches[0].Operation).To(Equal("replace"))
+				Expect(response.Patches[0].Path).To(Equal("/spec/template/spec/topologySpreadConstraints/1/maxSkew"))
+				Expect(response.Patches[0].Value).To(Equal(json.Number("1")))
+			})
+		})
+	})
+})
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package podtopology
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+const (
+	// EnvVarTopologyZone is the name of the environment variable for the availability zone.
+	EnvVarTopologyZone = "TOPOLOGY_ZONE"
+	// EnvVarTopologyRegion is the name of the environment variable for the region.
+	EnvVarTopologyRegion = "TOPOLOGY_REGION"
+	// EnvVarTopologySeed is the name of the environment variable for the seed name.
+	EnvVarTopologySeed = "TOPOLOGY_SEED"
+)
+
+// Handler handles admission requests and injects topology information as environment variables into all containers in
+// pods labeled with the topology injection label.
+type Handler struct {
+	Logger   logr.Logger
+	Zone     string
+	Region   string
+	SeedName string
+}
+
+// Default injects the topology environment variables into all containers in the pod.
+func (h *Handler) Default(ctx context.Context, obj runtime.Object) error {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return fmt.Errorf("expected *corev1.Pod but got %T", obj)
+	}
+
+	if pod.Labels[resourcesv1alpha1.PodTopologyInjection] != "true" {
+		return nil
+	}
+
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	log := h.Logger.WithValues("pod", kubernetesutils.ObjectKeyForCreateWebhooks(pod, req))
+
+	log.Info("Injecting topology environment variables into all containers in the pod")
+	h.injectTopologyEnv(pod.Spec.InitContainers)
+	h.injectTopologyEnv(pod.Spec.Containers)
+
+	return nil
+}
+
+func (h *Handler) injectTopologyEnv(containers []corev1.Container) {
+	for name, value := range map[string]string{
+		EnvVarTopologyZone:   h.Zone,
+		EnvVarTopologyRegion: h.Region,
+		EnvVarTopologySeed:   h.SeedName,
+	} {
+		if value == "" {
+			continue
+		}
+
+		for i, container := range containers {
+			if slices.ContainsFunc(container.Env, func(envVar corev1.EnvVar) bool { return envVar.Name == name }) {
+				continue
+			}
+
+			containers[i].Env = append(containers[i].Env, corev1.EnvVar{
+				Name:  name,
+				Value: value,
+			})
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package podtopology_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	. "github.com/gardener/gardener/pkg/resourcemanager/webhook/podtopology"
+)
+
+var _ = Describe("Handler", func() {
+	var (
+		ctx = context.Background()
+		log = logr.Discard()
+
+		handler *Handler
+		pod     *corev1.Pod
+	)
+
+	BeforeEach(func() {
+		ctx = admission.NewContextWithRequest(ctx, admission.Request{})
+
+		handler = &Handler{Logger: log, Zone: "europe-1a", Region: "europe", SeedName: "seed-1"}
+		pod = &corev1.Pod{
+			Spec: corev1.PodSpec{
+				InitContainers: []corev1.Container{{Name: "init"}},
+				Containers:     []corev1.Container{{Name: "app"}},
+			},
+		}
+	})
+
+	Describe("#Default", func() {
+		It("should not inject anything if the pod is not labeled", func() {
+			Expect(handler.Default(ctx, pod)).To(Succeed())
+			Expect(pod.Spec.InitContainers[0].Env).To(BeEmpty())
+			Expect(pod.Spec.Containers[0].Env).To(BeEmpty())
+		})
+
+		Context("when the pod is labeled", func() {
+			BeforeEach(func() {
+				pod.Labels = map[string]string{resourcesv1alpha1.PodTopologyInjection: "true"}
+			})
+
+			It("should inject the topology environment variables into all containers", func() {
+				Expect(handler.Default(ctx, pod)).To(Succeed())
+
+				for _, container := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+					Expect(container.Env).To(ConsistOf(
+						corev1.EnvVar{Name: EnvVarTopologyZone, Value: "europe-1a"},
+						corev1.EnvVar{Name: EnvVarTopologyRegion, Value: "europe"},
+						corev1.EnvVar{Name: EnvVarTopologySeed, Value: "seed-1"},
+					))
+				}
+			})
+
+			It("should not inject environment variables for empty topology values", func() {
+				handler.Zone = ""
+
+				Expect(handler.Default(ctx, pod)).To(Succeed())
+
+				Expect(pod.Spec.Containers[0].Env).To(ConsistOf(
+					corev1.EnvVar{Name: EnvVarTopologyRegion, Value: "europe"},
+					corev1.EnvVar{Name: EnvVarTopologySeed, Value: "seed-1"},
+				))
+			})
+
+			It("should not overwrite existing environment variables", func() {
+				pod.Spec.Containers[0].Env = []corev1.EnvVar{{Name: EnvVarTopologyZone, Value: "custom-zone"}}
+
+				Expect(handler.Default(ctx, pod)).To(Succeed())
+
+				Expect(pod.Spec.Containers[0].Env).To(ConsistOf(
+					corev1.EnvVar{Name: EnvVarTopologyZone, Value: "custom-zone"},
+					corev1.EnvVar{Name: EnvVarTopologyRegion, Value: "europe"},
+					corev1.EnvVar{Name: EnvVarTopologySeed, Value: "seed-1"},
+				))
+			})
+		})
+	})
+})
@@ -98,6 +98,19 @@ type ShootSchedulerConfiguration struct {
 	ConcurrentSyncs int `json:"concurrentSyncs"`
 	// Strategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 	Strategy CandidateDeterminationStrategy `json:"candidateDeterminationStrategy"`
+	// Profiles defines additional named scheduling profiles. Shoots select a profile via their spec.schedulerName
+	// field. Shoots that do not specify a scheduler name (or specify the default scheduler name) are scheduled with
+	// the strategy defined in Strategy.
+	// +optional
+	Profiles []ShootSchedulerProfile `json:"profiles,omitempty"`
+}
+
+// ShootSchedulerProfile defines a named scheduling profile of the Shoot to Seed scheduler.
+type ShootSchedulerProfile struct {
+	// Name is the name of the profile. It must not be equal to the default scheduler name.
+	Name string `json:"name"`
+	// Strategy defines how seed candidates are determined for shoots scheduled with this profile.
+	Strategy CandidateDeterminationStrategy `json:"candidateDeterminationStrategy"`
 }
 
 // ServerConfiguration contains details for the HTTP(S) servers.
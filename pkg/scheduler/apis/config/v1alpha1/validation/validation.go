@@ -9,6 +9,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/logger"
 	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
@@ -51,6 +52,31 @@ func validateSchedulerControllerConfiguration(schedulers schedulerconfigv1alpha1
 	if schedulers.Shoot != nil {
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(schedulers.Shoot.ConcurrentSyncs), fldPath.Child("shoot", "concurrentSyncs"))...)
 		allErrs = append(allErrs, validateStrategy(schedulers.Shoot.Strategy, fldPath.Child("shoot", "strategy"))...)
+		allErrs = append(allErrs, validateProfiles(schedulers.Shoot.Profiles, fldPath.Child("shoot", "profiles"))...)
+	}
+
+	return allErrs
+}
+
+func validateProfiles(profiles []schedulerconfigv1alpha1.ShootSchedulerProfile, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[string]()
+	)
+
+	for i, profile := range profiles {
+		idxPath := fldPath.Index(i)
+
+		if profile.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "profile name must not be empty"))
+		} else if profile.Name == v1beta1constants.DefaultSchedulerName {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), profile.Name, "profile name must not be equal to the default scheduler name"))
+		} else if names.Has(profile.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), profile.Name))
+		}
+		names.Insert(profile.Name)
+
+		allErrs = append(allErrs, validateStrategy(profile.Strategy, idxPath.Child("strategy"))...)
 	}
 
 	return allErrs
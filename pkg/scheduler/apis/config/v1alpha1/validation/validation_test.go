@@ -138,6 +138,48 @@ var _ = Describe("#ValidateConfiguration", func() {
 			}))))
 		})
 
+		It("should pass because the Gardener Scheduler Configuration contains valid profiles", func() {
+			profileConfiguration := conf.DeepCopy()
+			profileConfiguration.Schedulers.Shoot.Profiles = []schedulerconfigv1alpha1.ShootSchedulerProfile{
+				{Name: "ha-scheduler", Strategy: schedulerconfigv1alpha1.MinimalDistance},
+				{Name: "regulated-scheduler", Strategy: schedulerconfigv1alpha1.SameRegion},
+			}
+			err := ValidateConfiguration(profileConfiguration)
+
+			Expect(err).To(BeEmpty())
+		})
+
+		It("should fail because the Gardener Scheduler Configuration contains invalid profiles", func() {
+			invalidConfiguration := conf.DeepCopy()
+			invalidConfiguration.Schedulers.Shoot.Profiles = []schedulerconfigv1alpha1.ShootSchedulerProfile{
+				{Name: "ha-scheduler", Strategy: schedulerconfigv1alpha1.MinimalDistance},
+				{Name: "", Strategy: schedulerconfigv1alpha1.SameRegion},
+				{Name: "default-scheduler", Strategy: schedulerconfigv1alpha1.SameRegion},
+				{Name: "ha-scheduler", Strategy: schedulerconfigv1alpha1.SameRegion},
+				{Name: "invalid-strategy-scheduler", Strategy: "invalidStrategy"},
+			}
+			err := ValidateConfiguration(invalidConfiguration)
+
+			Expect(err).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("schedulers.shoot.profiles[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("schedulers.shoot.profiles[2].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("schedulers.shoot.profiles[3].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("schedulers.shoot.profiles[4].strategy"),
+				})),
+			))
+		})
+
 		It("should fail because backupBucket concurrentSyncs are negative", func() {
 			invalidConfiguration := conf.DeepCopy()
 			invalidConfiguration.Schedulers.BackupBucket.ConcurrentSyncs = -1
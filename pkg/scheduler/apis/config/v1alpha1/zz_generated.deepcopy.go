@@ -86,7 +86,7 @@ func (in *SchedulerControllerConfiguration) DeepCopyInto(out *SchedulerControlle
 	if in.Shoot != nil {
 		in, out := &in.Shoot, &out.Shoot
 		*out = new(ShootSchedulerConfiguration)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -146,6 +146,11 @@ func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootSchedulerConfiguration) DeepCopyInto(out *ShootSchedulerConfiguration) {
 	*out = *in
+	if in.Profiles != nil {
+		in, out := &in.Profiles, &out.Profiles
+		*out = make([]ShootSchedulerProfile, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -158,3 +163,19 @@ func (in *ShootSchedulerConfiguration) DeepCopy() *ShootSchedulerConfiguration {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootSchedulerProfile) DeepCopyInto(out *ShootSchedulerProfile) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootSchedulerProfile.
+func (in *ShootSchedulerProfile) DeepCopy() *ShootSchedulerProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootSchedulerProfile)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -53,12 +53,16 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 }
 
 // ShootUnassignedPredicate is a predicate that returns true if a shoot is not assigned to a seed
-// and the default scheduler is configured.
+// and the default scheduler or one of the configured scheduling profiles is responsible for it.
 func (r *Reconciler) ShootUnassignedPredicate() predicate.Predicate {
 	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
 		if shoot, ok := obj.(*gardencorev1beta1.Shoot); ok {
-			return shoot.Spec.SeedName == nil &&
-				ptr.Deref(shoot.Spec.SchedulerName, v1beta1constants.DefaultSchedulerName) == v1beta1constants.DefaultSchedulerName
+			if shoot.Spec.SeedName != nil {
+				return false
+			}
+
+			schedulerName := ptr.Deref(shoot.Spec.SchedulerName, v1beta1constants.DefaultSchedulerName)
+			return schedulerName == v1beta1constants.DefaultSchedulerName || r.profileForSchedulerName(schedulerName) != nil
 		}
 		return false
 	})
@@ -12,6 +12,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
 	. "github.com/gardener/gardener/pkg/scheduler/controller/shoot"
 )
 
@@ -19,7 +20,15 @@ var _ = Describe("Add", func() {
 	var reconciler *Reconciler
 
 	BeforeEach(func() {
-		reconciler = &Reconciler{}
+		reconciler = &Reconciler{
+			Config: &schedulerconfigv1alpha1.ShootSchedulerConfiguration{
+				Strategy: schedulerconfigv1alpha1.SameRegion,
+				Profiles: []schedulerconfigv1alpha1.ShootSchedulerProfile{{
+					Name:     "ha-scheduler",
+					Strategy: schedulerconfigv1alpha1.MinimalDistance,
+				}},
+			},
+		}
 	})
 
 	Describe("#ShootUnassignedPredicate", func() {
@@ -88,6 +97,19 @@ var _ = Describe("Add", func() {
 				})
 			})
 
+			Context("configured profile name", func() {
+				BeforeEach(func() {
+					shoot.Spec.SchedulerName = ptr.To("ha-scheduler")
+				})
+
+				It("should be true", func() {
+					Expect(predicate.Create(createEvent)).To(BeTrue())
+					Expect(predicate.Update(updateEvent)).To(BeTrue())
+					Expect(predicate.Delete(deleteEvent)).To(BeTrue())
+					Expect(predicate.Generic(genericEvent)).To(BeTrue())
+				})
+			})
+
 			Context("arbitrary scheduler name", func() {
 				BeforeEach(func() {
 					shoot.Spec.SchedulerName = ptr.To("foo-scheduler")
@@ -20,6 +20,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -82,7 +83,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		"cloudprofile", shoot.Spec.CloudProfileName,
 		"region", shoot.Spec.Region,
 		"seed", seed.Name,
-		"strategy", r.Config.Strategy,
+		"strategy", r.strategyForShoot(shoot),
 	)
 
 	r.reportEvent(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventSchedulingSuccessful, "Scheduled to seed '%s'", seed.Name)
@@ -155,6 +156,10 @@ func (r *Reconciler) DetermineSeed(
 	if err != nil {
 		return nil, err
 	}
+	filteredSeeds, err = filterSeedsMatchingAffinity(filteredSeeds, shoot.Spec.SeedAffinity)
+	if err != nil {
+		return nil, err
+	}
 	filteredSeeds, err = filterSeedsMatchingProviders(cloudProfile, shoot, filteredSeeds)
 	if err != nil {
 		return nil, err
@@ -179,13 +184,35 @@ func (r *Reconciler) DetermineSeed(
 	if err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = applyStrategy(log, shoot, filteredSeeds, r.Config.Strategy, regionConfig)
+	filteredSeeds, err = applyStrategy(log, shoot, filteredSeeds, r.strategyForShoot(shoot), regionConfig)
+	if err != nil {
+		return nil, err
+	}
+	filteredSeeds, err = preferSeedsMatchingAffinity(filteredSeeds, shoot.Spec.SeedAffinity)
 	if err != nil {
 		return nil, err
 	}
 	return getSeedWithLeastShootsDeployed(filteredSeeds, shootList)
 }
 
+// strategyForShoot returns the candidate determination strategy of the scheduling profile the shoot selects via its
+// spec.schedulerName field, or the default strategy if the shoot does not select a dedicated profile.
+func (r *Reconciler) strategyForShoot(shoot *gardencorev1beta1.Shoot) schedulerconfigv1alpha1.CandidateDeterminationStrategy {
+	if profile := r.profileForSchedulerName(ptr.Deref(shoot.Spec.SchedulerName, v1beta1constants.DefaultSchedulerName)); profile != nil {
+		return profile.Strategy
+	}
+	return r.Config.Strategy
+}
+
+func (r *Reconciler) profileForSchedulerName(schedulerName string) *schedulerconfigv1alpha1.ShootSchedulerProfile {
+	for i, profile := range r.Config.Profiles {
+		if profile.Name == schedulerName {
+			return &r.Config.Profiles[i]
+		}
+	}
+	return nil
+}
+
 func (r *Reconciler) getRegionConfigMap(ctx context.Context, log logr.Logger, cloudProfile *gardencorev1beta1.CloudProfile) (*corev1.ConfigMap, error) {
 	regionConfigList := &corev1.ConfigMapList{}
 	if err := r.Client.List(ctx, regionConfigList, client.InNamespace(r.GardenNamespace), client.MatchingLabels{v1beta1constants.SchedulingPurpose: v1beta1constants.SchedulingPurposeRegionConfig}); err != nil {
@@ -255,6 +282,81 @@ func filterSeedsMatchingLabelSelector(seedList []gardencorev1beta1.Seed, seedSel
 	return matchingSeeds, nil
 }
 
+// filterSeedsMatchingAffinity filters seeds whose labels match at least one of the required seed affinity terms
+// configured in the shoot.
+func filterSeedsMatchingAffinity(seedList []gardencorev1beta1.Seed, seedAffinity *gardencorev1beta1.SeedAffinity) ([]gardencorev1beta1.Seed, error) {
+	if seedAffinity == nil || len(seedAffinity.RequiredDuringScheduling) == 0 {
+		return seedList, nil
+	}
+
+	selectors := make([]labels.Selector, 0, len(seedAffinity.RequiredDuringScheduling))
+	for i, labelSelector := range seedAffinity.RequiredDuringScheduling {
+		selector, err := metav1.LabelSelectorAsSelector(&labelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("label selector conversion failed: %v for required seed affinity term at index %d: %w", labelSelector, i, err)
+		}
+		selectors = append(selectors, selector)
+	}
+
+	var matchingSeeds []gardencorev1beta1.Seed
+	for _, seed := range seedList {
+		for _, selector := range selectors {
+			if selector.Matches(labels.Set(seed.Labels)) {
+				matchingSeeds = append(matchingSeeds, seed)
+				break
+			}
+		}
+	}
+
+	if len(matchingSeeds) == 0 {
+		return nil, fmt.Errorf("none out of the %d seeds has the matching labels required by the seed affinity of the shoot", len(seedList))
+	}
+	return matchingSeeds, nil
+}
+
+// preferSeedsMatchingAffinity restricts the given candidates to the seeds with the highest sum of weights of the
+// matching preferred seed affinity terms configured in the shoot.
+func preferSeedsMatchingAffinity(seedList []gardencorev1beta1.Seed, seedAffinity *gardencorev1beta1.SeedAffinity) ([]gardencorev1beta1.Seed, error) {
+	if seedAffinity == nil || len(seedAffinity.PreferredDuringScheduling) == 0 {
+		return seedList, nil
+	}
+
+	selectors := make([]labels.Selector, 0, len(seedAffinity.PreferredDuringScheduling))
+	for i, term := range seedAffinity.PreferredDuringScheduling {
+		selector, err := metav1.LabelSelectorAsSelector(&term.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("label selector conversion failed: %v for preferred seed affinity term at index %d: %w", term.LabelSelector, i, err)
+		}
+		selectors = append(selectors, selector)
+	}
+
+	var (
+		preferredSeeds []gardencorev1beta1.Seed
+		maxWeight      int32 = math.MinInt32
+	)
+
+	for _, seed := range seedList {
+		var weight int32
+		for i, selector := range selectors {
+			if selector.Matches(labels.Set(seed.Labels)) {
+				weight += seedAffinity.PreferredDuringScheduling[i].Weight
+			}
+		}
+
+		if weight == maxWeight {
+			preferredSeeds = append(preferredSeeds, seed)
+			continue
+		}
+
+		if weight > maxWeight {
+			maxWeight = weight
+			preferredSeeds = []gardencorev1beta1.Seed{seed}
+		}
+	}
+
+	return preferredSeeds, nil
+}
+
 func filterSeedsMatchingProviders(cloudProfile *gardencorev1beta1.CloudProfile, shoot *gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
 	var possibleProviders []string
 	if cloudProfile.Spec.SeedSelector != nil {
@@ -601,6 +601,85 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(bestSeed.Name).To(Equal(newSeedEnvironment3.Name))
 		})
 
+		It("should find seed cluster that matches one of the required seed affinity terms of the Shoot", func() {
+			seedEnvironment1 := seed.DeepCopy()
+			seedEnvironment1.Name = "seed1"
+			seedEnvironment1.Labels = map[string]string{"environment": "one"}
+
+			seedEnvironment2 := seed.DeepCopy()
+			seedEnvironment2.Name = "seed2"
+			seedEnvironment2.Labels = map[string]string{"environment": "two"}
+
+			shoot.Spec.SeedAffinity = &gardencorev1beta1.SeedAffinity{
+				RequiredDuringScheduling: []metav1.LabelSelector{
+					{MatchLabels: map[string]string{"environment": "two"}},
+					{MatchLabels: map[string]string{"environment": "three"}},
+				},
+			}
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seedEnvironment1)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seedEnvironment2)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seedEnvironment2.Name))
+		})
+
+		It("should fail because no seed matches any of the required seed affinity terms of the Shoot", func() {
+			seed.Labels = map[string]string{"environment": "one"}
+			shoot.Spec.SeedAffinity = &gardencorev1beta1.SeedAffinity{
+				RequiredDuringScheduling: []metav1.LabelSelector{
+					{MatchLabels: map[string]string{"environment": "two"}},
+				},
+			}
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).To(HaveOccurred())
+			Expect(bestSeed).To(BeNil())
+		})
+
+		It("should prefer the seed cluster with the highest sum of weights of the matching preferred seed affinity terms", func() {
+			seedEnvironment1 := seed.DeepCopy()
+			seedEnvironment1.Name = "seed1"
+			seedEnvironment1.Labels = map[string]string{"environment": "one"}
+
+			seedEnvironment2 := seed.DeepCopy()
+			seedEnvironment2.Name = "seed2"
+			seedEnvironment2.Labels = map[string]string{"environment": "two", "hardware": "special"}
+
+			shoot.Spec.SeedAffinity = &gardencorev1beta1.SeedAffinity{
+				PreferredDuringScheduling: []gardencorev1beta1.WeightedSeedAffinityTerm{
+					{
+						Weight:        10,
+						LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"environment": "one"}},
+					},
+					{
+						Weight:        5,
+						LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"environment": "two"}},
+					},
+					{
+						Weight:        100,
+						LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"hardware": "special"}},
+					},
+				},
+			}
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seedEnvironment1)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seedEnvironment2)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seedEnvironment2.Name))
+		})
+
 		It("should find seed cluster with enough available capacity for shoots", func() {
 			seed.Status.Allocatable = corev1.ResourceList{
 				gardencorev1beta1.ResourceShoots: resource.MustParse("1"),
@@ -119,6 +119,21 @@ type nodeResult struct {
 	duration time.Duration
 }
 
+type taskProgress struct {
+	TaskID     TaskID
+	Percentage int32
+}
+
+type taskProgressReporterContextKey struct{}
+
+// ReportTaskProgress reports the progress in percent (from 0 to 100) of the task the given context belongs to. It is a
+// no-op if the context does not belong to a task of a running Flow.
+func ReportTaskProgress(ctx context.Context, percentage int32) {
+	if report, ok := ctx.Value(taskProgressReporterContextKey{}).(func(int32)); ok {
+		report(percentage)
+	}
+}
+
 // Stats are the statistics of a Flow execution.
 type Stats struct {
 	FlowName  string
@@ -128,16 +143,29 @@ type Stats struct {
 	Running   TaskIDs
 	Skipped   TaskIDs
 	Pending   TaskIDs
+	// TaskProgress contains the progress in percent of the running tasks that reported it via ReportTaskProgress.
+	TaskProgress map[TaskID]int32
 }
 
-// ProgressPercent retrieves the progress of a Flow execution in percent.
+// ProgressPercent retrieves the progress of a Flow execution in percent. The progress reported by running tasks
+// contributes the respective fraction of the tasks' share to the overall progress.
 func (s *Stats) ProgressPercent() int32 {
-	progress := (100 * s.Succeeded.Len()) / s.All.Len()
-	return int32(progress) // #nosec G115 -- s.All.Len() >= s.Succeeded.Len(), so progress is <= 100.
+	progress := 100 * s.Succeeded.Len()
+	for id := range s.Running {
+		if taskProgress, ok := s.TaskProgress[id]; ok {
+			progress += int(min(max(taskProgress, 0), 100))
+		}
+	}
+	return int32(progress / s.All.Len()) // #nosec G115 -- every task contributes at most 100, so progress is <= 100.
 }
 
 // Copy deeply copies a Stats object.
 func (s *Stats) Copy() *Stats {
+	taskProgress := make(map[TaskID]int32, len(s.TaskProgress))
+	for id, percentage := range s.TaskProgress {
+		taskProgress[id] = percentage
+	}
+
 	return &Stats{
 		s.FlowName,
 		s.All.Copy(),
@@ -146,6 +174,7 @@ func (s *Stats) Copy() *Stats {
 		s.Running.Copy(),
 		s.Skipped.Copy(),
 		s.Pending.Copy(),
+		taskProgress,
 	}
 }
 
@@ -160,6 +189,7 @@ func InitialStats(flowName string, all TaskIDs) *Stats {
 		NewTaskIDs(),
 		NewTaskIDs(),
 		all.Copy(),
+		make(map[TaskID]int32),
 	}
 }
 
@@ -186,6 +216,7 @@ func newExecution(flow *Flow, opts Opts) *execution {
 		opts.ErrorCleaner,
 		opts.ErrorContext,
 		make(chan *nodeResult),
+		make(chan *taskProgress, len(all)),
 		make(map[TaskID]int),
 	}
 }
@@ -202,6 +233,7 @@ type execution struct {
 	errorContext     *errorsutils.ErrorContext
 
 	done          chan *nodeResult
+	progress      chan *taskProgress
 	triggerCounts map[TaskID]int
 }
 
@@ -228,10 +260,17 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 	e.stats.Pending.Delete(id)
 	e.stats.Running.Insert(id)
 
+	taskCtx := context.WithValue(ctx, taskProgressReporterContextKey{}, func(percentage int32) {
+		select {
+		case e.progress <- &taskProgress{TaskID: id, Percentage: percentage}:
+		default:
+		}
+	})
+
 	go func() {
 		start := e.flow.clock.Now().UTC()
 		log.V(1).Info("Started")
-		err := node.fn(ctx)
+		err := node.fn(taskCtx)
 		duration := e.flow.clock.Now().UTC().Sub(start)
 		log.V(1).Info("Finished", "duration", duration)
 
@@ -249,11 +288,13 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 func (e *execution) updateSuccess(id TaskID) {
 	e.stats.Running.Delete(id)
 	e.stats.Succeeded.Insert(id)
+	delete(e.stats.TaskProgress, id)
 }
 
 func (e *execution) updateFailure(id TaskID) {
 	e.stats.Running.Delete(id)
 	e.stats.Failed.Insert(id)
+	delete(e.stats.TaskProgress, id)
 }
 
 func (e *execution) processTriggers(ctx context.Context, id TaskID) {
@@ -305,7 +346,18 @@ func (e *execution) run(ctx context.Context) error {
 	e.reportProgress(ctx)
 
 	for e.stats.Running.Len() > 0 || e.stats.Skipped.Len() > 0 {
-		result := <-e.done
+		var result *nodeResult
+
+		select {
+		case progress := <-e.progress:
+			if e.stats.Running.Has(progress.TaskID) {
+				e.stats.TaskProgress[progress.TaskID] = progress.Percentage
+				e.reportProgress(ctx)
+			}
+			continue
+		case result = <-e.done:
+		}
+
 		e.reportTaskMetrics(result)
 		if result.skipped {
 			e.stats.Skipped.Delete(result.TaskID)
@@ -147,6 +147,40 @@ var _ = Describe("Flow", func() {
 			Expect(cleaned).To(BeTrue())
 		})
 
+		It("should consider the reported task progress in the flow progress", func() {
+			var (
+				progresses []int32
+				lock       sync.Mutex
+				reported   = make(chan struct{})
+				once       sync.Once
+
+				reporter = flow.NewImmediateProgressReporter(func(_ context.Context, stats *flow.Stats) {
+					lock.Lock()
+					defer lock.Unlock()
+					progresses = append(progresses, stats.ProgressPercent())
+					if stats.ProgressPercent() == 25 {
+						once.Do(func() { close(reported) })
+					}
+				})
+
+				g = flow.NewGraph("foo")
+				x = g.Add(flow.Task{Name: "x", Fn: func(ctx context.Context) error {
+					flow.ReportTaskProgress(ctx, 50)
+					<-reported
+					return nil
+				}})
+				_ = g.Add(flow.Task{Name: "y", Fn: func(_ context.Context) error { return nil }, Dependencies: flow.NewTaskIDs(x)})
+				f = g.Compile()
+			)
+
+			Expect(f.Run(ctx, flow.Opts{ProgressReporter: reporter})).To(Succeed())
+
+			lock.Lock()
+			defer lock.Unlock()
+			Expect(progresses).To(ContainElement(int32(25)))
+			Expect(progresses[len(progresses)-1]).To(Equal(int32(100)))
+		})
+
 		It("should stop the execution after the context has been canceled in between tasks", func() {
 			var (
 				testCtx, cancelTestCtx = context.WithCancel(context.Background())
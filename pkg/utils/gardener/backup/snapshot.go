@@ -0,0 +1,248 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backup
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/utils/retry"
+)
+
+const (
+	// SnapshotVersion is the version of the snapshot format produced by Export.
+	SnapshotVersion = "v1"
+	// encryptionKeyLength is the required length of the AES-256 encryption key in bytes.
+	encryptionKeyLength = 32
+)
+
+// Snapshot is a versioned export of the most important garden cluster resources. It serves as an additional disaster
+// recovery mechanism beyond etcd snapshots. Secrets are exported with their metadata only, i.e., without any
+// credential data.
+type Snapshot struct {
+	// Version is the version of the snapshot format.
+	Version string `json:"version"`
+	// CreatedAt is the time when the snapshot was taken.
+	CreatedAt metav1.Time `json:"createdAt"`
+	// Projects is the list of all Project resources.
+	Projects []gardencorev1beta1.Project `json:"projects,omitempty"`
+	// CloudProfiles is the list of all CloudProfile resources.
+	CloudProfiles []gardencorev1beta1.CloudProfile `json:"cloudProfiles,omitempty"`
+	// Quotas is the list of all Quota resources.
+	Quotas []gardencorev1beta1.Quota `json:"quotas,omitempty"`
+	// SecretBindings is the list of all SecretBinding resources.
+	SecretBindings []gardencorev1beta1.SecretBinding `json:"secretBindings,omitempty"`
+	// Shoots is the list of all Shoot resources.
+	Shoots []gardencorev1beta1.Shoot `json:"shoots,omitempty"`
+	// Secrets is the list of the Secret resources referenced by the SecretBindings, with metadata only.
+	Secrets []corev1.Secret `json:"secrets,omitempty"`
+}
+
+// Export reads the garden cluster resources covered by the Snapshot type and returns them as gzipped JSON, encrypted
+// with AES-256-GCM using the given 32 byte key.
+func Export(ctx context.Context, c client.Reader, encryptionKey []byte) ([]byte, error) {
+	snapshot := &Snapshot{
+		Version:   SnapshotVersion,
+		CreatedAt: metav1.Time{Time: time.Now().UTC()},
+	}
+
+	projectList := &gardencorev1beta1.ProjectList{}
+	if err := c.List(ctx, projectList); err != nil {
+		return nil, fmt.Errorf("failed listing projects: %w", err)
+	}
+	snapshot.Projects = projectList.Items
+
+	cloudProfileList := &gardencorev1beta1.CloudProfileList{}
+	if err := c.List(ctx, cloudProfileList); err != nil {
+		return nil, fmt.Errorf("failed listing cloud profiles: %w", err)
+	}
+	snapshot.CloudProfiles = cloudProfileList.Items
+
+	quotaList := &gardencorev1beta1.QuotaList{}
+	if err := c.List(ctx, quotaList); err != nil {
+		return nil, fmt.Errorf("failed listing quotas: %w", err)
+	}
+	snapshot.Quotas = quotaList.Items
+
+	secretBindingList := &gardencorev1beta1.SecretBindingList{}
+	if err := c.List(ctx, secretBindingList); err != nil {
+		return nil, fmt.Errorf("failed listing secret bindings: %w", err)
+	}
+	snapshot.SecretBindings = secretBindingList.Items
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := c.List(ctx, shootList); err != nil {
+		return nil, fmt.Errorf("failed listing shoots: %w", err)
+	}
+	snapshot.Shoots = shootList.Items
+
+	for _, secretBinding := range snapshot.SecretBindings {
+		secret := &corev1.Secret{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: secretBinding.SecretRef.Namespace, Name: secretBinding.SecretRef.Name}, secret); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed getting secret %s/%s referenced by secret binding %s/%s: %w", secretBinding.SecretRef.Namespace, secretBinding.SecretRef.Name, secretBinding.Namespace, secretBinding.Name, err)
+		}
+
+		// Only the metadata is exported, the credential data itself must never leave the cluster.
+		secret.Data = nil
+		secret.StringData = nil
+		snapshot.Secrets = append(snapshot.Secrets, *secret)
+	}
+
+	return encode(snapshot, encryptionKey)
+}
+
+// Import decrypts and decodes the given snapshot and re-creates all contained resources which do not exist in the
+// garden cluster anymore. Existing resources are left untouched.
+func Import(ctx context.Context, c client.Client, data, encryptionKey []byte) error {
+	snapshot, err := Decode(data, encryptionKey)
+	if err != nil {
+		return err
+	}
+
+	var objects []client.Object
+	for i := range snapshot.Projects {
+		objects = append(objects, &snapshot.Projects[i])
+	}
+	for i := range snapshot.CloudProfiles {
+		objects = append(objects, &snapshot.CloudProfiles[i])
+	}
+	for i := range snapshot.Quotas {
+		objects = append(objects, &snapshot.Quotas[i])
+	}
+	for i := range snapshot.Secrets {
+		objects = append(objects, &snapshot.Secrets[i])
+	}
+	for i := range snapshot.SecretBindings {
+		objects = append(objects, &snapshot.SecretBindings[i])
+	}
+	for i := range snapshot.Shoots {
+		objects = append(objects, &snapshot.Shoots[i])
+	}
+
+	for _, obj := range objects {
+		obj.SetResourceVersion("")
+		obj.SetUID("")
+		obj.SetManagedFields(nil)
+
+		if err := retry.UntilTimeout(ctx, 2*time.Second, time.Minute, func(ctx context.Context) (done bool, err error) {
+			if err := c.Create(ctx, obj); err != nil {
+				if apierrors.IsAlreadyExists(err) {
+					return retry.Ok()
+				}
+				// The project namespaces are only created asynchronously by the project controller after the Project
+				// resources have been re-created, so retry namespaced creations until the namespace exists.
+				if obj.GetNamespace() != "" && apierrors.IsNotFound(err) {
+					return retry.MinorError(fmt.Errorf("failed creating %T %s: %w", obj, client.ObjectKeyFromObject(obj), err))
+				}
+				return retry.SevereError(fmt.Errorf("failed creating %T %s: %w", obj, client.ObjectKeyFromObject(obj), err))
+			}
+			return retry.Ok()
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Decode decrypts the given snapshot data with the given 32 byte key and decodes it into a Snapshot.
+func Decode(data, encryptionKey []byte) (*Snapshot, error) {
+	plaintext, err := decrypt(data, encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	gzipReader, err := gzip.NewReader(bytes.NewReader(plaintext))
+	if err != nil {
+		return nil, fmt.Errorf("failed creating gzip reader: %w", err)
+	}
+
+	snapshot := &Snapshot{}
+	if err := json.NewDecoder(gzipReader).Decode(snapshot); err != nil {
+		return nil, fmt.Errorf("failed decoding snapshot: %w", err)
+	}
+
+	if snapshot.Version != SnapshotVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %q, expected %q", snapshot.Version, SnapshotVersion)
+	}
+
+	return snapshot, nil
+}
+
+func encode(snapshot *Snapshot, encryptionKey []byte) ([]byte, error) {
+	var buffer bytes.Buffer
+
+	gzipWriter := gzip.NewWriter(&buffer)
+	if err := json.NewEncoder(gzipWriter).Encode(snapshot); err != nil {
+		return nil, fmt.Errorf("failed encoding snapshot: %w", err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return nil, fmt.Errorf("failed closing gzip writer: %w", err)
+	}
+
+	return encrypt(buffer.Bytes(), encryptionKey)
+}
+
+func encrypt(plaintext, key []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("failed generating nonce: %w", err)
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func decrypt(ciphertext, key []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, fmt.Errorf("ciphertext is too short")
+	}
+
+	plaintext, err := aead.Open(nil, ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():], nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed decrypting snapshot: %w", err)
+	}
+
+	return plaintext, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != encryptionKeyLength {
+		return nil, fmt.Errorf("encryption key must be %d bytes long, got %d", encryptionKeyLength, len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,193 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backup_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/utils/gardener/backup"
+	"github.com/gardener/gardener/pkg/utils/retry"
+	retryfake "github.com/gardener/gardener/pkg/utils/retry/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Snapshot", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+
+		encryptionKey = []byte("01234567890123456789012345678901")
+
+		project       *gardencorev1beta1.Project
+		cloudProfile  *gardencorev1beta1.CloudProfile
+		quota         *gardencorev1beta1.Quota
+		secretBinding *gardencorev1beta1.SecretBinding
+		shoot         *gardencorev1beta1.Shoot
+		secret        *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+
+		project = &gardencorev1beta1.Project{ObjectMeta: metav1.ObjectMeta{Name: "test-project"}}
+		cloudProfile = &gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "test-profile"}}
+		quota = &gardencorev1beta1.Quota{ObjectMeta: metav1.ObjectMeta{Name: "test-quota", Namespace: "garden-test"}}
+		secretBinding = &gardencorev1beta1.SecretBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-binding", Namespace: "garden-test"},
+			SecretRef:  corev1.SecretReference{Name: "test-secret", Namespace: "garden-test"},
+		}
+		shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "test-shoot", Namespace: "garden-test"}}
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-secret", Namespace: "garden-test"},
+			Data:       map[string][]byte{"password": []byte("super-secret")},
+		}
+	})
+
+	createAll := func() {
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+		Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+		Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+		Expect(fakeClient.Create(ctx, secretBinding)).To(Succeed())
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		Expect(fakeClient.Create(ctx, secret)).To(Succeed())
+	}
+
+	Describe("#Export", func() {
+		It("should export all resources into a decodable snapshot", func() {
+			createAll()
+
+			data, err := Export(ctx, fakeClient, encryptionKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			snapshot, err := Decode(data, encryptionKey)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshot.Version).To(Equal(SnapshotVersion))
+			Expect(snapshot.Projects).To(HaveLen(1))
+			Expect(snapshot.CloudProfiles).To(HaveLen(1))
+			Expect(snapshot.Quotas).To(HaveLen(1))
+			Expect(snapshot.SecretBindings).To(HaveLen(1))
+			Expect(snapshot.Shoots).To(HaveLen(1))
+			Expect(snapshot.Secrets).To(HaveLen(1))
+		})
+
+		It("should not export any secret data", func() {
+			createAll()
+
+			data, err := Export(ctx, fakeClient, encryptionKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			snapshot, err := Decode(data, encryptionKey)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshot.Secrets[0].Data).To(BeEmpty())
+		})
+
+		It("should skip secrets which do not exist", func() {
+			Expect(fakeClient.Create(ctx, secretBinding)).To(Succeed())
+
+			data, err := Export(ctx, fakeClient, encryptionKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			snapshot, err := Decode(data, encryptionKey)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshot.Secrets).To(BeEmpty())
+		})
+
+		It("should fail for an invalid encryption key", func() {
+			_, err := Export(ctx, fakeClient, []byte("too-short"))
+			Expect(err).To(MatchError(ContainSubstring("encryption key must be")))
+		})
+	})
+
+	Describe("#Decode", func() {
+		It("should fail when decrypting with a wrong key", func() {
+			data, err := Export(ctx, fakeClient, encryptionKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = Decode(data, []byte("10987654321098765432109876543210"))
+			Expect(err).To(MatchError(ContainSubstring("failed decrypting snapshot")))
+		})
+
+		It("should fail for truncated data", func() {
+			_, err := Decode([]byte("foo"), encryptionKey)
+			Expect(err).To(MatchError(ContainSubstring("ciphertext is too short")))
+		})
+	})
+
+	Describe("#Import", func() {
+		It("should re-create all missing resources", func() {
+			createAll()
+
+			data, err := Export(ctx, fakeClient, encryptionKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			restoreClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+			Expect(Import(ctx, restoreClient, data, encryptionKey)).To(Succeed())
+
+			Expect(restoreClient.Get(ctx, client.ObjectKeyFromObject(project), &gardencorev1beta1.Project{})).To(Succeed())
+			Expect(restoreClient.Get(ctx, client.ObjectKeyFromObject(cloudProfile), &gardencorev1beta1.CloudProfile{})).To(Succeed())
+			Expect(restoreClient.Get(ctx, client.ObjectKeyFromObject(quota), &gardencorev1beta1.Quota{})).To(Succeed())
+			Expect(restoreClient.Get(ctx, client.ObjectKeyFromObject(secretBinding), &gardencorev1beta1.SecretBinding{})).To(Succeed())
+			Expect(restoreClient.Get(ctx, client.ObjectKeyFromObject(shoot), &gardencorev1beta1.Shoot{})).To(Succeed())
+			Expect(restoreClient.Get(ctx, client.ObjectKeyFromObject(secret), &corev1.Secret{})).To(Succeed())
+		})
+
+		It("should retry namespaced creations until the project namespace exists", func() {
+			createAll()
+
+			data, err := Export(ctx, fakeClient, encryptionKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			DeferCleanup(test.WithVars(&retry.UntilTimeout, (&retryfake.Ops{MaxAttempts: 2}).UntilTimeout))
+
+			// Fail every namespaced creation once to simulate that the project namespace is only created
+			// asynchronously by the project controller after the Project resource has been re-created.
+			failedOnce := map[client.ObjectKey]bool{}
+			restoreClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					if key := client.ObjectKeyFromObject(obj); obj.GetNamespace() != "" && !failedOnce[key] {
+						failedOnce[key] = true
+						return apierrors.NewNotFound(corev1.Resource("namespaces"), obj.GetNamespace())
+					}
+					return c.Create(ctx, obj, opts...)
+				},
+			}).Build()
+
+			Expect(Import(ctx, restoreClient, data, encryptionKey)).To(Succeed())
+
+			Expect(restoreClient.Get(ctx, client.ObjectKeyFromObject(quota), &gardencorev1beta1.Quota{})).To(Succeed())
+			Expect(restoreClient.Get(ctx, client.ObjectKeyFromObject(secretBinding), &gardencorev1beta1.SecretBinding{})).To(Succeed())
+			Expect(restoreClient.Get(ctx, client.ObjectKeyFromObject(shoot), &gardencorev1beta1.Shoot{})).To(Succeed())
+			Expect(restoreClient.Get(ctx, client.ObjectKeyFromObject(secret), &corev1.Secret{})).To(Succeed())
+		})
+
+		It("should leave existing resources untouched", func() {
+			createAll()
+
+			data, err := Export(ctx, fakeClient, encryptionKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			existingProject := project.DeepCopy()
+			existingProject.Labels = map[string]string{"foo": "bar"}
+			restoreClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithObjects(existingProject).Build()
+
+			Expect(Import(ctx, restoreClient, data, encryptionKey)).To(Succeed())
+
+			result := &gardencorev1beta1.Project{}
+			Expect(restoreClient.Get(ctx, client.ObjectKeyFromObject(project), result)).To(Succeed())
+			Expect(result.Labels).To(HaveKeyWithValue("foo", "bar"))
+		})
+	})
+})
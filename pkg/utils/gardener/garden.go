@@ -64,6 +64,16 @@ func DomainIsDefaultDomain(domain string, defaultDomains []*Domain) *Domain {
 	return nil
 }
 
+// DomainIsCustomDomain identifies whether the given domain equals or is a subdomain of one of the given custom domains.
+func DomainIsCustomDomain(domain string, customDomains []*Domain) *Domain {
+	for _, customDomain := range customDomains {
+		if domain == customDomain.Domain || strings.HasSuffix(domain, "."+customDomain.Domain) {
+			return customDomain
+		}
+	}
+	return nil
+}
+
 var gardenRoleReq = utils.MustNewRequirement(v1beta1constants.GardenRole, selection.Exists)
 
 // ReadGardenInternalDomain reads the internal domain information from the Garden cluster.
@@ -211,6 +221,36 @@ func ReadGardenDefaultDomainsSecrets(
 	return secretList.Items, nil
 }
 
+// ReadProjectCustomDomains reads the custom domain secrets from the given project namespace and returns the domain
+// information constructed from them. The passed reader should target the garden cluster.
+func ReadProjectCustomDomains(
+	ctx context.Context,
+	c client.Reader,
+	namespace string,
+) (
+	[]*Domain,
+	error,
+) {
+	secretList := &corev1.SecretList{}
+	if err := c.List(ctx, secretList, client.InNamespace(namespace), client.MatchingLabels{
+		v1beta1constants.GardenRole: v1beta1constants.GardenRoleCustomDomain,
+	}); err != nil {
+		return nil, err
+	}
+
+	var domains []*Domain
+
+	for _, secret := range secretList.Items {
+		domain, err := constructDomainFromSecret(&secret)
+		if err != nil {
+			return nil, fmt.Errorf("error constructing custom domain from secret %s: %w", client.ObjectKeyFromObject(&secret), err)
+		}
+		domains = append(domains, domain)
+	}
+
+	return domains, nil
+}
+
 // ReadInternalDomainSecret reads the internal domain secret from the given namespace.
 // If enforceSecret is true, an error is returned if no secret is found.
 // If enforceSecret is false, the function can return (nil, nil) in case no internal domain secret is found.
@@ -47,6 +47,17 @@ var _ = Describe("Garden", func() {
 		Entry("no default domain but with same suffix", "foo.foobar.com", []*Domain{defaultDomain}, BeNil()),
 	)
 
+	DescribeTable("#DomainIsCustomDomain",
+		func(domain string, customDomains []*Domain, expected gomegatypes.GomegaMatcher) {
+			Expect(DomainIsCustomDomain(domain, customDomains)).To(expected)
+		},
+
+		Entry("no custom domain", "foo.bar.com", nil, BeNil()),
+		Entry("custom domain used directly", "bar.com", []*Domain{defaultDomain}, Equal(defaultDomain)),
+		Entry("subdomain of custom domain", "foo.bar.com", []*Domain{defaultDomain}, Equal(defaultDomain)),
+		Entry("no custom domain but with same suffix", "foo.foobar.com", []*Domain{defaultDomain}, BeNil()),
+	)
+
 	Describe("#NewGardenAccessSecret", func() {
 		var (
 			name      = "name"
@@ -882,4 +893,76 @@ var _ = Describe("Garden", func() {
 			Expect([]string{result[0].Name, result[1].Name}).To(Equal([]string{"first", "second"}))
 		})
 	})
+
+	Describe("#ReadProjectCustomDomains", func() {
+		var (
+			ctx       = context.Background()
+			client    client.Client
+			namespace = "garden-local"
+		)
+
+		BeforeEach(func() {
+			client = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		})
+
+		It("should return empty slice when no custom domain secrets exist", func() {
+			result, err := ReadProjectCustomDomains(ctx, client, namespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(BeEmpty())
+		})
+
+		It("should return domain information from labeled secrets in the project namespace", func() {
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "custom-domain",
+					Namespace: namespace,
+					Labels:    map[string]string{constants.GardenRole: constants.GardenRoleCustomDomain},
+					Annotations: map[string]string{
+						"dns.gardener.cloud/provider": "route-53",
+						"dns.gardener.cloud/domain":   "custom.example.com",
+						"dns.gardener.cloud/zone":     "zone-1",
+					},
+				},
+				Data: map[string][]byte{"foo": []byte("bar")},
+			}
+			otherSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "unrelated",
+					Namespace: namespace,
+				},
+			}
+
+			Expect(client.Create(ctx, secret)).To(Succeed())
+			Expect(client.Create(ctx, otherSecret)).To(Succeed())
+
+			result, err := ReadProjectCustomDomains(ctx, client, namespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(ConsistOf(&Domain{
+				Domain:     "custom.example.com",
+				Provider:   "route-53",
+				Zone:       "zone-1",
+				SecretData: map[string][]byte{"foo": []byte("bar")},
+			}))
+		})
+
+		It("should error if custom domain secret is malformed", func() {
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "malformed-secret",
+					Namespace: namespace,
+					Labels:    map[string]string{constants.GardenRole: constants.GardenRoleCustomDomain},
+					Annotations: map[string]string{
+						"dns.gardener.cloud/provider": "route-53",
+						// Missing domain annotation
+					},
+				},
+			}
+
+			Expect(client.Create(ctx, secret)).To(Succeed())
+
+			result, err := ReadProjectCustomDomains(ctx, client, namespace)
+			Expect(result).To(BeNil())
+			Expect(err).To(MatchError(ContainSubstring("error constructing custom domain from secret")))
+		})
+	})
 })
@@ -10,6 +10,9 @@ const (
 
 	// AnnotationKeyResourcesLabeled is an annotation indicating the completion of labeling the resources with the credentials.gardener.cloud/key-name label
 	AnnotationKeyResourcesLabeled = "credentials.gardener.cloud/resources-labeled"
+	// AnnotationKeyResourcesMigrated is an annotation listing the resource kinds whose objects have already been
+	// rewritten, so that an interrupted rewrite can be resumed without starting over
+	AnnotationKeyResourcesMigrated = "credentials.gardener.cloud/resources-migrated"
 	// AnnotationKeyEtcdSnapshotted is an annotation indicating that ETCD snapshot was completed
 	AnnotationKeyEtcdSnapshotted = "credentials.gardener.cloud/etcd-snapshotted"
 
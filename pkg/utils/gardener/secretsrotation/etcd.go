@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strings"
 
 	"github.com/go-logr/logr"
 	"golang.org/x/time/rate"
@@ -27,11 +28,19 @@ import (
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
+// ProgressReporterFn is used to report the progress of rewriting encrypted data. It is called after all objects of a
+// resource kind have been rewritten with the number of resource kinds which have already been migrated and the number
+// of resource kinds which are still remaining.
+type ProgressReporterFn func(ctx context.Context, migratedResourceCount, remainingResourceCount int) error
+
 // RewriteEncryptedDataAddLabel patches all encrypted data in all namespaces in the target clusters and adds a label
 // whose value is the name of the current ETCD encryption key secret. This function is useful for the ETCD encryption
 // key secret rotation which requires all encrypted data to be rewritten to ETCD so that they become encrypted with the
 // new key. After it's done, it snapshots ETCD so that we can restore backups in case we lose the cluster before the
 // next incremental snapshot has been taken.
+// Resource kinds whose objects have been rewritten completely are recorded in an annotation on the API server
+// deployment, so that an interrupted rewrite resumes with the remaining kinds instead of starting over. The optional
+// reportProgress function is called after each completed kind.
 func RewriteEncryptedDataAddLabel(
 	ctx context.Context,
 	log logr.Logger,
@@ -43,6 +52,7 @@ func RewriteEncryptedDataAddLabel(
 	resourcesToEncrypt []string,
 	encryptedResources []string,
 	defaultGVKs []schema.GroupVersionKind,
+	reportProgress ProgressReporterFn,
 ) error {
 	// Check if we have to label the resources to rewrite the data.
 	meta := &metav1.PartialObjectMetadata{}
@@ -65,6 +75,18 @@ func RewriteEncryptedDataAddLabel(
 		return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameETCDEncryptionKey)
 	}
 
+	var (
+		totalResourceCount = len(encryptedGVKs)
+		migratedGVKs       = parseMigratedGVKs(meta.Annotations[AnnotationKeyResourcesMigrated]).Intersection(sets.New(encryptedGVKs...))
+		gvksToRewrite      []schema.GroupVersionKind
+	)
+
+	for _, gvk := range encryptedGVKs {
+		if !migratedGVKs.Has(gvk) {
+			gvksToRewrite = append(gvksToRewrite, gvk)
+		}
+	}
+
 	if err := rewriteEncryptedData(
 		ctx,
 		log,
@@ -74,7 +96,21 @@ func RewriteEncryptedDataAddLabel(
 			metav1.SetMetaDataLabel(objectMeta, labelKeyRotationKeyName, etcdEncryptionKeySecret.Name)
 		},
 		message+" (Add label)",
-		encryptedGVKs...,
+		func(ctx context.Context, gvk schema.GroupVersionKind) error {
+			migratedGVKs.Insert(gvk)
+
+			if err := PatchAPIServerDeploymentMeta(ctx, runtimeClient, namespace, name, func(meta *metav1.PartialObjectMetadata) {
+				metav1.SetMetaDataAnnotation(&meta.ObjectMeta, AnnotationKeyResourcesMigrated, formatMigratedGVKs(migratedGVKs))
+			}); err != nil {
+				return fmt.Errorf("failed to record migrated resource kinds on API Server deployment: %w", err)
+			}
+
+			if reportProgress != nil {
+				return reportProgress(ctx, migratedGVKs.Len(), totalResourceCount-migratedGVKs.Len())
+			}
+			return nil
+		},
+		gvksToRewrite...,
 	); err != nil {
 		return fmt.Errorf("failed to rewrite encrypted data: %w", err)
 	}
@@ -116,6 +152,7 @@ func RewriteEncryptedDataRemoveLabel(
 			delete(objectMeta.Labels, labelKeyRotationKeyName)
 		},
 		message+" (Remove label)",
+		nil,
 		encryptedGVKs...,
 	); err != nil {
 		return fmt.Errorf("failed to rewrite encrypted data: %w", err)
@@ -124,6 +161,7 @@ func RewriteEncryptedDataRemoveLabel(
 	if err := PatchAPIServerDeploymentMeta(ctx, runtimeClient, namespace, name, func(meta *metav1.PartialObjectMetadata) {
 		delete(meta.Annotations, AnnotationKeyEtcdSnapshotted)
 		delete(meta.Annotations, AnnotationKeyResourcesLabeled)
+		delete(meta.Annotations, AnnotationKeyResourcesMigrated)
 	}); err != nil {
 		return fmt.Errorf("failed to remove annotations from API Server deployment: %w", err)
 	}
@@ -137,6 +175,7 @@ func rewriteEncryptedData(
 	requirement labels.Requirement,
 	mutateObjectMeta func(*metav1.ObjectMeta),
 	message string,
+	onKindCompleted func(ctx context.Context, gvk schema.GroupVersionKind) error,
 	gvks ...schema.GroupVersionKind,
 ) error {
 	var (
@@ -181,12 +220,45 @@ func rewriteEncryptedData(
 
 		// Execute the tasks for the current GVK in parallel.
 		taskFns = append(taskFns, flow.Parallel(fns...))
+
+		if onKindCompleted != nil {
+			taskFns = append(taskFns, func(ctx context.Context) error {
+				return onKindCompleted(ctx, gvk)
+			})
+		}
 	}
 
 	// Execute the sets of tasks for different GVKs in sequence.
 	return flow.Sequential(taskFns...)(ctx)
 }
 
+// formatMigratedGVKs serializes the given GroupVersionKinds into the value of the AnnotationKeyResourcesMigrated
+// annotation, each entry in the form "Kind.version.group".
+func formatMigratedGVKs(gvks sets.Set[schema.GroupVersionKind]) string {
+	var entries []string
+	for _, gvk := range gvks.UnsortedList() {
+		entries = append(entries, fmt.Sprintf("%s.%s.%s", gvk.Kind, gvk.Version, gvk.Group))
+	}
+
+	slices.Sort(entries)
+	return strings.Join(entries, ",")
+}
+
+// parseMigratedGVKs parses the value of the AnnotationKeyResourcesMigrated annotation.
+func parseMigratedGVKs(value string) sets.Set[schema.GroupVersionKind] {
+	gvks := sets.New[schema.GroupVersionKind]()
+
+	for _, entry := range strings.Split(value, ",") {
+		parts := strings.SplitN(entry, ".", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		gvks.Insert(schema.GroupVersionKind{Group: parts[2], Version: parts[1], Kind: parts[0]})
+	}
+
+	return gvks
+}
+
 // SnapshotETCDAfterRewritingEncryptedData performs a full snapshot on ETCD after the encrypted data (like secrets) have
 // been rewritten as part of the ETCD encryption secret rotation. It adds an annotation to the API server deployment
 // after it's done so that it does not take another snapshot again after it succeeded once.
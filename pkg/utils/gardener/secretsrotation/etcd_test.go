@@ -170,7 +170,7 @@ var _ = Describe("ETCD", func() {
 
 				defaultGVKs := []schema.GroupVersionKind{corev1.SchemeGroupVersion.WithKind("Secret")}
 
-				Expect(RewriteEncryptedDataAddLabel(ctx, logger, runtimeClient, fakeTargetInterface, fakeSecretsManager, kubeAPIServerNamespace, kubeAPIServerDeploymentName, resources, resources, defaultGVKs)).To(Succeed())
+				Expect(RewriteEncryptedDataAddLabel(ctx, logger, runtimeClient, fakeTargetInterface, fakeSecretsManager, kubeAPIServerNamespace, kubeAPIServerDeploymentName, resources, resources, defaultGVKs, nil)).To(Succeed())
 
 				for _, obj := range []client.Object{
 					secret1, secret2, secret3,
@@ -200,6 +200,43 @@ var _ = Describe("ETCD", func() {
 				Expect(kubeAPIServerDeployment.Annotations).To(HaveKeyWithValue("credentials.gardener.cloud/resources-labeled", "true"))
 			})
 
+			It("should skip already migrated resource kinds, record the migrated kinds and report progress", func() {
+				Expect(runtimeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-etcd-encryption-key-current", Namespace: kubeAPIServerNamespace}})).To(Succeed())
+
+				resources := []string{
+					corev1.Resource("secrets").String(),
+					corev1.Resource("configmaps").String(),
+					appsv1.Resource("deployments").String(),
+					discoveryv1.Resource("endpointslices").String(),
+				}
+
+				defaultGVKs := []schema.GroupVersionKind{corev1.SchemeGroupVersion.WithKind("Secret")}
+
+				metav1.SetMetaDataAnnotation(&kubeAPIServerDeployment.ObjectMeta, "credentials.gardener.cloud/resources-migrated", "ConfigMap.v1.")
+				Expect(runtimeClient.Update(ctx, kubeAPIServerDeployment)).To(Succeed())
+
+				var progressReports [][]int
+				reportProgress := func(_ context.Context, migratedResourceCount, remainingResourceCount int) error {
+					progressReports = append(progressReports, []int{migratedResourceCount, remainingResourceCount})
+					return nil
+				}
+
+				Expect(RewriteEncryptedDataAddLabel(ctx, logger, runtimeClient, fakeTargetInterface, fakeSecretsManager, kubeAPIServerNamespace, kubeAPIServerDeploymentName, resources, resources, defaultGVKs, reportProgress)).To(Succeed())
+
+				// configmaps were recorded as migrated already, so they must not have been rewritten again
+				Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(configMap2), configMap2)).To(Succeed())
+				Expect(configMap2.ResourceVersion).To(Equal(configMap2ResourceVersion))
+
+				Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret1), secret1)).To(Succeed())
+				Expect(secret1.ResourceVersion).NotTo(Equal(secret1ResourceVersion))
+
+				Expect(progressReports).To(Equal([][]int{{2, 2}, {3, 1}, {4, 0}}))
+
+				Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(kubeAPIServerDeployment), kubeAPIServerDeployment)).To(Succeed())
+				Expect(kubeAPIServerDeployment.Annotations).To(HaveKeyWithValue("credentials.gardener.cloud/resources-labeled", "true"))
+				Expect(kubeAPIServerDeployment.Annotations).To(HaveKeyWithValue("credentials.gardener.cloud/resources-migrated", "ConfigMap.v1.,Deployment.v1.apps,EndpointSlice.v1.discovery.k8s.io,Secret.v1."))
+			})
+
 			It("should not label the resources if the kube-apiserver deployment has the resources-labeled annotation", func() {
 				Expect(runtimeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-etcd-encryption-key-current", Namespace: kubeAPIServerNamespace}})).To(Succeed())
 
@@ -215,7 +252,7 @@ var _ = Describe("ETCD", func() {
 				metav1.SetMetaDataAnnotation(&kubeAPIServerDeployment.ObjectMeta, "credentials.gardener.cloud/resources-labeled", "true")
 				Expect(runtimeClient.Update(ctx, kubeAPIServerDeployment)).To(Succeed())
 
-				Expect(RewriteEncryptedDataAddLabel(ctx, logger, runtimeClient, fakeTargetInterface, fakeSecretsManager, kubeAPIServerNamespace, kubeAPIServerDeploymentName, resources, resources, defaultGVKs)).To(Succeed())
+				Expect(RewriteEncryptedDataAddLabel(ctx, logger, runtimeClient, fakeTargetInterface, fakeSecretsManager, kubeAPIServerNamespace, kubeAPIServerDeploymentName, resources, resources, defaultGVKs, nil)).To(Succeed())
 
 				Expect(secret1.ResourceVersion).To(Equal(secret1ResourceVersion))
 				Expect(secret2.ResourceVersion).To(Equal(secret2ResourceVersion))
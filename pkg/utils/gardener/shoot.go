@@ -144,7 +144,7 @@ func EffectiveShootMaintenanceTimeWindow(shoot *gardencorev1beta1.Shoot) *timewi
 		return timewindow.AlwaysTimeWindow
 	}
 
-	timeWindow, err := timewindow.ParseMaintenanceTimeWindow(maintenance.TimeWindow.Begin, maintenance.TimeWindow.End)
+	timeWindow, err := ShootMaintenanceTimeWindow(maintenance.TimeWindow)
 	if err != nil {
 		return timewindow.AlwaysTimeWindow
 	}
@@ -152,6 +152,16 @@ func EffectiveShootMaintenanceTimeWindow(shoot *gardencorev1beta1.Shoot) *timewi
 	return EffectiveMaintenanceTimeWindow(timeWindow)
 }
 
+// ShootMaintenanceTimeWindow parses the given maintenance time window of a shoot. If a location is specified, the
+// clock times of begin and end are evaluated in this location (honoring daylight saving time changes). Otherwise, the
+// zone offsets of begin and end apply.
+func ShootMaintenanceTimeWindow(timeWindow *gardencorev1beta1.MaintenanceTimeWindow) (*timewindow.MaintenanceTimeWindow, error) {
+	if timeWindow.Location != nil {
+		return timewindow.ParseMaintenanceTimeWindowInLocation(timeWindow.Begin, timeWindow.End, *timeWindow.Location)
+	}
+	return timewindow.ParseMaintenanceTimeWindow(timeWindow.Begin, timeWindow.End)
+}
+
 // GetShootNameFromOwnerReferences attempts to get the name of the Shoot object which owns the passed in object.
 // If it is not owned by a Shoot, an empty string is returned.
 func GetShootNameFromOwnerReferences(objectMeta metav1.Object) string {
@@ -205,6 +215,8 @@ const (
 	ShootProjectSecretSuffixCACluster = "ca-cluster"
 	// ShootProjectSecretSuffixCAClient is a constant for a shoot project secret with suffix 'ca-client'.
 	ShootProjectSecretSuffixCAClient = "ca-client"
+	// ShootProjectSecretSuffixCASSH is a constant for a shoot project secret with suffix 'ca-ssh'.
+	ShootProjectSecretSuffixCASSH = "ca-ssh"
 	// ShootProjectSecretSuffixSSHKeypair is a constant for a shoot project secret with suffix 'ssh-keypair'.
 	ShootProjectSecretSuffixSSHKeypair = v1beta1constants.SecretNameSSHKeyPair
 	// ShootProjectSecretSuffixOldSSHKeypair is a constant for a shoot project secret with suffix 'ssh-keypair.old'.
@@ -231,6 +243,7 @@ func GetShootProjectSecretSuffixes() []string {
 func GetShootProjectInternalSecretSuffixes() []string {
 	return []string{
 		ShootProjectSecretSuffixCAClient,
+		ShootProjectSecretSuffixCASSH,
 	}
 }
 
@@ -665,7 +678,21 @@ func ConstructExternalDomain(ctx context.Context, c client.Reader, shoot *garden
 		}
 
 	default:
-		return nil, &IncompleteDNSConfigError{}
+		// The shoot neither uses a default domain nor specifies an own primary DNS provider - check whether the domain
+		// is covered by a custom domain secret registered in the project namespace.
+		customDomains, err := ReadProjectCustomDomains(ctx, c, shoot.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("could not read custom domains of project namespace %q: %w", shoot.Namespace, err)
+		}
+
+		customDomain := DomainIsCustomDomain(*externalClusterDomain, customDomains)
+		if customDomain == nil {
+			return nil, &IncompleteDNSConfigError{}
+		}
+
+		externalDomain.SecretData = customDomain.SecretData
+		externalDomain.Provider = customDomain.Provider
+		externalDomain.Zone = customDomain.Zone
 	}
 
 	return externalDomain, nil
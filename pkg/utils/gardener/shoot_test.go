@@ -356,7 +356,7 @@ var _ = Describe("Shoot", func() {
 
 	Describe("#GetShootProjectInternalSecretSuffixes", func() {
 		It("should return the expected list", func() {
-			Expect(GetShootProjectInternalSecretSuffixes()).To(ConsistOf("ca-client"))
+			Expect(GetShootProjectInternalSecretSuffixes()).To(ConsistOf("ca-client", "ca-ssh"))
 		})
 	})
 
@@ -1121,6 +1121,80 @@ var _ = Describe("Shoot", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("returns the custom domain secret of the project namespace", func() {
+			var (
+				ctx = context.TODO()
+
+				customDomainSecretData = map[string][]byte{"custom": []byte("domain")}
+				shoot                  = &gardencorev1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: namespace,
+					},
+					Spec: gardencorev1beta1.ShootSpec{
+						DNS: &gardencorev1beta1.DNS{
+							Domain: &domain,
+						},
+					},
+				}
+			)
+
+			Expect(fakeClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-custom-domain",
+					Namespace: namespace,
+					Labels:    map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleCustomDomain},
+					Annotations: map[string]string{
+						"dns.gardener.cloud/provider": "custom-domain-provider",
+						"dns.gardener.cloud/domain":   "bar.com",
+						"dns.gardener.cloud/zone":     "zone-1",
+					},
+				},
+				Data: customDomainSecretData,
+			})).To(Succeed())
+
+			externalDomain, err := ConstructExternalDomain(ctx, fakeClient, shoot, nil, nil)
+
+			Expect(externalDomain).To(Equal(&Domain{
+				Domain:     domain,
+				Provider:   "custom-domain-provider",
+				Zone:       "zone-1",
+				SecretData: customDomainSecretData,
+			}))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns an error because no custom domain matches the shoot domain", func() {
+			var (
+				ctx = context.TODO()
+
+				shoot = &gardencorev1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: namespace,
+					},
+					Spec: gardencorev1beta1.ShootSpec{
+						DNS: &gardencorev1beta1.DNS{
+							Domain: &domain,
+						},
+					},
+				}
+			)
+
+			Expect(fakeClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-custom-domain",
+					Namespace: namespace,
+					Labels:    map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleCustomDomain},
+					Annotations: map[string]string{
+						"dns.gardener.cloud/provider": "custom-domain-provider",
+						"dns.gardener.cloud/domain":   "other.com",
+					},
+				},
+			})).To(Succeed())
+
+			_, err := ConstructExternalDomain(ctx, fakeClient, shoot, nil, nil)
+			Expect(err).To(MatchError(&IncompleteDNSConfigError{}))
+		})
+
 		It("returns the shoot secret", func() {
 			var (
 				ctx = context.TODO()
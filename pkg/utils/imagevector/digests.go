@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imagevector
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+var (
+	// digestCache caches resolved digests per image reference. Items are never removed from the cache, so each tag is
+	// resolved at most once per process.
+	digestCache      = map[string]string{}
+	digestCacheMutex sync.RWMutex
+)
+
+// ResolveTagsToDigests resolves the tags of all images in the given vector to their digests by querying the respective
+// registries. Images which are already pinned (via a sha256 tag or a ref) are returned unchanged. Resolved digests are
+// cached in memory.
+func ResolveTagsToDigests(vector ImageVector) (ImageVector, error) {
+	out := make(ImageVector, 0, len(vector))
+
+	for _, source := range vector {
+		resolved, err := resolveTagToDigest(source)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, resolved)
+	}
+
+	return out, nil
+}
+
+func resolveTagToDigest(source *ImageSource) (*ImageSource, error) {
+	if source.Ref != nil || source.Repository == nil || source.Tag == nil || strings.HasPrefix(*source.Tag, SHA256TagPrefix) {
+		return source, nil
+	}
+
+	image := *source.Repository + ":" + *source.Tag
+
+	digestCacheMutex.RLock()
+	digest, found := digestCache[image]
+	digestCacheMutex.RUnlock()
+
+	if !found {
+		ref, err := name.ParseReference(image, name.StrictValidation)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing image reference %q: %w", image, err)
+		}
+
+		desc, err := remote.Head(ref)
+		if err != nil {
+			return nil, fmt.Errorf("failed resolving digest for image %q: %w", image, err)
+		}
+		digest = desc.Digest.String()
+
+		digestCacheMutex.Lock()
+		digestCache[image] = digest
+		digestCacheMutex.Unlock()
+	}
+
+	out := *source
+	out.Tag = &digest
+
+	return &out, nil
+}
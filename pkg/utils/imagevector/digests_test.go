@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imagevector_test
+
+import (
+	"log"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+var _ = Describe("digests", func() {
+	Describe("#ResolveTagsToDigests", func() {
+		var (
+			server *httptest.Server
+			repo   string
+			digest string
+		)
+
+		BeforeEach(func() {
+			server = httptest.NewServer(registry.New(registry.Logger(log.New(GinkgoWriter, "", 0))))
+			DeferCleanup(func() { server.Close() })
+
+			u, err := url.Parse(server.URL)
+			Expect(err).NotTo(HaveOccurred())
+			repo = u.Host + "/test/image"
+
+			img, err := random.Image(256, 1)
+			Expect(err).NotTo(HaveOccurred())
+
+			ref, err := name.ParseReference(repo + ":v1.0.0")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(remote.Write(ref, img)).To(Succeed())
+
+			d, err := img.Digest()
+			Expect(err).NotTo(HaveOccurred())
+			digest = d.String()
+		})
+
+		It("should resolve tags to digests and cache the result", func() {
+			vector := ImageVector{{Name: "test", Repository: ptr.To(repo), Tag: ptr.To("v1.0.0")}}
+
+			resolved, err := ResolveTagsToDigests(vector)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resolved).To(HaveLen(1))
+			Expect(resolved[0].Tag).To(PointTo(Equal(digest)))
+
+			// the digest must now be served from the cache, i.e. resolution must work without registry access
+			server.Close()
+
+			resolved, err = ResolveTagsToDigests(vector)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resolved[0].Tag).To(PointTo(Equal(digest)))
+		})
+
+		It("should keep images which are already pinned unchanged", func() {
+			vector := ImageVector{
+				{Name: "ref", Ref: ptr.To("example.com/test/image:v1.0.0")},
+				{Name: "sha", Repository: ptr.To("example.com/test/image"), Tag: ptr.To("sha256:" + strings.Repeat("a", 64))},
+				{Name: "tagless", Repository: ptr.To("example.com/test/image")},
+			}
+
+			Expect(ResolveTagsToDigests(vector)).To(Equal(vector))
+		})
+
+		It("should fail for unresolvable images", func() {
+			vector := ImageVector{{Name: "test", Repository: ptr.To(repo), Tag: ptr.To("v9.9.9")}}
+
+			_, err := ResolveTagsToDigests(vector)
+			Expect(err).To(MatchError(ContainSubstring("failed resolving digest")))
+		})
+	})
+})
@@ -189,6 +189,16 @@ func WithEnvOverride(vector ImageVector, env string) (ImageVector, error) {
 	return Merge(vector, override), nil
 }
 
+// WithOverride reads an ImageVector from the given raw override and merges it with the given one.
+func WithOverride(vector ImageVector, override []byte) (ImageVector, error) {
+	overrideVector, err := Read(override)
+	if err != nil {
+		return nil, err
+	}
+
+	return Merge(vector, overrideVector), nil
+}
+
 // String implements Stringer.
 func (o *FindOptions) String() string {
 	var runtimeVersion string
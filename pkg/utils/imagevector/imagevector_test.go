@@ -355,6 +355,20 @@ images:
 			})
 		})
 
+		Describe("#WithOverride", func() {
+			It("should override the ImageVector with the given raw override", func() {
+				vector := ImageVector{image1Src3, image2Src1}
+
+				Expect(WithOverride(vector, []byte(image1Src1VectorJSON))).To(Equal(ImageVector{image1Src1, image2Src1}))
+			})
+
+			It("should fail if the raw override is no valid image vector", func() {
+				vector, err := WithOverride(image1Src1Vector, []byte("foo"))
+				Expect(vector).To(BeNil())
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
 		DescribeTable("#FindImage",
 			func(vec ImageVector, name string, opts []FindOptionFunc, imageMatcher, errorMatcher types.GomegaMatcher) {
 				image, err := vec.FindImage(name, opts...)
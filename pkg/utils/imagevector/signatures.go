@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imagevector
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+const (
+	// cosignSignatureTagSuffix is the suffix of the tag under which cosign stores the signatures of an image in the
+	// image's repository.
+	cosignSignatureTagSuffix = ".sig"
+	// cosignSignatureAnnotation is the annotation on a signature layer which contains the base64-encoded signature over
+	// the layer's payload.
+	cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+)
+
+// cosignPayload is the simple signing payload which cosign signs, see
+// https://github.com/containers/image/blob/main/docs/containers-signature.5.md.
+type cosignPayload struct {
+	Critical struct {
+		Identity struct {
+			DockerReference string `json:"docker-reference"`
+		} `json:"identity"`
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// ParsePublicKey parses the given PEM-encoded public key for use in VerifySignatures. ECDSA, RSA, and Ed25519 keys are
+// supported.
+func ParsePublicKey(pemEncodedKey string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(pemEncodedKey))
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing public key: %w", err)
+	}
+
+	switch key.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", key)
+	}
+}
+
+// VerifySignatures verifies the cosign signatures of all images in the given vector against the given PEM-encoded
+// public keys. For each image, at least one signature must verify against one of the configured keys, and the signed
+// payload must match the image's repository and digest.
+func VerifySignatures(vector ImageVector, pemEncodedKeys []string) error {
+	keys := make([]crypto.PublicKey, 0, len(pemEncodedKeys))
+	for i, pemEncodedKey := range pemEncodedKeys {
+		key, err := ParsePublicKey(pemEncodedKey)
+		if err != nil {
+			return fmt.Errorf("invalid public key at index %d: %w", i, err)
+		}
+		keys = append(keys, key)
+	}
+
+	for _, source := range vector {
+		if err := verifySignature(source, keys); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func verifySignature(source *ImageSource, keys []crypto.PublicKey) error {
+	image := source.ToImage(nil).String()
+
+	ref, err := name.ParseReference(image, name.StrictValidation)
+	if err != nil {
+		return fmt.Errorf("failed parsing image reference %q: %w", image, err)
+	}
+
+	digest, err := resolveDigest(ref)
+	if err != nil {
+		return fmt.Errorf("failed resolving digest for image %q: %w", image, err)
+	}
+
+	signatureTag := strings.Replace(digest, ":", "-", 1) + cosignSignatureTagSuffix
+
+	signatureImage, err := remote.Image(ref.Context().Tag(signatureTag))
+	if err != nil {
+		return fmt.Errorf("failed fetching signatures for image %q: %w", image, err)
+	}
+
+	manifest, err := signatureImage.Manifest()
+	if err != nil {
+		return fmt.Errorf("failed reading signature manifest for image %q: %w", image, err)
+	}
+
+	for _, layerDescriptor := range manifest.Layers {
+		encodedSignature, ok := layerDescriptor.Annotations[cosignSignatureAnnotation]
+		if !ok {
+			continue
+		}
+
+		signature, err := base64.StdEncoding.DecodeString(encodedSignature)
+		if err != nil {
+			continue
+		}
+
+		layer, err := signatureImage.LayerByDigest(layerDescriptor.Digest)
+		if err != nil {
+			return fmt.Errorf("failed reading signature payload for image %q: %w", image, err)
+		}
+
+		reader, err := layer.Uncompressed()
+		if err != nil {
+			return fmt.Errorf("failed reading signature payload for image %q: %w", image, err)
+		}
+
+		payload, err := io.ReadAll(reader)
+		_ = reader.Close()
+		if err != nil {
+			return fmt.Errorf("failed reading signature payload for image %q: %w", image, err)
+		}
+
+		if !verifyPayloadIdentity(payload, ref.Context().Name(), digest) {
+			continue
+		}
+
+		for _, key := range keys {
+			if verifyPayloadSignature(key, payload, signature) {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("failed verifying signature for image %q: no valid signature found for any of the configured public keys", image)
+}
+
+func resolveDigest(ref name.Reference) (string, error) {
+	if digest, ok := ref.(name.Digest); ok {
+		return digest.DigestStr(), nil
+	}
+
+	desc, err := remote.Head(ref)
+	if err != nil {
+		return "", err
+	}
+
+	return desc.Digest.String(), nil
+}
+
+func verifyPayloadIdentity(payload []byte, repository, digest string) bool {
+	var p cosignPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return false
+	}
+
+	return p.Critical.Identity.DockerReference == repository && p.Critical.Image.DockerManifestDigest == digest
+}
+
+func verifyPayloadSignature(key crypto.PublicKey, payload, signature []byte) bool {
+	hashed := sha256.Sum256(payload)
+
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(k, hashed[:], signature)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, hashed[:], signature) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(k, payload, signature)
+	default:
+		return false
+	}
+}
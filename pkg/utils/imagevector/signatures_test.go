@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imagevector_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+var _ = Describe("signatures", func() {
+	Describe("#ParsePublicKey", func() {
+		It("should parse a valid ECDSA public key", func() {
+			_, publicKeyPEM := generateKeyPair()
+
+			Expect(ParsePublicKey(publicKeyPEM)).NotTo(BeNil())
+		})
+
+		It("should fail for garbage input", func() {
+			_, err := ParsePublicKey("foo")
+			Expect(err).To(MatchError(ContainSubstring("no PEM block found")))
+		})
+
+		It("should fail for non-key PEM input", func() {
+			_, err := ParsePublicKey("-----BEGIN PUBLIC KEY-----\nZm9v\n-----END PUBLIC KEY-----\n")
+			Expect(err).To(MatchError(ContainSubstring("failed parsing public key")))
+		})
+	})
+
+	Describe("#VerifySignatures", func() {
+		var (
+			server *httptest.Server
+			repo   string
+			vector ImageVector
+
+			privateKey   *ecdsa.PrivateKey
+			publicKeyPEM string
+		)
+
+		BeforeEach(func() {
+			server = httptest.NewServer(registry.New(registry.Logger(log.New(GinkgoWriter, "", 0))))
+			DeferCleanup(func() { server.Close() })
+
+			u, err := url.Parse(server.URL)
+			Expect(err).NotTo(HaveOccurred())
+			repo = u.Host + "/test/image"
+			vector = ImageVector{{Name: "test", Repository: ptr.To(repo), Tag: ptr.To("v1.0.0")}}
+
+			privateKey, publicKeyPEM = generateKeyPair()
+		})
+
+		It("should succeed if the image is signed with the configured key", func() {
+			signImage(pushImage(repo, "v1.0.0"), repo, repo, privateKey)
+
+			Expect(VerifySignatures(vector, []string{publicKeyPEM})).To(Succeed())
+		})
+
+		It("should succeed if the image is signed with one of the configured keys", func() {
+			signImage(pushImage(repo, "v1.0.0"), repo, repo, privateKey)
+			_, otherPublicKeyPEM := generateKeyPair()
+
+			Expect(VerifySignatures(vector, []string{otherPublicKeyPEM, publicKeyPEM})).To(Succeed())
+		})
+
+		It("should fail if the image is not signed", func() {
+			pushImage(repo, "v1.0.0")
+
+			Expect(VerifySignatures(vector, []string{publicKeyPEM})).To(MatchError(ContainSubstring("failed fetching signatures")))
+		})
+
+		It("should fail if the image is signed with a different key", func() {
+			otherPrivateKey, _ := generateKeyPair()
+			signImage(pushImage(repo, "v1.0.0"), repo, repo, otherPrivateKey)
+
+			Expect(VerifySignatures(vector, []string{publicKeyPEM})).To(MatchError(ContainSubstring("no valid signature found")))
+		})
+
+		It("should fail if the signed payload references a different image", func() {
+			signImage(pushImage(repo, "v1.0.0"), repo, "example.com/other/image", privateKey)
+
+			Expect(VerifySignatures(vector, []string{publicKeyPEM})).To(MatchError(ContainSubstring("no valid signature found")))
+		})
+
+		It("should fail for invalid public keys", func() {
+			Expect(VerifySignatures(vector, []string{"foo"})).To(MatchError(ContainSubstring("invalid public key at index 0")))
+		})
+	})
+})
+
+func generateKeyPair() (*ecdsa.PrivateKey, string) {
+	GinkgoHelper()
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+
+	der, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	Expect(err).NotTo(HaveOccurred())
+
+	return privateKey, string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func pushImage(repo, tag string) string {
+	GinkgoHelper()
+
+	img, err := random.Image(256, 1)
+	Expect(err).NotTo(HaveOccurred())
+
+	ref, err := name.ParseReference(repo + ":" + tag)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(remote.Write(ref, img)).To(Succeed())
+
+	digest, err := img.Digest()
+	Expect(err).NotTo(HaveOccurred())
+
+	return digest.String()
+}
+
+// signImage creates a cosign-style signature for the image with the given digest in <repo>, signed with the given
+// private key, and pushes it to <repo>. The signed payload references <signedRepo>, which usually equals <repo>.
+func signImage(digest, repo, signedRepo string, privateKey *ecdsa.PrivateKey) {
+	GinkgoHelper()
+
+	payload, err := json.Marshal(map[string]any{
+		"critical": map[string]any{
+			"identity": map[string]any{"docker-reference": signedRepo},
+			"image":    map[string]any{"docker-manifest-digest": digest},
+			"type":     "cosign container image signature",
+		},
+		"optional": nil,
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	hashed := sha256.Sum256(payload)
+	signature, err := ecdsa.SignASN1(rand.Reader, privateKey, hashed[:])
+	Expect(err).NotTo(HaveOccurred())
+
+	signatureImage, err := mutate.Append(empty.Image, mutate.Addendum{
+		Layer:       static.NewLayer(payload, "application/vnd.dev.cosign.simplesigning.v1+json"),
+		Annotations: map[string]string{"dev.cosignproject.cosign/signature": base64.StdEncoding.EncodeToString(signature)},
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	ref, err := name.ParseReference(fmt.Sprintf("%s:%s.sig", repo, strings.Replace(digest, ":", "-", 1)))
+	Expect(err).NotTo(HaveOccurred())
+	Expect(remote.Write(ref, signatureImage)).To(Succeed())
+}
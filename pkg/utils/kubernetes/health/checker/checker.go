@@ -379,12 +379,16 @@ func (h *HealthChecker) CheckLoggingControlPlane(
 func (h *HealthChecker) CheckExtensionCondition(condition gardencorev1beta1.Condition, extensionsConditions []ExtensionCondition, staleExtensionHealthCheckThreshold *metav1.Duration) *gardencorev1beta1.Condition {
 	for _, cond := range extensionsConditions {
 		// check if the extension controller's last heartbeat time or the condition's LastUpdateTime is older than the configured staleExtensionHealthCheckThreshold
-		if staleExtensionHealthCheckThreshold != nil {
+		staleThreshold := staleExtensionHealthCheckThreshold
+		if cond.StaleThreshold != nil {
+			staleThreshold = cond.StaleThreshold
+		}
+		if staleThreshold != nil {
 			lastHeartbeatTime := cond.LastHeartbeatTime
 			if lastHeartbeatTime == nil {
 				lastHeartbeatTime = &metav1.MicroTime{}
 			}
-			if h.clock.Now().UTC().Sub(lastHeartbeatTime.UTC()) > staleExtensionHealthCheckThreshold.Duration {
+			if h.clock.Now().UTC().Sub(lastHeartbeatTime.UTC()) > staleThreshold.Duration {
 				c := v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionUnknown, fmt.Sprintf("%sOutdatedHealthCheckReport", cond.ExtensionType), fmt.Sprintf("%s extension (%s/%s) reports an outdated health status (last updated: %s ago at %s).", cond.ExtensionType, cond.ExtensionNamespace, cond.ExtensionName, h.clock.Now().UTC().Sub(lastHeartbeatTime.UTC()).Round(time.Minute).String(), lastHeartbeatTime.UTC().Round(time.Minute).String()))
 				return &c
 			}
@@ -411,6 +415,8 @@ type ExtensionCondition struct {
 	ExtensionName      string
 	ExtensionNamespace string
 	LastHeartbeatTime  *metav1.MicroTime
+	// StaleThreshold overrides the default stale extension health check threshold for this extension, if set.
+	StaleThreshold *metav1.Duration
 }
 
 // CheckControllerInstallations checks multiple controller installations in case the provided filter func returns true. If their state
@@ -375,6 +375,46 @@ var _ = Describe("HealthChecker", func() {
 				},
 				PointTo(beConditionWithStatus(gardencorev1beta1.ConditionUnknown)),
 			),
+			Entry("should determine that health check report is outdated - extension-specific threshold is lower than the default threshold",
+				// 10 minute default threshold for outdated health check reports
+				&metav1.Duration{Duration: time.Minute * 10},
+				gardencorev1beta1.Condition{
+					Type:   gardencorev1beta1.ShootControlPlaneHealthy,
+					Status: gardencorev1beta1.ConditionTrue,
+				},
+				[]ExtensionCondition{
+					{
+						Condition: gardencorev1beta1.Condition{
+							Type:   gardencorev1beta1.ShootControlPlaneHealthy,
+							Status: gardencorev1beta1.ConditionTrue,
+						},
+						ExtensionType:      "Worker",
+						ExtensionName:      "worker-ubuntu",
+						ExtensionNamespace: "shoot-namespace-in-seed",
+						// health check result is already 3 minutes old
+						LastHeartbeatTime: &metav1.MicroTime{Time: time.Now().Add(time.Minute * -3)},
+						StaleThreshold:    &metav1.Duration{Duration: time.Minute * 2},
+					},
+				},
+				PointTo(beConditionWithStatus(gardencorev1beta1.ConditionUnknown)),
+			),
+			Entry("health check report is not outdated - extension-specific threshold is higher than the default threshold",
+				// 2 minute default threshold for outdated health check reports
+				&metav1.Duration{Duration: time.Minute * 2},
+				gardencorev1beta1.Condition{Type: "type"},
+				[]ExtensionCondition{
+					{
+						Condition: gardencorev1beta1.Condition{
+							Type:   gardencorev1beta1.ShootControlPlaneHealthy,
+							Status: gardencorev1beta1.ConditionTrue,
+						},
+						// health check result is already 3 minutes old
+						LastHeartbeatTime: &metav1.MicroTime{Time: time.Now().Add(time.Minute * -3)},
+						StaleThreshold:    &metav1.Duration{Duration: time.Minute * 10},
+					},
+				},
+				BeNil(),
+			),
 			Entry("health check reports status progressing",
 				nil,
 				gardencorev1beta1.Condition{Type: "type"},
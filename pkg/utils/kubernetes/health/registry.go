@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	registryMutex sync.RWMutex
+	registry      = map[reflect.Type]Func{}
+)
+
+func init() {
+	// Health semantics of custom resources deployed by gardener components. They are registered here once so that all
+	// generic health check code (e.g. the resource-manager's health controller and the care controllers) shares the
+	// same semantics.
+	RegisterFunc(CheckAlertmanager)
+	RegisterFunc(CheckCertificate)
+	RegisterFunc(CheckCertificateIssuer)
+	RegisterFunc(CheckPrometheus)
+	RegisterFunc(CheckVerticalPodAutoscaler)
+}
+
+// RegisterFunc registers the given health check function for the object type T. This makes health semantics of custom
+// resources (e.g. extension resources, istio resources, or prometheus-operator resources) available to generic health
+// check code without it having to know the concrete Go types. A later registration for the same type replaces the
+// earlier one.
+func RegisterFunc[T client.Object](checkFunc func(T) error) {
+	var prototype T
+
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+
+	registry[reflect.TypeOf(prototype)] = func(obj client.Object) error {
+		typedObject, ok := obj.(T)
+		if !ok {
+			return fmt.Errorf("expected %T but got %T", prototype, obj)
+		}
+		return checkFunc(typedObject)
+	}
+}
+
+// FuncFor returns the health check function registered for the type of the given object, or nil if there is none.
+func FuncFor(obj client.Object) Func {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+
+	return registry[reflect.TypeOf(obj)]
+}
+
+// CheckRegisteredObject checks the given object with the health check function registered for its type. The returned
+// bool indicates whether such a function was registered, i.e. whether the object was actually checked.
+func CheckRegisteredObject(obj client.Object) (bool, error) {
+	checkFunc := FuncFor(obj)
+	if checkFunc == nil {
+		return false, nil
+	}
+
+	return true, checkFunc(obj)
+}
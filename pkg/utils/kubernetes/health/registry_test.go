@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package health_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+)
+
+var _ = Describe("Registry", func() {
+	Describe("#RegisterFunc, #FuncFor", func() {
+		It("should return the registered health check function", func() {
+			fakeErr := fmt.Errorf("fake")
+			health.RegisterFunc(func(_ *corev1.ConfigMap) error { return fakeErr })
+
+			checkFunc := health.FuncFor(&corev1.ConfigMap{})
+			Expect(checkFunc).NotTo(BeNil())
+			Expect(checkFunc(&corev1.ConfigMap{})).To(MatchError(fakeErr))
+		})
+
+		It("should return nil if no health check function is registered for the object's type", func() {
+			Expect(health.FuncFor(&corev1.Namespace{})).To(BeNil())
+		})
+	})
+
+	Describe("#CheckRegisteredObject", func() {
+		It("should not check objects without registered health check function", func() {
+			checked, err := health.CheckRegisteredObject(&corev1.Namespace{})
+			Expect(checked).To(BeFalse())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should check objects with built-in health check function", func() {
+			checked, err := health.CheckRegisteredObject(&monitoringv1.Prometheus{
+				Status: monitoringv1.PrometheusStatus{AvailableReplicas: 1, Conditions: []monitoringv1.Condition{{Type: monitoringv1.Available, Status: monitoringv1.ConditionTrue}}},
+			})
+			Expect(checked).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			checked, err = health.CheckRegisteredObject(&monitoringv1.Prometheus{})
+			Expect(checked).To(BeTrue())
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
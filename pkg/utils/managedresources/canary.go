@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresources
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+)
+
+const (
+	// canaryNameSuffix is the suffix appended to a ManagedResource's name for its canary twin.
+	canaryNameSuffix = "-canary"
+	// LabelKeyCanaryFor is a key for a label on a canary ManagedResource whose value is the name of the
+	// ManagedResource whose next revision it delivers.
+	LabelKeyCanaryFor = "resources.gardener.cloud/canary-for"
+)
+
+// CanaryName returns the name of the canary ManagedResource for the ManagedResource with the given name.
+func CanaryName(name string) string {
+	return name + canaryNameSuffix
+}
+
+// CreateCanaryForSeed deploys the given data as a canary ManagedResource next to the ManagedResource with the given
+// name, keeping the currently served revision untouched. The objects rendered into the data must not collide with the
+// objects of the current revision (e.g. by suffixing their names), since both revisions are reconciled at the same
+// time. Once the canary turned healthy (see WaitUntilCanaryHealthy), it can be promoted via PromoteCanaryForSeed.
+// Otherwise, it can be discarded via AbortCanaryForSeed.
+func CreateCanaryForSeed(ctx context.Context, client client.Client, namespace, name string, data map[string][]byte) error {
+	return CreateForSeedWithLabels(ctx, client, namespace, CanaryName(name), false, map[string]string{LabelKeyCanaryFor: name}, data)
+}
+
+// WaitUntilCanaryHealthy waits until the canary ManagedResource for the ManagedResource with the given name is healthy
+// and no longer progressing.
+func WaitUntilCanaryHealthy(ctx context.Context, reader client.Reader, namespace, name string) error {
+	return WaitUntilHealthyAndNotProgressing(ctx, reader, namespace, CanaryName(name))
+}
+
+// PromoteCanaryForSeed switches the ManagedResource with the given name over to the revision delivered by its canary
+// ManagedResource and deletes the canary afterwards. The keepObjects setting of the ManagedResource is preserved.
+// Objects of the previous revision which are no longer part of the promoted data are cleaned up by the
+// gardener-resource-manager's garbage collection.
+func PromoteCanaryForSeed(ctx context.Context, c client.Client, namespace, name string) error {
+	canary := &resourcesv1alpha1.ManagedResource{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: CanaryName(name)}, canary); err != nil {
+		return fmt.Errorf("could not read canary managed resource '%s/%s': %w", namespace, CanaryName(name), err)
+	}
+
+	data := map[string][]byte{}
+	for _, secretRef := range canary.Spec.SecretRefs {
+		secret := &corev1.Secret{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: secretRef.Name}, secret); err != nil {
+			return fmt.Errorf("could not read secret '%s/%s' of canary managed resource: %w", namespace, secretRef.Name, err)
+		}
+		maps.Copy(data, secret.Data)
+	}
+
+	keepObjects := false
+
+	managedResource := &resourcesv1alpha1.ManagedResource{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, managedResource); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("could not read managed resource '%s/%s': %w", namespace, name, err)
+		}
+	} else {
+		keepObjects = ptr.Deref(managedResource.Spec.KeepObjects, false)
+	}
+
+	if err := CreateForSeed(ctx, c, namespace, name, keepObjects, data); err != nil {
+		return fmt.Errorf("could not promote canary data to managed resource '%s/%s': %w", namespace, name, err)
+	}
+
+	return AbortCanaryForSeed(ctx, c, namespace, name)
+}
+
+// AbortCanaryForSeed discards the canary ManagedResource for the ManagedResource with the given name without touching
+// the currently served revision.
+func AbortCanaryForSeed(ctx context.Context, client client.Client, namespace, name string) error {
+	return DeleteForSeed(ctx, client, namespace, CanaryName(name))
+}
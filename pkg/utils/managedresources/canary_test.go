@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresources_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/utils/managedresources"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("canary", func() {
+	var (
+		ctx = context.Background()
+
+		namespace = "test"
+		name      = "managed-resource"
+		oldData   = map[string][]byte{"some": []byte("old data")}
+		newData   = map[string][]byte{"some": []byte("new data")}
+
+		fakeClient client.Client
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+	})
+
+	Describe("#CanaryName", func() {
+		It("should append the canary suffix", func() {
+			Expect(CanaryName(name)).To(Equal("managed-resource-canary"))
+		})
+	})
+
+	Describe("#CreateCanaryForSeed", func() {
+		It("should create the canary next to the current revision without touching it", func() {
+			Expect(CreateForSeed(ctx, fakeClient, namespace, name, false, oldData)).To(Succeed())
+
+			current := &resourcesv1alpha1.ManagedResource{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, current)).To(Succeed())
+
+			Expect(CreateCanaryForSeed(ctx, fakeClient, namespace, name, newData)).To(Succeed())
+
+			canary := &resourcesv1alpha1.ManagedResource{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: CanaryName(name)}, canary)).To(Succeed())
+			Expect(canary.Labels).To(HaveKeyWithValue(LabelKeyCanaryFor, name))
+			Expect(canary.Spec.KeepObjects).To(Equal(ptr.To(false)))
+			Expect(canary.Spec.SecretRefs).To(HaveLen(1))
+
+			secret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: canary.Spec.SecretRefs[0].Name}, secret)).To(Succeed())
+			Expect(secret.Data).To(Equal(newData))
+
+			unchanged := &resourcesv1alpha1.ManagedResource{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, unchanged)).To(Succeed())
+			Expect(unchanged).To(Equal(current))
+		})
+	})
+
+	Describe("#PromoteCanaryForSeed", func() {
+		It("should switch the managed resource to the canary data and delete the canary", func() {
+			Expect(CreateForSeed(ctx, fakeClient, namespace, name, true, oldData)).To(Succeed())
+			Expect(CreateCanaryForSeed(ctx, fakeClient, namespace, name, newData)).To(Succeed())
+
+			Expect(PromoteCanaryForSeed(ctx, fakeClient, namespace, name)).To(Succeed())
+
+			managedResource := &resourcesv1alpha1.ManagedResource{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, managedResource)).To(Succeed())
+			Expect(managedResource.Spec.KeepObjects).To(Equal(ptr.To(true)))
+			Expect(managedResource.Spec.SecretRefs).To(HaveLen(1))
+
+			secret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: managedResource.Spec.SecretRefs[0].Name}, secret)).To(Succeed())
+			Expect(secret.Data).To(Equal(newData))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: CanaryName(name)}, &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
+		})
+
+		It("should create the managed resource if only the canary exists", func() {
+			Expect(CreateCanaryForSeed(ctx, fakeClient, namespace, name, newData)).To(Succeed())
+
+			Expect(PromoteCanaryForSeed(ctx, fakeClient, namespace, name)).To(Succeed())
+
+			managedResource := &resourcesv1alpha1.ManagedResource{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, managedResource)).To(Succeed())
+			Expect(managedResource.Spec.KeepObjects).To(Equal(ptr.To(false)))
+		})
+
+		It("should fail if no canary exists", func() {
+			Expect(PromoteCanaryForSeed(ctx, fakeClient, namespace, name)).To(MatchError(ContainSubstring("could not read canary managed resource")))
+		})
+	})
+
+	Describe("#AbortCanaryForSeed", func() {
+		It("should delete the canary without touching the current revision", func() {
+			Expect(CreateForSeed(ctx, fakeClient, namespace, name, false, oldData)).To(Succeed())
+			Expect(CreateCanaryForSeed(ctx, fakeClient, namespace, name, newData)).To(Succeed())
+
+			Expect(AbortCanaryForSeed(ctx, fakeClient, namespace, name)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: CanaryName(name)}, &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &resourcesv1alpha1.ManagedResource{})).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package test
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/component"
+)
+
+// RenderComponent deploys the given component with the given client and returns the manifests it rendered into the
+// ManagedResource with the given name, in lexicographical order. Together with CombineManifests, this allows
+// golden-file comparisons of rendered component manifests.
+func RenderComponent(ctx context.Context, c client.Client, deployer component.Deployer, namespace, managedResourceName string) ([]string, error) {
+	if err := deployer.Deploy(ctx); err != nil {
+		return nil, err
+	}
+
+	managedResource := &resourcesv1alpha1.ManagedResource{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: managedResourceName}, managedResource); err != nil {
+		return nil, err
+	}
+
+	var manifests []string
+	for _, secretRef := range managedResource.Spec.SecretRefs {
+		secret := &corev1.Secret{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: secretRef.Name}, secret); err != nil {
+			return nil, err
+		}
+
+		secretManifests, err := ExtractManifestsFromManagedResourceData(secret.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		manifests = append(manifests, secretManifests...)
+	}
+
+	sort.Strings(manifests)
+	return manifests, nil
+}
+
+// CombineManifests normalizes the given manifests into a single multi-document YAML string.
+func CombineManifests(manifests []string) string {
+	normalized := make([]string, 0, len(manifests))
+	for _, manifest := range manifests {
+		normalized = append(normalized, strings.TrimSpace(manifest)+"\n")
+	}
+	return strings.Join(normalized, "---\n")
+}
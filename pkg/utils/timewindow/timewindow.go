@@ -111,15 +111,16 @@ func (m *MaintenanceTime) Compare(other *MaintenanceTime) int {
 	return m.second - other.second
 }
 
-func (m *MaintenanceTime) adjust(t time.Time) time.Time {
-	t = t.UTC()
-	return time.Date(t.Year(), t.Month(), t.Day(), m.hour, m.minute, m.second, 0, t.Location())
+func (m *MaintenanceTime) adjust(t time.Time, location *time.Location) time.Time {
+	t = t.In(location)
+	return time.Date(t.Year(), t.Month(), t.Day(), m.hour, m.minute, m.second, 0, location)
 }
 
 // MaintenanceTimeWindow contains the beginning and the end of a time window in which maintenance operations can be performed.
 type MaintenanceTimeWindow struct {
-	begin *MaintenanceTime
-	end   *MaintenanceTime
+	begin    *MaintenanceTime
+	end      *MaintenanceTime
+	location *time.Location
 }
 
 // AlwaysTimeWindow is a MaintenanceTimeWindow that contains all durations.
@@ -127,7 +128,7 @@ var AlwaysTimeWindow = NewMaintenanceTimeWindow(NewMaintenanceTime(0, 0, 0), New
 
 // NewMaintenanceTimeWindow takes a begin and an end of a time window and returns a pointer to a MaintenanceTimeWindow structure.
 func NewMaintenanceTimeWindow(begin, end *MaintenanceTime) *MaintenanceTimeWindow {
-	return &MaintenanceTimeWindow{begin, end}
+	return &MaintenanceTimeWindow{begin: begin, end: end}
 }
 
 // ParseMaintenanceTimeWindow takes a begin and an end of a time window in the maintenance format and returns a pointer
@@ -145,14 +146,61 @@ func ParseMaintenanceTimeWindow(begin, end string) (*MaintenanceTimeWindow, erro
 	return NewMaintenanceTimeWindow(maintenanceWindowBegin, maintenanceWindowEnd), nil
 }
 
+// ParseMaintenanceTimeWindowInLocation takes a begin and an end of a time window in the maintenance format as well as
+// the name of an IANA timezone and returns a pointer to a MaintenanceTimeWindow structure which evaluates the clock
+// times of begin and end in the given location (honoring daylight saving time changes). The zone offsets in begin and
+// end are ignored.
+func ParseMaintenanceTimeWindowInLocation(begin, end, location string) (*MaintenanceTimeWindow, error) {
+	loc, err := time.LoadLocation(location)
+	if err != nil {
+		return nil, fmt.Errorf("could not load location %q: %w", location, err)
+	}
+
+	maintenanceWindowBegin, err := parseWallClockTime(begin)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse begin time: %s", err.Error())
+	}
+	maintenanceWindowEnd, err := parseWallClockTime(end)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse end time: %s", err.Error())
+	}
+
+	window := NewMaintenanceTimeWindow(maintenanceWindowBegin, maintenanceWindowEnd)
+	window.location = loc
+	return window, nil
+}
+
+// parseWallClockTime parses the given value in the maintenance time format but keeps the clock time as given instead
+// of converting it to the UTC zone.
+func parseWallClockTime(value string) (*MaintenanceTime, error) {
+	t, err := time.Parse(maintenanceTimeLayout, value)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse the value into the maintenanceTime format: %s", err.Error())
+	}
+
+	return NewMaintenanceTime(t.Hour(), t.Minute(), t.Second()), nil
+}
+
 // String returns the string representation of the time window.
 func (m *MaintenanceTimeWindow) String() string {
+	if m.location != nil {
+		return fmt.Sprintf("begin=%s, end=%s, location=%s", m.begin, m.end, m.location)
+	}
 	return fmt.Sprintf("begin=%s, end=%s", m.begin, m.end)
 }
 
 // Equal returns true if the time windows are the same.
 func (m *MaintenanceTimeWindow) Equal(o *MaintenanceTimeWindow) bool {
-	return m.Begin().Compare(o.Begin()) == 0 && m.End().Compare(o.End()) == 0
+	return m.Begin().Compare(o.Begin()) == 0 && m.End().Compare(o.End()) == 0 && m.Location().String() == o.Location().String()
+}
+
+// Location returns the location in which the time window is evaluated. It defaults to UTC, i.e., in case no explicit
+// location is set, the zone offsets of the begin and end times apply.
+func (m *MaintenanceTimeWindow) Location() *time.Location {
+	if m.location == nil {
+		return time.UTC
+	}
+	return m.location
 }
 
 // Begin returns the begin of the time window.
@@ -167,17 +215,17 @@ func (m *MaintenanceTimeWindow) End() *MaintenanceTime {
 
 // WithBegin returns a new maintenance time window with the given <begin> (ending will be kept).
 func (m *MaintenanceTimeWindow) WithBegin(begin *MaintenanceTime) *MaintenanceTimeWindow {
-	return NewMaintenanceTimeWindow(begin, m.end)
+	return &MaintenanceTimeWindow{begin: begin, end: m.end, location: m.location}
 }
 
 // WithEnd returns a new maintenance time window with the given <end> (beginning will be kept).
 func (m *MaintenanceTimeWindow) WithEnd(end *MaintenanceTime) *MaintenanceTimeWindow {
-	return NewMaintenanceTimeWindow(m.begin, end)
+	return &MaintenanceTimeWindow{begin: m.begin, end: end, location: m.location}
 }
 
 // Contains returns true in case the given time is within the time window.
 func (m *MaintenanceTimeWindow) Contains(tTime time.Time) bool {
-	t := timeToMaintenanceTime(tTime)
+	t := m.toMaintenanceTime(tTime)
 
 	if m.spansDifferentDays() {
 		return t.Compare(m.end) <= 0 || t.Compare(m.begin) >= 0
@@ -185,6 +233,12 @@ func (m *MaintenanceTimeWindow) Contains(tTime time.Time) bool {
 	return t.Compare(m.begin) >= 0 && t.Compare(m.end) <= 0
 }
 
+// toMaintenanceTime converts the given time into a MaintenanceTime in the location of the time window.
+func (m *MaintenanceTimeWindow) toMaintenanceTime(t time.Time) *MaintenanceTime {
+	t = t.In(m.Location())
+	return NewMaintenanceTime(t.Hour(), t.Minute(), t.Second())
+}
+
 // RandomFunc is a function that computes a random number.
 var RandomFunc = rand.Int63nRange
 
@@ -197,7 +251,7 @@ var RandomFunc = rand.Int63nRange
 // consequence, this will return a random duration from <from> until the end of the maintenance time window which is
 // shorter than 24h.
 func (m *MaintenanceTimeWindow) RandomDurationUntilNext(from time.Time, shiftBeginToFromIfContained bool) time.Duration {
-	from = from.UTC()
+	from = from.In(m.Location())
 
 	var (
 		begin = m.AdjustedBegin(from)
@@ -234,12 +288,12 @@ func (m *MaintenanceTimeWindow) Duration() time.Duration {
 
 // AdjustedBegin returns the MaintenanceTimeWindow's begin time, projected on the day, month and year given by the parameter t.
 func (m *MaintenanceTimeWindow) AdjustedBegin(t time.Time) time.Time {
-	return m.begin.adjust(t)
+	return m.begin.adjust(t, m.Location())
 }
 
 // AdjustedEnd returns the MaintenanceWindow's end time, projected on the day, month and year given by the parameter t.
 func (m *MaintenanceTimeWindow) AdjustedEnd(t time.Time) time.Time {
-	end := m.end.adjust(t)
+	end := m.end.adjust(t, m.Location())
 	if m.end.Compare(m.begin) <= 0 {
 		return end.AddDate(0, 0, 1)
 	}
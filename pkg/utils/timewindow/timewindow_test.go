@@ -155,10 +155,49 @@ var _ = Describe("utils", func() {
 			Entry("valid maintenance time window", begin.Formatted(), end.Formatted(), Not(HaveOccurred()), Equal(maintenanceTimeWindow)),
 		)
 
+		Describe("#ParseMaintenanceTimeWindowInLocation", func() {
+			It("should keep the clock times as given and ignore the zone offsets", func() {
+				timeWindow, err := ParseMaintenanceTimeWindowInLocation("220000+0200", "230000+0200", "Europe/Berlin")
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(timeWindow.Begin()).To(Equal(NewMaintenanceTime(22, 0, 0)))
+				Expect(timeWindow.End()).To(Equal(NewMaintenanceTime(23, 0, 0)))
+				Expect(timeWindow.Location().String()).To(Equal("Europe/Berlin"))
+			})
+
+			It("should return an error for an invalid location", func() {
+				timeWindow, err := ParseMaintenanceTimeWindowInLocation("220000+0000", "230000+0000", "Mars/Olympus")
+
+				Expect(err).To(MatchError(ContainSubstring("could not load location")))
+				Expect(timeWindow).To(BeNil())
+			})
+
+			It("should return an error for an invalid begin", func() {
+				timeWindow, err := ParseMaintenanceTimeWindowInLocation("foo", "230000+0000", "Europe/Berlin")
+
+				Expect(err).To(MatchError(ContainSubstring("could not parse begin time")))
+				Expect(timeWindow).To(BeNil())
+			})
+
+			It("should return an error for an invalid end", func() {
+				timeWindow, err := ParseMaintenanceTimeWindowInLocation("220000+0000", "foo", "Europe/Berlin")
+
+				Expect(err).To(MatchError(ContainSubstring("could not parse end time")))
+				Expect(timeWindow).To(BeNil())
+			})
+		})
+
 		Describe("#String", func() {
 			It("should return the correct string representation", func() {
 				Expect(maintenanceTimeWindow.String()).To(Equal(fmt.Sprintf("begin=%s, end=%s", begin, end)))
 			})
+
+			It("should include the location if set", func() {
+				timeWindow, err := ParseMaintenanceTimeWindowInLocation(begin.Formatted(), end.Formatted(), "Europe/Berlin")
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(timeWindow.String()).To(Equal(fmt.Sprintf("begin=%s, end=%s, location=Europe/Berlin", begin, end)))
+			})
 		})
 
 		Describe("#Begin", func() {
@@ -274,6 +313,37 @@ var _ = Describe("utils", func() {
 			Entry("(23-0), shift begin if contained, does not contain now (after)", from23to0, true, newTime(0, 59, 1, 0), 23*time.Hour+59*time.Second),
 		)
 
+		Context("time window with location", func() {
+			var berlinWindow *MaintenanceTimeWindow
+
+			BeforeEach(func() {
+				var err error
+				berlinWindow, err = ParseMaintenanceTimeWindowInLocation("220000+0000", "230000+0000", "Europe/Berlin")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should evaluate Contains in the location during daylight saving time", func() {
+				Expect(berlinWindow.Contains(time.Date(2024, time.July, 15, 20, 30, 0, 0, time.UTC))).To(BeTrue())  // 22:30 CEST
+				Expect(berlinWindow.Contains(time.Date(2024, time.July, 15, 21, 30, 0, 0, time.UTC))).To(BeFalse()) // 23:30 CEST
+			})
+
+			It("should evaluate Contains in the location during standard time", func() {
+				Expect(berlinWindow.Contains(time.Date(2024, time.January, 15, 21, 30, 0, 0, time.UTC))).To(BeTrue())  // 22:30 CET
+				Expect(berlinWindow.Contains(time.Date(2024, time.January, 15, 20, 30, 0, 0, time.UTC))).To(BeFalse()) // 21:30 CET
+			})
+
+			It("should compute the duration until the next window begin based on the location", func() {
+				randomFunc := RandomFunc
+				defer func() { RandomFunc = randomFunc }()
+				RandomFunc = func(_ int64, _ int64) int64 { return 0 }
+
+				// 19:00 UTC on 2024-07-15 is 21:00 CEST, i.e. one hour before the window begins.
+				Expect(berlinWindow.RandomDurationUntilNext(time.Date(2024, time.July, 15, 19, 0, 0, 0, time.UTC), false)).To(Equal(time.Hour))
+				// 19:00 UTC on 2024-01-15 is 20:00 CET, i.e. two hours before the window begins.
+				Expect(berlinWindow.RandomDurationUntilNext(time.Date(2024, time.January, 15, 19, 0, 0, 0, time.UTC), false)).To(Equal(2 * time.Hour))
+			})
+		})
+
 		DescribeTable("#Duration",
 			func(maintenanceTimeWindow *MaintenanceTimeWindow, expected time.Duration) {
 				Expect(maintenanceTimeWindow.Duration()).To(Equal(expected))
@@ -53,10 +53,14 @@ const (
 	PluginNameShootManagedSeed = "ShootManagedSeed"
 	// PluginNameShootNodeLocalDNSEnabledByDefault is the name of the ShootNodeLocalDNSEnabledByDefault admission plugin.
 	PluginNameShootNodeLocalDNSEnabledByDefault = "ShootNodeLocalDNSEnabledByDefault"
+	// PluginNameShootPodSecurityDefaults is the name of the ShootPodSecurityDefaults admission plugin.
+	PluginNameShootPodSecurityDefaults = "ShootPodSecurityDefaults"
 	// PluginNameClusterOpenIDConnectPreset is the name of the ClusterOpenIDConnectPreset admission plugin.
 	PluginNameClusterOpenIDConnectPreset = "ClusterOpenIDConnectPreset"
 	// PluginNameOpenIDConnectPreset is the name of the OpenIDConnectPreset admission plugin.
 	PluginNameOpenIDConnectPreset = "OpenIDConnectPreset"
+	// PluginNameShootPurposePolicy is the name of the ShootPurposePolicy admission plugin.
+	PluginNameShootPurposePolicy = "ShootPurposePolicy"
 	// PluginNameShootQuotaValidator is the name of the ShootQuotaValidator admission plugin.
 	PluginNameShootQuotaValidator = "ShootQuotaValidator"
 	// PluginNameShootTolerationRestriction is the name of the ShootTolerationRestriction admission plugin.
@@ -69,6 +73,10 @@ const (
 	PluginNameShootVPAEnabledByDefault = "ShootVPAEnabledByDefault"
 	// PluginNameShootResourceReservation is the name of the ShootResourceReservation admission plugin.
 	PluginNameShootResourceReservation = "ShootResourceReservation"
+	// PluginNameShootFieldImmutability is the name of the ShootFieldImmutability admission plugin.
+	PluginNameShootFieldImmutability = "ShootFieldImmutability"
+	// PluginNameShootMaintenanceWindow is the name of the ShootMaintenanceWindow admission plugin.
+	PluginNameShootMaintenanceWindow = "ShootMaintenanceWindow"
 	// PluginNameBackupBucketValidator is the name of the BackupBucketValidator admission plugin.
 	PluginNameBackupBucketValidator = "BackupBucketValidator"
 )
@@ -85,7 +93,9 @@ func AllOrderedPluginNames() []string {
 		PluginNameShootDNS,                          // ShootDNS
 		PluginNameShootManagedSeed,                  // ShootManagedSeed
 		PluginNameShootNodeLocalDNSEnabledByDefault, // ShootNodeLocalDNSEnabledByDefault
+		PluginNameShootPodSecurityDefaults,          // ShootPodSecurityDefaults
 		PluginNameShootDNSRewriting,                 // ShootDNSRewriting
+		PluginNameShootPurposePolicy,                // ShootPurposePolicy
 		PluginNameShootQuotaValidator,               // ShootQuotaValidator
 		PluginNameShootMutator,                      // ShootMutator
 		PluginNameShootValidator,                    // ShootValidator
@@ -101,6 +111,8 @@ func AllOrderedPluginNames() []string {
 		PluginNameCustomVerbAuthorizer,              // CustomVerbAuthorizer
 		PluginNameShootVPAEnabledByDefault,          // ShootVPAEnabledByDefault
 		PluginNameShootResourceReservation,          // ShootResourceReservation
+		PluginNameShootFieldImmutability,            // ShootFieldImmutability
+		PluginNameShootMaintenanceWindow,            // ShootMaintenanceWindow
 		PluginNameManagedSeed,                       // ManagedSeed
 		PluginNameManagedSeedShoot,                  // ManagedSeedShoot
 		PluginNameBastion,                           // Bastion
@@ -132,6 +144,7 @@ func DefaultOnPlugins() sets.Set[string] {
 		PluginNameShootDNS,                        // ShootDNS
 		PluginNameShootManagedSeed,                // ShootManagedSeed
 		PluginNameShootResourceReservation,        // ShootResourceReservation
+		PluginNameShootPurposePolicy,              // ShootPurposePolicy
 		PluginNameShootQuotaValidator,             // ShootQuotaValidator
 		PluginNameShootMutator,                    // ShootMutator
 		PluginNameShootValidator,                  // ShootValidator
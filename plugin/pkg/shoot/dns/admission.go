@@ -222,6 +222,9 @@ func (d *DNS) Admit(_ context.Context, a admission.Attributes, _ admission.Objec
 		if err := checkFunctionlessDNSProviders(a, shoot); err != nil {
 			return err
 		}
+		if err := d.checkCustomDomain(a, shoot, defaultDomains); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -257,6 +260,41 @@ func checkPrimaryDNSProvider(a admission.Attributes, shoot *core.Shoot, defaultD
 	return nil
 }
 
+// checkCustomDomain checks that a shoot which uses neither a default domain nor an own primary DNS provider refers
+// to a custom domain registered via a secret in the project namespace. This way, misconfigured shoots are rejected
+// at admission time instead of failing later during reconciliation.
+func (d *DNS) checkCustomDomain(a admission.Attributes, shoot *core.Shoot, defaultDomains []string) error {
+	dns := shoot.Spec.DNS
+	if dns.Domain == nil || helper.ShootUsesUnmanagedDNS(shoot) || helper.IsShootSelfHosted(shoot.Spec.Provider.Workers) {
+		return nil
+	}
+	if isDefaultDomain(*dns.Domain, defaultDomains) || helper.FindPrimaryDNSProvider(dns.Providers) != nil {
+		return nil
+	}
+
+	selector, err := labels.Parse(fmt.Sprintf("%s=%s", v1beta1constants.GardenRole, v1beta1constants.GardenRoleCustomDomain))
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	customDomainSecrets, err := d.secretLister.Secrets(shoot.Namespace).List(selector)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+
+	for _, customDomainSecret := range customDomainSecrets {
+		_, customDomain, _, err := gardenerutils.GetDomainInfoFromAnnotations(customDomainSecret.GetAnnotations())
+		if err != nil {
+			return apierrors.NewInternalError(err)
+		}
+		if *dns.Domain == customDomain || strings.HasSuffix(*dns.Domain, "."+customDomain) {
+			return nil
+		}
+	}
+
+	fieldErr := field.Invalid(field.NewPath("spec", "dns", "domain"), *dns.Domain, "domain is neither a default domain nor covered by a primary DNS provider or a custom domain secret in the project namespace")
+	return apierrors.NewInvalid(a.GetKind().GroupKind(), shoot.Name, field.ErrorList{fieldErr})
+}
+
 func isShootDomainSet(shoot *core.Shoot) bool {
 	return shoot.Spec.DNS != nil && shoot.Spec.DNS.Domain != nil
 }
@@ -229,6 +229,73 @@ var _ = Describe("dns", func() {
 				})))
 			})
 
+			Context("custom domains", func() {
+				var customDomainSecret corev1.Secret
+
+				BeforeEach(func() {
+					customDomainSecret = corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "my-custom-domain",
+							Namespace: namespace,
+							Labels: map[string]string{
+								v1beta1constants.GardenRole: v1beta1constants.GardenRoleCustomDomain,
+							},
+							Annotations: map[string]string{
+								gardenerutils.DNSDomain:   "my-private-domain.com",
+								gardenerutils.DNSProvider: providerType,
+							},
+						},
+					}
+				})
+
+				It("should pass because the shoot domain is covered by a custom domain secret of the project", func() {
+					shootDomain := "my-shoot.my-private-domain.com"
+					shoot.Spec.DNS.Domain = &shootDomain
+
+					Expect(kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(&customDomainSecret)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+					err := admissionHandler.Admit(context.TODO(), attrs, nil)
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(*shoot.Spec.DNS.Domain).To(Equal(shootDomain))
+				})
+
+				It("should reject because the shoot domain is not covered by any custom domain secret of the project", func() {
+					shootDomain := "my-shoot.some-other-domain.com"
+					shoot.Spec.DNS.Domain = &shootDomain
+
+					Expect(kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(&customDomainSecret)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+					err := admissionHandler.Admit(context.TODO(), attrs, nil)
+
+					Expect(err).To(BeInvalidError())
+				})
+
+				It("should not require a custom domain secret if the shoot specifies a primary DNS provider", func() {
+					shootDomain := "my-shoot.some-other-domain.com"
+					shoot.Spec.DNS.Domain = &shootDomain
+					shoot.Spec.DNS.Providers = []core.DNSProvider{
+						{
+							Type: &providerType,
+						},
+					}
+
+					Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+					err := admissionHandler.Admit(context.TODO(), attrs, nil)
+
+					Expect(err).NotTo(HaveOccurred())
+				})
+			})
+
 			It("should set the correct primary DNS provider", func() {
 				var (
 					shootDomain = "my-shoot.my-private-domain.com"
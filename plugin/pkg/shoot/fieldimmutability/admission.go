@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fieldimmutability
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/admission"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	plugin "github.com/gardener/gardener/plugin/pkg"
+	"github.com/gardener/gardener/plugin/pkg/shoot/fieldimmutability/apis/shootfieldimmutability"
+	"github.com/gardener/gardener/plugin/pkg/shoot/fieldimmutability/apis/shootfieldimmutability/validation"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(plugin.PluginNameShootFieldImmutability, func(config io.Reader) (admission.Interface, error) {
+		cfg, err := LoadConfiguration(config)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := validation.ValidateConfiguration(cfg); err != nil {
+			return nil, fmt.Errorf("invalid config: %+v", err)
+		}
+
+		return New(cfg.ImmutableFields), nil
+	})
+}
+
+// FieldImmutability contains required information to process admission requests.
+type FieldImmutability struct {
+	*admission.Handler
+
+	immutableFields []shootfieldimmutability.ImmutableField
+}
+
+// New creates a new FieldImmutability admission plugin.
+func New(immutableFields []shootfieldimmutability.ImmutableField) admission.ValidationInterface {
+	return &FieldImmutability{
+		Handler:         admission.NewHandler(admission.Update),
+		immutableFields: immutableFields,
+	}
+}
+
+var _ admission.ValidationInterface = (*FieldImmutability)(nil)
+
+// Validate rejects updates of shoot fields which have been declared immutable by the landscape operator,
+// unless the requesting user belongs to one of the groups allowed to mutate the respective field.
+func (f *FieldImmutability) Validate(_ context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	if a.GetKind().GroupKind() != core.Kind("Shoot") {
+		return nil
+	}
+
+	if a.GetOperation() != admission.Update || a.GetSubresource() != "" {
+		return nil
+	}
+
+	if len(f.immutableFields) == 0 {
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*core.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+	oldShoot, ok := a.GetOldObject().(*core.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert old resource into Shoot object")
+	}
+
+	shootMap, err := shootToUnstructured(shoot)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	oldShootMap, err := shootToUnstructured(oldShoot)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+
+	userGroups := sets.New(a.GetUserInfo().GetGroups()...)
+
+	for _, immutableField := range f.immutableFields {
+		if userGroups.HasAny(immutableField.MutableByGroups...) {
+			continue
+		}
+
+		fields := strings.Split(immutableField.Path, ".")
+		value, _, err := unstructured.NestedFieldNoCopy(shootMap, fields...)
+		if err != nil {
+			return apierrors.NewInternalError(fmt.Errorf("could not read field %q from Shoot: %w", immutableField.Path, err))
+		}
+		oldValue, _, err := unstructured.NestedFieldNoCopy(oldShootMap, fields...)
+		if err != nil {
+			return apierrors.NewInternalError(fmt.Errorf("could not read field %q from old Shoot: %w", immutableField.Path, err))
+		}
+
+		if !apiequality.Semantic.DeepEqual(value, oldValue) {
+			return admission.NewForbidden(a, fmt.Errorf("field %q is declared immutable by the landscape operator and must not be changed", immutableField.Path))
+		}
+	}
+
+	return nil
+}
+
+// shootToUnstructured converts the given shoot into an unstructured map based on the v1beta1 API so that the
+// configured field paths can be resolved against the external field names.
+func shootToUnstructured(shoot *core.Shoot) (map[string]any, error) {
+	v1beta1Shoot := &gardencorev1beta1.Shoot{}
+	if err := gardencorev1beta1.Convert_core_Shoot_To_v1beta1_Shoot(shoot, v1beta1Shoot, nil); err != nil {
+		return nil, fmt.Errorf("could not convert Shoot to external version: %w", err)
+	}
+
+	shootMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(v1beta1Shoot)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert Shoot to unstructured map: %w", err)
+	}
+
+	return shootMap, nil
+}
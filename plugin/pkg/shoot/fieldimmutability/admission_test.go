@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fieldimmutability_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/fieldimmutability"
+	"github.com/gardener/gardener/plugin/pkg/shoot/fieldimmutability/apis/shootfieldimmutability"
+)
+
+var _ = Describe("field immutability", func() {
+	Describe("#Validate", func() {
+		var (
+			namespace = "dummy"
+
+			shoot    *core.Shoot
+			oldShoot *core.Shoot
+			userInfo *user.DefaultInfo
+
+			admissionHandler admission.ValidationInterface
+		)
+
+		BeforeEach(func() {
+			admissionHandler = New([]shootfieldimmutability.ImmutableField{
+				{Path: "spec.networking.type"},
+				{Path: "spec.region", MutableByGroups: []string{"operators"}},
+			})
+
+			shoot = &core.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shoot",
+					Namespace: namespace,
+				},
+				Spec: core.ShootSpec{
+					Region: "region-1",
+					Networking: &core.Networking{
+						Type: ptr.To("calico"),
+					},
+				},
+			}
+			oldShoot = shoot.DeepCopy()
+			userInfo = &user.DefaultInfo{Name: "foo"}
+		})
+
+		newUpdateAttributes := func() admission.Attributes {
+			return admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+		}
+
+		It("should do nothing because the resource is not Shoot", func() {
+			attrs := admission.NewAttributesRecord(nil, nil, core.Kind("Foo").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("foos").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+
+			Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+		})
+
+		It("should do nothing on CREATE operations", func() {
+			attrs := admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+
+			Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+		})
+
+		It("should allow updates which do not touch immutable fields", func() {
+			shoot.Spec.Kubernetes.Version = "1.33.1"
+
+			Expect(admissionHandler.Validate(context.TODO(), newUpdateAttributes(), nil)).To(Succeed())
+		})
+
+		It("should forbid changing an immutable field", func() {
+			shoot.Spec.Networking.Type = ptr.To("cilium")
+
+			err := admissionHandler.Validate(context.TODO(), newUpdateAttributes(), nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.networking.type"))
+		})
+
+		It("should forbid changing an immutable field for users not in the allowed groups", func() {
+			shoot.Spec.Region = "region-2"
+
+			err := admissionHandler.Validate(context.TODO(), newUpdateAttributes(), nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.region"))
+		})
+
+		It("should allow changing an immutable field for users in the allowed groups", func() {
+			shoot.Spec.Region = "region-2"
+			userInfo.Groups = []string{"operators"}
+
+			Expect(admissionHandler.Validate(context.TODO(), newUpdateAttributes(), nil)).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Configuration provides configuration for the ShootFieldImmutability admission controller.
+type Configuration struct {
+	metav1.TypeMeta
+
+	// ImmutableFields is a list of policies which declare additional Shoot fields as immutable after creation.
+	// +optional
+	ImmutableFields []ImmutableField `json:"immutableFields,omitempty"`
+}
+
+// ImmutableField declares a single Shoot field as immutable after creation.
+type ImmutableField struct {
+	// Path is the dotted path to the field in the Shoot resource, e.g. `spec.networking.type`.
+	Path string `json:"path"`
+	// MutableByGroups is an optional list of user groups whose members are still allowed to change the field,
+	// e.g. operator groups.
+	// +optional
+	MutableByGroups []string `json:"mutableByGroups,omitempty"`
+}
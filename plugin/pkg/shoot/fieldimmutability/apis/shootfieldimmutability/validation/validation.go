@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/plugin/pkg/shoot/fieldimmutability/apis/shootfieldimmutability"
+)
+
+// ValidateConfiguration validates the configuration.
+func ValidateConfiguration(config *shootfieldimmutability.Configuration) field.ErrorList {
+	var (
+		allErrs field.ErrorList
+		paths   = sets.New[string]()
+	)
+
+	for i, immutableField := range config.ImmutableFields {
+		idxPath := field.NewPath("immutableFields").Index(i)
+
+		if len(immutableField.Path) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("path"), "field path must not be empty"))
+		} else if !strings.HasPrefix(immutableField.Path, "spec.") {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("path"), immutableField.Path, "only fields in the Shoot specification can be declared immutable, the path must start with 'spec.'"))
+		}
+
+		if paths.Has(immutableField.Path) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("path"), immutableField.Path))
+		}
+		paths.Insert(immutableField.Path)
+
+		for j, group := range immutableField.MutableByGroups {
+			if len(group) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("mutableByGroups").Index(j), "group must not be empty"))
+			}
+		}
+	}
+
+	return allErrs
+}
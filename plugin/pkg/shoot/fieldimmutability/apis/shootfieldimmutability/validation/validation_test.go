@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/plugin/pkg/shoot/fieldimmutability/apis/shootfieldimmutability"
+	"github.com/gardener/gardener/plugin/pkg/shoot/fieldimmutability/apis/shootfieldimmutability/validation"
+)
+
+var _ = Describe("#ValidateConfiguration", func() {
+	var config *shootfieldimmutability.Configuration
+
+	BeforeEach(func() {
+		config = &shootfieldimmutability.Configuration{
+			ImmutableFields: []shootfieldimmutability.ImmutableField{
+				{Path: "spec.networking.type"},
+				{Path: "spec.region", MutableByGroups: []string{"operators"}},
+			},
+		}
+	})
+
+	It("should allow a valid configuration", func() {
+		Expect(validation.ValidateConfiguration(config)).To(BeEmpty())
+	})
+
+	It("should forbid empty field paths", func() {
+		config.ImmutableFields = append(config.ImmutableFields, shootfieldimmutability.ImmutableField{})
+
+		Expect(validation.ValidateConfiguration(config)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("immutableFields[2].path"),
+		}))))
+	})
+
+	It("should forbid field paths outside of the specification", func() {
+		config.ImmutableFields = append(config.ImmutableFields, shootfieldimmutability.ImmutableField{Path: "metadata.labels"})
+
+		Expect(validation.ValidateConfiguration(config)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("immutableFields[2].path"),
+		}))))
+	})
+
+	It("should forbid duplicate field paths", func() {
+		config.ImmutableFields = append(config.ImmutableFields, shootfieldimmutability.ImmutableField{Path: "spec.region"})
+
+		Expect(validation.ValidateConfiguration(config)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeDuplicate),
+			"Field": Equal("immutableFields[2].path"),
+		}))))
+	})
+
+	It("should forbid empty groups", func() {
+		config.ImmutableFields[1].MutableByGroups = []string{""}
+
+		Expect(validation.ValidateConfiguration(config)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("immutableFields[1].mutableByGroups[0]"),
+		}))))
+	})
+})
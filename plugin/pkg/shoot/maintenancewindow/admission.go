@@ -0,0 +1,256 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenancewindow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apiserver/pkg/admission"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	"github.com/gardener/gardener/pkg/utils/timewindow"
+	plugin "github.com/gardener/gardener/plugin/pkg"
+	"github.com/gardener/gardener/plugin/pkg/shoot/maintenancewindow/apis/shootmaintenancewindow/validation"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(plugin.PluginNameShootMaintenanceWindow, func(config io.Reader) (admission.Interface, error) {
+		cfg, err := LoadConfiguration(config)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := validation.ValidateConfiguration(cfg); err != nil {
+			return nil, fmt.Errorf("invalid config: %+v", err)
+		}
+
+		return New(cfg.MaxShootsPerHour)
+	})
+}
+
+// MaintenanceWindow contains listers and admission handler.
+type MaintenanceWindow struct {
+	*admission.Handler
+
+	maxShootsPerHour *int32
+	shootLister      gardencorev1beta1listers.ShootLister
+	readyFunc        admission.ReadyFunc
+}
+
+var (
+	_ = admissioninitializer.WantsCoreInformerFactory(&MaintenanceWindow{})
+
+	readyFuncs []admission.ReadyFunc
+)
+
+// New creates a new MaintenanceWindow admission plugin.
+func New(maxShootsPerHour *int32) (*MaintenanceWindow, error) {
+	return &MaintenanceWindow{
+		Handler:          admission.NewHandler(admission.Create),
+		maxShootsPerHour: maxShootsPerHour,
+	}, nil
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (m *MaintenanceWindow) AssignReadyFunc(f admission.ReadyFunc) {
+	m.readyFunc = f
+	m.SetReadyFunc(f)
+}
+
+// SetCoreInformerFactory gets Lister from SharedInformerFactory.
+func (m *MaintenanceWindow) SetCoreInformerFactory(f gardencoreinformers.SharedInformerFactory) {
+	shootInformer := f.Core().V1beta1().Shoots()
+	m.shootLister = shootInformer.Lister()
+
+	readyFuncs = append(readyFuncs, shootInformer.Informer().HasSynced)
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (m *MaintenanceWindow) ValidateInitialization() error {
+	if m.shootLister == nil {
+		return errors.New("missing shoot lister")
+	}
+	return nil
+}
+
+var (
+	_ admission.MutationInterface   = (*MaintenanceWindow)(nil)
+	_ admission.ValidationInterface = (*MaintenanceWindow)(nil)
+)
+
+// Admit assigns a maintenance time window to shoots which are created without an explicitly chosen one. Instead of the
+// randomized default, the least-loaded clock hour among all shoots in the same region is selected so that maintenance
+// operations are spread evenly across the day.
+func (m *MaintenanceWindow) Admit(_ context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	if err := m.waitUntilReady(a); err != nil {
+		return err
+	}
+
+	if a.GetKind().GroupKind() != core.Kind("Shoot") {
+		return nil
+	}
+
+	if a.GetOperation() != admission.Create || a.GetSubresource() != "" {
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*core.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+
+	if !isUnspecifiedTimeWindow(shoot.Spec.Maintenance) {
+		return nil
+	}
+
+	shootsPerHour, err := m.shootsPerBeginHour(shoot.Spec.Region)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+
+	begin := timewindow.NewMaintenanceTime(leastLoadedHour(shootsPerHour), 0, 0)
+
+	if shoot.Spec.Maintenance == nil {
+		shoot.Spec.Maintenance = &core.Maintenance{}
+	}
+	shoot.Spec.Maintenance.TimeWindow = &core.MaintenanceTimeWindow{
+		Begin: begin.Formatted(),
+		End:   begin.Add(1, 0, 0).Formatted(),
+	}
+
+	return nil
+}
+
+// Validate rejects shoot creations whose maintenance time window begins in a clock hour which is already occupied by
+// the configured maximum number of shoots in the same region.
+func (m *MaintenanceWindow) Validate(_ context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	if err := m.waitUntilReady(a); err != nil {
+		return err
+	}
+
+	if a.GetKind().GroupKind() != core.Kind("Shoot") {
+		return nil
+	}
+
+	if a.GetOperation() != admission.Create || a.GetSubresource() != "" {
+		return nil
+	}
+
+	if m.maxShootsPerHour == nil {
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*core.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+
+	if shoot.Spec.Maintenance == nil || shoot.Spec.Maintenance.TimeWindow == nil {
+		return nil
+	}
+
+	begin, err := timewindow.ParseMaintenanceTime(shoot.Spec.Maintenance.TimeWindow.Begin)
+	if err != nil {
+		// malformed time windows are rejected by the standard shoot validation
+		return nil
+	}
+
+	shootsPerHour, err := m.shootsPerBeginHour(shoot.Spec.Region)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+
+	if count := shootsPerHour[begin.Hour()]; count >= int(*m.maxShootsPerHour) {
+		return admission.NewForbidden(a, fmt.Errorf("maintenance time window must not begin at hour %d because %d shoot(s) in region %q already begin their maintenance in this hour (configured maximum is %d per hour)", begin.Hour(), count, shoot.Spec.Region, *m.maxShootsPerHour))
+	}
+
+	return nil
+}
+
+// waitUntilReady waits until the caches have been synced.
+func (m *MaintenanceWindow) waitUntilReady(a admission.Attributes) error {
+	if m.readyFunc == nil {
+		m.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	if !m.WaitForReady() {
+		return admission.NewForbidden(a, errors.New("not yet ready to handle request"))
+	}
+	return nil
+}
+
+// isUnspecifiedTimeWindow returns true if the given maintenance section does not carry an explicitly chosen time
+// window. The randomized defaulting has already run when admission plugins are invoked, so a shoot created without a
+// time window carries a full clock-hour window in UTC at this point. Such windows are treated as unspecified and are
+// re-assigned by this plugin.
+func isUnspecifiedTimeWindow(maintenance *core.Maintenance) bool {
+	if maintenance == nil || maintenance.TimeWindow == nil {
+		return true
+	}
+
+	if !strings.HasSuffix(maintenance.TimeWindow.Begin, "+0000") || !strings.HasSuffix(maintenance.TimeWindow.End, "+0000") {
+		return false
+	}
+
+	window, err := timewindow.ParseMaintenanceTimeWindow(maintenance.TimeWindow.Begin, maintenance.TimeWindow.End)
+	if err != nil {
+		return false
+	}
+
+	return window.Begin().Minute() == 0 && window.Begin().Second() == 0 && window.End().Compare(window.Begin().Add(1, 0, 0)) == 0
+}
+
+// shootsPerBeginHour returns how many shoots in the given region begin their maintenance time window in each UTC clock
+// hour.
+func (m *MaintenanceWindow) shootsPerBeginHour(region string) (map[int]int, error) {
+	shoots, err := m.shootLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	shootsPerHour := map[int]int{}
+	for _, shoot := range shoots {
+		if shoot.Spec.Region != region || shoot.Spec.Maintenance == nil || shoot.Spec.Maintenance.TimeWindow == nil {
+			continue
+		}
+
+		begin, err := timewindow.ParseMaintenanceTime(shoot.Spec.Maintenance.TimeWindow.Begin)
+		if err != nil {
+			continue
+		}
+
+		shootsPerHour[begin.Hour()]++
+	}
+
+	return shootsPerHour, nil
+}
+
+// leastLoadedHour returns the UTC clock hour with the fewest maintenance time window begins. Ties are resolved in
+// favor of the earliest hour.
+func leastLoadedHour(shootsPerHour map[int]int) int {
+	hour := 0
+	for candidate := 1; candidate < 24; candidate++ {
+		if shootsPerHour[candidate] < shootsPerHour[hour] {
+			hour = candidate
+		}
+	}
+	return hour
+}
@@ -0,0 +1,206 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenancewindow_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/maintenancewindow"
+)
+
+var _ = Describe("MaintenanceWindow", func() {
+	var (
+		shoot               *core.Shoot
+		coreInformerFactory gardencoreinformers.SharedInformerFactory
+		admissionHandler    *MaintenanceWindow
+
+		newExistingShoot = func(name, region, begin, end string) *gardencorev1beta1.Shoot {
+			return &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "garden-project",
+				},
+				Spec: gardencorev1beta1.ShootSpec{
+					Region: region,
+					Maintenance: &gardencorev1beta1.Maintenance{
+						TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{
+							Begin: begin,
+							End:   end,
+						},
+					},
+				},
+			}
+		}
+
+		newShootAttributes = func(shoot *core.Shoot) admission.Attributes {
+			return admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+		}
+
+		setupHandler = func(maxShootsPerHour *int32) {
+			var err error
+			admissionHandler, err = New(maxShootsPerHour)
+			Expect(err).NotTo(HaveOccurred())
+			admissionHandler.AssignReadyFunc(func() bool { return true })
+
+			coreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetCoreInformerFactory(coreInformerFactory)
+		}
+	)
+
+	BeforeEach(func() {
+		shoot = &core.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "shoot",
+				Namespace: "garden-project",
+			},
+			Spec: core.ShootSpec{
+				Region: "europe",
+			},
+		}
+
+		setupHandler(nil)
+	})
+
+	Describe("#Admit", func() {
+		It("should do nothing if the resource is not a Shoot", func() {
+			attrs := admission.NewAttributesRecord(nil, nil, core.Kind("Foo").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("foos").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+			Expect(admissionHandler.Admit(context.TODO(), attrs, nil)).To(Succeed())
+		})
+
+		It("should assign the earliest hour if no other shoots exist in the region", func() {
+			Expect(admissionHandler.Admit(context.TODO(), newShootAttributes(shoot), nil)).To(Succeed())
+
+			Expect(shoot.Spec.Maintenance.TimeWindow.Begin).To(Equal("000000+0000"))
+			Expect(shoot.Spec.Maintenance.TimeWindow.End).To(Equal("010000+0000"))
+		})
+
+		It("should assign the least-loaded hour in the shoot's region", func() {
+			Expect(coreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(newExistingShoot("shoot-1", "europe", "000000+0000", "010000+0000"))).To(Succeed())
+			Expect(coreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(newExistingShoot("shoot-2", "europe", "010000+0000", "020000+0000"))).To(Succeed())
+
+			Expect(admissionHandler.Admit(context.TODO(), newShootAttributes(shoot), nil)).To(Succeed())
+
+			Expect(shoot.Spec.Maintenance.TimeWindow.Begin).To(Equal("020000+0000"))
+			Expect(shoot.Spec.Maintenance.TimeWindow.End).To(Equal("030000+0000"))
+		})
+
+		It("should ignore shoots in other regions when computing the load", func() {
+			Expect(coreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(newExistingShoot("shoot-1", "asia", "000000+0000", "010000+0000"))).To(Succeed())
+
+			Expect(admissionHandler.Admit(context.TODO(), newShootAttributes(shoot), nil)).To(Succeed())
+
+			Expect(shoot.Spec.Maintenance.TimeWindow.Begin).To(Equal("000000+0000"))
+		})
+
+		It("should re-assign a time window which looks like the randomized default", func() {
+			shoot.Spec.Maintenance = &core.Maintenance{TimeWindow: &core.MaintenanceTimeWindow{Begin: "150000+0000", End: "160000+0000"}}
+
+			Expect(admissionHandler.Admit(context.TODO(), newShootAttributes(shoot), nil)).To(Succeed())
+
+			Expect(shoot.Spec.Maintenance.TimeWindow.Begin).To(Equal("000000+0000"))
+			Expect(shoot.Spec.Maintenance.TimeWindow.End).To(Equal("010000+0000"))
+		})
+
+		It("should keep an explicitly chosen time window", func() {
+			shoot.Spec.Maintenance = &core.Maintenance{TimeWindow: &core.MaintenanceTimeWindow{Begin: "153000+0000", End: "163000+0000"}}
+
+			Expect(admissionHandler.Admit(context.TODO(), newShootAttributes(shoot), nil)).To(Succeed())
+
+			Expect(shoot.Spec.Maintenance.TimeWindow.Begin).To(Equal("153000+0000"))
+			Expect(shoot.Spec.Maintenance.TimeWindow.End).To(Equal("163000+0000"))
+		})
+
+		It("should keep an explicitly chosen time window in a non-UTC zone", func() {
+			shoot.Spec.Maintenance = &core.Maintenance{TimeWindow: &core.MaintenanceTimeWindow{Begin: "150000+0200", End: "160000+0200"}}
+
+			Expect(admissionHandler.Admit(context.TODO(), newShootAttributes(shoot), nil)).To(Succeed())
+
+			Expect(shoot.Spec.Maintenance.TimeWindow.Begin).To(Equal("150000+0200"))
+			Expect(shoot.Spec.Maintenance.TimeWindow.End).To(Equal("160000+0200"))
+		})
+	})
+
+	Describe("#Validate", func() {
+		BeforeEach(func() {
+			shoot.Spec.Maintenance = &core.Maintenance{TimeWindow: &core.MaintenanceTimeWindow{Begin: "153000+0000", End: "163000+0000"}}
+		})
+
+		It("should do nothing if no maximum number of shoots per hour is configured", func() {
+			Expect(admissionHandler.Validate(context.TODO(), newShootAttributes(shoot), nil)).To(Succeed())
+		})
+
+		It("should forbid a time window beginning in an overloaded hour", func() {
+			setupHandler(ptr.To[int32](1))
+			Expect(coreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(newExistingShoot("shoot-1", "europe", "150000+0000", "160000+0000"))).To(Succeed())
+
+			err := admissionHandler.Validate(context.TODO(), newShootAttributes(shoot), nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("must not begin at hour 15"))
+		})
+
+		It("should allow a time window beginning in an hour below the maximum", func() {
+			setupHandler(ptr.To[int32](2))
+			Expect(coreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(newExistingShoot("shoot-1", "europe", "150000+0000", "160000+0000"))).To(Succeed())
+
+			Expect(admissionHandler.Validate(context.TODO(), newShootAttributes(shoot), nil)).To(Succeed())
+		})
+
+		It("should ignore shoots in other regions", func() {
+			setupHandler(ptr.To[int32](1))
+			Expect(coreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(newExistingShoot("shoot-1", "asia", "150000+0000", "160000+0000"))).To(Succeed())
+
+			Expect(admissionHandler.Validate(context.TODO(), newShootAttributes(shoot), nil)).To(Succeed())
+		})
+	})
+
+	Describe("#Register", func() {
+		It("should register the plugin", func() {
+			plugins := admission.NewPlugins()
+			Register(plugins)
+
+			registered := plugins.Registered()
+			Expect(registered).To(HaveLen(1))
+			Expect(registered).To(ContainElement("ShootMaintenanceWindow"))
+		})
+	})
+
+	Describe("#New", func() {
+		It("should only handle CREATE operations", func() {
+			admissionHandler, err := New(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(admissionHandler.Handles(admission.Create)).To(BeTrue())
+			Expect(admissionHandler.Handles(admission.Update)).NotTo(BeTrue())
+			Expect(admissionHandler.Handles(admission.Connect)).NotTo(BeTrue())
+			Expect(admissionHandler.Handles(admission.Delete)).NotTo(BeTrue())
+		})
+	})
+
+	Describe("#ValidateInitialization", func() {
+		It("should fail if the required listers are not set", func() {
+			admissionHandler, _ := New(nil)
+
+			err := admissionHandler.ValidateInitialization()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should not fail if the required listers are set", func() {
+			admissionHandler, _ := New(nil)
+			admissionHandler.SetCoreInformerFactory(gardencoreinformers.NewSharedInformerFactory(nil, 0))
+
+			err := admissionHandler.ValidateInitialization()
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,10 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// +k8s:deepcopy-gen=package
+// +k8s:conversion-gen=github.com/gardener/gardener/plugin/pkg/shoot/maintenancewindow/apis/shootmaintenancewindow
+// +k8s:defaulter-gen=TypeMeta
+// +groupName=shootmaintenancewindow.admission.gardener.cloud
+
+package v1alpha1 // import "github.com/gardener/gardener/plugin/pkg/shoot/maintenancewindow/apis/shootmaintenancewindow/v1alpha1"
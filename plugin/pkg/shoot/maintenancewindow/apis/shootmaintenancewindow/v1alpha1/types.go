@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Configuration provides configuration for the ShootMaintenanceWindow admission controller.
+type Configuration struct {
+	metav1.TypeMeta
+
+	// MaxShootsPerHour is the maximum number of shoots per region whose maintenance time window may begin within the
+	// same clock hour. If set, requests with an explicitly chosen maintenance time window are rejected when the
+	// window's begin hour is already occupied by this many shoots. If unset, windows are distributed but never
+	// rejected.
+	// +optional
+	MaxShootsPerHour *int32 `json:"maxShootsPerHour,omitempty"`
+}
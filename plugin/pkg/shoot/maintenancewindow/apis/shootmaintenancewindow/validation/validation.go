@@ -0,0 +1,22 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/plugin/pkg/shoot/maintenancewindow/apis/shootmaintenancewindow"
+)
+
+// ValidateConfiguration validates the configuration.
+func ValidateConfiguration(config *shootmaintenancewindow.Configuration) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config.MaxShootsPerHour != nil && *config.MaxShootsPerHour < 1 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("maxShootsPerHour"), *config.MaxShootsPerHour, "must be greater than 0"))
+	}
+
+	return allErrs
+}
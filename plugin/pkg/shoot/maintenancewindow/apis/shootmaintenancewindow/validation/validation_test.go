@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/plugin/pkg/shoot/maintenancewindow/apis/shootmaintenancewindow"
+	"github.com/gardener/gardener/plugin/pkg/shoot/maintenancewindow/apis/shootmaintenancewindow/validation"
+)
+
+var _ = Describe("#ValidateConfiguration", func() {
+	var config *shootmaintenancewindow.Configuration
+
+	BeforeEach(func() {
+		config = &shootmaintenancewindow.Configuration{}
+	})
+
+	It("should allow an empty configuration", func() {
+		Expect(validation.ValidateConfiguration(config)).To(BeEmpty())
+	})
+
+	It("should allow a positive maximum number of shoots per hour", func() {
+		config.MaxShootsPerHour = ptr.To[int32](10)
+
+		Expect(validation.ValidateConfiguration(config)).To(BeEmpty())
+	})
+
+	It("should forbid a non-positive maximum number of shoots per hour", func() {
+		config.MaxShootsPerHour = ptr.To[int32](0)
+
+		Expect(validation.ValidateConfiguration(config)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("maxShootsPerHour"),
+		}))))
+	})
+})
@@ -272,6 +272,7 @@ func addDNSRecordDeploymentTasks(shoot *core.Shoot) {
 	addDeploymentTasks(shoot,
 		v1beta1constants.ShootTaskDeployDNSRecordInternal,
 		v1beta1constants.ShootTaskDeployDNSRecordExternal,
+		v1beta1constants.ShootTaskDeployDNSRecordManagement,
 		v1beta1constants.ShootTaskDeployDNSRecordIngress,
 	)
 }
@@ -0,0 +1,289 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package podsecurity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/runtime/serializer/json"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apiserver/pkg/admission"
+	admissionapiv1 "k8s.io/pod-security-admission/admission/api/v1"
+	podsecurityapi "k8s.io/pod-security-admission/api"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	plugin "github.com/gardener/gardener/plugin/pkg"
+	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
+)
+
+const (
+	// AnnotationDefaultPodSecurityEnforce is a constant for an annotation on Projects. Its value is the PodSecurity
+	// enforce level which is defaulted for the kube-apiservers of all shoot clusters in the project.
+	AnnotationDefaultPodSecurityEnforce = "security.gardener.cloud/default-pod-security-enforce"
+	// AnnotationMinPodSecurityEnforce is a constant for an annotation on Projects. Its value is the minimum PodSecurity
+	// enforce level that shoot clusters in the project must configure for their kube-apiservers.
+	AnnotationMinPodSecurityEnforce = "security.gardener.cloud/min-pod-security-enforce"
+
+	podSecurityPluginName = "PodSecurity"
+)
+
+var (
+	runtimeScheme *runtime.Scheme
+	codec         runtime.Codec
+)
+
+func init() {
+	runtimeScheme = runtime.NewScheme()
+	utilruntime.Must(admissionapiv1.AddToScheme(runtimeScheme))
+
+	var (
+		ser = json.NewSerializerWithOptions(json.DefaultMetaFactory, runtimeScheme, runtimeScheme, json.SerializerOptions{
+			Yaml:   true,
+			Pretty: false,
+			Strict: false,
+		})
+		versions = schema.GroupVersions([]schema.GroupVersion{admissionapiv1.SchemeGroupVersion})
+	)
+
+	codec = serializer.NewCodecFactory(runtimeScheme).CodecForVersions(ser, ser, versions, versions)
+}
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(plugin.PluginNameShootPodSecurityDefaults, func(_ io.Reader) (admission.Interface, error) {
+		return New(), nil
+	})
+}
+
+// PodSecurityDefaults contains listers and admission handler.
+type PodSecurityDefaults struct {
+	*admission.Handler
+
+	projectLister gardencorev1beta1listers.ProjectLister
+	readyFunc     admission.ReadyFunc
+}
+
+var (
+	_ = admissioninitializer.WantsCoreInformerFactory(&PodSecurityDefaults{})
+
+	readyFuncs []admission.ReadyFunc
+)
+
+// New creates a new PodSecurityDefaults admission plugin.
+func New() *PodSecurityDefaults {
+	return &PodSecurityDefaults{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (p *PodSecurityDefaults) AssignReadyFunc(f admission.ReadyFunc) {
+	p.readyFunc = f
+	p.SetReadyFunc(f)
+}
+
+// SetCoreInformerFactory sets the garden core informer factory.
+func (p *PodSecurityDefaults) SetCoreInformerFactory(f gardencoreinformers.SharedInformerFactory) {
+	projectInformer := f.Core().V1beta1().Projects()
+	p.projectLister = projectInformer.Lister()
+
+	readyFuncs = append(readyFuncs, projectInformer.Informer().HasSynced)
+}
+
+func (p *PodSecurityDefaults) waitUntilReady(attrs admission.Attributes) error {
+	// Wait until the caches have been synced
+	if p.readyFunc == nil {
+		p.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+
+	if !p.WaitForReady() {
+		return admission.NewForbidden(attrs, errors.New("not yet ready to handle request"))
+	}
+
+	return nil
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (p *PodSecurityDefaults) ValidateInitialization() error {
+	if p.projectLister == nil {
+		return errors.New("missing Project lister")
+	}
+	return nil
+}
+
+var (
+	_ admission.MutationInterface   = (*PodSecurityDefaults)(nil)
+	_ admission.ValidationInterface = (*PodSecurityDefaults)(nil)
+)
+
+// Admit defaults the PodSecurity admission plugin configuration of the shoot kube-apiserver based on the project's
+// default enforce level or the shoot's purpose.
+func (p *PodSecurityDefaults) Admit(_ context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	if err := p.waitUntilReady(a); err != nil {
+		return fmt.Errorf("err while waiting for ready %w", err)
+	}
+
+	switch {
+	case a.GetKind().GroupKind() != core.Kind("Shoot"),
+		a.GetOperation() != admission.Create,
+		a.GetSubresource() != "":
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*core.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+
+	if findAdmissionPluginConfig(shoot, podSecurityPluginName) != nil {
+		// The user explicitly configured the PodSecurity admission plugin - the configuration is only checked against
+		// the project's minimum enforce level in Validate.
+		return nil
+	}
+
+	project, err := admissionutils.ProjectForNamespaceFromLister(p.projectLister, shoot.Namespace)
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("could not find referenced project: %+v", err.Error()))
+	}
+
+	level, err := defaultEnforceLevel(project.Annotations, shoot)
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("invalid %s annotation on project %s: %w", AnnotationDefaultPodSecurityEnforce, project.Name, err))
+	}
+	if level == nil {
+		return nil
+	}
+
+	config, err := encodePodSecurityConfiguration(*level)
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("could not encode PodSecurity configuration: %w", err))
+	}
+
+	if shoot.Spec.Kubernetes.KubeAPIServer == nil {
+		shoot.Spec.Kubernetes.KubeAPIServer = &core.KubeAPIServerConfig{}
+	}
+	shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins = append(shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins, core.AdmissionPlugin{
+		Name:   podSecurityPluginName,
+		Config: &runtime.RawExtension{Raw: config},
+	})
+
+	return nil
+}
+
+// Validate checks that a user-provided PodSecurity admission plugin configuration does not fall below the project's
+// minimum enforce level.
+func (p *PodSecurityDefaults) Validate(_ context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	if err := p.waitUntilReady(a); err != nil {
+		return fmt.Errorf("err while waiting for ready %w", err)
+	}
+
+	if a.GetKind().GroupKind() != core.Kind("Shoot") || a.GetSubresource() != "" {
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*core.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+
+	pluginConfig := findAdmissionPluginConfig(shoot, podSecurityPluginName)
+	if pluginConfig == nil {
+		return nil
+	}
+
+	project, err := admissionutils.ProjectForNamespaceFromLister(p.projectLister, shoot.Namespace)
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("could not find referenced project: %+v", err.Error()))
+	}
+
+	minLevelValue, ok := project.Annotations[AnnotationMinPodSecurityEnforce]
+	if !ok {
+		return nil
+	}
+
+	minLevel, err := podsecurityapi.ParseLevel(minLevelValue)
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("invalid %s annotation on project %s: %w", AnnotationMinPodSecurityEnforce, project.Name, err))
+	}
+
+	obj, err := runtime.Decode(codec, pluginConfig.Raw)
+	if err != nil {
+		// Invalid configurations are rejected by the standard shoot validation.
+		return nil
+	}
+
+	config, ok := obj.(*admissionapiv1.PodSecurityConfiguration)
+	if !ok {
+		return nil
+	}
+
+	level, err := podsecurityapi.ParseLevel(config.Defaults.Enforce)
+	if err != nil {
+		return nil
+	}
+
+	if podsecurityapi.CompareLevels(level, minLevel) < 0 {
+		return admission.NewForbidden(a, fmt.Errorf("PodSecurity enforce level %q is more permissive than the minimum level %q required by project %s", level, minLevel, project.Name))
+	}
+
+	return nil
+}
+
+func findAdmissionPluginConfig(shoot *core.Shoot, name string) *runtime.RawExtension {
+	if shoot.Spec.Kubernetes.KubeAPIServer == nil {
+		return nil
+	}
+
+	for _, plugin := range shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins {
+		if plugin.Name == name && plugin.Config != nil && len(plugin.Config.Raw) > 0 {
+			return plugin.Config
+		}
+	}
+
+	return nil
+}
+
+func defaultEnforceLevel(projectAnnotations map[string]string, shoot *core.Shoot) (*podsecurityapi.Level, error) {
+	if value, ok := projectAnnotations[AnnotationDefaultPodSecurityEnforce]; ok {
+		level, err := podsecurityapi.ParseLevel(value)
+		if err != nil {
+			return nil, err
+		}
+		return &level, nil
+	}
+
+	if shoot.Spec.Purpose != nil && *shoot.Spec.Purpose == core.ShootPurposeProduction {
+		level := podsecurityapi.LevelRestricted
+		return &level, nil
+	}
+
+	return nil, nil
+}
+
+func encodePodSecurityConfiguration(level podsecurityapi.Level) ([]byte, error) {
+	return runtime.Encode(codec, &admissionapiv1.PodSecurityConfiguration{
+		Defaults: admissionapiv1.PodSecurityDefaults{
+			Enforce:        string(level),
+			EnforceVersion: "latest",
+		},
+	})
+}
@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package podsecurity_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/podsecurity"
+)
+
+var _ = Describe("podsecurity", func() {
+	var (
+		namespace = "dummy"
+
+		shoot   *core.Shoot
+		project *gardencorev1beta1.Project
+
+		attrs            admission.Attributes
+		admissionHandler *PodSecurityDefaults
+
+		gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory
+	)
+
+	BeforeEach(func() {
+		gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+
+		admissionHandler = New()
+		admissionHandler.AssignReadyFunc(func() bool { return true })
+		admissionHandler.SetCoreInformerFactory(gardenCoreInformerFactory)
+
+		shoot = &core.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "dummy",
+				Namespace: namespace,
+			},
+		}
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "dummy",
+			},
+			Spec: gardencorev1beta1.ProjectSpec{
+				Namespace: &namespace,
+			},
+		}
+	})
+
+	Describe("#Admit", func() {
+		It("should do nothing because the resource is not a Shoot", func() {
+			attrs = admission.NewAttributesRecord(nil, nil, core.Kind("Foo").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("foos").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+			Expect(admissionHandler.Admit(context.TODO(), attrs, nil)).To(Succeed())
+		})
+
+		It("should do nothing because neither project annotation nor production purpose are present", func() {
+			Expect(gardenCoreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(project)).To(Succeed())
+			attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+			Expect(admissionHandler.Admit(context.TODO(), attrs, nil)).To(Succeed())
+			Expect(shoot.Spec.Kubernetes.KubeAPIServer).To(BeNil())
+		})
+
+		It("should default the PodSecurity configuration from the project annotation", func() {
+			project.Annotations = map[string]string{AnnotationDefaultPodSecurityEnforce: "baseline"}
+
+			Expect(gardenCoreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(project)).To(Succeed())
+			attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+			Expect(admissionHandler.Admit(context.TODO(), attrs, nil)).To(Succeed())
+			Expect(shoot.Spec.Kubernetes.KubeAPIServer).NotTo(BeNil())
+			Expect(shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins).To(HaveLen(1))
+			Expect(shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins[0].Name).To(Equal("PodSecurity"))
+			Expect(string(shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins[0].Config.Raw)).To(ContainSubstring("enforce: baseline"))
+		})
+
+		It("should default the PodSecurity configuration to restricted for production shoots", func() {
+			shoot.Spec.Purpose = ptr.To(core.ShootPurposeProduction)
+
+			Expect(gardenCoreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(project)).To(Succeed())
+			attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+			Expect(admissionHandler.Admit(context.TODO(), attrs, nil)).To(Succeed())
+			Expect(shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins).To(HaveLen(1))
+			Expect(string(shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins[0].Config.Raw)).To(ContainSubstring("enforce: restricted"))
+		})
+
+		It("should not overwrite a user-provided PodSecurity configuration", func() {
+			project.Annotations = map[string]string{AnnotationDefaultPodSecurityEnforce: "restricted"}
+			shoot.Spec.Kubernetes.KubeAPIServer = &core.KubeAPIServerConfig{
+				AdmissionPlugins: []core.AdmissionPlugin{{
+					Name:   "PodSecurity",
+					Config: &runtime.RawExtension{Raw: podSecurityConfig("baseline")},
+				}},
+			}
+
+			Expect(gardenCoreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(project)).To(Succeed())
+			attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+			Expect(admissionHandler.Admit(context.TODO(), attrs, nil)).To(Succeed())
+			Expect(shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins).To(HaveLen(1))
+			Expect(string(shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins[0].Config.Raw)).To(ContainSubstring("baseline"))
+		})
+
+		It("should fail when the project annotation contains an invalid level", func() {
+			project.Annotations = map[string]string{AnnotationDefaultPodSecurityEnforce: "foo"}
+
+			Expect(gardenCoreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(project)).To(Succeed())
+			attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+			Expect(admissionHandler.Admit(context.TODO(), attrs, nil)).To(MatchError(ContainSubstring("invalid")))
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should do nothing because the shoot does not configure PodSecurity", func() {
+			project.Annotations = map[string]string{AnnotationMinPodSecurityEnforce: "baseline"}
+
+			Expect(gardenCoreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(project)).To(Succeed())
+			attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+
+			Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+		})
+
+		It("should allow enforce levels at least as strict as the project minimum", func() {
+			project.Annotations = map[string]string{AnnotationMinPodSecurityEnforce: "baseline"}
+			shoot.Spec.Kubernetes.KubeAPIServer = &core.KubeAPIServerConfig{
+				AdmissionPlugins: []core.AdmissionPlugin{{
+					Name:   "PodSecurity",
+					Config: &runtime.RawExtension{Raw: podSecurityConfig("restricted")},
+				}},
+			}
+
+			Expect(gardenCoreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(project)).To(Succeed())
+			attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+
+			Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+		})
+
+		It("should forbid enforce levels more permissive than the project minimum", func() {
+			project.Annotations = map[string]string{AnnotationMinPodSecurityEnforce: "baseline"}
+			shoot.Spec.Kubernetes.KubeAPIServer = &core.KubeAPIServerConfig{
+				AdmissionPlugins: []core.AdmissionPlugin{{
+					Name:   "PodSecurity",
+					Config: &runtime.RawExtension{Raw: podSecurityConfig("privileged")},
+				}},
+			}
+
+			Expect(gardenCoreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(project)).To(Succeed())
+			attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+
+			err := admissionHandler.Validate(context.TODO(), attrs, nil)
+			Expect(err).To(MatchError(ContainSubstring("more permissive")))
+		})
+	})
+
+	Describe("#ValidateInitialization", func() {
+		It("should return an error if the project lister is missing", func() {
+			Expect(New().ValidateInitialization()).To(MatchError(ContainSubstring("missing Project lister")))
+		})
+
+		It("should succeed if the project lister is set", func() {
+			Expect(admissionHandler.ValidateInitialization()).To(Succeed())
+		})
+	})
+})
+
+func podSecurityConfig(enforce string) []byte {
+	return []byte(`apiVersion: pod-security.admission.config.k8s.io/v1
+kind: PodSecurityConfiguration
+defaults:
+  enforce: ` + enforce + `
+  enforce-version: latest
+`)
+}
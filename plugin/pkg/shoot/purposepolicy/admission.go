@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package purposepolicy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	plugin "github.com/gardener/gardener/plugin/pkg"
+	"github.com/gardener/gardener/plugin/pkg/shoot/purposepolicy/apis/shootpurposepolicy"
+	"github.com/gardener/gardener/plugin/pkg/shoot/purposepolicy/apis/shootpurposepolicy/validation"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(plugin.PluginNameShootPurposePolicy, func(config io.Reader) (admission.Interface, error) {
+		cfg, err := LoadConfiguration(config)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := validation.ValidateConfiguration(cfg); err != nil {
+			return nil, fmt.Errorf("invalid config: %+v", err)
+		}
+
+		return New(cfg), nil
+	})
+}
+
+// PurposePolicy contains the admission handler and the declared custom shoot purposes.
+type PurposePolicy struct {
+	*admission.Handler
+
+	customPurposes map[string]shootpurposepolicy.CustomShootPurpose
+}
+
+var (
+	_ admission.MutationInterface   = (*PurposePolicy)(nil)
+	_ admission.ValidationInterface = (*PurposePolicy)(nil)
+)
+
+// New creates a new ShootPurposePolicy admission plugin.
+func New(config *shootpurposepolicy.Configuration) *PurposePolicy {
+	customPurposes := make(map[string]shootpurposepolicy.CustomShootPurpose, len(config.CustomPurposes))
+	for _, purpose := range config.CustomPurposes {
+		customPurposes[purpose.Name] = purpose
+	}
+
+	return &PurposePolicy{
+		Handler:        admission.NewHandler(admission.Create, admission.Update),
+		customPurposes: customPurposes,
+	}
+}
+
+var standardPurposes = map[core.ShootPurpose]struct{}{
+	core.ShootPurposeEvaluation:     {},
+	core.ShootPurposeTesting:        {},
+	core.ShootPurposeDevelopment:    {},
+	core.ShootPurposeProduction:     {},
+	core.ShootPurposeInfrastructure: {},
+}
+
+// Admit applies the policy bundle of the shoot's custom purpose, i.e., it defaults the high availability configuration
+// and maintains the annotation for disabling the monitoring stack.
+func (p *PurposePolicy) Admit(_ context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	switch {
+	case a.GetKind().GroupKind() != core.Kind("Shoot"),
+		a.GetSubresource() != "":
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*core.Shoot)
+	if !ok {
+		return apierrors.NewInternalError(errors.New("could not convert resource into Shoot object"))
+	}
+
+	customPurpose, ok := p.customPurposeForShoot(shoot)
+	if !ok || ptr.Deref(customPurpose.Monitoring, true) {
+		delete(shoot.Annotations, v1beta1constants.AnnotationShootDisableMonitoring)
+	} else {
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootDisableMonitoring, "true")
+	}
+
+	if !ok || a.GetOperation() != admission.Create {
+		return nil
+	}
+
+	if customPurpose.HighAvailability != nil && (shoot.Spec.ControlPlane == nil || shoot.Spec.ControlPlane.HighAvailability == nil) {
+		if shoot.Spec.ControlPlane == nil {
+			shoot.Spec.ControlPlane = &core.ControlPlane{}
+		}
+		shoot.Spec.ControlPlane.HighAvailability = customPurpose.HighAvailability.DeepCopy()
+	}
+
+	return nil
+}
+
+// Validate verifies that the shoot's purpose is either a standard purpose or a declared custom purpose, and that the
+// shoot's maintenance time window is allowed by the custom purpose's policy bundle.
+func (p *PurposePolicy) Validate(_ context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	switch {
+	case a.GetKind().GroupKind() != core.Kind("Shoot"),
+		a.GetSubresource() != "":
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*core.Shoot)
+	if !ok {
+		return apierrors.NewInternalError(errors.New("could not convert resource into Shoot object"))
+	}
+
+	if shoot.Spec.Purpose == nil {
+		return nil
+	}
+	if _, ok := standardPurposes[*shoot.Spec.Purpose]; ok {
+		return nil
+	}
+
+	customPurpose, ok := p.customPurposes[string(*shoot.Spec.Purpose)]
+	if !ok {
+		return admission.NewForbidden(a, fmt.Errorf("purpose %q is neither a standard shoot purpose nor declared as a custom shoot purpose", *shoot.Spec.Purpose))
+	}
+
+	if len(customPurpose.AllowedMaintenanceWindows) > 0 && shoot.Spec.Maintenance != nil && shoot.Spec.Maintenance.TimeWindow != nil {
+		if !isMaintenanceWindowAllowed(*shoot.Spec.Maintenance.TimeWindow, customPurpose.AllowedMaintenanceWindows) {
+			return admission.NewForbidden(a, fmt.Errorf("maintenance time window %s-%s is not allowed for shoots with purpose %q", shoot.Spec.Maintenance.TimeWindow.Begin, shoot.Spec.Maintenance.TimeWindow.End, customPurpose.Name))
+		}
+	}
+
+	return nil
+}
+
+func (p *PurposePolicy) customPurposeForShoot(shoot *core.Shoot) (shootpurposepolicy.CustomShootPurpose, bool) {
+	if shoot.Spec.Purpose == nil {
+		return shootpurposepolicy.CustomShootPurpose{}, false
+	}
+	if _, ok := standardPurposes[*shoot.Spec.Purpose]; ok {
+		return shootpurposepolicy.CustomShootPurpose{}, false
+	}
+
+	customPurpose, ok := p.customPurposes[string(*shoot.Spec.Purpose)]
+	return customPurpose, ok
+}
+
+func isMaintenanceWindowAllowed(window core.MaintenanceTimeWindow, allowedWindows []core.MaintenanceTimeWindow) bool {
+	for _, allowedWindow := range allowedWindows {
+		if window.Begin == allowedWindow.Begin && window.End == allowedWindow.End {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package purposepolicy_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/purposepolicy"
+	"github.com/gardener/gardener/plugin/pkg/shoot/purposepolicy/apis/shootpurposepolicy"
+)
+
+var _ = Describe("PurposePolicy", func() {
+	var (
+		ctx = context.TODO()
+
+		plugin *PurposePolicy
+		shoot  *core.Shoot
+	)
+
+	BeforeEach(func() {
+		plugin = New(&shootpurposepolicy.Configuration{
+			CustomPurposes: []shootpurposepolicy.CustomShootPurpose{
+				{
+					Name:       "sandbox",
+					Monitoring: ptr.To(false),
+					HighAvailability: &core.HighAvailability{
+						FailureTolerance: core.FailureTolerance{Type: core.FailureToleranceTypeNode},
+					},
+					AllowedMaintenanceWindows: []core.MaintenanceTimeWindow{{Begin: "220000+0000", End: "230000+0000"}},
+				},
+			},
+		})
+
+		shoot = &core.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-shoot",
+				Namespace: "garden-project",
+			},
+			Spec: core.ShootSpec{
+				Purpose: ptr.To(core.ShootPurpose("sandbox")),
+			},
+		}
+	})
+
+	attrs := func(shoot *core.Shoot, operation admission.Operation) admission.Attributes {
+		return admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", operation, nil, false, &user.DefaultInfo{})
+	}
+
+	Describe("#Admit", func() {
+		It("should default the high availability configuration and set the monitoring annotation", func() {
+			Expect(plugin.Admit(ctx, attrs(shoot, admission.Create), nil)).To(Succeed())
+
+			Expect(shoot.Spec.ControlPlane.HighAvailability.FailureTolerance.Type).To(Equal(core.FailureToleranceTypeNode))
+			Expect(shoot.Annotations).To(HaveKeyWithValue(v1beta1constants.AnnotationShootDisableMonitoring, "true"))
+		})
+
+		It("should not overwrite an existing high availability configuration", func() {
+			shoot.Spec.ControlPlane = &core.ControlPlane{
+				HighAvailability: &core.HighAvailability{
+					FailureTolerance: core.FailureTolerance{Type: core.FailureToleranceTypeZone},
+				},
+			}
+
+			Expect(plugin.Admit(ctx, attrs(shoot, admission.Create), nil)).To(Succeed())
+
+			Expect(shoot.Spec.ControlPlane.HighAvailability.FailureTolerance.Type).To(Equal(core.FailureToleranceTypeZone))
+		})
+
+		It("should remove the monitoring annotation when the purpose no longer disables monitoring", func() {
+			shoot.Spec.Purpose = ptr.To(core.ShootPurposeProduction)
+			shoot.Annotations = map[string]string{v1beta1constants.AnnotationShootDisableMonitoring: "true"}
+
+			Expect(plugin.Admit(ctx, attrs(shoot, admission.Update), nil)).To(Succeed())
+
+			Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationShootDisableMonitoring))
+		})
+
+		It("should do nothing for standard purposes", func() {
+			shoot.Spec.Purpose = ptr.To(core.ShootPurposeProduction)
+
+			Expect(plugin.Admit(ctx, attrs(shoot, admission.Create), nil)).To(Succeed())
+
+			Expect(shoot.Spec.ControlPlane).To(BeNil())
+			Expect(shoot.Annotations).To(BeEmpty())
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should allow standard purposes", func() {
+			shoot.Spec.Purpose = ptr.To(core.ShootPurposeProduction)
+
+			Expect(plugin.Validate(ctx, attrs(shoot, admission.Create), nil)).To(Succeed())
+		})
+
+		It("should allow declared custom purposes", func() {
+			Expect(plugin.Validate(ctx, attrs(shoot, admission.Create), nil)).To(Succeed())
+		})
+
+		It("should forbid undeclared purposes", func() {
+			shoot.Spec.Purpose = ptr.To(core.ShootPurpose("unknown"))
+
+			err := plugin.Validate(ctx, attrs(shoot, admission.Create), nil)
+			Expect(err).To(MatchError(ContainSubstring("neither a standard shoot purpose nor declared as a custom shoot purpose")))
+		})
+
+		It("should allow an allowed maintenance time window", func() {
+			shoot.Spec.Maintenance = &core.Maintenance{
+				TimeWindow: &core.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"},
+			}
+
+			Expect(plugin.Validate(ctx, attrs(shoot, admission.Create), nil)).To(Succeed())
+		})
+
+		It("should forbid a maintenance time window which is not allowed for the purpose", func() {
+			shoot.Spec.Maintenance = &core.Maintenance{
+				TimeWindow: &core.MaintenanceTimeWindow{Begin: "010000+0000", End: "020000+0000"},
+			}
+
+			err := plugin.Validate(ctx, attrs(shoot, admission.Create), nil)
+			Expect(err).To(MatchError(ContainSubstring("is not allowed for shoots with purpose")))
+		})
+	})
+})
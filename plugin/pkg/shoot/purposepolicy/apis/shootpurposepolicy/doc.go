@@ -0,0 +1,8 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// +k8s:deepcopy-gen=package
+// +groupName=shootpurposepolicy.admission.gardener.cloud
+
+package shootpurposepolicy // import "github.com/gardener/gardener/plugin/pkg/shoot/purposepolicy/apis/shootpurposepolicy"
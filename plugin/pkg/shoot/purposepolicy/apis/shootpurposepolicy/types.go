@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootpurposepolicy
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Configuration provides configuration for the ShootPurposePolicy admission controller.
+type Configuration struct {
+	metav1.TypeMeta
+
+	// CustomPurposes is the list of custom shoot purposes declared for this landscape.
+	CustomPurposes []CustomShootPurpose
+}
+
+// CustomShootPurpose declares a custom shoot purpose together with its policy bundle.
+type CustomShootPurpose struct {
+	// Name is the name of the custom purpose.
+	Name string
+	// Monitoring specifies whether the monitoring stack is deployed for shoots with this purpose. Defaults to true.
+	Monitoring *bool
+	// HighAvailability is the default high availability configuration for shoots with this purpose. It is only applied
+	// if the shoot does not declare a high availability configuration itself.
+	HighAvailability *core.HighAvailability
+	// AllowedMaintenanceWindows restricts the maintenance time windows which may be configured for shoots with this
+	// purpose. If empty, all maintenance time windows are allowed.
+	AllowedMaintenanceWindows []core.MaintenanceTimeWindow
+}
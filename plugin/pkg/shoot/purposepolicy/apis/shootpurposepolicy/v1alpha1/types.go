@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Configuration provides configuration for the ShootPurposePolicy admission controller.
+type Configuration struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CustomPurposes is the list of custom shoot purposes declared for this landscape.
+	CustomPurposes []CustomShootPurpose `json:"customPurposes,omitempty"`
+}
+
+// CustomShootPurpose declares a custom shoot purpose together with its policy bundle.
+type CustomShootPurpose struct {
+	// Name is the name of the custom purpose.
+	Name string `json:"name"`
+	// Monitoring specifies whether the monitoring stack is deployed for shoots with this purpose. Defaults to true.
+	// +optional
+	Monitoring *bool `json:"monitoring,omitempty"`
+	// HighAvailability is the default high availability configuration for shoots with this purpose. It is only applied
+	// if the shoot does not declare a high availability configuration itself.
+	// +optional
+	HighAvailability *gardencorev1beta1.HighAvailability `json:"highAvailability,omitempty"`
+	// AllowedMaintenanceWindows restricts the maintenance time windows which may be configured for shoots with this
+	// purpose. If empty, all maintenance time windows are allowed.
+	// +optional
+	AllowedMaintenanceWindows []gardencorev1beta1.MaintenanceTimeWindow `json:"allowedMaintenanceWindows,omitempty"`
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	corevalidation "github.com/gardener/gardener/pkg/apis/core/validation"
+	"github.com/gardener/gardener/pkg/utils/timewindow"
+	"github.com/gardener/gardener/plugin/pkg/shoot/purposepolicy/apis/shootpurposepolicy"
+)
+
+var standardPurposes = sets.New(
+	string(core.ShootPurposeEvaluation),
+	string(core.ShootPurposeTesting),
+	string(core.ShootPurposeDevelopment),
+	string(core.ShootPurposeProduction),
+	string(core.ShootPurposeInfrastructure),
+)
+
+// ValidateConfiguration validates the configuration.
+func ValidateConfiguration(config *shootpurposepolicy.Configuration) field.ErrorList {
+	var (
+		allErrs field.ErrorList
+		names   = sets.New[string]()
+	)
+
+	for i, purpose := range config.CustomPurposes {
+		idxPath := field.NewPath("customPurposes").Index(i)
+
+		if len(purpose.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "custom purpose name is required"))
+		}
+		for _, msg := range validation.IsDNS1123Label(purpose.Name) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), purpose.Name, msg))
+		}
+		if standardPurposes.Has(purpose.Name) {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("name"), "custom purpose name must not be a standard purpose"))
+		}
+		if names.Has(purpose.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), purpose.Name))
+		}
+		names.Insert(purpose.Name)
+
+		if purpose.HighAvailability != nil {
+			allErrs = append(allErrs, corevalidation.ValidateFailureToleranceTypeValue(purpose.HighAvailability.FailureTolerance.Type, idxPath.Child("highAvailability", "failureTolerance", "type"))...)
+		}
+
+		for j, window := range purpose.AllowedMaintenanceWindows {
+			if _, err := timewindow.ParseMaintenanceTimeWindow(window.Begin, window.End); err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("allowedMaintenanceWindows").Index(j), window, err.Error()))
+			}
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/plugin/pkg/shoot/purposepolicy/apis/shootpurposepolicy"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/purposepolicy/apis/shootpurposepolicy/validation"
+)
+
+var _ = Describe("Validation", func() {
+	var config *shootpurposepolicy.Configuration
+
+	BeforeEach(func() {
+		config = &shootpurposepolicy.Configuration{
+			CustomPurposes: []shootpurposepolicy.CustomShootPurpose{{
+				Name: "sandbox",
+				HighAvailability: &core.HighAvailability{
+					FailureTolerance: core.FailureTolerance{Type: core.FailureToleranceTypeNode},
+				},
+				AllowedMaintenanceWindows: []core.MaintenanceTimeWindow{{Begin: "220000+0000", End: "230000+0000"}},
+			}},
+		}
+	})
+
+	It("should allow a valid configuration", func() {
+		Expect(ValidateConfiguration(config)).To(BeEmpty())
+	})
+
+	It("should forbid custom purposes with a standard purpose name", func() {
+		config.CustomPurposes[0].Name = "production"
+
+		Expect(ValidateConfiguration(config)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeForbidden),
+			"Field": Equal("customPurposes[0].name"),
+		}))))
+	})
+
+	It("should forbid duplicate custom purpose names", func() {
+		config.CustomPurposes = append(config.CustomPurposes, shootpurposepolicy.CustomShootPurpose{Name: "sandbox"})
+
+		Expect(ValidateConfiguration(config)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeDuplicate),
+			"Field": Equal("customPurposes[1].name"),
+		}))))
+	})
+
+	It("should forbid invalid failure tolerance types", func() {
+		config.CustomPurposes[0].HighAvailability.FailureTolerance.Type = "region"
+
+		Expect(ValidateConfiguration(config)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeNotSupported),
+			"Field": Equal("customPurposes[0].highAvailability.failureTolerance.type"),
+		}))))
+	})
+
+	It("should forbid invalid maintenance time windows", func() {
+		config.CustomPurposes[0].AllowedMaintenanceWindows = []core.MaintenanceTimeWindow{{Begin: "foo", End: "bar"}}
+
+		Expect(ValidateConfiguration(config)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("customPurposes[0].allowedMaintenanceWindows[0]"),
+		}))))
+	})
+})
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -35,10 +36,11 @@ import (
 )
 
 var (
-	quotaMetricNames = [6]corev1.ResourceName{
+	quotaMetricNames = [7]corev1.ResourceName{
 		core.QuotaMetricCPU,
 		core.QuotaMetricGPU,
 		core.QuotaMetricMemory,
+		core.QuotaMetricNodes,
 		core.QuotaMetricStorageStandard,
 		core.QuotaMetricStoragePremium,
 		core.QuotaMetricLoadbalancer}
@@ -233,12 +235,12 @@ func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ a
 			if err != nil {
 				return apierrors.NewInternalError(err)
 			}
-			if exceededMetrics != nil {
-				message := ""
-				for _, metric := range *exceededMetrics {
-					message = message + metric.String() + " "
+			if len(exceededMetrics) > 0 {
+				messages := make([]string, 0, len(exceededMetrics))
+				for _, metric := range exceededMetrics {
+					messages = append(messages, metric.String())
 				}
-				return admission.NewForbidden(a, fmt.Errorf("quota limits exceeded. Unable to allocate further %s", message))
+				return admission.NewForbidden(a, fmt.Errorf("quota limits of %q exceeded. Unable to allocate further %s", quota.Name, strings.Join(messages, ", ")))
 			}
 		}
 	}
@@ -264,7 +266,19 @@ func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ a
 	return nil
 }
 
-func (q *QuotaValidator) isQuotaExceeded(shoot core.Shoot, quota gardencorev1beta1.Quota) (*[]corev1.ResourceName, error) {
+// exceededMetric describes a quota metric whose limit would be exceeded by the requested shoot resources.
+type exceededMetric struct {
+	name     corev1.ResourceName
+	limit    resource.Quantity
+	required resource.Quantity
+}
+
+// String returns a human-readable representation of the exceeded metric for usage in rejection messages.
+func (e exceededMetric) String() string {
+	return fmt.Sprintf("%s (limit %s, required %s)", e.name, e.limit.String(), e.required.String())
+}
+
+func (q *QuotaValidator) isQuotaExceeded(shoot core.Shoot, quota gardencorev1beta1.Quota) ([]exceededMetric, error) {
 	allocatedResources, err := q.determineAllocatedResources(quota, shoot)
 	if err != nil {
 		return nil, err
@@ -274,19 +288,20 @@ func (q *QuotaValidator) isQuotaExceeded(shoot core.Shoot, quota gardencorev1bet
 		return nil, err
 	}
 
-	exceededMetrics := make([]corev1.ResourceName, 0)
+	exceededMetrics := make([]exceededMetric, 0)
 	for _, metric := range quotaMetricNames {
 		if _, ok := quota.Spec.Metrics[metric]; !ok {
 			continue
 		}
 		if !hasSufficientQuota(quota.Spec.Metrics[metric], requiredResources[metric]) {
-			exceededMetrics = append(exceededMetrics, metric)
+			exceededMetrics = append(exceededMetrics, exceededMetric{
+				name:     metric,
+				limit:    quota.Spec.Metrics[metric],
+				required: requiredResources[metric],
+			})
 		}
 	}
-	if len(exceededMetrics) != 0 {
-		return &exceededMetrics, nil
-	}
-	return nil, nil
+	return exceededMetrics, nil
 }
 
 func (q *QuotaValidator) determineAllocatedResources(quota gardencorev1beta1.Quota, shoot core.Shoot) (corev1.ResourceList, error) {
@@ -464,6 +479,7 @@ func (q *QuotaValidator) getShootResources(shoot core.Shoot) (corev1.ResourceLis
 		resources[core.QuotaMetricCPU] = sumQuantity(resources[core.QuotaMetricCPU], multiplyQuantity(machineType.CPU, worker.Maximum))
 		resources[core.QuotaMetricGPU] = sumQuantity(resources[core.QuotaMetricGPU], multiplyQuantity(machineType.GPU, worker.Maximum))
 		resources[core.QuotaMetricMemory] = sumQuantity(resources[core.QuotaMetricMemory], multiplyQuantity(machineType.Memory, worker.Maximum))
+		resources[core.QuotaMetricNodes] = sumQuantity(resources[core.QuotaMetricNodes], *resource.NewQuantity(int64(worker.Maximum), resource.DecimalSI))
 
 		size, _ := resource.ParseQuantity("0Gi")
 		if worker.Volume != nil {
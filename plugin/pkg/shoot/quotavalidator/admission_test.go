@@ -304,6 +304,45 @@ var _ = Describe("quotavalidator", func() {
 				Expect(err).To(HaveOccurred())
 			})
 
+			It("should fail with a message listing the exceeded metrics with their limits and required amounts", func() {
+				shoot.Spec.Provider.Workers[0].Maximum = 2
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				err := admissionHandler.Validate(context.TODO(), attrs, nil)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(`quota limits of "project-quota" exceeded`))
+				Expect(err.Error()).To(ContainSubstring("cpu (limit 2, required 4)"))
+				Expect(err.Error()).To(ContainSubstring("memory (limit 5Gi, required 10Gi)"))
+				Expect(err.Error()).To(ContainSubstring("storage.standard (limit 30Gi, required 60Gi)"))
+			})
+
+			It("should fail because the node count limit is exceeded", func() {
+				quotaProject.Spec.Metrics[core.QuotaMetricNodes] = resource.MustParse("1")
+				quotaProject.Spec.Metrics[core.QuotaMetricCPU] = resource.MustParse("4")
+				quotaProject.Spec.Metrics[core.QuotaMetricMemory] = resource.MustParse("10Gi")
+				quotaProject.Spec.Metrics[core.QuotaMetricStorageStandard] = resource.MustParse("60Gi")
+				Expect(coreInformerFactory.Core().V1beta1().Quotas().Informer().GetStore().Add(&quotaProject)).To(Succeed())
+
+				shoot.Spec.Provider.Workers[0].Maximum = 2
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				err := admissionHandler.Validate(context.TODO(), attrs, nil)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("nodes (limit 1, required 2)"))
+			})
+
+			It("should pass because the node count limit is sufficient", func() {
+				quotaProject.Spec.Metrics[core.QuotaMetricNodes] = resource.MustParse("1")
+				quotaSecret.Spec.Metrics[core.QuotaMetricNodes] = resource.MustParse("1")
+				Expect(coreInformerFactory.Core().V1beta1().Quotas().Informer().GetStore().Add(&quotaProject)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().Quotas().Informer().GetStore().Add(&quotaSecret)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				err := admissionHandler.Validate(context.TODO(), attrs, nil)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
 			It("should fail because other shoots exhaust quota limits", func() {
 				shoot2 := *versionedShootBase.DeepCopy()
 				shoot2.Name = "test-shoot-2"
@@ -376,7 +415,8 @@ var _ = Describe("quotavalidator", func() {
 
 					err := admissionHandler.Validate(context.TODO(), attrs, nil)
 					Expect(err).To(HaveOccurred())
-					Expect(err.Error()).To(ContainSubstring("quota limits exceeded"))
+					Expect(err.Error()).To(ContainSubstring(`quota limits of "project-quota" exceeded`))
+					Expect(err.Error()).To(ContainSubstring("storage.standard (limit 20Gi, required 21Gi)"))
 				})
 			})
 		})
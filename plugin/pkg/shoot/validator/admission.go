@@ -311,6 +311,9 @@ func (v *ValidateShoot) Validate(ctx context.Context, a admission.Attributes, _
 	if err := validationContext.validateDeletion(a); err != nil {
 		return err
 	}
+	if err := validationContext.validateMetadataDuringMigration(a); err != nil {
+		return err
+	}
 	if err := validationContext.validateShootHibernation(a); err != nil {
 		return err
 	}
@@ -617,6 +620,24 @@ func (c *validationContext) validateDeletion(a admission.Attributes) error {
 	return nil
 }
 
+func (c *validationContext) validateMetadataDuringMigration(a admission.Attributes) error {
+	if a.GetOperation() != admission.Update {
+		return nil
+	}
+
+	if !isShootInMigrationOrRestorePhase(c.shoot) || apiequality.Semantic.DeepEqual(c.shoot.ObjectMeta, c.oldShoot.ObjectMeta) {
+		return nil
+	}
+
+	// The gardenlets of the source and destination seed must still be able to update the shoot while the control plane
+	// migration is in progress, e.g. for maintaining finalizers, labels, and the operation annotation.
+	if sets.New(a.GetUserInfo().GetGroups()...).Has(v1beta1constants.SeedsGroup) {
+		return nil
+	}
+
+	return admission.NewForbidden(a, fmt.Errorf("cannot change shoot metadata during %s operation that is in state %s", c.shoot.Status.LastOperation.Type, c.shoot.Status.LastOperation.State))
+}
+
 func (c *validationContext) validateShootHibernation(a admission.Attributes) error {
 	// Prevent Shoots from getting hibernated in case they have problematic webhooks.
 	// Otherwise, we can never wake up this shoot cluster again.
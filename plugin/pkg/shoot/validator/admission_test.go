@@ -5647,6 +5647,29 @@ var _ = Describe("validator", func() {
 				Entry("should allow if the shoot has lastOperation=Restore:Succeeded", &seedName, core.LastOperationTypeRestore, core.LastOperationStateSucceeded, Not(HaveOccurred())),
 			)
 
+			DescribeTable("Changing shoot metadata during migration",
+				func(requestUserInfo user.Info, lastOperationType core.LastOperationType, lastOperationState core.LastOperationState, matcher types.GomegaMatcher) {
+					shoot.Status.LastOperation = &core.LastOperation{
+						Type:  lastOperationType,
+						State: lastOperationState,
+					}
+
+					oldShoot.Spec.SeedName = &seedName
+					shoot.Status.SeedName = &oldSeedName
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "reconcile")
+
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, requestUserInfo)
+					err := admissionHandler.Validate(ctx, attrs, nil)
+
+					Expect(err).To(matcher)
+				},
+				Entry("should reject if the shoot has lastOperation=Migrate:Processing", userInfo, core.LastOperationTypeMigrate, core.LastOperationStateProcessing, BeForbiddenError()),
+				Entry("should reject if the shoot has lastOperation=Restore:Processing", userInfo, core.LastOperationTypeRestore, core.LastOperationStateProcessing, BeForbiddenError()),
+				Entry("should allow if the shoot has lastOperation=Restore:Succeeded", userInfo, core.LastOperationTypeRestore, core.LastOperationStateSucceeded, Not(HaveOccurred())),
+				Entry("should allow for gardenlet users if the shoot has lastOperation=Migrate:Processing", &user.DefaultInfo{Name: "foo", Groups: []string{v1beta1constants.SeedsGroup}}, core.LastOperationTypeMigrate, core.LastOperationStateProcessing, Not(HaveOccurred())),
+				Entry("should allow for gardenlet users if the shoot has lastOperation=Restore:Processing", &user.DefaultInfo{Name: "foo", Groups: []string{v1beta1constants.SeedsGroup}}, core.LastOperationTypeRestore, core.LastOperationStateProcessing, Not(HaveOccurred())),
+			)
+
 			It("should allow changes to shoot spec if nothing else has changed", func() {
 				oldShoot.Spec.SeedName = &seedName
 				shoot.Spec.Kubernetes.Version = "1.6.4"
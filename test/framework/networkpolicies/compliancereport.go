@@ -0,0 +1,215 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package networkpolicies
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+)
+
+// ComplianceStatus describes whether the actual traffic rules of a network policy match the expected ones.
+type ComplianceStatus string
+
+const (
+	// StatusCompliant means that the actual network policy spec matches the expected one.
+	StatusCompliant ComplianceStatus = "Compliant"
+	// StatusNonCompliant means that the actual network policy spec deviates from the expected one.
+	StatusNonCompliant ComplianceStatus = "NonCompliant"
+	// StatusMissing means that an expected network policy does not exist.
+	StatusMissing ComplianceStatus = "Missing"
+	// StatusUnexpected means that a network policy exists although it is not expected.
+	StatusUnexpected ComplianceStatus = "Unexpected"
+)
+
+// ComplianceReport is a summary of the actual vs. expected traffic rules per namespace. It can be exported to JSON and
+// HTML for security audits.
+type ComplianceReport struct {
+	// Description describes the environment the report was generated for, e.g. the seed name.
+	Description string `json:"description,omitempty"`
+	// CreationTime is the time the report was generated.
+	CreationTime time.Time `json:"creationTime"`
+	// Namespaces contains one entry per inspected namespace.
+	Namespaces []NamespaceReport `json:"namespaces"`
+}
+
+// NamespaceReport contains the compliance results of all network policies of a single namespace.
+type NamespaceReport struct {
+	// Name is the name of the namespace.
+	Name string `json:"name"`
+	// Policies contains one entry per inspected network policy.
+	Policies []PolicyReport `json:"policies"`
+}
+
+// PolicyReport contains the compliance result of a single network policy.
+type PolicyReport struct {
+	// Name is the name of the network policy.
+	Name string `json:"name"`
+	// Status is the compliance status of the network policy.
+	Status ComplianceStatus `json:"status"`
+	// Expected is the expected network policy spec, if any.
+	Expected *networkingv1.NetworkPolicySpec `json:"expected,omitempty"`
+	// Actual is the actual network policy spec, if any.
+	Actual *networkingv1.NetworkPolicySpec `json:"actual,omitempty"`
+}
+
+// ComplianceReporter collects actual vs. expected network policy specs and exports them as a compliance report.
+type ComplianceReporter struct {
+	description string
+	namespaces  map[string]map[string]PolicyReport
+}
+
+// NewComplianceReporter creates a new ComplianceReporter. The description should identify the inspected environment, e.g. the seed name.
+func NewComplianceReporter(description string) *ComplianceReporter {
+	return &ComplianceReporter{
+		description: description,
+		namespaces:  map[string]map[string]PolicyReport{},
+	}
+}
+
+// RecordPolicy records the actual and expected spec of the network policy with the given namespace and name. A nil
+// expected spec marks the policy as unexpected, a nil actual spec marks it as missing.
+func (r *ComplianceReporter) RecordPolicy(namespace, name string, expected, actual *networkingv1.NetworkPolicySpec) {
+	status := StatusCompliant
+
+	switch {
+	case expected == nil:
+		status = StatusUnexpected
+	case actual == nil:
+		status = StatusMissing
+	case !apiequality.Semantic.DeepEqual(*expected, *actual):
+		status = StatusNonCompliant
+	}
+
+	if r.namespaces[namespace] == nil {
+		r.namespaces[namespace] = map[string]PolicyReport{}
+	}
+
+	r.namespaces[namespace][name] = PolicyReport{
+		Name:     name,
+		Status:   status,
+		Expected: expected,
+		Actual:   actual,
+	}
+}
+
+// Report returns the compliance report for all recorded network policies with namespaces and policies sorted by name.
+func (r *ComplianceReporter) Report() *ComplianceReport {
+	report := &ComplianceReport{
+		Description:  r.description,
+		CreationTime: time.Now().UTC(),
+		Namespaces:   []NamespaceReport{},
+	}
+
+	for _, namespace := range sortedKeys(r.namespaces) {
+		namespaceReport := NamespaceReport{Name: namespace}
+		for _, name := range sortedKeys(r.namespaces[namespace]) {
+			namespaceReport.Policies = append(namespaceReport.Policies, r.namespaces[namespace][name])
+		}
+		report.Namespaces = append(report.Namespaces, namespaceReport)
+	}
+
+	return report
+}
+
+// WriteJSON writes the compliance report in JSON format to the given file. Parent directories are created if needed.
+func (r *ComplianceReporter) WriteJSON(filename string) error {
+	file, err := createReportFile(filename)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(r.Report()); err != nil {
+		return fmt.Errorf("failed to encode compliance report: %w", err)
+	}
+
+	return file.Close()
+}
+
+// WriteHTML writes the compliance report in HTML format to the given file. Parent directories are created if needed.
+func (r *ComplianceReporter) WriteHTML(filename string) error {
+	file, err := createReportFile(filename)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+
+	if err := reportTemplate.Execute(file, r.Report()); err != nil {
+		return fmt.Errorf("failed to render compliance report: %w", err)
+	}
+
+	return file.Close()
+}
+
+func createReportFile(filename string) (*os.File, error) {
+	if err := os.MkdirAll(filepath.Dir(filename), 0750); err != nil {
+		return nil, fmt.Errorf("failed to create report directory: %w", err)
+	}
+
+	file, err := os.Create(filename) // #nosec G304 -- ReportFile is a test file.
+	if err != nil {
+		return nil, fmt.Errorf("failed to create report file: %w", err)
+	}
+
+	return file, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+var reportTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"toJSON": func(v any) (string, error) {
+		out, err := json.MarshalIndent(v, "", "  ")
+		return string(out), err
+	},
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<title>Network Policy Compliance Report {{ .Description }}</title>
+<style>
+body { font-family: sans-serif; }
+table { border-collapse: collapse; margin-bottom: 2em; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; vertical-align: top; }
+pre { margin: 0; font-size: smaller; }
+.Compliant { background-color: #dfd; }
+.NonCompliant, .Missing, .Unexpected { background-color: #fdd; }
+</style>
+</head>
+<body>
+<h1>Network Policy Compliance Report {{ .Description }}</h1>
+<p>Generated at {{ .CreationTime }}</p>
+{{ range .Namespaces }}
+<h2>Namespace {{ .Name }}</h2>
+<table>
+<tr><th>Policy</th><th>Status</th><th>Expected</th><th>Actual</th></tr>
+{{ range .Policies }}
+<tr class="{{ .Status }}">
+<td>{{ .Name }}</td>
+<td>{{ .Status }}</td>
+<td>{{ if .Expected }}<pre>{{ toJSON .Expected }}</pre>{{ end }}</td>
+<td>{{ if .Actual }}<pre>{{ toJSON .Actual }}</pre>{{ end }}</td>
+</tr>
+{{ end }}
+</table>
+{{ end }}
+</body>
+</html>
+`))
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package networkpolicies
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestComplianceReport(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Network Policy Compliance Report Test Suite")
+}
+
+var _ = Describe("ComplianceReport", func() {
+	var (
+		reporter *ComplianceReporter
+
+		denyAllSpec = networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{},
+			PolicyTypes: []networkingv1.PolicyType{"Ingress", "Egress"},
+		}
+		allowDNSSpec = networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"networking.gardener.cloud/to-dns": "allowed"}},
+			PolicyTypes: []networkingv1.PolicyType{"Egress"},
+		}
+	)
+
+	BeforeEach(func() {
+		reporter = NewComplianceReporter("seed-test")
+	})
+
+	Describe("#Report", func() {
+		It("should report matching specs as compliant", func() {
+			reporter.RecordPolicy("shoot--foo--bar", "deny-all", &denyAllSpec, denyAllSpec.DeepCopy())
+
+			report := reporter.Report()
+			Expect(report.Description).To(Equal("seed-test"))
+			Expect(report.Namespaces).To(HaveLen(1))
+			Expect(report.Namespaces[0].Name).To(Equal("shoot--foo--bar"))
+			Expect(report.Namespaces[0].Policies).To(HaveLen(1))
+			Expect(report.Namespaces[0].Policies[0].Name).To(Equal("deny-all"))
+			Expect(report.Namespaces[0].Policies[0].Status).To(Equal(StatusCompliant))
+		})
+
+		It("should report deviating specs as non-compliant", func() {
+			reporter.RecordPolicy("shoot--foo--bar", "allow-to-dns", &allowDNSSpec, &denyAllSpec)
+
+			report := reporter.Report()
+			Expect(report.Namespaces[0].Policies[0].Status).To(Equal(StatusNonCompliant))
+		})
+
+		It("should report policies without actual spec as missing", func() {
+			reporter.RecordPolicy("shoot--foo--bar", "allow-to-dns", &allowDNSSpec, nil)
+
+			report := reporter.Report()
+			Expect(report.Namespaces[0].Policies[0].Status).To(Equal(StatusMissing))
+		})
+
+		It("should report policies without expected spec as unexpected", func() {
+			reporter.RecordPolicy("shoot--foo--bar", "allow-everything", nil, &denyAllSpec)
+
+			report := reporter.Report()
+			Expect(report.Namespaces[0].Policies[0].Status).To(Equal(StatusUnexpected))
+		})
+
+		It("should sort namespaces and policies by name", func() {
+			reporter.RecordPolicy("shoot--foo--b", "deny-all", &denyAllSpec, &denyAllSpec)
+			reporter.RecordPolicy("shoot--foo--a", "deny-all", &denyAllSpec, &denyAllSpec)
+			reporter.RecordPolicy("shoot--foo--a", "allow-to-dns", &allowDNSSpec, &allowDNSSpec)
+
+			report := reporter.Report()
+			Expect(report.Namespaces).To(HaveLen(2))
+			Expect(report.Namespaces[0].Name).To(Equal("shoot--foo--a"))
+			Expect(report.Namespaces[0].Policies[0].Name).To(Equal("allow-to-dns"))
+			Expect(report.Namespaces[0].Policies[1].Name).To(Equal("deny-all"))
+			Expect(report.Namespaces[1].Name).To(Equal("shoot--foo--b"))
+		})
+	})
+
+	Describe("#WriteJSON", func() {
+		It("should write a parseable JSON report", func() {
+			filename := filepath.Join(GinkgoT().TempDir(), "report", "compliance.json")
+			reporter.RecordPolicy("shoot--foo--bar", "deny-all", &denyAllSpec, &denyAllSpec)
+
+			Expect(reporter.WriteJSON(filename)).To(Succeed())
+
+			content, err := os.ReadFile(filename) // #nosec G304 -- Test file.
+			Expect(err).NotTo(HaveOccurred())
+
+			report := &ComplianceReport{}
+			Expect(json.Unmarshal(content, report)).To(Succeed())
+			Expect(report.Namespaces).To(HaveLen(1))
+			Expect(report.Namespaces[0].Policies[0].Status).To(Equal(StatusCompliant))
+		})
+	})
+
+	Describe("#WriteHTML", func() {
+		It("should write an HTML report containing the compliance results", func() {
+			filename := filepath.Join(GinkgoT().TempDir(), "report", "compliance.html")
+			reporter.RecordPolicy("shoot--foo--bar", "allow-to-dns", &allowDNSSpec, nil)
+
+			Expect(reporter.WriteHTML(filename)).To(Succeed())
+
+			content, err := os.ReadFile(filename) // #nosec G304 -- Test file.
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(And(
+				ContainSubstring("shoot--foo--bar"),
+				ContainSubstring("allow-to-dns"),
+				ContainSubstring("Missing"),
+			))
+		})
+	})
+})
@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dnscache_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/test/integration/component/harness"
+)
+
+func TestDNSCache(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test Integration Component DNSCache Suite")
+}
+
+const testID = "component-dnscache-test"
+
+var (
+	ctx = context.Background()
+	log logr.Logger
+
+	testHarness *harness.Harness
+)
+
+var _ = BeforeSuite(func() {
+	logf.SetLogger(logger.MustNewZapLogger(logger.DebugLevel, logger.FormatJSON, zap.WriteTo(GinkgoWriter)))
+	log = logf.Log.WithName(testID)
+
+	By("Start test environment")
+	testHarness = harness.New()
+	Expect(testHarness.Start()).To(Succeed())
+
+	DeferCleanup(func() {
+		By("Stop test environment")
+		Expect(testHarness.Stop()).To(Succeed())
+	})
+})
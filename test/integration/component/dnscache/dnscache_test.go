@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dnscache_test
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/component/networking/dnscache"
+	"github.com/gardener/gardener/test/integration/component/harness"
+)
+
+var _ = Describe("DNSCache", func() {
+	var namespace *corev1.Namespace
+
+	BeforeEach(func() {
+		var err error
+		namespace, err = testHarness.CreateNamespace(ctx, "garden")
+		Expect(err).NotTo(HaveOccurred())
+		log.Info("Created namespace for test", "namespace", namespace.Name)
+
+		DeferCleanup(func() {
+			By("Delete test namespace")
+			Expect(testHarness.Client.Delete(ctx, namespace)).To(Succeed())
+		})
+	})
+
+	It("should render the expected manifests", func() {
+		deployer := dnscache.New(testHarness.Client, namespace.Name, dnscache.Values{Image: "registry.example.com/coredns:v1.0.0"})
+
+		manifests, err := testHarness.RenderedManifests(ctx, deployer, namespace.Name, dnscache.ManagedResourceName)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(harness.VerifySnapshot(filepath.Join("testdata", "dns-cache.yaml"), manifests)).To(Succeed())
+	})
+
+	It("should label the ManagedResource as seed system component", func() {
+		deployer := dnscache.New(testHarness.Client, namespace.Name, dnscache.Values{Image: "registry.example.com/coredns:v1.0.0"})
+
+		_, err := testHarness.RenderedManifests(ctx, deployer, namespace.Name, dnscache.ManagedResourceName)
+		Expect(err).NotTo(HaveOccurred())
+
+		managedResource := &resourcesv1alpha1.ManagedResource{}
+		Expect(testHarness.Client.Get(ctx, client.ObjectKey{Namespace: namespace.Name, Name: dnscache.ManagedResourceName}, managedResource)).To(Succeed())
+		Expect(managedResource.Labels).To(HaveKeyWithValue("gardener.cloud/role", "seed-system-component"))
+	})
+})
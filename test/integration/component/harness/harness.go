@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package harness provides an envtest-based environment for running individual components (see pkg/component)
+// against a real API server without a full e2e cluster. It installs the ManagedResource CRD so that components
+// deploying via ManagedResources can be executed, and offers snapshot assertions of the objects they render.
+package harness
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"os"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+//go:embed testdata/crd-managedresources.yaml
+var managedResourcesCRD []byte
+
+// Harness runs individual components against an envtest-backed seed cluster.
+type Harness struct {
+	// RESTConfig is the rest config for the started test environment.
+	RESTConfig *rest.Config
+	// Client is a client for the test environment using the seed scheme.
+	Client client.Client
+
+	testEnv *envtest.Environment
+}
+
+// New creates a new Harness.
+func New() *Harness {
+	return &Harness{}
+}
+
+// Start launches the test environment, installs the ManagedResource CRD, and creates a client for the cluster.
+func (h *Harness) Start() error {
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	if err := yaml.Unmarshal(managedResourcesCRD, crd); err != nil {
+		return fmt.Errorf("failed unmarshalling ManagedResource CRD: %w", err)
+	}
+
+	h.testEnv = &envtest.Environment{
+		CRDInstallOptions: envtest.CRDInstallOptions{
+			CRDs: []*apiextensionsv1.CustomResourceDefinition{crd},
+		},
+	}
+
+	restConfig, err := h.testEnv.Start()
+	if err != nil {
+		return err
+	}
+	h.RESTConfig = restConfig
+
+	c, err := client.New(restConfig, client.Options{Scheme: kubernetes.SeedScheme})
+	if err != nil {
+		return err
+	}
+	h.Client = c
+
+	return nil
+}
+
+// Stop tears down the test environment.
+func (h *Harness) Stop() error {
+	if h.testEnv == nil {
+		return nil
+	}
+	return h.testEnv.Stop()
+}
+
+// CreateNamespace creates a namespace with the given name. Use fixed names (instead of generated ones) so that
+// namespaces occurring in rendered manifests do not invalidate snapshots.
+func (h *Harness) CreateNamespace(ctx context.Context, name string) (*corev1.Namespace, error) {
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if err := h.Client.Create(ctx, namespace); err != nil {
+		return nil, err
+	}
+	return namespace, nil
+}
+
+// RenderedManifests deploys the given component and extracts the manifests it rendered into the ManagedResource
+// with the given name. The manifests are returned in lexicographical order.
+func (h *Harness) RenderedManifests(ctx context.Context, deployer component.Deployer, namespace, managedResourceName string) ([]string, error) {
+	return test.RenderComponent(ctx, h.Client, deployer, namespace, managedResourceName)
+}
+
+// VerifySnapshot compares the given rendered manifests with the snapshot file at the given path. If the
+// UPDATE_SNAPSHOTS environment variable is set, the snapshot file is written instead of compared so that intended
+// changes to rendered manifests become reviewable in the diff.
+func VerifySnapshot(path string, manifests []string) error {
+	actual := SnapshotString(manifests)
+
+	if os.Getenv("UPDATE_SNAPSHOTS") != "" {
+		return os.WriteFile(path, []byte(actual), 0o600)
+	}
+
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed reading snapshot file (set UPDATE_SNAPSHOTS to create it): %w", err)
+	}
+
+	if actual != string(expected) {
+		return fmt.Errorf("rendered manifests do not match snapshot %q (set UPDATE_SNAPSHOTS to update it)\n\n--- want\n%s\n--- got\n%s", path, string(expected), actual)
+	}
+
+	return nil
+}
+
+// SnapshotString normalizes the given manifests into the canonical snapshot representation.
+func SnapshotString(manifests []string) string {
+	return test.CombineManifests(manifests)
+}
@@ -233,6 +233,39 @@ var _ = Describe("Gardenlet Lifecycle controller tests", func() {
 			})
 		})
 
+		Context("availability history", func() {
+			BeforeEach(func() {
+				By("Update RenewTime of Lease")
+				patch := client.MergeFrom(lease.DeepCopy())
+				lease.Spec.RenewTime = microNow(fakeClock.Now().Add(-2 * seedMonitorPeriod))
+				Expect(testClient.Patch(ctx, lease, patch)).To(Succeed())
+			})
+
+			It("should record a downtime window and close it once the Lease is renewed again", func() {
+				By("Wait for downtime window to be opened")
+				Eventually(func(g Gomega) {
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(seed), seed)).To(Succeed())
+					g.Expect(seed.Status.Availability).NotTo(BeNil())
+					g.Expect(seed.Status.Availability.DowntimeWindows).To(Equal(int32(1)))
+					g.Expect(seed.Status.Availability.LastDowntimeBegin).NotTo(BeNil())
+					g.Expect(seed.Status.Availability.LastDowntimeEnd).To(BeNil())
+				}).Should(Succeed())
+
+				By("Renew Lease")
+				patch := client.MergeFrom(lease.DeepCopy())
+				lease.Spec.RenewTime = microNow(fakeClock.Now())
+				Expect(testClient.Patch(ctx, lease, patch)).To(Succeed())
+
+				By("Wait for downtime window to be closed")
+				Eventually(func(g Gomega) {
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(seed), seed)).To(Succeed())
+					g.Expect(seed.Status.Availability).NotTo(BeNil())
+					g.Expect(seed.Status.Availability.DowntimeWindows).To(Equal(int32(1)))
+					g.Expect(seed.Status.Availability.LastDowntimeEnd).NotTo(BeNil())
+				}).Should(Succeed())
+			})
+		})
+
 		Context("rebootstrapping of ManagedSeed", func() {
 			JustBeforeEach(func() {
 				By("Create ManagedSeed")
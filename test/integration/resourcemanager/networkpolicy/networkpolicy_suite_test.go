@@ -105,6 +105,10 @@ var _ = BeforeSuite(func() {
 				Namespace:   ingressControllerNamespace,
 				PodSelector: ingressControllerPodSelector,
 			},
+			CIDRGroups: []resourcemanagerconfigv1alpha1.CIDRGroup{{
+				Name:  "corp-proxies",
+				CIDRs: []string{"10.250.0.0/16", "2001:db8::/64"},
+			}},
 		},
 	}).AddToManager(mgr, mgr)).To(Succeed())
 
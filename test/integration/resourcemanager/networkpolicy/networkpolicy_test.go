@@ -1020,6 +1020,52 @@ var _ = Describe("NetworkPolicy Controller tests", func() {
 		})
 	})
 
+	Context("service with ingress from CIDR group", func() {
+		BeforeEach(func() {
+			metav1.SetMetaDataAnnotation(&service.ObjectMeta, "networking.resources.gardener.cloud/from-cidr-group-corp-proxies-allowed-ports", `[{"port":`+port1TargetPort.String()+`,"protocol":"`+string(port1Protocol)+`"}]`)
+		})
+
+		It("should create the expected ingress-from-cidr-group network policy", func() {
+			ensureNetworkPoliciesGetCreated()
+
+			By("Wait until ingress from CIDR group policy was created")
+			Eventually(func(g Gomega) networkingv1.NetworkPolicySpec {
+				networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "ingress-to-" + service.Name + "-from-cidr-group-corp-proxies", Namespace: service.Namespace}}
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)).To(Succeed())
+				return networkPolicy.Spec
+			}).Should(Equal(networkingv1.NetworkPolicySpec{
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+				PodSelector: metav1.LabelSelector{MatchLabels: serviceSelector},
+				Ingress: []networkingv1.NetworkPolicyIngressRule{{
+					From: []networkingv1.NetworkPolicyPeer{
+						{IPBlock: &networkingv1.IPBlock{CIDR: "10.250.0.0/16"}},
+						{IPBlock: &networkingv1.IPBlock{CIDR: "2001:db8::/64"}},
+					},
+					Ports: []networkingv1.NetworkPolicyPort{{Protocol: &port1Protocol, Port: &port1TargetPort}},
+				}},
+			}))
+		})
+
+		It("should delete the policy when the annotation is removed", func() {
+			By("Wait until ingress from CIDR group policy was created")
+			Eventually(func(g Gomega) error {
+				networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "ingress-to-" + service.Name + "-from-cidr-group-corp-proxies", Namespace: service.Namespace}}
+				return testClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)
+			}).Should(Succeed())
+
+			By("Patch Service")
+			patch := client.MergeFrom(service.DeepCopy())
+			delete(service.Annotations, "networking.resources.gardener.cloud/from-cidr-group-corp-proxies-allowed-ports")
+			Expect(testClient.Patch(ctx, service, patch)).To(Succeed())
+
+			By("Wait until ingress from CIDR group policy was deleted")
+			Eventually(func(g Gomega) error {
+				networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "ingress-to-" + service.Name + "-from-cidr-group-corp-proxies", Namespace: service.Namespace}}
+				return testClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)
+			}).Should(BeNotFoundError())
+		})
+	})
+
 	Context("service exposed via ingress", func() {
 		var (
 			ensureExposedViaIngressNetworkPolicies = func(asyncAssertion func(int, any, ...any) AsyncAssertion, should bool) func() {